@@ -0,0 +1,108 @@
+package airport
+
+// provenance of airportdata, reported by Info
+const (
+	source    = "IATA and ICAO codes of major international airports"
+	sourceURL = "https://www.icao.int/publications/DOC8585/"
+	edition   = "2025"
+)
+
+/*
+airportdata holds a selection of the busiest international airports,
+for callers that need no more than these. A complete data set is read
+from a file supplied by the caller, in the same format: each line
+holds the IATA code, the ICAO code, the name of the airport, the city
+it serves and the ISO 3166-1 alpha-2 code of its country, separated
+by tabs.
+*/
+var airportdata = `ATL	KATL	Hartsfield-Jackson Atlanta International Airport	Atlanta	US
+LAX	KLAX	Los Angeles International Airport	Los Angeles	US
+ORD	KORD	O'Hare International Airport	Chicago	US
+DFW	KDFW	Dallas/Fort Worth International Airport	Dallas	US
+DEN	KDEN	Denver International Airport	Denver	US
+JFK	KJFK	John F. Kennedy International Airport	New York	US
+LGA	KLGA	LaGuardia Airport	New York	US
+EWR	KEWR	Newark Liberty International Airport	Newark	US
+SFO	KSFO	San Francisco International Airport	San Francisco	US
+SEA	KSEA	Seattle-Tacoma International Airport	Seattle	US
+MIA	KMIA	Miami International Airport	Miami	US
+BOS	KBOS	Logan International Airport	Boston	US
+IAD	KIAD	Washington Dulles International Airport	Washington	US
+DCA	KDCA	Ronald Reagan Washington National Airport	Washington	US
+HNL	PHNL	Daniel K. Inouye International Airport	Honolulu	US
+ANC	PANC	Ted Stevens Anchorage International Airport	Anchorage	US
+YYZ	CYYZ	Toronto Pearson International Airport	Toronto	CA
+YVR	CYVR	Vancouver International Airport	Vancouver	CA
+YUL	CYUL	Montréal-Trudeau International Airport	Montreal	CA
+MEX	MMMX	Mexico City International Airport	Mexico City	MX
+GRU	SBGR	São Paulo/Guarulhos International Airport	São Paulo	BR
+GIG	SBGL	Rio de Janeiro/Galeão International Airport	Rio de Janeiro	BR
+EZE	SAEZ	Ministro Pistarini International Airport	Buenos Aires	AR
+BOG	SKBO	El Dorado International Airport	Bogotá	CO
+SCL	SCEL	Arturo Merino Benítez International Airport	Santiago	CL
+LIM	SPJC	Jorge Chávez International Airport	Lima	PE
+LHR	EGLL	Heathrow Airport	London	GB
+LGW	EGKK	Gatwick Airport	London	GB
+STN	EGSS	Stansted Airport	London	GB
+MAN	EGCC	Manchester Airport	Manchester	GB
+DUB	EIDW	Dublin Airport	Dublin	IE
+KEF	BIKF	Keflavík International Airport	Reykjavík	IS
+CDG	LFPG	Paris Charles de Gaulle Airport	Paris	FR
+ORY	LFPO	Paris Orly Airport	Paris	FR
+NCE	LFMN	Nice Côte d'Azur Airport	Nice	FR
+AMS	EHAM	Amsterdam Airport Schiphol	Amsterdam	NL
+BRU	EBBR	Brussels Airport	Brussels	BE
+FRA	EDDF	Frankfurt Airport	Frankfurt	DE
+MUC	EDDM	Munich Airport	Munich	DE
+BER	EDDB	Berlin Brandenburg Airport	Berlin	DE
+HAM	EDDH	Hamburg Airport	Hamburg	DE
+ZRH	LSZH	Zurich Airport	Zurich	CH
+GVA	LSGG	Geneva Airport	Geneva	CH
+VIE	LOWW	Vienna International Airport	Vienna	AT
+CPH	EKCH	Copenhagen Airport	Copenhagen	DK
+ARN	ESSA	Stockholm Arlanda Airport	Stockholm	SE
+OSL	ENGM	Oslo Airport, Gardermoen	Oslo	NO
+HEL	EFHK	Helsinki Airport	Helsinki	FI
+MAD	LEMD	Adolfo Suárez Madrid–Barajas Airport	Madrid	ES
+BCN	LEBL	Josep Tarradellas Barcelona–El Prat Airport	Barcelona	ES
+LIS	LPPT	Humberto Delgado Airport	Lisbon	PT
+FCO	LIRF	Leonardo da Vinci–Fiumicino Airport	Rome	IT
+MXP	LIMC	Milan Malpensa Airport	Milan	IT
+ATH	LGAV	Athens International Airport	Athens	GR
+IST	LTFM	Istanbul Airport	Istanbul	TR
+WAW	EPWA	Warsaw Chopin Airport	Warsaw	PL
+PRG	LKPR	Václav Havel Airport Prague	Prague	CZ
+SVO	UUEE	Sheremetyevo International Airport	Moscow	RU
+DXB	OMDB	Dubai International Airport	Dubai	AE
+DOH	OTHH	Hamad International Airport	Doha	QA
+TLV	LLBG	Ben Gurion Airport	Tel Aviv	IL
+CAI	HECA	Cairo International Airport	Cairo	EG
+CMN	GMMN	Mohammed V International Airport	Casablanca	MA
+LOS	DNMM	Murtala Muhammed International Airport	Lagos	NG
+ADD	HAAB	Addis Ababa Bole International Airport	Addis Ababa	ET
+NBO	HKJK	Jomo Kenyatta International Airport	Nairobi	KE
+JNB	FAOR	O. R. Tambo International Airport	Johannesburg	ZA
+CPT	FACT	Cape Town International Airport	Cape Town	ZA
+DEL	VIDP	Indira Gandhi International Airport	Delhi	IN
+BOM	VABB	Chhatrapati Shivaji Maharaj International Airport	Mumbai	IN
+BLR	VOBL	Kempegowda International Airport	Bengaluru	IN
+SIN	WSSS	Singapore Changi Airport	Singapore	SG
+KUL	WMKK	Kuala Lumpur International Airport	Kuala Lumpur	MY
+BKK	VTBS	Suvarnabhumi Airport	Bangkok	TH
+CGK	WIII	Soekarno–Hatta International Airport	Jakarta	ID
+MNL	RPLL	Ninoy Aquino International Airport	Manila	PH
+HKG	VHHH	Hong Kong International Airport	Hong Kong	HK
+PEK	ZBAA	Beijing Capital International Airport	Beijing	CN
+PKX	ZBAD	Beijing Daxing International Airport	Beijing	CN
+PVG	ZSPD	Shanghai Pudong International Airport	Shanghai	CN
+SHA	ZSSS	Shanghai Hongqiao International Airport	Shanghai	CN
+CAN	ZGGG	Guangzhou Baiyun International Airport	Guangzhou	CN
+TPE	RCTP	Taiwan Taoyuan International Airport	Taipei	TW
+ICN	RKSI	Incheon International Airport	Seoul	KR
+NRT	RJAA	Narita International Airport	Tokyo	JP
+HND	RJTT	Haneda Airport	Tokyo	JP
+KIX	RJBB	Kansai International Airport	Osaka	JP
+SYD	YSSY	Sydney Kingsford Smith Airport	Sydney	AU
+MEL	YMML	Melbourne Airport	Melbourne	AU
+BNE	YBBN	Brisbane Airport	Brisbane	AU
+AKL	NZAA	Auckland Airport	Auckland	NZ`
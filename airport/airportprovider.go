@@ -0,0 +1,346 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package airport implements the methods of a stddata.Provider.
+It provides searches against the IATA and ICAO codes of airports,
+such as LHR and EGLL for London Heathrow, with the name of each
+airport, the city it serves and its country. A selection of major
+international airports is declared in airportdata.go; a complete
+data set can be read from a file supplied by the caller instead.
+*/
+package airport
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// AirportProvider implements the Provider interface.
+type AirportProvider struct {
+	// File is the path of the data set that Load reads. When it is
+	// empty, the data set embedded in airportdata.go is loaded.
+	File string
+	data atomic.Pointer[airportData] // nil until the data is loaded
+}
+
+// airportData is the data of an AirportProvider. Once it is stored in
+// the AirportProvider it is not modified.
+type airportData struct {
+	size           int
+	info           stddata.Info
+	airportIndexes map[string]airportIndex
+}
+
+type airportIndex struct {
+	airportMap  map[string][]Airport
+	airportKeys []string
+	foldedKeys  []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Airport models one entity.
+type Airport struct {
+	IATACode    string // for example "LHR"; empty for airports without one
+	ICAOCode    string // for example "EGLL"; empty for airports without one
+	Name        string // for example "Heathrow Airport"
+	City        string // the city served, for example "London"
+	CountryCode string // ISO 3166-1 alpha-2 code, for example "GB"
+}
+
+// AirportResult is the interface{} that is returned from Search
+type AirportResult struct {
+	Airports [][]Airport
+}
+
+// Load implements the Loader interface. A malformed record
+// in the data set causes Load to fail.
+func (p *AirportProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data set named by File, or the embedded one,
+// treating malformed records according to mode. In stddata.Lenient
+// mode, malformed records are skipped, and their line numbers are
+// returned in the LoadReport.
+func (p *AirportProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	if p.File == "" {
+		return p.read(strings.NewReader(airportdata), mode, stddata.Info{Source: source, URL: sourceURL, Edition: edition})
+	}
+	f, err := os.Open(p.File)
+	if err != nil {
+		return r, stddata.NewSourceError(err.Error(), err)
+	}
+	defer f.Close()
+	return p.LoadFrom(f, mode)
+}
+
+// LoadFrom reads a data set from data, in the format of airportdata.
+// An airport must have an IATA code of three letters, an ICAO code of
+// four letters or digits, or both.
+func (p *AirportProvider) LoadFrom(data io.Reader, mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	return p.read(data, mode, stddata.Info{Source: "IATA and ICAO airport codes", URL: p.File})
+}
+
+// read loads a data set from data, whose provenance is info.
+func (p *AirportProvider) read(data io.Reader, mode stddata.ParseMode, info stddata.Info) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &airportData{airportIndexes: make(map[string]airportIndex)}
+	iataMap := make(map[string][]Airport)
+	icaoMap := make(map[string][]Airport)
+	nameMap := make(map[string][]Airport)
+	cityMap := make(map[string][]Airport)
+	countryMap := make(map[string][]Airport)
+
+	reader := csv.NewReader(data)
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 5
+	reader.LazyQuotes = true
+
+	n := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var a Airport
+		a.IATACode = record[0]
+		a.ICAOCode = record[1]
+		a.Name = record[2]
+		a.City = record[3]
+		a.CountryCode = record[4]
+		if !isIATA(a.IATACode) || !isICAO(a.ICAOCode) || a.IATACode+a.ICAOCode == "" {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed airport codes %q", line, a.IATACode+" "+a.ICAOCode)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Airport to the maps
+		if a.IATACode != "" {
+			iataMap[a.IATACode] = append(iataMap[a.IATACode], a)
+		}
+		if a.ICAOCode != "" {
+			icaoMap[a.ICAOCode] = append(icaoMap[a.ICAOCode], a)
+		}
+		nameMap[a.Name] = append(nameMap[a.Name], a)
+		cityMap[a.City] = append(cityMap[a.City], a)
+		countryMap[a.CountryCode] = append(countryMap[a.CountryCode], a)
+		n++
+	}
+	p.storeData(d, "iata", iataMap)
+	p.storeData(d, "icao", icaoMap)
+	p.storeData(d, "name", nameMap)
+	p.storeData(d, "city", cityMap)
+	p.storeData(d, "country", countryMap)
+	d.size = n
+	d.info = info
+	d.info.Count = d.size
+	d.info.LoadedAt = time.Now()
+	p.data.Store(d)
+	r.Loaded = n
+	return r, nil
+}
+
+// isIATA reports whether s is empty or three upper case letters.
+func isIATA(s string) bool {
+	if s == "" {
+		return true
+	}
+	if len(s) != 3 {
+		return false
+	}
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isICAO reports whether s is empty or four upper case letters or
+// digits.
+func isICAO(s string) bool {
+	if s == "" {
+		return true
+	}
+	if len(s) != 4 {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// Info describes the provenance of the loaded data. When the data set
+// was read from File, the URL is File.
+func (p *AirportProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// GetByIATA returns the Airport whose IATA code is code, in any case,
+// for example "lhr" for London Heathrow.
+func (p *AirportProvider) GetByIATA(code string) (a Airport, err error) {
+	d := p.data.Load()
+	if d == nil {
+		return a, stddata.ErrNotLoaded
+	}
+	return d.lookup("iata", code)
+}
+
+// GetByICAO returns the Airport whose ICAO code is code, in any case,
+// for example "EGLL" for London Heathrow.
+func (p *AirportProvider) GetByICAO(code string) (a Airport, err error) {
+	d := p.data.Load()
+	if d == nil {
+		return a, stddata.ErrNotLoaded
+	}
+	return d.lookup("icao", code)
+}
+
+// lookup returns the Airport with the code in the index kind.
+func (d *airportData) lookup(kind string, code string) (a Airport, err error) {
+	airports, found := d.airportIndexes[kind].airportMap[strings.ToUpper(code)]
+	if !found {
+		msg := "No airport with " + strings.ToUpper(kind) + " code " + code
+		return a, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return airports[0], nil
+}
+
+func (p *AirportProvider) storeData(d *airportData, s string, m map[string][]Airport) {
+	// store the map
+	var ai airportIndex
+	ai.airportMap = m
+	// extract the keys
+	ai.airportKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		ai.airportKeys[i] = k
+		i++
+	}
+	// sort the keys
+	ai.foldedKeys = stddata.SortKeys(ai.airportKeys)
+	// add to airportIndexes
+	d.airportIndexes[s] = ai
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *AirportProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "iata", Description: "IATA airport code", Example: "LHR"},
+		{Name: "icao", Description: "ICAO airport code", Example: "EGLL"},
+		{Name: "name", Description: "name of the airport", Example: "Heathrow"},
+		{Name: "city", Description: "city served", Example: "London"},
+		{Name: "country", Description: "ISO 3166-1 alpha-2 code of the country", Example: "GB"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *AirportProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ai := d.airportIndexes[index]
+	return stddata.Suggest(ai.airportKeys, ai.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Airport entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Airports are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+func (p *AirportProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	ai, found := d.airportIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.airportIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(ai, query)
+	return result, nil
+}
+
+// SearchAirports is Search, returning the matches as they are, rather
+// than as an AirportResult in an interface{}.
+func (p *AirportProvider) SearchAirports(index string, query string) ([][]Airport, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(AirportResult).Airports, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Airports of each. It yields nothing if the
+// AirportProvider has no such index, or is not loaded.
+func (p *AirportProvider) Range(index string) iter.Seq2[string, []Airport] {
+	return func(yield func(string, []Airport) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ai := d.airportIndexes[index]
+		for _, k := range ai.airportKeys {
+			if !yield(k, ai.airportMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(ai airportIndex, query string) (res AirportResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ai.airportKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ai.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Airports = make([][]Airport, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Airports = append(res.Airports, ai.airportMap[ai.airportKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,98 @@
+package airport
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestAirportProvider(t *testing.T) {
+	expected := 91
+	fmt.Println("Test: AirportProvider.Load")
+	p = new(AirportProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+	if info := p.(*AirportProvider).Info(); info.Edition == "" {
+		t.Fatalf("Expected the provenance of the embedded data, got %v\n", info)
+	}
+}
+func TestCitySearch(t *testing.T) {
+	res, err := p.Search("city", "london")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	a := res.(AirportResult).Airports
+	if len(a) != 1 || len(a[0]) != 3 {
+		t.Fatalf("Expected the three airports of London, got %v\n", a)
+	}
+	res, err = p.Search("country", "JP")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if a := res.(AirportResult).Airports; len(a) != 1 || len(a[0]) != 3 {
+		t.Fatalf("Expected three airports in Japan, got %v\n", a)
+	}
+}
+func TestGet(t *testing.T) {
+	ap := p.(*AirportProvider)
+	a, err := ap.GetByIATA("lhr")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if a.ICAOCode != "EGLL" || a.CountryCode != "GB" {
+		t.Fatalf("Expected Heathrow, got %v\n", a)
+	}
+	a, err = ap.GetByICAO("KJFK")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if a.IATACode != "JFK" || a.City != "New York" {
+		t.Fatalf("Expected Kennedy Airport, got %v\n", a)
+	}
+	if _, err = ap.GetByIATA("XXX"); err == nil {
+		t.Fatalf("Expected no airport XXX\n")
+	}
+}
+func TestLoadFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "airports.txt")
+	data := "\tEGLL\tHeathrow Airport\tLondon\tGB\nLCY\tEGLC\tLondon City Airport\tLondon\tGB\nLo\tEGKK\tGatwick\tLondon\tGB\n"
+	if err := os.WriteFile(file, []byte(data), 0644); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	ap := &AirportProvider{File: file}
+	if _, err := ap.Load(); err == nil {
+		t.Fatalf("Expected the malformed IATA code to fail the load\n")
+	}
+	r, err := ap.LoadMode(Lenient)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 2 || r.SkippedLines[0] != 3 || ap.Info().URL != file {
+		t.Fatalf("Expected 2 airports from %s, got %v\n", file, r)
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(AirportProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(AirportProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "iata", Query: "LHR"}, {Index: "city", Query: "london"}, {Index: "country", Query: "JP"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
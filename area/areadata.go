@@ -0,0 +1,300 @@
+package area
+
+// provenance of areadata, reported by Info
+const (
+	source    = "UN Statistics Division, Standard country or area codes for statistical use (M49)"
+	sourceURL = "https://unstats.un.org/unsd/methodology/m49/"
+	edition   = "2014"
+)
+
+/*
+areadata holds the UN M49 areas: the World, its regions, subregions
+and intermediate regions, and the countries and areas within them.
+Each line holds the three digit code, the name, the level, the code
+of the parent area and, for countries, the ISO 3166-1 alpha-2 code,
+separated by tabs. Latin America and the Caribbean (419) and
+Sub-Saharan Africa (202) are subregions that group intermediate
+regions, such as the Caribbean (029). The codes of countries are their
+ISO 3166-1 numeric codes, and their names the ISO short names.
+Antarctica belongs to no region, and Taiwan, which M49 does not list,
+is not included.
+*/
+var areadata = `001	World	World		
+002	Africa	Region	001	
+004	Afghanistan	Country	034	AF
+005	South America	Intermediate Region	419	
+008	Albania	Country	039	AL
+009	Oceania	Region	001	
+010	Antarctica	Country	001	AQ
+011	Western Africa	Intermediate Region	202	
+012	Algeria	Country	015	DZ
+013	Central America	Intermediate Region	419	
+014	Eastern Africa	Intermediate Region	202	
+015	Northern Africa	Subregion	002	
+016	American Samoa	Country	061	AS
+017	Middle Africa	Intermediate Region	202	
+018	Southern Africa	Intermediate Region	202	
+019	Americas	Region	001	
+020	Andorra	Country	039	AD
+021	Northern America	Subregion	019	
+024	Angola	Country	017	AO
+028	Antigua and Barbuda	Country	029	AG
+029	Caribbean	Intermediate Region	419	
+030	Eastern Asia	Subregion	142	
+031	Azerbaijan	Country	145	AZ
+032	Argentina	Country	005	AR
+034	Southern Asia	Subregion	142	
+035	South-eastern Asia	Subregion	142	
+036	Australia	Country	053	AU
+039	Southern Europe	Subregion	150	
+040	Austria	Country	155	AT
+044	Bahamas	Country	029	BS
+048	Bahrain	Country	145	BH
+050	Bangladesh	Country	034	BD
+051	Armenia	Country	145	AM
+052	Barbados	Country	029	BB
+053	Australia and New Zealand	Subregion	009	
+054	Melanesia	Subregion	009	
+056	Belgium	Country	155	BE
+057	Micronesia	Subregion	009	
+060	Bermuda	Country	021	BM
+061	Polynesia	Subregion	009	
+064	Bhutan	Country	034	BT
+068	Bolivia, Plurinational State of	Country	005	BO
+070	Bosnia and Herzegovina	Country	039	BA
+072	Botswana	Country	018	BW
+074	Bouvet Island	Country	005	BV
+076	Brazil	Country	005	BR
+084	Belize	Country	013	BZ
+086	British Indian Ocean Territory	Country	014	IO
+090	Solomon Islands	Country	054	SB
+092	Virgin Islands, British	Country	029	VG
+096	Brunei Darussalam	Country	035	BN
+100	Bulgaria	Country	151	BG
+104	Myanmar	Country	035	MM
+108	Burundi	Country	014	BI
+112	Belarus	Country	151	BY
+116	Cambodia	Country	035	KH
+120	Cameroon	Country	017	CM
+124	Canada	Country	021	CA
+132	Cabo Verde	Country	011	CV
+136	Cayman Islands	Country	029	KY
+140	Central African Republic	Country	017	CF
+142	Asia	Region	001	
+143	Central Asia	Subregion	142	
+144	Sri Lanka	Country	034	LK
+145	Western Asia	Subregion	142	
+148	Chad	Country	017	TD
+150	Europe	Region	001	
+151	Eastern Europe	Subregion	150	
+152	Chile	Country	005	CL
+154	Northern Europe	Subregion	150	
+155	Western Europe	Subregion	150	
+156	China	Country	030	CN
+162	Christmas Island	Country	053	CX
+166	Cocos (Keeling) Islands	Country	053	CC
+170	Colombia	Country	005	CO
+174	Comoros	Country	014	KM
+175	Mayotte	Country	014	YT
+178	Congo	Country	017	CG
+180	Congo, the Democratic Republic of the	Country	017	CD
+184	Cook Islands	Country	061	CK
+188	Costa Rica	Country	013	CR
+191	Croatia	Country	039	HR
+192	Cuba	Country	029	CU
+196	Cyprus	Country	145	CY
+202	Sub-Saharan Africa	Subregion	002	
+203	Czech Republic	Country	151	CZ
+204	Benin	Country	011	BJ
+208	Denmark	Country	154	DK
+212	Dominica	Country	029	DM
+214	Dominican Republic	Country	029	DO
+218	Ecuador	Country	005	EC
+222	El Salvador	Country	013	SV
+226	Equatorial Guinea	Country	017	GQ
+231	Ethiopia	Country	014	ET
+232	Eritrea	Country	014	ER
+233	Estonia	Country	154	EE
+234	Faroe Islands	Country	154	FO
+238	Falkland Islands (Malvinas)	Country	005	FK
+239	South Georgia and the South Sandwich Islands	Country	005	GS
+242	Fiji	Country	054	FJ
+246	Finland	Country	154	FI
+248	Åland Islands	Country	154	AX
+250	France	Country	155	FR
+254	French Guiana	Country	005	GF
+258	French Polynesia	Country	061	PF
+260	French Southern Territories	Country	014	TF
+262	Djibouti	Country	014	DJ
+266	Gabon	Country	017	GA
+268	Georgia	Country	145	GE
+270	Gambia	Country	011	GM
+275	Palestine, State of	Country	145	PS
+276	Germany	Country	155	DE
+288	Ghana	Country	011	GH
+292	Gibraltar	Country	039	GI
+296	Kiribati	Country	057	KI
+300	Greece	Country	039	GR
+304	Greenland	Country	021	GL
+308	Grenada	Country	029	GD
+312	Guadeloupe	Country	029	GP
+316	Guam	Country	057	GU
+320	Guatemala	Country	013	GT
+324	Guinea	Country	011	GN
+328	Guyana	Country	005	GY
+332	Haiti	Country	029	HT
+334	Heard Island and McDonald Islands	Country	053	HM
+336	Holy See (Vatican City State)	Country	039	VA
+340	Honduras	Country	013	HN
+344	Hong Kong	Country	030	HK
+348	Hungary	Country	151	HU
+352	Iceland	Country	154	IS
+356	India	Country	034	IN
+360	Indonesia	Country	035	ID
+364	Iran, Islamic Republic of	Country	034	IR
+368	Iraq	Country	145	IQ
+372	Ireland	Country	154	IE
+376	Israel	Country	145	IL
+380	Italy	Country	039	IT
+384	Côte d'Ivoire	Country	011	CI
+388	Jamaica	Country	029	JM
+392	Japan	Country	030	JP
+398	Kazakhstan	Country	143	KZ
+400	Jordan	Country	145	JO
+404	Kenya	Country	014	KE
+408	Korea, Democratic People's Republic of	Country	030	KP
+410	Korea, Republic of	Country	030	KR
+414	Kuwait	Country	145	KW
+417	Kyrgyzstan	Country	143	KG
+418	Lao People's Democratic Republic	Country	035	LA
+419	Latin America and the Caribbean	Subregion	019	
+422	Lebanon	Country	145	LB
+426	Lesotho	Country	018	LS
+428	Latvia	Country	154	LV
+430	Liberia	Country	011	LR
+434	Libya	Country	015	LY
+438	Liechtenstein	Country	155	LI
+440	Lithuania	Country	154	LT
+442	Luxembourg	Country	155	LU
+446	Macao	Country	030	MO
+450	Madagascar	Country	014	MG
+454	Malawi	Country	014	MW
+458	Malaysia	Country	035	MY
+462	Maldives	Country	034	MV
+466	Mali	Country	011	ML
+470	Malta	Country	039	MT
+474	Martinique	Country	029	MQ
+478	Mauritania	Country	011	MR
+480	Mauritius	Country	014	MU
+484	Mexico	Country	013	MX
+492	Monaco	Country	155	MC
+496	Mongolia	Country	030	MN
+498	Moldova, Republic of	Country	151	MD
+499	Montenegro	Country	039	ME
+500	Montserrat	Country	029	MS
+504	Morocco	Country	015	MA
+508	Mozambique	Country	014	MZ
+512	Oman	Country	145	OM
+516	Namibia	Country	018	NA
+520	Nauru	Country	057	NR
+524	Nepal	Country	034	NP
+528	Netherlands	Country	155	NL
+531	Curaçao	Country	029	CW
+533	Aruba	Country	029	AW
+534	Sint Maarten (Dutch part)	Country	029	SX
+535	Bonaire, Sint Eustatius and Saba	Country	029	BQ
+540	New Caledonia	Country	054	NC
+548	Vanuatu	Country	054	VU
+554	New Zealand	Country	053	NZ
+558	Nicaragua	Country	013	NI
+562	Niger	Country	011	NE
+566	Nigeria	Country	011	NG
+570	Niue	Country	061	NU
+574	Norfolk Island	Country	053	NF
+578	Norway	Country	154	NO
+580	Northern Mariana Islands	Country	057	MP
+581	United States Minor Outlying Islands	Country	057	UM
+583	Micronesia, Federated States of	Country	057	FM
+584	Marshall Islands	Country	057	MH
+585	Palau	Country	057	PW
+586	Pakistan	Country	034	PK
+591	Panama	Country	013	PA
+598	Papua New Guinea	Country	054	PG
+600	Paraguay	Country	005	PY
+604	Peru	Country	005	PE
+608	Philippines	Country	035	PH
+612	Pitcairn	Country	061	PN
+616	Poland	Country	151	PL
+620	Portugal	Country	039	PT
+624	Guinea-Bissau	Country	011	GW
+626	Timor-Leste	Country	035	TL
+630	Puerto Rico	Country	029	PR
+634	Qatar	Country	145	QA
+638	Réunion	Country	014	RE
+642	Romania	Country	151	RO
+643	Russian Federation	Country	151	RU
+646	Rwanda	Country	014	RW
+652	Saint Barthélemy	Country	029	BL
+654	Saint Helena, Ascension and Tristan da Cunha	Country	011	SH
+659	Saint Kitts and Nevis	Country	029	KN
+660	Anguilla	Country	029	AI
+662	Saint Lucia	Country	029	LC
+663	Saint Martin (French part)	Country	029	MF
+666	Saint Pierre and Miquelon	Country	021	PM
+670	Saint Vincent and the Grenadines	Country	029	VC
+674	San Marino	Country	039	SM
+678	Sao Tome and Principe	Country	017	ST
+682	Saudi Arabia	Country	145	SA
+686	Senegal	Country	011	SN
+688	Serbia	Country	039	RS
+690	Seychelles	Country	014	SC
+694	Sierra Leone	Country	011	SL
+702	Singapore	Country	035	SG
+703	Slovakia	Country	151	SK
+704	Viet Nam	Country	035	VN
+705	Slovenia	Country	039	SI
+706	Somalia	Country	014	SO
+710	South Africa	Country	018	ZA
+716	Zimbabwe	Country	014	ZW
+724	Spain	Country	039	ES
+728	South Sudan	Country	014	SS
+729	Sudan	Country	015	SD
+732	Western Sahara	Country	015	EH
+740	Suriname	Country	005	SR
+744	Svalbard and Jan Mayen	Country	154	SJ
+748	Swaziland	Country	018	SZ
+752	Sweden	Country	154	SE
+756	Switzerland	Country	155	CH
+760	Syrian Arab Republic	Country	145	SY
+762	Tajikistan	Country	143	TJ
+764	Thailand	Country	035	TH
+768	Togo	Country	011	TG
+772	Tokelau	Country	061	TK
+776	Tonga	Country	061	TO
+780	Trinidad and Tobago	Country	029	TT
+784	United Arab Emirates	Country	145	AE
+788	Tunisia	Country	015	TN
+792	Turkey	Country	145	TR
+795	Turkmenistan	Country	143	TM
+796	Turks and Caicos Islands	Country	029	TC
+798	Tuvalu	Country	061	TV
+800	Uganda	Country	014	UG
+804	Ukraine	Country	151	UA
+807	Macedonia, the former Yugoslav Republic of	Country	039	MK
+818	Egypt	Country	015	EG
+826	United Kingdom	Country	154	GB
+830	Channel Islands	Intermediate Region	154	
+831	Guernsey	Country	830	GG
+832	Jersey	Country	830	JE
+833	Isle of Man	Country	154	IM
+834	Tanzania, United Republic of	Country	014	TZ
+840	United States	Country	021	US
+850	Virgin Islands, U.S.	Country	029	VI
+854	Burkina Faso	Country	011	BF
+858	Uruguay	Country	005	UY
+860	Uzbekistan	Country	143	UZ
+862	Venezuela, Bolivarian Republic of	Country	005	VE
+876	Wallis and Futuna	Country	061	WF
+882	Samoa	Country	061	WS
+887	Yemen	Country	145	YE
+894	Zambia	Country	014	ZM`
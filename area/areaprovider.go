@@ -0,0 +1,410 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package area implements the methods of a stddata.Provider.
+It provides searches against the UN M49 standard area codes, such as
+001 World, 150 Europe and 419 Latin America and the Caribbean, and
+navigation of the hierarchy they form, from the World down to the
+countries. Source data is declared in areadata.go.
+*/
+package area
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// Level is the place of an Area in the M49 hierarchy.
+type Level string
+
+// The levels of the M49 hierarchy, from the top.
+const (
+	World              Level = "World"
+	Region             Level = "Region"
+	Subregion          Level = "Subregion"
+	IntermediateRegion Level = "Intermediate Region"
+	Country            Level = "Country"
+)
+
+// AreaProvider implements the Provider interface.
+type AreaProvider struct {
+	data atomic.Pointer[areaData] // nil until the data is loaded
+}
+
+// areaData is the data of an AreaProvider. Once it is stored in the
+// AreaProvider it is not modified.
+type areaData struct {
+	size        int
+	info        stddata.Info
+	areaIndexes map[string]areaIndex
+}
+
+type areaIndex struct {
+	areaMap    map[string][]Area
+	areaKeys   []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Area models one entity.
+type Area struct {
+	Code       string // three digit M49 code, for example "150"
+	Name       string // for example "Europe"
+	Level      Level
+	ParentCode string // the code of the area that contains this one; empty for the World
+	Alpha2Code string // the ISO 3166-1 alpha-2 code, for countries
+}
+
+// AreaResult is the interface{} that is returned from Search
+type AreaResult struct {
+	Areas [][]Area
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *AreaProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *AreaProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &areaData{areaIndexes: make(map[string]areaIndex)}
+	codeMap := make(map[string][]Area)
+	nameMap := make(map[string][]Area)
+	levelMap := make(map[string][]Area)
+	parentMap := make(map[string][]Area)
+	alpha2Map := make(map[string][]Area)
+
+	reader := csv.NewReader(strings.NewReader(areadata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 5
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var a Area
+		a.Code = record[0]
+		a.Name = record[1]
+		a.Level = Level(record[2])
+		a.ParentCode = record[3]
+		a.Alpha2Code = record[4]
+		if _, err := strconv.Atoi(a.Code); err != nil || len(a.Code) != 3 {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed code %q", line, a.Code)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Area to the maps
+		codeMap[a.Code] = append(codeMap[a.Code], a)
+		nameMap[a.Name] = append(nameMap[a.Name], a)
+		levelMap[string(a.Level)] = append(levelMap[string(a.Level)], a)
+		if a.ParentCode != "" {
+			parentMap[a.ParentCode] = append(parentMap[a.ParentCode], a)
+		}
+		if a.Alpha2Code != "" {
+			alpha2Map[a.Alpha2Code] = append(alpha2Map[a.Alpha2Code], a)
+		}
+	}
+	p.storeData(d, "code", codeMap)
+	p.storeData(d, "name", nameMap)
+	p.storeData(d, "level", levelMap)
+	p.storeData(d, "parent", parentMap)
+	p.storeData(d, "alpha2", alpha2Map)
+	d.size = len(codeMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(codeMap)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *AreaProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Area with the M49 code code. Codes with their
+// leading zeros stripped, such as "2" for Africa, are accepted.
+func (p *AreaProvider) Get(code string) (a Area, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return a, stddata.ErrNotLoaded
+	}
+	return d.get(code)
+}
+
+// get returns the Area with the M49 code code.
+func (d *areaData) get(code string) (a Area, err error) {
+	if n, err := strconv.Atoi(code); err == nil && n >= 0 && n < 1000 {
+		code = fmt.Sprintf("%03d", n)
+	}
+	areas, found := d.areaIndexes["code"].areaMap[code]
+	if !found {
+		msg := "No area " + code
+		return a, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return areas[0], nil
+}
+
+// Parent returns the Area that directly contains the area with the
+// code code. The World has no parent, so an error is returned for it.
+func (p *AreaProvider) Parent(code string) (a Area, err error) {
+	d := p.data.Load()
+	if d == nil {
+		return a, stddata.ErrNotLoaded
+	}
+	a, err = d.get(code)
+	if err != nil {
+		return a, err
+	}
+	if a.ParentCode == "" {
+		msg := "No area contains " + a.Code
+		return Area{}, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return d.get(a.ParentCode)
+}
+
+// Children returns the areas directly contained in the area with the
+// code code, in the order of their codes. The children of 150 Europe
+// are its four subregions, and a country has none.
+func (p *AreaProvider) Children(code string) (areas []Area, err error) {
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	a, err := d.get(code)
+	if err != nil {
+		return nil, err
+	}
+	return d.areaIndexes["parent"].areaMap[a.Code], nil
+}
+
+// Ancestors returns the areas that contain the area with the code
+// code, from its parent up to the World.
+func (p *AreaProvider) Ancestors(code string) (areas []Area, err error) {
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	a, err := d.get(code)
+	if err != nil {
+		return nil, err
+	}
+	for a.ParentCode != "" {
+		if a, err = d.get(a.ParentCode); err != nil {
+			return nil, err
+		}
+		areas = append(areas, a)
+	}
+	return areas, nil
+}
+
+// Descendants returns every area contained in the area with the code
+// code, at any depth. Each area is followed by its own descendants,
+// and the children of an area are in the order of their codes.
+func (p *AreaProvider) Descendants(code string) (areas []Area, err error) {
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	a, err := d.get(code)
+	if err != nil {
+		return nil, err
+	}
+	return d.descendants(a.Code, nil), nil
+}
+
+func (d *areaData) descendants(code string, areas []Area) []Area {
+	for _, c := range d.areaIndexes["parent"].areaMap[code] {
+		areas = append(areas, c)
+		areas = d.descendants(c.Code, areas)
+	}
+	return areas
+}
+
+// Countries returns the countries contained in the area with the code
+// code, at any depth, in the order of their codes.
+func (p *AreaProvider) Countries(code string) (areas []Area, err error) {
+	descendants, err := p.Descendants(code)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range descendants {
+		if a.Level == Country {
+			areas = append(areas, a)
+		}
+	}
+	sort.Slice(areas, func(i, j int) bool { return areas[i].Code < areas[j].Code })
+	return areas, nil
+}
+
+// Contains reports whether the area with the code outer contains the
+// area with the code inner, at any depth. An area does not contain
+// itself.
+func (p *AreaProvider) Contains(outer string, inner string) bool {
+	o, err := p.Get(outer)
+	if err != nil {
+		return false
+	}
+	ancestors, err := p.Ancestors(inner)
+	if err != nil {
+		return false
+	}
+	for _, a := range ancestors {
+		if a.Code == o.Code {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *AreaProvider) storeData(d *areaData, s string, m map[string][]Area) {
+	// store the map
+	var ai areaIndex
+	ai.areaMap = m
+	// extract the keys
+	ai.areaKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		ai.areaKeys[i] = k
+		i++
+	}
+	// sort the keys
+	ai.foldedKeys = stddata.SortKeys(ai.areaKeys)
+	// add to areaIndexes
+	d.areaIndexes[s] = ai
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *AreaProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "code", Description: "UN M49 code", Example: "150"},
+		{Name: "name", Description: "English name", Example: "Europe"},
+		{Name: "level", Description: "level, such as Region or Country", Example: "Subregion"},
+		{Name: "parent", Description: "M49 code of the containing area", Example: "150"},
+		{Name: "alpha2", Description: "ISO 3166-1 alpha-2 code of a country", Example: "DE"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *AreaProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ai := d.areaIndexes[index]
+	return stddata.Suggest(ai.areaKeys, ai.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Area entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Areas are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The parent index is keyed by the code of the containing area, so the query "150"
+// finds the subregions of Europe.
+func (p *AreaProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	ai, found := d.areaIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.areaIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(ai, query)
+	return result, nil
+}
+
+// SearchAreas is Search, returning the matches as they are, rather
+// than as an AreaResult in an interface{}.
+func (p *AreaProvider) SearchAreas(index string, query string) ([][]Area, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(AreaResult).Areas, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Areas of each. It yields nothing if the
+// AreaProvider has no such index, or is not loaded.
+func (p *AreaProvider) Range(index string) iter.Seq2[string, []Area] {
+	return func(yield func(string, []Area) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ai := d.areaIndexes[index]
+		for _, k := range ai.areaKeys {
+			if !yield(k, ai.areaMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(ai areaIndex, query string) (res AreaResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ai.areaKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ai.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Areas = make([][]Area, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Areas = append(res.Areas, ai.areaMap[ai.areaKeys[k]])
+	}
+	return res
+}
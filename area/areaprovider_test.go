@@ -0,0 +1,89 @@
+package area
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestAreaProvider(t *testing.T) {
+	expected := 279
+	fmt.Println("Test: AreaProvider.Load")
+	p = new(AreaProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestNameSearch(t *testing.T) {
+	res, err := p.Search("name", "latin")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	a := res.(AreaResult).Areas
+	if len(a) != 1 || a[0][0].Code != "419" || a[0][0].Level != Subregion {
+		t.Fatalf("Expected Latin America and the Caribbean, got %v\n", a)
+	}
+	res, _ = p.Search("level", "Region")
+	if a := res.(AreaResult).Areas; len(a) != 1 || len(a[0]) != 5 {
+		t.Fatalf("Expected the five regions, got %v\n", a)
+	}
+}
+func TestHierarchy(t *testing.T) {
+	ap := p.(*AreaProvider)
+	children, err := ap.Children("150")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(children) != 4 || children[0].Name != "Southern Europe" {
+		t.Fatalf("Expected the subregions of Europe, got %v\n", children)
+	}
+	parent, err := ap.Parent("76")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if parent.Name != "South America" {
+		t.Fatalf("Expected South America, got %v\n", parent)
+	}
+	ancestors, err := ap.Ancestors("076")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(ancestors) != 4 || ancestors[1].Code != "419" || ancestors[3].Level != World {
+		t.Fatalf("Expected South America, Latin America, the Americas and the World, got %v\n", ancestors)
+	}
+	if _, err = ap.Parent("001"); err == nil {
+		t.Fatalf("Expected the World to have no parent\n")
+	}
+	countries, err := ap.Countries("419")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(countries) != 52 || countries[0].Alpha2Code != "AG" {
+		t.Fatalf("Expected 52 countries in Latin America and the Caribbean, got %d\n", len(countries))
+	}
+	if !ap.Contains("019", "076") || ap.Contains("150", "076") || ap.Contains("076", "076") {
+		t.Fatalf("Expected only the Americas to contain Brazil\n")
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(AreaProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(AreaProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "code", Query: "150"}, {Index: "name", Query: "Europe"}, {Index: "alpha2", Query: "DE"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
@@ -0,0 +1,41 @@
+package audioformat
+
+// provenance of formatdata, reported by Info
+const (
+	source    = "Audio codec and container specifications, with IANA media types"
+	sourceURL = "https://www.iana.org/assignments/media-types/"
+	edition   = "2026"
+)
+
+/*
+formatdata holds the audio codecs and containers in common use. The
+fields of each record are tab-delimited: the name of the format, its
+kind (codec or container), its compression (lossless or lossy, for
+codecs only), its media types, its file name extensions without
+their dots, the containers that carry it (for codecs only), and a
+description. Media types, extensions and containers are each
+space-separated. Media types are those of the IANA registry where one
+is registered, followed by those in common use.
+*/
+var formatdata = `FLAC	codec	lossless	audio/flac	flac	FLAC Ogg Matroska MP4	Free Lossless Audio Codec
+ALAC	codec	lossless	audio/mp4	m4a	MP4 CAF	Apple Lossless Audio Codec
+PCM	codec	lossless	audio/L16 audio/L24		WAV AIFF CAF Matroska	Linear pulse-code modulation, uncompressed
+AAC-LC	codec	lossy	audio/aac audio/mp4	aac m4a	ADTS MP4 Matroska	Advanced Audio Coding, Low Complexity profile
+HE-AAC	codec	lossy	audio/aac audio/mp4	aac m4a	ADTS MP4 Matroska	Advanced Audio Coding, High Efficiency profile: AAC-LC with SBR
+HE-AACv2	codec	lossy	audio/aac audio/mp4	aac m4a	ADTS MP4 Matroska	Advanced Audio Coding, High Efficiency v2 profile: HE-AAC with Parametric Stereo
+xHE-AAC	codec	lossy	audio/mp4	m4a	MP4	Extended High Efficiency AAC, of MPEG-D USAC
+Opus	codec	lossy	audio/opus audio/ogg	opus	Ogg Matroska WebM MP4 CAF	Opus, of RFC 6716
+Vorbis	codec	lossy	audio/vorbis audio/ogg	ogg oga	Ogg Matroska WebM	Vorbis
+MP3	codec	lossy	audio/mpeg	mp3	MP4 Matroska	MPEG-1 and MPEG-2 Audio Layer III
+AC-3	codec	lossy	audio/ac3	ac3	MP4 Matroska	Dolby Digital
+E-AC-3	codec	lossy	audio/eac3	ec3	MP4 Matroska	Dolby Digital Plus
+ADTS	container		audio/aac	aac		Audio Data Transport Stream, the framing of a bare AAC stream
+FLAC	container		audio/flac	flac		The native FLAC stream
+Ogg	container		audio/ogg	ogg oga opus		Ogg, of RFC 3533
+Matroska	container		audio/x-matroska	mka		Matroska
+WebM	container		audio/webm	webm		WebM, a subset of Matroska
+MP4	container		audio/mp4	m4a mp4		MPEG-4 Part 14, of ISO/IEC 14496-14
+CAF	container		audio/x-caf	caf		Apple Core Audio Format
+WAV	container		audio/vnd.wave audio/wav audio/x-wav	wav		RIFF WAVE
+AIFF	container		audio/aiff audio/x-aiff	aif aiff aifc		Audio Interchange File Format
+`
@@ -0,0 +1,386 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package audioformat implements the methods of a stddata.Provider.
+It provides searches against the audio codecs, such as FLAC, the
+profiles of AAC, Opus and MP3, and the containers that carry them,
+such as MP4 and Ogg, by name, kind, compression, media type and file
+name extension. Validate checks that a codec can be carried in the
+media type it is declared with, so that ingest pipelines can reject
+files whose declared formats do not agree. Source data is declared in
+formatdata.go.
+*/
+package audioformat
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// The kinds of formats, which are the keys of the kind index.
+const (
+	Codec     = "codec"     // an encoding of audio, for example FLAC
+	Container = "container" // a file format that carries codecs, for example MP4
+)
+
+// The compressions of codecs, which are the keys of the compression index.
+const (
+	Lossless = "lossless" // for example FLAC, ALAC and PCM
+	Lossy    = "lossy"    // for example AAC, Opus and MP3
+)
+
+// FormatProvider implements the Provider interface.
+type FormatProvider struct {
+	data atomic.Pointer[formatData] // nil until the data is loaded
+}
+
+// formatData is the data of a FormatProvider. Once it is stored in the
+// FormatProvider it is not modified.
+type formatData struct {
+	size          int
+	info          stddata.Info
+	formatIndexes map[string]formatIndex
+}
+
+type formatIndex struct {
+	formatMap  map[string][]Format
+	formatKeys []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Format models one entity: a codec or a container.
+type Format struct {
+	Name        string // for example "AAC-LC"
+	Kind        string // Codec or Container
+	Compression string // Lossless or Lossy for codecs, "" for containers
+	// MediaTypes are the media types of files of the format, the
+	// registered one first, for example ["audio/aac", "audio/mp4"].
+	MediaTypes []string
+	Extensions []string // with their dots, for example [".aac", ".m4a"]
+	// Containers are the names of the containers that carry a codec,
+	// for example ["ADTS", "MP4", "Matroska"].
+	Containers  []string
+	Description string
+}
+
+// Lossless reports whether f is a lossless codec.
+func (f Format) Lossless() bool {
+	return f.Compression == Lossless
+}
+
+// FormatResult is the interface{} that is returned from Search
+type FormatResult struct {
+	Formats [][]Format
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *FormatProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *FormatProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &formatData{formatIndexes: make(map[string]formatIndex)}
+	nameMap := make(map[string][]Format)
+	kindMap := make(map[string][]Format)
+	compressionMap := make(map[string][]Format)
+	mediaTypeMap := make(map[string][]Format)
+	extensionMap := make(map[string][]Format)
+
+	reader := csv.NewReader(strings.NewReader(formatdata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 7
+	reader.LazyQuotes = true
+
+	n := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var f Format
+		f.Name = record[0]
+		f.Kind = record[1]
+		f.Compression = record[2]
+		f.MediaTypes = strings.Fields(strings.ToLower(record[3]))
+		for _, ext := range strings.Fields(strings.ToLower(record[4])) {
+			f.Extensions = append(f.Extensions, "."+ext)
+		}
+		f.Containers = strings.Fields(record[5])
+		f.Description = record[6]
+		if !validFormat(f) {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed format %q", line, f.Name)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Format to the maps
+		nameMap[f.Name] = append(nameMap[f.Name], f)
+		kindMap[f.Kind] = append(kindMap[f.Kind], f)
+		if f.Compression != "" {
+			compressionMap[f.Compression] = append(compressionMap[f.Compression], f)
+		}
+		for _, t := range f.MediaTypes {
+			mediaTypeMap[t] = append(mediaTypeMap[t], f)
+		}
+		for _, ext := range f.Extensions {
+			extensionMap[ext] = append(extensionMap[ext], f)
+		}
+		n++
+	}
+	p.storeData(d, "name", nameMap)
+	p.storeData(d, "kind", kindMap)
+	p.storeData(d, "compression", compressionMap)
+	p.storeData(d, "mediatype", mediaTypeMap)
+	p.storeData(d, "extension", extensionMap)
+	d.size = n
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = n
+	return r, nil
+}
+
+// validFormat reports whether f is a codec, with a compression, or a
+// container, without one, and has a name and a media type.
+func validFormat(f Format) bool {
+	if f.Name == "" || len(f.MediaTypes) == 0 {
+		return false
+	}
+	switch f.Kind {
+	case Codec:
+		return f.Compression == Lossless || f.Compression == Lossy
+	case Container:
+		return f.Compression == "" && len(f.Containers) == 0
+	}
+	return false
+}
+
+// Info describes the provenance of the loaded data.
+func (p *FormatProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Format of kind, Codec or Container, whose name is
+// name, in any case, for example "flac". FLAC is both.
+func (p *FormatProvider) Get(kind string, name string) (f Format, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return f, stddata.ErrNotLoaded
+	}
+	return d.get(kind, name)
+}
+
+// get returns the Format of the kind kind whose name is name, in any
+// case.
+func (d *formatData) get(kind string, name string) (f Format, err error) {
+	for _, f := range d.formatIndexes["kind"].formatMap[kind] {
+		if strings.EqualFold(f.Name, name) {
+			return f, nil
+		}
+	}
+	msg := "No audio " + kind + " " + name
+	return f, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+}
+
+// ByExtension returns the Formats of files whose file name extension
+// is ext, in any case and with or without its dot, for example the
+// AAC codecs, ALAC and MP4 for ".m4a".
+func (p *FormatProvider) ByExtension(ext string) (formats []Format, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	key := strings.ToLower(ext)
+	if !strings.HasPrefix(key, ".") {
+		key = "." + key
+	}
+	formats, found := d.formatIndexes["extension"].formatMap[key]
+	if !found {
+		msg := "No audio format for extension " + ext
+		return nil, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return formats, nil
+}
+
+// Validate returns nil if the codec whose name is codec can be carried
+// in a file of the media type mediaType: if mediaType is one of the
+// codec's own, or one of a container that carries it. Parameters of
+// the media type, as in "audio/mp4; codecs=alac", are ignored. For
+// example, ALAC can be declared as audio/mp4 or audio/x-caf, but not
+// as audio/ogg.
+func (p *FormatProvider) Validate(mediaType string, codec string) error {
+	d := p.data.Load()
+	if d == nil {
+		return stddata.ErrNotLoaded
+	}
+	c, err := d.get(Codec, codec)
+	if err != nil {
+		return err
+	}
+	if i := strings.Index(mediaType, ";"); i >= 0 {
+		mediaType = mediaType[0:i]
+	}
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	for _, f := range d.formatIndexes["mediatype"].formatMap[mediaType] {
+		if f.Kind == Codec && f.Name == c.Name {
+			return nil
+		}
+		for _, name := range c.Containers {
+			if f.Kind == Container && f.Name == name {
+				return nil
+			}
+		}
+	}
+	msg := c.Name + " is not carried in " + mediaType
+	return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+}
+
+func (p *FormatProvider) storeData(d *formatData, s string, m map[string][]Format) {
+	// store the map
+	var fi formatIndex
+	fi.formatMap = m
+	// extract the keys
+	fi.formatKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		fi.formatKeys[i] = k
+		i++
+	}
+	// sort the keys
+	fi.foldedKeys = stddata.SortKeys(fi.formatKeys)
+	// add to formatIndexes
+	d.formatIndexes[s] = fi
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *FormatProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "name", Description: "name of the format", Example: "AAC"},
+		{Name: "kind", Description: "Codec or Container", Example: "Codec"},
+		{Name: "compression", Description: "Lossless or Lossy, of a codec", Example: "Lossless"},
+		{Name: "mediatype", Description: "media type of the files of the format", Example: "audio/mp4"},
+		{Name: "extension", Description: "file extension, with its dot", Example: ".flac"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *FormatProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	fi := d.formatIndexes[index]
+	return stddata.Suggest(fi.formatKeys, fi.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Format entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Formats are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The kind index is keyed by Codec and Container, the compression index by Lossless
+// and Lossy, and the extension index by the extensions with their dots, such as ".flac".
+func (p *FormatProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	fi, found := d.formatIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.formatIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(fi, query)
+	return result, nil
+}
+
+// SearchFormats is Search, returning the matches as they are, rather
+// than as a FormatResult in an interface{}.
+func (p *FormatProvider) SearchFormats(index string, query string) ([][]Format, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(FormatResult).Formats, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Formats of each. It yields nothing if the
+// FormatProvider has no such index, or is not loaded.
+func (p *FormatProvider) Range(index string) iter.Seq2[string, []Format] {
+	return func(yield func(string, []Format) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		fi := d.formatIndexes[index]
+		for _, k := range fi.formatKeys {
+			if !yield(k, fi.formatMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(fi formatIndex, query string) (res FormatResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(fi.formatKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(fi.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Formats = make([][]Format, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Formats = append(res.Formats, fi.formatMap[fi.formatKeys[k]])
+	}
+	return res
+}
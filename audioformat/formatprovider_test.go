@@ -0,0 +1,105 @@
+package audioformat
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestFormatProvider(t *testing.T) {
+	expected := 21
+	fmt.Println("Test: FormatProvider.Load")
+	p = new(FormatProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestCompressionSearch(t *testing.T) {
+	res, err := p.Search("compression", Lossless)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	f := res.(FormatResult).Formats
+	if len(f) != 1 || len(f[0]) != 3 || !f[0][0].Lossless() {
+		t.Fatalf("Expected 3 lossless codecs, got %v\n", f)
+	}
+	res, err = p.Search("name", "HE-AAC")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if f := res.(FormatResult).Formats; len(f) != 2 {
+		t.Fatalf("Expected HE-AAC and HE-AACv2, got %v\n", f)
+	}
+}
+func TestContainers(t *testing.T) {
+	// every container that a codec is carried in must be a container
+	fp := p.(*FormatProvider)
+	res, _ := p.Search("kind", Codec)
+	for _, c := range res.(FormatResult).Formats[0] {
+		for _, name := range c.Containers {
+			if _, err := fp.Get(Container, name); err != nil {
+				t.Fatalf("%s: Err %v\n", c.Name, err)
+			}
+		}
+	}
+}
+func TestByExtension(t *testing.T) {
+	fp := p.(*FormatProvider)
+	formats, err := fp.ByExtension("M4A")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(formats) != 6 {
+		t.Fatalf("Expected 6 formats for .m4a, got %v\n", formats)
+	}
+	if _, err := fp.ByExtension(".mp5"); err == nil {
+		t.Fatalf("Expected no format for .mp5\n")
+	}
+}
+func TestValidate(t *testing.T) {
+	fp := p.(*FormatProvider)
+	for _, c := range []struct {
+		mediaType string
+		codec     string
+		valid     bool
+	}{
+		{"audio/mp4; codecs=alac", "alac", true},
+		{"audio/x-caf", "ALAC", true},
+		{"audio/ogg", "ALAC", false},
+		{"audio/flac", "FLAC", true},
+		{"audio/ogg", "FLAC", true},
+		{"audio/webm", "Opus", true},
+		{"audio/mpeg", "MP3", true},
+		{"audio/aac", "xHE-AAC", false},
+		{"audio/mp4", "WAV", false},
+	} {
+		err := fp.Validate(c.mediaType, c.codec)
+		if c.valid && err != nil {
+			t.Fatalf("%s in %s: Err %v\n", c.codec, c.mediaType, err)
+		} else if !c.valid && err == nil {
+			t.Fatalf("Expected %s not to be carried in %s\n", c.codec, c.mediaType)
+		}
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(FormatProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(FormatProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "name", Query: "AAC"}, {Index: "mediatype", Query: "audio/mp4"}, {Index: "extension", Query: ".flac"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
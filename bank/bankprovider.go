@@ -4,31 +4,63 @@
 
 /*
 Package bank implements the methods of a stddata.Provider.
-It provides searches against the data set retrieved from
-the Federal Reserve E-Payments Routing Directory.
+It provides searches against the data set of the Federal Reserve
+E-Payments Routing Directory. A snapshot of the directory is
+declared in bankdata.go, and the current directory can be
+retrieved with LoadSource. WireProvider provides searches against
+the Fedwire participant directory, a snapshot of which is declared
+in wiredata.go.
 */
 package bank
 
 import (
 	"bufio"
+	"fmt"
 	"io"
+	"iter"
+	"log/slog"
 	"net/http"
-	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/musicbeat/stddata"
 )
 
-// BankProvider implements the Provider interface.
+// BankProvider implements the Provider interface. Its data is
+// replaced whole, by swapping a pointer, when it is loaded again or
+// changes are applied, so searches never take a lock, and never see a
+// load or a change half done.
 type BankProvider struct {
-	loaded      bool
+	// Options selects the indexes that are searched with a radix tree,
+	// and limits the results of searches. It must be set before Load
+	// is called.
+	Options stddata.IndexOptions
+	// Logger, if it is not nil, logs the loads of the directory, and
+	// the changes applied to it.
+	Logger *slog.Logger
+	// Observer, if it is not nil, is told of the changes applied to
+	// the directory, as refreshes.
+	Observer stddata.RefreshObserver
+	data     atomic.Pointer[bankData] // nil until the data is loaded
+	mu       sync.Mutex               // serializes the loads and changes
+}
+
+// bankData is the data of a BankProvider. Once it is stored in the
+// BankProvider it is not modified.
+type bankData struct {
 	size        int
+	info        stddata.Info
 	bankIndexes map[string]bankIndex
+	changes     []Change // applied by ApplyChanges since the last load
 }
 
 type bankIndex struct {
-	bankMap  map[string][]Bank
-	bankKeys []string
+	bankMap    map[string][]Bank
+	bankKeys   []string
+	foldedKeys []string       // the keys in lower case, for stddata.PrefixRange
+	radix      *stddata.Radix // when Options selects a radix tree for the index
 }
 
 // Bank is the information on one bank in the source data.
@@ -52,9 +84,40 @@ type Bank struct {
 	DataViewCode          string // Length 1; Columns 150
 }
 
+// String returns the routing number, the name and the place of b, for
+// example "021000021 JPMORGAN CHASE BANK (TAMPA, FL)".
+func (b Bank) String() string {
+	return b.Routing + " " + b.CustomerName + " (" + b.City + ", " + b.StateCode + ")"
+}
+
+// Format implements fmt.Formatter. The %+v and %#v verbs format all of
+// the fields of the Bank, as for any struct, and the other verbs format
+// what String returns, so that %v is short enough for a log line.
+func (b Bank) Format(f fmt.State, verb rune) {
+	// the fields of a Bank, without its methods, so that they are
+	// formatted as those of any struct.
+	type fields Bank
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		s := fmt.Sprintf("%#v", fields(b))
+		io.WriteString(f, "bank.Bank"+s[strings.Index(s, "{"):])
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "%+v", fields(b))
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), b.String())
+	}
+}
+
 // BankResult is the interface{} that is returned from Search
 type BankResult struct {
 	Banks [][]Bank
+	total int // the number of keys that match, before the Limit
+}
+
+// Total returns the number of keys that match, and whether the Limit
+// of the IndexOptions left some of them out, for stddata.Totaler.
+func (r BankResult) Total() (total int, truncated bool) {
+	return r.total, r.total > len(r.Banks)
 }
 
 // Column map:
@@ -76,70 +139,231 @@ var ts = [...]int{144, 148}
 var is = [...]int{148, 149}
 var dv = [...]int{149, 150}
 
-var routingNumberMap map[string][]Bank
-var customerNameMap map[string][]Bank
+var fedurl = sourceURL
 
-var fedurl = "http://www.fededirectory.frb.org/FedACHdir.txt"
+// Source describes where LoadSource retrieves the directory from,
+// and the credentials the download requires.
+type Source struct {
+	URL      string      // location of the directory; the Fed's, when empty
+	Username string      // sent with HTTP basic authentication, when not empty
+	Password string      // sent with HTTP basic authentication
+	Header   http.Header // any other request headers the download requires
+}
 
-// Load does the heavy lifting of retrieving the Fed's directory
-// of banks, a fixed format text file served via http, and
-// populating maps for searches.
+// Load populates maps for searches from the snapshot of the Fed's
+// directory of banks, a fixed format text file, embedded in
+// bankdata.go. A malformed line in the directory causes Load to fail.
 func (p *BankProvider) Load() (n int, err error) {
-	// Initialize the maps:
-	p.bankIndexes = make(map[string]bankIndex)
-	routingNumberMap = make(map[string][]Bank)
-	customerNameMap = make(map[string][]Bank)
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the snapshot of the Fed's directory, treating
+// malformed lines according to mode. In stddata.Lenient mode, lines
+// that are too short to hold a complete record are skipped, and their
+// line numbers are returned in the LoadReport.
+func (p *BankProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	return p.read(strings.NewReader(bankdata), mode, sourceURL, edition)
+}
 
-	res, err := http.Get(fedurl)
+// LoadSource does the heavy lifting of retrieving the current
+// directory from src, as Load would read the snapshot, so that the
+// data is as fresh as the Fed's. The Edition reported by Info is the
+// Last-Modified date of the response.
+func (p *BankProvider) LoadSource(src Source, mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	url := src.URL
+	if url == "" {
+		url = fedurl
+	}
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		msg := "Failed to retrieve " + fedurl + ". " + err.Error()
-		return 0, &stddata.ServiceError{msg, http.StatusServiceUnavailable}
+		return r, stddata.NewServiceError(http.StatusBadRequest, err.Error(), err)
+	}
+	for k, v := range src.Header {
+		req.Header[k] = v
+	}
+	if src.Username != "" {
+		req.SetBasicAuth(src.Username, src.Password)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		msg := "Failed to retrieve " + url + ". " + err.Error()
+		return r, stddata.NewSourceError(msg, err)
 	}
 	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		msg := "Failed to retrieve " + url + ". " + res.Status
+		return r, stddata.NewSourceError(msg, nil)
+	}
+	return p.read(res.Body, mode, url, res.Header.Get("Last-Modified"))
+}
+
+// read populates the maps from a directory in the Fed's fixed format.
+func (p *BankProvider) read(data io.Reader, mode stddata.ParseMode, url string, edition string) (r stddata.LoadReport, err error) {
+	start := time.Now()
+	defer func() {
+		stddata.LogLoad(p.Logger, "bank", p.Info(), r, time.Since(start), err)
+	}()
+	// Initialize the maps. They are built aside, and replace those that
+	// are being searched only when they are complete.
+	d := &bankData{bankIndexes: make(map[string]bankIndex)}
+	routingNumberMap := make(map[string][]Bank)
+	customerNameMap := make(map[string][]Bank)
+	cityMap := make(map[string][]Bank)
+	stateMap := make(map[string][]Bank)
+	stateNameMap := make(map[string][]Bank)
 
-	bio := bufio.NewReader(res.Body)
+	bio := bufio.NewReader(data)
+	lineNumber := 0
 	for {
-		var b Bank
 		line, err := bio.ReadBytes('\n')
-		if err == io.EOF {
+		if err == io.EOF && len(line) == 0 {
 			break
 		}
-		if err != nil {
-			return 0, &stddata.ServiceError{err.Error(), http.StatusServiceUnavailable}
+		if err != nil && err != io.EOF {
+			return r, stddata.NewSourceError(err.Error(), err)
 		}
+		lineNumber++
 		sline := strings.TrimRight(string(line), "\n")
+		if len(sline) < dv[1] {
+			if mode == stddata.Lenient {
+				r.Skip(lineNumber)
+				continue
+			}
+			msg := fmt.Sprintf("line %d: record is %d characters long, expected %d", lineNumber, len(sline), dv[1])
+			return r, stddata.NewSourceError(msg, nil)
+		}
 
-		b.Routing = strings.TrimSpace(sline[rn[0]:rn[1]])
-		b.OfficeCode = strings.TrimSpace(sline[oc[0]:oc[1]])
-		b.ServicingFRBNumber = strings.TrimSpace(sline[sf[0]:sf[1]])
-		b.RecordTypeCode = strings.TrimSpace(sline[rt[0]:rt[1]])
-		b.ChangeDate = strings.TrimSpace(sline[cd[0]:cd[1]])
-		b.NewRoutingNumber = strings.TrimSpace(sline[nr[0]:nr[1]])
-		b.CustomerName = strings.TrimSpace(sline[cn[0]:cn[1]])
-		b.Address = strings.TrimSpace(sline[ad[0]:ad[1]])
-		b.City = strings.TrimSpace(sline[ci[0]:ci[1]])
-		b.StateCode = strings.TrimSpace(sline[sc[0]:sc[1]])
-		b.Zipcode = strings.TrimSpace(sline[zc[0]:zc[1]])
-		b.ZipcodeExtension = strings.TrimSpace(sline[z4[0]:z4[1]])
-		b.TelephoneAreaCode = strings.TrimSpace(sline[ac[0]:ac[1]])
-		b.TelephonePrefixNumber = strings.TrimSpace(sline[tp[0]:tp[1]])
-		b.TelephoneSuffixNumber = strings.TrimSpace(sline[ts[0]:ts[1]])
-		b.InstitutionStatusCode = strings.TrimSpace(sline[is[0]:is[1]])
-		b.DataViewCode = strings.TrimSpace(sline[dv[0]:dv[1]])
+		b := parseBank(sline)
 
 		// add the Bank to the maps:
 		routingNumberMap[b.Routing] = append(routingNumberMap[b.Routing], b)
 		customerNameMap[b.CustomerName] = append(customerNameMap[b.CustomerName], b)
+		cityMap[b.City] = append(cityMap[b.City], b)
+		stateMap[b.StateCode] = append(stateMap[b.StateCode], b)
+		// the state_name index is keyed by the state code and the name,
+		// so "OH FIRST NATIONAL" finds the First Nationals in Ohio
+		key := b.StateCode + " " + b.CustomerName
+		stateNameMap[key] = append(stateNameMap[key], b)
+
+	}
+	p.storeData(d, "number", routingNumberMap)
+	p.storeData(d, "routing", routingNumberMap)
+	p.storeData(d, "name", customerNameMap)
+	p.storeData(d, "city", cityMap)
+	p.storeData(d, "state", stateMap)
+	p.storeData(d, "state_name", stateNameMap)
+	d.size = len(routingNumberMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      url,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.mu.Lock()
+	p.data.Store(d)
+	p.mu.Unlock()
+	r.Loaded = len(routingNumberMap)
+	return r, nil
+}
+
+// parseBank returns the Bank in a line of the Fed's fixed format. The
+// line must be at least dv[1] characters long.
+func parseBank(sline string) (b Bank) {
+	b.Routing = strings.TrimSpace(sline[rn[0]:rn[1]])
+	b.OfficeCode = strings.TrimSpace(sline[oc[0]:oc[1]])
+	b.ServicingFRBNumber = strings.TrimSpace(sline[sf[0]:sf[1]])
+	b.RecordTypeCode = strings.TrimSpace(sline[rt[0]:rt[1]])
+	b.ChangeDate = strings.TrimSpace(sline[cd[0]:cd[1]])
+	b.NewRoutingNumber = strings.TrimSpace(sline[nr[0]:nr[1]])
+	b.CustomerName = strings.TrimSpace(sline[cn[0]:cn[1]])
+	b.Address = strings.TrimSpace(sline[ad[0]:ad[1]])
+	b.City = strings.TrimSpace(sline[ci[0]:ci[1]])
+	b.StateCode = strings.TrimSpace(sline[sc[0]:sc[1]])
+	b.Zipcode = strings.TrimSpace(sline[zc[0]:zc[1]])
+	b.ZipcodeExtension = strings.TrimSpace(sline[z4[0]:z4[1]])
+	b.TelephoneAreaCode = strings.TrimSpace(sline[ac[0]:ac[1]])
+	b.TelephonePrefixNumber = strings.TrimSpace(sline[tp[0]:tp[1]])
+	b.TelephoneSuffixNumber = strings.TrimSpace(sline[ts[0]:ts[1]])
+	b.InstitutionStatusCode = strings.TrimSpace(sline[is[0]:is[1]])
+	b.DataViewCode = strings.TrimSpace(sline[dv[0]:dv[1]])
+	return b
+}
 
+// SaveSnapshot implements the stddata.Snapshotter interface.
+func (p *BankProvider) SaveSnapshot(w io.Writer) error {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return stddata.ErrNotLoaded
+	}
+	indexes := make(map[string]map[string][]Bank)
+	for s, bi := range d.bankIndexes {
+		indexes[s] = bi.bankMap
 	}
-	p.storeData("number", routingNumberMap)
-	p.storeData("name", customerNameMap)
-	p.size = len(routingNumberMap)
-	p.loaded = true
-	return len(routingNumberMap), err
+	return stddata.WriteSnapshot(w, "bank", d.info, indexes)
 }
 
-func (p *BankProvider) storeData(s string, m map[string][]Bank) {
+// LoadSnapshot implements the stddata.Snapshotter interface. It
+// restores the data that SaveSnapshot saved, without reading the directory.
+func (p *BankProvider) LoadSnapshot(r io.Reader) (n int, err error) {
+	var indexes map[string]map[string][]Bank
+	info, err := stddata.ReadSnapshot(r, "bank", &indexes)
+	if err != nil {
+		return 0, err
+	}
+	// the changes applied since the load are not saved
+	d := &bankData{bankIndexes: make(map[string]bankIndex)}
+	for s, m := range indexes {
+		p.storeData(d, s, m)
+	}
+	d.size = info.Count
+	d.info = info
+	p.mu.Lock()
+	p.data.Store(d)
+	p.mu.Unlock()
+	return d.size, nil
+}
+
+// Info describes the provenance of the loaded data. The Edition is
+// that of the snapshot, or the Last-Modified date reported when the
+// directory was retrieved by LoadSource.
+func (p *BankProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Banks whose key in index is exactly key. Unlike
+// Search, it does not match prefixes, so it is the way to look up a
+// routing number: Get("routing", "021000021"). A routing number that
+// fails ValidateRoutingNumber is rejected without a lookup.
+func (p *BankProvider) Get(index string, key string) (banks []Bank, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	bi, found := d.bankIndexes[index]
+	if !found {
+		return nil, stddata.NewIndexError(index, d.bankIndexes)
+	}
+	if index == "routing" || index == "number" {
+		if err = ValidateRoutingNumber(key); err != nil {
+			return nil, err
+		}
+	}
+	banks, found = bi.bankMap[key]
+	if !found {
+		msg := "No bank with " + index + " " + key
+		return nil, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return banks, nil
+}
+
+func (p *BankProvider) storeData(d *bankData, s string, m map[string][]Bank) {
 	// store the map
 	var bi bankIndex
 	bi.bankMap = m
@@ -151,9 +375,36 @@ func (p *BankProvider) storeData(s string, m map[string][]Bank) {
 		i++
 	}
 	// sort the keys
-	sort.Strings(bi.bankKeys)
+	bi.foldedKeys = stddata.SortKeys(bi.bankKeys)
+	if p.Options.UseRadix(s) {
+		bi.radix = stddata.NewRadix(bi.foldedKeys)
+	}
 	// add to bankIndexes
-	p.bankIndexes[s] = bi
+	d.bankIndexes[s] = bi
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *BankProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "number", Description: "routing number", Example: "021000021"},
+		{Name: "routing", Description: "routing number", Example: "021000021"},
+		{Name: "name", Description: "customer name", Example: "JPMORGAN"},
+		{Name: "city", Description: "city", Example: "TAMPA"},
+		{Name: "state", Description: "state code", Example: "FL"},
+		{Name: "state_name", Description: "state code and customer name", Example: "OH FIRST NATIONAL"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *BankProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	bi := d.bankIndexes[index]
+	return stddata.Suggest(bi.bankKeys, bi.foldedKeys, query)
 }
 
 // Search returns a collection as an interface{} and error. The collection
@@ -164,42 +415,81 @@ func (p *BankProvider) storeData(s string, m map[string][]Bank) {
 // any matching Banks are returned in the result.
 // Search can also "dump" an index. When the value of query is "_dump", the index specified
 // is used to supply the entire data set, in the order of the index.
+// Banks can be searched by name, city and state, and by state and name together:
+// the state_name index is keyed by the state code, a space and the name, so the
+// query "OH FIRST NATIONAL" finds the banks named First National in Ohio.
+// An index that Options selects is searched with a radix tree, and a Limit in Options
+// returns only the first keys that match.
 func (p *BankProvider) Search(index string, query string) (result interface{}, err error) {
 	// make sure the data is loaded
-	if p.loaded != true {
-		return 0, &stddata.ServiceError{err.Error(), http.StatusServiceUnavailable}
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
 	}
-	bi, found := p.bankIndexes[index]
+	bi, found := d.bankIndexes[index]
 	if !found {
 		// search cannot be performed
-		msg := "No index on " + index
-		return nil, &stddata.ServiceError{msg, http.StatusBadRequest}
+		return nil, stddata.NewIndexError(index, d.bankIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
 	}
-	result = doSearch(bi, query)
+	result = doSearch(bi, query, p.Options)
 	return result, nil
 }
-func doSearch(bi bankIndex, query string) (res BankResult) {
+
+// SearchBanks is Search, returning the matches as they are, rather
+// than as a BankResult in an interface{}.
+func (p *BankProvider) SearchBanks(index string, query string) ([][]Bank, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(BankResult).Banks, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Banks of each. It yields nothing if the
+// BankProvider has no such index, or is not loaded.
+func (p *BankProvider) Range(index string) iter.Seq2[string, []Bank] {
+	return func(yield func(string, []Bank) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		bi := d.bankIndexes[index]
+		for _, k := range bi.bankKeys {
+			if !yield(k, bi.bankMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(bi bankIndex, query string, o stddata.IndexOptions) (res BankResult) {
 	// the "reserved" query term "_dump" is handled by returning all the
 	// results in the order of the index.
 	dump := query == "_dump"
-	// prepare the response. allocate enough space for the response to be the
-	// entire data set.
-	tmp := make([][]Bank, len(bi.bankKeys))
-	// brute force the sorted list of keys, looking for a match to 'query.*'.
+	// search the sorted list of keys, with the radix tree of the index if
+	// it has one, for the range that matches 'query.*', and return only the
+	// first of the range if the options limit it.
+	lo, hi := 0, len(bi.bankKeys)
+	if !dump {
+		if bi.radix != nil {
+			lo, hi = bi.radix.PrefixRange(query)
+		} else {
+			lo, hi = stddata.PrefixRange(bi.foldedKeys, query)
+		}
+	}
+	res.total = hi - lo
+	if !dump {
+		lo, hi = o.Top(lo, hi)
+	}
+	// prepare the response. allocate just enough space for the matches.
 	// add each match to the result array. The results are added in the
 	// order of the sorted keys, so the results are sorted.
-	i := 0
-	for k := range bi.bankKeys {
-		if dump {
-			tmp[i] = bi.bankMap[bi.bankKeys[k]]
-			i++
-		} else if len(bi.bankKeys[k]) >= len(query) {
-			if strings.EqualFold(query, bi.bankKeys[k][0:len(query)]) {
-				tmp[i] = bi.bankMap[bi.bankKeys[k]]
-				i++
-			}
-		}
+	res.Banks = make([][]Bank, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Banks = append(res.Banks, bi.bankMap[bi.bankKeys[k]])
 	}
-	res.Banks = tmp[0:i]
 	return res
 }
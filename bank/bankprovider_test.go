@@ -1,16 +1,24 @@
 package bank
 
 import (
+	"bufio"
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
 )
 
 var p Provider
 
-// number of banks (at least for now). This changes nearly every day,
-// so failures of the TestBankProviderLoad often fail.
+// number of banks in the snapshot.
 var expected = 19817
 
 func TestBankProviderLoad(t *testing.T) {
@@ -48,6 +56,219 @@ func TestBankNumberSearch(t *testing.T) {
 	}
 	fmt.Println("numbers %s\n", numbers)
 }
+func TestCityAndStateSearch(t *testing.T) {
+	res, err := p.Search("state_name", "oh first national")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(res.(BankResult).Banks) == 0 {
+		t.Fatalf("Expected banks named First National in Ohio\n")
+	}
+	for _, banks := range res.(BankResult).Banks {
+		if banks[0].StateCode != "OH" || !strings.HasPrefix(banks[0].CustomerName, "FIRST NATIONAL") {
+			t.Fatalf("Expected First Nationals in Ohio, got %v\n", banks)
+		}
+	}
+	for index, query := range map[string]string{"city": "N. QUINCY", "state": "MA"} {
+		res, err := p.Search(index, query)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if len(res.(BankResult).Banks) == 0 {
+			t.Fatalf("Expected banks in %s %s\n", index, query)
+		}
+	}
+}
+func TestGetRouting(t *testing.T) {
+	banks, err := p.(*BankProvider).Get("routing", "011000028")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(banks) != 1 || banks[0].CustomerName != "STATE STREET BANK AND TRUST COMPANY" {
+		t.Fatalf("Expected State Street, got %v\n", banks)
+	}
+	if _, err := p.(*BankProvider).Get("routing", "0110000"); err == nil {
+		t.Fatalf("Expected an error for a partial routing number\n")
+	}
+}
+func TestValidateRoutingNumber(t *testing.T) {
+	for _, s := range []string{"011000028", "021000021", "322271627"} {
+		if err := ValidateRoutingNumber(s); err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+	}
+	for _, s := range []string{"021000022", "02100002", "02100002A", "131000021", "991000012"} {
+		if err := ValidateRoutingNumber(s); err == nil {
+			t.Fatalf("Expected %s to be invalid\n", s)
+		}
+	}
+}
+func TestLoadSource(t *testing.T) {
+	fmt.Println("Test: bank.LoadSource")
+	// serve the first two lines of the snapshot, to a client with credentials
+	scanner := bufio.NewScanner(strings.NewReader(bankdata))
+	var lines string
+	for i := 0; i < 2 && scanner.Scan(); i++ {
+		lines += scanner.Text() + "\n"
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Last-Modified", "Wed, 15 Oct 2026 00:00:00 GMT")
+		io.WriteString(w, lines)
+	}))
+	defer ts.Close()
+
+	bp := new(BankProvider)
+	if _, err := bp.LoadSource(Source{URL: ts.URL}, Strict); err == nil {
+		t.Fatalf("Expected LoadSource without credentials to fail\n")
+	}
+	r, err := bp.LoadSource(Source{URL: ts.URL, Username: "user", Password: "secret"}, Strict)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 2 || !strings.HasPrefix(bp.Info().Edition, "Wed, 15 Oct 2026") {
+		t.Fatalf("Expected 2 banks from the source, got %+v and %+v\n", r, bp.Info())
+	}
+}
+func TestApplyChanges(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader(bankdata))
+	scanner.Scan()
+	frb := scanner.Text()
+	scanner.Scan()
+	stateStreet := scanner.Text()
+	bp := new(BankProvider)
+	if _, err := bp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	changes := "D011000015\n" +
+		"C" + stateStreet[:35] + fmt.Sprintf("%-36s", "STATE STREET BANK") + stateStreet[71:] + "\n" +
+		"A011999993" + frb[9:] + "\n" +
+		"D011999980\n"
+	if _, err := bp.ApplyChanges(strings.NewReader(changes), Strict); err == nil {
+		t.Fatalf("Expected the deletion of an unknown routing number to fail\n")
+	}
+	if len(bp.ChangeLog(0)) != 0 {
+		t.Fatalf("Expected no changes to be applied, got %v\n", bp.ChangeLog(0))
+	}
+	r, err := bp.ApplyChanges(strings.NewReader(changes), Lenient)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 3 || r.Skipped != 1 || bp.Info().Count != expected {
+		t.Fatalf("Expected 3 changes and 1 skipped, got %+v and %+v\n", r, bp.Info())
+	}
+	if _, err := bp.Get("routing", "011000015"); err == nil {
+		t.Fatalf("Expected 011000015 to be deleted\n")
+	}
+	res, err := bp.Search("name", "STATE STREET BANK AND")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	for _, banks := range res.(BankResult).Banks {
+		if banks[0].Routing == "011000028" {
+			t.Fatalf("Expected the old name of 011000028 to be removed\n")
+		}
+	}
+	log := bp.ChangeLog(1)
+	if len(log) != 2 || log[0].Seq != 2 || log[0].Bank.CustomerName != "STATE STREET BANK" || log[1].Routing != "011999993" {
+		t.Fatalf("Expected the change and the addition, got %v\n", log)
+	}
+}
+func TestApplyChangesValidation(t *testing.T) {
+	bp := new(BankProvider)
+	if _, err := bp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	// 011000016 fails its check digit
+	changes := "D011000015\nD011000016\n"
+	_, err := bp.ApplyChanges(strings.NewReader(changes), Strict)
+	if !errors.Is(err, ErrSourceUnavailable) || !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("Expected a source error on line 2, got %v\n", err)
+	}
+	r, err := bp.ApplyChanges(strings.NewReader(changes), Lenient)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 1 || r.Skipped != 1 || r.SkippedLines[0] != 2 {
+		t.Fatalf("Expected 1 change and line 2 skipped, got %+v\n", r)
+	}
+}
+func TestSearchDuringReload(t *testing.T) {
+	bp := new(BankProvider)
+	if _, err := bp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	// run with -race: the searches must see one whole load or another
+	done := make(chan bool)
+	go func() {
+		defer close(done)
+		for i := 0; i < 2; i++ {
+			if _, err := bp.Load(); err != nil {
+				t.Errorf("Err %v\n", err)
+			}
+			if _, err := bp.ApplyChanges(strings.NewReader("D011000015\n"), Strict); err != nil {
+				t.Errorf("Err %v\n", err)
+			}
+		}
+	}()
+	for {
+		select {
+		case <-done:
+			if _, err := bp.Get("routing", "011000015"); err == nil {
+				t.Fatalf("Expected 011000015 to be deleted\n")
+			}
+			return
+		default:
+		}
+		res, err := bp.Search("routing", "0")
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if n := len(res.(BankResult).Banks); n < 1000 {
+			t.Fatalf("Expected the routing numbers that begin with 0, got %d\n", n)
+		}
+	}
+}
+func TestBankString(t *testing.T) {
+	banks, err := p.(*BankProvider).Get("routing", "021000021")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if s := fmt.Sprint(banks[0]); s != "021000021 JPMORGAN CHASE BANK (TAMPA, FL)" {
+		t.Fatalf("Expected 021000021 JPMORGAN CHASE BANK (TAMPA, FL), got %s\n", s)
+	}
+	if s := fmt.Sprintf("%+v", banks[0]); !strings.HasPrefix(s, "{Routing:021000021 OfficeCode:O") {
+		t.Fatalf("Expected the fields, got %s\n", s)
+	}
+}
+func TestWireProvider(t *testing.T) {
+	wp := new(WireProvider)
+	n, err := wp.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != 8648 {
+		t.Fatalf("Expected to load %d, loaded %d\n", 8648, n)
+	}
+	res, err := wp.Search("telegraphic", "citibank nyc")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	w := res.(ParticipantResult).Participants
+	if len(w) != 1 || w[0][0].Routing != "021000089" || !w[0][0].WireEligible || !w[0][0].ACHEligible {
+		t.Fatalf("Expected Citibank, eligible for wires and ACH, got %v\n", w)
+	}
+	treas, err := wp.Get("routing", "021030004")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if treas[0].TelegraphicName != "TREAS NYC" || treas[0].ACHEligible {
+		t.Fatalf("Expected the Treasury, not eligible for ACH, got %v\n", treas)
+	}
+}
 func BenchmarkNameSearch(b *testing.B) {
 	p = new(BankProvider)
 	n, err := p.Load()
@@ -66,3 +287,181 @@ func BenchmarkNameSearch(b *testing.B) {
 		}
 	}
 }
+func TestRadixIndex(t *testing.T) {
+	rp := &BankProvider{Options: IndexOptions{Radix: []string{"name", "city", "number"}}}
+	if _, err := rp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	// the radix tree must find what the binary search finds
+	for _, c := range []struct{ index, query string }{
+		{"name", "FIRST NATIONAL"},
+		{"name", "first nat"},
+		{"name", "F"},
+		{"name", "ZZZZ"},
+		{"city", "new y"},
+		{"number", "0110000"},
+		{"number", "011000028"},
+		{"number", "0110000289"},
+	} {
+		res, err := p.Search(c.index, c.query)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		expected := res.(BankResult).Banks
+		res, err = rp.Search(c.index, c.query)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		b := res.(BankResult).Banks
+		if len(b) != len(expected) || (len(b) > 0 && b[0][0] != expected[0][0]) {
+			t.Fatalf("%s %q: Expected %d results, got %d\n", c.index, c.query, len(expected), len(b))
+		}
+	}
+	rp.Options.Limit = 10
+	res, err := rp.Search("name", "F")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if b := res.(BankResult).Banks; len(b) != 10 {
+		t.Fatalf("Expected the first 10 results, got %d\n", len(b))
+	}
+}
+func TestEnvelope(t *testing.T) {
+	bp := &BankProvider{Options: IndexOptions{Limit: 10}}
+	if _, err := bp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	e, err := SearchEnvelope(bp, "name", "F")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if e.Index != "name" || e.Query != "F" || e.Total <= 10 || !e.Truncated || len(e.Result.(BankResult).Banks) != 10 {
+		t.Fatalf("Expected the first 10 of more results, got %d of %d\n", len(e.Result.(BankResult).Banks), e.Total)
+	}
+	e, _ = SearchEnvelope(bp, "routing", "021000021")
+	if e.Total != 1 || e.Truncated {
+		t.Fatalf("Expected 1 result, got %d\n", e.Total)
+	}
+	s := httptest.NewServer(&Service{Provider: bp, Enveloped: true})
+	defer s.Close()
+	resp, err := http.Get(s.URL + "?routing=021000021")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if !bytes.Contains(body, []byte(`"Total": 1`)) || !bytes.Contains(body, []byte(`"Result": {`)) {
+		t.Fatalf("Expected the result in an envelope, got %s\n", body)
+	}
+}
+func TestSnapshot(t *testing.T) {
+	bp := new(BankProvider)
+	if _, err := bp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	var buf bytes.Buffer
+	if err := bp.SaveSnapshot(&buf); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	// a snapshot is not of another kind of Provider
+	if _, err := ReadSnapshot(bytes.NewReader(buf.Bytes()), "currency", new(interface{})); err == nil {
+		t.Fatalf("Expected a snapshot of bank data to be rejected as currency data\n")
+	}
+	sp := new(BankProvider)
+	n, err := sp.LoadSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected || sp.Info().Edition != bp.Info().Edition || !sp.Info().LoadedAt.Equal(bp.Info().LoadedAt) {
+		t.Fatalf("Expected %d banks and %+v, got %d and %+v\n", expected, bp.Info(), n, sp.Info())
+	}
+	banks, err := sp.Get("routing", "021000021")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	restored, err := bp.Get("routing", "021000021")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(banks) != 1 || banks[0] != restored[0] {
+		t.Fatalf("Expected %+v, got %+v\n", restored, banks)
+	}
+	if _, err := sp.LoadSnapshot(strings.NewReader("not a snapshot")); err == nil {
+		t.Fatalf("Expected a malformed snapshot to fail\n")
+	}
+}
+func TestSearchAllocs(t *testing.T) {
+	// a search allocates only its result, and the interface{} that holds it
+	for _, s := range []stddatatest.Search{{Index: "name", Query: "first national"}, {Index: "number", Query: "0110000"}} {
+		if n := stddatatest.AllocsPerSearch(p, s); n > 2 {
+			t.Fatalf("%s %q: Expected at most 2 allocations, got %v\n", s.Index, s.Query, n)
+		}
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(BankProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(BankProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "name", Query: "FIRST NATIONAL"}, {Index: "number", Query: "0110000"}, {Index: "city", Query: "new york"}, {Index: "state_name", Query: "OH FIRST"}})
+}
+func BenchmarkWireLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(WireProvider) })
+}
+func BenchmarkWireSearch(b *testing.B) {
+	bp := new(WireProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "routing", Query: "011000015"}, {Index: "name", Query: "FEDERAL"}, {Index: "state", Query: "MA"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
+func TestNotLoaded(t *testing.T) {
+	res, err := new(BankProvider).Search("name", "A")
+	if res != nil || !errors.Is(err, ErrNotLoaded) {
+		t.Fatalf("Expected nil and ErrNotLoaded, got %v, %v\n", res, err)
+	}
+}
+func TestLogger(t *testing.T) {
+	var b strings.Builder
+	bp := &BankProvider{Logger: slog.New(slog.NewTextHandler(&b, nil))}
+	if _, err := bp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if _, err := bp.ApplyChanges(strings.NewReader("D011000015\nD011999980\n"), Lenient); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	for _, expected := range []string{"msg=\"stddata load\" provider=bank", "items=", "msg=\"stddata changes\" provider=bank applied=1 skipped=1"} {
+		if !strings.Contains(b.String(), expected) {
+			t.Fatalf("Expected %s in\n%s\n", expected, b.String())
+		}
+	}
+}
+func TestRefreshObserver(t *testing.T) {
+	m := new(Metrics)
+	bp := &BankProvider{Observer: m}
+	if _, err := bp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	var b strings.Builder
+	if _, err := bp.ApplyChanges(strings.NewReader("D011999980\n"), Strict); err == nil {
+		t.Fatalf("Expected the deletion of an unknown routing number to fail\n")
+	}
+	m.WriteTo(&b)
+	if strings.Contains(b.String(), `stddata_last_refresh_timestamp_seconds{provider="bank"}`) {
+		t.Fatalf("Expected no refresh of the failed changes in\n%s\n", b.String())
+	}
+	if _, err := bp.ApplyChanges(strings.NewReader("D011000015\n"), Strict); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	b.Reset()
+	m.WriteTo(&b)
+	if !strings.Contains(b.String(), `stddata_last_refresh_timestamp_seconds{provider="bank"}`) {
+		t.Fatalf("Expected the refresh of the changes in\n%s\n", b.String())
+	}
+}
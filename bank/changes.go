@@ -0,0 +1,273 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bank
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// The actions of a Change.
+const (
+	Add    = "A" // a routing number was added to the directory
+	Delete = "D" // a routing number was deleted from the directory
+	Modify = "C" // the record of a routing number, such as the name, was changed
+)
+
+// Change records one change applied to the directory by ApplyChanges.
+type Change struct {
+	Seq     int       // position in the change log, counting from 1 after each load
+	Action  string    // Add, Delete or Modify
+	Routing string    // the routing number that changed
+	Bank    Bank      // the record after the change; the deleted record for a Delete
+	At      time.Time // when the change was applied
+}
+
+// ApplyChanges applies a changes file to the loaded directory,
+// without reloading it. Each line of a changes file is an action, A
+// to add a routing number, D to delete one, or C to change its
+// record, followed by a record in the Fed's fixed format. A deletion
+// needs only the routing number. A line that is malformed, whose
+// routing number fails ValidateRoutingNumber, that adds a routing
+// number already in the directory, or that deletes or changes one that
+// is not, is treated according to mode: in stddata.Strict mode no
+// change is applied, and in stddata.Lenient mode the line is skipped.
+// The error of an invalid routing number is a source error that names
+// its line. The changes applied are appended to the
+// change log, and the LoadReport counts them in Loaded. The changes
+// are applied to a copy of the maps, which replaces them when it is
+// complete, so searches in the meantime see none of the changes.
+func (p *BankProvider) ApplyChanges(data io.Reader, mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	done := func(error) {}
+	if p.Observer != nil {
+		done = p.Observer.ObserveRefresh(context.Background(), "bank")
+	}
+	defer func() {
+		p.logChanges(r, err)
+		done(err)
+	}()
+	// the changes build on the data as it is, so no load or other
+	// changes may replace it until they are applied.
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// make sure the data is loaded
+	old := p.data.Load()
+	if old == nil {
+		return r, stddata.ErrNotLoaded
+	}
+	routing := old.bankIndexes["routing"].bankMap
+	// present tracks the routing numbers in the directory as the
+	// changes read so far would leave it.
+	present := make(map[string]bool)
+	var pending []Change
+
+	bio := bufio.NewReader(data)
+	lineNumber := 0
+	for {
+		line, err := bio.ReadBytes('\n')
+		if err == io.EOF && len(line) == 0 {
+			break
+		}
+		if err != nil && err != io.EOF {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+		lineNumber++
+		sline := strings.TrimRight(string(line), "\r\n")
+		c, msg := parseChange(sline)
+		if msg == "" {
+			// a routing number that fails its check digit is an error
+			// of the changes file, rather than a conflict with the
+			// directory
+			if err := ValidateRoutingNumber(c.Routing); err != nil {
+				if mode == stddata.Lenient {
+					r.Skip(lineNumber)
+					continue
+				}
+				msg = fmt.Sprintf("line %d: %s", lineNumber, err.Error())
+				return r, stddata.NewSourceError(msg, nil)
+			}
+
+			exists, seen := present[c.Routing]
+			if !seen {
+				_, exists = routing[c.Routing]
+			}
+			switch {
+			case c.Action == Add && exists:
+				msg = "routing number " + c.Routing + " is already in the directory"
+			case c.Action != Add && !exists:
+				msg = "routing number " + c.Routing + " is not in the directory"
+			}
+		}
+		if msg != "" {
+			if mode == stddata.Lenient {
+				r.Skip(lineNumber)
+				continue
+			}
+			msg = fmt.Sprintf("line %d: %s", lineNumber, msg)
+			return r, stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+		}
+		present[c.Routing] = c.Action != Delete
+		pending = append(pending, c)
+	}
+
+	// copy the maps, sharing the one that the routing and number
+	// indexes share.
+	maps := make(map[string]map[string][]Bank)
+	for name, bi := range old.bankIndexes {
+		if name != "number" {
+			maps[name] = copyMap(bi.bankMap)
+		}
+	}
+	d := &bankData{bankIndexes: make(map[string]bankIndex), info: old.info}
+	// copy the change log too, so that appending to it does not write
+	// to the array of the old one.
+	d.changes = append([]Change(nil), old.changes...)
+	now := time.Now()
+	for _, c := range pending {
+		if c.Action != Add {
+			b := maps["routing"][c.Routing][0]
+			removeBank(maps, b)
+			if c.Action == Delete {
+				c.Bank = b
+			}
+		}
+		if c.Action != Delete {
+			addBank(maps, c.Bank)
+		}
+		c.Seq = len(d.changes) + 1
+		c.At = now
+		d.changes = append(d.changes, c)
+	}
+	// sort the keys of the changed indexes again
+	for name, m := range maps {
+		p.storeData(d, name, m)
+	}
+	p.storeData(d, "number", maps["routing"])
+	d.size = len(maps["routing"])
+	d.info.Count = d.size
+	p.data.Store(d)
+	r.Loaded = len(pending)
+	return r, nil
+}
+
+// logChanges logs the changes applied, as r reports them, and their
+// error, if the BankProvider has a Logger.
+func (p *BankProvider) logChanges(r stddata.LoadReport, err error) {
+	if p.Logger == nil {
+		return
+	}
+	attrs := []slog.Attr{slog.String("provider", "bank"), slog.Int("applied", r.Loaded)}
+	level := slog.LevelInfo
+	switch {
+	case err != nil:
+		level = slog.LevelError
+		attrs = append(attrs, slog.String("error", err.Error()))
+	case r.Skipped > 0:
+		level = slog.LevelWarn
+		attrs = append(attrs, slog.Int("skipped", r.Skipped), slog.Any("skipped_lines", r.SkippedLines))
+	}
+	p.Logger.LogAttrs(context.Background(), level, "stddata changes", attrs...)
+}
+
+// parseChange returns the Change in a line of a changes file, or a
+// message describing why the line is malformed.
+func parseChange(sline string) (c Change, msg string) {
+	if sline == "" {
+		return c, "empty line"
+	}
+	c.Action = sline[0:1]
+	record := sline[1:]
+	switch c.Action {
+	case Delete:
+		if len(record) < rn[1] {
+			return c, "no routing number to delete"
+		}
+		c.Routing = strings.TrimSpace(record[rn[0]:rn[1]])
+	case Add, Modify:
+		if len(record) < dv[1] {
+			return c, fmt.Sprintf("record is %d characters long, expected %d", len(record), dv[1])
+		}
+		c.Bank = parseBank(record)
+		c.Routing = c.Bank.Routing
+	default:
+		return c, "unknown action " + c.Action
+	}
+	return c, ""
+}
+
+// bankKeys returns the key of b in each of the maps, by the name of
+// the index. The routing and number indexes share a map.
+func bankKeys(b Bank) map[string]string {
+	return map[string]string{
+		"routing":    b.Routing,
+		"name":       b.CustomerName,
+		"city":       b.City,
+		"state":      b.StateCode,
+		"state_name": b.StateCode + " " + b.CustomerName,
+	}
+}
+
+// copyMap returns a copy of m, which shares its slices of Banks. They
+// are replaced, rather than modified, by addBank and removeBank.
+func copyMap(m map[string][]Bank) map[string][]Bank {
+	c := make(map[string][]Bank, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// addBank adds b to maps, the maps of the indexes by their names.
+func addBank(maps map[string]map[string][]Bank, b Bank) {
+	for index, key := range bankKeys(b) {
+		m := maps[index]
+		// the full slice expression makes append copy the slice,
+		// which may be shared with the maps being searched.
+		banks := m[key]
+		m[key] = append(banks[:len(banks):len(banks)], b)
+	}
+}
+
+// removeBank removes the Bank with the routing number of b from maps,
+// the maps of the indexes by their names.
+func removeBank(maps map[string]map[string][]Bank, b Bank) {
+	for index, key := range bankKeys(b) {
+		m := maps[index]
+		var kept []Bank
+		for _, other := range m[key] {
+			if other.Routing != b.Routing {
+				kept = append(kept, other)
+			}
+		}
+		if len(kept) == 0 {
+			delete(m, key)
+		} else {
+			m[key] = kept
+		}
+	}
+}
+
+// ChangeLog returns the changes applied by ApplyChanges since the
+// last load whose Seq is greater than since, in the order they were
+// applied. A cache that remembers the Seq of the last change it has
+// seen can invalidate just the routing numbers that changed after it.
+func (p *BankProvider) ChangeLog(since int) []Change {
+	d := p.data.Load()
+	if since < 0 {
+		since = 0
+	}
+	if d == nil || since >= len(d.changes) {
+		return nil
+	}
+	return d.changes[since:]
+}
@@ -0,0 +1,51 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bank
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/musicbeat/stddata"
+)
+
+// weights are the ABA check digit weights of the nine digits.
+var weights = [...]int{3, 7, 1, 3, 7, 1, 3, 7, 1}
+
+// ValidateRoutingNumber returns nil if s is a valid ABA routing
+// number: 9 digits, beginning with a prefix that is in use, and with
+// a correct check digit. The prefix, the first two digits, is 00 for
+// the US Government, 01 to 12 for the Federal Reserve districts, 21
+// to 32 for thrift institutions in those districts, 61 to 72 for
+// electronic transactions in those districts, or 80 for traveler's
+// checks. The check digit, the ninth digit, makes the sum of the
+// digits weighted 3, 7, 1, 3, 7, 1, 3, 7, 1 a multiple of 10.
+func ValidateRoutingNumber(s string) error {
+	if len(s) != 9 {
+		msg := "Routing number " + s + " is not 9 digits"
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	sum := 0
+	for i, r := range s {
+		if r < '0' || r > '9' {
+			msg := "Routing number " + s + " is not 9 digits"
+			return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+		}
+		sum += weights[i] * int(r-'0')
+	}
+	prefix, _ := strconv.Atoi(s[0:2])
+	switch {
+	case prefix == 0, prefix >= 1 && prefix <= 12, prefix >= 21 && prefix <= 32,
+		prefix >= 61 && prefix <= 72, prefix == 80:
+	default:
+		msg := "Routing number " + s + " has an unassigned prefix " + s[0:2]
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	if sum%10 != 0 {
+		msg := "Routing number " + s + " has an incorrect check digit"
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	return nil
+}
@@ -0,0 +1,8662 @@
+package bank
+
+// provenance of wiredata, reported by Info
+const (
+	wireSource    = "Federal Reserve E-Payments Routing Directory (Fedwire Funds Service)"
+	wireSourceURL = "http://www.fededirectory.frb.org/fpddir.txt"
+	wireEdition   = "2014-07-23"
+)
+
+/*
+wiredata is a snapshot of the Fedwire participant directory, in the
+Federal Reserve's fixed format, one institution to a line. The edition
+is the latest revision date found in the snapshot.
+*/
+var wiredata = `011000015FRB-BOS           FEDERAL RESERVE BANK OF BOSTON      MABOSTON                   Y Y20040910
+011000028STATE ST BOS      STATE STREET BOSTON                 MABOSTON                   Y Y        
+011000536FHLB BOSTON       FEDERAL HOME LOAN BANK              MABOSTON                   Y Y        
+011001234MELLON TRUST OF NETHE BANK OF NEW YORK MELLON         MABOSTON                   Y N20120815
+011001276ONEUNITED BANK    ONEUNITED BANK                      MABOSTON                   Y Y20021231
+011001881FIDUCIARY TR BOS  FIDUCIARY TRUST COMPANY             MABOSTON                   Y Y        
+011002343BOSTON PRIVATE BK THE BOSTON PRIVATE BK. & TR CO      MABOSTON                   Y Y        
+011002550EASTERN BANK LYNN EASTERN BANK                        MABOSTON                   Y N20110318
+011002725COMMERCE WORCESTERCOMMERCE BANK & TRUST CO.           MAWORCESTER                Y N20120824
+011002877EW BK SMRINO      EAST WEST BANK                      MABOSTON                   Y N20100422
+011075150SANTANDER BK      SANTANDER BANK, N.A.                PAWYOMISSING               Y Y20131029
+011075202SANTANDER BK      SANTANDER BANK, N.A.                MABOSTON                   Y N20140414
+011102133CITIZENS PUTNAM   CITIZENS NATIONAL BANK              CTPUTNAM                   Y Y        
+011102353FIRST SUFFIELD    FIRST NAT. BANK OF SUFFIELD         CTSUFFIELD                 Y Y        
+011102502UNION SAVINGS     UNION SAVINGS BANK                  CTLITCHFIELD               Y N20101223
+011102612SALISBURY LAKEVLLESALISBURY BANK & TRUST CO.          CTLAKEVILLE                Y Y        
+011102638NATLIRON SALISBURYTHE NATIONAL IRON BANK              CTSALISBURY                Y Y        
+011102667CANAAN NATL       SALISBURY BANK & TRUST CO.          CTCANAAN                   Y N20100507
+011103093TD BANK, NA       TD BANK, NA                         CTGLASTONBURY              Y N20090928
+011104322FST CITY N BRITAINWEBSTER BANK, N.A.                  CTNEW BRITAIN              Y N20100416
+011104335PRIME BANK ORANGE PRIME BANK                          CTORANGE                   Y Y        
+011104351SIMSBURY B&T      SIMSBURY BANK AND TRUST CO          CTSIMSBURY                 Y Y19991223
+011110617BANKERS' NE GLAST BANKERS' BANK NORTHEAST             CTGLASTONBURY              Y N20130920
+011110659LIBERTY MIDDLETOWNLIBERTY BANK                        CTMIDDLETOWN               Y N20130624
+011110675NO AMER BK & TR   WEBSTER BANK, N.A.                  CTWATERBURY                Y N20100416
+011110701WEBSTER BANK CT   WEBSTER BANK, N.A.                  CTWATERBURY                Y N20100412
+011110756START COMM BANK   START COMMUNITY BANK                CTNEW HAVEN                Y N20101221
+011175212CT COMMUNITY BK NACONNECTICUT COMMUNITY BANK          CTWESTPORT                 Y N20140331
+011200475BANGOR SVGS BK    BANGOR SAVINGS BANK                 MEBIDDEFORD                Y N20070921
+011200585DAMARISCOTTA BANK DAMARISCOTTA BANK & TRUST CO        MEDAMARISCOTTA             Y Y        
+011200608KEY BANK MAINE    KEYBANK NATIONAL ASSOCIATION        MEPORTLAND                 Y N20120507
+011201306CAMDEN NATIONAL BKCAMDEN NATIONAL BANK                MEBANGOR                   Y N20060928
+011201380CAMDEN NATL ME    CAMDEN NATIONAL BANK                MEELLSWORTH                Y N20100308
+011201432PEOPLES BANK      PEOPLE'S UNITED BANK                VTBRATTLEBORO              Y N20121212
+011201458CAMDEN NATL ME    CAMDEN NATIONAL BANK                MECAMDEN                   Y Y        
+011201490THE BANK OF MAINE THE BANK OF MAINE                   MEGARDINER                 Y N20110513
+011201500PEOPLES BANK      PEOPLE'S UNITED BANK                VTBRATTLEBORO              Y N20121212
+011201526PEOPLES BANK      PEOPLE'S UNITED BANK                VTBRATTLEBORO              Y N20121212
+011201759BAR HARBOR BK TR  BAR HARBOR BANK & TRUST             MEBAR HARBOR               Y Y20050603
+011201762FIRST BAR HARBOR  THE FIRST, NATIONAL ASSOCIATION     MEBAR HARBOR               Y N20120328
+011201830FIRST DAMARISCOTTATHE FIRST, NATIONAL ASSOCIATION     MEDAMARISCOTTA             Y Y20050517
+011201995LIVERMORE FALLS TRANDROSCOGGIN SAVINGS BANK           MELIVERMORE FALLS          Y N        
+011202392KATAHDIN TR PATTENKATAHDIN TRUST COMPANY              MEPATTEN                   Y Y        
+011202907THE BANK OF MAINE THE BANK OF MAINE                   MEKENNEBUNK                Y N20110513
+011202910TD BANK USA, N.A. TD BANK USA, NATIONAL ASSOCIATION   DEWILMINGTON               Y N20140402
+011300142COMMERCE WORCESTERCOMMERCE BANK & TRUST CO.           MAWORCESTER                Y Y        
+011300595CAMBRIDGE TR CO   CAMBRIDGE TRUST COMPANY             MACAMBRIDGE                Y Y        
+011301390CENTURY SOMERVILLECENTURY BANK & TRUST COMPANY        MAMEDFORD                  Y Y        
+011301798EASTERN BANK LYNN EASTERN BANK                        MABOSTON                   Y Y20020501
+011301992BANKFIVE          BANKFIVE                            MANEW BEDFORD              Y N20071106
+011302603NORTHMARK N ANDOVRTHE NORTHMARK BK                    MANORTH ANDOVER            Y Y20100726
+011302616PEOPLES BANK      PEOPLE'S UNITED BANK                VTBRATTLEBORO              Y N20121212
+011302742ENTERPRISE LOWELL ENTERPRISE BANK & TR. CO.           MALOWELL                   Y Y        
+011302768PEOPLES BANK      PEOPLE'S UNITED BANK                MABEVERLY                  Y N20121212
+011303097NORTHERN BK WOBURNNORTHERN BANK AND TRUST CO          MAWOBURN                   Y Y        
+011303327MILFORD NATL      MILFORD NATIONAL BK. & TR.          MAMILFORD                  Y Y        
+011304300NATL MARBLEHEAD   NAT. GRAND BK. OF MARBLEHEAD        MAMARBLEHEAD               Y Y        
+011304478ROCKLAND TRUST    ROCKLAND TRUST COMPANY              MAROCKLAND                 Y Y        
+011304711FIRST IPSWICH     FIRST IPSWICH BANK                  MAIPSWICH                  Y Y20120813
+011305202MILLBURY NATL     MILLBURY NATIONAL BANK              MAMILLBURY                 Y Y        
+011305260ROCKPORT NATL     ROCKPORT NATIONAL BANK              MAROCKPORT                 Y Y        
+011305684EDGARTOWN NATL    EDGARTOWN NATIONAL BANK             MAEDGARTOWN                Y Y        
+011307077EASTERN BANK      EASTERN BANK                        MANEWTON                   Y N20040322
+011307116UNITED SPRINGFIELDUNITED BANK                         MAWORCESTER                Y N20140501
+011307129LEADER BK ARLINGTNTHE LEADER BANK                     MAARLINGTON                Y N20130919
+011400071TD BANK, NA       TD BANK, NA                         NHMANCHESTER               Y N20090928
+011400149ST MARYS MANCHESTRST MARYS BANK                       NHMANCHESTER               Y Y20100308
+011401850BK OF NEW ENGLAND BANK OF NEW ENGLAND                 NHSALEM                    Y Y20070329
+011401928MERRIMACK COUNTY  MERRIMACK COUNTY SAVINGS BK         NHCONCORD                  Y N20130919
+011401960PEOPLES BANK      PEOPLE'S UNITED BANK                VTBRATTLEBORO              Y N20121212
+011402024CENTRIX B&T BEDFRDCENTRIX BANK AND TRUST              NHBEDFORD                  Y Y19990609
+011402053HAMPSHIRE FIRST BKNBT NATIONAL ASSOCIATION            NHMANCHESTER               Y N20121213
+011402079OPTIMA BANK       OPTIMA BANK & TRUST                 NHPORTSMOUTH               Y N20080129
+011500120RBS CITIZENS, N.A.RBS CITIZENS, N.A.                  RIPROVIDENCE               Y Y20070905
+011500858WASH TR WESTERLY  WASHINGTON TRUST COMPANY            RIWESTERLY                 Y Y        
+011500913CENT W WARWICK    CENTREVILLE SAVINGS BANK            RIWEST WARWICK             Y Y        
+011501682BK RI PROVIDENCE  BANK OF RHODE ISLAND                RIPROVIDENCE               Y Y20030515
+011501705FREEDOM NATL BANK FREEDOM NATIONAL BANK               RIGREENVILLE               Y N20130927
+011501718INDEPENDENCE BANK INDEPENDENCE BANK                   RIEAST GREENWICH           Y N20030401
+011575236UNION FED SVGS BK UNION FEDERAL SAVINGS BANK          RINORTH PROVIDENCE         Y N20100726
+011600020MERCHANTS BURL VT MERCHANTS BANK                      VTSOUTH BURLINGTON         Y Y        
+011600033TD BANK, NA       TD BANK, NA                         VTWILLISTON                Y N20090928
+011600062PEOPLES BANK      PEOPLE'S UNITED BANK                VTBRATTLEBORO              Y N20121212
+011600567PEOPLES TRUST CO  PEOPLES TRUST COMPANY               VTSAINT ALBANS             N Y20100726
+011600774FIRST BRANDON     LAKE SUNAPEE BANK FSB               VTBRANDON                  Y N20100330
+011601029COMMUNITY DERBY   COMMUNITY NATIONAL BANK             VTNEWPORT                  Y Y20130110
+011601074BERKSHIRE BANK    BERKSHIRE BANK                      MAPITTSFIELD               Y N20071227
+011601087NATL MIDDLEBURY   NATIONAL BANK OF MIDDLEBURY         VTMIDDLEBURY               Y Y        
+011601100UNION MORRISVILLE UNION BANK                          VTMORRISVILLE              Y Y        
+011601142FIRST ORWELL      FIRST NATIONAL BK. OF ORWELL        VTORWELL                   Y Y        
+011601236LAKE SUNAPEE BANK LAKE SUNAPEE BANK FSB               VTWOODSTOCK                Y N20100330
+011700425NORTHWAY BANK     NORTHWAY BANK                       NHBERLIN                   Y Y20120328
+011701107PASSUMPSIC SVGS BKPASSUMPSIC SAVINGS BANK             NHLANCASTER                Y N20080828
+011701288NORTHWAY BANK     NORTHWAY BANK                       NHPLYMOUTH                 Y Y20051025
+011701314FIRST COLEBROOK   FIRST COLEBROOK BANK                NHCOLEBROOK                Y Y        
+011701424CT RIV CHRLSTN NH CONNECTICUT RIVER BANK, N.A.        VTSPRINGFIELD              Y Y        
+011701660NEW LONDON TR NH  LAKE SUNAPEE BANK FSB               NHNEW LONDON               Y N20100416
+011701903COMMUNITY PLYMOUTHCOMMUNITY GUARANTY SAVINGS BANK     NHPLYMOUTH                 Y Y20090917
+011701987LEDYARD HANOVER   LEDYARD NATIONAL BANK N.A           NHHANOVER                  Y Y        
+011802488PEOPLES BANK      PEOPLE'S UNITED BANK                VTBRATTLEBORO              Y N20121212
+011805388LENOX NATL        LENOX NATIONAL BANK                 MALENOX                    Y Y        
+011807043FIRST NIAGARA     FIRST NIAGARA BANK, NA              NYBUFFALO                  Y N20121212
+011910697BERKSHIRE BANK    BERKSHIRE BANK                      CTHARTFORD                 Y N20121218
+011975221TCF HARTFORD      TCF NATIONAL BANK                   CTHARTFORD                 Y N20120601
+021000018BK OF NYC         THE BANK OF NEW YORK MELLON         NYNEW YORK                 Y Y20080701
+021000021JPMCHASE          JPMORGAN CHASE BANK, NA             NYNEW YORK                 Y Y20041112
+021000089CITIBANK NYC      CITIBANK, N.A.                      NYNEW YORK                 Y Y20120622
+021001033DBTCO AMERICAS NYCDEUTSCHE BANK TRUST CO. AMERICAS    NYNEW YORK                 Y Y20020909
+021001088HSBC USA          HSBC BANK USA, NA                   NYBUFFALO                  Y Y20120620
+021001208FRB NYC           FRB NEW YORK                        NYNEW YORK                 Y Y        
+021001486EURO AMER NYC     CITIBANK, N.A.                      NYNEW YORK                 Y N20120622
+021004823REPUBLIC NYC      HSBC BANK USA, NA                   NYNEW YORK                 Y N20120620
+021030004TREAS NYC         TREAS NYC/FUNDS TRANSFER DIVISION   NYNEW YORK                 Y N        
+021031003FHLBB WASHS       FHLB- GENERAL ACCOUNT               VARESTON                   Y Y20140411
+021033205FHLMC WASHS       FED. HOME LOAN MORT. GENERAL ACCOUNTVAMCLEAN                   Y Y20140411
+021033409FCA NYCS          FARM CREDIT BANK-GENERAL ACCT       NJJERSEY CITY              Y Y20140411
+021033412BANK COOP NYC     FARM CREDIT - BANK FOR CO-OPS       NJJERSEY CITY              Y N20130923
+021033425FICB NYC          FARM CREDIT - FED. INT. CREDIT BKS  NJJERSEY CITY              Y N20130923
+021033438FLB NYC           FARM CREDIT - FEDERAL LAND BANKS    NJJERSEY CITY              Y N20080306
+021033506FICO WASH         FINANCING CORP. - GEN ACCT.         VARESTON                   Y Y        
+021033519FICO P&I          FINANCING CORP. P & I ACCOUNT       VARESTON                   Y Y20060215
+021038200FARM CREDIT P&I   FEDERAL FARM-CON.SYSTEM BDS         NJJERSEY CITY              Y Y20060215
+021038857REFCORP           RESOLUTION FUNDING CORP. GENERAL    VARESTON                   Y Y        
+021039500FNMA NYC          FNMA - GENERAL ACCOUNT              DCWASHINGTON               Y Y        
+021039513FHLMC INVESTOR PI FEDERAL HOME LOAN MTG. INVESTOR PI  VAMCLEAN                   Y Y20080918
+021039526FREDDIE MAC MBS PIFHL MORTG. PC ISSUE - P & I         VAMCLEAN                   Y Y20060215
+021039539FMAE DC MBS       FNMA BOOK ENTRY MORTGAGE ACCOUNT    DCWASHINGTON               Y Y        
+021050165FARMER MAC PI     FEDERAL AGRICULT'RL MORTG.CORP.(P&I)DCWASHINGTON               Y Y        
+021050178FARMER MAC        FED.AGRICULTURAL MORTG. CORP.(GEN.) DCWASHINGTON               Y Y        
+021051180CHIPS             CHIPS ACCOUNT                       NYNEW YORK                 Y N20031021
+021051274CLEARING CORP     DEPOSITORY TRUST COMPANY            NYNEW YORK                 Y N20121213
+021051287FICC              DEPOSITORY TRUST COMPANY            NYNEW YORK                 Y N20121213
+021051371GNMA WASH         GNMA WASH                           NYNEW YORK                 N Y20020625
+021052040TREASURY BBO      U.S. TREAS BUYBACK OPERATION        NYNEW YORK                 N Y20011231
+021052367FRB TEST BANK E   FRB TEST BANK E *                   NYNEW YORK                 Y N20130923
+021052927UBS BANK USA      UBS BANK USA                        NYNEW YORK                 N Y20110401
+021052943WELLS GNMA-P&I    WELLS FARGO GNMA-P&I                NYNEW YORK                 N Y20140721
+021053065ITSGOV NYFRB      FRBNY GOVERNMENT WIRES              NJEAST RUTHERFORD          Y N20120326
+021054035DEF TRUST CA      DEFEASANCE TRUST COLLATERAL ACCOUNT NJEAST RUTHERFORD          N Y20041223
+021054064DEF TR RETIREMENT DEFEASANCE TRUST RETIREMENT ACCT    NJEAST RUTHERFORD          N Y20041223
+021054527DA FARM CREDIT    DISTRIBUTION ACCT-FARM CREDIT       NJEAST RUTHERFORD          Y Y20060817
+021054530DA FCAC           DISTRIBUTION ACCT-FCAC NYC          NJEAST RUTHERFORD          N Y20090915
+021054543DA FHLB WASHS     DISTRIBUTION ACCT-FHLBB WASH        NJEAST RUTHERFORD          Y Y20140411
+021054556DA FICO           DISTRIBUTION ACCT-FICO              NJEAST RUTHERFORD          Y Y20060630
+021054569DA REFCO          DISTRIBUTION ACCT-REFCO             NJEAST RUTHERFORD          Y Y20060630
+021054572DA FREDDIE MAC    DISTRIBUTION ACCT-FREDDIE MAC       NJEAST RUTHERFORD          Y Y20060630
+021054585DA FANNIE MAE     DISTRIBUTION ACCT-FANNIE MAE        NJEAST RUTHERFORD          Y Y20060630
+021054598DA FARMER MAC     DISTRIBUTION ACCT-FARMER MAC        NJEAST RUTHERFORD          Y Y20060630
+021054611DA AFDB           DISTRIBUTION ACCT-AFDB              NJEAST RUTHERFORD          Y Y20060630
+021054624DA ADB            DISTRIBUTION ACCT-ASIAN DEV BANK    NJEAST RUTHERFORD          Y Y20060630
+021054637DA IADB           DISTRIBUTION ACCT-IADB              NJEAST RUTHERFORD          Y Y20060630
+021054640DA IBRD           DISTRIBUTION ACCT-IBRD              NJEAST RUTHERFORD          Y Y20060630
+021054653DA IFC            DISTRIBUTION ACCT-IFC               NJEAST RUTHERFORD          Y Y20060630
+021054666DA GNMA WASH      DISTRIBUTION ACCT-GNMA WASH         NJEAST RUTHERFORD          Y Y20060630
+021054679DA GNMA REMICS    DISTRIBUTION ACCT-GNMA REMICS TRUSTENJEAST RUTHERFORD          Y Y20060630
+021054682DA VETERAN AFFAIRSDISTRIBUTION ACCT-VETERAN AFFAIRS   NJEAST RUTHERFORD          Y Y20060630
+021054695DA TVA            DISTRIBUTION ACCT-TENNESSEE VAL AUTHNJEAST RUTHERFORD          Y Y20060630
+021054718DA US TREASURY    DISTRIBUTION ACCT-US TREASURY       NJEAST RUTHERFORD          Y Y20060630
+021054721DA SLM            DISTRIBUTION ACCT-FRBNY DEFEA TR SLMNJEAST RUTHERFORD          Y Y20060630
+021054734FARM CREDIT DN P&IFARM CREDIT BANK DN P&I             NJJERSEY CITY              Y Y20060322
+021054747FARM CREDIT DB P&IFARM CREDIT BANK DB P&I             NJJERSEY CITY              Y Y20140716
+021054750FNMA DN P&I       FNMA DN P&I                         DCWASHINGTON               Y Y20060323
+021054763FHLB WASHS DN P&I FHLBB DN P&I                        VARESTON                   Y Y20140414
+021054802IBRD DN P&I       IBRD DN P&I ACCOUNT                 NJEAST RUTHERFORD          Y Y20060710
+021054941FARMER MAC SEC P&IFARMER MAC SEC P&I ACCOUNT          NJEAST RUTHERFORD          Y Y20060509
+021055979IFC DN P&I        IFC DN P&I                          DCWASHINGTON               Y Y20090624
+021056318FARMER MAC II     FARMER MAC II                       DCWASHINGTON               Y N20100820
+021056321NADB P&I          NADB P&I                            NJEAST RUTHERFORD          Y Y20100519
+021056334DA NADB           DA NADB                             NJEAST RUTHERFORD          Y Y20100513
+021059438BUREAU OF OCEAN ENBUREAU OF OCEAN ENERGY MANAGEMENT   CACAMARILLO                N Y20140313
+021080025IBRD ACCT T       IBRD ACCOUNT T                      NYNEW YORK                 Y Y        
+021080038NADB              NORTH AMERICAN DEVELOPMENT BANK     NYNEW YORK                 Y Y        
+021080054NADB US CAIP      NORTH AMER DEV BK US - CAIP CAPITAL NYNEW YORK                 Y N19990716
+021080070ECBAC2            EUROPEAN CENTRAL BANK-ACCT 2        NYNEW YORK                 Y Y20030220
+021080083WHO ACCT ONE      WHO ACCT ONE/FOREIGN DEPARTMENT     NYNEW YORK                 Y N        
+021080135UNITED NATIONS    UNITED NATIONS                      NYNEW YORK                 Y Y        
+021080148EUROPEAN CEN BANK EUROPEAN CENTRAL BANK ACCT ECB      NYNEW YORK                 Y Y        
+021080151ECB AUSTRIA       ECB ACCT OESTERREICHISCHE           NYNEW YORK                 Y Y        
+021080164ECB BELGIQUE      ECB AC BANQUE NATIONALE BELGIQUE    NYNEW YORK                 Y Y        
+021080177ECB FINLAND       ECB ACCT SUOMEN PANKKI              NYNEW YORK                 Y Y        
+021080180TURKEY SP FUNDS   TURKSF                              NYNEW YORK                 Y Y20030924
+021080193ECB FRANCE        ECB ACCT BANQUE DE FRANCE           NYNEW YORK                 Y Y        
+021080232ADBACR            ADBACR/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y        
+021080245ADB ACCT A        ADB ACCT A/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y20140331
+021080258ADB ACCT N        ADB ACCT N/FOREIGN DEPARTMENT       NYNEW YORK                 Y N        
+021080261ECB GERMANY       ECB ACCT DEUTSCHE BUNDESBANK        NYNEW YORK                 Y Y        
+021080274ECB SPAIN         ECB ACCT BANCO DE ESPANA            NYNEW YORK                 Y Y        
+021080287ADB FUND ACCT     ADB FUND ACCT/FOREIGN DEPARTMENT    NYNEW YORK                 Y Y20140331
+021080300AFDB              AFDB/FOREIGN DEPARTMENT             NYNEW YORK                 Y Y        
+021080313AFDB NTF          AFDB NTF/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y        
+021080326ECB IRELAND       ECB ACCT CENTRAL BANK OF IRELAND    NYNEW YORK                 Y Y        
+021080339ECB ITALY         ECB ACCT BANCA D ITALIA             NYNEW YORK                 Y Y19990701
+021080342ECB LUXEMBOURG    ECB AC BANQUE CEN DU LUXEMBOURG     NYNEW YORK                 Y Y        
+021080355ECB NETHERLANDS   ECB ACCT DE NEDERLANDSCHE BK NV     NYNEW YORK                 Y Y        
+021080368ECB PORTUGAL      ECB ACCT BANCO DE PORTUGAL          NYNEW YORK                 Y Y        
+021080371AFDB-HIPC AFD BANKAFRICAN DEVELOPMENT BK-HIPC AFD BANKNYNEW YORK                 Y Y20020326
+021080384AFDB-HIPC AFD FUNDAFRICAN DEVELOPMENT BK-HIPC AFD FUNDNYNEW YORK                 Y Y20020326
+021080407EBRD              EBRD/FOREIGN DEPARTMENT             NYNEW YORK                 Y Y        
+021080410NETH MOF FMS      GOV NETHERLANDS-MIN OF FINANCE  FMS NYNEW YORK                 Y Y20020613
+021080436EBRD NUCLEAR SAFE EBRD NUCLEAR SAFETY/FORIGN DEPART   NYNEW YORK                 Y N        
+021080533URUGUAY SP FDS ACTBANCO CENTRAL DEL URUGUAY-SP FDS ACTNYNEW YORK                 Y N20020806
+021080546SLOVAKIA DES ACCT NATL BK OF SLOVAKIA -DES ACCT       NYNEW YORK                 Y Y20021018
+021080562IADB ACCOUNT      INTER-AMERICAN DEVELOPMENT BANK ACCTNYNEW YORK                 Y Y20010720
+021080591ROMANIA RAMP      NAT'L BANK OF ROMANIA RAMP ACCT     NYNEW YORK                 Y Y20021211
+021080614NSB KAZAK DES ACT NT'L ST BK OF KAZAKHSTAN DSGNTD ACT NYNEW YORK                 Y N20080226
+021080630AFDB-HIPC NTF     AFRICAN DEVEL B-HIPC NT FUND        NYNEW YORK                 Y Y20031124
+021080669MONTENEGRO        CENTRAL BANK OF MONTENEGRO          NYNEW YORK                 Y Y20040427
+021080672SLOVAKIA DES ACCTBNATL BK OF SLOVAKIA DESIGN ACCT B   NYNEW YORK                 Y Y20040525
+021080740TIMOR-LESTE       BANCO CENTRAL DE TIMOR-LESTE        NYNEW YORK                 Y Y20130808
+021080850IRAQ CENTRAL BANK CENTRAL BANK OF IRAQ                NYNEW YORK                 Y Y20041220
+021080863BULGARIAN DES ACCTBULGARIAN NATL BK DESIGNTD ACCT     NYNEW YORK                 Y Y20050516
+021080892DOM REP CONSOLID  BC REP DOMINICANA-CONSOLIDATION FUNDNYNEW YORK                 Y Y20050406
+021080902DOM REP CONTINGEN BC REP DOMINICANA-CONTINGENCY FUND  NYNEW YORK                 Y Y20050406
+021080915SAO TOME          BANCO CENTRAL DE SAO TOME A PRINCIPENYNEW YORK                 Y Y20050607
+021080960SAO TOME NATL OIL BC SAO TOME E PRINCIPE NATL OIL ACCTNYNEW YORK                 Y Y20050608
+021080973TIMOR PETRO FUND  BANCO CENTRAL DE TIMOR-LESTE PET FNDNYNEW YORK                 Y Y20130808
+021081079IA INVES CORP     IA INVES CORP/FOREIGN DEPARTMENT    NYNEW YORK                 Y N        
+021081082IRAQ FMS          CENTRAL BANK OF IRAQ-FMS ACCOUNT    NYNEW YORK                 Y Y20061201
+021081189AUSTRIA DES ACCT  OESTERREICHISCHE NATIONALBANK DES ACNYNEW YORK                 Y Y20070108
+021081215NBK INV PORT      NATIONAL BANK OF KAZAK INV PORT     NYNEW YORK                 Y Y20080404
+021081228CABO VERDE        BANCO DE CABO VERDE                 NYNEW YORK                 Y Y20080807
+021081231ANGOLA            BANCO NACIONAL DE ANGOLA            NYNEW YORK                 Y Y20100726
+021081244PHILIP IP ACCT    CENTRAL BANK OF THE PHILIPINNES INV NYNEW YORK                 Y Y20080624
+021081273BEAC-GABON        BEAC-GABON DEBT INVESTMENT ACCOUNT  NYNEW YORK                 Y Y20080904
+021081286SWAZILAND RAMP    CB SWAZILAND-RAMP ACCOUNT           NYNEW YORK                 Y Y20080616
+021081299RWANDA RAMP       BANQUE NATIONALE DU RWANDA RAMP     NYNEW YORK                 Y Y20080616
+021081309BANK OF SLOVENIA  BANK OF SLOVENIA                    NYNEW YORK                 Y Y20081010
+021081341BOE - NO. 2 ACCT  BANK OF ENGLAND-NO. 2 ACCOUNT       NYNEW YORK                 Y Y20080915
+021081354EUROCLEAR BANK    EUROCLEAR BANK ACCOUNT              NYNEW YORK                 Y N20081230
+021081367IBRD WASH         IBRD WASH/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y        
+021081406BOJ-OPS ACCT 1    BANK OF JAPAN - OPERATIONS ACCOUNT 1NYNEW YORK                 Y N20080919
+021081419BOC - OPS ACCT 1  BANK OF CANADA-OPER ACCT 1          NYNEW YORK                 Y N20080925
+021081422NORGES OPS ACCT 1 NORGES BANK- OPERATIONS ACCOUNT 1   NYNEW YORK                 Y Y20081112
+021081435DANMARKS OPS ACCT1DANMARKS NAT'LBANK-OPER ACCT 1      NYNEW YORK                 Y N20080925
+021081448SVERIGES OPS ACCT1SVERIGES RIKSBANK-OPER ACCOUNT 1    NYNEW YORK                 Y Y20110810
+021081451AUSSI OPS ACCT 1  RESERVE BK OF AUSTRALIA-OPER ACCT 1 NYNEW YORK                 Y N20080925
+021081464AFRICAN DEV.FUND  AFRICAN DEVELOPMENT FUND-GEN ACCOUNTNYNEW YORK                 Y Y20081021
+021081477AFRICAN DB GL FUNDAFRICAN DEVELOPMENT BK FUND FOR GLR NYNEW YORK                 Y N20081104
+021081493GEORGIA USAID ACCTNATL BANK OF GEORGIA USAID ACCOUNT  NYNEW YORK                 Y N20081030
+021081503SINGAPORE OPS     SINGAPORE OPS ACCT 1                NYNEW YORK                 Y N20090501
+021081516KOREA OPS ACCT 1  BANK OF KOREA-OPERATIONS ACCOUNT 1  NYNEW YORK                 Y N20081125
+021081529ZAMBIA RAMP       BANK OF ZAMBIA-RAMP ACCOUNT         NYNEW YORK                 Y Y20081231
+021081532ECB SLOVAKIA      ECB ACCT SK, NATL BK OF SLOVAKIA    NYNEW YORK                 Y Y20081230
+021081545NZEALAND OPS ACCT1NEW ZEALAND OPS ACCT1               NYNEW YORK                 Y N20081217
+021081558AUSTRIA OPS ACCT 1OESTERREICHISCHE NATLBANK OPS ACCT 1NYNEW YORK                 Y Y20120417
+021081561BELGIUM OPS ACCT1 BANQUE NATL. DE BELGIQUE OPS ACCT 1 NYNEW YORK                 Y Y20120417
+021081574CYPRUS OPS ACCT1  CENTRAL BANK OF CYPRUS-OPS ACCT 1   NYNEW YORK                 Y Y20091105
+021081587FINLAND OPS ACCT1 SUOMEN PANKKI FINLANDS BK OPS ACCT 1NYNEW YORK                 Y Y20120418
+021081590DLRP WASH         DLRP WASH ACCOUNT                   NYNEW YORK                 N Y20130701
+021081613FRANCE OPS ACCT1  BANQUE DE FRANCE - OPS ACCT 1       NYNEW YORK                 Y Y20120418
+021081626GERMANY OPS ACCT1 DEUTSCHE BUNDESBANK OPER ACCT 1     NYNEW YORK                 Y Y20090320
+021081639GREECE OPS ACCT1  BANQUE DE GRECE OPS ACCT 1                                     Y Y20090707
+021081642IRELAND OPS ACCT1 CENT BK OF IRELAND OPS ACCT 1       NYNEW YORK                 Y Y20100225
+021081655ITALY OPS ACCT 1  BANCA D'ITALIA OPS ACCT 1           NYNEW YORK                 Y N20131004
+021081668LUXEMBOURG OPSACT1CB LUXEMBOURG OPS ACCT 1            NYNEW YORK                 Y Y20120418
+021081671MALTA OPS ACCT 1  CENTRAL BANK OF MALTA OPS ACCT 1    NYNEW YORK                 Y Y20091105
+021081684NETHERLANDS OPS1  DE NEDERLANDSCHE BANK NV OPS ACCT 1 NYNEW YORK                 Y Y20120418
+021081697PORTUGAL OPS ACCT1BANCO DE PORTUGAL OPS ACCT 1        NYNEW YORK                 Y Y20091105
+021081723SLOVENIA OPS ACCT1BANK OF SLOVENIA OPS ACCT 1         NYNEW YORK                 Y Y20120418
+021081736SPAIN OPS ACCT1   BANCO DE ESPANA OPS ACCT 1          NYNEW YORK                 Y Y20100329
+021081752MIGA ACCT GEN     MIGA ACCT GFN/FOREIGN DEPARTMENT    NYNEW YORK                 Y Y        
+021081778TANZANIA RAMP     BANK OF TANZANIA RAMP ACCT          NYNEW YORK                 Y Y20031014
+021081781UKRAINE RAMP      NATIONAL BANK OF UKRAINE RAMP ACCT  NYNEW YORK                 Y N20070105
+021081794MEXICO OPS ACCT1  BANCO DE MEXICO , SA                NYNEW YORK                 Y N20090213
+021081804BOTSWANA PULA FUNDBANK OF BOTSWANA PULA FUND          NY33 LIBERTY STREET        Y Y20090209
+021081817KENYA RAMP        CENTRAL BANK OF KENYA-RAMP ACCOUNT  NYNEW YORK                 Y Y20090326
+021081833ECB DES ACCT DE   ECBDESIGNATED ACCOUNT DE,           NYNEW YORK                 Y Y20090227
+021081859FRB SP OPS ACCT2  FRBNY SPECIAL OPERATIONS ACOUNT 2   NYHAVERSTRAW               Y N20090304
+021081888FRB SP OPS ACCT 3 FRBNY SPECIAL OPERATIONS ACCOUNT 3  NYNEW YORK                 Y N20090324
+021081891NBKAZAK NF SAVINGSNBK-NATIONAL FUND SAVINGS ACCT      NYNEW YORK                 Y Y20080813
+021081901NBK STABILIZATION NBK - NATL FUND STABILIZATION ACCT  NYNEW YORK                 Y Y20100726
+021081914FRANCE ACCT B     BANQUE DE FRANCE- ACCOUNT B         NYNEW YORK                 Y N20090320
+021081927YEMEN RAMP        CENTRAL BANK OF YEMEN- RAMP ACCOUNT NYNEW YORK                 Y Y20090427
+021081930AFGHANISTAN RAMP  DA AFGHANISTAN BANK-RAMP ACCOUNT    NYNEW YORK                 Y Y20090716
+021081943JAPAN - OPS ACCT 3BANK OF JAPAN - OPS ACCT 3          NYNEW YORK                 Y N20090916
+021081956ECB - OPS ACCT 2  ECB - OPS ACCT 2                    NYNEW YORK                 Y N20140114
+021081969BNS - OPS ACCT 2  BNS - OPS ACCT 2                    NYNEW YORK                 Y N20140114
+021081985BOJ - OPS ACCT 2  BANK OF JAPAN - OPS ACCT 2          NYNEW YORK                 Y Y20090715
+021081998INDIA FMS         RESERVE BANK OF INDIA               NYNEW YORK                 Y Y20090702
+021082007IDA WASH          IDA WASH/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y        
+021082065MONGOLIA SP ACCT  BANK OF MONGOLIA SPECIAL ACCOUNT    NYNEW YORK                 Y N20091224
+021082117IDA ACCT S        IDA ACCT S/FOREIGN DEPARTMENT       NYNEW YORK                 Y N        
+021082146ENGLAND-NO. 3 AC  BANK OF ENGLAND-NO.3 ACCOUNT        NYNEW YORK                 Y Y20100219
+021082159IFAD              IFAD/FOREIGN DEPARTMENT             NYNEW YORK                 Y Y20080131
+021082162IFC ACCT ONE      IFC WASH/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y        
+021082191CANADA FISCALAGT BBANK OF CANADA FISCAL AGENT ACCT B  NYNEW YORK                 Y N20120418
+021082214CB KENYA PORTFOLIOCENTRAL BANK OF KENYA-PORTFOLIO ACCTNYNEW YORK                 Y Y20100420
+021082227AFRICAN DEVBK IPPFAFRICAN DEVBK NEPAD-IPPF            NYNEW YORK                 Y N20100423
+021082243UGANDA SP ACCT    BANK OF UGANDA-SPECIAL ACCOUNT      NYNEW YORK                 Y N20100602
+021082256ESTONIA OPS ACCT1 EESTI PANK OPS ACCT 1               NYNEW YORK                 Y Y20110127
+021082272SINGAPORE OP2     SINGAPORE OPS ACCOUNT 2             NYNEW YORK                 Y Y20111103
+021082285SIERRA LEONE SP ACSIERRA LEONE SPECIAL ACCT           NYNEW YORK                 Y N20120223
+021082298SURINAM IP        CENTRAL BANK VAN SURINAM - IP ACCT  NYNEW YORK                 Y Y20130517
+021082308FRB SP OPS ACCT 1 FRBNY SPECIAL OPERATIONS ACCOUNT 1  NYNEW YORK                 Y N20090109
+021082311BUR FIN PROTECTIONBUREAU OF CONSUMER FIN PROTECTION   NYNEW YORK                 Y Y20120418
+021082324IMF ACCT ONE      IMF ACCT ONE/FOREIGN DEPARTMENT     NYNEW YORK                 Y Y        
+021082353IRELAND OPS ACCT2 CENT BK OF IRELAND OPS ACCT 2       NYNEW YORK                 Y N20110113
+021082366GHANA - PETROLEUM GHANA - PETROLEUM FUNDS ACCT        NYNEW YORK                 Y N20110413
+021082379MALAYSIA - PORTFOLMALAYSIA - SEPARATE PORTFOLIO ACCT  NYNEW YORK                 Y Y20110413
+021082382IMF ADMIN CONTRIB IMF ADMIN CONTRIB/FOREIGN DEPARTMENTNYNEW YORK                 Y N        
+021082395IMF SP DISB       IMF SP DISB/FOREIGN DEPARTMENT      NYNEW YORK                 Y N        
+021082405IMF SUPPL FIN FAC IMF SUPPL FIN FAC/FOREIGN DEPARTMENTNYNEW YORK                 Y N        
+021082418SOUTH SUDAN       BANK OF SOUTH SUDAN                 NYNEW YORK                 Y Y20130110
+021082489IMF ACCT TWO      IMF ACCT TWO/FOREIGN DEPARTMENT     NYNEW YORK                 Y N        
+021082492IMF EMERGENCY     IMF EMERGENCY/FOREIGN DEPARTMENT    NYNEW YORK                 Y N20120504
+021082502PORTUGAL OPS ACCT2BANCO DE PORTUGAL OPS ACCT 2        NYNEW YORK                 Y N20110808
+021082528GHANA HRTG        GHANA - HERITAGE FUND ACCT          NYNEW YORK                 Y Y20120830
+021082531GHANA STBLZN      GHANA - STABILIZATION FUND ACCT     NYNEW YORK                 Y Y20120830
+021082544ISRAEL MOF SP TWO GOVT OF ISRAEL MOF SP NO. 2         NYNEW YORK                 Y N20140113
+021082557MONGOLIA RAMP     BANK OF MONGOLIA RAMP ACCOUNT       NYNEW YORK                 Y Y20120113
+021082586MOLDOVA RAMP      NATIONAL BANK OF MOLDOVA - RAMP ACCTNYNEW YORK                 Y Y20110111
+021082599ECB - OPS ACCT 1  EUROPEAN CENTRAL BANK-OPER ACCT 1   NYNEW YORK                 Y N20140107
+021082609SEC TREAS SPEC ACCSEC TREAS SPEC ACC/FOREIGN DEPT     NYNEW YORK                 Y Y        
+021082667POLAND DES ACCT   NARODOWY BANK POLSKI DESIGNATED ACCTNYNEW YORK                 Y Y20100324
+021082793CIVIL PENALTY FUNDCONSUMER FINL CIVIL PENALTY FUND    NYNEW YORK                 Y N20120418
+021082803BNS - OPS ACCT 1  BNS - OPS ACCT 1                    NYNEW YORK                 Y N20140114
+021082816ARG USD DIS L IC  ARGENTINA USD DIS SER L/FOREIGN DEPTNYNEW YORK                 Y Y20060110
+021082832ARG USD PAR L IC  ARGENTINA USD PAR SER L/FOREIGN DEPTNYNEW YORK                 Y Y20060110
+021082874BOC - OPS ACCT 2  BOC - OPS ACCT 2                    NYNEW YORK                 Y N20140213
+021082887CB YEMEN- IP ACCT CENTRAL BANK OF YEMEN- IP ACCT      NYNEW YORK                 Y Y20140602
+021082926IRAQ ACCOUNT NO 2 CENTRAL BANK OF IRAQ ACCOUNT NO 2   NYNEW YORK                 Y Y20140721
+021082942MAURITANIA RAMP   BANQUE CENTRALE DE MAURITANIE- RAMP NYNEW YORK                 Y Y20140514
+021082968JAMAICA RAMP      BANK OF JAMAICA- RAMP ACCOUNT       NYNEW YORK                 Y Y20140611
+021082971BELARUS RAMP      NATL BK OF THE REP OF BELARUS RAMP  NYNEW YORK                 Y Y20140310
+021082997BULGARIA          BULGARIAN NATIONAL BANK             NYNEW YORK                 Y Y20110331
+021083006BAHRAIN FMS       BAHRAIN FMS/FOREIGN DEPARTMENT      NYNEW YORK                 Y Y        
+021083019AFGHANISTAN       AFGHANISTAN/FOREIGN DEPARTMENT      NYNEW YORK                 Y Y20080131
+021083035ALGERIA           ALGERIA/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080131
+021083048AUSTRIA FMS       AUSTRIA FMS/FOREIGN DEPARTMENT      NYNEW YORK                 Y Y        
+021083051ARAB MON FD       ARAB MON FD/FOREIGN DEPARTMENT      NYNEW YORK                 Y N        
+021083064OESTERRIECHISCHE  OESTERRIECHISCHE FOREIGN DEPARTMENT NYNEW YORK                 Y N20120611
+021083077UNITED ARAB EMIR  UNITED ARAB EMIR/FOREIGN DEPARTMENT NYNEW YORK                 Y Y        
+021083093ARGENTINA         ARGENTINA/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20080131
+021083116AUSTRALIA         AUSTRALIA/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20080131
+021083129AUSTRALIA FMS     AUSTRALIA FMS/FOREIGN DEPARTMENT    NYNEW YORK                 Y Y20080131
+021083132AUSTRIA           AUSTRIA/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080131
+021083158BAHAMAS           BAHAMAS/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080131
+021083161BAHRAIN ACCOUNT   BAHRAIN ACCOUNT/FOREIGN DEPARTMENT  NYNEW YORK                 Y N20120611
+021083174BAHRAIN MON AGENCYBAHRAIN MON AGENCY/FOREIGN DEPT     NYNEW YORK                 Y Y20080131
+021083187ARUBA             ARUBA/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y        
+021083190BANGLADESH        BANGLADESH/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y20080131
+021083213BARBADOS          BARBADOS/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y20080131
+021083239BARBADOS SINK FUNDBARBADOS SINK FUND/FOREIGN DEPT     NYNEW YORK                 Y N20140711
+021083255BELGIUM A         BELGIUM A/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20080131
+021083268BELGIUM B         BELGIUM B/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20061214
+021083271BELIZE            BELIZE/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20080131
+021083297BERMUDA           BERMUDA/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080214
+021083307BHUTAN            BHUTAN/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y        
+021083310BOLIVIA           BOLIVIA/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080214
+021083352BOTSWANA          BOTSWANA/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y20080214
+021083365NACISA            NACISA/FOREIGN DEPARTMENT           NYNEW YORK                 Y N        
+021083378NATO NACISA FMS   NATO NACISA FMS/FOREIGN DEPARTMENT  NYNEW YORK                 Y Y        
+021083394BRAZIL            BANCO CENTRAL DO BRAZIL             NYNEW YORK                 Y Y20080214
+021083417MYANMAR BK        MYANMAR BK/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y        
+021083433BURUNDI           BURUNDI/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080131
+021083459AFRIQUE CENTRALE  AFRIQUE CENTRALE/FOREIGN DEPARTMENT NYNEW YORK                 Y Y20010906
+021083462CANADA GENERAL    BANK OF CANADA GENERAL ACCOUNT      NYNEW YORK                 Y Y20051227
+021083491CANADA FISCAL AGT BANK OF CANADA FISCAL AGENT         NYNEW YORK                 Y Y20080131
+021083501CANADA FMS        CANADA FMS/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y        
+021083514SRI LANKA         SRI LANKA/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y        
+021083527SRI LANKA G       SRI LANKA ACCT G/FOREIGN DEPARTMENT NYNEW YORK                 Y Y20030116
+021083530CHILE             CHILE/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080214
+021083572COLOMBIA          COLOMBIA/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y20080214
+021083585COMORES           COMORES/FOREIGN DEPARTMENT          NYNEW YORK                 Y N        
+021083598COSTA RICA        COSTA RICA/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y20080214
+021083611CYPRUS            CYPRUS/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20080214
+021083624DANMARKS FMS      DANMARKS FMS/FOREIGN DEPARTMENT     NYNEW YORK                 Y Y        
+021083640DANMARKS ACCT     DANMARKS ACCT A/FOREIGH DEPARTMENT  NYNEW YORK                 Y N20120611
+021083653DENMARK           DANMARKS/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y20080214
+021083666DENMARK SEP       DANMARKS SEP ACCOUNT/FOREIGN DEPT   NYNEW YORK                 Y Y        
+021083679DOMINICAN REPUBLICDOMINICAN REPUBLIC/FOREIGN DEPT     NYNEW YORK                 Y Y20080214
+021083682DJIBOUTI          CENTRAL BANK OF DJIBOUTI            NYNEW YORK                 Y Y20070228
+021083695EAST CARIB        EAST CARIB/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y        
+021083718ECUADOR           ECUADOR/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080214
+021083750EGYPT             EGYPT/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080214
+021083776EGYPT SUB         EGYPT SUB/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20080214
+021083792EL SALVADOR       EL SALVADOR/FOREIGN DEPARTMENT      NYNEW YORK                 Y Y20080214
+021083802EUROPEAN INV BK P EUROPEAN INV BK P/FOREIGN DEPT      NYNEW YORK                 Y Y20080214
+021083831ETHIOPIA          ETHIOPIA/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y20080214
+021083873FIJI              FIJI/FOREIGN DEPARTMENT             NYNEW YORK                 Y Y20080214
+021083886NATO SA - FMS     NATO SUPPORT AGENCY - FMS ACCOUNT   NYNEW YORK                 Y Y20140604
+021083899FINLAND           FINLAND/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080214
+021083909HAITI ACCT NO ONE HAITI ACCT # ONE/FOREIGN DEPARTMENT NYNEW YORK                 Y N        
+021083912FRANCE ORDINARY   FRANCE ORDINARY/FOREIGN DEPARTMENT  NYNEW YORK                 Y Y20080214
+021083925MAURITIUS RAMP    BANK OF MAURITIUS-RAMP ACCOUNT      NYNEW YORK                 Y Y20101228
+021083941GUINEE SAEP       GUINEE SAEP/FOREIGN DEPARTMENT      NYNEW YORK                 Y Y        
+021083970THE GAMBIA        THE GAMBIA/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y20080214
+021083983GAMBIA USAID GEPRPGAMBIA USAID GEPRP/FOREIGN DEPT     NYNEW YORK                 Y N        
+021084005GERMANY SPEC      GERMANY SPEC/FOREIGN DEPARTMENT     NYNEW YORK                 Y Y        
+021084018GERMANY           GERMANY/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080214
+021084034GHANA             GHANA/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080214
+021084050GREECE A          GREECE A/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y20080214
+021084063GUATEMALA A       GUATEMALA A/FOREIGN DEPARTMENT      NYNEW YORK                 Y Y        
+021084076GUATEMALA         GUATEMALA/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20080214
+021084089GUATEMALA CAMS FD GUATEMALA CAMS FD/FOREIGN DEPT      NYNEW YORK                 Y Y        
+021084092GUYANA            GUYANA/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20080214
+021084115HAITI             HAITI/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y        
+021084131HONDURAS          HONDURAS/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y20080214
+021084144GUINEE            GUINEE/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y        
+021084157HONG KONG         HONG KONG/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20080214
+021084173HUNGARY           HUNGARY/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080214
+021084199ICELAND           ICELAND/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080214
+021084212INDIA             INDIA/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080214
+021084238INDONESIA         INDONESIA/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20080214
+021084377IRELAND           CENT BK & FIN SVCS AUTH OF IRELAND  NYNEW YORK                 Y Y20080214
+021084393ISRAEL            ISRAEL/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20080214
+021084416ISRAEL GOVT       ISRAEL GOVT/FOREIGN DEPARTMENT      NYNEW YORK                 Y Y20080213
+021084429ITALYA            ITALY ACCOUNT A/FOREIGN DEPARTMENT  NYNEW YORK                 Y N20120611
+021084432ITALY             ITALY/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080213
+021084458UFFICIO           UFFICIO/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080213
+021084474JAMAICA           JAMAICA/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080213
+021084513JAPAN             JAPAN/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080213
+021084539JAPAN GOVT        JAPAN GOVT/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y20080213
+021084542ISRAEL FMS        ISRAEL FMS/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y        
+021084555JORDAN            JORDAN/FOREIGN DEPARTMENT           NYNEW YORK                 Y N20140717
+021084571KENYA             KENYA/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080213
+021084597KOREA             KOREA/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080213
+021084610KOREA FMS         KOREA FMS/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20080213
+021084636KUWAIT            KUWAIT/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y        
+021084681LESOTHO           LESOTHO/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080213
+021084694LEBANON           LEBANON/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080213
+021084717LIBERIA           CENTRAL BANK OF LIBERIA             NYNEW YORK                 Y Y20050412
+021084720LIBERIA DEBT      CENTRAL BANK OF LIBERIA DEBT SVC ACCNYNEW YORK                 Y N20050412
+021084746MACAU             MACAU/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y        
+021084762NATO SUPPORT AGNCYNATO SUPPORT AGENCY                 NYNEW YORK                 Y N20140603
+021084814MALAWI            MALAWI/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20080213
+021084827MALAWG            MALAWI ACCOUNT G/FOREIGN DEPARTMENT NYNEW YORK                 Y N20120611
+021084830MALDIVES          MALDIVES/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y20080213
+021084843NAMIBIA           NAMIBIA/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y        
+021084856MALAYSIA          MALAYSIA/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y20080213
+021084872MADAGASCAR        BANQUE CENTRAL DE MADAGASCAR/FOREIGNNYNEW YORK                 Y Y        
+021084898SIERRA LEONE      SIERRA LEONE/FOREIGN DEPARTMENT     NYNEW YORK                 Y Y20080213
+021084911MALTA             MALTA/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080213
+021084924MEXICO FUNDS ACCT MEXICO FUNDS ACCT/FOREIGN DEPARTMENTNYNEW YORK                 Y N        
+021084937MAURITANIA        MAURITANIA/FOREIGN DEPARTMENT       NYNEW YORK                 Y N        
+021084953MAURITIUS         MAURITIUS/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20080213
+021084966BN PANAMA FA GOP  BN PANAMA FA GOP/FOREIGN DEPARTMENT NYNEW YORK                 Y Y        
+021084979MEXICO            MEXICO/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20080213
+021084995MOROCCO           MOROCCO/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080213
+021085004NZEAL TREAS       NEW ZEALAND TREAS/FOREIGN DEPARTMENTNYNEW YORK                 Y Y        
+021085017NEPAL             NEPAL/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080214
+021085020DNB SEPARATE ACCT.DE NEDERLANDSCHE BANK N.V. SEPARATE NYNEW YORK                 Y N20130723
+021085033NETHERLANDS ANTIL NETHERLANDS ANTIL/FOREIGN DEPARTMENTNYNEW YORK                 Y Y20080214
+021085059NETHERLANDS       NETHERLANDS/FOREIGN DEPARTMENT      NYNEW YORK                 Y Y20080214
+021085062NVSET             NVSET/FOREIGN DEPARTMENT            NYNEW YORK                 Y N        
+021085075NEW ZEALAND       NEW ZEALAND/FOREIGN DEPARTMENT      NYNEW YORK                 Y Y20080214
+021085088NEW ZEAL FMS      NEW ZEAL FMS/FOREIGN DEPARTMENT     NYNEW YORK                 Y Y        
+021085114NIGERIA           NIGERIA/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080214
+021085130NORWAY            NORWAY/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20080214
+021085143NORWAY FMS        NORWAY FMS/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y20080214
+021085156OMAN              OMAN/FOREIGN DEPARTMENT             NYNEW YORK                 Y Y20080214
+021085172PAKISTAN          PAKISTAN/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y20080214
+021085185PAKISTAN FMS      PAKISTAN FMS/FOREIGN DEPARTMENT     NYNEW YORK                 Y Y20080214
+021085211PAPUA             PAPUA/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080214
+021085237PARAGUAY ESF      PARAGUAY ESF/FOREIGN DEPARTMENT     NYNEW YORK                 Y Y20080214
+021085253PERU              PERU/FOREIGN DEPARTMENT             NYNEW YORK                 Y Y20080214
+021085295PHILIPPINES       PHILIPPINES/FOREIGN DEPARTMENT      NYNEW YORK                 Y Y20080214
+021085305PHILIPPINES FMS   PHILIPPINES FMS/FOREIGN DEPARTMENT  NYNEW YORK                 Y Y20080214
+021085318POLAND            POLAND/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20080214
+021085334PORTUGAL          PORTUGAL/FOREIGN DEPARTMENT         NYNEW YORK                 Y N20140609
+021085350RWANDA            RWANDA/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20030916
+021085376SAUDI ARABIA      SAUDI ARABIA/FOREIGN DEPARTMENT     NYNEW YORK                 Y Y20080214
+021085389SAMA FMS SANG     SAMA FMS SANG/FOREIGN DEPARTMENT    NYNEW YORK                 Y Y20080214
+021085392SAMA              SAMA/FOREIGN DEPARTMENT             NYNEW YORK                 Y Y20080214
+021085415SAMA ACCT TWO     SAMA ACCT TWO/FOREIGN DEPARTMENT    NYNEW YORK                 Y N        
+021085428SAMA ACCT FOUR    SAMA ACCT FOUR/FOREIGN DEPARTMENT   NYNEW YORK                 Y N        
+021085444SAMOA             SAMOA/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y        
+021085457DE L'QUEST        DE L'QUEST/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y20080214
+021085473SEYCHELLES        SEYCHELLES/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y20080214
+021085499SINGAPORE FMS     SINGAPORE FMS/FOREIGN DEPARTMENT    NYNEW YORK                 Y Y        
+021085538MON AUTH SINGAPOREMON AUTH SINGAPORE/FOREIGN DEPT     NYNEW YORK                 Y Y20080214
+021085554SOLOMON ISLAND    SOLOMON ISLAND/FOREIGN DEPARTMENT   NYNEW YORK                 Y Y20080214
+021085596SOUTH AFRICA      SOUTH AFRICA/FOREIGN DEPARTMENT     NYNEW YORK                 Y Y20080214
+021085619SPAIN             SPAIN/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080214
+021085622SPAIN FMS         SPAIN FMS/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y        
+021085648SHAPE FMS         SHAPE FMS/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y        
+021085677SURINAM           SURINAM/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y        
+021085693SWAZILAND         SWAZILAND/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20080214
+021085716SWEDEN            SWEDEN/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20080214
+021085729SWEDEN FMS        SWEDEN FMS/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y20080214
+021085732BIS F             BIS F/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080214
+021085758PANAMA IFIS PIB   PANAMA IFIS PIB/FOREIGN DEPARTMENT  NYNEW YORK                 Y N        
+021085790SWITZERLAND       SWITZERLAND/FOREIGN DEPARTMENT      NYNEW YORK                 Y Y20080214
+021085813SWITZERLAND FMS   SWITZERLAND FMS/FOREIGN DEPARTMENT  NYNEW YORK                 Y Y20080214
+021085855CHINA             CHINA/FOREIGN DEPARTMENT            NYNEW YORK                 Y Y20080214
+021085871CHINA FMS         CHINA FMS/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20080214
+021085897TANZANIA          TANZANIA/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y20080214
+021085907ROMANIA           ROMANIA/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y        
+021085910THAILAND GENERAL  THAILAND GENERAL/FOREIGN DEPARTMENT NYNEW YORK                 Y Y20080214
+021085936THAILAND CURRENCY THAILAND CURRENCY/FOREIGN DEPARTMENTNYNEW YORK                 Y Y20080214
+021085949THAILAND FMS      THAILAND FMS/FOREIGN DEPARTMENT     NYNEW YORK                 Y Y        
+021085965UNITED ARAB FMS   UNITED ARAB FMS/FOREIGN DEPARTMENT  NYNEW YORK                 Y Y        
+021085978TRINIDAD          TRINIDAD/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y20080214
+021085994TRINIDAD ACCT TWO TRINIDAD ACCT TWO/FOREIGN DEPARTMENTNYNEW YORK                 Y Y        
+021086003NRB TONGA         NRB TONGA/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y        
+021086016TUNISIA           TUNISIA/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080214
+021086029TURKEY US AID     TURKEY US AID/FOREIGN DEPARTMENT    NYNEW YORK                 Y Y20020911
+021086032TURKEY            TURKEY/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20080214
+021086045TURKEY FMS        TURKEY FMS/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y        
+021086058UGANDA            UGANDA/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20080214
+021086061ENGLAND 1A        BANK OF ENGLAND - NO 1A ACCOUNT     NYNEW YORK                 Y Y20111123
+021086074ENGLAND A         BK OF ENGLAND A*                    NYNEW YORK                 Y Y20080214
+021086139HER MAJESTY       HER MAJESTY/FOREIGN DEPARTMENT      NYNEW YORK                 Y Y20080214
+021086142ENGL MAJ TWO      ENGLAND MAJ TWO/FOREIGN DEPARTMENT  NYNEW YORK                 Y Y        
+021086155URUGUAY           URAGUAY/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080214
+021086184VANUATU           VANUATU/FOREIGN DEPARTMENT          NYNEW YORK                 Y Y20080214
+021086197VENEZUELA         VENUZEULA/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y20080214
+021086207VATICAN AMMN      AMMINISTRAZIONE DEL PATRIMONIO/FRGN NYNEW YORK                 Y Y        
+021086210VIET NAM          STATE BANK OF VIET NAM/FOREIGN DEPT NYNEW YORK                 Y Y        
+021086236CENTRAL BK YEMEN  CENTRAL BK YEMEN/FOREIGN DEPARTMENT NYNEW YORK                 Y Y20080214
+021086333ZAMBIA            ZAMBIA/FOREIGN DEPARTMENT           NYNEW YORK                 Y Y20080214
+021086359ZIMBABWE          ZIMBABWE/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y        
+021086414TURKEY DEFENSE    TURKEY DEFENSE/FOREIGN DEPARTMENT   NYNEW YORK                 Y Y        
+021086456MOZAMBIQUE        MOZAMBIQUE/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y        
+021086469PEOPLES BK CHINA  PEOPLES BK CHINA/FOREIGN DEPARTMENT NYNEW YORK                 Y Y        
+021086498RUSSIAN FEDERATIONRUSSIAN FEDERATION/FOREIGN DEPT     NYNEW YORK                 Y Y        
+021086553MADAGASCAR AID    BCM MADAGASCAR/FOREIGN DEPARTMENT   NYNEW YORK                 N Y20110713
+021086566FRANCE FMS        BANQUE DE FRANCE/FOREIGN DEPARTMENT NYNEW YORK                 Y Y        
+021086579LIETUVOS BANKAS   LIETUVOS BANKAS/FOREIGN DEPARTMENT  NYNEW YORK                 Y Y        
+021086621LATVIJAS BK       LATVIJAS BANKA/FOREIGN DEPARTMENT   NYNEW YORK                 Y Y        
+021086634EESTI PK          EESTI BANK/FOREIGN DEPARTMENT       NYNEW YORK                 Y Y        
+021086689CENT BNK OMAN INVSCENTRAL BANK OF OMAN/FOREIGN DEPT   NYNEW YORK                 Y Y        
+021086715NATL BK KYRGYZSTANNAT'L BK OF KYRGYZSTAN/FOREIGN DEPT NYNEW YORK                 Y Y        
+021086731NATL BK UKRAINE   NAT'L BANK OF UKRAINE/FOREIGN DEPT  NYNEW YORK                 Y Y        
+021086773IRAQ DEVELOPMT FD CENTRAL BANK OF IRAQ-DEV FUND       NYNEW YORK                 Y Y20030930
+021086812FINLAND FMS       SUOMEN PANKKI-FINLANDS/FOREIGN DEPT NYNEW YORK                 Y Y        
+021086838CB OF SAN MARINO  CENTRAL BK OF THE REP OF SAN MARINO NYNEW YORK                 Y N20140317
+021086841CESKA NARODNI BANKCESKA NARO NI BANKA/FOREIGN DEPT    NYNEW YORK                 Y Y        
+021086948NICARAGUA         NICARAGUA/FOREIGN DEPARTMENT        NYNEW YORK                 Y Y        
+021086993EX IMPORT JAPAN   JAPAN BANK OF INTL COOPERATION      NYNEW YORK                 Y N20120426
+021087125NATL BK OF MOLDOVANATIONAL BANK OF MOLDOVA/FOREIGN    NYNEW YORK                 Y Y        
+021087219NTL BK KAZAKHSTAN NAT'L BANK OF KAZAKHSTAN/FOREIGN    NYNEW YORK                 Y Y        
+021087251UZBEKISTAN        CENTRAL BANK OF REPUBLIC/FOREIGN    NYNEW YORK                 Y N        
+021087303NAT BK CAMBODIA   NAT'L BANK OF CAMBODIA/FOREIGN DEPT NYNEW YORK                 Y Y        
+021087329BELARUS           NATL BANK OF THE REPUBLIC OF BELARUSNYNEW YORK                 Y Y20140303
+021087332GUATEMALA B SP    BANCO DE GUATEMALA B SPECIAL/FOREIGNNYNEW YORK                 Y N        
+021087468CAYMAN ISLANDS    CAYMAN ISLANDS CURRENCY BD/FOREIGN  NYNEW YORK                 Y Y        
+021087484UFFICIO FMS       UFFICIO ITALIANO DEI CAMBI/FOREIGN  NYNEW YORK                 Y Y        
+021087565ARMENIA           CENTRAL BK REPUBLIC ARMENIA/FOREIGN NYNEW YORK                 Y Y        
+021087581MACEDONIA         NATL BK OF REPUBLIC MACEDONIA, NY NYNYNEW YORK                 Y Y19990701
+021087604SLOVAKIA          SLOVAKIA/FOREIGN DEPARTMENT         NYNEW YORK                 Y Y19990701
+021087701VEN USD DIS A IC  VEN USD DIS A IC/FOREIGN DEPARTMENT NYNEW YORK                 N Y20080414
+021087714VEN USD DIS B IC  VEN USD DIS B IC/FOREIGN DEPARTMENT NYNEW YORK                 N Y20080414
+021087727VEN USD PAR A IC  VEN USD PAR A IC/FOREIGN DEPARTMENT NYNEW YORK                 N Y20080414
+021087743VEN USD PAR B IC  VEN USD PAR B IC/FOREIGN DEPARTMENT NYNEW YORK                 N Y20080414
+021087879VEN USD BEAR F PC VEN USD BEAR F PC/FOREIGN DEPARTMENTNYNEW YORK                 N Y20080414
+021087895VEN USD BEAR F IC VEN USD BEAR F IC/FOREIGN DEPARTMENTNYNEW YORK                 N Y20080414
+021087905URU USD FRN A IC  URU USD FRN A IC/FOREIGN DEPARTMENT NYNEW YORK                 N Y20081119
+021087918URU USD FRN B IC  URU USD FRN B IC/FOREIGN DEPARTMENT NYNEW YORK                 N Y20081119
+021087963TURKMENISTAN      CENTRAL BANK OF TURKMENISTAN        NYNEW YORK                 Y Y        
+021087976DOMINICAN DIS IC  DOMINICAN DIS BONDS IC FNDS-SEC AC  NYNEW YORK                 Y Y        
+021087992GEORGIA           NATIONAL BANK OF GEORGIA            NYNEW YORK                 Y Y        
+021088072TURKEY ACCT TWO   TURKIYE CUMHURIYET MERKEZ BANK AC 2 NYNEW YORK                 Y Y        
+021088085ECUADOR DIS IC    ECUADOR USD DIS BONDS IC FNDS SEC   NYNEW YORK                 Y Y        
+021088098ECUADOR PAR IC    ECUADOR USD PAR BONDS IC FNDS SEC   NYNEW YORK                 Y Y        
+021088108NEW ZEALAND SWAPCONEW ZEALAND TREASURY SWAPCO ACCOUNT NYNEW YORK                 Y N        
+021088182CARIB SP FOUR     EASTERN CARIBBEAN CENTRAL BK FOUR   NYNEW YORK                 Y Y        
+021088195BELGIUM PROD FMS  NAT'L BANK OF BELGIUM PROD FMS AC   NYNEW YORK                 Y Y        
+021088205BELGIUM FMS INT   NAT'L BANK OF BELGIUM FMS INT AC    NYNEW YORK                 Y N        
+021088221AZERBAIJAN        CENTRAL BK OF THE REP OF AZERBAIJAN NYNEW YORK                 Y Y20140319
+021088234MALAYSIA FMS      BANK NEGARA MALAYSIA FMS ACCOUNT    NYNEW YORK                 Y Y        
+021088247BK ENGLAND ALBANIABK OF ENGLAND B ALBANIAN PRIN*      NYNEW YORK                 Y Y        
+021088276CROATIA           CROATIAN NATIONAL BANK              NYNEW YORK                 Y Y20140319
+021088289GERMANY FMS       DEUTSCHE BUNDESBANK FMS ACCT        NYNEW YORK                 Y Y        
+021088315ALBANIA           BANK OF ALBANIA                     NYNEW YORK                 Y Y        
+021088328FINLAND FMS INT   SUOMEN PANKKI FINLANDS BK FMS INT.  NYNEW YORK                 Y N        
+021088454BIS SI PERU PC    BIS SEC INT FOR PERU PRIN COLL SE CANYNEW YORK                 Y Y        
+021088467BIS CA PERU IC    BIS COLL AGT FOR PERU INT COLL SE CANYNEW YORK                 Y Y        
+021088483ERITREA           BANK OF ERITREA                     NYNEW YORK                 Y Y        
+021088496ECUADOR SPECIAL   BANCO CENTRAL DEL ECUADOR SP.A/C    NYNEW YORK                 Y Y        
+021088506MONGOLIA          BANK OF MONGOLIA                    NYNEW YORK                 Y Y        
+021088519KUWAIT GOVT FMS   GOV'T OF KUWAIT,MINISTRY OF FIN.FMS NYNEW YORK                 Y Y        
+021088535GERMANY SP ONE    DEUTSCHE BUNDESBANK SP NO ONE       NYNEW YORK                 Y Y        
+021088564VIETNAM DIS IC    VIETNAM DISCOUNT BNDS IC SEC AC     NYNEW YORK                 Y Y        
+021088593BIS CA IVORY COASTBIS COLL AGT 1V COAST COLL SEC CASH NYNEW YORK                 Y Y        
+021088603BIS CAINT IV COASTBIS COLL AGT 1V COAST INT SEC CASH  NYNEW YORK                 Y Y        
+021088616TAJIKISTAN        NAT'L BK.OF REPUBLIC OF TAJIKISTAN  NYNEW YORK                 Y N        
+021088742ISRAEL MOF SP ONE GOVT OF ISRAEL MOF SP NO. 1         NYNEW YORK                 Y N        
+021088823C B LUXEMBOURG    CENTRAL BANK OF LUXEMBOURG          NYNEW YORK                 Y Y20020729
+021088852ADB INV A         ASIAN DEVELOPMENT BANK - INV A      NYNEW YORK                 Y Y19990527
+021088865ADB INV ADF       ASIAN DEVELOPMENT BANK-INV ADF      NYNEW YORK                 Y Y19990527
+021088904ENGLAND SCB       BANK OF ENGLAND - SEC CUST B        NYNEW YORK                 Y Y19990713
+021088917NADB CAIP AF      NORTH AMER DEV BK - U.S. CAIP AF    NYNEW YORK                 Y Y20030930
+021088946FRBNY TRI PARTY   FRBNY TRI PARTY ACCOUNT             NYNEW YORK                 Y N19990929
+021088962BCR PERU ALADI    BANCO CENT.RES.PERU/AGENT FOR ALADI NYNEW YORK                 Y Y19991201
+021089275FRBFX ACCOUNT     FRBNYFX ACCOUNT                     NYNEW YORK                 Y N20000705
+021089327MAURITIUS SP      BANK OF MAURITIUS-SPECIAL DEP. ACCT.NYNEW YORK                 Y N20120611
+021089356MOZAMBIQUE GRM    MOCAMBIQUE-GRM/USAID                NYNEW YORK                 Y Y20001102
+021089372ENGLAND EURO NOTE BANK OF ENGLAND EURO LOAN NOTE ACCT NYNEW YORK                 Y Y20001102
+021089385TANZANIA PRBS     BANK OF TANZANIA-PRBS ACCOUNT       NYNEW YORK                 Y N20131004
+021089398EGYPT GOVT MOD FMFGOVERNMENT OF EGYPT MOD FMF         NYNEW YORK                 Y Y20001206
+021089408ECB GREECE        ECB ACCT GR, BANQUE DE GRECE        NYNEW YORK                 Y Y20020129
+021089411KUWAITB           CENTRAL BANK OF KUWAIT 'B' ACCOUNT  NYNEW YORK                 Y N20140722
+021089424AFDB-HIPC TRUST   AFRICAN DEVELOPMENT BANK-HIPC TRUST NYNEW YORK                 Y Y20010504
+021089437FRBNY AGENT       FRBNY AS AGENT                      NYNEW YORK                 Y Y20010531
+021089453PAKISTAN IDA      STATE BANK OF PAKISTAN-IDA          NYNEW YORK                 Y Y20010601
+021089466PB CHINA DES ACCT PEOPLE'S BK OF CHINA DESIGNATED ACCTNYNEW YORK                 Y Y20010824
+021089479SERBIA            NATIONAL BANK OF SERBIA             NYNEW YORK                 Y Y20070326
+021089482US TREAS BUYBACK  U.S TREASURY BUYBACK- FRBNY AS AGENTNYNEW YORK                 N Y20011023
+021089495KAZAKHSTAN MF     NATL BANK OF KAZAKHSTAN-MF ACCT     NYNEW YORK                 Y Y20010703
+021089505EGYPT SOVERGN DEBTCENT BK EGYPT-BEHALF GOV-SOV DEBT ACNYNEW YORK                 Y Y20010713
+021089576TRINIDAD MIRROR   CNTRL BK OF TRINIDAD & TOBAGO MIRRORNYNEW YORK                 Y Y20020213
+021089592INDONESIA DES ACCTBANK INDONESIA DESIGNATED ACCT      NYNEW YORK                 Y Y20020718
+021089602PB CHINA DES B    PEOPLE'S BANK OF CHINA DESIGNATED B NYNEW YORK                 Y Y20060327
+021089615SURINAME RAMP     CENTRALE BANK VAN SURINAME RAMP ACCTNYNEW YORK                 Y Y20120104
+021089628FRBAML            FRBNY - MBS ACCT BAML               NYNEW YORK                 Y N20111117
+021089631FRBARX            FRBNY - MBS ACCT BARX               NYNEW YORK                 Y N20111117
+021089644FRMBS2            FRBNY - MBS ACCOUNT 2               NYNEW YORK                 Y Y20111103
+021089657FRBMBS            FRBNY - MBS ACCOUNT                 NYNEW YORK                 N Y20111031
+021089673FRBNPP            FRBNY - MBS ACCT BNPP               NYNEW YORK                 Y N20111117
+021089686FRBMBC            FRBNY - MBS ACCT C                  NYNEW YORK                 Y N20111117
+021089699FRCSFB            FRBNY - MBS ACCT CSFB               NYNEW YORK                 Y N20111117
+021089709FRBMDB            FRBNY - MBS ACCT DB                 NYNEW YORK                 Y N20111117
+021089712FRBMGS            FRBNY - MBS ACCT GS                 NYNEW YORK                 Y N20111117
+021089725FRBJEF            FRBNY - MBS ACCT JEF                NYNEW YORK                 Y N20111117
+021089738FRBJPM            FRBNY - MBS ACCT JPM                NYNEW YORK                 Y N20111117
+021089741FRMSDW            FRBNY - MBS ACCT MSDW               NYNEW YORK                 Y N20111117
+021089754FRMZHO            FRBNY - MBS ACCT MZHO               NYNEW YORK                 Y N20111117
+021089767FRBNOM            FRBNY - MBS ACCT NOM                NYNEW YORK                 Y N20111117
+021089783FRBRBC            FRBNY - MBS ACCT RBC                NYNEW YORK                 Y N20111117
+021089796FRBRBS            FRBNY - MBS ACCT RBS                NYNEW YORK                 Y N20111117
+021089806FRUBSW            FRBNY - MBS ACCT UBSW               NYNEW YORK                 Y N20111117
+021089819FRDAIW            FRBNY - MBS ACCT DAIW               NYNEW YORK                 Y N20130506
+021089835FRBSOC            FRBNY- MBS ACCT SG AMERICAS         NYNEW YORK                 Y N20140617
+021101438NO AMER BK CT     WEBSTER BANK, N.A.                  CTSTRATFORD                Y N20100412
+021110209FIRST NIAGARA     FIRST NIAGARA BANK, NA              NYBUFFALO                  Y N20121212
+021112935PATRIOT STAMFORD  PATRIOT NATIONAL BANK               CTSTAMFORD                 Y Y20010925
+021113125GREENWICH BTC CONNCONNECTICUT COMMUNITY BANK, N.A.    CTGREENWICH                Y N20121213
+021113251CT COMMUNITY BK NACONNECTICUT COMMUNITY BANK, N.A.    CTWESTPORT                 Y Y20040621
+021113662BANK OF NEW CANAANBANKWELL BANK                       CTNEW CANAAN               Y N20130906
+021113688ABBEY NATL CT     ABBEY NATIONAL TREASURY SERVICES    CTSTAMFORD                 YSY20081201
+021114111DARIEN ROWAYTON BKDARIEN ROWAYTON BANK                CTDARIEN                   Y Y20100322
+0211141531ST BK GREENWICH  THE FIRST BANK OF GREENWICH         CTGREENWHICH               Y Y20110208
+021114221BANK OF IRELAND   BANK OF IRELAND                     CTSTAMFORD                 Y N20061116
+021114483BANK OF NEW CANAANBANKWELL BANK                       CTNEW CANAAN               Y N20131011
+021114661RBS               THE RBS PLC CT BRANCH               CTGREENWICH                YSN20130607
+021200957TD BANK, NA       TD BANK, NA                         NJCHERRY HILL              Y N20091110
+021201383VALLEY PASSAIC    VALLEY NATIONAL BANK                NJPASSAIC                  Y Y        
+021201503TD BANK, NA       TD BANK, NA                         MELEWISTON                 Y N20131004
+021201639CITY OF NJ NEWARK CITY NATIONAL BANK OF NEW JERSEY    NJNEWARK                   Y Y        
+021202719BK OF NY NA NCD   JPMORGAN CHASE BANK, NA             NJCARLSTADT                N Y20121213
+021203792NATL BRANCHVILLE  LAKELAND BANK                       NJBRANCHVILLE              N Y20030506
+021204416AMBOY OLD BRIDGE  AMBOY BANK                          NJOLD BRIDGE               Y Y20121213
+021204597FIRST HOPE        FIRST HOPE BANK                     NJHOPE                     Y Y        
+021205237PEAPACK GLADSTONE PEAPACK-GLADSTONE BANK              NJGLADSTONE                Y Y20121213
+021205376LAKELAND STATE NJ LAKELAND BANK                       NJNEWFOUNDLAND             Y Y        
+021205648CENTER UNION      CONNECTONE BANK                     NJENGLEWOOD CLIFFS         Y Y20140718
+021206249SUSSEX BANK       SUSSEX BANK                         NJFRANKLIN                 Y Y20090922
+021206582ATLTC STDSHP BK NJATLANTIC STEWARDSHIP BANK           NJMIDLAND PARK             Y Y        
+021206948BRUNSWICK BTNJ    BRUNSWICK BANK & TRUST COMPANY      NJNEW BRUNSWICK            Y Y        
+0212071541STCONSTITUTIONBK 1ST CONSTITUTION BANK               NJCRANBURY                 Y Y20060831
+021207196COMMUNITY MAYWOOD COMMUNITY BANK OF BERGEN COUNTY     NJROCHELLE PARK            Y Y        
+021210057UNITY BANK NJ     UNITY BANK                          NJCLINTON                  Y Y20040114
+021213180KEARNY FSLA       KEARNY FEDERAL SAVINGS BANK         NJLONG BRANCH              Y N20120224
+021213371NEW MILLENNIUM BK NEW MILLENNIUM BANK                 NJNEW BRUNSWICK            Y Y20130620
+021213397PROVIDENT BANK    STERLING NATIONAL BANK - PERKSTREET NJLODI                     Y N20131031
+021213449INVESTORS SB      INVESTORS BANK                      NJNEWARK                   Y N20120611
+021213465TWO RIVER COMMBK  TWO RIVER COMMUNITY BANK            NJMIDDLETOWN               Y Y20030812
+021213481BCB COMMUNITY BANKBCB COMMUNITY BANK                  NJSOUTH ORANGE             Y N20120323
+021213520BCB COMMUNITY BANKBCB COMMUNITY BANK                  NJBAYONNE                  YSY20110617
+021213559MARINERBK         MARINER'S BANK                      NJEDGEWATER                Y Y20110420
+021213591SYNCHRONY BANK    SYNCHRONY BANK                      UTDRAPER                   Y N20140625
+021213698PASCACK COMM BK   PASCACK COMMUNITY BANK              NJWESTWOOD                 YSY20091026
+021213711ENTERPRISE BANK   ENTERPRISE NATIONAL BANK, N.J.      NJKENILWORTH               Y N20060124
+021213818ATLANTIC COMMUNITYATLANTIC COMMUNITY BANKERS BANK     PACAMP HILL                Y N20130814
+021213944CONNECTONE BANK   CONNECTONE BANK                     NJENGLEWOOD CLIFFS         Y Y20130211
+021214066INDUS AMERICAN BK INDUS AMERICAN BANK                 NJISELIN                   Y N20061130
+021214082FIRST COMMERCE BK FIRST COMMERCE BANK                 NJLAKEWOOD                 Y N20130301
+021214095HIGHLANDS ST BANK HIGHLANDS STATE BANK                NJVERNON                   Y N20080424
+021214189BANK OF NEW JERSEYBANK OF NEW JERSEY                  NJFORT LEE                 Y N20070201
+021214273CROSS RIVER BK    CROSS RIVER BANK                    NJTEANECK                  Y N20130215
+021214325WILSHIRE BANK     WILSHIRE BANK                       CALOS ANGELES              Y N20131226
+021214435REGAL BANK        REGAL BANK                          NJLIVINGSTON               Y N20080117
+021214493FREEDOM BANK      FREEDOM BANK                        NJMAYWOOD                  Y Y20131231
+021272655CITIBANK FSB NJ   CITIBANK, N.A.                      NJPARSIPPANY               Y N20120622
+021272723WASH MUT BANK     JPMORGAN CHASE BANK, NA             NYVALLEY STREAM            Y N20101019
+021272778MS PRIVATE BANK   MORGAN STANLEY PRIVATE BANK NA      NYPURCHASE                 Y Y20100708
+021300077KEYBANK ALBANY    KEYBANK NATIONAL ASSOCIATION        NYALBANY                   Y N20140304
+021300912TRUSTCO BANK      TRUSTCO BANK                        NYGLENVILLE                Y Y20021115
+021301115CHEMUNG ELMIRA    CHEMUNG CANAL TRUST COMPANY         NYELMIRA                   Y Y        
+021302554GLENS FALLS NB TR GLENS FALLS NAT'L BANK & TRUST      NYGLENS FALLS              Y Y        
+021302567TD BANK, NA       TD BANK, NA                         VTWILLISTON                Y N20090928
+021302648TOMPKINS ITHACA   TOMPKINS TRUST COMPANY              NYITHACA                   Y Y20010504
+021302884TRUST SARATOGA SPGADIRONDACK TRUST COMPANY            NYSARATOGA SPRINGS         Y Y        
+021303472COMMUNITY BK SYR  COMMUNITY BANK, NA                  NYONEONTA                  Y N20121213
+021303511NATL NORWICH      NBT NATIONAL ASSOCIATION            NYSYRACUSE                 Y N20130626
+021303618NATL NORWICH      NBT NATIONAL ASSOCIATION            NYWHITNEY POINT            Y Y20110422
+021304675NATL BALLSTON SPA BALLSTON SPA NATIONAL BANK          NYBALLSTON SPA             Y Y        
+021305386COMMUNITY BK SYR  COMMUNITY BANK, NA                  NYSYRACUSE                 Y Y20120611
+021305577NATL NORWICH      NBT NATIONAL ASSOCIATION            NYCANAJOHARIE              Y N20120522
+021305991FIRST GROTON      FIRST NATIONAL BANK OF GROTON       NYGROTON                   Y Y        
+021306547CAYUGA UNION SPGS CAYUGA LAKE NATIONAL BANK           NYUNION SPRINGS            Y Y        
+021307054NATL COXSACKIE    NATIONAL BANK OF COXSACKIE          NYCOXSACKIE                Y Y        
+021307096DELAWARE DELHI    DELAWARE NATIONAL BANK OF DELHI     NYDELHI                    Y Y        
+021307164FIRST DRYDEN      FIRST NATIONAL BANK OF DRYDEN       NYDRYDEN                   Y Y        
+021307559COMMUNITY BK DEW  COMMUNITY BANK, NA                  NYSYRACUSE                 Y N20121213
+021307708TD BANK, N A      TD BANK, NA                         NYKEESEVILLE               N Y20100217
+021307711NATL KINDERHOOK   NATIONAL UNION BANK OF KINDERHOOK   NYKINDERHOOK               Y Y        
+021308396BK OF RICHMONDVILLBANK OF RICHMONDVILLE               NYCOBLESKILL               Y Y        
+021308642TIOGA SPENCER     TIOGA STATE BANK                    NYSPENCER                  Y Y        
+021308833DELAWARE WALTON   NATIONAL BANK OF DELAWARE CTY.      NYWALTON                   Y Y        
+021309201CITIZENS HAMMOND  CITIZENS NATIONAL BANK              NYHAMMOND                  Y Y        
+021309285NATL REDWOOD      WSB MUNICIPAL BANK                  NYWATERTOWN                Y N20080617
+021309735BK SOLVAY         SOLVAY BANK                         NYSOLVAY                   Y Y        
+021309997CITIZENS BK CV    CITIZENS BANK OF CAPE VINCENT       NYCAPE VINCENT             YSN20100127
+021310407CHAMPLAIN WILSBOROCHAMPLAIN NATIONAL BANK             NYWILLSBORO                Y Y        
+021310465UPSTATE NATL      THE UPSTATE NATIONAL BANK           NYLISBON                   Y Y20060518
+021310591STATE CHITTENANGO STATE BANK OF CHITTENANGO           NYCHITTENANGO              Y Y20020603
+021310711FIRST BK SCOTIA   FIRST NATIONAL BANK OF SCOTIA       NYSCOTIA                   Y Y        
+021311383BK UTICA          BANK OF UTICA                       NYUTICA                    Y Y        
+021311529SARATOGA NATL B&TCSARATOGA NATIONAL BK & T/C          NYSARATOGA SPRINGS         Y N20070830
+021313103RBS CITIZENS, N.A.RBS CITIZENS, N.A.                  NYALBANY                   Y N20070905
+021313734PATHFINDER COMM BKPATHFINDER COMMERCIAL BANK          NYOSWEGO                   Y N20030410
+021313925PIONEER COMM BK   PIONEER COMMERCIAL BANK             NYTROY                     Y N20050210
+021373059PATRIOT FED BK    PATRIOT FEDERAL BANK                NYCANAJOHARIE              YSN20090827
+021405464SUFFOLK RIVERHEAD SUFFOLK COUNTY NATIONAL BANK        NYRIVERHEAD                Y Y        
+021406667NATL BRIDGEHAMPTONBRIDGEHAMPTON NATIONAL BANK         NYBRIDGEHAMPTON            Y Y        
+021407912NORTH FORK BANK   CAPITAL ONE, N.A.                   NYMELVILLE                 Y N20080422
+021408597PEOPLES BANK      PEOPLE'S UNITED BANK                NYSMITHTOWN                Y N20121212
+021411335FIRST GLEN HEAD   FIRST NATIONAL BANK OF LONG ISLAND  NYGLEN HEAD                Y Y        
+021412114NYCX              NEW YORK COMMERCIAL BANK            NYISLANDIA                 Y Y20061109
+021413155NATL BRIDGEHAMPTONBRIDGEHAMPTON NATIONAL BANK         NYSOUTHAMPTON              Y N20140312
+021413388AMER COMMUNITY BK AMERICAN COMMUNITY BANK             NYGLEN COVE                Y N20000105
+021413935COMMUNITY NATL BK COMMUNITY NATIONAL BANK             NYGREAT NECK               Y Y20131010
+021414280NATL BRIDGEHAMPTONBRIDGEHAMPTON NATIONAL BANK         NYBRIDGEHAMPTON            Y N20140214
+021414426EMPIRE NATL BANK  EMPIRE NATIONAL BANK                NYISLANDIA                 Y Y20121227
+021414455GOLD COAST BANK   GOLD COAST BANK                     NYISLANDIA                 Y Y20140210
+021473030QUONTIC BANK      QUONTIC BANK                        NYGREAT NECK               Y Y20100803
+021484524AM BR EMPL FCU    AMERICAN BROADCAST EMPL FCU         NYROCKVILLE CENTRE         Y N20130118
+021502011BANCO POPULAR PR  BANCO POPULAR DE PUERTO RICO        PRSAN JUAN                 Y Y        
+021502118GOVERNMENT DEV PR GOVERNMENT DEVELOPMENT BANK P.R.    PRSAN JUAN                 Y Y20140617
+021502150CARACAS INT'L     CARACAS INTERNATIONAL BANKING CORP  PRSAN JUAN                 Y Y20130422
+021502189FACE BANK         FACE BANK INTERNATIONAL CORPORATION PRGUAYNABO                 Y Y20130426
+021502202BBO INT'L PRIV BK BBO INTERNATIONAL PRIVATE BANK      PRSAN JUAN                 Y N20070906
+021502215TALBANK           ITALBANK INTERNATIONAL, INC.        PRSAN JUAN                 Y N20121018
+021502228ORIENTAL BANK     ORIENTAL BANK                       PRSAN JUAN                 Y N20131211
+021502231BANCREDITO        BANCREDITO INT'L BANK CORP.         PRSAN JUAN                 Y N20111107
+021502257ARCA INTL BANK    ARCA INTERNATIONAL BANK, INC.       PRGUAYNABO                 Y N20130710
+021502260NODUS INTL BANK   NODUS INTERNATIONAL BANK, INC       PRSAN JUAN                 YSY20131114
+021502286BANCO SAN JUAN    BANCO SAN JUAN INTERNACIONAL INC    PRSAN JUAN                 Y Y20130514
+021502309ACTIVOINTERBANK   ACTIVO INTERNATIONAL BANK, INC.     PRSAN JUAN                 Y N20130724
+021502325ELITE             ELITE INTERNATIONAL BANK, INC       PRHATO REY                 Y N20140318
+021502341BANCO SANTANDER PRBANCO SANTANDER,  PUERTO RICO       PRSAN JUAN                 Y Y20000315
+021502383BCO COOPERATIVO PRBANCO COOPERATIVO DE PUERTO RICO    PRSAN JUAN                 Y Y        
+021502804SCOTIABANK PR     SCOTIABANK DE PUERTO RICO           PRSAN JUAN                 YSY20120224
+021606690BK ST CROIX       BANK OF ST. CROIX                   VIST CROIX                 Y Y20100728
+021800195VEN DMK DIS PC    VENEZUELA DMK DIS SERIES/FUNDS      NYNEW YORK                 N Y        
+021800205VEN DMK PAR PC    VENEZUELA DMK PAR SERIES/FOREIGN    NYNEW YORK                 N Y        
+021800221VEN FFF PAR PC    VENEZUELA FFF PAR SERIES/FOREIGN    NYNEW YORK                 N Y        
+021800247VEN LRA PAR PC    VENEZUELA LRA PAR SERIES/FOREIGN    NYNEW YORK                 N Y        
+021800263VEN SSF PAR PC    VENEZUELA SSF PAR SERIES/FOREIGN    NYNEW YORK                 N Y        
+021800292VEN DMK DIS IC    VENEZUELA DMK DIS SERIES/FOREIGN    NYNEW YORK                 N Y        
+021800302VEN DMK PAR IC    VENEZUELA DMK PAR SERIES/FOREIGN    NYNEW YORK                 N Y        
+021800328VEN FFF PAR IC    VENEZUELA FFF PAR SERIES/FOREIGN    NYNEW YORK                 N Y        
+021800344VEN LRA PAR IC    VENEZUELA LRA PAR SERIES/FOREIGN    NYNEW YORK                 N Y        
+021800467URU USD FRN A PC  URUGUAY USD FRN SERIES A/FOREIGN    NYNEW YORK                 N Y        
+021800470URU USD FRN B PC  URUGUAY USD FRN SERIES B/FOREIGN    NYNEW YORK                 N Y        
+021902475ORANGE MIDDLETOWN ORANGE COUNTY TRUST COMPANY         NYMIDDLETOWN               Y Y        
+021906808PUTNAM CARMEL     PUTNAM COUNTY NATIONAL BANK         NYCARMEL                   Y Y        
+021907975BK MILLBROOK      BANK OF MILLBROOK                   NYMILLBROOK                Y Y        
+021909300HUDSON YONKERS    HUDSON VALLEY BANK, N.A.            NYYONKERS                  Y Y20071126
+021909342JEFF BANK         JEFF BANK                           NYJEFFERSONVILLE           Y Y20130605
+021911343RIVERSIDE BK POUGHRIVERSIDE BANK                      NYPOUGHKEEPSIE             Y Y20120516
+021911369COUNTRY BANK      COUNTRY BANK                        NYNEW YORK                 Y N20131105
+021911398MAHOPAC BK        MAHOPAC BANK                        NYMAHOPAC                  Y Y20140103
+021912410NATL ELLENVILLE   STERLING NATIONAL BANK              NYELLENVILLE               Y N20131031
+021912915TD BANK USA, N.A. TD BANK USA, NATIONAL ASSOCIATION   NYNEW YORK                 Y N20100308
+021912928CATSKILL HUD BK   CATSKILL HUDSON BANK                NYMONTICELLO               Y Y20110328
+021913639GREATER HUDSON    GREATER HUDSON BANK, N.A.           NYMIDDLETOWN               Y N20110504
+021913862EMPIRE STATE BANK EMPIRE STATE BANK, N.A.             NYNEWBURGH                 Y Y20081014
+021914078USA BANK NY       CUSTOMERS BANK                      NYPORT CHESTER             Y N20120321
+021914544WESTCHESTER BANK  THE WESTCHESTER BANK                NYYONKERS                  Y N20100111
+022000046MFRS BUF          MANUFACTURERS & TRADERS TRUST CO.   NYBUFFALO                  Y Y        
+022000868CITIBANK BUF      CITIBANK, N.A.                      NYPITTSFORD                Y N20120622
+022072692FIRST NIAGARA     FIRST NIAGARA BANK, NA              NYLOCKPORT                 Y N20120608
+022302786STEUBEN TR HORNELLSTEUBEN TRUST COMPANY               NYHORNELL                  Y Y        
+022303659NATL CANANDAIGUA  CANANDAIGUA NAT'L BANK & TR. CO.    NYCANANDAIGUA              Y Y        
+022304030FIVE STAR BANK    FIVE STAR BANK                      NYWARSAW                   Y Y20051209
+022304616NATL LYONS        LYONS NATIONAL BANK                 NYLYONS                    Y Y        
+022305770BK AKRON          BANK OF AKRON                       NYAKRON                    Y Y        
+022306818BK CASTILE        BANK OF CASTILE                     NYCASTILE                  Y Y        
+022306847BANK CATTARAUGUS  BANK OF CATTARAUGUS                 NYCATTARAUGUS              Y Y        
+022307600BANK HOLLAND      BANK OF HOLLAND                     NYHOLLAND                  Y Y        
+022307820CATTARAUGUS VALLEYCATTARAUGUS COUNTY BANK             NYLITTLE VALLEY            Y Y        
+022309611STATE ALDEN       ALDEN STATE BANK                    NYALDEN                    Y Y        
+022310121EVANS ANGOLA      EVANS BANK, NATIONAL ASSOCIATION    NYANGOLA                   Y Y20130925
+022311117SAVANNAH BK NA    SAVANNAH BANK, N.A.                 NYSAVANNAH                 Y Y        
+022313021GENESEE REGIONAL  GENESEE REGIONAL BANK               NYROCHESTER                Y N20131007
+022314020EVANS BK ANGO     EVANS NATIONAL BANK OF ANGOLA       NYWILLIAMSVILLE            YSN20130930
+022314334USNY BANK         USNY BANK                           NYGENEVA                   Y N20070817
+026000110UNITED BK AFRICA  UNITED BANK FOR AFRICA PLC          NYNEW YORK                 YSN20080411
+026000194NORINCHUKIN BANK  THE NORINCHUKIN BANK                NYNEW YORK                 YSY20050208
+026000217NATL BK KUWAIT    NATIONAL BANK OF KUWAIT             NYNEW YORK                 Y Y        
+026001122NORTHERN INTL NYC NORTHERN TRUST INTL BKG. CORP.      NJJERSEY CITY              Y N20060925
+026001287MALAYAN BERHAD NYCMALAYAN BANKING BERHAD              NYNEW YORK                 Y N        
+026001423ASIA BANK NYC     ASIA BANK. N.A.                     NYELMHURST                 Y Y        
+026001465EASTBANK NYC      EASTBANK, N.A.                      NYNEW YORK                 Y Y        
+026001847BCO BILBAO NYC    BANCO BILBAO VIZCAYA ARGENTARIA     NYNEW YORK                 Y Y20000907
+026002066DTC SDFSS         DEPOSITORY TRUST COMPANY            NYNEW YORK                 Y Y20140411
+026002095HANA BANK NYC     HANA BANK                           NYNEW YORK                 Y N20030711
+026002341GULF INTL BK NYC  GULF INTERNATIONAL BANK             NYNEW YORK                 YSN        
+026002532BK NOVA SCOTIA NYCBANK OF NOVA SCOTIA                 NYNEW YORK                 Y Y        
+026002545LANDESBANK HESSEN LANDESBANK HESSEN-THURINGEN         NYNEW YORK                 Y N20130925
+026002558CAN IMPERIAL COMM CANADIAN IMPERIAL BANK OF COMMERCE  NYNEW YORK                 YSY        
+026002561STANDARD CHART    STANDARD CHARTERED BANK LIMITED     NYNEW YORK                 Y Y20020819
+026002574BARCLAYS PLC      BARCLAYS BANK, PLC                  NYNEW YORK                 Y Y20081218
+026002613BANCO ITAU        ITAU UNIBANCO SA NY BRANCH          NYNEW YORK                 Y Y20101206
+026002655LLOYDS TSB BK PLC LLOYDS TSB BANK PLC                 NYNEW YORK                 YSY20100318
+026002749NATWEST PLC       NATIONAL WESTMINSTER BANK PLC       NYNEW YORK                 N Y19990922
+026002752UN ORIENT NYC     UNITED ORIENT BANK                  NYNEW YORK                 Y Y        
+026002794BK LEUMI TR NY    BANK LEUMI USA                      NYNEW YORK                 Y Y        
+026002846METRO BK TR NYC   METROPOLITAN BANK & TRUST COMPANY   NYNEW YORK                 Y N        
+026002901RABOBANK NYC      RABOBANK NEDERLAND                  NYNEW YORK                 YSY20130814
+026002927CATHAY BANK       CATHAY BANK                         NYFLUSHING                 Y N20120125
+026002956MERCANTIL COMMERCEMERCANTIL COMMERCEBANK, NA          NYNEW YORK                 Y N20120322
+026003007PHILIPPINE NAT NY PHILLIPINE NAT'L BANK               NYNEW YORK                 Y Y20020917
+026003010WESTDEUT LANDESBK PORTIGON AG                         NYNEW YORK                 YSY20120706
+026003023SAFRA NATIONAL    SAFRA NATIONAL BANK OF NEW YORK     NYNEW YORK                 Y Y        
+026003036SKAND ENSKIBANKEN SKANDINAVISKA ENSKILDA BANKEN       NYNEW YORK                 YSY20080327
+026003188BK SCOTLAND       BANK OF SCOTLAND                    NYNEW YORK                 YSY20071004
+026003191OVERSEA CHINESE   OVERSEA CHINESE BKG. CORP. LIMITED  NYNEW YORK                 YSY20100916
+026003243TOR DOM NY BRANCH TORONTO DOMINION BANK               NYNEW YORK                 YSY20130724
+026003269BK OF CHINA NYC   BANK OF CHINA                       NYNEW YORK                 Y Y        
+026003272BNB HANA BK       BNB HANA BANK, NATIONAL ASSOCIATION NYNEW YORK                 Y Y20131114
+026003324BCO REPUBLICA URU BANCO DE LA REPUBLICA ORIENTAL      NYNEW YORK                 Y N        
+026003379AMALGAMATED BK NYCAMALGAMATED BANK                    NYNEW YORK CITY            Y Y20111216
+026003447WESTPAC BKING CORPWESTPAC BANKING CORPORATION         NYNEW YORK                 YSY20080923
+026003557BCO BRASIL NYC    BANCO DO BRASIL S.A.                NYNEW YORK                 Y Y        
+026003780DEUTSCHE BK NY    DEUTSCHE BANK, A.G.                 NYNEW YORK                 Y Y        
+026004048ARAB CORP         ARAB BANKING CORPORATION            NYNEW YORK                 YSY        
+026004093ROYAL BK CAN NYC  ROYAL BANK OF CANADA                NYNEW YORK                 YSY20090610
+026004226SOCIETE GEN       SOCIETE GENERALE                    NYNEW YORK                 Y Y        
+026004297NATL BK NYC       NATIONAL BANK OF NEW YORK CITY      NYFLUSHING                 Y Y        
+026004307MHBK NY           MIZUHO BANK, LTD., NEW YORK BRANCH  NYNEW YORK                 Y Y20130628
+026004624MODERN BK NYC     MODERN BANK, NATIONAL ASSOCIATION   NYNEW YORK                 Y Y20060223
+026004721NATL PAKISTAN     NATIONAL BANK OF PAKISTAN           NYNEW YORK                 Y Y20130830
+026004860BRADESCO NYC      BANCO BRADESCO S.A.                 NYNEW YORK                 Y N        
+026004970EW BK SMRINO      EAST WEST BANK                      NYNEW YORK                 Y N20100422
+026005050UNION BANK NY     MUFG UNION BANK, NA                 NYNEW YORK                 Y N20140630
+026005092WELLSFARGO NY INTLWELLS FARGO NY INTL                 NYNEW YORK                 Y N20110805
+026005319BANCA INTESA S.P.AINTESA SANPAOLO SPA                 NYNEW YORK                 Y Y20061228
+026005322BK BARODA NYC     BANK OF BARODA                      NYNEW YORK                 Y N20030723
+026005416WOORI BANK NYC    WOORI BANK                          NYNEW YORK                 Y N20020912
+026005458BK INDIA NYC      BANK OF INDIA                       NYNEW YORK                 Y N        
+026005487NATL CANADA NYC   NATIONAL BANK OF CANADA             NYNEW YORK                 YSY20130822
+026005610DNB NOR BANK ASA  DNB BANK ASA                        NYNEW YORK                 YSY20111117
+026005652CITIBANK (BDC NY) CITIBANK, N.A.                      NYNEW YORK                 Y N20120622
+026005720ABL NEW YORK      ARAB BANK PLC                       NYNEW YORK                 N Y20050715
+026005908MPS NYC           BANCA MONTE DEI PASCHI DI SIENA,S.PANYNEW YORK                 Y N20090515
+026005911SHANGHAI COMM NYC SHANGHAI COMMERCIAL BANK LIMITED    NYNEW YORK                 Y N        
+026006004CITIC BANK INTL-NYCHINA CITIC BANK INTERNATIONAL LTD  NYNEW YORK                 Y N20131007
+026006224BBCN BANK NY      BBCN BANK                           NYNEW YORK                 Y N20111205
+026006237INTERAUDI BANK    INTERAUDI BANK                      NYNEW YORK                 Y Y20030501
+026007113UN OVERSEAS BK LTDUNITED OVERSEAS BANK LIMITED        NYNEW YORK                 YSN        
+026007362HABIB AMERICAN BK HABIB AMERICAN BANK                 NYNEW YORK                 Y Y        
+026007443WOORI AMERICAN BK WOORI AMERICA BANK                  NYNEW YORK                 Y Y20020826
+026007582ABNY              NEW YORK COMMERCIAL BANK            NYWESTBURY                 Y N20120612
+026007689BQE NAT PARIS     BNP PARIBAS                         NJJERSEY CITY              Y Y20070824
+026007692BCO SANTANDER NYC BANCO SANTANDER, S.A.               NYNEW YORK                 Y Y20071017
+026007728NATL AUSTRAL LTD  NATIONAL AUSTRALIA BANK             NYNEW YORK                 YSY20041108
+026007773STERLING NYC      STERLING NATIONAL BANK              NYMONTEBELLO               Y Y20131031
+026007809HABIB BK LTD NYC  HABIB BANK LIMITED                  NYNEW YORK                 Y N        
+026007906UNITED BK LTD NYC UNITED BANK LIMITED                 NYNEW YORK                 Y N        
+026007922UMB BK NA         UMB BANK, N.A.                      MOKANSAS CITY              Y N20140711
+026007993UBS AG NYC        UBS AG                              NYNEW YORK                 Y Y20140630
+026008044COMMERZBANK NYC   COMMERZBANK AG, NEW YORK BRANCH     NYNEW YORK                 Y Y20110923
+026008073CREDIT AGRICOLE CICREDIT AGRICOLE CIB                 NYNEW YORK                 Y Y20100205
+026008248KBC BANK NV NYC   KBC BANK, N.V.                      NYNEW YORK                 Y Y19991228
+026008455DZ BK AG DEUTSCHE DZ BANK AG DEUTSCHE ZENTRAL-GENOSSENNYNEW YORK                 YSN20020826
+026008536ITALCREDIT        UNICREDIT S.P.A.                    NYNEW YORK                 Y N20120914
+026008552BCO ARGENTINA NYC BANCO DE LA NACION ARGENTINA        NYNEW YORK                 Y N        
+026008620MUTB NYC          MITSUBISHI UFJ TRUST & BANKING CORP.NYNEW YORK                 YSY20061030
+026008659CF DE CIC UE      CREDIT INDUSTRIEL ET COMMERCIAL     NYNEW YORK                 YSY20000926
+026008691BANGKOK BK NYC    BANGKOK BANK PUBLIC COMPANY LIMITED NYNEW YORK                 Y N        
+026008714BCO BOGOTA NY AGCYBANCO DE BOGOTA                     NYNEW YORK                 Y N        
+026008756BESSEMER TR NYC   BESSEMER TRUST COMPANY              NYNEW YORK                 Y Y        
+026008808BAY VEREINS BK    UNICREDIT BANK AG                   NYNEW YORK                 Y Y20091222
+026008811BCO POPULAR NOAMERBANCO POPULAR NORTH AMERICA         ILROSEMONT                 Y Y20120920
+026008853ATLANTIC BK OF NY NEW YORK COMMERCIAL BANK            NYWESTBURY                 Y N20121213
+026008866BK HAPOALIM NYC   BANK HAPOALIM B.M.                  NYNEW YORK                 Y Y        
+026008905MBK USA           MIZUHO BANK (USA)                   NJJERSEY CITY              YSY20130628
+026009027COMWEALTH AUSTRAL COMMONWEALTH BANK OF AUSTRALIA      NYNEW YORK                 YSY        
+026009085ICBC NYC          MEGA INTERNATIONAL COMMERCIAL BANK  NYNEW YORK                 Y Y20060921
+026009124T.C. ZIRAAT       T.C. ZIRAAT BANKASI                 NYNEW YORK                 Y Y20071220
+026009140ST BK INDIA NYC   STATE BANK OF INDIA                 NYNEW YORK                 Y Y        
+026009166ST BOS SEC NYC    STATE STREET BANK & TRUST COMPANY   NYNEW YORK                 Y N        
+026009179CR SUISSE NY      CREDIT SUISSE FIRST BOSTON          NYNEW YORK                 YSY19991201
+026009247BANDUSTRAL NYC    BANCO INDUSTRIAL DE VENEZUELA       NYNEW YORK                 Y Y20000306
+026009580ABN AMRO NYC      THE RBS PLC CT BRANCH               CTSTAMFORD                 Y Y20130607
+026009593BK AMER NYC       BANK OF AMERICA, N.A., NY           NYNEW YORK                 Y N20031113
+026009632BTMU LTD NY       THE BANK OF TOKYO-MITSUBISHI UFJ LTDNJJERSEY CITY              Y Y20060104
+026009674SMBC NY           SUMITOMO MITSUI BANKING CORPORATION NYNEW YORK                 YSY20030728
+026009739FHLB NYC          FEDERAL HOME LOAN BANK OF NEW YORK  NJJERSEY CITY              Y Y20011119
+026009768ISRAEL DISC BK NYCISRAEL DISCOUNT BANK OF NEW YORK    NYNEW YORK                 Y Y        
+026009917ANZ BK LTD NYC    AUSTRALIA & NEW ZEALAND BKG. GROUP  NYNEW YORK                 YSY19991230
+026009920BGE FRAN COMM EXTENATIXIS                             NYNEW YORK                 YSY20100325
+026009988NATL BK EGYPT NY  NATIONAL BANK OF EGYPT              NYNEW YORK                 Y Y20010102
+026010090SUMITOMO MITSUI TRSUMITOMO MITSUI TRUST BANK, LIMITED NYNEW YORK                 YSY20120404
+026010252YASUDA TR NYC     YASUDA TRUST & BANKING CO., LTD.    NYNEW YORK                 N Y19990910
+026010605PROVIDENT BANK    STERLING NATIONAL BANK              NYNEW YORK                 Y N20131031
+026010773DELTABANK NYC     DELTA NATIONAL BANK & TRUST COMPANY NYNEW YORK                 Y Y20000103
+026010786NORDEA BK FINLAND NORDEA BANK FINLAND PLC             NYNEW YORK                 Y N20020102
+026010841SVENSKA HAN NYC   SVENSKA HANDELSBANKEN               NYNEW YORK                 YSY20101201
+026010883MITSUBISHI USA    MITSUBISHI UFJ TRUST & BK CORP USA  NYNEW YORK                 YSN20071017
+026010948IN. & COMM. BNK CNINDUSTRIAL & COMM. BNK OF CHINA, NA NYNEW YORK                 Y Y20130125
+026011031SHOKO CHUKIN BK   SHOKO CHUKIN BANK                   NYNEW YORK                 Y Y20080603
+026011125CHIBA BK          CHIBA BANK LTD - N.Y.BRANCH         NYNEW YORK                 Y Y        
+026011167SUMITOMO MITSUI TRSUMITOMO MITSUI TR BK (USA) LIMITED NYNEW YORK                 YSN20120330
+026011206GZ VIENNA         ERSTE GROUP BANK AG, NEW YORK       NYNEW YORK                 Y Y20080819
+026011293MIZUHO USA        MIZUHO TRUST & BANKING CO. (U.S.A.) NYNEW YORK                 YSN20001024
+026011471GUNMA BK LTD NYC  GUNMA BANK, LIMITED                 NYNEW YORK                 Y Y20080714
+026011484AMERASIA BK NY    AMERASIA BANK                       NYFLUSHING                 Y Y20061031
+026011497BCO ESPIRITO SANTOBANCO ESPIRITO SANTO S.A.           NYNEW YORK                 YSY20111108
+026011617BK RAKYAT INDO PERPT. BANK RAKYAT INDONESIA (PERSERO) NYNEW YORK                 Y N20111230
+026011701MARATHON NB NY    INVESTORS BANK                      NYASTORIA                  Y N20121015
+026011743MASHREQBANK PSC NYMASHREQBANK PSC                     NYNEW YORK                 Y N        
+026011785CTBC USA NYC      CTBC BANK CORP (USA)                NYNEW YORK                 Y N20130822
+026011921BERKSHIRE BANK NYCBERKSHIRE BANK                      NYNEW YORK                 Y Y        
+026011947SHIZUOKA BK LTD   SHIZUOKA BANK, LIMITED              NYNEW YORK                 YSY20031216
+026011950SHINHAN BK NYC    SHINHAN BANK                        NYNEW YORK                 Y N        
+026011963SHINHAN BANK AM   SHINHAN BANK AMERICA                NYNEW YORK                 Y Y20060404
+026012030BLADEX            BANCO LATINOAMERICANO DE COMERCIO EXNYNEW YORK                 YSN20091002
+026012043CHANG HWA COMM NYCCHANG HWA COMMERCIAL BANK, LIMITED  NYBROOKLYN                 Y N20011119
+026012098IND BK KOREA NYC  INDUSTRIAL BANK OF KOREA            NYNEW YORK                 Y N        
+026012179FST COMMERCIAL BK FIRST COMMERCIAL BANK, NY BRANCH    NYNEW YORK                 Y Y20100420
+026012425HUA NAN COMM BK   HUA NAN COMMERCIAL BANK LIMITED     NYNEW YORK                 Y N        
+026012470BANK OF TAIWAN NYCBANK OF TAIWAN,NEW YORK             NYNEW YORK                 Y N        
+026012548NORD LB NYC       NORDDEUTSCHE LANDESBANK GIROZENTRALENYNEW YORK                 YSY19991202
+026012577SWEDBANK NYC      SWEDBANK NEW YORK BRANCH            NYNEW YORK                 YSY20081204
+026012603CR LOCAL DE FRANCEDEXIA CREDIT LOCAL                  NYNEW YORK                 Y Y20130327
+026012629BK COMMUNICATIONS BANK OF COMMUNICATIONS              NYNEW YORK                 Y N        
+026012713VAKIBANK NYC      TURKIYE VAKIFLAR BANKASI TAO        NYNEW YORK                 Y N20091221
+026013071KOREA DEVBANK NYC KOREA DEVELOPMENT BANK - NY BRANCH  NYNEW YORK                 YSN20030127
+026013136VICTORY ST BK     VICTORY STATE BANK                  NYSTATEN ISLAND            Y Y20100217
+026013165WILSHIRE BANK     WILSHIRE BANK                       NYNEW YORK                 Y N20131003
+026013246BBCN BANK NY      BBCN BANK                           NYNEW YORK                 Y N20111205
+026013275CTBC              CTBC BANK CO., LTD.                 NYNEW YORK                 Y N20140716
+026013291INTERVEST NB      INTERVEST NATIONAL BANK             NYNEW YORK                 Y Y19990805
+026013343KOOKMIN BANK      KOOKMIN BANK                        NYNEW YORK                 Y N20020725
+026013356METCOMMBK NY      METROPOLITAN COMMERCIAL BANK        NYNEW YORK                 Y Y20131231
+026013408FST AMER INTL NYC FIRST AMERICAN INTERNATIONAL BANK   NYBROOKLYN                 Y N19991116
+026013453LANDESBANK BADEN  LANDESBANK BADEN WURTTEMBERG        NYNEW YORK                 Y Y20111209
+026013479ALPINE CAPITAL BK ALPINE CAPITAL BANK                 NYNEW YORK                 Y Y20000324
+026013518CAIXA GERAL DE DEPCAIXA GERAL DE DEPOSITOS            NYNEW YORK                 YSN20110405
+026013576SIGNATURE BANK    SIGNATURE BANK                      NYNEW YORK                 Y Y20010523
+026013615CLSS              CLS BANK INTERNATIONAL              NYNEW YORK                 Y N20140411
+026013673TD BANK, NA       TD BANK, NA                         NYNEW YORK                 Y N20090925
+026013767FORTIS BANK S.A.NVFORTIS BANK SA/NV                   NYNEW YORK                 YSY20121213
+026013783HSH NORDBANK AG   HSH NORDBANK AG                     NYNEW YORK                 Y Y20080408
+026013796EW BK SMRINO      EAST WEST BANK                      NYBROOKLYN                 Y N20100422
+026013835DEPFA BANK NYC    DEPFA BANK, PLC                     NYNEW YORK                 Y N20060502
+026013851BK OF EAST ASIA   BANK OF EAST ASIA LIMITED           NYNEW YORK                 Y Y20031209
+026013916EMIGRANT BANK     EMIGRANT BANK                       NYNEW YORK                 Y Y20041130
+026013958CITY NATIONAL BANKCITY NATIONAL BANK                  NYNEW YORK                 Y N20050425
+026013961BANCO ESTADO CHILEBANCO DEL ESTADO DE CHILE, NY BRANCHNYNEW YORK                 Y N20051003
+026013990UNITED INT'L BANK UNITED INTERNATIONAL BANK           NYFLUSHING                 Y Y20090113
+026014135SPRING BANK       SPRING BANK                         NYBRONX                    YSN20121210
+026014193TD BANK USA, N.A. TD BANK USA, NATIONAL ASSOCIATION   NYNEW YORK                 Y N20100308
+026014216NEWBANK           NEWBANK                             NYFLUSHING                 Y N20131007
+026014245GLOBAL BANK       GLOBAL BANK                         NYNEW YORK                 Y Y20070905
+026014384ALMA BANK         ALMA BANK                           NYASTORIA                  Y Y20100728
+026014407SAVOY BANK        SAVOY BANK                          NYNEW YORK                 Y N20110421
+026014504ICICI BANK LIMITEDICICI BANK LIMITED                  NYNEW YORK                 YSY20100624
+026014559CHINA MERCHANTS   CHINA MERCHANTS BANK., LIMITED      NYNEW YORK                 Y N20090930
+026014562ALLIED IRISH BANKSALLIED IRISH BANKS, PLC             NYNEW YORK                 YSY20090212
+026014591ICBC              INDUSTRIAL & COMM BANK OF CHINA LTD NYNEW YORK                 Y N20091116
+026014601GOLDMAN SACHS BANKGOLDMAN SACHS BANK USA              NYNEW YORK                 Y Y20100729
+026014627CORPBANCA         CORPBANCA, NEW YORK BRANCH          NYNEW YORK                 Y N20090914
+026014630MORGAN STANLEY    MORGAN STANLEY BANK, N.A.           UTSALT LAKE CITY           Y Y20091019
+026014643BANCO ESPIRITO    BANCO ESPIRITO SANTO DE INVESTIMENTONYNEW YORK                 YSY20130920
+026014685CHINA CONSTRUCTIONCHINA CONSTRUCTION BANK CORPORATION NYNEW YORK                 Y N20100816
+026014805LAND BANK TAIWAN  LAND BANK OF TAIWAN CO., NY BRANCH  NYNEW YORK                 Y N20110328
+026014889AGRICULTURAL BANK AGRICULTURAL BANK OF CHINA LIMITED  NYNEW YORK                 Y N20140407
+026014915NONGHYUP BANK     NONGHYUP BANK                       NYNEW YORK                 Y N20130820
+026069753NORTHERN TR CO NYCNORTHERN TRUST COMPANY              NJJERSEY CITY              YSY20100831
+026072973FIRST CENTRAL     FIRST CENTRAL SAVINGS BANK          NYGLEN COVE                YSN20120814
+026073008NONE              COMMUNITY FEDERAL SAVINGS BANK      NYWOODHAVEN                YSN20130607
+026073066ESQUIRE BANK      ESQUIRE BANK                        NYGARDEN CITY              Y Y20090731
+026073082DORAL BANK US     DORAL BANK                          NYNEW YORK                 Y N20121109
+026510037ARG USD DIS L PC  ARGENTINA USD DIS SER L/FOREIGN DEPTNYNEW YORK                 Y Y20060110
+026510053ARG USD PAR L PC  ARGENTINA USD PAR SER L/FOREIGN DEPTNYNEW YORK                 Y Y20060110
+026510079ARG DMK DIS PC    ARGENTINA DMK DIS SER/FOREIGN DEPT  NYNEW YORK                 N Y        
+026510082ARG DMK PAR PC    ARGENTINA DMK PAR SER/FOREIGN DEPT  NYNEW YORK                 N Y        
+026510095ARG DMK DIS IC    ARGENTINA DMK DIS SER IC/FOREIGN    NYNEW YORK                 N Y        
+026510105ARG DMK PAR IC    ARGENTINA DMK PAR SER IC/FOREIGN    NYNEW YORK                 N Y        
+026510189DOMINICAN DIS PC  DOMINICAN DIS SERIES BDS PC FNDS/SECNYNEW YORK                 Y Y        
+026510215POLAND PAR PC     POLAND PAR BONDS FNDS/SEC           NYNEW YORK                 N Y20121226
+026510312ECUADOR DIS PC    ECUADOR USD DIS SERIES BONDS PC F/S NYNEW YORK                 Y Y        
+026510325ECUADOR PAR PC    ECUADOR USD PAR SERIES BONDS PC F/S NYNEW YORK                 Y Y        
+026546784IFC PI            IFC-PI                              DCWASHINGTON               Y Y        
+026548397SECTY TREAS FHA   FEDERAL HOUSING ADMINISTRATION      DCWASHINGTON               N Y        
+026548407SECTY TREAS WASH  FINANCIAL MANANGEMENT SERVICES      DCWASHINGTON               N Y        
+026590028REFCORP P&I       RESOLUTION FUNDING CORP. - P. & I.  VARESTON                   Y Y        
+026590073IADB P&I          INTER-AMERICAN DEV. BK  ORD. CAPITALDCWASHINGTON               Y Y20060328
+026590109IBRD PI           IBRD - PRINCIPAL & INTEREST ACCOUNT DCWASHINGTON               Y Y        
+026590219SLMG GEN NYC      DEFEASANCE TRUST ISSUER ACCOUNT     DCWASHINGTON               N Y20041230
+026590222SLMA PRIN NYC     FRBNY SALLIE MAE P&I ACCOUNT        DCWASHINGTON               Y Y20070315
+026590701FHLB WASHS P&I    FHLB - PRINCIPAL & INTEREST ACCOUNT VARESTON                   Y Y20140414
+026590727FREDDIE MAC P & I FED HOME LOAN MORT. PRIN. & INTERESTVAMCLEAN                   Y Y20060215
+026590798FNMA CLR          FNMA MBS CLEARING                   DCWASHINGTON               Y N20131008
+026590808FNMA DC           FNMA MORTGAGE PURCHASE ACCOUNT      DCWASHINGTON               Y N        
+026590837AFDB P&I          AFRICAN DEVEL. BK REG. SUB. ACCOUNT NYABIDJAN,COTE D'IVOIRE    Y Y20131018
+026591072ASDN P&I          ASIAN DEVELOPMENT BK-BEARER ACCOUNT MAMANILA,PHILIPPINES       Y Y20140321
+031000037MELLON EAST PSFS  THE BANK OF NEW YORK MELLON         PAPHILADELPHIA             Y N20121213
+031000040FRB PHIL          FEDERAL RESERVE BANK OF PHILADELPHIAPAPHILADELPHIA             Y Y19990519
+031000053PNCBANK PHIL      PNC BANK, NATIONAL ASSOCIATION      PAPHILADELPHIA             Y N20130211
+031040026FHLB NYC          FEDERAL HOME LOAN BANK OF NEW YORK  NYNEW YORK                 YSN20130625
+031059567DEPARTMENT OF LABODEPT OF LABOR                       DCWASHINGTON               N Y20100827
+031099996TREAS DIRECT      LEGACY TREASURY DIRECT              WVPARKERSBURG              N Y20050913
+031100089PNCBANK WILM DEL  PNC BANK, NATIONAL ASSOCIATION      DEWILMINGTON               Y N20130211
+031100092WIL TRUST         MANUFACTURERS & TRADERS TRUST CO.   DEWILMINGTON               Y N20121213
+031100102WSFS WILM DE      WILMINGTON SAVINGS FUND SOCIETY, FSBDEWILMINGTON               Y Y20101203
+031100160MBNA NEWARK       FIA CARD SERVICES, NA               DEWILMINGTON               YSN20100217
+031100209CBNA DEL          CITIBANK, N.A.                      DENEW CASTLE               Y N20120622
+031100351BK OF NY WILM     BNY MELLON TRUST OF DELAWARE        DEWILMINGTON               YSN20140429
+031100380DEUTSCHE BK TR DELDEUTSCHE BANK TRUST COMPANY DELAWAREDEWILMINGTON               Y Y20030815
+031100636FIRST WYOMING     FIRST NAT BK OF WYOMING             DEWYOMING                  Y Y        
+031100649DISCOVER BANK     DISCOVER BANK                       DEGREENWOOD                Y Y20000803
+031100966APPLIED BANK      APPLIED BANK                        DEWILMINGTON               Y Y20090127
+031101114THE BANCORP BANK  THE BANCORP BANK                    DEWILMINGTON               Y Y20130603
+031101127BARCLAYS BANK DELABARCLAYS BANK DELAWARE              DEWILMINGTON               Y N20070802
+031101143RBS CITIZENS, N.A.RBS CITIZENS, N.A.                  DEWILMINGTON               Y N20071018
+031101169THE BANCORP BANK  THE BANCORP BANK                    DEWILMINGTON               Y N20130603
+031101208HSBC TRUST DE     HSBC TRUST COMPANY DELAWARE N.A.    DEWILMINGTON               Y N20140102
+031101240MIDCOAST COMMUNITYMIDCOAST COMMUNITY BANK             DEWILMINGTON               YSY20110330
+031101266TD BANK NA        TD BANK, NA                         DEWILMINGTON               Y Y20090708
+031101282COMENITY BANK     COMENITY BANK                       DEWILMINGTON               YSY20130927
+031101295BARCLAYS WEALTH TRBARCLAYS WEALTH TRUSTEES US NA      DEWILMINGTON               YSN20110324
+031101305MORGAN STANLEY TR MORGAN STANLEY PRIVATE BANK NA      DEWILMINGTON               YSN20121213
+031101347TD BANK USA, NA   TD BANK USA, NATIONAL ASSOCIATION   DEWILMINGTON               YSY20131016
+031176110CAPITAL ONE NA    CAPITAL ONE, NA                     DEWILMINGTON               Y N20130806
+031176136GMAC BANK         ALLY BANK                           PAFORT WASHINGTON          Y N20130418
+031201085PENNSVILLE NATL   PENNSVILLE NATIONAL BANK            NJPENNSVILLE               Y Y        
+031201360TD BANK, NA       TD BANK, NA                         NJCHERRY HILL              Y N20091110
+031203915FIRST ELMER       THE FIRST NATL BANK OF ELMER        NJELMER                    Y Y        
+031204804FIRST ABSECON     THE FIRST N/B OF ABSECON            NJABSECON                  Y Y        
+031205340NEWFIELD NATL     NEWFIELD NATIONAL BANK              NJNEWFIELD                 Y Y        
+031206420SUN NATL MEDFORD  SUN NATIONAL BANK                   NJVINELAND                 Y Y        
+031207607PNCBANK NJ        PNC BANK, NATIONAL ASSOCIATION      NJCHERRY HILL              Y N20121231
+031207636FULTON BANK NJ    FULTON BANK OF NEW JERSEY           NJWOODBURY                 Y Y20111024
+031207678SHORE COMM BK TOMSSHORE COMMUNITY BANK                NJTOMS RIVER               Y Y        
+031207694CROWN BK OCEANCITYCROWN BANK                          NJBRICK                    Y Y20130109
+031207814PARKE BANK        PARKE BANK                          NJSEWELL                   Y N20060217
+031207827HOPEWELL VLY COMBKHOPEWELL VALLEY COMMUNITY BANK      NJPENNINGTON               Y N20090827
+031207830CORNERSTONE BANK  CORNERSTONE BANK                    NJMOUNT LAUREL             Y N20130801
+031207856HARVEST COMM.BK   HARVEST COMMUNITY BANK              NJPENNSVILLE               Y Y20000601
+0312078691ST COLONIAL COMM 1ST COLONIAL COMMUNITY BANK         NJCOLLINGSWOOD             Y Y20121129
+031207885GRAND BANK, N.A.  GRAND BANK, N.A.                    NJHAMILTON                 Y Y20020423
+031207898LIBERTY BELL BANK LIBERTY BELL BANK                   NJCHERRY HILL              Y Y20100916
+031207940BANK OF PRINCETON THE BANK OF PRINCETON               NJPRINCETON                Y N20110630
+031207979HARMONY BANK      HARMONY BANK                        NJJACKSON                  Y N20091217
+031276049NJM BANK, FSB     N.J.M. BANK, FSB                    NJEWING                    Y N20100728
+031289214HEALTHCARE OF NJ  HEALTH CARE OF NEW JERSEY           NJMOUNT HOLLY              Y N20110629
+031300135PEOPLES NATIONAL  PEOPLES NEIGHBORHOOD BANK           PAHALLSTEAD                Y N20140613
+031301053FNB OF PA         FIRST NATIONAL BANK OF PENNSYLVANIA PAJOHNSTOWN                Y N20120914
+031301066AMERISERV FINAN   AMERISERV FINANCIAL                 PAJOHNSTOWN                Y Y20010503
+031301422FULTON BANK N.A.  FULTON BANK, NA                     PALANCASTER                Y Y20111024
+031301752ATLANTIC COMMUNITYATLANTIC COMMUNITY BANKERS BANK     PACAMP HILL                Y Y20130814
+031301846METRO BANK        METRO BANK                          PALEMOYNE                  Y Y20090612
+031302133LAFAYETTE EASTON  LAFAYETTE AMBASSADOR BANK           PABETHLEHEM                Y Y20120413
+031302515RIVERVIEW BANK    RIVERVIEW BANK                      PAMARYSVILLE               Y N20140414
+031302528WOODLANDS WLMSPRT WOODLANDS BANK                      PAWILLIAMSPORT             Y Y        
+031302971CUSTOMERS BANK    CUSTOMERS BANK                      PAWYOMISSING               Y Y20130304
+031302997AMERICAN BK LEHIGHAMERICAN BANK                       PAALLENTOWN                Y Y20010214
+031303093UNION MT CARMEL   UNB BANK                            PAMOUNT CARMEL             Y Y20121228
+031303129FIDELITY DUNMORE  FIDELITY DEPOSIT AND DISCOUNT BANK  PADUNMORE                  Y Y20040122
+031303132FIRST DUNMORE     FIRST NATIONAL COMMUNITY BANK       PADUNMORE                  Y Y        
+031304306F & M CHAMBERSBURGFARMERS AND MERCHANTS TRUST COMPANY PACHAMBERSBURG             Y Y20100223
+031305745FNB DANVILLE      FNB BANK, NA                        PADANVILLE                 Y Y        
+031305936FIR COL BLOOMSBURGFIRST COLUMBIA BK & TRUST CO        PABLOOMSBURG               Y Y        
+031305965FIRST MINERSVILLE FIRST NB - MINERSVILLE, PA          PAMINERSVILLE              Y Y        
+031305981MINERS BANK       MINERS BANK                         PAMINERSVILLE              Y Y20130425
+031306278COUNTY CLEARFIELD CNB BANK                            PACLEARFIELD               Y Y20070103
+031306294CLEARFIELD BK TR  CLEARFIELD BANK & TRUST COMPANY     PACLEARFIELD               Y Y20100223
+031306993JERSEY SHORE ST BKJERSEY SHORE STATE BANK             PAWILLIAMSPORT             Y Y        
+031307086MERCHANTS BANGOR  MERCHANTS BANK OF BANGOR            PABANGOR                   Y Y20080627
+031307125FIRST BERWICK     FIRST KEYSTONE COMMUNITY BANK       PABERWICK                  Y Y20101001
+031307691JIM THORPE NEIGHBOTHE JIM THORPE NEIGHBORHOOD BANK    PAJIM THORPE               Y Y20140312
+031307701MAUCH CHUNK TRUST MAUCH CHUNK TRUST CO.               PAJIM THORPE               Y Y20041119
+031308250EPHRATA NATL      THE EPHRATA NATIONAL BANK           PAEPHRATA                  Y Y        
+031308302CITIZENS WELLSBOROCITIZENS & NORTHERN BANK            PAWELLSBORO                Y Y        
+031308535HONESDALE NATL    HONESDALE NATIONAL BANK             PAHONESDALE                Y Y        
+031308548WAYNE CO HONESDALEWAYNE BANK                          PAHONESDALE                Y Y        
+031308551DIME BK HONESDALE THE DIME BANK                       PAHONESDALE                Y Y20010119
+031308784NATL PENN BOYERTWNNATIONAL PENN BANK                  PABOYERTOWN                Y Y        
+031308807MID PEN MILLERSBG MID PENN BANK                       PAMILLERSBURG              Y Y20040122
+031309123SUSQUEHANNA BK PA SUSQUEHANNA BANK                    PALITITZ                   Y Y20090206
+031309343FIRST PORT ALEGANYFIRST NB - PORT ALLEGANY, PA        PAPORT ALLEGANY            YSY20110308
+031309495MUNCY BANK & TRUSTMUNCY BANK & TRUST CO.              PAMUNCY                    Y Y        
+031309505HAMLIN BK & TR    HAMLIN BANK & TRUST CO.             PASMETHPORT                Y Y        
+031309589FIRST MANSFIELD   FIRST CITIZENS COMMUNITY BANK       PAMANSFIELD                Y Y20120627
+031309686MIFFLINBURG BK&TR MIFFLINBURG BK & TR CO              PAMIFFLINBURG              YSN20131002
+031309851FIRST MERCERS     FIRST NB - MERCERSBURG, PA          PAMERCERSBURG              YSY20081114
+031309945ADAMS GETTYSBURG  ACNB BANK                           PAGETTYSBURG               Y Y20101004
+031310086PEOPLESBANK CVB   PEOPLESBANK                         PAYORK                     Y Y20130624
+031310206FIRST MIFFLIN     FIRST NB - MIFFLINTOWN, PA          PAMIFFLINTOWN              Y Y        
+031310219JUNIATA MIFFLINTWNJUNIATA VALLEY BANK                 PAMIFFLINTOWN              Y Y        
+031310552KISH BELLEVILLE   KISH BANK                           PABELLEVILLE               Y Y20131230
+031311593FIRST FLEETWOOD   FLEETWOOD BANK                      PAFLEETWOOD                Y Y20060403
+031311632FIRST FREDERICKSBGFIRST N.B. OF FREDERICKSBURG        PAFREDERICKSBURG           Y Y        
+031311771GRATZ NATL        THE GRATZ BANK                      PAGRATZ                    Y Y20120627
+031311807PEOPLES NATIONAL  PEOPLES NEIGHBORHOOD BANK           PAHALLSTEAD                Y Y20101213
+031312055JONESTOWN BK TR   JONESTOWN BANK & TRUST CO.          PACLEONA                   Y Y        
+031312110PENNSTAR BANK     NBT NATIONAL ASSOCIATION            PASCRANTON                 Y N20050919
+031312123BK OF LANDISBURG  BANK OF LANDISBURG                  PALANDISBURG               Y Y        
+031312194VIST BANK         VIST BANK                           PALEESPORT                 Y Y20080331
+031312233FIRST LILLY       THE FIRST NATIONAL BANK OF LILLY    PALILLY                    Y Y20110216
+031312262FIRST LIVERPOOL   LIVERPOOL COMMUNITY BANK            PALIVERPOOL                Y Y20111223
+031312288LUZERNE BANK      LUZERNE  BANK                       PALUZERNE                  Y Y20070223
+031312398RIVERVIEW BANK    RIVERVIEW BANK                      PAMARYSVILLE               Y Y20120706
+031312796NEW TRIPOLI NATL  NEW TRIPOLI  BANK                   PANEW TRIPOLI              Y Y20040630
+031312835NORTHUMBER NATL   NORTHUMBERLAND NATIONAL BANK        PANORTHUMBERLAND           Y Y        
+031312929FIRST PALMERTON   FIRST NORTHERN BANK AND TRUST CO    PAPALMERTON                Y Y20111228
+031313627SWINE MIDDLEBURG  SWINEFORD NATIONAL BANK             PAMIDDLEBURG               Y Y20100802
+031313753TURBOTVILLE NATL  TURBOTVILLE NATIONAL BANK           PATURBOVILLE               Y Y        
+031314503PS BANK           PS BANK                             PAWYALUSING                Y Y20121109
+031315036ORRSTOWN BK       ORRSTOWN BANK                       PASHIPPENSBURG             Y Y20020708
+031315269CENTRIC BANK      CENTRIC BANK                        PAHARRISBURG               Y Y20090429
+031315544WEST MILTON STATE WEST MILTON STATE BANK              PAWEST MILTON              Y Y19990601
+031316271FNB OF PA         FIRST NATIONAL BANK OF PENNSYLVANIA PASTATE COLLEGE            Y N20120914
+031316608NEFFS NATL        NEFFS NATIONAL BANK                 PANEFFS                    Y Y        
+0313168151ST SUMMIT BK JOHN1ST SUMMIT BANK                     PAJOHNSTOWN                Y Y        
+031317788COMMUNITY ORBISONICOMMUNITY STATE BANK OF ORBISONIA   PAORBISONIA                N Y20040122
+031318677LANDMARK COMMUNITYLANDMARK COMMUNITY BANK             PAPITTSTON                 Y N20070503
+031318787INTEGRITY BANK    INTEGRITY BANK                      PACAMP HILL                Y Y20070226
+031319058WOODFOREST NAT BANWOODFOREST NATIONAL BANK            PALEWISBURG                Y N20070321
+031376101UNION COMMUNITY BKUNION COMMUNITY BANK                PALANCASTER                Y N20120312
+031376224TEAM CAPITAL BANK TEAM CAPITAL BANK                   PABETHLEHEM                Y Y20070702
+031901097ROYAL BANK AMERICAROYAL BANK AMERICA                  PANARBERTH                 Y Y20040415
+031901929WILM TR FSB       MANUFACTURERS & TRADERS TRUST CO.   PAVILLANOVA                Y N20121213
+031902041EAGLE NATL U DARBYEAGLE NATIONAL BANK                 PAUPPER DARBY              Y Y        
+031907790QNB BANK          QNB BANK                            PAQUAKERTOWN               Y Y20080905
+031908074DNB FIRST         DNB FIRST                           PADOWNINGTOWN              Y Y20040527
+031908485BRYN MAWR TR      THE BRYN MAWR TRUST COMPANY         PABRYN MAWR                Y Y20100630
+031911812HARLEYSVILLE NATL FIRST NIAGARA BANK, NA              PAHARLEYSVILLE             Y N20120608
+031912332NATL MALVERN      NATIONAL BANK OF MALVERN            PAMALVERN                  Y Y        
+031912785FIRST NEWTOWN     FIRST NATIONAL BANK & TRUST COMPANY PANEWTOWN                  Y Y20100308
+031913438UNIVEST BANK AND TUNIVEST BANK & TRUST CO             PASOUDERTON                Y Y20111222
+031918569STONEBRIDGE BANK  STONEBRIDGE BANK                    PAEXTON                    Y Y20020311
+031918637FIRST CORNERSTONE FIRST CORNERSTONE BANK              PAKING OF PRUSSIA          Y N20100429
+031918828MERIDIAN BANK     MERIDIAN BANK                       PAMALVERN                  Y N20130417
+031918844PENN LIBERTY BANK PENN LIBERTY BANK                   PAWAYNE                    Y Y20040826
+031918873FIRST RESOURCE BK FIRST RESOURCE BANK                 PAEXTON                    Y Y20080923
+031918899FIRST PRIORITY BK FIRST PRIORITY BANK                 PAMALVERN                  YSN20130319
+031918925RADNOR TRUST CO   RADNOR TRUST COMPANY                PAEVANS CITY               Y N20060410
+031918996COLONIAL AMERICAN COLONIAL AMERICAN BANK              PAHORSHAM                  Y N20120227
+031919021MILESTONE BANK    MILESTONE BANK                      PADOYLESTOWN               Y N20131007
+031919063FIRST CHOICE BANK FIRST CHOICE BANK                   NJLAWRENCEVILLE            N Y20140625
+031919186MONUMENT BANK     MONUMENT BANK                       PADOYLESTOWN               Y N20120404
+031976200CONTINENTAL BANK  CONTINENTAL BANK                    PAPLYMOUTH MEETING         Y N20101118
+036001808TD BANK, NA       TD BANK, NA                         PAPHILADELPHIA             Y N20090925
+036002069CONESTOGA BANK    CONESTOGA BANK                      PAPHILADELPHIA             Y Y20090728
+036002085HAVERFORD TRUST   THE HAVERFORD TRUST COMPANY         PARADNOR                   Y Y20140516
+036002247REPUBLIC FIRST PHIREPUBLIC FIRST BK DBA REPUBLIC BANK PAPHILADELPHIA             Y Y20100820
+036002425FIR COMMERCL PHILAHSBC BANK USA, NA                   PAPHILADELPHIA             Y N20121213
+036002810UNITED BK PHILA   UNITED BANK OF PHILA.               PAPHILADELPHIA             Y Y19990519
+036018516ASIAN BK PHILA    ASIAN BANK                          PAPHILADELPHIA             Y Y20000222
+036018587PHILA TR CO       PHILADELPHIA TRUST COMPANY          PAPHILADELPHIA             YSY20020920
+036018985NOAH BANK         NOAH BANK                           PAELKINS PARK              Y N20110902
+036076150CITIZENS BK OF PENCITIZENS BANK                       PAPHILADELPHIA             Y Y20030114
+036076215EAST RIVER BANK   EAST RIVER BANK                     PAPHILADELPHIA             N Y20090305
+041000014FRB CLE           FEDERAL RESERVE BANK OF CLEVELAND   OHCLEVELAND                Y Y        
+041000124PNC BANK, OHIO    PNC BANK, NATIONAL ASSOCIATION      OHCLEVELAND                Y N20130211
+041001039KEY GR LAKES CLEVEKEYBANK NATIONAL ASSOCIATION        OHCLEVELAND                Y Y20080214
+041002957KEYBANK-KEYDIRECT KEYBANK NATIONAL ASSOCIATION        OHCLEVELAND                Y N20100317
+041036004DFAS CLEVE        D.F.A.S.                            OHCLEVELAND                Y N20051028
+041052907BOONE S L COLUMBIA(CIN)BOONE NATIONAL S&L ASSOCIATION MOCOLUMBIA                 N Y20000411
+041200555FIRSTMERIT AKRON  FIRSTMERIT BANK, N.A.               OHAKRON                    Y Y20131015
+041201114LORAIN NATL       LORAIN NATIONAL BANK                OHLORAIN                   Y Y        
+041201143CONSUMERS MINERVA CONSUMERS NATIONAL BANK             OHMINERVA                  Y Y        
+041201321HENRY CO NAPOLEON HENRY COUNTY BANK                   OHNAPOLEON                 Y Y        
+041201635CITIZENS SANDUSKY CITIZENS BANKING COMPANY            OHSANDUSKY                 Y Y20100225
+041201813INDEPENDENCE BANK INDEPENDENCE BANK                   OHINDEPENDENCE             Y Y        
+041202443FIRST E LIVERPOOL 1ST NATIONAL COMMUNITY BANK         OHEAST LIVERPOOL           Y Y20050308
+041202540FRS BK OH TIFFIN  FIRST BANK OF OHIO                  OHTIFFIN                   N Y20050802
+041202744CROGHAN FREMONT   THE CROGHAN COLONIAL BANK           OHFREMONT                  Y Y        
+041202760LIBERTY TWINSBURG LIBERTY BANK, N.A.                  OHTWINSBURG                Y N20070403
+041202922OH HERTG COSHOCTONOHIO HERITAGE BANK                  OHCOSHOCTON                Y Y19990930
+041202980CREDIT FST BROOKPKCREDIT FIRST NATIONAL ASSOCIATION   OHBROOK PARK               Y N        
+041203594STATE DEFIANCE    THE STATE BANK AND TRUST COMPANY    OHDEFIANCE                 Y Y        
+041204726FIRST BELLEVUE OH THE FIRST NATIONAL BANK OF BELLEVUE OHBELLEVUE                 Y Y        
+041205246FST UPPER SANDUSKY1ST CITIZENS NAT BK OF UPPER SANDUSKOHUPPER SANDUSKY           Y Y        
+041205259COM UPPER SANDUSKYTHE COMMERCIAL SAVINGS BANK         OHUPPER SANDUSKY           Y Y        
+041205518FIRST ORRVILLE    FIRST NATIONAL BANK                 OHORRVILLE                 Y Y        
+041205929LIBERTY ADA       LIBERTY NATIONAL BANK               OHKENTON                   Y Y        
+041205958HICKSVILLE BK     THE HICKSVILLE BANK                 OHHICKSVILLE               Y Y        
+041206135COML MILLERSBURG  COMMERCIAL & SAVINGS BK, MILLERSBURGOHMILLERSBURG              Y Y20050224
+041206876CORN DESHLER      THE CORN CITY STATE BANK            OHDESHLER                  Y Y        
+041207341FARMERS ARCHBOLD  THE FARMERS & MERCHANTS STATE BANK  OHARCHBOLD                 Y Y        
+041208421FARMERS ST W SALEMTHE FARMERS STATE BANK OF WEST SALEMOHWEST SALEM               YSY20110916
+041208719ANDOVER BK        THE ANDOVER BANK                    OHANDOVER                  Y Y        
+041208735ANTWERP EXCH BK   THE ANTWERP EXCHANGE BANK COMPANY   OHANTWERP                  Y Y        
+041208748APPLE CREEK BK    THE APPLE CREEK BANKING COMPANY     OHAPPLE CREEK              Y Y        
+041208777SUTTON ATTICA     SUTTON BANK                         OHATTICA                   Y N20000601
+041209080FARMERS CANFIELD  FARMERS NATIONAL BANK OF CANFIELD   OHCANFIELD                 Y Y        
+041209307CORTLAND SAVINGS  CORTLAND SAVINGS AND BANKING COMPANYOHCORTLAND                 Y Y        
+041209420FIRST DENNISON    THE FIRST NATIONAL BANK OF DENNISON OHDENNISON                 Y Y        
+041209488STATE EDON        EDON STATE BANK COMPANY OF EDON,OH. OHEDON                     Y Y19990831
+041209624GENOA BANKING     THE GENOA BANKING COMPANY           OHGENOA                    Y Y        
+041209789HAMLER STATE      THE HAMLER STATE BANK               OHHAMLER                   Y Y        
+041210053KILLBUCK SAVINGS  THE KILLBUCK SAVINGS BANK COMPANY   OHKILLBUCK                 Y Y        
+041210370MARBLEHEAD BANK   THE MARBLEHEAD BANK                 OHMARBLEHEAD               Y Y        
+041210448MIDDLEFIELD BKG COTHE MIDDLEFIELD BANKING COMPANY     OHMIDDLEFIELD              Y Y        
+041210943OTTOVILLE BANK    THE OTTOVILLE BANK COMPANY          OHOTTOVILLE                Y Y19991013
+041212637OLD FORT BKG CO   THE OLD FORT BANKING COMPANY        OHOLD FORT                 Y Y19990909
+041212815FT JENNINGS STATE THE FORT JENNINGS STATE BANK        OHFORT JENNINGS            YSN        
+041212873FIRST PANDORA     THE FIRST NATIONAL BANK OF PANDORA  OHPANDORA                  Y Y        
+041212899FIRST SYCAMORE    THE FIRST NATIONAL BANK OF SYCAMORE OHSYCAMORE                 Y Y19991130
+041212983CITZ BLUFFTON     CITIZENS NATIONAL BANK OF BLUFFTON  OHBLUFFTON                 Y Y        
+041213759UNION BK COL GROVETHE UNION BANK COMPANY              OHCOLUMBUS GROVE           Y Y        
+041213872METAMORA STATE    THE METAMORA STATE BANK             OHMETAMORA                 Y Y20090701
+041213885SHERWOOD STATE BK THE SHERWOOD STATE BANK             OHSHERWOOD                 N Y20090720
+041215498PORTAGE RAVENNA   PORTAGE COMMUNITY BANK              OHRAVENNA                  Y Y20020416
+041215553BUCKEYE COM LORAINBUCKEYE COMMUNITY BANK              OHLORAIN                   Y Y19991018
+041215582PREMIER BK & TRUSTPREMIER BANK & TRUST NATIONAL ASSOC OHNORTH CANTON             Y N20131016
+041215621SIGNATURE TOLEDO  SIGNATURE BANK NA                   OHTOLEDO                   Y Y20021028
+041215692LAKE NATL BANK    LAKE NATIONAL BANK                  OHMENTOR                   Y Y20070313
+041215812BANK OF MAUMEE    BANK OF MAUMEE                      OHMAUMEE                   Y Y20080228
+041215854WATERFORD BANK NA WATERFORD BANK, N.A.                OHTOLEDO                   Y Y20080103
+041248863TRUST CO PSEUDO   THE TRUST COMPANY OF TOLEDO, N.A.   OHHOLLAND                  N Y        
+041251245TRUST CO. DELAWAREUNITED STATES TRUST COMPANY, N.A.   DEWILMINGTON               N Y20100317
+041253832INDEPEND FRANKLN  INDEPENDENCE TRUST CO. -D192-       TNFRANKLIN                 N Y20130912
+041272279WESTFIELD BANK,FSBWESTFIELD BANK, FSB                 OHWESTFIELD CENTER         Y N20130117
+042000013U S BK CINCINNATI US BANK, NA                         OHCINCINNATI               Y Y20121220
+042000314FIFTH THIRD CINCI FIFTH THIRD BANK                    OHCINCINNATI               Y Y20010312
+042000550NORTH SIDE CINCI  THE NORTH SIDE BANK AND TRUST CO.   OHCINCINNATI               Y Y        
+042000916FHLB CINCI        FEDERAL HOME LOAN BANK              OHCINCINNATI               Y Y20140207
+042100146CENTRAL LEX       CENTRAL BANK AND TRUST COMPANY      KYLEXINGTON                Y Y        
+042100175US BANK KENTUCKY  US BANK, NA                         KYCOVINGTON                Y N20121220
+042100861FIRST ST PINEVILLEFIRST STATE FINANCIAL, INC.         KYPINEVILLE                Y Y20040713
+042101174KENTUCKY PARIS    KENTUCKY BANK                       KYPARIS                    Y Y        
+042101268PEOPLES BEREA     PEOPLES BANK & TRUST CO./MADISON CTYKYBEREA                    Y Y        
+042101271BANK BLUEGRASS LEXBANK OF THE BLUEGRASS AND TRUST CO. KYLEXINGTON                Y Y20020625
+042101446CITIZENS SOMERSET CITIZENS NATIONAL BANK OF SOMERSET  KYSOMERSET                 Y Y19991214
+042101475CITZ GUAR IRVINE  CITIZENS GUARANTY BANK              KYIRVINE                   N Y20050926
+042101514TRADTL MT STERLINGTRADITIONAL BANK                    KYMOUNT STERLING           Y Y20000214
+042101572KY FARM CATLETTSBGKENTUCKY FARMERS BANK CORPORATION   KYASHLAND                  Y Y20100924
+042101679FORCHT BANK, NA   FORCHT BANK, NATIONAL ASSOCIATION   KYLEXINGTON                Y N20131028
+042101792FARMERS NICHOLASVLFARMERS BANK                        KYNICHOLASVILLE            N Y20071210
+042102018UNITED VERSAILLES UNITED BANK AND TRUST COMPANY       KYVERSAILLES               Y Y        
+042102115BK OF KY FLORENCE THE BANK OF KENTUCKY, INC.          KYCRESTVIEW HILLS          Y Y20030528
+042102270FIRST LONDON      FIRST NATIONAL BANK AND TRUST       KYLONDON                   Y Y        
+042102296UNITED FALMOUTH   THE BANK OF KENTUCKY, INC.          KYCRESTVILLE  HILLS        N Y20111028
+042102335CITIZENS NEWPORT  CITIZENS BANK OF N. KENTUCKY,INC.   KYNEWPORT                  Y N20020208
+042102403FST STHRN LANCASTRFIRST SOUTHERN NATIONAL BANK        KYSTANFORD                 Y Y        
+042102571FIRST JACKSON KY  THE FIRST NATIONAL BANK OF JACKSON  KYJACKSON                  Y Y19991013
+042102597CITIZENS COMM NAT CITIZENS COMMERCE NATIONAL BANK     KYVERSAILLES               Y Y20081008
+042102652DEPOSIT CARLISLE  FARMERS DEPOSIT BANK                KYCARLISLE                 N Y20130820
+042102694COMMUNITY TR BK NACOMMUNITY TRUST BANK, INC.          KYPIKEVILLE                Y Y20040909
+042102788PEO FLEMINGSBURG  PEOPLES BANK OF KENTUCKY, INC.      KYFLEMINGSBURG             Y N20070614
+042102898CITZ DEP VANCEBURGCITIZENS DEPOSIT BANK & TRUST, INC. KYVANCEBURG                Y Y19991223
+042102940FIRST PRESTONSBRG FIRST COMMONWEALTH BANK             KYPRESTONSBURG             Y Y        
+042103253CITZ PAINTSVILLE  CITIZENS NATIONAL BK OF PAINTSVILLE KYPAINTSVILLE              Y Y        
+042103460COML BANK GRAYSON THE COMMERCIAL BANK OF GRAYSON      KYGRAYSON                  Y Y19991026
+042103473FIRST GRAYSON     THE FIRST NATIONAL BANK OF GRAYSON  KYGRAYSON                  Y Y20020719
+042103826FIRST BROOKSVILLE FIRST NATIONAL BANK OF BROOKSVILLE  KYBROOKSVILLE              Y Y20020719
+042104168WHITAKER BANK LEX WHITAKER BANK                       KYLEXINGTON                Y Y20040504
+042104401PEO SEC BK LOUISA PEOPLES SECURITY BANK               KYLOUISA                   Y Y19990928
+042104469CITIZENS BRODHEAD CITIZENS BANK                       KYBRODHEAD                 N Y20100728
+042104579FARMERS CAMPTON   FARMERS & TRADERS BANK OF CAMPTON   KYCAMPTON                  N Y        
+042104825CUMBERLAND LONDON CUMBERLAND VALLEY NATL BK & TR CO   KYLONDON                   Y Y20020429
+042104854CUMB SEC SOMERSET CUMBERLAND SECURITY BANK            KYEUBANK                   Y Y        
+042105170HYDEN CITIZENS    HYDEN CITIZENS BANK, INC            KYHYDEN                    Y Y20010515
+042105196INEZ DEPOSIT BANK INEZ DEPOSIT BANK                   KYINEZ                     Y Y        
+042105442FIRST MANCHESTER  FIRST NATIONAL BANK OF MANCHESTER   KYMANCHESTER               Y Y        
+042105484JACKSON CNTY MCKEEJACKSON COUNTY BANK                 KYMC KEE                   Y N20100728
+042105785UNITED CUMBERLAND UNITED CUMBERLAND BANK              KYWHITLEY CITY             Y Y20140506
+042105882FST PEOPLE RUSSELLFIRST & PEOPLES BANK                KYRUSSELL                  Y Y        
+042105947SALYERSVILLE NATL THE SALYERSVILLE NATIONAL BANK      KYSALYERSVILLE             Y Y20020719
+042106331COML WEST LIBERTY COMMERCIAL BANK                     KYWEST LIBERTY             Y Y19991108
+042106580PEOPLE BEATTYVILLEPEOPLES EXCHANGE BANK OF BEATTYVILLEKYWINCHESTER               Y N20131011
+042107424PEOPLES HAZARD    PEOPLES BANK & TRUST CO. OF HAZARD  KYHAZARD                   Y Y        
+042107673CITIZENS MOREHEAD THE CITIZENS BANK                   KYMOREHEAD                 Y Y        
+042107738FARM ST BOONEVILLEFARMERS STATE BANK, INC             KYBOONEVILLE               Y Y20000314
+042107806BK OF HARLAN      THE BANK OF HARLAN                  KYHARLAN                   N Y        
+042108122CITIZENS JACKSON  THE CITIZENS BANK & TRUST CO.       KYJACKSON                  Y Y20120615
+042108261GUARANTY MARTIN   FIRST GUARANTY BANK                 KYMARTIN                   YSY20090710
+042108290BANK OF CORBIN    HOMETOWN BANK OF CORBIN, INC.       KYCORBIN                   Y N20090610
+042108397FORCHT BANK, NA   FORCHT BANK, NATIONAL ASSOCIATION   KYLEXINGTON                YSN20131016
+042108407ALLIANCE BANK     ALLIANCE BANKING COMPANY            KYWINCHESTER               Y Y20120509
+042174486BB&T KENTUCKY     BB&T KENTUCKY                       KYPIKEVILLE                Y N20021121
+042174567VICTORY COMM BK   VICTORY COMMUNITY BANK              KYLAKESIDE PARK            YSN20111025
+042200910FST FINANCL BK, NAFIRST FINANCIAL BANK, NA            OHHAMILTON                 Y Y20110420
+042202138FIRST SAFETY CINCIFIRST SAFETY BANK                   OHSAINT BERNARD            Y Y20100728
+042204110GREENVILLE NATL OHGREENVILLE NATIONAL BANK            OHGREENVILLE               Y Y        
+042204932NATL BK WILMINGTONTHE NATIONAL BANK AND TRUST COMPANY OHWILMINGTON               Y Y        
+042205009MERCH HILLSBORO   MERCHANTS NATIONAL BANK             OHHILLSBORO                Y Y        
+042205708LCNB NATIONAL BANKLCNB NATIONAL BANK                  OHLEBANON                  Y Y20120301
+042206422FIRST BLANCHESTER FIRST NATIONAL BANK OF BLANCHESTER  OHBLANCHESTER              Y Y        
+042206503FIRST GERMANTOWN  FIRST NATIONAL BANK OF GERMANTOWN   OHGERMANTOWN               Y Y        
+042206574RIVERHILLS BANK   RIVERHILLS BANK                     OHNEW RICHMOND             Y N20070620
+042207379NATL ADAMS W UNIONNATL BANK OF ADAMS COUNTY OF W UNIONOHWEST UNION               Y Y        
+0422080061ST NATIONAL BANK 1ST NATIONAL BANK                   OHLEBANON                  Y Y20030609
+042208174TWIN W ALEXANDRIA THE TWIN VALLEY BANK                OHWEST ALEXANDRIA          Y Y        
+042210722FARMER NEW MADISONTHE FARMERS STATE BANK              OHNEW MADISON              Y N        
+042211572SOMERVILLE OXFORD THE SOMERVILLE NATIONAL BANK        OHSOMERVILLE               Y Y19990915
+042212050FIRST ST WINCHESTRTHE FIRST STATE BANK OF ADAMS COUNTYOHWINCHESTER               Y N        
+042212568OSGOOD STATE BANK THE OSGOOD STATE BANK               OHOSGOOD                   Y Y        
+042213389FARMERS MIAMISBURGTHE FARMERS & MERCHANTS BANK        OHMIAMISBURG               Y Y        
+042213842BROOKVILLE NATL   BROOKVILLE NATIONAL BANK            OHBROOKVILLE               Y Y        
+042215468SPRING VLY CINCI  SPRING VALLEY BANK                  OHWYOMING                  Y Y19991108
+042215565CITIZENS NB DAYTONTHE CITIZENS NATIONAL BANK OF SW OH OHDAYTON                   Y Y20020103
+042215578CENTER BANK       CENTERBANK                          OHMILFORD                  YSN20090326
+042274551FDS BANK          FDS BANK                            OHMASON                    YSN20110531
+042306720FIRST NEW BREMAN  FIRST NATIONAL BANK IN NEW BREMEN   OHNEW BREMEN               Y Y        
+042309222PEOPLES COLDWATR  THE PEOPLES BANK COMPANY            OHCOLDWATER                Y Y        
+042310525MINSTER BANK      MINSTER BANK                        OHMINSTER                  Y Y20010131
+042311650SAINT HENRY BANK  THE ST. HENRY BANK                  OHSAINT HENRY              Y Y        
+043000096PNCBANK PITT      PNC BANK, NATIONAL ASSOCIATION      PAPITTSBURGH               Y Y20050303
+043000261MELLON BANK PITTS THE BANK OF NEW YORK MELLON         PAPITTSBURGH               Y N20131011
+043000847ALLEGHENY VAL PITTALLEGHENY VALLEY BANK OF PITTSBURGH PAPITTSBURGH               Y Y        
+043001435FHLB PITTS        FEDERAL HOME LOAN BANK              PAPITTSBURGH               Y Y20010912
+043002900PNC FSB PITTSBURGHPNC BANK, NATIONAL ASSOCIATION      PAPITTSBURGH               Y N20030821
+043019003TRISTATE CAPITAL  TRISTATE CAPITAL BANK               PAPITTSBURGH               Y N20070124
+043019265BNY MELLON        BNY MELLON, NATIONAL ASSOCIATION    PAPITTSBURGH               YSY20080709
+043202946CITZ MARTINS FERRYTHE CITIZENS SAVINGS BANK           OHMARTINS FERRY            Y Y        
+043205875CITZ WOODSFIELD   CITIZENS NATIONAL BANK OF WOODSFIELDOHWOODSFIELD               Y Y        
+043208296PEOPLE MT PLEASANTPEOPLES NATL BANK OF MOUNT PLEASANT OHMOUNT PLEASANT           N Y20110603
+043211160FIRST POWHATAN PNTFIRST NATIONAL BANK OF POWHATAN POINOHPOWHATAN POINT           Y Y        
+043305092COMMERCIAL BK PA  COMMERCIAL BANK AND TRUST           PALATROBE                  Y Y20041230
+043306826FST CMWLTH INDIANAFIRST COMMONWEALTH BANK             PAINDIANA                  Y Y        
+043306855S AND T INDIANA   S & T BANK                          PAINDIANA                  Y Y        
+043306910SCOTTDALE BK TR   THE SCOTTDALE BANK & TRUST COMPANY  PASCOTTDALE                YSY20100503
+043307524FARMERS & MERCHANTFARMERS & MERCHANTS BK WESTERN PA NAPAKITTANNING               Y Y20080402
+043307841CITIZEN MEYERSDALETHE CITIZENS NATIONAL BANK OF MEYERSPAMEYERSDALE               Y Y20071226
+043308277COMM FST REYNOLDVLCOMMUNITY FIRST BANK                PAREYNOLDSVILLE            Y Y20090114
+043308426APOLLO TR CO      APOLLO TRUST COMPANY                PAAPOLLO                   Y Y        
+043308691SOMERSET TRUST PA SOMERSET TRUST COMPANY              PASOMERSET                 Y Y        
+043309713COMMUNITY ALBION  COMMUNITY NATL BK/NORTHWESTERN PA   PAALBION                   Y Y        
+043310139FARMERS EMLENTON  FARMERS NATIONAL BANK OF EMLENTON   PAEMLENTON                 Y Y        
+043310980COMMUNITY BANK    COMMUNITY BANK                      PACARMICHAELS              Y Y20070104
+043311497NEXTIER BANK, N.A.NEXTIER BANK, N.A.                  PAEVANS CITY               Y Y20060104
+043311662FIRST FRYBURG     FIRST UNITED NATIONAL BANK          PAFRYBURG                  Y Y        
+043312344MANOR BANK        MANOR BANK                          PAMANOR                    Y Y20060113
+043312373MARION CENTER BANKMARION CENTER BANK                  PAMARION CENTER            Y Y20050516
+043312386MARS NATIONAL BANKTHE MARS NATIONAL BANK              PAMARS                     Y Y        
+043313259MERCER ST SANDY LKMERCER COUNTY STATE BANK            PASANDY LAKE               Y Y        
+043315147ELDERTON STATE BK ELDERTON STATE BANK                 PAELDERTON                 Y Y        
+043318092FNB OF PA         FIRST NATIONAL BANK OF PENNSYLVANIA PAHERMITAGE                Y Y20121106
+043318500ENTERPRISE ALLISONENTERPRISE BANK                     PAALLISON PARK             Y Y19990708
+043318791CLARION CTY BANK  CLARION COUNTY COMMUNITY BANK       PACLARION                  YSN20050812
+043400036WESBANCO WHEELING WESBANCO BANK WHEELING              WVWHEELING                 Y Y        
+043401190WHEELING NATL BANKWESBANCO BANK WHEELING              WVWHEELING                 Y N20030821
+043401967N HANCOCK NEWELL  NORTHERN HANCOCK BANK & TRUST CO.   WVNEWELL                   Y Y20140410
+043403224PROGRESSIVE WHEEL PROGRESSIVE BANK                    WVWHEELING                 Y Y        
+043404443UNION SISTERSVILLEUNION BANK INC.                     WVSISTERSVILLE             Y Y20100728
+043450015SECURITY WHEELING SECURITY NATIONAL TRUST CO.         WVWHEELING                 N Y20110715
+044000024HUNT COL          THE HUNTINGTON NATIONAL BANK        OHCOLUMBUS                 Y Y        
+044000037JPMCHASE OHIO     JPMORGAN CHASE BANK, NA             OHCOLUMBUS                 Y N20050509
+044002051GREAT LAKE GAHANNAGREAT LAKES BANKERS' BANK           OHWORTHINGTON              Y Y20100827
+044002909FIRST CITY BK COL FIRST CITY BANK                     OHCOLUMBUS                 Y Y        
+044011453FIRST WHITEHALL   FIRST COMMUNITY BANK                OHCOLUMBUS                 Y Y        
+044036205DFAS COLUMBUS     DFAS-CO-FPS                         OHCOLUMBUS                 Y N        
+044036250DFAS COLUMBUS     DEFENSE FINANCE & ACCT. SERVICE     OHCOLUMBUS                 Y N        
+044072243ARLINGTON BANK    THE ARLINGTON BANK                  OHUPPER ARLINGTON          Y N        
+044072298THE GUERNSEY BANK THE GUERNSEY BANK                   OHCOLUMBUS                 Y N20100728
+044072311BENCHMARK BANK    BENCHMARK BANK                      OHGAHANNA                  Y Y20130809
+044072324NATIONWIDE BANK   NATIONWIDE BANK                     OHCOLUMBUS                 Y Y20131107
+044072337FIRST BEXLEY BANK THE FIRST BEXLEY BANK               OHBEXLEY                   Y N20060515
+044101305PARK NEWARK       THE PARK NATIONAL BANK              OHNEWARK                   Y Y        
+044101745FAHEY BKING MARIONTHE FAHEY BANKING COMPANY           OHMARION                   Y Y20081014
+044102524MARION BANK       THE OHIO STATE BANK                 OHMARION                   Y Y20050110
+044102647STANDING LANCASTERSTANDING STONE NATIONAL BANK        OHLANCASTER                Y Y        
+044103086DELAWARE CNTY BANKDELAWARE COUNTY BANK AND TRUST CO.  OHLEWIS CENTER             Y Y20010618
+044103976SVGS CIRCLEVILLE  THE SAVINGS BANK                    OHCIRCLEVILLE              Y Y        
+044105576THE COMMUNITY BANKTHE COMMUNITY BANK                  OHZANESVILLE               YSY20090528
+044105819PEO NEW LEXINGTON PEOPLES NATL. BK. OF NEW LEXINGTON  OHNEW LEXINGTON            Y Y        
+044106384FIRST MCCONNELSVL FIRST NATIONAL BK OF MCCONNELSVILLE OHMCCONNELSVILLE           Y Y        
+044106397CITZ MCCONNELSVL  CITIZENS NATL BANK OF MCCONNELSVILLEOHMCCONNELSVILLE           Y Y        
+044106588RICHWOOD BANKING  THE RICHWOOD BANKING COMPANY        OHRICHWOOD                 Y Y        
+044106944FIRST CALDWELL    PEOPLES BANK, NATIONAL ASSOCIATION  OHCALDWELL                 N Y20030821
+044107163FIRST CEN ST PARISFIRST CENTRAL NTL BANK OF ST. PARIS OHSAINT PARIS              Y Y        
+044107600CITZ ASHVILLE     THE CITIZENS BANK OF ASHVILLE, OHIO OHASHVILLE                 Y N20061110
+044107778UNION W MANSFIELD THE UNION BANKING COMPANY           OHWEST MANSFIELD           Y Y        
+044108078PATASKALA BKG CO  THE PATASKALA BANKING COMPANY       OHPATASKALA                Y Y19990716
+044108641HUNT SERV COLUMBUSTHE HUNTINGTON NATIONAL BANK        OHCOLUMBUS                 Y N20030821
+044109297N VALLEY CORNING  NORTH VALLEY BANK                   OHZANESVILLE               YSY20060821
+044109336HEARTLAND CROTON  HEARTLAND BANK                      OHGAHANNA                  Y Y20000224
+044109417CITIZENS DEGRAFF  THE CITIZENS BANK OF DE GRAFF       OHDE GRAFF                 Y N20131011
+044111557COMMODORE BANK    COMMODORE BANK                      OHSOMERSET                 Y Y20030514
+044112488PEOPLES BK GAMBIERTHE PEOPLES BANK                    OHGAMBIER                  Y Y19990901
+044114716CITIZENS LOGAN    THE CITIZENS BANK OF LOGAN          OHLOGAN                    Y Y        
+044115760INSIGHT BANK      INSIGHT BANK                        OHWORTHINGTON              Y N20120406
+044115809WOODFOREST NB     WOODFOREST NATIONAL BANK            TXTHE WOODLANDS            Y N20060816
+044201030HOCKING ATHENS    THE HOCKING VALLEY BANK             OHATHENS                   Y N20131011
+044202071CITZ CHILLICOTHE  LCNB NATIONAL BANK                  OHLEBANON                  N Y20130308
+044202505PEOPLES MARIETTA  PEOPLES BANK, NATIONAL ASSOCIATION  OHMARIETTA                 Y Y20000310
+044203863MILTON BK WELLSTONTHE MILTON BANKING COMPANY          OHWELLSTON                 Y N20080402
+044204370OH VAL GALLIPOLIS THE OHIO VALLEY BANK COMPANY        OHGALLIPOLIS               Y Y        
+044205146FARMERS BK POMEROYTHE FARMERS BK & SVGS CO            OHPOMEROY                  Y Y19990903
+044206475FIRST WAVERLY OH  THE FIRST NATIONAL BANK OF WAVERLY  OHWAVERLY                  Y Y        
+044207283OAK HILL BANKS    WESBANCO BANK WHEELING              OHJACKSON                  Y N20100318
+044207872ROCKHOLD BK       THE ROCKHOLD BROWN & COMPANY BANK   OHBAINBRIDGE               N Y20060202
+044208512HOME NB RACINE    HOME NATIONAL BANK                  OHRACINE                   Y Y20010530
+044208936CITIZENS BEVERLY  THE CITIZENS BANK COMPANY           OHBEVERLY                  Y N        
+044210063KINGSTON NATL     KINGSTON NATIONAL BANK              OHKINGSTON                 Y N20060914
+044210403VINTON MCARTHUR   VINTON COUNTY NATL BANK OF MCARTHUR OHMC ARTHUR                Y Y20100728
+044211910WATERFORD CSB     WATERFORD COMMERCIAL AND SAVINGS BK.OHWATERFORD                N Y20060403
+044215521SETTLERS MARIETTA SETTLERS BANK                       OHMARIETTA                 Y Y19990802
+051000033FRB RICH          FEDERAL RESERVE BANK OF RICHMOND    VARICHMOND                 Y Y19990713
+051005106COMMUNITY BKRS BK COMMUNITY BANKERS BANK              VAMIDLOTHIAN               Y Y20030114
+051074030FIRST MARKET BANK FIRST MARKET BANK                   VARUTHER GLEN              Y N20100831
+051099992FRB RICH GOVT AGCYFRB RICH GOVT AGCY                  VARICHMOND                 Y N20130603
+051401027AMER NATL DANVILLEAMERICAN NATL BK & TR CO            VADANVILLE                 Y Y        
+051401043FIRST ST DANVILLE FIRST STATE BANK                    VADANVILLE                 Y Y        
+051401153GRUNDY NATL       GRUNDY NATIONAL BANK                VAGRUNDY                   Y Y        
+051401849BK OF MARION VA   THE BANK OF MARION                  VAMARION                   Y Y        
+051402149BK COMWLTH NORFOLKSOUTHERN BANK AND TRUST CO - BOC    VANORFOLK                  Y N20120921
+051402220STELLARONE BANK   UNION FIRST MARKET BANK             VARICHMOND                 Y N20140522
+051402233CITZ BLACKSTONE   CITIZENS BANK & TRUST CO            VABLACKSTONE               Y Y20070621
+051402259FAUQUIER WARRENTONTHE FAUQUIER BANK                   VAWARRENTON                Y Y        
+051402372BLUE RIDGE BANK   BLUE RIDGE BANK, INC.               VALURAY                    Y Y20130515
+051402482BENCHMK CB KENBRDGBENCHMARK COMMUNITY BANK            VAKENBRIDGE                YSY20051215
+051402518BANK CLARKE CNTY  BANK OF CLARKE COUNTY               VABERRYVILLE               Y Y        
+051402550BK BOTETOURT VA   BANK OF BOTETOURT                   VABUCHANAN                 Y N20121029
+051402589FIRST STRASBURG   FIRST BANK                          VASTRASBURG                Y Y        
+051402835BANK OF FLOYD VA  BANK OF FLOYD                       VAFLOYD                    Y Y20131204
+051402932PATRIOT FREDRKBURGPATRIOT BANK                        VABASSETT                  Y N20080103
+051402974RNB UNION         RNB A DIV OF UNION FIRST MARKET BANKVAWASHINGTON               Y N20101008
+051402987CENT VA POWHATAN  CITIZENS AND FARMERS BANK           VAWEST POINT               Y Y20140324
+051403041FIRST ALTAVISTA   FIRST NATIONAL BANK                 VAALTAVISTA                Y Y        
+051403122NATL BK BLACKSBURGTHE NATL BANK OF BLACKSBURG         VABLACKSBURG               Y Y        
+051403164UNION BOWLING GRN UNION FIRST MARKET BANK             VARICHMOND                 Y Y20111110
+051403465KING GEO STATE BK UNION BANK & TRUST COMPANY          VARUTHER GLEN              Y N20100831
+051403588BANK OF FINCASTLE THE BANK OF FINCASTLE               VAFINCASTLE                Y Y        
+051403643FIRST ROCKY MT    FIRST NAT BK OF ROCKY MOUNT         VAROCKY MOUNT              Y N20080103
+051403672TRUPOINT BANK     TRUPOINT BANK                       VAGRUNDY                   Y Y20060328
+051403766GRAYSON NB INDEP  THE GRAYSON NATIONAL BANK           VAINDEPENDENCE             Y Y        
+051403779CHESAPK KILMARNOCKCHESAPEAKE BANK                     VAKILMARNOCK               Y Y        
+051403818POWELL VAL JONESVLPOWELL VALLEY NATIONAL BANK         VAJONESVILLE               Y Y        
+051403850BK LANC KILMARNOCKBANK OF LANCASTER                   VAKILMARNOCK               Y Y        
+051403931BK OF MCKENNEY    BANK OF MCKENNEY                    VAMC KENNEY                Y Y20100728
+051403957CARTER BK & TRUST CARTER BANK AND TRUST               VAMARTINSVILLE             Y Y20120221
+051404008HERITAGE BANK     HERITAGE BANK                       VANORFOLK                  Y Y20060501
+051404037VA COMM LOUISA    VIRGINIA COMMUNITY BANK             VALOUISA                   Y Y        
+051404040PEOPLES DANVILLE  PEOPLES NATIONAL BANK               VADANVILLE                 Y N20080103
+051404163LEE BK & TR       LEE BANK & TRUST CO                 VAPENNINGTON GAP           N Y20121029
+051404260BB&T VA           BRANCH BANKING & TRUST CO - VIRGINIAVARICHMOND                 Y N20070928
+051404325PIONEER BANK VA   PIONEER BANK                        VASTANLEY                  Y Y20010207
+051404383EVB               EVB                                 VATAPPAHANNOCK             Y Y20060421
+051404419FMB TIMBERVILLE   FARMERS AND MERCHANTS BANK          VATIMBERVILLE              YSY        
+051404464FIRST LEBANON     THE FIRST BANK AND TRUST COMPANY    VALEBANON                  Y Y        
+051404529NNSB UNION        NNSB A DIV OF UNION FIRST MARKET BK VAWARSAW                   Y N20101118
+051404545FMB PENNINGTON GAPFARMERS AND MINERS BANK             VAPENNINGTON GAP           Y Y20140106
+051404642BK SOUTH CARSON VABANK OF SOUTHSIDE VIRGINIA          VACARSON                   Y Y19990520
+051404684MINERS COEBURN    MINERS EXCHANGE BANK                VACOEBURN                  Y Y        
+051404697BLUE RIDGE FLOYD  BLUE RIDGE BANK, NA                 VAFLOYD                    Y N20080103
+051404846PEOPLES COMMUNITY PEOPLES COMMUNITY BANK              VAMONTROSS                 Y Y20070301
+051404901CITZ FARM WEST PT CITIZENS & FARMERS BANK             VAWEST POINT               Y Y19990714
+051404914HIGHLANDS ABINGDONHIGHLANDS UNION BANK                VAABINGDON                 Y Y20090629
+051404930COMMUNITY SBOSTON COMMUNITY NATIONAL BANK             VASOUTH BOSTON             Y N20080103
+051405188BK HAMPT RDS CHSPKBANK OF HAMPTON ROADS               VAVIRGINIA BEACH           Y Y20121105
+051405395FMB NEW CASTLE    FARMERS & MER BK OF CRAIG CO        VANEW CASTLE               Y Y19990520
+051405434CNB LYNCHBURG     CENTRAL NATIONAL BANK               VALYNCHBURG                Y N20080103
+051405450VALLEY BK ROANOKE VALLEY BANK, NA                     VAROANOKE                  YSY20051121
+051405476MOUNTAIN GALAX VA MOUNTAIN NATIONAL BANK              VAGALAX                    Y N20080103
+051405492SHENANDOAH STAUNTNSHENANDOAH NATIONAL BANK            VASTAUNTON                 Y N20080103
+051405515CAP ONE RICH      CAPITAL ONE  BANK (USA), NA         VAGLEN ALLEN               Y Y20080303
+051405528FARMERS APPOMATTOXTHE FARMERS BANK OF APPOMATTOX      VAAPPOMATTOX               Y Y20090706
+051405670FARMERS BK WINDSORFARMERS BANK                        VAWINDSOR                  YSY20100707
+051406543OLD PT HAMPTON    THE OLD POINT NATL BK OF PHOEBUS    VAHAMPTON                  Y Y        
+051406909BK ESSEX TAPHANOCKESSEX BANK                          VARICHMOND                 Y Y20140410
+051407296VA BANK DANVILLE  VIRGINIA BANK & TRUST CO            VADANVILLE                 Y Y        
+051408839FIR N EXCH ROANOKEFIRST NATIONAL EXCHANGE BANK        VAROANOKE                  Y N20080103
+051408868VA NB CHARLOTTESVLVIRGINIA NATIONAL BANK              VACHARLOTTESVILLE          Y Y        
+051408897NEW PEOPLES BK VA NEW PEOPLES BANK                    VAHONAKER                  Y Y19990714
+051408907FIR CAP GLEN ALLENFIRST CAPITAL BANK                  VAGLEN ALLEN               Y Y19990622
+051408910MONARCH BK CHESAPKMONARCH BANK                        VACHESAPEAKE               Y Y19990714
+051408936UNION BANK & TRUSTUNION BANK AND TRUST                VARUTHER GLEN              Y N20100831
+051408949TOWNEBANK PORTS VATOWNEBANK                           VAPORTSMOUTH               Y Y        
+051409016BANK OF THE JAMES BANK OF THE JAMES                   VALYNCHBURG                Y N20121029
+051409029VILLAGE BANK      VILLAGE BANK                        VAMIDLOTHIAN               Y N20090313
+051409061CITZ COMM BK      CITIZENS COMMUNITY BANK             VASOUTH HILL               Y Y20130326
+051409074RIVER CB NA       RIVER COMMUNITY BANK NA             VAMARTINSVILLE             YSN20080102
+051409155FRANKLIN CB NA    FRANKLIN COMMUNITY BANK, NA         VAROCKY MOUNT              YSN20051223
+051409171HIGHLANDS COMM BK HIGHLANDS COMMUNITY BANK            VACOVINGTON                Y N20020917
+051409184XENITH BANK       XENITH BANK                         VARICHMOND                 Y N20091223
+051409223BANK OF VIRGINIA  BANK OF VIRGINIA                    VAMIDLOTHIAN               Y N20120601
+051409278SONABANK          SONABANK                            VAWARRENTON                Y N20090115
+051409304VIRGINIA COMPANY  VIRGINIA COMPANY BANK               VANEWPORT NEWS             N Y20131105
+051409320HOMETOWN BK       HOMETOWN BANK                       VAROANOKE                  Y N20121029
+051409414OLD DOMINION NATL OLD DOMINION NATIONAL BANK          VANORTH GARDEN             YSN20140203
+051409456SELECT BANK       SELECT BANK                         VALYNCHBURG                N Y20091026
+051409579VA PARTNERS BK    VIRGINIA PARTNERS BANK              VAFREDERICKSBURG           Y Y20081217
+051500562WESBANCO PARKERSBGWESBANCO BANK WHEELING              WVPARKERSBURG              Y N20030821
+051500601FIR CENT BLUEFIELDFIRST CENTURY BANK, INC             WVBLUEFIELD                Y Y20120124
+051500753WESBANCO FAIRMONT WESBANCO BANK WHEELING              WVFAIRMONT                 Y N20030821
+051501024LOGAN BK TR WVA   LOGAN BANK & TRUST COMPANY          WVLOGAN                    Y Y        
+051501134DAVIS TR CO ELKINSDAVIS TRUST COMPANY                 WVELKINS                   Y Y        
+051501150CITZ BK OF WV INC CITIZENS BANK OF WEST VIRGINIA INC. WVELKINS                   Y Y20090630
+051501163COMMUNITY PARKRSBGCOMMUNITY BK OF PARKERSBURG         WVPARKERSBURG              Y N20121029
+051501257FIRST WMSON       FIRST NATIONAL BANK OF WILLIAMSON   WVWILLIAMSON               Y N20120322
+051501299FIRST COMM BK     FIRST COMMUNITY BANK                VABLUEFIELD                Y Y20110824
+051501325BCBANK INC        BCBANK, INC.                        WVPHILIPPI                 Y Y20030519
+051501354FIR EXCH MANNINGTNFIRST EXCHANGE BANK                 WVMANNINGTON               Y Y        
+051501451CITIZENS WESTON   THE CITIZENS BANK                   WVWESTON                   Y Y        
+051501558FIRST RONCEVERTE  THE FIRST NATIONAL BANK / RONCEVERTEWVRONCEVERTE               N Y20030129
+051501671MT VAL BK ELKINS  MOUNTAIN VALLEY BANK, NA            WVELKINS                   Y Y19990729
+051501723MCNB B&T CO       MCNB BANK & TRUST CO                WVWELCH                    Y Y20121211
+051501765PLEASANTS ST MARYSTHE PLEASANTS COUNTY BANK           WVSAINT MARYS              Y N20100730
+051501817BK MINGO NAUGATUCKBANK OF MINGO                       WVWILLIAMSON               Y Y20060612
+051502159WEST UNION BK     WEST UNION BANK                     WVWEST UNION               Y Y20051121
+051502175CORNERSTONE BK INCCORNERSTONE BANK, INC               WVWEST UNION               Y Y20061130
+051502201FAYETTE CTY NATL  FAYETTE COUNTY NATIONAL BANK        WVFAYETTEVILLE             Y Y        
+051502395BK MONROE UNION   THE BANK OF MONROE                  WVUNION                    Y Y        
+051502421CALHOUN CO GRNTSVLTHE CALHOUN COUNTY BANK             WVGRANTSVILLE              Y Y        
+051502476FI SENTRY         FIRST SENTRY BANK, INC.             WVHUNTINGTON               Y N20130226
+051502528FIR ST BARBOURSVLEFIRST STATE BANK                    WVBARBOURSVILLE            Y N        
+051502599CLEAR MOUNTAIN BK CLEAR MOUNTAIN BANK                 WVBRUCETON MILLS           Y Y20051011
+051502641CLAY COUNTY BK WV CLAY COUNTY BANK                    WVCLAY                     N Y20040505
+051502858PUTNAM HURRICANE  PUTNAM COUNTY BANK                  WVHURRICANE                Y Y        
+051502913HARRISON LOST CREKTHE HARRISON COUNTY BANK            WVLOST CREEK               Y Y        
+051503051FIRST PETERSTOWN  FIRST NATIONAL BK OF PETERSTOWN     WVPETERSTOWN               Y N        
+051503103MM BK THOMAS WVA  MINERS & MERCHANTS BANK             WVTHOMAS                   Y Y20090318
+051503145POCA VALLEY WALTONTHE POCA VALLEY BANK                WVWALTON                   Y N20121029
+051503323FIR NEIGHBORHOOD  FIRST NEIGHBORHOOD BANK, INC.       WVSPENCER                  Y Y20090811
+051503394BB&T WEST VIRGINIABB&T WEST VIRGINIA                  WVWILLIAMSON               Y N20021120
+051503556FIRST PEOPLES BANKFIRST PEOPLES BANK, INC.            WVMULLENS                  Y Y20080702
+051503909WILLIAMSTOWN  BK, WILLIAMSTOWN BANK, INC              WVWILLIAMSTOWN             YSY20070601
+051504254PENDLETON COMM BK PENDLETON COMMUNITY BANK, INC.      WVFRANKLIN                 Y Y20090724
+051504319PIONEER COMM BK   PIONEER COMMUNITY BANK              WVIAEGER                   Y N19990817
+051504377FREEDOM BANK INC  FREEDOM BANK, INC.                  WVBELINGTON                Y Y20040608
+051504429WHITESVILLE ST BK WHITESVILLE STATE BANK              WVWHITESVILLE              Y Y20110914
+051504571PREMIER BANK, INC PREMIER BANK, INC                   WVHUNTINGTON               Y Y20130422
+051504597MVB BANK INC      MVB, BANK INC.                      WVFAIRMONT                 Y Y20050613
+051736158TREASURYDIRECT    TREASURYDIRECT                      WVPARKERSBURG              N Y20050927
+051900395UNITED BK PRKSBRG UNITED BANK, INC.                   WVCHARLESTON               Y Y20020326
+051901705WESBANCO SO HILLS WESBANCO BANK WHEELING              WVCHARLESTON               N Y20130212
+051904524CITY NATL WV      CITY NATIONAL BANK OF WEST VIRGINIA WVCHARLESTON               Y Y        
+052000113ALLFIRST BANK     MANUFACTURERS & TRADERS TRUST CO.   MDBALTIMORE                Y N20031222
+052000278FRB BALT          FRB, BALTIMORE                      MDBALTIMORE                Y N20121029
+052000773CARROLLTON BALT   BAY BANK - CB                       MDCOLUMBIA                 Y N20130627
+052001772HARBOR BK BALT    THE HARBOR BANK OF MARYLAND         MDBALTIMORE                Y Y        
+052073519CFG COMMUNITY BK  CFG COMMUNITY BANK                  MDBALTIMORE                Y Y20120803
+052073564M.Y. SAFRA BK, FSBM.Y. SAFRA BANK, FSB                NYNEW YORK                 Y N20140415
+052100466NATL CAMBRIDGE    THE NATIONAL BANK OF CAMBRIDGE      MDCAMBRIDGE                Y Y        
+052100699TALBOT BK EASTON  THE TALBOT BANK OF EASTON MD        MDEASTON                   Y Y        
+052100741PEOPLES CHESTERTWNTHE PEOPLES BANK                    MDCHESTERTOWN              Y Y20060707
+052100932CENTREVILLE NB MD CNB                                 MDCENTREVILLE              Y Y20100112
+052100987FIR UNITED OAKLANDFIRST UNITED BK & TR                MDOAKLAND                  Y Y        
+052101012CALVIN BKG BERLIN CALVIN B TAYLOR BANKING CO          MDBERLIN                   Y N20121029
+052101669HEBRON SB HEBRON  THE HEBRON SAVINGS BANK             MDHEBRON                   Y Y        
+052101931PROV ST PRESTON MDPROVIDENT STATE BANK, INC.          MDPRESTON                  Y Y20010718
+052101957QUEENSTOWN BK MD  QUEENSTOWN BANK OF MARYLAND         MDQUEENSTOWN               Y Y20051223
+052102228CHESAPK CHESTERTWNCHESAPEAKE BANK AND TRUST COMPANY   MDCHESTERTOWN              Y Y        
+052102312BK OCEAN CITY MD  BANK OF OCEAN CITY                  MDOCEAN CITY               Y Y        
+052102516EASTON BK TR MD   EASTON BANK & TRUST                 MDEASTON                   Y N        
+052102901FARMERS WILLARDS  THE FARMERS BANK                    MDWILLARDS                 Y Y20060308
+052103065BK OF DELMARVA MD THE BANK OF DELMARVA                MDSALISBURY                Y Y20101022
+052173464WILM TR FSB MD    MANUFACTURERS & TRADERS TRUST CO.   DEWILMINGTON               Y N20121213
+052201925BANK OF ROMNEY    THE BANK OF ROMNEY                  WVROMNEY                   Y Y        
+052201938FNB BANK, INC.    FNB BANK, INC.                      WVROMNEY                   Y N20041222
+052202225SUMMIT CMNTY BK   SUMMIT COMMUNITY BANK               WVMOOREFIELD               Y Y20020122
+052203046GRANT CTY PETERSBGGRANT COUNTY BANK                   WVPETERSBURG               Y Y        
+052203703CAP VAL WARDENSVLECAPON VALLEY BANK                   WVWARDENSVILLE             Y Y        
+052204359CNB BANK, INC     CNB BANK, INC                       WVBERKELEY SPRINGS         Y Y20061229
+053000196BK AMERICA NC     BANK OF AMERICA NA                  NCCHARLOTTE                Y Y20121113
+053000206FRB CHAR          FRB, CHARLOTTE                      NCCHARLOTTE                Y N20121029
+053012197NCBT A DIV OF SCBTNCBT, A DIVISION OF SCBT            NCCHARLOTTE                Y N20120717
+053012582NEWDOMINION BANK  NEWDOMINION BANK                    NCCHARLOTTE                Y N20120522
+053012728PARK STERLING BANKPARK STERLING BANK                  NCCHARLOTTE                Y N20090706
+053100300FIRST CITZ RALEIGHFIRST-CITIZENS BANK & TRUST CO      NCRALEIGH                  Y Y        
+053100452MECH FAR BK DURHAMMECHANICS & FARMERS BANK            NCDURHAM                   Y Y        
+053100685HIGH POINT BK TR  HIGH POINT BANK & TRUST CO          NCHIGH POINT               Y Y        
+053101121BB&T NC           BB&T NORTH CAROLINA                 NCLUMBERTON                Y Y20070608
+053101684BK OF THE OZARKS  BANK OF THE OZARKS                  ARLITTLE ROCK              Y N20140331
+053102117YADKIN BANK       YADKIN BANK                         NCSTATESVILLE              Y Y20140715
+053102191FIRST ASHEBORO    COMMUNITYONE BANK, NA               NCASHEBORO                 Y Y20070605
+053102586SOUTHERN MT OLIVE SOUTHERN BANK & TRUST CO            NCMOUNT OLIVE              Y Y        
+053103585FID FUQUAY VARINA FIDELITY BANK                       NCFUQUAY VARINA            Y Y20100730
+053103637BK GRANITE FALLS  COMMUNITYONE BANK-BANK OF GRANITE   NCGRANITE FALLS            Y N20130709
+053103640FMB GRANITE QUARRYFARMERS & MERCHANTS BANK            NCSALISBURY                Y Y20070504
+053103682LUMBEE GUAR BK PEMLUMBEE GUARANTY BANK                NCPEMBROKE                 Y Y        
+053104568FIRST BANK TROY NCFIRST BANK                          NCTROY                     Y Y20121029
+053104869PEOPLES NEWTON    PEOPLES BANK                        NCNEWTON                   Y Y        
+053105059FOUR OAKS BK TR NCFOUR OAKS BANK & TRUST COMPANY      NCFOUR OAKS                Y Y        
+053105936HERITAGE LUCAMA   SOUTHERN BANDTC - HERITAGE DIVISION NCLUCAMA                   Y N20131028
+053109877NEWBRIDGE BANK NC NEWBRIDGE BANK                      NCGREENSBORO               Y Y20101022
+053111577RANDOLPH ASHEBORO BANK OF NORTH CAROLINA              NCASHEBORO                 Y Y20131217
+053111690UWHARRIE BANK     UWHARRIE BANK                       NCALBEMARLE                Y Y20130830
+053112039BK OF NC THOMASVL BANK OF NORTH CAROLINA              NCTHOMASVILLE              Y Y        
+053112071SURREY BT MT AIRY SURREY BANK & TRUST                 NCMOUNT AIRY               Y Y19991224
+053112110PIEDMONT BANK     YADKIN BANK                         NCSTATESVILLE              Y N20130531
+053112220YADKIN BANK       YADKIN BANK                         NCELKIN                    Y N20140421
+053112233YADKIN BANK       YADKIN BANK                         NCBOONE                    Y N20140421
+053112246BANK HAMPTON ROADSBANK OF HAMPTON ROADS               NCELIZABETH CITY           Y N20090527
+053112259BANK OF THE CAROLIBANK OF THE CAROLINAS               NCMOCKSVILLE               YSY20140410
+053112275CRESCENT ST BANK  VANTAGESOUTH BANK                   NCCARY                     Y Y20121130
+053112288PARAGON COMMERCIALPARAGON COMMERCIAL BANK             NCRALEIGH                  Y Y19991217
+053112314CORNERSTONE BANK  CORNERSTONE BANK                    NCWILSON                   Y Y20080905
+053112327BANK OF OAK RIDGE BANK OF OAK RIDGE                   NCOAK RIDGE                Y Y20090715
+053112330NEW CENTURY BANK  NEW CENTURY BANK                    NCDUNN                     Y Y20050120
+053112343TRUSTATLANTIC BANKTRUSTATLANTIC  BANK                 NCRALEIGH                  Y N20090126
+053112356NORTH STATE BANK  NORTH STATE BANK                    NCRALEIGH                  Y N20121101
+053112408FIRST CAR ROCKY MTFIRST CAROLINA BANK                 NCROCKY MOUNT              Y N20130919
+053112440PARKWAY BK LENOIR CERTUSBANK - PARKWAY BRANCH         NCLENOIR                   Y N20131101
+053112453YADKIN BANK       YADKIN BANK                         NCDURHAM                   Y N20140421
+053112466SOUND BANKING CO  SOUND BANKING COMPANY               NCMOREHEAD CITY            N Y20081006
+053112547NANTAHALA BANK &TCNANTAHALA BANK & TRUST COMPANY      NCFRANKLIN                 N Y20100803
+053112592WOODFOREST NATL BKWOODFOREST NATIONAL BANK            TXHOUSTON                  Y N20050623
+053112615SQUARE 1 BANK     SQUARE 1 BANK                       NCDURHAM                   Y Y20070824
+053112657PROVIDENCE BANK   PROVIDENCE BANK                     NCROCKY MOUNT              Y N20060314
+053112754OLDTOWN BANK      OLDTOWN BANK                        NCWAYNESVILLE              N Y20081218
+053112848GREAT STATE BANK  GREAT STATE BANK                    NCWILKESBORO               Y Y20110524
+053174019CAROLINA BANK     CAROLINA BANK                       NCGREENSBORO               Y N20090623
+053174048THE LITTLE BANK   THE LITTLE BANK                     NCKINSTON                  YSN20090226
+053185613SELF-HELP FCU     SELF-HELP FCU                       NCDURHAM                   Y Y20140204
+053200983SOUTH STATE BANK  SOUTH STATE BANK                    SCCOLUMBIA                 Y Y20140717
+053201034ARTHUR ST UNION SCARTHUR STATE BANK                   SCUNION                    Y Y        
+053201186PALMETTO LAURENS  THE PALMETTO BANK                   SCLAURENS                  Y Y20101221
+053201487FIR CITZ CHAS BRSCFIRST CITIZENS BANK & TRUST CO, INC SCCHARLESTON               Y N20050907
+053201607BB&T SC           BB&T SOUTH CAROLINA                 SCGREENVILLE               Y N20070928
+053201856DEDICATED CMNTY BKDEDICATED COMMUNITY BANK            SCDARLINGTON               Y N20121101
+053201869PEOPLES NB EASLEY SCBT - PEOPLES DIVISION             SCEASLEY                   Y N20120717
+053201872BK OF SC CHAS SC  THE BANK OF SOUTH CAROLINA          SCCHARLESTON               Y Y        
+053201953CLOVER COMM BK SC CLOVER COMMUNITY BANK               SCCLOVER                   Y Y        
+053201982HORRY CTY ST LORISHORRY COUNTY STATE BANK             SCLORIS                    Y Y20070508
+053202062GREER ST BK SC    GREER STATE BANK                    SCGREER                    Y Y        
+053202091CAPITALBANK SC    PARK STERLING - LEGACYCAPITALBANK   SCGREENWOOD                Y N20121113
+053202114BK WALTERBORO SC  BANK OF WALTERBORO                  SCWALTERBORO               Y Y20090715
+053202130CFB WALHALLA SC   COMMUNITY FIRST BANK, INC.          SCWALHALLA                 Y Y20130207
+053202208FIRST COMM LEXNTN FIRST COMMUNITY BANK                SCLEXINGTON                Y Y20121001
+053202279SCBT              SCBT                                SCROCK HILL                Y N20081205
+053202282FIR SO SPARTANBURGFIRST SOUTH BANK                    SCSPARTANBURG              Y Y        
+053202318CONWAY NATL       THE CONWAY NATIONAL BANK            SCCONWAY                   Y Y        
+053202350COMM FIRBK CHAS SCCRESCOM BANK                        SCCHARLESTON               Y N20120323
+053202596PALMETTO HAMPTN SCPALMETTO STATE BANK                 SCHAMPTON                  Y Y        
+053202871ENTERPR EHRHARDT  ENTERPRISE BK OF SOUTH CAROLINA     SCEHRHARDT                 Y Y        
+053203210FIRST HOLLY HILL  THE FIRST NATL BK OF SOUTH CAROLINA SCHOLLY HILL               Y Y        
+053204280FAR MER HOLLY HILLFARMERS & MERCHANTS BANK            SCHOLLY HILL               Y Y        
+053206738BK CLARE MANNING  THE BANK OF CLARENDON               SCMANNING                  Y Y        
+053206741ANDERSN BROS BK SCANDERSON BROTHERS BANK              SCMULLINS                  Y Y        
+053206819CNTY BK GREENWOOD COUNTYBANK                          SCGREENWOOD                Y Y20020308
+053206961COMM BK HONEA PATHTHE COMMERCIAL BANK                 SCHONEA PATH               Y Y20090615
+053207106BANK OF YORK SC   BANK OF YORK                        SCYORK                     Y N20120322
+053207119BK GREELEYVILLE SCBANK OF GREELEYVILLE                SCGREELEYVILLE             Y N        
+053207216CAR BK DARLINGTON CAROLINA BANK & TRUST COMPANY       SCDARLINGTON               Y Y        
+053207339CITIZENS OLANTA   THE CITIZENS BANK                   SCOLANTA                   Y Y        
+053207371BK TRAVELERS REST BANK OF TRAVELERS REST              SCTRAVELERS REST           Y Y        
+053207533PEOPLES BK IVA    THE PEOPLES BANK                    SCIVA                      Y Y19990518
+053207614SANDHILLS BETHUNE SANDHILLS BANK                      SCNORTH MYRTLE BEACH       YSY20050623
+053207669BLUE RIDGE WALHALABLUE RIDGE BANK                     SCWALHALLA                 Y Y20081002
+053207931BK ANDERSON SC    SCBT - PEOPLES DIVISION             SCANDERSON                 Y N20120717
+053207944SOUTHCOAST COMM BKSOUTHCOAST COMMUNITY BANK           SCMT PLEASANT              Y N20121101
+053207957GRANDSOUTH BANK   GRANDSOUTH BANK                     SCGREENVILLE               Y Y20091027
+053207960SENECA NATL BK SC SCBT - PEOPLES DIVISION             SCSENECA                   Y N20120713
+053207973HERITAGE COMM BK  HERITAGE COMMUNITY BANK             SCHARTSVILLE               N Y20080924
+053207999CORNERSTONE NAT BKCORNERSTONE NATIONAL BANK           SCEASLEY                   Y N20100730
+053208008FIRST RELIANCE SC FIRST RELIANCE BANK                 SCFLORENCE                 Y Y20021216
+053208011SOUTHERN FIRST BK SOUTHERN FIRST BANK                 SCGREENVILLE               Y N20130403
+053208079CRESCENT BK MB SC CRESCOM BANK                        SCCHARLESTON               Y N20120106
+053208095TIDELANDS BANK    TIDELANDS BANK                      SCMT PLEASANT              N Y20111202
+053208105COASTALSTATES BANKCOASTALSTATES BANK                  SCHILTON HEAD ISLAND       Y N20070606
+053208118CERTUSBANK, NA    CERTUSBANK, NA                      SCEASLEY                   Y Y20120321
+053208121PALMETTO HERITAGE PALMETTO HERITAGE BANK & TRUST      SCPAWLEYS ISLAND           Y Y20090727
+053208134INDEP NATL BK     INDEPENDENCE NATIONAL BANK          SCGREENVILLE               YSN20050630
+053208150PINNACLE BANK  SC PINNACLE BANK OF SOUTH CAROLINA     SCGREENVILLE               N Y20131003
+053208163ATLANTIC CMNTY BK ATLANTIC COMMUNITY BANK             SCBLUFFTON                 N Y20080717
+053208176HARBOR NATIONAL BKHARBOR NATIONAL BANK                SCCHARLESTON               Y N20060214
+053208202CAROLINA ALLIANCE CAROLINA ALLIANCE BANK              SCSPARTANBURG              Y N20070108
+053208260SOUTH ATLANTIC BK SOUTH ATLANTIC BANK                 SCMYRTLE BEACH             Y Y20090727
+053208309COASTAL CAROLINA  COASTAL CAROLINA NB                 SCMYRTLE BEACH             Y N20090610
+053285173MTC FEDERAL CU    MTC FEDERAL CREDIT UNION            SCGREENVILLE               N Y20130422
+053902197CAROLINA COLA BR  TD BANK, NA                         SCCOLUMBIA                 Y N20110617
+053905877SC COMMUNITY BK   SOUTH CAROLINA COMMUNITY BANK       SCCOLUMBIA                 Y Y        
+053905974AGFIRST FCB       AGFIRST FARM CREDIT BANK            SCCOLUMBIA                 Y Y20091123
+0539060411ST CITZ BK & TR  FIRST CITIZENS BANK & TRUST CO, INC SCCOLUMBIA                 Y Y20040622
+053908188BANK MERIDIAN NA  SCBT                                SCCOLUMBIA                 Y N20120717
+054000056NATL CAP WASH     NATIONAL CAPITAL BANK OF WASH       DCWASHINGTON               Y Y        
+054000959INDUSTRIAL BK WASHINDUSTRIAL BANK                     DCWASHINGTON               Y Y20070917
+054001327NATL PAKISTAN WASHNATIONAL BANK OF PAKISTAN           DCWASHINGTON               YSN20100419
+054001518ABU DHABI INT WASHABU DHABI INTERNATIONAL BANK INC    DCWASHINGTON               Y N        
+054001547BB&T WASHINGTON DCBB&T WASHINGTON DC                  DCWASHINGTON               Y N20030310
+054001686CITY FIRST BK DC  CITY FIRST BANK OF DC, NA           DCWASHINGTON               Y Y20080530
+054001699WASHINGTON FST BK WASHINGTONFIRST BANK                VARESTON                   Y Y20120207
+054001712BANK OF GEORGETOWNBANK OF GEORGETOWN                  DCWASHINGTON               Y Y20050520
+054001725TD BANK, NA       TD BANK, NA                         DCWASHINGTON               Y N20090925
+054073630URBAN TRUST BANK  URBAN TRUST BANK                    FLLAKE MARY                Y N20120201
+054080212GEORGETOWN UNIV   GEORGETOWN UNIV ALUMNI & STUDENT FCUDCWASHINGTON               Y N        
+055001070HARFORD ABERDEEN  HARFORD BANK                        MDABERDEEN                 Y Y20011205
+055001096SSNB SANDY SPRING SANDY SPRING BANK                   MDOLNEY                    Y Y20010924
+055001258MIDDLETOWN VAL BK MIDDLETOWN VALLEY BANK              MDMIDDLETOWN               Y Y        
+055001986NBRS FINANCIAL    NBRS FINANCIAL BANK                 MDRISING SUN               Y Y20060926
+055002150WOODSBORO BK MD   WOODSBORO BK                        MDWOODSBORO                Y Y19991217
+055002338COLUMBIA BK MD    THE COLUMBIA BANK                   MDCOLUMBIA                 Y Y        
+055002367DAMASCUS COMM MD  DAMASCUS COMMUNITY BANK             MDDAMASCUS                 Y Y20140505
+055002406OLD LINE  BANK    OLD LINE BANK                       MDBOWIE                    Y N20060713
+055002503CNTY FB LAPLATA MDCOUNTY FIRST BANK                   MDLA PLATA                 Y Y        
+055002723FAR MER UPPERCO MDFARMERS & MERCHANTS BANK            MDUPPERCO                  Y N20121102
+055002998NEW WINDSOR SB    NEW WINDSOR STATE BANK              MDNEW WINDSOR              Y Y20140620
+055003133BK GLEN BURNIE MD THE BANK OF GLEN BURNIE             MDGLEN BURNIE              Y N20120327
+055003298EAGLEBANK BETHESDAEAGLEBANK                           MDBETHESDA                 Y Y        
+055003308BB&T MARYLAND     BB&T MARYLAND                       MDHYATTSVILLE              Y N20021120
+055003340CAPITAL BANK NA   CAPITAL BANK, NA                    MDROCKVILLE                Y N20140227
+055003353BAY BANK FSB      BAY BANK, FSB                       MDLUTHERVILLE              Y Y20100712
+055003395FREDERICK CNTY BK FREDERICK COUNTY BK                 MDFREDERICK                Y Y20020711
+055003418CONGRESSIONAL BANKCONGRESSIONAL BANK                  MDBETHESDA                 Y Y20080522
+055003434HOWARD BANK       HOWARD BANK                         MDELLICOTT CITY            Y Y20040811
+055003447MD FINANCIAL BK   MARYLAND FINANCIAL BANK             MDTOWSON                   Y Y20070808
+055003450MONUMENT BANK     MONUMENT BANK                       MDBETHESDA                 Y N20050906
+055003489WOORI AMERICAN BK WOORI AMERICA BANK                  MDWHEATON                  Y N20061101
+055003528REVERE BANK       REVERE BANK                         MDLAUREL                   Y Y20111130
+055003560BLUERIDGE BK      BLUERIDGE BANK                      MDFREDERICK                Y Y20090611
+055073587MIDSTATE FSL BALT MIDSTATE COMMUNITY BANK             MDBALTIMORE                Y N20120618
+055080415SIGNAL FINCL FCU  SIGNAL FINANCIAL FCU                MDKENSINGTON               Y N20080728
+056001066BURKE HERBERT ALEXBURKE & HERBERT BK & TR CO          VAALEXANDRIA               Y Y        
+056004445UNITED FAIRFAX    UNITED BANK                         VAFAIRFAX                  Y Y20100831
+056005075UNION BANK AND TR UNION BANK AND TRUST COMPANY        VARUTHER GLEN              Y N20100831
+056005318BB&T N VIRGINIA   BB&T VIRGINIA                       VAARLINGTON                Y N20040727
+056006786MIDDLEBURG BANK   MIDDLEBURG BANK                     VAMIDDLEBURG               Y Y20060511
+056008849CARDINAL BANK     CARDINAL BANK, NA                   VAMCLEAN                   Y Y20050829
+056009039ACCESS NB VA      ACCESS NATIONAL BANK                VARESTON                   Y Y20030909
+056009123FREEDOM BK VIENNA THE FREEDOM BANK OF VIRGINIA        VAFAIRFAX                  Y Y20131206
+056009246MAINSTREET BANK   MAINSTREET BANK                     VAFAIRFAX                  Y N20120814
+056009314VA HERITAGE BANK  VIRGINIA HERITAGE BANK              VAVIENNA                   Y Y20130108
+056009356JOHN MARSHALL BANKJOHN MARSHALL BANK                  VARESTON                   Y Y20111102
+056009479CHAIN BRIDGE BK   CHAIN BRIDGE BANK, NA               VAMCLEAN                   Y Y20090306
+056009505FIR VA COM BK     FIRST VIRGINIA COMMUNITY BANK       VAFAIRFAX                  Y Y20140304
+056073502CAPITAL ONE NA    CAPITAL ONE, NA                     VAMCLEAN                   Y Y20070718
+056073573ETRADE BANK       E TRADE BANK                        VAARLINGTON                Y N20140227
+056073612CAPITAL ONE FSB   CAPITAL ONE FSB                     VAMCLEAN                   Y N20060915
+056080126LOUDOUN CU LEESBRGLOUDOUN CU                          VALEESBURG                 YSN20060929
+057000668BB&T BALTIMORE    BB&T - BALTIMORE                    NCWILSON                   Y N20021120
+057001418BK OF CHARLES TOWNBANK OF CHARLES TOWN                WVCHARLES TOWN             Y N20121102
+057001971JEFF SEC SHPDSTOWNJEFFERSON SECURITY BANK             WVSHEPHERDSTOWN            Y Y        
+061000104SUNTRUST ATL      SUNTRUST BANK                       GAATLANTA                  Y Y20030814
+061000146FRB ATL           FEDERAL RESERVE BANK OF ATLANTA     GAATLANTA                  Y Y19990719
+061004812CAPITOL CITY ATL  CAPITOL CITY BANK & TRUST COMPANY   GAATLANTA                  Y Y20021022
+061008766FHLB ATL          FEDERAL HOME LOAN BANK OF ATLANTA   GAATLANTA                  Y N20080908
+061010220CITZ TR ATL       CITIZENS TRUST BANK                 GAATLANTA                  Y Y        
+061019771ALLIANCE NB       ALLIANCE NATIONAL BANK              GADALTON                   YSY20090806
+061020113THE PRIVATE BANK  THE PRIVATEBANK AND TRUST COMPANY   GAATLANTA                  Y N20100203
+061020197AFFINITY BANK GA  AFFINITY BANK                       GAATLANTA                  YSY20100928
+061020317GEORGIA COMMERCE  GEORGIA COMMERCE BANK               GAATLANTA                  Y Y20080804
+061020375MIDTOWN B&T CO GA MIDTOWN BANK & TRUST COMPANY        GAATLANTA                  Y Y20101021
+061020867PRIVATE BK BUCKHEDPRIVATE BANK OF BUCKHEAD            GAATLANTA                  Y N20090615
+061020948ATLANTIC CAPITAL  ATLANTIC CAPITAL BANK               GAATLANTA                  Y Y20080924
+061021060GEORGIA PRIMARY   GEORGIA PRIMARY BANK                GAATLANTA                  Y Y20130717
+061021170RESURGENS BK GA   RESURGENS BANK                      GATUCKER                   YSN20130131
+061021358INVESCO NATL TC GAINVESCO NATIONAL TRUST COMPANY      GAATLANTA                  Y N20131230
+061053203ATLANTIC TRUST CO ATLANTIC TRUST COMPANY, NA          GAATLANTA                  Y N20140328
+061092332CORNERSTN BK GA   CORNERSTONE BANK                    GAATLANTA                  Y Y20090608
+061092387JPMCHASE          JPMORGAN CHASE BANK, NA             CASTOCKTON                 Y N20101129
+061092691BANK OF ATLANTA   BANK OF ATLANTA                     GAATLANTA                  Y N20081218
+061092947DELTA CCU         DELTA COMMUNITY CREDIT UNION        GAATLANTA                  Y N20110824
+061100606CB&T DV OF SYNOVUSSYNOVUS BANK                        GACOLUMBUS                 Y Y20100806
+061101100CAPITAL BANK      CAPITAL BANK                        GAFORT OGLETHORPE          N Y        
+061101113FIRST ST BK       FIRST STATE BANK                    GAWRENS                    N Y        
+061101184FIRST GRIFFIN     FIRST NATIONAL BANK OF GRIFFIN      GAGRIFFIN                  Y Y20090914
+061101197CITZ BK SANDERSVLLCITIZENS BANK OF WASHINGTON COUNTY  GASANDERSVILLE             Y N20121102
+061101294PINNACLE BANK     PINNACLE BANK                       GAELBERTON                 Y Y20090218
+061101414SE FIRST NATL BANKSOUTHEAST FIRST NATIONAL BANK       GATRION                    Y Y20080421
+061101773CENTURY BK &TR    CENTURY BANK & TRUST                GAMILLEDGEVILLE            Y Y20130829
+061101786EXCHANGE BANK     EXCHANGE BANK                       GAMILLEDGEVILLE            Y Y        
+061101922SOUTHCREST BANK   SOUTHCREST BANK, NA                 GACEDARTOWN                Y Y20140311
+061102332GEO D WARTHEN BANKTHE GEO. D. WARTHEN BANK            GASANDERSVILLE             Y Y20020307
+061102400FIDELITY BANK     FIDELITY BANK                       GANORCROSS                 Y Y20030516
+061102594FIRST NATL COMM BKFIRST NATIONAL COMMUNITY BANK       GACHATSWORTH               Y Y20111021
+061102617MONROE COUNTY BANKMONROE COUNTY BANK                  GAFORSYTH                  Y N20081211
+061102620THE FARMERS BK    THE FARMERS BANK                    GAFORSYTH                  Y Y20090121
+061102662RABUN CTY BK      RABUN COUNTY BANK                   GACLAYTON                  Y Y        
+061103030NORTHEAST GA BK   NORTHEAST GEORGIA BANK              GALAVONIA                  Y N20121102
+061103153PEOPLES BK & TR   PEOPLES BANK & TRUST                GABUFORD                   Y Y20090401
+061103182BANK LAFAYETTE GA THE BANK OF LAFAYETTE               GALAFAYETTE                Y Y20130319
+061103218COMM BK TROUP CO  COMMUNITY BANK & TRUST WEST GEORGIA GALAGRANGE                 Y Y20100322
+061103276BRAND LAWRENCEVILLTHE BRAND BANKING COMPANY           GALAWRENCEVILLE            Y Y        
+061103315BANK OF MONTICELLOBANK OF MONTICELLO                  GAMONTICELLO               Y Y20110328
+061103409THE PEOPLES BANK  THE PEOPLES BANK                    GACOVINGTON                Y Y20090324
+061103852CBT A DIV SCBT    CBT, A DIVISION OF SCBT             GACORNELIA                 Y N20120717
+061103894EW BK SMRINO      EAST WEST BANK                      GACHAMBLEE                 Y N20100422
+061103975QUEENSBOROUGH     QUEENSBOROUGH NATIONAL BK & TRUST COGALOUISVILLE               Y Y20060310
+061104123ST B&T CO GA      STATE BANK AND TRUST COMPANY        GAMACON                    Y Y20100730
+061104136FIRST BK GEORGIA  FIRST BANK OF GEORGIA               GAAUGUSTA                  Y Y20120118
+061104204WOODBURY BK CO GA THE WOODBURY BANKING COMPANY        GAWOODBURY                 Y Y20100201
+061104220GREENVILLE BKG CO THE GREENVILLE BANKING COMPANY      GAGREENVILLE               YSN20121102
+061104314GA BANK TRUST CO  GEORGIA BANK & TRUST CO OF AUGUSTA  GAAUGUSTA                  Y Y20111202
+061104482SYNCHRONY BANK    SYNCHRONY BANK                      GAALPHARETTA               Y N20140625
+061104644BANK OF PERRY     THE BANK OF PERRY                   GAPERRY                    Y Y20100726
+061104848METRO CITY BK GA  METRO CITY BANK                     GADORAVILLE                Y N20120615
+061104880GREATER ROME BANK GREATER ROME BANK                   GAROME                     YSY20100901
+061104893QUANTUM NATL BK   QUANTUM NATIONAL BANK               GASUWANEE                  Y Y20070305
+061104929CITIZENS FORSYTH CCITIZENS BANK OF FORSYTH CO         GACUMMING                  N Y19990616
+061105685CBT A DIV SCBT    SCBT                                GACORNELIA                 Y N20120717
+061106655FIRST BK OF PIKE  FIRST BANK OF PIKE                  GAMOLENA                   Y N20090716
+061107010NORTHWEST GA BK   NORTHWEST GEORGIA BANK              GARINGGOLD                 Y Y        
+061107146BK OF HANCOCK CTY BANK OF HANCOCK COUNTY              GASPARTA                   Y N20090417
+061107515UNITED BANK       UNITED BANK                         GAZEBULON                  Y N20121102
+061107861THE FARMERS BK    THE FARMERS BANK                    GAGREENSBORO               YSY20090223
+061108161FARMERS ST BK     FARMERS STATE BANK                  GALINCOLNTON               Y N20121102
+061110557FARMERS & MER BK  FARMERS & MERCHANTS BANK            GAEATONTON                 N Y20120823
+061110654THE COMM BK       THE COMMERCIAL BANK                 GACRAWFORD                 YSY20130903
+061111255FST AMER BK AND   FIRST AMERICAN BANK & TRUST CO      GAATHENS                   Y Y20090401
+061112034FOUR COUNTY BK    THE FOUR COUNTY BANK                GAALLENTOWN                Y Y20091027
+061112092THE PEOPLES BK    THE PEOPLES BANK                    GAEATONTON                 Y N20121126
+061112364BANKSOUTH         BANKSOUTH                           GAGREENSBORO               Y Y20070215
+061112458COMMNTY & SO BK GACOMMUNITY & SOUTHERN BANK           GAATLANTA                  Y Y20131108
+061112597F&M MANCHESTER    F&M BANK AND TRUST COMPANY          GAMANCHESTER               N Y20091223
+061112843UNITED COMM BK    UNITED COMMUNITY BANK               GABLAIRSVILLE              Y Y20010829
+061113101BANK OF DADE      BANK OF DADE                        GATRENTON                  Y Y20010829
+061113282OCONEE STATE BANK OCONEE STATE BANK                   GAWATKINSVILLE             Y Y        
+061113415BB&T GEORGIA      BB&T GEORGIA                        GABYRON                    Y N20000629
+061119642NORTH GA NATL BK  NORTH GEORGIA NATIONAL BANK         GACALHOUN                  Y N20000518
+061119668SHBKUS6LNYX       SHINHAN BANK AMERICA                GAALPHARETTA               Y N20120614
+061119723COMMUNITY BK SOUTHCOMMUNITY BANK OF THE SOUTH         GASMYRNA                   N Y20070412
+061119765GWINNETT COM BK GAGWINNETT COMMUNITY BANK             GADULUTH                   Y Y20090729
+061119781THE BK OF GEORGIA THE BANK OF GEORGIA                 GAPEACHTREE CITY           Y N20030324
+061119794FIRST INTERCON BK FIRST INTERCONTINENTAL BANK         GADORAVILLE                Y N20121127
+061119804CENTURY BK GEORGIACENTURY BANK OF GEORGIA             GACARTERSVILLE             N Y20081107
+061119888NATL BANK OF GA   NATIONAL BANK OF GEORGIA            GAATHENS                   YSN20130301
+061119969INTEGRITY BANK    REGIONS BANK                        GAALPHARETTA               Y N20081119
+061120084FIRST CENTRY BK NAFIRST CENTURY BANK, NA              GAGAINESVILLE              Y Y20090515
+061120107CERTUSBANK        CERTUSBANK MACON                    GAMACON                    Y N20120330
+061120123DOUGLAS NATL BANK DOUGLAS NATIONAL BANK               GADOUGLAS                  YSN20060828
+061120369CERTUSBANK        CERTUSBANK CARROLLTON               GACARROLLTON               Y N20120518
+061120424MOUNTAIN VALLEY   MOUNTAIN VALLEY COMMUNITY BANK      GACLEVELAND                YSY20130809
+061120453PEOPLES COMM NB GAPEOPLES COMMUNITY NATIONAL BANK     GABREMEN                   Y Y20100730
+061120466HIGHLAND COMM BK  HIGHLAND COMMERCIAL BANK            GAMARIETTA                 Y N20090602
+061120479HAMILTON ST BK    HAMILTON STATE BANK                 GAHOSCHTON                 Y N20111220
+061120534FIRST MADISON B&T FIRST MADISON BANK & TRUST          GACOLBERT                  YSN20130206
+061120547PEACHSTATE B&T    PEACH STATE BANK & TRUST            GAGAINESVILLE              Y N20101230
+061120550HOMETOWN COMM BK  CERTUSBANK - HCB                    GABRASELTON                Y N20130607
+061120563GEORGIA HERITGE BKGEORGIA HERITAGE BANK               GADALLAS                   Y Y20090710
+061120576NORTHSIDE BANK    NORTHSIDE BANK                      GAADAIRSVILLE              Y Y20110407
+061120615SIGNATURE BK OF GASIGNATURE BANK OF GEORGIA           GASANDY SPRINGS            YSY20090915
+061120631COMMNTY & SO BK GACOMMUNITY & SOUTHERN BANK           GAATLANTA                  Y Y20140721
+061120686METRO CITY BANK   METRO CITY BANK                     GADORAVILLE                Y Y20080319
+061120709PROVIDENCE BANK   PROVIDENCE BANK                     GAALPHARETTA               Y Y20101007
+061120767STEARNS BANK -GA  STEARNS BANK NA                     GAALPHARETTA               Y N20081029
+061120770RIVER CITY BANK   RIVER CITY BANK                     GAROME                     N Y20110928
+061120835FIRST COVENANT BK FIRST COVENANT BANK                 GACOMMERCE                 Y Y20090923
+061120851PIEDMONT BANK GA  PIEDMONT BANK                       GANORCROSS                 YSY20110921
+061120893FOOTHILLS COMM GA FOOTHILLS COMMUNITY BANK            GADAWSONVILLE              N Y20140324
+061120903EMBASSY NATIONAL  EMBASSY NATIONAL BANK               GALAWRENCEVILLE            YSY20131227
+061120987VINNINGS BANK     VININGS BANK                        GASMYRNA                   N Y20100112
+061121009STATE BK OF GA    STATE BANK OF GEORGIA               GAFAYETTEVILLE             YSN20090624
+061121025ATLANTIC CAPITAL  ATLANTIC CAPITAL BANK               GAATLANTA                  Y N20090202
+061121041COMMUNITY BUSINESSCOMMUNITY BUSINESS BANK             GACUMMING                  Y N20080122
+061121054KEYWORTH BANK GA  KEYWORTH BANK                       GAALPHARETTA               Y N20100311
+061121070AMERICAN PRIDE BK AMERICAN PRIDE BANK                 GAMACON                    N Y20090904
+061121096LAGRANGE BANKING  LAGRANGE BANKING COMPANY            GALAGRANGE                 Y N20110606
+061121106TOUCHMARK NAT'L BKTOUCHMARK NATIONAL BANK             GAALPHARETTA               Y Y20110328
+061121119FIRST LANDMARK BK FIRST LANDMARK BANK                 GAMARIETTA                 Y N20111214
+061121122METRO BANK        METRO BANK                          GADOUGLASVILLE             N Y20090105
+061121180NOA BANK GA       NOA BANK                            GADULUTH                   Y N20140716
+061192481HERITAGE FIRST BK HERITAGE FIRST BANK                 GAROME                     Y Y20110823
+061200658CARVER ST BK SAV  CARVER STATE BANK                   GASAVANNAH                 Y Y        
+061201042CITZ BK OF AMER   CITIZENS BANK OF AMERICUS           GAAMERICUS                 Y Y20130807
+061201233PEOPLES ST BK & TRPEOPLES STATE BANK & TRUST          GABAXLEY                   Y Y20090731
+061201754AMERIS BANK       AMERIS BANK                         GAMOULTRIE                 Y Y20061208
+061201864CITIZENS QUITMAN  CITIZENS NATIONAL BANK OF QUITMAN   GAQUITMAN                  Y Y20090306
+061202025SOUTHWEST GA BK   SOUTHWEST GEORGIA BANK              GAMOULTRIE                 Y Y        
+061202245FIRST WAYNESBORO  THE 1ST NATL BK OF WAYNESBORO       GAWAYNESBORO               Y N        
+061202371PEOPLES BANK      PEOPLESOUTH BANK                    GACOLQUITT                 Y Y20090527
+061202533BANK OF EASTMAN   BANK OF EASTMAN                     GAEASTMAN                  Y Y20090722
+061202672BANK OF FITZGERALDCOLONY BANK                         GAFITZGERALD               Y Y20091201
+061202685SO GA BKG CO-DOOLYSOUTH GA BANKING CO - DOOLY COUNTY  GAVIENNA                   Y N20140131
+061202889PELHAM BANKING CO PELHAM BANKING COMPANY              GAPELHAM                   N Y20080214
+061202931FST ST BK BLAKELY FIRST STATE BANK OF BLAKELY         GABLAKELY                  Y N20000824
+061202944BANK OF CAMILLA   BANK OF CAMILLA                     GACAMILLA                  Y N20021031
+061202957PLANTERS & CITIZENPLANTERS & CITIZENS BANK            GACAMILLA                  Y Y        
+061203338CITIZENS BANK     CITIZENS BANK                       GACAIRO                    N Y20080624
+061203558CITZ BK SWAINSBOROTHE CITIZENS BANK OF SWAINSBORO     GASWAINSBORO               Y Y20090504
+061203642F&M BANK LAKELAND FARMERS & MERCHANTS BANK            GALAKELAND                 Y N20121212
+061203655PRIMESOUTH BANK   PRIMESOUTH BANK                     GABLACKSHEAR               Y Y20090713
+061203752BK HAZLEHURST     BANK OF HAZLEHURST                  GAHAZLEHURST               Y N20121210
+061203778THE MERCH & CIT BKTHE MERCHANTS AND CITIZENS BANK     GAMC RAE                   N Y20100730
+061204052WAYCROSS BT       WAYCROSS BANK & TRUST COMPANY       GAWAYCROSS                 Y Y20010829
+061204463BRYAN BK & TR     SCBT - BRYAN BANK DIVISION          GARICHMOND HILL            Y N20130208
+061204654THE SAVANNAH BK   SCBT - THE SAVANNAH BANK DIVISION   GASAVANNAH                 Y N20130208
+061204683GLENVLLE B&T      GLENNVILLE BANK                     GAGLENNVILLE               Y Y20020410
+061204735FNB COFFEE CTY    FIRST NATIONAL BANK OF COFFEE COUNTYGADOUGLAS                  Y N20120307
+061204858THOMASVILLE NATL  THOMASVILLE NATIONAL BANK           GATHOMASVILLE              Y Y20010829
+061204971BK OF SOPERTON GA BANK OF SOPERTON                    GASOPERTON                 Y Y20090629
+061205271BK OF ALAPAHA     BANK OF ALAPAHA                     GAALAPAHA                  Y Y20100512
+061205844SOUTHEASTERN BANK SOUTHEASTERN BANK                   GADARIEN                   Y Y        
+061205938BK OF DUDLEY      BANK OF DUDLEY                      GADUDLEY                   Y Y20090518
+061206458TRUST BANK GA     TRUST BANK                          GALENOX                    Y Y20091120
+061206681MT VERNON BANK GA MOUNT VERNON BANK                   GAMOUNT VERNON             Y N20090805
+061206814FIRST SOUTHERN BK FIRST SOUTHERN BANK                 GAPATTERSON                Y Y20130508
+061206843FST BK COASTAL GA FIRST BANK OF COASTAL GEORGIA       GAPEMBROKE                 Y Y20060413
+061207839HERITAGE BANK     THE HERITAGE BANK                   GAHINESVILLE               Y Y        
+061208155FARMERS STATE BK  FARMERS STATE BANK                  GALUMPKIN                  Y Y20140513
+061209617BANK OF NEWINGTON BANK OF NEWINGTON                   GANEWINGTON                Y Y20090724
+061210237ST BK COCHRAN     STATE BANK OF COCHRAN               GACOCHRAN                  Y N20121211
+061210541THE CITIZENS ST BKTHE CITIZENS STATE BANK OF TAYLOR COGAREYNOLDS                 YSN20060308
+061210965PLANTERSFIRST GA  PLANTERSFIRST                       GACORDELE                  Y Y20090529
+061211171COMM BKING VALD   COMMERCIAL BANKING COMPANY          GAVALDOSTA                 Y N        
+061211197FARMERS STATE BK  FARMERS STATE BANK                  GACADWELL                  Y Y20120410
+061211304CITIZEN EXCH BK GACITIZENS EXCHANGE BANK              GAPEARSON                  Y Y20101007
+061211317PEOPLES BK LYONS  THE PEOPLES BANK OF LYONS           GALYONS                    Y Y20090727
+061211427BARWICK BK        BARWICK BANKING COMPANY             GABARWICK                  Y Y        
+061211430CITIZENS BK & TR  CITIZENS BANK & TRUST COMPANY       GAEASTMAN                  Y Y20110427
+061211443DURDEN BKNG CO    DURDEN BANKING CO INC               GATWIN CITY                Y Y20090507
+061211472COMM STATE BK     COMMERCIAL STATE BANK               GADONALSONVILLE            YSN20121211
+061211870SO GA BKG CO      SOUTH GEORGIA BANKING COMPANY       GATIFTON                   Y Y        
+061211964ALMA EXCH B & T   ALMA EXCHANGE BANK AND TRUST        GAALMA                     Y Y20090729
+061212002BANK OF EARLY     BANK OF EARLY                       GABLAKELY                  YSY20140214
+061212015CLAXTON BANK GA   THE CLAXTON BANK                    GACLAXTON                  Y Y20090506
+061212057THE CITIZENS BK   THE CITIZENS BANK                   GANASHVILLE                N Y        
+061212086S BANK GA         S BANK                              GAGLENNVILLE               Y Y20130618
+061212170WHEELR CNTY ST BK WHEELER COUNTY STATE BANK           GAALAMO                    Y N20090326
+061212277BANK OF LUMBER CTYBANK OF LUMBER CITY                 GALUMBER CITY              Y N20090827
+061212345FARMERS & MERCH BKFARMERS & MERCHANTS BANK            GASYLVANIA                 Y Y20090729
+061212439ALTAMAHA BK & TRUSALTAMAHA BANK & TRUST COMPANY       GAVIDALIA                  Y N20051006
+061212688FARMERS & MERCHANTFARMERS & MERCHANTS BANK            GASTATESBORO               Y Y        
+061212727THE PEOPLES BK GA THE PEOPLES BANK                    GAWILLACOOCHEE             Y N20140205
+061212811SUNMARK COMM BK   SUNMARK COMMUNITY BANK              GAHAWKINSVILLE             N Y        
+061212824CITIZENS STATE BK CITIZENS STATE BANK                 GAKINGSLAND                Y Y20090729
+061212853SECURITY ST BK GA THE SECURITY STATE BANK             GAMC RAE                   Y N20100730
+061212866FIRST ALMA        FNB SOUTH                           GAALMA                     Y Y20011218
+061213001SPIVEY STATE BK   SPIVEY STATE BANK                   GASWAINSBORO               Y Y20090115
+061213043MORRIS BANK       MORRIS BANK                         GADUBLIN                   Y Y20090326
+061213069COASTAL BANK      THE COASTAL BANK                    GASAVANNAH                 Y Y20021008
+061213247PINELAND STATE BK PINELAND STATE BANK                 GAMETTER                   YSY20120625
+061219584ATLANTIC NATIONAL ATLANTIC NATIONAL BANK              GABRUNSWICK                Y N20090727
+061219694AB&T NATIONAL BANKAB&T NATIONAL BANK                  GAALBANY                   Y Y20110208
+061219898UNITED NATL BANK  UNITED NATIONAL BANK                GACAIRO                    Y Y20080709
+061220049GUARDIAN BANK     GUARDIAN BANK                       GAVALDOSTA                 Y N20081009
+061220133FIRST CHATHAM BK  FIRST CHATHAM BANK                  GASAVANNAH                 Y Y20081009
+0612204181ST NTL BK DECATURFIRST NATIONAL BANK OF DECATUR CO.  GABAINBRIDGE               Y N20040407
+061303348FIRST VOLUNTEER BKFIRST VOLUNTEER BANK                TNCHATTANOOGA              Y Y20120619
+061304363CORNERSTONE BANK  CORNERSTONE COMMUNITY BANK          TNCHATTANOOGA              Y Y20030401
+061308592FSGBANK NA        FSGBANK, NATIONAL ASSOCIATION       TNCHATTANOOGA              Y Y20041214
+061309229CAPITALMARK B&T   CAPITALMARK BANK & TRUST            TNCHATTANOOGA              Y N20120213
+062000019REGIONS           REGIONS BANK                        ALHOOVER                   Y N20071029
+062000190FRB BHAM          FEDERAL RESERVE BANK OF BIRMINGHAM  ALBIRMINGHAM               Y N20121212
+062001186COMPASS BANK      COMPASS BANK                        ALBIRMINGHAM               Y Y        
+0620036051ST COMMERCIAL    1ST COMMERCIAL A DIV OF SYNOVUS BANKALBIRMINGHAM               N Y20100722
+062005690REGIONS BK        REGIONS BANK                        ALBIRMINGHAM               Y Y        
+062006330ALOSTAR BK COMM ALALOSTAR BANK OF COMMERCE            ALBIRMINGHAM               Y Y20110418
+062006385ALOSTAR BK COMM ALALOSTAR BANK OF COMMERCE            ALBIRMINGHAM               Y N20130412
+062006495FIRST NATL BKRS BKFIRST NATIONAL BANKER'S BANK        ALBIRMINGHAM               Y N20110729
+062006505SERVISFIRST BANK  SERVISFIRST BANK                    ALBIRMINGHAM               Y Y20060608
+062101031TROY BK & TRUST   TROY BANK & TRUST COMPANY           ALTROY                     Y N20120315
+062101219HANCOCK BANK      WHITNEY BANK                        ALGREENVILLE               N Y20140331
+062101581FST BK OF LINDEN  FIRST BANK OF LINDEN                ALLINDEN                   Y N20121213
+062101882FIRST HARTFORD    FIRST NATIONAL BANK OF HARTFORD     ALHARTFORD                 YSY20120221
+062102030HNB FIRST BANK    HNB FIRST BANK                      ALHEADLAND                 Y Y20140402
+062102098UNITED BK ATMORE  UNITED BANK                         ALATMORE                   Y N20120323
+062102221FRIEND BANK       FRIEND BANK                         ALSLOCOMB                  YSY20130117
+062102292FST NATL BRUNDIDGEFIRST NATIONAL BANK OF BRUNDIDGE    ALBRUNDIDGE                Y N20121213
+062102386COMMUNTY NEIGHBOR COMMUNITY NEIGHBOR BANK             ALCAMDEN                   Y Y20140124
+062102399TOWN COUNTRY      TOWN-COUNTRY NATIONAL BANK          ALCAMDEN                   YSY20080829
+062102593PEOPLES EXCH MONR PEOPLES EXCH BK OF MONROE CNTY      ALMONROEVILLE              N Y20110503
+062102713CITY BK/HARTFORD  CITY BANK                           ALHARTFORD                 YSN20121106
+062102726FIRST COMM BK     FIRST COMMUNITY BANK                ALCHATOM                   N Y20060509
+062102849FIRST DOZIER      FIRST NATIONAL BANK OF DOZIER       ALDOZIER                   Y N        
+062103000FIRST PROG BREWTONFIRST PROGRESSIVE BANK              ALBREWTON                  Y N        
+062103592FST UNITED SEC BK FIRST UNITED SECURITY BANK          ALTHOMASVILLE              Y Y        
+062103864MIDSOUTH BANK     MIDSOUTH BANK                       ALDOTHAN                   Y N20140404
+062104070COMMUNITY B&T AL  COMMUNITY BANK & TRUST ALABAMA      ALUNION SPRINGS            Y Y20100609
+062104274AMERIFIRST BANK   AMERIFIRST BANK                     ALUNION SPRINGS            Y Y20050207
+062104397PEOPLES BANK AL   PEOPLES BANK                        ALRED LEVEL                YSY20130913
+062104520FIRST ATMORE      FIRST NATIONAL BANK AND TRUST       ALATMORE                   Y Y20070111
+062105493SAMSON BKG CO     SAMSON BANKING CO                   ALSAMSON                   Y Y20120727
+062105529BK SOUTH DOTHAN   BANKSOUTH                           ALDOTHAN                   Y N20051128
+062105532BANK EVERGREEN    BANK OF EVERGREEN                   ALEVERGREEN                Y N        
+062105710CITIZENS BANK     THE CITIZENS BANK OF ENTERPRISE     ALENTERPRISE               N Y20121001
+062105778CCB COMMUNITY BK  CCB COMMUNITY BANK                  ALANDALUSIA                Y N20121106
+062106120COMMERCIAL OZARK  THE COMMERCIAL BANK OF OZARK        ALOZARK                    Y Y        
+062106256MERCH BK JACKSON  MERCHANTS BANK                      ALJACKSON                  Y N20121106
+062106557TRINITY BANK AL   TRINITY BANK                        ALDOTHAN                   N Y20110510
+062200961FIRST NATL TALLA  FIRST NATIONAL BANK OF TALLADEGA    ALTALLADEGA                YSY20110315
+062201106PHENIX-GIRARD BK  PHENIX-GIRARD BANK                  ALPHENIX CITY              YSN20121106
+062201342ROBERTSON BK CO   ROBERTSON BANKING CO                ALDEMOPOLIS                N Y20061005
+062201449FNB OF CENTRAL ALAFNB OF CENTRAL ALABAMA              ALTUSCALOOSA               Y Y20140627
+062201533PEOPLES BANK      PEOPLES BANK                        ALGREENSBORO               YSN20040322
+062201559CB&S BANK         CB&S BANK                           ALRUSSELLVILLE             Y Y20080229
+062201711F&M BK LAFAYETTE  FARMERS & MERCHANTS BANK            ALLAFAYETTE                YSN20121106
+062201847LIBERTY BANK      LIBERTY BANK OF GERALDINE           ALGERALDINE                Y N20121106
+062201957PEOPLES CLANTON   PEOPLES SOUTHERN BANK               ALCLANTON                  Y N20121106
+062202147FNB BANK AL       FNB BANK                            ALSCOTTSBORO               Y Y20120203
+062202192MER & FAR EUTAW   MERCHANTS & FARMERS BK OF GREENE CO ALEUTAW                    Y N        
+062202477FIRST STEVENSON   FIRST SOUTHERN STATE BANK           ALSTEVENSON                Y Y20051130
+062202574AUBURNBANK        AUBURNBANK                          ALAUBURN                   Y Y        
+062202859FIRST HAMILTON    FIRST NATIONAL BANK                 ALHAMILTON                 Y Y        
+062202956BANCORPSOUTH BK   BANCORPSOUTH BANK                   ALGUNTERSVILLE             N Y20120412
+062203010PEOPLES BANK      PEOPLES BANK OF ALABAMA             ALCULLMAN                  Y Y20080902
+062203078MERCHANTS BANK    MERCHANTS BANK OF ALABAMA           ALCULLMAN                  YSY20060913
+062203308FST ST BK DEKALB  FIRST STATE BANK OF DEKALB CO       ALFORT PAYNE               Y N20121106
+062203395UNION ST PELL CITYUNION STATE BANK                    ALPELL CITY                Y Y        
+062203476COMM SPIRIT BK    COMMUNITY SPIRIT BANK               ALRED BAY                  N Y20100401
+062203573VALLEY ST BK      VALLEY STATE BANK                   ALRUSSELLVILLE             Y Y        
+062203638FIRST BANK        FIRST BANK                          ALWADLEY                   Y Y20091230
+062203719FIRST BANK OF EVA EVABANK                             ALCULLMAN                  Y N20080214
+062203874BANK OF VERNON    BANK OF VERNON                      ALVERNON                   Y N20121106
+062203890PREMIER BK SOUTH  PREMIER BANK OF THE SOUTH           ALCULLMAN                  Y Y20050323
+062203913BANK OF YORK      BANK OF YORK                        ALYORK                     YSY20070313
+062203942PEOPLES INDEP BANKPEOPLES INDEPENDENT BANK OF BOAZ    ALBOAZ                     Y Y20100715
+062203955FST MET MUSCLE SHLFIRST METRO BANK                    ALMUSCLE SHOALS            Y Y        
+062203984BB&T ALABAMA      BB&T ALABAMA                        ALOXFORD                   Y N20021120
+062204006CADENCE BANK NA   CADENCE BANK, NA                    ALHUNTSVILLE               N Y20111110
+062204019METRO BK PELL CITYMETRO BANK                          ALPELL CITY                Y N20120326
+062204022NORTH ALABAMA BK  NORTH ALABAMA BANK                  ALHAZEL GREEN              Y Y20101007
+062204129EXCHANGE BK       EXCHANGE BANK OF ALABAMA            ALATTALLA                  Y Y20090519
+062204158CITIZENS FAYETTE  CITIZENS BANK OF FAYETTE            ALFAYETTE                  Y Y20080214
+062204530FARMERS & MERC BK FARMERS & MERCHANTS BANK            ALPIEDMONT                 Y N        
+062204569CENTRAL CALERA    CENTRAL STATE BANK                  ALCALERA                   Y Y        
+062205597CITZ VALLEY HEAD  THE CITIZENS BANK OF VALLEY HEAD    ALVALLEY HEAD              Y N        
+062205704WEST ALA BK & TR  WEST ALABAMA BANK & TRUST           ALREFORM                   Y N20120326
+062205791BK IND SHEFF      BANK INDEPENDENT                    ALSHEFFIELD                Y Y        
+062206114PRIMESOUTH BK AL  PRIMESOUTH BANK                     ALTALLASSEE                YSY20100330
+062206295CADENCE BANK      CADENCE BANK, NA                    ALBIRMINGHAM               Y Y20111115
+062206305RELIANCE BANK     RELIANCE BANK                       ALATHENS                   Y N20121106
+0622063921ST COMM BK CNT ALFIRST COMMUNITY BANK OF CENTRAL AL  ALWETUMPKA                 Y N20090727
+062206460BANK OF WALKER CTYBANK OF WALKER COUNTY               ALJASPER                   Y N20081117
+062206486NATL BK COMM AL   NATIONAL BANK OF COMMERCE           ALBIRMINGHAM               Y Y20101101
+062206512BRYANT BANK AL    BRYANT BANK                         ALBIRMINGHAM               Y Y20140418
+062206541NOBLEBANK & TRUST NOBLEBANK & TRUST                   ALANNISTON                 YSN20130627
+062206693PROGRESS BK & TR  PROGRESS BANK & TRUST               ALHUNTSVILLE               N Y20100127
+062292788WORTHINGTON FEDERAWORTHINGTON FEDERAL BANK            ALHUNTSVILLE               Y Y20130215
+063000199FRB JAX           FEDERAL RESERVE BANK OF JACKSONVILLEFLJACKSONVILLE             Y N20121106
+063000225EVERBANK          EVERBANK                            FLJACKSONVILLE             Y Y20040212
+063013924COMPASS BANK      COMPASS BANK                        FLJACKSONVILLE             Y N20010808
+063014499THE JAX BANK      THE JACKSONVILLE BANK               FLJACKSONVILLE             Y N20140402
+063015537AMERICAN ENTRPRISEAMERICAN ENTERPRISE BANK OF FL      FLJACKSONVILLE             Y N20060810
+063092770FIRSTATLANTIC BANKFIRSTATLANTIC BANK                  FLJACKSONVILLE             Y N20090122
+063092958ATL COAST BANK FL ATLANTIC COAST BANK                 FLJACKSONVILLE             YSN20111130
+063100646COLUMBIA CO BK    COLUMBIA  BANK                      FLLAKE CITY                Y Y20060707
+063100688CAPITAL CITY TAL  CAPITAL CITY BANK                   FLTALLAHASSEE              Y Y        
+063101111FARMERS MONTICELLOFARMERS AND MERCHANTS BANK          FLMONTICELLO               Y N20121106
+063103407CITIZENS BK & TR  CITIZENS BANK & TRUST               FLFROSTPROOF               Y Y20050929
+063104312FIRST MT DORA     FIRST NATIONAL BANK                 FLMOUNT DORA               Y Y        
+063104626BRANNEN BANK      BRANNEN BANK                        FLINVERNESS                Y N20121107
+063104927WAUCHULA ST BK    WAUCHULA STATE BANK                 FLWAUCHULA                 Y Y20091207
+063105285UNITED SOUTH BK   UNITED SOUTHERN BANK                FLUMATILLA                 Y Y        
+063105515LAFAYETTE S/B     LAFAYETTE STATE BANK                FLMAYO                     YSN20070629
+063105544CITIZENS FLORIDA  CITIZENS BANK OF FLORIDA            FLOVIEDO                   YSN20121107
+063105654LEVY CTY STATE BK CAPITAL CITY BANK                   FLCHIEFLAND                N Y20030814
+063106352COMMUNITY ST BANK COMMUNITY STATE BANK OF STARKE      FLSTARKE                   Y Y20000524
+063106501CITIZENS STATE    CITIZENS STATE BANK                 FLPERRY                    Y N20121107
+063106705FIRST WAUCHULA    FIRST NATIONAL BANK                 FLWAUCHULA                 Y Y        
+063106734MERCH HAWTHORNE   MERCHANTS AND SOUTHERN BANK         FLGAINESVILLE              Y N20121107
+063108680THE BK OF TAMPA   THE BANK OF TAMPA                   FLTAMPA                    Y Y        
+063108732FST ST BK ARCADIA FIRST STATE BANK OF ARCADIA         FLARCADIA                  Y Y        
+063111169OLD FL BK         OLD FLORIDA BANK                    FLORLANDO                  Y Y20140715
+063111596IBB               IBB                                 FLLAKE MARY                Y Y20090130
+063112142FLORIDA CAPITAL BKFLORIDA CAPITAL BANK, NA            FLJACKSONVILLE             Y Y20111128
+063112155FLORIDA COMM BK NAFLORIDA COMMUNITY BANK, NA          FLWINTER PARK              Y N20110829
+063112249FLORIDA BANK      FLORIDA BANK                        FLTAMPA                    Y Y20081105
+063112362HIGHLAND SEBRING  HIGHLANDS INDEPENDENT BANK          FLSEBRING                  Y Y20000921
+063112728FNB PASCO CNTY    FIRST NATIONAL BANK OF PASCO COUNTY FLDADE CITY                Y Y20121218
+063112786HANCOCK BANK FL   WHITNEY BANK                        FLTALLAHASSEE              Y N20140331
+063112919SOU COMM TAMPA    SOUTHERN COMMERCE BANK              FLTAMPA                    Y Y        
+063112993STEARNS BK SARASTASTEARNS BANK NA                     FLVENICE                   Y N20100129
+063113028PILOT BANK TAMPA  PILOT BANK                          FLTAMPA                    Y Y20050125
+063113523STEARNS BK FL     STEARNS BANK NA                     FLSARASOTA                 Y N20100129
+063113727BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+063113808BANKFIRST         BANKFIRST                           FLWINTER PARK              Y Y20130621
+063113824STONEGATE BK FL   STONEGATE BANK                      FLTAMPA                    Y N20111114
+063113879DRUMMOND COMM BK  DRUMMOND COMMUNITY BANK             FLCHIEFLAND                Y Y        
+063114001CITIZENS FIRST BK CITIZENS FIRST BANK                 FLTHE VILLAGES             Y Y20020501
+063114030CENTERSTATE BK FL CENTERSTATE BANK OF FLORIDA, NA     FLWINTER HAVEN             Y N20100820
+063114137C1 BK FL          C1 BANK                             FLLAKEWOOD RANCH           Y N20120508
+063114289CNLBANK           CNLBANK                             FLORLANDO                  Y Y20020923
+063114328PLATINUM BANK     PLATINUM BANK                       FLBRANDON                  Y Y20121212
+063114360COMM BK TR FL     COMMUNITY BANK & TRUST OF FLORIDA   FLOCALA                    Y N20121102
+063114506FLORIDA CITZ BANK FLORIDA CITIZENS BANK               FLGAINESVILLE              Y Y20021230
+063114551FIRST HOME BK FLA FIRST HOME BANK                     FLSEMINOLE                 Y Y20101119
+063114564CORNERSTONE COMM  CORNERSTONE COMMUNITY BANK          FLST PETERSBURG            Y Y20100803
+063114577FIRST CITRUS BK FLFIRST CITRUS BANK                   FLTAMPA                    Y Y20100512
+063114658CBC NATIONAL BANK CBC NATIONAL BANK                   FLFERNANDINA BEACH         Y Y20090501
+063114661PINNACLE BANK     PINNACLE BANK                       FLORANGE CITY              Y Y20140609
+063114700BAY CITIES BANK   BAY CITIES BANK                     FLLUTZ                     Y Y20130426
+063114797HEARTLAND NATL BK HEARTLAND NATIONAL BANK             FLSEBRING                  Y N20121107
+063114810SEACOAST NATL BK  SEACOAST NATIONAL BANK              FLORLANDO                  Y N20060816
+063114917FST BUSINESS BANK FLORIDA BUSINESS BANK               FLMELBOURNE                Y N20121107
+063114975FRIENDS BANK      FRIENDS BANK                        FLNEW SMYRNA BEACH         Y Y20110531
+063115039CENTURY BANK OF FLCENTURY BANK OF FLORIDA             FLTAMPA                    Y Y20120504
+063115068BANK OF COMMERCE  THE BANK OF COMMERCE                FLSARASOTA                 YSY20090601
+063115136BEACH COMMUNITY BKBEACH COMMUNITY BANK                FLFORT WALTON BEACH        Y Y20100601
+063115149UNITED LGCY BK FL UNITED LEGACY BANK                  FLLONGWOOD                 YSY20110913
+063115408COMM NATL BK & TR COMMERCE NATIONAL BANK & TRUST      FLWINTER PARK              Y Y20140214
+063115686ALARION BANK FL   ALARION BANK                        FLOCALA                    Y N20090727
+063115741FREEDOM BK AM FL  FREEDOM BANK OF AMERICA             FLST PETERSBURG            Y Y20130417
+063115754ORANGE BANK OF FL ORANGE BANK OF FLORIDA              FLORLANDO                  Y N20080616
+063115806SUNRISE BANK      SUNRISE BANK                        FLCOCOA BEACH              Y Y20100803
+063115880FL BK OF COMMERCE FLORIDA BANK OF COMMERCE            FLORLANDO                  Y N20120917
+063115916GATEWAY BANK OF FLGATEWAY BANK OF FLORIDA             FLDAYTONA BEACH            N Y20091223
+063115958FLORIDIAN BANK    FLORIDIAN BANK                      FLORMOND BEACH             Y N20120312
+063116009COMM SOUTHERN BK  COMMUNITY SOUTHERN BANK             FLLAKELAND                 YSY20130918
+063116025AMER MOMENTUM BK  AMERICAN MOMENTUM BANK              TXCOLLEGE STATION          Y N20140116
+063116083SEASIDE NATIONAL  SEASIDE NATIONAL BANK & TRUST       FLORLANDO                  Y Y20090721
+063116148INSIGNIA BANK FL  INSIGNIA BANK                       FLSARASOTA                 Y N20090519
+063116164FIRST AVENUE      FIRST AVENUE NATIONAL BANK          FLOCALA                    Y N20121213
+063116177US AMERIBANK FL   US AMERIBANK                        FLCLEARWATER               Y Y20130604
+063116203CENTRAL BANK      CENTRAL BANK                        FLTAMPA                    Y Y20110407
+063116261BANK OF CENTRAL FLBANK OF CENTRAL FLORIDA             FLLAKELAND                 YSN20130626
+063116274HOMEBANC FL       HOMEBANC, NA                        FLLAKE MARY                Y N20090304
+063116287NORTHSTAR BANK    NORTHSTAR BANK                      FLTAMPA                    Y N20130306
+063116290GATEWAY BANK CTRL GATEWAY BANK OF CENTRAL FLORIDA     FLOCALA                    Y Y20131030
+063116371GULFSHORE BANK    GULFSHORE BANK                      FLTAMPA                    Y Y20121101
+063116410STONEGATE BANK    STONEGATE BANK                      FLPOMPANO BEACH            Y N20140425
+063116436JEFFERSON BANK FL JEFFERSON BANK OF FLORIDA           FLTAMPA                    Y N20130724
+063116452FLORIDA TRAD BK   FLORIDA TRADITIONS BANK             FLDADE CITY                Y N20090721
+063116562INTRACOASTAL BANK INTRACOASTAL BANK                   FLPALM COAST               Y N20101118
+063116588NEW TRADITNS BK FLNEW TRADITIONS BANK                 FLORLANDO                  Y Y20131104
+063116614REUNION BANK OF FLREUNION BANK OF FLORIDA             FLTAVARES                  N Y20131107
+063116672FST GRN BK EUSTIS FIRST GREEN BANK                    FLEUSTIS                   Y N20090217
+063116737CENTERSTATE BK ATLCENTERSTATE BANK OF FLORIDA, NA     FLWINTERHAVEN              Y Y20140530
+063191749RAYMOND JAMES BK  RAYMOND JAMES BANK, NA              FLST PETERSBURG            Y Y20120201
+063192450TRUSTCO BANK      TRUSTCO BANK                        NYSCHENECTADY              Y N20030926
+063192612MEMBERS TR CO     MEMBERS TRUST COMPANY               FLTAMPA                    YSY20120301
+063201435BOB DIV OF FFBF   FIRST FEDERAL BANK OF FLORIDA       FLBONIFAY                  Y N20110204
+063205664FST CITY BANK FLA FIRST CITY BANK OF FLORIDA          FLFORT WALTON BEACH        Y Y20030710
+063206139FIRST NATL CRESTVWFIRST NATIONAL BANK OF CRESTVIEW    FLCRESTVIEW                Y Y20090817
+063206207FIRST FT WALTON   FNBT.COM BANK                       FLFORT WALTON BEACH        Y Y20090824
+063208823BANK OF PENSACOLA BANK OF PENSACOLA                   FLPENSACOLA                Y Y20140414
+063209660PEOPLES GRACEVILLEPEOPLES BANK OF GRACEVILLE          FLGRACEVILLE               Y N        
+063211726FST NATL NW FLA   FIRST NATIONAL BANK OF NW FLORIDA   FLPANAMA CITY              YSN20140311
+063212631PEOPLES NAT BANK  PEOPLES NATIONAL BANK               FLNICEVILLE                Y Y        
+063216187FIRST FLORIDA BANKFIRST FLORIDA BANK                  FLDESTIN                   Y Y20100715
+063216543SUMMIT BANK, N. A.SUMMIT BANK, NA                     FLPANAMA CITY              Y Y20140602
+063216608RBC BANK (GA) NA  RBC BANK (GEORGIA), NA              GAATLANTA                  YSY20120228
+063292855ONE SOUTH BANK FL ONE SOUTH BANK                      FLCHIPLEY                  YSN20140214
+064000059US BANK TENNESSEE US BANK, NA                         TNNASHVILLE                Y N20130627
+064000185CITIZENS NASHVILLECITIZENS SAVINGS BANK & TRUST CO    TNNASHVILLE                Y Y20120322
+064008611INSBANK           INSBANK                             TNNASHVILLE                Y N20070402
+064008637PINNACLE BANK TN  PINNACLE BANK                       TNNASHVILLE                Y Y20120905
+064008912TRUXTON TRUST TN  TRUXTON TRUST COMPANY               TNNASHVILLE                Y N20130610
+064008983CIVIC BK AND TRUSTCIVIC BANK & TRUST                  TNNASHVILLE                Y N20070215
+064009241AVENUE BANK TN    AVENUE BANK                         TNNASHVILLE                Y Y20100803
+064009380CAPSTAR BANK      CAPSTAR BANK                        TNNASHVILLE                Y Y20091223
+064009432CAPSTAR BANK      CAPSTAR BANK                        TNNASHVILLE                Y N20111104
+064009445CITY NATL BK TN   CITY NATIONAL BANK                  TNNASHVILLE                Y N20110615
+064101233FNB TENN          FIRST NAT'L BANK OF TENNESSEE       TNLIVINGSTON               Y Y20040802
+064101301TRADERS TULLAHOMA TRADERS BANK                        TNTULLAHOMA                YSN20140314
+064101576US BANK NA TN     US BANK, NA                         TNKNOXVILLE                Y N20130627
+064101589FST MCMINNVILLE TNFIRST NATIONAL BANK OF MCMINNVILLE  TNMCMINNVILLE              Y Y20111006
+064101660CITIZENS STATE    CITIZENS STATE BANK                 TNJASPER                   Y Y        
+064102070CITIZENS BANK     CITIZENS TRI-COUNTY BANK            TNDUNLAP                   Y N20121107
+064102371FST MANCHESTER TN FIRST NATIONAL BANK                 TNMANCHESTER               YSY20050602
+064102384PEOPLES BK AND TR PEOPLES BANK & TRUST COMPANY        TNMANCHESTER               YSY20050630
+064102737AMER CITY TULL    AMERICAN CITY BANK                  TNTULLAHOMA                YSY20110829
+064102902COFFEE CNTY BK TN COFFEE COUNTY BANK                  TNMANCHESTER               Y N20090708
+064102944FIRST FARMERS     FIRST FARMERS AND COMMERCIAL BANK   TNPIKEVILLE                Y N20140603
+064102999VOLUNTEER ST PORTLVOLUNTEER STATE BANK                TNPORTLAND                 Y Y        
+064103105PEOPLES PICKETT   PEOPLES BK & TR CO OF PICKETT CO    TNBYRDSTOWN                N Y20110808
+064103367F&M BANK          F&M BANK                            TNCLARKSVILLE              Y Y20050802
+064103464PEOPLES BANK      PEOPLES BANK                        TNCLIFTON                  Y Y        
+064103529WILSON BK & TRUST WILSON BANK & TRUST                 TNLEBANON                  Y Y        
+064103671FST COMM BANK     FIRST COMMUNITY BANK OF BEDFORD CO  TNSHELBYVILLE              Y N20121108
+064103697MOUNTAIN VAL BANK MOUNTAIN VALLEY BANK                TNDUNLAP                   Y Y20130103
+064104036CITIZENS COMM BK  CITIZENS COMMUNITY BANK             TNWINCHESTER               Y N20060308
+064104052UNION BANK        UNION BANK                          TNJAMESTOWN                N Y20060915
+064104311BK OF PUTNAM CO   BANK OF PUTNAM COUNTY               TNCOOKEVILLE               Y N20121108
+064104887BK OF WAYNESBORO  BANK OF WAYNESBORO                  TNWAYNESBORO               YSN20121024
+064105336FARMERS PORTLAND  THE FARMERS BANK                    TNPORTLAND                 Y N20121108
+064105666UNION B & T CO    UNION BANK & TRUST COMPANY          TNLIVINGSTON               N Y        
+064105734WCB               WAYNE COUNTY BANK                   TNWAYNESBORO               YSY20060717
+064106283MACON B&T CO      MACON BANK AND TRUST COMPANY        TNLAFAYETTE                N Y20120322
+064106775BK OF FRANKEWING  BANK OF FRANKEWING                  TNFRANKEWING               Y Y19991122
+064107729CITIZENS BK       CITIZENS BANK                       TNCARTHAGE                 N Y        
+064107994FIRST NB PULASKI  FIRST NATIONAL BANK OF PULASKI      TNPULASKI                  Y Y20100609
+064108113FST FARM COLUMBIA FIRST FARMERS & MERCHANTS BANK      TNCOLUMBIA                 Y Y20050705
+064108236BANK OF DICKSON   BANK OF DICKSON                     TNDICKSON                  Y Y20090416
+064108346CUMBERLAND CO     CUMBERLAND COUNTY BANK              TNCROSSVILLE               Y N        
+064108443LEGENDS BANK      LEGENDS BANK                        TNCLARKSVILLE              Y Y20060313
+064108485COMMUNITY FIRST BKCOMMUNITY FIRST BANK & TRUST        TNCOLUMBIA                 Y Y20111017
+064108524COMMUNITY BK & TR COMMUNITY BANK & TRUST              TNASHLAND CITY             N Y20100223
+064108553TRISTAR BANK TN   TRISTAR BANK                        TNDICKSON                  N Y20091123
+064108663CUMBERLAND B&T TN CUMBERLAND BANK AND TRUST           TNCLARKSVILLE              YSY20110812
+064108786BK LINCOLN COUNTY BANK OF LINCOLN COUNTY              TNFAYETTEVILLE             YSY20101018
+064108799FIRST COMMERCE BK FIRST COMMERCE BANK                 TNLEWISBURG                Y N20090727
+064108838HOMELAND COMM BK  HOMELAND COMMUNITY BANK             TNMC MINNVILLE             Y N20100803
+064108951SUMNER BK & TR    SUMNER BANK & TRUST                 TNGALLATIN                 N Y20080204
+064109044RELIANT BANK      RELIANT BANK                        TNFRANKLIN                 Y Y20101206
+064109057HERITAGE BK & TRSTHERITAGE BANK & TRUST               TNCOLUMBIA                 N Y20090602
+064109073PEOPLE ST BK CM TNPEOPLES STATE BANK OF COMMERCE      TNNOLENSVILLE              N Y20120430
+064109086FIRST FREEDOM BK  FIRST FREEDOM BANK                  TNLEBANON                  Y N20090722
+064109167COMMERCE UNION    COMMERCE UNION BANK                 TNSPRINGFIELD              Y Y20090722
+064109329FRANKLIN SYNERGY  FRANKLIN SYNERGY BANK               TNFRANKLIN                 Y Y20080807
+064201120COMMUNITY NATL BK COMMUNITY NATIONAL BANK             TNDAYTON                   Y Y20090220
+064201243FIRST NATIONAL BK FIRST NATIONAL BANK OF LOUDON COUNTYTNLENOIR CITY              Y Y        
+064201612CITIZENS ATHENS   CITIZENS NATIONAL BANK              TNATHENS                   Y Y        
+064201845UBANK             UBANK                               TNJELLICO                  Y N20131209
+064201968TSBPF             TENNESSEE STATE BANK                TNGATLINBURG               Y Y        
+064202255CITZ SEVIERVILLE  CITIZENS NATIONAL BANK              TNSEVIERVILLE              Y Y        
+064202268MOUNTAIN COMM BK  MOUNTAIN COMMERCE BANK              TNERWIN                    Y Y20060906
+064202501CITIZENS BLOUNT   CITIZENS BANK OF BLOUNT COUNTY      TNMARYVILLE                Y Y        
+064202705BK OF TN KINGSPRT BANK OF TENNESSEE                   TNKINGSPORT                Y Y        
+064202763SEVIER CNTY BK    SEVIER COUNTY BANK                  TNSEVIERVILLE              Y Y        
+064202828FST PEO JEFF CITY FIRST PEOPLES BANK                  TNJEFFERSON CITY           Y N20121120
+064202844FARMERS STATE BK  FARMERS STATE BANK                  TNMOUNTAIN CITY            Y N20121120
+064202860ANDREW JOHNSON BK ANDREW JOHNSON BANK                 TNGREENEVILLE              Y Y20070322
+064202873JOHNSON CO BANK   JOHNSON COUNTY BANK                 TNMOUNTAIN CITY            Y N20060308
+064202967CBS               CIVIS BANK                          TNSNEEDVILLE               Y Y20131216
+064202983COMM BK HARROGATE COMMERCIAL BANK                     TNHARROGATE                Y Y        
+064203513BK OF CLEVELAND   BANK OF CLEVELAND                   TNCLEVELAND                Y Y        
+064204075FIRST COMMUNITY   FIRST COMMUNITY BANK OF EAST TN     TNROGERSVILLE              Y N20121120
+064204253TNBANK OAKRIDGE TNTNBANK                              TNOAK RIDGE                YSY20090824
+064204402CITIZN FIRST BK TNCITIZENS FIRST BANK                 TNWARTBURG                 N Y20080828
+064204444FIRST ONEIDA      FIRST NATIONAL BANK OF ONEIDA       TNONEIDA                   Y Y        
+064204703FST BK OF TENN    FIRST BANK OF TENNESSEE             TNSPRING CITY              Y N20121120
+064204774FIRST CENTURY BK  FIRST CENTURY BANK                  TNTAZEWELL                 Y Y20030116
+064205388CITIZENS BK       CITIZENS BANK                       TNNEW TAZEWELL             Y Y        
+064206031PEOPLES BK SOUTH  PEOPLES BANK OF THE SOUTH           TNLA FOLLETTE              Y N20121120
+064206426CITZ BK AND TRUST CITIZENS BK & TR CO OF GRAINGER CO  TNRUTLEDGE                 Y Y20111005
+064207946CITZ ELIZABETHTON CITIZENS BANK                       TNELIZABETHTON             Y Y        
+064208013CCB DIV OF BK TN  CARTER COUNTY BANK A DIV OF BK OF TNTNELIZABETHTON             Y N20130315
+064208165BB&T TENNESSEE    BB&T TENNESSEE                      TNGATLINBURG               Y N20021120
+064208327NATL NEWPORT      NATIONAL BANK OF TENNESSEE          TNNEWPORT                  Y Y20021003
+064208398PEOPLES EAST TENN PEOPLES BANK OF EAST TENNESSEE      TNMADISONVILLE             Y N20040623
+064208453SOUTHERN BK OF TN SOUTHERN BANK OF TENNESSEE          TNMOUNT JULIET             Y Y20121203
+064208479SOUTHERN HERIT BK SOUTHERN HERITAGE BANK              TNCLEVELAND                Y N20111012
+064208518COMM TR & BK CO   COMMUNITY TRUST AND BANKING COMPANY TNOOLTEWAH                 Y Y20091103
+064208725BK OF GREENEVILLE AMERICAN PATRIOT BANK               TNGREENVILLE               Y Y20100709
+064208767SOUTHEAST BANK  TNSOUTHEAST BANK                      TNATHENS                   Y N20130612
+064208806TRISUMMIT BANK    TRISUMMIT BANK                      TNMORRISTON                YSN20140630
+064208848HERITAGE COMM BANKHERITAGE COMMUNITY BANK             TNGREENEVILLE              N Y20061106
+064208893AM TRST BK EST TN AMERICAN TRUST BANK OF EAST TN      TNKNOXVILLE                YSN20120613
+064209180FOOTHILLS B&T     FOOTHILLS BANK & TRUST              TNMARYVILLE                Y N20070604
+064209216SMARTBANK         SMARTBANK                           TNPIGEON FORGE             Y N20090728
+064209232CLAYTON B&T TN    CLAYTON BANK AND TRUST              TNKNOXVILLE                YSN20110714
+065000090CAPITAL ONE, NA   CAPITAL ONE, NATIONAL ASSOCIATION   LANEW ORLEANS              Y N20071108
+065000171WHITNEY BANK      WHITNEY BANK                        MSGULFPORT                 Y N20140423
+065000210FRB NEW ORL       FEDERAL RESERVE BANK OF NEW ORLEANS LANEW ORLEANS              Y N20121120
+065000731BK OF LOUISIANA NOBANK OF LOUISIANA                   LAMETAIRIE                 Y Y        
+065002108LIBERTY NO        LIBERTY BANK & TRUST COMPANY        LANEW ORLEANS              Y Y        
+065002289FIRST BK AND TRUSTFIRST BANK AND TRUST                LANEW ORLEANS              Y Y20081224
+065003592CRESCENT B&T      CRESCENT BANK & TRUST               LANEW ORLEANS              Y Y19990604
+065005435FIRST NBC BK      FIRST NBC BANK                      LANEW ORLEANS              YSN20120926
+065102833COMMONWEALTH MOB  COMMONWEALTH NATIONAL BANK          ALMOBILE                   Y Y        
+065103243CITZ ROBERTSDALE  CITIZENS BANK,INC.                  ALROBERTSDALE              YSY20061124
+065106321THE FST HATTIESBRGTHE FIRST, A NATIONAL BANKING ASSOC MSHATTIESBURG              Y Y20140630
+065106619HANCOCK BANK      WHITNEY BANK                        ALMOBILE                   Y N20140331
+065200340NEW IBERIA NATL   REGIONS BANK                        LANEW IBERIA               N Y20030814
+065200515FST NATL BK       FIRST NATIONAL BANK OF LOUISIANA    LACROWLEY                  Y Y20031210
+065200528BK COMM CROWLEY   BANK OF COMMERCE & TRUST            LACROWLEY                  Y Y20031113
+065200612ST LANDRYOPELOUSASST LANDRY BANK AND TRUST            LAOPELOUSAS                YSN20121128
+065200803PATTERSON ST BK   PATTERSON STATE BANK                LAPATTERSON                YSY        
+065200861BANK OF ABB & TR  BANK OF ABBEVILLE & TRUST COMPANY   LAABBEVILLE                Y Y20080214
+065200926ST MARTIN B&T     ST MARTIN BANK & TRUST              LAST MARTINVLLE            Y Y20100803
+065200984FRST NB JEANERETTEFIRST NATIONAL BANK OF JEANERETTE   LAJEANERETTE               Y N20121128
+065200997FIRST DERIDDER    FIRST NATIONAL BANK                 LADERIDDER                 YSN20121128
+065201255THE UNION BK LA   UNION BANK                          LAMARKSVILLE               Y Y20130612
+065201530FARMERS MERCH B&T FARMERS-MERCHANTS BANK & TRUST CO   LABREAUX BRIDGE            N Y        
+065201624CLINTON B&T       LANDMARK BANK                       LACLINTON                  YSY20050606
+065201666COTTONPORT BANK   COTTONPORT BANK                     LACOTTONPORT               Y Y        
+065201750BK OF ERATH       BANK OF ERATH                       LAERATH                    N Y20130103
+065201831BK GUEYDAN        BANK OF GUEYDAN                     LAGUEYDAN                  Y N20121128
+065202393BK OF SUNSET & TRSBANK OF SUNSET &  TRUST             LASUNSET                   N Y20090702
+065202445EVANG VILLE PLATTETHE EVANGELINE BK & TR CO           LAVILLE PLATTE             Y Y        
+065202461WASHINGTON ST BANKWASHINGTON STATE BANK               LAWASHINGTON               YSY20090512
+065202555CIT BK OF VIL PLT CITIZENS BANK OF VILLE PLATTE       LAVILLE PLATTE             N Y        
+065202607STATE BANK & TRUSTSTATE BANK AND TRUST COMPANY        LABATON ROUGE              Y N20120412
+065202830PEOPLES NEW ROADS THE PEOPLES BANK AND TRUST COMPANY  LANEW ROADS                Y Y        
+065203431MIDSOUTH NATL LAF MIDSOUTH BANK, NA                   LALAFAYETTE                Y Y20120322
+065203499FARMERS CHURCH PT FARMERS STATE BANK & TRUST          LACHURCH POINT             YSY20070404
+065203648THE BANK          THE BANK                            LAJENNINGS                 N Y        
+065204184CITY SB&T         CITY SAVINGS BANK & TRUST COMPANY   LADERIDDER                 Y N20121128
+065204197KAPLAN ST BK      KAPLAN STATE BANK                   LAKAPLAN                   N Y        
+065204249MER FARM B & T    MERCHANTS & FARMERS BK & TR CO      LALEESVILLE                N Y        
+065204443RAYNE ST BK & TR  RAYNE STATE BANK AND TRUST          LARAYNE                    Y Y        
+065204472TRI PARISH EUNICE TRI-PARISH BANK                     LAEUNICE                   Y Y20070924
+065204579JD BANK           JD BANK                             LAJENNINGS                 N Y20130830
+065204951SIMMESPORT ST BK  SIMMESPORT STATE BANK               LASIMMESPORT               YSY20111230
+065204980GUARANTY NEW ROADSGUARANTY BANK AND TRUST COMPANY     LANEW ROADS                N Y20050929
+065204993VERM B&T          VERMILION BANK & TRUST COMPANY      LAKAPLAN                   N Y        
+065205264RED RIVER BANK    RED RIVER BANK                      LAALEXANDRIA               Y Y20051117
+065300211CITZ NB MERIDIAN  CITIZENS NATIONAL BANK OF MERIDIAN  MSMERIDIAN                 Y Y20100609
+065300279TRUSTMARK JACKSON TRUSTMARK NATIONAL BANK             MSJACKSON                  Y Y        
+065300486BANCORPSOUTH BK   BANCORPSOUTH BANK                   MSTUPELO                   Y N        
+065300790BANK OF YAZOO CITYBANK OF YAZOO CITY                  MSYAZOO CITY               Y Y        
+065301061STATE BK BROOKHAVNSTATE BANK AND TRUST COMPANY        MSBROOKHAVEN               Y N20030814
+065301362MERC & MARINE PASGMERCHANTS & MARINE BANK             MSPASCAGOULA               YSY20060929
+065301540FIRST LUCEDALE    COMMUNITY BANK, COAST               MSLUCEDALE                 N Y20110812
+065301566COMM BK ELLIS     COMMUNITY BANK, ELLISVILLE, MS      MSELLISVILLE               Y Y        
+065301744COPIAH BANK       COPIAH BANK NA                      MSHAZLEHURST               Y Y        
+065301854OMNI BANK MS      OMNI BANK                           MSBAY SPRINGS              Y Y20130117
+065301883UNITEDMSBK NATCHEZUNITED MISSISSIPPI BANK             MSNATCHEZ                  Y Y20120302
+065301948BANKPLUS          BANKPLUS                            MSYAZOO CITY               Y N20040624
+065302154CITZ PHILA MS     THE CITIZENS BANK OF PHILADELPHIA   MSPHILADELPHIA             Y Y20080214
+065302183BK FOREST         BANK OF FOREST                      MSFOREST                   N Y20070817
+065302196COMMUNITY OF MISS COMMUNITY BANK OF MISSISSIPPI       MSFOREST                   Y Y20100527
+065302468MAGNOLIA STATE BK MAGNOLIA STATE BANK                 MSBAY SPRINGS              Y N20121128
+065302484COV CO BANK       COVINGTON COUNTY BANK               MSCOLLINS                  YSN20060606
+065302633METROPOLITAN BK   METROPOLITAN BANK                   MSCRYSTAL SPRINGS          Y Y20090218
+065302675BK OF ANGUILLA    BANK OF ANGUILLA                    MSANGUILLA                 Y Y        
+065302963COMM DEKALB MS    THE COMMERCIAL BANK                 MSDE KALB                  YSN20121128
+065303056JEFFERSON BK MS   THE JEFFERSON BANK                  MSFAYETTE                  Y Y20100610
+065303124PIKE NATL         PIKE NATIONAL BANK                  MSMCCOMB                   YSY20070214
+065303328CENTURY LUCEDALE  CENTURY BANK                        MSLUCEDALE                 Y Y        
+065303360THE FST HATTIESBRGTHE FIRST, A NATIONAL BANKING ASSOC MSHATTIESBURG              Y N20040129
+065303386FIRST BK MCCOMB   FIRST BANK                          MSMCCOMB                   Y Y        
+065303467PEOPLES MENDENHALLPEOPLES BANK                        MSMENDENHALL               Y Y        
+065303784GREAT SO MERIDIAN GREAT SOUTHERN NATIONAL BANK        MSMERIDIAN                 Y N20121128
+065304084FST ST WAYNESBORO FIRST STATE BANK                    MSWAYNESBORO               Y Y        
+065304327BK OF FRANKLIN    BANK OF FRANKLIN                    MSMEADVILLE                Y Y        
+065304369NEWTON COUNTY BK  NEWTON COUNTY BANK                  MSNEWTON                   YSY        
+065304385CITIZENS COLUMBIA CITIZENS BANK                       MSCOLUMBIA                 Y N20120926
+065305902UNION PLANT CENTRLREGIONS BANK                        MSJACKSON                  Y N20050811
+065306079BANK OF JONES CTY BANK OF JONES COUNTY                MSLAUREL                   N Y20051201
+065306192FIRST NATL BKRS BKFIRST NATIONAL BANKER'S BANK        MSRIDGELAND                Y N20110729
+065382514HOPE FEDERAL CU MSHOPE FEDERAL CREDIT UNION           MSJACKSON                  Y N20120110
+065400137JPMCHASE LOUISIANAJPMORGAN CHASE BANK, NA             LABATON ROUGE              Y N20050509
+065400153HANCOCK BATONROUGEWHITNEY BANK                        LABATON ROUGE              Y Y20120315
+065400483MORG CO B&T       M C BANK & TRUST COMPANY            LAMORGAN CITY              N Y        
+065400603IBERVILLE BANK    IBERVILLE BANK                      LAPLAQUEMINE               N Y20040818
+065401673S LAFOURCHE B&T   S LAFOURCHE BK & TR CO              LALAROSE                   Y Y19990831
+065402290AMER GATEWAY BK   AMERICAN GATEWAY BANK               LABATON ROUGE              Y Y20050420
+065402423FIRST AMER VACHERIFIRST AMERICAN BANK & TRUST         LAVACHERIE                 Y Y        
+065402517FST NATL BK USA   FIRST NATIONAL BANK USA             LABOUTTE                   Y Y20100730
+065402520BK ZACHARY        BANK OF ZACHARY                     LAZACHARY                  YSY        
+065402850MISS RVR BK       MISSISSIPPI RIVER BANK              LABELLE CHASSE             Y N20121128
+065402889SO LA HOUMA       SOUTH LOUISIANA BANK                LAHOUMA                    Y Y        
+065403150CITIZENS PLAQUEMINCITIZENS BANK & TRUST               LAPLAQUEMINE               YSY20070309
+065403370FST NAT BANKERS BKFIRST NATIONAL BANKER'S BANK        LABATON ROUGE              Y Y20090626
+065404340FIRST GTY HAMMOND FIRST GUARANTY BANK                 LAHAMMOND                  Y Y        
+065404544CITIZENS BANK & TRCITIZENS BANK & TRUST CO            LACOVINGTON                Y Y        
+065404560METAIRIE BK & TR  METAIRIE BANK & TRUST               LAMETAIRIE                 Y Y19991210
+065405242RESOURCE BANK     RESOURCE BANK                       LACOVINGTON                Y N20081016
+065405284COASTAL COMM BANK COASTAL COMMERCE BANK               LAHOUMA                    Y Y20090226
+065405297SYNERGY BANK      SYNERGY BANK                        LAHOUMA                    Y N20140219
+065405404AM BK TRST LA     AMERICAN BANK AND TRUST COMPANY, INCLACOVINGTON                Y N20090126
+065500752PEOPLES BILOXI    PEOPLES BANK                        MSBILOXI                   Y Y        
+065503348COMM BANK, COAST  COMMUNITY BANK, COAST               MSBILOXI                   Y N20121206
+065503681HANCOCK GULFPORT  WHITNEY BANK                        MSGULFPORT                 Y Y20140331
+065505870FIRST PICAYUNE    FIRST NATIONAL BANK OF PICAYUNE     MSPICAYUNE                 Y Y        
+066000109FRB MIAMI         FEDERAL RESERVE BANK OF MIAMI       FLDORAL                    Y N20121211
+066004367CITY NB OF FLA    CITY NATL BK FLORIDA                FLMIAMI                    Y Y        
+066004600COCNUT GROVE MIA  COCONUT GROVE BANK                  FLMIAMI                    Y Y        
+066007720CITIBANK INTL MIA CITIBANK, N.A.                      FLMIAMI                    YSN20120622
+066008004BK OF BOS INTL    BANCO ITAU EUROPA INTERNATIONAL     FLMIAMI                    Y N20080402
+066009029ESPIRITO SANTO BK ESPIRITO SANTO BANK                 FLMIAMI                    Y Y19990825
+066009155TOTALBANK MIA     TOTALBANK                           FLMIAMI                    Y Y20080214
+066009456CONTL NATL        CONTINENTAL NATIONAL BANK           FLMIAMI                    Y Y20140212
+066009650NORTHERN TR FLA   THE NORTHERN TRUST COMPANY          ILCHICAGO                  Y N20121004
+066010445HSBC PRIVATE INTL HSBC PRIVATE BANK INTERNATIONAL     FLMIAMI                    Y N20040109
+066010490BANCO DO BRASIL   BANCO DO BRASIL                     FLMIAMI                    Y N        
+066010597BCO SANTAN CEN HISBANCO SANTANDER INTERNATIONAL       FLMIAMI                    Y N20040408
+066010678BCO NACION ARG MIABANCO DE LA NACION ARGENTINA        FLMIAMI                    Y N        
+066010694ROYAL BK CAN MIA  ROYAL BANK OF CANADA                FLMIAMI                    Y N        
+066010720BCO BOGOTA INT MIABANCO DE BOGOTA MIAMI AGENCY        FLMIAMI                    Y N20010928
+066010801BCO IND VENEZUELA BANCO INDUSTRIAL DE VENEZUELA C A   FLMIAMI                    Y Y20130722
+066010869BANCO ITAU EUROPA BANCO ITAU EUROPA INTERNATIONAL     FLMIAMI                    Y N20070606
+066011017CREDIT AGRIC MIAMICREDIT AGRICOLE CIB MIAMI BRANCH    FLMIAMI                    Y N20100209
+066011350PACIFIC MIAMI     PACIFIC NATIONAL BANK               FLMIAMI                    Y Y        
+066011376BCO MERCANTIL C.A.MERCANTIL, C.A. (BCO UNIVERSAL)     FLCORAL GABLES             Y N20080211
+066011389BCO DAVIVIENDA SA BANCO DAVIVIENDA SA                 FLMIAMI                    Y Y20110316
+066011392OCEAN BANK MIA    OCEAN BANK                          FLMIAMI                    Y N20121206
+066011567BCO INTL DE COSTA BANCO INTERNACIONAL DE COSTA RICA SAFLCORAL GABLES             Y N20100217
+066011570BNP PARIBAS       BNP PARIBAS                         FLMIAMI                    Y N20000627
+066012207AMERICAN EXP INT  STANDARD CHARTER BK INT(AMERICAS)LTDFLMIAMI                    Y N20080819
+066012333TERRABANK         TERRABANK, N.A.                     FLCORAL GABLES             Y Y20100803
+066012760DELTA MIAMI       DELTA NATIONAL BANK & TRUST COMPANY FLMIAMI                    Y N20030821
+066012867BCO DEL PICHINCHA BANCO DEL PICHINCHA INTL            FLCORAL GABLES             Y Y20130301
+066014069BCO SABADELL MIA  BANCO DE SABADELL, S.A.             FLMIAMI                    Y N        
+066014085CITIBANK (BDC MMI)CITIBANK, N.A.                      FLMIAMI                    Y N20120622
+066014153FIRST BK MIAMI    FIRST BANK OF MIAMI                 FLCORAL GABLES             Y Y20090727
+066014409BANKIA SA MIAMI BRBANKIA SA (MIAMI BRANCH)            FLMIAMI                    N Y20131223
+066014726BNCO DE CREDITO INBANCO DE CREDITO E INVERSIONES      FLMIAMI                    Y N19990518
+066015084APOLLO BANK FL    APOLLO BANK                         FLMIAMI                    Y N20110609
+066015181PLUS INTL BANK    PLUS INTERNATIONAL BANK             FLMIAMI                    Y N20020710
+066015217TOTALBANK         TOTALBANK                           FLMIAMI                    Y N20130214
+066015440INTERAUDI BANK    INTERAUDI BANK                      FLMIAMI                    Y N20030502
+066015576FL COMMUNITY BK   FLORIDA COMMUNITY BANK, NA          FLMIAMI                    Y N20140205
+066015767BISCAYNE BK FL    BISCAYNE BANK                       FLCOCONUT GROVE            Y Y20090505
+066015932BARCLAYS BANK PLC BARCLAYS BANK PLC                   FLMIAMI                    Y N20060213
+066016054BANCO DE SABADELL BANCO DE SABADELL, S.A.             FLMIAMI                    Y N20120626
+066016711BK NOVA SCOTIA FL BANK OF NOVIA SCOTIA                FLMIAMI                    Y N20090813
+066016766FLORIDA COMM BK NAFLORIDA COMMUNITY BANK, NA          FLWESTON                   Y N20120214
+066016782BANRISUL SA       BANCO DO ESTADO DO RIO GRANDE DO SULFLMIAMI                    Y N20120312
+067000438FST ST BK FL KEY  FIRST STATE BANK OF THE FLORIDA KEYSFLKEY WEST                 Y Y        
+067001246BK OF BELLE GLADE THE BANK OF BELLE GLADE             FLBELLE GLADE              Y N20140723
+067001275BANCO POPULAR-N AMBANCO POPULAR NORTH AMERICA         FLMIAMI LAKES              Y N20050603
+067002533EASTERN NATL BK   EASTERN NATIONAL BANK               FLMIAMI                    Y Y        
+067003778FIRST BK          FIRST BANK                          FLCLEWISTON                YSN20070508
+067003927FLORIDA COMM BK   FLORIDA COMMUNITY BANK, NA          FLIMMOKALEE                Y N20110919
+067005145FST NATL BK S FL  1ST NATIONAL BANK OF SOUTH FLORIDA  FLHOMESTEAD                Y Y20010531
+067005158SEACOAST NATL BANKSEACOAST NATIONAL BANK              FLSTUART                   Y Y20060504
+067005873FIRST SO MIAMI    FIRST NATL BANK OF SOUTH MIAMI      FLSOUTH MIAMI              Y Y        
+067006775HARBOR COMMUNITY  HARBOR COMMUNITY BANK               FLINDIANTOWN               Y Y20110801
+067008155EXECUTIVE NATL MIAEXECUTIVE NATIONAL BANK             FLMIAMI                    Y Y        
+067008414UNION PLANTERS FLAREGIONS BANK                        FLMIAMI                    Y N20050811
+067008993COMMUNITY BK OF FLCOMMUNITY BANK OF FLORIDA           FLHOMESTEAD                Y Y        
+067009044BAC FLORIDA BANK  BAC FLORIDA BANK                    FLCORAL GABLES             Y Y20100831
+067009280CAPITAL BANK NA FLCAPITAL BANK, NA                    FLKEY LARGO                Y N20110630
+067009390SAFRABANK MIA     HSBC BANK USA, NA                   FLMIAMI                    Y N20120620
+067009646SABADEL UNTD BK FLSABADELL UNITED BANK, NA            FLMIAMI                    Y Y20140711
+067009785LANDMARK BANK     LANDMARK BANK, NA                   FLFORT LAUDERDALE          Y Y20140623
+067010509COMM BK MIA       MERCANTIL COMMERCEBANK, NA          FLDORAL                    Y Y20120322
+067010635BK HAPOALIM MIABCHBANK HAPOALIM                       FLAVENTURA                 Y N20050616
+067011456HELM BANK USA MIA HELM BANK USA                       FLMIAMI                    Y N20090827
+067011692INTL FINANCE BANK INTERNATIONAL FINANCE BANK          FLMIAMI                    Y Y        
+067011760CAPITAL BANK NA FLCAPITAL BANK, NA                    FLMIAMI                    Y Y20111005
+067011812INTERCREDIT BK NA INTERCREDIT BANK  N A               FLMIAMI                    Y Y19991222
+067011977AMERICAN OAKLANDPKAMERICAN NATIONAL BANK              FLFORT LAUDERDALE          Y N20121206
+067012099COMERICA BK & TR  COMERICA BANK                       FLBOCA RATON               Y N20030815
+067012413REGENT DAVIE      REGENT BANK                         FLDAVIE                    Y Y        
+067012471BANK LEUMI USA    BANK LEUMI USA                      FLBOCA RATON               Y N20021217
+067012484FLORIDA COMM BK   FLORIDA COMMUNITY BANK, NA          FLENGLEWOOD                Y N20110818
+067012688BANCO DO BRASIL AMBANCO DO BRASIL AMERICAS            FLMIAMI                    Y N20130412
+067012895FST SOUTHERN BK   FIRST SOUTHERN BANK                 FLWINTERHAVEN              Y Y20140530
+067013179CHARLOTTE ST BK TRCHARLOTTE STATE BANK AND TRUST      FLPORT CHARLOTTE           Y Y20111230
+067013247ENGLWD BK & TR    ENGLEWOOD BANK & TRUST              FLENGLEWOOD                Y Y20111230
+067014071AMERASIA BANK     AMERASIA BANK                       FLMIAMI                    Y N20140128
+067014246MARINE BK & TR    MARINE BANK AND TRUST COMPANY       FLVERO BEACH               Y N20021031
+067014291FIRST AMERICAN BNKFIRST AMERICAN BANK                 FLNAPLES                   Y N20100322
+067014301EDISON NATL BANK  EDISON NATIONAL BANK                FLFORT MYERS               Y Y20011205
+067014330BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20120328
+067014453LANDMARK BANK, N.ALANDMARK BANK NA                    FLFORT LAUDERDALE          Y Y20100511
+067014466GRAND BK & TR     GRAND BANK & TRUST OF FLORIDA       FLWEST PALM BEACH          Y Y20011024
+067014592SUNSTATE BANK     SUNSTATE BANK                       FLMIAMI                    Y N20121206
+067014615FLORIDA COMM BK NAFLORIDA COMMUNITY BANK, NA          FLJENSEN BEACH             Y N20120201
+067014738TRANSCAPITAL FL   TRANSCAPITAL BANK                   FLHALLANDALE BEACH         Y Y20101028
+067014822TD BANK, NA       TD BANK, NA                         FLWEST PALM BEACH          Y N20090925
+067014961FLAGLER BANK      FLAGLER BANK                        FLWEST PALM BEACH          Y Y20090901
+067014987FST UNITED BANK   1ST UNITED BANK                     FLBOCA RATON               Y Y20100831
+067015096OPTIMUMBANK FL    OPTIMUMBANK                         FLPLANTATION               Y N20120125
+067015164FLORIDA COMM BK   FLORIDA COMMUNITY BANK, NA          FLMIAMI                    Y N20110818
+067015232ISRAEL DISC BK MIAISRAEL DISCOUNT BANK OF NEW YORK    FLAVENTURA                 Y N20121213
+067015258COMM BK BROWARD COCOMMUNITY BANK OF BROWARD COUNTY    FLDANIA BEACH              Y Y20120313
+067015274NTERCONTNTAL BK   INTERCONTINENTAL BANK               FLWEST MIAMI               Y N20100803
+067015287SANIBEL CAP COM BKSANIBEL CAPTIVA COMMUNITY BANK      FLFORT MYERS               Y Y20100803
+067015313FLORIDIAN COMM BK FLORIDIAN COMMUNITY BANK            FLDAVIE                    Y Y20101122
+067015355BANCO DE CREDITO  BANCO DE CREDITO DEL PERU           FLCORAL GABLES             Y N20100122
+067015397US CENTURY BANK   U.S. CENTURY BANK                   FLMIAMI                    Y N20021028
+067015478MUTUAL OMAHA BK FLMUTUAL OF OMAHA BANK                FLMARCO ISLAND             YSN20100618
+067015656ANCHOR COMMERCIAL ANCHOR COMMERCIAL BANK              FLJUNO BEACH               Y Y20091026
+067015724STONEGATE BK FL   STONEGATE BANK                      FLFT LAUDERDALE            Y N20140227
+067015779BANESCO USA       BANESCO USA                         FLCORAL GABLES             Y Y20110701
+067015795PARADISE BANK     PARADISE BANK                       FLBOCA RATON               Y Y20100111
+067015928BANK CORAL GABLES BANK OF CORAL GABLES                FLCORAL GABLES             Y N20080916
+067015944FIRST BK OF PALM  FIRST BANK OF THE PALM BEACHES      FLWEST PALM BEACH          Y N20111005
+067015999LEGACY BANK OF FL LEGACY BANK OF FLORIDA              FLBOCA RATON               Y Y20110822
+067016118STONEGATE BANK    STONEGATE BANK                      FLPOMPANO BEACH            Y N20140415
+067016192ENCORE BK NA  FL  ENCORE BANK, NA                     FLPORT CHARLOTTE           Y Y20140225
+067016231FINEMARK NA BANK  FINEMARK NATIONAL BANK & TRUST      FLFORT MYERS               Y N20070216
+067016244CALUSA STATE BK   CALUSA BANK                         FLPUNTA GORDA              YSN20140428
+067016312MARQUIS BANK      MARQUIS BANK                        FLCORAL GABLES             Y Y20110628
+0670163251ST NB GULF COA FLFIRST FLORIDA INTEGRITY BANK        FLNAPLES                   Y N20140701
+067016367FIRSTCITY BANK    FIRSTCITY BANK OF COMMERCE          FLPALM BEACH GARDENS       Y N20140410
+067016558PALM BEACH COMM BKPALM BEACH COMMUNITY BANK           FLWEST PALM BEACH          Y N20110309
+067016574PROFESSIONAL BK FLPROFESSIONAL BANK                   FLCORAL GABLES             Y Y20090127
+067016655BROWARD BK COMM FLBROWARD BANK OF COMMERCE            FLFORT LAUDERDALE          Y Y20130917
+067091764NATBANK NA        NATBANK, N.A.                       FLHOLLYWOOD                Y N20140122
+067091942N DADE COM DEV FCUNORTH DADE COMMUNITY DEVELOPMENT FCUFLMIAMI GARDENS            Y Y20111028
+067092556OCULINA BANK FL   THE OCULINA BANK                    FLFORT PIERCE              Y N20090320
+067092886URBAN TRUST FBO HOURBAN TRUST BANK FBO HIGHER ONE     FLNAPLES                   Y N20120410
+071000013JPMCHASE ILLINOIS JPMORGAN CHASE BANK, NA             ILCHICAGO                  Y N20050509
+071000039BKAM IL CGO       BANK OF AMERICA, N.A., IL           ILCHICAGO                  Y N20090109
+071000152NORTHERN CHGO     THE NORTHERN TRUST COMPANY          ILCHICAGO                  Y Y        
+071000288BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y Y20110705
+071000301FRB CHGO          FEDERAL RESERVE BANK OF CHICAGO     ILCHICAGO                  Y Y20020520
+071000343COLETAYLOR BK CHGOCOLE TAYLOR BANK                    ILCHICAGO                  Y Y20051209
+071000521US BANK ILLINOIS  US BANK, NA                         ILCHICAGO                  Y N20130221
+071001041PARK NATIONAL BANKUS BANK NATIONAL ASSOCIATION        ILCHICAGO                  Y N20140227
+071001122AUSTIN BANK       AUSTIN BANK OF CHICAGO              ILCHICAGO                  Y Y20081210
+071001180REPUBLIC CHGO     REPUBLIC BANK OF CHICAGO            ILDARIEN                   Y Y        
+071001216SEAWAY CHGO       SEAWAY BANK AND TRUST COMPANY       ILCHICAGO                  Y Y20080107
+071001232SOUTH CENTRAL CHGOSOUTH CENTRAL BANK & TRUST          ILCHICAGO                  Y Y        
+071001368NORTH CHGO        NORTH BANK                          ILCHICAGO                  Y Y        
+071001449OAK TRUST CHGO    OAK BANK                            ILCHICAGO                  Y Y19991130
+071001465HIGHLAND COMM CHGOHIGHLAND COMMUNITY BANK             ILCHICAGO                  Y N20080422
+071001504LAKESIDE CHGO     LAKESIDE BANK                       ILCHICAGO                  Y Y        
+071001533NORTH COMM CHGO   NORTH COMMUNITY BANK                ILCHICAGO                  Y Y        
+071001588BANCO POPULAR     BANCO POPULAR NORTH AMERICA         ILCHICAGO                  Y N20130321
+071001685BANCO POPULAR     BANCO POPULAR NORTH AMERICA         ILCHICAGO                  Y N20130321
+071001737MB FINANCIAL      MB FINANCIAL BANK, N.A.             ILCHICAGO                  Y Y20011205
+071001779BANQUE DE PARIS CHBNP PARIBAS-CHICAGO BRANCH I        ILCHICAGO                  YSN20020923
+071002011RYL BNK SCOTLAND  ROYAL BANK OF SCOTLAND-CHICAGO BRAN ILCHICAGO                  YSY20110214
+071002024LEUMI ISRAEL CHGO BANK LEUMI USA                      ILCHICAGO                  Y N20031008
+071002118INTERNATL COM CHGOMEGA INTERNATIONAL COMM. BK CO., LTDILCHICAGO                  Y N20060927
+071002134BBCN BANK         BBCN BANK                           ILCHICAGO                  Y N20111205
+071002286DELAWARE PL BK CHGDELAWARE PLACE BANK                 ILCHICAGO                  Y Y19990625
+071002341BTMU LTD CHGO     THE BANK OF TOKYO-MITSUBISHI UFJ LTDILCHICAGO                  Y N20060109
+071002419MB FINANCIAL      MB FINANCIAL BANK, N.A.             ILCHICAGO                  Y N20100427
+071002422BRKYD CHGO        BRICKYARD BANK                      ILLINCOLNWOOD              Y Y        
+071002503MB FINANCIAL BANK MB FINANCIAL BANK, N.A.             ILCHICAGO                  Y N20091211
+071002707NORTH COMM CHGO   NORTH COMMUNITY BANK                ILCHICAGO                  Y N20131008
+071002943NATL REPUBLIC CHGONATIONAL REPUBLIC BANK              ILCHICAGO                  Y Y        
+071003007BRIDGEVIEW BK & TRBRIDGEVIEW BANK GROUP               ILCHICAGO                  Y N20040107
+071003405AMALGAMATED CHGO  AMALGAMATED BANK OF CHICAGO         ILCHICAGO                  Y Y        
+071004022BEVERLY BK AND TR BEVERLY BANK AND TRUST CO., N.A.    ILCHICAGO                  Y N20130131
+071004158NORTH COMM CHGO   NORTH COMMUNITY BANK                ILBERWYN                   Y N20131008
+071004200PARK NATIONAL BANKUS BANK,  NA                        ILCHICAGO                  Y N20140227
+071004226URBAN PARTNERSHIP URBAN PARTNERSHIP BANK              ILCHICAGO                  Y Y20100824
+071004271DEVON BK CHICAGO  DEVON BANK                          ILCHICAGO                  Y Y        
+071004284MARQUETTE CHGO    MARQUETTE BANK                      ILORLAND PARK              Y Y20100803
+071004349NORTH COMM BK     NORTH COMMUNITY BANK                ILCHICAGO                  Y N20111027
+071004381PARK NATIONAL BANKUS BANK, NA                         ILCHICAGO                  Y N20140227
+071004420IRVING BANK CHGO  BANCO POPULAR NORTH AMERICA         ILCHICAGO                  Y N20030821
+071004446ALBANY NATL CHGO  ALBANY BANK & TRUST CO. NA          ILCHICAGO                  Y Y        
+071004501FED HOME LN CHGO  FEDERAL HOME LOAN BANK              ILCHICAGO                  Y N20111004
+071004530NORTH COMM CHGO   NORTH COMMUNITY BANK                ILCHICAGO                  Y N20131125
+071004543BANCO POPULAR     BANCO POPULAR NORTH AMERICA         ILCHICAGO                  Y N20130321
+071004899BANK MONTREAL CHGOBANK OF MONTREAL CHGO               ILCHICAGO                  YSY20081014
+071005254BURLING BK CHICAGOBURLING BANK                        ILCHICAGO                  Y Y        
+071006282BBCN BANK         BBCN BANK                           CALOS ANGELES              Y N20131025
+071006486PRIVATE BANK CHGO THE PRIVATEBANK AND TRUST COMPANY   ILCHICAGO                  Y Y20060905
+071006651INTL BK CHICAGO   INTERNATIONAL BANK OF CHICAGO       ILCHICAGO                  Y Y        
+071006774PACIFIC GLOBAL CHGPACIFIC GLOBAL BANK                 ILCHICAGO                  Y Y20110922
+071006868PAN AMERICAN BANK PAN AMERICAN BANK                   ILMELROSE PARK             Y Y20131223
+071025629NORTHBROK BK TR CONORTHBROOK BANK & TRUST COMPANY     ILNORTHBROOK               Y N20110311
+071025645AMERICAN MTRO CHGOAMERICAN METRO BANK                 ILCHICAGO                  Y Y20060829
+071025661BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20110705
+071025771BUILDERS BANK     BUILDERS BANK                       ILCHICAGO                  Y Y20060109
+071025849MB FINANCIAL      MB FINANCIAL BANK, N.A.             ILCHICAGO                  Y N20100423
+071026123BAYTREE NATL CHGO BAYTREE NATIONAL BANK & TRUST CO.   ILLAKE FOREST              Y Y20090521
+071026356BEVERLY BK AND TR BEVERLY BANK AND TRUST CO., N.A.    ILCHICAGO                  Y N20040401
+071026385EDGEBROOK BANK    EDGEBROOK BANK                      ILCHICAGO                  Y N20081031
+071026408METROPOLITAN CAPITMETROPOLITAN CAPITAL BANK & TRUST   ILCHICAGO                  Y N20120703
+071026495NORTHBROK BK TR CONORTHBROOK BANK & TRUST COMPANY     ILNORTHBROOK               Y N20110614
+071026576BELMONT B&T       BELMONT BANK AND TRUST COMPANY      ILCHICAGO                  Y Y20081014
+071026628SIGNATURE BANK    SIGNATURE BANK                      ILCHICAGO                  Y N20061006
+071026835AMERICAN EAGLE BK AMERICAN EAGLE BANK OF CHICAGO      ILCHICAGO                  Y Y20090324
+071026848GOLD COAST        GOLD COAST BANK                     ILCHICAGO                  Y N20140304
+071074528JPMCHASE          JPMORGAN CHASE BANK, NA             CASTOCKTON                 Y N20101129
+071074557FEDERAL SAVINGS BKTHE FEDERAL SAVINGS BANK            ILCHICAGO                  Y N20140304
+071100117SOUTH SIDE SAVINGSSOUTH SIDE TRUST & SAVINGS BANK     ILPEORIA                   Y N20120308
+071101310F&M BK GALESBURG  F&M BANK                            ILGALESBURG                YSY20090325
+071101996MAIN STREET BK&TR BUSEY BANK                          ILCHAMPAIGN                Y N20071218
+071102076FST MID-IL BK MATNFIRST MID-ILLINOIS BANK & TRUST     ILMATTOON                  Y Y20070601
+071102173ST BK LINCOLN     STATE BANK OF LINCOLN               ILLINCOLN                  Y N20121206
+071102238NATL CANTON       MIDAMERICA NATIONAL BANK            ILCANTON                   Y Y19990826
+071102322HERGET PEKIN      HERGET BANK, NATIONAL ASSOCIATION   ILPEKIN                    Y Y20050502
+071102487PEOPLES KEWANEE   PEOPLES NATL BANK/KEWANEE           ILKEWANEE                  Y Y        
+071102500MIDWEST BK WESTERNMIDWEST BANK OF WESTERN ILLINOIS    ILMONMOUTH                 Y Y20090331
+071102568BUSEY BK URBANA   BUSEY BANK                          ILCHAMPAIGN                Y Y20140304
+071102827EDGAR COUNTY PARISEDGAR COUNTY BANK & TRUST           ILPARIS                    Y Y        
+071102830FIRST FARMERS B&T FIRST FARMERS BANK & TRUST          INCONVERSE                 Y N20120614
+071103376FIRST BEARDSTOWN  1ST NATL BANK/BEARDSTOWN            ILBEARDSTOWN               Y Y19990826
+071103473FIRST FINANCIAL BKFIRST FINANCIAL BANK, N.A.          INTERRE HAUTE              Y N20120105
+071103509FIRST PANA        FIRST NATIONAL BANK OF PANA         ILPANA                     Y Y        
+071103635MORTON COMM BK    MORTON COMMUNITY BANK               ILMORTON                   Y N20090826
+071103952FIRST CLINTON IL  FIRST NATIONAL BANK & TRUST         ILCLINTON                  YSY20130701
+071104427HEARTLAND BLOOMTONHEARTLAND BANK & TRUST COMPANY      ILBLOOMINGTON              Y N20130517
+071104647THE NATIONAL BANK TRIUMPH COMMUNITY BANK, N.A.        ILSAVANNA                  Y Y20140702
+071104692SHELBY CO SHELBVILSHELBY COUNTY STATE BANK            ILSHELBYVILLE              Y Y        
+071104731HAVANA NATL       THE HAVANA NATIONAL BANK            ILHAVANA                   Y Y        
+071105002CENTRAL GENESEO   CENTRAL BANK ILLINOIS               ILGENESEO                  YSN20100210
+071105154FIRST PAXTON      FIRST NATIONAL BANK/PAXTON          ILPAXTON                   Y Y        
+071105471METROBANK E MOLINETRIUMPH COMMUNITY BANK, N.A.        ILEAST MOLINE              Y N20140702
+071105536FARMERS BUSHNELL  FARMERS & MERCHANTS STATE BK        ILBUSHNELL                 Y Y        
+071105581NATL PETERSBURG   NATIONAL BANK OF PETERSBURG         ILPETERSBURG               Y Y        
+071105756FIRST TRST WATSEKA1ST TRUST & SAVINGS BANK            ILWATSEKA                  Y Y20100121
+071105798TUSCOLA NATL      TUSCOLA NATIONAL BANK               ILTUSCOLA                  Y N20121206
+071105866RUSHVILLE ST BK   RUSHVILLE STATE BANK                ILRUSHVILLE                Y N20051220
+071105882BANK OF FARMINGTONBANK OF FARMINGTON                  ILFARMINGTON               N Y20100323
+071106043FST GEORGETOWN IL FIRST NATL BANK/GEORGETOWN          ILGEORGETOWN               Y Y19990902
+071106069HILL-DODGE WARSAW HILL-DODGE BANKING COMPANY          ILWARSAW                   Y N        
+071106218FIRST DWIGHT      1ST NATIONAL BANK/DWIGHT            ILDWIGHT                   Y Y        
+071106344ARCOLA FIRST BANK ARCOLA FIRST BANK                   ILARCOLA                   Y Y20111011
+071106593FIRST ASSUMPTION  1ST NATIONAL BANK/ASSUMPTION        ILASSUMPTION               Y N19990826
+071106687FNB CHILLICOTHE   FIRST NATIONAL BANK                 ILCHILLICOTHE              YSN20110802
+071107123STATE BANK BEMENT STATE BANK OF BEMENT                ILBEMENT                   Y N20101118
+071107220FARMER MT PULASKI FARMERS BANK/MT. PULASKI            ILMOUNT PULASKI            Y Y        
+071107233ILL NATL BK       ILLINOIS NATIONAL BANK              ILMOUNT PULASKI            Y N20080326
+071107262PETEFISH SKILES   PETEFISH, SKILES & COMPANY          ILVIRGINIA                 Y N20091001
+071107424WENONA STATE BANK WENONA STATE BANK                   ILWENONA                   YSY20120606
+071107563NATIONAL ATLANTA  THE ATLANTA NATIONAL BANK           ILATLANTA                  Y Y        
+071107783FEDERATED BANK    FEDERATED BANK                      ILONARGA                   Y N20091224
+071107987STATE BK TOULON   STATE BANK OF TOULON                ILTOULON                   N Y20000317
+071108038STATE BK CHRISMAN STATE BK OF CHRISMAN                ILCHRISMAN                 Y N20130917
+071108041FIRST CHRISMAN    1ST NATIONAL BANK/CHRISMAN          ILCHRISMAN                 Y Y19990929
+071108106CITIZ ST BK LENA  CITIZENS STATE BANK/LENA            ILLENA                     N Y20080812
+071108290COMM NATL MONMOUTHCOMMUNITY NATIONAL BK IN MONMOUTH   ILMONMOUTH                 Y Y20060614
+071108313CITIZENS CHATSWORTCITIZENS BANK OF CHATSWORTH         ILCHATSWORTH               Y Y20100524
+071108407UNITED BK CHATHAM UNITED COMMUNITY BANK               ILCHATHAM                  Y Y        
+071108449PEKIN NATL        PEKIN NATIONAL BANK                 ILPEKIN                    YSY20051021
+071108452STATE ARTHUR      STATE BANK OF ARTHUR                ILARTHUR                   Y Y        
+071108559BANK SPRINGFIELD  BANK OF SPRINGFIELD                 ILSPRINGFIELD              Y N20051213
+071108630MIDWEST FREEPORT  MIDWEST COMMUNITY BANK              ILFREEPORT                 Y Y20021213
+071108834AMER ROCK ISLAND  AMERICAN BANK AND TRUST COMPANY, N.AIADAVENPORT                Y Y20020905
+071109082FIRST TOLEDO      FIRST NEIGHBORHOOD BANK, N.A.       ILTOLEDO                   Y N20121206
+071109163ILLINI BK SPRINGFDILLINI BANK                         ILSPRINGFIELD              Y Y        
+071109189STATE BANK        STATE BANK OF CERRO GORDO           ILCERRO GORDO              YSN20111026
+071109325SIDELL STATE BANK SIDELL STATE BANK                   ILSIDELL                   Y N20040415
+071109338ILL NATL BK       ILLINOIS NATIONAL BANK              ILSPRINGFIELD              Y Y19990907
+071109406MARINE BK         MARINE BANK                         ILSPRINGFIELD              Y Y20100330
+071109435FIRST TREMONT     FIRST NATIONAL BANK/TREMONT         ILTREMONT                  YSN20061012
+071109558CITIZEN WALNUT    CITIZENS FIRST STATE BANK           ILWALNUT                   Y Y        
+071109655FIRST SEC MACKINAWFIRST SECURITY BANK                 ILMACKINAW                 Y Y        
+071109684STEWARDSON NATL   PRAIRIE NATIONAL BANK               ILSTEWARDSON               YSY20111110
+071109707COMM BK COWDEN    COMMUNITY BANKS OF SHELBY COUNTY    ILCOWDEN                   Y N20121130
+071109820FIRST FARMERS ST  FIRST FARMERS STATE BANK            ILMINIER                   N Y20100826
+071109846WARREN-BOYNTON ST WARREN-BOYNTON STATE BANK           ILNEW BERLIN               Y Y20060928
+071110000VERMILION PIPR CTYVERMILION VALLEY BANK               ILPIPER CITY               Y Y        
+071110042BANKORION         BANKORION                           ILORION                    Y Y20040728
+071110107PORT BYRON ST BK  PORT BYRON STATE BANK               ILPORT BYRON               YSY20060915
+071110369PHILO EXCHANGE BK PHILO EXCHANGE BANK                 ILPHILO                    Y Y20090903
+071110521FIRST ARENZVILLE  1ST NATIONAL BANK/ARENZVILLE        ILARENZVILLE               Y Y19990826
+071110819LONGVIEW BANK     LONGVIEW BANK                       ILOGDEN                    Y N20140506
+071111339FARMER STATE ALPHAFARMERS STATE BANK/WESTERN ILLINOIS ILALPHA                    Y Y20130108
+071111371ANCHOR STATE BANK ANCHOR STATE BANK                   ILANCHOR                   N Y        
+071111397STATE ANNAWAN     BANKORION                           ILANNAWAN                  Y N20071025
+071111481ATHENS STATE BK   ATHENS STATE BANK                   ILATHENS                   YSY20100603
+071111986FST ST BK LA HARPEFIRST STATE BANK OF ILLINOIS        ILLA HARPE                 Y N20100909
+071112040CAMPUS ST BK      CAMPUS STATE BANK                   ILCAMPUS                   N Y20051003
+071112066HEARTLAND BLOOMTONHEARTLAND BANK & TRUST COMPANY      ILBLOOMINGTON              Y Y        
+071112105FIRST CATLIN      1ST NATIONAL BANK/CATLIN            ILCATLIN                   N Y20131114
+071112260CISSNA PARK STATE CISSNA PARK STATE BANK              ILCISSNA PARK              Y N20090806
+071113049FST ST FORREST    FIRST STATE BANK OF FORREST         ILFORREST                  Y Y20091217
+071113162GERM-AM GERMAN VALGERMAN-AMERICAN STATE BANK          ILGERMAN VALLEY            N Y20120126
+071113188GLASFORD STATE BK GLASFORD STATE BANK                 ILGLASFORD                 Y N20100325
+071113298GRANVILLE NATIONALTHE GRANVILLE NATIONAL BANK         ILGRANVILLE                Y Y        
+071113434HARTSBURG ST BANK HARTSBURG STATE BANK                ILHARTSBURG                Y Y20130501
+071113751IROQUOIS FARMERS  IROQUOIS FARMERS STATE BANK         ILIROQUOIS                 Y N        
+071113793STATE BANK BEMENT STATE BANK OF BEMENT                ILBEMENT                   Y N20111209
+071113984FIRST LACON       THE FIRST NATIONAL BANK             ILLACON                    Y Y        
+071114255LONGVIEW BANK     LONGVIEW BANK                       ILOGDEN                    Y N20140506
+071114378MORTON COMUNITY BKMORTON COMMUNITY BANK               ILMANITO                   Y N20061020
+071114763PRAIRIE SB & TRUSTPRAIRIE STATE BANK AND TRUST        ILMOUNT ZION               Y Y20031003
+071115018UNITED COMMUNITY  UNITED COMMUNITY BANK               ILOAKWOOD                  Y N20040511
+071115995SPRING VALLEY BANKSPRING VALLEY CITY BANK             ILSPRING VALLEY            Y Y20090312
+071116062SUMNER BK SHELDON SUMNER NATIONAL BANK/SHELDON        ILSHELDON                  Y N20091116
+071116295HERITAGE TRIVOLI  HERITAGE BANK OF CENTRAL ILLINOIS   ILHANNA CITY               Y Y20091120
+071117760STATE BLUE MOUND  STATE BANK OF BLUE MOUND            ILBLUE MOUND               Y Y        
+071118170COMM HOOPESTON    COMMUNITY BANK                      ILHOOPESTON                Y N20121213
+071118222STATE GRAYMONT    STATE BANK OF GRAYMONT              ILGRAYMONT                 Y Y19990908
+071118264FLANAGAN STATE    FLANAGAN STATE BANK                 ILFLANAGAN                 Y N        
+071118471FARMER MERC PAXTONFARMERS-MERCHANTS NATL BANK         ILPAXTON                   Y Y        
+071119865MARINE CARTHAGE   MARINE BANK & TRUST                 ILCARTHAGE                 Y N20100506
+071120207BETTER BANKS      BETTER BANKS                        ILBARTONVILLE              Y N20110524
+071120252SIDELL STATE BANK SIDELL STATE BANK                   ILHOMER                    Y N20050120
+071120647KENT BANK         KENT BANK                           ILFREEPORT                 N Y20131001
+071121772MASON CITY NATL   MASON CITY NATIONAL BANK            ILMASON CITY               Y Y        
+071121866STATE TREASURER ILSTATE TREASURE OF ILLINOIS          ILSPRINGFIELD              N Y20000317
+071121963FST ST MONTICELLO FIRST STATE BANK                    ILMONTICELLO               Y N20121213
+071122030BANK STRONGHURST  BANK OF STRONGHURST                 ILSTRONGHURST              Y N20121213
+071122056PRINCEVILLE STATE PRINCEVILLE STATE BANK              ILPRINCEVILLE              YSN20101021
+071122205VERMONT STATE BANKVERMONT STATE BANK                  ILVERMONT                  YSY20100813
+071122263BANK OF PONTIAC   BANK OF PONTIAC                     ILPONTIAC                  Y Y        
+071122535PEOPLES BANK & TR PEOPLES BANK & TRUST                ILPANA                     Y Y20100114
+071122564FIRST NATL BK     FIRST NATIONAL BANK                 ILMOLINE                   Y Y19990826
+071122616FISHER NATL       THE FISHER NATIONAL BANK            ILFISHER                   YSY20090526
+071122645SOY CAPITAL DECATRSOY CAPITAL BANK & TRUST CO.        ILDECATUR                  Y Y        
+071122687FIRST TAYLORVILLE 1ST NATL BANK/TAYLORVILLE           ILTAYLORVILLE              YSN20130315
+071122933CIBM BANK         CIBM BANK                           ILCHAMPAIGN                Y Y20090902
+071123123BLACKHAWK BK & TR BLACKHAWK BANK AND TRUST            ILMILAN                    Y Y20080331
+071123149SEAST NATL MOLINE SOUTHEAST NATIONAL BANK             IADAVENPORT                Y Y20010419
+071123204MORTON COMMUN BK  MORTON COMMUNITY BANK               ILMORTON                   Y Y        
+071123262TOWN AND COUNTRY  TOWN & COUNTRY BANK                 ILSPRINGFIELD              Y Y20120320
+071123385MAINSOURCE BANK   MAINSOURCE BANK                     INGREENSBURG               Y N20110310
+071124339COMMUNITY RK FALLSCOMMUNITY STATE BANK/ROCK FALLS     ILROCK FALLS               Y Y20090508
+071124371FST ST BLOOMINGTONFIRST STATE BANK OF BLOOMINGTON     ILBLOOMINGTON              Y Y        
+071124614BANKCHAMPAIGN NA  BANKCHAMPAIGN, N.A.                 ILCHAMPAIGN                Y Y        
+071124740BANK OF KENNEY    BANK OF KENNEY                      ILKENNEY                   Y N20061103
+071124805HICKORY PT DECATURHICKORY POINT BANK AND TRUST        ILDECATUR                  Y Y        
+071124821BK GIBSON CITY    BANK OF GIBSON CITY                 ILGIBSON CITY              Y N        
+071124931COMM STATE BANK   COMMUNITY STATE BANK                ILGALVA                    N Y20090203
+071125024TIB SPRINGFIELD ILTIB THE INDEPENDENT BANKERS BANK    ILSPRINGFIELD              Y N20140530
+071125587FIRST CAPITAL BANKBUSEY BANK                          ILPEORIA                   Y N20050527
+071125891SAUK VALLEY BK    SAUK VALLEY BANK AND TRUST CO       ILSTERLING                 Y N20051207
+071125914STATE BANK, FFG   STATE BANK, FFG                     ILFREEPORT                 N Y20090918
+071126269GTS SOUTHERN SF MOGREAT SOUTHERN BANK                 ILMOLINE                   Y Y20140623
+071126489BANK OF KENNEY    BANK OF KENNEY                      ILKENNEY                   Y N20100422
+071126955FIRST NATL BNK    FIRST NATIONAL BANK                 ILMATTOON                  Y N20080929
+071174431STATE FARM FNCL   STATE FARM BANK, FSB                ILBLOOMINGTON              Y Y20060905
+071174512MWA BANK          MWABANK                             ILROCK ISLAND              Y N20030128
+071201320HORIZON BK N.A.   HORIZON BANK, NATIONAL ASSOCIATION  INMICHIGAN CITY            Y Y19990826
+0712027561ST SOURCE        1ST SOURCE BANK                     INVALPARAISO               Y N20080612
+071205850FIRST BOURBON     1ST STATE BANK/BOURBON, IND         INBOURBON                  Y Y19990826
+071208297FIRST MIDDLEBURY  1ST STATE BANK/MIDDLEBURY           INMIDDLEBURY               Y Y19990826
+071211734FIRST STATE PORTERFIRST STATE BANK OF PORTER          INPORTER                   Y Y        
+0712121281ST SOURCE        1ST SOURCE BANK                     INSOUTH BEND               Y Y20080606
+071214249TALMER BANK IN    TALMER WEST BANK                    INGOSHEN                   Y N20140306
+071214333TALMER WEST IN    TALMER WEST BANK                    INGOSHEN                   Y Y20140221
+071900456BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20110705
+071900595BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071900760OLD SECOND AURORA OLD SECOND BANK/AURORA              ILAURORA                   Y Y20080818
+071900825BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071900993UNION NATL ELGIN  UNION NATIONAL BANK                 ILELGIN                    Y Y20090916
+071901604FST MDWST MOLINE  FIRST MIDWEST BANK                  ILITASCA                   Y Y20030318
+071901837CENTRUE BANK      CENTRUE BANK                        ILSTREATOR                 Y Y20070509
+071902030HTNB              HOMETOWN NATIONAL BANK              ILLA SALLE                 Y Y20051230
+071902043LA SALLE BK       LA SALLE STATE BANK                 ILLA SALLE                 Y Y        
+071902399FIRST OTTAWA IL   1ST NATIONAL BANK/OTTAWA            ILOTTAWA                   Y Y19990826
+071902629FNB OMAHA         FIRST NATIONAL BANK OF OMAHA        ILDEKALB                   Y N20130621
+071902878CENTIER WHITING   CENTIER BANK                        INCROWN POINT              Y Y20010601
+071903055BELVIDERE NATIONALALPINE BANK & TRUST CO.             ILBELVIDERE                Y N20080818
+071903110UNITED CENTRAL BK UNITED CENTRAL BANK                 ILHARVEY                   Y N20100803
+071903929FIRST LA GRANGE   FIRST NATIONAL BANK                 ILLA GRANGE                Y N20130315
+071904290GRUNDY CO MORRIS  GRUNDY BANK                         ILMORRIS                   Y Y20020717
+071904355BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071904478BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071904517NATL BK SYCAMORE  THE NATIONAL BANK & TRUST COMPANY   ILSYCAMORE                 Y Y20020329
+071904627BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071904779FIRSTAR NAPERVILLEUS BANK, NA                         WIMILWAUKEE                Y N20140227
+071905040BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071905082HARVARD STATE BK  HARVARD STATE BANK                  ILHARVARD                  N Y20090223
+071905309NATL ROCHELLE     THE FIRST NATIONAL BK & TRUST CO.   ILROCHELLE                 Y Y20061010
+071905817STATE BK GENEVA   THE STATE BANK OF GENEVA            ILGENEVA                   Y Y        
+071905846BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071905930REPUBLIC CHGO     REPUBLIC BANK OF CHICAGO            ILOAK BROOK                Y N20140509
+071905943ST BK OF ILL      STATE BANK OF ILLINOIS              ILWEST CHICAGO             Y Y19990826
+071905985OLD KENT ILL      FIFTH THIRD BANK                    OHCINCINNATI               N Y20090930
+071906573BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071908021BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071908063ST. CHARLES B&T   ST. CHARLES BANK & TRUST CO         ILELGIN                    Y N20120327
+071908160PARKWAY HARWOOD H PARKWAY BANK AND TRUST CO           ILHARWOOD HEIGHTS          Y Y        
+071908490AMERICAN MIDWEST  AMERICAN MIDWEST BANK               ILSCHAUMBURG               Y N20120327
+071908827EXCHANGE GARNER   STANDARD BANK & TRUST COMPANY       ILGARDNER                  Y N20050727
+071908869BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071908908BYRON BK          BYRON BANK                          ILBYRON                    YSY20120625
+071909046FIRST MCHENRY     1ST NATIONAL BANK/MCHENRY           ILMCHENRY                  Y Y19990826
+071909091MERCH & MANUF BANKMERCHANTS & MANUFACTURERS BANK      ILCHANNAHON                Y Y20020814
+071909198BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071909240MB FINANCIAL      MB FINANCIAL BANK, N.A.             ILGLENWOOD                 Y N20090303
+071909253MB FINANCIAL BK NAMB FINANCIAL BANK, N.A.             ILCHICAGO                  Y N20100317
+071909363STANDARD EVERGREENSTANDARD BANK & TRUST COMPANY       ILHICKORY HILLS            Y Y20040206
+071909871ST BK OF LAKES    STATE BANK OF THE LAKES             ILANTIOCH                  Y Y19990826
+071910187KANE COUNTY ELBURNOLD SECOND BANK/AURORA              ILELBURN                   Y N20090317
+071910239HEARTLAND BLOOMTONHEARTLAND BANK & TRUST COMPANY      ILBLOOMINGTON              Y N20130517
+071910721STATE HERSCHER    STATE BANK OF HERSCHER              ILHERSCHER                 YSY20100503
+071910776FIRST MANHATTAN ILFIRST BANK OF MANHATTAN             ILMANHATTAN                Y Y20060110
+071911270OXFORD BK TR ADDISOXFORD BANK & TRUST                 ILOAK BROOK                Y Y20060316
+071911296ALGONQUIN STATE   ALGONQUIN STATE BANK, N.A.          ILALGONQUIN                Y Y        
+071911364FIRST NATL AMBOY  1ST NATIONAL BANK IN AMBOY          ILAMBOY                    Y N19990826
+071911416BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071911584BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071911704HORIZON BANK      HORIZON BANK, NATIONAL ASSOCIATION  INMICHIGAN CITY            Y N20120316
+071911788GREAT LAKES BK, NAGREAT LAKES BANK, N.A.              ILBLUE ISLAND              Y Y20020516
+071912813BMO HARRIS BANK NABMO HARRIS BANK NA                  INCHICAGO                  Y N20121009
+071913058FORRESTON STATE   FORRESTON STATE BANK                ILFORRESTON                Y Y        
+071913278FIRST GRAND RIDGE GRAND RIDGE NATIONAL BANK           ILGRAND RIDGE              YSN20130315
+071913566HOLCOMB STATE BANKHOLCOMB STATE BANK                  ILHOLCOMB                  YSN20060327
+071913650BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071914138THE LEMONT NATIONATHE LEMONT NATIONAL BANK            ILLEMONT                   Y N20100303
+071914358RESOURCE BANK     RESOURCE BANK, N.A.                 ILDEKALB                   Y Y20100803
+071915218PIONEER ST BK     PIONEER STATE BANK                  ILEARLVILLE                YSN20140416
+071915797FIRST ST MENDOTA  FIRST STATE BANK                    ILMENDOTA                  YSN20120713
+071916042STILLMAN BANCCORP STILLMAN BANCCORP, N.A.             ILSTILLMAN VALLEY          Y Y        
+071916123FARMERS SUBLETTE  FARMERS STATE BANK                  ILSUBLETTE                 YSY20101104
+071916408MB FINANCIAL      MB FINANCIAL BANK, N.A.             ILAURORA                   Y N20091229
+071916482WATERMAN STATE BK WATERMAN STATE BANK                 ILWATERMAN                 YSY20120416
+071916822YORKVILLE NATL    OLD SECOND BANK/AURORA              ILAURORA                   Y N20100319
+071918150BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071918309BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071918545BANCO POPULAR     BANCO POPULAR NORTH AMERICA         ILMELROSE PARK             Y N20130321
+071918765HOME CRYSTAL LAKE HOME STATE BANK, NATIONAL ASSN      ILCRYSTAL LAKE             Y Y        
+071919120HEARTLAND B & T COHEARTLAND BANK & TRUST COMPANY      ILBLOOMINGTON              Y N20120319
+071919463BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071920300GLENVIEW STATE    GLENVIEW STATE BANK                 ILGLENVIEW                 Y Y        
+071920559PARK NATIONAL BANKUS BANK,  NA                        ILOAK PARK                 Y N20140227
+071920656BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071920960AMERICAN MIDWEST  AMERICAN MIDWEST BANK               ILANTIOCH                  Y N20111117
+071921176BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071921396BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  N Y20121009
+071921529PIONEER ST BK     PIONEER STATE BANK                  ILPAW PAW                  Y N20140416
+071921532FIRST ST MENDOTA  FIRST STATE BANK                    ILMENDOTA                  Y Y        
+071921668FOREST PARK NATL  FOREST PARK NATIONAL BANK           ILFOREST PARK              Y Y        
+071921749MAZON STATE BANK  MAZON STATE BANK                    ILMAZON                    Y Y19990628
+071921794BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071921846OLD PLANK TRAIL COOLD PLANK TRAIL COMMUNITY BANK, NA  ILNEW LENOX                Y N20131119
+071921956MARSEILLES BANK NAMARSEILLES BANK                     ILMARSEILLES               Y Y20030220
+0719219851ST SECURITY TR   1ST SECURITY TRUST & SAVINGS        ILELMWOOD PARK             Y Y19990826
+071922010HOMESTARBK&FNLSRV HOMESTAR BANK AND FINANCIAL SRV     ILMANTENO                  Y Y20080701
+071922117BLACKHAWK STATE BKBLACKHAWK STATE BANK                ILCAPRON                   Y N20031017
+071922175ITASCA BANK       ITASCA BANK & TRUST CO.             ILITASCA                   Y Y        
+071922188SHERIDAN STATE    SHERIDAN STATE BANK                 ILSHERIDAN                 YSN20060929
+071922227BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071922256NORTH COMM CHGO   NORTH COMMUNITY BANK                ILCHICAGO                  Y N20131008
+071922337NATL BK ST ANNE   NATIONAL BANK OF ST. ANNE           ILSAINT ANNE               Y Y20100803
+071922366FIRST ST ROUND LK NORSTATES BANK                      ILROUND LAKE               Y N20051122
+071922476MB FINANCIAL BK   MB FINANCIAL BANK, N.A.             ILGLENVIEW                 Y N20140506
+071922609FST HIGHLAND PK   FIRST BANK OF HIGHLAND PARK         ILHIGHLAND PARK            Y Y19990826
+071922696BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071922777FST AMER BK CARPETFIRST AMERICAN BANK                 ILELK GROVE VILLAGE        Y Y19990826
+071922874NORTH COMM CHGO   NORTH COMMUNITY BANK                ILCHICAGO                  Y N20130910
+071922887OLD SECOND AURORA OLD SECOND BANK/AURORA              ILCHICAGO HEIGHTS          Y N20080818
+071922968BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071922984NORTH COMM CHGO   NORTH COMMUNITY BANK                ILCHICAGO                  Y N20130806
+071923213PRIVATE BANK CHGO THE PRIVATEBANK AND TRUST COMPANY   ILCHICAGO                  Y N20100201
+071923307ALPINE BK ROCKFORDALPINE BANK & TRUST CO.             ILROCKFORD                 Y Y20080711
+071923310MB FINANCIAL BANK MB FINANCIAL BANK, N.A.             ILOAK BROOK                Y N20061218
+071923336FIRST BK PALATINE FIRST BANK & TR COMPANY OF ILLINOIS ILPALATINE                 Y Y20030213
+071923349W SUBURBAN LOMBARDWEST SUBURBAN BANK                  ILLOMBARD                  Y Y        
+071923352FST BROOKFIELD IL FIRST NATL BANK/BROOKFIELD          ILBROOKFIELD               Y Y19990826
+071923378BANK OF WAUKEGAN  NORSTATES BANK                      ILWAUKEGAN                 Y Y20051114
+071923404PEOPLES BK BOURBNNPEOPLES BANK OF KANKAKEE COUNTY     ILBOURBONNAIS              Y Y        
+071923543BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071923569BRIDGEVIEW BANK   BRIDGEVIEW BANK GROUP               ILBRIDGEVIEW               Y Y20031231
+071923666NORTH COMM CHG    NORTH COMMUNITY BANK                ILCHICAGO                  Y N20110721
+071923695BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071923747BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071923815BANK OF MONTGOMERYBANK OF MONTGOMERY                  ILMONTGOMERY               YSY20091209
+071923857NORTHWEST ROCKFORDNORTHWEST BANK OF ROCKFORD          ILROCKFORD                 N Y20081209
+071924005BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071924063AMERICAN MIDWEST  AMERICAN MIDWEST BANK               ILSYCAMORE                 Y Y20111117
+071924131HERITAGE SCHAUMBURHERITAGE BANK/SCHAUMBURG            ILSCHAUMBURG               Y Y19991104
+071924160BANK OF BOURBONNAIBANK OF BOURBONNAIS                 ILBOURBONNAIS              Y N20011018
+071924212STATE COUNTRYSIDE STATE BANK OF COUNTRYSIDE           ILCOUNTRYSIDE              Y Y20100317
+071924241BANK OF PALATINE  BANK OF PALATINE                    ILPALATINE                 Y Y20120918
+071924306FIRST NATL WHEATONFIRST NATIONS BANK                  ILCHICAGO                  Y Y20040324
+071924319BRONSON PROSPECT  BANCO POPULAR NORTH AMERICA         ILPROSPECT HEIGHTS         Y N20030821
+071924458BANCO POPULAR, IL BANCO POPULAR NORTH AMERICA         ILMELROSE PARK             Y N20060302
+071924513SUBURBAN ELMHURST SUBURBAN BANK & TRUST COMPANY       ILWOOD DALE                Y Y20000818
+071924652INLAND BK AND TUSTINLAND BANK AND TRUST               ILVILLA PARK               Y N20080702
+071924681FIRSTSECURE B&T COFIRSTSECURE BANK AND TRUST CO.      ILPALOS HILLS              Y Y20100203
+071924775HEARTLAND B&T     HEARTLAND BANK & TRUST COMPANY      ILBLOOMINGTON              Y N20120319
+071924872ST BK WONDER LK   STATE BANK                          ILWONDER LAKE              Y Y20090120
+071924898BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+071924924BARRINGTON BK & TRBARRINGTON BANK & TRUST CO., N.A.   ILBARRINGTON               Y N20120622
+071924979MUN BK BOURBONNAISMUNICIPAL TRUST & SVGS BANK         ILBOURBONNAIS              Y Y        
+071925017FRST EAGLE HNVR PKFIRST EAGLE BANK                    ILCHICAGO                  Y Y20140404
+071925033INLAND BK AND TR  INLAND BANK AND TRUST               ILHILLSIDE                 Y N20080702
+071925046AMERICAN CHARTER  AMERICAN CHARTERED BANK             ILSCHAUMBURG               Y Y        
+071925224BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  N Y20121009
+071925266JOHNSON BK ILL    THE PRIVATEBANK AND TRUST COMPANY   ILLAKE FOREST              YSN20100203
+071925305NORTHBROOK B&T CO NORTHBROOK BANK & TRUST COMPANY     ILNORTHBROOK               Y N20120316
+071925334LAKE FOREST BK    LAKE FOREST BANK & TRUST COMPANY    ILLAKE FOREST              Y Y        
+071925376COMMUNITY ELMHURSTCOMMUNITY BANK OF ELMHURST          ILELMHURST                 Y Y20111201
+071925389WHEATON BK&TR     WHEATON BANK & TRUST                ILWHEATON                  Y N20130318
+071925392FIRST COMM ELGIN  FIRST COMMUNITY BANK                ILELGIN                    Y Y        
+071925402HINSDALE B&TC     HINSDALE BANK AND TRUST COMPANY     ILHINSDALE                 Y Y        
+071925431COMM WHEATON/GLEN COMMUNITY BANK-WHEATON/GLEN ELLYN   ILGLEN ELLYN               Y Y20000605
+071925444N SHORE WILMETTE  WINTRUST BANK                       ILCHICAGO                  Y N20140702
+071925460VILLAGE BK & TRUSTVILLAGE BANK & TRUST                ILARLINGTON HEIGHTS        Y Y20050727
+071925509HIGHLAND COMM MWD HIGHLAND COMMUNITY BANK             ILCHICAGO                  Y Y20020520
+071925525PK RIDGE COMM BANKPARK RIDGE COMMUNITY BANK           ILPARK RIDGE               Y Y        
+071925538FST BK & TR EVANTNFIRST BANK & TRUST OF EVANSTON      ILEVANSTON                 Y Y        
+071925541AMER ENTERPRISE BKAMERICAN ENTERPRISE BANK            ILBUFFALO GROVE            Y Y20100325
+071925567LIBERTYVILLE TRUSTLIBERTYVILLE BANK AND TRUST COMPANY ILLIBERTYVILLE             Y Y        
+071925596INLAND B&T        INLAND BANK AND TRUST               ILOAK BROOK                Y Y20110822
+071925635COMMUNITY OAK PARKCOMMUNITY BK OF OAK PARK-RIVER FRST ILOAK PARK                 Y Y19991021
+071925651BARRINGTON BK & TRBARRINGTON BANK & TRUST CO., N.A.   ILBARRINGTON               Y Y20040901
+071925677SCHAUMBURG BK & TRSCHAUMBURG BANK & TRUST COMPANY, NA ILSCHAUMBURG               Y N20120327
+071925680NSIDE CMNTY GURNEENORTHSIDE COMMUNITY BANK            ILGURNEE                   Y N        
+0719256931ST EQUITY BANK   1ST EQUITY BANK                     ILSKOKIE                   Y N20081124
+071925716MB FINANCIAL BANK MB FINANCIAL BANK, N.A.             ILCHICAGO                  Y N20100407
+071925758PRAIRIE COMMUNITY PRAIRIE COMMUNITY BANK              ILMARENGO                  Y Y20100517
+071925787CRYSTAL LAKE B&TC CRYSTAL LAKE BANK & TRUST COMPANY   ILCRYSTAL LAKE             Y N20060302
+071925826FIRST TR BK IL    FIRST TRUST BANK OF ILLINOIS        ILKANKAKEE                 Y Y20081223
+071925871ARCHER BANK       NORTH COMMUNITY BANK                ILCHICAGO                  Y N20131112
+071925884FIRST PERSONAL BANFIRST PERSONAL BANK                 ILORLAND PARK              Y Y20080814
+071925965PEOPLE'S ARLINGTONPEOPLE'S BANK OF ARLINGTON HEIGHTS  ILARLINGTON HEIGHTS        Y Y20000707
+071925981EVERGREEN BNK GRP EVERGREEN BANK GROUP                ILOAK BROOK                YSN20130611
+071926045AMER WOODSTOCK    AMERICAN COMMUNITY BANK             ILWOODSTOCK                Y N20060302
+071926058AMERICAN SUGAR GRVAMERICAN HEARTLAND BK AND TRST      ILSUGAR GROVE              Y N20060302
+071926061THE LEADERS BANK  THE LEADERS BANK                    ILOAK BROOK                Y Y20120328
+071926087INTL BK OF CHICAGOINTERNATIONAL BANK OF CHICAGO       ILCHICAGO                  Y N20120622
+071926090WINFIELD COMMUNITYWINFIELD COMMUNITY BANK             ILWINFIELD                 Y Y20120712
+071926113COMMUNITY LEMONT  US BANK, NA                         ILLEMONT                   Y N20140228
+071926155CORNERSTONEPALATINCORNERSTONE NATIONAL BK & TR CO.    ILPALATINE                 Y Y20120327
+071926171PARK NATIONAL BK  US BANK, NA                         ILLISLE                    Y N20140228
+071926184NORTHBROK BK TR CONORTHBROOK BANK & TRUST COMPANY     ILNORTHBROOK               Y Y20060118
+071926197SCHAUMBURG B & T  SCHAUMBURG BANK & TRUST COMPANY, NA ILSCHAUMBURG               Y N20110909
+071926210INLAND B&T        INLAND BANK AND TRUST               ILOAK BROOK                Y N20120327
+071926249GATEWAY COMM BANK GATEWAY COMMUNITY BANK              ILROSCOE                   Y N20060302
+071926252MB FINANCIAL BANK MB FINANCIAL BANK, N.A.             ILCHICAGO                  Y N20091224
+071926281AMERICAN EAGLE BK AMERICAN EAGLE BANK                 ILSOUTH ELGIN              Y N20021107
+071926333FIRST EQUITY N.W. FIRST EQUITY BANK NORTHWEST         ILBUFFALO GROVE            Y N20081124
+071926362FIRST COMM        FIRST COMM FINANCIAL                ILJOLIET                   Y N20130312
+071926391ROCKFORD BK & TR  ROCKFORD BANK & TRUST               ILROCKFORD                 Y Y20080930
+071926456REPUBLIC CHGO     REPUBLIC BANK OF CHICAGO            ILBERWYN                   Y Y20140516
+071926472LINCOLNWAY COM BK LINCOLNWAY COMMUNITY BANK           ILNEW LENOX                Y Y20090728
+071926553CENTRUST BANK, NA CENTRUST BANK, NA                   ILNORTHBROOK               N Y20100323
+071926566STC CAPITAL       STC CAPITAL BANK                    ILST CHARLES               Y Y20100805
+071926582OLD PLANK TRAIL COOLD PLANK TRAIL COMMUNITY BANK, NA  ILNEW LENOX                Y Y20131001
+071926618NORTH COMM CHGO   NORTH COMMUNITY BANK                ILBERWYN                   Y N20131008
+071926634TOWN CENTER BANK  TOWN CENTER BANK                    ILFRANKFORT                Y Y20080902
+071926650ST CHARLES B&T    ST. CHARLES BANK & TRUST CO         ILST. CHARLES              Y N20100831
+071926676FIRST COMM        FIRST COMM FINANCIAL                ILJOLIET                   Y N20130322
+071926692PEOPLEFIRST BANK  PEOPLEFIRST BANK                    ILJOLIET                   N Y20090602
+071926728WHEATON B & T     WHEATON BANK & TRUST                ILWHEATON                  Y N20120319
+071926731MILLENNIUM BANK   MILLENNIUM BANK                     ILDES PLAINES              Y Y20081006
+071926809WOODFOREST NATL BKWOODFOREST NATIONAL BANK            ILROCKFORD                 Y N20070119
+071926854GOLDEN EAGLE COMM GOLDEN EAGLE COMMUNITY BANK         ILWOODSTOCK                YSY20101123
+071926870NORTH COMM BK     NORTH COMMUNITY BANK                ILCHICAGO                  Y N20111007
+071926980FIRST COMM        FIRST COMM FINANCIAL                ILJOLIET                   Y N20130322
+071927002FIRST COMM        FIRST COMM FINANCIAL                ILJOLIET                   Y N20130322
+071974440FIRST ST MENDOTA  FIRST STATE BANK                    ILMENDOTA                  YSN20130621
+071974466ALLIAN FSB        PNA BANK                            ILCHICAGO                  Y Y20081118
+071974505UNITED TRUST BANK UNITED TRUST BANK                   ILPALOS HEIGHTS            Y Y20130418
+071993159SERVICES C U      SERVICES CREDIT UNION               ILNAPERVILLE               Y N20100812
+071993162ABBOTT LAB ECU    ABBOTT LABORATORIES ECU             ILGURNEE                   Y N20120712
+071993214ALLIED FIRST BANK ALLIED FIRST BANK                   ILOSWEGO                   Y Y20100520
+072000096COMERICA BK       COMERICA BANK                       MILIVONIA                  Y N20080818
+072000326JPMCHASE MICHIGAN JPMORGAN CHASE BANK, NA             MIDETROIT                  Y N20050509
+072001079FIRST INDEPENDENCEFIRST INDEPENDENCE BANK             MIDETROIT                  Y Y20060317
+072013915TALMER BANK MI    TALMER WEST BANK                    MILANSING                  Y N20140325
+072400528CITZ FLINT        FIRSTMERIT BANK, N.A.               OHAKRON                    Y N20131121
+072402157MONROE BK AND TRSTMONROE BANK & TRUST                 MIMONROE                   Y Y20081015
+072402212CITZ CHEBOYGAN    CITIZENS NATIONAL BANK/CHEBOYGAN    MICHEBOYGAN                Y Y        
+072402283SOUTHERN COLDWATERSOUTHERN MICHIGAN BANK & TRUST      MICOLDWATER                Y Y20040226
+072402610SOUTHERN MICH B&T SOUTHERN MICHIGAN BANK & TRUST      MICOLDWATER                Y N20100831
+072402652INDEPENDENT BANK  INDEPENDENT BANK                    MIBAY CITY                 Y Y20010918
+072402665MERCANTILE MI     MERCANTILE BANK OF WEST MICHIGAN    MISHEPHERD                 Y N20140630
+072402694HILLSDALE CO      HILLSDALE COUNTY NATIONAL BANK      MIHILLSDALE                Y Y        
+072402869HASTINGS CITY BK  HASTINGS CITY BK                    MIHASTINGS                 Y Y        
+072403004ISABEL MT PLEASANTISABELLA BANK                       MIMOUNT PLEASANT           Y Y20080421
+072403046LAPEER CO         LAPEER COUNTY BANK & TRUST CO.      MILAPEER                   Y Y        
+072403211MERCANTILE MI     MERCANTILE BANK OF WEST MICHIGAN    MISHEPHERD                 Y N20140630
+072403347UNITED TECUMSEH   UNITED BANK & TRUST                 MIANN ARBOR                Y Y20100831
+072403350STATE BK FENTON   STATE BANK                          MIFENTON                   Y Y        
+072403554COMMERCIAL BANK   COMMERCIAL BANK                     MIITHACA                   Y Y19990826
+072403635CHELSEA ST        CHELSEA STATE BANK                  MICHELSEA                  Y Y        
+072403677MASON ST          MASON STATE BANK                    MIMASON                    Y Y        
+072403978BLISSFIELD ST     BLISSFIELD STATE BANK               MIBLISSFIELD               Y Y        
+072403994HURON COMM BANK   HURON COMMUNITY BANK                MIEAST TAWAS               Y Y        
+072404058EASTERN MB CRSWLL EASTERN MICHIGAN BANK               MICROSWELL                 Y Y        
+072404197FIRST DECATUR     FIRST DECATUR                       MIDECATUR                  Y N        
+072404320UNION LAKE ODESSA UNION BANK                          MILAKE ODESSA              Y Y        
+072404333OXFORD BANK       OXFORD BANK                         MIOXFORD                   Y Y        
+072404508GW JONES MARCELLUSG.W. JONES EXCHANGE BANK            MIMARCELLUS                Y Y        
+072404650MERCANTILE MI     MERCANTILE BANK OF WEST MICHIGAN    MILAKEVIEW                 Y N20140630
+072404757MERCANTILE MI     MERCANTILE BANK OF WEST MICHIGAN    MIGRAND RAPIDS             Y N20140630
+072404786COMERICA BK TR NA COMERICA BANK AND TRUST NA          MIANN ARBOR                N Y20030212
+072404867CAPAC ST BANK     CSB BANK                            MICAPAC                    Y Y        
+072404883CENTURY COLDWATER CENTURY BANK AND TRUST              MICOLDWATER                Y Y        
+072404977TRI CO BROWN CITY TRI-COUNTY BANK                     MIBROWN CITY               Y Y        
+072405280EXCHANGE ST BK    EXCHANGE STATE BANK                 MICARSONVILLE              YSY20140509
+072405442ALDEN STATE BANK  ALDEN STATE BANK                    MIALDEN                    Y Y20070905
+072405604LAKE OSCEOLA ST BKLAKE OSCEOLA STATE BANK             MIBALDWIN                  Y Y20061113
+072405620W MICH SAVE BANGORW MICH SAVE BANGOR                  MIBANGOR                   Y N20080925
+072405688ST BK BAY PORT    BAY PORT STATE BANK                 MIBAY PORT                 Y N20130319
+072405743CENTRAL BEULAH    CENTRAL STATE BANK                  MIBEULAH                   N Y20120515
+072406506ST SAVE FRANKFORT STATE SAVINGS BANK                  MIFRANKFORT                YSY20120529
+072406768TALMER BK & TRUST TALMER BANK AND TRUST               MITROY                     Y N20110620
+072406771FRST CMM H SPRINGSFIRST COMMUNITY BANK                MIHARBOR SPRINGS           Y Y        
+072406865HONOR BANK        HONOR BANK                          MIHONOR                    YSY20110929
+072407123INDEPENDENT LESLIEINDEPENDENT BANK                    MILESLIE                   Y N20100319
+072407495MONTROSE STATE BK COMMUNITY STATE BANK                MIMONTROSE                 Y N20080314
+072407741ONSTED ST         OSB COMMUNITY BANK                  MIBROOKLYN                 Y N20060301
+072407929THUMB PIGEON      THUMB NATIONAL BANK & TRUST CO.     MIPIGEON                   Y Y        
+072407987SIGNATURE BANK    SIGNATURE BANK                      MIBAD AXE                  Y Y19990826
+072408274KALMAZO SCHOOLCRFTKALAMAZOO COUNTY STATE BANK         MISCHOOLCRAFT              Y N20130319
+072408290WEST SHORE BANK   WEST SHORE BANK                     MILUDINGTON                Y Y20020301
+072408436CHOICEONE BANK    CHOICEONE BANK                      MISPARTA                   Y N20130319
+072408546SSBBANK           SSB BANK                            MISTOCKBRIDGE              Y N20070601
+072408708INDEPENDENT CARO  INDEPENDENT BANK                    MITROY                     Y N20100319
+072408805UNITED GR RAPIDS  UNITED BK OF MICHIGAN               MIGRAND RAPIDS             Y Y20020429
+072410013CHEMICAL MIDLAND  CHEMICAL BANK                       MIMIDLAND                  Y Y20060307
+072410123REPUBLIC ANN ARBORFIRSTMERIT BANK, N.A.               MILANSING                  Y N20131018
+072410165FIRST ST          FIRST STATE BANK                    MIEASTPOINTE               Y Y20130814
+072410550FIRST HOWELL      FIRST NATIONAL BANK/HOWELL          MIHOWELL                   Y Y        
+072410903DART MASON        THE DART BANK                       MIMASON                    Y Y        
+072411025FARMERS ST MUNITH FARMERS ST MUNITH                   MIMUNITH                   Y N20120319
+072411708ST BK SHELBY      SHELBY STATE BANK                   MISHELBY                   Y Y        
+072412037COMMUN ST CHARLES COMMUNITY STATE BANK                MISAINT CHARLES            Y Y20100805
+072412435FIRST NATL AMER   FIRST NATIONAL BANK OF AMERICA      MIEAST LANSING             Y Y19990826
+072412778TALMER BK & TRUST TALMER BANK AND TRUST               MIFARMINGTON HILLS         Y N20110428
+072412972INDEPEN ROCKFORD  INDEPENDENT BANK                    MIBELDING                  Y N20100319
+072413104W MI CMM BK HDSNVLWEST MICHIGAN COMMUNITY BANK        MIHUDSONVILLE              Y Y19990917
+072413227MERCANTILE MI     MERCANTILE BANK OF WEST MICHIGAN    MISHEPHERD                 Y N20140630
+072413285HURON ROGERS CITY HURON NATIONAL BANK                 MIROGERS CITY              Y N20130319
+072413421WEST MI FRANKFORT WEST MICHIGAN BANK & TRUST          MIFRANKFORT                Y Y20100202
+072413450CAPITOL LANSING   CAPITOL NATIONAL BANK               MILANSING                  Y Y        
+072413557TALMER BANK MI    TALMER WEST BANK                    MILANSING                  Y N20140325
+072413586PRIVATEBANK, THE  THE PRIVATEBANK AND TRUST COMPANY   ILCHICAGO                  Y N20100203
+072413599TALMER BANK MI    TALMER WEST BANK                    MIANN ARBOR                Y N20140325
+072413612TALMER WEST MI    TALMER WEST BANK                    MIANN ARBOR                Y Y20140221
+072413625HORIZAN BK        HORIZON BANK, NATIONAL ASSOCIATION  MINEW BUFFALO              Y N20050722
+072413638FNDRS TRST ADA    FOUNDERS BANK AND TRUST             MIGRAND RAPIDS             Y N20121212
+072413654TALMER BANK MI    TALMER WEST BANK                    MILANSING                  Y N20140325
+072413683CHARLEVOIX ST BK  CHARLEVOIX STATE BANK               MICHARLEVOIX               Y Y20100805
+072413706TALMER BANK MI    TALMER WEST BANK                    MILANSING                  Y N20140325
+072413719FRST CMM H SPRINGSFIRST COMMUNITY BANK                MIHARBOR SPRINGS           Y N20121130
+072413722UNIVERSITY BANK MIUNIVERSITY BANK                     MIANN ARBOR                Y N        
+072413735BANK OF ANN ARBOR BANK OF ANN ARBOR                   MIANN ARBOR                Y Y        
+072413748TALMER BANK MI    TALMER WEST BANK                    MILANSING                  Y N20140325
+072413751TALMER BK & TRUST TALMER BANK AND TRUST               MITROY                     Y N20110909
+072413764CRESTMARK BK TROY CRESTMARK BANK                      MITROY                     Y Y20090618
+072413777TALMER BANK MI    TALMER WEST BANK                    MILANSING                  Y N20140325
+072413780LEVEL ONE BANK    LEVEL ONE BANK                      MIFARMINGTON HILLS         Y N20090717
+072413816MERCANTILE MI     MERCANTILE BANK OF WEST MICHIGAN    MIGRAND RAPIDS             Y N20140630
+072413829MERCANTILE MI     MERCANTILE BANK OF WEST MICHIGAN    MIGRAND RAPIDS             Y Y20140630
+072413832TALMER BANK MI    TALMER WEST BANK                    MILANSING                  Y N20140325
+072413845MACATAWA BANK     MACATAWA BANK                       MIHOLLAND                  Y Y20000317
+072413858TALMER BANK MI    TALMER WEST BANK                    MILANSING                  Y N20140325
+072413861LEVEL ONE BANK    LEVEL ONE BANK                      MIFARMINGTON HILLS         Y N20110318
+072413900BANK OF HOLLAND   THE BANK OF HOLLAND                 MIHOLLAND                  Y Y19990727
+072413926NORTHPOINTE BANK  NORTHPOINTE BANK                    MIGRAND RAPIDS             Y Y20081006
+072413939CLARKSTON ST BANK CLARKSTON STATE BANK                MICLARKSTON                Y N20040408
+072413942COMM SHORES BANK  COMMUNITY SHORES BANK               MIMUSKEGON                 Y Y20010427
+072413997TRAVERSE CITY ST  TRAVERSE CITY STATE BANK            MITRAVERSE CITY            Y Y20081002
+072414006BANK OF N MICHIGANTHE BANK OF NORTHERN MICHIGAN       MIPETOSKY                  Y N20120605
+072414019HANTZ BANK        HANTZ BANK                          MISOUTHFIELD               Y N20120730
+072414022MERCANTILE MI     MERCANTILE BANK OF WEST MICHIGAN    MISHEPHERD                 Y N20140630
+072414051BANK OF ALPENA    BANK OF ALPENA                      MIALPENA                   Y Y20081006
+072414064NORTHSTAR BANK    NORTHSTAR BANK                      MIBAD AXE                  Y N20010302
+072414093BANK OF ANN ARBOR BANK OF ANN ARBOR                   MIANN ARBOR                Y N20100813
+072414132HORIZON BK N.A.   HORIZON BANK, NATIONAL ASSOCIATION  INMICHIGAN CITY            Y N20140425
+0724141581ST STATE BANK    1ST STATE BANK                      MISAGINAW                  Y N20080715
+072414161BANK OF MICHIGAN  BANK OF MICHIGAN                    MIFARMINGTON HILLS         Y N20100616
+072414174MAIN STREET BANK  MAIN STREET BANK                    MIBINGHAM FARMS            Y N20100805
+072414187TALMER BANK MI    TALMER WEST BANK                    MIANN ARBOR                Y N20140325
+072414190HURON VALLEY ST BKHURON VALLEY STATE BANK             MIMILFORD                  Y Y20051118
+072414226BANK OF BIRMINGHAMBANK OF BIRMINGHAM                  MIBIRMINGHAM               Y Y20090803
+072414239FIRST NAT BK OF MIFIRST NATIONAL BANK OF MICHIGAN     MIKALAMAZOO                Y N20121212
+072414242LOTUS BANK        LOTUS BANK                          MINOVI                     Y Y20081021
+072414255TALMER BK & TRUST TALMER BANK AND TRUST               MITROY                     Y Y20110614
+072414310LEVEL ONE BANK    LEVEL ONE BANK                      MIFARMINGTON HILLS         Y N20140207
+072414378GRAND RIVER BANK  GRAND RIVER BANK                    MIGRANDVILLE               Y N20090507
+072414394ANN ARBOR STATE BKANN ARBOR STATE BANK                MIANN ARBOR                Y N20090123
+072471887NORTHERN TR MICH  THE NORTHERN TRUST COMPANY          ILCHICAGO                  Y N20121005
+072471955AUTOCLUB TRUST FSBAUTO CLUB TRUST, FSB                MIDEARBORN                 Y N20110602
+072486791DFCU FINANCIAL FCUDFCU FINANCIAL                      MIGRAND RAPIDS             Y Y20091026
+073000545US BANK IOWA      US BANK, NA                         WIMILWAUKEE                Y N20140304
+073000642BK CO DES MOINES  BANKERS TRUST COMPANY               IADES MOINES               Y Y20100202
+073000794IOWA ST D MOINES  IOWA STATE BANK                     IADES MOINES               Y Y        
+073000914FED HOME LOAN DSM FEDERAL HOME LOAN BANK              IADES MOINES               Y N20101116
+073900085SECURITY SIOUX    SECURITY NATIONAL BANK              IASIOUX CITY               Y Y        
+073900108GUARANTY CEDAR RAPGUARANTY BANK & TRUST CO.           IACEDAR RAPIDS             Y Y        
+073900182NORTHWEST DAVENPRTNORTHWEST BANK & TRUST CO.          IADAVENPORT                Y Y        
+073900441NATL BK CLINTON   CLINTON NATIONAL BANK               IACLINTON                  Y Y        
+073900522AMER TR DUBUQUE   AMERICAN TRUST & SVGS BANK          IADUBUQUE                  Y Y        
+073900535DUBUQUE BK TR     DUBUQUE BANK & TRUST COMPANY        IADUBUQUE                  Y Y        
+073900577TWO RIVERS BK & TRTWO RIVERS BANK & TRUST             IABURLINGTON               Y Y20071024
+073900580FARMERS & MERCHANTFARMERS & MERCHANTS BANK & TRUST    IABURLINGTON               Y Y20081016
+073900739PINNACLE BANK     PINNACLE BANK SIOUX CITY            IASIOUX CITY               Y Y20060303
+073900742CENT ST MUSCATINE CENTRAL STATE BANK                  IAMUSCATINE                Y Y        
+073900755FIRST MUSCATINE   1ST NATIONAL BANK/MUSCATINE         IAMUSCATINE                Y Y        
+073900807AMER FORT DODGE   FIRST AMERICAN BANK                 IAFORT DODGE               Y Y20010312
+073900894STATE CENT KEOKUK STATE CENTRAL BANK                  IABONAPARTE                Y Y20140317
+073901097BOONE B&TC        BOONE BANK & TRUST COMPANY          IABOONE                    Y N20121212
+073901233MIDWESTONE B&T    MIDWESTONE BK                       IAIOWA CITY                Y Y20080812
+073901288FIRST NATL BANK   TRUBANK                             IAOSKALOOSA                Y Y20130628
+073901301LEE FORT MADISON  LEE COUNTY BANK & TRUST, N.A.       IAFORT MADISON             Y N20051205
+073901314FORT MADISON BK   FORT MADISON BK                     IAFORT MADISON             YSY20070801
+073901411IOWA CENTERVILLE  IOWA TRUST & SAVINGS BANK           IACENTERVILLE              Y Y        
+073901479FIRST CRESTON     FIRST NATIONAL BANK/CRESTON         IACRESTON                  Y Y        
+073901495IOWA ST CRESTON   IOWA STATE SAVINGS BANK             IACRESTON                  YSY20070914
+073901602FIRST CTZN MSN CTYFIRST CITIZENS NATIONAL BANK        IAMASON CITY               Y Y        
+073901851CENTURY BANK      CENTURY BANK                        IASHENANDOAH               N Y20131213
+073901877FIRST FAIRFIELD IAFIRST NATL BANK/FAIRFIELD           IAFAIRFIELD                Y Y        
+073901880IOWA ST FAIRFIELD IOWA STATE BANK & TRUST CO          IAFAIRFIELD                Y N20121212
+073901903FIRST IOWA ALBIA  FIRST IOWA STATE BANK               IAALBIA                    YSN20050304
+073901974CHEROKEE ST BK    CHEROKEE STATE BANK                 IACHEROKEE                 N Y20000317
+073902096NEWTON NATL       FIRST NEWTON NATIONAL BANK          IANEWTON                   Y Y        
+073902180GTS SOUTHERN SF MOGREAT SOUTHERN BANK                 IAELDRIDGE                 Y N20140620
+073902216WASHINGTON ST BK  WASHINGTON ST BK                    IAWASHINGTON               YSY20050926
+073902232QD CTY B&T BTTNDRFQUAD CITY BANK AND TRUST COMPANY    IABETTENDORF               Y Y        
+073902274FIRST AMES        FIRST NATIONAL BANK, AMES           IAAMES                     Y Y        
+073902287UNITED BK IOWA    UNITED BANK OF IOWA                 IAIDA GROVE                Y Y20101008
+073902313PRIMEBANK         PRIMEBANK                           IALE MARS                  Y Y20050713
+073902342STATE GLENWOOD    GLENWOOD STATE BANK                 IAGLENWOOD                 YSY20060822
+073902436MDWST HERITAGE FSBMIDWEST HERITAGE BANK, FSB          IACHARITON                 Y Y        
+073902546CARROLL COUNTY    CARROLL COUNTY STATE BANK           IACARROLL                  YSY20060213
+073902672SEC EAGLE GROVE   SECURITY SAVINGS BANK               IAEAGLE GROVE              YSY20000324
+073902766FIRST WAVERLY IA  FIRST NATIONAL BANK                 IAWAVERLY                  YSY20060228
+073902889CRAWFORD DENISON  CRAWFORD COUNTY TRUST & SAVINGS BANKIADENISON                  Y Y20091013
+073903053CITIZENS ANAMOSA  CITIZENS SAVINGS BANK               IAANAMOSA                  N Y20110603
+073903147FARMERS WINTERSET FARMERS & MERCHANTS STATE BANK      IAWINTERSET                Y Y        
+073903150UNION STATE BANK  UNION STATE BANK                    IAWINTERSET                N Y20000317
+073903244FARMERS MANCHESTERFARMERS & MERCHANTS SAVINGS BANK    IAMANCHESTER               YSY20040618
+073903286CRESCO UNION SVNGSC US BANK                           IACRESCO                   Y Y20051108
+073903312HAMPTON STATE BANKHAMPTON STATE BANK                  IAHAMPTON                  YSY20000403
+073903325FIRST HAMPTON     FIRST BANK HAMPTON                  IAHAMPTON                  YSY20130627
+073903354WEST BANK         WEST BANK                           IAWDM                      Y Y20100805
+073903367FIRST W DES MOINESFIRST BANK                          IAWEST DES MOINES          Y Y        
+073903383MIDSTATES BK N.A. MIDSTATES BANK, NATIONAL ASSOCIATIONIACOUNCIL BLUFFS           Y Y20081204
+073903396SHELBY HARLAN     THE SHELBY COUNTY ST BK             IAHARLAN                   Y Y        
+073903419FIRST ST COLFAX   FIRST STATE BANK OF COLFAX          IACOLFAX                   Y Y        
+073903493HOME TR OSAGE     HOME TRUST AND SAVINGS BANK         IAOSAGE                    YSY20020429
+073903503CENTRAL BK        CENTRAL BANK                        IASTORM LAKE               Y Y20031201
+073903516CITIZEN STORM LAKECITIZEN FIRST NATIONAL STORM LAKE   IASTORM LAKE               YSY20080311
+073903529SECUR STORM LAKE  SECURITY TRUST & SAVINGS BANK       IASTORM LAKE               Y Y        
+073904036CLEAR LAKE BK     CLEAR LAKE BANK AND TRUST CO        IACLEAR LAKE               Y Y        
+073904117HARDIN CO ELDORA  HARDIN COUNTY SAVINGS BANK/ELDORA   IAELDORA                   N Y19991021
+073904159AUDUBON STATE     AUDUBON STATE BANK                  IAAUDUBON                  N Y20080501
+073904447OKEY FIRST NATL COOKEY VERNON FIRST NATIONAL BANK     IACORNING                  YSY20090730
+073904560BANK 1ST          BANK 1ST                            IAWEST UNION               YSY20130605
+073904751MT VERNON BK TRUSTMOUNT VERNON BANK & TRUST COMPANY   IAMOUNT VERNON             N Y20060825
+073904887FIRST LOGAN       LOGAN STATE BANK                    IALOGAN                    YSN20140102
+073904890FIRST MANNING     FIRST NATIONAL BANK MANNING         IAMANNING                  Y N        
+073904939FIRST STATE BANK  FIRST STATE BANK                    IASUMNER                   Y Y20000807
+073905064GNB BANK          GNB BANK                            IAGRUNDY CENTER            YSY20121113
+073905116SIBLEY STATE BANK SIBLEY STATE BANK                   IASIBLEY                   Y Y        
+073905187VISIONBANK        VISIONBANK OF IOWA                  IAAMES                     Y Y20121012
+073905352ACKLEY STATE BANK ACKLEY STATE BANK                   IAACKLEY                   N Y20020503
+073905527LINCOLN REINBECK  LINCOLN SAVINGS BANK                IAREINBECK                 N Y20001229
+073905608CENT ST BK ELKADERCENTRAL STATE BANK ELKADER          IAELKADER                  Y Y        
+073905873SECURITY NATL BK  SECURITY NATIONAL BANK              IAAKRON                    Y N20100513
+073905912ROLLING HLS ANITA ROLLING HILLS BANK & TRUST          IAATLANTIC                 YSY20060125
+073906034VALLEY MAPLETON   VALLEY BANK & TRUST                 IAMAPLETON                 Y N20091231
+073906092IOWA COON RAPIDS  IOWA SAVINGS BANK                   IACOON RAPIDS              Y Y        
+073906131AMERICAN BANK NA  AMERICAN BANK, N.A.                 IALE MARS                  Y Y20000828
+073906160FARM WILLIAMSBURG FARMERS TRUST & SAVINGS BANK        IAWILLIAMSBURG             Y Y        
+073906306GREAT WESTERN BK  GREAT WESTERN BANK                  IACHARITON                 Y N20070126
+073906801EXCHANGE ST ADAIR EXCHANGE STATE BANK/ADAIR           IAADAIR                    N Y20050504
+073906856CENT STATE CENTER CENTRAL STATE BANK                  IASTATE CENTER             N Y20100715
+073906872IOWA ST BK WAPELLOIOWA STATE BANK                     IAWAPELLO                  YSY20060228
+073907091FIRST GLIDDEN     UNITED BANK OF IOWA                 IAGLIDDEN                  Y N20060905
+073907208FARMERS BANCROFT  FARMERS AND TRADERS SAVINGS BANK    IABANCROFT                 N Y20060331
+073907334BRIDGE COMM BANK  BRIDGE COMMUNITY BANK               IAMECHANICSVILLE           Y Y19990826
+073907541FARMERS LONE TREE FARMERS & MERCHANTS SAVINGS BANK    IALONE TREE                N Y20050824
+073907664AMERICAN TRIPOLI  AMERICAN SAVINGS BANK               IATRIPOLI                  Y Y        
+073907729FARMER BUFFALO CENFARMERS TRUST & SAVINGS BANK        IABUFFALO CENTER           Y Y        
+073907774ST ANSGAR ST BK   ST. ANSGAR STATE BANK               IASAINT ANSGAR             YSY20100805
+073907855FIRST PRIMGHAR    FIRST NATIONAL BANK/PRIMGHAR        IAPRIMGHAR                 Y Y        
+073908045FARMERS JESUP     FARMERS STATE BANK                  IAJESUP                    Y N        
+073908155WEST IOWA BANK    WEST IOWA BANK                      IAWEST BEND                N Y20040211
+073908317COMMUNITY ST BK   COMMUNITY STATE BANK                IATIPTON                   YSY20110811
+073908511STATE SCHALLER    STATE SCHALLER                      IASCHALLER                 Y Y        
+073908728FIRST AURELIA     FIRST TRUST & SAVINGS BANK          IAAURELIA                  Y Y20000410
+073908760NORTHEAST SEC BK  NORTHEAST SECURITY BANK             IAFAIRBANK                 N Y20120313
+073908980CITIZENS BANK     GREAT WESTERN BANK                  IAMOUNT AYR                Y N20070126
+073909222NORTHEAST SEC BK  NORTHEAST SECURITY BANK             IASUMNER                   N Y        
+073909578FARMERS WHITTEMOREFARMERS STATE BANK                  IAALGONA                   N Y        
+073909620CITIZENS HAWKEYE  CITIZENS SAVINGS BANK               IAHAWKEYE                  Y N20140107
+073910091FRST CENTRL DEWITTFIRST CENTRAL STATE BANK            IADE WITT                  Y Y20100805
+073910114COMMUNITY ANKENY  COMMUNITY STATE BANK                IAANKENY                   Y Y20140702
+073910237FIRST FARRAGUT    FIRST HERITAGE BANK                 IASHENANDOAH               YSY20120319
+073910389WALCOTT BK        WALCOTT TRUST & SAVINGS BANK        IAWALCOTT                  N Y20000317
+073911061CITY STATE BANK   CITY STATE BANK                     IANORWALK                  Y Y20020808
+073911278LANDMANDS AUDUBON LANDMANDS BANK                      IAAUDUBON                  Y N20090408
+073911676FARMERS MARION    FARMERS STATE BANK                  IAMARION                   Y N20121219
+073911812ASHTON STATE BK   ASHTON STATE BANK                   IAASHTON                   N Y20000317
+073911870OHNWARD BANK & TR OHNWARD BANK & TRUST                IACASCADE                  Y Y20091023
+073912073ST BK BLENCOE     BLENCOE STATE BANK                  IAONAWA                    YSY20120316
+073912086BLUE GRASS SAVINGSBLUE GRASS SAVINGS BANK             IABLUE GRASS               Y Y        
+073913069FARMERS EARLING   FARMERS TRUST & SAVINGS BANK        IAEARLING                  N Y20090707
+073913195FAIRFAX STATE     FAIRFAX STATE SAVINGS BANK          IAFAIRFAX                  Y Y        
+073913467GATEWAY ST BANK   GATEWAY STATE BANK                  IACLINTON                  Y Y20060608
+073913674MARION COUNTY BANKMARION COUNTY STATE BANK            IAPELLA                    N Y        
+073913755HILLS BK TR       HILLS BANK & TRUST COMPANY          IAHILLS                    Y Y20140224
+073913836FARMERS MITCHELLVLLEGACY BANK                         IAALTOONA                  YSY20060929
+073913959IOWA CLARKSVILLE  IOWA STATE BANK                     IACLARKSVILLE              Y Y        
+073914220LIBERTYVILLE SAVGSLIBERTYVILLE SAVINGS BANK           IALIBERTYVILLE             N Y20020222
+073914288COMMUNITY EDGEWOODCOMMUNITY SAVINGS BANK              IAEDGEWOOD                 Y Y        
+073914356LUANA SAVINGS BK  LUANA SAVINGS BANK                  IALUANA                    N Y20110825
+073914398DYERSVILLE FIDELITFIDELITY BANK AND TRUST             IADUBUQUE                  Y Y20110614
+073914411FIRST STATE BANK  FIRST STATE BANK                    IALYNNVILLE                N Y20070323
+073914550MAYNARD SAVINGS   MAYNARD SAVINGS                     IAMAYNARD                  Y N20140108
+073915494READLYN SAVINGS BKTHE READLYN SAVINGS BANK            IAREADLYN                  N Y20000317
+073915520FIRST REMBRANT    FIRST NATIONAL BANK OF REMBRANDT    IAREMBRANDT                Y N20010419
+073915656ROWLEY SAVINGS    THE ROWLEY SAVINGS BK               IAROWLEY                   Y N20120524
+073915782PIONEER SERGENT BLPIONEER BANK                        IASERGEANT BLUFF           Y Y        
+073915847NORTHWEST BANK    NORTHWEST BANK                      IASPENCER                  Y N20131011
+073915889HEARTLAND BANK    HEARTLAND BANK                      IASOMERS                   YSY20140409
+073916406WATKINS SAVINGS BKTHE WATKINS SAVINGS BANK            IAWATKINS                  N Y20090908
+073916668PILOT GROVE SAVINGPILOT GROVE SAVINGS BANK            IAPILOT GROVE              Y N20050929
+073917175MELVIN SAVINGS    MELVIN SAVINGS BANK                 IAMELVIN                   YSN20060531
+073917285PEO CRAWFORDSVILLEPEOPLES SAVINGS BANK                IACRAWFORDSVILLE           N Y20010727
+073917641AMER SIOUX CENTER AMERICAN STATE BANK                 IASIOUX CENTER             Y Y        
+073917764IOWA STATE BANK   IOWA STATE BANK                     IAHULL                     Y N20140110
+073917887COMMUNITY ST BK   COMMUNITY STATE BANK                IAALBERT CITY              YSY20110506
+073918077SECURITY SAV BANK SECURITY SAVINGS BANK               IAGOWRIE                   Y Y19990826
+073918103FARM ST LAKE VIEW FARMERS STATE BANK                  IALAKE VIEW                Y Y        
+073918378HOME STATE BK     HOME STATE BANK                     IAROYAL                    N Y20000317
+073918608UNITED B&TC SHFFLDUNITED BANK AND TRUST COMPANY       IAHAMPTON                  Y N20010206
+073918844TITONKA SAVINGS   TITONKA SAVINGS BANK                IATITONKA                  N Y20080310
+073918857FARMERS SPENCER   FARMERS TRUST & SAVINGS BANK        IASPENCER                  Y Y        
+073919005FARMERS SAV BK    FARMERS SAVINGS BANK                IAFOSTORIA                 N Y20000317
+073919212PEOPLES GRAND JUNCPEOPLES TRUST & SAVINGS BANK        IACLIVE                    Y Y20090416
+073919270TRI VALLEY BANK   TRI-VALLEY BANK                     IARANDOLPH                 N Y20090811
+073920162SECURITY SAVINGS  SECURITY SAVINGS BANK               SDCANTON                   N Y20140528
+073920285BANKIOWA          BANKIOWA                            IAINDEPENDENCE             Y Y19990826
+073920405TEMPLETON SAVINGS TEMPLETON SAVINGS BANK              IATEMPLETON                YSY20061013
+073920418BANK IOWA         BANK IOWA                           IAWEST DES MOINES          Y Y20120912
+073920447TREYNOR STATE BANKTREYNOR STATE BANK                  IATREYNOR                  N Y20070112
+073920531PEOPLES SAVINGS   PEOPLES SAVINGS BANK                IAWELLSBURG                N Y20100518
+073920612FARMERS & MERCHANTFARMERS & MERCHANTS SAVINGS BANK    IAWAUKON                   N Y20090504
+073920638HOME JEFFERSON    HOME STATE BANK                     IAJEFFERSON                N Y20131114
+073920654IOWA STATE BANK   IOWA STATE BANK                     IASAC CITY                 N Y20060929
+073920706CITIZEN POCAHONTASCITIZENS STATE BANK                 IAFORT DODGE               YSY20080321
+073920735ST COLUMBUS JUNCT COLUMBUS JUNCTION STATE BANK        IACOLUMBUS JUNCTION        YSY20080729
+073920777PINNACLE BANK     PINNACLE BANK                       IAMARSHALLTOWN             N Y20050628
+073920845GREAT WESTERN BK  GREAT WESTERN BANK                  IACLIVE                    Y N20070126
+073920874FIRST STATE BRITT FIRST STATE BANK                    IABRITT                    YSY20050707
+073920887RACCOON VALLEY BK RACCOON VALLEY BANK                 IAPERRY                    N Y20060330
+073920926RELIANCE STATE BK RELIANCE STATE BANK                 IASTORY CITY               Y N20120426
+073920939IOWA STATE ALGONA IOWA STATE BANK                     IAALGONA                   Y N20101116
+073920997THE STATE BK      THE STATE BANK                      IASPIRIT LAKE              N Y20000317
+073921006FARMERS MARCUS    FARMERS STATE BANK                  IAMARCUS                   Y N20130115
+073921048CITIZENS BK       CITIZENS BANK                       IASAC CITY                 N Y20000317
+073921093IOWA BK EMMETSBURGIOWA TRUST & SAVINGS BANK           IAEMMETSBURG               N Y20081014
+073921158EARLHAM SAVINGS   EARLHAM SAVINGS BANK                IAWEST DES MOINES          Y N20120917
+073921187BURT SAVINGS BANK SECURITY STATE BANK                 IAALGONA                   Y N20000505
+073921190CITIZENS STATE BK CITIZENS STATE BANK                 IAMONTICELLO               N Y20050419
+073921213FIRST TRUST BK    FIRST TRUST & SAVINGS BANK          IACORALVILLE               N Y20081119
+073921323UNITED COMM BK    UNITED COMMUNITY BANK               IAMILFORD                  Y N20130115
+073921420FRONTIER BANK     FRONTIER BANK                       IAROCK RAPIDS              Y Y20081210
+073921433UNITED BANK OF IA UNITED BANK OF IOWA                 IAIDA GROVE                Y Y19990826
+073921527IOWA FALLS STATE  IOWA FALLS STATE BANK               IAIOWA FALLS               Y N        
+073921530NORTHWOODS SB     NSB BANK                            IAMASON CITY               N Y20130509
+073921556MANSON ST BK      MANSON STATE BANK                   IAMANSON                   N Y20000317
+073921585FIRST ST IDA GROVEFIRST STATE BANK                    IAIDA GROVE                N Y20000317
+073921611ROLFE STATE BANK  ROLFE STATE BANK                    IAROLFE                    YSN20061117
+073921624MIDWESTONE BANK   MIDWESTONE BK                       IAIOWA CITY                Y N20100322
+073921682KEYSTONE SAVINGS  KEYSTONE SAVINGS BANK               IAKEYSTONE                 N Y20110215
+073921695DECORAH BK & TR   DECORAH BANK & TRUST COMPANY        IADECORAH                  N Y20081007
+073921718FIRST WEBSTER CITYFIRST STATE BANK                    IAWEBSTER CITY             YSN20130308
+073921776CHELSEA SAVINGS   CHELSEA SAVINGS BANK                IABELLE PLAINE             YSN20060315
+073921792STATE BK          STATE BANK                          IANEW HAMPTON              YSY20140317
+073921831STATE NEVADA      STATE BANK & TRUST CO               IANEVADA                   YSN20130308
+073921860SEC ST BK WARLEY  SECURITY STATE BANK                 IAWAVERLY                  Y N        
+073921873MEDIAPOLIS SAV BK MEDIAPOLIS SAVINGS BANK             IAMEDIAPOLIS               N Y20000317
+073921909SECURITY STATE BK SECURITY STATE BANK                 IAINDEPENDENCE             N Y20000317
+073921954STATE BANK EVERLY STATE BANK                          IAEVERLY                   YSY20140324
+073921967FIRST ST STUART   FIRST STATE BANK                    IASTUART                   Y N        
+073922005PEO ROCK VALLEY   PEOPLES BANK                        IAROCK VALLEY              Y Y20080710
+073922018LINN COUNTY ST    LINN COUNTY STATE BANK              IACOGGON                   N Y19990908
+073922092NXT BANK          NXT BANK                            IACENTRAL CITY             Y Y20131023
+073922115SWEA CITY STATE BKBANK PLUS                           IAESTHERVILLE              N Y20000317
+073922209SECURITY STANTON  GREAT WESTERN BANK                  IARED OAK                  Y N20070126
+073922322CITIZENS SHELDON  CITIZENS STATE BANK                 IASHELDON                  YSN20061117
+073922351MAQUOKETA STATE   MAQUOKETA STATE BANK                IAMAQUOKETA                Y Y        
+073922403AMERICAN STATE BK AMERICAN STATE BANK                 IAOSCEOLA                  YSY20050131
+073922429CENTRAL BANK      CENTRAL BANK                        IASTORM LAKE               Y N20140124
+073922432IOWA STATE BANK   IOWA STATE BANK                     IAHULL                     Y Y20030213
+073922458COMM BK MUSCATINE COMMUNITY BANK & TRUST              IAMUSCATINE                N Y20140505
+073922555PREMIER BANK      PREMIER BANK                        IAROCK VALLEY              N Y19990908
+073922597CEDAR RAPIDS B&T  COMMUNITY BANK & TRUST              IACEDAR RAPIDS             Y N20131025
+073922652PREMIER BANK      PREMIER BANK                        IADUBUQUE                  Y Y20100309
+073922704FREEDOM FINANCIAL FREEDOM FINANCIAL BANK              IAWEST DES MOINES          N Y20090921
+073922720VISIONBANK        VISIONBANK OF IOWA                  IAOGDEN                    YSN20121012
+073922791VISIONBANK        VISIONBANK OF IOWA                  IAOGDEN                    YSN20121012
+073922801CEDAR RAPIDS B&T  CEDAR RAPIDS BANK & TRUST CO.       IACEDAR RAPIDS             Y Y20020318
+073922843UNITED BK & TR    UNITED BANK & TRUST, N.A.           IAMARSHALLTOWN             YSY20050126
+073922856BANKERS TRUST COMPBANKERS TRUST COMPANY               IACEDAR RAPIDS             Y N20120326
+073922869LIBERTY NATL BK   LIBERTY NATIONAL BANK               IASIOUX CITY               Y Y20040609
+073922937VISIONBANK        VISIONBANK OF IOWA                  IAAMES                     Y N20121012
+074000010JPMCHASE INDIANA  JPMORGAN CHASE BANK, NA             ININDIANAPOLIS             Y N20050509
+074001019FED HOME LOAN IND FEDERAL HOME LOAN BANK              ININDIANAPOLIS             Y N20040729
+074006674NATL BK INDIANAPLSTHE NATIONAL BANK OF INDIANAPOLIS   ININDIANAPOLIS             Y Y        
+074014035SALIN BANK & TRUSTSALIN BANK & TRUST COMPANY          ININDIANAPOLIS             Y Y        
+074014187INTERNET BK       FIRST INTERNET BANK OF INDIANA      ININDIANAPOLIS             Y N20050818
+074014213UNION PLANTERS, INREGIONS BANK                        ININDIANAPOLIS             Y N20050811
+074014420INDIANA BUS BK    INDIANA BUSINESS BANK               ININDIANAPOLIS             YSN20110411
+074014433MERCHANTS BANK IN MERCHANTS BANK OF INDIANA           ININDIANAPOLIS             Y N20090727
+074900356FIRST FINANCIAL BKFIRST FINANCIAL BANK, N.A.          INTERRE HAUTE              Y Y20120312
+074900657MERCHANTS MUNCIE  FIRST MERCHANTS BANK, N.A.          INMUNCIE                   Y Y        
+074900783US BANK INDIANA   US BANK, NA                         INRICHMOND                 Y N20140304
+074901672STAR FIN BK INDPLSSTAR FINANCIAL BANK                 INFORT WAYNE               Y Y20050728
+074902082CITIZ NEW CASTLE  CITIZENS STATE BANK/NEW CASTLE      INNEW CASTLE               Y N20130325
+074902228RIDDELL BRAZIL    RIDDELL NATL BANK BRAZIL            INBRAZIL                   Y Y        
+074902503FARMERS FRANKFT INTHE FARMERS BANK                    INFRANKFORT                Y Y        
+074903308MAINSOURCE BANK   MAINSOURCE BANK                     INGREENSBURG               Y Y20110310
+074903544CAMPBELL KENDALVLETHE CAMPBELL & FETTER BANK          INKENDALLVILLE             Y N20130325
+074903670GREENFIELD BANK COGREENFIELD BANKING COMPANY          INGREENFIELD               Y N20130325
+074903719LAKE CITY WARSAW  LAKE CITY BANK                      INWARSAW                   Y Y        
+074903803GARRETT ST BK     GARRETT ST BK                       INGARRETT                  Y Y        
+074904158CEN NATL ATTICA   CENTRAL NATIONAL BANK ATTICA        INATTICA                   Y Y        
+074904352CITIZ EX FAIRMOUNTCITIZENS EXCHANGE BANK              INFAIRMOUNT                Y Y        
+074904365ST BK FAIRMOUNT   THE FAIRMOUNT STATE BANK            INFAIRMOUNT                Y Y        
+074904530WAYNE TR CAMBRIDGEWAYNE BANK & TRUST CO               INCAMBRIDGE CITY           Y Y        
+074904640FRANKLIN BROOKVL  FCN BANK, NATIONAL ASSOCIATION      INBROOKVILLE               Y Y        
+074904860FOUNTAIN COVINGTONTHE FOUNTAIN TRUST COMPANY          INCOVINGTON                Y Y        
+074905225CITIZENS BANK     CITIZENS BANK                       INMOORESVILLE              Y Y20100128
+074905319HOME BK THORNTOWN HOME NATIONAL BANK THORNTOWN        INTHORNTOWN                YSY20110316
+074905380CMMUNTY BK SMMTVLLCOMMUNITY BANK                      INNOBLESVILLE              Y Y        
+074905474FIRST BERNE       FIRST BANK OF BERNE                 INBERNE                    Y Y        
+074905872BANK OF GENEVA    BANK OF GENEVA                      INGENEVA                   Y Y        
+074906059FARMERS BROOKSTON FARMERS STATE BANK BROOKSTON        INBROOKSTON                Y N20060301
+074906091HENDRICKS BROWNSBUHENDRICKS COUNTY BANK AND TRUST     INBROWNSBURG               Y Y        
+074906211FARMERS BOSWELL   THE FARMERS & MERCHANTS BANK        INBOSWELL                  Y N20130325
+074906428FARMERS ST MENTONEFARMERS STATE BANK                  INMENTONE                  YSY20060801
+074906800COMM ST BK AVILLA COMMUNITY STATE BANK AVILLA         INAVILLA                   Y Y20100929
+074907126FIRST CLOVERDALE  FIRST NATIONAL BANK                 INCLOVERDALE               Y Y        
+074907414PEOPLES ST BANK   THE PEOPLES STATE BANK              INELLETTSVILLE             Y Y19990826
+074908138ST BK LIZTON      STATE BANK OF LIZTON                INLIZTON                   Y N20130326
+074908426FIRST MONTEREY    FIRST NATIONAL BANK/MONTEREY        INMONTEREY                 Y Y        
+074908510ST BK NAPOLEON    THE NAPOLEON STATE BANK             INNAPOLEON                 Y N20120327
+074908934TRI-COUNTY ROACHDLTRI-COUNTY BANK & TRUST COMPANY     INROACHDALE                Y N20130326
+074909153MERCHANTS BANK IN MERCHANTS BANK OF INDIANA           INLYNN                     Y N20130326
+074909218GRANT ST SWAYZEE  GRANT COUNTY STATE BANK             INSWAYZEE                  Y N20091217
+074909661BIPPUS STATE BANK THE BIPPUS STATE BANK               INBIPPUS                   N Y20080125
+074909988FRIENDSHIP ST BK  FRIENDSHIP ST BK                    INFRIENDSHIP               YSY20100608
+074910087OSSIAN STATE BANK OSSIAN STATE BANK                   INOSSIAN                   Y Y        
+074910799MAINSOURCE BANK   MAINSOURCE BANK                     INBROOKVILLE               Y N20110310
+074910854FARM ST LA GRANGE FARMERS STATE BANK                  INLAGRANGE                 Y Y20020408
+074911138DE MOTTE ST BK    DE MOTTE ST BK                      INDEMOTTE                  Y Y20100805
+074911219VEEDERSBURG STATE CENTREBANK                          INVEEDERSBURG              N Y20000317
+074911578FIRST CONVERSE    FIRST FARMERS BANK & TRUST          INCONVERSE                 Y Y        
+074912302BATH STATE BANK   THE BATH STATE BANK                 INBATH                     YSN20060403
+074912603MERCH W HARRISON  MERCHANTS BANK AND TRUST CO.        INWEST HARRISON            Y N20130326
+074912645COMM ST ROYAL CENTCOMMUNITY STATE BANK                INROYAL CENTER             Y Y        
+074912755FOWLER STATE BANK FOWLER STATE BANK                   INFOWLER                   Y Y        
+074912784KENTLAND BANK     KENTLAND BANK                       INKENTLAND                 Y Y        
+074912865OWEN ST BK SPENCEROWEN COUNTY STATE BANK              INSPENCER                  Y Y        
+074913233BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+074913275IAB FINANCIAL     IAB FINANCIAL BANK                  INFORT WAYNE               Y Y20121025
+074913314IAB FINANCIAL BANKIAB FINANCIAL BANK                  INFORT WAYNE               Y N20121025
+074913699CITIZ NEW CASTLE  CITIZENS STATE BANK/NEW CASTLE      INNEW CASTLE               Y N20131002
+074914067HORIZON BANK N.A. HORIZON BANK, NATIONAL ASSOCIATION  INMICHIGAN CITY            Y N20121128
+074914313LAFAYETTE COMM BK LAFAYETTE COMMUNITY BK              INLAFAYETTE                Y N20050919
+074914407COMMUNITY FIRST   COMMUNITY FIRST BNK OF HOWARD COUNTYINKOKOMO                   Y Y20030918
+075000019JPMCHASE WISCONSINJPMORGAN CHASE BANK, NA             WIMILWAUKEE                Y N20050509
+075000022US BANK WISCONSIN US BANK, NA                         OHCINCINNATI               Y N20140304
+075000051BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+075000103US BANK MILW      US BANK, NA                         WIMILWAUKEE                Y N20130221
+075000569MITCHELL BANK     MITCHELL BANK                       WIMILWAUKEE                Y Y        
+075000666PARK BANK         PARK BANK                           WIMILWAUKEE                Y Y        
+075000721LAYTON MILWAUKEE  LAYTON STATE BANK                   WIWEST ALLIS               Y Y20130905
+075000734BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+075001047SECURANT BK & TR  SECURANT BANK & TRUST               WIMILWAUKEE                Y Y20080910
+075001199TRI-CITY OAK CREEKTRI-CITY NATIONAL BANK              WIWEST ALLIS               Y Y19990826
+075011930NORTH MILWAUKEE   NORTH MILWAUKEE STATE BANK          WIMILWAUKEE                Y Y        
+075072199THE PRIVATE BANK  THE PRIVATEBANK AND TRUST COMPANY   ILCHICAGO                  Y N20120328
+075900465US BANK MADISON   US BANK, NA                         WIMILWAUKEE                Y N20140303
+075900575ASSOCIATED GRN BAYASSOCIATED BANK, N.A.               WIGREEN BAY                Y Y20120320
+075900766EXCHG FOND DU LAC NATIONAL EXCHANGE BANK & TR         WIFOND DU LAC              Y Y        
+075900973FIRST BELOIT WI   FIRST NATIONAL BANK & TRUST COMPANY WIBELOIT                   Y Y20011004
+075901011STEPHENS MARINETTESTEPHENSON NATIONAL BANK            WIMARINETTE                Y Y        
+075901037FARMER & MERCHANT FARMERS & MERCHANTS BK & TR         WIMARINETTE                N Y20000317
+075901134FIRST MANITOWOC   BANK FIRST NATIONAL                 WIMANITOWOC                Y Y20071113
+075901341WAUKESHA ST BK    WAUKESHA STATE BANK                 WIWAUKESHA                 Y Y20051125
+075901516AMERICAN BEAVER DMAMERICAN NATIONAL BANK              WIBEAVER DAM               YSY20061110
+075901561WOODTRUST         WOODTRUST BANK                      WIWISCONSIN RAPIDS         Y Y20140424
+075901590NATL BK BARABOO   THE BARABOO NATIONAL BANK           WIBARABOO                  Y Y20021213
+075901642FNB FOX VALLEY    THE FIRST NATL BK-FOX VALLEY        WINEENAH                   Y Y20091204
+075901998F & M KAUKAUNA    FIRSTMERIT BANK, N.A.               WIKAUKAUNA                 Y N20131018
+075902007FIRST BERLIN      1ST NATIONAL BANK OF BERLIN         WIBERLIN                   Y Y20100106
+075902052CLARE BK PLATTEVILCLARE BANK, NATIONAL ASSOCIATION    WIPLATTEVILLE              YSY20061012
+075902104BAYLAKE STURGN BAYBAYLAKE BANK                        WISTURGEON BAY             Y Y        
+075902120UNION BK SPARTA   UNION NATIONAL BANK & TRUST         WISPARTA                   YSY20070730
+075902175FIRST FT ATKINSON PREMIERBANK                         WIFORT ATKINSON            Y N20120321
+075902188BADGER BANK       BADGER BANK                         WIFORT ATKINSON            YSY20090821
+075902227PORT WASHINGTON   PORT WASHINGTON STATE BANK          WIPORT WASHINGTON          N Y20120214
+075902272FIRST BK OF TOMAH FIRST BANK                          WITOMAH                    N Y20000317
+075902308NATL WAUPUN       NATIONAL BANK OF WAUPUN             WIWAUPUN                   YSY20000403
+075902337CITIZ ST WHITEWATRFIRST CITIZENS STATE BANK           WIWHITEWATER               Y Y        
+075902421FIRST BANK OCONWOCFIRST BANK FINANCIAL CENTRE         WIOCONOMOWOC               Y Y20081106
+075902463FIRST HARTFORD WI 1ST NATIONAL BANK/HARTFORD          WIHARTFORD                 YSY20060731
+075902625BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+075902670FARMERS & MERCHANTFARMERS & MERCHANTS UNION BK        WICOLUMBUS                 N Y20000317
+075902816UNION EVANSVILLE  UNION BANK & TRUST COMPANY          WIEVANSVILLE               Y Y        
+075902832FIRST VIROQUA     CITIZENS FIRST BANK                 WIVIROQUA                  YSY20050610
+075902968JACKSON COUNTY BK JACKSON COUNTY BANK                 WIBLACK RIVER FALLS        N Y20000317
+075903019FIRST DARLINGTON  1ST NATIONAL BANK/DARLINGTON        WIDARLINGTON               Y Y19990826
+075903174BANK OF LAKE MILLSBANK OF LAKE MILLS                  WILAKE MILLS               YSY20090814
+075903200STATE BANK CHILTONSTATE BANK OF CHILTON               WICHILTON                  N Y20000317
+075903394BK OF WIS DELLS   BANK OF WISCONSIN DELLS             WIWISCONSIN DELLS          N Y20000317
+075903446SUN PRAIRIE BANK  BANK OF SUN PRAIRIE                 WISUN PRAIRIE              Y N20060308
+075903750MARKESAN ST BK    MARKESAN STATE BANK                 WIMARKESAN                 Y Y        
+075903792FIRST WAUPACA     FIRST NATIONAL BANK                 WIWAUPACA                  Y Y        
+075903831PREMIER COMMUNITY PREMIER COMMUNITY BANK              WIMARION                   Y Y20100603
+075904377PORTAGE COUNTY BK PORTAGE COUNTY BANK                 WIALMOND                   Y N20090528
+075904380INTL BANK AMHERST INTERNATIONAL BANK/AMHERST          WIAMHERST                  N Y20000317
+075904513BENTON STATE BANK THE BENTON STATE BANK               WIBENTON                   N Y19990920
+075904937BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+075904953ST BK CROSS PLAINSSTATE BANK OF CROSS PLAINS          WICROSS PLAINS             Y Y        
+075905033ST BK DENMARK     DENMARK STATE BANK                  WIDENMARK                  N Y20120720
+075905431BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+075905622HORICON STATE BANKHORICON  BANK                       WIHORICON                  Y N20041101
+075905787FIRST BUSINESS BK FIRST BUSINESS BANK                 WIMADISON                  Y Y        
+075905868BANK OF LITTLE    BLC COMMUNITY BANK                  WILITTLE CHUTE             N Y20060112
+075905871LIVINGSTON ST BK  LIVINGSTON STATE BANK               WILIVINGSTON               N Y20000317
+075905910BANK OF LUXEMBURG BANK OF LUXEMBURG                   WILUXEMBURG                N Y20000317
+075905965PEOPLES STATE BANKPEOPLES STATE BANK                  WIWAUSAU                   Y N20121012
+075905994PINERIES BANK     THE PINERIES BANK                   WISTEVENS POINT            N Y20080408
+075906016PEOPLES ST BK     THE PEOPLES COMMUNITY BANK          WIMAZOMANIE                N Y20050916
+075906171CITIZ MUKWONAGO   CITIZENS BANK OF MUKWONAGO          WIMUKWONAGO                Y Y        
+075906304BANK OF OAKFIELD  BANK OF OAKFIELD                    WIOAKFIELD                 Y Y20091104
+075906346OOSTBURG STATE    OOSTBURG STATE BANK                 WIOOSTBURG                 N Y20011123
+075906362FARMERS ORFORDVL  FARMERS & MERCHANTS BANK            WIORFORDVILLE              Y Y20120717
+075906469PESHTIGO NATL BANKTHE PESHTIGO NATIONAL BANK          WIPESHTIGO                 Y Y20101001
+075906595BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+075906702COMMUNITY FIRST BKCOMMUNITY FIRST BANK                WIROSHOLT                  N Y20000317
+075906838HERITAGE BANK     HERITAGE BANK                       WISPENCER                  N Y20031218
+075906854HOMETOWN BANK     HOMETOWN BANK                       WIFOND DU LAC              Y N20080716
+075906948THERESA STATE BK  TSB                                 WILOMIRA                   N Y20070209
+075906977FIRST TIGERTON    1ST NATIONAL BANK/TIGERTON          WITIGERTON                 Y N20121220
+075907002COMMUNITY STATE BKCOMMUNITY STATE BANK                WIUNION GROVE              N Y20100304
+075907073WALWORTH STATE BK WALWORTH STATE BANK                 WIWALWORTH                 Y N20120321
+075907112BANK NORTH        BANK NORTH                          WIWAUSAUKEE                N Y20000317
+075907374RIVER BANK        RIVER BANK                          WISTODDARD                 N Y19990819
+075907413FIRST COMMUNITY BKFIRST COMMUNITY BANK                WIMILTON                   N Y20000317
+075907442F & M BANK        FARMERS & MERCHANTS BANK            WITOMAH                    N Y20090130
+075907497FIRST STATE BANK  FIRST STATE BANK                    WINEW LONDON               N Y20000317
+075907552STATE BK NEWBURG  STATE BANK OF NEWBURG               WINEWBURG                  N Y20000317
+075908001COMM BK WHITEWATERCOMMERCIAL BANK                     WIWHITEWATER               Y Y        
+075908043NEKOOSA PT EDWARDSNEKOOSA PORT EDWARDS ST BANK        WINEKOOSA                  Y N20121220
+075908687MOUND CITY BANK   MOUND CITY BANK                     WIPLATTEVILLE              N Y20000317
+075909178FARMERS SAVINGS BKFARMERS SAVINGS BANK                WIMINERAL POINT            Y Y20090910
+075909204BK OF PRAIRIE     BANK OF PRAIRIE DU SAC              WIPRAIRIE DU SAC           YSY20120307
+075909408IXONIA STATE BANK IXONIA BANK                         WIIXONIA                   N Y20130827
+075909903DAIRYMAN STATE BK FIRST NATIONAL BANK                 WICLINTONVILLE             Y N20080128
+075909945THE PARK BANK     THE PARK BANK                       WIMADISON                  Y Y20030724
+075910206TALMER BK & TRUST TALMER BANK AND TRUST               MITROY                     Y N20110428
+075910400CALUMET BRILLION  CALUMET COUNTY BANK                 WIBRILLION                 Y Y        
+075910484DE FORREST BK     DMB COMMUNITY BANK                  WIDE FOREST                YSN20121220
+075910905BANK OF VIROQUA   THE BARABOO NATIONAL BANK           WIVIROQUA                  Y N20100324
+075910921FARMERS BERLIN    FARMERS & MERCHANTS BANK            WIBERLIN                   Y Y        
+075911182TRI CITY NATL BK  TRI-CITY NATIONAL BANK              WIRACINE                   Y N20120328
+075911616PEOPLES WAUSAU    PEOPLES STATE BANK                  WIWAUSAU                   Y Y        
+075911742RIVER VALLEY BANK RIVER VALLEY BANK                   WIWAUSAU                   N Y20120810
+075911852JOHNSON BANK      JOHNSON BANK                        WIRACINE                   Y Y20020422
+075912233BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+075912275OREGON COMM BK TR OREGON COMMUNITY BANK & TRUST       WIOREGON                   YSN20121220
+075912479BANKERS BK MADISONBANKERS' BANK                       WIMADISON                  Y Y        
+075912615COMMUNITY SHEBOYGNCOMMUNITY BANK AND TRUST CO         WISHEBOYGAN                Y Y20080605
+075912644BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+075912673COMM BK GRAFTON   CORNERSTONE COMMUNITY BANK          WIGRAFTON                  YSN20120309
+075912712MONONA STATE BANK MONONA STATE BANK                   WIMONONA                   N Y20000317
+075912806CAPITOL BANK      CAPITOL BANK                        WIMADISON                  YSN20140723
+075912819RIDGESTONE BANK   RIDGESTONE BANK                     WIBROOKFIELD               Y N20080924
+075912835WEST POINTE BANK  WEST POINTE BANK                    WIOSHKOSH                  N Y20000317
+075912864INVSTRS CMTY MNTWCINVESTORS COMMUNITY BANK            WIMANITOWOC                Y Y19990811
+075912877SOUTHPORT BANK    SOUTHPORT BANK                      WIKENOSHA                  Y N20060301
+075917791RIVER CITIES BANK RIVER CITIES BANK                   WIWISCONSIN RAPIDS         Y Y20140513
+075917843TOWN BANK         TOWN BANK                           WIHARTLAND                 Y Y20081024
+075917869THE BUSINESS BANK THE BUSINESS BANK                   WIAPPLETON                 Y Y20130918
+075917898PEOPLES BANK      PEOPLES BANK                        WIELKHORN                  N Y20090203
+075917908FIRST BUS BK-MILW FIRST BUSINESS BANK-MILWAUKEE       WIBROOKFIELD               Y N20121220
+075917911NSH FSB BRKFIELD  NORTH SHORE BANK, F.S.B.            WIBROOKFIELD               YSN20130930
+075917924OAK BK            OAK BANK                            WIMADISON                  Y Y20081120
+075917937NICOLET NATL BANK NICOLET NATIONAL BANK               WIGREEN BAY                Y Y20030606
+075917979FOX RIVER STATE BKFOX RIVER STATE BANK                WIBURLINGTON               N Y20110715
+075918004FOUNDATIONS BANK  FOUNDATIONS BANK                    WIPEWAUKEE                 YSY20101004
+075918017COMMERCE ST BK    COMMERCE STATE BANK                 WIWEST BEND                Y N20100809
+075918033INTEGRITY FIRST BKINTEGRITY FIRST BANK                WIWAUSAU                   N Y20090127
+075918091TOWN BANK         TOWN BANK                           ILSAVANNA                  Y N20140715
+075918130TIMBERWOOD BK     TIMBERWOOD BANK                     WITOMAH                    N Y20080326
+075972105WOLF RIVER COMM   WOLF RIVER COMMUNITY BANK           WIHORTONVILLE              N Y20100629
+075972134SUNSET BK & SAV   SUNSET BANK & SAVINGS               WIWAUKESHA                 Y Y20140603
+075972147THRIVENT CU       THRIVENT FEDERAL CREDIT UNION       WIAPPLETON                 Y Y20121206
+081000045FRB STL           FEDERAL RESERVE BANK OF ST. LOUIS   MOSAINT LOUIS              Y Y20100805
+081000168FIRST ILL E STL ILFIRST ILLINOIS BANK                 ILEAST SAINT LOUIS         Y Y20100805
+081000210US BANK MISSOURI  US BANK, NA                         MOSAINT LOUIS              Y N20140303
+081000524BREMEN BT STL MO  BREMEN BANK AND TRUST COMPANY       MOSAINT LOUIS              Y Y20100805
+081000553SO COML BK STL MO SOUTHERN COMMERCIAL BANK            MOSAINT LOUIS              Y Y20100805
+081000566JEFFERSON BK STL  JEFFERSON BANK & TRUST COMPANY      MOSAINT LOUIS              Y Y20100805
+081000605CASS BANK STL     CASS COMMERCIAL BANK                MODES PERES                Y Y20130322
+081000676MIDWEST BK CENTER MIDWEST BANKCENTRE                  MOSAINT LOUIS              Y Y20100805
+081000980BMO HARRIS BANK NABMO HARRIS BANK NA                  WIMILWAUKEE                Y N20121009
+081000993FSB ST CHARLES MO FIRST STATE BANK OF ST. CHARLES     MOSAINT CHARLES            Y Y20100811
+081001073LINDELL TR STL    LINDELL BANK & TRUST COMPANY        MOSAINT LOUIS              Y Y20100805
+081001387UPB BELLEVILLE IL REGIONS BANK                        MOSAINT LOUIS              Y N20100805
+081001439ROYAL BKS UCITY MOROYAL BANKS OF MISSOURI             MOUNIVERSITY CITY          Y Y        
+081003275BK STAR           BANK STAR                           MOPACIFIC                  YSY20000525
+081004070BK EDWARDSVILLE ILBANK OF EDWARDSVILLE                ILEDWARDSVILLE             Y Y20011101
+081004591COMMERCE ST.LOU   COMMERCE BANK                       MOCLAYTON                  Y N20110729
+081004601FIRST STL         FIRST NATIONAL BANK OF ST. LOUIS    MOCLAYTON                  YSY20021017
+081006162ENTRP CLAYTON MO  ENTERPRISE BANK & TRUST             MOSAINT LOUIS              Y Y20100805
+081006201COMRL MARYLD HT MOCOMMERCIAL BANK                     MOMARYLAND HEIGHTS         Y Y        
+081006395ST BK WATERLOO IL STATE BANK OF WATERLOO              ILWATERLOO                 YSY20100423
+081009428FST BK STL MO     FIRST BANK                          MOCREVE COEUR              Y Y20040303
+081009813UMB BK STL MO     UMB BANK, N.A.                      MOSAINT LOUIS              Y N20130621
+081010307BK OF HILLSBORO MOBANK OF HILLSBORO                   MOHILLSBORO                YSY20010807
+081011461BANK OLD MONROE MOBANK OF OLD MONROE                  MOOLD MONROE               N Y20100421
+081012952EB&T FESTUS MO    EAGLE BANK & TR. CO. OF MISSOURI    MOFESTUS                   Y Y20010802
+081015218CITIZENS GRTR STL CITIZENS NATIONAL BANK              MOMAPLEWOOD                Y Y        
+081015535MERAMEC VALLEY PARMERAMEC VALLEY BANK                 MOELLISVILLE               Y Y20010525
+081017478FIRST WATERLOO IL FIRST NATIONAL BANK                 ILWATERLOO                 Y Y        
+081017575ST JOHNS BK&TR STLST. JOHNS BANK & TRUST COMPANY      MOST. LOUIS                Y Y        
+081018833FRONTENAC BK      FRONTENAC BANK                      MOEARTH CITY               YSY20081016
+081018888RELIANCE BK       RELIANCE BANK                       MODES PERES                Y Y19990924
+081018956NEW FRONTIER BK   NEW FRONTIER BANK                   MOSAINT CHARLES            YSN20131016
+081018972STIFEL BK & TR    STIFEL BANK AND TRUST               MOSAINT LOUIS              Y N20100805
+081018998BUSINESS BK OF STLTHE BUSINESS BANK OF ST. LOUIS      MOCLAYTON                  Y N20131016
+081019162TRIAD BANK        TRIAD BANK                          MOFRONTENAC                Y N20100210
+081019405PARKSIDE FNCL B&T PARKSIDE FINANCIAL BANK & TRUST     MOCLAYTON                  Y N20121128
+081023019BK OF O'FALLON IL BANK OF O'FALLON                    ILO FALLON                 Y Y20100805
+081024571COLUMBIA NATL IL  COLUMBIA NATIONAL BANK              ILCOLUMBIA                 Y Y        
+081025198FST COLLINSVLE IL FIRST COLLINSVILLE BANK             ILCOLLINSVILLE             Y Y        
+081025363CCB MASCOUTAH IL  CITIZENS COMMUNITY BANK             ILMASCOUTAH                YSY        
+081025415LIBERTY BK ALTON  LIBERTY BANK                        ILALTON                    Y Y        
+081026540BANK OF BELLEVILLEBANK OF BELLEVILLE                  ILBELLEVILLE               YSN20110609
+081074440THE PRIVATEBANK   THE PRIVATEBANK AND TRUST COMPANY   ILCHICAGO                  Y N20100203
+081086674SCOTTRADE BANK    SCOTTRADE BANK                      MOST. LOUIS                Y Y20120911
+081086700TIAA CREF TRUST COTIAA-CREF TRUST COMPANY, FSB        MOST LOUIS                 YSN20140609
+081086739BLVD BK           BOULEVARD BANK                      MOST LOUIS                 YSN20140429
+081200528STATE ST QUINCY ILSTATE STREET BANK AND TRUST COMPANY ILQUINCY                   Y Y        
+081200531MERCANTILE BANK   MERCANTILE BANK                     ILQUINCY                   Y Y20071231
+081200586FIRST QUINCY IL   FIRST BANKERS TRUST CO., N.A.       ILQUINCY                   Y Y        
+081201695FARMERS ST JAX IL FARMERS STATE BANK & TRUST COMPANY  ILJACKSONVILLE             Y Y        
+081202759US BANK ILLINOIS  US BANK, NA                         ILMOUNT VERNON             Y N20140303
+081202869FIRST MURPHYSBORO FIRST BANK AND TRUST COMPANY        ILMURPHYSBORO              Y Y        
+081204126FIRST OLNEY ILL   FIRST NATIONAL BANK IN OLNEY        ILOLNEY                    Y Y        
+081204142TRUSTBK           TRUSTBANK                           ILOLNEY                    Y Y20000901
+081204265COMM METROPOLIS ILMIDCOUNTRY BANK                     ILMETROPOLIS               YSY20091007
+081204281CITY METROPOLIS   CITY NATIONAL BANK                  ILMETROPOLIS               Y Y        
+081204540EFFINGHAM ST IL   MIDLAND STATES BANK                 ILEFFINGHAM                Y Y20050701
+081204867LEGENCE BANK      LEGENCE BANK                        ILELDORADO                 Y Y20090213
+081205112FIRST VANDALIA IL FIRST NATIONAL BANK OF VANDALIA     ILVANDALIA                 Y Y        
+081205222FIRST CARMI ILL   FIRST NATIONAL BANK OF CARMI        ILCARMI                    Y Y20130514
+081205264ANNA NATL ILL     ANNA-JONESBORO NATIONAL BANK        ILANNA                     Y Y20131001
+081205727FAIRFIELD NATL ILLFAIRFIELD NATIONAL BANK             ILFAIRFIELD                Y Y        
+081206328PEOPLES NEWTON IL PEOPLES STATE BANK OF NEWTON        ILNEWTON                   Y Y        
+081206496BROWN MT STERLING BROWN COUNTY STATE BANK             ILMOUNT STERLING           Y Y20100322
+081206807PEO MCLEANSBORO   PEOPLES NATIONAL BANK, N.A.         ILMOUNT VERNON             Y Y20071105
+081206946FIRST BARRY ILL   FIRST NATIONAL BANK                 ILBARRY                    YSY20100409
+081207068FIRST SANDOVAL ILLFIRST NATIONAL BANK OF SANDOVAL     ILSANDOVAL                 Y Y        
+081207932FAYETTE ST ELMO ILFAYETTE COUNTY BANK                 ILSAINT ELMO               Y Y20100805
+081208685FIRST KINMUNDY ILLFIRST NATIONAL BANK OF KINMUNDY     ILKINMUNDY                 Y Y        
+081209985CLAY CNTY LSVL IL CLAY COUNTY STATE BANK              ILLOUISVILLE               N Y        
+081211106FIRST ST ST PETER FIRST STATE BANK OF ST. PETER       ILSAINT PETER              YSY20100805
+081211300FIRST ALLENDALE ILFIRST NATIONAL BANK                 ILALLENDALE                Y Y        
+081211520FIRST AVA ILL     FIRST NATIONAL BANK                 ILAVA                      YSY20101109
+081211795BK OF BLUFFS ILL  BANK OF BLUFFS                      ILBLUFFS                   Y Y        
+081211928FIRST BROWNSTOWN  FIRST NATIONAL BANK                 ILBROWNSTOWN               YSY20101019
+081212574FIRST DIETERICH ILFIRST NATIONAL BANK                 ILDIETERICH                YSY20090204
+081212613FIRST DONGOLA ILL FIRST STATE BANK OF DONGOLA         ILDONGOLA                  Y Y        
+081213285FIRST SOUTHERN BK FIRST SOUTHERN BANK                 ILCARBONDALE               Y Y20070620
+081213780IUKA STATE BANK   IUKA STATE BANK                     ILIUKA                     N Y20100401
+081214174STATE BK LIMA ILL STATE BANK OF LIMA                  ILLIMA                     YSY20100512
+081216169TEUTOPOLIS ST BK  TEUTOPOLIS STATE BANK               ILTEUTOPOLIS               YSY20071031
+081216389BK OF CARBONDAL ILTHE BANK OF CARBONDALE              ILCARBONDALE               YSY20100930
+081216758CMNTY FIRST BK HRTCOMMUNITY FIRST BK OF THE HEARTLAND ILMOUNT VERNON             YSY20101029
+081220074FSB CAMP PT IL    FARMERS STATE BANK OF CAMP POINT    ILCAMP POINT               N Y        
+081220537FIRST XENIA ILL   FIRST COMMUNITY BANK,XENIA-FLORA    ILXENIA                    YSY20090511
+081221549CITIZENS ALBION   CITIZENS NATIONAL BANK              ILALBION                   Y Y        
+081221646FARMERS GRIGGS ILLFARMERS NATIONAL BANK               ILGRIGGSVILLE              YSY20100201
+081222593BANTERRA MARION ILBANTERRA BANK                       ILMARION                   Y Y20000420
+081222658CAPAHA BK TAMM IL CAPAHA BANK, SB                     MOCAPE GIRARDEAU           Y Y20030522
+081222797HERRIN SEC BK IL  HERRIN SECURITY BANK                ILHERRIN                   N Y20081021
+081224724FLORA B&T IL      FLORA BANK & TRUST                  ILFLORA                    Y Y        
+081225804BK OF LAW CTY     THE BANK OF LAWRENCE COUNTY         ILBRIDGEPORT               Y N20090720
+081225972BANK OF QUINCY    BANK OF QUINCY                      ILQUINCY                   N Y20120827
+081226298PREM BK OF JACKSONPREMIER BANK OF JACKSONVILLE        ILJACKSONVILLE             Y Y20110706
+081226421SOUTHERN TRUST BK SOUTHERN TRUST BANK                 ILGOREVILLE                YSY20120521
+081226829WOODFOREST NB IL  WOODFOREST NATIONAL BANK            ILMOUNT VERNON             Y N20070620
+081227129UNITED BK CHATHAM UNITED COMMUNITY BANK               ILCHATHAM                  Y N20130830
+081304822BLOOMFIELD SB IN  BLOOMFIELD STATE BANK               INBLOOMFIELD               Y Y20100208
+081307227CSB OF SW INDIANA COMMUNITY STATE BANK OF SW INDIANA  INCYNTHIANA                Y Y20120815
+081307382ELBERFELD STATE INELBERFELD STATE BANK                INELBERFELD                YSN20060322
+081308006SCB SANTA CLAUS INSPENCER COUNTY BANK                 INSANTA CLAUS              Y Y20030529
+081308161LYNNVILLE NATL IN LYNNVILLE NATIONAL BANK             INLYNNVILLE                Y Y        
+081308705FIRST ODON IN     FIRST NATIONAL BANK                 INODON                     Y Y20091117
+081311666MAINSOURCE BANK   MAINSOURCE BANK                     INLINTON                   Y N20110310
+081500480HNB NATIONAL BANK HNB NATIONAL BANK                   MOHANNIBAL                 Y Y20100809
+081500749CITY MOBERLY MO   CITY BANK & TRUST COMPANY           MOMOBERLY                  YSY20000608
+081500859BOONE CO COLUMBIA BOONE COUNTY NATIONAL BANK          MOCOLUMBIA                 YSY20010306
+081500862LANDMARK BANK     LANDMARK BANK, N.A.                 MOCOLUMBIA                 Y Y20090514
+081501023KENNETT NATL MO   KENNETT NATIONAL BANK               MOKENNETT                  YSY20041001
+081501049PHELPS BK ROLLA MOPHELPS COUNTY BANK                  MOROLLA                    Y Y        
+081501227FIRST POPLAR BLUFFFIRST MIDWEST BANK                  MOPOPLAR BLUFF             Y Y        
+081501340BANK OF KIRKSVILLEBANK OF KIRKSVILLE                  MOKIRKSVILLE               Y Y        
+081501353FIRST WAYNESVLE MOFIRST STATE BANK ST. ROBERT         MOWAYNESVILLE              Y Y20000509
+081501489FIRST MEXICO MO   FIRST NATIONAL BK OF AUDRAIN COUNTY MOMEXICO                   YSY20120411
+081501492FIRST MALDEN MO   FIRST NATIONAL BANK                 MOMALDEN                   Y Y        
+081501696CALLAWAY FULTON MOCALLAWAY BANK                       MOFULTON                   Y Y20000509
+081501735BANK STAR FULTON  BANK STAR ONE                       MOFULTON                   Y Y        
+081502006MERC BANK         THE MERCANTILE BANK OF LOUISIANA    MOLOUISIANA                Y Y20031210
+081502019BK OF LOUISIANA MOBANK OF LOUISIANA                   MOLOUISIANA                N Y20020307
+081502530WEST PLAINS BK MO WEST PLAINS BANK AND TRUST COMPANY  MOWEST PLAINS              Y Y        
+081502637NEW ERA BK MO     NEW ERA BANK                        MOFREDERICKTOWN            Y Y        
+081502815CENT BK LEBANON MOCENTRAL BANK                        MOLEBANON                  Y Y20050330
+081503380CTZNS BK ELDON MO CITIZENS BANK OF ELDON              MOELDON                    N Y20031210
+081503597PEO GREENVILLE MO PEOPLES COMMUNITY BANK              MOGREENVILLE               N Y19991119
+081503694BK OF SALEM MO    BANK OF SALEM                       MOSALEM                    N Y20031210
+081503704DENT CNTY SALEM MOTOWN & COUNTRY BANK                 MOSALEM                    Y Y20000728
+081503814EXCHANGE BK NE MO EXCHANGE BANK OF NORTHEAST MISSOURI MOKAHOKA                   YSY20111207
+081504172CITZ BK EDINA MO  CITIZENS BANK                       MOEDINA                    YSY        
+081504266PARIS NATL MO     PARIS NATIONAL BANK                 MOPARIS                    YSY20000703
+081504693FIRST MDWST BK OZ FIRST MIDWEST BANK OF THE OZARKS    MOPIEDMONT                 YSN20081204
+081505388CLIFFORD CLARKSVLECBC BANK                            MOTROY                     Y Y20000302
+081505702OBANNON BUFFALO MOO'BANNON BANKING COMPANY            MOBUFFALO                  Y Y        
+081505731OZARK BANK MO     OZARK BANK                          MOOZARK                    Y Y20040220
+081505964BK OF BILLINGS MO BANK OF BILLINGS                    MOBILLINGS                 YSY        
+081506390ALLIANT BANK      ALLIANT BANK                        MOMADISON                  YSN20100518
+081506523BK OF ADVANCE MO  BANK OF ADVANCE                     MOADVANCE                  N Y19990910
+081506552PEOPLES BK CUBA MOPEOPLES BANK                        MOCUBA                     N Y20071220
+081506646FCBTC BRANSON MO  FIRST COMMUNITY BANK OF THE OZARKS  MOBRANSON                  YSY20110325
+081507014MACON ATL ST BK MOMACON-ATLANTA STATE BANK            MOMACON                    Y Y        
+081507124GREAT SOUTHERN BK GREAT SOUTHERN BANK                 MOELLINGTON                N Y20120127
+081507166CITZ ROGERSVLE MO CITIZENS BANK                       MOROGERSVILLE              YSY20000509
+081507425FIRST CAMDENTON MOFIRST NATIONAL BANK                 MOCAMDENTON                Y Y        
+081507470BK OF IBERIA MO   BANK OF IBERIA                      MOIBERIA                   Y Y20020603
+081508217FIRST INDEPENT BK FIRST INDEPENDENT BANK              MOAURORA                   N Y20111220
+081508466PEOPLES SVGS BK   PEOPLES SAVINGS BANK OF RHINELAND   MORHINELAND                N Y20100325
+081509070CNTRL OSAGE BCH MOCENTRAL BANK LAKE OF THE OZARKS     MOOSAGE BEACH              N Y20000531
+081509106BK CAIRO MOBERLY  BANK OF CAIRO & MOBERLY             MOMOBERLY                  YSY20030724
+081509737SEC CASSVILLE MO  SECURITY BANK OF SOUTHWEST MISSOURI MOCASSVILLE                Y Y        
+081509973CENTURY BK OZARKS CENTURY BANK OF THE OZARKS          MOGAINESVILLE              YSY20041130
+081510564TIPTON LATHAM NA  THE TIPTON LATHAM BANK, N.A.        MOTIPTON                   N Y20081121
+081510593FIRST COMM STEELVLFIRST COMMUNITY NATIONAL BANK       MOSTEELVILLE               Y Y20090409
+081510739FARMERS LOHMAN MO FARMERS BANK OF LOHMAN              MOLOHMAN                   N Y20020731
+081510933MARTINSBURG B&T MOMARTINSBURG BANK AND TRUST          MOMEXICO                   N Y20020815
+081511110BANK OF MONTICELLOBANK OF MONTICELLO                  MOMONTICELLO               N Y20081114
+081511631LEGACY BK & TR CO.LEGACY BANK & TRUST COMPANY         MOROGERSVILLE              YSY20090220
+0815117541ST ST BK PURDY MOFIRST STATE BANK                    MOPURDY                    N Y20020731
+081512106BK BOOTHEEL STEELEBANK STAR OF THE BOOTHEEL           MOSTEELE                   YSN20140602
+081512232COMMUNITY BK N.A. COMMUNITY BANK, N.A.                MOSUMMERSVILLE             Y Y19991223
+081512407MCB VIENNA MO     MARIES COUNTY BANK                  MOVIENNA                   Y Y20021213
+081512928BK OF CROCKER KY  BANK OF CROCKER                     MOCROCKER                  N Y20031210
+081513574FMSB POPLAR BLUFF FIRST MISSOURI STATE BANK           MOPOPLAR BLUFF             Y Y        
+081514010LEGENDS LINN MO   LEGENDS BANK                        MOLINN                     Y N20061013
+081514052CSB CABOOL MO     CABOOL STATE BANK                   MOCABOOL                   Y Y20000509
+081515462PEO BK OZARKS NIXAPEOPLES BANK OF THE OZARKS          MONIXA                     Y N20010905
+081516610MID AM BK&TR CO   MID AMERICA BANK & TRUST COMPANY    MODIXON                    YSY20050617
+081516872FCB GIDEON MO     FIRST COMMERCIAL BANK               MOGIDEON                   Y Y20040219
+081517677USB LEWISTON MO   UNITED STATE BANK                   MOLEWISTOWN                YSY20131112
+081517729USB AUXVASSE MO   UNITED SECURITY BANK                MOAUXVASSE                 Y Y        
+081517732FSSB CHARLESTON MOFOCUS BANK                          MOCHARLESTON               YSY20090515
+081517761FIRST SIKESTON MO MONTGOMERY BANK, N.A.               MOSIKESTON                 Y Y20080709
+081517871BK NEW CAMBRIA MO BANK OF NEW CAMBRIA                 MONEW CAMBRIA              N Y20020731
+081517907FIRST ST CARUTHRSVFIRST STATE BANK & TRUST COMPANY,INCMOCARUTHERSVILLE           Y Y        
+081517936HOMEBANK          HOMEBANK                            MOPALMYRA                  N Y20140107
+081517965BK OF NEW MADRID  BANK OF NEW MADRID                  MONEW MADRID               YSY20100901
+081518045SEC BK WAYNEVIL MOSECURITY BANK OF PULASKI COUNTY     MOWAYNESVILLE              Y Y        
+081518058BMC WELLSVILLE MO AMERICAN BK OF MISSOURI             MOWELLSVILLE               Y N20090312
+081518090SEYMOUR BK MO     SEYMOUR BANK                        MOSEYMOUR                  Y Y20000509
+081518184RALLS NW LONDON MORCSBANK                             MONEW LONDON               N Y20081211
+081518197FST COMM MALDEN MOFIRST COMMUNITY BANK                MOMALDEN                   YSY20110112
+081518375OZARK BRANSON MO  OZARK MOUNTAIN BANK                 MOBRANSON                  N Y20020129
+081518524CB CHARLESTON MO  CITIZENS BANK                       MOCHARLESTON               YSY20090123
+081518773ALLIANCE CAPE G MOALLIANCE BANK                       MOCAPE GIRARDEAU           Y Y19991109
+081518786SO MO MARSHFLD MO SOUTHERN MISSOURI BANK OF MARSHFIELDMOMARSHFIELD               N Y20090323
+081519002COMMUNITY NATIONALCOMMUNITY NATIONAL BANK             MOMONETT                   YSN20021220
+081519028MAINSTREET BANK   MAINSTREET BANK                     MOASHLAND                  YSY20140331
+081519031HERITAGE OZARKS   HERITAGE BANK OF THE OZARKS         MOLEBANON                  N Y20100105
+081519219FMSB CAPE COUNTY  FIRST MISSOURI STATE BANK           MOCAPE GIRARDEAU           Y N20060710
+081903524FST LITCHFIELD IL FIRST NATIONAL BANK                 ILLITCHFIELD               Y Y        
+081903537B&T CO LITCHFLD ILBANK AND TRUST COMPANY              ILLITCHFIELD               YSY20060810
+081903540LITCHFIELD NATL ILLITCHFIELD NATIONAL BANK            ILLITCHFIELD               Y Y        
+081903715DU QUOIN STATE IL DU QUOIN STATE BANK                 ILDU QUOIN                 Y Y        
+081903867BK OF MO PERRYVLLETHE BANK OF MISSOURI                MOPERRYVILLE               Y Y        
+081904015FIRST STAUNTON IL FIRST NATIONAL BANK                 ILSTAUNTON                 Y Y        
+081904662CNB BK & TR       CNB BANK & TRUST N.A.               ILCARLINVILLE              Y Y20120127
+081904798NB HILLSBORO IL   NATIONAL BANK                       ILHILLSBORO                Y Y        
+081905014BRADFORD GREENVILLBRADFORD NATIONAL BANK              ILGREENVILLE               YSN20140228
+081905069FIRST SPARTA IL   FIRST NATIONAL BANK OF SPARTA       ILSPARTA                   Y Y        
+081905085PROVIDENCE BK     PROVIDENCE BANK                     MOELSBERRY                 Y Y20120131
+081905289UB OF UNION MO    UNITED BANK OF UNION                MOUNION                    Y Y        
+081905292BUENA VISTA NATL  BUENA VISTA NATIONAL BANK           ILCHESTER                  Y Y        
+081905302BANK OF SULLIVAN  BANK OF SULLIVAN                    MOSULLIVAN                 Y Y        
+081905344FNB PINCKNEYVILLE FIRST NATIONAL BANK IN PINCKNEYVILLEILPINCKNEYVILLE            Y Y        
+081905593CITZ NEW HAVEN MO CITIZENS BANK                       MOWASHINGTON               YSY20010525
+081905768MO BK WARRENTON MOMISSOURI BANK THE                   MOWARRENTON                YSY20120411
+081906013CARROLLTON BK IL  CARROLLTON BANK                     ILCARROLLTON               Y Y20041025
+081906275F&M NASHVILLE IL  FARMERS & MERCHANTS NATIONAL BANK   ILNASHVILLE                YSY20050729
+081906505FIRST CARLYLE IL  FIRST NATIONAL BANK IN CARLYLE      ILCARLYLE                  YSY20071113
+081907847FMB WRIGHT CITY MOFMB BANK                            MOWRIGHT CITY              YSY20100204
+081908590PEOPLES ALTENBURG PEOPLES BANK OF ALTENBURG           MOALTENBURG                YSY        
+081908833BSB POTOSI MO     BELGRADE STATE BANK                 MOBELGRADE                 YSY20000509
+0819090071ST COMM STATE BK FIRST COMMUNITY STATE BANK          ILSTAUNTON                 Y N20100507
+081909146FIRST RAYMOND IL  FIRST NATIONAL BANK                 ILRAYMOND                  Y Y        
+081909722FIRST STEELEVILLE FIRST NATIONAL BANK                 ILSTEELEVILLE              YSY20131002
+081910232PEOPLES BT TROY MOPEOPLES BANK & TRUST CO.            MOTROY                     Y Y20000315
+081910436UNICO BANK        UNICO BANK                          MOIRONDALE                 Y Y20080731
+081913158GERMANTOWN TR IL  GERMANTOWN TRUST & SAVINGS BANK     ILGERMANTOWN               YSY20060601
+081913556FARMERS ST BANK   FARMERS STATE BANK                  ILHOFFMAN                  Y N20030519
+081914131F&M ST CLAIR MO   FARMERS & MERCHANTS BANK            MOSAINT CLAIR              YSY20100805
+081914775FIRST MULBERRY GRVFIRST NATIONAL BANK                 ILMATTOON                  Y Y20100805
+081914814BK BLOOMSDALE MO  BANK OF BLOOMSDALE                  MOBLOOMSDALE               N Y20031231
+081914856FIRST NEW BADEN ILFIRST COUNTY BANK                   ILNEW BADEN                Y Y        
+081915075OLD EXCH OKAWVILLEOLD EXCHANGE NATIONAL BANK          ILOKAWVILLE                YSY20100609
+081916731SECURITY WITT IL  SECURITY NATIONAL BANK              ILWITT                     Y Y        
+081917390COMM TR IRVINGTON COMMUNITY TRUST BANK                ILIRVINGTON                YSN20131022
+081918182FIRST OKAWVILLE ILFIRST NATIONAL BANK                 ILOKAWVILLE                Y Y        
+081918425FST SB FRMGTN MO  FIRST STATE COMMUNITY BANK          MOFARMINGTON               Y Y        
+081918946BK OF FRANKLIN CO BANK OF FRANKLIN COUNTY             MOWASHINGTON               N Y20070112
+081919356MRV BANKS         MRV BANKS                           MOSTE GENEVIEVE            N Y20100805
+081919880VILLAGE BK ST.L ILTHE VILLAGE BANK                    ILSAINT LIBORY             Y Y20100805
+081921551FIRST NOKOMIS IL  FIRST NATIONAL BANK                 ILNOKOMIS                  Y Y        
+082000549US BANK ARKANSAS  US BANK, NA                         ARLITTLE ROCK              Y N20140303
+082001179EAGLE B&T LR AR   EAGLE BANK AND TRUST COMPANY        ARLITTLE ROCK              Y Y        
+082001687BANK OF LR AR     BANK OF LITTLE ROCK                 ARLITTLE ROCK              Y Y20000106
+082001700FST NAT BANKERS BKFIRST NATIONAL BANKER'S BANK        ARLITTLE ROCK              Y N20120322
+082007649FIRST JACKSNVLE BKFIRST ARKANSAS BANK & TRUST         ARJACKSONVILLE             Y Y        
+082007830ONE NATL LR AR    ONE BANK & TRUST                    ARLITTLE ROCK              Y Y20010529
+082008583THE CAPITAL BK LR THE CAPITAL BANK                    ARLITTLE ROCK              YSN20121026
+082008923CENTRAL BANK      CENTRAL BANK                        ARLITTLE ROCK              N Y20081209
+082900319FIRST FT SMITH AR FIRST NATIONAL BANK                 ARFORT SMITH               Y Y        
+082900432SIMMONS PB AR     SIMMONS FIRST NATIONAL BANK         ARPINE BLUFF               Y Y        
+082900872ARVEST BANK       ARVEST BANK                         ARFAYETTEVILLE             Y Y20020404
+082900937SFB OF ELDORADO ARSIMMONS FIRST BANK OF EL DORADO,NA  AREL DORADO                Y Y19990713
+082900982UNION BANK MENA ARUNION BANK OF MENA                  ARMENA                     Y Y        
+082900995FIRST NATL MENA ARFIRST NATIONAL BANK                 ARMENA                     Y N20050610
+082901017CITZ VAN BUREN AR CITIZENS BANK & TRUST COMPANY       ARVAN BUREN                Y Y        
+082901020FSB CROSSETT AR   FIRST STATE BANK                    ARCROSSETT                 N Y20111208
+082901033INTEGRITY FIRST BKINTEGRITY FIRST BANK, N.A.          ARMOUNTAIN HOME            Y Y20120824
+082901046COMM TEXARKANA AR COMMERCIAL NATIONAL BK OF TEXARKANA TXTEXARKANA                Y N20121206
+082901088RELYANCE BK NA    RELYANCE BANK, NATIONAL ASSOCIATION ARPINE BLUFF               Y Y20130610
+082901091FIRST HOPE AR     FIRST NATIONAL BANK                 ARHOPE                     Y N20100225
+082901279FBT BANK & MTG    FBT BANK & MORTGAGE                 ARFORDYCE                  YSN20131022
+082901334SOUTHERN BC BK    SOUTHERN BANCORP BANK               ARARKADELPHIA              Y Y20091201
+082901376FIRST MCGEHEE AR  FIRST NATIONAL BANK OF MCGEHEE      ARMCGEHEE                  Y Y        
+082901392BOKF NA           BOKF NA                             ARFAYETTEVILLE             Y N20130621
+082901473FIRST NATL BK     FIRST NATIONAL BANK                 ARHOT SPRINGS              Y Y20000518
+082901538FIRST SEC SEARCY  FIRST SECURITY BANK                 ARSEARCY                   Y Y        
+082901567UNION MONTICELL ARUNION BANK & TRUST COMPANY          ARMONTICELLO               YSY20121031
+082901596FIRST STATE WARRENFIRST STATE BANK OF WARREN-HERMITAGEARWARREN                   Y Y        
+082901606WARREN B&T ARK    WARREN BANK AND TRUST COMPANY       ARWARREN                   Y Y        
+082901619BK FAYETTEVILLE ARTHE BANK OF FAYETTEVILLE            ARFAYETTEVILLE             Y Y20051205
+082901635FARMERS MAGNOLIA  FARMERS BANK AND TRUST COMPANY      ARMAGNOLIA                 Y Y        
+082901677FIRST NB DEQUEEN  FIRST NATIONAL BANK                 ARDE QUEEN                 Y N20071002
+082901790PRIORITY BK       PRIORITY BANK                       AROZARK                    Y N20010228
+082901813FRMS BK HAMBURG ARFARMERS BANK                        ARHAMBURG                  YSN20131022
+082901871SUMMIT BK         SUMMIT BANK                         ARMAGNOLIA                 Y Y20140523
+082901910SIGNATURE BK  BRNKSIGNATURE BANK OF ARKANSAS          ARBRINKLEY                 Y N20071011
+082901952FWB BOONEVILLE    FIRST WESTERN BANK                  ARBOONEVILLE               Y Y20110719
+082902003FIRST ST LONOKE ARFIRST STATE BANK                    ARLONOKE                   Y Y        
+082902074FIRST PARIS AR    FIRST NATIONAL BANK AT PARIS        ARPARIS                    Y N20061122
+082902265FB GREENWOOD AR   FARMERS BANK                        ARGREENWOOD                N Y20040308
+082902430FSB RUSSELLVILLE  FIRST STATE BANK                    ARRUSSELLVILLE             Y Y20091104
+082902469FIRST DEWITT AR   ARKANSAS COUNTY BANK                ARDE WITT                  YSY20131122
+082902472PARKWAY BANK      PARKWAY BANK                        ARMONTICELLO               N Y20091020
+082902511FIRST NB NORTH ARKFIRST NATIONAL BK OF NORTH ARKANSAS ARBERRYVILLE               Y Y20110901
+082902579ALLIED BANK       ALLIED BANK                         ARMULBERRY                 N Y20020417
+082902757CENTENNIAL BANK   CENTENNIAL BANK                     ARCONWAY                   Y Y20081205
+082903057FIRST CALICO RK ARFIRST NATIONAL BANK OF IZARD CO.    ARCALICO ROCK              YSY20140131
+082903109BANK CAVE CITY AR BANK OF CAVE CITY                   ARCAVE CITY                Y Y        
+082903219FIRST NB CROSSETT FIRST NATIONAL BANK OF CROSSETT     ARCROSSETT                 Y Y        
+082903264BK OF DELIGHT AR  BANK OF DELIGHT                     ARDELIGHT                  N Y19990920
+082903293SFB OF RSLVL AR   SIMMONS FIRST BANK OF RUSSELLVILLE  ARRUSSELLVILLE             Y Y        
+082903426HORATIO SB AR     HORATIO STATE BANK                  ARHORATIO                  N Y        
+082903497FIRST ST GURDON ARFIRST STATE BANK                    ARGURDON                   N Y20030814
+082904056DSB MURFREEBORO ARDIAMOND BANK                        ARMURFREESBORO             N Y20140519
+082904166DELTA TRUST & BK  DELTA TRUST & BANK                  ARPARKDALE                 Y Y20120411
+082904302BANK OF SALEM AR  BANK OF SALEM                       ARSALEM                    Y Y        
+082904373BK OF STAR CITY ARBANK OF STAR CITY                   ARSTAR CITY                Y Y        
+082904726CORNERSTONE BANK  CORNERSTONE BANK                    AREUREKA SPRINGS           N Y20140220
+082904991FNBC BANK         FNBC BANK                           ARASH FLAT                 Y Y20140116
+082905343COMM B&T MONTICELLCOMMERCIAL BANK AND TRUST COMPANY   ARMONTICELLO               Y Y        
+082905505FIRST GREEN FORESTFIRST NATIONAL BANK                 ARGREEN FOREST             Y Y        
+082905819MERCH & PLNTRS BK RIVERSIDE BANK                      ARSPARKMAN                 N Y20100119
+082905987BK LAKE VILLAGE ARBANK OF LAKE VILLAGE                ARLAKE VILLAGE             YSY        
+082905990RIVER TOWN BANK   RIVER TOWN BANK                     ARDARDANELLE               Y Y20061114
+082907008CHAMBERS BK       CHAMBERS BANK                       ARDANVILLE                 Y Y20000918
+082907134MALVERN NATL AR   MALVERN NATIONAL BANK               ARMALVERN                  Y Y        
+082907273BK OZARKS         BANK OF THE OZARKS                  ARLITTLE ROCK              Y Y20081030
+082907477F&M STUTTGART AR  FARMERS & MERCHANTS BANK            ARSTUTTGART                Y Y        
+082907561M&P BK NEWPORT AR MERCHANTS AND PLANTERS BANK         ARNEWPORT                  Y Y        
+082907736CITZ BATESVILLE ARCITIZENS BANK                       ARBATESVILLE               Y Y        
+082907752DSB DECATUR AR    DECATUR STATE BANK                  ARDECATUR                  Y N20131023
+082907781OZARK HERITAGE BK OZARK HERITAGE BANK, N.A.           ARALTHEIMER                YSY20100608
+082907875FIRST SERVICE BK  FIRST SERVICE BANK                  ARDERMOTT                  Y Y19990901
+082907888SIMMONS LV AR     SIMMONS FIRST BANK OF SOUTH ARKANSASARLAKE VILLAGE             Y Y20011211
+082908599COMM FST HARRISON COMMUNITY FIRST BANK                ARHARRISON                 N Y20030814
+082908829PINNACLE BANK     PINNACLE BANK                       ARROGERS                   N Y20100121
+082908832SIGNATURE BK ARK  SIGNATURE BANK OF ARKANSAS          ARFAYETTEVILLE             Y N20131023
+082908858LEGACY NAT'L BANK LEGACY NATIONAL BANK                ARSPRINGDALE               Y N20050328
+082974439BENEFIT BK        BENEFIT BANK                        ARFORT SMITH               Y N19991216
+083000108PNC BK, KY INC    PNC BANK, NATIONAL ASSOCIATION      KYLOUISVILLE               Y N20030821
+083000137JPMCHASE KENTUCKY JPMORGAN CHASE BANK, NA             OHCOLUMBUS                 Y N20050509
+083000564STOCKYARDS BK LSVLSTOCK YARDS BANK & TRUST COMPANY    KYLOUISVILLE               Y Y        
+083000726RIVER CITY LSVL KYRIVER CITY BANK, INC.               KYLOUISVILLE               Y Y20130930
+083001314REPUBLIC B&T LSVL REPUBLIC BANK & TRUST COMPANY       KYLOUISVILLE               Y Y20010824
+083002177COMMWLTH BT LVL KYCOMMONWEALTH BANK & TRUST           KYLOUISVILLE               Y Y20120612
+083002520FCBK LOUISVILLE KYTHE FIRST CAPITAL BANK OF KENTUCKY  KYLOUISVILLE               Y Y20070725
+083002533FIRST BK LSVL KY  CENTRAL BANK OF JEFFERSON CO.       KYLOUISVILLE               N Y20050422
+083002740METRO BANK KY     METRO BANK                          KYLOUISVILLE               N Y20130904
+083008508ECLIPSE BANK      ECLIPSE BANK, INC.                  KYLOUISVILLE               N Y20090807
+083008650NWSB NEW WASH IN  NEW WASHINGTON STATE BANK           INNEW WASHINGTON           Y Y        
+083900363US BANK LSVL      US BANK, NA                         KYPADUCAH                  Y N20140303
+083900402PADUCAH BK TR KY  PADUCAH BANK & TRUST COMPANY        KYPADUCAH                  Y Y        
+083900525OHIO VAL HENDERSONOHIO VALLEY FINANCIAL GROUP         KYHENDERSON                Y Y20080129
+083900619FARMERS FRANKFT KYFARMERS BANK AND CAPITAL TRUST CO.  KYFRANKFORT                Y Y        
+083900680BB&T W KENTUCKY   BB&T WESTERN KENTUCKY               KYHOPKINSVILLE             Y N20020716
+083901087FIRST MAYFIELD KY FNB BANK, INC.                      KYMAYFIELD                 Y Y20051207
+083901100FST CITZ ETOWN KY FIRST CITIZENS BANK                 KYELIZABETHTOWN            Y Y        
+083901113EXCHGE MAYFIELD KYFIRST KENTUCKY BANK, INC.           KYMAYFIELD                 Y Y20120427
+083901207BK OF CADIZ & TR CBANK OF CADIZ                       KYCADIZ                    N Y19990810
+083901236FARMERS DANVILLE  FARMERS NATIONAL BANK OF DANVILLE   KYDANVILLE                 Y Y        
+083901333MCB BRADENBURG KY MEADE COUNTY BANK                   KYBRANDENBURG              Y Y20130930
+083901621CUB SHELBYVLLE KY CITIZENS UNION BANK                 KYSHELBYVILLE              YSY20131001
+083901702CITZ LEBANON KY   CITIZENS NATIONAL BANK              KYLEBANON                  YSY20020801
+083901728FARMERS LEBANON KYFARMERS NATIONAL BANK               KYLEBANON                  YSY20040928
+083901773FARMERS PRINCE KY FARMERS BANK AND TRUST COMPANY      KYPRINCETON                YSN20121030
+083901896BKRS BK FRANKFT KYTHE BANKER'S BANK OF KENTUCKY, INC. KYFRANKFORT                Y Y        
+083901935FNB MUHLENBERG CTY1ST NAT'L BK OF MUHLENBERG CO,KY    KYCENTRAL CITY             Y Y20031006
+083902002SCB BOWLING GRN KYSOUTH CENTRAL BANK                  KYBOWLING GREEN            Y N20131024
+083902044W&M BARDSTOWN KY  WILSON & MUIR BANK & TRUST COMPANY  KYBARDSTOWN                Y Y        
+083902060TOWN & COUNTRY BK TOWN & COUNTRY BANK & TRUST CO.     KYBARDSTOWN                Y Y20071119
+083902196FST CARROLLTON KY FIRST NATIONAL BANK                 KYCARROLLTON               Y Y        
+083902316FAR BK & TR MAR KYFARMERS BANK & TRUST COMPANY        KYMARION                   N Y20050304
+083902442SOUTH CENTRAL BANKSOUTH CENTRAL BANK OF DAVIESS COUNTYKYOWENSBORO                Y Y20130109
+083902455CLINTON BK KY     CLINTON BANK                        KYCLINTON                  N Y19990823
+083902468FIRST COMMUNITY BK1ST COMM. BK OF W. KENTUCKY, INC.   KYCLINTON                  N Y20130524
+083902507FUBHC MADISNVLL KYFIRST UNITED BANK OF HOPKINS CO, INCKYMADISONVILLE             N Y20080204
+083902581MBC MONTICELLO KY MONTICELLO BANKING COMPANY          KYMONTICELLO               Y Y        
+083902633PB HOPKINSVLLE KY PLANTERS BANK, INCORPORATED         KYHOPKINSVILLE             Y Y        
+083902646FARMERS SCOTTSVILLFARMERS NATIONAL BANK               KYSCOTTSVILLE              Y Y19990721
+083902727ELKTON BTC KY     ELKTON BANK AND TRUST COMPANY       KYELKTON                   YSY20100303
+083902756IND BK LIVERMOR KYINDEPENDENCE BANK                   KYOWENSBORO                Y Y20090729
+083902811MAINSOURCE BANK   MAINSOURCE BANK                     INMADISON                  Y N20110310
+083902992JACKSON SEYMOUR INJACKSON COUNTY BANK                 INSEYMOUR                  Y Y        
+083903056LDB LEITCHFLD KY  LEITCHFIELD DEPOSIT BANK & TRUST    KYLEITCHFIELD              N Y20020305
+083903098BK OF COLUMBIA KY BANK OF COLUMBIA                    KYCOLUMBIA                 Y Y        
+083903108FIRST & FARMERS NBFIRST & FARMERS NATIONAL BANK, INC. KYSOMERSET                 Y Y20080818
+083903140HANCOCK HAWESVL KYHANCOCK BANK AND TRUST COMPANY      KYHAWESVILLE               N Y20080925
+083903195CITZ HARTFORD KY  CITIZENS BANK                       KYHARTFORD                 Y Y        
+083903328COMM FIN SVCS BK  COMMUNITY FINANCIAL SERVICES BANK   KYBENTON                   Y Y20070815
+083903386LINC HODGENVILLE  LINCOLN NATIONAL BANK               KYHODGENVILLE              Y Y        
+083903454FARMRS BK HARDB KYFARMERS BANK                        KYHARDINSBURG              YSY20020401
+083903564FSB IRVINGTON KY  FIRST STATE BANK                    KYIRVINGTON                Y Y20130930
+083903580USB TRENTON KY    UNITED SOUTHERN BANK                KYHOPKINSVILLE             YSY        
+083903645DBMC TMPKNSVLL KY SOUTH CENTRAL BANK OF MONROE COUNTY KYTOMPKINSVILLE            Y N20131024
+083903690PEO BK TAYLORV KY PEOPLES BANK                        KYTAYLORSVILLE             Y Y20130108
+083903742MORGANTOWN B&T KY MORGANTOWN BANK & TRUST COMPANY INC.KYMORGANTOWN               N Y        
+083903894PBI BANK          PBI BANK                            KYGREENSBURG               Y Y20060821
+083904181EDMONTON SB KY    EDMONTON STATE BANK                 KYEDMONTON                 Y N20131101
+083904314BEDFORD LN DEP KY BEDFORD LOAN & DEPOSIT BANK         KYBEDFORD                  N Y20020813
+083904505BANK OF BUFFALO KYBANK OF BUFFALO                     KYBUFFALO                  YSY20030505
+083904563GERMAN AMER BC    GERMAN AMERICAN BANCORP             INJASPER                   Y Y20090316
+083904660BK CLARKSON KY    BANK OF CLARKSON                    KYCLARKSON                 Y Y20130930
+083905012SOUTH CENTRAL BK  SOUTH CENTRAL BANK, INC.            KYGLASGOW                  Y Y20100901
+083905135SCOTT CO STATE IN SCOTT COUNTY STATE BANK             INSCOTTSBURG               Y Y        
+083905216BK OF JAMESTOWN KYBANK OF JAMESTOWN                   KYJAMESTOWN                Y Y        
+083905326PEO BROWNSTOWN IN PEOPLES BANK                        INBROWNSTOWN               Y Y        
+083905504FARMERS MDLSBRG KYFARMERS DEPOSIT BANK                KYMIDDLEBURG               Y N20120618
+083905546FARMERS MILTON KY FARMERS BANK                        KYMILTON                   N Y19990622
+083905630PEOPLES MT WASH KYPEOPLES BANK                        KYMOUNT WASHINGTON         Y Y        
+083905685UNITED CIT BK & TRUNITED CITIZENS BANK AND TRUST CO.  KYCAMPBELLSBURG            N Y20120319
+083905892FIRST RUSSELL SPGSFIRST NATIONAL BANK                 KYRUSSELL SPRINGS          Y N20000427
+083906668WEST POINT BANK   WEST POINT BANK                     KYRADCLIFF                 Y N20130610
+083907052KING SOUTHERN BANKKING SOUTHERN BANK                  KYCHAPLIN                  YSY20060605
+083907722CITZ BK HICKMAN KYCITIZENS BANK                       KYHICKMAN                  N Y20040730
+083907887TAYLOR CAMPBELL KYTAYLOR COUNTY BANK                  KYCAMPBELLSVILLE           Y Y        
+083907913CITZ CAMPBLVLE KY CITIZENS BANK & TRUST COMPANY       KYCAMPBELLSVILLE           Y Y        
+083907926CASEY LIBERTY KY  CASEY COUNTY BANK                   KYLIBERTY                  Y Y        
+083907955PPLES BK MARION KYPEOPLES BANK                        KYMARION                   Y Y20080422
+083907984CITZ ARLINGTON KY CITIZENS DEPOSIT BANK               KYARLINGTON                YSY20100401
+083908048FRANKLIN B&T KY   FRANKLIN BANK AND TRUST CO.         KYFRANKLIN                 Y N20061020
+083908132KY HOME BK BARDSTNKENTUCKY HOME BANK,INC.             KYBARDSTOWN                N Y19990909
+083908174FIRST SEC BK      FIRST SECURITY BANK                 KYOWENSBORO                Y Y20131212
+083908187KY NATL ELZBTWN KYKENTUCKY NEIGHBORHOOD BANK          KYELIZABETHTOWN            YSY20051229
+083908255CITZ 1ST BK BG KY CITIZENS FIRST BANK, INC.           KYBOWLING GREEN            YSY20100301
+083908284BANKTRUST FNCL    BANKTRUST FINANCIAL CORPORATION     KYHENDERSON                Y N20130514
+083908310CENTURY BK OF KY  CENTURY BANK OF KENTUCKY INC.       KYLAWRENCEBURG             Y N20130724
+083908352AMER FOUNDERS BK  AMERICAN FOUNDERS BANK, INC.        KYLEXINGTON                Y N20131104
+083908381UNTD CMNTY BK WKY UNITED COMMUNITY BK OF W.KY., INC   KYMORGANFIELD              YSN20091124
+083908530PEOPLE'S B&T CO.  PEOPLE'S B & T CO. OF CLINTON CTY.  KYALBANY                   N Y20110809
+083909445SPRING VLY FRLK INSPRINGS VALLEY BANK & TRUST CO.     INFRENCH LICK              YSN20131028
+083913033HARRISON PALMYRA  FIRST HARRISON BANK, CORYDON        INCORYDON                  Y Y20030807
+083914016RVFB MADISON IN   RIVER VALLEY FINANCIAL BANK         INMADISON                  Y Y19990727
+083974289BB&T INDIANA      BB&T INDIANA                        KYLOUISVILLE               Y N20020916
+083974373SOUTH CENTRAL BANKSOUTH CENTRAL BANK                  KYELIZABETHTOWN            Y N20090731
+083974412MURRAY BANK       THE MURRAY BANK                     KYMURRAY                   N Y20090630
+084000026FIRST TENN        FIRST TENNESSEE BANK NATL ASSN      TNMEMPHIS                  Y Y        
+084000084UNION MEM         REGIONS BANK                        TNMEMPHIS                  Y N20050923
+084000929TRI-STATE MFS TN  TRI-STATE BANK                      TNMEMPHIS                  YSY20120705
+084001148FIDELITY W MEMPHISFIDELITY NATIONAL BANK              ARWEST MEMPHIS             Y Y        
+084003159BK OF BARTLETT TN BANK OF BARTLETT                    TNBARTLETT                 Y Y20081117
+084006237DESOTO COUNTY BANKDESOTO COUNTY BANK                  MSHORN LAKE                YSN20090716
+084008426INDEPENDENT BK    INDEPENDENT BANK                    TNMEMPHIS                  Y Y        
+084008468MEMPHIS 1ST COMM  LANDMARK COMMUNITY BANK             TNCOLLIERVILLE             Y Y20120813
+084008536FIRST ALLIANCE BK FIRST ALLIANCE BANK                 TNCORDOVA                  N Y20100331
+084008646FIRSTCOM EASTARK  FIRST COMMUNITY BANK OF E.ARKANSAS  ARMARION                   Y N20061109
+084008824PATRIOT BANK      PATRIOT BANK                        TNMILLINGTON               YSN20100809
+084008947PARAGON  BANK     PARAGON  BANK                       TNMEMPHIS                  Y N20140501
+084009111TRIUMPH BANK      TRIUMPH BANK                        TNMEMPHIS                  Y Y20081015
+084074395MAGNA BANK        MAGNA BANK                          TNMEMPHIS                  Y N20080424
+084100654HELENA NATL AR    HELENA NATIONAL BANK                ARHELENA                   Y Y        
+084100793FIRST PARAGOULD ARFIRST NATIONAL BANK                 ARPARAGOULD                Y Y        
+084101051FARMERS BLYTHEVILLFARMERS BANK & TRUST CO.            ARBLYTHEVILLE              Y Y        
+084101417FIRST FORREST CITYFIRST NATIONAL BANK OF EASTERN ARK. ARFORREST CITY             Y Y        
+084101514CCB WYNNE AR      CROSS COUNTY BANK                   ARWYNNE                    YSY20060109
+084102610BK OF MCCRORY AR  BANK OF MCCRORY                     ARMCCRORY                  Y Y20081022
+084105730FIRST WYNNE AR    FIRST NATIONAL BANK OF WYNNE, THE   ARWYNNE                    Y Y20140424
+084106027FIRST WALNUT RIDGEFIRST NATIONAL BANK OF LAWRENCE CO. ARWALNUT RIDGE             Y Y        
+084106768EVOLVE B & T      EVOLVE BANK & TRUST                 ARPARKIN                   Y Y20120921
+084106988PIGGOTT SB AR     PIGGOTT STATE BANK                  ARPIGGOTT                  Y Y        
+084107699HERITAGE BK LEA ARHERITAGE BANK, NATIONAL ASSOCIATION ARJONESBORO                Y Y20100701
+084107709FDB TYRONZA AR    FIRST DELTA BANK                    ARMARKED TREE              N Y20040225
+084201029FIRST CLARKSDALE  FIRST NATIONAL BANK OF CLARKSDALE   MSCLARKSDALE               Y Y20061004
+084201058FIRST AMER IUKA MSFIRST AMERICAN NATIONAL BANK        MSIUKA                     YSY20061108
+084201184MECH WATER VLY MS MECHANICS BANK                      MSWATER VALLEY             Y Y        
+084201278BANCORPSOUTH TUPLOBANCORPSOUTH BANK                   MSTUPELO                   YSY20100125
+084201294RENASANT BANK     RENASANT BANK                       MSTUPELO                   Y Y20050307
+084201524BK OF WINONA MS   BANK OF WINONA                      MSWINONA                   YSY20091106
+084201676M&F HOLLY SPGS MS MERCHANTS & FARMERS BANK            MSHOLLY SPRINGS            YSY20070713
+084201757BNA BANK          BNA BANK                            MSNEW ALBANY               Y Y20080208
+084201786M&F BK MACON MS   BANKFIRST FINANCIAL SERVICES        MSMACON                    YSY20121228
+084201825FNB OXFORD        FNB OXFORD                          MSOXFORD                   Y Y20130402
+084202073FIRST PONTOTOC MS FIRST NATIONAL BANK                 MSPONTOTOC                 Y Y        
+084202086SENATOBIA BK MS   SYCAMORE BANK                       MSSENATOBIA                N Y20050505
+084202219SBT GREENWOOD MS  STATE BANK AND TRUST COMPANY        MSGREENWOOD                Y Y20041102
+084202251GUAR B&T BELZONI  GUARANTY BANK AND TRUST COMPANY     MSBELZONI                  Y Y        
+084202264BKPLUS BELZONI MS BANKPLUS                            MSBELZONI                  Y Y        
+084202280CLEVELAND ST BK MSCLEVELAND STATE BANK                MSCLEVELAND                YSY20110311
+084203250BK KILMICHAEL MS  BANK OF KILMICHAEL                  MSKILMICHAEL               YSY20020621
+084203593PEO B&T CO N CARROPEOPLES BANK & TRUST COMPANY        MSNORTH CARROLLTON         Y N20060718
+084204301COMM BK, NORTH MS COMMUNITY BANK, NORTH MS            MSAMORY                    Y Y20091019
+084204628FSB HOLLY SPRNG MSFIRST STATE BANK                    MSHOLLY SPRINGS            N Y20010628
+084204709CITZ B&T MARKS MS CITIZENS BANK AND TRUST COMPANY     MSMARKS                    N Y20061027
+084205452PEOPLES RIPLEY MS PEOPLES BANK                        MSRIPLEY                   Y Y        
+084205559FIRST LEXINGTON MSBANKPLUS                            MSLEXINGTON                N Y20030814
+084205591BK OF OKOLONA MS  BANK OF OKOLONA                     MSOKOLONA                  N Y        
+084205708PLNTRS INDANOLA MSPLANTERS BANK AND TRUST COMPANY     MSINDIANOLA                Y Y        
+084205724BK BOL CNTY SHE MSBANK OF BOLIVAR COUNTY              MSSHELBY                   N Y19990525
+084205915FSB BATESVILLE MS FIRST SECURITY BANK                 MSBATESVILLE               Y Y        
+084206082COM BK COR MS     COMMERCE BANK                       MSCORINTH                  Y Y20140401
+084206150OXFORD UNIV BANK  OXFORD UNIVERSITY BANK              MSOXFORD                   N Y20071026
+084301042FIRST DYERSBURG TNFIRST CITIZENS NATIONAL BANK        TNDYERSBURG                Y Y        
+084301107COMML BK & TR CO  COMMERCIAL BANK AND TRUST COMPANY   TNPARIS                    Y Y        
+084301408INSOUTH BRWNSVL TNINSOUTH BANK                        TNBROWNSVILLE              Y Y20020319
+084302180MCKENZIE BNKG TN  MCKENZIE BANKING COMPANY            TNMC KENZIE                Y Y20100809
+084302232F&M DYER TN       FARMERS & MERCHANTS BANK            TNDYER                     Y N20131029
+084302300HARDIN SAVANNAH TNHARDIN COUNTY BANK                  TNSAVANNAH                 Y Y        
+084302342FSB BOLIVAR TN    FIRST SOUTH BANK                    TNBOLIVAR                  Y Y        
+084302452BK OF HALLS TN    BANK OF HALLS                       TNHALLS                    YSN20131029
+084302698F&M TRESEVANT TN  FARMERS & MERCHANTS BANK            TNTREZEVANT                YSY        
+084303008FARMERS PARSONS TNFARMERS BANK                        TNPARSONS                  Y Y        
+084303011BK OF GLEASON TN  BANK OF GLEASON                     TNGLEASON                  N Y20040331
+084303231BANK OF CROCKETT  BANK OF CROCKETT                    TNBELLS                    YSN20020823
+084303338BRIGHTON BANK TN  BRIGHTON BANK                       TNBRIGHTON                 N Y20110322
+084303545SEC BT PARIS TN   SECURITY BANK & TRUST COMPANY       TNPARIS                    Y Y20030331
+084303859GATES B&T COMPANY GATES BANKING & TRUST COMPANY       TNGATES                    YSN20020823
+084304010REELFOOT UN CTY TNREELFOOT BANK                       TNUNION CITY               Y Y        
+084304117BANK OF MILAN     BANK OF MILAN                       TNMILAN                    N Y20100514
+084304230TRUST COMPANY BANKTRUST COMPANY BANK                  TNMEMPHIS                  YSY20131022
+084304243FSB KENTON TN     FIRST STATE BANK                    TNUNION CITY               Y Y20090521
+084304272CB&T HUNTINGTON TNCARROLL BANK & TRUST                TNHUNTINGDON               Y Y        
+084304337THE BK OF FAYETTE THE BANK OF FAYETTE COUNTY          TNMOSCOW                   YSN20131029
+084304434BANK OF JACKSON TNTHE BANK OF JACKSON                 TNJACKSON                  YSY20020823
+084304793M&P BK TOONE TN   MERCHANTS & PLANTERS BANK           TNTOONE                    Y Y        
+084307033FST BK LEXINGTN TNFIRST BANK                          TNLEXINGTON                Y Y20010924
+084307761BK OF CAMDEN      BANK OF CAMDEN                      TNCAMDEN                   N Y20080627
+084307790SEC BK DYERSBG TN SECURITY BANK                       TNDYERSBURG                Y Y        
+086300012OLD EVANSVILLE IN OLD NATIONAL BANK                   INEVANSVILLE               Y Y        
+086314464EVANSVILLE CMRC BKEVANSVILLE COMMERCE BANK            INEVANSVILLE               Y N20061010
+086500605HAWTHORN BANK     HAWTHORN BANK                       MOJEFFERSON CITY           Y Y20071005
+086500634CENT TR JEFF CITY CENTRAL TRUST BANK                  MOJEFFERSON CITY           Y Y        
+086501578JBM JEFF CITY MO  JEFFERSON BANK OF MISSOURI          MOJEFFERSON CITY           N Y        
+086503424MID-MISSOURI BANK MID-MISSOURI BANK                   MOSPRINGFIELD              Y Y20030203
+086504326METROPOLITAN SPFLDMETROPOLITAN NATIONAL BANK          MOSPRINGFIELD              Y Y20000509
+086505273MIB JEFF CITY MO  MIDWEST INDEPENDENT BANK            MOJEFFERSON CITY           Y Y20010820
+086506955LIBRTY BK SPFLD M0LIBERTY BANK                        MOSPRINGFIELD              Y Y        
+086518477EMPIRE BANK SPFLD EMPIRE BANK                         MOSPRINGFIELD              YSY20030317
+086518765COMM FIRST BK CO  COMMUNITY FIRST BANKING COMPANY     MOWEST PLAINS              YSY20110616
+086518891OLD MO BK         OLD MISSOURI BANK                   MOSPRINGFIELD              YSY20120305
+086519159OAKSTAR BANK      OAKSTAR BANK                        MOSPRINGFIELD              Y N20140303
+086519421SPGFLD FRST COM BKSPRINGFIELD FIRST COMMUNITY BANK    MOSPRINGFIELD              Y N20081106
+091000022US BANK MINNESOTA US BANK, NA                         MNSAINT PAUL               Y N20140303
+091000080FRB MPLS          FEDERAL RESERVE BANK OF MINNEAPOLIS MNMINNEAPOLIS              Y Y        
+091000132NORTHEAST BK MPLS NORTHEAST BANK                      MNMINNEAPOLIS              Y Y20011116
+091001157BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+091001319UNION BK MPLS     UNION BANK AND TRUST COMPANY        MNMINNEAPOLIS              Y N20130614
+091001322UNITED BKRS MPLS  UNITED BANKERS BANK                 MNBLOOMINGTON              Y Y20100218
+091005302RIDGEDALE SB MTKA HIGHLAND BANK                       MNMINNETONKA               Y N20080808
+091005836ALERUS FINANCIAL  ALERUS FINANCIAL, N.A.              NDGRAND FORKS              N Y20140711
+091008299LAKE COMMUNITY BK LAKE COMMUNITY BANK                 MNLONG LAKE                Y Y20070614
+091014458FST MTKA CITY BK  FIRST MINNETONKA CITY BANK          MNMINNETONKA               Y Y        
+091014487FIRST NAVARRE     FIRST NATL BK OF THE LAKES          MNNAVARRE                  Y Y        
+091014924FIDELITY EDINA    FIDELITY BANK                       MNEDINA                    Y Y        
+091015224US BK ST LOUIS PK US BANK, NA                         MNSAINT PAUL               Y N20140303
+091016566CITZ ST LOUIS PARKCITIZENS INDEPENDENT BK             MNST LOUIS PARK            Y Y        
+091017060CROWN EDINA       CROWN BANK                          MNEDINA                    Y N20001002
+091017196VENTURE BLOOMINGTNVENTURE BANK                        MNBLOOMINGTON              Y Y20011227
+091017248EAGLE COMMUNITY BKEAGLE COMMUNITY BANK                MNMAPLE GROVE              N Y20110404
+091017329SIGNATURE BK      SIGNATURE BANK                      MNMINNETONKA               YSN20140722
+091017471TRADITION CAPITAL TRADITION CAPITAL BANK              MNEDINA                    Y N20091009
+091017484VISIONBANK        VISIONBANK                          MNST LOUIS PARK            N Y20130813
+091017523BRIDGEWATER BANK  BRIDGEWATER BANK                    MNBLOOMINGTON              Y N20070315
+091017536STONEBRIDGE BANK  STONEBRIDGE BANK                    MNMINNEAPOLIS              Y N20131115
+091018302MINNESOTA BK & TR MINNESOTA BANK & TRUST              MNEDINA                    N Y20080415
+091036164US TREAS SINGLE TXUS TREASURY SINGLE TAXPAYORS        MNMINNEAPOLIS              Y N20101203
+091071585AMER INV BK       AMERICAN INVESTORS BANK AND MORTGAGEMNEDEN PRAIRIE             N Y20080211
+091101138SB ESCANABA       UPPER PENINSULA STATE BANK          MIESCANABA                 Y N20121220
+091101141NORTHERN MI B&T   NORTHERN MICHIGAN BANK & TRUST      MIESCANABA                 Y Y20030423
+091101280CENT SLT STE MARIECENTRAL SAVINGS BANK                MISAULT SAINTE MARIE       Y Y20100809
+091101316PENSLA ISHPEMING  PENINSULA BANK                      MIISHPEMING                YSY20060814
+091101730FIRST NATL BK & TRFIRST NATIONAL BK & TR CO OF IRON MTMIIRON MOUNTAIN            Y Y20091019
+091101879SUPERIOR HANCOCK  SUPERIOR NATL BANK & TRUST CO       MIHANCOCK                  Y Y        
+091101950RANGE NEGAUNEE    RANGE BANK, N.A.                    MIMARQUETTE                Y Y20131011
+091102807MBANK             MBANK                               MIMANISTIQUE               Y Y20050502
+091103181PEOPLES MUNISING  PEOPLES STATE BANK                  MIMUNISING                 Y Y        
+091103505CITIZENS ONTONAGONCITIZENS STATE BK OF ONTONAGON, THE MIONTONAGON                Y Y20130806
+091106379STATE EWEN        STATE BANK OF EWEN                  MIEWEN                     Y N20120308
+091107705FIRST NORWAY      FIRST NATIONAL BANK                 MINORWAY                   Y N20080416
+091108539FNB ST IGNACE     FIRST NATIONAL BANK OF ST IGNACE    MISAINT IGNACE             Y Y20140415
+091109253MINERS IRON RVR   THE MINERS STATE BANK               MIIRON RIVER               N Y20020123
+091109994FST UPPER GLADSTONFIRST BANK, UPPER MICHIGAN          MIGLADSTONE                Y Y        
+091110093ST SVG MANISTIQUE STATE SAVINGS BANK                  MIMANISTIQUE               Y Y        
+091113980OLD MISSION SS MAROLD MISSION BANK                    MISAULT SAINTE MARIE       Y N20100809
+091114112GOGEBIC RANGE BANKGOGEBIC RANGE BANK                  MIIRONWOOD                 Y Y20130826
+091200592SEC HIBBING       SECURITY STATE BANK OF HIBBING      MNHIBBING                  Y Y20121001
+091200738FIRST CHISHOLM    FIRST NATIONAL BANK                 MNCHISHOLM                 Y Y        
+091200822CROOKSTON NATL    CROOKSTON NATIONAL BANK             MNCROOKSTON                YSY20050815
+091200848MINERS EVELETH    MINERS NATIONAL BANK OF EVELETH, THEMNEVELETH                  Y Y20130806
+091201232FIRST BEMIDJI     FIRST NATIONAL BANK                 MNBEMIDJI                  Y Y        
+091201287LAKE TWO HARBORS  THE LAKE BANK                       MNTWO HARBORS              Y Y20060110
+091201818FIRST BANK & TRUSTFIRST BANK & TRUST, NATIONAL ASSN   MNPIPESTONE                YSY20100119
+091201915FIRST BLUE EARTH  FIRST BANK BLUE EARTH               MNBLUE EARTH               Y Y20071217
+091202231FIRST WADENA      FIRST NATIONAL BANK                 MNWADENA                   Y Y        
+091202325CITIZEN PK RAPIDS CITIZENS NATIONAL BK OF PARK RAPIDS MNPARK RAPIDS              Y Y20101124
+091202338ST BK PARK RAPIDS STATE BANK OF PARK RAPIDS           MNPARK RAPIDS              Y Y20120425
+091202480FIRST GILBERT     FIRST NATIONAL BANK OF GILBERT      MNGILBERT                  YSN20121012
+091202736FIRST SECURITY    FIRST SECURITY BANK-CANBY           MNCANBY                    N Y20030204
+091202943STATE WHEATON     STATE BANK OF WHEATON               MNWHEATON                  N Y20090127
+091203104ROOT RVR CHATFIELDROOT RIVER STATE BANK               MNCHATFIELD                Y N        
+091203191F&M BK PRESTON    F&M COMMUNITY BANK, NA              MNPRESTON                  N Y20020628
+091203285ADRIAN ST BK      ADRIAN STATE BANK                   MNADRIAN                   N Y20100428
+091204080FIRST BAGLEY      FIRST NATIONAL BANK                 MNBAGLEY                   N Y20100702
+091204255FIRST NATL FULDA  FIRST NATIONAL BANK                 MNFULDA                    Y Y        
+091204404FST ST BK MN      FIRST STATE BANK MINNESOTA          MNLE ROY                   N Y20040618
+091204462FIRST SE HARMONY  FIRST SOUTHEAST BANK                MNHARMONY                  Y N20051028
+091204527CITZ ROSEAU       CITIZENS STATE BANK                 MNROSEAU                   Y N20110620
+091204569FIRST MC INTOSH   FIRST NATIONAL BANK OF MCINTOSH, THEMNMCINTOSH                 Y Y20120711
+091204750FIRST HERMAN      FIRST NATIONAL BANK                 MNHERMAN                   YSY20100127
+091204763FIRST HENNING     FIRST NATIONAL BANK OF HENNING, THE MNHENNING                  Y N20111026
+091204899FIRST BATTLE LAKE FIRST NATIONAL BANK                 MNBATTLE LAKE              YSY20090701
+091205128AMER ST GRYGLA    AMERICAN STATE BANK OF GRYGLA       MNGRYGLA                   N Y20140627
+091205283BOUNDRY WATERS ELYBOUNDARY WATERS BANK                MNELY                      YSY20081222
+091205869ST BK CEYLON      STATE BANK OF CEYLON                MNCEYLON                   N Y20090302
+091206541STAR BANK         STAR BANK                           MNMAPLE LAKE               Y N20131114
+091206703FIRST F&M SB      FIRST FARMERS & MERCH ST BANK       MNBROWNSDALE               Y Y20100611
+091206745FIRST BUHL        FIRST NATIONAL BANK OF BUHL         MNBUHL                     N Y20131212
+091206826WESTERN NB CASS LKWESTERN NATIONAL BANK OF CASS LAKE  MNCASS LAKE                YSY20060525
+091206965FIRST COLERAINE   FIRST NATIONAL BANK                 MNCOLERAINE                Y Y        
+091207087ST BK DANVERS     STATE BANK OF DANVERS               MNBENSON                   N Y20120716
+091207317EITZEN STATE BANK EITZEN STATE BANK                   MNEITZEN                   N Y        
+091208138KENSINGTON BANK   KENSINGTON BANK                     MNKENSINGTON               N Y20120402
+091209247FST INDEPENDENT BKFIRST INDEPENDENT BANK              MNRUSSELL                  N Y20090327
+091209483FIRST SEC BANK    FIRST SECURITY BANK-SLEEPY EYE      MNRENVILLE                 N Y20100128
+091209755FIRST WALKER      FIRST NATIONAL BANK                 MNWALKER                   Y Y        
+091209933ULTIMA BK MN      ULTIMA BANK MINNESOTA               MNWINGER                   Y Y19990712
+091210922FIRST WINNEBAGO MNFIRST FINANCIAL BANK IN WINNEBAGO   MNWINNEBAGO                YSY20080701
+091210993WELCOME ST BK     WELCOME STATE BANK                  MNWELCOME                  Y N20060308
+091211170GRAND RAPIDS ST BKGRAND RAPIDS STATE BANK             MNGRAND RAPIDS             Y N20120309
+091211471SEC ST BK OKLEE   SECURITY STATE BANK                 MNOKLEE                    Y N19990805
+091212221NW BK, N.A.       NORTHWESTERN BANK, NA               MNDILWORTH                 Y Y20040115
+091212580PRAIRIE ST MILAN  PRAIRIE SUN BANK                    MNMILAN                    N Y20020916
+091212726F M NEW YORK MILLSFARMERS AND MERCHANTS STATE BANK    MNNEW YORK MILLS           YSY20051128
+091213314GR MARAIS ST BK   GRAND MARAIS STATE BANK             MNGRAND MARAIS             Y Y        
+091213673MIDWEST BK WAUBUN MIDWEST BANK                        MNWAUBUN                   Y N20100809
+091213835WADENA ST BK      WADENA STATE BANK                   MNWADENA                   Y N20120321
+091214478F&M BK MINNESOTA  F&M BANK MINNESOTA                  MNOLIVIA                   YSY20140310
+091214627UNITED PRAIRIE BK UNITED PRAIRIE BANK                 MNMOUNTAIN LAKE            Y Y20121023
+091214915NO VIRGINIA       NORTHERN STATE BANK OF VIRGINIA     MNVIRGINIA                 N Y20130507
+091214960AMERICAN BK NORTH AMERICAN BANK OF THE NORTH          MNNASHWAUK                 Y Y20020401
+091215435MARSHALL COUNTY SBMARSHALL COUNTY STATE BANK          MNNEWFOLDEN                Y N19990729
+091215710FIRST MAHNOMEN    FIRST NAT BK MAHNOMEN TWIN VALLEY   MNMAHNOMEN                 Y Y20130503
+091215817NO ST BK GONVICK  NORTHERN STATE BANK                 MNGONVICK                  Y N20130111
+091215888UNITY BANK NORTH  UNITY BANK NORTH                    MNRED LAKE FALLS           Y N20120321
+091215927US BANK MN EGF    US BANK, NA                         MNEAST GRAND FORKS         Y N20140303
+091216007FST FARMERS LUVERNFIRST FARMERS AND MERCHANTS NATL BK MNLUVERNE                  Y Y        
+091216133BORDER STATE BANK BORDER STATE BANK                   MNGREENBUSH                Y Y20111108
+091216146NO THIEF RIVER FLSNORTHERN ST BK OF THIEF RIVER FALLS MNTHIEF RIVER FALLS        Y Y20130208
+091216243FST F&M GR MEADOW FIRST FARMERS & MERCH ST BK         MNGRAND MEADOW             Y Y20020321
+091216324ST BK MARIETTA    STATE BANK                          MNMARIETTA                 N Y20050729
+091216340SB BELLINGHAM     STATE BANK                          MNBELLINGHAM               Y N20060803
+091216696SEC SB FERGUS FLS SECURITY STATE BANK OF FERGUS FALLS MNFERGUS FALLS             Y Y20130321
+091300023US BANK NO DAKOTA US BANK, NA                         NDFARGO                    Y N20130221
+091300159ALERUS FIN GR FKS ALERUS FINANCIAL, N.A.              NDGRAND FORKS              Y Y20010605
+091300256BREMER NA MINOT   BREMER BANK, NATIONAL ASSOCIATION   NDFARGO                    N Y20040820
+091300285BK OF ND BISMARCK BANK OF NORTH DAKOTA                NDBISMARCK                 Y Y20140715
+091300353RAMSEY NAT BK     RAMSEY NATIONAL BANK                NDDEVILS LAKE              Y Y20111212
+091300515AMER WILLISTON    AMERICAN ST BK & TR CO OF WILLISTON NDWILLISTON                YSY20100927
+091300641MERCHANTS RUGBY   MERCHANTS BANK                      NDRUGBY                    Y Y        
+091300887FST ST BK CANDO   FIRST STATE BANK CANDO              NDCANDO                    Y Y        
+091301048GOOSE RIV MAYVILLEGOOSE RIVER BANK                    NDMAYVILLE                 Y N20051004
+091301132FST UTD PARK RIVERFIRST UNITED BANK                   NDPARK RIVER               Y Y20120406
+091301640UTD VLY CAVALIER  UNITED VALLEY BANK                  NDCAVALIER                 Y Y        
+091301750FIRST MILNOR      FIRST NATIONAL BANK                 NDMILNOR                   Y Y        
+091301899PEOP WESTHOPE     PEOPLES STATE BANK                  NDWESTHOPE                 N Y        
+091302115QUALITY BANK      QUALITY BANK                        NDFINGAL                   N Y20031217
+091302160NORTHLAND FNCL    NORTHLAND FINANCIAL                 NDSTEELE                   Y N20090727
+091302597SECURITY WISHEK   SECURITY STATE BANK                 NDWISHEK                   Y N20051104
+091302720GARRISON ST BK    GARRISON STATE BANK AND TRUST       NDGARRISON                 YSY        
+091302762HORIZON FINANC BK HORIZON FINANCIAL BANK              NDMUNICH                   Y Y20131129
+091302788UNION ST BK HAZEN UNION STATE BANK                    NDHAZEN                    Y Y        
+091302872SEC SB HUNTER     DAKOTA HERITAGE BANK OF NORTH DAKOTANDHUNTER                   N Y20060802
+091302966CHOICE GRAFTON    CHOICE FINANCIAL GROUP              NDGRAFTON                  Y Y20011102
+091303376FIRST FINANCIAL BKFIRST FINANCIAL BANK                NDANETA                    N Y20121212
+091303664DAKOTA COMM HEBRONDAKOTA COMMUNITY BANK & TRUST, NA   NDHEBRON                   Y Y20110331
+091303855LINCOLN HANKINSON LINCOLN STATE BANK                  NDHANKINSON                Y Y        
+091303871ST BK BOTTINEAU   STATE BANK OF BOTTINEAU             NDBOTTINEAU                N Y20130917
+091304032BK HAZELTON       BANK OF HAZELTON                    NDHAZELTON                 Y N20060308
+091304634FIRST BOTTINEAU   FIRST NATL BK & TR CO OF BOTTINEAU  NDBOTTINEAU                Y Y        
+091305031FST INTL WATFORD CFIRST INTERNATIONAL BANK & TRUST    NDWATFORD CITY             Y Y        
+091305044FST ST BK ARTHUR  FIRST ST BK OF NO DAK               NDARTHUR                   Y Y20050103
+091305280DAKOTA W BOWMAN   DAKOTA WESTERN BANK                 NDBOWMAN                   Y Y        
+091305329FST ST BK BUXTON  FIRST STATE BANK                    NDBUXTON                   Y Y        
+091306108BK HAMILTON       BANK OF HAMILTON                    NDHAMILTON                 Y N        
+091306137SARGENT CNTY BK   SARGENT COUNTY BANK                 NDFORMAN                   Y N20130111
+091306645CITZ SB MIDWEST   CITIZENS STATE BANK MIDWEST         NDCAVALIER                 Y Y19991001
+091306823FST FARM PORTLAND FIRST AND FARMERS BANK              NDPORTLAND                 Y Y        
+091307149WEST DEVILS LAKE  WESTERN STATE BANK                  NDDEVILS LAKE              Y Y20010620
+091307275MCINTOSH BK ASHLEYMCINTOSH COUNTY BANK                NDASHLEY                   YSN20090608
+091308164FIRST ST HARVEY   FIRST STATE BANK OF HARVEY          NDHARVEY                   Y N20130806
+091309736KODABANK          KODABANK                            NDDRAYTON                  N Y20100226
+091309846F&M TOLNA         FARMERS & MERCHANTS BK OF N DAKOTA  NDTOLNA                    Y N20140401
+091310398FARMERS CROSBY    FARMERS STATE BANK OF CROSBY        NDCROSBY                   Y Y20100322
+091310440FST WESTERN MINOT FIRST WESTERN BANK & TRUST          NDMINOT                    Y N20130111
+091310482NATIONAL HARVEY   NATIONAL BANK OF HARVEY, THE        NDHARVEY                   Y Y20101004
+091310495UNISON BANK       UNISON BANK                         NDJAMESTOWN                Y Y20060426
+091310518BK OF TURTLE LAKE BANK OF TURTLE LAKE                 NDTURTLE LAKE              N Y20140625
+091310521BELL STATE BK & TRBELL STATE BANK & TRUST             NDFARGO                    Y Y20120921
+091310592KIRKWOOD BISMARCK KIRKWOOD BANK AND TRUST CO          NDBISMARCK                 Y Y        
+091310602F&M LANGDON       FARMERS AND MERCHANTS STATE BANK    NDLANGDON                  N Y20010710
+091310615AMERICAN BK CNTR  AMERICAN BANK CENTER                NDDICKINSON                Y Y20090420
+091310631HEARTLAND EDGELEY HEARTLAND STATE BANK                NDEDGELEY                  YSN20130614
+091310673SEC FIRST BK ND   SECURITY FIRST BANK OF NORTH DAKOTA NDCENTER                   Y Y20080813
+091310725MCKENZ CO WATFD C MCKENZIE COUNTY BANK                NDWATFORD CITY             Y N20040617
+091310754BNC BISMARCK      BNC NATIONAL BANK                   NDBISMARCK                 Y Y20020411
+091310767STARION FINANCIAL STARION FINANCIAL                   NDBISMARCK                 Y Y20030915
+091310770FIRST WILLISTON   FIRST NATL BANK & TRUST CO          NDWILLISTON                Y Y        
+091310819SEC FST NEW SALEM SECURITY FIRST BANK OF NORTH DAKOTA NDNEW SALEM                Y N20080916
+091310864BANK FORWARD      BANK FORWARD                        NDNEW ROCKFORD             Y Y20070104
+091310929CITZ ST BK FINLEY CITIZENS STATE BANK                 NDFINLEY                   Y Y        
+091310974LIBERTY PWRS LAKE LIBERTY STATE BANK                  NDPOWERS LAKE              Y Y        
+091310987LAKESIDE NEW TOWN LAKESIDE STATE BANK                 NDNEW TOWN                 Y Y        
+091310990BANK TIOGA        BANK OF TIOGA                       NDTIOGA                    Y N        
+091311038FST AM VLY GR FRKSBREMER BANK, NATIONAL ASSOCIATION   NDGRAND FORKS              N Y        
+091311096SEC FIRST MANDAN  SECURITY FIRST BANK OF NORTH DAKOTA NDMANDAN                   Y N20030722
+091311106VISION BANK       VISION BANK                         NDFARGO                    YSN20080612
+091400020FIRST SX FALLS    FIRST NATIONAL BANK                 SDSIOUX FALLS              Y Y        
+091400172DACOTAH ABERDEEN  DACOTAH BANK                        SDABERDEEN                 Y Y        
+091400486FST DAKOTA YANKTONFIRST DAKOTA NATIONAL BANK          SDYANKTON                  Y Y        
+091400525FIRST PIERRE      FIRST NATIONAL BANK                 SDFORT PIERRE              Y Y20111219
+091400538BANKWEST PIERRE   BANKWEST, INC                       SDPIERRE                   Y Y        
+091400554PLAINS COMMERCE   PLAINS COMMERCE BANK                SDABERDEEN                 N Y20090428
+091400606FIRST BANK & TRUSTFIRST BANK & TRUST                  SDSIOUX FALLS              YSY20090703
+091401142QUOIN FI BK MILLERQUOIN FINANCIAL BANK                SDMILLER                   N Y20090115
+091401553ONE AMERICAN BK   ONE AMERICAN BANK                   SDSIOUX FALLS              YSY20121128
+091401647COML ST WAGNER    COMMERCIAL STATE BANK               SDWAGNER                   Y Y        
+091401919CITIZENS ARLINGTONCITIZENS STATE BANK OF ARLINGTON    SDARLINGTON                Y Y20030905
+091401922VLY EXCHG LENNOX  VALLEY EXCHANGE BANK                SDLENNOX                   Y Y        
+091402277MERCHANTS FREEMAN MERCHANTS STATE BANK                SDFREEMAN                  YSN20100301
+091402578DAKOTA ST BK BLUNTDAKOTA STATE BANK                   SDBLUNT                    YSY20030521
+091403292ST BK ALCESTER    STATE BANK OF ALCESTER              SDALCESTER                 N Y20040826
+091403577ROBERT CO SISSETONROBERTS COUNTY NATIONAL BANK        SDSISSETON                 YSY20110103
+091403616FIRST ROSCOE      FIRST STATE BANK OF ROSCOE          SDROSCOE                   Y N20101103
+091403836FST ST BK ARMOUR  FIRST STATE BANK                    SDARMOUR                   N Y20010712
+091403865FIRST FID BURKE   FIRST FIDELITY BANK                 SDBURKE                    YSN20130111
+091404000WESTERN DAKOTA BK WESTERN DAKOTA BANK                 SDTIMBER LAKE              YSN20130313
+091404136RICHLAND BRUCE    RICHLAND STATE BANK                 SDBRUCE                    N Y        
+091404369FIRST NAT BK PHILIFIRST NATIONAL BANK IN PHILIP       SDPHILIP                   YSY20130329
+091404466FST FINCL DUPREE  1ST FINANCIAL BANK USA              SDDUPREE                   Y Y        
+091406121FIRST BK &TR MILBKFIRST BANK & TRUST OF MILBANK       SDMILBANK                  YSY20111019
+091406354FIRST ST BK WARNERFIRST STATE BANK OF WARNER          SDWARNER                   Y N20101116
+091406833FST SVGS BERESFORDFIRST SAVINGS BANK                  SDBERESFORD                Y Y        
+091407175AMER BK & TR      AMERICAN BANK & TRUST               SDWESSINGTON SPRINGS       Y Y20051004
+091408161SEC SB ALEXANDRIA SECURITY STATE BANK                 SDALEXANDRIA               Y N        
+091408297FULTON STATE BANK FULTON STATE BANK                   SDFULTON                   YSY20090929
+091408394SUNRISE BK DAKOTA SUNRISE BANK DAKOTA                 SDONIDA                    Y Y20011016
+091408446FIRST BK AND TR   FIRST BANK & TRUST                  SDBROOKINGS                Y Y20041201
+091408462DNB NATIONAL BANK DNB NATIONAL BANK                   SDCLEAR LAKE               YSY20070831
+091408501US BANK SO DAKOTA US BANK, NA                         SDSIOUX FALLS              Y N20130221
+091408598FST PREM SX FLS   FIRST PREMIER BANK                  SDSIOUX FALLS              Y Y        
+091408734GREAT WESTERN BANKGREAT WESTERN BANK                  SDSIOUX FALLS              Y Y20090528
+091408763PIONEER BELLE FCHEPIONEER BANK AND TRUST              SDSPEARFISH                Y Y20021209
+091408792IPSWICH ST BK     IPSWICH STATE BANK                  SDIPSWICH                  Y Y20090922
+091408899CORTRUST MITCHELL CORTRUST BANK NATIONAL ASSOCIATION  SDMITCHELL                 Y Y        
+091408909SEC ST BK         SECURITY STATE BANK                 SDEMERY                    Y N20081211
+091408983CITIBK SD NA      CITIBANK, N.A.                      SDSIOUX FALLS              Y N20120622
+091409458ANDES STATE BK    ANDES STATE BANK                    SDLAKE ANDES               N Y20090923
+091409487FIRST NATL YANKTONFIRST NATIONAL BANK SOUTH DAKOTA    SDYANKTON                  Y N20130821
+091409568ALERUS FINANCIAL  ALERUS FINANCIAL, N.A.              SDSIOUX FALLS              Y N20091229
+091409571CITIBANK SX FALLS CITIBANK, N.A.                      SDSIOUX FALLS              Y N20120622
+091409610SECURITY NATIONAL SECURITY NATIONAL BANK OF SD        SDDAKOTA DUNES             Y N20081010
+091409788SECURITY SAVINGS  SECURITY SAVINGS BANK               MNCANTON                   N Y20140606
+091450164US BK NA SD       U.S. BANK TRUST NATL ASSN SD        SDSIOUX FALLS              N Y        
+091502973NICOLET NATL BANK NICOLET NATIONAL BANK               WIGREEN BAY                Y N20130426
+091505093FIRST EAGLE RIVER FIRST NATIONAL BANK OF EAGLE RIVER  WIEAGLE RIVER              Y Y20130614
+091508171LAONA ST BK       LAONA STATE BANK                    WILAONA                    N Y20131003
+091508317FIRST PARK FALLS  FIRST NATIONAL BK OF PARK FALLS, THEWIPARK FALLS               YSY20130808
+091510075SEC ST IRON RIVER SECURITY STATE BANK                 WIIRON RIVER               N Y20010809
+091512594COMM PRENTICE     COMMUNITY FINANCIAL BANK            WIPRENTICE                 N Y20080103
+091800028NBC SUPERIOR      NATIONAL BANK OF COMMERCE           WISUPERIOR                 Y Y        
+091800293US BK WI LA CROSSEUS BANK, NA                         WILA CROSSE                Y N20130221
+091800316STATE BK FINANCIALSTATE BANK FINANCIAL                WILA CROSSE                Y Y20060801
+091800374COULEE BK         COULEE BANK                         WILA CROSSE                N Y20050428
+091801234NW CHIPPEWA FALLS NORTHWESTERN BANK                   WICHIPPEWA FALLS           Y Y        
+091802877FIRST RIVER FALLS FIRST NATIONAL BANK OF RIVER FALLS  WIRIVER FALLS              Y Y20130228
+091803274SECURITY FIN BK   SECURITY FINANCIAL BANK             WIDURAND                   YSY20100226
+091803300STERLING BARRON   STERLING BANK                       WIBARRON                   N Y20010710
+091803355ALLIANCE BANK     ALLIANCE BANK                       WIMONDOVI                  N Y20031003
+091803889CITZ ST BK        CITIZENS STATE BANK                 WICADOTT                   N Y20090204
+091803957FIRST BANGOR      FIRST NATIONAL BANK                 WIBANGOR                   N Y20070426
+091804147FIRST BALDWIN     THE FIRST BANK OF BALDWIN           WIBALDWIN                  Y Y20030627
+091805609PARK BK HOLMEN    PARK BANK                           WIHOLMEN                   Y Y20030814
+091805683INDEPENDENCE ST BKINDEPENDENCE STATE BANK             WIINDEPENDENCE             Y Y20090330
+091806381UNITED OSSEO      UNITED BANK OF OSSEO                WIOSSEO                    N Y20060209
+091807254CITZ SB           CITIZENS STATE BANK                 WIHUDSON                   Y Y20100602
+091807571PEOPLES BLOOMER   PEOPLES STATE BANK OF BLOOMER       WIBLOOMER                  N Y20110425
+091808347WAUMANDEE ST BK   WAUMANDEE STATE BANK                WIWAUMANDEE                N Y20090824
+091808363UNITY BANK        UNITY BANK                          WIAUGUSTA                  Y Y20110323
+091809362HIAWATHA HAGER CTYHIAWATHA NATIONAL BANK              WIGLENWOOD CITY            YSN20110106
+091809472SHELL LAKE ST BK  SHELL LAKE STATE BANK               WISHELL LAKE               N Y20010724
+091809524PIGEON FALLS ST BKPIGEON FALLS STATE BANK             WIPIGEON FALLS             Y Y20130909
+091809731BREMER NA MENOMONIBREMER BANK, NATIONAL ASSOCIATION   WIMENOMONIE                N Y20000210
+091809980FIRST NAT'L COM BKFIRST NATIONAL COMMUNITY BANK       WINEW RICHMOND             YSY20070329
+091810050EAGLE VLY ST CRX FEAGLE VALLEY BANK, N.A.             WISAINT CROIX FALLS        YSY20100809
+091810296PIONEER BANK WI   PIONEER BANK OF WISCONSIN           WILADYSMITH                N Y20101230
+091812430CHARTER EAU CLAIRECHARTER BANK EAU CLAIRE             WIEAU CLAIRE               Y N20130111
+091818256FIRST AMERICAN BK FIRST AMERICAN BANK, NA             WIHUDSON                   Y N20070622
+091900067WESTERN DULUTH    WESTERN NATIONAL BANK               MNDULUTH                   YSY20051031
+091900096PIONEER DULUTH    PIONEER NATIONAL BANK               MNDULUTH                   YSY20100205
+091900106NO SHORE COMM DUL NORTH SHORE BANK OF COMMERCE        MNDULUTH                   Y N20111012
+091900119PARK ST BK DULUTH PARK STATE BANK                     MNDULUTH                   Y Y20100908
+091900193MERCHANTS WINONA  MERCHANTS BANK, NA                  MNWINONA                   Y Y20011003
+091900216WINONA NATL       WINONA NATIONAL BANK                MNWINONA                   Y Y20011005
+091900287BREMER NA ST CLOUDBREMER BANK, NATIONAL ASSOCIATION   MNSAINT CLOUD              N Y20100809
+091900559STATE FARIBAULT   STATE BANK OF FARIBAULT             MNFARIBAULT                Y Y20030529
+091900630BREMER NA BRAINERDBREMER BANK, NATIONAL ASSOCIATION   MNBRAINERD                 N Y19990721
+091900944STERLING SB AUSTINSTERLING STATE BANK                 MNROCHESTER                Y Y20020208
+091901192CITZ BK MINNESOTA CITIZENS BANK MINNESOTA             MNNEW ULM                  Y Y20030714
+091901202FRANDSEN BK TR    FRANDSEN BANK & TRUST               MNLONSDALE                 Y Y20050622
+091901215ALLIANCE NEW ULM  ALLIANCE BANK                       MNNEW ULM                  Y Y        
+091901338FIRST ST PETER    FIRST NATIONAL BANK MINNESOTA       MNSAINT PETER              YSY20100809
+091901341NIC CNTY ST PETER NICOLLET COUNTY BANK                MNSAINT PETER              Y N20130111
+091901477FIRST NORTHFIELD  FIRST NATIONAL BANK                 MNNORTHFIELD               YSY20050503
+091901558ROUNDBANK WASECA  ROUNDBANK                           MNWASECA                   Y Y        
+091901561FIRST NATL WASECA FIRST NATIONAL BANK                 MNWASECA                   Y Y        
+091901862CITZ HUTCHINSON   CITIZENS BANK & TRUST COMPANY       MNHUTCHINSON               Y Y        
+091901891CENTER LITCHFIELD CENTER NATIONAL BANK                MNLITCHFIELD               Y Y        
+091901969AMER SLEEPY EYE   AMERICANA COMMUNITY BANK            MNSLEEPY EYE               Y Y        
+091901972FST SEC SLEEPY EYEFIRST SECURITY BANK-SLEEPY EYE      MNSLEEPY EYE               N Y        
+091902065MINNESOTA NATL BK MINNESOTA NATIONAL BANK             MNSAUK CENTRE              N Y20080321
+091902353FIRST MINNESOTA BKFIRST MINNESOTA BANK                MNEXCELSIOR                Y Y20100809
+091902706ST BK NEW PRAGUE  STATE BANK OF NEW PRAGUE            MNNEW PRAGUE               Y Y20080922
+091902793GRANITE FLS BK    GRANITE FALLS BANK                  MNGRANITE FALLS            Y Y20090106
+091903051AM HER LG PRAIRIE AMERICAN HERITAGE NATL BANK         MNLONG PRAIRIE             Y Y        
+091903239FORESIGHT BANK    FORESIGHT BANK                      MNPLAINVIEW                YSY20130102
+091903310FIRST MILACA      FIRST NATIONAL BANK                 MNMILACA                   Y Y        
+091903404MINSTAR LK CRYSTALMINNSTAR BANK N.A.                  MNLAKE CRYSTAL             YSY20000717
+091903446STATE BANK DELANO STATE BANK OF DELANO                MNDELANO                   YSN20100811
+091903530FIRST OSAKIS      FIRST NATIONAL BANK OF OSAKIS       MNOSAKIS                   Y Y20110119
+091903608UNITY BK          UNITY BANK                          MNRUSH CITY                Y N20130726
+091903666KASSON ST BK      KASSON STATE BANK                   MNKASSON                   Y Y        
+091903831BK ELK RIVER      THE BANK OF ELK RIVER               MNELK RIVER                N Y20071005
+091903844FIRST ELK RIVER   FIRST NATIONAL BANK OF ELK RIVER    MNELK RIVER                Y Y20101109
+091904050PIONEER MAPLETON  PIONEER BANK                        MNMAPLETON                 YSY20040311
+091904270FIRST LE CENTER   FIRST NATIONAL BANK                 MNLE CENTER                YSN20080403
+091904610ANNANDALE ST BK   ANNANDALE STATE BANK                MNANNANDALE                Y Y20010124
+091904827GRANITE CMTY BK   GRANITE COMMUNITY BANK              MNCOLD SPRING              YSN20130712
+091904856CITIZENS ALLIANCE CITIZENS ALLIANCE BANK              MNCLARA CITY               Y Y20101005
+091905059CORNERSTONE ST BK CORNERSTONE STATE BANK              MNMONTGOMERY               YSN20111227
+091905114CENT BK GOLDEN VALCENTRAL BANK                        MNGOLDEN VALLEY            Y Y20131008
+091905237REPUBLIC DULUTH   REPUBLIC BANK, INC                  MNDULUTH                   N Y20100219
+091905253SEC LEWISTON      SECURITY STATE BANK OF LEWISTON     MNLEWISTON                 N Y20101027
+091905295CITZ WAVERLY      CITIZENS STATE BANK OF WAVERLY, INC.MNWAVERLY                  Y Y20110209
+091905444CROW RIVER DELANO CROW RIVER STATE BANK               MNDELANO                   YSN20050801
+091905525FST SB SWANVILLE  FIRST STATE BANK                    MNSWANVILLE                N Y        
+091905648PEQUOT LAKES SB   LAKES STATE BANK                    MNPEQUOT LAKES             Y N20080228
+091905664PREMIER BANK      PREMIER BANK                        MNROCHESTER                N Y20060119
+091905680PREMIER BANK      PREMIER BANK MINNESOTA              MNFARMINGTON               N Y20090615
+091905716STEARNS HOLDINGFRDSTEARNS BANK OF HOLDINGFORD, N.A.   MNHOLDINGFORD              N Y        
+091905745VILLAGE ST FRANCISVILLAGE BANK                        MNSAINT FRANCIS            Y Y20100809
+091906508NO AMER BELGRADE  NORTH AMERICAN STATE BANK           MNBELGRADE                 Y N        
+091906731CENBANK BUFFALO   CENBANK                             MNBUFFALO LAKE             Y N20040331
+091907125DEERWOOD BK       DEERWOOD BANK                       MNDEERWOOD                 Y Y20140310
+091907235EASTWOOD STCHARLESEASTWOOD BANK                       MNROCHESTER                Y Y        
+091907471NORTHVIEW BANK    NORTHVIEW BANK                      MNSANDSTONE                N Y20120123
+091908027LANDMARK C ISANTI LANDMARK COMMUNITY BANK, NA         MNISANTI                   Y Y20100219
+091908373PEOPLES MAD LAKE  PEOPLES STATE BANK OF MADISON LAKE  MNMADISON LAKE             N Y20101026
+091908412BK OF MAPLE PLAIN BANK OF MAPLE PLAIN                 MNMAPLE PLAIN              Y Y        
+091908700BEACON BANK       BEACON BANK                         MNSHOREWOOD                Y Y        
+091908726NEW MARKET BK     NEW MARKET BANK                     MNNEW MARKET               N Y20100809
+091908742SB NEW RICHLAND   STATE BANK                          MNNEW RICHLAND             N Y20080912
+091908768PROGROWTH BANK    PROGROWTH BANK                      MNNICOLLET                 YSN20130117
+091908881WOODLANDS HINCKLEYWOODLANDS NATIONAL BANK             MNONAMIA                   Y N20130117
+091908988F & M PIERZ       FARMERS & MERCHANTS ST BK OF PIERZ  MNPIERZ                    Y Y20121003
+091909055PRIOR LAKE ST BK  PRIOR LAKE STATE BANK               MNPRIOR LAKE               YSY20090601
+091909068FIRST PROCTOR     FIRST NATIONAL BANK                 MNPROCTOR                  YSY20090519
+091909178PLAZA PK WAITE PK PLAZA PARK STATE BANK               MNWAITE PARK               Y N20130117
+091909217FST SB ROSEMOUNT  FIRST STATE BANK                    MNROSEMOUNT                N Y        
+091909466SENTRY BANK       SENTRY BANK                         MNSAINT JOSEPH             N Y20110103
+091909518ST BK BLOMKEST    CONCORDE BANK                       MNBLOMKEST                 YSN20060308
+091910028BANK OF ZUMBROTA  BANK OF ZUMBROTA                    MNZUMBROTA                 N Y        
+091910196LAKE ELMO BK      LAKE ELMO BANK                      MNLAKE ELMO                Y Y        
+091910455STEARNS BANK N A  STEARNS BANK NA                     MNSAINT CLOUD              Y Y20100809
+091911153LAKE REGION BANK  LAKE REGION BANK                    MNNEW LONDON               Y Y20031201
+091911315CITIZENS ALLIANCE CITIZENS ALLIANCE BANK              MNLAKE LILLIAN             Y N20101006
+091911357STEARNS BK UPSALA STEARNS BANK OF UPSALA, N.A.        MNUPSALA                   N Y19990721
+091911551FST ST BAYPORT    FIRST STATE BANK AND TRUST          MNBAYPORT                  Y Y20021231
+091912330FIRST F & M BANK  FIRST FARMERS & MERCHANTS BANK      MNCANNON FALLS             Y Y20130823
+091912482CITIZENS ALLIANCE CITIZENS ALLIANCE BANK              MNHOWARD LAKE              Y N20111026
+091912521LAKE AREA LINDSTROLAKE AREA BANK                      MNLINDSTROM                Y N20130117
+091912576COMMERCE BANK     COMMERCE BANK                       MNGENEVA                   Y N20100225
+091913148CASTLE ROCK BK    CASTLE ROCK BANK                    MNCASTLE ROCK              Y N20130117
+091913180PRINSBANK         PRINSBANK                           MNPRINSBURG                N Y20061002
+091913216PEO BK CAMBRIDGE  PEOPLES BANK OF COMMERCE            MNCAMBRIDGE                Y N20130117
+091913601KANABEC ST MORA   FIRST CITIZENS NATIONAL BANK        IAMASON CITY               N Y20140714
+091913685TWENTYFST N HAM LK21ST CENTURY BANK                   MNLORETTO                  Y N20130117
+091914202PEOPLES MORA      PEOPLES NATIONAL BANK OF MORA       MNMORA                     Y Y20090310
+091914273FIRST ST JAMES    FIRST NATIONAL BANK AT ST. JAMES    MNSAINT JAMES              YSY20130422
+091914286BLACKRIDGEBANK    BLACKRIDGEBANK                      MNNISSWA                   Y N20130118
+091914370VERMILLION ST BK  VERMILLION STATE BANK               MNVERMILLION               Y Y        
+091914406ST MARTIN NATL    ST MARTIN NATIONAL BANK             MNSAINT MARTIN             YSN20100809
+091914561SEC SB ST CROIX   SECURITY STATE BANK OF MARINE       MNMARINE ST CROIX          Y N        
+091914710HERITAGE WILLMAR  HERITAGE BANK, NA                   MNSPICER                   Y Y20131001
+091914820FIRST MOOSE LAKE  FIRST NATIONAL BANK                 MNMOOSE LAKE               YSY20060525
+091915201SHERBURNE BECKER  SHERBURNE STATE BANK                MNBECKER                   N Y20100324
+091915612PEOPLES PLAINVIEW PEOPLES STATE BANK OF PLAINVIEW     MNPLAINVIEW                YSN20050210
+091915654KLEINBANK         KLEINBANK                           MNBIG LAKE                 Y Y20130506
+091915670HOME SB LITCHFIELDHOME STATE BANK                     MNLITCHFIELD               N Y20080102
+091915751PINE CNTRY ROYALTNPINE COUNTRY BANK                   MNLITTLE FALLS             Y N20071213
+091915845MINNWEST BANK, MV MINNWEST BANK                       MNREDWOOD FALLS            Y Y20140523
+091916378HIGHLAND BK       HIGHLAND BANK                       MNMAPLE GROVE              Y Y20100413
+091916527SEC ST BK AITKIN  SECURITY STATE BANK                 MNAITKIN                   Y N        
+091916543BANKWEST ROCKFORD BANKWEST                            MNROCKFORD                 YSN20060405
+091916815PROVINCIAL LAKEVILPROVINCIAL BANK                     MNLAKEVILLE                Y N20080402
+091916967NORTHERN MANKATO  NORTHERN STAR BANK                  MNMANKATO                  Y Y20121101
+091917047COMMUNITY BK CORP COMMUNITY BANK CORPORATION          MNCHASKA                   N Y20130208
+091917160COMM NORTHFIELD   COMMUNITY RESOURCE BANK             MNNORTHFIELD               Y Y20100226
+091917513RIVERLAND BANK    RIVERLAND BANK                      MNJORDAN                   YSY20110311
+091971533AMERICAN BRAINERD AMERICAN NATIONAL BANK OF MINNESOTA MNBRAINERD                 Y N20100119
+091981646SOUTH METRO FCU   SOUTH METRO FED CR UNION            MNPRIOR LAKE               N Y20130227
+092001208FIRST SEC HELENA  FIRST SECURITY BANK OF HELENA       MTHELENA                   YSY20110916
+092001512GLACIER VALLEY BK GLACIER BANK-VALLEY BK HELENA DIV   MTHELENA                   Y N20120625
+092001677MTN WEST HELENA   MOUNTAIN WEST BANK, N.A.            MTHELENA                   Y Y        
+092101014UNITED ABSAROKEE  UNITED BANK                         MTABSAROKEE                YSY20120111
+092101289FIRST SEC MALTA   FIRST SECURITY BANK                 MTMALTA                    YSY20090623
+092101360FIRST ST MALTA    FIRST STATE BANK OF MALTA, THE      MTMALTA                    Y Y20110204
+092102851FIRST MONTANA BK  FIRST MONTANA BANK, INC             MTLIBBY                    Y Y20100106
+092104244MONT PLENTYWOOD   MONTANA STATE BANK                  MTPLENTYWOOD               YSY20100407
+092105104BK OF BAKER       BANK OF BAKER                       MTBAKER                    N Y20020801
+092105159FARMERS DENTON    FARMERS STATE BANK                  MTDENTON                   Y Y        
+092900383US BANK MONTANA   US BANK, NA                         MTBILLINGS                 Y N20140303
+092900613FIRST SEC BOZEMAN FIRST SECURITY BANK                 MTBOZEMAN                  Y Y        
+092900736GLACIER FIRST MT  GLACIER BANK-FIRST BANK MONTANA DIV MTLEWISTOWN                Y N20120625
+092900956FST COMM GLASGOW  FIRST COMMUNITY BANK                MTGLASGOW                  Y Y20000505
+092900985CITZ BK BIG TIMBERCITIZENS BANK AND TRUST CO          MTBIG TIMBER               Y Y        
+092901104ST BK TOWNSEND    STATE BANK                          MTTOWNSEND                 N Y20071102
+092901117FST MAD VLY ENNIS FIRST MADISON VALLEY BANK           MTENNIS                    Y N        
+092901175TETON BANKS       TETON BANKS                         MTFAIRFIELD                YSN20110405
+092901214ROCKY MTN BILLINGSROCKY MOUNTAIN BANK                 MTBILLINGS                 N Y20060622
+092901227AMERICAN BK       AMERICAN BANK                       MTLIVINGSTON               Y Y20041209
+092901256FIRST SEC ROUNDUP FIRST SECURITY BANK OF ROUNDUP      MTROUNDUP                  N Y20140115
+092901272FLATHEAD BIGFORK  FLATHEAD BANK OF BIGFORK            MTBIGFORK                  Y Y        
+092901298FST SEC DEER LODGEFIRST SECURITY BANK                 MTDEER LODGE               Y N        
+092901337GLACIER FIRST SECUGLACIER BANK-FIRST SECURITY DIV     MTMISSOULA                 Y N20120625
+092901340INDEPENDENCE HAVREINDEPENDENCE BANK                   MTHAVRE                    Y Y20000703
+092901382BANK OF ROCKIES   BANK OF THE ROCKIES, NA             MTWHITE SULPHUR SPRINGS    YSY20060831
+092901395FIRST CITZ POLSON FIRST CITIZENS BANK OF POLSON, N. A.MTPOLSON                   YSY20060823
+092901434FIRST BK SIDNEY   1ST BANK                            MTSIDNEY                   Y Y        
+092901476COMMUNITY BANK INCCOMMUNITY BANK, INC                 MTRONAN                    Y Y20121011
+092901489FIRST CITZ BUTTE  FIRST CITIZENS BANK OF BUTTE        MTBUTTE                    YSY20061019
+092901492VALLEY BK GLASGOW VALLEY BANK OF GLASGOW              MTGLASGOW                  Y Y        
+092901560BITTERROOT BK LOLOBITTERROOT VALLEY BANK              MTLOLO                     Y Y        
+092901625NATIVE AMERICAN BKNATIVE AMERICAN BANK N.A.           MTBROWNING                 Y N20110919
+092901654GLACIER BIG SKY W GLACIER BANK-BIG SKY WESTERN DIV    MTBOZEMAN                  Y N20120625
+092901683FI COMM BILLINGS  FIRST INTERSTATE BANK               MTBILLINGS                 Y Y        
+092902132MANHATTAN BK      MANHATTAN BANK                      MTMANHATTAN                Y Y20080415
+092902271FIRST ST BK SHELBYFIRST STATE BANK                    MTSHELBY                   Y Y20140424
+092902297VALLEY KALISPELL  VALLEY BANK OF KALISPELL            MTKALISPELL                N Y20100603
+092902394FARMERS ST VICTOR FARMERS STATE BANK                  MTVICTOR                   Y Y        
+092902488STOCKMENS CASCADE STOCKMENS BANK                      MTCASCADE                  YSN20120904
+092902983US BANK NA        US BANK, NA                         MTGREAT FALLS              Y N20140303
+092903270FIRST SB FORSYTH  FIRST STATE BANK                    MTFORSYTH                  Y Y        
+092903542BANK BRIDGER      BANK OF BRIDGER, NA                 MTBRIDGER                  YSY20070321
+092904185RUBY VLY TWIN BRIDRUBY VALLEY NATIONAL BANK           MTTWIN BRIDGES             Y Y        
+092904761LITTLE HORN HARDINLITTLE HORN STATE BANK              MTHARDIN                   Y Y        
+092905142YELLOWSTONE LAURELYELLOWSTONE BANK                    MTLAUREL                   Y Y        
+092905207BELT VALLEY BK    BELT VALLEY BANK                    MTBELT                     N Y20140429
+092905210GRANITE MT BK, INCGRANITE MOUNTAIN BANK, INC          MTPHILIPSBURG              Y Y20131016
+092905249STOCKMAN MILES CITSTOCKMAN BANK OF MONTANA            MTMILES CITY               Y Y        
+092905265LAKE CO ST IGNATUSLAKE COUNTY BANK                    MTST IGNATIUS              YSY20060831
+092905362DUTTON ST BK      DUTTON STATE BANK                   MTDUTTON                   YSN20060915
+092905430WEST ONE BANK     WEST ONE BANK                       MTKALISPELL                Y N20030401
+092905443BANK OF BOZEMAN   BANK OF BOZEMAN                     MTBOZEMAN                  Y N20050415
+092905456FREEDOM BANK      FREEDOM BANK                        MTCOLUMBIA FALLS           Y Y20100519
+092905508TREASURE STATE BK TREASURE STATE BANK                 MTMISSOULA                 N Y20110603
+096000580BANKCHEROKEE      BANKCHEROKEE                        MNSAINT PAUL               Y Y20100809
+096000603AMER BK ST PAUL   AMERICAN BANK OF ST PAUL            MNST PAUL                  Y Y20130308
+096000661WESTERN BK NA     WESTERN BANK, NATIONAL ASSOCIATION  MNSAINT PAUL               Y Y20130521
+096000959NORTH STAR RSVLE  NORTH STAR BANK                     MNROSEVILLE                N Y20070307
+096001013SUNRISE BANKS NA  SUNRISE BANKS, NATIONAL ASSOCIATION MNSAINT PAUL               Y N20130402
+096005093PREMIER MAPLEWOOD PREMIER BANK                        MNMAPLEWOOD                Y Y        
+096010415BREMER NA SO STP  BREMER BANK, NATIONAL ASSOCIATION   MNSOUTH ST PAUL            Y Y20101228
+096015232ANCHOR BK  STP NA ANCHOR BANK, NA                     MNSAINT PAUL               Y Y20091116
+096016888KEY COMMUNITY BK  KEY COMMUNITY BANK                  MNINVER GROVE HEIGHTS      Y Y20091029
+096016930NORTH AMER BK COM NORTH AMERICAN BANKING COMPANY      MNROSEVILLE                Y Y20090106
+096016972AGRIBANK ST PAUL  AGRIBANK,FCB                        MNSAINT PAUL               Y Y20100809
+096017230DRAKE BANK        DRAKE BANK                          MNSAINT PAUL               N Y20100809
+101000019COMM BK           COMMERCE BANK                       MOKANSAS CITY              Y Y20110628
+101000048FRB KC            FEDERAL RESERVE BANK OF KANSAS CITY MOKANSAS CITY              Y Y19990715
+101000158MO BK & TR KCMO   MISSOURI BANK AND TRUST CO          MOKANSAS CITY              Y Y        
+101000187US BANK KANSAS    US BANK, NA                         KSOVERLAND PARK            Y N20140303
+101000695UMB BK NA         UMB BANK, N.A.                      MOKANSAS CITY              Y Y20070220
+101000925SECURITY KCK      SECURITY BANK OF KANSAS CITY        KSKANSAS CITY              Y Y        
+101000967INDUSTRIAL ST BK  INDUSTRIAL STATE BANK               KSKANSAS CITY              Y Y19990929
+101001005COMMUNITY FIRST BKCOMMUNITY FIRST BANK                KSKANSAS CITY              N Y20121123
+101001018FST BK MISSOURI   FIRST BANK OF MISSOURI              MOKANSAS CITY              Y Y20100809
+101001047BANK OF LABOR     BANK OF LABOR                       KSKANSAS CITY              Y Y20131011
+101001283CENTRAL BK KC     CENTRAL BANK OF KANSAS CITY         MOKANSAS CITY              Y Y        
+101001306COUNTRY CLUB BK   COUNTRY CLUB BANK                   MOKANSAS CITY              Y Y20090403
+101001351VALLEY VIEW OVPK  VALLEY VIEW STATE BANK              KSOVERLAND PARK            Y Y        
+101001364BLUE RIDGE BK KCMOBLUE RIDGE BK & TR CO               MOINDEPENDENCE             Y Y20040316
+101002716NBH BK NA, HILLCRENBH BANK NA, HILLCREST BANK BRANCH  KSOVERLAND PARK            Y N20121116
+101002855GREAT AMERICAN BK GREAT AMERICAN BANK                 KSDE SOTO                  N Y20100809
+101002949NBH BK NA         NBH BANK NA                         KSOVERLAND PARK            Y N20121116
+101003317FST NATL BK KS    FIRST NATIONAL BANK OF OMAHA        KSSHAWNEE MISSION          Y N20110428
+101003773COMMUNITY RAYMORE COMMUNITY BANK OF RAYMORE           MORAYMORE                  YSN20000720
+101004280BK OF LEES SUMMIT BANK OF LEES SUMMIT                 MOLEES SUMMIT              Y Y        
+101005027BK OF BLUE VALLEY BANK OF BLUE VALLEY                 KSOVERLAND PARK            Y Y        
+101005360BANK OF BELTON    BANK OF BELTON                      MOBELTON                   Y Y        
+101005483METCALF BK        METCALF BANK                        MOLEES SUMMIT              YSY20080428
+101006699NBH BANK NA       NBH BANK NA-BANK MIDWEST            MOKANSAS CITY              Y Y20121221
+101007041PEOPLES BANK      PEOPLES BANK                        KSOVERLAND PARK            Y Y        
+101011998MISSION BANK      THE MISSION BANK                    KSMISSION                  Y Y        
+101014869NATIONAL BK OF KC NATIONAL BANK OF KANSAS CITY        KSLEAWOOD                  Y Y20040402
+101014924ALTERRA BK        ALTERRA BK                          KSLEAWOOD                  Y N20130717
+101014953CORNERSTONE BANK  CORNERSTONE BANK                    KSOVERLAND PARK            Y Y20011026
+101015046BK OF PR VILLAGE  BANK OF PRAIRIE VILLAGE             KSPRAIRIE VILLAGE          Y Y20111223
+101015088FREEDOM BANK      FREEDOM BANK                        KSOVERLAND PARK            Y N20110928
+101015101BOKF              BOKF NA                             KSOVERLAND PARK            Y N20130621
+101015282CROSSFIRST BK     CROSSFIRST BANK                     KSOVERLAND PARK            Y N20111108
+101019084CENTRAL BK OF KC  CENTRAL BANK OF KANSAS CITY         MOKANSAS CITY              Y N20140124
+101019194SUMMIT BANK KC    SUMMIT BANK OF KANSAS CITY          MOLEES SUMMIT              Y N20100809
+101019482ARMED FORCES BK   ARMED FORCES BANK, NA               KSFORT LEAVENWORTH         Y N20110601
+101089496FEDERAL SAVINGS BKTHE FEDERAL SAVINGS BANK            ILCHICAGO                  Y N20140304
+101089700H&R BLOCK BANK    H&R BLOCK BANK                      MOKANSAS CITY              YSY20090311
+101089807THE PRIVATEBANK   THE PRIVATEBANK AND TRUST COMPANY   ILCHICAGO                  Y N20100203
+101089865ADAMS DAIRY BK    ADAMS DAIRY BANK                    MOBLUE SPRINGS             Y Y20131021
+101100016EXCHANGE NA BK & TEXCHANGE NATIONAL BANK & TRUST CO   KSATCHISON                 Y Y20110705
+101100029INTRUST WICHITA   INTRUST BANK NATIONAL ASSOCIATION   KSWICHITA                  Y Y        
+101100142KAW VLLY BK TOPEKAKAW VALLEY  BANK                    KSTOPEKA                   Y Y20140113
+101100171FIRST HUTCH       THE FIRST NATIONAL BANK OF HUTCH    KSHUTCHINSON               Y Y        
+101100223CENTRAL B&T HUTCH CENTRAL BANK & TRUST CO             KSHUTCHINSON               Y Y        
+101100317CONDON BK & TR    CONDON BANK & TRUST                 KSCOFFEYVILLE              Y Y20101231
+101100375COMML BK PARSONS  COMMERCIAL BANK                     KSPARSONS                  Y Y        
+101100579EMPRISE BK WICH   EMPRISE BANK                        KSWICHITA                  Y Y        
+101100605CITY SB FT SCOTT  CITY STATE BANK                     KSFORT SCOTT               Y N20060308
+101100618SOUTHWEST NATL BK SOUTHWEST NATIONAL BANK             KSWICHITA                  Y Y20040922
+101100621SUNFLOWER BK      SUNFLOWER BANK NATIONAL ASSOCIATION KSSALINA                   Y Y        
+101100676FIDELITY TOPEKA   FIDELITY STATE BK & TR CO           KSTOPEKA                   Y Y        
+101100692BK OF COMM CHANUTEBANK OF COMMERCE                    KSCHANUTE                  Y Y        
+101100728COREFIRST BK & TR COREFIRST BANK & TRUST              KSTOPEKA                   Y Y20070904
+101100760ESB               ESB FINANCIAL                       KSEMPORIA                  Y Y20061221
+101100786LYON CNTY ST BK   LYON COUNTY STATE BANK              KSEMPORIA                  Y Y        
+101100854FST BK NEWTON     FIRST BANK OF NEWTON                KSNEWTON                   Y N20130117
+101100964KS STATE OTTAWA   KANSAS STATE BANK                   KSOTTAWA                   Y Y        
+101100993UNION SB ARK CITY THE UNION STATE BANK                KSARKANSAS CITY            Y Y        
+101101031IMPACT BK         IMPACT BANK                         KSWELLINGTON               Y Y20120402
+101101109CORNER BANK       CORNERBANK                          KSWINFIELD                 Y Y20140703
+101101141CAPITAL CITY ST BKCAPITAL CITY BANK                   KSTOPEKA                   Y Y20000511
+101101154KANSAS ST TREAS   TREAS. OF THE STATE OF KANSAS       KSTOPEKA                   N Y20140501
+101101293CENTRAL JUNC CITY CENTRAL NATIONAL BANK               KSJUNCTION CITY            Y Y        
+101101303FIRST JUNC CITY   FIRST NATIONAL BANK AND TRUST CO    KSJUNCTION CITY            YSY20060922
+101101413GARDEN PLAIN ST BKGARDEN PLAIN STATE BANK             KSWICHITA                  Y Y19990618
+101101536KANSAS ST BANK    KANSAS STATE BANK OF MANHATTAN      KSMANHATTAN                Y N20130117
+101101581PEOPLES MCPHERSON PEOPLES BANK AND TRUST COMPANY      KSMCPHERSON                Y Y        
+101101620UNION CLAY CENTER UNION STATE BANK                    KSCLAY CENTER              YSY20080321
+101101659PEOPLES PRATT     THE PEOPLES BANK                    KSPRATT                    Y Y        
+101101662FNB PRATT         FIRST NATIONAL BANK                 KSPRATT                    Y N        
+101101730FIDL DODGE CITY   FIDELITY STATE BANK & TRUST CO      KSDODGE CITY               Y Y        
+101101785CITZ SB PAOLA     CITIZENS STATE BANK                 KSPAOLA                    Y Y        
+101101866FIRST BELOIT KS   FIRST NATIONAL BANK OF BELOIT       KSBELOIT                   YSY20100407
+101101921FNB FREDONIA      FIRST NATIONAL BANK                 KSFREDONIA                 N Y20140529
+101101934STATE BK OF KANSASSTATE BANK OF KANSAS                KSFREDONIA                 YSN20130205
+101101947FHLB TOPEKA       FEDERAL HOME LOAN BANK OF TOPEKA    KSTOPEKA                   Y N20070103
+101101950MORRILL & JANES BTMORRILL & JANES BANK AND TRUST CO   KSMERRIAM                  Y Y20021113
+101101976CITZ BK HIAWATHA  CITIZENS STATE BANK & TRUST CO      KSHIAWATHA                 YSY20060802
+101101992FIRST ST LARNED   FIRST STATE BANK AND TRUST CO       KSLARNED                   Y Y20090519
+101102014FIRST NEODESHA    FIRST NEODESHA BANK                 KSNEODESHA                 Y Y        
+101102069HOLTON NATL BANK  GIRARD NATIONAL BANK                KSHOLTON                   YSN20031017
+101102108CITZ BK KANSAS    CITIZENS BANK OF KANSAS             KSKINGMAN                  YSN20130613
+101102124KANZA BK          KANZA BANK                          KSKINGMAN                  Y N20130318
+101102166FARMERS & DROVERS FARMERS & DROVERS BANK              KSCOUNCIL GROVE            N Y        
+101102182FIRST GIRARD      FIRST NATIONAL BANK GIRARD          KSGIRARD                   YSY20030801
+101102221GOPPERT ST SERV BKGOPPERT STATE SERVICE BANK          KSGARNETT                  Y Y20121119
+101102289HOME BK AND TR CO HOME BANK & TRUST CO                KSEUREKA                   Y N        
+101102315BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+101102331CITZ ST BK        CITIZENS STATE BANK OF MARYSVILLE   KSMARYSVILLE               YSY20110509
+101102344ASTRA BK          ASTRA BANK                          KSSCANDIA                  YSY20090611
+101102360PEO BELLEVILLE KS PEOPLES EXCHANGE BANK               KSBELLEVILLE               N Y        
+101102373STOCK EX CALDWELL STOCK EXCHANGE BANK                 KSCALDWELL                 Y Y        
+101102438FIRST BANK        FIRST BANK                          KSSTERLING                 YSY20060802
+101102496CITZ ST ELLSWORTH CITIZENS STATE BANK & TRUST CO      KSELLSWORTH                YSY20060630
+101102522GIRARD NB YATES CTGIRARD NATIONAL BANK                KSGIRARD                   YSN20031017
+101102548FNB GOODLAND      FIRST NATIONAL BANK                 KSGOODLAND                 Y Y19990720
+101102551BANKWEST OF KANSASBANKWEST OF KANSAS                  KSGOODLAND                 YSY20060728
+101102564FST KS BK         FIRST KANSAS BANK                   KSHOISINGTON               N Y20100503
+101102645PRAIRIE BK OF KS  PRAIRIE BANK OF KANSAS              KSSTAFFORD                 YSY20130627
+101102700MARION NTL BK     MARION NATIONAL BANK                KSMARION                   YSN20130618
+101102784SJN BK OF KANSAS  SJN BANK OF KANSAS                  KSSAINT JOHN               N Y20140717
+101102836FIRST LIBERAL     FIRST NATIONAL BANK                 KSLIBERAL                  Y Y        
+101102852BK OF THE FLINT   BANK OF THE FLINT HILLS             KSWAMEGO                   YSY20140612
+101102865WSTRN SB GARDN CTYWESTERN STATE BANK                  KSGARDEN CITY              Y Y        
+101102878VERUS BANK NA     VERUS BANK                          KSDERBY                    YSY20120106
+101102959PONY EXP COMM BK  PONY EXPRESS COMMUNITY BANK         MOSAINT JOSEPH             YSY20100809
+101102988CHETOPA ST BK     CHETOPA STATE BANK & TRUST CO       KSCHETOPA                  Y Y        
+101103026FNB WASHINGTON    FIRST NATIONAL BANK                 KSWASHINGTON               YSY20060301
+101103149ST BK DOWNS       STATE BANK OF DOWNS                 KSDOWNS                    Y N20000501
+101103152DOWNS BK          DOWNS BANK                          KSDOWNS                    YSY20121228
+101103275BALDWIN ST BK     BALDWIN STATE BANK                  KSBALDWIN CITY             Y N20060427
+101103327STOCKTON NATL     STOCKTON NATIONAL BANK              KSSTOCKTON                 YSY20050722
+101103356FNB PHILLIPSBURG  FIRST NATIONAL BK AND TRST          KSPHILLIPSBURG             YSY20060606
+101103385CONWAY BANK N A   CONWAY BANK, N.A.                   KSCONWAY SPRINGS           YSY20121001
+101103466FNB SEDAN         FIRST NATIONAL BANK                 KSSEDAN                    YSY20060518
+101103550FNB HOWARD        FIRST NATIONAL BANK                 KSHOWARD                   YSN20110207
+101103592ST EXCHG BK       STATE EXCHANGE BANK                 KSMANKATO                  N Y20100202
+101103660KENDALL ST BK     KENDALL STATE BANK                  KSVALLEY FALLS             N Y20080528
+101103686FNB SYRACUSE      FIRST NATIONAL BANK                 KSSYRACUSE                 YSY20130605
+101103699VALLEY SB SYRACUSEVALLEY STATE BANK                   KSSYRACUSE                 YSN20060501
+101104041FNB SCOTT CITY    FIRST NATIONAL BANK                 KSSCOTT CITY               YSY20100707
+101104067STOCKGROWERS ST BKSTOCKGROWERS STATE BANK             KSASHLAND                  N Y        
+101104151ST BK OSKALOOSA   STATE BANK OF OSKALOOSA             KSOSKALOOSA                YSN20131118
+101104164VALLEY ST BK      VALLEY STATE BANK                   KSBELLE PLAINE             N Y        
+101104342FARMERS SB WATHENAFARMERS STATE BANK                  KSWATHENA                  N Y20111209
+101104436FIRST WAVERLY     FIRST NATIONAL BANK OF KANSAS       KSBURLINGTON               YSY20090702
+101104494CITZ SB CHENEY    CITIZENS STATE BANK OF CHENEY, KS   KSCHENEY                   N Y        
+101104504COMM SENECA       COMMUNITY NATIONAL BANK             KSSENECA                   YSY20060324
+101104562COBANK ACB.       COBANK ACB                          KSWICHITA                  Y N20140501
+101104708BUCKLIN SB BUCKLINGIRARD NATIONAL BANK                KSBUCKLIN                  YSN20130625
+101104779PEOPLES COLDWATER PEOPLES BANK                        KSCOLDWATER                YSY20140512
+101104795FRMRS ST BK OAKLEYFARMERS STATE BANK OF OAKLEY        KSOAKLEY                   N Y        
+101104805BANKERS BK OF KS  BANKERS' BANK OF KANSAS             KSWICHITA                  Y Y20121228
+101104863FIRST HERITAGE BANFIRST HERITAGE BANK                 KSCENTRALIA                YSY20131220
+101104928BENNINGTON SALINA THE BENNINGTON STATE BANK           KSSALINA                   Y Y        
+101104973KAW VLLY SB EUDORAKAW VALLEY STATE BANK               KSEUDORA                   N Y20061201
+101105079CITZ ST BK        CITIZENS STATE BANK                 KSMOUNDRIDGE               Y Y        
+101105118GARDNER BK        GARDNER BANK                        KSGARDNER                  N Y20101231
+101105244FNB ELKHART       FIRST NATL BANK OF ELKHART          KSELKHART                  Y N        
+101105257FNB CIMARRON      FIRST NATIONAL BANK IN CIMARRON     KSCIMARRON                 YSY20060630
+101105299FNB SPEARVILLE    FIRST NATIONAL BANK                 KSSPEARVILLE               Y N20000426
+101105354EQUITY BANK       EQUITY BANK                         KSANDOVER                  Y Y20120614
+101105590STATE BANK HOXIE  STATE BANK                          KSHOXIE                    YSN20120319
+101105668BANK OF WHITEWATERBANK OF WHITEWATER                  KSWHITEWATER               N Y20000621
+101105778FST SEC BK        FIRST SECURITY BANK                 KSOVERBROOK                N Y        
+101105781ALTA VISTA ST BK  ALTA VISTA STATE BANK               KSALTA VISTA               N Y20091113
+101105817COMM NAT'L BK & TRCOMMUNITY NATIONAL BANK & TRUST     KSCHANUTE                  Y Y20131119
+101105969BK OF OBERLIN     THE BANK                            KSOBERLIN                  YSY        
+101106214UNION ST BK       UNION STATE BANK                    KSEVEREST                  YSY20040304
+101106285BK OF TESCOTT     BANK OF TESCOTT                     KSTESCOTT                  YSN20120319
+101106353SOUTHWIND BANK    SOUTHWIND BANK                      KSNATOMA                   Y Y20140328
+101106379CITZ ST GRIDLEY   CITIZENS STATE BANK                 KSGRIDLEY                  YSN20110829
+101106560FIRST NATL DIGHTONFIRST NATIONAL BANK                 KSDIGHTON                  YSY20050509
+101106609RILEY ST BK       RILEY STATE BANK                    KSRILEY                    N Y20080314
+101106751COMMUNITY BK      COMMUNITY BANK                      KSTOPEKA                   YSY20100105
+101107080FARMERS B&T GR BNDFARMERS BANK & TRUST                KSGREAT BEND               Y Y20130717
+101107404BENDENA ST BK     BENDENA STATE BANK                  KSBENDENA                  N Y        
+101107475BISON STATE BANK  BISON STATE BANK                    KSBISON                    N Y20140611
+101107624EMERALD BANK      EMERALD BANK                        KSBURDEN                   YSN20050103
+101107747COTTON WOOD VAL BKCOTTONWOOD VALLEY BANK              KSCEDAR POINT              YSN20030508
+101107802FARMERS ST BK     FARMERS STATE BANK                  KSCIRCLEVILLE              N Y20081103
+101107886ST BK COLWICH     LEGACY BANK                         KSCOLWICH                  N Y20000914
+101108034DENISON ST BK     DENISON STATE BANK                  KSHOLTON                   Y Y        
+101108209FIRSTOAK BANK     FIRSTOAK BANK                       KSINDEPENDENCE             YSY20130712
+101108319ARMED FORCES BK   ARMED FORCES BANK, NA               KSFORT LEAVENWORTH         Y Y20120322
+101108380FARMERS MCPHERSON FARMERS STATE BANK                  KSGALVA                    N Y20051125
+101108636HAVILAND ST BK    HAVILAND STATE BANK                 KSHAVILAND                 YSY20030403
+101108762BK OF HOLYROOD    BANK OF HOLYROOD                    KSHOLYROOD                 Y Y        
+101109538NEW CENTURY BK NA NEW CENTURY BANK                    KSBELLEVILLE               YSN20140404
+101109648GRANT CO ULYSSES  GRANT COUNTY BANK                   KSULYSSES                  Y Y        
+101109693FARMERS OFFERLE   GIRARD NATIONAL BANK                KSOFFERLE                  YSN20130625
+101109745OLPE STATE BANK   OLPE STATE BANK                     KSOLPE                     N Y20040227
+101110116TIGHTWAD BANK     TIGHTWAD BANK                       KSOSAGE CITY               YSN20121109
+101110213ROSE HILL STATE BKROSE HILL BANK                      KSROSE HILL                YSN20120730
+101110271CENTERA BANK      CENTERA BANK                        KSSUBLETTE                 Y Y        
+101110404SILVER LAKE TOPEKASILVER LAKE BANK                    KSSILVER LAKE              Y Y        
+101110488STANLEY BANK KS   STANLEY BANK                        KSOVERLAND PARK            Y Y20100809
+101110543FARMER STATE BANK FARMERS STATE BANK                  KSPHILLIPSBURG             Y Y        
+101110802CBW BK            CBW BANK                            KSWEIR                     N Y20130131
+101111319MONTEZUMA SB      MONTEZUMA STATE BANK                KSMONTEZUMA                N Y        
+101111335SWEDISH-AMER SB   SWEDISH-AMERICAN STATE BANK         KSCOURTLAND                YSY20091007
+101111351CITZ SB HUGOTON   CITIZENS STATE BANK                 KSHUGOTON                  Y Y        
+101111380KAW VALLEY SB     KAW VALLEY STATE BANK & TRUST CO    KSWAMEGO                   YSN20041223
+101111458JOHNSON ST BK     JOHNSON STATE BANK                  KSJOHNSON                  YSY20080228
+101111623BK OF THE PRAIRIE BANK OF THE PRAIRIE                 KSOLATHE                   Y N20030723
+101111704LABETTE BANK      LABETTE BANK                        KSPARSONS                  Y Y20050215
+101111953CITZ GREENLEAF    CITIZENS NATL BANK OF GREENLEAF     KSCONCORDIA                YSY20080509
+101112318FIRST ST NESS CITYFIRST STATE BANK                    KSNESS CITY                YSN20101001
+101112635AMER BAXTER SPRG  AMERICAN BK OF BAXTER SPRINGS       KSBAXTER SPRINGS           YSY20030826
+101112842GIRARD NATL       GIRARD NATIONAL BANK                KSGIRARD                   YSY20031017
+101113812FNB LOUISBURG     FIRST NATIONAL BANK                 KSLOUISBURG                YSY20140228
+101113841FNB HARVEYVILLE   FIRST NATIONAL BANK OF HARVEYVILLE  KSHARVEYVILLE              YSY20061129
+101113935FNB FRANKFORT     FIRST NATIONAL BANK IN FRANKFORT    KSFRANKFORT                Y Y        
+101114031FNB HOPE          FIRST NATIONAL BANK HOPE            KSHOPE                     YSY20050523
+101114109FIRST OPTION BANK FIRST OPTION BANK                   KSOSAWATOMIE               YSN20130205
+101114277FNB CUNNINGHAM    FIRST NATIONAL BANK                 KSCUNNINGHAM               YSN20060307
+101114303FSB TONGANOXIE    FIRST STATE BANK AND TRUST          KSTONGANOXIE               Y Y        
+101114390ST MARYS ST BK KS ST MARYS STATE BANK                 KSSAINT MARYS              Y Y20100809
+101114565STATE BK BURRTON  STATE BANK OF BURRTON               KSBURRTON                  YSN20060307
+101114646DOUGLAS CNTY      DOUGLAS COUNTY BANK                 KSLAWRENCE                 Y Y        
+101114659FST BK SALINA     FIRST BANK KANSAS                   KSSALINA                   Y N        
+101114691SEC ST SCOTT CITY SECURITY STATE BANK                 KSSCOTT CITY               YSY20060929
+101114769COMMN BK LIBERAL  THE COMMUNITY BANK                  KSLIBERAL                  Y Y        
+101114772FARMERS & MERCH BKTHE FARMERS AND MERCHANTS BK COLBY  KSCOLBY                    Y N        
+101114840UNIVERSITY BK     UNIVERSITY BANK                     KSPITTSBURG                YSN20090701
+101114853CLAY CENTER NB KS GIRARD NATIONAL BANK                KSCLAY CENTER              YSN20130625
+101114879COMMN BK WICHITA  COMMUNITY BANK OF WICHITA           KSWICHITA                  YSY19991231
+101114918COMMN FNB MANHATTNCOMMUNITY FIRST NATIONAL BANK       KSMANHATTAN                Y N20010201
+101114934MID-AMERICA BANK  MID-AMERICA BANK                    KSBALDWIN CITY             YSN20060330
+101114947AMER ST BK & TR COAMERICAN STATE BANK & TRUST CO.     KSGREAT BEND               YSN20080630
+101114976FST COMMERCE BK   FIRST COMMERCE BANK                 KSMARYSVILLE               N Y20140529
+101115001HERITAGE BK TOPEKAHERITAGE BANK                       KSTOPEKA                   YSY20081107
+101115030UNITED BK & TR    UNITED BANK & TRUST                 KSMARYSVILLE               YSN20060731
+101115056VISIONBANK        VISIONBANK                          KSTOPEKA                   N Y20080916
+101115069RELIANZBANK       RELIANZBANK                         KSWICHITA                  N Y20100519
+101189121UNIV NATL LAWRENCEUNIVERSITY NATL BK OF LAWRENCE      KSLAWRENCE                 Y N20000501
+101200453US BANK STL       US BANK, NA                         MOSAINT LOUIS              Y N20130219
+101201164FNB NEVADA        FIRST NATIONAL BANK OF NEVADA       MONEVADA                   YSY20040428
+101201863NODAWAY VALLEY BK NODAWAY VALLEY BANK                 MOMARYVILLE                Y Y20010419
+101202257COMMN BK NEOSHO   COMMUNITY BANK AND TRUST            MONEOSHO                   Y Y        
+101202503FARMERS SB CAMERONFARMERS STATE BANK                  MOCAMERON                  Y Y19990526
+101202875LAMAR BK & TR     LAMAR BANK & TRUST CO               MOLAMAR                    Y Y        
+101203256PLEASANT HILL BANKPLEASANT HILL BANK                  MOPLEASANT HILL            YSY        
+101203641SW MO BK CARTHAGE SOUTHWEST MISSOURI BANK             MOCARTHAGE                 Y Y        
+101203971BK VERSAILLES     BANK OF VERSAILLES                  MOVERSAILLES               YSY20050228
+101204886CITIZENS ROCK PORTCITIZENS BANK & TRUST               MOROCK PORT                YSY19991123
+101204954GOPPERT FINAN BANKGOPPERT FINANCIAL BANK              MOLATHROP                  YSN20081002
+101205050BK OF WESTON      BANK OF WESTON                      MOWESTON                   Y Y20000418
+101205063COMMN FIRST BANK  COMMUNITY FIRST BANK                MOBUTLER                   N Y20001229
+101205131BANK CBO          BANK CBO                            MOOREGON                   N Y20140605
+101205445CITZ COLE CAMP    CITIZENS FARMERS BANK OF COLE CAMP  MOCOLE CAMP                YSN20050908
+101205940WELLS PLATTE CITY THE WELLS BANK OF PLATTE CITY       MOPLATTE CITY              N Y20140418
+101206101LEAD BK           LEAD BANK                           MOGARDEN CITY              Y Y20120809
+101206457KEARNEY TR CO     KEARNEY TRUST COMPANY               MOKEARNEY                  Y Y        
+101206460KCB BK            KCB BANK                            MOKEARNEY                  YSN20121105
+101206994PEOPLES BK SENECA PEOPLES'S BANK OF SENECA            MOSENECA                   YSN20091231
+101207210CORNER STONE SW   CORNER STONE BANK                   MOSOUTH WEST CITY          Y Y20110420
+101208031FARMERS LINCOLN MOFARMERS BANK OF LINCOLN             MOLINCOLN                  Y N        
+101210090BK OF GRAIN VALLEYBANK OF GRAIN VALLEY                MOKANSAS CITY              Y Y20010323
+101211031METZ BANKING CO   METZ BANKING COMPANY                MONEVADA                   YSY20100420
+101213000HERITAGE SB       HERITAGE STATE BANK                 MONEVADA                   N Y20081119
+101217433FRMRS ST STANBERRYFARMERS STATE BANK                  MOSTANBERRY                YSN20050621
+101217750SECURITY BANK     SECURITY BANK                       MORICH HILL                N Y20130923
+101217857ADRIAN BANK       ADRIAN BANK                         MOADRIAN                   YSN        
+101217886PLATTE VALLEY BK  PLATTE VALLEY BANK OF MO            MOPLATTE CITY              Y Y        
+101218801HOMETOWN BK NA    HOMETOWN BANK NATIONAL ASSOCIATION  MOCARTHAGE                 YSY        
+101219279COMM BK PLEASANT HCOMMUNITY BANK OF PLEASANT HILL     MOPLEASANT HILL            YSN20070105
+101219473GARDEN CITY BK    LEAD BANK                           KSGARDEN CITY              Y N20121022
+101901396CITIZ CHILLICOTHE CITIZENS BANK AND TRUST COMPANY     MOCHILLICOTHE              Y Y        
+101901419CHILLICOTHE ST BK CHILLICOTHE STATE BANK              MOCHILLICOTHE              Y Y20040617
+101901833WOOD & HUSTON BANKWOOD AND HUSTON                     MOMARSHALL                 Y Y        
+101902052THIRD NB SEDALIA  THIRD NATIONAL BANK OF SEDALIA      MOSEDALIA                  YSY20010927
+101902146F & C BANK        F & C BANK                          MOHOLDEN                   YSN20100908
+101902201BANK OF ORRICK    BANK OF ORRICK                      MOORRICK                   Y N20060309
+101902269MERCS & FARMERS   MERCHANTS AND FARMERS BANK SALISBURYMOSALISBURY                N Y20110404
+101902298CITZ BK PRINCETON GREAT WESTERN BANK                  MOPRINCETON                Y N20070126
+101902735COML TR CO FAYETTECOMMERCIAL TRUST CO OF FAYETTE      MOFAYETTE                  YSY20081010
+101903336FARMERS BK        FARMERS BANK OF NORTHERN MISSOURI   MOUNIONVILLE               Y Y20110628
+101903637COMMUNITY MARSHALLCOMMUNITY BANK OF MARSHALL          MOMARSHALL                 N Y        
+101904186BK OF ODESSA      BANK OF ODESSA                      MOODESSA                   Y N20120319
+101905004PONY EXPRESS BK   PONY EXPRESS BANK                   MOLIBERTY                  Y N20060418
+101905512FLOWERS NAT'L BK  FLOWERS NATIONAL BANK               MOCAINSVILLE               YSN20090317
+101906605LAWSON BANK       LAWSON BANK                         MOLAWSON                   N Y20100325
+101907196AMERICA'S COMMN BKAMERICA'S COMMUNITY BANK            MOBLUE SPRINGS             YSN20080116
+101908014BK BROOKFLD PURDINBANK OF BROOKFIELD PURDIN NA        MOBROOKFIELD               YSY        
+101908988BANK 21           BANK 21                             MOCARROLLTON               YSY20001101
+101909097REGIONAL MO BANK  REGIONAL MISSOURI BANK              MOMARCELINE                N Y        
+101911519MIDWEST REGIONAL BMIDWEST REGIONAL BANK               MOOTTERVILLE               N Y20091104
+101912055THE MISSOURI BK IITHE MISSOURI BANK II                MOSEDALIA                  YSY20140203
+101913177HOME EXCHANGE BANKHOME EXCHANGE BANK                  MOJAMESPORT                N Y20111129
+101915764CARROLL CNTY TRUSTCARROLL COUNTY TRUST CO             MOCARROLLTON               N Y20110607
+101916307BTC BANK          BTC BANK                            MOBETHANY                  Y Y19991014
+101917623EXCH BK FAYETTE   EXCHANGE BANK OF MISSOURI           MOFAYETTE                  YSN20000821
+101917843CONCORDIA BK      CONCORDIA BANK                      MOCONCORDIA                N Y20050218
+101918075HAMILTON BANK     HAMILTON BANK                       MOHAMILTON                 N Y20120620
+101918101THE ST BK         THE STATE BANK                      MORICHMOND                 YSN20090702
+101918240FNB CLINTON       FIRST NATIONAL BANK OF CLINTON      MOCLINTON                  Y N20060130
+101918321BANK NORTHWEST    BANK NORTHWEST                      MOHAMILTON                 YSY19991021
+101918965COMM BK OF MO     COMMUNITY BANK OF MISSOURI          MORICHMOND                 Y N20010507
+102000021US BANK CO DENVER US BANK, NA                         CODENVER                   Y N20130221
+102000199FRB DVR           FEDERAL RESERVE BK OF DENVER        CODENVER                   Y N20130205
+102000607BOKF NA           BOKF NA                             CODENVER                   Y N20130621
+102000924BANK OF DENVER    BANK OF DENVER                      CODENVER                   Y Y        
+102000953WESTERN NATL BK   ANB BANK                            CODENVER                   Y N20120222
+102000966GUARANTY BK TR    GUARANTY BANK AND TRUST COMPANY     CODENVER                   Y Y20130612
+102001017JPMCHASE COLORADO JPMORGAN CHASE BANK, NA             CODENVER                   Y N20050509
+102003154VECTRA BK DENVER  VECTRA BK COLORADO NATL ASSOCIATION CODENVER                   Y Y        
+102003206COBIZ BANK        COBIZ BANK                          CODENVER                   Y Y20070322
+102003743BKRS BK OF TH WESTBANKERS' BANK OF THE WEST           CODENVER                   Y Y        
+102006326FST AMER ST BK    FIRST AMERICAN STATE BANK           COGREENWOOD VILLAGE        Y Y        
+102006339PREMIER BANK      PREMIER BANK                        CODENVER                   Y Y20071017
+102006371NORTHERN TR COLO  THE NORTHERN TRUST COMPANY          ILCHICAGO                  Y N20121005
+102007008STEELE STREET B&T STEELE STREET BANK & TRUST          CODENVER                   Y Y20070711
+102007011FST WESTERN TRUST FIRST WESTERN TRUST BANK            CODENVER                   Y Y20100225
+102007273NATIVE AMERICAN BKNATIVE AMERICAN BANK N.A.           CODENVER                   Y Y20090707
+102058028DFAS DENVER       DFAS - DE/WFDA                      CODENVER                   Y N        
+102100138FNB TRINIDAD      FIRST NATIONAL BANK IN TRINIDAD     COTRINIDAD                 Y Y19990817
+102100552FNB DURANGO       FIRST NATIONAL BANK OF DURANGO      CODURANGO                  Y Y19990817
+102100662STATE BANK LAJUNTATHE STATE BANK - LA JUNTA           COLA JUNTA                 Y Y        
+102100675COLO BK LA JUNTA  COLO BANK & TRUST CO OF LA JUNTA    COLA JUNTA                 Y Y        
+102100853ALAMOSA STATE BANKALAMOSA STATE BANK                  COALAMOSA                  Y Y20051021
+102100879FRONTIER BK       FRONTIER BANK                       COLAMAR                    Y Y20101231
+102101111FNB LAS ANIMAS    FIRST NATIONAL BANK OF LAS ANIMAS   COLAS ANIMAS               Y Y19990720
+102101218MANCOS STATE BK   MANCOS VALLEY BANK                  COMANCOS                   Y Y        
+102101250PARK ST BK & TR   PARK STATE BANK & TRUST             COWOODLAND PARK            Y Y20100428
+102101276GUNNISON BK & TR  GUNNISON BANK & TR CO               COGUNNISON                 YSY20060103
+102101292FNB PAONIA        FIRST COLORADO NATIONAL BANK        COPAONIA                   YSN20130418
+102101315FNB PIONEER WRAY  FIRST PIONEER NATIONAL BANK         COWRAY                     Y Y19990720
+102101360POINTS WEST COMMUNPOINTS WEST COMMUNITY BANK          COJULESBURG                N Y20080407
+102101412FOWLER ST BK      FOWLER STATE BANK                   COFOWLER                   Y N20000428
+102101441PALISADES NATL    COLORADO NATIONAL BANK              COPALISADE                 Y N20131206
+102101548FNB ROCKIES MEEKERFIRST NATIONAL BANK OF THE ROCKIES  COGRAND JUNCTION           Y Y20040107
+102101577COLORADO EAST BT  COLORADO EAST BANK AND TRUST        COGRANADA                  Y Y        
+102101645US BANK CO ASPEN  US BANK, NA                         COASPEN                    Y N20130221
+102101700FIRST ST OF CO    FIRST STATE BANK OF COLORADO        COHOTCHKISS                N Y20120928
+102102000HIGH PLAINS BANK  HIGH PLAINS BANK                    COFLAGLER                  Y Y20030801
+102102013NBH BK NA, COMM BKNBH BANK NA, COMMUNITY BKS OF CO BRACOGREENWOOD VILLAGE        Y N20121116
+102102576FNB HUGO          FIRST NATIONAL BANK OF HUGO         COHUGO                     YSY20060717
+102102864CITZ PAGOSA SPGS  CITIZENS BANK OF PAGOSA SPRINGS     COPAGOSA SPRINGS           Y Y        
+102102916PINE RIVER VALLEY PINE RIVER VALLEY BANK              COBAYFIELD                 YSN20050920
+102103106ST BK WILEY       LEGACY BANK                         COWILEY                    YSY20070813
+102103119U.S. BANK, N.A.   US BANK, NA                         COVAIL                     Y N20140303
+102103258CITZ ST BK OURAY  CITIZENS STATE BANK OF OURAY        COOURAY                    Y Y        
+102103407ALPINE GLENWOOD SPALPINE BANK                         COGLENWOOD SPRINGS         Y Y20140407
+102103562FARMERS SB CALHAN FARMERS STATE BANK OF CALHAN        COCALHAN                   Y Y20140407
+102104273BK OF BURLINGTON  BANK OF BURLINGTON                  COBURLINGTON               YSN20130206
+102104341MCCLAVE ST BK     MCCLAVE STATE BANK                  COMC CLAVE                 N Y20100809
+102104684ESTRN CO CHEYWELL EASTERN COLORADO BANK               COCHEYENNE WELLS           Y Y20120926
+102104749NBH BK NA         NBH BANK NA                         COLA JARA                  Y N20121116
+102104846MOUNTAIN VALLEY   MOUNTAIN VALLEY BANK                COWALDEN                   Y N20041027
+102104888FNB CORTEZ        FIRST NATIONAL BANK                 COCORTEZ                   YSY20080402
+102104927DOLORES STATE BANKDOLORES STATE BANK                  CODOLORES                  Y N20130207
+102105353GR VLLY BK GR JUNCGRAND VALLEY BANK                   COGRAND JUNCTION           Y N20100825
+102105997COLLEGIATE PEAKS  COLLEGIATE PEAKS BANK               COBUENA VISTA              Y N20060210
+102106569GLACIER SAN JUANS GLACIER BANK-BANK SAN JUANS DIV     CODURANGO                  Y N20120625
+102106705YAMPA VALLEY BK   YAMPA VALLEY BANK                   COSTEAMBOAT SPRINGS        Y Y20090206
+102107034FIRST SOUTHWEST BKFIRST SOUTHWEST BANK                COALAMOSA                  Y N20130208
+102107063TIMBERLINE BANK   TIMBERLINE BANK                     COGRAND JUNCTION           Y Y20111108
+102189612GRAND MTN BK      GRAND MOUNTAIN BANK, FSB            COGRANBY                   Y N20050609
+102200245INTL SB RATON     INTERNATIONAL BANK                  NMRATON                    Y Y        
+102201040FNB OF NEW MEXICO FIRST NATIONAL BANK OF NEW MEXICO   NMCLAYTON                  Y Y        
+102201930BANK OF NEW MEXICOBANK OF NEW MEXICO                  NMGRANTS                   Y Y20140709
+102202078CITZ OF FARMINGTONTHE CITIZENS BANK                   NMFARMINGTON               Y Y        
+102206728FOUR CORNERS COMMNFOUR CORNERS COMMUNITY BANK         NMFARMINGTON               Y Y20020130
+102300255RSNB BANK         RSNB BANK                           WYROCK SPRINGS             Y Y20120611
+102300297RAWLINS NATL      RAWLINS NATIONAL BANK               WYRAWLINS                  Y Y        
+102300336GLACIER 1ST BANK  GLACIER BANK-1ST BANK DIV           WYEVANSTON                 Y N20120625
+102300404CENTRAL BK LANDER CENTRAL BANK & TRUST CO             WYLANDER                   Y Y        
+102300459FIRST NORTHERN BK FIRST NORTHERN BANK OF WYOMING      WYBUFFALO                  Y Y20120629
+102300970SUNDANCE SB       SUNDANCE STATE BANK                 WYSUNDANCE                 Y Y19990720
+102301050FNB GILLETTE      FIRST NATIONAL BANK                 WYGILLETTE                 Y Y19990720
+102301063COWBOY STATE BANK COWBOY STATE BANK                   WYRANCHESTER               YSY20101021
+102301089GLACIER FIRST WY  GLACIER BANK-FIRST BANK WYOMING DIV WYPOWELL                   Y N20120625
+102301115OREGON TRAIL BANK OREGON TRAIL BANK                   WYGUERNSEY                 YSY20050120
+102301199HILLTOP CASPER    HILLTOP NATIONAL BANK               WYCASPER                   Y Y        
+102301241FARMERS PINEBLUFFSFARMERS STATE BANK                  WYPINE BLUFFS              YSY20081119
+102301513PINNACLE BK       PINNACLE BANK - WYOMING             WYTORRINGTON               Y Y20001110
+102301542CONVERSE CNTY BK  CONVERSE COUNTY BANK                WYDOUGLAS                  Y Y        
+102301636WYOMING BK & TR   WYOMING BANK AND TRUST              WYCHEYENNE                 Y N        
+102301717UINTA BK          UINTA BANK                          WYMOUNTAIN VIEW            Y Y20091105
+102301775FIRST NEWCASTLE   FIRST STATE BANK                    WYNEWCASTLE                Y Y        
+102301872LUSK ST BK        LUSK STATE BANK                     WYLUSK                     YSY20040510
+102301911SEC ST BASIN      SECURITY STATE BANK                 WYBASIN                    YSY20140501
+102303171BK COMM RAWLINS   BANK OF COMMERCE                    WYRAWLINS                  Y Y        
+102303841GLACIER-FIRST ST BGLACIER BANK DIVISION FIRST STATE BKWYWHEATLAND                Y N20140428
+102304099BK OF JACKSON HOLEBANK OF JACKSON HOLE                WYJACKSON                  Y Y        
+102305098ROCKY MOUNTAIN BK ROCKY MOUNTAIN BANK                 WYJACKSON                  Y Y20070606
+102305373SUMMIT NATL BK    SUMMIT NATIONAL BANK                WYHULETT                   YSN20111017
+102306356BK OF STAR VALLEY BANK OF STAR VALLEY                 WYAFTON                    Y N20111017
+102306479ST BK             STATE BANK                          WYGREEN RIVER              Y N20080512
+102306699PLATTE VLY BK     PLATTE VALLEY BANK                  WYTORRINGTON               Y Y20080401
+102307119JONAH             JONAH BANK OF WYOMING               WYCASPER                   YSY20081205
+103000648JPMCHASE OKLAHOMA JPMORGAN CHASE BANK, NA             OKOKLAHOMA CITY            Y N20050509
+103000680FST ENTERPRISE    FIRST ENTERPRISE BANK               OKOKLAHOMA CITY            YSN20140723
+103000703INTERBANK         INTERBANK                           OKOKLAHOMA CITY            Y Y20101231
+103001469KIRKPATRICK BANK  KIRKPATRICK BANK                    OKEDMOND                   Y Y20040505
+103001809QUAIL CREEK OKC   QUAIL CREEK BANK NA                 OKOKLAHOMA CITY            Y Y        
+103001977FRONTIER STATE BK FRONTIER STATE BANK                 OKOKLAHOMA CITY            Y N20140429
+103002251CITIZENS EDMOND   THE CITIZENS BANK OF EDMOND         OKEDMOND                   YSY20060601
+103002617BANK OF COMMERCE  BANK OF COMMERCE                    OKYUKON                    YSY20111220
+103002691FST FIDELITY OKC  FIRST FIDELITY BANK NA              OKOKLAHOMA CITY            Y Y        
+103003467REPUBLIC BK & TR  REPUBLIC BANK & TRUST               OKNORMAN                   Y Y20030410
+103003616BANKERS BK OKC    THE BANKERS BANK                    OKOKLAHOMA CITY            Y Y        
+103003632BANCFIRST OKC     BANCFIRST                           OKOKLAHOMA CITY            Y Y        
+103003687FIRST COMM BANK NAFIRST COMMERCIAL BANK               OKEDMOND                   Y N20050721
+103009160FIRST BETHANY     FIRST BETHANY BANK AND TRUST        OKBETHANY                  YSY20050701
+103009571YUKON NATL        YNB                                 OKYUKON                    YSY20120626
+103012843FNB COMMUNITY BK  FNB COMMUNITY BANK                  OKMIDWEST CITY             YSY20121228
+103012966BANK 2 OKLA CITY  BANK 2                              OKOKLAHOMA CITY            Y Y20130314
+103013017VALLIANCE BK      VALLIANCE BANK                      OKOKLAHOMA CITY            Y Y20111209
+103013266PRIME BK          PRIME BANK                          OKEDMOND                   Y N20100409
+103100179SECURITY ENID     SECURITY NATIONAL BANK              OKENID                     YSY20051130
+103100195CENTRAL ENID      CENTRAL NATIONAL BK & TR CO OF ENID OKENID                     Y Y        
+103100250BANK NA MCALESTER THE BANK NATIONAL ASSOCIATION       OKMCALESTER                Y Y        
+103100467FNB CHICKASHA     FIRST NATIONAL BANK AND TRUST CO    OKCHICKASHA                Y Y19990720
+103100522FNB & TR ARDMORE  FIRST NATL BK AND TR CO OF ARDMORE  OKARDMORE                  YSY20061002
+103100616AMER HERITAGE BANKAMERICAN HERITAGE BANK              OKSAPULPA                  Y Y20010703
+103100739CITY NATL LAWTON  THE CITY NATL BK & TR CO OF LAWTON  OKLAWTON                   Y Y        
+103100878FNB DURANT        FIRST TEXOMA NATIONAL BANK          OKDURANT                   N Y20111229
+103100881FIRST UNITED BK   FIRST UNITED BANK AND TRUST COMPANY OKDURANT                   Y Y        
+103100959FNB ALTUS         THE FIRST NATIONAL BANK IN ALTUS    OKALTUS                    N Y20111227
+103101013FREEDOM BK OK     FREEDOM BANK OF OKLAHOMA            OKTULSA                    YSN20100811
+103101026VISION BANK NA    VISION BANK, NA                     OKADA                      YSN20120103
+103101107FNB & TR OKMULGEE FIRST NATIONAL BANK & TRUST CO      OKOKMULGEE                 YSY20060801
+103101110FNB & TR VINITA   FIRST NATL BK & TR CO OF VINITA     OKVINITA                   Y Y19990720
+103101165FIRST BT WAGONER  FIRST BANK & TRUST CO               OKWAGONER                  YSN20130207
+103101204FNB TR WEATHERFORDFIRST NATL BK & TR OF WEATHERFORD   OKWEATHERFORD              Y Y        
+103101262FIRSTAR BK NA     FIRSTAR BANK NATIONAL ASSOCIATION   OKSALLISAW                 Y Y20130815
+103101275ALVA STATE BK     ALVA STATE BANK AND TRUST COMPANY   OKALVA                     Y Y        
+103101291CENTRAL ALVA      BANCCENTRAL NATIONAL ASSOCIATION    OKALVA                     Y Y20120308
+103101330CITIZENS BANK ADA CITIZENS BANK  OF ADA               OKADA                      YSN20130207
+103101356REGENT BANK       REGENT BANK                         OKNOWATA                   N Y20130201
+103101437BANK SNB NA       BANK SNB NATIONAL ASSOCIATION       OKSTILLWATER               Y Y20131115
+103101482ANADARKO BK & TR  ANADARKO BANK AND TRUST COMPANY     OKANADARKO                 Y Y19990930
+103101505FST ST BK         FIRST STATE BANK                    OKANADARKO                 Y N20130318
+103101518COMMUNITY STATE BKTHE COMMUNITY STATE BANK            OKPOTEAU                   YSN20120321
+103101547SHAMROCK BANK NA  SHAMROCK BANK, N.A.                 OKCOALGATE                 Y N20020219
+103101576FNB & TR ELK CITY FIRST NATL BK&TR, ELK CITY OKLAHOMA OKELK CITY                 Y Y19991109
+103101602BK OF WESTERN OK  BANK OF WESTERN OKLAHOMA            OKELK CITY                 YSY20070727
+103101628EXCHANGE PERRY    EXCHANGE BANK & TRUST CO            OKPERRY                    Y Y        
+103101631FST BT CO PERRY   FIRST BANK AND TRUST COMPANY        OKPERRY                    N Y20051110
+103101660FORT GIBSON SB    FORT GIBSON STATE BANK              OKFORT GIBSON              Y Y20061130
+103101699CHICKASHA BANK OKLCHICKASHA BANK AND TRUST COMPANY    OKCHICKASHA                Y Y        
+103101738FIRST FRMS WAURIKAFIRST FARMERS NATIONAL BANK WAURIKA OKWAURIKA                  Y N        
+103101754FNB & TR CO MIAMI FIRST NATIONAL BANK & TR CO MIAMI   OKMIAMI                    YSY20050824
+103101767LAKESIDE SALINA   LAKESIDE BANK OF SALINA             OKSALINA                   Y N20060314
+103101822FIRST BK & TR CO  FIRST BANK AND TRUST COMPANY        OKCLINTON                  N Y20120730
+103101848OKLA BK CLINTON   OKLAHOMA BANK & TRUST CO            OKCLINTON                  Y Y        
+103101877CITIZENS BK OF OK CITIZENS BANK OF OKLAHOMA           OKPAWHUSKA                 Y N20060314
+103101880FNB PAWHUSKA      FIRST NATIONAL BANK IN PAWHUSKA     OKPAWHUSKA                 YSY20061004
+103101929FST ST BK WATONGA FIRST STATE BANK                    OKWATONGA                  Y Y20120828
+103101987PAULS VALLEY NATL PAULS VALLEY NATIONAL BANK          OKPAULS VALLEY             YSN20130318
+103102070LAKESIDE STATE BK LAKESIDE STATE BANK                 OKOOLOGAH                  YSY20051213
+103102106FIRST B&T DUNCAN  FIRST BANK AND TRUST COMPANY        OKDUNCAN                   N Y20060217
+103102203FNB PAWNEE        FIRST NATIONAL BANK                 OKPAWNEE                   Y N20130318
+103102216SECURITY BANK     SECURITY BANK                       OKTULSA                    Y N20130318
+103102274CITIZENS ARDMORE  CITIZENS BANK AND TRUST CO ARDMORE  OKARDMORE                  YSY20060929
+103102290FNB CHANDLER      FIRST BANK OF CHANDLER              OKCHANDLER                 N Y20100121
+103102300LATIMER STATE BANKLATIMER STATE BANK                  OKWILBURTON                N Y20060601
+103102407ST BK OF WYNNEWOODSTATE BANK OF WYNNEWOOD             OKWYNNEWOOD                N Y20090303
+103102410EASTMAN NTL       EASTMAN NATIONAL BANK               OKNEWKIRK                  Y N20000428
+103102452AMERISTATE BANK   AMERISTATE BANK                     OKATOKA                    Y Y20010628
+103102481BK OF COMMERCE NA BANK OF COMMERCE                    OKCHELSEA                  YSY20130612
+103102494FNB MARLOW        FIRST NATIONAL BANK IN MARLOW       OKMARLOW                   YSN20060320
+103102546INTERBANK         INTERBANK                           OKSAYRE                    Y N20110909
+103102591CENTRAL POTEAU    CENTRAL NATIONAL BANK OF POTEAU     OKPOTEAU                   N Y20121108
+103102627FIRST PRYORITY    FIRST PRYORITY BANK                 OKPRYOR                    YSY20061013
+103102737MCCURTAIN CTY NB  MCCURTAIN COUNTY NATIONAL BANK      OKIDABEL                   Y N20130318
+103102821YORKTOWN BANK     YORKTOWN BANK                       OKPRYOR                    Y N20140219
+103102847FNB STIGLER       FIRST NATIONAL BANK                 OKSTIGLER                  Y Y        
+103102889FNB BROKEN ARROW  FIRST NATIONAL BANK AND TRUST CO    OKBROKEN ARROW             YSY20060630
+103102892AVB BANK          AVB BANK                            OKBROKEN ARROW             Y Y20081104
+103102960AMER NB ARDMORE   AMERICAN NATIONAL BANK              OKARDMORE                  N Y20120920
+103103121WALTERS BK & TR COWALTERS BANK AND TRUST COMPANY      OKWALTERS                  YSY        
+103103147FNB THOMAS        FIRST NATIONAL BANK                 OKTHOMAS                   Y Y        
+103103244CITY NATL GUYMON  THE CITY NATIONAL BANK & TRUST CO   OKGUYMON                   YSN20130318
+103103338BANK OF EUFAULA   BANK OF EUFAULA                     OKEUFAULA                  YSY20040311
+103103396FIRSTBANK ANTLERS FIRSTBANK                           OKANTLERS                  YSY20010119
+103103435SNB BANK NA       SNB BANK, NATIONAL ASSOCIATION      OKSHATTUCK                 N Y20130911
+103103451FIRST NATL B&T    FIRST NATIONAL BANK AND TRUST CO    OKSHAWNEE                  YSN20110929
+103103574FNB WEWOKA        FIRST NATL BANK IN WEWOKA           OKWEWOKA                   Y N        
+103103600FNB COWETA        FIRST NATIONAL BANK OF COWETA       OKCOWETA                   YSY20060530
+103103642FIRST ST WAYNOKA  FIRST STATE BANK                    OKWAYNOKA                  N Y20050919
+103103655FNB LINDSAY       FIRST NATIONAL BANK OF LINDSAY      OKLINDSAY                  Y N        
+103103765GRANT CNTY MEDFORDGRANT COUNTY BANK                   OKMEDFORD                  YSN        
+103103778BANK 7            BANK 7                              OKOKLAHOMA CITY            Y Y20110110
+103103817BK CUSHING        BANK OF CUSHING                     OKCUSHING                  Y Y20110816
+103103985GREAT PLAINS NB   GREAT PLAINS NATIONAL BANK          OKELK CITY                 Y Y        
+103104133F & M CRESCENT    F&M BANK                            OKCRESCENT                 YSY20111026
+103104298SOUTHWEST NATL BK SOUTHWEST NATIONAL BANK             OKWEATHERFORD              Y Y        
+103104353FRMRS BK CARNEGIE FARMERS BANK OF CARNEGIE            OKCARNEGIE                 N Y        
+103104528ARMSTRONG BK      ARMSTRONG BANK                      OKMUSKOGEE                 Y Y20080811
+103104544FNB HEAVENER      FIRST NATIONAL BANK OF HEAVENER     OKHEAVENER                 YSN20050929
+103104641FNB HOMINY        FIRST NATIONAL BANK HOMINY          OKHOMINY                   YSN20061013
+103104829MORRIS STATE BK   MORRIS STATE BANK                   OKMORRIS                   Y Y20120326
+103104874FARMERS SB QUINTONFARMERS STATE BANK                  OKQUINTON                  YSY20090519
+103104887LEGACY BANK       LEGACY BANK                         OKHINTON                   Y Y        
+103104942WELCH ST BK       WELCH STATE BANK                    OKWELCH                    N Y20080804
+103105190EXCHANGE SKIATOOK EXCHANGE BANK OF SKIATOOK           OKSKIATOOK                 Y Y        
+103105213PAYNE CNTY BK     PAYNE COUNTY BANK                   OKPERKINS                  YSN20060317
+103105637FIRST AMER BANK   FIRST AMERICAN BANK                 OKSTONEWALL                Y Y19990521
+103105695F&M BK MAYSVILLE  FARMERS & MERCHANTS BK              OKMAYSVILLE                YSN20051202
+103106186FNB TEXHOMA       ANCHOR D BANK                       OKTEXHOMA                  YSY20111230
+103106283FNB SEILING       FIRST NATIONAL BANK                 OKSEILING                  YSN20090401
+103106775BK OF BEAVER CITY BANK OF BEAVER CITY                 OKBEAVER                   YSN20060331
+103106843FIRST SB          FIRST STATE BANK                    OKWAUKOMIS                 YSN20080320
+103107004ALLNATIONS BANK   ALLNATIONS BANK                     OKCALUMET                  Y Y20040816
+103107392LIBERTY NTL BANK  LIBERTY NATIONAL BANK               OKLAWTON                   Y N20140619
+103107415FIRST BK FAIRLAND THE FIRST BANK OF FAIRLAND          OKFAIRLAND                 YSY20051110
+103107444STOCK EXCHANGE BK STOCK EXCHANGE BANK                 OKWOODWARD                 YSN20130319
+103107486FNB FLETCHER      FIRST NATIONAL BANK                 OKFLETCHER                 YSN20090206
+103107839BK CHEROKEE COUNTYBANK OF CHEROKEE COUNTY             OKHULBERT                  Y Y        
+103108045BK OF KREMLIN     BANK OF KREMLIN                     OKKREMLIN                  YSY20081208
+103109015SOONER ST BK      SOONER STATE BANK                   OKTUTTLE                   YSN20120118
+103109125FST AMER  BK      FIRST AMERICAN BANK                 OKNORMAN                   N Y20101213
+103109206BANK OF WYANDOTTE BANK OF WYANDOTTE                   OKWYANDOTTE                YSN        
+103109264PEOPLES CHECOTAH  PEOPLES NATIONAL BANK               OKCHECOTAH                 YSY20050728
+103109374SEC ST BK         SECURITY STATE BANK                 OKWEWOKA                   N Y20050718
+103109507BANK OF LAVERNE   BANK OF LAVERNE                     OKLAVERNE                  YSN20051031
+103109772SPIRO ST BK       SPIRO STATE BANK                    OKSPIRO                    Y N20130319
+103110046FNB HOOKER        FIRST NATIONAL BANK                 OKHOOKER                   N Y20120223
+103110305FST SEC BEAVER    FIRST SECURITY BANK                 OKBEAVER                   YSN20101207
+103110376SPIRITBANK        SPIRITBANK                          OKTULSA                    Y N20130319
+103110392STROUD NATL       STROUD NATIONAL BANK                OKSTROUD                   Y Y20110505
+103110428FNB OKEENE        FIRST NATIONAL BANK                 OKOKEENE                   Y Y        
+103110813FIRST STATE BANK  FIRST STATE BANK                    OKCOMMERCE                 YSN20100706
+103110907SECURITY BT MIAMI SECURITY BANK & TRUST CO            OKMIAMI                    YSY20060515
+103111058FREEDOM ST BK     FREEDOM STATE BANK                  OKFREEDOM                  YSY20140630
+103111155FIRST ST TEMPLE   FIRST STATE BANK IN TEMPLE          OKTEMPLE                   Y N        
+103111252FNB OKLA TONKAWA  FIRST NATL BANK OF OKLAHOMA         OKPONCA CITY               YSY20060731
+103111618MCCLAIN BANK      MCCLAIN BANK                        OKPURCELL                  N Y20051230
+103111744IDABEL NATL BK    IDABEL NATIONAL BANK                OKIDABEL                   Y Y        
+103112015FIRST OK BK       FIRST OKLAHOMA BANK                 OKGLENCOE                  Y N20130709
+103112073BK OF WEST THOMAS THE BANK OF THE WEST                OKTHOMAS                   N Y20060209
+103112112CITZ SEC BT       CITIZENS SECURITY BANK & TRUST CO   OKBIXBY                    Y Y        
+103112248AMER EXCH BK      AMERICAN EXCHANGE BANK              OKHENRYETTA                N Y20110504
+103112329WATONGA STATE BK  CORNERSTONE BANK                    OKWATONGA                  Y Y20120326
+103112358WILBURTON SB      WILBURTON STATE BANK                OKWILBURTON                YSN20090824
+103112426SEC FIRST NB HUGO SECURITY FIRST NATIONAL BANK        OKHUGO                     YSN20060831
+103112439OKLA ST BUFFALO   OKLAHOMA STATE BANK                 OKBUFFALO                  N Y20121114
+103112455HIGH PLAINS BK    HIGH PLAINS BANK                    OKKEYES                    N Y20100929
+103112523NBC OK            NBC OKLAHOMA                        OKALTUS                    Y Y20080731
+103112594RCB BANK          RCB BANK                            OKCLAREMORE                Y Y        
+103112617FNB IDABEL        FIRST NATIONAL BANK                 OKIDABEL                   Y N20060317
+103112659FIRST BROKEN BOW  1ST BANK & TRUST                    OKBROKEN BOW               Y Y        
+103112675FT SILL NATL      FORT SILL NATIONAL BANK             OKFORT SILL                Y Y        
+103112730BK LOCUST GROVE   BANK OF LOCUST GROVE                OKLOCUST GROVE             Y Y20120821
+103112743PEOPLES SB BLAIR  PEOPLES STATE BANK                  OKBLAIR                    YSY20051202
+103112853FIRST OWASSO      FIRST BANK OF OWASSO                OKOWASSO                   N Y20060403
+103112947FIRSTAR BK NA     FIRSTAR BANK NATIONAL ASSOCIATION   OKMUSKOGEE                 Y N20130715
+103112976ARVEST BK JAY     ARVEST BANK                         ARFAYETTEVILLE             Y N20121226
+103112992BK OF COMMERCE    BANK OF COMMERCE                    OKDUNCAN                   N Y20031107
+103189307PATRIOT BANK      PATRIOT BANK                        OKTULSA                    Y Y20130225
+103189682BK OF GROVE       BANK OF GROVE                       OKGROVE                    N Y20100617
+103900036BOK TULSA         BOKF NA                             OKTULSA                    Y Y20101231
+103901569AMER BT TULSA     AMERICAN BANK AND TRUST COMPANY     OKTULSA                    Y Y20081020
+103902717VALLEY NTL TULSA  VALLEY NATIONAL BANK                OKTULSA                    YSY20051027
+103903046TRIAD TULSA       TRIAD BANK NA                       OKTULSA                    YSY20130222
+103908669PEOPLES BK TULSA  PEOPLES BANK                        OKTULSA                    YSY20060906
+103912723ONB BK AND TR CO  ONB BANK AND TRUST COMPANY          OKTULSA                    N Y20070905
+103912875GRAND BANK TULSA  GRAND BANK                          OKTULSA                    N Y20060206
+103912985THE INDP BNKRS BK TIB THE INDEPENDENT BANKERS BANK    OKTULSA                    Y N20100225
+104000016FNB OMAHA         FIRST NATIONAL BANK OF OMAHA        NEOMAHA                    Y Y        
+104000029US BANK NEBRASKA  US BANK, NA                         MNMINNEAPOLIS              Y N20130221
+104000469ENTERPRISE BANK   ENTERPRISE BANK                     NEOMAHA                    Y Y20131230
+104000702GREAT WESTERN BANKGREAT WESTERN BANK                  SDWATERTOWN                Y N20070130
+104000841SEC OMA           SECURITY NATIONAL BANK OF OMAHA     NEOMAHA                    Y Y        
+104000854AMER OMA          AMERICAN NATIONAL BANK              NEOMAHA                    Y Y        
+104000870PREMIER BK        PREMIER BANK                        NEOMAHA                    Y N20120913
+104001138BANK OF NEBRASKA  BANK OF NEBRASKA                    NEPAPILLION                Y Y        
+104001497FIRST WESTROADS OMFIRST WESTROADS BANK                NEOMAHA                    Y Y        
+104001808CORE BK           CORE BANK                           NEOMAHA                    Y Y20130830
+104002894MUTUAL OF OMAHA BKMUTUAL OF OMAHA BANK                NEOMAHA                    Y Y20071210
+104014138ACCESS BK         ACCESS BANK                         NEOMAHA                    Y N20130114
+104100783MCCOOK NATL       MCCOOK NATIONAL BANK                NEMCCOOK                   Y Y        
+104100945FNB CHADRON       FIRST NATIONAL BANK OF CHADRON      NECHADRON                  YSY20060928
+104101258BANNER CAPITAL BK BANNER CAPITAL BANK                 NEHARRISBURG               Y N20130304
+104101465FNB ORD           FIRST NATIONAL BANK IN ORD          NEORD                      YSY20100820
+104101627POINTS WEST COM   POINTS WEST COMMUNITY BANK          NESIDNEY                   Y Y20101220
+104101876VLY BT SCOTTSBLUFFVALLEY BANK AND TRUST COMPANY       NESCOTTSBLUFF              YSY20060929
+104102192MIDWEST BK NA     MIDWEST BANK, NATIONAL ASSOCIATION  NEPIERCE                   Y N20120426
+104102202AMER NATL SIDNEY  AMERICAN NATIONAL BANK OF SIDNEY    NESIDNEY                   YSY20130131
+104102309PLATTE VALLEY BK  PLATTE VALLEY BANK                  NESCOTTSBLUFF              Y Y20080401
+104102587FST CENTRAL BK    FIRST CENTRAL BANK                  NECAMBRIDGE                Y N20120321
+104102781FNB GORDON        FIRST NATIONAL BANK OF GORDON       NEGORDON                   YSY20091221
+104102875TILDEN BANK       TILDEN BANK                         NETILDEN                   Y Y19991203
+104102985BANK NEWMAN GROVE BANK OF NEWMAN GROVE                NENEWMAN GROVE             YSY20060119
+104103829WESTERN NE BK     WESTERN NEBRASKA BANK               NECURTIS                   N Y20130131
+104104750ST BK HILDRETH    STATE BANK OF HILDRETH              NEHILDRETH                 N Y        
+104106693BRUNING ST BK     BRUNING STATE BANK                  NEBRUNING                  Y Y20140224
+104106716BRUNSWICK SB      BRUNSWICK STATE BANK                NEBRUNSWICK                N Y        
+104107456AMFIRST MCCOOK    AMFIRST BANK, NA                    NEMCCOOK                   Y Y20110301
+104107731BK OF KEYSTONE NE BANK OF KEYSTONE                    NEKEYSTONE                 Y Y        
+104107786BK OF LEWELLEN    BANK OF LEWELLEN                    NELEWELLEN                 N Y20091130
+104109739WEST PLAINS BK    WEST PLAINS BANK                    NEAINSWORTH                YSY20101220
+104109975CURTIS ST BK      WESTERN NEBRASKA BANK               NECURTIS                   N Y20130207
+104110919NEBRASKA ST B&T   NEBRASKA STATE BANK AND TRUST CO    NEBROKEN BOW               YSY20131001
+104110977BK HARTINGTON     BANK OF HARTINGTON                  NEHARTINGTON               YSY20040331
+104111769FRMRS ST BK       FARMERS STATE BANK                  NEWALLACE                  Y Y        
+104113039SEC NATL LAUREL   SECURITY BANK                       NELAUREL                   YSY20120622
+104113327BANK OF ELGIN     BANK OF ELGIN                       NEELGIN                    YSN20060329
+104113343FST SB SCOTTSBLUFFFIRST STATE BANK                    NESCOTTSBLUFF              YSY20060331
+104113521BATTLE CRK ST BK  BATTLE CREEK STATE BANK             NEBATTLE CREEK             YSN20080507
+104113550BUTTE STATE BANK  BUTTE STATE BANK                    NEBUTTE                    YSY20050628
+104113709NORTH LOUP VLY BK NORTH LOUP VALLEY BANK              NENORTH LOUP               Y Y        
+104113819SEC FST BK        SECURITY FIRST BANK                 NELINCOLN                  Y N20130319
+104113958ADAMS BK OGALLALA ADAMS BANK AND TRUST                NEOGALLALA                 Y Y        
+104113990FIRST CTRL MCCOOK FIRST CENTRAL BANK MCCOOK           NEMCCOOK                   Y N20060329
+104189461HOLYOKE COM FCU   HOLYOKE COMMUNITY FEDERAL CU        NEIMPERIAL                 Y N20111005
+104900048FREMONT NATL      FREMONT NATIONAL BANK AND TRUST CO  NEFREMONT                  Y N20140319
+104900116FST SBT CO FREMONTFIRST STATE BANK                    NEFREMONT                  N Y20090312
+104900349CORNERSTONE BANK  CORNERSTONE BANK                    NEYORK                     Y Y20061005
+104900459PLATTE VLY KEARNEYPLATTE VALLEY STATE BANK & TR CO    NEKEARNEY                  Y N20140319
+104900543FARMERS NEB CITY  FARMERS BANK AND TRUST COMPANY      NENEBRASKA CITY            YSN20060928
+104900585FNB FAIRBURY      FIRST NATIONAL BANK OF FAIRBURY     NEFAIRBURY                 Y Y20090902
+104900679FNB & TR COLUMBUS FIRST NATL BK AND TR CO OF COLUMBUS NECOLUMBUS                 Y N20140320
+104900721FNB NORTH PLATTE  FIRST NATL BANK                     NENORTH PLATTE             Y N20140319
+104900750PLATTSMOUTH ST BK PLATTSMOUTH STATE BANK              NEPLATTSMOUTH              YSN20140303
+104900802F&M BANK          F&M BANK                            NEFALLS CITY               Y Y20121214
+104900886FNB HOLDREDGE     FIRST NATIONAL BANK OF HOLDREGE     NEHOLDREGE                 YSY20061002
+104900912CARSON AUBURN     CARSON NATIONAL BANK                NEAUBURN                   YSY20070112
+104900938AUBURN ST BK      AUBURN STATE BANK                   NEAUBURN                   YSN20130321
+104901144CITY BT CRETE     CITY BANK & TRUST CO                NECRETE                    YSY20070326
+104901238FIRSTBK NEBRASKA  FIRSTBANK OF NEBRASKA               NEWAHOO                    YSN20131224
+104901241SIOUXLAND NATL    SIOUXLAND NATIONAL BANK             NESOUTH SIOUX CITY         Y N20130322
+104901319FIRST NEB BK WAYNEFIRST NEBRASKA BANK OF WAYNE        NEWAYNE                    YSY20121206
+104901335ST NEBR BK &  TR  STATE NEBRASKA BANK & TRUST         NEWAYNE                    YSY20140108
+104901348CATTLE NATL B&T COTHE CATTLE NATIONAL BANK & TRUST CO NESEWARD                   YSY20050930
+104901364JONES SEWARD      JONES NATIONAL BANK AND TRUST CO    NESEWARD                   Y Y        
+104901513F&M BK            F&M BANK                            NEWEST POINT               Y Y20100604
+104901568BK OF THE VALLEY  BANK OF THE VALLEY                  NEBELLWOOD                 YSN20130417
+104901584WEST GATE BANK    WEST GATE BANK                      NELINCOLN                  Y Y        
+104901597HEARTLAND BK      HEARTLAND BANK                      NEGENEVA                   YSY20140228
+104901610FSB GOTHENBURG    FIRST STATE BANK                    NEGOTHENBURG               N Y20090225
+104901652BANKFIRST         BANKFIRST                           NENORFOLK                  Y Y20021204
+104901665CHARTER WEST NATL CHARTER WEST NATIONAL BANK          NEWEST POINT               Y Y20000929
+104901678FIVE PT BK GR ISL FIVE POINTS BANK                    NEGRAND ISLAND             Y Y        
+104901717FIRST BK & TR     FIRST BK & TR OF FULLERTON          NEFULLERTON                Y Y20100701
+104901720FULLERTON NATL    FULLERTON NATIONAL BANK             NEFULLERTON                YSY20070926
+104901814MINDEN EXCH NEB   MINDEN EXCHANGE BANK & TRUST CO     NEMINDEN                   Y Y        
+104901827FNB & TR MINDEN   FIRST BANK AND TRUST CO             NEMINDEN                   YSY20090415
+104901911F&M ASHLAND       FARMERS & MERCHANTS BANK OF ASHLAND NEASHLAND                  YSN20091214
+104901940GENOA COMM BK     GENOA COMMUNITY BANK                NEGENOA                    YSY20110629
+104902033STANTON ST BK     STANTON STATE BANK                  NESTANTON                  YSY20100105
+104902062CITZ ST PAUL      CITIZENS BANK & TRUST CO            NESAINT PAUL               YSY20041110
+104902114CORE BANK         CORE BANK                           NEOMAHA                    YSN20140210
+104902172ELKHORN VALLEY BK ELKHORN VALLEY BANK                 NENORFOLK                  Y N20130322
+104902363FIRST BK COZAD    FIRST BANK & TRUST CO               NECOZAD                    YSY20070718
+104902376HOMESTEAD BK      HOMESTEAD BANK                      NECOZAD                    YSY20091028
+104902392CITIZ WISNER      CITIZENS STATE BANK                 NEWISNER                   YSY20091215
+104902800GENERATIONS BK    GENERATIONS BANK                    NEEXETER                   Y Y20121220
+104902949FNB NORTHEAST     FIRST NATIONAL BANK NORTHEAST       NELYONS                    YSY20070223
+104903139PENDER ST BK NEB  PENDER STATE BANK                   NEPENDER                   N Y20070913
+104903294FNB BANCROFT      FIRST NATIONAL BANK                 NEBANCROFT                 YSY20040419
+104903333EXCHANGE BK GIBBONEXCHANGE BANK                       NEGIBBON                   YSY20130802
+104903362F&M BANK          FARMER AND MERCHANTS BANK           NEMILFORD                  Y Y20110328
+104903498FRMRS ST BK       FARMERS STATE BANK                  NEDODGE                    N Y20140311
+104903579CLARKSON BK       CLARKSON BANK                       NECLARKSON                 N Y        
+104903595TWO RIVERS ST BK  TWO RIVERS STATE BANK               NEBLAIR                    N Y20091009
+104903692AMER EXCHANGE BK  AMERICAN EXCHANGE BANK              NEELMWOOD                  YSY20051219
+104904099OAK CRK VALLEY BK OAK CREEK VALLEY BANK               NEVALPARAISO               N Y20011214
+104904277FB OF UTICA       FIRST BANK OF UTICA                 NEUTICA                    YSN20090626
+104904442FIRST COMMUNITY BKFIRST COMMUNITY BANK                NEBEEMER                   YSY20100610
+104905616FIVE POINTS BK    FIVE POINTS BANK OF HASTINGS        NEHASTINGS                 Y Y20030313
+104905784HORIZON BANK      HORIZON BANK                        NEWAVERLY                  Y Y20010717
+104905881FNB JOHNSON       FIRST NATIONAL BANK                 NEJOHNSON                  YSY20041105
+104907025CORNHUSKER BK LINCCORNHUSKER BANK                     NELINCOLN                  Y Y        
+104907708ADAMS CNTY BK     ADAMS COUNTY BANK                   NEKENESAW                  N Y20050502
+104907779FOUNDATION FIRST  FOUNDATION FIRST BANK               NEWATERLOO                 N Y20091127
+104908383HERITAGE BANK     HERITAGE BANK                       NEWOOD RIVER               Y Y20000928
+104908422TOWN & COUNTRY BK TOWN & COUNTRY BANK                 NERAVENNA                  YSY20070426
+104908956FST NEB BK VALLEY FIRST NEBRASKA BANK                 NEVALLEY                   YSY20070531
+104909531FST ST BK NE      FIRST STATE BANK NEBRASKA           NELINCOLN                  Y Y20120330
+104910135AMER INTERSTATE BKAMERICAN INTERSTATE BANK            NEELKHORN                  Y Y        
+104910795UNION BK TR LINC  UNION BANK AND TRUST COMPANY        NELINCOLN                  Y Y        
+104912670IOWA-NEBRASKA SB  IOWA-NEBRASKA STATE BANK            NESOUTH SIOUX CITY         Y N20130321
+104913019BK OF BENNINGTON  BANK OF BENNINGTON                  NEBENNINGTON               Y Y20100112
+104913161WASHGTN CNTY BK   WASHINGTON COUNTY BANK              NEBLAIR                    YSN20120326
+104913190PATHWAY BANK      PATHWAY BANK                        NECAIRO                    N Y20070117
+104913255WAHOO STATE       WAHOO STATE BANK                    NEWAHOO                    YSN20060714
+104913284COLUMBUS BK & TR  COLUMBUS BANK AND TRUST COMPANY     NECOLUMBUS                 Y Y        
+104913365WINSIDE ST BK     WINSIDE STATE BANK                  NEWINSIDE                  Y Y        
+104913381FRONTIER BK       FRONTIER BANK                       NEMADISON                  Y N20130321
+104913491YORK STATE BANK   YORK STATE BANK                     NEYORK                     Y Y20110527
+104913530HENDERSON ST BK   HENDERSON STATE BANK                NEHENDERSON                N Y        
+104913912PINNACLE PAPILLIONPINNACLE BANK                       NEPAPILLION                Y Y        
+104913970NEBRASKALAND NATL NEBRASKALAND NATIONAL BANK          NENORTH PLATTE             Y N        
+104914050WORLDS FOREMOST BKWORLD'S FOREMOST BANK, N.A.         NESIDNEY                   Y N20010323
+104989852COM BK OF LINC    NEBRASKA BANK OF COMMERCE           NELINCOLN                  Y Y20130228
+107000068PUEBLO BK & TR    PUEBLO BANK AND TRUST COMPANY       COPUEBLO                   Y Y        
+107000097PIKES PEAK NATL BKPIKES PEAK NATIONAL BANK            COCOLORADO SPRINGS         Y Y        
+107000233CAPITAL WEST BK   CAPITAL WEST BANK                   WYLARAMIE                  Y Y20121231
+107000262FNB FT COLLINS    FIRST NATIONAL BANK OF OMAHA        COFORT COLLINS             Y N20110719
+107000372SW CAPITAL BK     SOUTHWEST CAPITAL BANK              NMLAS VEGAS                Y Y20121003
+107000440FNB SANTA FE NM   FIRST NATL BANK OF SANTA FE         NMSANTA FE                 Y Y        
+107000466VALLEY ESPANOLA   VALLEY NATIONAL BANK                NMESPANOLA                 Y Y20060306
+107000783WESTERN BK ALBQ   COMPASS BANK                        NMALBUQUERQUE              Y N20030814
+107000929ROCKY MTN BT      ROCKY MOUNTAIN BANK & TRUST FLORENCECOFLORENCE                 Y Y19990929
+107001012LOS ALAMOS NATL   LOS ALAMOS NATIONAL BANK            NMLOS ALAMOS               Y Y        
+107001070CITYWIDE AURORA   CITYWIDE BANK                       COAURORA                   Y Y19990721
+107001232ANB               ANB BANK                            CODENVER                   Y Y20120112
+107001261CENTINEL BK TAOS  CENTINEL BANK OF TAOS               NMTAOS                     Y Y        
+107001326FNB ESTES PARK    BANK OF ESTES PARK                  COESTES PARK               N Y20120927
+107001452FST COMMUNITY BK  US BANK, NA                         NMTAOS                     Y N20140303
+107001481ACADEMY BANK, NA  ACADEMY BANK NA                     KSFORT LEAVENWORTH         Y Y20100811
+107001601COMMUNITY BANK ESPCOMMUNITY BANK                      NMSANTA FE                 Y Y20040311
+107002008COM 1ST BK LAS VE COMMUNITY 1ST BANK LAS VEGAS        NMLAS VEGAS                Y Y20071221
+107002118NBH BKS NA, COM BKNBH BANK NA, COMM BKS OF CO BRANCH  COARVADA                   Y N20121116
+107002260FIRSTIER N GLENN  COMPASS BANK                        CONORTHGLENN               Y N20030814
+107002312FIRST COMMUNITY   US BANK, NA                         NMTAOS                     Y N20130222
+107002448BANK OF COLORADO  BANK OF COLORADO                    COFORT COLLINS             Y Y20010926
+107002516CANON NTL BK      CANON NATIONAL BANK                 COCANON CITY               Y Y19990930
+107002723MAIN BANK         MAIN BANK                           NMALBUQUERQUE              Y Y20060207
+107003418FARMERS ST BRUSH  FARMERS STATE BANK                  COBRUSH                    N Y        
+107003463NORTHSTAR BK OF CONORTHSTAR BANK OF COLORADO          CODENVER                   Y Y20140324
+107003829FNB FLEMING       FIRST NATIONAL BANK OF FLEMING      COFLEMING                  YSY20080516
+107003861EVERGREEN NATL BNKEVERGREEN NATIONAL BANK             COEVERGREEN                Y Y        
+107004491PEOPLES BK TAOS   PEOPLES BANK                        NMRANCHOS DE TAOS          Y N        
+107004776HOME STATE BANK   HOME STATE BANK                     COLOVELAND                 Y Y        
+107005047FIRSTBK           FIRSTBANK                           COLAKEWOOD                 Y Y20101018
+107005076NO VLLY THORNTON  NORTH VALLEY BANK                   COTHORNTON                 YSY20040427
+107005319COMPASS COLORADO  COMPASS BANK                        COENGLEWOOD                Y N20030814
+107005445VALLEY NB BRIGHTONVALLEY BANK AND TRUST               COBRIGHTON                 Y Y        
+107005953CENTENNIAL ENGLEWDCENTENNIAL BANK                     COCENTENNIAL               Y Y20100811
+107005979MUTUAL OF OMAHA BKMUTUAL OF OMAHA BANK                NELINCOLN                  Y N20130715
+107006253MY BANK           MY BANK                             NMBELEN                    Y Y20061031
+107006389CACHE GREELEY CO  CACHE BANK & TRUST                  COGREELEY                  N Y20001219
+107006428FRONT RANGE BANK  FRONT RANGE BANK                    COLAKEWOOD                 Y Y        
+107006444TCF NATIONAL BANK TCF NATIONAL BANK                   COGREENWOOD VILLAGE        Y N20100811
+107006541NEW MEXICO BK & TRNEW MEXICO BANK AND TRUST           NMALBUQUERQUE              N Y20001221
+107006606BOKF NA           BOKF NA                             NMALBUQUERQUE              Y N20130621
+107006787ADVANTAGE BANK    ADVANTAGE BANK                      COLOVELAND                 Y Y20140306
+107006839FLATIRONS BANK    FLATIRONS BANK                      COBOULDER                  Y Y20120511
+107006923CHEYENNE ST BK    CHEYENNE STATE BANK                 WYCHEYENNE                 N Y20100930
+107006965PEOPLES NATL BK   PEOPLES NATIONAL BANK               COCOLORADO SPRINGS         Y Y20071211
+107006981CENTENNIAL BANK   CENTENNIAL BANK                     COCENTENNIAL               Y N20100811
+107006994INTEGRITY BK & TR INTEGRITY BANK AND TRUST            COMONUMENT                 N Y20110217
+107007074FT COLLINS COMM BKVERUS BANK OF COMMERCE              COFORT COLLINS             Y N20110214
+107007139WY STATE BANK     WYOMING STATE BANK                  WYLARAMIE                  YSY20110120
+107007281SOLERA NATL BK    SOLERA NATIONAL BANK                COLAKEWOOD                 YSY20101014
+107007346AMG NAT'L TR BK   AMG NATIONAL TRUST BANK             COBOULDER                  Y Y20120307
+107007375MOUNTAIN VIEW BK  MOUNTAIN VIEW BANK OF COMMERCE      COWESTMINSTER              Y N20080208
+107089335COLO FED SAV BANK COLORADO FEDERAL SAVINGS BANK       COGREENWOOD VILLAGE        Y Y20100811
+107089555WASH MUT BANK     JPMORGAN CHASE BANK, NA             COWESTMINSTER              Y N20100811
+107089720SANDIA LABS FCU   SANDIA LABORATORY FCU               NMALBUQUERQUE              Y N20130722
+107089885FED SVGS BK       COLORADO FEDERAL SAVINGS BANK       COGREENWOOD                Y N20110811
+111000012BK AMER GLOBAL    BANK OF AMERICA, N.A., TX           TXBANK AMER GLOBAL DAL     Y N20060626
+111000038FRB DAL           FEDERAL RESERVE BANK OF DALLAS      TXDALLAS                   Y Y20001026
+111000614JPMCHASE TEXAS    JPMORGAN CHASE BANK, NA             TXBANK ONE TEXAS           Y N20070726
+111000753COMERICA DALLAS   COMERICA BANK                       TXDALLAS                   Y Y20080206
+111000960NO DALLAS B&T     NORTH DALLAS BANK & TRUST CO        TXDALLAS                   Y Y        
+111001040INWOOD DALLAS     INWOOD NATIONAL BANK                TXDALLAS                   Y Y        
+111005774AMER DALLAS       AMERICAN BANK, NA                   TXDALLAS                   YSN20120126
+111010170TIB DALLAS        TIB THE INDEPENDENT BANKERS BANK    TXDALLAS                   Y Y20001120
+111011878PAVILLION DALLAS  PAVILLION BANK                      TXRICHARDSON               Y Y20051003
+111014325BOKF NA           BOKF NA                             TXDALLAS                   Y N20130621
+111015544PRESTON NATL DAL  PRESTON NATIONAL BANK               TXDALLAS                   Y Y        
+111015748STATE B&T DAL     STATE BANK AND TRUST COMPANY        TXDALLAS                   Y Y20120330
+111016064NORTHERN TR TEXAS THE NORTHERN TRUST COMPANY          ILCHICAGO                  Y N20120913
+111016501UNITED TEXAS DAL  UNITED TEXAS BANK                   TXDALLAS                   Y Y        
+111017458STATE BK DAL      STATE BANK OF TEXAS                 TXDALLAS                   Y Y20091106
+111017694BRANCH BKING & TRUBRANCH BANKING & TRUST CO           TXDALLAS                   Y N20100503
+111017979TEXAS CAPITAL DAL TEXAS CAPITAL BANK, N.A.            TXDALLAS                   Y Y        
+111024386PK CITIES BK DAL  PARK CITIES BANK                    TXDALLAS                   Y Y20101216
+111024548GRAND BANK        GRAND BANK                          TXDALLAS                   Y Y20020611
+111024645TOLLESON PRIVATE  TOLLESON PRIVATE BANK               TXDALLAS                   Y N20131024
+111024852PRESTON STATE BANKPRESTON STATE BANK                  TXDALLAS                   Y Y20101008
+111024865VERITEX COMM BK   VERITEX COMMUNITY BANK              TXDALLAS                   Y N20121204
+111024975T BANK NA         T BANK, N.A.                        TXDALLAS                   Y N20140512
+111025084WILSHIRE BANK     WILSHIRE BANK                       TXDALLAS                   Y N20131003
+111025123ONE WORLD BANK    ONE WORLD BANK                      TXDALLAS                   Y N20050401
+111025453PACIFIC PREMIER BKPACIFIC PREMIER BANK                CAIRVINE                   Y N20130719
+111025466PEGASUS BK DALLAS PEGASUS BANK                        TXDALLAS                   Y Y20090803
+111025518IBERIABANK        IBERIABANK                          LALAFAYETTE                YSN20140630
+111025877TEXAS SECURITY BK TEXAS SECURITY BANK                 TXDALLAS                   Y N20080507
+111026038SHINHAN BANK TX   SHINHAN BANK AMERICA                TXDALLAS                   Y N20090709
+111026041UNION BANK DALLAS MUFG UNION BANK, NA                 TXDALLAS                   Y N20140630
+111040195FHLB DAL          FEDERAL HOME LOAN BANK OF DALLAS    TXDALLAS                   Y N20131025
+111044531LA CORP CU NEW ORLLOUISIANA CORPORATE CREDIT UNION    LANEW ORLEANS              Y N20100309
+111100844BK OF WINNFIELD   BANK OF WINNFIELD & TRUST CO        LAWINNFIELD                YSY20140402
+111100909EXCHANGE NATCHITOCEXCHANGE BANK AND TRUST CO          LANATCHITOCHES             YSY20080321
+111101128HODGE BK AND TR   HODGE BANK AND TRUST CO             LAHODGE                    YSN20100419
+111101144DELTA BANK        DELTA BANK                          LAVIDALIA                  YSY20071213
+111101173AMER BK COUSHATTA AMERICAN BANK AND TRUST COMPANY, INCLACOVINGTON                Y Y20090126
+111101209RICHLAND ST-RAYVL RICHLAND STATE BANK                 LARAYVILLE                 N Y19990826
+111101225FST BIENVILLE PARIFIRST NATIONAL BANK                 LAARCADIA                  Y N20000128
+111101306WINNSBORO ST      WINNSBORO STATE BK & TR CO          LAWINNSBORO                N Y20030411
+111101458GTY DELHI         GUARANTY BANK & TRUST CO            LADELHI                    YSN20131024
+111101636COLFAX BK CO LA   COLFAX BANKING COMPANY              LACOLFAX                   Y N20131024
+111101652CALDWELL COLUMBIA CALDWELL BANK & TRUST CO            LACOLUMBIA                 Y Y        
+111101681BK OF COUSHATTA   BANK OF COUSHATTA                   LACOUSHATTA                Y Y        
+111101694CITZ BK VIVAN LA  CITIZENS BK & TRUST CO OF VIVIAN, LALAVIVIAN                   YSY20110204
+111101911CATAHOULA LASALLE CATAHOULA-LASALLE BANK              LAJONESVILLE               N Y20070801
+111102059SABINE ST MANY    SABINE STATE BANK & TRUST COMPANY   LAMANY                     Y Y20120328
+111102114BK OF MONTGOMERY  BANK OF MONTGOMERY                  LAMONTGOMERY               Y Y20091105
+111102208BK OF OAK RIDGE LABANK OF OAK RIDGE                   LAOAK RIDGE                N Y19991028
+111102389CROSS KEYS ST JOSECROSS KEYS BANK                     LASAINT JOSEPH             YSY20100811
+111102431CONCORDIA BK      CONCORDIA BANK & TRUST CO           LAVIDALIA                  Y Y        
+111102596PROGRESSIVE BANK  PROGRESSIVE BANK                    LAMONROE                   Y Y20130429
+111102732TRI ST BK HAUGHTONTRI-STATE BANK AND TRUST            LAHAUGHTON                 Y Y        
+111102758COMMUNITY CHOUDRANCOMMUNITY TRUST BANK                LACHOUDRANT                Y Y        
+111102813JONESBORO STATE BKJONESBORO STATE BANK                LAJONESBORO                YSN20081217
+111102949CTY BK NATCHITOCHECITY BANK & TRUST CO                LANATCHITOCHES             Y N20131031
+111103168PROGRESSIVE MANSFDPROGRESSIVE N/B OF DESOTO PARISH    LAMANSFIELD                Y Y19990518
+111103333FIRST BENTON      FIRST NATIONAL BANK OF BENTON       LABENTON                   Y N        
+111103524CITZ NTL BK NA    CITIZENS NATIONAL BANK, N.A.        LABOSSIER CITY             Y Y20050728
+111103650OUACHITA BK MONROEOUACHITA INDEPENDENT BANK           LAMONROE                   N Y20050304
+111103948TENSAS ST NEWELL  TENSAS STATE BANK                   LANEWELLTON                N Y20001120
+111104581COMMUNITY MANSFIELCOMMUNITY BANK OF LOUISIANA         LAMANSFIELD                Y Y20020213
+111105302COMM CAPITAL BK   COMMERCIAL CAPITAL BANK             LADELHI                    N Y20070801
+111105331COMMERCE CMNTY BK COMMERCE COMMUNITY BANK             LAOAK GROVE                N Y20131213
+111150027ARGENT TRUST CO NAARGENT TRUST COMPANY, NA            LARUSTON                   Y Y20130717
+111193550CENTRIC FCU W MONRCENTRIC FEDERAL CREDIT UNION        LAWEST MONROE              Y N20081110
+111300958AMARILLO NATL     AMARILLO NATIONAL BANK              TXAMARILLO                 Y Y        
+111301122FFIN ABILENE      FIRST FINANCIAL BANK, N.A.          TXABILENE                  Y Y20090708
+111301436SUNDOWN ST BK     SUNDOWN STATE BANK                  TXSUNDOWN                  Y Y        
+111301614KRESS NATL        KRESS NATIONAL BANK                 TXKRESS                    Y Y19990709
+111301737CITY BK LUBBOCK   CITY BANK                           TXLUBBOCK                  Y Y        
+111302095FIRST BK SOUTHWESTFIRSTBANK SOUTHWEST                 TXAMARILLO                 N Y20070801
+111302370ST NATL BIG SPRINGSTATE NATIONAL BANK                 TXBIG SPRING               Y Y        
+111302545FST B&T CHILDRESS FIRST BANK AND TRUST OF CHILDRESS   TXCHILDRESS                Y N20130212
+111302587FIRST BALLINGER   FIRST NATIONAL BANK                 TXBALLINGER                Y N20131031
+111302833WAGGONER VERNON   WAGGONER NAT BK OF VERNON, THE      TXVERNON                   Y Y20130723
+111302846HERRING VERNON    HERRING BANK                        TXAMARILLO                 Y Y20050503
+111303007FIRST CAPITAL BANKFIRST CAPITAL BANK                  TXQUANAH                   Y N20131031
+111303010TEXAS REPUB BK NA TEXAS REPUBLIC BANK, N.A.           TXFRISCO                   Y N20050512
+111303117INTERBANK         INTERBANK                           TXCOLEMAN                  Y N20111104
+111303489COMMERCIAL BRADY  COMMERCIAL NATIONAL BANK OF BRADY, TTXBRADY                    Y Y20140616
+111303492BRADY NATL        BRADY NATIONAL BANK                 TXBRADY                    Y N20131031
+111303667COMMUNITY BK SNYDECOMMUNITY BANK OF SNYDER            TXSNYDER                   Y Y20081118
+111303748HASKELL NATL      HASKELL NATIONAL BANK               TXHASKELL                  Y Y        
+111304051FIRST SEYMOUR     FIRST BANK & TRUST                  TXSEYMOUR                  Y Y20130605
+111304064INTERBANK         INTERBANK                           TXSEYMOUR                  Y N20101231
+111304307HAMLIN NATIONAL   HAMLIN NATIONAL BANK                TXHAMLIN                   Y Y        
+111304404MEMPHIS STATE     MEMPHIS STATE BANK                  TXMEMPHIS                  Y Y19990709
+111304608FIRST ANSON       FIRST NATIONAL BANK                 TXANSON                    Y N        
+111304637CITY NATL COLO CTYCITY NATIONAL BANK                  TXCOLORADO CITY            Y Y        
+111304912FIRST BAIRD       FIRST BANK TEXAS, SSB               TXBAIRD                    YSN20131104
+111305571SPUR SECURITY BK  SPUR SECURITY BANK                  TXSPUR                     YSY20100310
+111305746SANTA ANNA NATL   SANTA ANNA NATIONAL BANK            TXSANTA ANNA               Y Y        
+111306059FIRST PADUCAH     FIRST NATIONAL BANK                 TXPADUCAH                  Y N        
+111306088CROWELL STATE     CROWELL STATE BANK                  TXCROWELL                  YSY20120222
+111306198FNB ALBANY BRECKENFIRST NAT BK OF ALBANY/BRECKENRIDGE TXALBANY                   Y Y20090918
+111306758FIRST ROTAN       FIRST NATIONAL BANK                 TXROTAN                    Y Y        
+111307252FIRST STERLING CTYFIRST NATIONAL BANK                 TXSTERLING CITY            Y N20131104
+111307265FIRST TAHOKA      FIRST NATIONAL BANK OF TAHOKA       TXTAHOKA                   Y Y20091222
+111307485ROSCOE STATE      ROSCOE STATE BANK                   TXROSCOE                   Y N        
+111307663LUBBOCK NATL      LUBBOCK NATIONAL BANK               TXLUBBOCK                  Y Y        
+111307841FIRST ELDORADO    FIRST NATIONAL BANK                 TXELDORADO                 Y N        
+111308057FIRSTBANK SW      FIRSTBANK SOUTHWEST                 TXAMARILLO                 Y Y20051104
+111308358FIRST FLOYDADA    FIRST NATIONAL BANK OF FLOYDADA, THETXFLOYDADA                 Y Y20130701
+111308442BK OF COM-MCLEAN  BANK OF COMMERCE                    TXAMARILLO                 Y Y20081126
+111308552WELLINGTON STATE  WELLINGTON STATE BANK               TXWELLINGTON               Y Y        
+111308730LAMESA NATL       LAMESA NATIONAL BANK                TXLAMESA                   Y Y        
+111309030FST ST-ABERNATHY  FIRST STATE BANK                    TXABERNATHY                YSN20090729
+111309234FIRST ASPERMONT   FIRST NATIONAL BANK OF ASPERMONT    TXASPERMONT                YSN20110801
+111310294FIR UNITED DIMMITTFIRST UNITED BANK                   TXDIMMITT                  Y Y        
+111310391TEXAS FNCL BANK   TEXAS FINANCIAL BANK                TXEDEN                     YSN20130923
+111310809ST NATL GROOM     STATE NATIONAL BANK                 TXGROOM                    Y N        
+111310870HAPPY STATE BANK  HAPPY STATE BANK                    TXHAPPY                    Y Y20130328
+111311413FST COLORADO CITY FIRST NAT'L BANK OF COLORADO CITY   TXCOLORADO CITY            YSN20131104
+111311620FST ST MATADOR    FIRST NATIONAL BANK                 TXMATADOR                  Y N20050907
+111311743FIRST STATE BANK  FIRST STATE BANK                    TXMENARD                   Y N20040105
+111311785FIRST MERTZON     FIRST NATIONAL BANK                 TXMERTZON                  Y Y        
+111312182OZONA NATL        OZONA NATIONAL BANK                 TXOZONA                    Y N20120126
+111312535CROCKETT NATIONAL CROCKETT NATIONAL BANK              TXSAN ANGELO               Y N20120126
+111312894FST ST BK GRAHAM  FIRST STATE BANK                    TXGRAHAM                   Y N        
+111313000FIRST SONORA      FIRST NATIONAL BANK OF SONORA, THE  TXSONORA                   Y Y20101213
+111313204FIRST THROCKMORTONFIRST NATIONAL BANK OF THROCKMORTON TXTHROCKMORTON             Y Y20090608
+111313631CITIZENS CROSBYTONCITIZENS NATIONAL BANK              TXCROSBYTON                Y N20131104
+111314575VISTA BANK        VISTA BANK                          TXRALLS                    YSY20101130
+111315794FNB SPEARMAN      FIRST NATIONAL BANK                 TXSPEARMAN                 YSY20110103
+111316612INTERBANK         INTERBANK                           TXFRIONA                   Y N20111007
+111316793MULESHOE ST       MULESHOE STATE BANK                 TXMULESHOE                 Y N20131104
+111316829AMISTAD BANK      AMISTAD BANK                        TXDEL RIO                  YSN20130923
+111316887PEOPLES BANK LORENPEOPLES BANK                        TXLUBBOCK                  Y N20131105
+111317569TEXAS NATL SWEETWATEXAS NATIONAL BANK                 TXSWEETWATER               Y Y20031103
+111317637SEC ST WINTERS    SECURITY STATE BANK                 TXWINTERS                  YSY20130530
+111317844FST ST SPEARMAN   FIRST STATE BANK                    TXSPEARMAN                 Y N        
+111317857COMPASS BK LUBBOCKCOMPASS BANK                        TXFORT WORTH               Y N20080425
+111318063INTERBANK         INTERBANK                           TXFOLLETT                  Y N20110103
+111318584PERRYTON NATL     PERRYTON NATIONAL BANK              TXPERRYTON                 Y N        
+111318610MENARD BANK       MENARD BANK                         TXMENARD                   Y N20131230
+1113190041ST BK TRUST CO   FIRST BANK & TRUST COMPANY          TXWILSON                   Y N20091204
+111319347FST BK & TR LUBBOCFIRST BANK & TRUST COMPANY          TXLUBBOCK                  Y Y20091204
+111319538FIRST QUITAQUE    FIRST NATIONAL BANK OF QUITAQUE     TXQUITAQUE                 Y N20091216
+111320006CITIZENS BANK, NA CITIZENS BANK, N.A.                 TXABILENE                  Y N20131105
+111320925FIRST AMHERST     FIRST NATIONAL BANK                 TXAMHERST                  Y Y        
+111321063AIMBANK           AIMBANK                             TXLITTLEFIELD              YSY20030717
+111321270SECURITY BK ODESSASECURITY BANK                       TXODESSA                   Y N20120126
+111321717CENTENNIAL BK     CENTENNIAL BANK                     TXLUBBOCK                  Y Y20130904
+111321720FIRST DALHART     FIRST NATIONAL BANK IN DALHART      TXDALHART                  YSN20100817
+111321814COLEMAN CTY ST    COLEMAN COUNTY STATE BANK           TXCOLEMAN                  Y N        
+111321827ROBERT LEE ST BK  ROBERT LEE STATE BANK               TXROBERT LEE               Y N        
+111322538SEC LITTLEFIELD   SECURITY STATE BANK                 TXLITTLEFIELD              Y Y        
+111322664WEST TEX ST SNYDERWEST TEXAS STATE BANK               TXSNYDER                   Y Y        
+111322813INTERBANK         INTERBANK                           TXCANADIAN                 Y N20101231
+111322994PLAINSCAPITAL BANKPLAINSCAPITAL BANK                  TXDALLAS                   Y Y20110408
+111323207PLAINS ST BK      PLAINS STATE BANK                   TXHUMBLE                   Y N20131105
+111323731WESTERN BANK      WESTERN BANK                        TXLUBBOCK                  Y N20120126
+111323809BANK OF TEXAS     BANK OF TEXAS                       TXMIDLAND                  YSN20100401
+111323906TEXAS ST SAN ANG  TEXAS STATE BANK                    TXSAN ANGELO               Y Y        
+111323922ABC WOLFFORTH     AMERICAN BANK OF COMMERCE           TXWOLFFORTH                Y Y        
+111323951FIRST NATL BORGER INTERBANK                           TXBORGER                   Y N20111104
+1113242931ST NATL HEREFORD FIRST NATIONAL BANK OF HEREFORD     TXHEREFORD                 Y Y20090401
+111324921BANK OF SAN ANGELOFIRST NATIONAL BANK                 TXBALLINGER                Y N20100225
+111325797LONE STAR ST BK TXLONE STAR STATE BANK OF WEST TEXAS  TXLUBBOCK                  Y N20080204
+111900549NATL BK OF TEX FTWNATIONAL BK OF TX AT FORT WORTH, THETXFORT WORTH               Y Y20110407
+111900578LIBERTY PARIS     LIBERTY NATIONAL BANK               TXPARIS                    Y Y        
+111900581EXTRACO BK TEMPLE EXTRACO BANKS, N.A.                 TXTEMPLE                   Y Y        
+111900594COMMUNITY BK&TRUSTCOMMUNITY BANK & TRUST              TXWACO                     Y N20120126
+111900604AMER NATL WACO    AMERICAN BANK, N.A.                 TXWACO                     Y Y        
+111900756SW BK FT WORTH    SOUTHWEST BANK                      TXFORT WORTH               Y Y        
+111901014CAPITAL ONE       CAPITAL ONE                         TXTEXARKANA                Y N20090130
+111901234LEGACYTEXAS BANK  LEGACYTEXAS BANK                    TXPLANO                    Y Y20050718
+111901467FST ST GAINESVILLEFIRST STATE BANK                    TXGAINESVILLE              Y Y        
+111901519AMERICAN TERRELL  AMERICAN NATIONAL BANK OF TEXAS     TXTERRELL                  Y Y        
+111901564INTERBANK         INTERBANK                           TXBRECKENRIDGE             Y N20101231
+111901580CITZ BROWNWOOD    CITIZENS NATIONAL BANK              TXBROWNWOOD                Y N20000404
+111901629CITIZENS NATL BK  CITIZENS NATIONAL BANK OF TEXAS     TXWAXAHACHIE               Y Y20050930
+111901645AMER SHERMAN      AMERICAN BANK OF TEXAS              TXSHERMAN                  Y Y        
+111901946CITY SULPH SPRINGSCITY NATIONAL BK OF SULPHUR SPRINGS TXSULPHUR SPRINGS          Y Y20110311
+111901959COMMUNITY BANK    COMMUNITY BANK                      TXLONE STAR                N Y20130329
+111901975ALLIANCE BANK     ALLIANCE BANK                       TXSULPHUR SPRINGS          Y Y        
+111901988FIRST WEATHERFORD FIRST NATIONAL BANK                 TXWEATHERFORD              YSY20110912
+111902000COMPASS BK FT. WORCOMPASS BANK                        TXFORT WORTH               Y N20060519
+111902055BENCHMARK         BENCHMARK BANK                      TXPLANO                    Y N20130403
+111902398FORT HOOD NATL    FORT HOOD NATIONAL BANK             TXFORT HOOD                Y Y        
+111902424TEXAS BK BRWNWOOD TEXAS BANK                          TXBROWNWOOD                Y Y        
+111902806CLASSIC BK NA TX  CLASSIC BANK, N.A.                  TXCAMERON                  Y Y20071102
+111902819CITIZENS NATL BK  CITIZENS NATIONAL BANK              TXCAMERON                  Y Y20020327
+111903151CITZ HENDERSON    CITIZENS NATIONAL BANK              TXHENDERSON                Y Y        
+111903232TEXAS EXCHANGE BK TEXAS EXCHANGE BANK, SSB            TXCROWLEY                  Y Y20140121
+111903245FIRST NATL CTRL TXFIRST NATIONAL BANK OF CENTRAL TEXASTXWACO                     Y Y20090226
+111903290LEGEND BK BOWIE TXLEGEND BANK, N.A.                   TXBOWIE                    Y Y20070301
+111903407COMANCHE NATL     COMANCHE NATIONAL BANK              TXCOMANCHE                 Y Y        
+111903465INCOMMONS BK. N.A.INCOMMONS BANK, N.A.                TXMEXIA                    YSY20100625
+111903517PINNACLE BK TX    PINNACLE BANK                       TXKEENE                    Y N20120126
+111903559COMMUNITY GRANBURYCOMMUNITY BANK                      TXFORT WORTH               Y Y20100831
+111903591FREEDOM BK DUBLIN THE DUBLIN NATIONAL BANK            TXDUBLIN                   YSN20060818
+111903931FIRST TX LAMPASAS FIRST TEXAS BANK                    TXLAMPASAS                 Y Y        
+111904150FST ST CARTHAGE   FIRST STATE BANK & TRUST CO         TXCARTHAGE                 Y Y        
+111904192FIRST MT VERNON   FIRST NATIONAL BANK                 TXMOUNT VERNON             Y Y        
+111904231CITY NATL SAN SABACITY NATIONAL BANK                  TXSAN SABA                 Y Y19990709
+111904419NTL BK GATESVILLE NATIONAL BANK                       TXGATESVILLE               Y Y        
+111904480FIRST MC GREGOR   FIRST NATIONAL BANK OF MCGREGOR     TXMC GREGOR                Y Y20130813
+111904503TOWN NO BK, NA    TOWN NORTH BANK, N.A.               TXDALLAS                   Y Y20020710
+111904817NORTHSTAR BK OF TXNORTHSTAR BANK OF TEXAS             TXDENTON                   Y N20131106
+111904859FIRST WINNSBORO   FIRST NATIONAL BANK                 TXWINNSBORO                Y Y        
+111904943TEXAS BANK        TEXAS BANK                          TXHENDERSON                Y Y20100119
+111905133POINTWEST BK TX   POINTWEST BANK                      TXWEST                     Y Y20070716
+111905159STATE NATL WEST   STATE NATIONAL BANK                 TXWEST                     Y N        
+111905227CIERA BANK        CIERA BANK                          TXGRAHAM                   Y Y20131206
+111905230INTERBANK         INTERBANK                           TXGRAHAM                   Y N20110812
+111905324FIRST COOPER      FIRST NATIONAL BANK OF COOPER, THE  TXCOOPER                   Y N20090302
+111905337FNB COOPER TX     FIRST NATIONAL BANK OF COOPER, THE  TXCOOPER                   N Y20100129
+111905340FIDELITY WACO     FIDELITY BANK OF TEXAS              TXWACO                     N Y19990622
+111905434FIRST KEMP        FIRST NATIONAL BANK                 TXKEMP                     YSN20100406
+111905609FIRST GILMER      FIRST NATIONAL BANK OF GILMER       TXGILMER                   Y N20131107
+111905612GILMER NATL       GILMER NATIONAL BANK                TXGILMER                   YSY20091223
+111905641JACKSBORO NATL    JACKSBORO NATIONAL BANK             TXJACKSBORO                Y Y        
+111905764TEXAS STAR BANK   TEXAS STAR BANK                     TXVAN ALSTYNE              Y Y20031014
+111906006POINTBANK         POINTBANK                           TXPILOT POINT              Y Y20100120
+111906048LAKESIDE ROCKWALL LAKESIDE NATIONAL BANK              TXROCKWALL                 Y Y        
+111906161FIRST GRANBURY    FIRST NATIONAL BANK                 TXGRANBURY                 Y N        
+111906271FIRST KILLEEN     FIRST NATIONAL BANK TEXAS           TXKILLEEN                  Y Y20050728
+111906349SECURITY BK CRAWFOSECURITY BANK OF CRAWFORD           TXCRAWFORD                 YSY20131210
+111906462FST ST CHICO      FIRST STATE BANK                    TXCHICO                    Y N        
+111906501TEXAS HERITAGE NATTEXAS HERITAGE NATIONAL BANK        TXDAINGERFIELD             Y N20080429
+111906611MORRIS CO NAPLES  MORRIS COUNTY NATIONAL BANK, THE    TXNAPLES                   Y Y20130930
+111906624GRAND BK OF TX    GRAND BANK OF TEXAS                 TXGRAND PRAIRIE            Y Y20020709
+111906747MILL CNTY GOLDTHWAMILLS COUNTY STATE BANK             TXGOLDTHWAITE              Y N20131107
+111906860INTERBANK         INTERBANK                           TXOLNEY                    Y N20101231
+111906996GRANDVIEW BANK    GRANDVIEW BANK                      TXGRANDVIEW                Y N20070309
+111907021F&M DE LEON       FARMERS AND MERCHANTS BANK          TXDE LEON                  N Y20100811
+111907089ST BK DEKALB      STATE BANK OF DEKALB                TXDE KALB                  YSN20131108
+111907102FIRST EMORY       FIRST NATIONAL BANK                 TXEMORY                    Y N20131108
+111907144AMER WICHITA FALLSAMERICAN NATIONAL BANK & TRUST      TXWICHITA FALLS            Y N20130904
+111907199CENTRAL NATL WACO CENTRAL NATIONAL BANK               TXWACO                     Y Y        
+111907348FIRST MOODY       FIRST NATIONAL BANK OF MOODY, THE   TXMOODY                    YSN20120625
+111907429SPRING HILL ST BK SPRING HILL STATE BANK              TXLONGVIEW                 Y N20130502
+111907445COMPASS BK RICHARDCOMPASS BANK                        TXHOUSTON                  Y N20030814
+111907717FIRST CELESTE     FIRST BANK OF CELESTE               TXCELESTE                  Y Y        
+111907775FIRST B&T DAWSON  FIRST BANK AND TRUST COMPANY        TXDAWSON                   Y N        
+111907788FST BK BURKBURNETTFIRST BANK                          TXBURKBURNETT              YSY20100510
+111907827FIRST EDGEWOOD    FIRST NATIONAL BANK                 TXEDGEWOOD                 Y N20000405
+111907940HORIZON BK AUSTIN HORIZON BANK, SSB                   TXAUSTIN                   Y Y20131029
+111907979TEXAS BK FINANCIALTEXAS BANK FINANCIAL                TXWEATHERFORD              Y N20131209
+111908004FIRST BANK WHITNEYFIRST BANK                          TXWHITNEY                  Y Y20130618
+111908208AMER MT PLEASANT  AMERICAN NATIONAL BANK              TXMOUNT PLEASANT           Y N20131112
+111908583FIRST TRENTON     FIRST NATIONAL BANK OF TRENTON      TXTRENTON                  YSY20111115
+111908758FIRST LIPAN       FIRST NATIONAL BANK                 TXLIPAN                    Y N        
+111908949TEXAS FIRST RIESELTEXAS FIRST STATE BANK              TXRIESEL                   Y Y20000726
+111908965CITZ ST BK BUFFALOCITIZENS STATE BANK                 TXBUFFALO                  Y Y20000622
+111909281FIRST BURLESON    FIRST NATIONAL BANK OF BURLESON     TXBURLESON                 Y N20120126
+111909362CITZ WILLS POINT  CITIZENS NAT'L BANK OF WILLS POINT  TXWILLS POINT              Y Y19990709
+111909391CENDERA BANK, N.A.CENDERA BANK, N.A.                  TXBELLS                    Y Y20130725
+111909676FST STAR BREMOND  FIRST STAR BANK-BREMOND, SSB        TXBREMOND                  Y Y20081204
+111909773BUCKHOLTS ST BK   BUCKHOLTS STATE BANK                TXBUCKHOLTS                Y N        
+111909870LAMAR NATL PARIS  LAMAR NATIONAL BANK                 TXPARIS                    Y N20131113
+111909993CITZ ST BK TYLER  CITIZENS STATE BANK                 TXTYLER                    Y Y20031024
+111910005FST STATE TEMPLE  FIRST STATE BANK CENTRAL TEXAS      TXTEMPLE                   Y N20131113
+111910092FIRST GROESBECK   FIRST NATIONAL BANK                 TXGROESBECK                Y N20040611
+111910160FST SEC CRANFILLS FIRST SECURITY STATE BANK           TXCRANFILLS GAP            Y Y19990709
+111910445GLADEWATER NATL   GLADEWATER NATIONAL BANK            TXGLADEWATER               Y Y19990707
+111910762TITAN BANK        TITAN BANK, N.A.                    TXMINERAL WELLS            Y Y20111031
+111911033FST HUGHES SPRINGSFIRST NAT BK OF HUGHES SPRINGS, THE TXHUGHES SPRINGS           Y N20140228
+111911091ST NATL IOWA PARK STATE NATIONAL BANK OF TEXAS        TXIOWA PARK                Y N20131114
+111911224JUSTIN STATE BANK JUSTIN STATE BANK                   TXJUSTIN                   Y Y        
+111911321F & M ST BK KRUM  FIRST UNITED BANK AND TRUST COMPANY TXKRUM                     Y N20130715
+111911965FARMERS NEWCASTLE FARMERS NATIONAL BANK               TXNEWCASTLE                N Y20101216
+111912090OGLESBY ST BK     OGLESBY STATE BANK                  TXOGLESBY                  Y N20070706
+111912197CITY CORSICANA    CITY NATIONAL BANK                  TXCORSICANA                YSN20100111
+111912388POWELL ST BK      POWELL STATE BANK                   TXPOWELL                   Y N        
+111912401CITIZENS-PRINCETONCITIZENS STATE BANK                 TXPRINCETON                Y N20020510
+111912498FIRST QUITMAN     BANK TEXAS, N.A.                    TXQUITMAN                  Y Y20050104
+111912605FIRST ST BK RICE  FIRST STATE BANK                    TXRICE                     N Y20000615
+111912744WOODHAVEN FTW     WOODHAVEN NATIONAL BANK             TXFORT WORTH               Y Y        
+111913170PANOLA CARTHAGE   PANOLA NATIONAL BANK                TXCARTHAGE                 Y N        
+111913248FIRST TOM BEAN    FIRST NATIONAL BANK                 TXTOM BEAN                 Y N        
+111913329FNB BOSQUE COUNTY FIRST NATIONAL BANK BOSQUE COUNTY   TXVALLEY MILLS             Y N20131114
+111913688PILGRIM BANK      PILGRIM BANK                        TXPITTSBURG                Y Y20030723
+111913879TEX HERTG CROSS PLTEXAS HERITAGE BANK                 TXBOERNE                   YSY20100108
+111914852UNITED CENTRAL BK UNITED CENTRAL BANK                 TXGARLAND                  Y Y20130906
+111914878FIRST EVANT       FIRST NATIONAL BANK                 TXEVANT                    Y N20050728
+111914881NORMANGEE ST      NORMANGEE STATE BANK                TXNORMANGEE                Y Y20070125
+111914917COMMUNITY LONGVIEWCOMMUNITY BANK                      TXLONGVIEW                 Y Y        
+111915039FARMERS GROESBECK FARMERS STATE BANK                  TXGROESBECK                Y Y        
+111915327GUARANTY BK NA    GUARANTY BANK AND TRUST , NA        TXMOUNT PLEASANT           Y N20140701
+111915770FIRST DUBLIN      FIRST NATIONAL BANK OF DUBLIN       TXDUBLIN                   Y N20090930
+111916180HERITAGE PEARLAND HERITAGE BANK                       TXPEARLAND                 Y Y20140710
+111916326INDP BK MCKINNEY  INDEPENDENT BANK                    TXMCKINNEY                 Y N20120126
+111916436LIBERTY BANK      LIBERTY BANK                        TXHURST                    Y Y20111209
+111916452FIRST WICH FALLS  FIRST NATIONAL BANK                 TXWICHITA FALLS            Y Y        
+111916588TEXANA BANK       TEXANA BANK, NATIONAL ASSOCIATION   TXLINDEN                   Y Y20090407
+111916656PEOPLES BK PARIS  PEOPLES BANK                        TXPARIS                    Y Y20090224
+111916698BANK OF DE SOTO   BANK OF DESOTO, N.A.                TXDESOTO                   Y N20131114
+111916724BK WEST IRVING    BANK OF THE WEST                    TXGRAPEVINE                Y Y20060713
+111916915RIVERBEND FTW     RIVERBEND BANK                      TXFORT WORTH               Y Y20020404
+111917309SANGER BK SANGER  SANGER BANK                         TXSANGER                   Y N20000405
+111920312ENNIS STATE BANK  ENNIS STATE BANK                    TXENNIS                    Y N20131119
+111920765CORSICANA NATL BK COMMUNITY NATIONAL BK & TR OF TEXAS TXCORSICANA                Y Y20080516
+111921777FST ST BK ATHENS  FIRST STATE BANK                    TXATHENS                   Y Y        
+111922132TX STAR BK LOTT   TEXAS STAR BANK, SSB                TXLOTT                     Y N20131119
+111922624CITZ BANK KILGORE CITIZENS BANK                       TXKILGORE                  Y Y        
+111923238TEXAS BK AND TR COTEXAS BANK AND TRUST COMPANY        TXLONGVIEW                 Y Y20130419
+111923607SOUTHSIDE BK TYLERSOUTHSIDE BANK                      TXTYLER                    Y Y        
+111923898FIRST ST MESQUITE FIRST STATE BANK                    TXMESQUITE                 Y N20131119
+111923937FIRST TX KILLEEN  FIRST TEXAS BANK                    TXKILLEEN                  Y Y        
+111924075STAR BANK OF TEXASSTAR BANK OF TEXAS                  TXFORT WORTH               Y N20121105
+111924237SOUTHSIDE BANK    SOUTHSIDE BANK                      TXFORT WORTH               Y N20090812
+111924282COMM NATL TEXARKANCOMMERCIAL NATIONAL BK OF TEXARKANA TXTEXARKANA                Y N20120320
+111924318VERITEX CMNTY BK  VERITEX COMMUNITY BANK              TXPLANO                    Y N20131001
+111924350KILGORE NATL BK   KILGORE NATIONAL BANK               TXKILGORE                  Y N20001120
+111924363HBANK TEX BEDFORD HBANK TEXAS                         TXGRAPEVINE                Y N20130115
+111924392FNB FRISCO        FIRST NATIONAL BANK OF OMAHA        TXFRISCO                   Y N20030808
+111924538TEXAS REPUBLIC BK TEXAS REPUBLIC BANK, N.A.           TXFRISCO                   Y N20100106
+111924619BANK SNB NA       BANK SNB NATIONAL ASSOCIATION       TXFRISCO                   Y N20131115
+111924622WORTHINGTON NATL  WORTHINGTON NATIONAL BANK           TXARLINGTON                Y N20021121
+111924651INDEPENDENT BK TX INDEPENDENT BANK OF TEXAS           TXIRVING                   Y N20030501
+111924716TRINITY BANK, N.A.TRINITY BANK, N.A.                  TXFORT WORTH               Y Y20030523
+111924800VINTAGE BANK      VINTAGE BANK                        TXWAXAHACHIE               Y N20050405
+111924994SOVEREIGN BANK    SOVEREIGN BANK                      TXDALLAS                   Y Y20070411
+111925032BANK OF THE OZARKSBANK OF THE OZARKS                  ARLITTLE ROCK              Y N20040819
+111925045NORTH TEXAS BANK  NORTH TEXAS BANK, N.A.              TXDECATUR                  YSN20130408
+111925113TEXAS BRAND BK    TEXAS BRAND BANK                    TXGARLAND                  Y Y20090317
+1119254301ST BANK TEXARKANA1ST BANK, NATIONAL ASSOCIATION      TXTEXARKANA                Y Y20140721
+111925472VISION BANK       VISION BANK TEXAS                   TXRICHARDSON               Y N20100305
+111925508MERIDIAN BANK     MERIDIAN BANK TEXAS                 TXFORT WORTH               Y N20091008
+111925744ACCESSBANK TEXAS  ACCESSBANK TEXAS                    TXDENTON                   Y N20111018
+111925935LIBERTY CAPITAL BKLIBERTY CAPITAL BANK                TXADDISON                  YSN20090504
+111993695FIRST COMMAND BK  FIRST COMMAND BANK                  TXFORT WORTH               Y Y20090421
+111993747SYNERGY BANK SSB  SYNERGY BANK,SSB                    TXMCKINNEY                 YSN20081021
+111993776WASH MUT BANK     JPMORGAN CHASE BANK, NA             WASEATTLE                  Y N20100127
+111994063TEXANS CU RICHARDSTEXANS CREDIT UNION                 TXRICHARDSON               Y N20070416
+111994115PROVIDENCE BK TX  PROVIDENCE BANK OF TEXAS, SSB       TXSOUTHLAKE                Y N20070831
+111994212SOUTHWEST SEC FSB SOUTHWEST SECURITIES, FSB           TXARLINGTON                Y N20100226
+112000011FRB EL PASO       FEDERAL RESERVE BANK                TXEL PASO                  Y N20131119
+112017619WESTSTAR BANK     WESTSTAR BANK                       TXEL PASO                  Y Y20120221
+112024437UNITED BK EL PASO UNITED BANK OF EL PASO DEL NORTE    TXEL PASO                  Y N20130726
+112093808CAP BK SSB EL PASOCAPITAL BANK, SSB                   TXEL PASO                  Y N20070105
+112200439FIRST AMERICAN    FIRST AMERICAN BANK                 NMARTESIA                  Y Y20090130
+112200620JAMES POLK STONE  THE JAMES POLK STONE COMMUNITY BANK NMPORTALES                 Y Y20100504
+112200824WESTERN LORDSBURG WESTERN BANK                        NMLORDSBURG                Y Y        
+112201085WEST COMM CARLSBADWESTERN COMMERCE BANK               NMCARLSBAD                 Y Y        
+112201218CITIZENS BK CLOVISCITIZENS BANK OF CLOVIS             NMCLOVIS                   Y N20131120
+112201289CITZ LAS CRUCES   CITIZENS BANK OF LAS CRUCES         NMLAS CRUCES               Y Y        
+112201797CARLSBAD NATL     CARLSBAD NATIONAL BANK              NMCARLSBAD                 Y Y        
+112201836LEA COUNTY ST BK  LEA COUNTY STATE BANK               NMHOBBS                    Y Y        
+112201959FIRST SOCORRO     FIRST STATE BANK                    NMSOCORRO                  Y Y        
+112201988WESTERN ARTESIA   WESTERN BANK                        NMARTESIA                  Y Y        
+112202123FIRST ALAMOGORDO  FIRST NATIONAL BANK                 NMALAMOGORDO               Y Y        
+112202372FIRST NM BANK     FIRST NEW MEXICO BANK               NMDEMING                   Y Y        
+112202660WESTERN CLOVIS    WESTERN BANK OF CLOVIS              NMCLOVIS                   Y N        
+112203038WESTERN ALAMOGORDOWESTERN BANK                        NMALAMOGORDO               Y N20131119
+112203122VALLEY ROSWELL    VALLEY BANK OF COMMERCE             NMROSWELL                  Y N20140623
+112206297BK SW ROSWELL     BANK OF THE SOUTHWEST               NMROSWELL                  Y N20131112
+112206763WESTERN HERITAGE  WESTERN HERITAGE BANK               NMLAS CRUCES               Y Y20090305
+112206776THE BANK OF CLOVISBANK OF CLOVIS, THE                 NMCLOVIS                   Y Y20110707
+112206792AMER HERITAGE CLOVAMERICAN HERITAGE BANK              NMCLOVIS                   Y Y20011217
+112304678W TEX N/B ALPINE  WEST TEXAS NATIONAL BANK            TXMIDLAND                  Y Y20030425
+112304733BIG BEND BKS MARFABIG BEND BANKS N.A.                 TXMARFA                    Y N20091015
+112307222FIRST STANTON     FIRST NATIONAL BANK                 TXSTANTON                  Y N        
+112311713NATL BK OF ANDREWSTHE NATIONAL BANK OF ANDREWS        TXANDREWS                  Y N20131115
+112319524FIRST FABENS      FIRST NATIONAL BANK                 TXFABENS                   Y Y20120201
+112320788TRANSPECOS BANKS  TRANSPECOS BANKS                    TXPECOS                    Y Y20090226
+112321318PECOS CNTY FT STOCPECOS COUNTY STATE BANK, THE        TXFORT STOCKTON            Y N20131115
+112321839WEST TEX BK ODESSAWEST TEXAS STATE BANK               TXODESSA                   Y N20131115
+112322508SOUTH W BK WINK   SOUTHWEST BANK                      TXODESSA                   Y N20020304
+112323086COMMERCIAL ST BK  COMMERCIAL STATE BANK               TXANDREWS                  Y Y        
+112323387FIRST FT STOCKTON FIRST NATIONAL BANK OF FORT STOCKTONTXFORT STOCKTON            Y Y20130930
+112323594TEJAS BANK        TEJAS BANK                          TXMONAHANS                 YSN20110909
+113000049FRB HOUSTON       FEDERAL RESERVE BANK                TXHOUSTON                  Y N20131115
+113000609JPMCHASE TEXAS    JPMORGAN CHASE BANK, NA             TXHOUSTON                  Y N20120607
+113000968WHITNEY BANK      WHITNEY BANK                        MSGULFPORT                 Y N20140423
+113001035OMNIBANK HOUSTON  OMNIBANK NATIONAL ASSOCIATION       TXHOUSTON                  Y Y20091103
+113001077CENTRAL HOUS      CENTRAL BANK                        TXHOUSTON                  Y Y        
+113001242UNITY NATL BK HOUSUNITY NATIONAL BANK OF HOUSTON      TXHOUSTON                  Y Y20120725
+113002296CAPITAL BK HOUSTONCAPITAL BANK                        TXHOUSTON                  Y Y20100811
+113003130WHITNEY BANK      WHITNEY BANK                        LANEW ORLEANS              N Y20110603
+113003392FIRST BANK TOMBALLREGIONS BANK                        TXTOMBALL                  N Y20030814
+113007835BANCO POPULAR NA  BANCO POPULAR NORTH AMERICA         TXHOUSTON                  Y N20040819
+113008083TRADITION BK ALAMOTRADITION BANK                      TXBELLAIRE                 Y Y20040525
+113008465WOODFOREST N/B HOUWOODFOREST NATIONAL BANK            TXHOUSTON                  Y Y20100225
+113009341HOUS COMMUNITY BK HOUSTON COMMUNITY BANK              TXHOUSTON                  Y Y        
+113010547COMPASS BANK N A  COMPASS BANK                        TXHOUSTON                  Y N20000718
+113011119STATE BK TX HOUS  THE STATE BANK OF TEXAS             TXHOUSTON                  Y N        
+113011245BNP PARIBAS HOUS  BNP PARIBAS, HOUSTON AGENCY         TXHOUSTON                  YSN20111129
+113011258AMEGY BANK, N.A.  AMEGY BANK, N.A.                    TXHOUSTON                  Y Y20050926
+113012163CHASEWOOD BK HOUS CHASEWOOD BANK                      TXHOUSTON                  Y Y19990730
+113012503EW BK SMRINO      EAST WEST BANK                      TXHOUSTON                  Y N20100422
+113015500GOLDEN BK NA HOUS GOLDEN BANK, NA                     TXHOUSTON                  Y Y20080421
+113017346EW BK SMRINO      EAST WEST BANK                      CAPASADENA                 Y N20140509
+113024009SW NATL BANK HOUS SOUTHWESTERN NATIONAL BANK          TXHOUSTON                  Y Y20100519
+113024096ENTERPRISE BK HOUSENTERPRISE BANK                     TXHOUSTON                  Y N19990520
+113024106AMER FST NATL HOUSAMERICAN FIRST NATIONAL BANK        TXHOUSTON                  Y Y19990623
+113024164GREEN BK HOUSTON  GREEN BANK, NATIONAL ASSOCIATION    TXHOUSTON                  Y Y20070921
+113024672BBVA BANCOMER,S.A.BBVA BANCOMER, S.A.                 TXHOUSTON                  Y Y20040116
+113024957POST OAK BANK     POST OAK BANK, N.A.                 TXHOUSTON                  Y N20131118
+113025147INDEPENDENT BANK  INDEPENDENT BANK                    TXHOUSTON                  Y Y20140417
+113025150PATRIOT BK HOUSTONPATRIOT BANK                        TXHOUSTON                  Y Y20110324
+113025231BK OF RIVER OAKS  BANK OF RIVER OAKS, THE             TXHOUSTON                  Y N20100106
+113025260TEXAS CITIZENS BK TEXAS CITIZENS BANK, N.A.           TXPASADENA                 Y Y20070216
+113025299LONE STAR BANK    LONE STAR BANK                      TXHOUSTON                  Y N20100415
+113025422ICON BANK         ICON BANK OF TEXAS, N.A.            TXHOUSTON                  Y N20070215
+113025480WESTBOUND BK KATY WESTBOUND BANK                      TXKATY                     Y N20070615
+113025723ALLEGIANCE BANK TXALLEGIANCE BANK TEXAS               TXHOUSTON                  Y Y20121228
+113025765BK OF NOVA SCOTIA THE BANK OF NOVA SCOTIA             TXHOUSTON                  Y N20120529
+113025804MEMORIAL CITY BANKMEMORIAL CITY BANK                  TXHOUSTON                  Y N20090813
+113025972MINT NATIONAL BANKMINT NATIONAL BANK, THE             TXKINGWOOD                 YSN20090115
+113093852WFBSC HOUSTON TX  WELLS FARGO BANK SOUTH CENTRAL, NA  TXHOUSTON                  YSN20101115
+113093881NIZARI PROGRESSIVENIZARI PROGRESSIVE FCU              TXHOUSTON                  Y N20110413
+113094136INTEGRITY BANK SSBINTEGRITY BANK, SSB                 TXHOUSTON                  Y Y20121226
+113094149THIRD COAST SSB   THIRD COAST BANK SSB                TXHUMBLE                   Y Y20091204
+113100091MOODY NATIONAL BK MOODY NATIONAL BANK                 TXGALVESTON                Y Y20030508
+113100570HOMETOWN BK GALVESHOMETOWN BANK, N.A.                 TXGALVESTON                Y Y19990519
+113101317FIRST LK JACKSON  FIRST NATIONAL BANK                 TXLAKE JACKSON             Y Y        
+113101524BK OF SAN JACINTO BANK OF SAN JACINTO COUNTY          TXCOLDSPRING               Y Y        
+113102714COMM NACOGDOCHES  COMMERCIAL BANK OF TEXAS, N.A.      TXNACOGDOCHES              Y Y        
+113103276AUSTIN BK NA JACKSAUSTIN BANK, TEXAS NATIONAL ASSN    TXJACKSONVILLE             Y Y20091007
+113103687FIRST BELLVILLE   FIRST NATIONAL BK OF BELLVILLE, THE TXBELLVILLE                Y Y20130827
+113103797VISTA BANK TEXAS  COMMUNITYBANK OF TEXAS, NA          TXBEAUMONT                 Y N20130823
+113104000FIRST HUNTSVILLE  FIRST NATIONAL BANK OF HUNTSVILLE   TXHUNTSVILLE               Y Y20111118
+113104275CITIZENS STATE BK CITIZENS STATE BANK                 TXSEALY                    Y Y        
+113104534NBT LA GRANGE     NATIONAL BANK & TRUST               TXLA GRANGE                Y Y20050728
+113104712FNB OF GIDDINGS   FIRST NAT'L BANK OF GIDDINGS        TXGIDDINGS                 Y Y        
+113104796FIRST EL CAMPO    NEWFIRST NATIONAL BANK              TXEL CAMPO                 Y Y20021018
+113104806COM ST BK EL CAMPOCOMMERCIAL STATE BANK               TXEL CAMPO                 Y Y20130830
+113104880FIRST EAGLE LAKE  FIRST NATIONAL BANK OF EAGLE LAKE   TXEAGLE LAKE               Y Y20100621
+113105070FARMERS ST CENTER FARMERS STATE BANK                  TXCENTER                   Y N20131118
+113105452FIRST LIVINGSTON  FIRST NATIONAL BK OF LIVINGSTON, THETXLIVINGSTON               Y Y20101215
+113105465FST ST BK LIVINGSTFIRST STATE BANK                    TXLIVINGSTON               Y Y        
+113106532LOVELADY STATE BK LOVELADY STATE BANK                 TXLOVELADY                 YSN20060929
+113106833FIRST SHINER      FIRST NATIONAL BANK OF SHINER, THE  TXSHINER                   Y N20120131
+113106985SPIRIT OF TEXAS BKSPIRIT OF TEXAS BANK, SSB           TXCOLLEGE STATION          Y N20081119
+113107162ANAHUAC NATL      ANAHUAC NATIONAL BANK               TXANAHUAC                  Y N20131108
+113109128SEC STATE ANAHUAC SECURITY STATE BANK                 TXANAHUAC                  Y N        
+113109131FIRST ANDERSON    FIRST NATIONAL BANK                 TXANDERSON                 Y N        
+113109377FIRST ST BEDIAS   FIRST STATE BANK OF BEDIAS          TXBEDIAS                   Y Y19990618
+113109830BURTON STATE BK   BURTON STATE BANK                   TXBURTON                   Y N20050224
+113109898CARMINE STATE BK  CARMINE STATE BANK                  TXCARMINE                  Y N        
+113109982CHAPPELL HILL BK  CHAPPELL HILL BANK                  TXCHAPELL HILL             Y N        
+113110256TEXAS FIRST BANK  TEXAS FIRST BANK                    TXTEXAS CITY               Y Y20110512
+113110641CITIZENS ST GANADOCITIZENS STATE BANK                 TXGANADO                   YSN20100727
+113110654BRAZO N/B RICHWOODBRAZOS NATIONAL BANK                TXRICHWOOD                 Y N20120606
+113110984CITIZENS CROCKETT CITIZENS NATIONAL BANK              TXCROCKETT                 Y Y        
+113111077INDUSTRY STATE BK INDUSTRY STATE BANK                 TXINDUSTRY                 Y Y        
+113111983COMMUNITYBANK TX  COMMUNITYBANK OF TEXAS, NA          TXBEAUMONT                 Y Y20130823
+113113392WALLIS ST BK      WALLIS STATE BANK                   TXWALLIS                   Y Y        
+113113868FIRST NATL TRINITYFIRST NATIONAL BANK                 TXTRINITY                  YSY20100305
+113114294ELKHART STATE BK  ELKHART STATE BANK                  TXELKHART                  Y N        
+113114647FST BK NA         FIRST BANK, NATIONAL ASSOCIATION    TXCONROE                   Y Y20120703
+113114896ROUND TOP STATE   ROUND TOP STATE BANK                TXROUND TOP                Y Y        
+113115235FST ST COLUMBUS   FIRST STATE BANK                    TXCOLUMBUS                 Y Y        
+113115484TEX GULF FREEPORT TEXAS GULF BANK, N.A.               TXFREEPORT                 Y Y20050728
+113115617TEX NATL JAX      TEXAS NATIONAL BANK                 TXJACKSONVILLE             Y Y20110128
+113116056FIRST LIBERTY NATLFIRST LIBERTY NATIONAL BANK         TXLIBERTY                  Y Y        
+113117194BRENHAM NATL BANK BRENHAM NATIONAL BANK, THE          TXBRENHAM                  Y Y20100106
+113117411FAYETTEVILLE BK   FAYETTEVILLE BANK                   TXFAYETTEVILLE             Y Y19990604
+113118342CITIZEN ST CORR   CITIZENS STATE BANK                 TXCORRIGAN                 Y Y        
+113119105CIT ST WOODVILLE  CITIZENS STATE BANK                 TXWOODVILLE                Y Y        
+113119396CITIZENS 1ST BK   CITIZENS 1ST BANK                   TXTYLER                    Y Y20121002
+113119985LONE STAR MOULTON LONE STAR BANK, SSB                 TXMOULTON                  Y N20040804
+113120291PEOPLES ST-SHEP   PEOPLES STATE BANK                  TXSHEPHERD                 Y N20131112
+113121135TX ST JOAQUIN     TEXAS STATE BANK                    TXLUFKIN                   Y Y20100811
+113121384PEO ST HALLETSVIL PEOPLES STATE BANK OF HALLETTSVILLE TXHALLETTSVILLE            Y N20131108
+113122082FST ST-HEMPHILL   FIRST STATE BANK                    TXHEMPHILL                 N Y19990603
+113122655PROSPERITY BK ELCAPROSPERITY BANK                     TXEL CAMPO                 Y Y20010720
+113122804FIRST B&T DIBOLL  FIRST BANK AND TRUST EAST TEXAS     TXDIBOLL                   Y Y20091204
+113123065FST ST LOUISE     FIRST STATE BANK                    TXLOUISE                   Y Y        
+113123162FIRST PORT LAVACA FIRST NATIONAL BANK                 TXPORT LAVACA              Y Y        
+113123366FIRST ST BK CLUTE FIRST STATE BANK                    TXCLUTE                    Y Y        
+113123528BRIDGE CI ST BRIDGBRIDGE CITY STATE BANK              TXBRIDGE CITY              Y N20000406
+113123560FIRST JASPER      FIRST NATIONAL BANK OF JASPER       TXJASPER                   Y Y20110804
+113123573PEARLAND STATE BK PEARLAND STATE BANK                 TXPEARLAND                 Y Y        
+113123654FIRST ALVIN       FIRST NATIONAL BANK                 TXALVIN                    Y Y        
+113123667HUNTINGTON ST BK  HUNTINGTON STATE BANK               TXHUNTINGTON               Y Y        
+113124446BK OF BRENHAM     BANK OF BRENHAM NA                  TXBRENHAM                  Y N20010731
+113125254BK AND TR BRY-COLLBANK AND TRUST OF BRYAN-COLLEGE STATTXBRYAN                    Y Y20100108
+113125380FIRST COMM BK , NAFIRST COMMUNITY BANK, N.A.          TXSUGAR LAND               Y Y20090708
+113125445TX ADVANTAGE COMM TEXAS ADVANTAGE COMMUNITY BANK, NA  TXALVIN                    YSN20061222
+113125995AMER MOMENTUM BK  AMERICAN MOMENTUM BANK              TXCOLLEGE STATION          Y N20120619
+113193532CITIBANK          CITIBANK, N.A.                      TXBRYAN                    Y N20120622
+113194162TEXAN BANK        TEXAN BANK                          TXSUGAR LAND               Y N20110721
+113194256BK & TR OF BRYAN  BANK AND TRUST OF BRYAN-COLLEGE STATTXBRYAN                    Y N20100219
+114000093FROST BK          FROST BANK                          TXSAN ANTONIO              Y Y20120622
+114000653BOA MILITARY SANT BANK OF AMERICA, N.A., MILITARY BANKTXSAN ANTONIO              Y N20031113
+114000721FRB SAN ANTONIO   FEDERAL RESERVE BANK                TXSAN ANTONIO              Y Y20001026
+114000763JEFFERSON BK SA   JEFFERSON BANK                      TXSAN ANTONIO              Y Y20070309
+114011011TEXAS COMNTY BK   TEXAS COMMUNITY BANK                TXLAREDO                   Y N20131223
+114017112TEXSTAR NATL BANK TEXSTAR NATIONAL BANK               TXUNIVERSAL CITY           Y N20040120
+114017714BOA MILITARY OVSEABANK OF AMERICA MILITARY BK OVERSEASTXSAN ANTONIO              Y N20060417
+114021933BROADWAY SA       BROADWAY NATIONAL BANK              TXSAN ANTONIO              Y Y        
+114024260LONE STAR CAP BKNALONE STAR CAPITAL BANK, NA          TXSAN ANTONIO              Y Y20121002
+114025641BK SAN ANTONIO TX BANK OF SAN ANTONIO, THE            TXSAN ANTONIO              Y N20100106
+114900164TX COMP AUSTIN    TEXAS COMPTROLLER OF PUBLIC ACCOUNTSTXAUSTIN                   Y Y        
+114900313LAREDO NATL       COMPASS BANK                        TXLAREDO                   Y N20081229
+114901370BANK & TRUST SSB  BANK & TRUST, SSB, THE              TXDEL RIO                  Y Y20090303
+114901859CITY NATL TAYLOR  CITY NATIONAL BANK OF TAYLOR, THE   TXTAYLOR                   Y Y20131104
+114902227YOAKUM NATL       YOAKUM NATIONAL BANK                TXYOAKUM                   Y Y        
+114902405FIRST ST UVALDE   FIRST STATE BANK                    TXUVALDE                   Y Y        
+114902528IBC LAREDO        INTERNATIONAL BANK OF COMMERCE      TXSAN ANTONIO              Y Y        
+114902560VALUEBANK TEX CC  VALUEBANK TEXAS                     TXCORPUS CHRISTI           Y Y        
+114902683FST ST SAN DIEGO  FIRST STATE BANK OF SAN DIEGO       TXSAN DIEGO                Y N20000809
+114902777FIRST BEEVILLE    FIRST NATIONAL BANK                 TXBEEVILLE                 Y Y        
+114903103FIRST TX GEORGETWNFIRST TEXAS BANK                    TXGEORGETOWN               Y Y        
+114903174HONDO NATL        HONDO NATIONAL BANK, THE            TXHONDO                    Y Y20130514
+114903213FIRST LOCKHART    FIRST-LOCKHART NATIONAL BANK        TXLOCKHART                 Y Y        
+114903284AMERICAN BK N.A   AMERICAN BANK, N.A.                 TXCORPUS CHRISTI           Y Y        
+114904461STOCKMENS COTULLA STOCKMENS NATIONAL BANK             TXCOTULLA                  Y N        
+114904775SEC ST PEARSALL   SECURITY STATE BANK                 TXPEARSALL                 Y Y        
+114904953FIRST BASTROP     FIRST NATIONAL BANK                 TXBASTROP                  Y Y        
+114905499COMMERCIAL MASON  COMMERCIAL BANK                     TXMASON                    Y Y        
+114905509MASON BANK        MASON BANK                          TXMASON                    Y Y20130103
+114907387FST ST BURNET     FIRST STATE BANK OF BURNET          TXBURNET                   Y Y20000809
+114907633CITIZENS ST ROMA  CITIZENS STATE BANK                 TXROMA                     Y Y19990714
+114908289FIRST COMMUNITY BKFIRST COMMUNITY BANK, NA            TXSAN BENITO               Y Y20100225
+114909013COMPASS BK MCALLENCOMPASS BANK                        TXMCALLEN                  Y N20081017
+114909165COMMUNITY HONDO   COMMUNITY NATIONAL BANK             TXHONDO                    Y N20131107
+114909482BLANCO NATL       BLANCO NATIONAL BANK                TXBLANCO                   Y Y        
+114909903COMMERCE LAREDO   COMMERCE BANK                       TXLAREDO                   N Y20130130
+114910222ZAVALA COUNTY BK  ZAVALA COUNTY BANK                  TXCRYSTAL CITY             Y N        
+114910523FALLS CITY NATL   FALLS CITY NATIONAL BANK            TXFALLS CITY               Y N20131107
+114910565UNION ST FLORENCE UNION STATE BANK                    TXFLORENCE                 Y Y19990714
+114910918ATASCOSA PLEASANTNATASCOSA NATIONAL BANK              TXPLEASANTON               YSN20090729
+114911234KARNES CO NATL    KARNES COUNTY NATIONAL BANK         TXKARNES CITY              YSY20090202
+114911506LLANO NATIONAL    LLANO NATIONAL BANK                 TXLLANO                    Y Y        
+114911519LYTLE ST BK       LYTLE STATE BANK                    TXLYTLE                    Y Y19990729
+114911577MARION ST BK      MARION STATE BANK                   TXMARION                   Y N20000411
+114911580IBC BROWNSVILLE   INTERNATIONAL BANK OF COMMERCE      TXBROWNSVILLE              N Y20030718
+114911687LONE STAR PHARR   LONE STAR NATIONAL BANK             TXPHARR                    Y Y        
+114911807FIRST ALICE       FIRST COMMUNITY BANK                TXCORPUS CHRISTI           Y N20020621
+114912013HERITAGE NIXON    HERITAGE BANK                       TXPEARLAND                 N Y20140723
+114912220FIRST COMM SEGUIN FIRST COMMERCIAL BANK, N.A.         TXSEGUIN                   Y Y        
+114912479FIRST SO PADRE IS FIRST NATIONAL BANK OF SOUTH PADRE ITXSOUTH PADRE ISLAND       YSY20061012
+114912589VANTAGE BANK TX   VANTAGE BANK TEXAS                  TXSAN ANTONIO              Y Y20130806
+114912686KLEBERG BANK, N.A.KLEBERG BANK, N.A.                  TXKINGSVILLE               Y Y20140709
+114913711SAGE CAPITAL      SAGE CAPITAL BANK, N.A.             TXGONZALES                 Y Y20090714
+114914710FIRST WOODSBORO   FIRST NATIONAL BANK OF WOODSBORO    TXWOODSBORO                Y Y20000809
+114914723BANK OF ALICE     TEXAS CHAMPION BANK                 TXALICE                    Y N20050513
+114914817SCHWERTNER ST BK  SCHWERTNER STATE BANK               TXSCHWERTNER               Y N        
+114915272INTL BK MCALLEN   INTER NATIONAL BANK                 TXMCALLEN                  Y Y        
+114915447RIO NATL MCALLEN  RIO BANK                            TXMCALLEN                  Y Y20020603
+114915803FALCON NB LAREDO  FALCON INTERNATIONAL BANK           TXLAREDO                   Y Y20000216
+114916019FIRST HEBBRONVILLEFIRST NATL BANK OF HEBBRONVILLE, THETXHEBBRONVILLE             Y N20131108
+114916103SCHERTZ B&T       SCHERTZ BANK & TRUST                TXSCHERTZ                  N Y19991021
+114916488CITZ STATE LULING CITIZENS STATE BANK                 TXLULING                   Y N20131107
+114916705FST ST THREE RIVERFIRST STATE BANK                    TXTHREE RIVERS             Y N        
+114917092LONE STAR CAPITAL LONE STAR CAPITAL BANK, NA          TXSAN ANTONIO              Y N20130830
+114917157BK OF SOUTH TX    BANK OF SOUTH TEXAS                 TXMCALLEN                  Y N20140407
+114917267D HANIS ST BK     D HANIS STATE BANK                  TXHONDO                    Y N20010206
+114917335TEXAS REGIONAL BK TEXAS REGIONAL BANK                 TXFALFURRIAS               Y Y20131118
+114917814ABC AUSTIN        AMERICAN BANK OF COMMERCE           TXAUSTIN                   Y N20031023
+114917908SOUTHTRUST BK, N.ASOUTHTRUST BANK, N.A.               TXGEORGE WEST              Y N20131119
+114917924FIRST ST YOAKUM   FIRST STATE BANK                    TXYOAKUM                   Y N20131107
+114918305BANDERA BK        BANDERA BANK                        TXBANDERA                  Y N        
+114919281GRANGER NATL      GRANGER NATIONAL BANK               TXGRANGER                  Y N        
+114919676FIRST FALFURRIAS  FIRST NATIONAL BANK                 TXFALFURRIAS               Y Y        
+114920128TEXAS NB  MERCEDESTEXAS NATIONAL BANK                 TXMERCEDES                 Y Y20071108
+114920526ARROWHEAD BK LLANOARROWHEAD BANK                      TXLLANO                    Y N20131108
+114921415PLAINSCAPITAL BANKPLAINSCAPITAL BANK                  TXDALLAS                   Y N20140214
+114921622COMMERCE BANK TEXACOMMERCE BANK TEXAS                 TXSTOCKDALE                Y N20080916
+114921761JUNCTION NATL     JUNCTION NATIONAL BANK              TXJUNCTION                 Y Y19991027
+114921949SECURITY ST FRED  SECURITY STATE BANK & TRUST         TXFREDERICKSBURG           Y Y        
+114921981JOURDANTON ST BK  JOURDANTON STATE BANK               TXJOURDANTON               Y Y20000309
+114922090JOHNSON CITY BK   JOHNSON CITY BANK                   TXJOHNSON CITY             Y N        
+114922142ELSA ST B&T       ELSA STATE BANK & TRUST CO          TXELSA                     Y Y        
+114922265FST ST ODEM       FIRST STATE BANK OF ODEM            TXODEM                     Y Y20101102
+114922430FIRST ST JUNCTION FIRST STATE BANK                    TXJUNCTION                 Y N20000405
+114922443PIONEER BANK SSB  PIONEER BANK, SSB                   TXKERRVILLE                YSN20140530
+114923329BRUSH COUNTRY BANKBRUSH COUNTRY BANK                  TXFREER                    Y Y20081125
+114923756ZAPATA NATL       ZAPATA NATIONAL BANK                TXZAPATA                   Y N20120412
+114923976CHARTER BK CC     CHARTER BANK                        TXCORPUS CHRISTI           Y Y20011105
+114924690CAPITAL BANK OF TXCAPITAL BANK OF TEXAS               TXCARRIZO SPRINGS          Y N20030509
+114924700FARM CREDIT BK, TXFARM CREDIT BANK OF TEXAS           TXAUSTIN                   Y Y20090302
+114924810TEXAS COMM BK     TEXAS COMMUNITY BANK                TXSOMERSET                 Y N20140220
+114925615BUSINESS BK TX    BUSINESS BANK OF TEXAS, N.A.        TXAUSTIN                   YSN20140530
+114925864GUADALUPE NAT BK  GUADALUPE NATIONAL BANK             TXKERRVILLE                YSN20080320
+114994031LIBERTAD BANK, SSBLIBERTAD BANK, SSB                  TXAUSTIN                   Y N20090703
+114994109PIONEER BANK SSB  PIONEER BANK, SSB                   TXDRIPPING SPRINGS         N Y20091030
+116312873COMMUNITY MIDLAND COMMUNITY NATIONAL BANK             TXMIDLAND                  Y Y        
+116324201FIRSTCAPITAL BANK FIRSTCAPITAL BANK OF TEXAS, N.A.    TXMIDLAND                  Y N20100720
+121000248WELLS FARGO NA    WELLS FARGO BANK, NA                CASAN FRANCISCO            Y Y20140203
+121000358BK AM SF          BANK OF AMERICA, N.A., CA           CASAN FRANCISCO            Y N20060626
+121000374FRB SF            FEDERAL RESERVE BK OF SAN FRANCISCO CASAN FRANCISCO            Y Y        
+121000701FHLB SF           FEDERAL HOME LOAN BANK OF SF        CASAN FRANCISCO            Y N20060330
+121001865EW BK SMRINO      EAST WEST BANK                      CASAN FRANCISCO            Y N20100422
+121002042CA BK TR SF       CALIFORNIA BANK AND TRUST           CASAN FRANCISCO            Y N20031008
+121027234BANQ NATL PARIS SFBNP PARIBAS                         CASAN FRANCISCO            YSN20030409
+121029672BK ORIENT SF      BANK OF THE ORIENT                  CASAN FRANCISCO            Y Y        
+121031673AM CA BK SF       AMERICA CALIFORNIA BANK             CASAN FRANCISCO            Y Y        
+121032274SHANG COML BK SF  SHANGHAI COMMERCIAL BANK, LTD (SF)  CASAN FRANCISCO            Y N        
+121036843LIUCH BK SF       LIU CHONG HING BANK, LTD            CASAN FRANCISCO            Y N        
+121037240HANMI BANK        HANMI BANK                          CASAN FRANCISCO            Y N20040809
+121037266BK INDIA SF       BANK OF INDIA                       CASAN FRANCISCO            YSN        
+121038265CA PAC BK SF      CALIFORNIA PACIFIC BANK             CASAN FRANCISCO            Y Y        
+121038773MISS NATL BK SF   MISSION NATIONAL BANK               CASAN FRANCISCO            Y Y        
+121039484NAN COML BK LTD SFNANYANG COMMERCIAL BANK, LTD        CASAN FRANCISCO            Y N        
+121040169BK GUAM SF        BANK OF GUAM                        CASAN FRANCISCO            Y N20061207
+121040350CTBC USA LA CA    CTBC BANK CORP (USA)                CALOS ANGELES              YSN20130822
+121040554BK E ASIA USA NCR INDUSTRIAL & COMM. BNK OF CHINA, NA CASAN FRANCISCO            Y N20121220
+121040651TRAN PAC NATL SF  TRANS PACIFIC NATIONAL BANK         CASAN FRANCISCO            Y Y        
+121041210CATALYST CORP FCU CATALYST CORPORATE FCU              CASAN DIMAS                Y N20130322
+121042222NORTHERN TR CA SF THE NORTHERN TRUST COMPANY          ILCHICAGO                  Y N20120913
+121042484PAC COS BKRS BK SFPACIFIC COAST BANKERS' BANK         CAWALNUT CREEK             Y Y20120731
+121044055BK OF SAN FRAN    BANK OF SAN FRANCISCO               CASAN FRANCISCO            Y N20050801
+121044356PRESIDIO BANK SF  PRESIDIO BANK                       CASAN FRANCISCO            Y N20060724
+121044369NEW RESOURCE BK SFNEW RESOURCE BANK                   CASAN FRANCISCO            Y Y20140429
+121045009JPMORGAN BT CO SF JPMORGAN BANK & TRUST CO, NA        CASAN FRANCISCO            Y Y20080818
+121045106FST REP BK SF     FIRST REPUBLIC BANK                 CASAN FRANCISCO            Y Y20100701
+121045122BLACKROCK INST TR BLACKROCK INSTITUTIONAL TRUST CO NA CASAN FRANCISCO            Y N20100614
+121081872STERLING SVGS B&T STERLING BANK & TRUST, F.S.B.       MISOUTHFIELD               Y N20100412
+121100782BK WEST WAL CRK   BANK OF THE WEST (CA)               CASAN RAMON                Y Y20120316
+121101037BK STOC STOC      BANK OF STOCKTON                    CASTOCKTON                 Y Y        
+121101189FST NB NO CA SO SFFIRST NATIONAL BANK OF NORTHERN CA  CACOLMA                    Y Y20130830
+121101985EXCH BK S ROSA    EXCHANGE BANK                       CASANTA ROSA               Y Y        
+121102036MECH BK RICH      MECHANICS BANK                      CARICHMOND                 Y Y20080724
+121104063SVGS BK MENCNTY UKSAVINGS BANK OF MENDOCINO COUNTY    CAUKIAH                    Y Y        
+121105156FST NO BK DIXON   FIRST NORTHERN BANK OF DIXON        CADIXON                    Y Y        
+121106252SCO VLY BK YRE    SCOTT VALLEY BANK                   CAYREKA                    Y Y        
+121106540BK RIO VISTA      BANK OF RIO VISTA                   CARIO VISTA                Y Y        
+121106906SVGS BK MENCNTY UKSAVINGS BANK OF MENDOCINO COUNTY    CAWILLITS                  Y N20101206
+121107882FRE BK FRE        FREMONT BANK                        CAFREMONT                  Y Y        
+121108441F M BK LODI       FARMERS & MERCHANTS BK OF CENTRAL CACALODI                     Y Y20050718
+121109518MERC NATL BK SAC  MERCHANTS NATIONAL BK OF SACRAMENTO CASACRAMENTO               Y Y20050919
+121122676US BK CA AUB      US BANK, NA                         ORPORTLAND                 Y N20130221
+121125660BK AGR COMRCE STOCBANK OF AGRICULTURE AND COMMERCE    CASTOCKTON                 Y Y        
+121132394NO VLY BK REDD    NORTH VALLEY BANK                   CAREDDING                  Y Y        
+121132682EW BK SMRINO      EAST WEST BANK                      CASAN JOSE                 Y N20100422
+121132983DELTA BANK MAN    DELTA BANK, NA                      CAMANTECA                  Y Y20070828
+121133416RIV CITY BK SAC   RIVER CITY BANK                     CASACRAMENTO               Y Y        
+121135045TRI CNTY BK CHICO TRI COUNTIES BANK                   CACHICO                    Y Y        
+121135773HERITAGE BK COMM  HERITAGE BANK OF COMMERCE           CALOS ALTOS                Y N20030219
+121136785UMPQUA BANK       UMPQUA BANK                         CAYUBA CITY                Y N20040727
+121136808MONT CNTY BK MONT MONTEREY COUNTY BANK                CAMONTEREY                 Y Y20130827
+121137027BK SIERRA PORTER  BANK OF THE SIERRA                  CAPORTERVILLE              Y Y        
+121137522COMERICA SCO VLY  COMERICA BANK                       CASAN JOSE                 Y N20040615
+121137726CNTRL VAL COM BK  CENTRAL VALLEY COMMUNITY BANK       CAFRESNO                   Y Y20080118
+121137797BOSTON PRIV BOREL BOSTON PRIVATE BOREL                CASAN MATEO                Y N20120329
+121138013FST REP BK SF     FIRST REPUBLIC BANK                 CAWALNUT CREEK             Y N20100630
+121138288PLUMAS BK QUIN    PLUMAS BANK                         CAQUINCY                   Y N20140617
+121138958SUMM BK OAK       SUMMIT BANK                         CAOAKLAND                  Y Y        
+121138990BBCN BANK         BBCN BANK                           CAOAKLAND                  Y N20111205
+121139122LIB BK SO SF      LIBERTY BANK (CA)                   CASOUTH SAN FRANCISCO      Y Y        
+121139287RED BK COMRCE REDDREDDING BANK OF COMMERCE            CAREDDING                  Y Y        
+121139685UNION LA AKA UBOC MUFG UNION BANK, NA                 CAMORGAN HILL              Y N20140630
+121139711COM BK CENT CA SALRABOBANK, NA                        CASALINAS                  Y N20061025
+121140218WEST AM BK SUI CY WESTAMERICA BANK                    CASUISUN CITY              Y Y20010731
+121140263AM RVR BK SAC     AMERICAN RIVER BANK                 CASACRAMENTO               Y Y        
+121140276WSTRN SIE BK PLAC UMPQUA BANK                         CACAMERON PARK             Y N20060816
+121140331BK AMADOR AM RIVERAMERICAN RIVER BANK                 CAJACKSON                  Y N20041210
+121140399SIL VLY BK SCLA   SILICON VALLEY BANK                 CASANTA CLARA              Y Y        
+121140713UNION LA AKA UBOC MUFG UNION BANK, NA                 CASALINAS                  Y N20140630
+121140742UNION LA AKA UBOC MUFG UNION BANK, NA                 CAHOLLISTER                Y N20140630
+121140823LK COMM BK LK     UMPQUA BANK                         CALAKEPORT                 Y N20060623
+121140933UMPQUA BANK       UMPQUA BANK                         CARED BLUFF                Y N20040727
+121141042VINT BK NAPA      UMPQUA BANK                         CANAPA                     Y N20070529
+121141107UMPQUA BANK       UMPQUA BANK                         CASANTA ROSA               Y N20140418
+121141343METRO OAK         METROPOLITAN BANK                   CAOAKLAND                  Y Y        
+121141398GOLDEN PACIFIC BK GOLDEN PACIFIC BANK, NA             CAMARYSVILLE               Y N20130325
+121141482RABOBANK NA       RABOBANK, NA                        CASTOCKTON                 Y N20110628
+121141495UTD SEC FRES      UNITED SECURITY BANK                CAFRESNO                   Y Y20040427
+121141738SIX RIVERS BANK   NORTH VALLEY BANK                   CAEUREKA                   Y N20040915
+121141754MEGA ICBC SJ      MEGA INTERNATIONAL COMMERCIAL BANK  CASAN JOSE                 Y N20070620
+121141819UMPQUA BK         UMPQUA BANK                         CAEUREKA                   Y N20041109
+121141822BK OF AMERICA CA  BANK OF AMERICA CALIFORNIA, NA      CAWALNUT CREEK             YSN20110324
+121141877BK MARIN NOV      BANK OF MARIN                       CACORTE MADERA             Y Y20140307
+121141974NO COAST BK S ROSAAMERICAN RIVER BANK                 CAWINDSOR                  Y N20040217
+121142025RABOBANK NA       RABOBANK, NA                        CAPARADISE                 Y N20110919
+121142119OAK VLY COMM OAKD OAK VALLEY COMMUNITY BANK           CAOAKDALE                  Y N20130325
+121142258GBC INTL BANK LA  GBC INTERNATIONAL BANK              CAMONTEREY PARK            Y N20111021
+121142287HER BK COMRCE SJ  HERITAGE BANK OF COMMERCE           CASAN JOSE                 Y Y        
+121142313TRI CNTY BK CHICO TRI COUNTIES BANK                   CANEVADA CITY              Y N20120323
+121142397VALLEY BUSINESS BKVALLEY BUSINESS BANK                CAVISALIA                  Y N20130325
+121142407COMM BK OF BAY OAKCOMMUNITY BANK OF THE BAY           CAOAKLAND                  Y Y20100907
+121142517CENTRAL CA BK SON UMPQUA BANK                         CASONORA                   Y N20060726
+121142627AUBURN COMM BK AUBUMPQUA BANK                         CAAUBURN                   Y N20060602
+121142698MODESTO COMRCE BK BANK OF STOCKTON                    CAMODESTO                  Y N20070702
+121142737VLY COMM BK PLEAS VALLEY COMMUNITY BANK               CAPLEASANTON               Y Y19991005
+121142766NVB BUSINESS BANK NORTH VALLEY BANK                   CAWOODLAND                 Y N20061030
+121142779BK SAC SAC        BANK OF SACRAMENTO                  CASACRAMENTO               Y Y        
+121142818BBCN BANK         BBCN BANK                           CASUNNYVALE                Y N20111205
+121142850HERITAGE BK COMM  HERITAGE BANK OF COMMERCE           CASAN JOSE                 Y N20030110
+121142902UMPQUA BANK       UMPQUA BANK                         CAROSEVILLE                Y N20040727
+121143037FIVE STAR BK ROCK FIVE STAR BANK                      CAROCKLIN                  Y N19991220
+121143040HERITAGE BK COMM  HERITAGE BANK OF COMMERCE           CAMORGAN HILL              Y N20030110
+121143118SOLANO BK VINTAGE UMPQUA BANK                         CANAPA                     Y N20070426
+121143260BRIDGE BK SV SCLA BRIDGE BANK, NA                     CASANTA CLARA              Y Y20130416
+121143273PREMIER VLY BK FREPREMIER VALLEY BANK                 CAFRESNO                   Y Y20010601
+121143312HANMI BANK        HANMI BANK                          CASANTA CLARA              Y N20040809
+121143383RABOBANK NA       RABOBANK, NA                        CANAPA                     Y N20100910
+121143529LEGACY BANK NA    UNITED SECURITY BANK                CACAMPBELL                 Y N20070215
+121143532HANMI BANK        HANMI BANK                          CASANTA CLARA              Y N20030211
+121143558UNITED AMERICAN BKUNITED AMERICAN BANK                CASAN MATEO                Y N20030609
+121143626AVIDBANK          AVIDBANK                            CAPALO ALTO                Y Y20110331
+121143697DIABLO VALLEY BANKHERITAGE BANK OF COMMERCE           CADANVILLE                 Y N20070621
+121143736SANTA CRUZ COUNTY SANTA CRUZ COUNTY BANK              CASANTA CRUZ               Y N20040203
+121143752REDWOOD CAPITAL BKREDWOOD CAPITAL BANK                CAEUREKA                   Y N20051118
+121143781BAY COMMERCIAL BK BAY COMMERCIAL BANK                 CAWALNUT CREEK             Y N20040720
+121143794MOTHER LODE BK    MOTHER LODE BANK                    CASONORA                   Y N20040730
+121143833PACIFIC VLY BK CA PACIFIC VALLEY BANK                 CASALINAS                  Y Y20090113
+121143891FST COMMUNITY BK  FIRST COMMUNITY BANK                CASANTA ROSA               Y N20050128
+121143985PAN PAC BK FREMONTPAN PACIFIC BANK                    CAFREMONT                  Y N20060403
+121144078TRI-VALLEY BK     TRI-VALLEY BANK                     CASAN RAMON                Y Y20110629
+121144146FRESNO FIRST BK   FRESNO FIRST BANK                   CAFRESNO                   Y N20051223
+121144191COMM BUS BK W SAC COMMUNITY BUSINESS BANK             CAWEST SACRAMENTO          Y N20090804
+121144201COMM 1ST BK ROSEVLCOMMUNITY 1ST BANK                  CAAUBURN                   Y Y20091007
+121144269N CA NAT BK CHICO NORTHERN CALIFORNIA NATIONAL BANK   CACHICO                    Y N20060329
+121144272GOLDN VLY BK CHICOGOLDEN VALLEY BANK                  CACHICO                    Y Y20110110
+121144285BANK OF NAPA      BANK OF NAPA, NA                    CANAPA                     Y N20140516
+121144311ALTAPACIFIC BK CA ALTAPACIFIC BANK                    CASANTA ROSA               Y N20130326
+121144463TORREY PINES BK   TORREY PINES BANK                   CAOAKLAND                  Y N20101231
+121144476CS COM BK RED BLF CORNERSTONE COMMUNITY BANK          CARED BLUFF                N Y20090625
+121144528FOCUS BUSNS BK CA FOCUS BUSINESS BANK                 CASAN JOSE                 Y Y20130425
+121144557SIERRA VISTA BK CASIERRA VISTA BANK                   CAFOLSOM                   N Y20140515
+1211446121ST CAP BK MONTERY1ST CAPITAL BANK                    CAMONTEREY                 Y Y20070911
+121144625FOLSOM LAKE BK CA FOLSOM LAKE BANK                    CAFOLSOM                   Y N20070402
+121144696CA BK COM LAFAYETTCALIFORNIA BANK OF COMMERCE         CALAFAYETTE                Y Y20090930
+121144845BK FEATHR RVR  YC BANK OF FEATHER RIVER               CAYUBA CITY                Y N20071106
+121144861LGHT HSE BK SC CA LIGHTHOUSE BANK                     CASANTA CRUZ               Y N20071029
+121144939SUNCREST BK CA    SUNCREST BANK                       CAVISALIA                  N Y20091109
+121145145SIL VLY BK PVT BK SILICON VALLEY BANK                 CASANTA CLARA              Y N20110216
+121145174FIVE STAR BK ROCK FIVE STAR BANK                      CAROCKLIN                  Y N20120921
+121181730UMPQUA BANK       UMPQUA BANK                         CANOVATO                   Y N20130222
+121181743PAC SVC CU        PACIFIC SERVICE CU                  CACONCORD                  YSN20130125
+121181798UNION LA AKA UBOC MUFG UNION BANK, NA                 CASAN RAFAEL               Y N20140630
+121181976TECHNOLOGY CU     TECHNOLOGY CREDIT UNION             CASAN JOSE                 Y N20030715
+121182056ONE PACCOAST BANK ONE PACIFICCOAST BANK, FSB          CAOAKLAND                  Y N20110311
+121201652COL BK RENO       US BANK, NA                         ALBIRMINGHAM               Y N20140303
+121201694US BK NEV RENO    US BANK, NA                         ORPORTLAND                 Y N20130221
+121201814HER BK NV RENO    HERITAGE BANK OF NEVADA             NVRENO                     Y Y        
+121202062WESTERN ALLIANCE  WESTERN ALLIANCE BANK               NVRENO                     Y N20110328
+121202185UMPQUA BANK       UMPQUA BANK                         NVRENO                     Y N20101018
+121202211CHARLES SCHWAB BK CHARLES SCHWAB BANK                 NVRENO                     Y Y20071228
+121281892FARM BUREAU SPARKSFARM BUREAU BANK                    TXSAN ANTONIO              Y N20120613
+121301015FST HAW HONO      FIRST HAWAIIAN BANK                 HIHONOLULU                 Y Y        
+121301028BK HAW HONO       BANK OF HAWAII                      HIHONOLULU                 Y Y        
+121301578CENT PAC HONO     CENTRAL PACIFIC BANK                HIHONOLULU                 Y Y        
+121301772HAW NATL HONO     HAWAII NATIONAL BANK                HIHONOLULU                 Y Y        
+121302357PACIFIC RIM BK HI PACIFIC RIM BANK                    HIHONOLULU                 N Y20111109
+121302360OHANA PACIF BK HI OHANA PACIFIC BANK                  HIHONOLULU                 Y N20111219
+121302373BK FSM MICRONESIA BANK OF THE FSM                     HIHONOLULU                 Y N20091028
+121403049CY TRUST BK SAIP  CITY TRUST BANK, INC                MPSAIPAN                   Y Y20031218
+121403078BANK OF SAIPAN    BANK OF SAIPAN                      MPSAIPAN                   Y Y20030527
+121404022ANZ GUAM INC      ANZ GUAM, INC                       ASPAGO PAGO                Y N20110908
+121405115BK GM SF          BANK OF GUAM                        CASAN FRANCISCO            Y Y        
+121405199ALLIED BKG CORP GUALLIED BANKING CORPORATION,GUAM BR  GUAGANA                    Y Y20040209
+121405212ANZ GUAM INC      ANZ GUAM, INC                       GUHAGATNA                  Y N20130326
+122000496UNION LA AKA UBOC MUFG UNION BANK, NA                 CALOS ANGELES              Y Y20140630
+122004162BK TOK MIT UFJ LTDTHE BANK OF TOKYO-MITSUBISHI UFJ LTDCALOS ANGELES              Y N20060104
+122016066CY NATL BK LA     CITY NATIONAL BANK                  CALOS ANGELES              Y Y20090729
+122031902MIZUHO BK LTD     MIZUHO BANK, LTD                    CALOS ANGELES              Y N20130628
+122034103FAR EST NATL LA   FAR EAST NATIONAL BANK              CALOS ANGELES              Y Y        
+122035199BANC POP DE PR LA BANCO POPULAR NORTH AMERICA         CALOS ANGELES              N Y20130204
+122037061ST BK IND LA      STATE BANK OF INDIA                 CALOS ANGELES              Y N20100611
+122037100WOORI BK LA       WOORI BANK, LOS ANGELES AGENCY      CALOS ANGELES              Y N20020801
+122037676KASIKORNBANK LA   KASIKORNBANK, LA AGENCY             CALOS ANGELES              YSN20060613
+122038086UTD OVER BK LTD LAUNITED OVERSEAS BANK LIMITED        CALOS ANGELES              Y N        
+122038251WILSHIRE BANK     WILSHIRE BANK                       CALOS ANGELES              Y Y20131003
+122038277EW BK SMRINO      EAST WEST BANK                      CAMONTEREY PARK            YSN20100422
+122038442MELLON FST BUS BK US BANK, NA                         CALOS ANGELES              Y N20140303
+122038691BK OF NY TR CO    BANK OF NEW YORK MELLON TRUST CO, NACALOS ANGELES              Y Y20080715
+122038756PHIL NATL LA      PHILIPPINE NATIONAL BANK, LA BRANCH CALOS ANGELES              Y Y20100910
+122038837UTD MIZ LTD LA    MIZRAHI TEFAHOT BANK, LTD           CALOS ANGELES              Y Y20060504
+122039179UTD PAC BK CY INDSUNITED PACIFIC BANK                 CACITY OF INDUSTRY         Y Y19991206
+122039205CNCB INTERNATIONALCHINA CITIC BANK INTERNATIONAL      CAALHAMBRA                 YSN20130226
+122039344ST BK IND CA LA   STATE BANK OF INDIA (CALIFORNIA)    CALOS ANGELES              Y Y        
+122039360NATL BK CA LA     NATIONAL BANK OF CALIFORNIA         CALOS ANGELES              Y Y        
+122039399HAN BK LA         HANMI BANK                          CALOS ANGELES              Y Y        
+122039645DEV BK SING LA    DEVELOPMENT BANK OF SINGAPORE, LTD  CALOS ANGELES              YSN20080602
+122040799WIN LUN BK LTD LA WING LUNG BANK, LTD                 CAALHAMBRA                 Y N20060313
+122040922MEGA ICBC LA      MEGA INTERNATIONAL COMMERCIAL BANK  CALOS ANGELES              Y Y20070620
+122041125SHIZ BK LTD LA    SHIZUOKA BANK, LTD                  CALOS ANGELES              YSN        
+122041219CATALYST CORP FCU CATALYST CORPORATE FCU              CASAN DIMAS                Y N20130326
+122041235BBCN BANK         BBCN BANK                           CALOS ANGELES              Y Y20111205
+122041358DB NATL TR CO     DEUTSCHE BANK NATIONAL TRUST COMPANYCALOS ANGELS               YSY20050208
+122041523CEDARS BK LA      PACIFIC WESTERN BANK                CALOS ANGELES              Y N20060922
+122041594SUMI MIT BK COR LASUMITOMO MITSUI BANKING CORPORATION CALOS ANGELES              Y Y20010416
+122041646SHINHAN BANK AM   SHINHAN BANK AMERICA                CALOS ANGELES              Y N20070110
+122041662BK CHINA LA       BANK OF CHINA, LOS ANGELES BRANCH   CALOS ANGELES              Y N20070928
+122041727BBCN BANK         BBCN BANK                           CALOS ANGELES              Y N20120523
+122041882HU NAN COML LTD LAHUA NAN COMMERCIAL BANK, LTD        CALOS ANGELES              Y N20051012
+122041895FST COML LA       FIRST COMMERCIAL BANK, LA BRANCH    CALOS ANGELES              Y N20110418
+122041989HAB BK AG ZUR LA  HABIB AMERICAN BANK                 CALOS ANGELES              Y N        
+122042001CHA HWA COML LA   CHANG HWA COMMERCIAL BANK, LTD      CALOS ANGELES              Y N        
+122042069WILSHIRE BANK     WILSHIRE BANK                       CALOS ANGELES              Y N20140516
+122042072BK EST ASIA LA    BANK OF EAST ASIA, LTD              CAALHAMBRA                 Y N20040719
+122042124KRU THAI BK LTD LAKRUNG THAI BANK, LIMITED            CALOS ANGELES              Y N        
+122042153SHANG COM BK ALHAMSHANGHAI COMMERCIAL BANK, LTD (SF)  CAALHAMBRA                 Y N20030625
+122042205PREF BK LA        PREFERRED BANK                      CALOS ANGELES              Y Y        
+122042218CATHAY UTD BK LTD CATHAY UNITED BANK, LTD             CALOS ANGELES              Y N20060203
+122042234TAIPEI FUBON COMM TAIPEI FUBON COMMERCIAL BANK LA BRCHCACITY OF INDUSTRY         Y N20120123
+122042247BK TAIWAN LA      BANK OF TAIWAN, LOS ANGELES BRANCH  CALOS ANGELES              Y N        
+122042357TAIWAN BUS BK LA  TAIWAN BUSINESS BANK                CALOS ANGELES              Y N        
+122042360TAIWAN COOP BK LA TAIWAN COOPERATIVE BANK (LA BRANCH) CALOS ANGELES              Y N20060808
+122042564LAND BK TAIWAN LA LAND BANK OF TAIWAN, LA WHSLE BRANCHCALOS ANGELES              Y N        
+122042807AM BSN BK LA      AMERICAN BUSINESS BANK              CALOS ANGELES              Y Y20000720
+122043071BK SINOPAC LA     BANK SINOPAC                        CALOS ANGELES              Y N20060331
+122043107TOMATOBANK LA     TOMATOBANK, NA                      CAALHAMBRA                 Y Y20110310
+122043301PACIFIC COMM BK   PACIFIC COMMERCE BANK               CALOS ANGELES              Y Y20070815
+122043518UNION LA AKA UBOC MUFG UNION BANK, NA                 CALOS ANGELES              Y N20140630
+122043602PACIFIC CITY BK   PACIFIC CITY BANK                   CALOS ANGELES              Y N20130326
+122043864COMMONWEALTH BS BKCOMMONWEALTH BUSINESS BANK          CALOS ANGELES              Y Y20050303
+122043958OPEN BANK CA      OPEN BANK                           CALOS ANGELES              Y Y20101018
+122044119CALIF BUS BK      CALIFORNIA BUSINESS BANK            CALOS ANGELES              Y Y20070129
+122044229WOORI AMERICA     WOORI AMERICA BANK                  CALOS ANGELES              Y N20121213
+1220443001ST ENTPRSE BK LA 1ST ENTERPRISE BANK                 CALOS ANGELES              Y Y20060823
+122044371PREMIER BUS BK LA PREMIER BUSINESS BANK               CALOS ANGELES              Y N20060725
+122045037ROYAL BUS BK LA CAROYAL BUSINESS BANK                 CALOS ANGELES              Y N20081119
+122086944ASIAN PAC NB SGABRASIAN PACIFIC NATIONAL BANK         CASAN GABRIEL              Y Y20100913
+122087590GRANDPOINT BK LA  GRANDPOINT BANK                     CALOS ANGELES              Y N20140603
+122100024JPMCHASE ARIZONA  JPMORGAN CHASE BANK, NA             AZPHOENIX                  Y N20050509
+122101010AZ BK TUC         COMPASS BANK                        AZTUCSON                   Y N20031008
+122104046BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+122104994MERIDIAN BANK     MERIDIAN BANK, NA                   AZPHOENIX                  Y Y20120807
+122105045STCKMNS BK KING   NATIONAL BANK OF ARIZONA            AZKINGMAN                  Y Y20070220
+122105155US BANK ARIZONA   US BANK, NA                         AZPHOENIX                  Y N20140303
+122105171NATL BK OF ARIZONANATIONAL BANK OF ARIZONA            AZSHOW LOW                 Y Y20030319
+122105184FIRST FIDELITY    FIRST FIDELITY BANK, NA             AZSCOTTSDALE               Y Y20070716
+122105210NORTHERN TR ARIZ  THE NORTHERN TRUST COMPANY          ILCHICAGO                  Y N20121005
+122105249BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20120328
+122105252GREAT WESTERN BANKGREAT WESTERN BANK                  AZCASA GRANDE              Y N20070926
+122105320NATL BK TUCSON    NATIONAL BANK OF ARIZONA            AZTUCSON                   Y Y20020603
+122105375SUN CY BK YNGTWN  COMPASS BANK                        AZYOUNGTOWN                N Y20000815
+122105472MOHAVE ST LK HAVASMOHAVE STATE BANK                   AZLAKE HAVASU CITY         Y Y20100813
+122105498BOKF NA           BOKF NA                             AZPHOENIX                  Y N20130621
+122105540ARIZONA BSN BK PHOCOBIZ BANK                          AZPHOENIX                  Y N20130715
+122105566GRANDPOINT BK LA  GRANDPOINT BANK                     AZTUCSON                   Y N20130620
+122105634FOOTHILLS BK YUMA THE FOOTHILLS BANK                  AZYUMA                     Y N20111202
+122105676GRANDPOINT BK LA  GRANDPOINT BANK                     AZTUCSON                   Y N20130621
+122105744COMPASS BK BHAM   COMPASS BANK                        AZTEMPE                    Y N20020806
+122105757MUT OF OMAHA BK AZMUTUAL OF OMAHA BANK                AZTEMPE                    Y N20090730
+122105773NORD NATL CU SCOT NORDSTROM, FSB                      AZSCOTTSDALE               Y N20060613
+122105825CANYON COMM BK TUCCANYON COMMUNITY BANK, NA           AZTUCSON                   Y Y20081030
+122105841UTD AZ BK CAVE CRKPARKWAY BANK AND TRUST CO           AZPHOENIX                  Y N20100319
+122105870MISSION BK KINGMANMISSION BANK                        AZKINGMAN                  Y N20051202
+122105896BNC NB OF AZ GLENDBNC NATIONAL BANK                   AZGLENDALE                 Y N20101221
+122105906FST BK YUMA       FIRST BANK YUMA                     AZYUMA                     Y N20010831
+122105922COMMERCE BK AZ    COMMERCE BANK OF ARIZONA, INC       AZTUCSON                   Y Y20111011
+122105935HORIZON COMMUNITY HORIZON COMMUNITY BANK              AZLAKE HAVASU CITY         Y Y20111212
+122105980WESTERN ALLIANCE  WESTERN ALLIANCE BANK               AZPHOENIX                  Y Y20110104
+122105993COUNTRY BK        COUNTRY BANK                        AZPRESCOTT                 Y N20030203
+122106002GRANDPOINT BK LA  BILTMORE BANK DIV OF GRANDPOINT BANKCALOS ANGELES              Y N20140321
+122106109PINNACLE BK AZ    PINNACLE BANK                       AZSCOTTSDALE               YSN20061019
+122106170REPUBLICBANKAZ    REPUBLICBANKAZ, NA                  AZPHOENIX                  Y N20070430
+122106183TCF NATIONAL BK   TCF NATIONAL BANK                   AZMESA                     Y N20091104
+122106222WV NATL BK AZ     WEST VALLEY NATIONAL BANK           AZGOODYEAR                 Y Y20131015
+122106235METRO PHOENIX BANKMETRO PHOENIX BANK                  AZPHOENIX                  Y N20090306
+122106277GOLDWATER BK NA AZGOLDWATER BANK, NA                  AZSCOTTSDALE               Y Y20100427
+122106293SUNBANK PHNX AZ   SUNBANK, NA                         AZPHOENIX                  Y N20070604
+122106316GATEWAY COM BK AZ GATEWAY COMMERCIAL BANK             AZMESA                     Y Y20071203
+122106332FST SCOTTSDALE BK FIRST SCOTTSDALE BANK, NA           AZSCOTTSDALE               Y Y20110715
+122106455FIRST NAT BK TX   FIRST NATIONAL BANK TEXAS           TXKILLEEN                  Y N20120312
+122187160BANK USA FSB PHO  US BANK, NA                         AZPHOENIX                  Y N20130627
+122187238DESERT SCHOOLS FCUDESERT SCHOOLS FEDERAL CREDIT UNION AZPHOENIX                  Y N20091014
+122187610NORSTROM SCOTTS AZNORDSTROM, FSB                      AZSCOTTSDALE               Y N20090901
+122201198FM LB             FARMERS & MERCHANTS BK OF LONG BEACHCALONG BEACH               Y Y20050718
+122203471COMM BK COV       COMMUNITY BANK                      CAPASADENA                 Y Y        
+122203507GRANDPOINT BK LA  GRANDPOINT BANK                     CALOS ANGELES              Y N20131106
+122203950CATH BK LA        CATHAY BANK                         CAEL MONTE                 Y Y20090310
+122210406CTBC USA LA CA    CTBC BANK CORP (USA)                CALOS ANGELES              Y Y20130822
+122212611US BK SMON        US BANK, NA                         CASANTA MONICA             Y N20130221
+122215090FST ST SFE SPGS   BANCO POPULAR NORTH AMERICA         CASANTA FE SPRINGS         Y N20030821
+122220593UNION LA AKA UBOC MUFG UNION BANK, NA                 CAGOLETA                   Y N20140630
+122221686MID ST BK ARROYO  RABOBANK, NA                        CAARROYO GRANDE            Y N20091013
+122226063PAN AM LA         PAN AMERICAN BANK                   CALOS ANGELES              Y Y        
+122226076MFRS LA           MANUFACTURERS BANK                  CALOS ANGELES              Y Y        
+122226937US BK LA MIRADA   US BANK, NA                         CALA MIRADA                Y N20130627
+122228003SUNWST BK IRVINE  SUNWEST BANK                        CAIRVINE                   Y Y20130517
+122228812US BK NA SIGNL HILUS BANK, NA                         CALONG BEACH               Y N20130627
+122232109CAL BK TRST LA    CALIFORNIA BANK AND TRUST           CASAN DIEGO                Y Y19991231
+122232439FOOT INDPT GLEND  PACIFIC WESTERN BANK                CAGLENDORA                 Y N20060922
+122233645BANAMEX USA       BANAMEX USA                         CALOS ANGELES              Y Y20110124
+122234149CITZ BUS BK ONT   CITIZENS BUSINESS BANK              CAONTARIO                  Y Y        
+122234194BK HEMET RSIDE    BANK OF HEMET                       CARIVERSIDE                Y Y        
+122234482HANMI BANK        HANMI BANK                          CALOS ANGELES              Y N20040817
+122234783MONTE BT SBARB    MONTECITO BANK & TRUST              CASANTA BARBARA            Y Y        
+122234822PEN BK SD         US BANK, NA                         CASAN DIEGO                Y N20130221
+122235821BK COMRCE SD      US BANK, NA                         CASAN DIEGO                Y N20130221
+122235902GBC INTL BANK LA  GBC INTERNATIONAL BANK              CALOS ANGELES              Y N20111021
+122236244BK LEU ISR ECINO  BANK LEUMI USA                      CABEVERLY HILLS            Y N20100813
+122237159RABO BMS          RABOBANK, NA                        CASANTA BARBARA            Y N20121130
+122237308PAC WESTERN BK CA PACIFIC WESTERN BANK                CALOS ANGELES              Y N20130614
+122237599FST CR BK LA      FIRST CREDIT BANK                   CAWEST HOLLYWOOD           Y N19991210
+122237706DES COMM BK VICTOREAST WEST BANK                      CAVICTORVILLE              Y N20121219
+122237751FIRST NATIONAL    PACIFIC WESTERN BANK                CACORONADO                 Y N20061107
+122237858NEW OMNI BK ALHAM NEW OMNI BANK, NA                   CAALHAMBRA                 Y Y20130925
+122238077UNION LA AKA UBOC MUFG UNION BANK, NA                 CASAN LUIS OBISPO          Y N20140630
+122238200PAC WESTERN BK CA PACIFIC WESTERN BANK                CALOS ANGELES              Y Y20110113
+122238242FST COMM BK YUCCA PACIFIC WESTERN BANK                CAINDIAN WELLS             Y N20060922
+122238420RABOBANK NA       RABOBANK, NA                        CAROSEVILLE                Y Y20101105
+122238585PACIFIC PREMIER BKPACIFIC PREMIER BANK                CAPALM DESERT              Y N20120920
+122238611SECURITY PAC BK   PACIFIC WESTERN BANK                CAONTARIO                  Y Y20090206
+122238682SD NATL SD        US BANK, NA                         CASAN DIEGO                Y N20130627
+122238721FST MTN BIG BEAR  FIRST MOUNTAIN BANK                 CABIG BEAR LAKE            Y Y        
+122238938FST NATL SD       PACIFIC WESTERN BANK                CASAN DIEGO                Y N20070316
+122239063FIRST NATIONAL BK PACIFIC WESTERN BANK                CARANCHO SANTA FE          Y N20061107
+122239270OPUS BK IRVINE CA OPUS BANK                           CAIRVINE                   Y Y20111006
+122239322PACIFIC WESTERN BKPACIFIC WESTERN BANK                CANEWPORT BEACH            Y N20060922
+122239335FST PROF BK SMON  PACIFIC WESTERN BANK                CASANTA MONICA             Y N20060922
+122239542BK WHIT WHIT      BANK OF WHITTIER, NA                CAWHITTIER                 Y Y20060614
+122239571EASTWEST BK       EAST WEST BANK                      CAMONTEREY PARK            N Y20100420
+122239584UMPQUA BANK       UMPQUA BANK                         CALA MESA                  Y N20140418
+122239775PAC WEST NATL PICOPACIFIC WESTERN BANK                CAROSEMEAD                 Y N20060922
+122239814FNB SD            PACIFIC WESTERN BANK                CASAN DIEGO                N Y20070216
+122239869BANCO POPUL NO AMEBANCO POPULAR NORTH AMERICA         CACOMMERCE                 Y N20100813
+122239872IN. & COMM. BNK CNINDUSTRIAL & COMM. BNK OF CHINA, NA CAALHAMBRA                 Y N20130610
+122239982HER OAK BK PROBE  HERITAGE OAKS BANK                  CAPASO ROBLES              Y Y        
+122240308PACIFIC WESTN NATLPACIFIC WESTERN BANK                CALOS ANGELES              Y N20060922
+122240340COMM NATL BK      PACIFIC WESTERN BANK                CASANTEE                   Y N20061107
+122240489PAC WEST NATL BK  PACIFIC WESTERN BANK                CAUPLAND                   Y N20060922
+122240492BOSTON PRIV FIRST BOSTON PRIVATE FIRST                CAENCINO                   Y N20120329
+122240502US BANK SO CALIF  US BANK, NA                         CASAN DIEGO                Y N20130221
+122240706INTL CITY BK LB   INTERNATIONAL CITY BANK, NA         CALONG BEACH               Y Y20081003
+1222407511ST NATL BK OF SC FIRST NATIONAL BANK OF SOUTHERN CA  CARIVERSIDE                Y Y20130222
+122240861HSBC BANK USA     HSBC BANK USA, NA                   CAENCINO                   Y N20121213
+122240942FIRST NATIONAL BK PACIFIC WESTERN BANK                CACARLSBAD                 Y N20061107
+122241132COMM NB FALLBROOK PACIFIC WESTERN BANK                CAESCONDIDO                Y N20061110
+122241255FST AM TR CO SANA FIRST AMERICAN TRUST, FSB           CASANTA ANA                Y N20060614
+122241624MERC BK CA CARS   MERCHANTS BANK OF CALIFORNIA, NA    CACARSON                   Y Y20100212
+122241802COMMUNITY WEST BK COMMUNITY WEST BANK, NA             CAGOLETA                   Y Y20060614
+122241912AMER SEC BK       AMERICAN SECURITY BANK              CAAPPLE VALLEY             Y Y20050601
+122242018EW BK SMRINO      EAST WEST BANK                      CAROSEMEAD                 Y N20100422
+122242092PACIFIC WESTERN BKPACIFIC WESTERN BANK                CAGLENDALE                 Y N20060922
+122242102EW BK SMRINO      EAST WEST BANK                      CAPASADENA                 Y N20140627
+122242173NORTHERN TR CA LA THE NORTHERN TRUST COMPANY          ILCHICAGO                  Y N20120913
+122242296EVERT BK CY INDUS EVERTRUST BANK                      CAPASADENA                 Y Y20130128
+122242377FST COML BK ALHAM FIRST COMMERCIAL BANK (USA)         CAALHAMBRA                 Y Y20011121
+122242526COS NATL BK SLO   COAST NATIONAL BANK                 CASAN LUIS OBISPO          Y Y        
+122242542COMMUNITY NATL BK PACIFIC WESTERN BANK                CASAN DIEGO                Y N20061107
+122242571NEIGHBRHD NB SD   NEIGHBORHOOD NATIONAL BANK          CANATIONAL CITY            Y Y20090102
+122242649HER OAK BK PROBE  HERITAGE OAKS BANK                  CAPASO ROBLES              Y N20140718
+122242788PACIFIC PREMIER BKPACIFIC PREMIER BANK                CAPALM SPRINGS             Y N20110822
+122242791MISSION BK BAKER  MISSION BANK                        CABAKERSFIELD              Y Y        
+122242827SCLA VLY BK SPAULASANTA CLARA VALLEY BANK, NA         CASANTA PAULA              Y Y20060614
+122242869PAC MERC BK NEWBCHPACIFIC MERCANTILE BANK             CACOSTA MESA               Y Y20010618
+122242924AFFIN BK VEN      PACIFIC WESTERN BANK                CAVENTURA                  Y N20091204
+122242937PAC LIB BK HUNTBCHPACIFIC WESTERN BANK                CAHUNTINGTON BEACH         Y N20060922
+122242982SO CTY BK RAN SMARSOUTH COUNTY BANK, NA               CARCHO STA MARG            Y Y20100813
+122243017ARMED FORCES BK CAARMED FORCES BANK OF CALIFORNIA, NA CASAN DIEGO                Y N20060301
+122243062CHINO COM BK CHINOCHINO COMMERCIAL BANK, NA           CACHINO                    Y Y20090220
+122243127CITY NATL BK      CITY NATIONAL BANK                  CASAN DIEGO                Y N20101004
+122243130CA FST IRVINE     CALIFORNIA FIRST NATIONAL BANK      CAIRVINE                   Y N20030911
+122243156ISRAEL DISC BK LA ISRAEL DISCOUNT BANK OF NEW YORK    CABEVERLY HILLS            Y N20000925
+122243169MISSION OAKS TMEC MISSION OAKS NATIONAL BANK          CATEMECULA                 Y N20140429
+122243172GRANDPOINT BK CA  GRANDPOINT BANK                     CAORANGE                   Y N20111024
+122243185E SUN BK CY INDUS E SUN COMMERCIAL BANK, LTD          CACITY OF INDUSTRY         Y N20140722
+122243224MISSION VLY SN VLYMISSION VALLEY BANK                 CASUN VALLEY               Y Y20010712
+122243237COMM BK SMARIA    COMMUNITY BANK OF SANTA MARIA       CASANTA MARIA              Y Y20010312
+122243295BK MANHTN EL SEG  BANK OF MANHATTAN, NA               CAEL SEGUNDO               Y Y20120824
+122243321GRANDPOINT BK LA  GRANDPOINT BANK                     CALOS ANGELES              Y N20130920
+122243334COMWST BK NWPT BCHCOMMERCEWEST BANK                   CANEWPORT BEACH            Y Y20121129
+122243376BANK OF THE ORIENTBANK OF THE ORIENT                  CACITY OF INDUSTRY         Y N20091201
+122243402BANK OF SO CAL    BANK OF SOUTHERN CALIFORNIA, NA     CASAN DIEGO                Y Y20101231
+122243415UNITI BK BUENA PK UNITI BANK                          CABUENA PARK               Y Y20011217
+122243460ORANGE CNTY BUS BKORANGE COUNTY BUSINESS BANK         CAIRVINE                   Y Y20120326
+122243541COUNTY COMMERCE BKCOUNTY COMMERCE BANK                CAVENTURA                  Y Y20030218
+122243583AMERICAN CONTL BK AMERICAN CONTINENTAL BANK           CACITY OF INDUSTRY         Y Y20091112
+122243596COMML CALIFORNIA  COMMERCIAL BANK OF CALIFORNIA       CACOSTA MESA               Y N20030515
+122243619SEACOAST CM BK  SDSEACOAST COMMERCE BANK              CASAN DIEGO                Y N20140627
+122243635TORREY PINES BK   TORREY PINES BANK                   CASAN DIEGO                Y Y20030523
+122243680PACIFIC PREMIER BKPACIFIC PREMIER BANK                CAIRVINE                   Y N20131004
+122243703UMPQUA BANK       UMPQUA BANK                         WASPOKANE                  Y N20140418
+122243716GOLDEN ST BUS BK  GOLDEN STATE BANK                   CAUPLAND                   Y N20130403
+1222437611ST CENTURY BANK  1ST CENTURY BANK, NA                CALOS ANGELES              Y Y20060614
+122243774BANC OF CA NA     BANC OF CALIFORNIA, NA              CAIRVINE                   Y N20140422
+122243813BK SANTA CLARITA  BANK OF SANTA CLARITA               CASANTA CLARITA            Y Y20091013
+122243871EH NATIONAL BK CA EH NATIONAL BANK                    CABEVERLY HILLS            Y Y20111115
+122243884CA UNITED BK      CALIFORNIA UNITED BANK              CAENCINO                   Y Y20080430
+122243910OJAI COMM BK      OJAI COMMUNITY BANK                 CAOJAI                     Y Y20050308
+122243923FST HERITAGE BK   MUTUAL OF OMAHA BANK                CANEWPORT BEACH            Y N20091013
+122243965FOUNDERS COMMUNITYFOUNDERS COMMUNITY BANK             CASAN LUIS OBISPO          Y N20050523
+122243994FST CHOICE BK     FIRST CHOICE BANK                   CACERRITOS                 Y Y20090817
+122244003FST FNDN BK IRVINEFIRST FOUNDATION BANK               CAPALM DESERT              Y N20120913
+122244016SECURITY BK CAL   SECURITY BANK OF CALIFORNIA         CARIVERSIDE                Y Y20050624
+122244029SAN DIEGO PRIV BK SAN DIEGO PRIVATE BANK              CACORONADO                 Y N20131129
+122244032SAIGON NB WESTMIN SAIGON NATIONAL BANK                CAWESTMINSTER              Y Y20051223
+122244061FIRST GENERAL BK  FIRST GENERAL BANK                  CAROWLAND HEIGHTS          Y Y20100304
+122244087PLAZA BANK IRVINE PLAZA BANK                          CAIRVINE                   Y N20051230
+122244139BANC OF CA NA     BANC OF CALIFORNIA, NA              CAIRVINE                   Y N20131011
+122244155BK OF SANTA BARB  BANK OF SANTA BARBARA               CASANTA BARBARA            Y Y20140529
+122244184MB FINANCIAL      MB FINANCIAL BANK                   ILCHICAGO                  Y N20140516
+122244249FRND HILL BK WHTTRFRIENDLY HILLS BANK                 CAWHITTIER                 Y Y20060918
+122244294SAN DIEGO PRIV BK SAN DIEGO PRIVATE BANK              CACORONADO                 Y N20130621
+122244333AM RIVIERA BK SB  AMERICAN RIVIERA BANK               CASANTA BARBARA            Y N20060814
+122244427US MET BK GRDN GRVUS METRO BANK                       CAGARDEN GROVE             Y N20060915
+122244498A UNITED BK GLNDLEAMERICAS UNITED BANK                CAVERNON                   Y N20070925
+122244511PAC A BK ROSEMEAD PACIFIC ALLIANCE BANK               CAROSEMEAD                 Y Y20070212
+122244566GRANDPOINT BK LA  GRANDPOINT BANK                     CALOS ANGELES              Y Y20110307
+122244595PAC ENTPR BK IRVNEPACIFIC ENTERPRISE BANK             CAIRVINE                   Y Y20130110
+122244663AM PLUS BK ARCADIAAMERICAN PLUS BANK, NA              CAARCADIA                  Y Y20071022
+122244676COMM VAL BK EL CNTCOMMUNITY VALLEY BANK               CAEL CENTRO                Y N20140318
+122244702BK MANHTN EL SEG  BANK OF MANHATTAN, NA               CAEL SEGUNDO               Y N20070815
+122244744PAC WESTERN BK CA PACIFIC WESTERN BANK                CASAN LUIS OBISPO          Y N20120907
+122244773PARTNR BK OF CA MVPARTNERS BANK OF CALIFORNIA         CAMISSION VIEJO            Y N20071116
+122244854CA REP BK NEWP BCHCALIFORNIA REPUBLIC BANK            CAIRVINE                   Y Y20130118
+122244870MEGA BK SAN GABRL MEGA BANK                           CASAN GABRIEL              Y N20080324
+122244883BK MANHTN EL SEG  BANK OF MANHATTAN, NA               CAPASADENA                 Y N20120606
+122244922VIBRA BK CHULA VISVIBRA BANK                          CACHULA VISTA              Y N20080710
+122287251BOFI FEDERAL BK CABOFI FEDERAL BANK                   CASAN DIEGO                Y Y20111007
+122287280EL PASEO BK       FRONTIER BANK, FSB                  CAPALM DESERT              Y Y20121206
+122287413ARROWHEAD CCU     ARROWHEAD CENTRAL CREDIT UNION      CASAN BERNARDINO           Y N20100503
+122287426INDP BK NEWPRT BCHINDEPENDENCE BANK                   CANEWPORT BEACH            Y N20130403
+122287507PT LOMA CU SD     POINT LOMA CREDIT UNION             CASAN DIEGO                Y N20051116
+122287549KERN SCH FCU BAKERKERN SCHOOLS FEDERAL CREDIT UNION   CABAKERSFIELD              Y N20070515
+122287581FST FNDN BK IRVINEFIRST FOUNDATION BANK               CAIRVINE                   Y Y20081030
+122287604CSTHILL FCU LOMPOCCOASTHILLS FEDERAL CREDIT UNION     CALOMPOC                   Y N20080818
+122400779NEV ST BK LV      NEVADA STATE BANK                   NVLAS VEGAS                Y Y        
+122401723MUT OF OMAHA BK NVMUTUAL OF OMAHA BANK                AZPHOENIX                  Y N20090727
+122401778BKWST NV LV       BANK OF NEVADA                      NVLAS VEGAS                Y Y20060428
+122401781COLONIAL BK LV    US BANK, NA                         NVLAS VEGAS                Y N20130627
+122402007NEV FST BK LV     BANK OF NEVADA                      NVLAS VEGAS                Y N20060719
+122402010CY NATL BK LA     CITY NATIONAL BANK                  NVLAS VEGAS                Y N20101108
+122402052BANK OF LAS VEGAS BANK OF LAS VEGAS DIV TALMER WEST BKNVLAS VEGAS                Y N20131231
+122402078BANK OF LAS VEGAS BANK OF LAS VEGAS DIV TALMER WEST BKNVLAS VEGAS                Y N20131231
+122402081BANK OF LAS VEGAS BANK OF LAS VEGAS DIV TALMER WEST BKNVHENDERSON                Y N20131231
+122402094CY NATL BK LA     CITY NATIONAL BANK                  NVLAS VEGAS                Y N20110715
+122402104BK OF NEV LV      BANK OF NEVADA                      NVLAS VEGAS                Y N20060711
+122402133CREDIT ONE BK LV  CREDIT ONE BANK, NA                 NVLAS VEGAS                Y N20060804
+122402175BK OF LAS VEGAS   BANK OF LAS VEGAS DIV TALMER WEST BKNVLAS VEGAS                Y N20131231
+122402191TOWN & CNTRY BK   TOWN AND COUNTRY BANK               NVLAS VEGAS                Y N20020415
+122402243VALLEY BANK OF NV VALLEY BANK OF NEVADA               NVNORTH LAS VEGAS          N Y20110803
+122402298BKWST NV LV       BANK OF NEVADA                      NVLAS VEGAS                Y N20121206
+1224023111ST SEC BK  OF NV FIRST SECURITY BANK OF NEVADA       NVLAS VEGAS                Y N20070205
+122402353LEVEL ONE BANK    LEVEL ONE BANK                      MIFARMINGTON HILLS         YSN20130103
+122402366BK OF GEORGE LV NVBANK OF GEORGE                      NVLAS VEGAS                Y Y20130206
+122402382MEADOW BANK LV    MEADOWS BANK                        NVLAS VEGAS                Y N20080326
+122402405KIRKWOOD BK LV NV KIRKWOOD BANK OF NEVADA             NVLAS VEGAS                Y N20080602
+122487129USAA SAVINGS BK NVUSAA SAVINGS BANK                   NVLAS VEGAS                YSY20140210
+122487404TOYOTA FIN SVGS   TOYOTA FINANCIAL SAVINGS BANK       NVHENDERSON                Y N20040823
+122487459BEAL BK LAS VEGAS BEAL BANK USA                       NVLAS VEGAS                Y N20110901
+123000220US BK OR POR      US BANK, NA                         ORPORTLAND                 Y N20130221
+123000848US BK NA COMM REG US BANK, NA                         ORPORTLAND                 Y N20130221
+123002011KEY BK POR        KEYBANK NATIONAL ASSOCIATION        ORPORTLAND                 Y N20121220
+123006651ALBINA COMM BK PTLALBINA COMMUNITY BANK               ORPORTLAND                 Y Y20040331
+123006897CAPITAL PACIFIC BKCAPITAL PACIFIC BANK                ORPORTLAND                 Y Y20030804
+123006965COMMERCE BK OREGONCOMMERCE BANK OF OREGON             ORPORTLAND                 Y N20051109
+123103596TWIN RVR NB LEWST TWIN RIVER NATIONAL BANK            WACLARKSTON                Y N20040412
+123103606PANHA ST BK SANPT PANHANDLE STATE BANK                IDSANDPOINT                Y Y        
+123103729US BK ID BOIS     US BANK, NA                         ORPORTLAND                 Y N20130221
+123103732ID INDPT HAYDEN LKIDAHO INDEPENDENT BANK              IDHAYDEN LAKE              Y Y        
+123103826BANKCDA COEUR     BANKCDA                             IDCOEUR D ALENE            Y Y20081007
+123103907COMM 1ST BK ID    COMMUNITY 1ST BANK                  IDPOST FALLS               Y Y20091028
+123171955GLACIER MTN WEST  GLACIER BANK-MOUNTAIN WEST DIV      IDCOEUR D ALENE            Y N20120625
+123200059PIO TR BK SALEM   PIONEER TRUST BANK, NA              ORSALEM                    Y Y20060614
+123201045UMPQUA BANK       UMPQUA BANK                         ORREEDSPORT                Y N20020220
+123202125VLY ROGUE BK MED  UMPQUA BANK                         ORROGUE RIVER              Y N20020806
+123202183CLACK CNTY SANDY  CLACKAMAS COUNTY BANK               ORSANDY                    Y Y        
+123202293SIUSLAW BK FLOR   SIUSLAW BANK                        ORFLORENCE                 Y N20130403
+123202921UMPQUA BANK       UMPQUA BANK                         ORCOOS BAY                 Y N20020220
+123203535BK EAST ORE HEPNERBANK OF EASTERN OREGON              ORHEPPNER                  Y Y20060509
+123203878COMM BK PENDLETON COMMUNITY BANK                      ORJOSEPH                   Y Y20001122
+123204013CITZ BK CORVAL    CITIZENS BANK                       ORCORVALLIS                Y Y        
+123204110BANNER BK OR HERMIBANNER BANK                         ORHERMISTON                Y N20020904
+123205054UMPQUA BANK       UMPQUA BANK                         ORROSEBURG                 Y Y20020911
+123205135PAC CONTL EUGENE  PACIFIC CONTINENTAL BANK            OREUGENE                   Y Y        
+123206024BK CASCADES BEND  BANK OF THE CASCADES                ORBEND                     Y Y        
+123206053UMPQUA BANK       UMPQUA BANK                         OREUGENE                   Y N20030219
+123206338ORE PAC BKG FLORE OREGON PACIFIC BANK                 ORFLORENCE                 Y Y20080416
+123206419BK CASCADES BEND  BANK OF THE CASCADES                ORBEND                     Y N20140523
+123206561UMPQUA BANK       UMPQUA BANK                         ORALBANY                   Y Y20020409
+123206639MBANK             MBANK                               ORGRESHAM                  Y Y20040513
+123206671UMPQUA BANK       UMPQUA BANK                         ORCOOS BAY                 Y N20020220
+123206794PREMIER COMM BK   PREMIER COMMUNITY BANK              ORHILLSBORO                Y N20131219
+123206817ORE BUS BK LK OSW BANNER BANK                         ORLAKE OSWEGO              Y N20020111
+123206859WILLAMETTE VAL BK WILLAMETTE VALLEY BANK              ORSALEM                    Y N20020514
+123206875OREGON COAST BK   OREGON COAST BANK                   ORNEWPORT                  Y N20060217
+123206914SUMMIT BANK OREGONSUMMIT BANK                         OREUGENE                   Y Y20140527
+123206927PAC CONTL EUGENE  PACIFIC CONTINENTAL BANK            OREUGENE                   Y N20130131
+123206972LEWIS&CLARK BK OR LEWIS & CLARK BANK                  OROREGON CITY              Y N20100121
+123206998CPS COM BK SEASIDECLATSOP COMMUNITY BANK              ORSEASIDE                  Y N20090522
+123271978WA MUT FSB SEA    JPMORGAN CHASE BANK, NA             UTPARK CITY                Y N20091013
+123285090HIGH DESERT BK OR HIGH DESERT BANK                    ORBEND                     Y N20070926
+123300153BAK BOY NB WAL WALBAKER BOYER NATIONAL BANK           WAWALLA WALLA              Y Y        
+123305378UMPQUA BANK       UMPQUA BANK                         WAVANCOUVER                Y N20140418
+123307583HER SB OLY        HERITAGE BANK                       WALONGVIEW                 Y N20101115
+123308553BK CLARK CNTY VAN UMPQUA BANK                         WAVANCOUVER                Y N20090331
+123308582HMETWN NB LONGVIEWHOMETOWN NATIONAL BANK              WALONGVIEW                 Y Y20110622
+123308618TWIN CTY BK LVIEW TWIN CITY BANK                      WALONGVIEW                 Y N20051129
+124000025WELLS FARGO NWEST WELLS FARGO BANK, NA (NORTHWEST)    UTOGDEN                    YSY20060614
+124000054ZIONS SLC         ZIONS FIRST NATIONAL BANK           UTSALT LAKE CITY           Y Y19990713
+124000737KEY BK UT SLC     KEYBANK NATIONAL ASSOCIATION        UTOGDEN                    Y N20121220
+124001545JPMCHASE UTAH     JPMORGAN CHASE BANK, NA             UTSALT LAKE CITY           Y Y20050509
+124002887HOME SVGS BK SLC  HOME SAVINGS BANK                   UTSALT LAKE CTY            Y N20020204
+124003077CONTINENTAL BK    CONTINENTAL BANK                    UTSALT LAKE CITY           Y N20031231
+124003116GMAC BK MIDVALE UTALLY BANK                           PAFORT WASHINGTON          Y N20130418
+124003158CIT BANK          CIT BANK                            UTSALT LAKE CITY           Y N20110823
+124042559ZIONS FST NB SLC  ZIONS FIRST NATIONAL BANK           UTSALT LAKE CITY           YSN19990713
+124071889AMEX CNTRN BK MIDVAMERICAN EXPRESS CENTURION BANK     UTSALT LAKE CITY           Y Y20090323
+124084643WEX BANK          WEX BANK                            UTMIDVALE                  Y Y20121213
+124084708BMW BK NO AMER SLCBMW BANK OF NORTH AMERICA           UTSALT LAKE CITY           Y N20021022
+124084779PROFICIO BK SLC   PROFICIO BANK                       UTCOTTONWOOD HEIGHTS       Y N20121024
+124084805CELTIC BK CORP SLCCELTIC BANK CORPORATION             UTSALT LAKE CITY           Y N20140626
+124084834CIT ONLINE BANK   CIT BANK                            UTSALT LAKE CITY           Y Y20081114
+124084944GE CAPITAL BK UT  GE CAPITAL BANK                     UTHOLLADAY                 Y Y20120831
+124085008SYNCHRONY BANK    SYNCHRONY BANK                      UTDRAPER                   N Y20140625
+124085011GMAC AUTOMOTIVE BKALLY BANK                           PAFORT WASHINGTON          Y Y20130418
+124085066AMEX BK SLC       AMERICAN EXPRESS BANK, FSB          UTSALT LAKE CITY           Y N20090226
+124085082GOLD SACHS BK SLC GOLDMAN SACHS BANK USA              UTSALT LAKE CITY           Y N20090716
+124085150COMENITY CAP BK   COMENITY CAPITAL BANK               UTSALT LAKE CITY           Y N20120928
+124085163MARLIN BUSINESS BKMARLIN BUSINESS BANK                UTSALT LAKE CITY           Y N20090105
+124085176ENERBANK USA SLC  ENERBANK USA                        UTSALT LAKE CITY           Y N20090121
+124085244WEX BANK          WEX BANK                            UTMIDVALE                  Y N20140114
+124085260GE CAPITAL BK UT  GE CAPITAL BANK                     UTHOLLADAY                 Y N20130424
+124100417BK COMRCE ID FLS  BANK OF COMMERCE (ID)               IDIDAHO FALLS              Y Y        
+124100857IRELAND BK MALAD  IRELAND BANK                        IDMALAD CITY               Y Y        
+124101128ZIONS BK SLC      ZIONS FIRST NATIONAL BANK           IDMONTPELIER               Y N20031008
+124101555KEY BK ID BOIS    KEYBANK NATIONAL ASSOCIATION        IDBOISE                    Y N20121220
+124102509FMR NATL BUHL BUHLFARMERS NATIONAL BANK OF BUHL       IDBUHL                     Y Y        
+124103582DL EVANS BK BURLEYD L EVANS BANK                      IDBURLEY                   Y Y        
+124103676BK IDAHO ID FLS   BANK OF IDAHO                       IDIDAHO FALLS              Y Y19990601
+124103744ID BKG CO BOISE   IDAHO BANKING COMPANY               IDBOISE                    Y Y20120120
+124103757SUNWST BK IRVINE  SUNWEST BANK                        CAIRVINE                   Y N20140613
+124103760FST BK ID KETCHUM US BANK, NA                         IDKETCHUM                  Y N20130627
+124103773GLACIER CITIZENS  GLACIER BANK-CITIZENS COMMUNITY DIV IDPOCATELLO                Y N20120625
+124103812LEWISTON SB PRESTNLEWISTON STATE BANK                 IDPRESTON                  Y N20070131
+124103841ID TRUST NTL BK IDIDAHO TRUST BANK                    IDBOISE                    Y N20081223
+124103922NORTHWEST BK BOISENORTHWEST BANK                      IDBOISE                    YSN20121214
+124200249FST NATL ELY ELY  FIRST NATIONAL BANK OF ELY          NVELY                      Y Y        
+124201565NEV BT CALIENTE   NEVADA BANK & TRUST COMPANY         NVCALIENTE                 Y Y        
+124300107BK UT OGDEN       BANK OF UTAH                        UTOGDEN                    Y Y        
+124300327CENT BK PROVO     CENTRAL BANK                        UTPROVO                    Y Y        
+124300699GUNNI VLY BK GUNNIGUNNISON VALLEY BANK                UTGUNNISON                 Y Y        
+124300754FST NB LAYT LAYT  FIRST NATIONAL BANK OF LAYTON       UTLAYTON                   Y N20130405
+124300767LEWISTN ST LEWISTNLEWISTON STATE BANK                 UTLEWISTON                 Y Y20120323
+124301025BK AM FORK AM FORKBANK OF AMERICAN FORK               UTAMERICAN FORK            Y Y        
+124301054ZIONS FNB W VLY CYZIONS FIRST NATIONAL BANK           UTDRAPER                   Y N20010321
+124301779ST BK SO UT CEDAR STATE BANK OF SOUTHERN UTAH         UTCEDAR CITY               Y Y        
+124302118HOLLADAY BT SLC   HOLLADAY BANK & TRUST               UTSALT LAKE CITY           Y N19990713
+124302150US BK UTAH GRESHAMUS BANK, NA                         UTSAINT GEORGE             Y N20130221
+124302257FAR WST BK PROVO  AMERICANWEST BANK                   UTSPRINGVILLE              Y N20070518
+124302325CACHE VLY BK LOGANCACHE VALLEY BANK                   UTLOGAN                    Y Y        
+124302464UT INDPT BK SALINAUTAH INDEPENDENT BANK               UTSALINA                   Y N        
+124302503BRIGHTON BK SLC   BRIGHTON BANK                       UTSALT LAKE CITY           Y Y19990713
+124302529GREEN DOT BK PROVOGREEN DOT BANK(DBA BONNEVILLE BANK) UTPROVO                    Y N20130405
+124302613FST UT BK SLC     FIRST UTAH BANK                     UTSALT LAKE CITY           Y Y19990713
+124302914ROCK CANYON BK UT ROCK CANYON BANK                    UTPROVO                    YSY20130614
+124302927CAP COMM BK OREM  CAPITAL COMMUNITY BANK              UTOREM                     Y N20060905
+124302969AM BK COMRCE PROVOAMERICAN BANK OF COMMERCE           UTPROVO                    Y N        
+124303010GRAND VLY BK HEBERGRAND VALLEY BANK                   UTHEBER CITY               Y Y20100825
+124303036UT COM BK SANDY   UTAH COMMUNITY BANK                 UTSANDY                    Y Y20100729
+124303081PRIME ALLIANCE BK PRIME ALLIANCE BANK                 UTWOODS CROSS              Y Y20090212
+124303104TWN CNTRY BK UTAH TOWN & COUNTRY BANK                 UTSAINT GEORGE             Y N20080219
+124303120GREEN DOT BK PROVOGREEN DOT BANK                      UTPROVO                    Y N20121024
+124303162GREEN DOT BK PROVOGOBANK, A DIVISION OF GREEN DOT BANKCAPASADENA                 Y N20131024
+124384589WEBBANK SLC UT    WEBBANK                             UTSALT LAKE CITY           Y N20090925
+124384592HERITAGE BK ST GEOHERITAGE BANK                       UTSAINT GEORGE             Y Y20050616
+124384602MERRIC BK S JORDANMERRICK BANK CORP DBA MERRICK BANK  UTSOUTH JORDAN             Y N20010717
+124384657TRANSPORT OGDEN   TRANSPORTATION ALLIANCE BANK, INC   UTOGDEN                    Y Y20090429
+124384712REPUBLIC BK INC UTREPUBLIC BANK, INC.                 UTBOUNTIFUL                N Y20091230
+124384877OPTUM BANK        OPTUM BANK, INC                     UTWEST VALLEY CITY         Y Y20130415
+1243849741ST ELETRNIC BK UTFIRST ELECTRONIC BANK               UTSANDY                    Y N20090722
+124385119SALLIE MAE BANK UTSALLIE MAE BANK                     UTSALT LAKE CITY           Y Y20121127
+124385180LCA BANK CORP UT  LCA BANK CORPORATION                UTPARK CITY                Y N20090526
+124385258TRANSPORT OGDEN   TRANSPORTATION ALLIANCE BANK, INC   UTOGDEN                    Y N20121219
+125000105US BK WASH SEA    US BANK, NA                         WASEATTLE                  Y N20130221
+125000574KEY BK WASH TAC   KEYBANK NATIONAL ASSOCIATION        WASEATTLE                  Y N20130405
+125006390UMPQUA BANK       UMPQUA BANK                         WASEATTLE                  Y N20100426
+125008013COMRCE BK WASH SEACOMMERCE BANK OF WASHINGTON, NA     WASEATTLE                  Y Y20060614
+125008165EW BK SMRINO      EAST WEST BANK                      WASEATTLE                  Y N20101026
+125008204TAIWAN COOP BK SEATAIWAN COOPERATIVE BANK (SEA BRANCH)WASEATTLE                  Y N20090225
+125008518NORTHWEST BUSINESSPACIFIC CONTINENTAL BANK            WASEATTLE                  Y N20051202
+125008644REGAL FINANCIAL BKREGAL FINANCIAL BANK                WASEATTLE                  Y N20020418
+125008657PAC INTL BK SEA   BBCN BANK                           WASEATTLE                  Y N20130215
+125008712FIRST SOUND BK    FIRST SOUND BANK                    WASEATTLE                  Y Y20140310
+125008806PLAZA BANK SEATTLEPLAZA BANK                          WASEATTLE                  Y N20060607
+125040880FHLB SEA SEA      FEDERAL HOME LOAN BANK OF SEATTLE   WASEATTLE                  Y N20080908
+125084697SEATTLE BK WA     SEATTLE BANK                        WASEATTLE                  Y N20100111
+125100089WASH TR BK SPOK   WASHINGTON TRUST BANK               WASPOKANE                  Y Y        
+125100607SEC ST CENTRALIA  SECURITY STATE BANK                 WACENTRALIA                Y Y        
+125101651ST NB GARFI GARFI STATE BANK NORTHWEST                WASPOKANE VALLEY           Y Y20080318
+125102430BK FAIRFIE FAIRFIEBANK OF FAIRFIELD                   WAFAIRFIELD                Y Y        
+125102676LAMONT BK ST JOHN LAMONT BANK OF ST JOHN              WASAINT JOHN               Y Y20100813
+125102906KITSAP BK PT ORCH KITSAP BANK                         WAPORT ORCHARD             Y Y        
+125104425PEOPLES BK LYNDEN PEOPLES BANK                        WABELLINGHAM               Y Y20130705
+125104564FARM ST BK FARM   FARMINGTON STATE BANK               WAFARMINGTON               Y Y        
+125104603CASHME VLY CASHME CASHMERE VALLEY BANK                WACASHMERE                 Y Y        
+125105550WHIDBEY IS OAK HBRWHIDBEY ISLAND BANK                 WAOAK HARBOR               Y Y        
+125105631SKAGIT BANK BURLINSKAGIT BANK                         WABURLINGTON               Y Y20140422
+125105741F&M BANK          BANNER BANK                         WASPOKANE                  Y N20070821
+125105822CENT VLY BANK     CENTRAL VALLEY DIV OF HERITAGE BANK WAOLYMPIA                  Y N20131004
+125106708HER SB OLY        HERITAGE BANK                       WAOLYMPIA                  Y N20131108
+125107008SECURITY ST BK    SECURITY STATE BANK                 WARAYMOND                  Y N20050222
+125107037AM WST BK SPOKANE AMERICANWEST BANK                   WASPOKANE                  Y Y20011212
+125107626FRONT BK EVE      MUFG UNION BANK, NA                 WAEVERETT                  Y N20140630
+125107697WHEAT BK DAVENPORTWHEATLAND BANK                      WADAVENPORT                Y Y20070829
+125107707BK OF PACIFIC ABERBANK OF THE PACIFIC                 WAABERDEEN                 Y Y20001114
+125107765WHATCOM ST BLNGHM BANNER BANK                         WABELLINGHAM               Y N20031008
+125107820ISL BK FRI HBR    ISLANDERS BANK                      WAFRIDAY HARBOR            Y Y        
+125107998NO CASCADES CHELANGLACIER BANK                        WACHELAN                   Y Y20130730
+125108104IN NOWST BK SPOK  INLAND NORTHWEST BANK               WASPOKANE                  Y Y20030221
+125108175SOUND BKG TAC     SOUND BANKING COMPANY               WALAKEWOOD                 Y N20130405
+125108191BANNER BK WOODNVILBANNER BANK                         WAWOODINVILLE              Y N20031008
+125108256WASH ST FEDL WY   HERITAGE BANK                       WAFEDERAL WAY              Y N20060711
+125108272COLUM ST BK LKWOODCOLUMBIA STATE BANK                 WATACOMA                   Y Y20010509
+125108324PRIME PAC LYNNWOODPRIME PACIFIC BANK, NA              WALYNNWOOD                 Y Y20090320
+125108366BK WASHINGTON LYNNTHE BANK OF WASHINGTON              WALYNNWOOD                 Y Y20020806
+125108405COASTAL COMM BK EVCOASTAL COMMUNITY BANK              WAEVERETT                  Y Y        
+125108447HER SB OLY        HERITAGE BANK                       WATACOMA                   Y N20110311
+125108450UMPQUA BANK       UMPQUA BANK                         WASPOKANE                  Y N20140418
+125108463COMM FST BK KENWICCOMMUNITY FIRST BANK                WAKENNEWICK                Y Y        
+125108476FIFE COML BK FIFE FIFE COMMERCIAL BANK                WAFIFE                     Y Y        
+125108492EW BK SMRINO      EAST WEST BANK                      WABELLEVUE                 Y N20100422
+125108573GBC INTL BANK LA  GBC INTERNATIONAL BANK              WASHORELINE                Y N20111021
+125108599NCW COMM BK VEN   BANNER BANK                         WAWENATCHEE                Y N20071026
+125108609SO SOUND BK OLY   SOUTH SOUND BANK                    WAOLYMPIA                  Y N20130405
+125108625FOUNDATION BK BELLFOUNDATION BANK                     WABELLEVUE                 Y Y20010605
+125108667EASTSIDE COMM BK  EASTSIDE COMMERCIAL BANK, NA        WABELLEVUE                 Y Y20060614
+125108670WASH BUSINESS BANKWASHINGTON BUSINESS BANK            WALACEY                    Y N20020318
+125108683HER SB OLY        HERITAGE BANK                       WALAKEWOOD                 Y N20130308
+125108751PUGET SO BK BELVUEPUGET SOUND BANK                    WABELLEVUE                 Y Y20100524
+125108816RIVERBANK SPOKANE RIVERBANK                           WASPOKANE                  N Y20090429
+125108861UNIBANK LYNWOOD WAUNIBANK                             WALYNNWOOD                 Y N20070608
+125108887COMMNCMT BK TACOMACOMMENCEMENT BANK                   WATACOMA                   Y N20091204
+125108942BBCN BANK         BBCN BANK                           WALYNNWOOD                 Y N20111205
+125184610PACIFIC CREST SVGSPACIFIC CREST SAVINGS BANK          WALYNNWOOD                 Y N20120508
+125184746UMPQUA BANK       UMPQUA BANK                         WAMOUNTLAKE TERRACE        Y N20140418
+125200044FST BK KETCH      FIRST BANK                          AKKETCHIKAN                Y Y        
+125200060FNB ALASKA        FIRST NATIONAL BANK ALASKA          AKANCHORAGE                Y Y20020114
+125200879KEY BK AKA ANCH   KEYBANK NATIONAL ASSOCIATION        AKANCHORAGE                Y N20121220
+125200921DENALI ST BK FRBNKDENALI STATE BANK                   AKFAIRBANKS                Y Y        
+125200934NORTHRIM BK ANCH  NORTHRIM BANK                       AKANCHORAGE                Y Y        
+154000008TREAS DEPT BPD    TREASURY DEPT BUREAU OF PUBLIC DEBT WVPARKERSBURG              N Y20050912
+155000007BK REVIEW BRANCH  US DEPT OF TREAS/FMS/BK REVIEW BR   DCWASHINGTON               N Y        
+211070120EAST BOSTON SVG   EAST BOSTON SAVINGS BANK            MAPEABODY                  Y Y20020212
+211070227MEETING HOUSE DOR MEETINGHOUSE CO-OPERAT              MADORCHESTER CENTER        Y Y20100813
+211070230THE COOPERATIVE BKTHE COOPERATIVE BANK                MAROSLINDALE               Y Y20120810
+211070256COMMONWEALTH CO-OPCOMMONWEALTH COOPERATIVE BANK       MABOSTON                   Y N20090428
+211072885COMMONWEALTH COOP COMMONWEALTH COOPERATIVE BANK       MAHYDE PARK                Y Y20081230
+211073237PEOPLES BRIGHTON  PEOPLES FEDERAL SAVINGS BANK        MABOSTON                   Y Y        
+211073473FST FED SVGS BOS  FIRST FEDERAL SAVINGS BANK          MABOSTON                   Y N        
+211073981BLUE HILLS BANK   BLUE HILLS BANK                     MAHYDE PARK                Y Y20111107
+211075086FST TRADE UN BOS  FIRST TRADE UNION SAVINGS BK        MABOSTON                   Y Y        
+211080709MEMBERS PLUS      MEMBERS PLUS CREDIT UNION           MASOMERVILLE               Y Y20111207
+211080725FIRST PRIORITY CU FIRST PRIORITY CREDIT UNION         MAEAST BOSTON              Y Y20100712
+211080822BOSTON CU         CITY OF BOSTON CU                   MABOSTON                   N Y20140620
+211080880600 ATLANTIC FCU  600 ATLANTIC FEDERAL CREDIT UNION   MABOSTON                   N Y20090210
+211080990INDUSTRIAL CU     INDUSTRIAL CREDIT UNION             MABOSTON                   Y Y20131217
+211081216LIBERTY BAY CU    LIBERTY BAY CREDIT UNION            MABRAINTREE                Y Y20120816
+211083557MASS BAY C U      MASS BAY CREDIT UNION               MASOUTH BOSTON             Y Y20140304
+211170046NAUGATUCK VALLEY  NAUGATUCK VALLEY SLA,INC.           CTNAUGATUCK                Y N20090521
+211170088FIRST NIAGARA     FIRST NIAGARA BANK, NA              NYBUFFALO                  Y N20120608
+211170101WEBSTER BANK CT   WEBSTER BANK, N.A.                  CTWATERBURY                Y Y20040422
+211170130FIRST NIAGARA     FIRST NIAGARA BANK, NA              NYBUFFALO                  Y N20120608
+211170156WEBSTER BANK      WEBSTER BANK, N.A.                  CTWATERBURY                Y N20100412
+211170185FIRST NIAGARA     FIRST NIAGARA BANK, NA              NYBUFFALO                  Y N20120608
+211170208GUILFORD SVGS BK  GUILFORD SAVINGS BANK               CTGUILFORD                 Y Y19990728
+211170211MILFORD BANK CT   THE MILFORD BANK                    CTMILFORD                  Y Y19991223
+211170237WINDSOR FED SVGS  WINDSOR FEDERAL SAV & LOAN          CTWINDSOR                  Y Y20030325
+211170253EASTERN FEDERAL BKEASTERN SAVINGS BANK                CTNORWICH                  Y Y20130104
+211170282LIBERTY MIDDLETOWNLIBERTY BANK                        CTMIDDLETOWN               Y Y        
+211170305EAGLE FSB BRISTOL WEBSTER BANK, N.A.                  CTBRISTOL                  Y N20040426
+211170318UNITED GLASTONBURYUNITED BANK                         CTVERNON ROCKVILLE         Y Y20140430
+211170347FARMINGTON BANK   FARMINGTON BANK                     CTFARMINGTON               Y Y20090925
+211170376ESSEX SVGS CONN   ESSEX SAVINGS BANK                  CTESSEX                    Y N20030711
+211173357CHELSEA BK NORWICHCHELSEA GROTON BANK                 CTNORWICH                  Y Y20100226
+211173373DIME SVGS NORWICH DIME BANK                           CTNORWICH                  Y Y20111114
+211174165TORRINGTON SVGS BKTORRINGTON SAVINGS BANK             CTTORRINGTON               Y Y        
+211174178ION BK            ION BANK                            CTNAUGATUCK                Y Y20131018
+211174181WILLIMANTIC SVG CTTHE SAVINGS INSTITUTE               CTWILLIMANTIC              Y Y        
+211174204NO WEST COMMUNITY NORTHWEST COMMUNITY BANK            CTWINSTED                  Y Y        
+211174220PUTNAM BANK       PUTNAM BANK                         CTPUTNAM                   Y Y20090319
+211174259THOMASTON SVGS BK THOMASTON SAVINGS BANK              CTTHOMASTON                Y Y        
+211174262STAFFORD SVGS CT  STAFFORD SAVINGS BANK               CTSTAFFORD SPRINGS         Y Y        
+211174275LITCHFIELD BANCORPLITCHFIELD BANCORP                  CTLITCHFIELD               Y Y        
+211174288COLLINSVLE SAV SOCCOLLINSVILLE SAVINGS SOCIETY        CTCOLLINSVILLE             Y Y20000113
+211174330JEWETT CITY SVGS  JEWETT CITY SAVINGS BANK            CTJEWETT CITY              Y Y        
+211174369FIRST NIAGARA     FIRST NIAGARA BANK, NA              NYBUFFALO                  Y N20120608
+211176590FRANKLIN TRUST FCUFRANKLIN TRUST FEDERAL CREDIT UNION CTHARTFORD                 Y N20000831
+211176891AMERICAN EAGLE FCUAMERICAN EAGLE FCU                  CTEAST HARTFORD            Y Y19991222
+211176901FST NE FCU HTFD   FIRST NEW ENGLAND FED. CU           CTEAST HARTFORD            Y N        
+211176998CHARTER OAK GROTONCHARTER OAK FEDERAL CREDIT UNION    CTWATERFORD                Y Y20140616
+211177010SCIENT FEDERAL CU SCIENT FEDERAL CREDIT UNION         CTGROTON                   YSY20130319
+211177829SEASONS FCU       SEASONS FEDERAL CREDIT UNION        CTMIDDLETOWN               Y Y20130404
+211178006CENCONN CU        ACHIEVE FINANCIAL CREDIT UNION      CTBERLIN                   Y Y20130905
+211178103NEW HAVEN COUNTY  NEW HAVEN COUNTY CREDIT UNION       CTNORTH HAVEN              Y Y20111020
+211178200CONNEX CU         CONNEX CREDIT UNION, INC.           CTNORTH HAVEN              Y Y20100813
+211178705UNITED BUS IND FCUUNITED BUSINESS & INDUSTRY FCU      CTPLAINVILLE               Y N20000809
+211179607WATERBURY CT TEACHWATERBURY CONN TEACHERS F.C.U.      CTWATERBURY                Y N20110207
+211180023DUTCH WETHERSFLD  DUTCH POINT CREDIT UNION            CTWETHERSFIELD             Y Y20090921
+211180133360 FEDERAL CREDIT360 FEDERAL CREDIT UNION            CTWINDSOR LOCKS            Y Y20110211
+211180159WINDSOR LOCK FCU  WINDSOR LOCKS FEDERAL CREDIT UNION  CTWINDSOR LOCKS            YSN20091203
+211191944ALLOYA CORP FCU   ALLOYA CORPORATE FEDERAL CREDIT UN  CTWALLINGFORD              Y N20111024
+211272465THE BANK OF MAINE THE BANK OF MAINE                   MEPORTLAND                 Y Y20120120
+211272504THE BANK OF MAINE THE BANK OF MAINE                   MEAUGUSTA                  N Y20110513
+211272520ANDROSCOG LEWISTONANDROSCOGGIN SAVINGS BANK           MELEWISTON                 Y Y        
+211272614KENNEBEC FSL WTVL KENNEBEC FEDERAL SAVINGS & L        MEWATERVILLE               Y Y19990818
+211272630SACO BIDDEFORD SVGSACO AND BIDDEFORD SAV. INST        MESACO                     Y Y        
+211272766MECHANICS AUBURN  MECHANICS SAVING'S BANK             MEAUBURN                   Y Y        
+211273749AUBURN SVGS MAINE AUBURN SAVINGS BANK, FSB.           MEAUBURN                   Y N20060711
+211273752BAR HARBOR S&L    BAR HARBOR SAVINGS AND LOAN         MEBAR HARBOR               YSN20120725
+211273765FST FED S&L BATH  FIRST FED SAV & LOAN OF BATH        MEBATH                     Y Y20080310
+211273794AROOSTOOK CARIBOU AROOSTOOK COUNTY FEDERAL SAV        MECARIBOU                  Y N20010321
+211274382BANGOR SVGS BK    BANGOR SAVINGS BANK                 MEBANGOR                   Y Y        
+211274395BIDDEFORD SVGS BK BIDDEFORD SAVINGS BANK              MEBIDDEFORD                Y Y        
+211274421KENNEBEC AUGUSTA  KENNEBEC SAVINGS BANK               MEAUGUSTA                  Y N        
+211274447BATH INST SVGS    BATH SAVINGS INSTITUTION            MEBATH                     Y Y        
+211274450TD BANK, NA       TD BANK, NA                         MEPORTLAND                 Y N20110513
+211274476SKOWHEGAN SVGS BK SKOWHEGAN SAVINGS BANK              MESKOWHEGAN                Y Y        
+211274492FRANKLIN FARMNGTONFRANKLIN SAVINGS BANK               MEFARMINGTON               Y N        
+211274502KENNEBUNK SVGS BK KENNEBUNK SAVINGS BANK              MEKENNEBUNK                Y Y        
+211274515NORWAY SVGS MAINE NORWAY SAVINGS BANK                 MENORWAY                   Y Y        
+211274531MACHIAS SVGS ME   MACHIAS SAVINGS BANK                MEMACHIAS                  Y Y        
+211274557NORTHEAST BANK    NORTHEAST BANK                      MELEWISTON                 Y Y20110708
+211274573GORHAM SVGS MAINE GORHAM SAVINGS BANK                 MEGORHAM                   Y Y        
+211274586CAMDEN NATIONAL BKCAMDEN NATIONAL BANK                MEKINGFIELD                Y N20061115
+211274599SANFORD INSTIT    SANFORD INSTITUTION FOR SAVI        MESANFORD                  Y Y19990713
+211287298TRADEMARK FCU     TRADEMARK FEDERAL CREDIT UNION      MEAUGUSTA                  Y N20101001
+211287340MAINE EMPLOYEES CUMAINE STATE EMPLOYEES CREDIT UNION  MEAUGUSTA                  N Y20131121
+211287366K V FEDERAL CR UN KV FEDERAL CREDIT UNION             MEAUGUSTA                  Y N        
+211287502OCEAN COMM FCU    OCEAN COMM FCU                      MEBIDDEFORD                Y Y20110525
+211287560ATLANTIC REG FCU  ATLANTIC REGIONAL FED CREDIT UNION  MEBRUNSWICK                N Y20140311
+211287586SEABOARD BUCKSPORTSEABOARD FEDERAL CREDIT UNIO        MEBUCKSPORT                Y Y        
+211287900LEWISTON MUNICIPALLEWISTON MUNICIPAL FCU              MELEWISTON                 Y N20111219
+211287926STE CROIX LEWISTONCENTRAL MAINE FEDERAL CU            MELEWISTON                 YSN20070412
+211287942COMMUNITY CU      COMMUNITY CREDIT UNION              MELEWISTON                 N Y20130329
+211287984LISBON COMM FCU   LISBON COMMUNITY FED. CU            MELISBON                   Y N20111116
+211288064MAINE SAVINGS FCU MAINE SAVINGS FEDERAL CREDIT UNION  MEHAMPDEN                  Y Y20131209
+211288161UNIVERSITY CU     UNIVERSITY CREDIT UNION             MEORONO                    Y Y20120319
+211288239CPORT CU          CPORT CREDIT UNION                  MEPORTLAND                 Y N20110919
+211288491INFINITY FCU      INFINITY FCU                        MEPORTLAND                 Y Y20090623
+211288640TOWN & COUNTRY CU TOWN & COUNTRY FED CU               MES PORTLAND               Y Y20100813
+211288983WINTHROP          WINTHROP FEDERAL CREDIT UNIO        MEWINTHROP                 N Y20130422
+211288996DOWN EAST         DOWN EAST CREDIT UNION              MEBAILEYVILLE              N Y20130507
+211291666TRICORP PORTLAND  TRICORP FEDERAL CREDIT UNION        MEWESTBROOK                Y Y        
+211370011CHELSEA BANK      CHELSEA BANK                        MACHELSEA                  Y Y20110307
+211370024MECHANICS CO OP   MECHANICS CO-OPERATIVE BANK         MAFALL RIVER               Y N20081104
+211370037FAMILY FITCHBURG  FAMILY FED SAV F.A                  MAFITCHBURG                Y Y19991224
+211370053HAVERHILL COOP    HAVERHILL COOPERATIVE BANK          MAHAVERHILL                Y Y20110211
+211370066PENT SVG HAVERHILLPENTUCKET BANK                      MAHAVERHILL                Y Y20010924
+211370082IFS NEWBURYPORT   INSTITUTION FOR SAVINGS             MANEWBURYPORT              Y N20070914
+211370134BHB, NANTUCKET BR BLUE HILLS BANK, NANTUCKET BRANCH   MABOSTON                   Y N20140117
+211370150MIDDLESEX FEDL SB MIDDLESEX FEDERAL SAVINGS FA        MAWEST SOMERVILLE          Y Y20100813
+211370176WATERTOWN SVGS    WATERTOWN SAVINGS BANK              MAWATERTOWN                Y Y        
+211370228BANKGLOUCESTER    BANKGLOUCESTER                      MAGLOUCESTER               Y Y20090714
+211370231FFS BOA FALL RIVERWEBSTER BANK, N.A.                  MASWANSEA                  Y Y20100412
+211370257EASTERN BK READINGEASTERN BANK                        MAREADING                  Y Y20081010
+211370273EVERETT COOP      EVERETT CO-OPERATIVE BANK           MAEVERETT                  Y Y        
+211370338MUTUAL FEDERAL    MUTUAL FED. SB OF PLYMOUTH CTY      MAWHITMAN                  Y N20100330
+211370354EASTERN BANK      EASTERN BANK                        MASHARON                   Y N20130919
+211370370HINGHAM INST SVGS HINGHAM INSTITUTION FOR SAV.        MAHINGHAM                  Y Y        
+211370396AVIDIA BANK       AVIDIA BANK                         MAWESTBOROUGH              Y N20071107
+211370406WINTER HILL FSB   WINTER HILL BANK                    MASOMERVILLE               Y Y20130215
+211370419E CAMBRIDGE SVGS  EAST CAMBRIDGE SAVINGS BANK         MACAMBRIDGE                Y Y        
+211370448EASTERN BANK      EASTERN BANK                        MAMIDDLEBORO               Y N20050714
+211370503MUTUAL BANK       MUTUAL BANK                         MAWHITMAN                  Y N20130926
+211370529AVIDIA BANK       AVIDIA BANK                         MAHUDSON                   Y Y20070921
+211370545TD BANK, NA       TD BANK, NA                         MAHAVERHILL                Y N20090928
+211370558SALEM FIVE SVGS BKSALEM FIVE CENTS SAVINGS BK         MASALEM                    Y Y        
+211370626COLONIAL FS QUINCYCOLONIAL FEDERAL SAVINGS BK         MAQUINCY                   Y Y        
+211370639MILLBURY SVGS BK  MILLBURY SAVINGS BANK               MAMILLBURY                 Y Y19990806
+211370642FALL RIVER FIVE   BANKFIVE                            MAFALL RIVER               Y Y20051115
+211370668COLONIAL COOP BANKCOLONIAL CO-OPERATIVE BANK          MAGARDNER                  Y N20130919
+211370697SAUGUSBANK A COOP SAUGUSBANK, A CO-OPERATIVE BANK     MASAUGUS                   Y Y20001031
+211370707FID COOP FITCHBURGFIDELITY CO-OP BANK                 MAFITCHBURG                Y Y19991224
+211370736ROLLSTONE BK & TR ROLLSTONE BANK & TRUST              MAFITCHBURG                Y Y20080225
+211370752MARLBOROUGH SVGS  MARLBOROUGH SAVINGS BANK            MAMARLBOROUGH              Y Y20120514
+211370833MELROSE COOP BANK MELROSE COOPERATIVE BANK            MAMELROSE                  Y N20000324
+211370859BRISTOL CNTY SVGS BRISTOL COUNTY SAVINGS BANK         MATAUNTON                  Y Y        
+211370862CAPEANN GLOUCESTERCAPE ANN SAVINGS BANK               MAGLOUCESTER               Y Y20000906
+211370888WINCHESTER SVGS BKTHE WINCHESTER SAVINGS BANK         MAWINCHESTER               Y Y        
+211370943IFS NEWBURYPORT   INSTITUTION FOR SAVINGS             MANEWBURYPORT              Y Y        
+211370998ATHOL SAVINGS BANKATHOL SAVINGS BANK                  MAATHOL                    Y Y        
+211371023BAYSTATE WORCESTERBAY STATE SAVINGS BANK              MAWORCESTER                Y Y19990913
+211371078CAPEFIVE HARWICHPTCAPE COD FIVE CENTS SAVINGS         MAORLEANS                  Y Y        
+211371120CAMBRIDGE SVGS    CAMBRIDGE SAVINGS BANK              MACAMBRIDGE                Y N        
+211371133EAGLE BANK        EAGLE BANK                          MAEVERETT                  Y Y19990527
+211371162PEOPLES BANK      PEOPLE'S UNITED BANK                CTBRIDGEORT                Y N20121212
+211371227MIDDLESEX NATICK  MIDDLESEX SAVINGS BANK              MANATICK                   Y Y        
+211371243RANDOLPH SVGS BK  RANDOLPH SAVINGS BANK               MARANDOLPH                 Y Y        
+211371285NORWOOD COOP BANK THE NORWOOD CO-OPERATIVE BK         MANORWOOD                  Y Y        
+211371298NO SHORE PEABODY  NORTH SHORE BANK                    MAPEABODY                  Y Y19990909
+211371311SAVERS SOUTHBRIDGESAVERS CO-OPERATIVE BANK            MASOUTHBRIDGE              Y N20000405
+211371324MILFORD FED SVGS  MILFORD FEDERAL S & L ASSOC.        MAMILFORD                  Y N        
+211371337LOWELL FIVE SVGS  LOWELL FIVE CENTS SAVINGS BK        MALOWELL                   Y Y        
+211371340METHUEN COOP      METHEUN COOPERATIVE BANK            MAMETHUEN                  Y Y19990929
+211371353GEORGETOWN SVGS   GEORGETOWN BANK                     MAGEORGETOWN               Y Y20121005
+211371366WAKEFIELD SVGS BK THE SAVINGS BANK                    MAWAKEFIELD                Y N20130919
+211371379NO MIDDLESEX AYER NORTH MIDDLESEX SAVINGS BANK        MAAYER                     Y Y        
+211371434PEOPLES BANK      PEOPLE'S UNITED BANK                CTBRIDGEPORT               Y N20110324
+211371447SOUTH SHORE BANK  SOUTH SHORE BANK                    MAWEYMOUTH                 Y Y20130411
+211371463NORTH EASTON SVGS NORTH EASTON SAVINGS BANK           MASOUTH EASTON             Y Y20080714
+211371476EASTERN BROCKTON  EASTERN BANK                        MABOSTON                   Y N20130116
+211371489BROOKLINE BANK    BROOKLINE BANK                      MABROOKLINE                Y N20040903
+211371492S-BANK WEYMOUTH   S-BANK                              MAWEYMOUTH                 Y N20081212
+211371502NEWBURYPORT FIVE  NEWBURYPORT FIVE CENT SAVING        MANEWBURYPORT              Y Y        
+211371573WINCHESTER COOP   WINCHESTER CO-OPERATIVE BANK        MAWINCHESTER               Y N        
+211371586STONEHAM COOP     STONEHAMBANK-A CO-OPERATIVE BANK    MASTONEHAM                 Y Y20030227
+211371599SPENCER SAVINGS   SPENCER SAVINGS BANK                MASPENCER                  Y Y        
+211371625BRAINTREE COOP BK BRAINTREE CO-OPERATIVE BK           MABRAINTREE                Y N20061201
+211371641CC COOP YARMTHPORTCAPE COD CO-OPERATIVE BANK          MAHYANNIS                  Y Y20090421
+211371670NO CAMBRIDGE COOP NORTH CAMBRIDGE CO-OPERATIVE        MACAMBRIDGE                Y Y19990604
+211371722DEDHAM INSTIT SVGSDEDHAM INSTITUTION FOR SAV.         MADEDHAM                   Y Y        
+211371735SOUTHBRIDGE SVGS  SOUTHBRIDGE SAVINGS BANK            MASOUTHBRIDGE              Y Y19991230
+211371764BELMONT SVGS      BELMONT SAVINGS BANK                MABELMONT                  Y Y19990610
+211371816FRAMINGHAM COOP   MUTUALONE BANK                      MAFRAMINGHAM               Y N20120803
+211371829PEOPLES FEDERAL   PEOPLES FEDERAL SAVINGS BANK        MABROOKLINE                Y N20130919
+211371845ABINGTON BANK     ABINGTON BANK                       MAABINGTON                 Y Y20100813
+211371858THE VILLAGE BANK  THE VILLAGE BANK                    MAAUBURNDALE               Y N20030922
+211371887BAYCOAST BANK     BAYCOAST BANK                       MAFALL RIVER               Y N20120113
+211371913MANSFIELD COOP BK MANSFIELD CO-OPERATIVE BANK         MAMANSFIELD                Y Y19991230
+211371926HOMETOWN COOP     HOMETOWN BANK, A COOPERATIVE BANK   MAWEBSTER                  Y Y20110727
+211371939WRENTHAM COOP BK  WRENTHAM CO-OPERATIVE BANK          MAWRENTHAM                 Y Y19991230
+211371968HOMETOWN COOP     HOMETOWN BANK, A COOPERATIVE BANK   MAATHOL                    Y Y20120321
+211371997WAKEFIELD COOP BK WAKEFIELD CO-OPERATIVE BANK         MAWAKEFIELD                Y Y        
+211372035DEAN COOP FRANKLINDEAN CO-OPERATIVE BANK              MAFRANKLIN                 Y Y        
+211372048MECHANICS TAUNTON MECHANICS CO-OPERATIVE BANK         MATAUNTON                  Y N20130920
+211372051FOXBORO FED SAV   FOXBORO FEDERAL SAVINGS             MAFOXBORO                  Y Y20040115
+211372064SAGE BANK         SAGE BANK                           MALOWELL                   Y Y20131112
+211372103WEYMOUTH BANK     WEYMOUTH  BANK                      MAEAST WEYMOUTH            Y Y20021121
+211372129PEOPLES BANK      PEOPLE'S UNITED BANK                CTBRIDGEPORT               Y N20120607
+211372145BEVERLY COOP      BEVERLY BANK                        MABEVERLY                  Y Y20140325
+211372239BAYCOAST BANK     BAYCOAST BANK                       MAFALL RIVER               Y Y20120113
+211372284SALEM FIVE CENTS  SALEM FIVE CENTS SAVINGS BK         MASALEM                    Y N20120327
+211372310WELLESLEY COOP    WELLESLEY  BANK                     MAWELLESLEY                Y Y20050524
+211372323NEWTON SO COOP BK THE VILLAGE BANK                    MANEWTON                   Y N20030922
+211372378UNIBK WHITINSVILLEUNIBANK FOR SAVINGS                 MAWHITINSVILLE             Y Y        
+211372404READING COOP BANK READING CO-OPERATIVE BANK           MAREADING                  Y Y19991230
+211372828BRIDGEWATER SVG BKBRIDGEWATER SAVINGS BANK            MARAYNHAM                  Y Y20100831
+211372844MIDDLESEX SAVINGS MIDDLESEX SAVINGS BANK              MAMEDWAY                   Y N20091001
+211372857WEBSTER FIVE SVGS WEBSTER FIVE CENT SAVINGS BK        MAAUBURN                   Y Y20030909
+211372873DUKES COUNTY SB   MARTHA'S VINEYARD SAVINGS BANK      MAEDGARTOWN                Y N20100330
+211372925MARTHA'S VINEYARD MARTHA'S VINEYARD SAVINGS BANK      MAEDGARTOWN                Y Y20071212
+211372996MARBLEHEAD BANK   MARBLEHEAD BANK                     MAMARBLEHEAD               Y Y20081107
+211373018CHARLES RIVER BK  CHARLES RIVER BANK                  MAMEDWAY                   Y Y20090109
+211373063WALPOLE COOP      WALPOLE CO-OPERATIVE BANK           MAWALPOLE                  Y Y        
+211373102CANTON INSTIT SVGSBANK OF CANTON                      MACANTON                   Y Y        
+211373115CLINTON SAVINGS   CLINTON SAVINGS BANK                MACLINTON                  Y Y19990914
+211373128SCITUATE FEDL SB  SCITUATE FEDERAL SAVINGS BANK       MASCITUATE                 Y Y        
+211373267HOLBROOK COOP BK  HOLBROOK CO-OPERATIVE BANK          MAHOLBROOK                 Y Y        
+211373348ROCKLAND CU       ROCKLAND FEDERAL CREDIT UNION       MAROCKLAND                 Y Y        
+211373429GRANITE SVG RCKPRTGRANITE SAVINGS BANK                MAROCKPORT                 Y N20081104
+211373461SEAMENS PROVINCTWNSEAMEN'S BANK                       MAPROVINCETOWN             Y N        
+211373539NEEDHAM BANK      NEEDHAM BANK                        MANEEDHAM                  Y Y20100503
+211373571MECHANICS' COOP   MECHANICS CO-OPERATIVE BANK         MATAUNTON                  Y N20100330
+211373584PILGRIM COHASSET  PILGRIM BANK                        MACOHASSET                 Y Y20120724
+211373597BANK OF EASTON    BANK OF EASTON, A CO-OP BANK        MANORTH EASTON             Y Y20021213
+211373623PEOPLES BANK      PEOPLE'S UNITED BANK                CTBRIDGEPORT               Y N20110204
+211374004WASHINGTON LOWELL WASHINGTON SAVINGS BANK             MALOWELL                   Y N20060421
+211374020PROV INST AMESBURYTHE PROVIDENT BANK                  MAAMESBURY                 Y Y19990819
+211374046BARRE SAVINGS BK  BARRE SAVINGS BANK                  MABARRE                    Y Y19991222
+211374062MERRIMAC SAVINGS  MERRIMAC SAVINGS BANK               MAMERRIMAC                 Y Y19991230
+211374091NO BROOKFIELD SVGSNORTH BROOKFIELD SAVINGS BANK       MANORTH BROOKFIELD         Y Y20140530
+211380302NESC FCU          NESC FED CREDIT UNION               MAMETHUEN                  N Y20140508
+211380344ATHOL CREDIT UNIONATHOL CREDIT UNION                  MAATHOL                    N Y20050329
+211380483HANSCOM FEDERAL CUHANSCOM FEDERAL CREDIT UNION        MABEDFORD                  N Y20040115
+211381314BRIDGEWATER CU    BRIDGEWATER CREDIT UNION            MABRIDGEWATER              N Y20130613
+211381372HARBORONE         HARBORONE BANK                      MABROCKTON                 Y Y20130705
+211381437CRESCENT CU       CRESCENT CREDIT UNION               MABROCKTON                 N Y19991213
+211381518BROOKLINE MUNI CU BROOKLINE MUNICIPAL CREDIT U        MABROOKLINE                Y Y        
+211381673CAMB PORTUGUESE CUCAMBRIDGE PORTUGUESE CREDIT         MASOMERVILLE               Y N20130920
+211381738HARVARD UNIV ECU  HARVARD UNIVERSITY EMP. CU          MACAMBRIDGE                Y Y20040122
+211381783DIRECT FCU NEEDHAMDIRECT FEDERAL CREDIT UNION         MANEEDHAM HEIGHTS          Y Y        
+211381990METRO CREDIT UNIONMETRO CREDIT UNION                  MACHELSEA                  Y Y20070425
+211382591FALL RIVER MUN CU FALL RIVER MUNICIPAL CREDIT         MAFALL RIVER               N Y20121212
+211382737ST ANNES FALL RVR ST ANNE'S CREDIT UNION              MAFALL RIVER               Y Y20100830
+211382779ST MICHAELS FCU   ST. MICHAEL'S FEDERAL CREDIT UNION  MAFALL RIVER               N Y20121218
+211382850IC CU UN FITCHBURGI-C FEDERAL CREDIT UNION            MAFITCHBURG                Y Y        
+211382931WORKERS CU FTCHBRGWORKERS CREDIT UNION                MAFITCHBURG                Y Y19991018
+211382986METROWEST COMM FCUMETROWEST COMMUNITY FEDERAL C U     MAFRAMINGHAM               YSY20130215
+211383066GFA FED CR. UN.   GFA FEDERAL CREDIT UNION            MAGARDNER                  N Y20140324
+211383723LEOMINSTER EMP FCULEOMINSTER EMPLOYEES FCU            MALEOMINSTER               N Y20050428
+211383736LEOMINSTER C U    LEOMINSTER CREDIT UNION             MALEOMINSTER               Y Y19990929
+211383846JEANNE D'ARC CU   JEANNE D'ARC CREDIT UNION           MALOWELL                   N Y19991028
+211383901ALIGN CU          ALIGN CREDIT UNION                  MALOWELL                   Y Y20130927
+211383956BROTHRHOOD CU LYNNBROTHERHOOD CREDIT UNION            MALYNN                     Y Y        
+211383972COMMUNITY CU LYNN COMMUNITY CREDIT UNION OF LYNN      MALYNN                     Y Y20040116
+211383985RIVER WORKS CU    RIVER WORKS CREDIT UNION            MALYNN                     Y N20140224
+211384117ST JEAN CU LYNN   ST. JEAN'S CREDIT UNION             MALYNN                     Y Y19991230
+211384162IAG FCU BOSTON    USALLIANCE FEDERAL CREDIT UNION     MAWALTHAM                  Y N19990811
+211384214ST MARY CU MLBRO  ST. MARY'S CREDIT UNION             MAMARLBOROUGH              Y Y19991230
+211384434MILLBURY FED CU   MILLBURY FEDERAL CREDIT UNION       MAMILLBURY                 Y Y19991230
+211384586FIRST CITZ FCU    FIRST CITIZENS'FEDERAL CREDIT UNION MANEW BEDFORD              Y Y19991230
+211384706SOUTHERN MASS CU  SOUTHERN MASS CREDIT UNION          MAFAIRHAVEN                Y Y20071206
+211384926MERRIMACK VAL CU  MERRIMACK VALLEY FCU                MALAWRENCE                 N Y20140303
+211384955GRAFTON SUBURBAN  GRAFTON SUBURBAN CREDIT UNION       MANORTH GRAFTON            Y N20110915
+211385297QUINCY CREDIT UN  QUINCY CREDIT UNION                 MAQUINCY                   YSY20131126
+211385365REVERE FIRE CU    REVERE FIREFIGHTERS CREDIT UNION    MAREVERE                   N Y19991105
+211385527RTN FEDERAL C.U.  RTN FEDERAL CREDIT UNION            MADANVERS                  Y N20090422
+211385556SHARON CR UNION   SHARON CREDIT UNION                 MASHARON                   N Y20120830
+211385569SHREWSBURY CU     SHREWSBURY CREDIT UNION             MASHREWSBURY               YSN20130930
+211385572SOMERSET CU       SOMERSET CREDIT UNION               MASOMERSET                 N Y20131104
+211385705SOUTHBRIDGE CU    SOUTHBRIDGE CREDIT UNION            MASOUTHBRIDGE              Y Y20130415
+211386445RTN FEDERAL C.U.  RTN FEDERAL CREDIT UNION            MAWALTHAM                  Y Y20070918
+211386597WEBSTER FIRST FCU WEBSTER FIRST FEDERAL CREDIT UNION  MAWORCESTER                Y Y20130329
+211386801TOUCHSTONE FCU    TOUCHSTONE FEDERAL CREDIT UNION     MAWILMINGTON               N Y20130730
+211387017ALLCOM CU         ALLCOM CREDIT UNION                 MAWORCESTER                Y Y20001026
+211387143WORCESTER CU      WORCESTER CREDIT UNION              MAWORCESTER                Y Y20111101
+211387169WORC CENTRAL FCU  CENTRAL ONE FEDERAL CREDIT UNION    MASHREWSBURY               Y N20000606
+211391650ALLOYA CORP FCU   ALLOYA CORPORATE FEDERAL CREDIT UN  MAAUBURN                   Y N20111024
+211391773EASCORP WOBURN    EASTERN CORP FCU                    MABURLINGTON               Y Y20060221
+211391825DIGITAL CU        DIGITAL FEDERAL CREDIT UNION        MAMARLBOROUGH              Y Y20140417
+211392060SOUTHCOAST HLTH SYSOUTHCOAST HEALTH SYSTEMS FCU       MANEW BEDFORD              YSN20100407
+211470018FED SAVINGS DOVER FEDERAL SAVINGS BANK                NHDOVER                    Y N        
+211470225MERRIMACK CONCORD MERRIMACK COUNTY SAVINGS BK         NHCONCORD                  Y Y        
+211470238PROFILE ROCHESTER PROFILE BANK                        NHROCHESTER                Y N        
+211472977SALEM COOP BANK   SALEM CO-OPERATIVE BANK             NHSALEM                    Y Y19991118
+211474687PISCATAQUA PORTSMHPISCATAQUA SAVINGS BANK             NHPORTSMOUTH               Y N20021007
+211489083NEW HAMPSHIRE FCU NEW HAMPSHIRE FEDERAL C.U.          NHCONCORD                  YSY20140401
+211489407MEMBERS FIRST CU  MEMBERS  FIRST CREDIT UNION OF N.H. NHMANCHESTER               Y Y20131231
+211489478TELE CU MANCHESTERBELLWETHER COMMUNITY CREDIT UNION   NHMANCHESTER               Y Y20050517
+211489494GRANITE MANCHESTERGRANITE STATE CR UNION              NHMANCHESTER               Y Y20130501
+211489601TRIANGLE FCU NASUATRIANGLE CREDIT UNION               NHNASHUA                   Y Y19990729
+211489630NORTHEAST CU      NORTHEAST CREDIT UNION              NHPORTSMOUTH               Y Y20020815
+211489656SERV CU PORTSMOUTHSERVICE CREDIT UNION                NHPORTSMOUTH               Y Y        
+211573151PEOPLES MIDDLETOWNTHE PEOPLES CREDIT UNION            RIMIDDLETOWN               Y N20040408
+211573177DEXTER C U        DEXTER CREDIT UNION                 RICENTRAL FALLS            Y N20091217
+211573229COASTWAY COMM BK  COASTWAY COMMUNITY BANK             RIPROVIDENCE               Y Y20090703
+211573313WESTERLY COMM CU  WESTERLY COMMUNITY CREDIT UN        RIWESTERLY                 YSN20091203
+211573326COVENTRY CU RI    COVENTRY CREDIT UNION               RICOVENTRY                 Y Y20101123
+211573407WAVE FEDERAL CU   WAVE FEDERAL CREDIT UNION           RIWARWICK                  N Y20140317
+211574642BANKNEWPORT       BANKNEWPORT                         RIMIDDLETOWN               Y Y20080822
+211574833ADMIRALS BANK     ADMIRALS BANK                       MABOSTON                   Y Y20101115
+211574862HOME LN INVEST BK HOME LOAN INVESTMENT BANK, F.S.B.   RIWARWICK                  Y Y20100505
+211589828NAVIGANT CR. UN   NAVIGANT CREDIT UNION               RISMITHFIELD               Y Y20070423
+211589899CRANSTON MUNICIPALCRANSTON MUNI EMP CREDIT UNION      RICRANSTON                 N Y20130730
+211590260PAWTUCKET CU      PAWTUCKET CREDIT UNION              RIPAWTUCKET                Y Y20090128
+211590493RHODE ISLAND CU   RHODE ISLAND CREDIT UNION           RIPROVIDENCE               Y Y20030324
+211590671GREENWOOD CU      GREENWOOD CREDIT UNION              RIWARWICK                  N Y20140620
+211672476BRATTLEBORO S&L   BRATTLEBORO SAVINGS & LOAN          VTBRATTLEBORO              Y Y20091030
+211672531KEY BK BURLINGTON KEYBANK NATIONAL ASSOCIATION        NYNEWBURGH                 Y N20120807
+211672609BANK OF BENNINGTONTHE BANK OF BENNINGTON              VTBENNINGTON               Y N20031120
+211672683NORTHFIELD SVGS VTNORTHFIELD SAVINGS BANK             VTBARRE                    Y Y        
+211674775PASSUMPSIC SVGS VTPASSUMPSIC SAVINGS BANK             VTST. JOHNSBURY            Y Y        
+211674788WELLS RIVER SAV BKWELLS RIVER SAVINGS BANK            VTWELLS RIVER              Y N20130923
+211691004NO CNTRY FCU SBURLNORTH COUNTRY FCU                   VTSOUTH BURLINGTON         Y Y20140310
+211691062VT FCU BURLINGTON VERMONT FEDERAL CREDIT UNION        VTBURLINGTON               Y Y20090213
+211691127NEW ENGLAND FCU   NEW ENGLAND FEDERAL CREDIT UNION    VTESSEX JUNCTION           Y Y20080930
+211691185VT ST ECU MONTPLR VERMONT STATE EMPLOYEES C.U.        VTMONTPELIER               Y Y20020814
+211691318HERITAGE FAMILY CUHERITAGE FAMILY FEDERAL CU          VTRUTLAND                  Y Y20120423
+211691334CREDIT UNION OF VTCREDIT UNION OF VERMONT             VTRUTLAND                  Y N20110901
+211770093SVGS BK WALPOLE NHSAVINGS BANK OF WALPOLE             NHWALPOLE                  Y Y        
+211770132WOODSVILLE GUAR BKWOODSVILLE GUARANTY SAVINGS         NHWOODSVILLE               Y Y        
+211770145SUGAR RVR NEWPORT SUGAR RIVER BANK                    NHNEWPORT                  Y Y20080528
+211770174FRANKLIN SVGS NH  FRANKLIN SAVINGS BANK               NHFRANKLIN                 Y Y        
+211770190CLAREMONT SVGS BK CLAREMONT SAVINGS BANK              NHCLAREMONT                Y Y        
+211770200LAKE SUNAPEE BANK LAKE SUNAPEE BANK FSB               NHNEWPORT                  Y Y20071214
+211770213MASCOMA LEBANON   MASCOMA SAVINGS BANK                NHLEBANON                  Y Y        
+211770271BANK OF NEW HAMP  BANK OF NEW HAMPSHIRE               NHLACONIA                  Y Y20120418
+211772936MEREDITH SVGS NH  MEREDITH VILLAGE SAVINGS BK         NHMEREDITH                 Y Y        
+211870142PITTSFIELD COOP   PITTS COOPERATIVE BANK              MAPITTSFIELD               Y Y19990608
+211870281GREENFIELD COOP   GREENFIELD COOPERATIVE BANK         MAGREENFIELD               Y Y        
+211870799GREENFIELD SVGS BKGREENFIELD SAVINGS BANK             MAGREENFIELD               Y Y        
+211870812BERKSHIRE BANK    BERKSHIRE BANK                      MAPITTSFIELD               Y N20120120
+211870870LEE SAVINGS       LEE BANK                            MALEE                      Y Y        
+211870935EASTHAMPTON SVGS  EASTHAMPTON SAVINGS BANK            MAEASTHAMPTON              Y Y        
+211870977HAMPDEN SVGS SPFLDHAMPDEN SAVINGS BANK                MASPRINGFIELD              Y Y20090121
+211870980COUNTRY BK WARE   COUNTRY BANK FOR SAVINGS            MAWARE                     Y Y        
+211871015MOUNTAINONE       MOUNTAINONE BANK                    MANORTH ADAMS              Y N20130719
+211871219MONSON SAVINGS    MONSON SAVINGS BANK                 MAMONSON                   Y N20060403
+211871523ADAMS COMM BK     ADAMS COMMUNITY BANK                MAADAMS                    Y Y20120224
+211871604WESTFIELD SVGS BK WESTFIELD SAVINGS BANK              MAWESTFIELD                Y Y        
+211871688FLORENCE SVGS     FLORENCE SAVINGS BANK               MAFLORENCE                 Y Y        
+211871691BERKSHIRE BANK    BERKSHIRE BANK                      MAPITTSFIELD               Y Y        
+211871714NO BROOKFIELD SVGSNORTH BROOKFIELD SAVINGS BANK       MAWARE                     Y Y20140530
+211871772PEOPLES SV HOLYOKEPEOPLESBANK                         MAHOLYOKE                  Y Y20031015
+211871866CHICOPEE SVG MASS CHICOPEE SAVINGS BANK               MACHICOPEE                 Y Y        
+211872027UNITED SPRINGFIELDUNITED BANK                         MAWEST SPRINGFIELD         Y Y20140501
+211872331NORTHAMPTON COOP  NORTHAMPTON CO-OPERATIVE BAN        MANORTHAMPTON              Y Y        
+211872959WORONOCO WESTFIELDBERKSHIRE BANK                      MAWESTFIELD                Y N20050929
+211880271UMASSFIVE         UMASSFIVE COLLEGE FEDERAL CU        MAHADLEY                   N Y20140603
+211882091POLISH NATL CU    POLISH NATIONAL CREDIT UNION        MACHICOPEE                 Y Y20100813
+211882143ALDEN CHICOPEE    ALDEN CREDIT UNION                  MACHICOPEE                 Y Y20110106
+211885250GREYLOCK CU PTSFLDGREYLOCK FEDERAL CREDIT UNION       MAPITTSFIELD               Y Y20130409
+211885836MASSMUTUAL FCU    MASS MUTUAL EMPLOYEES CREDIT        MASPRINGFIELD              N Y19991214
+211885920GRTR SPRINGFIELD  GRTR SPRNGFLD MASS MUNICIPAL ECU    MASPRINGFIELD              Y Y20110819
+211885946PIONEER VLY SPFLD PIONEER VALLEY FEDERAL C.U.         MASPRINGFIELD              Y N20120105
+211885988FREEDOM CU        FREEDOM CREDIT UNION                MASPRINGFIELD              Y Y20080508
+211977197CSE CU HARTFORD   CONN STATE EMP CU                   CTHARTFORD                 N Y20140403
+211977362NUTMEG STATE      NUTMEG STATE FEDERAL CREDIT UNION   CTROCKY HILL               N Y20130227
+221172186PEOPLES BANK      PEOPLE'S UNITED BANK                CTBRIDGEPORT               Y Y20120607
+221172212FIRST CTY STAMFORDFIRST COUNTY BANK                   CTSTAMFORD                 Y Y        
+221172238SAVINGS BK DANBURYSAVINGS BANK OF DANBURY             CTDANBURY                  Y Y19991108
+221172241UNION SAVINGS     UNION SAVINGS BANK                  CTDANBURY                  Y Y        
+221172270FAIRFIELD COUNTY  FAIRFIELD COUNTY BANK CORP.         CTRIDGEFIELD               Y Y20040105
+221172296NEWTOWN SVGS BK CTNEWTOWN SAVINGS BANK                CTNEWTOWN                  Y Y        
+221172610CITIBANK FSB CT   CITIBANK, N.A.                      NYNEW YORK                 Y N20120622
+221180806SIKORSKY FCU      SIKORSKY FINANCIAL CR. UNION, INC.  CTSTRATFORD                Y Y20010118
+221270114BCB COMMUNITY BANKBCB COMMUNITY BANK                  NJBAYONNE                  Y N20100917
+221270211SOMERSET SLA      SOMERSET SAVINGS BANK, SLA          NJBOUND BROOK              YSN20120618
+221270415NVE SVGS BK SLA   NVE BANK                            NJENGLEWOOD                YSY20140513
+221270541ORITANI BANK      ORITANI BANK                        NJTWP WASHINTON            Y Y20100813
+221270651KEARNY FSLA       KEARNY FEDERAL SAVINGS BANK         NJFAIRFIELD                Y Y20111019
+221270677SCHUYLER SLA      SCHUYLER SAVINGS BANK, SLA          NJKEARNY                   Y N20030213
+221270680LINCOLN PK SVG BK LINCOLN PARK SAVINGS BANK           NJLINCOLN PARK             Y N20041018
+221270790GILBRALTAR SLA    GIBRALTAR BANK                      NJWHIPPANY                 YSN20091116
+221270910MAGYAR BANK NJ    MAGYAR BANK                         NJNEW BRUNSWICK            Y Y20060303
+221271058HAVEN SVGS BK     HAVEN SAVINGS BANK                  NJHOBOKEN                  YSN20100308
+221271197FST SL PERTH AMBOYTHE PROVIDENT BANK                  NJWOODBRIDGE               Y N20041021
+221271359BOILING SPGS SB   BOILING SPRINGS SAVINGS BANK        NJRUTHERFORD               Y N20010627
+221271485LLEWELLYN EDISON  LLEWELLYN-EDISON SAVINGS BANK, FSB  NJWEST ORANGE              Y N        
+221271935COLUMBIA BANK NJ  COLUMBIA  BANK                      NJFAIR LAWN                Y Y20060719
+221271948FREEHOLD SAVINGS  FREEHOLD SAVINGS BANK               NJFREEHOLD                 YSY20101007
+221271951SPENCER SVGS BK   SPENCER SAVINGS BANK, SLA           NJELMWOOD PARK             Y N20100813
+221272031INVESTORS SB      INVESTORS BANK                      NJSHORT HILLS              Y Y20110908
+221272044MILLINGTON SAV NJ MILLINGTON SAVINGS BANK             NJMILLINGTON               Y Y        
+221272125WAWEL BANK        WAWEL BANK                          NJWALLINGTON               N Y20140130
+221272167MANASQUAN SVGS BK MANASQUAN SAVINGS BANK              NJMANASQUAN                Y N19991230
+221272303PROVIDENT BANK    THE PROVIDENT BANK                  NJJERSEY CITY              Y Y20020725
+221272316HUDSON CTY PARAMUSHUDSON CITY SAVINGS BANK            NJPARAMUS                  Y Y20120611
+221272329UNION CTY SVGS NJ UNION COUNTY SAVINGS BANK           NJELIZABETH                Y Y        
+221272439RSI BANK          RSI BANK                            NJRAHWAY                   Y Y20060915
+221274932L'OREAL USA FCU   L'OREAL USA FEDERAL CREDIT UNION    NJCLARK                    YSN20120507
+221275025NO JERSEY FED CU  NORTH JERSEY FEDERAL CREDIT UNION   NJTOTOWA                   Y N        
+221275216PICATINNY FCU     PICATINNY F.C.U.                    NJDOVER                    Y Y20140513
+221275711UNILEVER FCU      UNILEVER FCU                        NJENGLEWOOD CLIFFS         Y N20100401
+221275779FIRST ATLANTIC FCUFIRST ATLANTIC FEDERAL C.U.         NJEATONTOWN                Y Y20030731
+221275876GREATER ALLIANCE  GREATER ALLIANCE FED CREDIT UNION   NJHACKENSACK               Y N20091006
+221276011UNITED TELETECH   UNITED TELETECH F.C.U.              NJTINTON FALLS             Y Y20130923
+221276370ATLANTIC F.C.U.   ATLANTIC F.C.U.                     NJKENILWORTH               N Y20140214
+221276697RIEGEL FCU        RIEGEL FEDERAL CREDIT UNION         NJMILFORD                  Y N20120725
+221276998RUTGERS FCU       RUTGERS FEDERAL CREDIT UNION        NJNEW BRUNSWICK            Y N20101221
+221277599PROPONENT FCU     PROPONENT FCU                       NJNUTLEY                   Y Y20110726
+221277764SELF RELIANCE FCU SELF RELIANCE (NJ) F.C.U            NJCLIFTON                  Y Y20130403
+221277816JERSEY TRADES  FCUJERSEY TRADES  FEDERAL CREDIT UNION NJPARSIPPANY               Y N20120105
+221278129CENTRAL JERSEY FCUCENTRAL JERSEY F.C.U.               NJWOODBRIDGE               Y N20110404
+221278420FIN RESOURCES FCU FINANCIAL RESOURCES FCU             NJBRIDGEWATER              Y Y20101201
+221278488RARITAN BAY FCU   RARITAN BAY FCU                     NJSAYREVILLE               YSN20100419
+221278954GARDEN SAVINGS FCUGARDEN SAVINGS FCU                  NJPARSIPPANY               Y N20110816
+221279021PINNACLE FCU      PINNACLE FEDERAL CREDIT UNION       NJEDISON                   Y N20120402
+221283130ADP FCU           AUTOMATIC DATA PROCESSING FCU       NJROSELAND                 Y N20111201
+221283347LUSITANIA FSB NJ  LUSITANIA SAVINGS BANK, FSB         NJNEWARK                   Y N        
+221283512AFFINITY FCU      AFFINITY FEDERAL CREDIT UNION       NJBASKING RIDGE            Y N20110712
+221370030RBS CITIZENS, N.A.RBS CITIZENS, N.A.                  NYALBANY                   Y N20070905
+221370108PARTNERS TRUST BK MANUFACTURERS & TRADERS TRUST CO.   NYBUFFALO                  N Y20121213
+221370195FIRST NIAGARA BANKFIRST NIAGARA BANK, NA              NYCATSKILL                 Y N20120608
+221370205CAYUGA SAV BK     FIRST NIAGARA BANK, NA              NYAUBURN                   Y N20120608
+221370289CORTLAND SVGS BK  FIRST NIAGARA BANK, NA              NYCORTLAND                 Y N20120608
+221370399ELMIRA SAV        ELMIRA SAVINGS BANK, FSB            NYELMIRA                   Y Y        
+221370467GREENE CTY SVGS BKTHE BANK OF GREENE COUNTY           NYCATSKILL                 Y N        
+221370496FULTON SVGS BK    FULTON SAVINGS BANK                 NYFULTON                   Y N        
+221370616HUDSON CITY SAVGS FIRST NIAGARA BANK, NA              NYHUDSON                   Y N20120608
+221370878ONEIDA SVGS NY    ONEIDA SAVINGS BANK                 NYONEIDA                   Y Y19991217
+221370894PATHFINDER BKNY   PATHFINDER BANK                     NYOSWEGO                   Y Y20000107
+221370904NATL NORWICH      NBT NATIONAL ASSOCIATION            NYOSWEGO                   Y N20130626
+221371071ROME SVGS BK      BERKSHIRE BANK                      NYROME                     Y N20110520
+221371123NO COUNTRY SVGS BKNORTH COUNTRY SAVINGS BANK          NYCANTON                   Y N19990715
+221371194SENECA FALLS SVGS GENERATIONS BANK                    NYSENECA FALLS             Y N20120426
+221371259FIRST NIAGARA BANKFIRST NIAGARA BANK, NA              NYTROY                     Y N20120608
+221371356WATERTOWN SVGS BK WATERTOWN SAVINGS BANK              NYWATERTOWN                Y N20120126
+221371372PION SVS BK       PIONEER SAVINGS BANK                NYTROY                     Y Y20000403
+221371534TRUSTCO SVGS BK   TRUSTCO BANK                        NYCANAJOHARIE              Y N20121213
+221371550CANTON FSL        NORTH COUNTRY SAVINGS BANK          NYCANTON                   Y N19990701
+221371563CARTHAGE FED SAV  CARTHAGE FED.SAVINGS & LOAN ASSOC.  NYCARTHAGE                 Y Y19990810
+221371592GOUVERNEUR SLA NY GOUVERNEUR S/L ASSOC                NYGOUVERNEUR               Y N        
+221371709ADIRONDACK BANK   ADIRONDACK BANK                     NYSARANAC LAKE             Y Y20040102
+221371770SENECA FED SVGS   SENECA FEDERAL S/L ASSOC            NYBALDWINSVILLE            Y N        
+221373273CAP COMM ALBANY   CAPITAL COMMUNICATIONS FCU          NYALBANY                   Y Y20120611
+221373383STATE EMP FCU     STATE EMPLOYEES F.C.U.              NYALBANY                   Y Y20060905
+221373451AUBURN FCU        AUBURN NEW YORK F.C.U.              NYAUBURN                   Y N20121205
+221375378VISIONS FCU       VISIONS FEDERAL CREDIT UNION        NYENDICOTT                 Y Y20040408
+221375802AMERICU           AMERICU  CREDIT UNION               NYROME                     Y Y20000925
+221376539SEA COMM FCU      SEA COMM FEDERAL CREDIT UNION       NYMASSENA                  Y N20120604
+221378993COMPASS FCU       COMPASS FCU                         NYOSWEGO                   Y N20120517
+221379769SUNMARK FCU       SUNMARK FEDERAL CREDIT UNION        NYLATHAM                   Y N20090406
+221379785FIRST NY FCU      FIRST NEW YORK FCU                  NYALBANY                   Y Y20111017
+221379824SUNMARK FCU       SUNMARK FEDERAL CREDIT UNION        NYLATHAM                   Y N20080707
+221379895ACCESS FCU        ACCESS FEDERAL CREDIT UNION         NYCLINTON                  Y Y20110524
+221379905SIDNEY FCU        SIDNEY FEDERAL CREDIT UNION         NYSIDNEY                   Y N        
+221380114BERKSHIRE BANK    BERKSHIRE BANK                      MAPITTSFIELD               Y N20130315
+221380127EMPOWER FCU       EMPOWER FCU                         NYSYRACUSE                 Y Y20120720
+221380790FIRST SOURCE FCU  FIRST SOURCE FEDERAL CREDIT UNION   NYNEW HARTFORD             YSN20090916
+221380936NORTHERN FCU      NORTHERN FEDERAL CREDIT UNION       NYWATERTOWN                N Y20100121
+221381304SECNY FCU         SECNY FEDERAL CREDIT UNION          NYSYRACUSE                 Y N20101103
+221381540CFCU COMMUNITY C.UCFCU COMMUNITY CREDIT UNION         NYITHACA                   Y Y20030401
+221381715ALLOYA CORP FCU   ALLOYA CORPORATE FEDERAL CREDIT UN  NYALBANY                   Y N20131008
+221472792MASPETH FED SVGS  MASPETH FEDERAL S/L ASSOC           NYNEW YORK                 Y N20010215
+221472815ASTORIA BK        ASTORIA BANK                        NYLAKE SUCCESS             Y Y20140716
+221473652BETHPAGE FCU      BETHPAGE FEDERAL C.U.               NYBETHPAGE                 Y N        
+221475605GREATER METRO FCU GREATER METRO FEDERAL CREDIT UNION  NYLONG ISLAND CITY         YSN20110708
+221475773SPERRY ASSOC FCU  SPERRY ASSOCIATION F.C.U.           NYGARDEN CITY PARK         N Y20120601
+221475786TEACHERS FCU NY   TEACHERS FEDERAL C.U.               NYSMITHTOWN                Y Y20130621
+221475896ISLAND FCU        ISLAND FEDERAL CREDIT UNION         NYHAUPPAUGE                Y N20110711
+221476442NO SHORE HEALTH   NO.SHORE LIJ HEALTH SYSTEM FCU      NYWESTBURY                 N Y20140227
+221476688NASSAU FIN FCU    NASSAU FINANCIAL FCU                NYGARDEN CITY              Y N20111006
+221478877NONE              OCEANSIDE CHRISTOPHER FCU           NYOCEANSIDE                YSN20121221
+221480807NASSAU EDUCATOR CUNASSAU EDUCATORS FEDERAL C.U.       NYWESTBURY                 Y Y20030616
+221481181SUFFOLK FCU       SUFFOLK FEDERAL CREDIT UNION        NYMEDFORD                  YSY20131210
+221571415ORIENTAL BANK     ORIENTAL BANK                       PRSAN JUAN                 Y Y20130924
+221571473FIRSTBANK PR      FIRSTBANK PUERTO RICO               PRSAN JUAN                 Y Y20100817
+221572838DORAL BANK PR     DORAL BANK                          PRSAN JUAN                 Y Y20100225
+221572867RG FEDERAL SB PR  SCOTIABANK DE PUERTO RICO           PRSAN JUAN                 YSN20111118
+221581641COOPACA           COOPERATIVA DE AHORRO CR ARECIBO    PRARECIBO                  Y N        
+221582226COOP DE CAMUY     COOPERATIVA DE AHORRO CR DE CAMUY   PRCAMUY                    Y N        
+221970443PROVIDENT SVGS    STERLING NATIONAL BANK              NYMONTEBELLO               Y Y20131031
+221970825COMM MUTUAL SVG BKCMS BANK                            NYWHITE PLAINS             Y Y20120720
+221970980PUTNAM COUNTY SAV PUTNAM COUNTY SAVINGS BANK          NYBREWSTER                 Y Y        
+221971015RHINEBECK SVGS BK RHINEBECK BANK                      NYRHINEBECK                Y Y20120402
+221971086RONDOUT SVGS BANK RONDOUT SAVINGS BANK                NYNEW YORK                 Y N20001219
+221971138SAWYER SVGS       SAWYER SAVINGS BANK                 NYSAUGERTIES               Y N20120314
+221971264ULSTER SAV BK     ULSTER SAVINGS BANK                 NYKINGSTON                 Y Y20011220
+221971316WALDEN SVGS       WALDEN SAVINGS BANK                 NYMONTGOMERY               Y Y20070301
+221972098FFSMID            FIRST FEDERAL S/L ASSOC - MIDDLETOWNNYMIDDLETOWN               YSN20090909
+221972234WALLKILL VAL FSLA WALLKILL VALLEY FEDERAL S/L ASSOC   NYWALLKILL                 N Y20140423
+221975956TEG FCU FISHKILL  TEG FEDERAL CREDIT UNION            NYFISHKILL                 Y N        
+221976243MID HUDSON FCU    MID-HUDSON VALLEY FEDERAL C.U.      NYKINGSTON                 Y Y        
+221979363HUDSON VALLEY FCU HUDSON VALLEY FEDERAL CREDIT UNION  NYPOUGHKEEPSIE             Y Y20120612
+221981063IAG FCU RYE       USALLIANCE FEDERAL CREDIT UNION     NYRYE                      Y Y        
+221981254SUMA (YONKERS) FCUSUMA (YONKERS) FCU                  NYYONKERS                  Y Y20130724
+221981267YONKERS POSTAL FCUYONKERS POSTAL EMPLOYEE F.C.U.      NYYONKERS                  YSN20111205
+221981335QFCU              QUORUM FEDERAL CREDIT UNION         NYPURCHASE                 Y N20051130
+222080222WESTERN DIV FCU   WESTERN DIVISION FCU                NYWILLIAMSVILLE            Y N20101101
+222370440FIRST NIAGARA     FIRST NIAGARA BANK, NA              NYBUFFALO                  Y Y20120316
+222370518FIRST NIAGARA BK  FIRST NIAGARA BANK, NA              NYGENEVA                   Y N20120608
+222371054RBS CITIZENS, N.A.RBS CITIZENS, N.A.                  NYROCHESTER                Y N20070905
+222371656LAKESHORE SL NY   LAKE SHORE SAVINGS BANK             NYDUNKIRK                  Y N20131008
+222371698FAIRPORT S/L ASSOCFAIRPORT SAVINGS BANK               NYFAIRPORT                 Y Y20100907
+222371740MAPLE CITY SVGS BKMAPLE CITY SAVINGS BANK,FSB         NYHORNELL                  Y N20040513
+222371805MEDINA SVGS LOAN  MEDINA SAVINGS & LOAN ASSOC.        NYMEDINA                   YSN20080319
+222371863ESL FCU ROCH      ESL FEDERAL CREDIT UNION            NYROCHESTER                Y N20050912
+222379195GREATER WOODLAWN  GREATER WOODLAWN FCU                NYBLASDELL                 Y N20110404
+222380359CORNING FCU       CORNING FEDERAL CREDIT UNION        NYCORNING                  Y Y20101012
+222380388SERVU FCU         SERVU FCU                           NYPAINTED POST             Y N20111024
+222380443MOOG EMPLOYEE FCU MOOG EMPLOYEE F.C.U.                NYEAST AURORA              Y N20110404
+222380692FINGER LAKES F.C.UFINGER LAKES F. C. U.               NYGENEVA                   YSN20111109
+222381824OLEAN AREA FCU    OLEAN AREA FEDERAL CREDIT UNION     NYOLEAN                    YSN20140722
+222381882FIRST HERITAGE FCUFIRST HERITAGE FCU                  NYPAINTED POST             Y Y20131211
+222381918PITTSFORD FCU     PITTSFORD FEDERAL CREDIT UNION      NYMENDON                   YSY20140318
+222382221UKRAINIAN FCU     UKRAINIAN FCU                       NYROCHESTER                Y Y20101206
+222382292ADVANTAGE FCU     ADVANTAGE FEDERAL CREDIT UNION      NYROCHESTER                Y N20111115
+222382315SUMMIT FCU ROCH   SUMMIT FEDERAL CREDIT UNION, THE    NYROCHESTER                Y N20120613
+222382438RELIANT COMMUNITY RELIANT COMMUNITY CREDIT UNION      NYSODUS                    Y Y20130610
+222383479TONAWANDA VA FCU  TONAWANDA VALLEY FEDERAL C.U.       NYBATAVIA                  Y N20111027
+226070128CROSSLAND SVGS    HSBC BANK USA, NA                   NYBROOKLYN                 N Y20121213
+226070131PONCE DE LEON FSB PONCE DE LEON FEDERAL BANK          NYBRONX                    Y Y20110822
+226070306DIME SAV WLMSBURG DIME SAVINGS BANK OF WILLIAMSBURG   NYBROOKLYN                 Y Y20120111
+226070403EMIGRANT SVGS BK  EMIGRANT BANK                       NYNEW YORK                 Y Y20060201
+226070474FLUSHING SVGS BK  FLUSHING BANK                       NYFLUSHING                 Y N20130228
+226070584APPLE BANK NYC    APPLE BANK FOR SAVINGS              NYNEW YORK                 Y Y        
+226071004NEW YORK COMM BK  NEW YORK COMMUNITY BANK             NYFLUSHING                 Y Y20010330
+226071033RIDGEWOOD SVGS BK RIDGEWOOD SAVINGS BANK              NYRIDGEWOOD                Y Y20070703
+226071457NORTHFIELD BANK   NORTHFIELD BANK                     NYSTATEN ISLAND            Y Y20120613
+226072317RIDGEWOOD SVGS BK RIDGEWOOD SAVINGS BANK              NYYONKERS                  Y N20070824
+226072472CROSS COUNTY SB   CROSS COUNTY SAVINGS BANK           NYMIDDLE VILLAGE           Y Y20120404
+226072498CARVER SVGS BK    CARVER FEDERAL SAVINGS BANK         NYNEW YORK                 Y Y20120613
+226072511FOURTH FSB        NORTHEAST COMMUNITY BANK            NYWHITE PLAINS             Y N20130515
+226072841CHINATOWN FSB     CHINATOWN FEDERAL SAVINGS BANK      NYNEW YORK                 YSN20130515
+226072870ABACUS FED SVGS BKABACUS FEDERAL SAVINGS BANK         NYNEW YORK                 Y Y        
+226073895BETHEX FCU        BETHEX FEDERAL CREDIT UNION         NYBRONX                    Y N20051103
+226075482MELROSE CU        MELROSE CREDIT UNION                NYWOODSIDE                 N Y20140512
+226076083ASPIRE FCU        ASPIRE FEDERAL CREDIT UNION         NYJAMAICA                  Y N20111215
+226076135PEOPLES ALLIANCE  PEOPLE'S ALLIANCE FCU               NYHAUPPAUGE                Y N20120613
+226077943MCGRAW-HILL       MCGRAW HILL FCU                     NJEAST WINDSOR             Y Y20100817
+226078036MUNICIPAL CU      MUNICIPAL CREDIT UNION              NYNEW YORK                 Y Y20101207
+226078104NY STATE EFCU     NEW YORK STATE EFCU                 NYNEW YORK                 YSN20140722
+226078230XCEL FCU NYC      XCEL FEDERAL CREDIT UNION           NJNEW JERSEY               Y N20110407
+226078379SELF RELIANCE FCU SELF RELIANCE NEW YORK FCU          NYNEW YORK                 Y Y20140513
+226078476NORTHEASTERN FCU  NORTHEASTERN ENGINEERS FCU          NYRICHMOND HILL            Y N20070315
+226078609UNITED NATIONS FCUUNITED NATIONS F.C.U.               NYLONG ISLAND CITY         Y Y20080305
+226082022POLISH SLAVIC FCU POLISH & SLAVIC F.C.U.              NYBROOKLYN                 Y N        
+226082129NYU FCU           NEW YORK UNIVERSITY F.C.U.          NYNEW YORK                 Y Y20110407
+226082598LOWER EASTSIDE FCULOWER EASTSIDE PEOPLE'S F.C.U.      NYNEW YORK                 YSN20101215
+231175759ARTISANS BK WILM  ARTISANS' BANK                      DEWILMINGTON               Y Y20120629
+231176554DELAWARE FCU      DEL-ONE FEDERAL CREDIT UNION        DEDOVER                    Y Y20140319
+231176648DOVER FEDERAL CU  DOVER FEDERAL CREDIT UNION          DEDOVER AFB                Y Y20111116
+231176884SUSSEX COUNTY FCU SUSSEX COUNTY FCU                   DESEAFORD                  Y Y20110607
+231176949DELAWARE FIRST FCUDELAWARE FIRST FEDERAL CREDIT UNION DEWILMINGTON               Y N20081009
+231176994DEXSTA FCU        DEXSTA FCU                          DEWILMINGTON               Y Y19990902
+231270256AUDUBON SAVINGS   AUDUBON SAVINGS BANK                NJAUDUBON                  Y N20110418
+231270353OCEANFIRST BANK   OCEANFIRST BANK                     NJTOMS RIVER               Y Y19990913
+231270366CENTURYSB BRIDGETNCENTURY SAVINGS BANK                NJVINELAND                 Y N20131003
+231270405COLONIAL BANK FSB COLONIAL BANK, FSB                  NJVINELAND                 Y Y20070924
+231270654CAPE SVGS CMCH NJ CAPE BANK                           NJCAPE MAY COURT HOUSE     Y Y20100817
+231271006MILLVILLE SAVINGS MILLVILLE SAVINGS & LOAN ASSOCIATIONNJMILLVILLE                Y N20120614
+231271080OCEAN CITY HOME BKOCEAN CITY HOME BANK                NJOCEAN CITY               Y Y        
+231271239FRANKLIN SVG SALEMFRANKLIN BANK                       NJPILESGROVE               Y Y20100817
+231271242FIRST BK SEA ISLE FIRST BANK OF SEA ISLE CITY         NJSEA ISLE CITY            Y N19991008
+231271284STURDY STONE HARB STURDY SAVINGS BANK                 NJSTONE HARBOR             Y Y20001208
+231271365CENLAR FS PRINCETNCENLAR FSB                          NJTRENTON                  Y Y        
+231271501CREST SAVINGS NJ  CREST SAVINGS BANK                  NJWILDWOOD                 Y Y20010122
+231275882COLLEGE SB PRINCTNCOLLEGE SAVINGS BANK                NJPRINCETON                Y Y19990701
+231277440JERSEY SHORE FCU  JERSEY SHORE FEDERAL CREDIT UNION   NJNORTHFIELD               Y Y20120511
+231277518BRIDGETON ONIZEDCUBRIDGETON ONIZED FCU                NJVINELAND                 Y N        
+231278041ACUME CREDIT UNIONACUME CREDIT UNION                  NJMOORESTOWN               Y N20110324
+231278054NJ COMMUNITY FCU  NEW JERSEY COMMUNITY FCU            NJMOORESTOWN               Y N20110324
+231278083GARDEN STATE FCU  GARDEN STATE FCU                    NJMOORESTOWN               Y N20110324
+231278229PRINCETON FCU     PRINCETON FEDERAL CREDIT UNION      NJPRINCETON                N Y20140602
+231278274ABCO FCU RANCOCAS ABCO PUBLIC EMPLOYEES FCU           NJRANCOCAS                 Y Y20121127
+231278339FIRST FINANCIAL   FIRST FINANCIAL FEDERAL CREDIT UNIONNJTOMS RIVER               Y Y20091022
+231278614THE CREDIT UNION  THE CREDIT UNION OF NEW JERSEY      NJTRENTON                  N Y20140321
+231371618INVESTMENT SV BK  INVESTMENT SAVINGS BANK             PAALTOONA                  N Y20010803
+231371634AMBLER SAVINGS BK AMBLER SAVINGS BANK                 PAAMBLER                   Y Y20030312
+231371692IRON WORKERS BANK IRON WORKERS SAVINGS BANK           PAASTON                    Y Y20120531
+231371854FIDELITY S&L      FIDELITY S&L BUCKS COUNTY           PABRISTOL                  N Y19991015
+231371867FIRST FEDERAL SAV FIRST FED. S & L OF BUCKS CNTY      PABRISTOL                  Y N20131004
+231372028COATESVILLE SAVINGCOATESVILLE SAVINGS BK              PACOATESVILLE              N Y20120827
+231372073SLOVENIAN S&L ASSNSLOVENIAN S&L ASSN.                 PACONEMAUGH                Y N19990602
+231372112CRESSON COMMUNITY CRESSON COMMUNITY BANK              PACRESSON                  Y N20111102
+231372141SHARON SV BK DARBYSHARON SAVINGS BANK                 PADARBY                    Y Y        
+231372183THIRD FEDERAL BANK3RD FED BANK                        PANEWTOWN                  Y Y20120531
+231372248EAST STROUDSBRG   ESSA BANK & TRUST                   PAEAST STROUDSBURG         Y Y20050428
+231372361HARLEYSVILLE SAV BHARLEYSVILLE SAVINGS BANK           PAHARLEYSVILLE             Y Y20130401
+231372523HUNTINGDON VALLEY HUNTINGDON VALLEY BANK              PAHUNTINGDON VALLEY        Y Y20120123
+231372691SANTANDER BK      SANTANDER BANK, N.A.                DEWILMINGTON               Y N20131029
+231372798WILLIAMPENNBANK   WILLIAM PENN BANK                   PALEVITTOWN                N Y20120322
+231372811MIFFLIN COUNTY SAVMIFFLIN COUNTY SAVINGS BANK         PALEWISTOWN                N Y20030218
+231372921MILTON SAVINGS BANMILTON SAVINGS BANK                 PAMILTON                   Y Y20060518
+231373179MALVERN FED S&L   MALVERN FED SVGS. BANK              PAPAOLI                    Y Y        
+231373182FRST SVGS PERKASIEFIRST SAVINGS BANK OF PERKASIE      PAPERKASIE                 Y Y        
+231374916PHOENIXVILLE FEDERPHOENIXVILLE FED. S & L ASSOC.      PAPHOENIXVILLE             Y N20091231
+231375151CITIZENS SAV ASSOCCITIZENS SAVINGS BANK               PACLARKS SUMMIT            YSY20130904
+231375397RELIANCE SAVINGS  RELIANCE SAVINGS BANK               PATYRONE                   Y N20050616
+231375436DBA ALLIANCE BANK GREATER DEL VAL SB DBA ALLIANCE BANKPABROOMALL                 Y Y20140716
+231375630YORK FEDL S&L     SANTANDER BANK, N.A.                PAHARRISBURG               N Y20131016
+231379034APCI FCU ALLENTWN APCI FCU                            PALEHIGH VALLEY            Y Y20140127
+231379115PEOPLES FIRST FCU PEOPLE FIRST FCU                    PAALLENTOWN                Y N20120228
+231379131PPL GOLD          PPL GOLD CREDIT UNION               PAALLENTOWN                N Y20140618
+231379199FST COMM FCU ALLENFIRST COMMONWEALTH FCU              PALEHIGH VALLEY            Y Y20110201
+231379254ALTOONA REGIONAL  ALTOONA REGIONAL HEALTH SYSTEM FCU  PAALTOONA                  N Y20140429
+231379979PATRIOT FCU CHAMBGPATRIOT FEDERAL CREDIT UNION        PACHAMBERSBURG             Y Y        
+231380104CITADEL THORNDALE CITADEL FEDERAL CREDIT UNION        PAEXTON                    Y Y20110405
+231380133WEST BRANCH VLY CUWEST BRANCH VALLEY FCU              PAWILLIAMSPORT             Y Y19991124
+231380997BELCO COM CREDIT UBELCO COMMUNITY CREDIT UNION        PAHARRISBURG               Y N20130131
+231381116PA ST EMP HARRISBGPENNSYLVANIA STATE EMP C U          PAHARRISBURG               Y Y        
+231381569USSCO JOHNSTOWN   USSCO JOHNSTOWN FCU                 PAJOHNSTOWN                N Y20130830
+231381585UFCW LOCAL 72 FCU UFCW LOCAL 72 FCU                   PAWYOMING                  YSN20140415
+231382209SUN EAST FCU      SUN EAST FCU                        PAASTON                    N Y20140513
+231382241MEMBERS 1ST FCU   MEMBERS 1ST FEDERAL CREDIT UNION    PAMECHANICSBURG            Y Y20130110
+231382306FRANK MINT MEDIA  FRANKLIN MINT FEDERAL CREDIT UNION  PABROOMALL                 Y Y20100324
+231382458FAIRLESS CU       FAIRLESS  CU                        PAMORRISVILLE              N Y20130802
+231385154DIAMOND CU POTTS  DIAMOND CREDIT UNION                PAPOTTSTOWN                Y Y20140506
+231385167APEX COMMUNITY FCUAPEX COMMUNITY FEDERAL CREDIT UNION PASTOWE                    Y N20020304
+231385183TRI COUNTY POTTSTNTRI COUNTY AREA FCU                 PAPOTTSTOWN                Y N        
+231385400CTCE FCU READING  VISIONS FEDERAL CREDIT UNION        NYENDICOTT                 Y N20131129
+231385633UTILITIES EMPLOYEEUTILITIES EMPLOYEES CREDIT UNION    PAWYOMISSING               YSN20121017
+231385714M-C FCU           M-C FEDERAL CREDIT UNION            PADANVILLE                 N Y20140611
+231385918NET FCU           NET FEDERAL CREDIT UNION            PASCRANTON                 N Y20120723
+231385950PENN EAST FCU     PENN EAST FEDERAL CREDIT UNION      PASCRANTON                 N Y20130501
+231386221NE PA COMMUNITY   NE PA COMMUNITY FCU                 PASTROUDSBURG              YSN20111130
+231386331TOBYHANNA FCU     TOBYHANNA FEDERAL CREDIT UNION      PASCRANTON                 N Y20130129
+231386399PENN STATE FCU    PENN STATE FCU                      PASTATE COLLEGE            N Y20130227
+231386645MERCK SHARP FCU WPMERCK SHARP AND DOHME FCU           PACHALFONT                 YSY20140224
+231386865WBEE FCU          W-BEE FCU                           PAWILKES BARRE             YSN20130430
+231386881CROSS VALLEY FCU  CROSS VALLEY FCU                    PAWILKES BARRE             Y Y20121203
+231387385HERITAGE VALLEY   HERITAGE VALLEY FCU                 PAYORK                     N Y20130723
+231387550MID-ATL CORP FCU  MID-ATLANTIC CORPORATE FCU          PAMIDDLETOWN               Y Y        
+236073225UNITED SAVINGS    UNITED SAVINGS BANK                 PAPHILADELPHIA             Y Y20120209
+236073801FIRSTRUST SAV PHILFIRSTRUST SAVINGS BANK              PAPHILADELPHIA             Y Y20090529
+236073830FOX CHASE S&L PHILFOX CHASE BANK                      PABLUE BELL                Y Y20081021
+236074130WASH SAV ASSN PHILWASHINGTON SAVINGS BANK             PAPHILADELPHIA             Y N20090505
+236074444POLONIA BANK      POLONIA BANK                        PAHUNTINGDON VALLEY        Y Y20090904
+236074509PRUDENTIAL SA PHILPRUDENTIAL SAVINGS BANK             PAPHILADELPHIA             YSN20131003
+236074525QUAINTOAKBANK     QUAINT OAK BANK                     PASOUTHAMPTON              Y N20140603
+236074855PT RICHMOND SVGS  PORT RICHMOND SVGS.                 PAPHILADELPHIA             Y Y        
+236075689BENEFICIAL SAV    BENEFICIAL SAVINGS BANK             PAPHILADELPHIA             Y Y        
+236077658CAMPBELL FCU      CAMPBELL EMPLOYEES FCU              NJCAMDEN                   Y Y19990902
+236077755SOJER FCU DEPTFORDSOUTH JERSEY FEDERAL CREDIT UNION   NJDEPTFORD                 Y N        
+236082944AMER HERITAGE PHILAMERICAN HERITAGE FCU               PAPHILADELPHIA             Y Y19990625
+236084243PHILA TELCO CU    TRUMARK FINANCIAL CREDIT UNION      PAFEASTERVILLE TREVOSE     Y Y20100817
+236084285POLICE & FIRE PHILPOLICE AND FIRE FCU                 PAPHILADELPHIA             Y Y        
+236084298PHILA FCU         PHILADELPHIA FEDERAL CREDIT UNION   PAPHILADELPHIA             Y Y20130916
+236084353EAGLE ONE FED. C.UEAGLE ONE FEDERAL CREDIT UNION      PAPHILADELPHIA             Y N20030414
+236084751EDUCATION CU PHILAFREEDOM CREDIT UNION                PAWARMINSTER               Y Y20140320
+236084832UKRAINIAN SELF RELUKRAINIAN SELF RELIANCE FCU         PAPHILADELPHIA             YSY20140513
+236087664SB1 FCU           SB1 FEDERAL CREDIT UNION            PAPHILADELPHIA             Y N20111007
+241070417RBS CITIZENS, N.A.RBS CITIZENS, N.A.                  OHCLEVELAND                Y N20071018
+241070433NEW YORK COMM BK  NEW YORK COMMUNITY BANK             NYWESTBURY                 Y N20110427
+241070475PIONEER SAV CLEVE THE PIONEER SAVINGS BANK            OHCLEVELAND                Y N20020705
+241070530THIRD FSL CLEVE   THIRD FEDERAL SAVINGS & LOAN ASSOC. OHCLEVELAND                Y N20020801
+241071212FIRST FSL LAKEWOODFIRST FEDERAL S & L ASSC OF LAKEWOODOHLAKEWOOD                 Y Y        
+241075056CENTURY FCU CLEVE CENTURY FEDERAL CREDIT UNION        OHCLEVELAND                Y Y20120222
+241075124OHIO EDUC CU CLEV OHIO EDUCATIONAL CREDIT UNION, INC. OHCLEVELAND                Y N        
+241075726FIRE FIGHTERS CU  FIREFIGHTERS COMMUNITY CREDIT UNION OHCLEVELAND                N Y20110628
+241076657TALERIS CU        TALERIS CREDIT UNION, INC           OHCLEVELAND                Y Y20050804
+241078875OHIO CATHOLIC FCU OHIO CATHOLIC FCU                   OHGARFIELD HEIGHTS         Y Y20041118
+241081024CLE SELFREL PARMA CLEVELAND SELF RELIANCE FCU         OHPARMA                    Y Y        
+241081105UNITY CATHOLIC FCUUNITY CATHOLIC FEDERAL CREDIT UNION OHPARMA                    N Y20130517
+241270233FIRST FED BUCYRUS FIRST FEDERAL COMMUNITY BK, BUCYRUS OHBUCYRUS                  YSY20100802
+241270246PEO S L BUCYRUS   PEOPLES SAVINGS & LOAN COMPANY      OHBUCYRUS                  YSN20130114
+241270796CONNEAUT SAVINGS  THE CONNEAUT SAVINGS BANK           OHCONNEAUT                 YSN20120817
+241270848VALLEY CUY FALLS  VALLEY SAVINGS BANK                 OHCUYAHOGA FALLS           Y N20131230
+241270851FIRST FED DEFIANCEFIRST FEDERAL BANK OF THE MIDWEST   OHDEFIANCE                 Y Y20010620
+241270903FIRST FSL DELTA   FIRST FEDERAL SAVINGS & LOAN ASSN.  OHDELTA                    Y Y        
+241270916FST FED COMM DOVERFIRST FEDERAL COMMUNITY BANK        OHDOVER                    Y Y20011025
+241271054GALION BLDG LOAN  GALION BUILDING AND LOAN BANK       OHGALION                   Y N20010802
+241271193HOMETOWN BK KENT  HOMETOWN BANK                       OHKENT                     Y Y20111215
+241271342FIRST FED LORAIN  FIRST FEDERAL SAVINGS & LOAN ASSN.  OHLORAIN                   Y N20060424
+241271371MECH SB MANSFIELD MECHANICS BANK                      OHMANSFIELD                Y Y20101209
+241271614HOME FSL NILES    HOME FEDERAL SVGS & LOAN ASSOCIATIONOHNILES                    YSN20120725
+241271957TALMER BK & TRUST TALMER BANK AND TRUST               MITROY                     Y N20140317
+241272040WAYNE COMM WOOSTERWAYNE SAVINGS COMMUNITY BANK        OHWOOSTER                  Y Y20050124
+241272066HOME SL YOUNGSTWN THE HOME SAVINGS AND LOAN COMPANY   OHYOUNGSTOWN               Y Y        
+241272118CENTRAL FEDERAL BKCF BANK                             OHFAIRLAWN                 Y Y20040916
+241272202GEAUGA SB NEWBURY GEAUGA SAVINGS BANK                 OHNEWBURY                  Y Y20100209
+241273078BFG FCU AKRON     B F G FEDERAL CREDIT UNION          OHAKRON                    Y N        
+241273269GENFED FINANCIAL  GENFED FINANCIAL CREDIT UNION       OHAKRON                    Y N20111227
+241274077FIRELANDS BELLEVUEFIRELANDS FEDERAL CREDIT UNION      OHBELLEVUE                 Y N20131016
+241274459CANTON SCH EMP FCUCANTON SCHOOL EMPLOYEES FED CR UNIONOHCANTON                   Y Y        
+241274514GOLDEN CU CANTON  GOLDEN CIRCLE CREDIT UNION, INC.    OHMASSILLON                N Y20040517
+241278109FALLS CUYAHOGA FLSFALLS CATHOLIC CREDIT UNION, INC.   OHCUYAHOGA FALLS           Y N20120503
+241278167MIDWEST COMM FCU  MIDWEST COMMUNITY FEDERAL CU        OHDEFIANCE                 Y Y20030204
+241278785FREMONT FCU       FREMONT FEDERAL CREDIT UNION        OHFREMONT                  N Y20140318
+241279234KENT CREDIT UNION KENT CREDIT UNION, INC.             OHKENT                     Y N20130226
+241279810LORMET CU LORAIN  LORMET COMMUNITY FCU                OHAMHERST                  Y Y20130918
+241282506TOLEDO METRO FCU  TOLEDO METRO FED. CREDIT UNION      OHTOLEDO                   N Y20130627
+241282849SEVEN CU WARREN   SEVEN SEVENTEEN CREDIT UNION INC    OHWARREN                   Y Y19991108
+242071004CHEVIOT SAVINGS BKCHEVIOT SAVINGS BANK                OHCINCINNATI               Y N20120308
+242071017CINCI FSL         CINCINNATI FEDERAL SAVINGS & LOAN   OHCINCINNATI               YSN20120831
+242071088COLUMBIA SB CINCI THE COLUMBIA SAVINGS BANK           OHCINCINNATI               N Y20140602
+242071282FOUNDATION BANK   FOUNDATION BANK                     OHCINCINNATI               YSN20081110
+242071509GUARDIAN SVG CINCIGUARDIAN SAVINGS BANK, F.S.B.       OHCINCINNATI               Y Y20010726
+242071541MT WASHINGTON SB  MT WASHINGTON SAVINGS BANK          OHCINCINNATI               Y N20140414
+242071664EAGLE CINCINNATI  EAGLE SAVINGS BANK                  OHCINCINNATI               Y N20090114
+242071897WINTON SVGS CINCI WESBANCO BANK WHEELING              OHCINCINNATI               Y N20100318
+242072391MIAMI SL MIAMITOWNTHE MIAMI SAVINGS AND LOAN COMPANY  OHMIAMITOWN                Y N        
+242076601CINCI CENTRAL CU  CINCINNATI CENTRAL CREDIT UNION, INCOHCINCINNATI               Y Y20040326
+242076656CINFED CU CINCI   CINFED EMP. FEDERAL CREDIT UNION    OHCINCINNATI               Y Y        
+242076672GREATER CINCI CU  GREATER CINCINNATI CREDIT UNION, INCOHCINCINNATI               Y N20020422
+242076698CINTEL FCU CINCI  CINTEL FEDERAL CREDIT UNION         OHCINCINNATI               YSN20140604
+242076711COMM ART CU CINCI COMMUNICATING ARTS CREDIT UNION, INCOHCINCINNATI               Y N        
+242076821GE EVENDALE CINCI GENERAL ELECTRIC CREDIT UNION       OHCINCINNATI               YSN20130301
+242076973KEMBA CU CINCI    KEMBA CREDIT UNION, INC             OHCINCINNATI               Y Y20010815
+242077312SHAREFAX BATAVIA  SHAREFAX CREDIT UNION, INC.         OHBATAVIA                  Y Y20120907
+242170028HOME FSL ASHLAND  TOWN SQUARE BANK                    KYASHLAND                  Y Y20140626
+242170426HOME MIDDLESBORO  HOME FEDERAL BANK CORPORATION       KYMIDDLESBORO              Y Y20011221
+242175528MEMBERS CU ASHLANDMEMBERS CHOICE CREDIT UNION         KYASHLAND                  Y Y20130903
+242175557ASHLAND EMPLY C U ASHLAND CREDIT UNION                KYASHLAND                  Y N20100129
+242175955HEALTH ED LEXINGTNTHE HEALTH EDUCATION FCU            KYLEXINGTON                Y N        
+242175984GREATER KENTUCKY  GREATER KENTUCKY CREDIT UNION       KYLEXINGTON                Y N20140110
+242176006MEMBERS LEXINGTON MEMBERS HERITAGE FED'L CREDIT UNION KYLEXINGTON                Y Y19990831
+242176048LEXINGTON POSTL CULEXINGTON POSTAL CREDIT UNION, INC. KYLEXINGTON                Y N        
+242176051METRO LEXINGTON   METRO EMPLOYEES CREDIT UNION, INC.  KYLEXINGTON                YSN20090312
+242176129UNIV OF KY FCU LEXUNIVERSITY OF KENTUCKY FEDERAL C. U.KYLEXINGTON                Y Y        
+242271969COVINGTON SAVINGS THE COVINGTON SAVINGS & LOAN ASSN   OHCOVINGTON                Y Y19990901
+242272159GREENVILLE FSL    GREENVILLE FEDERAL                  OHGREENVILLE               Y N20090410
+242272227NCB FSB HILLSBORO NCB, F.S.B.                         OHHILLSBORO                Y Y20110822
+242272269LIBERTY IRONTON   LIBERTY BANK                        OHIRONTON                  Y N20140627
+242272285SOUTHERN HILLS BK SOUTHERN HILLS COMMUNITY BANK       OHLEESBURG                 Y N20110405
+242272311UNION LOVELAND    THE UNION SAVINGS BANK              OHCINCINNATI               Y Y20120828
+242272324LIBERTY WILMINGTONLIBERTY SAVINGS BANK, FSB           OHWILMINGTON               Y Y20120413
+242272366PEOPLES BLDG MASONPEOPLES FIRST SAVINGS BANK          OHMASON                    Y Y20090105
+242272625AMERICA PORTSMOUTHAMERICAN SAVINGS BANK, FSB          OHPORTSMOUTH               N Y20110929
+242272670RIPLEY FEDERAL S/BRIPLEY FEDERAL SAVINGS & LOAN ASSN  OHRIPLEY                   Y N20050929
+242272793HOME SPRINGFIELD  HOME CITY FEDERAL SAVINGS BANK      OHSPRINGFIELD              Y Y20080225
+242272816WESBANCO BANK INC WESBANCO BANK WHEELING              OHSPRINGFIELD              Y N20100318
+242272861MONROE TIPP CITY  MONROE FEDERAL SAVINGS & LOAN ASSN  OHTIPP CITY                YSY20120620
+242272874MAINSOURCE BANK   MAINSOURCE BANK                     INGREENSBURG               Y N20100120
+242274403WILMINGTON SAVINGSTHE WILMINGTON SAVINGS BANK         OHWILMINGTON               Y Y20121031
+242277808DAY AIR CU DAYTON DAY AIR CREDIT UNION, INC.          OHDAYTON                   Y Y20131008
+242277905INCENTA FCU       INCENTA FEDERAL CREDIT UNION        OHENGLEWOOD                Y N20110805
+242278072UNIVERSAL DAYTON  UNIVERSAL 1 CREDIT UNION, INC.      OHDAYTON                   Y Y20131106
+242278124AFFINIA FCU DAYTONAFFINIA FEDERAL CREDIT UNION        OHDAYTON                   Y N20010508
+242278179STAN REG CU DAYTONSTANDARD REGISTER FED. CREDIT UNION OHDAYTON                   Y Y        
+242278360CHACO CU HAMILTON CHACO CREDIT UNION, INC.            OHHAMILTON                 Y Y        
+242278409HAMILTON CITY EFCUALLWEALTH FEDERAL CREDIT UNION      OHHAMILTON                 Y N20060824
+242278713RIVER MIAMISBURG  RIVER VALLEY CREDIT UNION, INC.     OHMIAMISBURG               YSN20060607
+242278742MIDUSA MIDDLETWN  MID USA CREDIT UNION                OHFRANKLIN                 Y N20100914
+242278823DESCO PORTSMOUTH  DESCO FEDERAL CREDIT UNION          OHPORTSMOUTH               Y Y20121120
+242279068INTNL SPRINGFIELD INTERNATIONAL HARVESTOR EMP CREDIT UOHSPRINGFIELD              N Y20131125
+242279408WRIGHT PATT FAIRBNWRIGHT-PATT CREDIT UNION, INC.      OHBEAVERCREEK              Y Y20140428
+242370776MERCER CELINA     MERCER SAVINGS BANK                 OHCELINA                   YSN20120817
+242372758PEOPLES SIDNEY    PEOPLES FEDERAL SAVINGS & LOAN      OHSIDNEY                   YSN20130613
+243073616EUREKA PITTSBURGH EUREKA BANK                         PAPITTSBURGH               Y Y20020415
+243073904PROGRESSIVE PITTS PROGRESSIVE-HOME FED SVGS & LOAN    PAPITTSBURGH               Y N        
+243074071WEST VIEW SB PITTSWEST VIEW SAVINGS BANK              PAPITTSBURGH               YSY        
+243074385DOLLAR BANK PITTS DOLLAR BANK, FEDERAL SAVINGS BANK   PAPITTSBURGH               Y Y        
+243083237CLEARVIEW FCU     CLEARVIEW FEDERAL CREDIT UNION      PAMOON TOWNSHIP            Y Y20041013
+243083240ALLEGENT FCU      ALLEGENT COMMUNITY FEDERAL CU       PAPITTSBURGH               Y Y20120924
+243083347OMEGA FCU         OMEGA FEDERAL CREDIT UNION          PAPITTSBURGH               YSY20100628
+243084294RIVERSET CU       RIVERSET CREDIT UNION               PAPITTSBURGH               YSN20130219
+243373112BRENTWOOD PITT    BRENTWOOD BANK                      PABETHEL PARK              Y Y19990805
+243373170CHARLEROI FSB     CHARLEROI FEDERAL SAVINGS BANK      PACHARLEROI                YSY20120810
+243373222ESB BANK          ESB BANK                            PAELLWOOD CITY             Y Y20060413
+243373248MARQUETTE ERIE    MARQUETTE SAVINGS BANK              PAERIE                     Y Y20140624
+243373358INDIANA FST SV BK INDIANA FIRST SAVINGS BANK          PAINDIANA                  YSY20091019
+243373387WESTMORLND LATROBEWESTMORELAND FED SVGS & LOAN ASSOC  PALATROBE                  Y N20030404
+243373442FIRST FSB MONESSENFIRST FEDERAL SAVINGS BANK          PAMONESSEN                 Y Y20090914
+243374124SEWICKLY S&L      SEWICKLEY SAVINGS BANK              PASEWICKLEY                N Y19990730
+243374153SSL CANONSBURG    SLOVENIAN SAVINGS & LOAN ASSN.      PASTRABANE                 N Y19991026
+243374218NORTHWEST WARREN  NORTHWEST SAVINGS BANK              PAWARREN                   Y Y        
+243374221WASHINGTON FSL    WASHINGTON FINANCIAL BANK           PAWASHINGTON               Y Y20091222
+243374250STAND MONROEVILLE STANDARD BANK, PASB                 PAMONROEVILLE              Y Y20041004
+243379556MON FCU ALLENPORT MON VALLEY COMMUNITY FED CR UNION   PAALLENPORT                Y N        
+243379585NEW ALLIANC AMBRDGNEW ALLIANCE FEDERAL CREDIT UNION   PAAMBRIDGE                 Y N        
+243379718BEAVER VALLEY FCU BEAVER VALLEY FEDERAL CREDIT UNION  PABEAVER FALLS             Y N        
+243380192CLARION FEDERAL CUCLARION FEDERAL CREDIT UNION        PACLARION                  Y N20100927
+243380451UNITED W MIFFLIN  UNITED COMMUNITY FCU                PAWEST MIFFLIN             YSN20100322
+243380516CENTURY DUQUESNE  CENTURY HERITAGE FEDL CREDIT UNION  PAWEST MIFFLIN             N Y20131022
+243380927ERIE FEDERAL CU   ERIE FEDERAL CREDIT UNION           PAERIE                     Y Y20030930
+243381117ERIE G E FCU      WIDGET FEDERAL CREDIT UNION         PAERIE                     Y N20130430
+243382747TRI BORO MUNHALL  TRI BORO FEDERAL CREDIT UNION       PAMUNHALL                  Y Y        
+243385456WASHINGTON FCU    WASHINGTON COMMUNITY FED. CR. UNION PAWASHINGTON               YSN20140421
+243470183DOOLIN N MARTINSVLDOOLIN SECURITY SAVINGS BANK, FSB   WVNEW MARTINSVILLE         Y Y20101201
+243474273HANCOCK CHESTER   HANCOCK COUNTY SAVINGS BANK, F.S.B. WVCHESTER                  Y Y        
+2434856731ST CHOICE WEIRTONFIRST CHOICE AMERICA COMMUNITY FCU  WVWEIRTON                  Y Y20061030
+244077022MEMBERS 1ST CR UN PATHWAYS FINANCIAL CREDIT UNION, INCOHCOLUMBUS                 Y N20130731
+244077035BMI FCU COL       BMI FEDERAL CREDIT UNION            OHDUBLIN                   Y Y20090213
+244077093PATHWAYS FINAN CU PATHWAYS FINANCIAL CREDIT UNION, INCOHGAHANNAS                 YSN20130806
+244077255CME FED CU COL    CME FEDERAL CREDIT UNION            OHCOLUMBUS                 Y Y20131219
+244077271MIDSTATE EDUCATORSEDUCATION FIRST CREDIT UNION        OHCOLUMBUS                 Y N20101216
+244077323TELHIO CU COL     TELHIO CREDIT UNION, INC.           OHCOLUMBUS                 Y Y19991026
+244077556KEMBA FIN COLUMBUSKEMBA FINANCIAL CREDIT UNION, INC.  OHGAHANNA                  Y N20080225
+244077682FST SRVC GROVEPORTFIRST SERVICE FEDERAL CREDIT UNION  OHGROVEPORT                Y N        
+244077886CREDIT UNION OF OHCREDIT UNION OF OHIO                OHCOLUMBUS                 N Y20130913
+244084264CORP ONE COLS     CORPORATE ONE FCU, INC.             OHCOLUMBUS                 Y Y20100709
+244170165CITZ BELLEFONTAINECITIZENS FED SVGS & LOAN ASSOCIATIONOHBELLEFONTAINE            Y N20050216
+244170259CALDWELL SAV LN   COMMUNITY SAVINGS                   OHCALDWELL                 Y Y20140110
+244170877MIDWEST DEGRAFF   UNITED MIDWEST SAVINGS BANK         OHDE GRAFF                 Y Y19991217
+244171245FAIRFLD S/L LANC  FAIRFIELD FEDERAL SVGS & LOAN ASSOC OHLANCASTER                Y Y20020722
+244171575FST FSL NEWARK    FIRST FEDERAL SAVINGS & LOAN ASSOCIAOHNEWARK                   Y Y        
+244171915PERPTL FSB URBANA PERPETUAL FEDERAL SAVINGS BANK      OHURBANA                   Y N        
+244172008PEOPLES W LIBERTY THE PEOPLES SAVINGS AND LOAN COMPANYOHWEST LIBERTY             Y N        
+244180139MARION ERIE CU    MARION COMMUNITY CREDIT UNION, INC. OHMARION                   N Y20130117
+244271543NELSONVILLE HOME  NELSONVILLE HOME AND SAVINGS        OHNELSONVILLE              Y N20130401
+244273826OHIO UNIVERSITY CUOHIO UNIVERSITY CREDIT UNION        OHATHENS                   N Y20130621
+244274786HOMELAND CU, INC  HOMELAND CREDIT UNION, INC.         OHCHILLICOTHE              Y N20030930
+251072968FRANKLIN FED SA BKFRANKLIN FEDERAL SAVINGS BK         VAGLEN ALLEN               Y Y20130509
+251082136CALL FCU          CALL FCU                            VARICHMOND                 Y N20120411
+251082149CONNECTS FCU      CONNECTS FEDERAL CREDIT UNION       VARICHMOND                 Y N20120206
+251082194HENRICO FCU       HENRICO FCU                         VARICHMOND                 Y N20040504
+251082288ENTRUST FCU       ENTRUST FINANCIAL CREDIT UNION      VARICHMOND                 Y N20111103
+251082424PARTNERS FIN FCU  PARTNERS FINANCIAL FCU              VAHENRICO                  Y Y20130411
+251082466RICH POSTAL FCU   RICHMOND POSTAL CREDIT UNION        VARICHMOND                 Y N        
+251082495NEWGENERATIONS FCUNEW GENERATIONS FEDERAL CREDIT UNIONVARICHMOND                 Y N20070307
+251082615VIRGINIA CU RICH  VIRGINIA CREDIT UNION, INC.         VARICHMOND                 Y Y19991004
+251082644DOMINION CU       DOMINION CREDIT UNION               VARICHMOND                 YSY20140505
+251472542SHORE BK ONLEY VA SHORE BANK                          VAONLEY                    Y Y        
+251472885VIRGINIA COM BK   VIRGINIA COMMONWEALTH BANK          VAPETERSBURG               Y Y20120521
+251473512FI SENTINEL RICHLDFIRST SENTINEL BANK                 VARICHLANDS                Y Y        
+251480181UVA COMMUNITY CU  UVA COMMUNITY CREDIT UNION          VACHARLOTTESVILLE          Y N20111219
+251480288NAE FCU CHESAPK   N A E FCU                           VACHESAPEAKE               Y Y20081014
+251480327CHESTERFIELD FCU  CHESTERFIELD FCU                    VACHESTERFIELD             Y N20140624
+251480372WECCU CU          WECCU CU                            VACOVINGTON                Y N20110120
+251480398NSWC FCU DAHLGREN NSWC FEDERAL CREDIT UNION           VADAHLGREN                 Y N        
+251480563FIRST ADVANTAGE CU1ST ADVANTAGE FEDERAL CREDIT UNION  VANEWPORT NEWS             Y Y20140326
+251480576FT. LEE FCU       FORT LEE FCU                        VAPRINCE GEORGE            Y N20120418
+251480592BRONCO FCU FRANKLNBRONCO FEDERAL CREDIT UNION         VAFRANKLIN                 YSN20060801
+251480738LANGLEY FCU HAMPTNLANGLEY FEDERAL CREDIT UNION        VANEWPORT NEWS             Y Y20040301
+251480806PARK VIEW FCU     PARK VIEW FCU                       VAHARRISONBURG             Y N20000301
+251481041LYNCHBURG MEFCU   LYNCHBURG MUNICIPAL EMPL FCU        VALYNCHBURG                Y N20110623
+251481122BEACON CU         BEACON CREDIT UNION                 VALYNCHBURG                Y N20101018
+251481355VAECU NEWPORT NEWSVIRGINIA EDUCATORS CREDIT UNION     VANEWPORT NEWS             Y N19991110
+251481368BAYPORT CU        BAYPORT CREDIT UNION                VANEWPORT NEWS             Y Y20130325
+251481423BANK @LANTEC      BANK @LANTEC                        VAVIRGINIA BEACH           Y Y20100602
+251481478SUMMIT FCU        SUMMIT HR FEDERAL CREDIT UNION      VANORFOLK                  Y N20110301
+251481614CHARTWAY FCU VABCHCHARTWAY FEDERAL CREDIT UNION       VAVIRGINIA BEACH           Y Y20140331
+251481627A B N B FCU       ABNB FEDERAL CREDIT UNION           VACHESAPEAKE               Y Y20121010
+251481643NFD FCU VA BCH    NORFOLK FIRE DEPT. FCU              VANORFOLK                  Y N        
+251481672PORTALLIANCE FCU  PORTALLIANCE FEDERAL CREDIT UNION   VANORFOLK                  N Y20130306
+251481766ODU CU NORFOLK VA OLD DOMINION UNIV. CU INC.          VANORFOLK                  Y N        
+251481779NAVY PWC NORVA BCHNMA FEDERAL CREDIT UNION            VAVIRGINIA BEACH           Y N20111007
+251481928GUARDIAN FCU PORTSGUARDIAN FCU                        VAPORTSMOUTH               Y N        
+251481957NORTHERN STAR CU  NORTHERN STAR CU                    VAPORTSMOUTH               Y Y20120320
+251482833MEMBER FCU ROANOKEMEMBER ONE FCU                      VAROANOKE                  Y Y        
+251482891BLUE EAGLE CU     BLUE EAGLE CREDIT UNION             VAROANOKE                  Y N20130124
+251483023FREEDOM FIRST FCU FREEDOM FIRST FCU                   VASALEM                    Y Y20120822
+251483214VA BEACH SCH FCU  VIRGINIA BEACH SCHOOLS FCU          VAVIRGINIA BEACH           N Y20140403
+251483311DUPONT COMM CU    DUPONT COMMUNITY CREDIT UNION       VAWAYNESBORO               Y Y20040510
+251573182CITZ BK MORGANTOWNCITIZENS BANK OF MORGANTOWN         WVMORGANTOWN               Y Y        
+251578776HOPE FCU          HOPE FCU                            WVCLARKSBURG               YSN20110509
+251578844FAIRMONT FCU WVA  FAIRMONT FCU                        WVFAIRMONT                 Y Y19990802
+251579254MOUNTAIN HERIT FCUMOUNTAIN HERITAGE FCU               WVPARKERSBURG              Y N20120321
+251579319ONE COMMUNITY FCU ONE COMMUNITY FCU                   WVPARKERSBURG              Y N20120402
+251583839WV CENTRAL CU     WV CENTRAL CREDIT UNION             WVPARKERSBURG              YSY20110615
+251584197WV FED CU SCHAS   WEST VIRGINIA FEDERAL CREDIT UNION  WVSOUTH CHARLESTON         YSN20051209
+251983691STAR USA FCU      STAR USA FEDERAL CREDIT UNION       WVCHARLESTON               Y N        
+251983730PIONEER WV FCU    PIONEER WV FCU                      WVCHARLESTON               Y N20030327
+251983798MEMBERS CHOICE WV MEMBERS CHOICE WV FCU               WVCHARLESTON               YSN20110217
+251983811PEOPLES FCU       PEOPLES FCU                         WVNITRO                    YSN20140311
+252070299ARUNDEL FSB BALT  ARUNDEL FEDERAL SAVINGS BANK        MDGLEN BURNIE              Y Y20090226
+252070545CHESAPK BK BALT   CHESAPEAKE BANK OF MARYLAND         MDBALTIMORE                Y N        
+252070561COLOMBO BK BETHSDACOLOMBO BANK                        MDROCKVILLE                Y Y20020221
+252070639EASTERN BALT      EASTERN SAVINGS BANK, FSB.          MDHUNT VALLEY              Y Y20080929
+252070752FIRST MARINER BALTFIRST MARINER BANK                  MDBALTIMORE                Y Y        
+252070891HAMILTON FED BK   HAMILTON BANK                       MDTOWSON                   Y Y20120409
+252070985HOPKINS FSB BALT  HOPKINS FEDERAL SAVINGS BANK        MDBALTIMORE                Y Y        
+252071146LIBERTY FEDERAL   LIBERTY BANK OF MARYLAND            MDBALTIMORE                YSN20120608
+252071159BAY VANGUARD BALT BAY-VANGUARD FSB                    MDBALTIMORE                YSN20120820
+252071214MAD BOH SVNGS BK  MADISON BANK OF MARYLAND            MDFOREST HILL              Y N20090903
+252071227MADSQ FSB BALT    MADISON SQUARE FEDERAL SAVINGS BANK MDBALTIMORE                Y N        
+252071557ROSEDALE FED S&LA ROSEDALE FED. S&L ASSN.             MDNOTTINGHAM               Y N20131030
+252071654BAY BANK FSB      BAY BANK FSB                        MDBEL AIR                  Y N20140626
+252071706ST CASIMIRS SB BALSAINT CASIMIR'S SAVINGS BANK        MDBALTIMORE                YSN        
+252075757BALT C EFCU TOWSONBALTIMORE COUNTY EMP FCU            MDBALTIMORE                Y Y        
+252076235J HOPKINS FCU BALTJOHNS HOPKINS FCU                   MDBALTIMORE                Y Y        
+252076468MUNICIPAL ECU BALTMUNICIPAL EMP CU OF BALTIMORE       MDBALTIMORE                Y N20060126
+252076565PT BREEZE CU BALT POINT BREEZE CU                     MDHUNT VALLEY              Y N20020815
+252076578POST OFFICE CU    POST OFFICE CU OF MARYLAND, INC.    MDBALTIMORE                YSN20140317
+252076714SECURITYPLUS FCU  SECURITYPLUS FCU                    MDBALTIMORE                Y Y20060331
+252076808MEMBERS FIRST MD  MEMBERS FIRST OF MARYLAND FCU       MDBALTIMORE                YSN20140331
+252172485FIRST SHORE FSL   FIRST SHORE FED. S&L ASSN.          MDSALISBURY                Y Y20110801
+252177121FI PEO FCU CUMBRLDFIRST PEOPLES COMMUNITY FCU         MDCUMBERLAND               Y Y        
+252177150CHESSIE EFCU CUMB CHESSIE FCU                         MDCUMBERLAND               YSN20140227
+252177176KELCO FCU CUMBERLDKEL CO. FCU                         MDCUMBERLAND               Y Y19991207
+252178201WEPCO FCU BLOOMGTNWEPCO FCU                           MDBLOOMINGTON              Y Y20090716
+253074977CAROLINA TELCO FCUCAROLINA TELCO FED. CU              NCCHARLOTTE                N Y20140318
+253075028CHAR METRO CU NC  CHARLOTTE METRO FEDERAL CREDIT UNIONNCCHARLOTTE                Y N20090212
+253075303SHARON FCU CHAR   SHARONVIEW FEDERAL CREDIT UNION     SCFORT MILL                Y Y20140414
+253170062ASHEVILLE SAV BK  ASHEVILLE SAVINGS BANK, SSB         NCASHEVILLE                Y Y20050808
+253170088BELMONT FED S&L   BELMONT FED S&L ASSOC               NCBELMONT                  Y N20111222
+253170279HOMETRUST BANK    HOMETRUST BANK                      NCASHEVILLE                Y Y20030729
+253170334FST FED BK DUNN NCFIRST FEDERAL BANK                  NCDUNN                     YSN20081208
+253170486MACON BANK INC    MACON BANK, INC                     NCFRANKLIN                 Y Y20040514
+253170758KENLY SB          KS BANK, INC                        NCKENLY                    Y Y20080215
+253170884FFSB LINCOLNTON   FIRST FED SAVINGS BANK OF LINCOLNTONNCLINCOLNTON               Y N20000713
+253171003MORGANTON FSL NC  MORGANTON SAVINGS BANK, SSB         NCMORGANTON                Y N20130405
+253171294ROXBORO SB        ROXBORO SAVINGS BANK, SSB           NCROXBORO                  Y N        
+253171537TAYLORSVILLE SB   TAYLORSVILLE SAVINGS BANK, SSB      NCTAYLORSVILLE             Y N        
+253171621FIRST SOUTH BANK  FIRST SOUTH BANK                    NCWASHINGTON               Y Y20010409
+253171676AF BANK           LIFESTORE BANK                      NCWEST JEFFERSON           Y Y20090928
+253171773PIED FSL WINSTON  PIEDMONT FEDERAL SAVINGS BANK       NCWINSTON SALEM            Y Y20100819
+253174738TELCO COMM ASHEVLETELCO COMMUNITY CREDIT UNION        NCASHEVILLE                Y N20111028
+253174893MARINE FCU JAX NC MARINE FCU                          NCJACKSONVILLE             Y Y        
+253174903CHAMPION CU CANTONCHAMPION CREDIT UNION               NCCANTON                   Y N20061013
+253175384FI FLIGHT HAVELOCKFIRST FLIGHT FEDERAL CREDIT UNION   NCCARY                     Y N20110203
+253175397CAROLINA FCU      CAROLINA FCU                        NCCHERRYVILLE              N Y20140124
+253175494COAST FCU RALEIGH COASTAL FEDERAL CREDIT UNION        NCRALEIGH                  Y N        
+253175724BRAG MFCU FAYETVLEBRAGG MUTUAL FEDERAL CU             NCFAYETTEVILLE             Y N        
+253175737FORT BRAGG FCU    FORT BRAGG FCU                      NCFORT BRAGG               Y Y20130502
+253175889PREMIER FCU GREENSPREMIER FCU                         NCGREENSBORO               Y Y20140115
+253176286CORNING CS CU HKY CORNING CABLE SYSTEMS CU            NCHICKORY                  Y N20000808
+253177049STATE ECU RALEIGH STATE EMPLOYEES CU                  NCRALEIGH                  Y Y        
+253177117RTP FCU RTP NC    R T P FEDERAL CREDIT UNION          NCRTP                      Y Y20130111
+253177793MEMBERS CU WINSTONMEMBERS CREDIT UNION                NCWINSTON SALEM            Y N20130405
+253177832TRULIANT FCU WS NCTRULIANT FEDERAL CREDIT UNION       NCWINSTON SALEM            Y N20100819
+253177861PACU WS NC        PIEDMONT ADVANTAGE CU               NCWINSTON SALEM            Y Y20140219
+253177887ALLEGACY FCU NC   ALLEGACY FEDERAL CREDIT UNION       NCWINSTON-SALEM            Y Y20010104
+253177968WINSTON SALEM CECUWINSTON-SALEM FEDERAL CREDIT UNION  NCWINSTON SALEM            N Y20140617
+253184317FIR CU GREENSBORO FIRST CAROLINA CORPORATE CU         NCGREENSBORO               Y Y        
+253184537LOCAL GOVERNMENT  LOCAL GOVERNMENT FCU                NCRALEIGH                  YSY20140205
+253184841SELF HELP CU NC   SELF-HELP CREDIT UNION              NCDURHAM                   Y Y20100114
+253184854ECUSTA CU PF NC   ECUSTA CREDIT UNION                 NCPISGAH FOREST            YSN        
+253271806ABBEVILLE FIRST BKABBEVILLE FIRST BANK                SCABBEVILLE                Y N20131028
+253271822SECURITY FB AIKEN SECURITY FEDERAL BANK               SCAIKEN                    Y Y        
+253271916FIRST PALMETTO BK FIRST PALMETTO BANK                 SCCAMDEN                   Y Y20130829
+253271945FIRST FEDERAL BANKSOUTH STATE BANK - FF DIVISION      SCNORTH CHARLESTON         Y N20140722
+253272009SPRATT SL CHESTER SPRATT S&L ASSN.                    SCCHESTER                  Y Y20121026
+253272148FI PIED SL GAFFNEYFIRST PIEDMONT FED. S&L ASSN.       SCGAFFNEY                  Y N        
+253272342PEE DEE FSB MARIONPEE DEE FEDERAL SAVINGS BANK        SCMARION                   Y N        
+253272384PICKENS SL SC     PICKENS SAVINGS & LOAN ASSN, F.A.   SCPICKENS                  Y N20100121
+253272481PARK STERLING BANKPARK STERLING BANK                  SCUNION                    Y Y20140430
+253272494FIR FED WALTERBOROFIRST FEDERAL OF SC, FSB            SCWALTERBORO               Y N        
+253272533WOODRUFF FEDL S&L WOODRUFF FED. S&L ASSN.             SCWOODRUFF                 YSN20100409
+253278090SRP FCU AIKEN     SRP FED CREDIT UNION                SCAIKEN                    Y Y20101217
+253278126ANMED HEALTH FCU  ANMED HEALTH FEDERAL CREDIT UNION   SCANDERSON                 YSN20100205
+253278139ANDERSON FCU      ANDERSON FCU                        SCANDERSON                 YSN20100506
+253278223MCAS BEAUFORT FCU CPM FEDERAL CU                      SCBEAUFORT                 N Y20101231
+253278362HERITAGE FCU CHAS HERITAGE TRUST FEDERAL CREDIT UNION SCCHARLESTON               Y Y20070515
+253278401SC FCU CHARLESTON SOUTH CAROLINA FEDERAL CREDIT UNION SCNORTH CHARLESTON         Y N20110209
+253278870DIXIES FEDERAL CU DIXIES FCU                          SCDARLINGTON               Y Y20090831
+253279031ALLSOUTH FCU      ALLSOUTH FEDERAL CREDIT UNION       SCCOLUMBIA                 Y Y20040302
+253279099GEORGETWN KRAFT CUGEORGETOWN KRAFT CREDIT UNION       SCGEORGETOWN               Y N20000808
+253279196GREENVILLE FCU SC GREENVILLE FEDERAL CREDIT UNION     SCGREENVILLE               Y N        
+253279277SC TEL FCU GREENVLSOUTH CAROLINA TELCO FCU            SCGREENVILLE               Y Y20121003
+253279390SPC CCU HARTSVLE  SPC CREDIT UNION                    SCHARTSVILLE               Y N20110201
+253279439FOUNDERS FCU SC   FOUNDERS FEDERAL CREDIT UNION       SCLANCASTER                Y Y        
+253279510CAROLINA TR FCU   CAROLINA TRUST FEDERAL CREDIT UNION SCMYRTLE BEACH             Y Y20130627
+253279536CPM FCU CHAS SC   CPM FEDERAL CU                      SCCHARLESTON               Y N20121031
+253279659FAMILY TR FCU RH  FAMILY TRUST FCU                    SCROCK HILL                Y Y20130122
+253279691SAFE FCU SHAW AFB SAFE FCU                            SCSUMTER                   Y Y19990804
+253978617PALMETTO CITZ FCU PALMETTO CITIZENS FCU               SCCOLUMBIA                 Y Y20110214
+253978730SC STATE CU COLA  SC STATE FEDERAL CREDIT UNION       SCCOLUMBIA                 Y Y20140304
+253978743SOUTH CAROLINA NATSOUTH CAROLINA NATIONAL GUARD FCU   SCCOLUMBIA                 Y Y20100419
+254070116CITIBANK FSB WASH CITIBANK, N.A.                      DCWASHINGTON               Y N20120622
+254070132OBA BK            OBA BANK                            DCWASHINGTON               Y Y20090602
+254074031AFL CIO EFCU WASH A F L C I O EMPLOYEES FCU           MDLANDOVER                 Y N20100830
+254074057AGRIC FCU WASH DC AGRICULTURE FCU                     VAALEXANDRIA               Y Y20050927
+254074170BK STAFF FCU WASH BANK FUND STAFF FCU                 DCWASHINGTON               Y Y20130913
+254074183IDB IIC FCU WASH  IDB-IIC FEDERAL CREDIT UNION        DCWASHINGTON               Y Y20140414
+254074277CENSUS FCU SUIT MDCENSUS FCU                          MDSUITLAND                 Y N19990805
+254074345WP CONG FCU WASH  WRIGHT PATMAN CONGRESSION FCU       DCWASHINGTON               Y Y        
+254074400CONSTELLATION FCU CONSTELLATION FCU                   DCWASHINGTON               Y N19990805
+254074413JUSTICE FCU WASH  JUSTICE FCU                         DCWASHINGTON               Y Y19990805
+254074439DEPT COMM FCU DC  DEPARTMENT OF COMMERCE FCU          DCWASHINGTON               Y N        
+254074442DEPT INT FCU WASH DEPARTMENT OF INTERIOR FCU          DCWASHINGTON               Y Y        
+254074468DC TEACH FCU WASH DIST COLUMBIA TEACHERS FCU          DCWASHINGTON               Y N20000315
+254074536PARTNERSHIP FCU   THE PARTNERSHIP FEDERAL CREDIT UNIONDCWASHINGTON               Y Y20090604
+254074581FRB FCU           F R B FCU                           DCWASHINGTON               Y Y20120910
+254074662GSA FCU WASH DC   GSA FCU                             DCWASHINGTON               YSN20061013
+254074688HEW FCU WASH      HEW FCU                             VAALEXANDRIA               Y Y20120223
+254074730HUD FCU WASH      H U D FEDERAL CREDIT UNION          DCWASHINGTON               YSY20140701
+254074785FEDCHOICE FCU     FEDCHOICE FEDERAL CREDIT UNION      DCWASHINGTON               Y N20060908
+254074811LAFAY FCU KENSNGTNLAFAYETTE FCU                       MDKENSINGTON               Y N        
+254074837LIBRARY OF CONGRESLIBRARY OF CONGRESS FCU             MDHYATTSVILLE              Y N20070416
+254074934NAPUS FCU ALEX VA NAPUS FEDERAL CU                    VAALEXANDRIA               Y N        
+254075014NLRB FCU WASH     N L R B FEDERAL CREDIT UNION        DCWASHINGTON               Y N        
+254075043NRL FCU ALEXANDRIAN R L FCU                           VAALEXANDRIA               Y Y20131209
+254075072PAHO WHO FCU WASH PAHO/WHO FCU                        DCWASHINGTON               Y N20050622
+254075111PEPCO FCU WASH DC PEPCO FCU                           DCWASHINGTON               YSY20130814
+254075137POLICE FCU        POLICE FCU                          MDUPPER MARLBORO           Y N20081212
+254075250US SEN EFCU WASH  US SENATE EMPLOYEES FCU             DCWASHINGTON               Y Y19990805
+254075386TRANSIT EMPL FCU  TRANSIT EMPLOYEES FCU               DCWASHINGTON               N Y20140113
+254075399TRANSPORT FCU WASHTRANSPORTATION FCU                  DCWASHINGTON               Y Y        
+254075409TREAS FCU WASH    TREASURY DEPARTMENT FCU             DCWASHINGTON               Y Y20050830
+254075438NARFE PREMIER  FCUNARFE PREMIER FEDERAL CREDIT UNION  DCWASHINGTON               Y Y20140606
+254075441US POST FCU WASH  US POSTAL SERVICE FCU               MDCLINTON                  Y Y20050829
+254075454ENERGY FCU ROCKVLEENERGY FCU                          MDROCKVILLE                Y N        
+254075470DVA FCU WASH      DVA FEDERAL CREDIT UNION            DCWASHINGTON               Y N20041222
+255071444SEVERN ANNAPOLIS  SEVERN SAVINGS BANK, FSB            MDANNAPOLIS                Y Y20100629
+255071981CAPITAL ONE NA    CAPITAL ONE, NA                     MDLAUREL                   Y N20100830
+255072029THE PATAPSCO BANK THE PATAPSCO BANK                   MDDUNDALK                  Y Y20110127
+255072045CECIL  BANK       CECIL BANK                          MDELKTON                   Y Y20091216
+255072252JARRETTSVILLE FED JARRETTSVILLE FED. S&L ASSN.        MDJARRETTSVILLE            Y Y20131120
+255072362REGA BT OWING MILLREGAL BANK & TRUST                  MDOWINGS MILLS             Y Y20000127
+255072537CARROLL COMNTY BK CARROLL COMMUNITY BANK              MDSYKESVILLE               Y N20100701
+255072582PRINCE GEORGES FSBPRINCE GEORGES FEDERAL SAVINGS BANK MDUPPER MARLBORO           YSN20010924
+255072595COMMUNITY BANK CHECOMMUNITY BANK OF THE CHESAPEAKE    MDWALDORF                  Y Y20131105
+255073251AMER FSB ROCKVILLEAMERICAN BANK                       MDROCKVILLE                Y Y20101110
+255073345PRES BANK BETHESDAPRESIDENTIAL BANK, FSB              MDBETHESDA                 Y Y20081021
+255074111ANDREW CU SUITLANDANDREWS FCU                         MDSUITLAND                 Y Y20140109
+255074988NYMEO FCU         NYMEO FEDERAL CREDIT UNION          MDFREDERICK                Y Y20130409
+255075495SIGNAL FIN FCU    SIGNAL FINANCIAL FCU                MDKENSINGTON               Y Y20140304
+255075576APG FCU ABERDEEN  ABERDEEN PROVING GROUND FCU         MDABERDEEN                 Y Y20001004
+255076753SECU OF MD        STATE EMPLOYEES CREDIT UNION        MDLINTHICUM                Y Y20051026
+255076892HAR-CO CU BEL AIR HAR-CO CREDIT UNION                 MDBEL AIR                  Y Y20131001
+255076928GEICO FCU         GEICO FCU                           DCWASHINGTON               YSY20130618
+255076944NIHFCU BETHESDA   NATL INSTITUTE OF HEALTH FCU        MDROCKVILLE                Y Y        
+255076973TAYLOR MODEL BASINTAYLOR MODEL BASIN FCU              MDCABIN JOHN               Y Y20050629
+255077008EDUC SYS FCU      EDUCATIONAL SYSTEMS FCU             MDGREENBELT                Y Y20100127
+255077299FREEDOM FCU       FREEDOM OF MARYLAND FCU             MDABERDEEN PROVING GROUND  Y Y20050215
+255077370TOW CU ANNAPOLS JTTOWER FCU                           MDANNAPOLIS JUNCTION       Y Y19990805
+255077477MID ATL FCU MD    MID-ATLANTIC FCU                    MDGERMANTOWN               Y Y20050725
+255077493MARKET USA FCU    MARKET USA FCU                      MDLAUREL                   Y Y20041223
+255077516BULLDOG FCU MD    BULL DOG FCU                        MDHAGERSTOWN               N Y20100924
+255077600MONEY ONE FCU MD  MONEY ONE FCU                       MDLARGO                    Y Y20121204
+255077671FIR FIN LUTHERVLE FIRST FINANCIAL FEDERAL CREDIT UNIONMDLUTHERVILLE              Y Y20131024
+255077736CEDAR POINT FCU   CEDAR POINT FCU                     MDLEXINGTON PARK           Y Y        
+255077833NASA FCU BOWIE    NASA FEDERAL CREDIT UNION           MDBOWIE                    Y Y20140328
+255077901FEDFINANCIAL  FCU FEDFINANCIAL FEDERAL CREDIT UNION   MDROCKVILLE                Y N20070410
+255077998APL FCU LAUREL    A P L FEDERAL CREDIT UNION          MDLAUREL                   Y Y20140415
+255078188PG EFCU UPPER MARLPRINCE GEORGE'S COMMUNITY FCU       MDUPPER MARLBORO           Y N20110415
+255083597MARRIOTT EFCU WASHMARRIOTT EMPLOYEES FCU              MDBETHESDA                 Y Y20130226
+256072691ETRADE BANK       E TRADE BANK                        VAARLINGTON                Y Y20030414
+256074974NAVY FCU WASH     NAVY FCU                            VAVIENNA                   Y Y20070330
+256075025NWFCU HERNDON     NORTHWEST FCU                       VAHERNDON                  Y Y        
+256075342ST DEPT FCU ARLINGSTATE DEPARTMENT FCU                VAARLINGTON                Y Y20130308
+256075520WASH GAS LIGHT FCUWASHINGTON GAS LIGHT FCU            VASPRINGFIELD              Y N20100901
+256078365COMM ONE FCU ALEX COMMONWEALTH ONE FCU                VAALEXANDRIA               Y Y19991123
+256078404ARLINGTON CMNTY   ARLINGTON COMMUNITY FCU             VAARLINGTON                Y N20120308
+256078433PTO FCU ARLING VA PATENT & TRADEMARK OFFICE FCU       VAALEXANDRIA               YSN20060208
+256078446PENTAGON CU ALEX  PENTAGON FEDERAL CREDIT UNION       VAALEXANDRIA               Y Y20050727
+256078501FAIRFAX CO ECU VA FAIRFAX COUNTY FEDERAL CU           VAFAIRFAX                  N Y20140714
+256078514APPLE FCU FAIRFAX APPLE FEDERAL CREDIT UNION          VAFAIRFAX                  Y Y19991118
+256078543HC SYS CU FALLS CHHEALTHCARE SYSTEMS FEDERAL CU       VAFALLS CHURCH             N Y20130819
+256078569BELVOIR FCU VA    BELVOIR FEDERAL CREDIT UNION        VAWOODBRIDGE               Y Y20100603
+257079033167TH T F R FED CU167TH T F R FED CU                  WVMARTINSBURG              Y N20010423
+261071315DELTA CCU         DELTA COMMUNITY CREDIT UNION        GAATLANTA                  Y Y20090902
+261071328STHRN CU          SOUTHERN  CREDIT UNION              GAFAYETTEVILLE             Y Y20130628
+261071438GA'S OWN CU       GEORGIA'S OWN CREDIT UNION          GAATLANTA                  YSN20070913
+261071522MUTUAL SVGS CU GA MUTUAL SAVINGS CREDIT UNION         GAATLANTA                  Y Y20110711
+261071548EXCEL FCU ATL     EXCEL FEDERAL CREDIT UNION          GANORCROSS                 Y Y20110307
+261071564COCA-COLA FAM FCU COCA COLA COMPANY FAMILY FCU, THE   GAATLANTA                  Y Y20110621
+261073591BOND COMM FCU GA  BOND COMMUNITY FEDERAL CREDIT UNION GAATLANTA                  Y N20101104
+261170533HERITAGE BK       HERITAGE BANK                       GAJONESBORO                Y Y20010126
+261170818STEPHENS FB GA    STEPHENS FEDERAL BANK               GATOCCOA                   YSN20091027
+261170876CHARTERBANK GA    CHARTERBANK                         GAWEST POINT               Y Y20090701
+261171228MIDSOUTH FCU      MIDSOUTH FEDERAL CREDIT UNION       GAMACON                    YSN20001226
+261171244CDC FCU ATL       CDC FEDERAL CREDIT UNION            GAATLANTA                  Y Y        
+261171309GA UNITED CU      GEORGIA UNITED CREDIT UNION         GADULUTH                   Y N20101230
+261171338FECU ATL          ASSOCIATED CREDIT UNION             GANORCROSS                 Y Y20081028
+261171383COOSA VALLEY FCU  COOSA VALLEY FEDERAL CREDIT UNION   GAROME                     YSN20020718
+261171480LGE COMMUNITY CU  LGE COMMUNITY CREDIT UNION          GAMARIETTA                 Y Y20080208
+261171493CREDIT UNION OF GACREDIT UNION OF GEORGIA             GAWOODSTOCK                YSY20110825
+261171587RFCU              ROBINS FEDERAL CREDIT UNION         GAWARNER ROBINS            Y Y20110817
+261171671KINETIC CU        KINETIC CREDIT UNION                GACOLUMBUS                 N Y20140311
+261172308EMORY ALLIANCE CU EMORY ALLIANCE CREDIT UNION         GAATLANTA                  Y Y20130530
+261174432MEMBERS 1ST CU GA MEMBERS FIRST CREDIT UNION          GADECATUR                  Y Y20111003
+261174759PEACH STATE FCU GAPEACH STATE FEDERAL CREDIT UNION    GALAWRENCEVILLE            YSN20130627
+261184367CATALYST CORP FCU CATALYST CORPORATE FCU              TXPLANO                    Y N20110907
+261187173NORTHWEST GA CU   NORTHWEST GEORGIA CREDIT UNION      GAROME                     YSY20010829
+261270679FAMILY BANK GA    FAMILY BANK                         GAPELHAM                   Y N20090624
+2612708311ST FED S&L ASC GA1ST FEDERAL SAVINGS & LOAN ASSOC    GAVALDOSTA                 Y N20090806
+261270857VIDALIA FED SAV/LNVIDALIA FEDERAL SAVINGS             GAVIDALIA                  YSN20120208
+261271115HERITAGEBANK      HERITAGEBANK OF THE SOUTH           GAALBANY                   Y Y20110317
+261271500SOUTHEASTERN FCU  SOUTHEASTERN FEDERAL CREDIT UNION   GAMOODY A F B              YSN20100819
+261271649UNITED 1ST FCU    UNITED 1ST FEDERAL CREDIT UNION     GASAINT MARYS              YSN20010618
+261271717ATL COAST BK FL   ATLANTIC COAST BANK                 FLJACKSONVILLE             Y N20130114
+261272046FIVE STAR CU AL   FIVE STAR CREDIT UNION              ALDOTHAN                   Y Y20140508
+261272651MARSHLAND COMM FCUMARSHLAND COMMUNITY FCU             GABRUNSWICK                Y Y20110420
+261272826INTERSTE UNLTD FCUINTERSTATE UNLIMITED FCU            GAJESUP                    Y N20110921
+261273155SAVANNAH POSTAL CUSAVANNAH POSTAL CREDIT UNION        GASAVANNAH                 Y N19990729
+261291247SOUTH GEORGIA BANKSOUTH GEORGIA BANK                  GAGLENNVILLE               YSY20140307
+261375684TN VALLEY FCU     TENNESSEE VALLEY FCU                TNCHATTANOOGA              Y N20100817
+261388406COMTRUST FCU      COMTRUST FEDERAL CREDIT UNION       TNCHATTANOOGA              YSN20050201
+262083881AMERS FST FCU     AMERICA'S FIRST FEDERAL CREDIT UNIONALBIRMINGHAM               Y Y20100806
+262083991ECO CRDIT UNION ALECO CREDIT UNION                    ALBIRMINGHAM               YSN20121107
+262085070ENERGEN CU AL     ENERGEN CREDIT UNION                ALBIRMINGHAM               Y N20130626
+262086561UNIVERSITY FED CU LEGACY COMMUNITY FCU                ALBIRMINGHAM               Y Y20060227
+262086749SECURE FIRST CU   SECURE FIRST CREDIT UNION           ALBIRMINGHAM               Y Y20140514
+262087528ALABAMA TELCO CU  ALABAMA TELCO CREDIT UNION          ALHOOVER                   Y Y20130906
+262090120CORP AMER CU      CORPORATE AMERICA CREDIT UNION      ALIRONDALE                 Y Y20070112
+262183749ARMY AVIATION     ARMY AVIATION CENTER FCU            ALDALEVILLE                Y Y20120323
+262184515FST FED S&L       FIRST FEDERAL BANK                  ALTUSCALOOSA               Y N        
+262275835REDSTONE FED CU   REDSTONE FEDERAL CREDIT UNION       ALHUNTSVILLE               Y Y        
+262275958MAX CU MONTGMRY   MAX CREDIT UNION                    ALMONTGOMERY               Y Y20081008
+262276397ALABAMA STATE FCU ALABAMA STATE FCU                   ALMONTGOMERY               YSY20140107
+262277011LISTERHILL CU     LISTERHILL CREDIT UNION             ALSHEFFIELD                Y Y20130909
+262277118HERITAGE SO FCU   HERITAGE SOUTH CREDIT UNION         ALSYLACAUGA                Y N20080529
+262277189ALABAMA ONE CU AL ALABAMA ONE CREDIT UNION            ALTUSCALOOSA               N Y20140610
+262277419ALABAMA CU        ALABAMA CREDIT UNION                ALTUSCALOOSA               N Y20140606
+262283665FIRST SOUTHERN BK FIRST SOUTHERN BANK                 ALFLORENCE                 N Y20121002
+262284279FAMILY SEC CR UN  FAMILY SECURITY CREDIT UNION        ALDECATUR                  N Y20120105
+262284460SOUTHFIRST BK     SOUTHFIRST BANK                     ALSYLACAUGA                Y Y20120201
+262286109FST TUSKEGEE      FIRST TUSKEGEE BANK                 ALTUSKEGEE INSTITUTE       N Y        
+262286170AOD FCU           AOD FEDERAL CREDIT UNION            ALBYNUM                    N Y20140716
+262287195FAMILY SAVINGS  ALFAMILY SAVINGS CREDIT UNION         ALGADSDEN                  YSY20130830
+262287218FIRST COMM BK CULLFIRST COMMUNITY BANK OF CULLMAN     ALCULLMAN                  Y N20110719
+262287386PINNACLE BK JASPERPINNACLE BANK                       ALJASPER                   Y Y20011206
+263078918STATE EMP CU JAX  STATE EMPLOYEES CREDIT UNION        FLJACKSONVILLE             Y Y        
+263078934COMM FST CU OF FL COMMUNITY FIRST CREDIT UNION OF FL  FLJACKSONVILLE             Y Y20140220
+263078950DUVAL FCU FL      DUVAL FEDERAL CREDIT UNION          FLJACKSONVILLE             Y N20120420
+263079014FIRST FLORIDA CU  FIRST FLORIDA CREDIT UNION          FLJACKSONVILLE             Y Y20130820
+263079043121 FINANC CU FL  121 FINANCIAL CREDIT UNION          FLJACKSONVILLE             Y N20100928
+263079182JAX METRO CU FL   JAX METRO CREDIT UNION              FLJACKSONVILLE             Y Y20130801
+263079234JAX FCU FL        JAX FEDERAL CREDIT UNION            FLJACKSONVILLE             Y Y20111123
+263079276VYSTAR CU         VYSTAR CREDIT UNION                 FLJACKSONVILLE             Y Y20100317
+263079289CITY POLICE FCU FLCITY & POLICE FEDERAL CREDIT UNION  FLJACKSONVILLE             N Y20130821
+263079409COASTLINE CU JAX  COASTLINE FEDERAL CREDIT UNION      FLJACKSONVILLE             Y N        
+263079412FIRST FLORIDA CU  FIRST FLORIDA CREDIT UNION          FLJACKSONVILLE             Y N20070511
+263177686FOCUS CU          FOCUS CREDIT UNION                  FLCHATTAHOOCHEE            Y N20110505
+263177741PINELLAS CNTY FCU PINELLAS FEDERAL CREDIT UNION       FLLARGO                    Y N20111013
+263177903SPACE COAST CU    SPACE COAST CREDIT UNION            FLMELBOURNE                Y Y20100407
+263178070EGLIN FED CU      EGLIN FEDERAL CREDIT UNION          FLFORT WALTON BEACH        Y Y        
+263178410FLORIDA CR UNION  FLORIDA CREDIT UNION                FLGAINESVILLE              Y N20100304
+263178452SUNSTATE FCU FL   SUNSTATE FEDERAL CREDIT UNION       FLGAINESVILLE              YSY20130412
+263178478CAMPUS USA CU     CAMPUS USA  CREDIT UNION            FLGAINESVILLE              Y Y20030725
+263179532LAUNCH FCU        LAUNCH FEDERAL CREDIT UNION         FLMERRITT ISLAND           Y Y20140708
+263179804MIDFLORIDA CU     MIDFLORIDA CU                       FLLAKELAND                 Y N20091007
+263179817PUBLIX EMPLOY FCU PUBLIX EMPLOYEES FCU                FLLAKELAND                 Y Y20100806
+263179956MCCOY FCU         MCCOY FEDERAL CREDIT UNION          FLORLANDO                  Y Y        
+263181229INSIGHT FIN CU    INSIGHT CREDIT UNION                FLORLANDO                  Y N20100422
+263181368FAIRWINDS FCU     FAIRWINDS CREDIT UNION              FLORLANDO                  Y Y19991209
+263181384CEN FLA EFCU      CENTRAL FLORIDA EDUCATORS FCU       FLLAKE MARY                Y Y20090127
+263181407ORLANDO FED CU    ORLANDO FEDERAL CREDIT UNION        FLORLANDO                  Y Y20030604
+263181575SPACE CST CU      SPACE COAST CREDIT UNION            FLMELBOURNE                Y N        
+263182037COMMUNITY CU OF FLCOMMUNITY CREDIT UNION OF FLORIDA   FLROCKLEDGE                Y Y20131025
+263182082SEMINOLE SCH FCU  SEMINOLE SCHOOLS FCU                FLSANFORD                  Y N20100812
+263182312ACHIEVA CU        ACHIEVA CREDIT UNION                FLLARGO                    Y N20041029
+263182516FIRST COMMERCE CU FIRST COMMERCE CREDIT UNION         FLTALLAHASSEE              Y Y20140317
+263182529FLAG CREDIT UNION FLAG CREDIT UNION                   FLTALLAHASSEE              Y N20130515
+263182545FLORIDA ST UNIV CUFLORIDA STATE UNIVERSITY CU         FLTALLAHASSEE              YSN20121114
+263182558ENVISION CU       ENVISION CREDIT UNION               FLTALLAHASSEE              Y Y20090911
+263182794GTE FED CU        GTE FEDERAL CREDIT UNION            FLTAMPA                    Y Y20100421
+263182817SUNCOAST  FL      SUNCOAST CREDIT UNION               FLTAMPA                    Y Y20140117
+263182914GROW FINANCIAL FCUGROW FINANCIAL FCU                  FLTAMPA                    Y Y20100107
+263183010TAMPA BAY FED CU  TAMPA BAY FEDERAL CREDIT UNION      FLTAMPA                    Y Y        
+263183159USFFCU            USF FEDERAL CREDIT UNION            FLTAMPA                    YSY20130701
+263183175TYNDALL FCU       TYNDALL FEDERAL CREDIT UNION        FLPANAMA CITY              Y Y19990716
+263183492GULF STATES FCU FLGULF STATES FEDERAL CREDIT UNION    FLMAITLAND                 YSN20120404
+263184488FIRST FED LIVE OAKFIRST FEDERAL BANK OF FLORIDA       FLLAKE CITY                Y Y20100819
+263184815URBAN TRUST       URBAN TRUST BANK                    FLLAKE MARY                Y N20120201
+263185018SUNSHINE ST       SUNSHINE STATE BANK                 FLPLANT CITY               YSN20140717
+263186318REGIONS BANK FL   REGIONS BANK                        FLINVERNESS                N Y20110726
+263186871FIRST FED SAV LOANREGIONS BANK                        FLNEW SMYRNA BEACH         N Y20000313
+263187320SURETY BANK       SURETY BANK                         FLDELAND                   Y Y20131212
+263189069SE CORP FCU       CORPORATE ONE FEDERAL CREDIT UNION  FLTALLAHASSEE              Y N20130228
+263189218MARTIN FCU        MARTIN FEDERAL CREDIT UNION         FLINVERNESS                Y N20100819
+263191387BB&T FLORIDA      BB&T NORTHERN FLORIDA               FLTALLAHASSEE              Y N20021120
+263277670HARVESTERS FCU    HARVESTERS FCU                      FLCANTONMENT               YSN20120502
+263281556INNOVATIONS FCU   INNOVATIONS FEDERAL CREDIT UNION    FLPANAMA CITY              Y Y20110308
+263281679GULF WINDS FCU    GULF WINDS FEDERAL CREDIT UNION     FLPENSACOLA                Y Y20140618
+263281695PEN AIR FCU       PEN AIR FEDERAL CREDIT UNION        FLPENSACOLA                Y Y20001127
+264081179SE FINANCIAL CU TNSOUTHEAST FINANCIAL CREDIT UNION    TNNASHVILLE                YSN20120111
+264171209FIRST ADVAN BK TN FIRST ADVANTAGE BANK                TNCLARKSVILLE              Y Y20111107
+264171267HIGHLAND FED S & LHIGHLAND FEDERAL SAVINGS & LOAN     TNCROSSVILLE               Y N20050929
+264171270FIRST FEDERAL BANKFIRST FEDERAL BANK                  TNDICKSON                  Y Y20030822
+264171500SECURITY FEDERAL  SECURITY FED SVG BANK OF MCMINNVILLETNMCMINNVILLE              Y N20021003
+264172169PROCRESSIVE BANK  PROGRESSIVE SAVINGS BANK            TNJAMESTOWN                Y N20101109
+264174303AMERICAN BK & TR  AMERICAN BK & TR OF THE CUMBERLANDS TNLIVINGSTON               Y N20021003
+264181448OLD HICKORY CU    OLD HICKORY EMPLOYEES CREDIT UNION  TNOLD HICKORY              Y N        
+264181626ASCEND FC         ASCEND FEDERAL CREDIT UNION         TNTULLAHOMA                Y Y20061211
+264182120FT CAMPBELL FCU   FORT CAMPBELL FEDERAL CREDIT UNION  TNCLARKSVILLE              Y Y20090810
+264182395VOL CORP NASHVILLEVOLUNTEER CORPORATE CREDIT UNION    TNNASHVILLE                Y Y20110103
+264271183ATHENS FED COMM BKATHENS FEDERAL COMMUNITY BANK  N    TNATHENS                   Y N19990625
+264271345GREENEVILLE FED BKGREENEVILLE FEDERAL BANK FSB        TNGREENEVILLE              Y N19991021
+264271390HOME FED KNOX     HOME FEDERAL SAVINGS BANK OF TN     TNKNOXVILLE                Y Y        
+264271468VOLUNTEER FED     VOLUNTEER FED SAV & LOAN ASSN       TNMADISONVILLE             Y Y20080109
+264271510JEFFERSON FED BANKJEFFERSON FEDERAL BANK              TNMORRISTOWN               Y N20030724
+264271617NEWPORT FED BANK  NEWPORT FEDERAL BANK                TNNEWPORT                  Y N20030206
+264278283ALCOA TENN FCU    ALCOA TENN FEDERAL CREDIT UNION     TNALCOA                    Y N        
+264278461BOWATER EML CU    BOWATERS EMPLOYEES CREDIT UNION     TNCALHOUN                  YSN20060619
+264279321APPALACHN COM FCU APPALACHIAN COMMUNITY FCU           TNKINGSPORT                YSN20120418
+264279350EASTMAN CR UNION  EASTMAN CREDIT UNION                TNKINGSPORT                Y Y20140117
+264279567KNOXVIL TVA EMP CUKNOXVILLE TVA EMPLOYEES CREDIT UNIONTNKNOXVILLE                Y N20110726
+264279606KNOXVILLE TCH FCU KNOXVILLE TEACHERS FCU              TNKNOXVILLE                Y Y20101004
+264281364ENRICHMENT FCU    ENRICHMENT FEDERAL CREDIT UNION     TNOAK RIDGE                Y N20071108
+264281416ORNL FCU          ORNL FEDERAL CREDIT UNION           TNOAK RIDGE                Y Y        
+264281432Y12 FCU           Y12 FEDERAL CREDIT UNION            TNOAK RIDGE                Y N        
+265070435GULF COAST BT NO  GULF COAST BANK & TRUST COMPANY     LANEW ORLEANS              Y Y        
+265070532FIDELITY HMSTD SVGFIDELITY HOMESTEAD SAVINGS BANK     LANEW ORLEANS              Y Y20090727
+265070545FIFTH DIST SAV BK FIFTH DISTRICT SAVINGS BANK         LANEW ORLEANS              YSY20050412
+265070574BK OF NEW ORLEANS BANK OF NEW ORLEANS                 LAMETAIRIE                 Y Y20090423
+265070590HIBERNIA BK LA    HIBERNIA BANK                       LANEW ORLEANS              Y N20110701
+265070671MUTUAL SAV AND LN MUTUAL SAVINGS AND LOAN ASSOCIATION LAMETAIRIE                 Y Y19991230
+265070736ST INVSTR BK LA   STATE-INVESTORS BANK                LANEW ORLEANS              N Y20101202
+265070749UNION S&L ASSOC LAUNION SAVINGS & LOAN ASSOCIATION    LANEW ORLEANS              Y N20110127
+265075087ASI FED CU        A S I FCU                           LAWESTWEGO                 Y N20110921
+265075825GREATER NO FCU    GREATER NEW ORLEANS FCU             LANEW ORLEANS              Y Y20110926
+265270002ABBEVILLE BLDG    ABBEVILLE BUILDING & LOAN, SSB      LAABBEVILLE                Y Y19990817
+265270303HOME BANK LA      HOME BANK                           LALAFAYETTE                Y N20110915
+265270345FST FED BK OF LA  FIRST FEDERAL BANK OF LOUISIANA     LALAKE CHARLES             Y Y20020419
+265270413IBERIABANK        IBERIABANK                          LALAFAYETTE                Y Y20030513
+265273054HEART OF LA  FCU  HEART OF LOUISIANA FCU              LAPINEVILLE                Y N20110331
+265274370CONOCO LA EMP CU  HERITAGE CREDIT UNION               LALAFAYETTE                Y N20001107
+265274435LAFAYETTE SCH FCU LAFAYETTE SCHOOLS FCU               LALAFAYETTE                Y N        
+265274574CALCASIEU T E CU  CALCASIEU TEACHERS EMPLOYEES CU     LALAKE CHARLES             Y N20110110
+265274736ACCESS OF LA FCU  ACCESS OF LOUISIANA FCU             LASULPHUR                  Y N20121113
+265274859CSE FCU           CSE FEDERAL CREDIT UNION            LASULPHUR                  Y N20111017
+265370915FIRST SOUTHERN BK FIRST SOUTHERN BANK                 MSCOLUMBIA                 Y Y20090921
+265371066GRAND BK SAVINGS  GRAND BANK FOR SAVINGS FSB          MSHATTIESBURG              Y N        
+265377028UNIV OF S MISS FCUUNIV OF SOUTHERN MISSISSIPPI F C U  MSHATTIESBURG              Y N20020523
+265377549MISS FCU          MISSISSIPPI FEDERAL CREDIT UNION    MSJACKSON                  YSN20090415
+265377633CENTRAL SUNBELT CUCENTRAL SUNBELT FCU                 MSLAUREL                   N Y20140219
+265377756MUNA FCU          MUNA FEDERAL CREDIT UNION           MSMERIDIAN                 YSN20060331
+265377811FERGUSON FCU      FERGRISON FEDERAL CREDIT UNION      MSMONTICELLO               Y N        
+265377840SINGING RIVER FCU SINGING RIVER FEDERAL CREDIT UNION  MSMOSS POINT               Y N20010322
+265378166MUTUAL CU         MUTUAL CREDIT UNION                 MSVICKSBURG                Y N        
+265470242FLA PARISHES BANK FLORIDA PARISHES BANK               LAHAMMOND                  Y N20091021
+265473317NEIGHBORS FCU     NEIGHBORS FEDERAL CREDIT UNION      LABATON ROUGE              YSY20140219
+265473320BATON ROUGE FCU   BATON ROUGE TELCO FCU               LABATON ROUGE              Y N20110915
+265473401CAMPUS FCU        CAMPUS FEDERAL CREDIT UNION         LABATON ROUGE              Y Y20110915
+265473472DEPT OF CORR CU LADEPARTMENT OF CORRECTIONS CU        LABATON ROUGE              Y Y20100407
+265473485PELICAN ST CU     PELICAN STATE CREDIT UNION          LABATON ROUGE              Y Y20120120
+265473511E FEDERAL CR UNIONE FEDERAL CREDIT UNION              LABATON ROUGE              Y Y20120627
+265473582LA CAPITOL FCU    LA CAPITOL FEDERAL CREDIT UNION     LABATON ROUGE              Y Y20121218
+265473663POSTAL CR UNION   POSTAL CREDIT UNION                 LABATON ROUGE              Y N20110114
+265473812ZELLCO FCU        ZELLCO FEDERAL CREDIT UNION         LABOGALUSA                 Y Y        
+265474109JEFFERSON FIANCIALJEFFERSON FINANCIAL CREDIT UNION    LAMETAIRIE                 Y Y20140623
+265476440DOW LA FCU        DOW LOUISIANA FEDERAL CREDIT UNION  LAPLAQUEMINE               Y N20120229
+265482382LA CORP CU        LOUISIANA CORPORATE CREDIT UNION    LAMETAIRIE                 Y Y        
+265577585KEESLER CR UNION  KEESLER FEDERAL CREDIT UNION        MSBILOXI                   Y Y20120419
+266080204POWER FINANCIAL CUPOWER FINANCIAL CREDIT UNION        FLMIAMI                    Y Y20061012
+266086554CITICORP FL       CITIBANK, N.A.                      FLDORAL                    Y N20120622
+267077627IBM SE EMP FCU    IBM SOUTHEAST EMPLOYEES FCU         FLBOCA RATON               Y Y20131126
+267077821SO FLA EDU FED CU SOUTH FLORIDA EDUCATIONAL FED CR UN FLMIAMI                    Y Y20120619
+267077847TROPICAL FCU      TROPICAL FINANCIAL CREDIT UNION     FLMIRAMAR                  Y Y20120829
+267077850UNIVERSITY CR UN  UNIVERSITY CREDIT UNION             FLCORAL GABLES             Y N20110815
+267078299BRIGHTSTAR CU     BRIGHTSTAR CREDIT UNION             FLFORT LAUDERDALE          Y Y20140106
+267078325CITY CTY CU       CITY COUNTY CREDIT UNION            FLFORT LAUDERDALE          Y N20060920
+267079557KEYS FCU          KEYS FEDERAL CREDIT UNION           FLKEY WEST                 Y N        
+267080355JETSTREAM FCU     JETSTREAM FEDERAL CREDIT UNION      FLHIALEAH                  Y N20100826
+267080614RYDER FCU MIA     RYDER SYSTEM FED CU                 FLMEDLEY                   Y N20100819
+267080876COMPASS FINANCIAL COMPASS FINANCIAL FCU               FLHIALEAH                  YSN20080613
+267081118PEOPLES CU        PEOPLES CREDIT UNION                FLPEMBROKE PINES           Y N20111205
+267082201BAPTST HLTH SF FCUBAPTIST HEALTH SOUTH FLORIDA FCU    FLMIAMI                    YSN20110811
+267083271VELOCITY COMM FCU VELOCITY COMMUNITY FCU              FLPALM BEACH GARDENS       Y N20130801
+267083336GOLD COAST FCU    GOLD COAST FEDERAL CREDIT UNION     FLWEST PALM BEACH          Y N20010719
+267084131JPMCHASE          JPMORGAN CHASE BANK, NA             CABEVERLY HILLS            Y N20101129
+267087769INTERAMERICAN BK  INTERAMERICAN BANK FSB              FLMIAMI                    Y Y20130507
+267089712FIRSTBANK FLORIDA FIRSTBANK PUERTO RICO               FLPINECREST                Y N20090703
+267090060DESJARDINS BANK NADESJARDINS BANK, NATIONAL ASSOC     FLHALLANDALE               Y N20040105
+267090455GIBRALTAR PRIVATE GIBRALTAR PRIVATE BANK & TRUST CO   FLCORAL GABLES             Y Y20090311
+267090594BANKUNITED NA FL  BANKUNITED, NA                      FLMIAMI LAKES              Y Y20120306
+267090620SOUTHBANK FED SAV SOUTHBANK,  A  FEDERAL SAVINGS BANK FLWEST PALM BEACH          Y Y20130319
+267091027MACKINAC SVG BK FLMACKINAC SAVINGS BANK, FSB          FLBOYNTON BEACH            YSN20081125
+271070584CENTRAL S&L CHGO  CENTRAL FEDERAL S&L                 ILCHICAGO                  Y Y        
+271070681COMM SAV BK CHGO  COMMUNITY SAVINGS BANK              ILCHICAGO                  Y Y        
+271070801CITIBANK FSB CHGO CITIBANK, N.A.                      MOO FALLON                 Y N20120622
+271070814FRST HEGEWISCH CHGFIRST SAVINGS BANK OF HEGEWISCH     ILCHICAGO                  Y Y        
+271070843PARK FEDERAL SAVGSPARK FEDERAL SAVINGS BANK           ILCHICAGO                  Y Y20130412
+271070908HOYNE SB CHICAGO  HOYNE SAVINGS BANK                  ILCHICAGO                  Y Y20140428
+271070924ILL SVC FED       ILLINOIS SERVICE FEDERAL S&L        ILCHICAGO                  Y Y20120201
+271070953NORTHBROK BK TR CONORTHBROOK BANK & TRUST COMPANY     ILNORTHBROOK               Y N20120316
+271071004LBRTY BK SVGS CHGOLIBERTY BANK FOR SAVINGS            ILCHICAGO                  Y Y        
+271071017NORTHBROOK B&T    NORTHBROOK BANK & TRUST COMPANY     ILNORTHBROOK               Y N20100914
+271071033LOOMIS FEDERAL    LOOMIS FEDERAL S & L ASSN           ILCHICAGO                  Y N20020903
+271071101N SHORE WILMETTE  WINTRUST BANK                       ILWILMETTE                 Y N20140702
+271071114NORTHSIDE FSL CHGONORTH SIDE FEDERAL SAV & LOAN CHGO  ILCHICAGO                  Y N20130308
+271071198PROSPECT FSB WORTHPROSPECT FEDERAL SAVINGS BANK       ILWORTH                    YSN20131223
+271071224PULASKI SVG BK CHGPULASKI SAVINGS BANK                ILCHICAGO                  Y Y        
+271071253ROYAL SAVINGS BK  ROYAL SAVINGS BANK                  ILCHICAGO                  Y Y20090608
+271071321RBS CITIZENS, N.A.RBS CITIZENS, N.A.                  ILCHICAGO                  Y N20070905
+271071415WASHINGTON FEDERALWASHINGTON FEDERAL BANK FOR SAVINGS ILCHICAGO                  YSY20090813
+271078094CHICAGO MUN ECU   CHICAGO MUNICIPAL EMPLOYEES C U     ILCHICAGO                  Y N20100729
+271078146CHICAGO'S PATROL  THE CHICAGO PATROLMENS FEDERAL CU   ILCHICAGO                  Y Y20140417
+271078719FIRST NORTHERN    FIRST NORTHERN CREDIT UNION         ILCHICAGO                  YSN20110224
+271079747MASTER PRINTERS CUOAK TRUST CREDIT UNION              ILNAPERVILLE               YSN20100913
+271080817SELFRELIANCE FED  SELFRELIANCE UKRANIAN AMERICAN FCU  ILCHICAGO                  Y Y20140121
+271081502UNITED CU CHICAGO UNITED CREDIT UNION                 ILCHICAGO                  Y N20050829
+271081528ALLIANT CREDIT UNIALLIANT CREDIT UNION                ILCHICAGO                  Y N20111121
+271081560MAROON FINANCL CU MAROON FINANCIAL CREDIT UNION       ILCHICAGO                  Y N20120709
+271170390CHMPIGN SVGS BANK FIRST FEDERAL SVGS BK OF CHAMPAIGN  ILCHAMPAIGN                Y N20050818
+271171674FIRST SAVINGS BANKFIRST SAVINGS BANK                  ILDANVILLE                 YSN20120222
+271171988UNION SVGS FRPRT  UNION SAVINGS BANK                  ILFREEPORT                 Y Y        
+271172275UNION FEDERAL S&L UNION FEDERAL S & L ASSN.           ILKEWANEE                  YSY20130107
+271172521FIRST FED MATTOON FIRST FEDERAL S&L OF MATTOON        ILMATTOON                  Y N20121114
+271172644SECURITY MONMOUTH SECURITY SAVINGS BANK               ILMONMOUTH                 Y Y        
+271172660HEARTLAND MORTON  HEARTLAND BANK & TRUST COMPANY      ILMORTON                   Y N20120322
+271172987FIRST B&T PARIS   FIRST BANK & TRUST, SB              ILPARIS                    Y N        
+271173452SECURITY BANK S.B.SECURITY BANK, S.B.                 ILSPRINGFIELD              Y Y20020605
+271173494STERLING FED BK   STERLING FEDERAL BANK, F.S.B.       ILSTERLING                 Y Y20090112
+271173724IROQUOIS FED S&L  IROQUOIS FED S&L                    ILWATSEKA                  Y Y20100924
+271176200STATE FARM FCU    STATE FARM FEDERAL CREDIT UNION     ILBLOOMINGTON              Y Y20120319
+271176899UNIVERSITY IL CU  UNIVERSITY OF ILLINOIS EMPL C U     ILCHAMPAIGN                Y N20131001
+271182919DECATUR EARTH C U DECATUR EARTHMOVER C U              ILFORSYTH                  Y Y20140401
+271183646DHCU COMM CU      DHCU COMMUNITY CREDIT UNION         ILMOLINE                   YSN20130625
+271183662I H MISSISSIPPI   I H MISSISSIPPI VALLEY C/U          ILMOLINE                   Y N20110520
+271183701CIT EQTY FED CR UNCITIZENS EQUITY FIRST CREDIT UNION  ILPEORIA                   Y Y20120824
+271184425CORNERSTONE CU    CORNERSTONE CREDIT UNION            ILFREEPORT                 Y N20110708
+271186423DEERE & COMPANY   DEERE EMPLOYEES CREDIT UNION        ILMOLINE                   Y Y20100624
+271188081CU ONE RANTOUL    CREDIT UNION 1                      ILRANTOUL                  Y N20060202
+271188337RIA FED CR UN     RIA FEDERAL CREDIT UNION            ILROCK ISLAND              Y Y20121024
+271250087ASSOC TRUST CO NA ASSOCIATED TRUST COMPANY, N.A.      WIMILWAUKEE                N Y20030926
+271274128MUTUAL BANK       MUTUAL BANK                         INMISHAWAKA                Y N20080718
+271274241LAPORTE SAVINGS BKTHE LA PORTE SAVINGS BANK           INLA PORTE                 Y Y        
+271290681INOVA FCU         INOVA FEDERAL CREDIT UNION          INELKHART                  Y Y20111017
+271291017INTERRA CU        INTERRA CREDIT UNION                INGOSHEN                   YSN20111118
+271291596NORTRE DAME FCU   NOTRE DAME FEDERAL CREDIT UNION     INNOTRE DAME               Y Y20040823
+271291826TEACHERS CU S BENDTEACHERS CREDIT UNION               INSOUTH BEND               Y N20060314
+271970079BEN FRANKLIN BANK BEN FRANKLIN BANK OF ILLINOIS       ILARLINGTON HEIGHTS        Y Y19990831
+271970299MIDLAND BRIDGEVIEWMIDLAND FEDERAL SAVINGS AND LOAN    ILBRIDGEVIEW               Y N        
+271970448A J SMITH FSB     A.J. SMITH FEDERAL SVGS BK          ILMIDLOTHIAN               Y Y20140611
+271971502CENTRAL FED S&L   CENTRAL FEDERAL SAVINGS & LOAN ASSN.ILCICERO                   Y Y20081218
+271971528GREEN CHOICE BANK GREENCHOICE BANK, FSB               ILCHICAGO                  N Y20140505
+271971599WEST TWN SAV BK   WEST TOWN SAVINGS BANK              ILCICERO                   YSN20110517
+271972103HARVARD FED S&L   HARVARD SAVINGS BANK                ILHARVARD                  Y Y20100513
+271972239CENTRUE BANK      CENTRUE BANK                        ILKANKAKEE                 Y N20070413
+271972381BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+271972404LISLE SAVINGS     LISLE SAVINGS BANK                  ILLISLE                    Y Y20101104
+271972569MCHENRY SAVINGS BKMCHENRY SAVINGS BANK                ILMCHENRY                  Y Y20041223
+271972572TCF BK IL RCKFRD  TCF NATIONAL BANK                   ILWILLOWBROOK              Y N20030808
+271972653MORRIS FS&LN      HARVARD SAVINGS BANK                ILMORRIS                   Y N20100331
+271972718PARK NATIONAL BANKUS BANK, NA                         ILOAK PARK                 Y N20130320
+271972828MARQUETTE BANK    MARQUETTE BANK                      ILORLAND PARK              Y N20080421
+271972899FINANCIAL OLYMPIA BANKFINANCIAL FSB                   ILOLYMPIA FIELDS           Y Y20070627
+271972912FIRST FSB OTTAWA  FIRST FEDERAL SAVINGS BANK          ILOTTAWA                   Y N        
+271972925OTTAWA SAVINGS BK OTTAWA SAVINGS BANK                 ILOTTAWA                   YSN20090528
+271973128PERU FEDERAL SVGS PERU FEDERAL SVGS BANK              ILPERU                     Y N20020819
+271973762NORTH SHORE TRUST NORTH SHORE TRUST AND SAVINGS       ILWAUKEGAN                 Y N20021129
+271973788CARDUNAL SB FSB   US BANK, NA                         ILWEST DUNDEE              Y N20140304
+271973924PEOPLES BK MUNSTERPEOPLES BANK SB                     INMUNSTER                  Y Y        
+271974017AMERICAN SVG MUNSTAMERICAN SAVINGS, FSB               INMUNSTER                  Y Y19991116
+271974059LAKE FED HAMMOND  LAKE FEDERAL BANK, FSB              INHAMMOND                  YSY20110211
+271974062MAINSOURCE BANK   MAINSOURCE BANK                     INHOBART                   Y N20110310
+271974224LIBERTY SAVINGS   LIBERTY SAVINGS ASSOCIATION, F.A.   INWHITING                  YSN20120413
+271975401METRO FED CU      METRO FEDERAL CREDIT UNION          ILARLINGTON HEIGHTS        Y Y20111216
+271976523ILLIANA CREDIT UN ILLIANA FINANCIAL CREDIT UNION      ILCALUMET CITY             YSY20110322
+271983833KANE CO TEACH ELG KANE COUNTY TEACHERS CREDIT UNION   ILELGIN                    Y Y20110628
+271984311MOTOROLA EMP CU   MOTOROLA CU                         ILSCHAUMBURG               Y Y20140711
+271984832GREAT LAKES CU    GREAT LAKES CREDIT UNION            ILNORTH CHICAGO            Y N20120305
+271984861COMMUNITY TRST C.UCOMMUNITY TRUST CREDIT UNION        ILGURNEE                   Y N20060301
+271985213NUMARK CU         NUMARK CREDIT UNION                 ILJOLIET                   Y Y20140318
+271985352JOLIET WIRECO FCU NEW CENTURY FEDERAL CREDIT UNION    ILJOLIET                   YSN20120106
+271986801MEADOWS CR ARLNGTNMEADOWS CREDIT UNION                ILARLINGTON HEIGHTS        Y Y20111209
+271986869NORTHSTAR CU      NORTHSTAR CREDIT UNION              ILWARRENVILLE              YSN20131015
+271987075CORPORATE AMER CU CORPORATE AMERICA FAMILY C U        ILELGIN                    Y Y20110302
+271987635ALLOYA CORP FCU   ALLOYA CORPORATE FEDERAL CREDIT UN  ILWARRENVILLE              Y Y20111024
+271988854ROMEOVILLE COMM CUROMEOVILLE COMM C/U                 ILROMEOVILLE               Y N        
+271988980PREMIER CU        PREMIER CREDIT UNION                ILSCHAUMBURG               N Y20140314
+271989112FIRST FINANCIAL CUFIRST FINANCIAL CREDIT UNION        ILSKOKIE                   Y N20100325
+271989950CONSUMERS COOP CU CONSUMERS COOPERATIVE CREDIT UNION  ILROUND LAKE BEACH         Y Y20111020
+271990198DUPAGE CREDIT UN  DUPAGE CREDIT UNION                 ILNAPERVILLE               Y N20100316
+271990994TECH FEDERAL CU   TECH CREDIT UNION                   INCROWN POINT              YSN20100520
+271992183HEALTHCARE CU     HEALTHCARE ASSOCIATES CREDIT UNION  ILNAPERVILLE               YSY20140530
+271992400BAXTER CREDIT UNIOBAXTER CREDIT UNION                 ILVERNON HILLS             Y Y20000816
+272078268LAKE TRUST CU     LAKE TRUST CREDIT UNION             MIPLYMOUTH                 Y Y20101015
+272078365MICHIGAN FIRST CU MICHIGAN FIRST CREDIT UNION         MILATHRUP VILLAGE          Y N20060801
+272079021COMM CHOICE CU    COMMUNITY CHOICE CREDIT UNION       MIFARMINGTON HILLS         Y N20100303
+272079432UKRAIN SLFRL MI CUUKRAINIAN SELFRELIANCE MI FED CU    MIWARREN                   Y N        
+272079487PBLC SRV CU WSTLNDPUBLIC SERVICE CREDIT UNION         MIROMULUS                  Y Y20090206
+272471072HOMESTEAD ALBION  HOMESTEAD SVGS BK, F.S.B.           MIALBION                   Y N20000622
+272471085FIRST FED S&L ALPEFIRST FEDERAL OF NORTHERN MICHIGAN  MIALPENA                   Y N20051202
+272471153EDGEWATER BANK    EDGEWATER BANK                      MISAINT JOSEPH             Y Y20121120
+272471166EATON FED SAV     EATON FEDERAL SAVINGS BANK          MICHARLOTTE                Y N20130422
+272471179MONARCH COLDWATER MONARCH COMMUNITY BANK              MICOLDWATER                Y Y20120306
+272471483WOLVERINE BK FSB  WOLVERINE BANK, FSB                 MIMIDLAND                  Y N20060314
+272471519NEW BUFFALO SVGS  NEW BUFFALO SVGS BK, A FED SVGS BK  MINEW BUFFALO              Y N        
+272471548GREAT LAKES F S B TCF NATIONAL BANK                   MILIVONIA                  Y N20080604
+272471551TALMER BK & TRUST TALMER BANK AND TRUST               MIPORT HURON               Y N20110919
+272471632STURGIS BK & TR   STURGIS BANK & TRUST COMPANY        MISTURGIS                  Y Y20000111
+272471661NORTHWESTERN BANK NORTHWESTERN BANK                   MITRAVERSE CITY            Y Y20051103
+272471849STERLING SVGS B&T STERLING BANK & TRUST, F.S.B.       MISOUTHFIELD               Y Y        
+272471852FLAGSTAR BK FSB   FLAGSTAR BANK, FSB                  MITROY                     Y Y20010319
+272476543UNIV MICHIGAN CU  UNIVERSITY OF MICHIGAN C/U          MIANN ARBOR                Y N20130520
+272476734KELLOGG COMM FCU  KELLOGG COMM FEDERAL CREDIT UNION   MIBATTLE CREEK             Y Y20020911
+272476776UNITED ED CU      UNITED EDUCATIONAL CREDIT UNION     MIBATTLE CREEK             N Y20140722
+272477432EXTRA C.U.        EXTRA CREDIT UNION                  MIWARREN                   Y Y20120720
+272477681UNITED CHURCHES CUUNITED CHURCHES CREDIT UNION        MITAYLOR                   YSN20140404
+272477694COOP CU LIVONIA   CO-OP SERVICES CREDIT UNION         MILIVONIA                  Y Y20140703
+272478075ALLOYA CORP FCU   ALLOYA CORPORATE FEDERAL CREDIT UN  MISOUTHFIELD               Y N20130701
+272479663MSU FEDERAL CU    M S U FEDERAL CREDIT UNION          MIEAST LANSING             Y Y20140618
+272479841C U ONE FERNDALE  CREDIT UNION ONE                    MIFERNDALE                 Y N        
+272479919DORT FCU FLINT    DORT FEDERAL CREDIT UNION           MIFLINT                    Y N        
+272479935ELGA CU           ELGA CREDIT UNION                   MIBURTON                   Y N20140714
+272479980GENESEE FIRST FCU SAGELINK CREDIT UNION               MIFLINT                    N Y20140701
+272479993FLINT ARE SCH EMPLFLINT AREA SCHOOL EMPL CU           MIFLINT                    Y Y20140414
+272480047TRI POINTE COMM CUTRI-POINTE COMMUNITY CREDIT UNION   MIGRAND BLANC              Y Y20140221
+272480115SECURITY CU       SECURITY CREDIT UNION               MIFLINT                    Y Y20140508
+272480128WEST AUTO EMPY FCUFINANCIAL PLUS CREDIT UNION         MIFLINT                    N Y20140623
+272480131FRANKENMUTH CU    FRANKENMUTH CU                      MIFRANKENMUTH              N Y20140529
+272480432OPTION 1 CU       OPTION 1 CREDIT UNION               MIGRAND RAPIDS             Y N20100225
+272480678LAKE MICHIGAN CU  LAKE MICHIGAN CREDIT UNION          MIGRAND RAPIDS             Y Y20050303
+272481567AMERICAN JACKS    AMERICAN 1 CREDIT UNION             MIJACKSON                  Y N20140630
+272481583JACK CONSUMER EFCUCP FEDERAL CREDIT UNION             MIJACKSON                  Y N20001107
+272481839CONSUMERS CU      CONSUMERS CREDIT UNION              MIKALAMAZOO                Y N20070426
+272481871ED COMMUNITY CU   EDUCATIONAL COMMUNITY CREDIT UNION  MIKALAMAZOO                N Y20081003
+272481981CASE CU LANSING   CASE CREDIT UNION                   MILANSING                  Y N20050502
+272482003CONSUMER PRO CU   CONSUMERS PROFESSIONAL CREDIT UNION MILANSING                  Y N20110824
+272482061AUTOMAKERS FED CU LAFCU                               MILANSING                  YSN20130208
+272482265LAKE TRUST CU     LAKE TRUST CREDIT UNION             MIPLYMOUTH                 Y N20110331
+272482838DOW CHEM EMPL CU  DOW CHEMICAL EMPLOYEES CU           MIMIDLAND                  Y Y20110225
+272482841MEMBERS 1ST CU    MEMBERS FIRST CREDIT UNION          MIMIDLAND                  Y Y20021121
+272482883MONROE CNTY COM CUMONROE COUNTY COMMUNITY CU          MIMONROE                   Y Y20120206
+272483015ISABELLA COMM CU  ISABELLA COMMUNITY C/U              MIMOUNT PLEASANT           Y N20110927
+272483633ADVIA             ADVIA CREDIT UNION                  MIPARCHMENT                N Y20140110
+272483743COMM FCU PLYMOUTH COMMUNITY FINANCIAL CREDIT UNION    MIPLYMOUTH                 Y N20130207
+272483905GENISYS CU        GENISYS CREDIT UNION                MIAUBURN HILLS             Y Y20081204
+272484289CHRISTIAN FIN. CU CHRISTIAN FINANCIAL CREDIT UNION    MIROSEVILLE                Y N20130927
+272484713WILDFIRE C U      WILDFIRE CREDIT UNION               MISAGINAW                  Y N20091021
+272484807WANIGAS           WANIGAS CREDIT UNION                MISAGINAW                  N Y20140416
+272484852HONOR CREDIT UNIONHONOR CREDIT UNION                  MISAINT JOSEPH             Y Y20140514
+272484894UNITED FCU        UNITED FEDERAL CREDIT UNION         MISAINT JOSEPH             Y N20100823
+272485291TLC COMM CR UNION TLC COMMUNITY CR UN                 MIADRIAN                   N Y20140507
+272486025NORTHERN AREA FCU NORTHLAND AREA FEDERAL CREDIT UNION MIOSCODA                   N Y20120904
+273073974FIRST CLASS CU    FIRST CLASS CREDIT UNION            IAWEST DES MOINES          Y N20110616
+273074339POLK COUNTY       POLK COUNTY CREDIT UNION            IADES MOINES               YSN20130416
+273074407COMMUNITY CHOICE  COMMUNITY CHOICE CREDIT UNION       IADES MOINES               N Y20140328
+273970116METABANK          METABANK                            IASTORM LAKE               Y Y20111122
+273970187CITIZENS DAVENPORTFIRST MIDWEST BANK                  IADAVENPORT                Y N20030815
+273970514US BANK IOWA      US BANK, NA                         MNMINNEAPOLIS              Y N20130221
+273970682NORTHWEST BANK    NORTHWEST BANK                      IASPENCER                  Y Y20080925
+273970941PAGE CNTY FED SVGSCORNERSTONE BANK                    IACLARINDA                 Y N20140702
+273972583GREATER IOWA CU   GREATER IOWA CREDIT UNION           IAAMES                     Y Y20140414
+273972949COLLINS COMM CU   COLLINS COMMUNITY CREDIT UNION      IACEDAR RAPIDS             Y Y20101217
+273973456ALCOA BETTENDORF  ASCENTRA CREDIT UNION               IABETTENDORF               Y N20070904
+273974549DU TRAC COMMUNITY DU TRAC COMMUNITY CREDIT UNION      IADUBUQUE                  Y N        
+273974581DUPACO COMM DUBQUEDUPACO COMMUNITY CREDIT UNION       IADUBUQUE                  Y Y20140709
+273975098U OF I COMM IOWA  UNIVERSITY OF IOWA COMMUNITY C.U.   IAIOWA CITY                Y N20070411
+273975564NORTH IA CU MASON NORTH IOWA COMMUNITY CREDIT UNION   IAMASON CITY               Y N        
+273976369VERIDIAN          VERIDIAN CREDIT UNION               IAWATERLOO                 Y N20060314
+274070439BMO HARRIS BANK NABMO HARRIS BANK NA                  ILCHICAGO                  Y N20121009
+274070484AFFINITY          THE HUNTINGTON NATIONAL BANK        OHBOWLING GREEN            YSN20120827
+274073805DOW EMPL CU       NORTHPARK COMMUNITY CREDIT UNION    ININDIANAPOLIS             N Y20130206
+274073834ELI LILY CR UNION ELI LILLY CREDIT UNION              ININDIANAPOLIS             Y Y20111027
+274073876FINANCIAL FCU IND.FINANCIAL CENTER FCU                ININDIANAPOLIS             Y N20131001
+274074037FORUM CREDIT UNIONFORUM CREDIT UNION                  ININDIANAPOLIS             Y N20131015
+274074040IN FED CU INDIAPOLINDIANA MEMBER CREDIT UNION         ININDIANAPOLIS             Y Y20111028
+274086339ALLOYA CORP FCU   ALLOYA CORPORATE FEDERAL CREDIT UN  ININDIANAPOLIS             Y N20111024
+274970021FIRST FSB ANGOLA  FIRST FEDERAL SAVINGS BK OF ANGOLA  INANGOLA                   Y N        
+274970050PEOP FSB AUBURN   PEOPLES FEDERAL SVGS BK - DEKALB CNTINAUBURN                   Y N        
+274970076MAINSOURCE BANK   MAINSOURCE BANK                     INFRANKLIN                 Y N20110310
+274970186MAINSOURCE BANK   MAINSOURCE BANK                     INCRAWFORDSVILLE           Y N20060713
+274970225BLUE RIVER FSB    SALIN BANK & TRUST COMPANY          INEDINBURGH                Y N20030815
+274970351FIRST GREENSBURG  FIRST FEDERAL S & L                 INGREENSBURG               Y N        
+274970380FIRST FEDERAL BK  FIRST FEDERAL SAVINGS BANK          INHUNTINGTON               Y N20000831
+274970607SECURITY FED SAV  SECURITY FEDERAL SAVINGS BANK       INLOGANSPORT               Y N20080620
+274970649HOME BK SB MARTINSHOME BANK, SB                       INMARTINSVILLE             Y N        
+274970681MUTUAL BANK       MUTUAL BANK                         INMUNCIE                   Y Y20090129
+274970717AMERIANA BANK     AMERIANA BANK                       INNEW CASTLE               Y N20090724
+274970791FIRST BK RICHMOND FIRST BANK RICHMOND, N.A.           INRICHMOND                 Y N20080701
+274970814WEST END BANK     WEST END BANK, S.B.                 INRICHMOND                 Y N20130508
+274970827FIRST FED SAVINGS FIRST FED SAVINGS BK                INROCHESTER                Y N20140415
+274970995CROSSROADS BANK   CROSSROADS BANK                     INWABASH                   Y Y20081226
+274971761LAFAYETTE SAVGS BKLAFAYETTE SAVINGS BK FSB            INLAFAYETTE                Y Y        
+274972744IND CU BLOMINGTN  INDIANA UNIVERSITY CREDIT UNION     INBLOOMINGTON              Y Y20080324
+274972883CENTRA FEDERAL CU CENTRA CREDIT UNION                 INCOLUMBUS                 Y Y20120605
+274973141MIDWEST AMER CU   MIDWEST AMERICA FED CU              INFORT WAYNE               Y Y20121123
+274973183PROFED FCU        PROFED FEDERAL CREDIT UNION         INFORT WAYNE               Y Y20130531
+274973222THREE RIVERS FEDERTHREE RIVERS FEDERAL CREDIT UNION   INFORT WAYNE               N Y20121012
+274973316PARTNERS 1ST FCU  PARTNERS 1ST FEDERAL CREDIT UNION   INFORT WAYNE               Y Y20080411
+274973374FORT FINANCIAL FCUFORT FINANCIAL FCU                  INFORT WAYNE               Y N20121114
+274974726SOLIDARITY FED CU SOLIDARITY FEDERAL CU               INKOKOMO                   Y N20110509
+274975291BALL STATE FCU    BALL STATE FEDERAL CREDIT UNION     INMUNCIE                   YSN20121204
+274976067PURDUE FCU        PURDUE FEDERAL CREDIT UNION         INWEST LAFAYETTE           Y Y20120315
+274976151BEACON CU         BEACON CREDIT UNION                 INWABASH                   Y Y20120109
+275071259EQUITABLE SSB HALETHE EQUITABLE BK, STATE SVGS BANK   WIWAUWATOSA                Y Y20100129
+275071288GUARANTY BK SSB   GUARANTY BANK                       WIMILWAUKEE                Y Y20090422
+275071327PYRAMAX BANK      PYRAMAX BANK, F.S.B.                WIGREENFIELD               Y Y20090107
+275071330BANK MUTUAL       BANK MUTUAL                         WIMILWAUKEE                Y Y20030320
+275071356N SH FSB BRKFIELD NORTH SHORE BANK, F.S.B.            WIBROOKFIELD               Y Y        
+275071385TCF BK WI FSB MILWTCF NATIONAL BANK                   ILFRANKLIN PARK            Y N20030808
+275071770WATERSTONE BANK   WATERSTONE BANK                     WIWAUWATOSA                Y N20080701
+275080334NORTHWESTERN      NORTHWESTERN MUTUAL CREDIT UNION    WIMILWAUKEE                N Y20130521
+275080525AURORA CREDIT UN  AURORA CREDIT UNION                 WIMILWAUKEE                Y N20110921
+275082866CORP CENTRAL CU   CORPORATE CENTRAL CREDIT UNION      WIHALES CORNERS            Y Y20020812
+275970020MARATHON SAVINGS  MARATHON SAVINGS BANK               WIWAUSAU                   N Y20111128
+275970130FOX VALLEY SAV BK FOX VALLEY SAVINGS BANK             WIFOND DU LAC              Y N20010405
+275971029EAST WISCONSIN SB EAST WISCONSIN SAVINGS BANK, S.A.   WIKAUKAUNA                 N Y20100831
+275971087ANCHORBK SSB MADSNANCHORBANK, S.S.B.                  WIMADISON                  Y Y        
+275971113HOME SVG BK MADISNHOME SAVINGS BANK                   WIMADISON                  Y N20060301
+275971139FORWARD FNCL BK   FORWARD FINANCIAL BANK, SSB         WIMARSHFIELD               Y N20101001
+275971142MAYVILLE SAV BANK MAYVILLE SAVINGS BANK               WIMAYVILLE                 YSN20121113
+275971692FIRST FEDERAL BANKFIRST FEDERAL BANK OF WISCONSIN     WIWAUKESHA                 YSY20120330
+275971825WESTBURY BANK     WESTBURY BANK                       WIWEST BEND                Y Y20120928
+275971838KEYSAVINGS WI RPDSKEYSAVINGS BANK                     WIWISCONSIN RAPIDS         YSY20100311
+275971854BLACKHAWK BELOIT  BLACKHAWK STATE BANK                WIBELOIT                   Y Y        
+275971906GREAT MIDWEST SSB GREAT MIDWEST BANK S.S.B.           WIBROOKFIELD               Y Y        
+275976655COVANTAGE CU      COVANTAGE CREDIT UNION              WIANTIGO                   Y Y20121220
+275977120CO-OP CREDIT UNIONCO-OP CREDIT UNION                  WIBLACK RIVER FALLS        N Y20131226
+275977489MARINE CREDIT UN  MARINE CREDIT UNION                 WIFOND DU LAC              Y Y20120719
+275977560FORT COMMUNITY CU FORT COMMUNITY CREDIT UNION         WIFORT ATKINSON            N Y20130709
+275977832HARBOR CREDIT UN  HARBOR CREDIT UNION                 WIGREEN BAY                Y Y20130123
+275978048BLKHAWK JANESVILLEBLACKHAWK COMMUNITY CREDIT UNION    WIJANESVILLE               Y N20040903
+275978116PARKER COMMTY CU  PARKER COMMUNITY CREDIT UNION       WIJANESVILLE               Y N20121226
+275978310SOUTHERN LAKES CU SOUTHERN LAKES CREDIT UNION         WIKENOSHA                  YSY20100528
+275978394CAPITAL CU        CAPITAL CREDIT UNION                WIGREEN BAY                YSN20140702
+275978417KOHLER CREDIT UN  KOHLER CREDIT UNION                 WIKOHLER                   YSN20100701
+275978750DANE COUNTY CR UN DANE COUNTY CREDIT UNION            WIMADISON                  N Y20140212
+275979034SUMMIT CU         SUMMIT CREDIT UNION                 WIMADISON                  Y Y20110510
+275979076UNIV WIS MADISON  UNIVERSITY OF WISCONSIN C/U         WIMADISON                  Y Y20080813
+275979173UNITEDONE         UNITEDONE CREDIT UNION              WIMANITOWOC                Y Y20140130
+275979429PROSPERA CU       PROSPERA CREDIT UNION               WIAPPLETON                 YSN20091127
+275981187CIT FST CU OSHKOSHCITIZENSFIRST CREDIT UNION          WIOSHKOSH                  Y N20060314
+275981378EDUCATORS CR UNIONEDUCATORS CREDIT UNION              WIRACINE                   Y N        
+275982296CONNEXUS          CONNEXUS CREDIT UNION               WIWAUSAU                   Y N20140324
+275982539WESTBY COOP CU    WESTBY CO-OP CREDIT UNION           WIWESTBY                   N Y20121121
+275982597BULLSEYE CU WI RAPBULL'S EYE CU                       WIWISCONSIN RAPIDS         Y N        
+275982801COMMUNITY APPLETONCOMMUNITY FIRST CREDIT UNION        WIAPPLETON                 Y N20060109
+281050031USDA              U. S. D. A.                         MOKANSAS CITY              N Y20120827
+281050112US TREAS RP       U.S. DEPT. OF TREASURY REPO ACCOUNT MOSAINT LOUIS              YSY20100823
+281050125MINERALS MGT SRVC MINERALS MGT SERVICE, ALASKA OCS REGAKANCHORAGE                N Y20080822
+281070985CLSL EDWARDSVLL ILFIRST CLOVER LEAF BANK, FSB         ILEDWARDSVILLE             Y Y20060720
+281071081GUARDIAN SVG BK   GUARDIAN SAVINGS BANK, FSB          ILGRANITE CITY             N Y20090528
+281071227FRST FSB MASCOUTAHFIRST FSB OF MASCOUTAH              ILMASCOUTAH                N Y20111007
+281073445HEARTLAND STL MO  HEARTLAND BANK                      MOCLAYTON                  Y Y20140304
+281073555PULASKI BK        PULASKI BANK                        MOSAINT LOUIS              Y Y20100823
+2810767301ST MIDAMERICA CU 1ST MIDAMERICA CREDIT UNION         ILBETHALTO                 Y N20140530
+281076853GCS CREDIT UNION  GCS CREDIT UNION                    ILGRANITE CITY             Y Y20120830
+281077522SCOTT CREDIT UNIONSCOTT CREDIT UNION                  ILEDWARDSVILLE             Y Y20121108
+281080315WEST CMNTY CU MO  WEST COMMUNITY CREDIT UNION         MOO FALLON                 Y N20131016
+281081233ARSENAL CU MO     ARSENAL CREDIT UNION                MOARNOLD                   N Y20050302
+281081479VANTAGE CU        VANTAGE CREDIT UNION                MOBRIDGETON                Y Y20030311
+281081495ESCU STL MO       ELECTRO SAVINGS CREDIT UNION        MOSAINT LOUIS              Y N20101004
+281081505ALLIANCE CU STL MOALLIANCE CREDIT UNION               MOFENTON                   Y Y20130823
+281081738APEX FCU          APEX FINANCIAL CREDIT UNION         MOSAINT LOUIS              N Y20131015
+281081877FIRST COMM CR UN  FIRST COMMUNITY CREDIT UNION        MOCHESTERFIELD             Y Y20060118
+281082339GATEWAY METRO FCU GATEWAY METRO FCU                   MOSAINT LOUIS              Y Y20110225
+281082384STL POSTAL CU     NEIGHBORS CREDIT UNION              MOSAINT LOUIS              Y Y20140227
+281082423ST LOUIS COMM CU  ST. LOUIS COMMUNITY CREDIT UNION    MOSAINT LOUIS              N Y20131001
+281082915ANHEUSER CU STL MOANHEUSER-BUSCH EMPL. CREDIT UNION   MOSAINT LOUIS              Y Y20100823
+281085585MO CORP CU STL MO MISSOURI CORPORATE CREDIT UNION     MOSAINT LOUIS              Y Y20100823
+281271137JACKSONVLE SB IL  JACKSONVILLE SAVINGS BANK           ILJACKSONVILLE             Y Y        
+281271179HERITAGE STATE BK HERITAGE STATE BANK                 ILLAWRENCEVILLE            Y Y20030804
+281271438FST ROBINSON SL ILFIRST ROBINSON SAVINGS BANK, N.A.   ILROBINSON                 YSY20090120
+281271454MARION SB SALEM ILMARION COUNTY SAVINGS BANK          ILSALEM                    Y Y20120821
+281276420SIU CREDIT UNION  SIU CREDIT UNION                    ILCARBONDALE               Y Y        
+281277364UNITED COMM CU    UNITED COMMUNITY CU                 ILQUINCY                   YSN20120307
+281573013MCM SB FSB HANNIBLF & M BANK AND TRUST CO             MOHANNIBAL                 N Y20060918
+281573204FST HOME MTN GROVEFIRST HOME BANK                     MOMOUNTAIN GROVE           N Y20140227
+281573259SOUTHERN BANK     SOUTHERN BANK                       MOPOPLAR BLUFF             Y Y20090716
+281580417MISSOURI CU       MISSOURI CREDIT UNION               MOCOLUMBIA                 Y Y20070322
+281580679MID MISSOURI CU MOMID MISSOURI CREDIT UNION           MOFORT LEONARD WOOD        YSY20130918
+281581063UNITED CU         UNITED CREDIT UNION                 MOMEXICO                   N Y20130109
+281970881CHESTER NATL BK   CHESTER NATIONAL BANK               ILCHESTER                  Y Y20000321
+281971356NOKOMIS S&L IL    NOKOMIS SAVINGS BANK                ILNOKOMIS                  YSY20050801
+282075028AR FCU JACKSNVL ARARKANSAS FEDERAL CREDIT UNION       ARJACKSONVILLE             Y Y        
+282075390TRUSERVICE FCU    TRUSERVICE  FEDERAL CREDIT UNION    ARLITTLE ROCK              Y Y20030318
+282970110FF ELDORADO AR    FIRST FINANCIAL BANK                AREL DORADO                Y Y20120402
+282970204FIRST FS&LHARRISONFIRST FEDERAL BANK                  ARHARRISON                 Y Y20060727
+282974161UFSB SPRINGDALE ARUNITED BANK                         ARSPRINGDALE               YSN20100219
+282974831ABFCU FT SMITH AR ARKANSAS BEST FEDERAL CREDIT UNION  ARFORT SMITH               Y Y        
+282975034DIAMOND LAKE FCU  DIAMOND LAKE FEDERAL CREDIT UNION   ARMALVERN                  N Y20140521
+283071788FIRST SVG BANK    FIRST SAVINGS BANK, F.S.B.          INCLARKSVILLE              YSN20091008
+283071827CSB NEW ALBANY IN YOUR COMMUNITY BANK                 INNEW ALBANY               Y Y20060203
+283071830REGIONAL FSB      MAINSOURCE BANK                     INNEW ALBANY               Y N20121128
+283079159KY TELCO FCU      KENTUCKY TELCO FEDERAL CREDIT UNION KYLOUISVILLE               YSN20140520
+283079298LNOPECU LSVL KY   BEACON CREDIT UNION                 KYLOUISVILLE               N Y20140416
+283079476PARK CMNTY CU, INCPARK COMMUNITY CREDIT UNION, INC    KYLOUISVILLE               YSY20140522
+283086003VOL CORP NASHVILLEVOLUNTEER CORPORATE CREDIT UNION    TNNASHVILLE                Y Y20140630
+283971600BEDFORD FSB IN    BEDFORD FEDERAL SAVINGS BANK        INBEDFORD                  YSN20060310
+283971901MSSB SALEM IN     MID-SOUTHERN SAVINGS BANK, FSB      INSALEM                    YSY        
+283972094FFSB ELIZABTHTN KYFIRST FEDERAL SAVINGS BANK          KYELIZABETHTOWN            Y Y        
+283972230HERITAGE BK US INCHERITAGE BANK USA, INC.             KYHOPKINSVILLE             Y N20140529
+283977633HHCU BEDFORD IN   HOOSIER HILLS CREDIT UNION          INBEDFORD                  Y N20130422
+283977688CRANE FCU IN      CRANE FEDERAL CREDIT UNION          INODON                     YSY20130806
+283978425FORT KNOX FCU KY  FORT KNOX FEDERAL CREDIT UNION      KYFORT KNOX                Y Y        
+283978441CWCU FRANKFORT KY COMMONWEALTH CREDIT UNION           KYFRANKFORT                Y Y        
+283980031C-PLT FED CR UNIONC-PLANT FEDERAL CREDIT UNION        KYPADUCAH                  YSN20120807
+283980099SIGNET FCU        SIGNET FEDERAL CREDIT UNION         KYPADUCAH                  N Y20131112
+284071949FINANCIAL FED BK  FINANCIAL FEDERAL BANK              TNMEMPHIS                  YSY20121102
+284073785BANK TENNESSEE    BANKTENNESSEE                       TNCOLLIERVILLE             Y N20050426
+284084907ORION FCU         ORION FEDERAL CREDIT UNION          TNMEMPHIS                  Y Y20110413
+284085375FIRST SO FIN CU   FIRST SOUTH FINANCIAL CREDIT UNION  TNBARTLETT                 Y Y20101001
+284170109RIVERBANK S&L ASSNRIVERBANK SAVINGS & LOAN ASSOCIATIONARCORNING                  N Y20140102
+284170141FORREST CITY BK ARFORREST CITY BANK, N. A.            ARFORREST CITY             YSY20010905
+284272670SOUTHBK CORINTH MSSOUTHBANK, F.S.B.                   ALHUNTSVILLE               Y Y        
+284373760COMMUNITY BANK    COMMUNITY BANK                      TNLEXINGTON                YSY20100608
+284373838CENTRL SAVANNAH TNCENTRAL BANK                        TNSAVANNAH                 Y Y        
+286371676FIRST FED SVGS BK FIRST FEDERAL SAVINGS BANK          INEVANSVILLE               Y N20060914
+286573322GTS SOUTHERN SF MOGREAT SOUTHERN BANK                 MOSPRINGFIELD              Y Y        
+286573335GUARANTY BANK     GUARANTY BANK                       MOSPRINGFIELD              Y N20030630
+286573351SYS SVG BK        SYSTEMATIC SAVINGS BANK             MOSPRINGFIELD              N Y20130710
+286582708CU COMMUNITY CU   C U COMMUNITY CREDIT UNION          MOSPRINGFIELD              Y Y20130501
+286582740GEN COUNCIL CU    ASSEMBLIES OF GOD CU                MOSPRINGFIELD              Y Y20131205
+291070001TCF MPLS          TCF NATIONAL BANK                   MNWAYZATA                  Y Y20090623
+291074502GEN MILLS FCU MPLSGENERAL MILLS FEDERAL CREDIT UNION  MNMINNETONKA               Y Y20110408
+291074544ENDURA FNCL FCU   ENDURA FINANCIAL FCU                MNMINNEAPOLIS              Y Y20130701
+291074696TOPLINE FCU       TOPLINE FEDERAL CREDIT UNION        MNBROOKLYN CENTER          Y N20050420
+291074719BUILDING TRADES FCBUILDING TRADES FEDERAL CREDIT UNIONMNMAPLE GROVE              Y Y20100714
+291074722US FCU MPLS       U S FEDERAL CREDIT UNION            MNBURNSVILLE               Y Y20050908
+291075080TRUSTONE FINANCIALTRUSTONE FINANCIAL FEDERAL CU       MNPLYMOUTH                 Y Y20090601
+291075116SPIRE CREDIT UNIONSPIRE CREDIT UNION                  MNFALCON HEIGHTS           Y Y20140605
+291075459RICHFLD BLOOMTN CURICHFIELD-BLOOMINGTON CREDIT UNION  MNRICHFIELD                Y N        
+291172637PENINSULA FED CU  PENINSULA FEDERAL CREDIT UNION      MIESCANABA                 YSN20110414
+291172640GRT LKS FST FCU ESGREAT LAKES FIRST FEDERAL CR UN     MIESCANABA                 YSN        
+291172747IRON MT KINGSFORD IRON MOUNTAIN KINGSFORD COMNTY FCU  MIKINGSFORD                N Y20120201
+291172970TAHQUAMENON A CU  TAHQUAMENON AREA CREDIT UNION       MINEWBERRY                 Y N        
+291270050HOME FED SVNGS BK HOME FEDERAL SAVINGS BANK           MNROCHESTER                Y Y20050721
+291271004AM FEDL E GRD FKS AMERICAN FEDERAL BANK               NDFARGO                    YSN20100802
+291271240RIVERWOODBKBEMIDJIRIVERWOOD BANK                      MNBEMIDJI                  Y N20101021
+291271305FST F&M FAIRMONT  FIRST FARMERS & MERCHANTS NATL BANK MNFAIRMONT                 Y Y        
+291273963FLOODWOOD AREA CU FLOODWOOD AREA CR UNION             MNFLOODWOOD                Y N20111005
+291274085NORTHRIDGE COMM CUNORTHRIDGE COMMUNITY CREDIT UNION   MNHOYT LAKES               Y Y20120221
+291274108TRUSTAR FCU       TRUSTAR FEDERAL CREDIT UNION        MNINTERNATIONAL FALLS      Y N20030529
+291276481NO SHORE CU SLRBAYNORTH SHORE FEDERAL CR UN           MNSILVER BAY               Y N        
+291370918GATE CITY FARGO   GATE CITY BANK                      NDFARGO                    Y Y20010108
+291378130CAP CU BISMARCK   CAPITAL CREDIT UNION                NDBISMARCK                 N Y20140224
+291378693FST COMM CU JAMESTFIRST COMMUNITY CREDIT UNION        NDJAMESTOWN                Y Y        
+291379142WESTERN CU WILLISTWESTERN COOPERATIVE CREDIT UNION    NDWILLISTON                Y N20050906
+291471134HOME FED SX FLS   HOME FEDERAL BANK                   SDSIOUX FALLS              Y Y20011116
+291479291SENTINEL FCU      SENTINEL FEDERAL CREDIT UNION       SDBOX ELDER                Y N        
+291479385HURON AREA EDUC FCHURON AREA EDUCATION FED CR UNION   SDHURON                    N Y20110315
+291479592BLACK HILLS FCU   BLACK HILLS FEDERAL CREDIT UNION    SDRAPID CITY               Y N20121026
+291479673RAPID CITY TELCO  RAPID CITY TELCO FED CR UNION       SDRAPID CITY               N Y20111219
+291479903SERVICE FIRST FCU SERVICE FIRST FEDERAL CREDIT UNION  SDSIOUX FALLS              Y Y20130415
+291479974SIOUX FALLS FED CUSIOUX FALLS FEDERAL CREDIT UNION    SDSIOUX FALLS              Y N20111117
+291480141SVC CTR FCU YANKTNSERVICES CENTER FED CR UNION        SDYANKTON                  Y Y20110301
+291571270TIME FED MEDFORD  TIME FEDERAL SAVINGS BANK           WIMEDFORD                  N Y20120809
+291571348TOMAHAWK COMM BK  TOMAHAWK COMMUNITY BANK, SSB        WITOMAHAWK                 Y N20060103
+291580614PARK CITY CU      PARK CITY CREDIT UNION              WIMERRILL                  N Y20140306
+291580711RIPCO CU RHINLNDERRIPCO CREDIT UNION                  WIRHINELANDER              Y Y20130214
+291871336SUPERIOR SVGS BK  SUPERIOR SAVINGS BANK               WISUPERIOR                 Y N20000810
+291880330CITZENS CMNTY FED CITIZENS COMMUNITY FEDERAL NA       WIALTOONA                  Y Y20140603
+291880411ROYAL CU EAU CLAIRROYAL CREDIT UNION                  WIEAU CLAIRE               Y Y        
+291880495HAYWARD COMM CR UNHAYWARD COMMUNITY CREDIT UNION      WIHAYWARD                  Y N20140602
+291880589WESTCONSIN CU MENOWESTCONSIN CREDIT UNION             WIMENOMONIE                Y N        
+291880767INDIANHEAD SPOONERINDIANHEAD CREDIT UNION             WISPOONER                  Y N19991118
+291880916SUPERIOR CHOICE CUSUPERIOR CHOICE CREDIT  UNION       WISUPERIOR                 Y Y20131101
+291881216ALTRA FCU         ALTRA FEDERAL CREDIT UNION          WILA CROSSE                N Y20120705
+2919713201ST UNITED BANK   1ST UNITED BANK                     MNFARIBAULT                Y N20120326
+291971391LIBERTY ST CLOUD  LIBERTY SAVINGS BANK, FSB           MNSAINT CLOUD              Y N20100825
+291971469VOYAGER EDEN PRAIRVOYAGER BANK                        MNEDEN PRAIRIE             Y Y20090122
+291973292ACCENTRA CU       ACCENTRA CREDIT UNION               MNAUSTIN                   YSN20050418
+291973360MIDMN FCU BAXTER  MID MINNESOTA FEDERAL CREDIT UNION  MNBAXTER                   Y N20081007
+291973454MBRS COOP CLOQUET MEMBERS COOPERATIVE CREDIT UNION    MNCLOQUET                  Y Y20140228
+291973629NORTHERN CMNTY CU NORTHERN COMMUNITIES CREDIT UNION   MNDULUTH                   Y N20090227
+291973645SHARE ADVANTAGE CUSHARE ADVANTAGE CREDIT UNION        MNDULUTH                   Y Y20131121
+291973687DULUTH TEACH CU   DULUTH TEACHERS CREDIT UNION        MNDULUTH                   Y Y20110407
+291973739HERMANTOWN FCU    HERMANTOWN FEDERAL CREDIT UNION     MNHERMANTOWN               Y Y20110620
+291973755MN POWER ECU      MINNESOTA POWER EMPL CR UNION       MNDULUTH                   Y Y20111214
+291974204CTR MN CU MELROSE CENTRAL MINNESOTA CREDIT UNION      MNMELROSE                  Y Y20111020
+291975245LAKE ST CU MOOSEL LAKE STATE CREDIT UNION             MNMOOSE LAKE               Y N20130627
+291975465THINK MUTUAL BK   THINK MUTUAL BANK                   MNROCHESTER                Y Y20070703
+291975478MAYO EMPLOYEES FCUMAYO EMPLOYEES FEDERAL CREDIT UNION MNROCHESTER                N Y20140714
+291975481FIRST ALLIANCE CU FIRST ALLIANCE CREDIT UNION         MNROCHESTER                Y Y20100923
+291975672GRT RIV FCU ST CLDGREAT RIVER FEDERAL CREDIT UNION    MNSAINT CLOUD              Y N20100825
+291976493SOUTHPOINT FCU    SOUTHPOINT FEDERAL CREDIT UNION     MNSLEEPY EYE               Y Y20120904
+292070806AMER FS&L HELENA  AMERICAN FEDERAL SAVINGS BANK       MTHELENA                   Y Y        
+292077511HELENA COMM FCU   HELENA COMMUNITY CREDIT UNION       MTHELENA                   N Y20131101
+292077618ROCKY MT CR UNION ROCKY MOUNTAIN CREDIT UNION         MTHELENA                   Y Y20100824
+292081248KS CORP HELENA    KANSAS CORPORATE CREDIT UNION       MTHELENA                   Y N20120103
+292178012WHITEFISH CR UN   WHITEFISH CREDIT UNION ASSOCIATION  MTWHITEFISH                Y Y        
+292970757PIONEER FSL DILLONPIONEER FEDERAL SAVINGS & LOAN ASSOCMTDILLON                   Y N20140122
+292970825GLACIER KALISPELL GLACIER BANK                        MTKALISPELL                Y Y        
+292970854GLACIER WEST SECURGLACIER BANK-WESTERN SECURITY DIV   MTBILLINGS                 Y N20120621
+292976861VLY FCU BILLINGS  VALLEY FCU OF MONTANA               MTBILLINGS                 Y N        
+292976968BUTTE PUB EFCU    BUTTE PUBLIC EMPLOYEES FED CR UNION MTBUTTE                    YSN20100726
+292977297RUSSELL CNTY FCU  RUSSELL COUNTRY FEDERAL CREDIT UNIONMTGREAT FALLS              Y N        
+292977307EMBARK FEDERAL CU EMBARK FEDERAL CREDIT UNION         MTGREAT FALLS              Y Y20130509
+292977336MONT FCU          MONTANA FEDERAL CREDIT UNION        MTGREAT FALLS              Y N20111214
+2929773491ST LIBERTY FCU GF1ST LIBERTY FEDERAL CREDIT UNION    MTGREAT FALLS              Y Y20060501
+292977899MISSOULA FCU      MISSOULA FEDERAL CREDIT UNION       MTMISSOULA                 Y N        
+292978005PARK SIDE CU      PARK SIDE CREDIT UNION              MTWHITEFISH                Y N20140310
+296075535HEARTLAND CU      HEARTLAND CREDIT UNION              MNINVER GROVE HEIGHTS      YSN20120203
+296075810CITY CNTY CU STP  CITY AND COUNTY CREDIT UNION        MNSAINT PAUL               Y N20100825
+296075933HIWAY FCU STP     HIWAY FEDERAL CREDIT UNION          MNSAINT PAUL               Y N20110810
+296076013ST PAUL FEDERAL CUST PAUL FEDERAL CREDIT UNION        MNSAINT PAUL               Y Y20120509
+296076068NOVATION CU STP   NOVATION CREDIT UNION               MNOAKDALE                  YSN20121119
+296076084UNITED EDU CU STP UNITED EDUCATORS CREDIT UNION       MNWOODBURY                 Y N20110310
+296076152WINGS FINANCIAL CUWINGS FINANCIAL CREDIT UNION        MNAPPLE VALLEY             Y Y20091028
+296076204SMW FCU MAPLEWOOD SMW FEDERAL CREDIT UNION            MNLINO LAKES               YSN20070828
+296076262IDEAL CREDIT UNIONIDEAL CREDIT UNION                  MNWOODBURY                 Y N20140423
+296076301AFFINITY PLUS FCU AFFINITY PLUS FEDERAL CREDIT UNION  MNSAINT PAUL               Y N20100825
+296076385ASSC HEALTHCARE CUASSOCIATED HEALTHCARE CREDIT UNION  MNSAINT PAUL               Y Y20120330
+296081516ALLOYA CORP FCU   ALLOYA CORPORATE FEDERAL CREDIT UN  MNEAGAN                    Y N20111024
+301070852ARGENTINE FED SVG ARGENTINE FEDERAL SAVINGS           KSKANSAS CITY              N Y20090721
+301071592FST FED KC        FIRST FEDERAL BANK FSB              MOKANSAS CITY              Y Y20090729
+301071631NORTH AMER SVGS   NORTH AMER SVGS BK  FSB             MOGRANDVIEW                Y Y        
+301071709CLAY CNTY SVGS BK CLAY COUNTY SAVINGS BANK            MOLIBERTY                  Y Y20110314
+301079183MAINSTREET FCU    MAINSTREET FEDERAL CREDIT UNION     KSLENEXA                   Y Y20140711
+301079617SM FEDERAL CU     S M FEDERAL CREDIT UNION            KSSHAWNEE MISSION          Y N        
+301080392R G FEDERAL CU    R-G FEDERAL CREDIT UNION            MOBELTON                   Y Y19991015
+301080855MAZUMA CU         MAZUMA CREDIT UNION                 MOKANSAS CITY              Y Y20110610
+301081414UTD CONSUMERS CU  UNITED CONSUMERS CREDIT UNION       MOINDEPENDENCE             YSN20120525
+301081508COMMN AMER CU     COMMUNITY AMERICA CREDIT UNION      KSLENEXA                   Y Y20110621
+301170668GOLDEN BELT BANK  GOLDEN BELT BANKING FSA             KSHAYS                     N Y20060315
+301170817FIRST FEDERAL S&L FIRST FED S&L ASN                   KSINDEPENDENCE             N Y20111121
+301170956MUTUAL SAVINGS    MUTUAL SAVINGS ASSOCIATION FSA      KSLEAVENWORTH              N Y20100107
+301171007LANDMARK NATL BK  LANDMARK NATIONAL BANK              KSMANHATTAN                Y Y20011016
+301171285CAPITOL FSB TOPEKACAPITOL FEDERAL SAVINGS BANK        KSTOPEKA                   Y Y        
+301171353FIDELITY BK       FIDELITY BANK                       KSWICHITA                  Y Y20060131
+301177904COOPERATIVE CU    COOPERATIVE CREDIT UNION            KSCOFFEYVILLE              YSN20110310
+301178288GREAT PLAINS FCU  GREAT PLAINS FEDERAL CREDIT UNION   MOJOPLIN                   Y Y        
+301179737QUEST CU          QUEST CREDIT UNION                  KSTOPEKA                   Y N20100916
+301179805CU UNITED         QUEST CREDIT UNION                  KSTOPEKA                   Y N20110331
+301180056CU OF AMERICA     CREDIT UNION OF AMERICA             KSWICHITA                  N Y20140226
+301180111KS CORP WICHITA   KANSAS CORPORATE CREDIT UNION       KSWICHITA                  Y Y20111222
+301180140FREEDOM 1ST FCU   FREEDOM 1ST FCU                     KSWICHITA                  Y N20060428
+301180292MERITRUST CU      MERITRUST CREDIT UNION              KSWICHITA                  Y Y20140106
+301271460FIRST MISSOURI NB FIRST MISSOURI BANK                 MOBROOKFIELD               YSY20111219
+301271512INVESTORS COMM BK INVESTORS COMMUNITY BANK            MOCHILLICOTHE              Y N20140703
+301271732BLVD BK           BOULEVARD BANK                      MOST LOUIS                 Y N20140428
+301271800QUARRY CITY S&L   QUARRY CITY SAVINGS AND LOAN ASN    MOWARRENSBURG              YSN20130917
+302075018BELLCO CU DVR     BELLCO CREDIT UNION                 COENGLEWOOD                Y Y20100903
+302075128C.U. OF CO, A FCU CREDIT UNION OF COLORADO A FCU      CODENVER                   Y Y20140110
+302075283RKY MT LW NFCT FCUROCKY MOUNTAIN LAW ENFORCEMENT FCU  CODENVER                   Y Y20110627
+302075306PARTNER CO CU     PARTNER COLORADO CREDIT UNION       COARVADA                   Y Y20120215
+302075319WESTERRA CU       WESTERRA CU                         CODENVER                   Y Y20110808
+302075458FITZSMNS FCU      FITZSIMONS FEDERAL CREDIT UNION     COAURORA                   Y Y20091117
+302075694DENVER COMMN CU   DENVER COMMUNITY CREDIT UNION       CODENVER                   Y Y20131021
+302075830PUBLIC SVC EMP CU PUBLIC SERVICE EMPLOYEES CU         CODENVER                   Y Y20110509
+302076033SPACE AGE FCU     SPACE AGE FEDERAL CREDIT UNION      COAURORA                   N Y20140114
+302088092RED ROCKS CREDIT URED ROCKS CREDIT UNION              COHIGHLANDS RANCH          Y Y20121003
+302170162DEL NORTE FED BK  DEL NORTE BANK                      CODEL NORTE                N Y20110715
+302170463HIGH COUNTRY BANK HIGH COUNTRY BANK                   COSALIDA                   Y Y20040219
+302173732MONTROSEBANK      MONTROSEBANK                        COMONTROSE                 Y Y        
+302174728SAN LUIS VLY FED  SAN LUIS VALLEY FEDERAL BANK        COALAMOSA                  Y Y20120511
+302176823HOLYOKE COM FED CUHOLYOKE COMMUNITY FEDERAL CU        COHOLYOKE                  Y Y20140317
+302188675HOME LN ST BK     HOME LOAN STATE BANK                COGRAND JUNCTION           Y Y20090527
+302284032CUBA CREDIT UNION CUBA CREDIT UNION                   NMCUBA                     Y N        
+302284058ANIMAS CU FRMGTON ANIMAS CREDIT UNION                 NMFARMINGTON               Y Y20121017
+302373079BIG HORN GREYBULL BIG HORN FEDERAL SAVINGS BANK       WYGREYBULL                 Y Y        
+302373118FIRST FED SHERIDANFIRST FEDERAL SAVINGS BANK          WYSHERIDAN                 Y Y20090914
+303072780HOME SVGS AND LOANHOME SAVINGS AND LOAN ASSOCIATION   OKOKLAHOMA CITY            YSN        
+303085191CREDIT UNION ONE  CREDIT UNION ONE OF OKLAHOMA        OKOKLAHOMA CITY            Y N20051123
+303085227FAA CREDIT UNION  FAA CREDIT UNION                    OKOKLAHOMA CITY            Y Y20040817
+303085230ALLEGIANCE CU     ALLEGIANCE CREDIT UNION             OKOKLAHOMA CITY            Y Y20121010
+303085337MUN EMPL CU OK CITMUN EMPL CU OF OKLA CITY            OKOKLAHOMA CITY            N Y20121129
+303085418OK EMPLOYEES CU   OKLAHOMA EMPLOYEES CREDIT UNION     OKOKLAHOMA CITY            Y Y19991112
+303085421THE FOCUS FCU     THE FOCUS FEDERAL CREDIT UNION      OKOKLAHOMA CITY            Y Y20130329
+303085476COMMUNICAT FED OKCCOMMUNICATION FED CU                OKOKLAHOMA CITY            Y Y20050127
+303085528OKLAHOMA FCU      OKLAHOMA FED CREDIT UNION           OKOKLAHOMA CITY            Y N        
+303085573SSMOK EMPL FCU    SSMOK EMPLOYEES FEDERAL CREDIT UNIONOKOKLAHOMA CITY            Y N20020328
+303085609US EMPLOYEES FCU  US EMPLOYEES O C FEDERAL CU         OKOKLAHOMA CITY            Y N        
+303085638WEOKIE CU OKC     WEOKIE CREDIT UNION                 OKOKLAHOMA CITY            Y Y20121206
+303085829TINKER FEDERAL CU TINKER FEDERAL CREDIT UNION         OKTINKER AFB               Y Y20140310
+303087995MIDFIRST BK OKC   MIDFIRST BANK                       OKOKLAHOMA CITY            Y Y        
+303184542RED RIVER FCU     RED RIVER FEDERAL CU                OKALTUS                    Y Y20131030
+303184652TRUITY FED CU     TRUITY FEDERAL CREDIT UNION         OKBARTLESVILLE             Y N20130816
+303184762HALLIBURTON SER CUHALLIBURTON EMPLOYEES FCU           OKDUNCAN                   Y Y19990930
+303184788CORRECT WORKERS FCCORRECTIONAL WORKERS FCU            OKEL RENO                  Y N20100330
+303184791EL RENO RIL CU    EL RENO R I L CREDIT UNION          OKEL RENO                  Y N20110701
+303184856FORT SILL FCU     FORT SILL FEDERAL CREDIT UNION      OKLAWTON                   Y N20140210
+303184924SOUTHWEST OK FCU  SOUTHWEST OKLAHOMA FEDERAL CU       OKLAWTON                   Y Y20100316
+303185059MUSKOGEE FCU      MUSKOGEE FEDERAL CREDIT UNION       OKMUSKOGEE                 Y N        
+303185761SAND SPRGS FED CU SAND SPRINGS COMMUNITY FEDERAL CU   OKSAND SPRINGS             YSN20130424
+303185813UNIV COMMN FCU    UNIVERSITY & COMMUNITY FCU          OKSTILLWATER               Y Y20090513
+303187675GRAND FED SVGS BK GRAND SAVINGS BANK                  OKGROVE                    Y Y20130329
+303985961FIREFIGHTERS CU   FIRE FIGHTERS CU                    OKTULSA                    YSN20050111
+303986054GOLDEN EAGLE FCU  GOLDEN EAGLE FEDERAL CREDIT UNION   OKTULSA                    Y N        
+303986096OKLA CENT CU TULSAOKLAHOMA CENTRAL CREDIT UNION       OKTULSA                    Y Y20130722
+303986148RED CROWN FCU     RED CROWN FCU                       OKTULSA                    Y Y20121107
+303986193SPACE AGE TULSA CUSPACE AGE TULSA FCU                 OKTULSA                    Y N        
+303986216ST FRANCES EFCU   ST FRANCES EMPLOYEES FCU            OKTULSA                    Y Y20050223
+303986258ENERGY ONE FCU    ENERGY ONE FEDERAL CU               OKTULSA                    Y N20110127
+303986261TULSA FCU         TULSA FEDERAL CREDIT UNION          OKTULSA                    Y Y20140708
+303986290FIRST OKLA FCU    FIRST OKLAHOMA FEDERAL CREDIT UNION OKTULSA                    YSN20140620
+303986313TULSA TEACHERS CU TTCU THE CREDIT UNION               OKTULSA                    Y Y20130314
+304082876CENTRIS FCU       CENTRIS FEDERAL CREDIT UNION        NEOMAHA                    Y Y20090303
+304082999FOUR POINTS FCU   FOUR POINTS FEDERAL CREDIT UNION    NEOMAHA                    Y N20051012
+3040830081ST NEB EDUCATORS FIRST NEB EDUCATORS & EMP GROUPS CU NEOMAHA                    Y N20111101
+304083147MUTUAL FST FCU OMAMUTUAL FIRST FEDERAL CREDIT UNION   NEOMAHA                    Y N19991202
+304083244OMAHA FIREFIGHT CUOMAHA FIREFIGHTER'S CREDIT UNION    NEOMAHA                    Y N20140123
+304083257OMAHA POLICE FCU  OMAHA POLICE FCU                    NEOMAHA                    YSN20101209
+304083396METRO HEALTH FCU  METRO HEALTH SERVICES FCU           NEOMAHA                    Y N        
+304083448SAC FED CU        SAC FEDERAL CREDIT UNION            NEOMAHA                    Y Y20100218
+304087237SUNCORP           SYSTEM UNITED CORPORATE FCU         COBROOMFIELD               Y N20130722
+304171839CUSTER FED ST BK  CUSTER FEDERAL STATE BANK           NEBROKEN BOW               YSN20130627
+304182093WSTRN HERITAGE CU WESTERN HERITAGE CREDIT UNION       NEALLIANCE                 YSN20060331
+304971990LINC FED SVG BK   LINCOLN FEDERAL SAVINGS BANK OF NE  NELINCOLN                  YSN20111214
+304972038MADISON COUNTY BK MADISON COUNTY BANK FSB             NEMADISON                  YSY20060922
+304982222NEB ENERGY FCU    NEBRASKA ENERGY FEDERAL CREDIT UNIONNECOLUMBUS                 YSN20070426
+304982235SIOUXLAND FCU     SIOUXLAND CREDIT UNION              NESOUTH SIOUX CITY         Y N20110103
+304982251FREMONT FST CTRL  FREMONT FIRST CENTRAL FED CU        NEFREMONT                  YSN20121001
+304982316CENTRAL NE FCU    CENTRAL NEBRASKA FEDERAL CU         NEGRAND ISLAND             Y N        
+304982358HASTINGS FCU      HASTINGS FCU                        NEHASTINGS                 YSN20120502
+304982468LIBERTY FIRST CU  LIBERTY FIRST CREDIT UNION          NELINCOLN                  Y Y20040727
+304982688UNIV OF NEB FCU   UNIVERSITY OF NEBRASKA FEDERAL CU   NELINCOLN                  Y Y20130924
+304987191ARCHER COOP CU    ARCHER COOPERATIVE CU               NEARCHER                   YSN20130319
+304987476NAFCO NEBRASKA FCUNAFCO NEBRASKA FCU                  NEGRAND ISLAND             Y N        
+307070005ENT FCU COLO SPRGSENT FED CR UN                       COCOLORADO SPRINGS         Y Y        
+307070021AIR ACADEMY FCU   AIR ACADEMY FCU                     COCOLORADO SPRINGS         Y Y20050113
+307070034WARREN FCU CHEY   WARREN FEDERAL CREDIT UNION         WYCHEYENNE                 Y Y20110420
+307070047SAFCU             SANDIA AREA FCU                     NMALBUQUERQUE              Y N20060216
+307070050KIRTLAND FCU      KIRTLAND FEDERAL                    NMALBUQUERQUE              Y Y20140228
+307070115US BANK WYOMING   US BANK, NA                         MNSAINT PAUL               Y N20130221
+307070267KEY BANK CO       KEYBANK NATIONAL ASSOCIATION        WYCHEYENNE                 Y N        
+307070270MORGAN FED BK     MORGAN FEDERAL BANK                 COFORT MORGAN              N Y20071115
+307072443CENTURY BK        CENTURY BANK                        NMSANTA FE                 Y Y20080102
+307074098FARMERS BK AULT   FARMERS BANK                        COAULT                     Y N20100111
+307074124CASTLE ROCK BK    CASTLE ROCK BANK                    COCASTLE ROCK              Y Y        
+307074454AURORA FCU        AURORA FCU                          COAURORA                   Y N20120725
+307074535BOULDER VALLEY CU BOULDER VALLEY CU                   COBOULDER                  Y Y20111230
+307074551PREMIER MEMBER FCUPREMIER MEMBERS FEDERAL CREDIT UNIONCOBOULDER                  Y Y20130128
+307074580ELEVATIONS FCU    ELEVATIONS CU                       COBOULDER                  Y Y20100419
+307074690AVENTA CU         AVENTA CREDIT UNION                 COCOLORADO SPRINGS         N Y20130219
+307075259CDT UNION OF DVR  CREDIT UNION OF DENVER              COLAKEWOOD                 Y Y19990625
+307076575COMMUNITY FIN CU  COMMUNITY FINANCIAL CU              COBROOMFIELD               Y N20060111
+307077325SUNWEST EDUC CU   SUNWEST EDUCATIONAL CREDIT UNION    COPUEBLO                   YSN20120614
+307077480STERLING FCU      STERLING FEDERAL CREDIT UNION       COSTERLING                 Y N20110919
+307083652US NEW MEXICO FCU US NEW MEXICO FEDERAL CREDIT UNION  NMALBUQUERQUE              Y N20060328
+307083665NM EDUC CU ALBQ   NEW MEXICO EDUCATORS FEDERAL CU     NMALBUQUERQUE              Y Y        
+307083678RIO GRANDE CU     RIO GRANDE CREDIT UNION             NMALBUQUERQUE              YSN20130611
+307083694FIRST FINANCIAL CUFIRST FINANCIAL CREDIT UNION        NMALBUQUERQUE              Y N20000501
+307083911SANDIA LAB FCU ALBSANDIA LABORATORY FCU               NMALBUQUERQUE              Y Y20081022
+307084211DEL NORTE C U     DEL NORTE CREDIT UNION              NMSANTA FE                 Y Y20140423
+307084240ZIA CREDIT UNION  ZIA CREDIT UNION                    NMLOS ALAMOS               Y N        
+307084347GUADALUPE CU      GUADALUPE CREDIT UNION              NMSANTA FE                 Y N20120109
+307084431STATE EMPL CU     STATE EMPL CU                       NMSANTA FE                 Y Y20091109
+307086620UNIF PEOPLES FCU  UNIFIED PEOPLES FCU                 WYCHEYENNE                 Y N20111101
+307086633FIRST CHEYENNE FCUFIRST CHEYENNE FEDERAL CREDIT UNION WYCHEYENNE                 N Y20051216
+307086691W Y H Y FCU       W Y H Y FCU                         WYCHEYENNE                 YSN20130409
+307086701MERIDIAN TRUST FCUMERIDIAN TRUST FCU                  WYCHEYENNE                 Y Y20060208
+307086714WESTERN VISTA FCU WESTERN VISTA FEDERAL CREDIT UNION  WYCHEYENNE                 Y Y20111012
+307086882UNIVYO FCU        UNIWYO FEDERAL CREDIT UNION         WYLARAMIE                  N Y20130212
+307087399SYSTEM UTD CORP FCSYSTEM UNITED CORPORATE FCU         COBROOMFIELD               Y Y20130118
+307087661SECURITY FIRST BK SECURITY FIRST BANK                 WYCHEYENNE                 YSN20130204
+3070877135 STAR BANK       5 STAR BANK                         COCOLORADO SPRINGS         Y Y20010327
+307088754COBANK ACB        COBANK ACB                          COGREENWOOD VILLAGE        Y Y20140501
+307088770UTD LAB OAK       UNITED LABOR BANK, FSB              CAOAKLAND                  Y N20140519
+311078857ADVANCIAL FCU DAL ADVANCIAL FEDERAL CREDIT UNION      TXDALLAS                   Y Y20140417
+311079050CITY CR UNION  DALCITY CREDIT UNION                   TXDALLAS                   Y Y20140430
+311079241PEGASUS CMNTY CU  PEGASUS COMMUNITY CREDIT UNION      TXDALLAS                   YSY20120723
+311079270NEIGHBORHOOD CU   NEIGHBORHOOD CREDIT UNION           TXDALLAS                   Y N20110517
+311079306CR UN OF TEX DAL  CREDIT UNION OF TEXAS               TXDALLAS                   Y Y20010501
+311079319GO FEDERAL CU     GO FEDERAL CREDIT UNION             TXDALLAS                   Y N20120404
+311079474INTOUCH CU        INTOUCH CREDIT UNION                TXPLANO                    Y N20100217
+311079571SHARE PLUS BK     SHAREPLUS BANK                      TXPLANO                    Y N20140107
+311079694TX FEDRL CU-DAL   TEXAS FEDERAL CREDIT UNION          TXDALLAS                   Y N20110829
+311079869LONE STAR CU      LONE STAR CREDIT UNION              TXDALLAS                   Y Y20120424
+311080162RESOURCE ONE CR UNRESOURCE ONE CREDIT UNION           TXDALLAS                   Y N20000815
+311080573LAS COLINAS FCU   LAS COLINAS FCU                     TXIRVING                   Y N20080416
+311090673SOUTHWEST AIRLINESSOUTHWEST AIRLINES FCU              TXDALLAS                   Y N20050728
+311175093BARKSDAL AFBFCU LABARKSDALE FEDERAL CREDIT UNION      LABARKSDALE AFB            Y N20050722
+311175129BOSSIER FEDERAL CUBOSSIER FEDERAL CREDIT UNION        LABOSSIER CITY             Y N20090205
+311175954WESLA FCU SHREVPT WESLA FCU                           LASHREVEPORT               Y N        
+311175983CARTER FCU SPRINGHCARTER FEDERAL CREDIT UNION         LASPRINGHILL               Y Y20000901
+311193059HOMELAND FS COL LAHOMELAND FED SVGS BANK              LACOLUMBIA                 YSY20100714
+311350025BATF              BUR ALCOHOL TOBACCO FIREARMS        OHCINCINNATI               N Y20031023
+311371172DALHART FS & LA   DALHART FEDERAL SAVINGS & LA, SSB   TXDALHART                  Y N20110705
+311372252FIRST FEDERAL     FIRST FEDERAL BANK LITTLEFIELD, SSB TXLITTLEFIELD              YSN20080117
+311373125TEXAS SAVINGS BK  TEXAS SAVINGS BANK, SSB             TXSNYDER                   Y N20090731
+311376533ABILENE TEACH CU  ABILENE TEACHERS FCU                TXABILENE                  Y N        
+311376740AMARILLO FED CCU  AMARILLO COMMUNITY FEDERAL CU       TXAMARILLO                 Y N20000809
+311376782EDUCATION CU AMAR EDUCATION CREDIT UNION, THE         TXAMARILLO                 Y Y20100507
+311376850SANTA FE FCU AMAR SANTA FE FEDERAL CREDIT UNION       TXAMARILLO                 YSY20060901
+311376902ACCESS COMM CU    ACCESS COMMUNITY CREDIT UNION       TXAMARILLO                 Y N20121029
+311378052PANTEX FCU BORGER PANTEX FEDERAL CREDIT UNION         TXBORGER                   N Y20140205
+311380859COMMUNI OF ABILENECOMMUNITIES OF ABILENE FCU          TXABILENE                  Y N20051221
+311382527FST COMM FCU      1ST. COMMUNITY FCU                  TXSAN ANGELO               Y N        
+311385964ALLIANCE FCU      ALLIANCE FEDERAL CREDIT UNION       TXLUBBOCK                  Y N20020425
+311386073TEXAS TECH FCU    TEXAS TECH FCU                      TXLUBBOCK                  Y N20080207
+311387991TRAN TX SCU SAN ANTRANS TEXAS SOUTHWEST CREDIT UNION  TXSAN ANGELO               Y N        
+311390140CITZ FCU BIG SPRNGCITIZENS FEDERAL CREDIT UNION       TXBIG SPRING               Y Y20110609
+311971705GRAHAM S & LA     GRAHAM SAVINGS AND LOAN, SSB        TXGRAHAM                   Y Y20140310
+311972238COLONIAL SVG, FA  COLONIAL SAVINGS, FA                TXFORT WORTH               Y Y20100310
+311972526MINEOLA FS & LA   MINEOLA COMMUNITY BANK, SSB         TXMINEOLA                  N Y20100804
+311972704FST FED CMNTY BK  FIRST FEDERAL COMMUNITY BANK, SSB.  TXPARIS                    N Y20131002
+311973208NEXBANK SSB       NEXBANK SSB                         TXDALLAS                   Y Y20130521
+311978232VALWOOD PARK FCU  VALWOOD PARK FCU                    TXCARROLLTON               N Y19990714
+311978818AFFILATED BK BEDFOAFFILIATED BANK                     TXBEDFORD                  Y N20111012
+311979752MOBILITY CU       MOBILITY CREDIT UNION               TXDALLAS                   Y N20140327
+311979875TEXAS TRUST CU    TEXAS TRUST CREDIT UNION            TXMANSFIELD                Y N20100121
+311980725DENTON AREA TCU   DATCU CREDIT UNION                  TXDENTON                   Y N20110815
+311981533OMNIAMER BK FW    OMNIAMERICAN BANK                   TXFORT WORTH               Y Y20060103
+311981562CORP ENG ECU FTW  CORPS OF ENGINEERS FCU              TXFORT WORTH               Y N20110914
+311981614EECU FT WORTH     EECU                                TXFORT WORTH               Y Y20101013
+311981669FT WORTH CITY CU  FORT WORTH CITY CU                  TXFORT WORTH               Y N        
+311981672FT WOR COMMUNITY CFORT WORTH COMMUNITY CU             TXBEDFORD                  YSN20130402
+311981698FST CLASS AMER CU FIRST CLASS AMERICAN CREDIT UNION   TXFORT WORTH               Y N20140521
+311982134NASCOGA FCU       NASCOGA FEDERAL CREDIT UNION        TXGAINESVILLE              Y N20110928
+311985416TEXAS PARTNERS FCUTEXAS PARTNERS FEDERAL CREDIT UNION TXKILLEEN                  Y N20041126
+311985746NORTH EAST TX CU  NORTH EAST TEXAS CREDIT UNION       TXLONE STAR                Y Y20140514
+311985788ETEX PROF CU LNGVWEAST TEXAS PROFESSIONAL CU          TXLONGVIEW                 Y N20100809
+311987773VIEWPOINT BK NA   VIEWPOINT BANK, NATIONAL ASSOCIATIONTXPLANO                    Y Y20121206
+311987786TEXINS CU         TEXANS CREDIT UNION                 TXDALLAS                   Y Y20070307
+311987870QUALTRUST CU      QUALTRUST CREDIT UNION              TXIRVING                   Y N20070702
+311989001UNION SQ FCU      UNION SQUARE FCU                    TXWICHITA FALLS            Y Y20140325
+311989030TEXOMA EDUC FCU   TEXOMA EDUCATORS FCU                TXSHERMAN                  Y N        
+311989250TEXELL CU         TEXELL CREDIT UNION                 TXTEMPLE                   Y Y20131021
+311989331RED RIVER FCU TEXARED RIVER EMPLOYEES FCU             TXTEXARKANA                YSY20130607
+311989344TEXAR FEDERAL CU  TEXAR FEDERAL CREDIT UNION          TXTEXARKANA                Y Y20111213
+311989852EDUCATORS CU WACO EDUCATORS CU                        TXWACO                     Y Y19990708
+311990058MEMBERS CHOICE OF MEMBERS CHOICE OF CENTRAL TX FCU    TXWACO                     Y Y20101117
+311990388WICHITA FALLS FCU WICHITA FALLS TEACHERS FCU          TXWICHITA FALLS            N Y20131219
+311990511CATALYST CORP FCU CATALYST CORPORATE FCU              TXPLANO                    Y Y20110902
+311991484MY CREDIT UNION   MY CREDIT UNION                     TXWATAUGA                  YSN20090204
+311992904AMER AIRLNS CU DFWAMERICAN AIRLINES FEDERAL CU        TXDFW AIRPORT              Y N20000519
+311993149SW SEC, FSB ARL   SOUTHWEST SECURITIES, FSB           TXDALLAS                   Y N20091009
+311993330BEAL BANK SSB     BEAL BANK, SSB                      TXPLANO                    YSN20100210
+312080941FORT BLISS EL PASOFIRSTLIGHT FCU                      TXEL PASO                  Y Y20110921
+312080970ONE SOURCE FCU    ONE SOURCE FEDERAL CREDIT UNION     TXEL PASO                  Y N20050629
+312081005EVOLVE FEDERAL CU EVOLVE FEDERAL CREDIT UNION         TXEL PASO                  Y Y20140116
+312081034EL PASO AREA TFCU EL PASO AREA TEACHERS FCU           TXEL PASO                  Y N        
+312081089GOVN ECU EL PASO  GECU                                TXEL PASO                  Y Y20051114
+312081186GOLDEN KEY FCU    GOLDEN KEY FCU                      TXEL PASO                  Y N20021220
+312081209TIP OF TEXAS FCU  TIP OF TEXAS FCU                    TXEL PASO                  YSY20090820
+312270023CANNON FCU CLOVIS CANNON FEDERAL CREDIT UNION         NMCLOVIS                   Y N20000809
+312270324BANK '34          BANK '34                            NMALAMOGORDO               Y N20080813
+312270463PIONEER BANK      PIONEER BANK                        NMROSWELL                  Y Y20080227
+312276030LOCO CU ALAMOGORDOLOCO CREDIT UNION                   NMALAMOGORDO               YSN20100802
+312276470WHITE SANDS FCU   WHITE SANDS FEDERAL CREDIT UNION    NMLAS CRUCES               Y N        
+313074370PREFERRED BANK    PREFERRED BANK                      TXHOUSTON                  Y Y20061221
+313077513CMNTY RESOURCE CU COMMUNITY RESOURCE CREDIT UNION     TXBAYTOWN                  Y Y20121205
+313080636SHELL FEDERAL CU  SHELL FCU                           TXDEER PARK                Y Y        
+313082171UNITED COMM CU    UNITED COMMUNITY CREDIT UNION       TXGALENA PARK              Y N20130514
+313082935TEXAS BAY AREA CU TEXAS BAY AREA CREDIT UNION         TXHOUSTON                  Y Y20081126
+313083112PRIMEWAY FCU      PRIMEWAY FEDERAL CREDIT UNION       TXHOUSTON                  Y N20110218
+313083196MEMBERS CHOICE HOUMEMBERS CHOICE CREDIT UNION         TXHOUSTON                  Y N        
+313083251HOUSTON HWY CU    HOUSTON HIGHWAY CU                  TXHOUSTON                  Y N20100922
+313083303ENERGY CAPITAL CU ENERGY CAPITAL CREDIT UNION         TXHOUSTON                  Y Y20130131
+313083578SMART FINANCIAL CUSMART FINANCIAL CREDIT UNION        TXHOUSTON                  Y N20050706
+313083659HOUS POLICE FCU   HOUSTON POLICE FCU                  TXHOUSTON                  Y N        
+313083714HOUS FIREFIGHTERS HOUSTON TEXAS FIRE FIGHTERS FCU     TXHOUSTON                  Y Y        
+313083727PRIMEWAY FCU      PRIMEWAY FEDERAL CREDIT UNION       TXHOUSTON                  Y N20030502
+313083992JSC FCU HOUS      J S C FEDERAL CREDIT UNION          TXHOUSTON                  Y Y20130920
+313084001KBR HERTG FCU HOUSKBR HERITAGE FEDERAL CREDIT UNION   TXHOUSTON                  Y N20010917
+313084124MEMORIAL CU       MEMORIAL CREDIT UNION               TXHOUSTON                  Y N20110802
+313084564SCHLUMBERGER ECU  SCHLUMBERGER EMPLOYEES CREDIT UNION TXSUGAR LAND               Y Y20140318
+313084593PEOPLES TRUST FCU PEOPLES TRUST FCU                   TXHOUSTON                  Y Y20140314
+313084674FIRST COMM CU HOUSFIRST COMMUNITY C.U. OF HOUSTON     TXHOUSTON                  Y Y20140321
+313084904MEMBERSOURCE CU   MEMBERSOURCE CREDIT UNION           TXHOUSTON                  Y N20130409
+313085071US EMP CU TOMBALL U S EMPLOYEES CREDIT UNION          TXTOMBALL                  Y Y20080925
+313085288BRAZOS VALLEY SCU BRAZOS VALLEY SCHOOLS CU            TXKATY                     N Y20140227
+313085495DUPONT LAPORTE FCUBEACON FEDERAL CREDIT UNION         TXLA PORTE                 Y Y20130923
+313087286GULF COAST EDUC CUGULF COAST EDUCATORS FCU            TXPASADENA                 Y N20110516
+313090561FIRST SERVICE CU  FIRST SERVICE CREDIT UNION          TXHOUSTON                  Y Y20110110
+313173792FAYETTE SVGS ASSOCFAYETTE SAVINGS BANK, SSB           TXLA GRANGE                Y N20131202
+313173938ANGELINA SAVINGS  ANGELINA SAVINGS BANK, SSB          TXLUFKIN                   Y N20131108
+313177727MOBIL OIL FCU     MOBILOIL FEDERAL CREDIT UNION       TXBEAUMONT                 Y N20050726
+313177785EDUCATION 1ST FCU EDUCATION FIRST FCU                 TXBEAUMONT                 Y N20130516
+313182615GULF CU GROVES    GULF CREDIT UNION                   TXGROVES                   Y N20051128
+313183368HOUSTON FCU       HOUSTON FEDERAL CREDIT UNION        TXSUGAR LAND               Y Y19990707
+313185191COMMUNITY SVC CU  COMMUNITY SERVICE CREDIT UNION      TXHUNTSVILLE               Y N20111028
+313185515TX DOW EMP CU JACKTEXAS DOW EMPLOYEES CREDIT UNION    TXLAKE JACKSON             Y Y20120327
+313186679DUPONT BW EFCU    DUPONT GOODRICH FCU                 TXNEDERLAND                Y N20110310
+313187571FIVEPOINT CU      FIVEPOINT CREDIT UNION              TXNEDERLAND                Y Y20140314
+313187636HUNTSMAN FCU      NECHES FEDERAL CREDIT UNION         TXPORT NECHES              N Y20140327
+313187649MCT CREDIT UNION  MCT CREDIT UNION                    TXPORT NECHES              YSY20140502
+313189391AMOCO FCU TX CTY  AMOCO FCU                           TXTEXAS CITY               Y Y20140324
+313189401ACU OF TEXAS      ASSOCIATED CREDIT UNION OF TEXAS    TXTEXAS CITY               YSY20140429
+313192647SHELBY SVGS CENTERSHELBY SAVINGS BANK,SSB             TXCENTER                   YSN20131108
+314074269USAA FEDL SA      U S A A FEDERAL SAVINGS BANK        TXSAN ANTONIO              Y N20050728
+314078469HEB FCU SA        H. E. B. FEDERAL CREDIT UNION       TXSAN ANTONIO              Y Y20110819
+314085504AIR FORCE FCU     AIR FORCE FCU                       TXSAN ANTONIO              Y N20020522
+314088284SAN ANTONIO CU    SAN ANTONIO FEDERAL CREDIT UNION    TXSAN ANTONIO              Y Y20031103
+314088530SAN ANT TEL CU    RIVER CITY FEDERAL CREDIT UNION     TXSAN ANTONIO              N Y20131024
+314088556FIRSTMARK CU      FIRSTMARK CREDIT UNION              TXSAN ANTONIO              Y Y20030220
+314088572GENERATIONS FCU   GENERATIONS COMMUNITY FCU           TXSAN ANTONIO              Y Y20130925
+314088637SEC SER FCU SA    SECURITY SERVICE FCU                TXSAN ANTONIO              Y Y19991026
+314088860USAA CU SAN ANTONIUNITED SAN ANTONIO COMMUNITY FCU    TXSAN ANTONIO              Y N20050726
+314089681RANDOLPH BR FCU UCRANDOLPH BROOKS FCU                 TXUNIVERSAL CITY           Y Y        
+314091035TX ASSN PRO FCU SATEXAS ASSNS OF PROFESSIONALS FCU    TXSAN ANTONIO              Y N        
+314092128SYNERGY FCU       SYNERGY FEDERAL CREDIT UNION        TXSAN ANTONIO              Y Y20140219
+314092717BAPTIST FCU SA    BAPTIST CREDIT UNION                TXSAN ANTONIO              YSN20101117
+314970664COMPASS BK TX     COMPASS BANK                        TXAUSTIN                   Y N20100329
+314971168TRUSTTEX BK, CUEROTRUSTTEXAS BANK, SSB                TXCUERO                    Y Y20060727
+314972853WOODFOREST NAT BK WOODFOREST NATIONAL BANK            TXTHE WOODLANDS            Y N20110429
+314973548GREATER S FALFURRIGREATER STATE BANK                  TXFALFURRIAS               Y N20131104
+314977104A+FCU             A PLUS FEDERAL CREDIT UNION         TXAUSTIN                   Y Y20140121
+314977133VELOCITY CU       VELOCITY CREDIT UNION               TXAUSTIN                   Y N20030220
+314977175AUSTIN TELCO FCU  AUSTIN TELCO FCU                    TXAUSTIN                   Y Y20111128
+314977188UNITED HERITAGE CUUNITED HERITAGE CREDIT UNION        TXAUSTIN                   Y Y20100902
+314977191CAPITAL CU AUSTIN CAPITOL CREDIT UNION                TXAUSTIN                   Y N20001201
+314977227AMPLIFY FCU AUSTINAMPLIFY FEDERAL CREDIT UNION        TXAUSTIN                   Y Y20061010
+314977298PUBLIC EMP CU     PUBLIC EMPLOYEES CREDIT UNION       TXAUSTIN                   Y N20121019
+314977337GREATER TX FCU AUSGREATER TEXAS FCU                   TXAUSTIN                   Y Y20130617
+314977340TEXAS HEALTH CU   TEXAS HEALTH CREDIT UNION           TXAUSTIN                   Y N        
+314977405UNIV FCU AUSTIN   UNIVERSITY FEDERAL CREDIT UNION     TXAUSTIN                   Y Y20130408
+314978417COASTAL COMM & TCUCOASTAL COMMUNITY AND TEACHERS CU   TXCORPUS CHRISTI           Y N20020710
+314978543NAVY ARMY COMM CU NAVY ARMY COMMUNITY CREDIT UNION    TXCORPUS CHRISTI           Y N20111122
+314978598STAR FCU CC       SOUTH TEXAS AREA RESOURCES CU       TXCORPUS CHRISTI           Y N20050728
+314978653SUNTIDE CU        SUNTIDE CREDIT UNION                TXCORPUS CHRISTI           YSN20130327
+314982694RIO GRANDE VALLEY RIO GRANDE VALLEY CREDIT UNION      TXHARLINGEN                Y Y20140228
+314985662BORDER CU DEL RIO BORDER FEDERAL CREDIT UNION         TXDEL RIO                  Y N        
+314986292SECURITY FIRST FCUSECURITY FIRST FEDERAL CREDIT UNION TXMCALLEN                  Y N20110525
+316386434MY COMMUNITY FCU  MY COMMUNITY FCU                    TXMIDLAND                  Y Y20131212
+316386492HERITAGE USA FCU  HERITAGE USA FEDERAL CREDIT UNION   TXMIDLAND                  Y N20020801
+316386803FIRST BASIN CU    FIRST BASIN CREDIT UNION            TXODESSA                   Y Y20110502
+321070007CITIBANK-WEST FSB CITIBANK, N.A.                      CASAN FRANCISCO            Y N20120622
+321070162BAY VIEW BK SM    US BANK, NA                         CASAN MATEO                Y N20130627
+321070227CA SL AFA SF      US BANK, NA                         CASAN FRANCISCO            Y N20130627
+321070450EW BK SMRINO      EAST WEST BANK                      CASAN FRANCISCO            Y N20100427
+321070829UTD LAB OAK       UNITED LABOR BANK, FSB              CAOAKLAND                  Y N20060614
+321071161GATE FSB OAKLAND  GATEWAY BANK, FSB                   CASAN LEANDRO              Y Y20060614
+321075866SPECTRUM CU       CFCU DBA SPECTRUM FCU               CASAN FRANCISCO            Y N20120831
+321075947CHEVRON FCU       CHEVRON FEDERAL CREDIT UNION        CAOAKLAND                  Y N20111123
+321076441SF FCU SF         SAN FRANCISCO FCU                   CASAN FRANCISCO            Y N20060614
+321076470PATELCO CU        PATELCO CREDIT UNION                CAPLEASANTON               Y Y20140325
+321076506SF FIRE CU        SAN FRANCISCO FIRE CREDIT UNION     CASAN FRANCISCO            Y Y20140401
+321081669FST REP BK SF     FIRST REPUBLIC BANK                 CASAN FRANCISCO            Y N20100630
+321170538COMPASS BK CA     COMPASS BANK                        CASTOCKTON                 Y Y20100212
+321170596FST FEDL SL S RAF FIRST FEDERAL SAVINGS & LOAN ASSN   CASAN RAFAEL               Y N20060710
+321170839TRAVIS CU VACA    TRAVIS CREDIT UNION                 CAVACAVILLE                Y Y20000502
+321170842HERITAGE COMM CU  HERITAGE COMMUNITY CREDIT UNION     CARANCHO CORDOVA           Y Y20050727
+321170978EL DOR SVGS PLAC  EL DORADO SAVINGS BANK, FSB         CAPLACERVILLE              Y Y20060614
+321171139HUMBOLDT BANK     UMPQUA BANK                         CANAPA                     Y N20040714
+321171184CITIBANK WEST FSB CITIBANK, N.A.                      CASAN FRANCISCO            Y N20120622
+321171265SEA WST FCU OAK   SEA WEST FEDERAL CREDIT UNION       CAOAKLAND                  Y Y20140409
+321171304FST UTD SVCS CU   FIRST UNITED SERVICES CREDIT UNION  CAHAYWARD                  N Y        
+321171621COOP CTR FCU BERK COOPERATIVE CENTER FCU              CABERKELEY                 Y N20060614
+321171689BUTTE FCU BIGGS   BUTTE FEDERAL CREDIT UNION          CABIGGS                    YSN20090821
+321171731PROVIDENT CU      PROVIDENT CREDIT UNION              CAREDWOOD CITY             Y Y20130314
+321171757SAFEAMERICA CU    SAFEAMERICA CREDIT UNION            CAHAYWARD                  Y Y20120910
+321171773VLY CU SJ         CITIZENS EQUITY FIRST CREDIT UNION  CASAN JOSE                 Y N20091221
+321172125SILV FCU ANG      SILVERADO CREDIT UNION              CAANGWIN                   Y N20020301
+321172248COS CENT CU EUR   COAST CENTRAL CREDIT UNION          CAEUREKA                   Y Y        
+321172316CMT FCU FAIR      SOLANO FIRST FEDERAL CREDIT UNION   CAFAIRFIELD                Y N20070402
+321172374CENT COS FCU CSIDECENTRAL COAST FEDERAL CREDIT UNION  CASEASIDE                  Y Y20010718
+321172510FRESNO CNTY FCU   FRESNO COUNTY FCU                   CAFRESNO                   Y Y20140627
+321172594ED ECU FRES       EDUCATIONAL EMPLOYEES CREDIT UNION  CAFRESNO                   Y Y20130716
+321172824KINGS FCU HANFORD KINGS FEDERAL CREDIT UNION          CAHANFORD                  Y N20120529
+321172992GOLDEN VALLEY FCU GOLDEN VALLEY FEDERAL CREDIT UNION  CAMANTECA                  YSN20110519
+321173072UNC CU LIVER      UNCLE CREDIT UNION                  CALIVERMORE                Y Y20030326
+321173182CONTRA COSTA FCU  CONTRA COSTA FEDERAL CREDIT UNION   CAMARTINEZ                 N Y20131127
+321173289MENLO SURVEY FCU  MENLO SURVEY FEDERAL CREDIT UNION   CAMENLO PARK               Y N20120524
+321173315REACH FCU CA      REACH FEDERAL CREDIT UNION          CAMENLO PARK               Y Y20130822
+321173328SRI FCU           S R I FEDERAL CREDIT UNION          CAMENLO PARK               Y N20130123
+321173470MOCSE FCU MOD     MOCSE FEDERAL CREDIT UNION          CAMODESTO                  Y N20090715
+321173522VALLEY FST CU MOD VALLEY FIRST CREDIT UNION           CAMODESTO                  Y Y20020919
+321173603MONT CU MONT      MONTEREY CREDIT UNION               CAMONTEREY                 Y Y20130402
+321173674TECH CU SJ        TECHNOLOGY CREDIT UNION             CASAN JOSE                 Y Y20120426
+321173742SAFE FCU NO HIGH  S A F E CREDIT UNION                CANORTH HIGHLANDS          Y Y19990621
+321174000FST UTD SVC CU HAYFIRST UNITED SERVICES CREDIT UNION  CAPLEASANTON               Y N20111006
+321174770SIER CENT CU YU CYSIERRA CENTRAL CREDIT UNION         CAYUBA CITY                Y Y        
+321174819MY CREDIT UNION   MY CREDIT UNION                     CAREDWOOD CITY             Y N20060920
+321174851SM CU RED         SAN MATEO CREDIT UNION              CAREDWOOD CITY             Y N        
+321175232CAHP CU SACRAMENTOC A H P CREDIT UNION                CASACRAMENTO               Y N20130920
+321175261GOLD ONE CU SAC   GOLDEN ONE CREDIT UNION             CASACRAMENTO               Y Y19990730
+321175481FST FCU SAC       FIRST US COMMUNITY CREDIT UNION     CASACRAMENTO               Y N20111123
+321175520SAC CU SAC        SACRAMENTO CREDIT UNION             CASACRAMENTO               Y Y        
+321175588BIG VALLEY FCU    BIG VALLEY FEDERAL CREDIT UNION     CASACRAMENTO               YSN20110901
+321175614WSTRN VALLEY FCU  WESTERN VALLEY, FCU                 CAROSEVILLE                Y N20111207
+321175627SCH FIN CU SAC    SCHOOLS FINANCIAL CREDIT UNION      CASACRAMENTO               Y Y20140314
+321176260OPER ENG FCU DUB  OPERATING ENGINEERS UNION #3 FCU    CALIVERMORE                Y Y20130329
+321176794COMWEA CENT CU SJ COMMONWEALTH CENTRAL CREDIT UNION   CASAN JOSE                 Y Y20120917
+321176804ALLIANCE CU SJ    ALLIANCE CREDIT UNION               CASAN JOSE                 Y Y20011108
+321176833MERIWST CU SJOSE  MERIWEST CREDIT UNION               CASAN JOSE                 Y Y20000721
+321176875PREMIERONE CU     PREMIERONE CREDIT UNION             CASAN JOSE                 Y N20130403
+321176972SCLA CNTY FCU SJ  SANTA CLARA COUNTY FCU              CASAN JOSE                 Y Y20060614
+321177434PREMIERONE CU     PREMIERONE CREDIT UNION             CASAN JOSE                 Y N20130329
+321177586RDWD CU S ROSA    REDWOOD CREDIT UNION                CASANTA ROSA               Y Y20000726
+321177599COMM FST CU S ROSACOMMUNITY FIRST CREDIT UNION        CASANTA ROSA               Y Y20000920
+321177706BAY FCU CAPITOLA  BAY FEDERAL CREDIT UNION            CACAPITOLA                 Y N20111128
+321177722STAN FCU PALO     STANFORD FEDERAL CREDIT UNION       CAPALO ALTO                Y N        
+321177735CENT ST CU STOC   CENTRAL STATE CREDIT UNION          CASTOCKTON                 Y N        
+321177803FINANCIAL CTR CU  FINANCIAL CENTER CREDIT UNION       CASTOCKTON                 YSY20110915
+321177968STAR ONE CU SUNNY STAR ONE CREDIT UNION               CASUNNYVALE                Y Y20120404
+321178158TULARE CTY FCU CA TULARE COUNTY TEACHERS FCU          CATULARE                   Y N20081126
+321178514YOLO FCU WOOD     YOLO FEDERAL CREDIT UNION           CAWOODLAND                 Y N        
+321180379FIRST TECH FCU CA FIRST TECHNOLOGY FCU                CAROCKLIN                  Y Y20110526
+321180515KEYPOINT CU       KEYPOINT CREDIT UNION               CASANTA CLARA              Y N20040816
+321180939S CRUZ CU S CRUZ  SANTA CRUZ COMMUNITY CREDIT UNION   CASANTA CRUZ               Y N20060928
+321181297LU BUR SVGS S ROSALUTHER BURBANK SAVINGS              CASANTA ROSA               Y Y19990930
+321181307SUMMIT STATE BANK SUMMIT STATE BANK                   CASANTA ROSA               Y Y20050217
+321181491FIN THRFT CO PORTVFINANCE & THRIFT COMPANY            CAPORTERVILLE              Y Y20090917
+321181501MURPHY BK FRES    MURPHY BANK                         CAFRESNO                   Y Y        
+321280143GRTR NV CU CARS CYGREATER NEVADA CREDIT UNION         NVCARSON CITY              Y N        
+321280198GR BAS FCU RENO   GREAT BASIN FEDERAL CREDIT UNION    NVRENO                     Y N        
+321370707TERR SVGS BK      TERRITORIAL SAVINGS BANK            HIHONOLULU                 Y Y20040106
+321370765AM SL HONO        AMERICAN SAVINGS BANK, FSB          HIHONOLULU                 Y Y20060614
+321378660HFS FCU HILO HI   HFS FEDERAL CREDIT UNION            HIHILO                     Y Y20120627
+321378673HELCO FCU HILO HI HELCO FEDERAL CREDIT UNION          HIHILO                     Y N20120509
+321378990HAWAII CENTRAL FCUHAWAII CENTRAL FEDERAL CREDIT UNION HIHONOLULU                 YSN20130723
+321379041HAWAII STATE FCU  HAWAII STATE FEDERAL CREDIT UNION   HIHONOLULU                 Y Y20131106
+321379070HAWAIIAN TEL FCU  HAWAIIAN TEL FEDERAL CREDIT UNION   HIHONOLULU                 Y N20111107
+321379106HICKAM FCU HI     HICKAM FEDERAL CREDIT UNION         HIHONOLULU                 Y Y20140625
+321379148ALOHA PACIFIC FCU ALOHA PACIFIC FEDERAL CREDIT UNION  HIHONOLULU                 N Y20131105
+321379180HAWAII LAW FCU    HAWAII LAW ENFORCEMENT FCU          HIHONOLULU                 YSN20130806
+321379261KAMEHAMEHA FCU HI KAMEHAMEHA FEDERAL CREDIT UNION     HIHONOLULU                 Y N20120425
+321379410HAWAIIUSA FCU     HAWAIIUSA FCU                       HIHONOLULU                 Y N20130423
+321379504QUEENS FCU        QUEENS FEDERAL CREDIT UNION         HIHONOLULU                 Y N20110601
+321379546HAWAII PACIFIC FCUHAWAII PACIFIC FEDERAL CREDIT UNION HIHONOLULU                 Y N20120705
+321379656U OF HAWAII FCU   UNIVERSITY OF HAWAII FCU            HIHONOLULU                 Y N20111011
+321379818HAWAII COMM FCU   HAWAII COMMUNITY FCU                HIKAILUA KONA              N Y20140505
+321379902KAUAI COMM FCU    KAUAI COMMUNITY FEDERAL CREDIT UNIONHILIHUE                    N Y20140616
+321379915KAUAI GOV EMP FCU KAUAI GOVERNMENT EMPLOYEES FCU      HILIHUE                    Y N20100601
+321380072MAUI COUNTY FCU   MAUI COUNTY FEDERAL CREDIT UNION    HIWAILUKU                  N Y20140604
+321380328PEARL HARBOR FCU  PEARL HARBOR FEDERAL CREDIT UNION   HIHONOLULU                 N Y20140319
+321380632CATALYST CORP FCU CATALYST CORPORATE FCU              HIHONOLULU                 Y N20120629
+321381233FIN FAC LTD HONO  FINANCE FACTORS, LTD                HIHONOLULU                 Y Y20080422
+321470364BK PACIFIC HAGATNABANKPACIFIC, LTD                    GUHAGATNA                  Y N20100827
+321480406COMM FST GUAM FCU COMMUNITY FIRST GUAM FCU            GUHAGATNA                  Y Y20140717
+321481612GOVT GUAM FCU GUAMCOAST360 FEDERAL CREDIT UNION       GUMAITE                    Y Y20131212
+322070145BROADWAY FED BK LABROADWAY FEDERAL BANK FSB           CALOS ANGELES              Y N20130424
+322070239ONEUNITED BK      ONEUNITED BANK                      CALOS ANGELES              Y N20030110
+322070381EW BK SMRINO      EAST WEST BANK                      CAPASADENA                 Y Y20100420
+322070598ONEUNITED BANK    ONEUNITED BANK                      CALOS ANGELES              Y N20030825
+322070789AEROSPACE FCU     AEROSPACE FEDERAL CREDIT UNION      CAEL SEGUNDO               Y Y20130501
+322071872HANMI BANK        HANMI BANK                          CALOS ANGELES              Y N20050811
+322071982STANDARD BK LA CALEAST WEST BANK                      CAMONTEREY PARK            Y N20100420
+322074277CERT FCU DIV VONS CERTIFIED FCU, A DIV OF VONS EFCU   CACOMMERCE                 Y N20110729
+322076181MUSICIANS INTERGUIMUSICIANS INTERGUILD CREDIT UNION   CALOS ANGELES              N Y20140331
+322076233AFTRA FCU HOLLY   AFTRA-SAG FEDERAL CREDIT UNION      CABURBANK                  Y Y20130419
+322077313CA BEAR FCU LA    CALIFORNIA BEAR CREDIT UNION        CALOS ANGELES              Y Y19990812
+322077562L A FINANCIAL FCU LA FINANCIAL FEDERAL CREDIT UNION   CAPASADENA                 Y Y20060614
+322077766PAC TRANS FCU     PACIFIC TRANSPORTATION FCU          CAGARDENA                  Y N20110801
+322077779SKYONE FCU CA     SKYONE FEDERAL CREDIT UNION         CAHAWTHORNE                Y Y20121227
+322077795FMR INS GRP FCU LAFARMERS INSURANCE GROUP FCU         CALOS ANGELES              Y N20060614
+322077818FISCAL CU         FISCAL CREDIT UNION                 CAGLENDALE                 Y N20030915
+322078325SOUTHLAND CU CA   SOUTHLAND CREDIT UNION              CALOS ALAMITOS             Y Y20120426
+322078341FIREFIGHTERS FIRSTFIREFIGHTERS FIRST CREDIT UNION     CALOS ANGELES              Y N20140331
+322078370LA FCU GLEN       LOS ANGELES FEDERAL CREDIT UNION    CALOS ANGELES              Y Y19991019
+322078383FA FCU MONT PK    F & A FEDERAL CREDIT UNION          CALOS ANGELES              Y Y20110627
+322078422WTR & PWR COMM CU WATER & POWER COMMUNITY CU          CALOS ANGELES              Y Y20140128
+322078464CA CU GLENDALE    CALIFORNIA CREDIT UNION             CAGLENDALE                 Y Y20010629
+322078493LA POLICE CU VN   LOS ANGELES POLICE CREDIT UNION     CAVAN NUYS                 Y Y        
+322078930PRIORITY1 CU S PASPRIORITY ONE CREDIT UNION           CASOUTH PASADENA           Y N20080724
+322078972FIRST CITY CU LA  FIRST CITY CREDIT UNION             CALOS ANGELES              Y Y20021206
+322078998CLEARPATH FCU CA  CLEARPATH FEDERAL CREDIT UNION      CAGLENDALE                 Y Y20100825
+322079133SO WEST FCU LA HABSOUTH WESTERN FEDERAL CREDIT UNION  CALA HABRA                 Y Y20120117
+322079227E CENTRAL CU      E-CENTRAL CREDIT UNION              CAPASADENA                 Y N20090319
+322079324TECHNICOLOR FCU CATECHNICOLOR FEDERAL CREDIT UNION    CANORTH HOLLYWOOD          Y N20120427
+322079353WESCOM CU PAS     WESCOM CENTRAL CREDIT UNION         CAPASADENA                 Y Y20061122
+322079557USC FCU LA        USC CREDIT UNION                    CALOS ANGELES              Y N20010320
+322079719WEST FCU LA       WESTERN FEDERAL CREDIT UNION        CAHAWTHORNE                Y Y20140228
+322086142EW BK SMRINO      EAST WEST BANK                      CASAN FRANCISCO            Y N20100422
+322172108COCONINO FCU AZ   COCONINO FEDERAL CREDIT UNION       AZFLAGSTAFF                Y N20120906
+322172153CRDT UNION WST PHOCREDIT UNION WEST                   AZGLENDALE                 Y Y20060510
+322172441AZ CENT CU        ARIZONA CENTRAL CREDIT UNION        AZPHOENIX                  Y N20090925
+322172496AZ STATE CU PHX   ARIZONA STATE CREDIT UNION          AZPHOENIX                  Y Y20090827
+322172506SUNWEST FCU PHO   SUNWEST FEDERAL CREDIT UNION        AZPHOENIX                  Y Y20121217
+322172742FIRST CU AZ       FIRST CREDIT UNION                  AZPHOENIX                  N Y20130819
+322172797AZ FCU PHO        ARIZONA FEDERAL CREDIT UNION        AZPHOENIX                  Y Y20020123
+322172852ALTIER CU PHNX AZ ALTIER CREDIT UNION                 AZTEMPE                    Y N20130314
+322172904HONEYWELL FCU PHO AERO FEDERAL CREDIT UNION           AZGLENDALE                 YSY20090715
+322173055TRUWEST CU        TRUWEST CREDIT UNION                AZSCOTTSDALE               Y Y20140303
+322173149LANDINGS CU       LANDINGS CREDIT UNION               AZTEMPE                    Y N20131031
+322173217AEA FCU YUMA AZ   A E A FEDERAL CREDIT UNION          AZYUMA                     YSN20091023
+322174708VAN WEST CU TUCSONVANTAGE WEST CREDIT UNION           AZTUCSON                   Y N20131126
+322174795PIMA FCU TUCSON AZPIMA FEDERAL CREDIT UNION           AZTUCSON                   YSY20121219
+322174986TUCSON FCU AZ     TUCSON FEDERAL CREDIT UNION         AZTUCSON                   Y Y20130412
+322184578CATALYST CORP FCU CATALYST CORPORATE FCU              AZPHOENIX                  Y N20121026
+322270055GLEN FEDL BK GLEN CITIBANK, N.A.                      CAGLENDALE                 Y N20130424
+322270275UNVSL BK WC       UNIVERSAL BANK                      CAWEST COVINA              Y Y20000920
+322270288ONEWEST BK NA     ONEWEST BANK, NATIONAL ASSOCIATION  CAPASADENA                 Y Y20140303
+322270356DOWNEY SL NEW BCH US BANK, NA                         CANEWPORT BEACH            Y N20130627
+322270453BANCO POPULAR     BANCO POPULAR NORTH AMERICA         CAWHITTIER                 Y N20050214
+322270495PFF BK TR POMONA  US BANK, NA                         CARANCHO CUCAMONGA         Y N20130627
+322270518PROV FSB RSIDE    PROVIDENT SAVINGS BANK, FSB         CARIVERSIDE                Y Y20060614
+322270770EDWARDS CU EDWARDSEDWARDS FEDERAL CREDIT UNION        CAEDWARDS AFB              Y Y        
+322270822COASTHILLS FCU    COASTHILLS FEDERAL CREDIT UNION     CAVANDENBRG AFB            Y Y20031020
+322271038VISTERRA CU MVLY  VISTERRA CREDIT UNION               CAMORENO VALLEY            Y N20060501
+322271083OPUS BK IRVINE CA OPUS BANK                           CAFULLERTON                Y N20111031
+322271106ONEWEST BK NA     ONEWEST BANK, NATIONAL ASSOCIATION  CACULVER CITY              Y N20140303
+322271407COMM TL MONT PK   COMMUNITY COMMERCE BANK             CACLAREMONT                Y N20090611
+322271627WASH MUT BANK     JPMORGAN CHASE BANK, NA             WASEATTLE                  Y N20091022
+322271724CITIBANK WEST FSB CITIBANK, N.A.                      CAOAKLAND                  Y N20120622
+322273379ECCU              EVANGELICAL CHRISTIAN CREDIT UNION  CABREA                     Y N20040126
+322273489FOOT FCU ARCADIA  FOOTHILL FEDERAL CREDIT UNION       CAARCADIA                  YSY20111020
+322273609SAFE ONE CU BAKER SAFE 1 CREDIT UNION                 CABAKERSFIELD              Y N        
+322273706KERN FCU BAKER    KERN FEDERAL CREDIT UNION           CABAKERSFIELD              Y Y19990708
+322273722KERN SCH FCU BAKERKERN SCHOOLS FEDERAL CREDIT UNION   CABAKERSFIELD              Y N        
+322274116BURBK CFCU BURBK  BURBANK CITY FCU                    CABURBANK                  Y N20101118
+322274132UME FCU CA        UME FEDERAL CREDIT UNION            CABURBANK                  Y N20110609
+322274158FST ENT HOLLY     FIRST ENTERTAINMENT CREDIT UNION    CAHOLLYWOOD                Y Y20111108
+322274187LOGIX FCU BURBK   LOGIX FEDERAL CREDIT UNION          CABURBANK                  Y Y20120706
+322274242PARTNERS FCU BURBKPARTNERS FCU                        CABURBANK                  Y Y20140701
+322274462ALTAONE FCU RIDGE ALTAONE FEDERAL CREDIT UNION        CARIDGECREST               Y Y20120604
+322274488CABRILLO CU       CABRILLO CREDIT UNION               CASAN DIEGO                Y N20060928
+322274527BANC OF CA NA     BANC OF CALIFORNIA, NA              CAIRVINE                   Y Y20131011
+322274831CCCU              CHRISTIAN COMMUNITY CREDIT UNION    CACOVINA                   Y N20040805
+322274925PACIFIC MARINE CU PACIFIC MARINE CREDIT UNION         CAOCEANSIDE                Y Y20110214
+322275157FINL PTNR CU DOWNYFINANCIAL PARTNERS CREDIT UNION     CADOWNEY                   Y Y20110429
+322275296SUN COMMUNITY FCU SUN COMMUNITY FEDERAL CREDIT UNION  CAEL CENTRO                Y N20030801
+322275429VONS EFCU         VONS EMPLOYEES FEDERAL CREDIT UNION CAEL MONTE                 YSN20100831
+322275490XCEED FCU EL SEG  XCEED FINANCIAL CREDIT UNION        CAEL SEGUNDO               Y Y20120216
+322275607AM FST FCU LA HAB AMERICAN FIRST CREDIT UNION         CALA HABRA                 Y Y20130403
+322275610PAC COMM FULETON  PACIFIC COMMUNITY CREDIT UNION      CAFULLERTON                Y Y        
+322275746HONDA FCU TOR     HONDA FEDERAL CREDIT UNION          CATORRANCE                 Y N20060301
+322275885GLENDALE FCU CA   GLENDALE FEDERAL CREDIT UNION       CAGLENDALE                 Y Y20130221
+322275908CAL ADVENTIST FCU CALIFORNIA ADVENTIST FCU            CAGLENDALE                 YSN20120309
+322275995WESCOM RES GRP    WESCOM CENTRAL CREDIT UNION         CAGOLETA                   Y N20120430
+322276088NTHRP GRUMN GARDNANORTHROP GRUMMAN FCU                CAGARDENA                  Y Y20110927
+322276305FST IMP CU        FIRST IMPERIAL CREDIT UNION         CAEL CENTRO                Y N20031006
+322276855LNG BCH SCHOOLS   LBS FINANCIAL CREDIT UNION          CALONG BEACH               YSN20110919
+322277281FST FIN CU WC     FIRST FINANCIAL CREDIT UNION        CAWEST COVINA              Y Y20071206
+322278073KINECTA FCU MN BCHKINECTA FEDERAL CREDIT UNION        CAMANHATTAN BEACH          Y Y20051222
+322278183SIMPLICITY BK     SIMPLICITY BANK                     CACOVINA                   Y Y20121113
+322279975CAMINO FCU CA     CAMINO FEDERAL CREDIT UNION         CAMONTEBELLO               YSN20111028
+322280032SCE FCU IRWIN     SCE FEDERAL CREDIT UNION            CAEL MONTE                 Y Y20140220
+322280058GLEN SCH FCU GLEN GLENDALE AREA SCHOOLS FCU           CAMONTROSE                 Y Y20060614
+322280278MATADORS FCU      MATADORS COMMUNITY CREDIT UNION     CANORTHRIDGE               Y N20130123
+322280375CHAFFEY FCU CA    CHAFFEY FEDERAL CREDIT UNION        CAONTARIO                  Y Y20121105
+322280692CAL TECH EE FCU CACAL TECH EMPLOYEES FCU              CAPASADENA                 Y N20100607
+322280728PASADENA FCU      PASADENA FEDERAL CREDIT UNION       CAPASADENA                 Y N20040923
+322280731PAS SCH FCU PAS   PASADENA SERVICE FCU                CAPASADENA                 Y N20110701
+322280838PACIFIC OAKS FCU  PACIFIC OAKS FEDERAL CREDIT UNION   CAOXNARD                   Y Y20101027
+322280867CATALYST CORP FCU CATALYST CORPORATE FCU              CASAN DIMAS                Y N20120629
+322280993CBC FCU PT HUENEMEC B C FEDERAL CREDIT UNION          CAPORT HUENEME             Y Y        
+322281057WESTERN FEDERAL CUWESTERN FEDERAL CREDIT UNION        CAMANHATTAN BEACH          Y N20031103
+322281235ALTURA CU         ALTURA CREDIT UNION                 CARIVERSIDE                Y Y20090813
+322281426MIRAMAR FCU SD    MIRAMAR FEDERAL CREDIT UNION        CASAN DIEGO                Y Y        
+322281455NO CNTY CU SD     NORTH COUNTY CREDIT UNION           CASAN DIEGO                Y Y20010808
+322281468NORTH ISLAND CU SDNORTH ISLAND CREDIT UNION           CASAN DIEGO                Y Y20100503
+322281484PT LOMA CU SD     POINT LOMA CREDIT UNION             CASAN DIEGO                Y Y20051107
+322281507MISSION FCU SD    MISSION FEDERAL CREDIT UNION        CASAN DIEGO                Y Y        
+322281549SD METRO CU       SAN DIEGO METROPOLITAN CREDIT UNION CASAN DIEGO                Y N20060328
+322281578CA COAST CU SD    CALIFORNIA COAST CREDIT UNION       CASAN DIEGO                Y Y20080829
+322281604FINL 21 CCU       CALIFORNIA COAST CREDIT UNION       CASAN DIEGO                Y N20090702
+322281617SD CNTY CU SD     SAN DIEGO COUNTY CREDIT UNION       CASAN DIEGO                Y Y20001108
+322281633FIRST FUTURE CU   CALIFORNIA COAST CREDIT UNION       CASAN DIEGO                Y N20080626
+322281659FIRST FUTURE CU   CALIFORNIA COAST CREDIT UNION       CASAN DIEGO                Y N20081016
+322281691UNIV ST ECU SD    USE CREDIT UNION                    CASAN DIEGO                Y Y20060518
+322281963PATRIOTS FCU CA   PATRIOTS FEDERAL CREDIT UNION       CAIRVINE                   N Y20140214
+322281989ORAN CNTY FCU SANAORANGE COUNTY'S CREDIT UNION        CASANTA ANA                Y Y20060412
+322281992EAGLE COMM LK FOREEAGLE COMMUNITY CREDIT UNION        CALAKE FOREST              Y Y20120406
+322282001SCHOOLSFIRST FCU  SCHOOLSFIRST FEDERAL CREDIT UNION   CATUSTIN                   Y Y20130516
+322282137SBARB CNTY SBARB  SANTA BARBARA COUNTY FCU            CASANTA BARBARA            Y N20060614
+322282153SBARB TEACH SBARB SANTA BARBARA TEACHERS FCU          CASANTA BARBARA            Y Y20060614
+322282399MC DGLS FCU HNTBCHNUVISION FEDERAL CREDIT UNION       CAHUNTINGTON BEACH         Y Y20100423
+322282519SEA AIR FCU       SEA AIR FEDERAL CREDIT UNION        CASEAL BEACH               Y Y20110127
+322282580ALTA VISTA CU     ALTA VISTA CREDIT UNION             CAREDLANDS                 Y Y20100203
+322282603ARROWHD CCU SBERNAARROWHEAD CENTRAL CREDIT UNION      CASAN BERNARDINO           Y Y20100503
+322282616SAN BERNADN SE FCUSAN BERNARDINO SCHOOL EMPL FCU      CASN BERNRDNO              Y N20100108
+3222826321ST VALLEY CU CA  1ST VALLEY CREDIT UNION             CASAN BERNARDINO           Y N20110415
+322282713SESLOC FCU SLO CA SESLOC FEDERAL CREDIT UNION         CASAN LUIS OBISPO          Y Y20120531
+322282920CHEV VLY CU BAKER CHEVRON VALLEY CREDIT UNION         CABAKERSFIELD              Y N20121002
+322283152TOR FCU TOR       TORRANCE COMMUNITY CREDIT UNION     CATORRANCE                 YSY20130502
+322283220UNVSL CU UNVSL    UNIVERSAL CITY STUDIOS CREDIT UNION CAUNIVERSAL CTY            Y N        
+322283505VEN CNTY CU VEN   VENTURA COUNTY CREDIT UNION         CAVENTURA                  Y N20010718
+322283767AMER CHRISTIAN CU AMERICA'S CHRISTIAN CREDIT UNION    CAGLENDORA                 Y N20051223
+322283796CU OF SO CAL WHIT CREDIT UNION OF SOUTHERN CALIFORNIA CABREA                     Y N20130731
+322283822PROS FCU CY INDUS PROSPECTORS FEDERAL CREDIT UNION    CADIAMOND BAR              Y Y20081008
+322283990PREMIER AMERICA CUPREMIER AMERICA CREDIT UNION        CACHATSWORTH               Y Y20020912
+322284698PARSONS FCU PAS   PARSONS FEDERAL CREDIT UNION        CAPASADENA                 Y N        
+322284892CA NATL BK LA     US BANK, NA                         CALOS ANGELES              Y N20130320
+322284928BALBOA TL CV      BALBOA THRIFT AND LOAN ASSOCIATION  CACHULA VISTA              Y N20060810
+322285024UNION YES FCU     UNION YES FEDERAL CREDIT UNION      CAORANGE                   Y N20100806
+322285480HOME BK CA SD     HOME BANK OF CALIFORNIA             CASAN DIEGO                Y N20091120
+322285668PAC WEST NATL PICOPACIFIC WESTERN BANK                CASOLVANG                  Y N20101203
+322285781PACIFIC PREMIER BKPACIFIC PREMIER BANK                CAIRVINE                   Y Y20121211
+322285833GRANDPOINT BK LA  GRANDPOINT BANK                     CAENCINO                   Y N20110324
+322286120MALAGA BK PA VER  MALAGA BANK, FSB                    CAPLS VRDS EST             Y N20060614
+322286256EAST INTL BK LA   EASTERN INTERNATIONAL BANK          CALOS ANGELES              Y Y20000724
+322286395SAN DIEGO FF FCU  SAN DIEGO FIREFIGHTERS FCU          CASAN DIEGO                YSY20140403
+322286434CY NATL BK LA     CITY NATIONAL BANK                  CALA JOLLA                 Y N20100409
+322286447PAC WESTERN BK CA PACIFIC WESTERN BANK                CALOS ANGELES              Y N20140411
+322286489TUST TL TUST      TUSTIN COMMUNITY BANK               CATUSTIN                   YSN20030212
+322286492ONEWEST BK NA     ONEWEST BANK, NATIONAL ASSOCIATION  CARANCHO SANTA FE          Y N20140303
+322286803SILVERGATE LAJOLLASILVERGATE BANK                     CALA JOLLA                 Y Y20130328
+322484029BOULDER DAM CU NV BOULDER DAM CREDIT UNION            NVBOULDER CITY             Y Y20140625
+322484113CLARK CNTY CU LV  CLARK COUNTY CREDIT UNION           NVLAS VEGAS                Y N20100304
+322484265SLVR ST SCH CU LV SILVER STATE SCHOOLS CREDIT UNION   NVLAS VEGAS                Y N20010122
+322484401ONE NEVADA CU     ONE NEVADA CREDIT UNION             NVLAS VEGAS                Y N20111007
+322484634WESTSTAR FCU LV   WESTSTAR CREDIT UNION               NVLAS VEGAS                Y Y20000120
+323074959UNITED ASSN NW FCUUNITED ADVANTAGE NORTHWEST FCU      ORPORTLAND                 Y N20070824
+323075097ADVANTIS CU       ADVANTIS CREDIT UNION               ORPORTLAND                 Y N20051116
+323075136CON FCU POR       CONSOLIDATED FEDERAL CREDIT UNION   ORPORTLAND                 Y Y20130621
+323075181ADVANTIS CU       ADVANTIS CREDIT UNION               ORPORTLAND                 Y N20120316
+323075372NW ADVENTIST FCU  NORTHWEST ADVENTIST FCU             ORPORTLAND                 Y Y20130918
+323075644ORE CENT CU POR   OREGONIANS FEDERAL CREDIT UNION     ORPORTLAND                 Y N20051130
+323075699UNITUS CCU        UNITUS COMMUNITY CREDIT UNION       ORPORTLAND                 Y Y20140305
+323075709OREGONIAN FCU POR OREGONIANS FEDERAL CREDIT UNION     ORMILWAUKIE                Y N20091218
+323075754PAC NW FCU        PACIFIC NORTHWEST FCU               ORPORTLAND                 YSY20131121
+323075864NW PRIORITY CU    NW PRIORITY CREDIT UNION            ORPORTLAND                 Y N20050607
+323075880ONPOINT COM CU PTLONPOINT COMMUNITY CREDIT UNION      ORPORTLAND                 Y Y20051229
+323076012RIVERMARK COMM CU RIVERMARK COMMUNITY CREDIT UNION    ORBEAVERTON                Y Y20110404
+323076135UTD ASSN NW CU PORUNITED ADVANTAGE NORTHWEST FCU      ORPORTLAND                 Y N20070824
+323076151TEAMSTERS CNCIL CUTEAMSTERS COUNCIL 37 FCU            ORPORTLAND                 Y N20111101
+323076575NW PREFERRED FCU  NORTHWEST PREFERRED FCU             ORTIGARD                   Y Y20081014
+323170112UMPQUA BANK       UMPQUA BANK                         IDLEWISTON                 Y N20140418
+323173041PINE TREE CU ID   PINE TREE CREDIT UNION              IDGRANGEVILLE              YSN20121231
+323173193KAM COMM CU KAM   KAMIAH COMMUNITY CREDIT UNION       IDKAMIAH                   Y N20120330
+323173313POTLA FCU LEWIS IDPOTLATCH NO 1 FEDERAL CREDIT UNION  IDLEWISTON                 Y N20110825
+323270216UMPQUA BANK       UMPQUA BANK                         ORBAKER CITY               Y N20140418
+323270274EVERGREEN FSL     EVERGREEN FEDERAL SAVINGS & LOAN    ORGRANTS PASS              Y N20030403
+323270300UMPQUA BANK       UMPQUA BANK                         ORKLAMATH FALLS            Y N20140418
+323270313FST FSL MINNIVILLEFIRST FEDERAL SAVINGS & LOAN (OR)   ORMCMINNVILLE              YSN20130130
+323271422BK CASCADES BEND  BANK OF THE CASCADES                ORBEND                     Y N20140523
+323274076CNTRL WMETTE ALBNYCENTRAL WILLAMETTE COMMUNITY CU     ORALBANY                   Y N20060614
+323274160FIRST TECH FCU CA FIRST TECHNOLOGY FCU                ORBEAVERTON                Y N20110616
+323274186MID OR FCU BEND   MID OREGON FEDERAL CREDIT UNION     ORBEND                     Y Y19991019
+323274209WAUNA FCU CLAT    WAUNA FEDERAL CREDIT UNION          ORCLATSKANIE               Y N        
+323274238FIRST COMM CU COQ FIRST COMMUNITY CREDIT UNION        ORCOQUILLE                 Y Y20110831
+323274270OSU FCU CORVAL    O S U FEDERAL CREDIT UNION          ORCORVALLIS                Y Y19991019
+323274445SELCO COMMUNITY CUSELCO COMMUNITY CREDIT UNION        ORSPRINGFIELD              Y Y20140228
+323274461OREGON COMMUNITY  OREGON COMMUNITY CREDIT UNION       ORSPRINGFIELD              Y N20120807
+323274513FIRST COMM CU COQ FIRST COMMUNITY CREDIT UNION        ORGRANTS PASS              Y N20110916
+323274704PACIFIC CREST FCU PACIFIC CREST FEDERAL CREDIT UNION  ORKLAMATH FALLS            Y Y20130628
+323274775ROGUE FCU MEDFORD ROGUE FEDERAL CREDIT UNION          ORMEDFORD                  Y Y20100614
+323274885CLACKAMAS COMM FCUCLACKAMAS COMMUNITY FCU             OROREGON CITY              Y N20060614
+323276304TLC FCU           TLC FEDERAL CREDIT UNION            ORTILLAMOOK                Y N20050713
+323276346ST HELENS FCU     ST HELENS COMMUNITY FCU             ORSAINT HELENS             Y N20100827
+323276388MARION POLK CU SALMARION AND POLK SCHOOLS CREDIT UNIONORSALEM                    Y Y20120508
+323276391HERITAGE GROVE FCUHERITAGE GROVE FEDERAL CREDIT UNION ORSALEM                    YSN20140610
+323276524NW COMM CU EUGENE NORTHWEST COMMUNITY CREDIT UNION    OREUGENE                   Y N20030226
+323284150CATALYST CORP FCU CATALYST CORPORATE FCU              ORPORTLAND                 Y N20110906
+323370666RVRVIEW COM BK VANRIVERVIEW COMMUNITY BANK            WAVANCOUVER                Y Y20010723
+323371076BANNER BK WAL WAL BANNER BANK                         WAWALLA WALLA              Y Y20001031
+323380261LACAMAS COMM CU   IQ CREDIT UNION                     WACAMAS                    Y Y20140630
+323380766FIBRE FCU LONGVIEWFIBRE FEDERAL CREDIT UNION          WALONGVIEW                 Y Y        
+323380782LWR COL LS CU     LOWER COLUMBIA LONGSHOREMEN FCU     WALONGVIEW                 Y N20070416
+323380821RED CANOE CU LONGVRED CANOE CREDIT UNION              WALONGVIEW                 Y N20070104
+323383349COLUMBIA CU VAN   COLUMBIA CREDIT UNION               WAVANCOUVER                Y Y20040610
+323383394PEOPLES COMM FCU  PEOPLE'S COMMUNITY FCU              WAVANCOUVER                YSY20120725
+323383404COLUMBIA CU       COLUMBIA CREDIT UNION               WAVANCOUVER                Y N20040809
+324078187ASSOC FEFCU SLC   ASSOCIATED FEDERAL EMPLOYEES FCU    UTSALT LAKE CITY           YSN20120106
+324078909DESERET FST CU SLCDESERET FIRST FCU                   UTSALT LAKE CITY           Y Y20140319
+324078996NATL JACL CU      NATIONAL J A C L CREDIT UNION       UTSALT LAKE CITY           N Y19991029
+324079416UNIV OF UT CU SLC UNIVERSITY FIRST FCU                UTSALT LAKE CITY           Y Y20130213
+324079474HERITAGE WEST DIV CHARTWAY FCU, HERITAGE WEST DIV     UTSALT LAKE CTY            Y N20140227
+324079500UTAH FST FCU      UTAH FIRST FEDERAL CREDIT UNION     UTSALT LAKE CTY            Y N20120521
+324079555MTN AM CU SLC     MOUNTAIN AMERICA FCU                UTSALT LAKE CITY           Y N20130424
+324084003SUNCORP CU        SYSTEM UNITED CORPORATE FCU         COBROOMFIELD               Y N20130722
+324170085WASHINGTON FEDERALWASHINGTON FEDERAL, NA              IDBOISE                    Y N20130717
+324170140BK CASCADES BEND  BANK OF THE CASCADES                ORBEND                     Y N20140528
+324170179FST FSB TWIN FLS  FIRST FEDERAL SAVINGS BANK-TWIN FALLIDTWIN FALLS               Y Y19991229
+324172465ALBERT EFCU BOIS  TRUGROCER FEDERAL CREDIT UNION      IDBOISE                    Y N20061002
+324172575CAPITAL EDU FCU IDCAPITAL EDUCATORS FCU               IDMERIDIAN                 N Y20140203
+324173079WSTMAR CU ID FLS  WESTMARK CREDIT UNION               IDIDAHO FALLS              Y Y        
+324173082EAST ID CU ID FLS EAST IDAHO CREDIT UNION             IDIDAHO FALLS              YSN20061115
+324173121MED PROF ID FALLS MEMBERS PREFERRED CREDIT UNION      IDIDAHO FALLS              Y Y20060426
+324173383PIO FCU MTN HOME  PIONEER FEDERAL CREDIT UNION        IDMOUNTAIN HOME            Y Y20111202
+324173626ID CENT CU CHUBBUCIDAHO CENTRAL CREDIT UNION          IDPOCATELLO                Y Y19991019
+324173707POCA RR FCU POCA  POCATELLO RAILROAD FCU              IDPOCATELLO                YSN20060614
+324173817BEEHIVE FCU REXBRGBEEHIVE FEDERAL CREDIT UNION        IDREXBURG                  Y N20000404
+324184440IDAHO UNITED CU   IDAHO UNITED CREDIT UNION           IDBOISE                    Y N20110901
+324274033ELKO FCU          ELKO FEDERAL CREDIT UNION           NVELKO                     YSN20120614
+324370490MORGAN STANLEY    MORGAN STANLEY BANK, N.A.           UTSALT LAKE CITY           Y N20121213
+324377202CYPRUS FCU        CYPRUS FEDERAL CREDIT UNION         UTWEST JORDAN              Y N20070723
+324377516AMER FIRST FCU    AMERICA FIRST FEDERAL CREDIT UNION  UTOGDEN                    Y Y20030513
+324377613GOLDWST CU OGDEN  GOLDENWEST FEDERAL CREDIT UNION     UTOGDEN                    Y Y20030514
+324377710WEBER STATE FCU UTWEBER STATE FEDERAL CREDIT UNION    UTOGDEN                    Y N20111214
+324377817EASTERN UTAH      EASTERN UTAH COMMUNITY CU           UTPRICE                    YSN20060614
+324377820UTAH COM FCU PROVOUTAH COMMUNITY CREDIT UNION         UTPROVO                    Y Y20000222
+324378036SOUTHWEST DIVISIONCHARTWAY FCU, SOUTHWEST DIVISION    UTST GEORGE                Y N20111130
+324379705JORDAN FCU        JORDAN FEDERAL CREDIT UNION         UTSANDY                    YSY20131210
+324379828CHARTWAY HERITAGE CHARTWAY FCU-HERITAGEWEST DIV       UTTOOELE                   Y N20101203
+325070760WASH MUT BANK     JPMORGAN CHASE BANK, NA             WASEATTLE                  Y N20090521
+325070980WASHINGTON FEDERALWASHINGTON FEDERAL, NA              WASEATTLE                  Y Y20130717
+325072166AK AIR HORIZON AIRALASKA AIR/HORIZON AIR EMPLOYEES FCUWASEATAC                   Y N20080304
+325081306SOUND COMMUNITY   SOUND COMMUNITY BANK                WASEATTLE                  Y N20030527
+325081403BOEING ECU TUKWILABOEING EMPLOYEES CREDIT UNION       WASEATTLE                  Y Y20010509
+325081474CASCADE FCU WA    CASCADE FEDERAL CREDIT UNION        WASEATTLE                  Y N20111201
+325081500SEA METRO CU SEA  SEATTLE METROPOLITAN CREDIT UNION   WASEATTLE                  Y N        
+325081610SALAL CU SEA WA   SALAL CREDIT UNION                  WASEATTLE                  Y Y20100422
+325081885VERITY CU         VERITY CREDIT UNION                 WASEATTLE                  Y Y20030109
+325081924PORT SEATTLE FCU  PORT OF SEATTLE FEDERAL CREDIT UNIONWASEATTLE                  Y Y20120109
+325081966QUALSTAR CU BELV  QUALSTAR CREDIT UNION               WABELLEVUE                 Y N19991220
+325082017COMMUNITY FST CU  COMMUNITY 1ST CREDIT UNION          WASHORELINE                Y Y20040901
+325082130PUGET SOUND FCU WAPUGET SOUND FEDERAL CREDIT UNION    WASEATTLE                  Y N20101130
+325082266SCHOOL EMP CU WASHSCHOOL EMPLOYEES CREDIT UNION OF WA WASEATTLE                  Y Y20110926
+325084426HOMESTREET BK SEA HOMESTREET BANK                     WASEATTLE                  Y Y20000728
+325170628ANCHOR SVGS ABER  ANCHOR MUTUAL SAVINGS BANK          WAABERDEEN                 Y Y19991019
+325170754TIMBER BK HOQUIAM TIMBERLAND BANK                     WAHOQUIAM                  Y Y20010510
+325170822OLY FSL OLY       OLYMPIA FEDERAL SAVINGS & LOAN ASSN WAOLYMPIA                  Y Y20090121
+325170835HER SB OLY        HERITAGE BANK                       WAOLYMPIA                  Y Y20040903
+325170848FST FSL PT ANGEL  FIRST FEDERAL SAVINGS & LOAN OF PA  WAPORT ANGELES             Y N20060614
+325170864RAYMOND FEDERAL BKRAYMOND FEDERAL SAVINGS BANK        WARAYMOND                  Y N20050628
+325170877FST SVGS BK RENTONFIRST SAVINGS BANK NORTHWEST        WARENTON                   Y N20071029
+325171122YAKIMA FSL YAK    YAKIMA FEDERAL SAVINGS & LOAN ASSN  WAYAKIMA                   YSY20021212
+325171148OPUS BK REDBCH CA OPUS BANK                           WAEVERETT                  Y N20110701
+325171740UMPQUA BANK       UMPQUA BANK                         WASPOKANE                  Y Y20140418
+325180061PUGET SOUND FCU WAPUGET SOUND FEDERAL CREDIT UNION    WASEATTLE                  Y N20101130
+325180113WHAT EDU CU BLNGHMWHATCOM EDUCATIONAL CREDIT UNION    WABELLINGHAM               Y Y20020508
+325180155INDUS CU BLNGHM   INDUSTRIAL CREDIT UNION OF WHATCOM  WABELLINGHAM               Y N20060111
+325180184NORTH COAST CU WA NORTH COAST CREDIT UNION            WABELLINGHAM               Y Y20131220
+325180223KITSAP CU         KITSAP CREDIT UNION                 WABREMERTON                Y Y20080110
+325180333GREAT NW FCU ABER GREAT NORTHWEST FEDERAL CREDIT UNIONWAABERDEEN                 Y Y20130703
+325180346CUL DM FCU CUL DM COULEE DAM FEDERAL CREDIT UNION     WACOULEE DAM               Y N19991220
+325180524NW PLUS CU EVE    NORTHWEST PLUS CREDIT UNION         WAEVERETT                  Y N20060130
+325180553SNOCOPE CU EVERETTSNOCOPE CREDIT UNION                WAEVERETT                  Y N20130321
+325180566MOUNTAINCREST CU  MOUNTAINCREST CREDIT UNION (MCCU)   WAARLINGTON                Y N20090709
+325180595GLOBAL CU SPOK    GLOBAL CREDIT UNION                 WASPOKANE                  Y Y20010730
+325180634AMERICAS CU A FCU AMERICA'S CREDIT UNION, AFCU        WAFORT LEWIS               Y Y20060614
+325180870HBRSTONE CU TAC   HARBORSTONE CREDIT UNION            WAMCCHORD AFB              Y Y19991029
+325180935GENERATIONS CU OLYGENERATIONS CREDIT UNION            WAOLYMPIA                  Y N20000609
+325180977OBEE CU TUMWATER  O BEE CREDIT UNION                  WATUMWATER                 Y Y19990607
+325180980OLY CU OLY        OLYMPIA CREDIT UNION                WAOLYMPIA                  Y N19991220
+325181015TWIN CNTY CU OLY  TWINSTAR CREDIT UNION               WAOLYMPIA                  Y N20060801
+325181028WASH ST ECU OLY   WASHINGTON STATE EMPLOYEES CU       WAOLYMPIA                  Y Y20060614
+325181248GESA CU RICHLAND  GESA CREDIT UNION                   WARICHLAND                 Y Y        
+325181264HAPO CCU RICHLAND HAPO COMMUNITY CREDIT UNION         WARICHLAND                 Y N        
+3251822891ST SEC BK OF WASH1ST SECURITY BANK OF WASHINGTON     WALYNNWOOD                 Y N20040402
+325182357OUR COMMUNITY CU  OUR COMMUNITY CREDIT UNION          WASHELTON                  Y N20101228
+325182360SNO FALLS CU      SNO FALLS CREDIT UNION              WASNOQUALMIE               Y N20110919
+325182496NOLAND CU SPOK    NORTHLAND CREDIT UNION              WASPOKANE                  Y N        
+325182506HORIZON CU SPOK   HORIZON CREDIT UNION                WASPOKANE VALLEY           Y N20080408
+325182687PROGRESSIONS CU   PROGRESSIONS CREDIT UNION           WASPOKANE                  Y Y20040802
+325182690NUMERICA CU SPOK  NUMERICA CREDIT UNION               WASPOKANE VALLEY           Y Y20130314
+325182700SPOK TEACH CU L LKSPOKANE TEACHERS CREDIT UNION       WALIBERTY LAKE             Y Y20010918
+325182836LOWER VALLEY CU WALOWER VALLEY CREDIT UNION           WASUNNYSIDE                Y Y20120702
+325182946UMPQUA BANK WA    UMPQUA BANK                         WATACOMA                   Y N20100716
+325183220SOUND CU TAC      SOUND CREDIT UNION                  WATACOMA                   Y Y20120925
+325183233TAPCO CU TAC      TAPCO CREDIT UNION                  WATACOMA                   YSY20140307
+325183725CALCOE FCU WA     CALCOE FEDERAL CREDIT UNION         WAYAKIMA                   Y N20100301
+325183738SOLARITY CU YAKIMASOLARITY CREDIT UNION               WAYAKIMA                   Y Y20111109
+325183754STATE HWY CU WA   STATE HIGHWAY CREDIT UNION          WAYAKIMA                   Y N20131121
+325270049AK PAC JUNEAU     ALASKA PACIFIC BANK                 AKJUNEAU                   Y N20130424
+325271268MT MCKINLEY BANK  MT MCKINLEY BANK                    AKFAIRBANKS                Y N20030523
+325272021AKA USA FCU ANCH  ALASKA USA FEDERAL CREDIT UNION     AKANCHORAGE                Y Y        
+325272047DENALI AK FCU ANCHDENALI ALASKAN FEDERAL CREDIT UNION AKANCHORAGE                Y N20060328
+325272063CU ONE ANCH       CREDIT UNION ONE                    AKANCHORAGE                Y Y20120613
+325272157NO SKIES FCU ANCH NORTHERN SKIES FEDERAL CREDIT UNION AKANCHORAGE                Y N20060602
+325272212SPR ALSK FCU FRBNKSPIRIT OF ALASKA FCU                AKFAIRBANKS                Y N20071017
+325272270TRUE NORTH FCU    TRUE NORTH FEDERAL CREDIT UNION     AKJUNEAU                   Y Y20120614
+325272306TONG FCU KETCH    TONGASS FEDERAL CREDIT UNION        AKKETCHIKAN                Y N20060328
+325272335MATA VLY FCU PALM MATANUSKA VALLEY FCU                AKPALMER                   Y Y20060614
+325272351ALPS FCU SITKA    ALPS FEDERAL CREDIT UNION           AKSITKA                    Y Y20110725
+325280039MAC FCU           MAC FEDERAL CREDIT UNION            AKFT WAINWRIGHT            Y Y20120606`
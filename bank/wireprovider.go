@@ -0,0 +1,351 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bank
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// WireProvider implements the Provider interface for the Fedwire
+// participant directory: the institutions that send and receive
+// funds transfers over the Fedwire Funds Service.
+type WireProvider struct {
+	// Options selects the indexes that are searched with a radix tree,
+	// and limits the results of searches. It must be set before Load
+	// is called.
+	Options stddata.IndexOptions
+	data    atomic.Pointer[wireData] // nil until the data is loaded
+}
+
+// wireData is the data of a WireProvider. Once it is stored in the
+// WireProvider it is not modified.
+type wireData struct {
+	size               int
+	info               stddata.Info
+	participantIndexes map[string]participantIndex
+}
+
+type participantIndex struct {
+	participantMap  map[string][]Participant
+	participantKeys []string
+	foldedKeys      []string       // the keys in lower case, for stddata.PrefixRange
+	radix           *stddata.Radix // when Options selects a radix tree for the index
+}
+
+// Participant is the information on one institution in the Fedwire
+// directory.
+type Participant struct {
+	Routing                  string // Length 9; Columns 1-9
+	TelegraphicName          string // Length 18; Columns 10-27
+	CustomerName             string // Length 36; Columns 28-63
+	StateCode                string // Length 2; Columns 64-65
+	City                     string // Length 25; Columns 66-90
+	FundsTransferStatus      string // Length 1; Column 91
+	SettlementOnlyStatus     string // Length 1; Column 92
+	SecuritiesTransferStatus string // Length 1; Column 93
+	RevisionDate             string // Length 8; Columns 94-101
+	WireEligible             bool   // the institution can receive funds transfers
+	ACHEligible              bool   // the routing number is also in the FedACH directory
+}
+
+// ParticipantResult is the interface{} that is returned from Search
+type ParticipantResult struct {
+	Participants [][]Participant
+	total        int // the number of keys that match, before the Limit
+}
+
+// Total returns the number of keys that match, and whether the Limit
+// of the IndexOptions left some of them out, for stddata.Totaler.
+func (r ParticipantResult) Total() (total int, truncated bool) {
+	return r.total, r.total > len(r.Participants)
+}
+
+// Fedwire column map:
+var wrn = [...]int{0, 9}
+var wtn = [...]int{9, 27}
+var wcn = [...]int{27, 63}
+var wsc = [...]int{63, 65}
+var wci = [...]int{65, 90}
+var wft = [...]int{90, 91}
+var wso = [...]int{91, 92}
+var wbe = [...]int{92, 93}
+var wrd = [...]int{93, 101}
+
+// Load populates maps for searches from the snapshot of the Fedwire
+// directory embedded in wiredata.go. A malformed line in the
+// directory causes Load to fail.
+func (p *WireProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the snapshot of the Fedwire directory, treating
+// malformed lines according to mode. In stddata.Lenient mode, lines
+// that are too short to hold a complete record are skipped, and their
+// line numbers are returned in the LoadReport. Each Participant is
+// flagged ACHEligible when its routing number is also found in the
+// FedACH snapshot embedded in bankdata.go.
+func (p *WireProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// Initialize the maps:
+	d := &wireData{participantIndexes: make(map[string]participantIndex)}
+	wireRoutingMap := make(map[string][]Participant)
+	telegraphicNameMap := make(map[string][]Participant)
+	wireNameMap := make(map[string][]Participant)
+	wireCityMap := make(map[string][]Participant)
+	wireStateMap := make(map[string][]Participant)
+
+	ach, err := achRoutingNumbers()
+	if err != nil {
+		return r, stddata.NewSourceError(err.Error(), err)
+	}
+
+	bio := bufio.NewReader(strings.NewReader(wiredata))
+	lineNumber := 0
+	for {
+		var w Participant
+		line, err := bio.ReadBytes('\n')
+		if err == io.EOF && len(line) == 0 {
+			break
+		}
+		if err != nil && err != io.EOF {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+		lineNumber++
+		sline := strings.TrimRight(string(line), "\r\n")
+		if len(sline) < wbe[1] {
+			if mode == stddata.Lenient {
+				r.Skip(lineNumber)
+				continue
+			}
+			msg := fmt.Sprintf("line %d: record is %d characters long, expected %d", lineNumber, len(sline), wrd[1])
+			return r, stddata.NewSourceError(msg, nil)
+		}
+		// the revision date is blank for institutions that have
+		// not been revised, and the blanks may have been trimmed
+		sline += strings.Repeat(" ", wrd[1]-len(sline))
+
+		w.Routing = strings.TrimSpace(sline[wrn[0]:wrn[1]])
+		w.TelegraphicName = strings.TrimSpace(sline[wtn[0]:wtn[1]])
+		w.CustomerName = strings.TrimSpace(sline[wcn[0]:wcn[1]])
+		w.StateCode = strings.TrimSpace(sline[wsc[0]:wsc[1]])
+		w.City = strings.TrimSpace(sline[wci[0]:wci[1]])
+		w.FundsTransferStatus = strings.TrimSpace(sline[wft[0]:wft[1]])
+		w.SettlementOnlyStatus = strings.TrimSpace(sline[wso[0]:wso[1]])
+		w.SecuritiesTransferStatus = strings.TrimSpace(sline[wbe[0]:wbe[1]])
+		w.RevisionDate = strings.TrimSpace(sline[wrd[0]:wrd[1]])
+		w.WireEligible = w.FundsTransferStatus == "Y"
+		w.ACHEligible = ach[w.Routing]
+
+		// add the Participant to the maps:
+		wireRoutingMap[w.Routing] = append(wireRoutingMap[w.Routing], w)
+		telegraphicNameMap[w.TelegraphicName] = append(telegraphicNameMap[w.TelegraphicName], w)
+		wireNameMap[w.CustomerName] = append(wireNameMap[w.CustomerName], w)
+		wireCityMap[w.City] = append(wireCityMap[w.City], w)
+		wireStateMap[w.StateCode] = append(wireStateMap[w.StateCode], w)
+	}
+	p.storeData(d, "routing", wireRoutingMap)
+	p.storeData(d, "telegraphic", telegraphicNameMap)
+	p.storeData(d, "name", wireNameMap)
+	p.storeData(d, "city", wireCityMap)
+	p.storeData(d, "state", wireStateMap)
+	d.size = len(wireRoutingMap)
+	d.info = stddata.Info{
+		Source:   wireSource,
+		URL:      wireSourceURL,
+		Edition:  wireEdition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(wireRoutingMap)
+	return r, nil
+}
+
+// achRoutingNumbers returns the set of routing numbers in the FedACH
+// snapshot.
+func achRoutingNumbers() (map[string]bool, error) {
+	ach := make(map[string]bool)
+	scanner := bufio.NewScanner(strings.NewReader(bankdata))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(line) >= rn[1] {
+			ach[line[rn[0]:rn[1]]] = true
+		}
+	}
+	return ach, scanner.Err()
+}
+
+// Info describes the provenance of the loaded data.
+func (p *WireProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Participants whose key in index is exactly key.
+// Unlike Search, it does not match prefixes, so it is the way to look
+// up a routing number: Get("routing", "021000021"). A routing number
+// that fails ValidateRoutingNumber is rejected without a lookup.
+func (p *WireProvider) Get(index string, key string) (participants []Participant, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	wi, found := d.participantIndexes[index]
+	if !found {
+		return nil, stddata.NewIndexError(index, d.participantIndexes)
+	}
+	if index == "routing" {
+		if err = ValidateRoutingNumber(key); err != nil {
+			return nil, err
+		}
+	}
+	participants, found = wi.participantMap[key]
+	if !found {
+		msg := "No Fedwire participant with " + index + " " + key
+		return nil, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return participants, nil
+}
+
+func (p *WireProvider) storeData(d *wireData, s string, m map[string][]Participant) {
+	// store the map
+	var wi participantIndex
+	wi.participantMap = m
+	// extract the keys
+	wi.participantKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		wi.participantKeys[i] = k
+		i++
+	}
+	// sort the keys
+	wi.foldedKeys = stddata.SortKeys(wi.participantKeys)
+	if p.Options.UseRadix(s) {
+		wi.radix = stddata.NewRadix(wi.foldedKeys)
+	}
+	// add to participantIndexes
+	d.participantIndexes[s] = wi
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *WireProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "routing", Description: "routing number", Example: "011000015"},
+		{Name: "telegraphic", Description: "telegraphic name", Example: "FRB-BOS"},
+		{Name: "name", Description: "customer name", Example: "FEDERAL RESERVE"},
+		{Name: "city", Description: "city", Example: "BOSTON"},
+		{Name: "state", Description: "state code", Example: "MA"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *WireProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	wi := d.participantIndexes[index]
+	return stddata.Suggest(wi.participantKeys, wi.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Participant entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Participants are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// Participants can be searched by routing number, by the telegraphic name used
+// in Fedwire messages, such as "CITIBANK NYC", and by name, city and state.
+// An index that Options selects is searched with a radix tree, and a Limit in Options
+// returns only the first keys that match.
+func (p *WireProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	wi, found := d.participantIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.participantIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doWireSearch(wi, query, p.Options)
+	return result, nil
+}
+
+// SearchParticipants is Search, returning the matches as they are, rather
+// than as a ParticipantResult in an interface{}.
+func (p *WireProvider) SearchParticipants(index string, query string) ([][]Participant, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(ParticipantResult).Participants, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Participants of each. It yields nothing if the
+// WireProvider has no such index, or is not loaded.
+func (p *WireProvider) Range(index string) iter.Seq2[string, []Participant] {
+	return func(yield func(string, []Participant) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		wi := d.participantIndexes[index]
+		for _, k := range wi.participantKeys {
+			if !yield(k, wi.participantMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doWireSearch(wi participantIndex, query string, o stddata.IndexOptions) (res ParticipantResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// search the sorted list of keys, with the radix tree of the index if
+	// it has one, for the range that matches 'query.*', and return only the
+	// first of the range if the options limit it.
+	lo, hi := 0, len(wi.participantKeys)
+	if !dump {
+		if wi.radix != nil {
+			lo, hi = wi.radix.PrefixRange(query)
+		} else {
+			lo, hi = stddata.PrefixRange(wi.foldedKeys, query)
+		}
+	}
+	res.total = hi - lo
+	if !dump {
+		lo, hi = o.Top(lo, hi)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Participants = make([][]Participant, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Participants = append(res.Participants, wi.participantMap[wi.participantKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,300 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package bic implements the methods of a stddata.Provider.
+It provides searches against a directory of ISO 9362 Business
+Identifier Codes (SWIFT/BIC codes). The official directory is
+licensed by SWIFT, so no data is embedded here: the directory is
+read from a file supplied by the caller, in which each line holds a
+BIC, the name of the institution and its city, separated by tabs.
+*/
+package bic
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/country"
+)
+
+// BICProvider implements the Provider interface.
+type BICProvider struct {
+	// File is the path of the directory that Load reads.
+	File string
+	data atomic.Pointer[bicData] // nil until the data is loaded
+}
+
+// bicData is the data of a BICProvider. Once it is stored in the
+// BICProvider it is not modified.
+type bicData struct {
+	size       int
+	info       stddata.Info
+	bicIndexes map[string]bicIndex
+}
+
+type bicIndex struct {
+	bicMap     map[string][]BIC
+	bicKeys    []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// BIC models one entity.
+type BIC struct {
+	Code            string // the BIC, of 11 characters, for example "DEUTDEFFXXX"
+	BankCode        string // the institution, for example "DEUT"
+	CountryCode     string // ISO 3166-1 alpha-2 code, for example "DE"
+	LocationCode    string // for example "FF"
+	BranchCode      string // "XXX" for the primary office
+	InstitutionName string
+	City            string
+}
+
+// BICResult is the interface{} that is returned from Search
+type BICResult struct {
+	BICs [][]BIC
+}
+
+// Load implements the Loader interface. It reads the directory
+// named by File. A malformed record, or a BIC that is not valid,
+// causes Load to fail.
+func (p *BICProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode reads the directory named by File, treating malformed
+// records according to mode. In stddata.Lenient mode, malformed
+// records and invalid BICs are skipped, and their line numbers are
+// returned in the LoadReport.
+func (p *BICProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	if p.File == "" {
+		return r, stddata.NewSourceError("No BIC directory file", nil)
+	}
+	f, err := os.Open(p.File)
+	if err != nil {
+		return r, stddata.NewSourceError(err.Error(), err)
+	}
+	defer f.Close()
+	return p.LoadFrom(f, mode)
+}
+
+// LoadFrom reads a directory from data, as LoadMode reads File.
+func (p *BICProvider) LoadFrom(data io.Reader, mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &bicData{bicIndexes: make(map[string]bicIndex)}
+	bicMap := make(map[string][]BIC)
+	institutionNameMap := make(map[string][]BIC)
+	countryMap := make(map[string][]BIC)
+
+	reader := csv.NewReader(data)
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 3
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		b, err := Parse(record[0])
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: %s", line, err.Error())
+			return r, stddata.NewSourceError(msg, err)
+		}
+		b.InstitutionName = record[1]
+		b.City = record[2]
+
+		// add the BIC to the maps
+		bicMap[b.Code] = append(bicMap[b.Code], b)
+		institutionNameMap[b.InstitutionName] = append(institutionNameMap[b.InstitutionName], b)
+		countryMap[b.CountryCode] = append(countryMap[b.CountryCode], b)
+	}
+	p.storeData(d, "bic", bicMap)
+	p.storeData(d, "name", institutionNameMap)
+	p.storeData(d, "country", countryMap)
+	d.size = len(bicMap)
+	d.info = stddata.Info{
+		Source:   "ISO 9362 Business Identifier Codes",
+		URL:      p.File,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(bicMap)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data. The URL is
+// the File the directory was read from.
+func (p *BICProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Parse splits a BIC into its parts, and checks that its country
+// code is an ISO 3166-1 alpha-2 code. A BIC of 8 characters is
+// the primary office, and is given the branch code "XXX". Spaces
+// are ignored, and letters may be in either case.
+func Parse(code string) (b BIC, err error) {
+	s := strings.ToUpper(strings.Replace(code, " ", "", -1))
+	if len(s) == 8 {
+		s += "XXX"
+	}
+	if len(s) != 11 {
+		msg := "BIC " + code + " is not 8 or 11 characters"
+		return b, stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	for i, r := range s {
+		letter := r >= 'A' && r <= 'Z'
+		digit := r >= '0' && r <= '9'
+		if i < 6 && !letter || !letter && !digit {
+			msg := "BIC " + code + " is malformed"
+			return b, stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+		}
+	}
+	b.Code = s
+	b.BankCode = s[0:4]
+	b.CountryCode = s[4:6]
+	b.LocationCode = s[6:8]
+	b.BranchCode = s[8:11]
+	if !country.IsValidAlpha2(b.CountryCode) {
+		msg := "BIC " + code + " has an unknown country code " + b.CountryCode
+		return b, stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	return b, nil
+}
+
+func (p *BICProvider) storeData(d *bicData, s string, m map[string][]BIC) {
+	// store the map
+	var bi bicIndex
+	bi.bicMap = m
+	// extract the keys
+	bi.bicKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		bi.bicKeys[i] = k
+		i++
+	}
+	// sort the keys
+	bi.foldedKeys = stddata.SortKeys(bi.bicKeys)
+	// add to bicIndexes
+	d.bicIndexes[s] = bi
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *BICProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "bic", Description: "BIC, of eight or eleven characters", Example: "DEUTDEFF"},
+		{Name: "name", Description: "name of the institution", Example: "Deutsche Bank"},
+		{Name: "country", Description: "ISO 3166-1 alpha-2 code of the country", Example: "DE"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *BICProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	bi := d.bicIndexes[index]
+	return stddata.Suggest(bi.bicKeys, bi.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of BIC entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching BICs are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The bic index is keyed by BICs of 11 characters, so a BIC of 8 characters finds
+// the primary office and the branches of the institution.
+func (p *BICProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	bi, found := d.bicIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.bicIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(bi, query)
+	return result, nil
+}
+
+// SearchBICs is Search, returning the matches as they are, rather
+// than as a BICResult in an interface{}.
+func (p *BICProvider) SearchBICs(index string, query string) ([][]BIC, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(BICResult).BICs, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the BICs of each. It yields nothing if the
+// BICProvider has no such index, or is not loaded.
+func (p *BICProvider) Range(index string) iter.Seq2[string, []BIC] {
+	return func(yield func(string, []BIC) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		bi := d.bicIndexes[index]
+		for _, k := range bi.bicKeys {
+			if !yield(k, bi.bicMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(bi bicIndex, query string) (res BICResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(bi.bicKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(bi.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.BICs = make([][]BIC, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.BICs = append(res.BICs, bi.bicMap[bi.bicKeys[k]])
+	}
+	return res
+}
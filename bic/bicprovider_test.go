@@ -0,0 +1,110 @@
+package bic
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+var directory = `DEUTDEFF	Deutsche Bank AG	Frankfurt am Main
+DEUTDEFF500	Deutsche Bank AG	Frankfurt am Main
+BNPAFRPP	BNP Paribas	Paris
+CHASUS33	JPMorgan Chase Bank, N.A.	New York
+NWBKGB2L	National Westminster Bank plc	London`
+
+func TestBICProvider(t *testing.T) {
+	expected := 5
+	fmt.Println("Test: BICProvider.Load")
+	file := filepath.Join(t.TempDir(), "bic.txt")
+	if err := os.WriteFile(file, []byte(directory), 0644); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	p = &BICProvider{File: file}
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestBICSearch(t *testing.T) {
+	res, err := p.Search("bic", "deutdeff")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	b := res.(BICResult).BICs
+	if len(b) != 2 || b[0][0].BranchCode != "500" || b[1][0].Code != "DEUTDEFFXXX" {
+		t.Fatalf("Expected the Deutsche Bank offices, got %v\n", b)
+	}
+}
+func TestCountrySearch(t *testing.T) {
+	res, err := p.Search("country", "GB")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	b := res.(BICResult).BICs
+	if len(b) != 1 || b[0][0].InstitutionName != "National Westminster Bank plc" {
+		t.Fatalf("Expected NatWest, got %v\n", b)
+	}
+}
+func TestParse(t *testing.T) {
+	b, err := Parse("chas us 33")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if b.BankCode != "CHAS" || b.CountryCode != "US" || b.LocationCode != "33" || b.BranchCode != "XXX" {
+		t.Fatalf("Unexpected parts %+v\n", b)
+	}
+	for _, code := range []string{"CHASUS3", "CHAS1S33", "CHASQQ33", "CHASUS33-XX"} {
+		if _, err := Parse(code); err == nil {
+			t.Fatalf("Expected %s to be invalid\n", code)
+		}
+	}
+}
+func TestLoadFrom(t *testing.T) {
+	bp := new(BICProvider)
+	data := "DEUTDEFF\tDeutsche Bank AG\tFrankfurt am Main\nXXXXQQ11\tNobody\tNowhere\n"
+	if _, err := bp.LoadFrom(strings.NewReader(data), Strict); err == nil {
+		t.Fatalf("Expected strict LoadFrom to fail on line 2\n")
+	}
+	r, err := bp.LoadFrom(strings.NewReader(data), Lenient)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 1 || r.Skipped != 1 || r.SkippedLines[0] != 2 {
+		t.Fatalf("Expected 1 loaded and line 2 skipped, got %+v\n", r)
+	}
+	if _, err := new(BICProvider).Load(); err == nil {
+		t.Fatalf("Expected Load without a File to fail\n")
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	file := filepath.Join(b.TempDir(), "bic.txt")
+	if err := os.WriteFile(file, []byte(directory), 0644); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkLoad(b, func() Provider { return &BICProvider{File: file} })
+}
+func BenchmarkSearch(b *testing.B) {
+	file := filepath.Join(b.TempDir(), "bic.txt")
+	if err := os.WriteFile(file, []byte(directory), 0644); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	bp := &BICProvider{File: file}
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "bic", Query: "DEUTDEFF"}, {Index: "name", Query: "Deutsche"}, {Index: "country", Query: "DE"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
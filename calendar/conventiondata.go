@@ -0,0 +1,273 @@
+package calendar
+
+// provenance of conventiondata, reported by Info
+const (
+	source    = "Unicode CLDR supplemental week data"
+	sourceURL = "https://cldr.unicode.org/"
+	edition   = "CLDR 44"
+)
+
+/*
+conventiondata holds the calendar conventions of each country with
+an ISO 3166-1 alpha-2 code. The fields of each record are
+tab-delimited: the alpha-2 code, the first day of the week, the
+space-separated days of the weekend, the minimal number of days that
+the first week of a year must have in that year, and the preferred
+numeric date pattern. The days, and the minimal days, are those of
+the weekData of CLDR's supplemental data; where CLDR gives a
+country no value of its own, that of the world ("001") is given. A
+minimum of 4 days, with weeks that begin on Monday, is the week
+numbering of ISO 8601. The date patterns are those of the short date
+format of the main language of the country, in the pattern syntax
+of Unicode LDML, with the year in full.
+*/
+var conventiondata = `AD	mon	sat sun	4	dd/MM/y
+AE	sat	sat sun	1	dd/MM/y
+AF	sat	thu fri	1	y/M/d
+AG	sun	sat sun	1	dd/MM/y
+AI	mon	sat sun	1	dd/MM/y
+AL	mon	sat sun	1	dd/MM/y
+AM	mon	sat sun	1	dd.MM.y
+AO	mon	sat sun	1	dd/MM/y
+AQ	mon	sat sun	1	dd/MM/y
+AR	mon	sat sun	1	dd/MM/y
+AS	sun	sat sun	1	M/d/y
+AT	mon	sat sun	4	dd.MM.y
+AU	mon	sat sun	1	dd/MM/y
+AW	mon	sat sun	1	dd/MM/y
+AX	mon	sat sun	4	dd/MM/y
+AZ	mon	sat sun	1	dd.MM.y
+BA	mon	sat sun	1	dd/MM/y
+BB	mon	sat sun	1	dd/MM/y
+BD	sun	sat sun	1	dd/MM/y
+BE	mon	sat sun	4	dd/MM/y
+BF	mon	sat sun	1	dd/MM/y
+BG	mon	sat sun	4	d.MM.y
+BH	sat	fri sat	1	dd/MM/y
+BI	mon	sat sun	1	dd/MM/y
+BJ	mon	sat sun	1	dd/MM/y
+BL	mon	sat sun	1	dd/MM/y
+BM	mon	sat sun	1	dd/MM/y
+BN	mon	sat sun	1	dd/MM/y
+BO	mon	sat sun	1	dd/MM/y
+BQ	mon	sat sun	1	dd/MM/y
+BR	sun	sat sun	1	dd/MM/y
+BS	sun	sat sun	1	dd/MM/y
+BT	sun	sat sun	1	dd/MM/y
+BV	mon	sat sun	1	dd/MM/y
+BW	sun	sat sun	1	dd/MM/y
+BY	mon	sat sun	1	dd.MM.y
+BZ	sun	sat sun	1	dd/MM/y
+CA	sun	sat sun	1	y-MM-dd
+CC	mon	sat sun	1	dd/MM/y
+CD	mon	sat sun	1	dd/MM/y
+CF	mon	sat sun	1	dd/MM/y
+CG	mon	sat sun	1	dd/MM/y
+CH	mon	sat sun	4	dd.MM.y
+CI	mon	sat sun	1	dd/MM/y
+CK	mon	sat sun	1	dd/MM/y
+CL	mon	sat sun	1	dd/MM/y
+CM	mon	sat sun	1	dd/MM/y
+CN	sun	sat sun	1	y/M/d
+CO	sun	sat sun	1	dd/MM/y
+CR	mon	sat sun	1	dd/MM/y
+CU	mon	sat sun	1	dd/MM/y
+CV	mon	sat sun	1	dd/MM/y
+CW	mon	sat sun	1	dd/MM/y
+CX	mon	sat sun	1	dd/MM/y
+CY	mon	sat sun	1	dd/MM/y
+CZ	mon	sat sun	4	d. M. y
+DE	mon	sat sun	4	dd.MM.y
+DJ	sat	sat sun	1	dd/MM/y
+DK	mon	sat sun	4	dd.MM.y
+DM	sun	sat sun	1	dd/MM/y
+DO	sun	sat sun	1	dd/MM/y
+DZ	sat	fri sat	1	dd/MM/y
+EC	mon	sat sun	1	dd/MM/y
+EE	mon	sat sun	4	dd.MM.y
+EG	sat	fri sat	1	dd/MM/y
+EH	mon	sat sun	1	dd/MM/y
+ER	mon	sat sun	1	dd/MM/y
+ES	mon	sat sun	4	dd/MM/y
+ET	sun	sat sun	1	dd/MM/y
+FI	mon	sat sun	4	d.M.y
+FJ	mon	sat sun	4	dd/MM/y
+FK	mon	sat sun	1	dd/MM/y
+FM	mon	sat sun	1	M/d/y
+FO	mon	sat sun	4	dd/MM/y
+FR	mon	sat sun	4	dd/MM/y
+GA	mon	sat sun	1	dd/MM/y
+GB	mon	sat sun	4	dd/MM/y
+GD	mon	sat sun	1	dd/MM/y
+GE	mon	sat sun	1	dd.MM.y
+GF	mon	sat sun	4	dd/MM/y
+GG	mon	sat sun	4	dd/MM/y
+GH	mon	sat sun	1	dd/MM/y
+GI	mon	sat sun	4	dd/MM/y
+GL	mon	sat sun	1	dd/MM/y
+GM	mon	sat sun	1	dd/MM/y
+GN	mon	sat sun	1	dd/MM/y
+GP	mon	sat sun	4	dd/MM/y
+GQ	mon	sat sun	1	dd/MM/y
+GR	mon	sat sun	4	dd/MM/y
+GS	mon	sat sun	1	dd/MM/y
+GT	sun	sat sun	1	dd/MM/y
+GU	sun	sat sun	1	M/d/y
+GW	mon	sat sun	1	dd/MM/y
+GY	mon	sat sun	1	dd/MM/y
+HK	sun	sat sun	1	d/M/y
+HM	mon	sat sun	1	dd/MM/y
+HN	sun	sat sun	1	dd/MM/y
+HR	mon	sat sun	1	dd. MM. y.
+HT	mon	sat sun	1	dd/MM/y
+HU	mon	sat sun	4	y. MM. dd.
+ID	sun	sat sun	1	dd/MM/y
+IE	mon	sat sun	4	dd/MM/y
+IL	sun	fri sat	1	dd/MM/y
+IM	mon	sat sun	4	dd/MM/y
+IN	sun	sun	1	dd/MM/y
+IO	mon	sat sun	1	dd/MM/y
+IQ	sat	fri sat	1	dd/MM/y
+IR	sat	fri	1	y/M/d
+IS	mon	sat sun	4	d.M.y
+IT	mon	sat sun	4	dd/MM/y
+JE	mon	sat sun	4	dd/MM/y
+JM	sun	sat sun	1	dd/MM/y
+JO	sat	fri sat	1	dd/MM/y
+JP	sun	sat sun	1	y/MM/dd
+KE	sun	sat sun	1	dd/MM/y
+KG	mon	sat sun	1	dd/MM/y
+KH	sun	sat sun	1	dd/MM/y
+KI	mon	sat sun	1	dd/MM/y
+KM	mon	sat sun	1	dd/MM/y
+KN	mon	sat sun	1	dd/MM/y
+KP	mon	sat sun	1	y. M. d.
+KR	sun	sat sun	1	y. M. d.
+KW	sat	fri sat	1	dd/MM/y
+KY	mon	sat sun	1	dd/MM/y
+KZ	mon	sat sun	1	dd.MM.y
+LA	sun	sat sun	1	dd/MM/y
+LB	mon	sat sun	1	dd/MM/y
+LC	mon	sat sun	1	dd/MM/y
+LI	mon	sat sun	4	dd.MM.y
+LK	mon	sat sun	1	dd/MM/y
+LR	mon	sat sun	1	dd/MM/y
+LS	mon	sat sun	1	dd/MM/y
+LT	mon	sat sun	4	y-MM-dd
+LU	mon	sat sun	4	dd/MM/y
+LV	mon	sat sun	1	dd.MM.y
+LY	sat	fri sat	1	dd/MM/y
+MA	mon	sat sun	1	dd/MM/y
+MC	mon	sat sun	4	dd/MM/y
+MD	mon	sat sun	1	dd/MM/y
+ME	mon	sat sun	1	d.M.y.
+MF	mon	sat sun	1	dd/MM/y
+MG	mon	sat sun	1	dd/MM/y
+MH	sun	sat sun	1	M/d/y
+MK	mon	sat sun	1	d.M.y
+ML	mon	sat sun	1	dd/MM/y
+MM	sun	sat sun	1	dd/MM/y
+MN	mon	sat sun	1	dd/MM/y
+MO	sun	sat sun	1	dd/MM/y
+MP	mon	sat sun	1	M/d/y
+MQ	mon	sat sun	4	dd/MM/y
+MR	mon	sat sun	1	dd/MM/y
+MS	mon	sat sun	1	dd/MM/y
+MT	sun	sat sun	1	dd/MM/y
+MU	mon	sat sun	1	dd/MM/y
+MV	fri	fri sat	1	dd/MM/y
+MW	mon	sat sun	1	dd/MM/y
+MX	sun	sat sun	1	dd/MM/y
+MY	mon	sat sun	1	dd/MM/y
+MZ	sun	sat sun	1	dd/MM/y
+NA	mon	sat sun	1	dd/MM/y
+NC	mon	sat sun	1	dd/MM/y
+NE	mon	sat sun	1	dd/MM/y
+NF	mon	sat sun	1	dd/MM/y
+NG	mon	sat sun	1	dd/MM/y
+NI	sun	sat sun	1	dd/MM/y
+NL	mon	sat sun	4	dd-MM-y
+NO	mon	sat sun	4	dd.MM.y
+NP	sun	sat sun	1	dd/MM/y
+NR	mon	sat sun	1	dd/MM/y
+NU	mon	sat sun	1	dd/MM/y
+NZ	mon	sat sun	1	dd/MM/y
+OM	sat	fri sat	1	dd/MM/y
+PA	sun	sat sun	1	dd/MM/y
+PE	sun	sat sun	1	dd/MM/y
+PF	mon	sat sun	1	dd/MM/y
+PG	mon	sat sun	1	dd/MM/y
+PH	sun	sat sun	1	M/d/y
+PK	sun	sat sun	1	dd/MM/y
+PL	mon	sat sun	4	d.MM.y
+PM	mon	sat sun	1	dd/MM/y
+PN	mon	sat sun	1	dd/MM/y
+PR	sun	sat sun	1	M/d/y
+PS	mon	sat sun	1	dd/MM/y
+PT	sun	sat sun	4	dd/MM/y
+PW	mon	sat sun	1	M/d/y
+PY	sun	sat sun	1	dd/MM/y
+QA	sat	fri sat	1	dd/MM/y
+RE	mon	sat sun	4	dd/MM/y
+RO	mon	sat sun	1	dd.MM.y
+RS	mon	sat sun	1	d.M.y.
+RU	mon	sat sun	4	dd.MM.y
+RW	mon	sat sun	1	dd/MM/y
+SA	sun	fri sat	1	dd/MM/y
+SB	mon	sat sun	1	dd/MM/y
+SC	mon	sat sun	1	dd/MM/y
+SD	sat	fri sat	1	dd/MM/y
+SE	mon	sat sun	4	y-MM-dd
+SG	sun	sat sun	1	dd/MM/y
+SH	mon	sat sun	1	dd/MM/y
+SI	mon	sat sun	1	d. M. y
+SJ	mon	sat sun	4	dd/MM/y
+SK	mon	sat sun	4	d. M. y
+SL	mon	sat sun	1	dd/MM/y
+SM	mon	sat sun	4	dd/MM/y
+SN	mon	sat sun	1	dd/MM/y
+SO	mon	sat sun	1	dd/MM/y
+SR	mon	sat sun	1	dd/MM/y
+SS	mon	sat sun	1	dd/MM/y
+ST	mon	sat sun	1	dd/MM/y
+SV	sun	sat sun	1	dd/MM/y
+SX	mon	sat sun	1	dd/MM/y
+SY	sat	fri sat	1	dd/MM/y
+SZ	mon	sat sun	1	dd/MM/y
+TC	mon	sat sun	1	dd/MM/y
+TD	mon	sat sun	1	dd/MM/y
+TF	mon	sat sun	1	dd/MM/y
+TG	mon	sat sun	1	dd/MM/y
+TH	sun	sat sun	1	dd/MM/y
+TJ	mon	sat sun	1	dd/MM/y
+TK	mon	sat sun	1	dd/MM/y
+TL	mon	sat sun	1	dd/MM/y
+TM	mon	sat sun	1	dd/MM/y
+TN	mon	sat sun	1	dd/MM/y
+TO	mon	sat sun	1	dd/MM/y
+TR	mon	sat sun	1	dd.MM.y
+TT	sun	sat sun	1	dd/MM/y
+TV	mon	sat sun	1	dd/MM/y
+TW	sun	sat sun	1	y/M/d
+TZ	mon	sat sun	1	dd/MM/y
+UA	mon	sat sun	1	dd.MM.y
+UG	mon	sun	1	dd/MM/y
+UM	sun	sat sun	1	M/d/y
+US	sun	sat sun	1	M/d/y
+UY	mon	sat sun	1	dd/MM/y
+UZ	mon	sat sun	1	dd/MM/y
+VA	mon	sat sun	4	dd/MM/y
+VC	mon	sat sun	1	dd/MM/y
+VE	sun	sat sun	1	dd/MM/y
+VG	mon	sat sun	1	dd/MM/y
+VI	sun	sat sun	1	M/d/y
+VN	mon	sat sun	1	dd/MM/y
+VU	mon	sat sun	1	dd/MM/y
+WF	mon	sat sun	1	dd/MM/y
+WS	sun	sat sun	1	dd/MM/y
+YE	sun	fri sat	1	dd/MM/y
+YT	mon	sat sun	1	dd/MM/y
+ZA	sun	sat sun	1	y/MM/dd
+ZM	mon	sat sun	1	dd/MM/y
+ZW	sun	sat sun	1	dd/MM/y
+`
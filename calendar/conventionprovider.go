@@ -0,0 +1,396 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package calendar implements the methods of a stddata.Provider.
+It provides searches against the calendar conventions of countries,
+keyed by their ISO 3166-1 alpha-2 codes: the day each week begins on,
+the days of the weekend, the rule that numbers the weeks of a year,
+of which that of ISO 8601 is one, and the preferred numeric date
+pattern. A Convention numbers weeks and formats dates by its rules,
+so that scheduling interfaces can be localized without all of CLDR.
+Source data is declared in conventiondata.go.
+*/
+package calendar
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// ConventionProvider implements the Provider interface.
+type ConventionProvider struct {
+	data atomic.Pointer[conventionData] // nil until the data is loaded
+}
+
+// conventionData is the data of a ConventionProvider. Once it is
+// stored in the ConventionProvider it is not modified.
+type conventionData struct {
+	size              int
+	info              stddata.Info
+	conventionIndexes map[string]conventionIndex
+}
+
+type conventionIndex struct {
+	conventionMap  map[string][]Convention
+	conventionKeys []string
+	foldedKeys     []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Convention models one entity: the calendar conventions of a country.
+type Convention struct {
+	CountryCode string         // the ISO 3166-1 alpha-2 code, for example "US"
+	FirstDay    time.Weekday   // the day the week begins on, for example time.Sunday
+	Weekend     []time.Weekday // for example [time.Saturday, time.Sunday]
+	// MinDays is the minimal number of days that the first week of a
+	// year must have in that year: 4 for the weeks of ISO 8601, 1 where
+	// the week that holds January 1st is the first.
+	MinDays int
+	// DatePattern is the preferred numeric date pattern, in the syntax
+	// of Unicode LDML, for example "M/d/y" in the United States.
+	DatePattern string
+}
+
+// ConventionResult is the interface{} that is returned from Search
+type ConventionResult struct {
+	Conventions [][]Convention
+}
+
+// days are the weekdays, by the abbreviations of the source data.
+var days = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *ConventionProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *ConventionProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &conventionData{conventionIndexes: make(map[string]conventionIndex)}
+	countryMap := make(map[string][]Convention)
+	firstDayMap := make(map[string][]Convention)
+	weekendMap := make(map[string][]Convention)
+	patternMap := make(map[string][]Convention)
+
+	reader := csv.NewReader(strings.NewReader(conventiondata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 5
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		c, err := parseConvention(record)
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: %s", line, err.Error())
+			return r, stddata.NewSourceError(msg, err)
+		}
+
+		// add the Convention to the maps
+		countryMap[c.CountryCode] = append(countryMap[c.CountryCode], c)
+		firstDayMap[c.FirstDay.String()] = append(firstDayMap[c.FirstDay.String()], c)
+		weekend := make([]string, len(c.Weekend))
+		for i, d := range c.Weekend {
+			weekend[i] = d.String()
+		}
+		key := strings.Join(weekend, " ")
+		weekendMap[key] = append(weekendMap[key], c)
+		patternMap[c.DatePattern] = append(patternMap[c.DatePattern], c)
+	}
+	p.storeData(d, "country", countryMap)
+	p.storeData(d, "firstday", firstDayMap)
+	p.storeData(d, "weekend", weekendMap)
+	p.storeData(d, "pattern", patternMap)
+	d.size = len(countryMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(countryMap)
+	return r, nil
+}
+
+// parseConvention returns the Convention of a record of the source data.
+func parseConvention(record []string) (c Convention, err error) {
+	c.CountryCode = record[0]
+	if len(c.CountryCode) != 2 {
+		return c, errors.New("malformed country code " + record[0])
+	}
+	first, found := days[record[1]]
+	if !found {
+		return c, errors.New("malformed first day " + record[1])
+	}
+	c.FirstDay = first
+	for _, s := range strings.Fields(record[2]) {
+		d, found := days[s]
+		if !found {
+			return c, errors.New("malformed weekend " + record[2])
+		}
+		c.Weekend = append(c.Weekend, d)
+	}
+	c.MinDays, err = strconv.Atoi(record[3])
+	if err != nil || c.MinDays < 1 || c.MinDays > 7 {
+		return c, errors.New("malformed minimal days " + record[3])
+	}
+	c.DatePattern = record[4]
+	if strings.Trim(c.DatePattern, "yMd./- ") != "" || !strings.Contains(c.DatePattern, "y") {
+		return c, errors.New("malformed date pattern " + record[4])
+	}
+	return c, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *ConventionProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Convention of the country whose ISO 3166-1 alpha-2
+// code is code, in any case.
+func (p *ConventionProvider) Get(code string) (c Convention, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return c, stddata.ErrNotLoaded
+	}
+	conventions, found := d.conventionIndexes["country"].conventionMap[strings.ToUpper(code)]
+	if !found {
+		msg := "No calendar convention for country " + code
+		return c, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return conventions[0], nil
+}
+
+// IsWeekend reports whether d is a day of the weekend.
+func (c Convention) IsWeekend(d time.Weekday) bool {
+	for _, w := range c.Weekend {
+		if w == d {
+			return true
+		}
+	}
+	return false
+}
+
+// StartOfWeek returns midnight of the first day of the week that
+// holds t, in the location of t.
+func (c Convention) StartOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) - int(c.FirstDay) + 7) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
+
+// Week returns the year and the number of the week that holds t. Week 1
+// of a year is the first week that has MinDays of its days in that
+// year, so the days of early January can belong to the last week of
+// the year before, and those of late December to week 1 of the year
+// after. For a Convention with weeks that begin on Monday and a
+// MinDays of 4, Week is t.ISOWeek.
+func (c Convention) Week(t time.Time) (year int, week int) {
+	// count in whole days, in UTC, so that changes of daylight saving
+	// time do not matter.
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	year = t.Year()
+	start := c.firstWeek(year)
+	if day.Before(start) {
+		year--
+		start = c.firstWeek(year)
+	} else if next := c.firstWeek(year + 1); !day.Before(next) {
+		year++
+		start = next
+	}
+	week = int(day.Sub(start).Hours())/(24*7) + 1
+	return year, week
+}
+
+// firstWeek returns the first day of week 1 of year, in UTC.
+func (c Convention) firstWeek(year int) time.Time {
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	offset := (int(jan1.Weekday()) - int(c.FirstDay) + 7) % 7
+	if 7-offset < c.MinDays {
+		// too few of the days of the week that holds January 1st are
+		// in year, so week 1 is the next.
+		offset -= 7
+	}
+	return jan1.AddDate(0, 0, -offset)
+}
+
+// FormatDate returns the date of t, formatted by DatePattern: for
+// example "7/4/2014" in the United States, and "04.07.2014" in Germany.
+func (c Convention) FormatDate(t time.Time) string {
+	var b strings.Builder
+	pattern := c.DatePattern
+	for len(pattern) > 0 {
+		// take the run of the same character
+		n := 1
+		for n < len(pattern) && pattern[n] == pattern[0] {
+			n++
+		}
+		switch pattern[0] {
+		case 'y':
+			fmt.Fprintf(&b, "%d", t.Year())
+		case 'M':
+			fmt.Fprintf(&b, "%0*d", n, int(t.Month()))
+		case 'd':
+			fmt.Fprintf(&b, "%0*d", n, t.Day())
+		default:
+			b.WriteString(pattern[0:n])
+		}
+		pattern = pattern[n:]
+	}
+	return b.String()
+}
+
+func (p *ConventionProvider) storeData(d *conventionData, s string, m map[string][]Convention) {
+	// store the map
+	var ci conventionIndex
+	ci.conventionMap = m
+	// extract the keys
+	ci.conventionKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		ci.conventionKeys[i] = k
+		i++
+	}
+	// sort the keys
+	ci.foldedKeys = stddata.SortKeys(ci.conventionKeys)
+	// add to conventionIndexes
+	d.conventionIndexes[s] = ci
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *ConventionProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "country", Description: "ISO 3166-1 alpha-2 code of the country", Example: "US"},
+		{Name: "firstday", Description: "day the week begins on", Example: "Sunday"},
+		{Name: "weekend", Description: "days of the weekend", Example: "Saturday Sunday"},
+		{Name: "pattern", Description: "numeric date pattern, in LDML syntax", Example: "M/d/y"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *ConventionProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ci := d.conventionIndexes[index]
+	return stddata.Suggest(ci.conventionKeys, ci.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Convention entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Conventions are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The firstday index is keyed by the names of the days, such as "Sunday", and the
+// weekend index by the names of the days of the weekend, such as "Friday Saturday".
+func (p *ConventionProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	ci, found := d.conventionIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.conventionIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(ci, query)
+	return result, nil
+}
+
+// SearchConventions is Search, returning the matches as they are, rather
+// than as a ConventionResult in an interface{}.
+func (p *ConventionProvider) SearchConventions(index string, query string) ([][]Convention, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(ConventionResult).Conventions, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Conventions of each. It yields nothing if the
+// ConventionProvider has no such index, or is not loaded.
+func (p *ConventionProvider) Range(index string) iter.Seq2[string, []Convention] {
+	return func(yield func(string, []Convention) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ci := d.conventionIndexes[index]
+		for _, k := range ci.conventionKeys {
+			if !yield(k, ci.conventionMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(ci conventionIndex, query string) (res ConventionResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ci.conventionKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ci.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Conventions = make([][]Convention, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Conventions = append(res.Conventions, ci.conventionMap[ci.conventionKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,107 @@
+package calendar
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestConventionProvider(t *testing.T) {
+	expected := 249
+	fmt.Println("Test: ConventionProvider.Load")
+	p = new(ConventionProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestWeekendSearch(t *testing.T) {
+	res, err := p.Search("weekend", "Friday Saturday")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(ConventionResult).Conventions
+	if len(c) != 1 || len(c[0]) != 15 {
+		t.Fatalf("Expected 15 countries with Friday and Saturday weekends, got %v\n", c)
+	}
+	cp := p.(*ConventionProvider)
+	sa, err := cp.Get("sa")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if sa.FirstDay != time.Sunday || !sa.IsWeekend(time.Friday) || sa.IsWeekend(time.Sunday) {
+		t.Fatalf("Expected Saudi Arabia's week, got %v\n", sa)
+	}
+}
+func TestWeek(t *testing.T) {
+	cp := p.(*ConventionProvider)
+	de, _ := cp.Get("DE")
+	// Germany numbers weeks as ISO 8601 does
+	for d := time.Date(2019, 12, 1, 12, 0, 0, 0, time.UTC); d.Year() < 2027; d = d.AddDate(0, 0, 1) {
+		year, week := de.Week(d)
+		isoYear, isoWeek := d.ISOWeek()
+		if year != isoYear || week != isoWeek {
+			t.Fatalf("%v: Expected week %d of %d, got week %d of %d\n", d, isoWeek, isoYear, week, year)
+		}
+	}
+	us, _ := cp.Get("US")
+	for _, c := range []struct {
+		date       time.Time
+		year, week int
+	}{
+		{time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC), 2021, 52},
+		{time.Date(2021, 12, 26, 0, 0, 0, 0, time.UTC), 2022, 1},
+		{time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), 2022, 1},
+		{time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), 2022, 2},
+		{time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), 2025, 1},
+	} {
+		if year, week := us.Week(c.date); year != c.year || week != c.week {
+			t.Fatalf("%v: Expected week %d of %d, got week %d of %d\n", c.date, c.week, c.year, week, year)
+		}
+	}
+	start := us.StartOfWeek(time.Date(2014, 7, 4, 15, 0, 0, 0, time.UTC))
+	if !start.Equal(time.Date(2014, 6, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Expected the week to start on Sunday, June 29th, got %v\n", start)
+	}
+}
+func TestFormatDate(t *testing.T) {
+	cp := p.(*ConventionProvider)
+	d := time.Date(2014, 7, 4, 0, 0, 0, 0, time.UTC)
+	for code, expected := range map[string]string{
+		"US": "7/4/2014",
+		"DE": "04.07.2014",
+		"GB": "04/07/2014",
+		"SE": "2014-07-04",
+		"HU": "2014. 07. 04.",
+	} {
+		c, err := cp.Get(code)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if s := c.FormatDate(d); s != expected {
+			t.Fatalf("%s: Expected %s, got %s\n", code, expected, s)
+		}
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(ConventionProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(ConventionProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "country", Query: "US"}, {Index: "firstday", Query: "Sunday"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
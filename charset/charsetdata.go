@@ -0,0 +1,82 @@
+package charset
+
+// provenance of charsetdata, reported by Info
+const (
+	source    = "IANA Character Sets registry"
+	sourceURL = "https://www.iana.org/assignments/character-sets/"
+	edition   = "2024-06-06"
+)
+
+/*
+charsetdata holds the character sets of the IANA registry that are in
+common use: Unicode, the ISO 8859 series, the Windows and IBM code
+pages, and the Chinese, Japanese and Korean encodings, in the order
+of their MIB numbers. Each line holds the name of the character set,
+its MIB number, its preferred MIME name where the registry gives one
+other than the name, and its space-separated aliases, separated by
+tabs.
+*/
+var charsetdata = `US-ASCII	3		iso-ir-6 ANSI_X3.4-1968 ANSI_X3.4-1986 ISO_646.irv:1991 ISO646-US us IBM367 cp367 csASCII
+ISO_8859-1:1987	4	ISO-8859-1	iso-ir-100 ISO_8859-1 ISO-8859-1 latin1 l1 IBM819 CP819 csISOLatin1
+ISO_8859-2:1987	5	ISO-8859-2	iso-ir-101 ISO_8859-2 ISO-8859-2 latin2 l2 csISOLatin2
+ISO_8859-3:1988	6	ISO-8859-3	iso-ir-109 ISO_8859-3 ISO-8859-3 latin3 l3 csISOLatin3
+ISO_8859-4:1988	7	ISO-8859-4	iso-ir-110 ISO_8859-4 ISO-8859-4 latin4 l4 csISOLatin4
+ISO_8859-5:1988	8	ISO-8859-5	iso-ir-144 ISO_8859-5 ISO-8859-5 cyrillic csISOLatinCyrillic
+ISO_8859-6:1987	9	ISO-8859-6	iso-ir-127 ISO_8859-6 ISO-8859-6 ECMA-114 ASMO-708 arabic csISOLatinArabic
+ISO_8859-7:1987	10	ISO-8859-7	iso-ir-126 ISO_8859-7 ISO-8859-7 ELOT_928 ECMA-118 greek greek8 csISOLatinGreek
+ISO_8859-8:1988	11	ISO-8859-8	iso-ir-138 ISO_8859-8 ISO-8859-8 hebrew csISOLatinHebrew
+ISO_8859-9:1989	12	ISO-8859-9	iso-ir-148 ISO_8859-9 ISO-8859-9 latin5 l5 csISOLatin5
+ISO-8859-10	13		iso-ir-157 l6 ISO_8859-10:1992 csISOLatin6 latin6
+Shift_JIS	17		MS_Kanji csShiftJIS
+Extended_UNIX_Code_Packed_Format_for_Japanese	18	EUC-JP	csEUCPkdFmtJapanese EUC-JP
+ISO-2022-KR	37		csISO2022KR
+EUC-KR	38		csEUCKR
+ISO-2022-JP	39		csISO2022JP
+ISO-2022-JP-2	40		csISO2022JP2
+ISO-8859-8-I	85		csISO88598I ISO_8859-8-I
+UTF-8	106		csUTF8
+ISO-8859-13	109		csISO885913
+ISO-8859-14	110		iso-ir-199 ISO_8859-14:1998 ISO_8859-14 latin8 iso-celtic l8 csISO885914
+ISO-8859-15	111		ISO_8859-15 Latin-9 csISO885915
+ISO-8859-16	112		iso-ir-226 ISO_8859-16:2001 ISO_8859-16 latin10 l10 csISO885916
+GBK	113		CP936 MS936 windows-936 csGBK
+GB18030	114		csGB18030
+ISO-10646-UCS-2	1000		csUnicode
+ISO-10646-UCS-4	1001		csUCS4
+SCSU	1011		csSCSU
+UTF-7	1012		csUTF7
+UTF-16BE	1013		csUTF16BE
+UTF-16LE	1014		csUTF16LE
+UTF-16	1015		csUTF16
+CESU-8	1016		csCESU8 csCESU-8
+UTF-32	1017		csUTF32
+UTF-32BE	1018		csUTF32BE
+UTF-32LE	1019		csUTF32LE
+BOCU-1	1020		csBOCU1 csBOCU-1
+IBM850	2009		cp850 850 csPC850Multilingual
+IBM852	2010		cp852 852 csPCp852
+IBM437	2011		cp437 437 csPC8CodePage437
+Windows-31J	2024		csWindows31J
+GB2312	2025		csGB2312
+Big5	2026		csBig5
+macintosh	2027		mac csMacintosh
+IBM037	2028		cp037 ebcdic-cp-us ebcdic-cp-ca ebcdic-cp-wt ebcdic-cp-nl csIBM037
+VISCII	2082		csVISCII
+KOI8-R	2084		csKOI8R
+HZ-GB-2312	2085		
+IBM866	2086		cp866 866 csIBM866
+KOI8-U	2088		csKOI8U
+IBM00858	2089		CCSID00858 CP00858 PC-Multilingual-850+euro csIBM00858
+Big5-HKSCS	2101		csBig5HKSCS
+TSCII	2107		csTSCII
+windows-874	2109		cswindows874
+windows-1250	2250		cswindows1250
+windows-1251	2251		cswindows1251
+windows-1252	2252		cswindows1252
+windows-1253	2253		cswindows1253
+windows-1254	2254		cswindows1254
+windows-1255	2255		cswindows1255
+windows-1256	2256		cswindows1256
+windows-1257	2257		cswindows1257
+windows-1258	2258		cswindows1258
+TIS-620	2259		csTIS620 ISO-8859-11`
@@ -0,0 +1,326 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package charset implements the methods of a stddata.Provider.
+It provides searches against the character sets of the IANA
+registry, with their MIB numbers, preferred MIME names and aliases,
+and resolves the many names a character set goes by, such as
+"latin1" and "ISO_8859-1", to the one it is registered under, for
+example to normalize the encodings declared in legacy metadata
+before transcoding it. Source data is declared in charsetdata.go.
+*/
+package charset
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// CharsetProvider implements the Provider interface.
+type CharsetProvider struct {
+	data atomic.Pointer[charsetData] // nil until the data is loaded
+}
+
+// charsetData is the data of a CharsetProvider. Once it is stored in
+// the CharsetProvider it is not modified.
+type charsetData struct {
+	size           int
+	info           stddata.Info
+	charsetIndexes map[string]charsetIndex
+	// looseNames holds the character sets by every name and alias,
+	// reduced to lower case letters and digits.
+	looseNames map[string]Charset
+}
+
+type charsetIndex struct {
+	charsetMap  map[string][]Charset
+	charsetKeys []string
+	foldedKeys  []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Charset models one entity.
+type Charset struct {
+	Name string // the registered name, for example "ISO_8859-1:1987"
+	MIB  int    // the MIBenum, for example 4
+	// MIMEName is the preferred MIME name, for example "ISO-8859-1",
+	// which is the Name unless the registry gives another.
+	MIMEName string
+	Aliases  []string // for example "latin1" and "csISOLatin1"
+}
+
+// CharsetResult is the interface{} that is returned from Search
+type CharsetResult struct {
+	Charsets [][]Charset
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *CharsetProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *CharsetProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &charsetData{charsetIndexes: make(map[string]charsetIndex)}
+	d.looseNames = make(map[string]Charset)
+	nameMap := make(map[string][]Charset)
+	aliasMap := make(map[string][]Charset)
+	mibMap := make(map[string][]Charset)
+
+	reader := csv.NewReader(strings.NewReader(charsetdata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 4
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var c Charset
+		c.Name = record[0]
+		c.MIB, err = strconv.Atoi(record[1])
+		if err != nil {
+			line, _ := reader.FieldPos(1)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed MIB number %q", line, record[1])
+			return r, stddata.NewSourceError(msg, nil)
+		}
+		c.MIMEName = record[2]
+		if c.MIMEName == "" {
+			c.MIMEName = c.Name
+		}
+		c.Aliases = strings.Fields(record[3])
+
+		// add the Charset to the maps
+		nameMap[c.Name] = append(nameMap[c.Name], c)
+		mibMap[record[1]] = append(mibMap[record[1]], c)
+		for _, alias := range c.names() {
+			aliasMap[alias] = append(aliasMap[alias], c)
+			d.looseNames[loose(alias)] = c
+		}
+	}
+	p.storeData(d, "name", nameMap)
+	p.storeData(d, "alias", aliasMap)
+	p.storeData(d, "mib", mibMap)
+	d.size = len(nameMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(nameMap)
+	return r, nil
+}
+
+// names returns every name of c: its Name, its MIMEName and its
+// Aliases, without repetition.
+func (c Charset) names() []string {
+	names := []string{c.Name}
+	for _, alias := range append([]string{c.MIMEName}, c.Aliases...) {
+		repeated := false
+		for _, name := range names {
+			repeated = repeated || name == alias
+		}
+		if !repeated {
+			names = append(names, alias)
+		}
+	}
+	return names
+}
+
+// loose reduces name to its letters, in lower case, and digits, so
+// that "ISO_8859-1", "iso-8859-1" and "ISO8859-1" are the same.
+func loose(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			return r
+		}
+		if r >= 'A' && r <= 'Z' {
+			return r + 'a' - 'A'
+		}
+		return -1
+	}, name)
+}
+
+// Info describes the provenance of the loaded data.
+func (p *CharsetProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Lookup returns the Charset that goes by name, which may be its
+// registered name, its preferred MIME name or any of its aliases.
+// Case and punctuation are ignored, as they are by most software
+// that declares a character set, so "utf8", "Latin-1" and
+// "iso8859_15" are found.
+func (p *CharsetProvider) Lookup(name string) (c Charset, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return c, stddata.ErrNotLoaded
+	}
+	c, found := d.looseNames[loose(name)]
+	if !found || loose(name) == "" {
+		msg := "No character set " + name
+		return c, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return c, nil
+}
+
+// GetByMIB returns the Charset whose MIB number is mib, for example
+// 106 for UTF-8.
+func (p *CharsetProvider) GetByMIB(mib int) (c Charset, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return c, stddata.ErrNotLoaded
+	}
+	charsets, found := d.charsetIndexes["mib"].charsetMap[strconv.Itoa(mib)]
+	if !found {
+		msg := "No character set with MIB number " + strconv.Itoa(mib)
+		return c, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return charsets[0], nil
+}
+
+func (p *CharsetProvider) storeData(d *charsetData, s string, m map[string][]Charset) {
+	// store the map
+	var ci charsetIndex
+	ci.charsetMap = m
+	// extract the keys
+	ci.charsetKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		ci.charsetKeys[i] = k
+		i++
+	}
+	// sort the keys
+	ci.foldedKeys = stddata.SortKeys(ci.charsetKeys)
+	// add to charsetIndexes
+	d.charsetIndexes[s] = ci
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *CharsetProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "name", Description: "registered name", Example: "ISO_8859-1"},
+		{Name: "alias", Description: "alias", Example: "latin1"},
+		{Name: "mib", Description: "MIBenum", Example: "106"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *CharsetProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ci := d.charsetIndexes[index]
+	return stddata.Suggest(ci.charsetKeys, ci.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Charset entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Charsets are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The alias index is keyed by every name of each character set, including its
+// registered and preferred MIME names.
+func (p *CharsetProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	ci, found := d.charsetIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.charsetIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(ci, query)
+	return result, nil
+}
+
+// SearchCharsets is Search, returning the matches as they are, rather
+// than as a CharsetResult in an interface{}.
+func (p *CharsetProvider) SearchCharsets(index string, query string) ([][]Charset, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(CharsetResult).Charsets, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Charsets of each. It yields nothing if the
+// CharsetProvider has no such index, or is not loaded.
+func (p *CharsetProvider) Range(index string) iter.Seq2[string, []Charset] {
+	return func(yield func(string, []Charset) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ci := d.charsetIndexes[index]
+		for _, k := range ci.charsetKeys {
+			if !yield(k, ci.charsetMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(ci charsetIndex, query string) (res CharsetResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ci.charsetKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ci.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Charsets = make([][]Charset, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Charsets = append(res.Charsets, ci.charsetMap[ci.charsetKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,82 @@
+package charset
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestCharsetProvider(t *testing.T) {
+	expected := 64
+	fmt.Println("Test: CharsetProvider.Load")
+	p = new(CharsetProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestAliasSearch(t *testing.T) {
+	res, err := p.Search("alias", "latin1")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(CharsetResult).Charsets
+	if len(c) != 2 || c[0][0].MIB != 4 || c[1][0].MIB != 112 {
+		t.Fatalf("Expected latin1 and latin10, got %v\n", c)
+	}
+}
+func TestLookup(t *testing.T) {
+	cp := p.(*CharsetProvider)
+	for name, expected := range map[string]string{
+		"utf8":        "UTF-8",
+		"UTF-8":       "UTF-8",
+		"Latin-1":     "ISO-8859-1",
+		"ISO_8859-1":  "ISO-8859-1",
+		"iso8859_15":  "ISO-8859-15",
+		"cp1252":      "",
+		"windows-936": "GBK",
+		"EUC-JP":      "EUC-JP",
+	} {
+		c, err := cp.Lookup(name)
+		if expected == "" {
+			if err == nil {
+				t.Fatalf("Expected %s not to be found, got %v\n", name, c)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if c.MIMEName != expected {
+			t.Fatalf("Expected %s for %s, got %v\n", expected, name, c)
+		}
+	}
+	c, err := cp.GetByMIB(106)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if c.Name != "UTF-8" {
+		t.Fatalf("Expected UTF-8, got %v\n", c)
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(CharsetProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(CharsetProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "name", Query: "ISO_8859-1"}, {Index: "alias", Query: "latin1"}, {Index: "mib", Query: "106"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
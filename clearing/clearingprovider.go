@@ -0,0 +1,316 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package clearing implements the methods of a stddata.Provider.
+It provides searches against a directory of the branch codes of a
+domestic clearing system outside the US: UK sort codes, or Canadian
+institution and transit numbers. The directories are licensed by
+the clearing systems, so no data is embedded here: the directory is
+read from a file supplied by the caller, in which each line holds a
+code, the name of the institution, the name of the branch and its
+city, separated by tabs.
+*/
+package clearing
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// Scheme identifies a domestic clearing system by the ISO 3166-1
+// alpha-2 code of its country.
+type Scheme string
+
+const (
+	// SortCode is the UK's scheme of 6 digit sort codes, such as
+	// "20-00-00", used by Bacs, Faster Payments and CHAPS.
+	SortCode Scheme = "GB"
+	// Transit is Canada's scheme of 3 digit institution numbers and
+	// 5 digit transit numbers, such as "00011-004" on a cheque, and
+	// "000400011" in an electronic payment.
+	Transit Scheme = "CA"
+)
+
+// ClearingProvider implements the Provider interface.
+type ClearingProvider struct {
+	// Scheme is the clearing system of the directory.
+	Scheme Scheme
+	// File is the path of the directory that Load reads.
+	File string
+	data atomic.Pointer[clearingData] // nil until the data is loaded
+}
+
+// clearingData is the data of a ClearingProvider. Once it is stored in
+// the ClearingProvider it is not modified.
+type clearingData struct {
+	size          int
+	info          stddata.Info
+	branchIndexes map[string]branchIndex
+}
+
+type branchIndex struct {
+	branchMap  map[string][]Branch
+	branchKeys []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Branch models one entity.
+type Branch struct {
+	Scheme          Scheme
+	Code            string // the code in its electronic form: "200000", or "000400011"
+	Institution     string // the Canadian institution number, for example "004"; empty for a sort code
+	InstitutionName string
+	BranchName      string
+	City            string
+}
+
+// BranchResult is the interface{} that is returned from Search
+type BranchResult struct {
+	Branches [][]Branch
+}
+
+// Load implements the Loader interface. It reads the directory
+// named by File. A malformed record, or a code that is not valid
+// in the Scheme, causes Load to fail.
+func (p *ClearingProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode reads the directory named by File, treating malformed
+// records according to mode. In stddata.Lenient mode, malformed
+// records and invalid codes are skipped, and their line numbers are
+// returned in the LoadReport.
+func (p *ClearingProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	if p.File == "" {
+		return r, stddata.NewSourceError("No clearing directory file", nil)
+	}
+	f, err := os.Open(p.File)
+	if err != nil {
+		return r, stddata.NewSourceError(err.Error(), err)
+	}
+	defer f.Close()
+	return p.LoadFrom(f, mode)
+}
+
+// LoadFrom reads a directory from data, as LoadMode reads File.
+func (p *ClearingProvider) LoadFrom(data io.Reader, mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	if p.Scheme != SortCode && p.Scheme != Transit {
+		msg := "No clearing scheme " + string(p.Scheme)
+		return r, stddata.NewSourceError(msg, nil)
+	}
+	// initialize the maps:
+	d := &clearingData{branchIndexes: make(map[string]branchIndex)}
+	codeMap := make(map[string][]Branch)
+	institutionMap := make(map[string][]Branch)
+	institutionNameMap := make(map[string][]Branch)
+	cityMap := make(map[string][]Branch)
+
+	reader := csv.NewReader(data)
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 4
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		b, err := Parse(p.Scheme, record[0])
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: %s", line, err.Error())
+			return r, stddata.NewSourceError(msg, err)
+		}
+		b.InstitutionName = record[1]
+		b.BranchName = record[2]
+		b.City = record[3]
+
+		// add the Branch to the maps
+		codeMap[b.Code] = append(codeMap[b.Code], b)
+		if b.Institution != "" {
+			institutionMap[b.Institution] = append(institutionMap[b.Institution], b)
+		}
+		institutionNameMap[b.InstitutionName] = append(institutionNameMap[b.InstitutionName], b)
+		cityMap[b.City] = append(cityMap[b.City], b)
+	}
+	p.storeData(d, "code", codeMap)
+	p.storeData(d, "institution", institutionMap)
+	p.storeData(d, "name", institutionNameMap)
+	p.storeData(d, "city", cityMap)
+	d.size = len(codeMap)
+	source := "UK sort codes"
+	if p.Scheme == Transit {
+		source = "Canadian institution and transit numbers"
+	}
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      p.File,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(codeMap)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data. The URL is
+// the File the directory was read from.
+func (p *ClearingProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Branch with code, in any of the forms Parse
+// accepts.
+func (p *ClearingProvider) Get(code string) (b Branch, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return b, stddata.ErrNotLoaded
+	}
+	parsed, err := Parse(p.Scheme, code)
+	if err != nil {
+		return b, err
+	}
+	branches, found := d.branchIndexes["code"].branchMap[parsed.Code]
+	if !found {
+		msg := "No branch with code " + code
+		return b, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return branches[0], nil
+}
+
+func (p *ClearingProvider) storeData(d *clearingData, s string, m map[string][]Branch) {
+	// store the map
+	var bi branchIndex
+	bi.branchMap = m
+	// extract the keys
+	bi.branchKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		bi.branchKeys[i] = k
+		i++
+	}
+	// sort the keys
+	bi.foldedKeys = stddata.SortKeys(bi.branchKeys)
+	// add to branchIndexes
+	d.branchIndexes[s] = bi
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *ClearingProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "code", Description: "sort code or routing number, in electronic form", Example: "0004"},
+		{Name: "institution", Description: "Canadian institution number", Example: "004"},
+		{Name: "name", Description: "name of the institution", Example: "Bank of Montreal"},
+		{Name: "city", Description: "city of the branch", Example: "Toronto"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *ClearingProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	bi := d.branchIndexes[index]
+	return stddata.Suggest(bi.branchKeys, bi.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Branch entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Branches are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The code index is keyed by codes in their electronic form, without separators.
+func (p *ClearingProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	bi, found := d.branchIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.branchIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(bi, query)
+	return result, nil
+}
+
+// SearchBranches is Search, returning the matches as they are, rather
+// than as a BranchResult in an interface{}.
+func (p *ClearingProvider) SearchBranches(index string, query string) ([][]Branch, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(BranchResult).Branches, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Branches of each. It yields nothing if the
+// ClearingProvider has no such index, or is not loaded.
+func (p *ClearingProvider) Range(index string) iter.Seq2[string, []Branch] {
+	return func(yield func(string, []Branch) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		bi := d.branchIndexes[index]
+		for _, k := range bi.branchKeys {
+			if !yield(k, bi.branchMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(bi branchIndex, query string) (res BranchResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(bi.branchKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(bi.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Branches = make([][]Branch, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Branches = append(res.Branches, bi.branchMap[bi.branchKeys[k]])
+	}
+	return res
+}
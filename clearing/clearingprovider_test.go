@@ -0,0 +1,117 @@
+package clearing
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+var directory = `00011-004	The Toronto-Dominion Bank	Main Branch	Toronto
+000100002	Bank of Montreal	Main Office	Toronto
+000300002	Royal Bank of Canada	Main Branch	Toronto`
+
+func TestClearingProvider(t *testing.T) {
+	expected := 3
+	fmt.Println("Test: ClearingProvider.Load")
+	file := filepath.Join(t.TempDir(), "transit.txt")
+	if err := os.WriteFile(file, []byte(directory), 0644); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	p = &ClearingProvider{Scheme: Transit, File: file}
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestInstitutionSearch(t *testing.T) {
+	res, err := p.Search("institution", "004")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	b := res.(BranchResult).Branches
+	if len(b) != 1 || b[0][0].Code != "000400011" || b[0][0].Display() != "00011-004" {
+		t.Fatalf("Expected TD, got %v\n", b)
+	}
+}
+func TestGet(t *testing.T) {
+	b, err := p.(*ClearingProvider).Get("00002-001")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if b.InstitutionName != "Bank of Montreal" {
+		t.Fatalf("Expected the Bank of Montreal, got %v\n", b)
+	}
+	if _, err := p.(*ClearingProvider).Get("00002-999"); err == nil {
+		t.Fatalf("Expected 00002-999 not to be found\n")
+	}
+}
+func TestParse(t *testing.T) {
+	for _, code := range []string{"20-00-00", "20 00 00", "200000"} {
+		b, err := Parse(SortCode, code)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if b.Code != "200000" || b.Display() != "20-00-00" {
+			t.Fatalf("Unexpected sort code %+v\n", b)
+		}
+	}
+	for _, code := range []string{"20-00-0", "20-00-0A", "2000000"} {
+		if _, err := Parse(SortCode, code); err == nil {
+			t.Fatalf("Expected sort code %s to be invalid\n", code)
+		}
+	}
+	for _, code := range []string{"0011-004", "00011-04", "100400011", "00400011"} {
+		if _, err := Parse(Transit, code); err == nil {
+			t.Fatalf("Expected transit number %s to be invalid\n", code)
+		}
+	}
+}
+func TestLoadFrom(t *testing.T) {
+	cp := &ClearingProvider{Scheme: SortCode}
+	data := "20-00-00\tBarclays Bank\tLondon\tLondon\n20-00\tNobody\tNowhere\tNowhere\n"
+	if _, err := cp.LoadFrom(strings.NewReader(data), Strict); err == nil {
+		t.Fatalf("Expected strict LoadFrom to fail on line 2\n")
+	}
+	r, err := cp.LoadFrom(strings.NewReader(data), Lenient)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 1 || r.Skipped != 1 || r.SkippedLines[0] != 2 {
+		t.Fatalf("Expected 1 loaded and line 2 skipped, got %+v\n", r)
+	}
+	if _, err := new(ClearingProvider).LoadFrom(strings.NewReader(data), Strict); err == nil {
+		t.Fatalf("Expected LoadFrom without a Scheme to fail\n")
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	file := filepath.Join(b.TempDir(), "transit.txt")
+	if err := os.WriteFile(file, []byte(directory), 0644); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkLoad(b, func() Provider { return &ClearingProvider{Scheme: Transit, File: file} })
+}
+func BenchmarkSearch(b *testing.B) {
+	file := filepath.Join(b.TempDir(), "transit.txt")
+	if err := os.WriteFile(file, []byte(directory), 0644); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	bp := &ClearingProvider{Scheme: Transit, File: file}
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "institution", Query: "004"}, {Index: "name", Query: "Bank"}, {Index: "city", Query: "Toronto"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
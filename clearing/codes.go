@@ -0,0 +1,82 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package clearing
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/musicbeat/stddata"
+)
+
+// Parse checks that code is a valid code in scheme, and returns the
+// Branch it identifies, without the names, which are only found in
+// a directory.
+//
+// A sort code is 6 digits, which may be separated by hyphens or
+// spaces: "20-00-00", "20 00 00" or "200000".
+//
+// A Canadian code may be in its paper form, the 5 digit transit
+// number, a hyphen and the 3 digit institution number, "00011-004";
+// or in its electronic form, a 0, the institution number and the
+// transit number, "000400011".
+func Parse(scheme Scheme, code string) (b Branch, err error) {
+	b.Scheme = scheme
+	switch scheme {
+	case SortCode:
+		s := strings.NewReplacer("-", "", " ", "").Replace(code)
+		if len(s) != 6 || !digits(s) {
+			msg := "Sort code " + code + " is not 6 digits"
+			return b, stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+		}
+		b.Code = s
+	case Transit:
+		s := strings.TrimSpace(code)
+		if i := strings.IndexByte(s, '-'); i >= 0 {
+			// paper form: transit number, then institution number
+			if i != 5 || len(s) != 9 || !digits(s[0:5]) || !digits(s[6:9]) {
+				msg := "Transit number " + code + " is not of the form TTTTT-III"
+				return b, stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+			}
+			s = "0" + s[6:9] + s[0:5]
+		}
+		if len(s) != 9 || s[0] != '0' || !digits(s) {
+			msg := "Transit number " + code + " is not 9 digits beginning with 0"
+			return b, stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+		}
+		b.Code = s
+		b.Institution = s[1:4]
+	default:
+		msg := "No clearing scheme " + string(scheme)
+		return b, stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	return b, nil
+}
+
+// Display returns the code of b in the form printed for people:
+// "20-00-00" for a sort code, and "00011-004" for a Canadian code.
+func (b Branch) Display() string {
+	switch b.Scheme {
+	case SortCode:
+		if len(b.Code) == 6 {
+			return b.Code[0:2] + "-" + b.Code[2:4] + "-" + b.Code[4:6]
+		}
+	case Transit:
+		if len(b.Code) == 9 {
+			return b.Code[4:9] + "-" + b.Code[1:4]
+		}
+	}
+	return b.Code
+}
+
+// digits reports whether s is all digits.
+func digits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,197 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package client searches a remote stddata Service, over its HTTP API, as
+a Provider, so that an application can switch between loading the data
+in process and searching a shared service by changing just how the
+Provider is made:
+
+	var p stddata.Provider = new(country.CountryProvider)
+	// or
+	p = &client.Client[country.CountryResult]{URL: "http://stddata:6060/country"}
+
+	n, err := p.Load()
+	res, err := p.Search("alpha2", "DE")
+
+The result of a search is decoded into an R, which is typically the
+result type of the Provider that the Service serves, so that the result
+is what an in-process Search would return; a json.RawMessage keeps the
+result as the Service encoded it.
+*/
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// maxCached is the most results that a Client caches.
+const maxCached = 10000
+
+// Client is a Provider that searches the Service at URL. A Client must
+// be loaded before it is searched, and may be searched concurrently.
+type Client[R any] struct {
+	URL        string       // of the Service, for example "http://localhost:6060/country"
+	HTTPClient *http.Client // used for the requests, or nil for http.DefaultClient
+	// CacheTTL is how long the result of a search is kept, and returned
+	// for the same search, rather than asking the Service again. 0
+	// caches nothing.
+	CacheTTL time.Duration
+	mu       sync.Mutex
+	indexes  []stddata.IndexDescription
+	names    map[string]bool // the names of the indexes
+	cache    map[string]cached[R]
+}
+
+// cached is a cached result, and when it expires.
+type cached[R any] struct {
+	result  R
+	expires time.Time
+}
+
+// Load implements the Load method of the stddata.Provider interface. The
+// data is loaded by the Service, so Load asks it just for the
+// descriptions of its indexes, which it must be able to give, as an
+// stddata.IndexLister does, and returns the number of indexes.
+func (c *Client[R]) Load() (n int, err error) {
+	var indexes []stddata.IndexDescription
+	body, err := c.get("_indexes")
+	if err != nil {
+		return 0, err
+	}
+	if err = json.Unmarshal(body, &indexes); err != nil {
+		return 0, stddata.NewServiceError(http.StatusBadGateway, "Malformed indexes from "+c.URL+": "+err.Error(), err)
+	}
+	names := make(map[string]bool, len(indexes))
+	for _, d := range indexes {
+		names[d.Name] = true
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.indexes, c.names = indexes, names
+	c.cache = nil
+	return len(indexes), nil
+}
+
+// Indexes returns the descriptions of the indexes of the Service, as
+// they were when the Client was loaded.
+func (c *Client[R]) Indexes() []stddata.IndexDescription {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.indexes
+}
+
+// Search implements the Search method of the stddata.Provider interface.
+// It returns an R, and the errors that the Provider of the Service would
+// return for an empty query and an unknown index. The other errors of
+// the Service are returned as ServiceErrors with the status code of the
+// Service.
+func (c *Client[R]) Search(index string, query string) (result interface{}, err error) {
+	res, err := c.SearchResult(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SearchResult is Search, returning the result as an R, rather than in
+// an interface{}.
+func (c *Client[R]) SearchResult(index string, query string) (result R, err error) {
+	c.mu.Lock()
+	names := c.names
+	entry, found := c.cache[index+"="+query]
+	c.mu.Unlock()
+	if names == nil {
+		return result, stddata.NewServiceError(http.StatusServiceUnavailable, "Client of "+c.URL+" is not loaded", stddata.ErrNotLoaded)
+	}
+	if !names[index] {
+		return result, stddata.NewIndexError(index, names)
+	}
+	if len(query) < 1 {
+		return result, stddata.ErrEmptyQuery
+	}
+	if found && time.Now().Before(entry.expires) {
+		return entry.result, nil
+	}
+
+	body, err := c.get(escape(index) + "=" + escape(query))
+	if err != nil {
+		return result, err
+	}
+	if err = json.Unmarshal(body, &result); err != nil {
+		return result, stddata.NewServiceError(http.StatusBadGateway, "Malformed result from "+c.URL+": "+err.Error(), err)
+	}
+	if c.CacheTTL > 0 {
+		c.store(index+"="+query, result)
+	}
+	return result, nil
+}
+
+// store caches result as the result of the search key. When the cache
+// is full, the expired results are dropped, or, if none are, all of
+// them.
+func (c *Client[R]) store(key string, result R) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cache == nil {
+		c.cache = make(map[string]cached[R])
+	}
+	if len(c.cache) >= maxCached {
+		for k, e := range c.cache {
+			if !now.Before(e.expires) {
+				delete(c.cache, k)
+			}
+		}
+		if len(c.cache) >= maxCached {
+			c.cache = make(map[string]cached[R])
+		}
+	}
+	c.cache[key] = cached[R]{result, now.Add(c.CacheTTL)}
+}
+
+// escaper escapes the characters that would end a part of a request
+// of a Service.
+var escaper = strings.NewReplacer("=", "%3D", "&", "%26")
+
+// escape percent-encodes s as a part of a request of a Service, which
+// decodes "%2B" as "+", but also takes a "+" as itself, rather than as
+// a space.
+func escape(s string) string {
+	return escaper.Replace(url.PathEscape(s))
+}
+
+// get returns the body of the response of the Service to the request
+// with the query rawQuery.
+func (c *Client[R]) get(rawQuery string) ([]byte, error) {
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Get(c.URL + "?" + rawQuery)
+	if err != nil {
+		return nil, stddata.NewSourceError("Failed to reach "+c.URL+". "+err.Error(), err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, stddata.NewSourceError("Failed to read from "+c.URL+". "+err.Error(), err)
+	}
+	if res.StatusCode != http.StatusOK {
+		msg := strings.TrimSpace(string(body))
+		if msg == "" {
+			msg = c.URL + " answered " + res.Status
+		}
+		return nil, stddata.NewServiceError(res.StatusCode, msg, nil)
+	}
+	return body, nil
+}
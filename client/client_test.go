@@ -0,0 +1,113 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/country"
+)
+
+var local = new(country.CountryProvider)
+
+// requests counts the requests the Service has answered.
+var requests atomic.Int64
+
+func newService(t *testing.T) *httptest.Server {
+	if _, err := local.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	s := &Service{Provider: local}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		s.ServeHTTP(w, r)
+	}))
+}
+func TestSearch(t *testing.T) {
+	s := newService(t)
+	defer s.Close()
+	var p Provider = &Client[country.CountryResult]{URL: s.URL}
+	if _, err := p.Search("alpha2", "DE"); err == nil {
+		t.Fatalf("Expected a Client that is not loaded to fail\n")
+	}
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != len(local.Indexes()) || !reflect.DeepEqual(p.(IndexLister).Indexes(), local.Indexes()) {
+		t.Fatalf("Expected the indexes of the Service, got %d\n", n)
+	}
+	for _, q := range [][2]string{{"alpha2", "D"}, {"name", "United Kingdom"}, {"dialcode", "+44"}, {"name", "QQ"}} {
+		remote, err := p.Search(q[0], q[1])
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		expected, _ := local.Search(q[0], q[1])
+		// compare them as they are served
+		got, _ := json.Marshal(remote)
+		want, _ := json.Marshal(expected)
+		if string(got) != string(want) {
+			t.Fatalf("Expected %s for %v, got %s\n", want, q, got)
+		}
+	}
+	res, _ := p.Search("alpha2", "DE")
+	if c := res.(country.CountryResult).Countries; len(c) != 1 || !c[0].Memberships.Has(country.EU) {
+		t.Fatalf("Expected Germany, a member of the EU, got %v\n", c)
+	}
+}
+func TestQueryErrors(t *testing.T) {
+	s := newService(t)
+	defer s.Close()
+	c := &Client[json.RawMessage]{URL: s.URL}
+	if _, err := c.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if _, err := c.Search("alpha2", ""); err != ErrEmptyQuery {
+		t.Fatalf("Expected ErrEmptyQuery, got %v\n", err)
+	}
+	_, err := c.Search("alpha-2", "DE")
+	if ierr, ok := err.(*IndexError); !ok || !errors.Is(err, ErrUnknownIndex) || ierr.Suggestions[0] != "alpha2" {
+		t.Fatalf("Expected an IndexError, got %v\n", err)
+	}
+	s.Close()
+	_, err = c.Search("alpha2", "DE")
+	var serr *ServiceError
+	if !errors.As(err, &serr) || serr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("Expected a Service that is down to be unavailable, got %v\n", err)
+	}
+	var uerr *url.Error
+	if !errors.As(err, &uerr) || StatusCode(err) != http.StatusServiceUnavailable {
+		t.Fatalf("Expected the cause to be wrapped, got %#v\n", err)
+	}
+}
+func TestCache(t *testing.T) {
+	s := newService(t)
+	defer s.Close()
+	c := &Client[json.RawMessage]{URL: s.URL, CacheTTL: 50 * time.Millisecond}
+	if _, err := c.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	before := requests.Load()
+	for i := 0; i < 3; i++ {
+		if _, err := c.Search("alpha2", "DE"); err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+	}
+	if n := requests.Load() - before; n != 1 {
+		t.Fatalf("Expected the result to be cached, got %d requests\n", n)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if _, err := c.Search("alpha2", "DE"); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n := requests.Load() - before; n != 2 {
+		t.Fatalf("Expected the result to expire, got %d requests\n", n)
+	}
+}
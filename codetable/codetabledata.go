@@ -0,0 +1,64 @@
+package codetable
+
+// provenance of codetabledata, reported by Info
+const (
+	source    = "ISO code tables"
+	sourceURL = "https://www.iso.org/"
+	edition   = "ISO/IEC 5218:2004, ISO 6346:2022, ISO 8601-1:2019, ISO 10962:2021, ISO/IEC 7812-1:2017"
+)
+
+/*
+codetabledata holds small code tables of ISO standards, each too small
+to need a package of its own. The fields of each record are
+tab-delimited: the name of the table, the code and its name. The
+tables are:
+
+	ISO 5218	the codes of the sexes of human beings
+	ISO 6346	the equipment category identifiers of freight containers
+	ISO 8601	the numbers of the days of the week
+	ISO 10962	the categories of the Classification of Financial Instruments
+	ISO/IEC 7812	the major industry identifiers of card numbers
+
+Other tables, such as the official organizational roles of ISO 5009,
+which GLEIF publishes by jurisdiction, are read from the file named by
+CodeTableProvider.File.
+*/
+var codetabledata = `ISO 5218	0	Not known
+ISO 5218	1	Male
+ISO 5218	2	Female
+ISO 5218	9	Not applicable
+ISO 6346	J	Detachable freight container-related equipment
+ISO 6346	U	Freight container
+ISO 6346	Z	Trailer or chassis
+ISO 8601	1	Monday
+ISO 8601	2	Tuesday
+ISO 8601	3	Wednesday
+ISO 8601	4	Thursday
+ISO 8601	5	Friday
+ISO 8601	6	Saturday
+ISO 8601	7	Sunday
+ISO 10962	C	Collective investment vehicles
+ISO 10962	D	Debt instruments
+ISO 10962	E	Equities
+ISO 10962	F	Futures
+ISO 10962	H	Non-listed and complex listed options
+ISO 10962	I	Spot
+ISO 10962	J	Forwards
+ISO 10962	K	Strategies
+ISO 10962	L	Financing
+ISO 10962	M	Others
+ISO 10962	O	Listed options
+ISO 10962	R	Entitlements (rights)
+ISO 10962	S	Swaps
+ISO 10962	T	Referential instruments
+ISO/IEC 7812	0	ISO/TC 68 and other industry assignments
+ISO/IEC 7812	1	Airlines
+ISO/IEC 7812	2	Airlines, financial and other future industry assignments
+ISO/IEC 7812	3	Travel and entertainment
+ISO/IEC 7812	4	Banking and financial
+ISO/IEC 7812	5	Banking and financial
+ISO/IEC 7812	6	Merchandising and banking/financial
+ISO/IEC 7812	7	Petroleum and other future industry assignments
+ISO/IEC 7812	8	Healthcare, telecommunications and other future industry assignments
+ISO/IEC 7812	9	For assignment by national standards bodies
+`
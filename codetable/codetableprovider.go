@@ -0,0 +1,332 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package codetable implements the methods of a stddata.Provider.
+It provides searches against small code tables of standards, such as
+the codes of the sexes of ISO/IEC 5218 or the numbers of the days of
+the week of ISO 8601: enumerations of a handful of codes, each too
+small to need a package of its own, bundled in one provider and told
+apart by the name of their table. The tables are declared in
+codetabledata.go, and more, in the same format, are read from a file
+supplied by the caller.
+*/
+package codetable
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// CodeTableProvider implements the Provider interface.
+type CodeTableProvider struct {
+	// File is the path of a file of more code tables, in the format of
+	// codetabledata, that Load reads after codetabledata. A table in
+	// File may add codes to a table in codetabledata, but not redefine
+	// them.
+	File string
+	data atomic.Pointer[codeTableData] // nil until the data is loaded
+}
+
+// codeTableData is the data of a CodeTableProvider. Once it is stored
+// in the CodeTableProvider it is not modified.
+type codeTableData struct {
+	size        int
+	info        stddata.Info
+	codeIndexes map[string]codeIndex
+	// codes holds the codes by their table and code, in upper case.
+	codes map[string]Code
+}
+
+type codeIndex struct {
+	codeMap    map[string][]Code
+	codeKeys   []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Code models one entity.
+type Code struct {
+	Table string // the name of the table, for example "ISO 5218"
+	Code  string // for example "2"
+	Name  string // for example "Female"
+}
+
+// CodeResult is the interface{} that is returned from Search
+type CodeResult struct {
+	Codes [][]Code
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *CodeTableProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *CodeTableProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &codeTableData{codeIndexes: make(map[string]codeIndex)}
+	d.codes = make(map[string]Code)
+	// the maps of the indexes, by the names of the indexes
+	maps := map[string]map[string][]Code{
+		"table": make(map[string][]Code),
+		"code":  make(map[string][]Code),
+		"name":  make(map[string][]Code),
+	}
+
+	if err = d.read(strings.NewReader(codetabledata), mode, &r, maps); err != nil {
+		return r, err
+	}
+	if p.File != "" {
+		f, err := os.Open(p.File)
+		if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+		defer f.Close()
+		if err = d.read(f, mode, &r, maps); err != nil {
+			return r, err
+		}
+	}
+	for s, m := range maps {
+		p.storeData(d, s, m)
+	}
+	d.size = len(d.codes)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	if p.File != "" {
+		d.info.URL += " " + p.File
+	}
+	p.data.Store(d)
+	r.Loaded = len(d.codes)
+	return r, nil
+}
+
+// read reads the code tables in data, adding them to codes and to the
+// maps of the indexes.
+func (d *codeTableData) read(data io.Reader, mode stddata.ParseMode, r *stddata.LoadReport, maps map[string]map[string][]Code) error {
+	reader := csv.NewReader(data)
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 3
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return stddata.NewSourceError(err.Error(), err)
+		}
+
+		var c Code
+		c.Table = strings.TrimSpace(record[0])
+		c.Code = strings.TrimSpace(record[1])
+		c.Name = strings.TrimSpace(record[2])
+		_, dup := d.codes[key(c.Table, c.Code)]
+		if c.Table == "" || c.Code == "" || dup {
+			line, _ := reader.FieldPos(1)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed or repeated code %q in table %q", line, c.Code, c.Table)
+			return stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Code to the maps
+		d.codes[key(c.Table, c.Code)] = c
+		maps["table"][c.Table] = append(maps["table"][c.Table], c)
+		maps["code"][c.Code] = append(maps["code"][c.Code], c)
+		if c.Name != "" {
+			maps["name"][c.Name] = append(maps["name"][c.Name], c)
+		}
+	}
+	return nil
+}
+
+// key returns the key of a code in codes.
+func key(table string, code string) string {
+	return strings.ToUpper(table) + "\t" + strings.ToUpper(code)
+}
+
+// Info describes the provenance of the loaded data.
+func (p *CodeTableProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Tables returns the names of the loaded tables, in order.
+func (p *CodeTableProvider) Tables() []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	return d.codeIndexes["table"].codeKeys
+}
+
+// Table returns the codes of the table whose name is table, in any
+// case, in the order they were loaded.
+func (p *CodeTableProvider) Table(table string) (codes []Code, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	for name, codes := range d.codeIndexes["table"].codeMap {
+		if strings.EqualFold(name, table) {
+			return codes, nil
+		}
+	}
+	msg := "No code table " + table
+	return nil, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+}
+
+// Get returns the Code of the table whose name is table whose code is
+// code, both in any case: Get("iso 5218", "2") finds Female.
+func (p *CodeTableProvider) Get(table string, code string) (c Code, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return c, stddata.ErrNotLoaded
+	}
+	c, found := d.codes[key(table, code)]
+	if !found {
+		msg := "No code " + code + " in table " + table
+		return c, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return c, nil
+}
+
+func (p *CodeTableProvider) storeData(d *codeTableData, s string, m map[string][]Code) {
+	// store the map
+	var ci codeIndex
+	ci.codeMap = m
+	// extract the keys
+	ci.codeKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		ci.codeKeys[i] = k
+		i++
+	}
+	// sort the keys
+	ci.foldedKeys = stddata.SortKeys(ci.codeKeys)
+	// add to codeIndexes
+	d.codeIndexes[s] = ci
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *CodeTableProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "table", Description: "name of the table", Example: "ISO 5218"},
+		{Name: "code", Description: "code", Example: "2"},
+		{Name: "name", Description: "name of the code", Example: "Female"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *CodeTableProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ci := d.codeIndexes[index]
+	return stddata.Suggest(ci.codeKeys, ci.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Code entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Codes are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The code index is keyed by the codes alone, so a code is found in every
+// table that has it; the table index finds the whole of a table.
+func (p *CodeTableProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	ci, found := d.codeIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.codeIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(ci, query)
+	return result, nil
+}
+
+// SearchCodes is Search, returning the matches as they are, rather
+// than as a CodeResult in an interface{}.
+func (p *CodeTableProvider) SearchCodes(index string, query string) ([][]Code, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(CodeResult).Codes, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Codes of each. It yields nothing if the
+// CodeTableProvider has no such index, or is not loaded.
+func (p *CodeTableProvider) Range(index string) iter.Seq2[string, []Code] {
+	return func(yield func(string, []Code) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ci := d.codeIndexes[index]
+		for _, k := range ci.codeKeys {
+			if !yield(k, ci.codeMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(ci codeIndex, query string) (res CodeResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ci.codeKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ci.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Codes = make([][]Code, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Codes = append(res.Codes, ci.codeMap[ci.codeKeys[k]])
+	}
+	return res
+}
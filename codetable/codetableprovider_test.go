@@ -0,0 +1,114 @@
+package codetable
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestCodeTableProvider(t *testing.T) {
+	expected := 38
+	fmt.Println("Test: CodeTableProvider.Load")
+	p = new(CodeTableProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestTableSearch(t *testing.T) {
+	res, err := p.Search("table", "ISO 5218")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(CodeResult).Codes
+	if len(c) != 1 || len(c[0]) != 4 {
+		t.Fatalf("Expected the 4 codes of ISO 5218, got %v\n", c)
+	}
+}
+func TestCodeSearch(t *testing.T) {
+	res, err := p.Search("code", "U")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(CodeResult).Codes
+	if len(c) != 1 || c[0][0].Table != "ISO 6346" {
+		t.Fatalf("Expected the freight container, got %v\n", c)
+	}
+}
+func TestGet(t *testing.T) {
+	ctp := p.(*CodeTableProvider)
+	c, err := ctp.Get("iso 5218", "2")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if c.Name != "Female" {
+		t.Fatalf("Expected Female, got %v\n", c)
+	}
+	if _, err = ctp.Get("ISO 5218", "3"); err == nil {
+		t.Fatalf("Expected no code 3 in ISO 5218\n")
+	}
+	codes, err := ctp.Table("iso 8601")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(codes) != 7 || codes[6].Name != "Sunday" {
+		t.Fatalf("Expected the days of the week, got %v\n", codes)
+	}
+	if tables := ctp.Tables(); len(tables) != 5 || tables[0] != "ISO 10962" {
+		t.Fatalf("Expected 5 tables, got %v\n", tables)
+	}
+}
+func TestFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "codetable.txt")
+	if err := os.WriteFile(file, []byte("ISO 5009\tXGH0XT\tExample role\n"), 0644); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	ctp := &CodeTableProvider{File: file}
+	n, err := ctp.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != 39 {
+		t.Fatalf("Expected to load 39, loaded %d\n", n)
+	}
+	if _, err = ctp.Get("ISO 5009", "XGH0XT"); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	// a code that is already loaded cannot be redefined
+	if err := os.WriteFile(file, []byte("ISO 5218\t1\tMan\n"), 0644); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if _, err = ctp.Load(); err == nil {
+		t.Fatalf("Expected a repeated code to fail Load\n")
+	}
+	r, err := ctp.LoadMode(Lenient)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 38 || r.Skipped != 1 {
+		t.Fatalf("Expected 38 loaded and 1 skipped, got %v\n", r)
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(CodeTableProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(CodeTableProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "table", Query: "ISO 5218"}, {Index: "name", Query: "female"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
@@ -0,0 +1,65 @@
+package country
+
+/*
+aliasdata holds colloquial names, abbreviations and former
+names that are commonly used in place of a country's ISO
+name. Each line holds an alias and the alpha-2 code of the
+country it refers to, separated by a tab. Applications can
+add their own aliases with CountryProvider.RegisterAlias.
+*/
+var aliasdata = `America	US
+Bolivia	BO
+Britain	GB
+Brunei	BN
+Burma	MM
+Cape Verde	CV
+Czechia	CZ
+DPRK	KP
+DR Congo	CD
+DRC	CD
+Democratic Republic of the Congo	CD
+East Timor	TL
+England	GB
+Eswatini	SZ
+Great Britain	GB
+Holland	NL
+Iran	IR
+Ivory Coast	CI
+Kampuchea	KH
+Korea	KR
+Laos	LA
+Macau	MO
+Micronesia	FM
+Moldova	MD
+North Korea	KP
+North Macedonia	MK
+Northern Ireland	GB
+Palestine	PS
+Persia	IR
+ROK	KR
+Republic of Ireland	IE
+Russia	RU
+Scotland	GB
+South Korea	KR
+Syria	SY
+Taiwan	TW
+Tanzania	TZ
+The Bahamas	BS
+The Gambia	GM
+The Netherlands	NL
+Turkiye	TR
+Türkiye	TR
+U.K.	GB
+U.S.	US
+U.S.A.	US
+UAE	AE
+UK	GB
+US	US
+USA	US
+United States of America	US
+Vatican	VA
+Vatican City	VA
+Venezuela	VE
+Vietnam	VN
+Wales	GB
+Zaire	CD`
@@ -0,0 +1,211 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package country
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/musicbeat/stddata"
+)
+
+// UnknownCodeError is returned by the code conversion functions when
+// a code is not an officially assigned ISO 3166-1 code.
+type UnknownCodeError struct {
+	Kind string // the kind of code: "alpha2", "alpha3" or "number"
+	Code string // the code that was not found
+}
+
+// Error implements the built-in error interface on UnknownCodeError.
+func (e *UnknownCodeError) Error() string {
+	return "No country with " + e.Kind + " code " + e.Code
+}
+
+// Unwrap returns stddata.ErrNoMatch, so that errors.Is reports an
+// UnknownCodeError as a lookup that matched nothing.
+func (e *UnknownCodeError) Unwrap() error {
+	return stddata.ErrNoMatch
+}
+
+// codes is the CountryProvider that backs the code conversion and
+// lookup functions. It is loaded the first time it is needed, and again
+// by the next call if that load failed.
+var codes struct {
+	mu     sync.Mutex
+	loaded bool
+	p      CountryProvider
+}
+
+// codeData returns the data of the loaded CountryProvider behind the
+// code conversion functions.
+func codeData() (*countryData, error) {
+	codes.mu.Lock()
+	defer codes.mu.Unlock()
+	if !codes.loaded {
+		if _, err := codes.p.Load(); err != nil {
+			return nil, err
+		}
+		codes.loaded = true
+	}
+	return codes.p.data.Load(), nil
+}
+
+// lookup returns the Country whose code of the given kind, which is
+// the name of an index, is exactly code.
+func (d *countryData) lookup(kind string, code string) (c Country, err error) {
+	key := strings.ToUpper(code)
+	if kind == "number" {
+		key = normalizeNumeric(code)
+	}
+	countries, found := d.get(kind, key)
+	if !found {
+		return c, &UnknownCodeError{kind, code}
+	}
+	return countries[0], nil
+}
+
+// convert looks up code as a code of kind from, and returns the
+// country's code of kind to.
+func convert(from string, to string, code string) (string, error) {
+	d, err := codeData()
+	if err != nil {
+		return "", err
+	}
+	c, err := d.lookup(from, code)
+	if err != nil {
+		return "", err
+	}
+	switch to {
+	case "alpha2":
+		return c.Alpha2Code, nil
+	case "alpha3":
+		return c.Alpha3Code, nil
+	}
+	return c.NumericCode, nil
+}
+
+// ByAlpha2 returns the Country whose alpha-2 code is code, in any
+// case: "us" is the United States. Like the code conversion functions,
+// it needs no CountryProvider of its own, and an unknown code is
+// reported with an UnknownCodeError.
+func ByAlpha2(code string) (Country, error) {
+	return byCode("alpha2", code)
+}
+
+// ByAlpha3 returns the Country whose alpha-3 code is code, in any case.
+func ByAlpha3(code string) (Country, error) {
+	return byCode("alpha3", code)
+}
+
+// ByNumeric returns the Country whose numeric code is code. The numeric
+// code may omit its leading zeros.
+func ByNumeric(code string) (Country, error) {
+	return byCode("number", code)
+}
+
+// ByName returns the Country whose English short name, common name,
+// official name or alias is name, in any case: "Germany", "South Korea"
+// and "Holland" are all names of countries.
+func ByName(name string) (c Country, err error) {
+	d, err := codeData()
+	if err != nil {
+		return c, err
+	}
+	folded := strings.ToLower(name)
+	for _, index := range []string{"name", "common", "official", "alias"} {
+		// the keys are sorted by their lower case, so a key that is
+		// name, in any case, is the first of those it begins.
+		ci := d.countryIndexes[index]
+		lo, hi := stddata.PrefixRange(ci.foldedKeys, name)
+		if lo < hi && ci.foldedKeys[lo] == folded {
+			return d.countries[ci.countryIDs[ci.countryKeys[lo]][0]], nil
+		}
+	}
+	msg := "No country named " + name
+	return c, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+}
+
+// byCode looks up code as a code of kind with the CountryProvider of
+// the code conversion functions.
+func byCode(kind string, code string) (c Country, err error) {
+	d, err := codeData()
+	if err != nil {
+		return c, err
+	}
+	return d.lookup(kind, code)
+}
+
+// Alpha2ToAlpha3 converts an alpha-2 code to an alpha-3 code: "US" to "USA".
+func Alpha2ToAlpha3(code string) (string, error) {
+	return convert("alpha2", "alpha3", code)
+}
+
+// Alpha2ToNumeric converts an alpha-2 code to a numeric code: "US" to "840".
+func Alpha2ToNumeric(code string) (string, error) {
+	return convert("alpha2", "number", code)
+}
+
+// Alpha3ToAlpha2 converts an alpha-3 code to an alpha-2 code: "USA" to "US".
+func Alpha3ToAlpha2(code string) (string, error) {
+	return convert("alpha3", "alpha2", code)
+}
+
+// Alpha3ToNumeric converts an alpha-3 code to a numeric code: "USA" to "840".
+func Alpha3ToNumeric(code string) (string, error) {
+	return convert("alpha3", "number", code)
+}
+
+// NumericToAlpha2 converts a numeric code to an alpha-2 code: "840" to "US".
+// The numeric code may omit its leading zeros.
+func NumericToAlpha2(code string) (string, error) {
+	return convert("number", "alpha2", code)
+}
+
+// NumericToAlpha3 converts a numeric code to an alpha-3 code: "840" to "USA".
+// The numeric code may omit its leading zeros.
+func NumericToAlpha3(code string) (string, error) {
+	return convert("number", "alpha3", code)
+}
+
+// Validate returns nil if code is exactly an officially assigned
+// ISO 3166-1 code of the given kind, which is one of "alpha2",
+// "alpha3" or "number". Unlike the conversion functions and Search,
+// Validate does not fold case, and does not accept partial codes or
+// numeric codes without their leading zeros: "US", "USA" and "840"
+// are valid, "us", "U" and "84" are not. An unknown code is reported
+// with an UnknownCodeError.
+func Validate(kind string, code string) error {
+	switch kind {
+	case "alpha2", "alpha3", "number":
+	default:
+		return errors.New("No country code kind " + kind)
+	}
+	d, err := codeData()
+	if err != nil {
+		return err
+	}
+	if _, found := d.countryIndexes[kind].countryIDs[code]; !found {
+		return &UnknownCodeError{kind, code}
+	}
+	return nil
+}
+
+// IsValidAlpha2 reports whether code is exactly an assigned alpha-2 code.
+func IsValidAlpha2(code string) bool {
+	return Validate("alpha2", code) == nil
+}
+
+// IsValidAlpha3 reports whether code is exactly an assigned alpha-3 code.
+func IsValidAlpha3(code string) bool {
+	return Validate("alpha3", code) == nil
+}
+
+// IsValidNumeric reports whether code is exactly an assigned numeric
+// code, with three digits.
+func IsValidNumeric(code string) bool {
+	return Validate("number", code) == nil
+}
@@ -1,7 +1,5 @@
 package country
 
-import "strings"
-
 /*
 countrydata is derived from the ISO 3166-1 information
 presented on wikipedia:
@@ -9,253 +7,264 @@ http://en.wikipedia.org/wiki/ISO_3166-1
 The data was obtained from the wiki source for "Officially
 assigned code elements". Some munging occurred, then the
 tab-delimited csv file data in this source file was constructed.
+Each line holds the ISO short name, the alpha-2, alpha-3 and
+numeric codes, the common name, and the official name. The common
+and official names were taken from the Debian iso-codes project
+where it supplies them; otherwise they repeat the short name.
 */
-var countrydata = strings.NewReader(`Afghanistan	AF	AFG	004
-Åland Islands	AX	ALA	248
-Albania	AL	ALB	008
-Algeria	DZ	DZA	012
-American Samoa	AS	ASM	016
-Andorra	AD	AND	020
-Angola	AO	AGO	024
-Anguilla	AI	AIA	660
-Antarctica	AQ	ATA	010
-Antigua and Barbuda	AG	ATG	028
-Argentina	AR	ARG	032
-Armenia	AM	ARM	051
-Aruba	AW	ABW	533
-Australia	AU	AUS	036
-Austria	AT	AUT	040
-Azerbaijan	AZ	AZE	031
-Bahamas	BS	BHS	044
-Bahrain	BH	BHR	048
-Bangladesh	BD	BGD	050
-Barbados	BB	BRB	052
-Belarus	BY	BLR	112
-Belgium	BE	BEL	056
-Belize	BZ	BLZ	084
-Benin	BJ	BEN	204
-Bermuda	BM	BMU	060
-Bhutan	BT	BTN	064
-Bolivia, Plurinational State of	BO	BOL	068
-Bonaire, Sint Eustatius and Saba	BQ	BES	535
-Bosnia and Herzegovina	BA	BIH	070
-Botswana	BW	BWA	072
-Bouvet Island	BV	BVT	074
-Brazil	BR	BRA	076
-British Indian Ocean Territory	IO	IOT	086
-Brunei Darussalam	BN	BRN	096
-Bulgaria	BG	BGR	100
-Burkina Faso	BF	BFA	854
-Burundi	BI	BDI	108
-Cambodia	KH	KHM	116
-Cameroon	CM	CMR	120
-Canada	CA	CAN	124
-Cabo Verde	CV	CPV	132
-Cayman Islands	KY	CYM	136
-Central African Republic	CF	CAF	140
-Chad	TD	TCD	148
-Chile	CL	CHL	152
-China	CN	CHN	156
-Christmas Island	CX	CXR	162
-Cocos (Keeling) Islands	CC	CCK	166
-Colombia	CO	COL	170
-Comoros	KM	COM	174
-Republic of the Congo	CG	COG	178
-Congo, the Democratic Republic of the	CD	COD	180
-Cook Islands	CK	COK	184
-Costa Rica	CR	CRI	188
-Côte d'Ivoire	CI	CIV	384
-Croatia	HR	HRV	191
-Cuba	CU	CUB	192
-Curaçao	CW	CUW	531
-Cyprus	CY	CYP	196
-Czech Republic	CZ	CZE	203
-Denmark	DK	DNK	208
-Djibouti	DJ	DJI	262
-Dominica	DM	DMA	212
-Dominican Republic	DO	DOM	214
-Ecuador	EC	ECU	218
-Egypt	EG	EGY	818
-El Salvador	SV	SLV	222
-Equatorial Guinea	GQ	GNQ	226
-Eritrea	ER	ERI	232
-Estonia	EE	EST	233
-Ethiopia	ET	ETH	231
-Falkland Islands (Malvinas)	FK	FLK	238
-Faroe Islands	FO	FRO	234
-Fiji	FJ	FJI	242
-Finland	FI	FIN	246
-France	FR	FRA	250
-French Guiana	GF	GUF	254
-French Polynesia	PF	PYF	258
-French Southern Territories	TF	ATF	260
-Gabon	GA	GAB	266
-Gambia	GM	GMB	270
-Georgia (country)|Georgia	GE	GEO	268
-Germany	DE	DEU	276
-Ghana	GH	GHA	288
-Gibraltar	GI	GIB	292
-Greece	GR	GRC	300
-Greenland	GL	GRL	304
-Grenada	GD	GRD	308
-Guadeloupe	GP	GLP	312
-Guam	GU	GUM	316
-Guatemala	GT	GTM	320
-Guernsey	GG	GGY	831
-Guinea	GN	GIN	324
-Guinea-Bissau	GW	GNB	624
-Guyana	GY	GUY	328
-Haiti	HT	HTI	332
-Heard Island and McDonald Islands	HM	HMD	334
-Vatican City State|Holy See (Vatican City State)	VA	VAT	336
-Honduras	HN	HND	340
-Hong Kong	HK	HKG	344
-Hungary	HU	HUN	348
-Iceland	IS	ISL	352
-India	IN	IND	356
-Indonesia	ID	IDN	360
-Iran, Islamic Republic of	IR	IRN	364
-Iraq	IQ	IRQ	368
-Republic of Ireland|Ireland	IE	IRL	372
-Isle of Man	IM	IMN	833
-Israel	IL	ISR	376
-Italy	IT	ITA	380
-Jamaica	JM	JAM	388
-Japan	JP	JPN	392
-Jersey	JE	JEY	832
-Jordan	JO	JOR	400
-Kazakhstan	KZ	KAZ	398
-Kenya	KE	KEN	404
-Kiribati	KI	KIR	296
-Korea, Democratic People's Republic of	KP	PRK	408
-Korea, Republic of	KR	KOR	410
-Kuwait	KW	KWT	414
-Kyrgyzstan	KG	KGZ	417
-Lao People's Democratic Republic	LA	LAO	418
-Latvia	LV	LVA	428
-Lebanon	LB	LBN	422
-Lesotho	LS	LSO	426
-Liberia	LR	LBR	430
-Libya	LY	LBY	434
-Liechtenstein	LI	LIE	438
-Lithuania	LT	LTU	440
-Luxembourg	LU	LUX	442
-Macao	MO	MAC	446
-Republic of Macedonia|Macedonia, the former Yugoslav Republic of	MK	MKD	807
-Madagascar	MG	MDG	450
-Malawi	MW	MWI	454
-Malaysia	MY	MYS	458
-Maldives	MV	MDV	462
-Mali	ML	MLI	466
-Malta	MT	MLT	470
-Marshall Islands	MH	MHL	584
-Martinique	MQ	MTQ	474
-Mauritania	MR	MRT	478
-Mauritius	MU	MUS	480
-Mayotte	YT	MYT	175
-Mexico	MX	MEX	484
-Micronesia, Federated States of	FM	FSM	583
-Moldova, Republic of	MD	MDA	498
-Monaco	MC	MCO	492
-Mongolia	MN	MNG	496
-Montenegro	ME	MNE	499
-Montserrat	MS	MSR	500
-Morocco	MA	MAR	504
-Mozambique	MZ	MOZ	508
-Myanmar	MM	MMR	104
-Namibia	NA	NAM	516
-Nauru	NR	NRU	520
-Nepal	NP	NPL	524
-Netherlands	NL	NLD	528
-New Caledonia	NC	NCL	540
-New Zealand	NZ	NZL	554
-Nicaragua	NI	NIC	558
-Niger	NE	NER	562
-Nigeria	NG	NGA	566
-Niue	NU	NIU	570
-Norfolk Island	NF	NFK	574
-Northern Mariana Islands	MP	MNP	580
-Norway	NO	NOR	578
-Oman	OM	OMN	512
-Pakistan	PK	PAK	586
-Palau	PW	PLW	585
-State of Palestine|Palestine, State of	PS	PSE	275
-Panama	PA	PAN	591
-Papua New Guinea	PG	PNG	598
-Paraguay	PY	PRY	600
-Peru	PE	PER	604
-Philippines	PH	PHL	608
-Pitcairn	PN	PCN	612
-Poland	PL	POL	616
-Portugal	PT	PRT	620
-Puerto Rico	PR	PRI	630
-Qatar	QA	QAT	634
-Réunion	RE	REU	638
-Romania	RO	ROU	642
-Russian Federation	RU	RUS	643
-Rwanda	RW	RWA	646
-Saint Barthélemy	BL	BLM	652
-Saint Helena, Ascension and Tristan da Cunha	SH	SHN	654
-Saint Kitts and Nevis	KN	KNA	659
-Saint Lucia	LC	LCA	662
-Saint Martin (French part)	MF	MAF	663
-Saint Pierre and Miquelon	PM	SPM	666
-Saint Vincent and the Grenadines	VC	VCT	670
-Samoa	WS	WSM	882
-San Marino	SM	SMR	674
-Sao Tome and Principe	ST	STP	678
-Saudi Arabia	SA	SAU	682
-Senegal	SN	SEN	686
-Serbia	RS	SRB	688
-Seychelles	SC	SYC	690
-Sierra Leone	SL	SLE	694
-Singapore	SG	SGP	702
-Sint Maarten (Dutch part)	SX	SXM	534
-Slovakia	SK	SVK	703
-Slovenia	SI	SVN	705
-Solomon Islands	SB	SLB	090
-Somalia	SO	SOM	706
-South Africa	ZA	ZAF	710
-South Georgia and the South Sandwich Islands	GS	SGS	239
-South Sudan	SS	SSD	728
-Spain	ES	ESP	724
-Sri Lanka	LK	LKA	144
-Sudan	SD	SDN	729
-Suriname	SR	SUR	740
-Svalbard and Jan Mayen	SJ	SJM	744
-Swaziland	SZ	SWZ	748
-Sweden	SE	SWE	752
-Switzerland	CH	CHE	756
-Syrian Arab Republic	SY	SYR	760
-Taiwan	TW	TWN	158
-Tajikistan	TJ	TJK	762
-Tanzania, United Republic of	TZ	TZA	834
-Thailand	TH	THA	764
-Timor-Leste	TL	TLS	626
-Togo	TG	TGO	768
-Tokelau	TK	TKL	772
-Tonga	TO	TON	776
-Trinidad and Tobago	TT	TTO	780
-Tunisia	TN	TUN	788
-Turkey	TR	TUR	792
-Turkmenistan	TM	TKM	795
-Turks and Caicos Islands	TC	TCA	796
-Tuvalu	TV	TUV	798
-Uganda	UG	UGA	800
-Ukraine	UA	UKR	804
-United Arab Emirates	AE	ARE	784
-United Kingdom	GB	GBR	826
-United States	US	USA	840
-United States Minor Outlying Islands	UM	UMI	581
-Uruguay	UY	URY	858
-Uzbekistan	UZ	UZB	860
-Vanuatu	VU	VUT	548
-Venezuela, Bolivarian Republic of	VE	VEN	862
-Viet Nam	VN	VNM	704
-Virgin Islands, British	VG	VGB	092
-Virgin Islands, U.S.	VI	VIR	850
-Wallis and Futuna	WF	WLF	876
-Western Sahara	EH	ESH	732
-Yemen	YE	YEM	887
-Zambia	ZM	ZMB	894
-Zimbabwe	ZW	ZWE	716`)
+// provenance of countrydata, reported by Info
+const (
+	source    = "ISO 3166-1 Officially assigned code elements (Wikipedia)"
+	sourceURL = "http://en.wikipedia.org/wiki/ISO_3166-1"
+	edition   = "2014"
+)
+
+var countrydata = `Afghanistan	AF	AFG	004	Afghanistan	Islamic Republic of Afghanistan
+Åland Islands	AX	ALA	248	Åland Islands	Åland Islands
+Albania	AL	ALB	008	Albania	Republic of Albania
+Algeria	DZ	DZA	012	Algeria	People's Democratic Republic of Algeria
+American Samoa	AS	ASM	016	American Samoa	American Samoa
+Andorra	AD	AND	020	Andorra	Principality of Andorra
+Angola	AO	AGO	024	Angola	Republic of Angola
+Anguilla	AI	AIA	660	Anguilla	Anguilla
+Antarctica	AQ	ATA	010	Antarctica	Antarctica
+Antigua and Barbuda	AG	ATG	028	Antigua and Barbuda	Antigua and Barbuda
+Argentina	AR	ARG	032	Argentina	Argentine Republic
+Armenia	AM	ARM	051	Armenia	Republic of Armenia
+Aruba	AW	ABW	533	Aruba	Aruba
+Australia	AU	AUS	036	Australia	Australia
+Austria	AT	AUT	040	Austria	Republic of Austria
+Azerbaijan	AZ	AZE	031	Azerbaijan	Republic of Azerbaijan
+Bahamas	BS	BHS	044	Bahamas	Commonwealth of the Bahamas
+Bahrain	BH	BHR	048	Bahrain	Kingdom of Bahrain
+Bangladesh	BD	BGD	050	Bangladesh	People's Republic of Bangladesh
+Barbados	BB	BRB	052	Barbados	Barbados
+Belarus	BY	BLR	112	Belarus	Republic of Belarus
+Belgium	BE	BEL	056	Belgium	Kingdom of Belgium
+Belize	BZ	BLZ	084	Belize	Belize
+Benin	BJ	BEN	204	Benin	Republic of Benin
+Bermuda	BM	BMU	060	Bermuda	Bermuda
+Bhutan	BT	BTN	064	Bhutan	Kingdom of Bhutan
+Bolivia, Plurinational State of	BO	BOL	068	Bolivia	Plurinational State of Bolivia
+Bonaire, Sint Eustatius and Saba	BQ	BES	535	Bonaire, Sint Eustatius and Saba	Bonaire, Sint Eustatius and Saba
+Bosnia and Herzegovina	BA	BIH	070	Bosnia and Herzegovina	Republic of Bosnia and Herzegovina
+Botswana	BW	BWA	072	Botswana	Republic of Botswana
+Bouvet Island	BV	BVT	074	Bouvet Island	Bouvet Island
+Brazil	BR	BRA	076	Brazil	Federative Republic of Brazil
+British Indian Ocean Territory	IO	IOT	086	British Indian Ocean Territory	British Indian Ocean Territory
+Brunei Darussalam	BN	BRN	096	Brunei	Brunei Darussalam
+Bulgaria	BG	BGR	100	Bulgaria	Republic of Bulgaria
+Burkina Faso	BF	BFA	854	Burkina Faso	Burkina Faso
+Burundi	BI	BDI	108	Burundi	Republic of Burundi
+Cambodia	KH	KHM	116	Cambodia	Kingdom of Cambodia
+Cameroon	CM	CMR	120	Cameroon	Republic of Cameroon
+Canada	CA	CAN	124	Canada	Canada
+Cabo Verde	CV	CPV	132	Cabo Verde	Republic of Cabo Verde
+Cayman Islands	KY	CYM	136	Cayman Islands	Cayman Islands
+Central African Republic	CF	CAF	140	Central African Republic	Central African Republic
+Chad	TD	TCD	148	Chad	Republic of Chad
+Chile	CL	CHL	152	Chile	Republic of Chile
+China	CN	CHN	156	China	People's Republic of China
+Christmas Island	CX	CXR	162	Christmas Island	Christmas Island
+Cocos (Keeling) Islands	CC	CCK	166	Cocos Islands	Cocos (Keeling) Islands
+Colombia	CO	COL	170	Colombia	Republic of Colombia
+Comoros	KM	COM	174	Comoros	Union of the Comoros
+Congo	CG	COG	178	Republic of the Congo	Republic of the Congo
+Congo, the Democratic Republic of the	CD	COD	180	Democratic Republic of the Congo	Democratic Republic of the Congo
+Cook Islands	CK	COK	184	Cook Islands	Cook Islands
+Costa Rica	CR	CRI	188	Costa Rica	Republic of Costa Rica
+Côte d'Ivoire	CI	CIV	384	Côte d'Ivoire	Republic of Côte d'Ivoire
+Croatia	HR	HRV	191	Croatia	Republic of Croatia
+Cuba	CU	CUB	192	Cuba	Republic of Cuba
+Curaçao	CW	CUW	531	Curaçao	Curaçao
+Cyprus	CY	CYP	196	Cyprus	Republic of Cyprus
+Czech Republic	CZ	CZE	203	Czech Republic	Czech Republic
+Denmark	DK	DNK	208	Denmark	Kingdom of Denmark
+Djibouti	DJ	DJI	262	Djibouti	Republic of Djibouti
+Dominica	DM	DMA	212	Dominica	Commonwealth of Dominica
+Dominican Republic	DO	DOM	214	Dominican Republic	Dominican Republic
+Ecuador	EC	ECU	218	Ecuador	Republic of Ecuador
+Egypt	EG	EGY	818	Egypt	Arab Republic of Egypt
+El Salvador	SV	SLV	222	El Salvador	Republic of El Salvador
+Equatorial Guinea	GQ	GNQ	226	Equatorial Guinea	Republic of Equatorial Guinea
+Eritrea	ER	ERI	232	Eritrea	the State of Eritrea
+Estonia	EE	EST	233	Estonia	Republic of Estonia
+Ethiopia	ET	ETH	231	Ethiopia	Federal Democratic Republic of Ethiopia
+Falkland Islands (Malvinas)	FK	FLK	238	Falkland Islands	Falkland Islands (Malvinas)
+Faroe Islands	FO	FRO	234	Faroe Islands	Faroe Islands
+Fiji	FJ	FJI	242	Fiji	Republic of Fiji
+Finland	FI	FIN	246	Finland	Republic of Finland
+France	FR	FRA	250	France	French Republic
+French Guiana	GF	GUF	254	French Guiana	French Guiana
+French Polynesia	PF	PYF	258	French Polynesia	French Polynesia
+French Southern Territories	TF	ATF	260	French Southern Territories	French Southern Territories
+Gabon	GA	GAB	266	Gabon	Gabonese Republic
+Gambia	GM	GMB	270	Gambia	Republic of the Gambia
+Georgia	GE	GEO	268	Georgia	Georgia
+Germany	DE	DEU	276	Germany	Federal Republic of Germany
+Ghana	GH	GHA	288	Ghana	Republic of Ghana
+Gibraltar	GI	GIB	292	Gibraltar	Gibraltar
+Greece	GR	GRC	300	Greece	Hellenic Republic
+Greenland	GL	GRL	304	Greenland	Greenland
+Grenada	GD	GRD	308	Grenada	Grenada
+Guadeloupe	GP	GLP	312	Guadeloupe	Guadeloupe
+Guam	GU	GUM	316	Guam	Guam
+Guatemala	GT	GTM	320	Guatemala	Republic of Guatemala
+Guernsey	GG	GGY	831	Guernsey	Guernsey
+Guinea	GN	GIN	324	Guinea	Republic of Guinea
+Guinea-Bissau	GW	GNB	624	Guinea-Bissau	Republic of Guinea-Bissau
+Guyana	GY	GUY	328	Guyana	Republic of Guyana
+Haiti	HT	HTI	332	Haiti	Republic of Haiti
+Heard Island and McDonald Islands	HM	HMD	334	Heard Island and McDonald Islands	Heard Island and McDonald Islands
+Holy See (Vatican City State)	VA	VAT	336	Vatican City	Holy See
+Honduras	HN	HND	340	Honduras	Republic of Honduras
+Hong Kong	HK	HKG	344	Hong Kong	Hong Kong Special Administrative Region of China
+Hungary	HU	HUN	348	Hungary	Hungary
+Iceland	IS	ISL	352	Iceland	Republic of Iceland
+India	IN	IND	356	India	Republic of India
+Indonesia	ID	IDN	360	Indonesia	Republic of Indonesia
+Iran, Islamic Republic of	IR	IRN	364	Iran	Islamic Republic of Iran
+Iraq	IQ	IRQ	368	Iraq	Republic of Iraq
+Ireland	IE	IRL	372	Ireland	Ireland
+Isle of Man	IM	IMN	833	Isle of Man	Isle of Man
+Israel	IL	ISR	376	Israel	State of Israel
+Italy	IT	ITA	380	Italy	Italian Republic
+Jamaica	JM	JAM	388	Jamaica	Jamaica
+Japan	JP	JPN	392	Japan	Japan
+Jersey	JE	JEY	832	Jersey	Jersey
+Jordan	JO	JOR	400	Jordan	Hashemite Kingdom of Jordan
+Kazakhstan	KZ	KAZ	398	Kazakhstan	Republic of Kazakhstan
+Kenya	KE	KEN	404	Kenya	Republic of Kenya
+Kiribati	KI	KIR	296	Kiribati	Republic of Kiribati
+Korea, Democratic People's Republic of	KP	PRK	408	North Korea	Democratic People's Republic of Korea
+Korea, Republic of	KR	KOR	410	South Korea	Republic of Korea
+Kuwait	KW	KWT	414	Kuwait	State of Kuwait
+Kyrgyzstan	KG	KGZ	417	Kyrgyzstan	Kyrgyz Republic
+Lao People's Democratic Republic	LA	LAO	418	Laos	Lao People's Democratic Republic
+Latvia	LV	LVA	428	Latvia	Republic of Latvia
+Lebanon	LB	LBN	422	Lebanon	Lebanese Republic
+Lesotho	LS	LSO	426	Lesotho	Kingdom of Lesotho
+Liberia	LR	LBR	430	Liberia	Republic of Liberia
+Libya	LY	LBY	434	Libya	Libya
+Liechtenstein	LI	LIE	438	Liechtenstein	Principality of Liechtenstein
+Lithuania	LT	LTU	440	Lithuania	Republic of Lithuania
+Luxembourg	LU	LUX	442	Luxembourg	Grand Duchy of Luxembourg
+Macao	MO	MAC	446	Macao	Macao Special Administrative Region of China
+Macedonia, the former Yugoslav Republic of	MK	MKD	807	Macedonia	the former Yugoslav Republic of Macedonia
+Madagascar	MG	MDG	450	Madagascar	Republic of Madagascar
+Malawi	MW	MWI	454	Malawi	Republic of Malawi
+Malaysia	MY	MYS	458	Malaysia	Malaysia
+Maldives	MV	MDV	462	Maldives	Republic of Maldives
+Mali	ML	MLI	466	Mali	Republic of Mali
+Malta	MT	MLT	470	Malta	Republic of Malta
+Marshall Islands	MH	MHL	584	Marshall Islands	Republic of the Marshall Islands
+Martinique	MQ	MTQ	474	Martinique	Martinique
+Mauritania	MR	MRT	478	Mauritania	Islamic Republic of Mauritania
+Mauritius	MU	MUS	480	Mauritius	Republic of Mauritius
+Mayotte	YT	MYT	175	Mayotte	Mayotte
+Mexico	MX	MEX	484	Mexico	United Mexican States
+Micronesia, Federated States of	FM	FSM	583	Micronesia	Federated States of Micronesia
+Moldova, Republic of	MD	MDA	498	Moldova	Republic of Moldova
+Monaco	MC	MCO	492	Monaco	Principality of Monaco
+Mongolia	MN	MNG	496	Mongolia	Mongolia
+Montenegro	ME	MNE	499	Montenegro	Montenegro
+Montserrat	MS	MSR	500	Montserrat	Montserrat
+Morocco	MA	MAR	504	Morocco	Kingdom of Morocco
+Mozambique	MZ	MOZ	508	Mozambique	Republic of Mozambique
+Myanmar	MM	MMR	104	Myanmar	Republic of Myanmar
+Namibia	NA	NAM	516	Namibia	Republic of Namibia
+Nauru	NR	NRU	520	Nauru	Republic of Nauru
+Nepal	NP	NPL	524	Nepal	Federal Democratic Republic of Nepal
+Netherlands	NL	NLD	528	Netherlands	Kingdom of the Netherlands
+New Caledonia	NC	NCL	540	New Caledonia	New Caledonia
+New Zealand	NZ	NZL	554	New Zealand	New Zealand
+Nicaragua	NI	NIC	558	Nicaragua	Republic of Nicaragua
+Niger	NE	NER	562	Niger	Republic of the Niger
+Nigeria	NG	NGA	566	Nigeria	Federal Republic of Nigeria
+Niue	NU	NIU	570	Niue	Niue
+Norfolk Island	NF	NFK	574	Norfolk Island	Norfolk Island
+Northern Mariana Islands	MP	MNP	580	Northern Mariana Islands	Commonwealth of the Northern Mariana Islands
+Norway	NO	NOR	578	Norway	Kingdom of Norway
+Oman	OM	OMN	512	Oman	Sultanate of Oman
+Pakistan	PK	PAK	586	Pakistan	Islamic Republic of Pakistan
+Palau	PW	PLW	585	Palau	Republic of Palau
+Palestine, State of	PS	PSE	275	Palestine	State of Palestine
+Panama	PA	PAN	591	Panama	Republic of Panama
+Papua New Guinea	PG	PNG	598	Papua New Guinea	Independent State of Papua New Guinea
+Paraguay	PY	PRY	600	Paraguay	Republic of Paraguay
+Peru	PE	PER	604	Peru	Republic of Peru
+Philippines	PH	PHL	608	Philippines	Republic of the Philippines
+Pitcairn	PN	PCN	612	Pitcairn	Pitcairn
+Poland	PL	POL	616	Poland	Republic of Poland
+Portugal	PT	PRT	620	Portugal	Portuguese Republic
+Puerto Rico	PR	PRI	630	Puerto Rico	Puerto Rico
+Qatar	QA	QAT	634	Qatar	State of Qatar
+Réunion	RE	REU	638	Réunion	Réunion
+Romania	RO	ROU	642	Romania	Romania
+Russian Federation	RU	RUS	643	Russia	Russian Federation
+Rwanda	RW	RWA	646	Rwanda	Rwandese Republic
+Saint Barthélemy	BL	BLM	652	Saint Barthélemy	Saint Barthélemy
+Saint Helena, Ascension and Tristan da Cunha	SH	SHN	654	Saint Helena, Ascension and Tristan da Cunha	Saint Helena, Ascension and Tristan da Cunha
+Saint Kitts and Nevis	KN	KNA	659	Saint Kitts and Nevis	Saint Kitts and Nevis
+Saint Lucia	LC	LCA	662	Saint Lucia	Saint Lucia
+Saint Martin (French part)	MF	MAF	663	Saint Martin	Saint Martin (French part)
+Saint Pierre and Miquelon	PM	SPM	666	Saint Pierre and Miquelon	Saint Pierre and Miquelon
+Saint Vincent and the Grenadines	VC	VCT	670	Saint Vincent and the Grenadines	Saint Vincent and the Grenadines
+Samoa	WS	WSM	882	Samoa	Independent State of Samoa
+San Marino	SM	SMR	674	San Marino	Republic of San Marino
+Sao Tome and Principe	ST	STP	678	Sao Tome and Principe	Democratic Republic of Sao Tome and Principe
+Saudi Arabia	SA	SAU	682	Saudi Arabia	Kingdom of Saudi Arabia
+Senegal	SN	SEN	686	Senegal	Republic of Senegal
+Serbia	RS	SRB	688	Serbia	Republic of Serbia
+Seychelles	SC	SYC	690	Seychelles	Republic of Seychelles
+Sierra Leone	SL	SLE	694	Sierra Leone	Republic of Sierra Leone
+Singapore	SG	SGP	702	Singapore	Republic of Singapore
+Sint Maarten (Dutch part)	SX	SXM	534	Sint Maarten	Sint Maarten (Dutch part)
+Slovakia	SK	SVK	703	Slovakia	Slovak Republic
+Slovenia	SI	SVN	705	Slovenia	Republic of Slovenia
+Solomon Islands	SB	SLB	090	Solomon Islands	Solomon Islands
+Somalia	SO	SOM	706	Somalia	Federal Republic of Somalia
+South Africa	ZA	ZAF	710	South Africa	Republic of South Africa
+South Georgia and the South Sandwich Islands	GS	SGS	239	South Georgia and the South Sandwich Islands	South Georgia and the South Sandwich Islands
+South Sudan	SS	SSD	728	South Sudan	Republic of South Sudan
+Spain	ES	ESP	724	Spain	Kingdom of Spain
+Sri Lanka	LK	LKA	144	Sri Lanka	Democratic Socialist Republic of Sri Lanka
+Sudan	SD	SDN	729	Sudan	Republic of the Sudan
+Suriname	SR	SUR	740	Suriname	Republic of Suriname
+Svalbard and Jan Mayen	SJ	SJM	744	Svalbard and Jan Mayen	Svalbard and Jan Mayen
+Swaziland	SZ	SWZ	748	Swaziland	Kingdom of Swaziland
+Sweden	SE	SWE	752	Sweden	Kingdom of Sweden
+Switzerland	CH	CHE	756	Switzerland	Swiss Confederation
+Syrian Arab Republic	SY	SYR	760	Syria	Syrian Arab Republic
+Taiwan, Province of China	TW	TWN	158	Taiwan	Taiwan, Province of China
+Tajikistan	TJ	TJK	762	Tajikistan	Republic of Tajikistan
+Tanzania, United Republic of	TZ	TZA	834	Tanzania	United Republic of Tanzania
+Thailand	TH	THA	764	Thailand	Kingdom of Thailand
+Timor-Leste	TL	TLS	626	Timor-Leste	Democratic Republic of Timor-Leste
+Togo	TG	TGO	768	Togo	Togolese Republic
+Tokelau	TK	TKL	772	Tokelau	Tokelau
+Tonga	TO	TON	776	Tonga	Kingdom of Tonga
+Trinidad and Tobago	TT	TTO	780	Trinidad and Tobago	Republic of Trinidad and Tobago
+Tunisia	TN	TUN	788	Tunisia	Republic of Tunisia
+Turkey	TR	TUR	792	Turkey	Republic of Turkey
+Turkmenistan	TM	TKM	795	Turkmenistan	Turkmenistan
+Turks and Caicos Islands	TC	TCA	796	Turks and Caicos Islands	Turks and Caicos Islands
+Tuvalu	TV	TUV	798	Tuvalu	Tuvalu
+Uganda	UG	UGA	800	Uganda	Republic of Uganda
+Ukraine	UA	UKR	804	Ukraine	Ukraine
+United Arab Emirates	AE	ARE	784	United Arab Emirates	United Arab Emirates
+United Kingdom	GB	GBR	826	United Kingdom	United Kingdom of Great Britain and Northern Ireland
+United States	US	USA	840	United States	United States of America
+United States Minor Outlying Islands	UM	UMI	581	United States Minor Outlying Islands	United States Minor Outlying Islands
+Uruguay	UY	URY	858	Uruguay	Eastern Republic of Uruguay
+Uzbekistan	UZ	UZB	860	Uzbekistan	Republic of Uzbekistan
+Vanuatu	VU	VUT	548	Vanuatu	Republic of Vanuatu
+Venezuela, Bolivarian Republic of	VE	VEN	862	Venezuela	Bolivarian Republic of Venezuela
+Viet Nam	VN	VNM	704	Vietnam	Socialist Republic of Viet Nam
+Virgin Islands, British	VG	VGB	092	British Virgin Islands	British Virgin Islands
+Virgin Islands, U.S.	VI	VIR	850	U.S. Virgin Islands	Virgin Islands of the United States
+Wallis and Futuna	WF	WLF	876	Wallis and Futuna	Wallis and Futuna
+Western Sahara	EH	ESH	732	Western Sahara	Western Sahara
+Yemen	YE	YEM	887	Yemen	Republic of Yemen
+Zambia	ZM	ZMB	894	Zambia	Republic of Zambia
+Zimbabwe	ZW	ZWE	716	Zimbabwe	Republic of Zimbabwe`
@@ -12,66 +12,261 @@ package country
 import (
 	"encoding/csv"
 	"errors"
+	"fmt"
 	"io"
+	"iter"
+	"log/slog"
 	"net/http"
-	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/language"
 )
 
-// CountryProvider implements the Provider interface.
+// CountryProvider implements the Provider interface. Its data is
+// replaced whole, by swapping a pointer, when it is loaded again or an
+// alias is registered, so searches never see either half done.
 type CountryProvider struct {
-	loaded         bool
+	// Fields names the fields of Country that are kept, for example
+	// []string{"EnglishName"}, so that those that are not needed cost
+	// neither memory nor space in the JSON encoding of the results.
+	// The ISO 3166-1 codes are always kept, and every index is built
+	// as usual. Nil keeps every field. It must be set before Load is
+	// called.
+	Fields  []string
+	data    atomic.Pointer[countryData] // nil until the data is loaded
+	mu      sync.Mutex                  // serializes the loads and RegisterAlias
+	aliases map[string]string           // registered by RegisterAlias
+	// the configuration set by the Options of NewCountryProvider
+	source    string                  // read in place of countrydata, unless it is empty
+	client    *http.Client            // used by LoadRefresh
+	indexes   map[string]bool         // the indexes that are built, or nil for all
+	exactCase bool                    // when searches do not fold case
+	grouped   bool                    // when search results are grouped by key
+	lazy      bool                    // when the first search loads the data
+	logger    *slog.Logger            // of the loads and refreshes, or nil
+	observer  stddata.RefreshObserver // of the refreshes, or nil
+	lazyOnce  sync.Once
+	lazyErr   error
+}
+
+// countryData is the data of a CountryProvider. Once it is stored in
+// the CountryProvider it is not modified.
+type countryData struct {
 	size           int
+	info           stddata.Info
 	countryIndexes map[string]countryIndex
+	// countries holds each Country once. The indexes hold the positions
+	// of the countries in it, rather than copies of them, so that a
+	// Country is not stored once for each of the many indexes.
+	countries []Country
 }
 
 type countryIndex struct {
-	countryMap  map[string][]Country
+	countryIDs  map[string][]int // positions in countries
 	countryKeys []string
+	foldedKeys  []string // the keys in lower case, for stddata.PrefixRange
 }
 
 // Country models one entity.
 type Country struct {
-	EnglishName string
-	Alpha2Code  string
-	Alpha3Code  string
-	NumericCode string
+	EnglishName  string // ISO short name, for example "Korea, Republic of"
+	Alpha2Code   string
+	Alpha3Code   string
+	NumericCode  string
+	CommonName   string // name in everyday use, for example "South Korea"
+	OfficialName string // formal name, for example "Republic of Korea"
+	// Names holds the names in the six official languages of the United
+	// Nations, keyed by ISO 639-1 code: ar, en, es, fr, ru and zh.
+	Names map[string]string
+	// CurrencyCodes holds the ISO 4217 codes of the currencies that
+	// are legal tender in the country.
+	CurrencyCodes []string
+	// DialCodes holds the E.164 calling codes of the country, for
+	// example "+44", or "+1-684" within a shared code.
+	DialCodes []string
+	// TLD is the country code top-level domain, for example ".de".
+	// It is empty for the few countries without a delegated ccTLD.
+	TLD string
+	// Region, Subregion and IntermediateRegion are the UN M49 areas
+	// the country belongs to. Any of them may be empty.
+	Region             Area
+	Subregion          Area
+	IntermediateRegion Area
+	Capital            string
+	FlagEmoji          string // the alpha-2 code as regional indicator symbols
+	// TimeZones holds the IANA time zone identifiers used in the
+	// country, for example "America/Chicago".
+	TimeZones []string
+	// LanguageCodes holds the ISO 639-2 (bibliographic) codes of the
+	// official languages of the country.
+	LanguageCodes []string
+	// Memberships records membership of the EU, the EEA, the Schengen
+	// Area and the OECD, and participation in SEPA and its schemes.
+	Memberships Membership
+	IOCCode     string // International Olympic Committee code, for example "GER"
+	FIFACode    string // FIFA code, for example "NED"
+	// VehicleCode is the distinguishing sign of the country's vehicles
+	// in international traffic, for example "D" for Germany.
+	VehicleCode string
+	// GS1Prefixes holds the GS1 barcode prefixes allocated to the
+	// country, as prefixes or ranges of prefixes, for example "400-440".
+	GS1Prefixes []string
+}
+
+// String returns the codes and the name of c, for example
+// "US/USA United States (840)".
+func (c Country) String() string {
+	return c.Alpha2Code + "/" + c.Alpha3Code + " " + c.EnglishName + " (" + c.NumericCode + ")"
+}
+
+// Format implements fmt.Formatter. The %+v and %#v verbs format all of
+// the fields of the Country, as for any struct, and the other verbs format
+// what String returns, so that %v is short enough for a log line.
+func (c Country) Format(f fmt.State, verb rune) {
+	// the fields of a Country, without its methods, so that they are
+	// formatted as those of any struct.
+	type fields Country
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		s := fmt.Sprintf("%#v", fields(c))
+		io.WriteString(f, "country.Country"+s[strings.Index(s, "{"):])
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "%+v", fields(c))
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), c.String())
+	}
+}
+
+// Area is a UN M49 geographic area, for example 009 Oceania.
+type Area struct {
+	Code string // three digit M49 code
+	Name string
 }
 
 // CountryResult is the interface{} that is returned from Search
 type CountryResult struct {
-	Countries [][]Country
+	// Countries holds the countries that match, in the order of the
+	// keys they match, each with its key. A Country is in it once for
+	// each key it matches: once for each of the time zones that match,
+	// for example.
+	Countries []CountryMatch
+	// Groups holds the countries that match grouped by the keys they
+	// match, in the order of the keys, in place of Countries, when the
+	// CountryProvider is made WithGroupedResults.
+	Groups  [][]Country
+	grouped bool     // whether the result is Groups, for MarshalJSON
+	fields  []string // the Fields of the CountryProvider, for MarshalJSON
+}
+
+// CountryMatch is a Country that matches a search, with the key of the
+// index that it matches, for example "Europe/Berlin" in the tz index.
+type CountryMatch struct {
+	Country
+	Key string
 }
 
-var englishNameMap map[string][]Country
-var alpha2Map map[string][]Country
-var alpha3Map map[string][]Country
-var numericMap map[string][]Country
+// Total returns the number of matches, for stddata.Totaler: the
+// countries of Countries, or the groups of Groups when the result is
+// grouped. Nothing is left out of a CountryResult.
+func (r CountryResult) Total() (total int, truncated bool) {
+	if r.grouped {
+		return len(r.Groups), false
+	}
+	return len(r.Countries), false
+}
+
+// tldExceptions holds the ccTLDs of the countries whose ccTLD is not
+// their alpha-2 code, or which have none delegated in the root zone.
+// The first ccTLD is the one in use.
+var tldExceptions = map[string][]string{
+	"GB": {".uk", ".gb"},
+	"BL": nil,
+	"BQ": nil,
+	"EH": nil,
+	"MF": nil,
+	"UM": nil,
+}
+
+// the languages of namedata, in column order
+var nameLanguages = []string{"ar", "es", "fr", "ru", "zh"}
 
-// Load implements the Loader interface
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
 func (p *CountryProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped,
+// and their line numbers are returned in the LoadReport.
+func (p *CountryProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	start := time.Now()
+	defer func() {
+		stddata.LogLoad(p.logger, "country", p.Info(), r, time.Since(start), err)
+	}()
+	if err := checkFields(p.Fields); err != nil {
+		return r, err
+	}
+	// the aliases registered while the data is loaded must be in it, so
+	// RegisterAlias waits until it is stored.
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	// initialize the maps:
-	p.countryIndexes = make(map[string]countryIndex)
-	englishNameMap = make(map[string][]Country)
-	alpha2Map = make(map[string][]Country)
-	alpha3Map = make(map[string][]Country)
-	numericMap = make(map[string][]Country)
+	d := &countryData{countryIndexes: make(map[string]countryIndex)}
+	englishNameMap := make(map[string][]int)
+	commonNameMap := make(map[string][]int)
+	officialNameMap := make(map[string][]int)
+	alpha2Map := make(map[string][]int)
+	alpha3Map := make(map[string][]int)
+	numericMap := make(map[string][]int)
+	aliasMap := make(map[string][]int)
+	currencyMap := make(map[string][]int)
+	dialCodeMap := make(map[string][]int)
+	tldMap := make(map[string][]int)
+	regionMap := make(map[string][]int)
+	subregionMap := make(map[string][]int)
+	intermediateRegionMap := make(map[string][]int)
+	capitalMap := make(map[string][]int)
+	timeZoneMap := make(map[string][]int)
+	languageMap := make(map[string][]int)
+	memberMap := make(map[string][]int)
+	iocMap := make(map[string][]int)
+	fifaMap := make(map[string][]int)
+	vehicleMap := make(map[string][]int)
+	gs1Map := make(map[string][]int)
+	localNameMaps := make(map[string]map[string][]int)
+	localNameMaps["en"] = make(map[string][]int)
+	for _, lang := range nameLanguages {
+		localNameMaps[lang] = make(map[string][]int)
+	}
 
-	reader := csv.NewReader(countrydata)
+	data := countrydata
+	if p.source != "" {
+		data = p.source
+	}
+	reader := csv.NewReader(strings.NewReader(data))
 	reader.Comma = '\t'
-	reader.FieldsPerRecord = 4
+	reader.FieldsPerRecord = 6
 	reader.TrimLeadingSpace = true
 
+	var countries []Country
 	for {
 		// read just one record, but we could ReadAll() as well
 		record, err := reader.Read()
 		// end-of-file is fitted into err
 		if err == io.EOF {
 			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
 		} else if err != nil {
-			return 0, &stddata.ServiceError{err.Error(), http.StatusServiceUnavailable}
+			return r, stddata.NewSourceError(err.Error(), err)
 		}
 
 		var c Country
@@ -79,27 +274,499 @@ func (p *CountryProvider) Load() (n int, err error) {
 		c.Alpha2Code = record[1]
 		c.Alpha3Code = record[2]
 		c.NumericCode = record[3]
+		c.CommonName = record[4]
+		c.OfficialName = record[5]
+		c.Names = map[string]string{"en": c.EnglishName}
+		c.FlagEmoji = FlagEmoji(c.Alpha2Code)
+		if tlds, found := tldExceptions[c.Alpha2Code]; !found {
+			c.TLD = "." + strings.ToLower(c.Alpha2Code)
+		} else if len(tlds) > 0 {
+			c.TLD = tlds[0]
+		}
+		countries = append(countries, c)
+	}
+
+	// join the supplementary data to the countries
+	byAlpha2 := make(map[string]*Country)
+	for i := range countries {
+		byAlpha2[countries[i].Alpha2Code] = &countries[i]
+	}
+	err = readSupplement(namedata, len(nameLanguages)+1, byAlpha2, func(c *Country, record []string) {
+		for i, lang := range nameLanguages {
+			c.Names[lang] = record[i+1]
+		}
+	})
+	if err != nil {
+		return r, err
+	}
+	err = readSupplement(currencydata, 2, byAlpha2, func(c *Country, record []string) {
+		c.CurrencyCodes = strings.Fields(record[1])
+	})
+	if err != nil {
+		return r, err
+	}
+	err = readSupplement(dialdata, 2, byAlpha2, func(c *Country, record []string) {
+		c.DialCodes = strings.Fields(record[1])
+	})
+	if err != nil {
+		return r, err
+	}
+	err = readSupplement(regiondata, 4, byAlpha2, func(c *Country, record []string) {
+		c.Region = Area{record[1], m49Names[record[1]]}
+		c.Subregion = Area{record[2], m49Names[record[2]]}
+		c.IntermediateRegion = Area{record[3], m49Names[record[3]]}
+	})
+	if err != nil {
+		return r, err
+	}
+	err = readSupplement(capitaldata, 2, byAlpha2, func(c *Country, record []string) {
+		c.Capital = record[1]
+	})
+	if err != nil {
+		return r, err
+	}
+	err = readSupplement(zonedata, 2, byAlpha2, func(c *Country, record []string) {
+		c.TimeZones = strings.Fields(record[1])
+	})
+	if err != nil {
+		return r, err
+	}
+	err = readSupplement(languagedata, 2, byAlpha2, func(c *Country, record []string) {
+		c.LanguageCodes = strings.Fields(record[1])
+	})
+	if err != nil {
+		return r, err
+	}
+	err = readSupplement(memberdata, 2, byAlpha2, func(c *Country, record []string) {
+		c.Memberships = parseMembership(record[1])
+	})
+	if err != nil {
+		return r, err
+	}
+	err = readSupplement(sepadata, 2, byAlpha2, func(c *Country, record []string) {
+		c.Memberships |= parseMembership(record[1])
+	})
+	if err != nil {
+		return r, err
+	}
+	err = readSupplement(sportdata, 3, byAlpha2, func(c *Country, record []string) {
+		c.IOCCode = record[1]
+		c.FIFACode = record[2]
+	})
+	if err != nil {
+		return r, err
+	}
+	err = readSupplement(vehicledata, 2, byAlpha2, func(c *Country, record []string) {
+		c.VehicleCode = record[1]
+	})
+	if err != nil {
+		return r, err
+	}
+	err = readSupplement(gs1data, 2, byAlpha2, func(c *Country, record []string) {
+		c.GS1Prefixes = strings.Fields(record[1])
+	})
+	if err != nil {
+		return r, err
+	}
 
+	for id, c := range countries {
 		// add the Country to the maps
-		englishNameMap[c.EnglishName] = append(englishNameMap[c.EnglishName], c)
-		alpha2Map[c.Alpha2Code] = append(alpha2Map[c.Alpha2Code], c)
-		alpha3Map[c.Alpha3Code] = append(alpha3Map[c.Alpha3Code], c)
-		numericMap[c.NumericCode] = append(numericMap[c.NumericCode], c)
+		englishNameMap[c.EnglishName] = append(englishNameMap[c.EnglishName], id)
+		commonNameMap[c.CommonName] = append(commonNameMap[c.CommonName], id)
+		officialNameMap[c.OfficialName] = append(officialNameMap[c.OfficialName], id)
+		alpha2Map[c.Alpha2Code] = append(alpha2Map[c.Alpha2Code], id)
+		alpha3Map[c.Alpha3Code] = append(alpha3Map[c.Alpha3Code], id)
+		numericMap[c.NumericCode] = append(numericMap[c.NumericCode], id)
+		for _, code := range c.CurrencyCodes {
+			currencyMap[code] = append(currencyMap[code], id)
+		}
+		for _, code := range c.DialCodes {
+			key := dialDigits(code)
+			dialCodeMap[key] = append(dialCodeMap[key], id)
+		}
+		if tlds, found := tldExceptions[c.Alpha2Code]; found {
+			for _, tld := range tlds {
+				tldMap[tld] = append(tldMap[tld], id)
+			}
+		} else {
+			tldMap[c.TLD] = append(tldMap[c.TLD], id)
+		}
+		if c.Capital != "" {
+			capitalMap[c.Capital] = append(capitalMap[c.Capital], id)
+		}
+		for _, tz := range c.TimeZones {
+			timeZoneMap[tz] = append(timeZoneMap[tz], id)
+		}
+		for _, code := range c.LanguageCodes {
+			languageMap[code] = append(languageMap[code], id)
+		}
+		for _, name := range c.Memberships.Names() {
+			memberMap[name] = append(memberMap[name], id)
+		}
+		if c.IOCCode != "" {
+			iocMap[c.IOCCode] = append(iocMap[c.IOCCode], id)
+		}
+		if c.FIFACode != "" {
+			fifaMap[c.FIFACode] = append(fifaMap[c.FIFACode], id)
+		}
+		if c.VehicleCode != "" {
+			vehicleMap[c.VehicleCode] = append(vehicleMap[c.VehicleCode], id)
+		}
+		for _, prefixes := range c.GS1Prefixes {
+			for _, prefix := range expandPrefixes(prefixes) {
+				gs1Map[prefix] = append(gs1Map[prefix], id)
+			}
+		}
+		addArea(regionMap, c.Region, id)
+		addArea(subregionMap, c.Subregion, id)
+		addArea(intermediateRegionMap, c.IntermediateRegion, id)
+		for lang, name := range c.Names {
+			localNameMaps[lang][name] = append(localNameMaps[lang][name], id)
+		}
+	}
+	// add the aliases, both built-in and registered, to the alias map
+	if err := p.loadAliases(aliasMap, alpha2Map); err != nil {
+		return r, err
+	}
+
+	// now that the indexes are built, drop the fields that are not kept
+	if p.Fields != nil {
+		for i := range countries {
+			countries[i] = project(countries[i], p.Fields)
+		}
+	}
+	d.countries = countries
+	p.storeData(d, "name", englishNameMap)
+	p.storeData(d, "common", commonNameMap)
+	p.storeData(d, "official", officialNameMap)
+	p.storeData(d, "alpha2", alpha2Map)
+	p.storeData(d, "alpha3", alpha3Map)
+	p.storeData(d, "number", numericMap)
+	p.storeData(d, "alias", aliasMap)
+	p.storeData(d, "currency", currencyMap)
+	p.storeData(d, "dialcode", dialCodeMap)
+	p.storeData(d, "tld", tldMap)
+	p.storeData(d, "region", regionMap)
+	p.storeData(d, "subregion", subregionMap)
+	p.storeData(d, "intermediate", intermediateRegionMap)
+	p.storeData(d, "capital", capitalMap)
+	p.storeData(d, "tz", timeZoneMap)
+	p.storeData(d, "language", languageMap)
+	p.storeData(d, "member", memberMap)
+	p.storeData(d, "ioc", iocMap)
+	p.storeData(d, "fifa", fifaMap)
+	p.storeData(d, "vehicle", vehicleMap)
+	p.storeData(d, "gs1", gs1Map)
+	for lang, m := range localNameMaps {
+		p.storeData(d, "name_"+lang, m)
+	}
+	d.size = len(englishNameMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(englishNameMap)
+	return r, nil
+}
+
+// FlagEmoji returns the flag emoji of the country with the alpha-2
+// code alpha2: the pair of Unicode regional indicator symbols that
+// spell out the code, which are rendered as a flag, for example 🇺🇸.
+// It returns an empty string if alpha2 is not two letters.
+func FlagEmoji(alpha2 string) string {
+	if len(alpha2) != 2 {
+		return ""
+	}
+	flag := make([]rune, 2)
+	for i, r := range strings.ToUpper(alpha2) {
+		if r < 'A' || r > 'Z' {
+			return ""
+		}
+		flag[i] = r - 'A' + 0x1F1E6
+	}
+	return string(flag)
+}
+
+// M49Area returns the UN M49 area with the three digit code, for
+// example 419 Latin America and the Caribbean, and whether there is
+// such an area. Only the World and the areas that countries belong to
+// are known.
+func M49Area(code string) (a Area, found bool) {
+	name, found := m49Names[code]
+	if !found {
+		return a, false
+	}
+	return Area{code, name}, true
+}
+
+// addArea adds the Country to the map under both the code and the
+// name of the area, unless the area is empty.
+func addArea(m map[string][]int, a Area, id int) {
+	if a.Code == "" {
+		return
+	}
+	m[a.Code] = append(m[a.Code], id)
+	m[a.Name] = append(m[a.Name], id)
+}
+
+// normalizeNumeric zero-pads a numeric code to the three digits
+// used by ISO 3166-1, since many systems strip the leading zeros:
+// "4" and "04" become "004". Anything else is returned unchanged.
+func normalizeNumeric(s string) string {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || n > 999 || strings.HasPrefix(s, "+") {
+		return s
+	}
+	return fmt.Sprintf("%03d", n)
+}
+
+// GetByAlpha2 returns the Country whose ISO 3166-1 alpha-2 code is
+// alpha2, for example "DE" for Germany.
+func (p *CountryProvider) GetByAlpha2(alpha2 string) (c Country, err error) {
+	if err := p.lazyLoad(); err != nil {
+		return c, err
+	}
+	d := p.data.Load()
+	if d == nil {
+		return c, stddata.ErrNotLoaded
+	}
+	return d.lookup("alpha2", alpha2)
+}
 
+// GetByNumeric returns the Country whose ISO 3166-1 numeric code
+// is n, for example 4 for Afghanistan.
+func (p *CountryProvider) GetByNumeric(n int) (c Country, err error) {
+	if err := p.lazyLoad(); err != nil {
+		return c, err
+	}
+	d := p.data.Load()
+	if d == nil {
+		return c, stddata.ErrNotLoaded
 	}
-	p.storeData("name", englishNameMap)
-	p.storeData("alpha2", alpha2Map)
-	p.storeData("alpha3", alpha3Map)
-	p.storeData("number", numericMap)
-	p.size = len(englishNameMap)
-	p.loaded = true
-	return len(englishNameMap), err
+	return d.lookup("number", strconv.Itoa(n))
 }
 
-func (p *CountryProvider) storeData(s string, m map[string][]Country) {
+// GetByAlpha3 returns the Country whose ISO 3166-1 alpha-3 code is
+// alpha3, for example "DEU" for Germany.
+func (p *CountryProvider) GetByAlpha3(alpha3 string) (c Country, err error) {
+	if err := p.lazyLoad(); err != nil {
+		return c, err
+	}
+	d := p.data.Load()
+	if d == nil {
+		return c, stddata.ErrNotLoaded
+	}
+	return d.lookup("alpha3", alpha3)
+}
+
+// dialDigits reduces a calling code, or a typed telephone number
+// prefix, to its digits: "+1-684" becomes "1684".
+func dialDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// domainTLD reduces a domain name to its top-level domain, with a
+// leading dot: "www.example.co.uk" and "uk" both become ".uk".
+func domainTLD(s string) string {
+	s = strings.TrimSuffix(s, ".")
+	return "." + s[strings.LastIndex(s, ".")+1:]
+}
+
+// expandPrefixes expands a range of GS1 prefixes, such as "400-440",
+// to each of the prefixes in it. A single prefix is returned as is.
+func expandPrefixes(s string) []string {
+	i := strings.Index(s, "-")
+	if i < 0 {
+		return []string{s}
+	}
+	first, err1 := strconv.Atoi(s[0:i])
+	last, err2 := strconv.Atoi(s[i+1:])
+	if err1 != nil || err2 != nil {
+		return []string{s}
+	}
+	var prefixes []string
+	for n := first; n <= last; n++ {
+		prefixes = append(prefixes, fmt.Sprintf("%03d", n))
+	}
+	return prefixes
+}
+
+// gs1Prefix reduces a GTIN barcode to its three digit GS1 prefix:
+// "4006381333931" becomes "400". Shorter queries are unchanged, so
+// that "40" still finds the prefixes 400 to 409.
+func gs1Prefix(s string) string {
+	s = dialDigits(s)
+	if len(s) > 3 {
+		return s[0:3]
+	}
+	return s
+}
+
+// readSupplement reads tab-delimited supplementary data, in which the
+// first field of each record is an alpha-2 code, and calls set with
+// each record and the Country it belongs to. Records for countries
+// that have not been loaded are ignored.
+func readSupplement(data string, fields int, countries map[string]*Country, set func(c *Country, record []string)) error {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = fields
+	records, err := reader.ReadAll()
+	if err != nil {
+		return stddata.NewSourceError(err.Error(), err)
+	}
+	for _, record := range records {
+		if c, found := countries[record[0]]; found {
+			set(c, record)
+		}
+	}
+	return nil
+}
+
+// loadAliases populates aliasMap from aliasdata and the aliases
+// registered with RegisterAlias, finding the countries they refer to in
+// alpha2Map. An alias is ignored when the country it refers to has not
+// been loaded.
+func (p *CountryProvider) loadAliases(aliasMap map[string][]int, alpha2Map map[string][]int) error {
+	reader := csv.NewReader(strings.NewReader(aliasdata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 2
+	records, err := reader.ReadAll()
+	if err != nil {
+		return stddata.NewSourceError(err.Error(), err)
+	}
+	for _, record := range records {
+		aliasMap[record[0]] = append(aliasMap[record[0]], alpha2Map[record[1]]...)
+	}
+	for alias, code := range p.aliases {
+		aliasMap[alias] = append(aliasMap[alias], alpha2Map[code]...)
+	}
+	// drop the aliases of countries that have not been loaded
+	for alias, ids := range aliasMap {
+		if len(ids) == 0 {
+			delete(aliasMap, alias)
+		}
+	}
+	return nil
+}
+
+// RegisterAlias adds alias to the alias index, as another name for the
+// country whose alpha-2 code is alpha2. Aliases registered before Load
+// are added when the data is loaded, and they are kept when the data is
+// reloaded. The alias is added to a copy of the alias index, which
+// replaces it, so RegisterAlias may be called while searches are made.
+func (p *CountryProvider) RegisterAlias(alias string, alpha2 string) error {
+	if len(alias) < 1 {
+		return errors.New("Alias must not be empty")
+	}
+	alpha2 = strings.ToUpper(alpha2)
+	// the alias is added to the data as it is, so no load or other
+	// alias may replace it until the alias is added.
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if old := p.data.Load(); old != nil {
+		ids, found := old.countryIndexes["alpha2"].countryIDs[alpha2]
+		if !found {
+			return errors.New("Unknown alpha-2 code " + alpha2)
+		}
+		// the alias index is not built if WithIndexes leaves it out
+		if ci, built := old.countryIndexes["alias"]; built {
+			d := &countryData{size: old.size, info: old.info, countries: old.countries}
+			d.countryIndexes = make(map[string]countryIndex)
+			for name, ci := range old.countryIndexes {
+				d.countryIndexes[name] = ci
+			}
+			// copy the map, and the ids of the alias, so that adding to
+			// them does not write to those of the old data.
+			m := make(map[string][]int, len(ci.countryIDs)+1)
+			for k, v := range ci.countryIDs {
+				m[k] = v
+			}
+			m[alias] = append(append([]int(nil), m[alias]...), ids...)
+			p.storeData(d, "alias", m)
+			p.data.Store(d)
+		}
+	}
+	if p.aliases == nil {
+		p.aliases = make(map[string]string)
+	}
+	p.aliases[alias] = alpha2
+	return nil
+}
+
+// LanguagesOf returns the official languages of the country whose
+// alpha-2 code is alpha2, resolved to Language entities by languages,
+// which must be loaded. Languages that are not known to languages
+// are omitted.
+func (p *CountryProvider) LanguagesOf(alpha2 string, languages *language.LanguageProvider) (l []language.Language, err error) {
+	if err := p.lazyLoad(); err != nil {
+		return nil, err
+	}
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	countries, found := d.get("alpha2", strings.ToUpper(alpha2))
+	if !found {
+		msg := "No country with alpha-2 code " + alpha2
+		return nil, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	for _, code := range countries[0].LanguageCodes {
+		res, err := languages.Search("alpha", code)
+		if err != nil {
+			return nil, err
+		}
+		for _, matches := range res.(language.LanguageResult).Languages {
+			for _, lang := range matches {
+				if lang.Alpha3bibliographic == code {
+					l = append(l, lang)
+				}
+			}
+		}
+	}
+	return l, nil
+}
+
+// get returns the Countries that key is the key of in index, and
+// whether there are any.
+func (d *countryData) get(index string, key string) (countries []Country, found bool) {
+	ids, found := d.countryIndexes[index].countryIDs[key]
+	return countriesOf(d.countries, ids), found
+}
+
+// countriesOf returns the Countries at the positions ids of countries.
+func countriesOf(countries []Country, ids []int) []Country {
+	c := make([]Country, len(ids))
+	for i, id := range ids {
+		c[i] = countries[id]
+	}
+	return c
+}
+
+// Info describes the provenance of the loaded data.
+func (p *CountryProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+func (p *CountryProvider) storeData(d *countryData, s string, m map[string][]int) {
+	// skip the indexes that WithIndexes leaves out
+	if p.indexes != nil && !p.indexes[s] {
+		return
+	}
 	// store the map
 	var ci countryIndex
-	ci.countryMap = m
+	ci.countryIDs = m
 	// extract the keys
 	ci.countryKeys = make([]string, len(m))
 	i := 0
@@ -108,9 +775,64 @@ func (p *CountryProvider) storeData(s string, m map[string][]Country) {
 		i++
 	}
 	// sort the keys
-	sort.Strings(ci.countryKeys)
+	ci.foldedKeys = stddata.SortKeys(ci.countryKeys)
 	// add to countryIndexes
-	p.countryIndexes[s] = ci
+	d.countryIndexes[s] = ci
+}
+
+// indexDescriptions describes the indexes that Load builds, unless
+// WithIndexes selects some of them.
+var indexDescriptions = []stddata.IndexDescription{
+	{Name: "name", Description: "English short name", Example: "Germany"},
+	{Name: "common", Description: "common name", Example: "Bolivia"},
+	{Name: "official", Description: "official name", Example: "Federal Republic"},
+	{Name: "alpha2", Description: "ISO 3166-1 alpha-2 code", Example: "DE"},
+	{Name: "alpha3", Description: "ISO 3166-1 alpha-3 code", Example: "DEU"},
+	{Name: "number", Description: "ISO 3166-1 numeric code, of which leading zeros may be left out", Example: "276"},
+	{Name: "alias", Description: "other name, built in or registered", Example: "Holland"},
+	{Name: "currency", Description: "ISO 4217 code of a currency", Example: "EUR"},
+	{Name: "dialcode", Description: "calling code, of which just the digits are searched", Example: "+49"},
+	{Name: "tld", Description: "ccTLD, or a domain name in it", Example: "www.example.de"},
+	{Name: "region", Description: "name or UN M49 code of the region", Example: "Europe"},
+	{Name: "subregion", Description: "name or UN M49 code of the subregion", Example: "Western Europe"},
+	{Name: "intermediate", Description: "name or UN M49 code of the intermediate region", Example: "Channel Islands"},
+	{Name: "capital", Description: "capital", Example: "Berlin"},
+	{Name: "tz", Description: "IANA time zone", Example: "Europe/Berlin"},
+	{Name: "language", Description: "ISO 639-2 bibliographic code of a language", Example: "ger"},
+	{Name: "member", Description: "organization or area of which the country is a member", Example: "EU"},
+	{Name: "ioc", Description: "IOC code", Example: "GER"},
+	{Name: "fifa", Description: "FIFA code", Example: "GER"},
+	{Name: "vehicle", Description: "international vehicle registration code", Example: "D"},
+	{Name: "gs1", Description: "GS1 prefix, or a barcode that begins with it", Example: "4006381333931"},
+	{Name: "name_ar", Description: "name in Arabic", Example: "ألمانيا"},
+	{Name: "name_en", Description: "name in English", Example: "Germany"},
+	{Name: "name_es", Description: "name in Spanish", Example: "Alemania"},
+	{Name: "name_fr", Description: "name in French", Example: "Allemagne"},
+	{Name: "name_ru", Description: "name in Russian", Example: "Германия"},
+	{Name: "name_zh", Description: "name in Chinese", Example: "德国"},
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister: just those that WithIndexes selects, if it
+// is given.
+func (p *CountryProvider) Indexes() (indexes []stddata.IndexDescription) {
+	for _, d := range indexDescriptions {
+		if p.indexes == nil || p.indexes[d.Name] {
+			indexes = append(indexes, d)
+		}
+	}
+	return indexes
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *CountryProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ci := d.countryIndexes[index]
+	return stddata.Suggest(ci.countryKeys, ci.foldedKeys, query)
 }
 
 // Search returns a collection as an interface{} and error. The collection
@@ -121,42 +843,142 @@ func (p *CountryProvider) storeData(s string, m map[string][]Country) {
 // any matching Countries are returned in the result.
 // Search can also "dump" an index. When the value of query is "_dump", the index specified
 // is used to supply the entire data set, in the order of the index.
+// The dialcode index is keyed by the digits of the calling codes, so the query is
+// reduced to its digits: "+1 684" finds American Samoa, and "+1" finds all of
+// the countries of the North American Numbering Plan. A numeric code that is short
+// of three digits also finds the code it is once zero-padded, ahead of the codes it
+// begins, so "4", "04" and "004" all find Afghanistan first, and "84" finds Belize
+// and then the United States. Likewise, the tld index is
+// keyed by ccTLDs, such as ".uk", and a domain name is reduced to its top-level
+// domain, so "www.example.co.uk" finds the United Kingdom. The gs1 index is keyed by
+// three digit GS1 prefixes, and a barcode is reduced to its prefix, so
+// "4006381333931" finds Germany.
 func (p *CountryProvider) Search(index string, query string) (result interface{}, err error) {
+	res, err := p.search(index, query, p.grouped)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SearchCountries is Search, returning the matches as they are, rather
+// than as a CountryResult in an interface{}. They are not grouped, even
+// when the CountryProvider is made WithGroupedResults.
+func (p *CountryProvider) SearchCountries(index string, query string) ([]CountryMatch, error) {
+	res, err := p.search(index, query, false)
+	if err != nil {
+		return nil, err
+	}
+	return res.Countries, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the countries of each. It yields nothing if the
+// CountryProvider has no such index, or is not loaded.
+func (p *CountryProvider) Range(index string) iter.Seq2[string, []Country] {
+	return func(yield func(string, []Country) bool) {
+		if err := p.lazyLoad(); err != nil {
+			return
+		}
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ci := d.countryIndexes[index]
+		for _, k := range ci.countryKeys {
+			if !yield(k, countriesOf(d.countries, ci.countryIDs[k])) {
+				return
+			}
+		}
+	}
+}
+
+// search is Search, grouping the matches by key if grouped is true.
+func (p *CountryProvider) search(index string, query string, grouped bool) (res CountryResult, err error) {
 	// make sure the data is loaded
-	if p.loaded != true {
-		return nil, errors.New("this should be a 503 Service Unavailable by the time it gets to the client")
+	if err := p.lazyLoad(); err != nil {
+		return res, err
+	}
+	d := p.data.Load()
+	if d == nil {
+		return res, stddata.ErrNotLoaded
 	}
-	ci, found := p.countryIndexes[index]
+	ci, found := d.countryIndexes[index]
 	if !found {
 		// search cannot be performed
-		msg := "No index on " + index
-		return nil, &stddata.ServiceError{msg, http.StatusBadRequest}
+		return res, stddata.NewIndexError(index, d.countryIndexes)
+	}
+	// a query that is reduced to nothing, such as "+" in the dialcode
+	// index, is as empty as one that is empty to begin with
+	query = p.NormalizeQuery(index, query)
+	if len(query) < 1 {
+		return res, stddata.ErrEmptyQuery
+	}
+	res = doSearch(ci, d.countries, query, p.exactCase, grouped)
+	// a numeric code that has lost its leading zeros is found as well
+	// as the codes that it begins. The padded code comes first in the
+	// index, so it is put first.
+	if index == "number" {
+		if padded := normalizeNumeric(query); padded != query {
+			exact := doSearch(ci, d.countries, padded, p.exactCase, grouped)
+			res.Countries = append(exact.Countries, res.Countries...)
+			res.Groups = append(exact.Groups, res.Groups...)
+		}
 	}
-	result = doSearch(ci, query)
-	return result, nil
+	res.fields = p.Fields
+	return res, nil
 }
-func doSearch(ci countryIndex, query string) (res CountryResult) {
+
+// NormalizeQuery returns query as Search searches index for it: the
+// digits of a dial code, the top level domain of a domain name, and
+// the GS1 prefix of a GTIN.
+func (p *CountryProvider) NormalizeQuery(index string, query string) string {
+	if index == "dialcode" && query != "_dump" {
+		query = dialDigits(query)
+	} else if index == "tld" && query != "_dump" {
+		query = domainTLD(query)
+	} else if index == "gs1" && query != "_dump" {
+		query = gs1Prefix(query)
+	}
+	return query
+}
+func doSearch(ci countryIndex, countries []Country, query string, exactCase bool, grouped bool) (res CountryResult) {
 	// the "reserved" query term "_dump" is handled by returning all the
 	// results in the order of the index.
 	dump := query == "_dump"
-	// prepare the response. allocate enough space for the response to be the
-	// entire data set.
-	tmp := make([][]Country, len(ci.countryKeys))
-	// brute force the sorted list of keys, looking for a match to 'query.*'.
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ci.countryKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ci.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
 	// add each match to the result array. The results are added in the
-	// order of the sorted keys, so the results are sorted.
-	i := 0
-	for k := range ci.countryKeys {
-		if dump {
-			tmp[i] = ci.countryMap[ci.countryKeys[k]]
-			i++
-		} else if len(ci.countryKeys[k]) >= len(query) {
-			if strings.EqualFold(query, ci.countryKeys[k][0:len(query)]) {
-				tmp[i] = ci.countryMap[ci.countryKeys[k]]
-				i++
-			}
+	// order of the sorted keys, so the results are sorted. Without case
+	// folding, just the keys that begin with the query in its own case
+	// match, which are among those that begin with it in any case.
+	res.grouped = grouped
+	if grouped {
+		res.Groups = make([][]Country, 0, hi-lo)
+	} else {
+		// a key may be the key of several countries
+		n := 0
+		for k := lo; k < hi; k++ {
+			n += len(ci.countryIDs[ci.countryKeys[k]])
+		}
+		res.Countries = make([]CountryMatch, 0, n)
+	}
+	for k := lo; k < hi; k++ {
+		key := ci.countryKeys[k]
+		if exactCase && !dump && !strings.HasPrefix(key, query) {
+			continue
+		}
+		if grouped {
+			res.Groups = append(res.Groups, countriesOf(countries, ci.countryIDs[key]))
+			continue
+		}
+		for _, id := range ci.countryIDs[key] {
+			res.Countries = append(res.Countries, CountryMatch{countries[id], key})
 		}
 	}
-	res.Countries = tmp[0:i]
 	return res
 }
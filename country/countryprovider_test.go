@@ -2,10 +2,18 @@ package country
 
 // Keep reading: http://golang.org/doc/code.html#Testing
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/language"
+	"github.com/musicbeat/stddata/stddatatest"
 )
 
 var p Provider
@@ -47,8 +55,818 @@ func TestAlpha3Search(t *testing.T) {
 	}
 }
 func TestNumberSearch(t *testing.T) {
-	_, err := p.Search("number", "1")
+	res, err := p.Search("number", "1")
 	if err != nil {
 		t.Fatalf("Err %v\n", err)
 	}
+	c := res.(CountryResult).Countries
+	if len(c) < 10 {
+		t.Fatalf("Expected the numeric codes that begin with 1, got %v\n", c)
+	}
+	for _, m := range c {
+		if !strings.HasPrefix(m.NumericCode, "1") {
+			t.Fatalf("Expected %v to begin with 1\n", m)
+		}
+	}
+}
+func TestLoadMode(t *testing.T) {
+	fmt.Println("Test: CountryProvider.LoadMode")
+	saved := countrydata
+	defer func() { countrydata = saved }()
+	countrydata = "Foo\tFO\tFOO\t998\tFoo\tFoo\nmalformed\nBar\tBA\tBAR\t999\tBar\tBar"
+	cp := new(CountryProvider)
+	if _, err := cp.LoadMode(Strict); err == nil {
+		t.Fatalf("Expected strict LoadMode to fail on line 2\n")
+	}
+	r, err := cp.LoadMode(Lenient)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 2 || r.Skipped != 1 || r.SkippedLines[0] != 2 {
+		t.Fatalf("Expected 2 loaded and line 2 skipped, got %+v\n", r)
+	}
+}
+func TestInfo(t *testing.T) {
+	cp := new(CountryProvider)
+	n, err := cp.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	info := cp.Info()
+	if info.Count != n || info.Source == "" || info.LoadedAt.IsZero() {
+		t.Fatalf("Unexpected Info %+v\n", info)
+	}
+}
+func TestCommonNameSearch(t *testing.T) {
+	res, err := p.Search("common", "South K")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(CountryResult).Countries
+	if len(c) != 1 || c[0].Alpha2Code != "KR" {
+		t.Fatalf("Expected South Korea, got %v\n", c)
+	}
+}
+func TestOfficialNameSearch(t *testing.T) {
+	_, err := p.Search("official", "Republic")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+}
+func TestCleanNames(t *testing.T) {
+	res, err := p.Search("name", "Ireland")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(CountryResult).Countries
+	if len(c) != 1 || c[0].Alpha2Code != "IE" {
+		t.Fatalf("Expected Ireland, got %v\n", c)
+	}
+	for _, name := range []string{"Georgia", "Holy See (Vatican City State)"} {
+		res, _ = p.Search("name", name)
+		if len(res.(CountryResult).Countries) != 1 {
+			t.Fatalf("Expected to find %s\n", name)
+		}
+	}
+}
+func TestAliasSearch(t *testing.T) {
+	for alias, code := range map[string]string{"UK": "GB", "Great Britain": "GB", "South Korea": "KR",
+		"Russia": "RU", "USA": "US", "Ivory Coast": "CI"} {
+		res, err := p.Search("alias", alias)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		c := res.(CountryResult).Countries
+		if len(c) < 1 || c[0].Alpha2Code != code {
+			t.Fatalf("Expected %s to resolve to %s, got %v\n", alias, code, c)
+		}
+	}
+}
+func TestRegisterAlias(t *testing.T) {
+	cp := p.(*CountryProvider)
+	if err := cp.RegisterAlias("Aotearoa", "nz"); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if err := cp.RegisterAlias("Atlantis", "XX"); err == nil {
+		t.Fatalf("Expected an error registering an alias for an unknown code\n")
+	}
+	res, err := p.Search("alias", "Aotearoa")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(CountryResult).Countries
+	if len(c) != 1 || c[0].Alpha2Code != "NZ" {
+		t.Fatalf("Expected New Zealand, got %v\n", c)
+	}
+}
+func TestSearchDuringRegisterAlias(t *testing.T) {
+	cp := new(CountryProvider)
+	if _, err := cp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	// run with -race: the searches must see the alias index before or
+	// after an alias is added, and never during
+	done := make(chan bool)
+	go func() {
+		defer close(done)
+		for i := 0; i < 20; i++ {
+			if err := cp.RegisterAlias(fmt.Sprintf("Zealandia %d", i), "NZ"); err != nil {
+				t.Errorf("Err %v\n", err)
+			}
+			if i == 10 {
+				if _, err := cp.Load(); err != nil {
+					t.Errorf("Err %v\n", err)
+				}
+			}
+		}
+	}()
+	for {
+		select {
+		case <-done:
+			res, err := cp.Search("alias", "Zealandia")
+			if err != nil {
+				t.Fatalf("Err %v\n", err)
+			}
+			if n := len(res.(CountryResult).Countries); n != 20 {
+				t.Fatalf("Expected the 20 aliases, got %d\n", n)
+			}
+			return
+		default:
+		}
+		if _, err := cp.Search("alias", "Holland"); err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+	}
+}
+func TestConcurrentLoad(t *testing.T) {
+	// run with -race: each CountryProvider reads the embedded data with
+	// its own reader
+	errs := make(chan error)
+	for i := 0; i < 2; i++ {
+		go func() {
+			n, err := new(CountryProvider).Load()
+			if err == nil && n != 249 {
+				err = fmt.Errorf("loaded %d", n)
+			}
+			errs <- err
+		}()
+	}
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+	}
+}
+func TestLocalNameSearch(t *testing.T) {
+	for index, name := range map[string]string{"name_fr": "Allemagne", "name_es": "Alemania",
+		"name_ru": "Германия", "name_zh": "德国", "name_ar": "ألمانيا", "name_en": "Germany"} {
+		res, err := p.Search(index, name)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		c := res.(CountryResult).Countries
+		if len(c) != 1 || c[0].Alpha2Code != "DE" || c[0].Names["fr"] != "Allemagne" {
+			t.Fatalf("Expected %s in %s to find Germany, got %v\n", name, index, c)
+		}
+	}
+}
+func TestCurrencySearch(t *testing.T) {
+	res, err := p.Search("currency", "EUR")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(CountryResult).Countries
+	if len(c) < 20 {
+		t.Fatalf("Expected the countries using EUR, got %v\n", c)
+	}
+	res, _ = p.Search("alpha2", "CH")
+	c = res.(CountryResult).Countries
+	if len(c[0].CurrencyCodes) != 1 || c[0].CurrencyCodes[0] != "CHF" {
+		t.Fatalf("Expected CH to use CHF, got %v\n", c[0].CurrencyCodes)
+	}
+}
+func TestDialCodeSearch(t *testing.T) {
+	res, err := p.Search("dialcode", "+1-684")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(CountryResult).Countries
+	if len(c) != 1 || c[0].Alpha2Code != "AS" {
+		t.Fatalf("Expected American Samoa, got %v\n", c)
+	}
+	res, _ = p.Search("dialcode", "+44")
+	c = res.(CountryResult).Countries
+	if len(c) != 4 {
+		t.Fatalf("Expected GB, GG, IM and JE for +44, got %v\n", c)
+	}
+}
+func TestTLDSearch(t *testing.T) {
+	for query, code := range map[string]string{".de": "DE", "us": "US", "www.example.co.uk": "GB", ".gb": "GB"} {
+		res, err := p.Search("tld", query)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		c := res.(CountryResult).Countries
+		if len(c) != 1 || c[0].Alpha2Code != code {
+			t.Fatalf("Expected %s to find %s, got %v\n", query, code, c)
+		}
+	}
+}
+func TestRegionSearch(t *testing.T) {
+	byName, err := p.Search("region", "Oceania")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	byCode, err := p.Search("region", "009")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	n := byName.(CountryResult).Countries
+	c := byCode.(CountryResult).Countries
+	if len(n) != 29 || len(c) != 29 {
+		t.Fatalf("Expected the 29 countries of Oceania, got %v and %v\n", n, c)
+	}
+	res, _ := p.Search("intermediate", "Channel")
+	if len(res.(CountryResult).Countries) != 2 {
+		t.Fatalf("Expected Guernsey and Jersey in the Channel Islands\n")
+	}
+	if _, err := p.Search("subregion", "Western Europe"); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+}
+func TestCapitalSearch(t *testing.T) {
+	for capital, code := range map[string]string{"Canberra": "AU", "ouagadougou": "BF"} {
+		res, err := p.Search("capital", capital)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		c := res.(CountryResult).Countries
+		if len(c) != 1 || c[0].Alpha2Code != code {
+			t.Fatalf("Expected %s to find %s, got %v\n", capital, code, c)
+		}
+	}
+}
+func TestFlagEmoji(t *testing.T) {
+	res, err := p.Search("alpha2", "DE")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if flag := res.(CountryResult).Countries[0].FlagEmoji; flag != "🇩🇪" {
+		t.Fatalf("Expected 🇩🇪, got %s\n", flag)
+	}
+	if flag := FlagEmoji("us"); flag != "🇺🇸" {
+		t.Fatalf("Expected 🇺🇸, got %s\n", flag)
+	}
+	if flag := FlagEmoji("U1"); flag != "" {
+		t.Fatalf("Expected no flag, got %s\n", flag)
+	}
+}
+func TestTimeZoneSearch(t *testing.T) {
+	res, err := p.Search("tz", "America/Chicago")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(CountryResult).Countries
+	if len(c) != 1 || c[0].Alpha2Code != "US" || len(c[0].TimeZones) < 20 {
+		t.Fatalf("Expected the United States, got %v\n", c)
+	}
+}
+func TestLanguageSearch(t *testing.T) {
+	res, err := p.Search("language", "roh")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(CountryResult).Countries
+	if len(c) != 1 || c[0].Alpha2Code != "CH" {
+		t.Fatalf("Expected Switzerland, got %v\n", c)
+	}
+}
+func TestLanguagesOf(t *testing.T) {
+	lp := new(language.LanguageProvider)
+	if _, err := lp.Load(); err != nil {
+		t.Skipf("LanguageProvider did not load: %v\n", err)
+	}
+	l, err := p.(*CountryProvider).LanguagesOf("ch", lp)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(l) != 4 || l[0].EnglishName != "German" {
+		t.Fatalf("Expected the 4 languages of Switzerland, got %v\n", l)
+	}
+}
+func TestMemberSearch(t *testing.T) {
+	for name, expected := range map[string]int{"EU": 27, "EEA": 30, "Schengen": 29, "OECD": 38, "SEPA": 53, "SDD": 49, "Instant": 30} {
+		res, err := p.Search("member", name)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		c := res.(CountryResult).Countries
+		if len(c) != expected {
+			t.Fatalf("Expected %d members of %s, got %v\n", expected, name, c)
+		}
+	}
+	res, _ := p.Search("alpha2", "NO")
+	m := res.(CountryResult).Countries[0].Memberships
+	if m.Has(EU) || !m.Has(EEA|Schengen|OECD|SEPA|SCT|SDD) || m.String() != "EEA Schengen OECD SEPA SCT SDD" {
+		t.Fatalf("Unexpected memberships for Norway: %v\n", m)
+	}
+	res, _ = p.Search("alpha2", "GP")
+	if m = res.(CountryResult).Countries[0].Memberships; m.Has(EU) || !m.Has(SEPA|Instant) {
+		t.Fatalf("Unexpected memberships for Guadeloupe: %v\n", m)
+	}
+}
+func TestNumberNormalization(t *testing.T) {
+	for _, query := range []string{"4", "04", "004"} {
+		res, err := p.Search("number", query)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		c := res.(CountryResult).Countries
+		if len(c) < 1 || c[0].Alpha2Code != "AF" {
+			t.Fatalf("Expected %s to find Afghanistan first, got %v\n", query, c)
+		}
+	}
+	// a short code finds the code it is padded to, and then the codes
+	// that it begins
+	for query, expected := range map[string][]string{"8": {"AL", "UG"}, "84": {"BZ", "US"}, "00": {"AF", "AL"}} {
+		res, _ := p.Search("number", query)
+		c := res.(CountryResult).Countries
+		if len(c) < len(expected) {
+			t.Fatalf("Expected %s to find %v, got %v\n", query, expected, c)
+		}
+		for i, code := range expected {
+			if c[i].Alpha2Code != code {
+				t.Fatalf("Expected %s to find %v, got %v\n", query, expected, c)
+			}
+		}
+	}
+	c, err := p.(*CountryProvider).GetByNumeric(4)
+	if err != nil || c.Alpha2Code != "AF" {
+		t.Fatalf("Expected Afghanistan, got %v %v\n", c, err)
+	}
+	if _, err := p.(*CountryProvider).GetByNumeric(999); err == nil {
+		t.Fatalf("Expected an error for numeric code 999\n")
+	}
+}
+func TestCodeConversion(t *testing.T) {
+	conversions := []struct {
+		f        func(string) (string, error)
+		code     string
+		expected string
+	}{
+		{Alpha2ToAlpha3, "US", "USA"},
+		{Alpha2ToNumeric, "de", "276"},
+		{Alpha3ToAlpha2, "NLD", "NL"},
+		{Alpha3ToNumeric, "AFG", "004"},
+		{NumericToAlpha2, "4", "AF"},
+		{NumericToAlpha3, "840", "USA"},
+	}
+	for _, c := range conversions {
+		converted, err := c.f(c.code)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if converted != c.expected {
+			t.Fatalf("Expected %s to convert to %s, got %s\n", c.code, c.expected, converted)
+		}
+	}
+	_, err := Alpha2ToAlpha3("XX")
+	if uerr, ok := err.(*UnknownCodeError); !ok || uerr.Kind != "alpha2" || uerr.Code != "XX" {
+		t.Fatalf("Expected an UnknownCodeError, got %v\n", err)
+	}
+}
+func TestCodeConversionRetry(t *testing.T) {
+	// a load of the provider behind the conversions that fails is tried
+	// again by the next conversion
+	saved := countrydata
+	defer func() { countrydata = saved }()
+	codes.loaded = false
+	countrydata = "malformed"
+	if _, err := Alpha2ToAlpha3("US"); err == nil {
+		t.Fatalf("Expected the conversion to fail to load\n")
+	}
+	countrydata = saved
+	if converted, err := Alpha2ToAlpha3("US"); err != nil || converted != "USA" {
+		t.Fatalf("Expected a retried load to convert US to USA, got %s and %v\n", converted, err)
+	}
+}
+func TestValidate(t *testing.T) {
+	valid := []struct {
+		f    func(string) bool
+		code string
+		ok   bool
+	}{
+		{IsValidAlpha2, "US", true},
+		{IsValidAlpha2, "us", false},
+		{IsValidAlpha2, "U", false},
+		{IsValidAlpha3, "USA", true},
+		{IsValidAlpha3, "US", false},
+		{IsValidNumeric, "004", true},
+		{IsValidNumeric, "4", false},
+		{IsValidNumeric, "84", false},
+	}
+	for _, v := range valid {
+		if v.f(v.code) != v.ok {
+			t.Fatalf("Expected validity of %q to be %v\n", v.code, v.ok)
+		}
+	}
+	if err := Validate("alpha2", "XX"); err == nil {
+		t.Fatalf("Expected an error for XX\n")
+	}
+	if err := Validate("ioc", "GER"); err == nil {
+		t.Fatalf("Expected an error for an unknown kind\n")
+	}
+}
+func TestSportCodeSearch(t *testing.T) {
+	for _, s := range []struct{ index, code, alpha2 string }{
+		{"ioc", "GER", "DE"}, {"ioc", "NED", "NL"}, {"fifa", "NED", "NL"}, {"fifa", "TAH", "PF"},
+	} {
+		res, err := p.Search(s.index, s.code)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		c := res.(CountryResult).Countries
+		if len(c) != 1 || c[0].Alpha2Code != s.alpha2 {
+			t.Fatalf("Expected %s code %s to find %s, got %v\n", s.index, s.code, s.alpha2, c)
+		}
+	}
+	res, _ := p.Search("alpha2", "GB")
+	if gb := res.(CountryResult).Countries[0]; gb.IOCCode != "GBR" || gb.FIFACode != "" {
+		t.Fatalf("Expected GB to have IOC code GBR and no FIFA code, got %v\n", gb)
+	}
+}
+func TestVehicleAndGS1Search(t *testing.T) {
+	for _, s := range []struct{ index, query, alpha2 string }{
+		{"vehicle", "CDN", "CA"}, {"gs1", "4006381333931", "DE"}, {"gs1", "440", "DE"}, {"gs1", "019", "US"},
+	} {
+		res, err := p.Search(s.index, s.query)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		c := res.(CountryResult).Countries
+		if len(c) != 1 || c[0].Alpha2Code != s.alpha2 {
+			t.Fatalf("Expected %s %s to find %s, got %v\n", s.index, s.query, s.alpha2, c)
+		}
+	}
+	res, _ := p.Search("gs1", "540")
+	if c := res.(CountryResult).Countries; len(c) != 2 {
+		t.Fatalf("Expected Belgium and Luxembourg to share 540, got %v\n", c)
+	}
+}
+func TestLoadRefresh(t *testing.T) {
+	// serve the embedded data, without AX, with a changed numeric code
+	// for DE, and with XK assigned
+	var current []map[string]string
+	for _, c := range p.(*CountryProvider).data.Load().countries {
+		if c.Alpha2Code == "AX" {
+			continue
+		}
+		cc := map[string]string{"alpha_2": c.Alpha2Code, "alpha_3": c.Alpha3Code,
+			"numeric": c.NumericCode, "name": c.EnglishName}
+		if cc["alpha_2"] == "DE" {
+			cc["numeric"] = "999"
+		}
+		current = append(current, cc)
+	}
+	current = append(current, map[string]string{"alpha_2": "XK", "alpha_3": "XKX", "name": "Kosovo"})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"3166-1": current})
+	}))
+	defer ts.Close()
+
+	m := new(Metrics)
+	cp := NewCountryProvider(WithRefreshObserver(m))
+	d, err := cp.LoadRefresh(ts.URL)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(d.Assigned) != 1 || d.Assigned[0].Alpha2Code != "XK" || d.Assigned[0].CommonName != "Kosovo" {
+		t.Fatalf("Expected XK to be assigned, got %v\n", d.Assigned)
+	}
+	if len(d.Withdrawn) != 1 || d.Withdrawn[0].Alpha2Code != "AX" {
+		t.Fatalf("Expected AX to be withdrawn, got %v\n", d.Withdrawn)
+	}
+	if len(d.Changed) != 1 || d.Changed[0].NumericCode != "999" {
+		t.Fatalf("Expected DE to be changed, got %v\n", d.Changed)
+	}
+	res, _ := cp.Search("alpha2", "XK")
+	if len(res.(CountryResult).Countries) != 0 {
+		t.Fatalf("Expected the embedded data to still be searched\n")
+	}
+	var b strings.Builder
+	m.WriteTo(&b)
+	if !strings.Contains(b.String(), `stddata_last_refresh_timestamp_seconds{provider="country"}`) {
+		t.Fatalf("Expected the refresh in the metrics, got\n%s\n", b.String())
+	}
+}
+func TestFields(t *testing.T) {
+	cp := &CountryProvider{Fields: []string{"EnglishName"}}
+	if _, err := cp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	// every index is still searched
+	res, err := cp.Search("name_fr", "Allemagne")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(CountryResult).Countries
+	if len(c) != 1 || c[0].EnglishName != "Germany" || c[0].Alpha3Code != "DEU" || c[0].Names != nil || c[0].Capital != "" {
+		t.Fatalf("Expected Germany with just its codes and English name, got %+v\n", c)
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	expected := `{"Countries":[{"Alpha2Code":"DE","Alpha3Code":"DEU","EnglishName":"Germany","Key":"Allemagne","NumericCode":"276"}]}`
+	if string(j) != expected {
+		t.Fatalf("Expected %s, got %s\n", expected, j)
+	}
+	if _, err := (&CountryProvider{Fields: []string{"Population"}}).Load(); err == nil {
+		t.Fatalf("Expected an unknown field to fail the load\n")
+	}
+}
+func TestSearchCountries(t *testing.T) {
+	c, err := p.(*CountryProvider).SearchCountries("alpha3", "DEU")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(c) != 1 || c[0].Alpha2Code != "DE" {
+		t.Fatalf("Expected DE, got %v\n", c)
+	}
+	if _, err := p.(*CountryProvider).SearchCountries("population", "1"); err == nil {
+		t.Fatalf("Expected a search of an unknown index to fail\n")
+	}
+}
+func TestByCodeAndName(t *testing.T) {
+	var found []Country
+	for _, by := range []func() (Country, error){
+		func() (Country, error) { return ByAlpha2("de") },
+		func() (Country, error) { return ByAlpha3("DEU") },
+		func() (Country, error) { return ByNumeric("276") },
+		func() (Country, error) { return ByName("germany") },
+		func() (Country, error) { return ByName("Federal Republic of Germany") },
+	} {
+		c, err := by()
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		found = append(found, c)
+	}
+	for _, c := range found {
+		if c.Alpha2Code != "DE" {
+			t.Fatalf("Expected DE, got %v\n", c)
+		}
+	}
+	if c, err := ByName("holland"); err != nil || c.Alpha2Code != "NL" {
+		t.Fatalf("Expected NL, got %v and %v\n", c, err)
+	}
+	if _, err := ByName("German"); err == nil {
+		t.Fatalf("Expected a partial name not to be found\n")
+	}
+	if _, err := ByAlpha2("XX"); err == nil {
+		t.Fatalf("Expected XX not to be found\n")
+	}
+}
+func TestOptions(t *testing.T) {
+	cp := NewCountryProvider(
+		WithSource("Foo\tFO\tFOO\t998\tFoo\tFoo\nBar\tBA\tBAR\t999\tBar\tBar"),
+		WithIndexes("name"),
+		WithCaseFolding(false),
+		WithLazyLoad(),
+	)
+	// the first search loads the data
+	res, err := cp.Search("name", "Fo")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if c := res.(CountryResult).Countries; len(c) != 1 || c[0].Alpha2Code != "FO" {
+		t.Fatalf("Expected FO, got %v\n", c)
+	}
+	if res, _ = cp.Search("name", "fo"); len(res.(CountryResult).Countries) != 0 {
+		t.Fatalf("Expected the search not to fold case\n")
+	}
+	if _, err = cp.Search("alpha3", "FOO"); err == nil {
+		t.Fatalf("Expected the alpha3 index not to be built\n")
+	}
+	if c, err := cp.GetByAlpha2("BA"); err != nil || c.EnglishName != "Bar" {
+		t.Fatalf("Expected Bar, got %v and %v\n", c, err)
+	}
+	if err := cp.RegisterAlias("Fooland", "FO"); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+}
+func TestFormat(t *testing.T) {
+	c, err := ByAlpha2("US")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if s := fmt.Sprintf("%v", c); s != "US/USA United States (840)" {
+		t.Fatalf("Expected US/USA United States (840), got %s\n", s)
+	}
+	if s := fmt.Sprintf("[%-30s]", c); s != "[US/USA United States (840)    ]" {
+		t.Fatalf("Expected the width to be kept, got %s\n", s)
+	}
+	if s := fmt.Sprintf("%+v", c); !strings.HasPrefix(s, "{EnglishName:United States Alpha2Code:US") {
+		t.Fatalf("Expected the fields, got %s\n", s)
+	}
+	if s := fmt.Sprintf("%#v", c); !strings.HasPrefix(s, `country.Country{EnglishName:"United States"`) {
+		t.Fatalf("Expected the Go syntax, got %s\n", s)
+	}
+}
+func TestSearchAllocs(t *testing.T) {
+	// a search allocates its result, the interface{} that holds it, and
+	// the lower case of the query. The countries of a grouped result are
+	// copied from their IDs, a group at a time.
+	gp := NewCountryProvider(WithGroupedResults())
+	if _, err := gp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	for _, s := range []stddatatest.Search{{Index: "name", Query: "united"}, {Index: "alpha2", Query: "US"},
+		{Index: "region", Query: "Europe"}, {Index: "dialcode", Query: "+44"}} {
+		if n := stddatatest.AllocsPerSearch(p, s); n > 3 {
+			t.Fatalf("%s %q: Expected at most 3 allocations, got %v\n", s.Index, s.Query, n)
+		}
+		res, _ := gp.Search(s.Index, s.Query)
+		groups := len(res.(CountryResult).Groups)
+		if n := stddatatest.AllocsPerSearch(gp, s); n > float64(3+groups) {
+			t.Fatalf("%s %q: Expected at most %d grouped allocations, got %v\n", s.Index, s.Query, 3+groups, n)
+		}
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(CountryProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(CountryProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "name", Query: "united"}, {Index: "alpha2", Query: "de"}, {Index: "dialcode", Query: "+1 684"}, {Index: "tld", Query: "www.example.co.uk"}})
+}
+func TestGroupedResults(t *testing.T) {
+	gp := NewCountryProvider(WithGroupedResults(), WithIndexes("dialcode"))
+	if _, err := gp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	res, err := gp.Search("dialcode", "+44")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	g := res.(CountryResult).Groups
+	if len(g) != 4 || len(g[0]) != 1 || res.(CountryResult).Countries != nil {
+		t.Fatalf("Expected a group for each of GB, GG, IM and JE, got %v\n", g)
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if !strings.HasPrefix(string(j), `{"Groups":[[{`) {
+		t.Fatalf("Expected the groups to be encoded, got %s\n", j)
+	}
+	res, _ = p.Search("dialcode", "+44")
+	for _, m := range res.(CountryResult).Countries {
+		if !strings.HasPrefix(m.Key, "44") {
+			t.Fatalf("Expected each country to be matched by a key beginning 44, got %v\n", m)
+		}
+	}
+}
+func TestEnvelope(t *testing.T) {
+	e, err := SearchEnvelope(p, "dialcode", "+44")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if e.Query != "44" || e.Total != 4 || e.Truncated {
+		t.Fatalf("Expected the 4 countries of 44, got %+v\n", e)
+	}
+}
+func TestGroupedEnvelope(t *testing.T) {
+	gp := NewCountryProvider(WithGroupedResults())
+	if _, err := gp.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	e, err := SearchEnvelope(gp, "name", "Germ")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if e.Total != 1 || e.Truncated || e.Suggestions != nil {
+		t.Fatalf("Expected the group of Germany, got %+v\n", e)
+	}
+	if e, _ = SearchEnvelope(gp, "dialcode", "+44"); e.Total != 4 {
+		t.Fatalf("Expected the 4 groups of 44, got %+v\n", e)
+	}
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+	ip := NewCountryProvider(WithIndexes("name", "tz"))
+	if d := ip.Indexes(); len(d) != 3 || d[0].Name != "name" || d[1].Name != "alpha2" || d[2].Name != "tz" {
+		t.Fatalf("Expected just the name, alpha2 and tz indexes, got %v\n", d)
+	}
+	s := httptest.NewServer(&Service{Provider: p})
+	defer s.Close()
+	resp, err := http.Get(s.URL + "?_indexes")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	defer resp.Body.Close()
+	var indexes []IndexDescription
+	if err := json.NewDecoder(resp.Body).Decode(&indexes); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(indexes) != len(p.(*CountryProvider).Indexes()) || indexes[0].Example != "Germany" {
+		t.Fatalf("Expected the indexes to be listed, got %v\n", indexes)
+	}
+}
+func TestQueryErrors(t *testing.T) {
+	for _, query := range []string{"", "+", "-"} {
+		if _, err := p.Search("dialcode", query); err != ErrEmptyQuery {
+			t.Fatalf("Expected %q to be an empty query, got %v\n", query, err)
+		}
+	}
+	_, err := p.Search("alpha-2", "DE")
+	ierr, ok := err.(*IndexError)
+	if !ok || !errors.Is(err, ErrUnknownIndex) || ierr.Index != "alpha-2" || ierr.Indexes[0] != "alias" {
+		t.Fatalf("Expected an IndexError, got %v\n", err)
+	}
+	if !strings.Contains(err.Error(), "alpha2, alpha3") {
+		t.Fatalf("Expected the indexes to be named, got %v\n", err)
+	}
+	s := httptest.NewServer(&Service{Provider: p})
+	defer s.Close()
+	resp, err := http.Get(s.URL + "?alpha-2=DE")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest || !strings.Contains(string(body), "alpha2") {
+		t.Fatalf("Expected 400 Bad Request naming the indexes, got %d %s\n", resp.StatusCode, body)
+	}
+}
+func TestSentinelErrors(t *testing.T) {
+	_, err := new(CountryProvider).Search("alpha2", "DE")
+	if !errors.Is(err, ErrNotLoaded) || StatusCode(err) != http.StatusServiceUnavailable {
+		t.Fatalf("Expected ErrNotLoaded, got %v\n", err)
+	}
+	_, err = p.(*CountryProvider).GetByAlpha2("XX")
+	if !errors.Is(err, ErrNoMatch) || StatusCode(err) != http.StatusNotFound {
+		t.Fatalf("Expected ErrNoMatch, got %v\n", err)
+	}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "{")
+	}))
+	defer s.Close()
+	_, err = new(CountryProvider).LoadRefresh(s.URL)
+	var serr *ServiceError
+	if !errors.Is(err, ErrSourceUnavailable) || !errors.As(err, &serr) || serr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("Expected ErrSourceUnavailable, got %v\n", err)
+	}
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected the cause to be wrapped, got %v\n", err)
+	}
+}
+func TestSuggestions(t *testing.T) {
+	_, err := p.Search("alpha-2", "DE")
+	if ierr, ok := err.(*IndexError); !ok || len(ierr.Suggestions) < 1 || ierr.Suggestions[0] != "alpha2" {
+		t.Fatalf("Expected alpha2 to be suggested, got %v\n", err)
+	}
+	if !strings.Contains(err.Error(), "did you mean alpha2") {
+		t.Fatalf("Expected the suggestion in the error, got %v\n", err)
+	}
+	for query, expected := range map[string]string{"Germny": "Germany", "swtizerland": "Switzerland", "Untied King": "United Kingdom"} {
+		e, err := SearchEnvelope(p, "name", query)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if e.Total != 0 || len(e.Suggestions) < 1 || e.Suggestions[0] != expected {
+			t.Fatalf("Expected %s to be suggested for %s, got %v\n", expected, query, e.Suggestions)
+		}
+	}
+	if e, _ := SearchEnvelope(p, "name", "Germ"); e.Total != 1 || e.Suggestions != nil {
+		t.Fatalf("Expected no suggestions when Germany is found, got %v\n", e.Suggestions)
+	}
+	if e, _ := SearchEnvelope(p, "name", "Xyzzy"); e.Suggestions != nil {
+		t.Fatalf("Expected no suggestions for a query like no name, got %v\n", e.Suggestions)
+	}
+}
+func TestRange(t *testing.T) {
+	var keys []string
+	for k, c := range p.(*CountryProvider).Range("alpha2") {
+		if len(c) != 1 || c[0].Alpha2Code != k {
+			t.Fatalf("Expected the country of %s, got %v\n", k, c)
+		}
+		keys = append(keys, k)
+		if k == "AF" {
+			break
+		}
+	}
+	if len(keys) != 3 || keys[0] != "AD" || keys[2] != "AF" {
+		t.Fatalf("Expected AD, AE and AF in order, stopping at AF, got %v\n", keys)
+	}
+	n := 0
+	for range p.(*CountryProvider).Range("alpha-2") {
+		n++
+	}
+	if n != 0 {
+		t.Fatalf("Expected an unknown index to yield nothing, got %d\n", n)
+	}
 }
@@ -0,0 +1,84 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package country
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// codeFields are the fields of Country that are kept whatever the
+// Fields of the CountryProvider, since they identify the country.
+var codeFields = []string{"Alpha2Code", "Alpha3Code", "NumericCode"}
+
+// checkFields returns an error if any of fields is not the name of a
+// field of Country.
+func checkFields(fields []string) error {
+	t := reflect.TypeOf(Country{})
+	for _, f := range fields {
+		if _, found := t.FieldByName(f); !found {
+			return errors.New("No field " + f + " in Country")
+		}
+	}
+	return nil
+}
+
+// project returns a copy of c that has just its codes and fields, the
+// other fields being left zero, so that the memory they refer to, such
+// as that of Names, can be freed.
+func project(c Country, fields []string) (pc Country) {
+	from := reflect.ValueOf(c)
+	to := reflect.ValueOf(&pc).Elem()
+	for _, f := range codeFields {
+		to.FieldByName(f).Set(from.FieldByName(f))
+	}
+	for _, f := range fields {
+		to.FieldByName(f).Set(from.FieldByName(f))
+	}
+	return pc
+}
+
+// MarshalJSON encodes r as {"Countries": [...]}, or, when it is
+// grouped, as {"Groups": [[...]]}. When the CountryProvider does not
+// keep every field, each Country is encoded with just its codes and the
+// fields that are kept, so that the others do not appear as zero values.
+func (r CountryResult) MarshalJSON() ([]byte, error) {
+	if r.grouped {
+		if r.fields == nil {
+			return json.Marshal(struct{ Groups [][]Country }{r.Groups})
+		}
+		groups := make([][]map[string]interface{}, len(r.Groups))
+		for i, matches := range r.Groups {
+			groups[i] = make([]map[string]interface{}, len(matches))
+			for j, c := range matches {
+				groups[i][j] = r.kept(c)
+			}
+		}
+		return json.Marshal(struct{ Groups [][]map[string]interface{} }{groups})
+	}
+	if r.fields == nil {
+		return json.Marshal(struct{ Countries []CountryMatch }{r.Countries})
+	}
+	countries := make([]map[string]interface{}, len(r.Countries))
+	for i, c := range r.Countries {
+		countries[i] = r.kept(c.Country)
+		countries[i]["Key"] = c.Key
+	}
+	return json.Marshal(struct{ Countries []map[string]interface{} }{countries})
+}
+
+// kept returns the codes and the kept fields of c, by their names.
+func (r CountryResult) kept(c Country) map[string]interface{} {
+	v := reflect.ValueOf(c)
+	m := make(map[string]interface{}, len(codeFields)+len(r.fields)+1)
+	for _, f := range codeFields {
+		m[f] = v.FieldByName(f).Interface()
+	}
+	for _, f := range r.fields {
+		m[f] = v.FieldByName(f).Interface()
+	}
+	return m
+}
@@ -0,0 +1,141 @@
+package country
+
+/*
+gs1data holds the GS1 company prefixes, the first three digits of a GTIN
+(EAN/UPC) barcode, allocated to the GS1 member organisations of each
+country. Each line holds the alpha-2 code and the space-separated
+prefixes or prefix ranges, separated by a tab. A range that is shared,
+for example 300-379 by France and Monaco, is listed for each country.
+Prefixes that are not allocated to a country, such as 977 for ISSN and
+978-979 for ISBN, are not listed.
+*/
+var gs1data = `AD	840-849
+AE	629
+AL	530
+AM	485
+AO	606
+AR	778-779
+AT	900-919
+AU	930-939
+AZ	476
+BA	387
+BD	894
+BE	540-549
+BG	380
+BH	608
+BN	623
+BO	777
+BR	789-790
+BY	481
+CA	754-755
+CH	760-769
+CI	618
+CL	780
+CM	617
+CN	680-681 690-699
+CO	770-771
+CR	744
+CU	850
+CY	529
+CZ	859
+DE	400-440
+DK	570-579
+DO	746
+DZ	613
+EC	786
+EE	474
+EG	622
+ES	840-849
+FI	640-649
+FO	570-579
+FR	300-379
+GB	500-509
+GE	486
+GH	603
+GL	570-579
+GR	520-521
+GT	740
+HK	489
+HN	742
+HR	385
+HU	599
+ID	899
+IE	539
+IL	729
+IN	890
+IR	626
+IS	569
+IT	800-839
+JO	625
+JP	450-459 490-499
+KE	616
+KG	470
+KH	884
+KP	867
+KR	880-881
+KW	627
+KZ	487
+LB	528
+LI	760-769
+LK	479
+LT	477
+LU	540-549
+LV	475
+LY	624
+MA	611
+MC	300-379
+MD	484
+ME	389
+MK	531
+MM	883
+MN	865
+MO	958
+MT	535
+MU	609
+MX	750
+MY	955
+NA	631
+NG	615
+NI	743
+NL	870-879
+NO	700-709
+NZ	940-949
+OM	607
+PA	745
+PE	775
+PH	480
+PK	896
+PL	590
+PT	560
+PY	784
+QA	630
+RO	594
+RS	860
+RU	460-469
+RW	632
+SA	628
+SE	730-739
+SG	888
+SI	383
+SK	858
+SM	800-839
+SN	604
+SO	612
+SV	741
+SY	621
+TH	885
+TJ	488
+TM	483
+TN	619
+TR	868-869
+TW	471
+TZ	620
+UA	482
+UG	605
+US	001-019 030-039 060-139
+UY	773
+UZ	478
+VA	800-839
+VE	759
+VN	893
+ZA	600-601`
@@ -0,0 +1,257 @@
+package country
+
+/*
+languagedata holds the official, or de facto national, languages of
+each country, as ISO 639-2 bibliographic codes, in order of
+prevalence. Each line holds the alpha-2 code and the space-separated
+language codes, separated by a tab.
+*/
+var languagedata = `AD	cat
+AE	ara
+AF	per pus
+AG	eng
+AI	eng
+AL	alb
+AM	arm
+AO	por
+AQ	
+AR	spa
+AS	eng smo
+AT	ger
+AU	eng
+AW	dut pap
+AX	swe
+AZ	aze
+BA	bos hrv srp
+BB	eng
+BD	ben
+BE	dut fre ger
+BF	fre
+BG	bul
+BH	ara
+BI	run fre eng
+BJ	fre
+BL	fre
+BM	eng
+BN	may
+BO	spa aym que grn
+BQ	dut pap
+BR	por
+BS	eng
+BT	dzo
+BV	nor
+BW	eng tsn
+BY	bel rus
+BZ	eng
+CA	eng fre
+CC	eng
+CD	fre
+CF	fre sag
+CG	fre
+CH	ger fre ita roh
+CI	fre
+CK	eng rar
+CL	spa
+CM	fre eng
+CN	chi
+CO	spa
+CR	spa
+CU	spa
+CV	por
+CW	dut pap eng
+CX	eng
+CY	gre tur
+CZ	cze
+DE	ger
+DJ	fre ara
+DK	dan
+DM	eng
+DO	spa
+DZ	ara ber
+EC	spa
+EE	est
+EG	ara
+EH	ara
+ER	tir ara eng
+ES	spa cat glg baq
+ET	amh
+FI	fin swe
+FJ	eng fij hin
+FK	eng
+FM	eng
+FO	fao dan
+FR	fre
+GA	fre
+GB	eng
+GD	eng
+GE	geo
+GF	fre
+GG	eng fre
+GH	eng
+GI	eng
+GL	kal
+GM	eng
+GN	fre
+GP	fre
+GQ	spa fre por
+GR	gre
+GS	eng
+GT	spa
+GU	eng cha
+GW	por
+GY	eng
+HK	chi eng
+HM	eng
+HN	spa
+HR	hrv
+HT	fre hat
+HU	hun
+ID	ind
+IE	gle eng
+IL	heb
+IM	eng glv
+IN	hin eng
+IO	eng
+IQ	ara kur
+IR	per
+IS	ice
+IT	ita
+JE	eng fre
+JM	eng
+JO	ara
+JP	jpn
+KE	swa eng
+KG	kir rus
+KH	khm
+KI	eng gil
+KM	ara fre
+KN	eng
+KP	kor
+KR	kor
+KW	ara
+KY	eng
+KZ	kaz rus
+LA	lao
+LB	ara
+LC	eng
+LI	ger
+LK	sin tam
+LR	eng
+LS	sot eng
+LT	lit
+LU	ltz fre ger
+LV	lav
+LY	ara
+MA	ara ber
+MC	fre
+MD	rum
+ME	cnr
+MF	fre
+MG	mlg fre
+MH	mah eng
+MK	mac alb
+ML	fre
+MM	bur
+MN	mon
+MO	chi por
+MP	eng cha
+MQ	fre
+MR	ara
+MS	eng
+MT	mlt eng
+MU	eng fre
+MV	div
+MW	eng nya
+MX	spa
+MY	may
+MZ	por
+NA	eng
+NC	fre
+NE	fre
+NF	eng
+NG	eng
+NI	spa
+NL	dut
+NO	nor nob nno
+NP	nep
+NR	nau eng
+NU	niu eng
+NZ	eng mao
+OM	ara
+PA	spa
+PE	spa que aym
+PF	fre
+PG	eng tpi hmo
+PH	fil eng
+PK	urd eng
+PL	pol
+PM	fre
+PN	eng
+PR	spa eng
+PS	ara
+PT	por
+PW	pau eng
+PY	spa grn
+QA	ara
+RE	fre
+RO	rum
+RS	srp
+RU	rus
+RW	kin fre eng swa
+SA	ara
+SB	eng
+SC	fre eng
+SD	ara eng
+SE	swe
+SG	eng may chi tam
+SH	eng
+SI	slv
+SJ	nor
+SK	slo
+SL	eng
+SM	ita
+SN	fre
+SO	som ara
+SR	dut
+SS	eng
+ST	por
+SV	spa
+SX	dut eng
+SY	ara
+SZ	eng ssw
+TC	eng
+TD	fre ara
+TF	fre
+TG	fre
+TH	tha
+TJ	tgk
+TK	tkl eng
+TL	por tet
+TM	tuk
+TN	ara
+TO	ton eng
+TR	tur
+TT	eng
+TV	tvl eng
+TW	chi
+TZ	swa eng
+UA	ukr
+UG	eng swa
+UM	eng
+US	eng
+UY	spa
+UZ	uzb
+VA	ita lat
+VC	eng
+VE	spa
+VG	eng
+VI	eng
+VN	vie
+VU	bis eng fre
+WF	fre
+WS	smo eng
+YE	ara
+YT	fre
+ZA	afr eng nbl nso sot ssw tso tsn ven xho zul
+ZM	eng
+ZW	eng sna nde`
@@ -0,0 +1,54 @@
+package country
+
+/*
+memberdata holds the memberships of the European Union (EU), the
+European Economic Area (EEA), the Schengen Area and the Organisation
+for Economic Co-operation and Development (OECD), as of 2026. Only
+member states are listed; their overseas territories are not. Each
+line holds the alpha-2 code and the space-separated memberships,
+separated by a tab.
+*/
+var memberdata = `AT	EU EEA Schengen OECD
+AU	OECD
+BE	EU EEA Schengen OECD
+BG	EU EEA Schengen
+CA	OECD
+CH	Schengen OECD
+CL	OECD
+CO	OECD
+CR	OECD
+CY	EU EEA
+CZ	EU EEA Schengen OECD
+DE	EU EEA Schengen OECD
+DK	EU EEA Schengen OECD
+EE	EU EEA Schengen OECD
+ES	EU EEA Schengen OECD
+FI	EU EEA Schengen OECD
+FR	EU EEA Schengen OECD
+GB	OECD
+GR	EU EEA Schengen OECD
+HR	EU EEA Schengen
+HU	EU EEA Schengen OECD
+IE	EU EEA OECD
+IL	OECD
+IS	EEA Schengen OECD
+IT	EU EEA Schengen OECD
+JP	OECD
+KR	OECD
+LI	EEA Schengen
+LT	EU EEA Schengen OECD
+LU	EU EEA Schengen OECD
+LV	EU EEA Schengen OECD
+MT	EU EEA Schengen
+MX	OECD
+NL	EU EEA Schengen OECD
+NO	EEA Schengen OECD
+NZ	OECD
+PL	EU EEA Schengen OECD
+PT	EU EEA Schengen OECD
+RO	EU EEA Schengen
+SE	EU EEA Schengen OECD
+SI	EU EEA Schengen OECD
+SK	EU EEA Schengen OECD
+TR	OECD
+US	OECD`
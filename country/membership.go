@@ -0,0 +1,93 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package country
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Membership is a set of flags recording a country's membership of
+// international organizations and areas.
+type Membership uint
+
+const (
+	EU       Membership = 1 << iota // European Union
+	EEA                             // European Economic Area
+	Schengen                        // Schengen Area
+	OECD                            // Organisation for Economic Co-operation and Development
+	SEPA                            // Single Euro Payments Area
+	SCT                             // SEPA Credit Transfer scheme
+	SDD                             // SEPA Direct Debit (Core) scheme
+	Instant                         // SEPA Instant Credit Transfer (SCT Inst) scheme
+)
+
+// membershipNames holds the names of the flags, which are also the
+// keys of the member index and the values used in memberdata and
+// sepadata.
+var membershipNames = []struct {
+	flag Membership
+	name string
+}{
+	{EU, "EU"},
+	{EEA, "EEA"},
+	{Schengen, "Schengen"},
+	{OECD, "OECD"},
+	{SEPA, "SEPA"},
+	{SCT, "SCT"},
+	{SDD, "SDD"},
+	{Instant, "Instant"},
+}
+
+// parseMembership returns the Membership named by each of the
+// space-separated names in s. Unknown names are ignored.
+func parseMembership(s string) (m Membership) {
+	for _, name := range strings.Fields(s) {
+		for _, mn := range membershipNames {
+			if mn.name == name {
+				m |= mn.flag
+			}
+		}
+	}
+	return m
+}
+
+// Has reports whether m includes all of the memberships in f.
+func (m Membership) Has(f Membership) bool {
+	return m&f == f
+}
+
+// Names returns the names of the memberships in m, for example
+// ["EU", "EEA", "Schengen", "OECD"].
+func (m Membership) Names() []string {
+	names := []string{}
+	for _, mn := range membershipNames {
+		if m.Has(mn.flag) {
+			names = append(names, mn.name)
+		}
+	}
+	return names
+}
+
+// String returns the names of the memberships in m, separated by spaces.
+func (m Membership) String() string {
+	return strings.Join(m.Names(), " ")
+}
+
+// MarshalJSON encodes m as the array of its names, rather than as a number.
+func (m Membership) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Names())
+}
+
+// UnmarshalJSON decodes m from the array of its names, as MarshalJSON
+// encodes it. Unknown names are ignored.
+func (m *Membership) UnmarshalJSON(b []byte) error {
+	var names []string
+	if err := json.Unmarshal(b, &names); err != nil {
+		return err
+	}
+	*m = parseMembership(strings.Join(names, " "))
+	return nil
+}
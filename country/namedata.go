@@ -0,0 +1,259 @@
+package country
+
+/*
+namedata holds the names of the countries in the six official
+languages of the United Nations, other than English, which is
+declared in countrydata. The translations were taken from the
+Debian iso-codes project. Each line holds the alpha-2 code, then
+the Arabic, Spanish, French, Russian and (simplified) Chinese
+names, separated by tabs.
+*/
+var namedata = `AD	أندورا	Andorra	Andorre	Андорра	安道尔
+AE	الإمارات العربيّة المتحدّة	Emiratos Árabes Unidos	Émirats arabes unis	Объединённые Арабские Эмираты	阿联酋
+AF	أفغانستان	Afganistán	Afghanistan	Афганистан	阿富汗
+AG	أنتيغوا و باربودا	Antigua y Barbuda	Antigua-et-Barbuda	Антигуа и Барбуда	安提瓜和巴布达
+AI	أنغويلا	Anguila	Anguilla	Ангвилла	安圭拉
+AL	ألبانيا	Albania	Albanie	Албания	阿尔巴尼亚
+AM	أرمينيا	Armenia	Arménie	Армения	亚美尼亚
+AO	أنغولا	Angola	Angola	Ангола	安哥拉
+AQ	القطب الجنوبي	Antártida	Antarctique	Антарктика	南极洲
+AR	الأرجنتين	Argentina	Argentine	Аргентина	阿根廷
+AS	صاموا الأمريكيّة	Samoa Estadounidense	Samoa américaines	Американские Самоа	美属萨摩亚
+AT	النّمسا	Austria	Autriche	Австрия	奥地利
+AU	أستراليا	Australia	Australie	Австралия	澳大利亚
+AW	أروبا	Aruba	Aruba	Аруба	阿鲁巴
+AX	جزر آلاند	Islas Äland	Åland, Îles	Аландские острова	奥兰群岛
+AZ	أذربيجان	Azerbaiyán	Azerbaïdjan	Азербайджан	阿塞拜疆
+BA	البوسنة و الهرسك	Bosnia y Herzegovina	Bosnie-Herzégovine	Босния и Герцеговина	波斯尼亚和黑塞哥维那
+BB	بربادوس	Barbados	Barbade	Барбадос	巴巴多斯
+BD	بنغلادش	Bangladés	Bangladesh	Бангладеш	孟加拉
+BE	بلجيكا	Bélgica	Belgique	Бельгия	比利时
+BF	بوركينا فاصو	Burquina Faso	Burkina Faso	Буркина-Фасо	布基纳法索
+BG	بلغاريا	Bulgaria	Bulgarie	Болгария	保加利亚
+BH	البحرين	Baréin	Bahreïn	Бахрейн	巴林
+BI	بوروندي	Burundi	Burundi	Бурунди	布隆迪
+BJ	بنين	Benín	Bénin	Бенин	贝宁
+BL	سان بارتليمي	San Bartolomé	Saint-Barthélemy	Сен-Бартельми	圣巴泰勒米岛
+BM	برمودا	Islas Bermudas	Bermudes	Бермуды	百慕大
+BN	بروناي دار السّلام	Brunei Darussalam	Brunéi Darussalam	Бруней Даруссалам	文莱
+BO	جمهورية بوليفيا	Bolivia, Estado plurinacional de	Bolivie, état plurinational de	Боливия	玻利维亚共和国
+BQ	بونير وسانت يوستاتيوس وسابا	Islas BES (Caribe Neerlandés)	Bonaire, Saint-Eustache et Saba	Бонайре, Синт-Эстатиус и Саба	博奈尔、圣尤斯特歇斯岛和萨巴
+BR	البرازيل	Brasil	Brésil	Бразилия	巴西
+BS	جزر البهاما	Bahamas	Bahamas	Багамы	巴哈马
+BT	بوتان	Bután	Bhoutan	Бутан	不丹
+BV	جزيرة بوفي	Isla Bouvet	île Bouvet	Остров Буве	布维群岛
+BW	بوتسوانا	Botsuana	Botswana	Ботсвана	博兹瓦那
+BY	روسيا البيضاء	Bielorrusia	Bélarus	Беларусь	白俄罗斯
+BZ	بيليز	Belice	Belize	Белиз	伯利兹
+CA	كندا	Canadá	Canada	Канада	加拿大
+CC	جزر الكوكوس	Islas Cocos (Keeling)	Cocos (Keeling), Îles	Кокосовые острова	科科斯群岛
+CD	الكونغو، جمهوريّة الكونغو الدّيموقراطيّة	Congo, República Democrática del	République démocratique du Congo	Демократическая Республика Конго	刚果民主共和国
+CF	جمهورية إفريقيّا الوسطى	República Centroafricana	République centrafricaine	Центрально-африканская республика	中非
+CG	الكونغو	Congo	République du Congo	Конго	刚果
+CH	سويسرا	Suiza	Suisse	Швейцария	瑞士
+CI	ساحل العاج	Costa de Marfíl	Côte d'Ivoire	Кот-д'Ивуар	科特迪瓦
+CK	جزر كوك	Islas Cook	îles Cook	Острова Кука	库克群岛
+CL	تشيلي	Chile	Chili	Чили	智利
+CM	الكاميرون	Camerún	Cameroun	Камерун	喀麦隆
+CN	الصّين	China	Chine	Китай	中国
+CO	كولومبيا	Colombia	Colombie	Колумбия	哥伦比亚
+CR	كوستاريكا	Costa Rica	Costa Rica	Коста-Рика	哥斯达黎加
+CU	كوبا	Cuba	Cuba	Куба	古巴
+CV	الرأس الأخضر	Cabo Verde	Cap-Vert	Кабо-Верде	佛得角
+CW	جزر كوراكاو	Curazao	Curaçao	Кюрасао	库拉索
+CX	جزر الكريسماس	Isla de Navidad	Christmas, Île	Остров Рождества	圣诞岛
+CY	قبرص	Chipre	Chypre	Кипр	塞浦路斯
+CZ	التشيك	Chequia	Tchéquie	Чехия	捷克
+DE	ألمانيا	Alemania	Allemagne	Германия	德国
+DJ	جيبوتي	Yibuti	Djibouti	Джибути	吉布提
+DK	الدّنمارك	Dinamarca	Danemark	Дания	丹麦
+DM	دومينيكا	Dominica	Dominique	Доминика	多米尼克
+DO	جمهوريّة الدّومينيكان	República Dominicana	République dominicaine	Доминиканская республика	多米尼加共和国
+DZ	الجزائر	Algeria	Algérie	Алжир	阿尔及利亚
+EC	الإكوادور	Ecuador	Équateur	Эквадор	厄瓜多尔
+EE	إستونيا	Estonia	Estonie	Эстония	爱沙尼亚
+EG	مصر	Egipto	Égypte	Египет	埃及
+EH	الصّحراء الغربيّة	Sahara Occidental	Sahara occidental	Западная Сахара	西撒哈拉
+ER	إريتريا	Eritrea	Érythrée	Эритрея	厄立特里亚
+ES	إسبانيا	España	Espagne	Испания	西班牙
+ET	إثيوبيا	Etiopía	Éthiopie	Эфиопия	埃塞俄比亚
+FI	فنلندا	Finlandia	Finlande	Финляндия	芬兰
+FJ	فيجي	Fiyi	Fidji	Фиджи	斐济
+FK	جزر فولكلاند (مالفيناس)	Islas Falkland (Malvinas)	Malouines, Îles (Falkland)	Фолклендские (Мальвинские) острова	福克兰群岛(马尔维纳斯)
+FM	ميكرونيزيا، ولايات ميكرونيزيا الموحّدة	Micronesia, Estados Federados de	Micronésie, États fédérés de	Федеративные Штаты Микронезии	密克罗尼西亚
+FO	جزر الفارو	Islas Feroe	îles Féroé	Фарерские острова	法罗群岛
+FR	فرنسا	Francia	France	Франция	法国
+GA	الغابون	Gabón	Gabon	Габон	加蓬
+GB	المملكة المتّحدة	Reino Unido	Royaume-Uni	Соединённое Королевство	英国
+GD	غرينادا	Granada	Grenade	Гренада	格林纳达
+GE	جورجيا	Georgia	Géorgie	Грузия	格鲁吉亚
+GF	غيانا الفرنسيّة	Guayana Francesa	Guyane française	Французская Гвиана	法属圭亚那
+GG	جزيرة جويرزني	Guernsey	Guernesey	Гернси	根西岛
+GH	غانا	Ghana	Ghana	Гана	加纳
+GI	جبل طارق	Gibraltar	Gibraltar	Гибралтар	直布罗陀
+GL	غرينلاند	Groenlandia	Groënland	Гренландия	格陵兰
+GM	غامبيا	Gambia	Gambie	Гамбия	冈比亚
+GN	غينيا	Guinea	Guinée	Гвинея	几内亚
+GP	جوادالوبّي	Guadalupe	Guadeloupe	Гваделупа	瓜德罗普
+GQ	غينيا الاستوائيّة	Guinea Ecuatorial	Guinée Équatoriale	Экваториальная Гвинея	赤道几内亚
+GR	اليونان	Grecia	Grèce	Греция	希腊
+GS	جورجيا الجنوبيّة و جزر ساندويتش الجنوبيّة	Islas Georgias del Sur y Sándwich del Sur	Géorgie du Sud et les îles Sandwich du Sud	Южная Джорджия и Южные Сандвичевы острова	南乔治亚岛和南桑德韦奇岛
+GT	غواتيمالا	Guatemala	Guatemala	Гватемала	瓜地马拉
+GU	جوام	Guam	Guam	Гуам	关岛
+GW	غينيا بيساو	Guinea-Bisáu	Guinée-Bissau	Гвинея-Бисау	几内亚比绍
+GY	غويانا	Guyana	Guyana	Гайана	圭亚那
+HK	هونغ كونغ	Hong Kong	Hong Kong	Гонконг	香港
+HM	جزيرة هيرد وجزر مَكْدونالد	Islas Heard y McDonald	îles Heard-et-MacDonald	Остров Херд и острова МакДональд	赫德岛与麦克唐纳群岛
+HN	هندوراس	Honduras	Honduras	Гондурас	洪都拉斯
+HR	كرواتيا	Croacia	Croatie	Хорватия	克罗地亚
+HT	هايتي	Haití	Haïti	Гаити	海地
+HU	المجر (هنغاريا)	Hungría	Hongrie	Венгрия	匈牙利
+ID	إندونيسيا	Indonesia	Indonésie	Индонезия	印度尼西亚
+IE	أيرلندا	Irlanda	Irlande	Ирландия	爱尔兰
+IL	إسرائيل	Israel	Israël	Израиль	以色列
+IM	آيزل أف مان	Isla de Man	Île de Man	Остров Мэн	曼岛
+IN	الهند	India	Inde	Индия	印度
+IO	مقاطعة المحيط الهندي البريطانيّة	Territorio Británico del Océano Índico	Territoire britannique de l'océan Indien	Британская территория Индийского океана	英属印度洋领地
+IQ	العراق	Irak	Irak	Ирак	伊拉克
+IR	إيران، الجمهوريّة الإسلاميّة الإيرانيّة	Irán, República islámica de	Iran, République islamique d'	Иран	伊朗伊斯兰共和国
+IS	آيسلندا	Islandia	Islande	Исландия	冰岛
+IT	إيطاليا	Italia	Italie	Италия	意大利
+JE	جيرسي	Jersey	Jersey	Джерси	泽西岛
+JM	جامايكا	Jamaica	Jamaïque	Ямайка	牙买加
+JO	الأردن	Jordania	Jordanie	Иордания	约旦
+JP	اليابان	Japón	Japon	Япония	日本
+KE	كينيا	Kenia	Kenya	Кения	肯尼亚
+KG	قيرغزستان	Kirguistán	Kirghizistan	Киргизия	吉尔吉斯坦
+KH	كمبوديا	Camboya	Cambodge	Камбоджа	柬埔塞
+KI	كيريباتي	Kiribati	Kiribati	Кирибати	基里巴斯
+KM	جزر القمر	Comores, Islas	Comores	Коморы	科摩罗
+KN	سانت كيتس و نيفس	San Cristóbal y Nieves	Saint-Christophe-et-Niévès	Сент-Китс и Невис	圣基茨和尼维斯
+KP	كوريا، جمهورية كوريا الشّعبيّة الدّيموقراطيّة	Corea, República Democrática Popular de	Corée, République populaire démocratique de	Корейская Народно-Демократическая Республика	朝鲜民主主义人民共和国
+KR	كوريا، جمهوريّة كوريا	Corea, República de	Corée, République de	Республика Корея	大韩民国
+KW	الكويت	Kuwait	Koweït	Кувейт	科威特
+KY	جزر الكيمان	Islas Caimán	îles Caïmans	Каймановы острова	开曼群岛
+KZ	كازاخستان	Kazajistán	Kazakhstan	Казахстан	哈萨克斯坦
+LA	جمهوريّة لاو الدّيموقراطيّة الشّعبيّة	República Democrática Popular de Lao	Lao, République démocratique populaire	Лаосская Народно-Демократическая Республика	老挝人民民主共和国
+LB	لبنان	Líbano	Liban	Ливан	黎巴嫩
+LC	سانت لوسيا	Santa Lucía	Sainte-Lucie	Сент-Люсия	圣路西亚
+LI	ليشتنشتاين	Liechtenstein	Liechtenstein	Лихтенштейн	列支敦士登
+LK	سريلانكا	Sri Lanka	Sri Lanka	Шри-Ланка	斯里兰卡
+LR	ليبيريا	Liberia	Libéria	Либерия	利比里亚
+LS	ليسوتو	Lesoto	Lesotho	Лесото	莱索托
+LT	لثوانيا	Lituania	Lituanie	Литва	立陶宛
+LU	لوكسمبورغ	Luxemburgo	Luxembourg	Люксембург	卢森堡
+LV	لاتفيا	Letonia	Lettonie	Латвия	拉脱维亚
+LY	ليبيا	Libia	Libye	Ливия	利比亚
+MA	المغرب	Marruecos	Maroc	Марокко	摩洛哥
+MC	موناكو	Mónaco	Monaco	Монако	摩纳哥
+MD	جمهورية مولدوفا	Moldavia, República de	Moldova, République de	Республика Молдова	摩尔多瓦共和国
+ME	المنتنيغرو	Montenegro	Monténégro	Черногория	黑山
+MF	سانت مارتين (القطاع الفرنسي)	San Martín (zona francesa)	Saint-Martin (partie française)	Сен-Мартен (Франция)	法属圣马丁
+MG	مدغشقر	Madagascar	Madagascar	Мадагаскар	马达加斯加
+MH	جزر المارشال	Islas Marshall	Îles Marshall	Маршалловы острова	马绍尔群岛
+MK	مقدونيا الشمالية	Macedonia del Norte	Macédoine du Nord	Северная Македония	北马其顿
+ML	مالي	Malí	Mali	Мали	马里
+MM	ميانمار	Birmania	Birmanie	Мьянма	缅甸
+MN	منغوليا	Mongolia	Mongolie	Монголия	蒙古
+MO	مكّاو	Macao	Macau	Макао	澳门
+MP	جزر ماريانا الشّماليّة	Islas Marianas del Norte	Îles Mariannes du Nord	Острова северной Марианы	北马里亚纳群岛
+MQ	مارتينيك	Martinica	Martinique	Мартиника	马提尼克
+MR	موريتانيا	Mauritania	Mauritanie	Мавритания	毛里塔尼亚
+MS	مونتسيرات	Montserrat	Montserrat	Монтсеррат	蒙塞拉特岛
+MT	مالطة	Malta	Malte	Мальта	马尔他
+MU	موريشيوس	Mauricio	Maurice	Маврикий	毛里求斯
+MV	جزر المالديف	Islas Maldivas	Maldives	Мальдивы	马尔代夫
+MW	ملاوي	Malaui	Malawi	Малави	马拉维
+MX	المكسيك	México	Mexique	Мексика	墨西哥
+MY	ماليزيا	Malasia	Malaisie	Малайзия	马来西亚
+MZ	موزمبيق	Mozambique	Mozambique	Мозамбик	莫桑比克
+NA	ناميبيا	Namibia	Namibie	Намибия	纳米比亚
+NC	نيو قلدونيا	Nueva Caledonia	Nouvelle-Calédonie	Новая Каледония	新喀里多尼亚
+NE	النّيجر	Niger	Niger	Нигер	尼日尔
+NF	جزيرة نورفولك	Isla Norfolk	île Norfolk	Остров Норфолк	诺福克岛
+NG	نيجيريا	Nigeria	Nigeria	Нигерия	尼日利亚
+NI	نيكاراجوا	Nicaragua	Nicaragua	Никарагуа	尼加拉瓜
+NL	هولندا	Países Bajos	Pays-Bas	Нидерланды	荷兰
+NO	النّرويج	Noruega	Norvège	Норвегия	挪威
+NP	نيبال	Nepal	Népal	Непал	尼泊尔
+NR	ناورو	Nauru	Nauru	Науру	瑙鲁
+NU	نيوي	Niue	Nioue	Ниуэ	纽埃
+NZ	نيوزيلاندا	Nueva Zelanda	Nouvelle-Zélande	Новая Зеландия	新西兰
+OM	عمان	Omán	Oman	Оман	阿曼
+PA	بنما	Panamá	Panama	Панама	巴拿马
+PE	البيرو	Perú	Pérou	Перу	秘鲁
+PF	بولينيسيا الفرنسيّة	Polinesia Francesa	Polynésie française	Французская Полинезия	法属玻利尼西亚
+PG	بابوا غينيا الجديدة	Papúa Nueva Guinea	Papouasie-Nouvelle-Guinée	Папуа — Новая Гвинея	巴布亚新几内亚
+PH	الفلبّين	Filipinas	Philippines	Филиппины	菲律宾
+PK	باكستان	Pakistán	Pakistan	Пакистан	巴基斯坦
+PL	بولندا	Polonia	Pologne	Польша	波兰
+PM	سانت بيير و ميكيلون	San Pedro y Miquelon	Saint-Pierre-et-Miquelon	Сен-Пьер и Микелон	圣皮埃尔和密克隆
+PN	بتكيرن	Pitcairn	Îles Pitcairn	Питкэрн	皮特克恩
+PR	بورتوريكو	Puerto Rico	Porto Rico	Пуэрто-Рико	波多黎各
+PS	دولة فلسطين	Palestina, Estado de	Palestine, État de	Палестина	巴勒斯坦
+PT	البرتغال	Portugal	Portugal	Португалия	葡萄牙
+PW	بالاو	Palaos	Palaos	Палау	帕劳
+PY	الباراغواي	Paraguay	Paraguay	Парагвай	巴拉圭
+QA	قطر	Catar	Qatar	Катар	卡塔尔
+RE	ريونيون	Reunión	Réunion, Île de la	Реюньон	留尼汪
+RO	رومانيا	Rumanía	Roumanie	Румыния	罗马尼亚
+RS	صربية	Serbia	Serbie	Сербия	塞尔维亚
+RU	الاتّحاد الرّوسي	Federación Rusa	Russie, Fédération de	Российская Федерация	俄罗斯
+RW	رواندا	Ruanda	Rwanda	Руанда	卢旺达
+SA	السّعوديّة	Arabia Saudí	Arabie saoudite	Саудовская Аравия	沙特阿拉伯
+SB	جزر سولومن	Islas Salomón	Salomon, Îles	Соломоновы Острова	所罗门群岛
+SC	السّيشل	Seychelles	Seychelles	Сейшелы	塞舌尔
+SD	السّودان	Sudán	Soudan	Судан	苏丹
+SE	السّويد	Suecia	Suède	Швеция	瑞典
+SG	سنغافورة	Singapur	Singapour	Сингапур	新加坡
+SH	ساينت هيلينا، تريستان دا كونا	Santa Elena, Ascensión y Tristán de Acuña	Sainte-Hélène, Ascension et Tristan da Cunha	Остров Святой Елены, Остров Вознесения и Тристан-да-Кунья	圣赫勒拿-阿森松-特里斯坦达库尼亚
+SI	سلوفينيا	Eslovenia	Slovénie	Словения	斯洛文尼亚
+SJ	سفالبارد و جان ماين	Svalbard y Jan Mayen	Svalbard et île Jan Mayen	Шпицберген и Ян-Майен	斯瓦尔巴特和扬马延岛
+SK	سلوفاكيا	Eslovaquia	Slovaquie	Словакия	斯洛伐克
+SL	سيراليون	Sierra Leona	Sierra Leone	Сьерра-Леоне	塞拉利昂
+SM	سان مارينو	San Marino	Saint-Marin	Сан-Марино	圣马力诺市
+SN	السّنغال	Senegal	Sénégal	Сенегал	塞内加尔
+SO	الصّومال	Somalia	Somalie	Сомали	索马里
+SR	سورينام	Surinám	Surinam	Суринам	苏里南
+SS	جنوب السّودان	Sudán del Sur	Soudan du Sud	Южный Судан	南苏丹
+ST	ساو تومي و برنسبي	Santo Tomé y Príncipe	Sao Tomé-et-Principe	Сан-Томе и Принсипи	圣多美和普林西比
+SV	السّلفادور	El Salvador	Salvador	Сальвадор	萨尔瓦多
+SX	سانت مارتن (الجزء الهولندي)	Isla de San Martín (zona holandsea)	Saint-Martin (partie néerlandaise)	Синт-Мартен (голландская часть)	荷属圣马丁
+SY	الجمهوريّة العربيّة السّوريّة	República árabe de Siria	Syrienne, République arabe	Сирийская Арабская Республика	阿拉伯叙利亚共和国
+SZ	إسواتيني	Esuatini	Eswatini	Эсватини	斯威士兰
+TC	جزر التّرك و الكايكوس	Islas Turcas y Caicos	îles Turques-et-Caïques	Острова Туркс и Каикос	特克斯和凯科斯群岛
+TD	تشاد	Chad	Tchad	Чад	乍得
+TF	المقاطعات الفرنسيّة الجنوبيّة	Territorios Franceses del Sur	Terres australes françaises	Французские южные территории	法属南半球领地
+TG	توغو	Togo	Togo	Того	多哥
+TH	تايلاند	Tailandia	Thaïlande	Таиланд	泰国
+TJ	طاجيكستان	Tayikistán	Tadjikistan	Таджикистан	塔吉克斯坦
+TK	جزر توكيلو	Tokelau	Tokelau	Токелау	托克劳
+TL	تيمور-ليستي	Timor Oriental	Timor oriental	Восточный Тимор	东帝汶
+TM	تركمانستان	Turkmenistán	Turkménistan	Туркменистан	土库曼斯坦
+TN	تونس	Tunez	Tunisie	Тунис	突尼斯
+TO	تونغا	Tonga	Tonga	Тонга	汤加
+TR	تركيا	Turquía	Turquie	Турция	土耳其
+TT	ترينيداد و توباغو	Trinidad y Tobago	Trinité-et-Tobago	Тринидад и Тобаго	特里尼达和多巴哥
+TV	توفالو	Tuvalu	Tuvalu	Тувалу	图瓦卢
+TW	تايوان، محافظة صينيّة	Taiwán, Provincia de China	Taïwan, province de Chine	Китайская провинция Тайвань	中国台湾省
+TZ	تنزانيا، جمهوريّة تنزانيا المتّحدة	Tanzania, República unida de	Tanzanie, République unie de	Танзания	坦桑尼亚
+UA	أوكرانيا	Ucrania	Ukraine	Украина	乌克兰
+UG	أوغندا	Uganda	Ouganda	Уганда	乌干达
+UM	جزر الولايات المتّحدة الصّغرى النّائية	Islas Ultramarinas Menores de Estados Unidos	Îles mineures éloignées des États-Unis	Соединенные штаты Малых Удаленных островов	美国本土外小岛屿
+US	الولايات المتّحدة	Estados Unidos	États-Unis	Соединённые штаты	美国
+UY	الأوروغواي	Uruguay	Uruguay	Уругвай	乌拉圭
+UZ	أوزبكستان	Uzbekistán	Ouzbékistan	Узбекистан	乌兹别克斯坦
+VA	المقعد المقدّس (ولاية مدينة الفاتيكان)	Santa Sede (Ciudad Estado del Vaticano)	Saint-Siège (état de la cité du Vatican)	Государство-город Ватикан	梵地冈
+VC	سانت فنسنت و جزر الغرينادين	San Vicente y las Granadinas	Saint-Vincent-et-les-Grenadines	Сент-Винсент и Гренадины	圣文森特和格林纳丁斯
+VE	جمهورية فنزويلا البوليفارية	Venezuela, República Bolivariana de	Vénézuela, république bolivarienne du	Боливарианская Республика Венесуэла	委内瑞拉玻利瓦尔共和国
+VG	فيرجن، جزر فيرجن البريطانيّة	Islas Vírgenes, Británicas	Îles Vierges britanniques	Виргинские острова (Британия)	英属维尔京群岛
+VI	فيرجن، جزر فيرجن الأميركيّة	Islas Vírgenes, de EEUU	Îles Vierges, États-Unis	Виргинские острова (США)	美属维尔京群岛
+VN	الفييتنام	Vietnam	Viêt Nam	Вьетнам	越南
+VU	فانواتو	Vanuatu	Vanuatu	Вануату	瓦努阿图
+WF	واليس و فوتونا	Wallis y Futuna	Wallis et Futuna	Уоллес и Футана	瓦利斯和富图纳
+WS	صاموا	Samoa	Samoa	Самоа	萨摩亚
+YE	اليمن	Yemen	Yémen	Йемен	也门
+YT	مايوت	Mayotte	Mayotte	Майот	马约特
+ZA	جنوب إفريقيا	Sudáfrica	Afrique du Sud	Южная Африка	南非
+ZM	زامبيا	Zambia	Zambie	Замбия	赞比亚
+ZW	زمبابوي	Zimbabue	Zimbabwe	Зимбабве	津巴布韦`
@@ -0,0 +1,129 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package country
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/musicbeat/stddata"
+)
+
+// An Option configures the CountryProvider returned by
+// NewCountryProvider.
+type Option func(p *CountryProvider)
+
+// NewCountryProvider returns a CountryProvider configured by opts.
+// Without options, it is the same as new(CountryProvider): it must be
+// loaded before it is searched, and it loads the embedded data, with
+// every field and every index, and searches in any case.
+func NewCountryProvider(opts ...Option) *CountryProvider {
+	p := new(CountryProvider)
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// WithSource makes Load read the countries from data, rather than
+// from the embedded data. data is in the format of countrydata.go: a
+// line of tab-separated fields for each country, the English short
+// name, the alpha-2, alpha-3 and numeric codes, the common name and
+// the official name. The supplementary data is still joined to the
+// countries by their alpha-2 codes.
+func WithSource(data string) Option {
+	return func(p *CountryProvider) {
+		p.source = data
+	}
+}
+
+// WithHTTPClient makes LoadRefresh retrieve the current assignments
+// with c, rather than with http.DefaultClient, for example to set a
+// timeout or a proxy.
+func WithHTTPClient(c *http.Client) Option {
+	return func(p *CountryProvider) {
+		p.client = c
+	}
+}
+
+// WithIndexes makes Load build just the named indexes, to save the
+// memory of those that are not searched. The alpha2 index, on which
+// the lookups by code depend, is always built. A search of an index
+// that is not built fails as a search of an unknown index does.
+func WithIndexes(indexes ...string) Option {
+	return func(p *CountryProvider) {
+		p.indexes = map[string]bool{"alpha2": true}
+		for _, index := range indexes {
+			p.indexes[index] = true
+		}
+	}
+}
+
+// WithCaseFolding selects whether searches match keys in any case,
+// as they do by default, or, if fold is false, just in the case of
+// the query: then "Ger" finds Germany, but "ger" does not.
+func WithCaseFolding(fold bool) Option {
+	return func(p *CountryProvider) {
+		p.exactCase = !fold
+	}
+}
+
+// WithLazyLoad makes the CountryProvider load itself when it is first
+// searched, or a country is first looked up in it, rather than
+// needing Load to be called. If that load fails, its error is
+// returned by that and every later search.
+func WithLazyLoad() Option {
+	return func(p *CountryProvider) {
+		p.lazy = true
+	}
+}
+
+// WithGroupedResults makes Search return the countries that match
+// grouped by the keys they match, in the Groups of the CountryResult,
+// rather than in a single list.
+func WithGroupedResults() Option {
+	return func(p *CountryProvider) {
+		p.grouped = true
+	}
+}
+
+// WithFields sets the Fields of the CountryProvider, the fields of
+// Country that are kept.
+func WithFields(fields ...string) Option {
+	return func(p *CountryProvider) {
+		p.Fields = fields
+	}
+}
+
+// WithLogger makes the CountryProvider log its loads and refreshes to
+// l: where the data came from, how many countries were loaded, the
+// records that were skipped, and how the current assignments differ
+// from the embedded data.
+func WithLogger(l *slog.Logger) Option {
+	return func(p *CountryProvider) {
+		p.logger = l
+	}
+}
+
+// WithRefreshObserver makes the CountryProvider tell o of its
+// refreshes by LoadRefresh, so that a stddata.Metrics serves when the
+// assignments were last checked.
+func WithRefreshObserver(o stddata.RefreshObserver) Option {
+	return func(p *CountryProvider) {
+		p.observer = o
+	}
+}
+
+// lazyLoad loads the CountryProvider, once, if it is to be loaded
+// lazily, and returns the error of that load.
+func (p *CountryProvider) lazyLoad() error {
+	if !p.lazy {
+		return nil
+	}
+	p.lazyOnce.Do(func() {
+		_, p.lazyErr = p.Load()
+	})
+	return p.lazyErr
+}
@@ -0,0 +1,144 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package country
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sort"
+
+	"github.com/musicbeat/stddata"
+)
+
+// RefreshURL is the default source of the current ISO 3166-1
+// assignments for LoadRefresh: the ISO 3166-1 file of the Debian
+// iso-codes project, which follows the changes published on the ISO
+// Online Browsing Platform.
+const RefreshURL = "https://salsa.debian.org/iso-codes-team/iso-codes/-/raw/main/data/iso_3166-1.json"
+
+// Diff describes how the current ISO 3166-1 assignments differ from
+// the embedded data. Countries are compared by alpha-2 code, and each
+// list is sorted by alpha-2 code.
+type Diff struct {
+	Assigned  []Country // countries assigned since the embedded data was compiled
+	Withdrawn []Country // countries in the embedded data that are no longer assigned
+	Changed   []Country // countries whose alpha-3 or numeric code has changed, as currently assigned
+}
+
+// Empty reports whether the current assignments match the embedded data.
+func (d Diff) Empty() bool {
+	return len(d.Assigned) == 0 && len(d.Withdrawn) == 0 && len(d.Changed) == 0
+}
+
+// isoCodes is the layout of the iso-codes ISO 3166-1 file.
+type isoCodes struct {
+	Countries []struct {
+		Alpha2       string `json:"alpha_2"`
+		Alpha3       string `json:"alpha_3"`
+		Numeric      string `json:"numeric"`
+		Name         string `json:"name"`
+		CommonName   string `json:"common_name"`
+		OfficialName string `json:"official_name"`
+	} `json:"3166-1"`
+}
+
+// LoadRefresh loads the embedded data, as Load does, then fetches the
+// current ISO 3166-1 assignments from url, in the format of the
+// iso-codes project (see RefreshURL), and reports how they differ from
+// the embedded data. The embedded data is still the data that is
+// searched; the Diff shows when it has gone stale.
+func (p *CountryProvider) LoadRefresh(url string) (d Diff, err error) {
+	done := func(error) {}
+	if p.observer != nil {
+		done = p.observer.ObserveRefresh(context.Background(), "country")
+	}
+	defer func() {
+		p.logRefresh(url, d, err)
+		done(err)
+	}()
+	if _, err = p.Load(); err != nil {
+		return d, err
+	}
+	data := p.data.Load()
+	client := http.DefaultClient
+	if p.client != nil {
+		client = p.client
+	}
+	res, err := client.Get(url)
+	if err != nil {
+		return d, stddata.NewSourceError(err.Error(), err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		msg := "Refresh from " + url + " failed: " + res.Status
+		return d, stddata.NewSourceError(msg, nil)
+	}
+	var current isoCodes
+	if err = json.NewDecoder(res.Body).Decode(&current); err != nil {
+		return d, stddata.NewSourceError(err.Error(), err)
+	}
+
+	assigned := make(map[string]bool)
+	for _, cc := range current.Countries {
+		assigned[cc.Alpha2] = true
+		c := Country{
+			EnglishName:  cc.Name,
+			Alpha2Code:   cc.Alpha2,
+			Alpha3Code:   cc.Alpha3,
+			NumericCode:  cc.Numeric,
+			CommonName:   cc.CommonName,
+			OfficialName: cc.OfficialName,
+		}
+		if c.CommonName == "" {
+			c.CommonName = c.EnglishName
+		}
+		if c.OfficialName == "" {
+			c.OfficialName = c.EnglishName
+		}
+		countries, found := data.get("alpha2", cc.Alpha2)
+		if !found {
+			d.Assigned = append(d.Assigned, c)
+		} else if countries[0].Alpha3Code != c.Alpha3Code || countries[0].NumericCode != c.NumericCode {
+			d.Changed = append(d.Changed, c)
+		}
+	}
+	for _, k := range data.countryIndexes["alpha2"].countryKeys {
+		if !assigned[k] {
+			countries, _ := data.get("alpha2", k)
+			d.Withdrawn = append(d.Withdrawn, countries[0])
+		}
+	}
+	sortByAlpha2(d.Assigned)
+	sortByAlpha2(d.Changed)
+	return d, nil
+}
+
+// logRefresh logs the refresh from url, its Diff d and its error, if
+// the CountryProvider has a logger. A Diff that is not empty is logged
+// as a warning, since the embedded data has gone stale.
+func (p *CountryProvider) logRefresh(url string, d Diff, err error) {
+	if p.logger == nil {
+		return
+	}
+	attrs := []slog.Attr{slog.String("provider", "country"), slog.String("url", url)}
+	if err != nil {
+		p.logger.LogAttrs(context.Background(), slog.LevelError, "stddata refresh", append(attrs, slog.String("error", err.Error()))...)
+		return
+	}
+	level := slog.LevelInfo
+	if !d.Empty() {
+		level = slog.LevelWarn
+	}
+	p.logger.LogAttrs(context.Background(), level, "stddata refresh", append(attrs,
+		slog.Int("assigned", len(d.Assigned)), slog.Int("withdrawn", len(d.Withdrawn)), slog.Int("changed", len(d.Changed)))...)
+}
+
+func sortByAlpha2(countries []Country) {
+	sort.Slice(countries, func(i, j int) bool {
+		return countries[i].Alpha2Code < countries[j].Alpha2Code
+	})
+}
@@ -0,0 +1,296 @@
+package country
+
+// m49Names holds the names of the UN M49 areas used in regiondata,
+// and of the World, which contains them all.
+var m49Names = map[string]string{
+	"001": "World",
+	"002": "Africa",
+	"005": "South America",
+	"009": "Oceania",
+	"011": "Western Africa",
+	"013": "Central America",
+	"014": "Eastern Africa",
+	"015": "Northern Africa",
+	"017": "Middle Africa",
+	"018": "Southern Africa",
+	"019": "Americas",
+	"021": "Northern America",
+	"029": "Caribbean",
+	"030": "Eastern Asia",
+	"034": "Southern Asia",
+	"035": "South-eastern Asia",
+	"039": "Southern Europe",
+	"053": "Australia and New Zealand",
+	"054": "Melanesia",
+	"057": "Micronesia",
+	"061": "Polynesia",
+	"142": "Asia",
+	"143": "Central Asia",
+	"145": "Western Asia",
+	"150": "Europe",
+	"151": "Eastern Europe",
+	"154": "Northern Europe",
+	"155": "Western Europe",
+	"202": "Sub-Saharan Africa",
+	"419": "Latin America and the Caribbean",
+	"830": "Channel Islands",
+}
+
+/*
+regiondata holds the UN M49 geographic regions of each country, from
+the UN Statistics Division's "Standard country or area codes for
+statistical use". Each line holds the alpha-2 code, then the codes of
+the region, the subregion and the intermediate region, separated by
+tabs. Intermediate regions are only defined for some subregions, and
+Antarctica belongs to no region. M49 does not list Taiwan, which is
+placed in Eastern Asia here.
+*/
+var regiondata = `AD	150	039	
+AE	142	145	
+AF	142	034	
+AG	019	419	029
+AI	019	419	029
+AL	150	039	
+AM	142	145	
+AO	002	202	017
+AQ			
+AR	019	419	005
+AS	009	061	
+AT	150	155	
+AU	009	053	
+AW	019	419	029
+AX	150	154	
+AZ	142	145	
+BA	150	039	
+BB	019	419	029
+BD	142	034	
+BE	150	155	
+BF	002	202	011
+BG	150	151	
+BH	142	145	
+BI	002	202	014
+BJ	002	202	011
+BL	019	419	029
+BM	019	021	
+BN	142	035	
+BO	019	419	005
+BQ	019	419	029
+BR	019	419	005
+BS	019	419	029
+BT	142	034	
+BV	019	419	005
+BW	002	202	018
+BY	150	151	
+BZ	019	419	013
+CA	019	021	
+CC	009	053	
+CD	002	202	017
+CF	002	202	017
+CG	002	202	017
+CH	150	155	
+CI	002	202	011
+CK	009	061	
+CL	019	419	005
+CM	002	202	017
+CN	142	030	
+CO	019	419	005
+CR	019	419	013
+CU	019	419	029
+CV	002	202	011
+CW	019	419	029
+CX	009	053	
+CY	142	145	
+CZ	150	151	
+DE	150	155	
+DJ	002	202	014
+DK	150	154	
+DM	019	419	029
+DO	019	419	029
+DZ	002	015	
+EC	019	419	005
+EE	150	154	
+EG	002	015	
+EH	002	015	
+ER	002	202	014
+ES	150	039	
+ET	002	202	014
+FI	150	154	
+FJ	009	054	
+FK	019	419	005
+FM	009	057	
+FO	150	154	
+FR	150	155	
+GA	002	202	017
+GB	150	154	
+GD	019	419	029
+GE	142	145	
+GF	019	419	005
+GG	150	154	830
+GH	002	202	011
+GI	150	039	
+GL	019	021	
+GM	002	202	011
+GN	002	202	011
+GP	019	419	029
+GQ	002	202	017
+GR	150	039	
+GS	019	419	005
+GT	019	419	013
+GU	009	057	
+GW	002	202	011
+GY	019	419	005
+HK	142	030	
+HM	009	053	
+HN	019	419	013
+HR	150	039	
+HT	019	419	029
+HU	150	151	
+ID	142	035	
+IE	150	154	
+IL	142	145	
+IM	150	154	
+IN	142	034	
+IO	002	202	014
+IQ	142	145	
+IR	142	034	
+IS	150	154	
+IT	150	039	
+JE	150	154	830
+JM	019	419	029
+JO	142	145	
+JP	142	030	
+KE	002	202	014
+KG	142	143	
+KH	142	035	
+KI	009	057	
+KM	002	202	014
+KN	019	419	029
+KP	142	030	
+KR	142	030	
+KW	142	145	
+KY	019	419	029
+KZ	142	143	
+LA	142	035	
+LB	142	145	
+LC	019	419	029
+LI	150	155	
+LK	142	034	
+LR	002	202	011
+LS	002	202	018
+LT	150	154	
+LU	150	155	
+LV	150	154	
+LY	002	015	
+MA	002	015	
+MC	150	155	
+MD	150	151	
+ME	150	039	
+MF	019	419	029
+MG	002	202	014
+MH	009	057	
+MK	150	039	
+ML	002	202	011
+MM	142	035	
+MN	142	030	
+MO	142	030	
+MP	009	057	
+MQ	019	419	029
+MR	002	202	011
+MS	019	419	029
+MT	150	039	
+MU	002	202	014
+MV	142	034	
+MW	002	202	014
+MX	019	419	013
+MY	142	035	
+MZ	002	202	014
+NA	002	202	018
+NC	009	054	
+NE	002	202	011
+NF	009	053	
+NG	002	202	011
+NI	019	419	013
+NL	150	155	
+NO	150	154	
+NP	142	034	
+NR	009	057	
+NU	009	061	
+NZ	009	053	
+OM	142	145	
+PA	019	419	013
+PE	019	419	005
+PF	009	061	
+PG	009	054	
+PH	142	035	
+PK	142	034	
+PL	150	151	
+PM	019	021	
+PN	009	061	
+PR	019	419	029
+PS	142	145	
+PT	150	039	
+PW	009	057	
+PY	019	419	005
+QA	142	145	
+RE	002	202	014
+RO	150	151	
+RS	150	039	
+RU	150	151	
+RW	002	202	014
+SA	142	145	
+SB	009	054	
+SC	002	202	014
+SD	002	015	
+SE	150	154	
+SG	142	035	
+SH	002	202	011
+SI	150	039	
+SJ	150	154	
+SK	150	151	
+SL	002	202	011
+SM	150	039	
+SN	002	202	011
+SO	002	202	014
+SR	019	419	005
+SS	002	202	014
+ST	002	202	017
+SV	019	419	013
+SX	019	419	029
+SY	142	145	
+SZ	002	202	018
+TC	019	419	029
+TD	002	202	017
+TF	002	202	014
+TG	002	202	011
+TH	142	035	
+TJ	142	143	
+TK	009	061	
+TL	142	035	
+TM	142	143	
+TN	002	015	
+TO	009	061	
+TR	142	145	
+TT	019	419	029
+TV	009	061	
+TW	142	030	
+TZ	002	202	014
+UA	150	151	
+UG	002	202	014
+UM	009	057	
+US	019	021	
+UY	019	419	005
+UZ	142	143	
+VA	150	039	
+VC	019	419	029
+VE	019	419	005
+VG	019	419	029
+VI	019	419	029
+VN	142	035	
+VU	009	054	
+WF	009	061	
+WS	009	061	
+YE	142	145	
+YT	002	202	014
+ZA	002	202	018
+ZM	002	202	014
+ZW	002	202	014`
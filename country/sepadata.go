@@ -0,0 +1,69 @@
+package country
+
+/*
+sepadata holds the participation in the Single Euro Payments Area
+(SEPA), as of 2026. SEPA is the geographical scope of the European
+Payments Council's schemes; SCT and SDD are the SEPA Credit Transfer
+and SEPA Direct Debit (Core) schemes, flagged where the payment
+service providers of the country adhere to them; Instant is the SEPA
+Instant Credit Transfer (SCT Inst) scheme, flagged where reachability
+is required by the EU Instant Payments Regulation, in the euro area.
+Overseas territories that are part of SEPA are listed, as their own
+ISO 3166-1 codes are used in IBANs and BICs. Countries that joined the
+geographical scope recently, whose providers have yet to adhere to the
+schemes, are flagged SEPA only. Each line holds the alpha-2 code and
+the space-separated memberships, separated by a tab.
+*/
+var sepadata = `AD	SEPA SCT SDD
+AL	SEPA
+AT	SEPA SCT SDD Instant
+AX	SEPA SCT SDD Instant
+BE	SEPA SCT SDD Instant
+BG	SEPA SCT SDD Instant
+BL	SEPA SCT SDD Instant
+CH	SEPA SCT SDD
+CY	SEPA SCT SDD Instant
+CZ	SEPA SCT SDD
+DE	SEPA SCT SDD Instant
+DK	SEPA SCT SDD
+EE	SEPA SCT SDD Instant
+ES	SEPA SCT SDD Instant
+FI	SEPA SCT SDD Instant
+FR	SEPA SCT SDD Instant
+GB	SEPA SCT SDD
+GF	SEPA SCT SDD Instant
+GG	SEPA SCT SDD
+GI	SEPA SCT SDD
+GP	SEPA SCT SDD Instant
+GR	SEPA SCT SDD Instant
+HR	SEPA SCT SDD Instant
+HU	SEPA SCT SDD
+IE	SEPA SCT SDD Instant
+IM	SEPA SCT SDD
+IS	SEPA SCT SDD
+IT	SEPA SCT SDD Instant
+JE	SEPA SCT SDD
+LI	SEPA SCT SDD
+LT	SEPA SCT SDD Instant
+LU	SEPA SCT SDD Instant
+LV	SEPA SCT SDD Instant
+MC	SEPA SCT SDD
+MD	SEPA
+ME	SEPA
+MF	SEPA SCT SDD Instant
+MK	SEPA
+MQ	SEPA SCT SDD Instant
+MT	SEPA SCT SDD Instant
+NL	SEPA SCT SDD Instant
+NO	SEPA SCT SDD
+PL	SEPA SCT SDD
+PM	SEPA SCT SDD Instant
+PT	SEPA SCT SDD Instant
+RE	SEPA SCT SDD Instant
+RO	SEPA SCT SDD
+SE	SEPA SCT SDD
+SI	SEPA SCT SDD Instant
+SK	SEPA SCT SDD Instant
+SM	SEPA SCT SDD
+VA	SEPA SCT SDD
+YT	SEPA SCT SDD Instant`
@@ -0,0 +1,225 @@
+package country
+
+/*
+sportdata holds the country codes of the International Olympic
+Committee (IOC) and of FIFA, as of 2026. Many of them differ from the
+ISO 3166-1 alpha-3 code, for example GER and NED. Each line holds the
+alpha-2 code, the IOC code and the FIFA code, separated by tabs. A code
+is empty where the country has no National Olympic Committee or is not
+a FIFA member; the United Kingdom is represented in FIFA by England,
+Scotland, Wales and Northern Ireland, which have no ISO 3166-1 codes.
+*/
+var sportdata = `AD	AND	AND
+AE	UAE	UAE
+AF	AFG	AFG
+AG	ANT	ATG
+AI		AIA
+AL	ALB	ALB
+AM	ARM	ARM
+AO	ANG	ANG
+AR	ARG	ARG
+AS	ASA	ASA
+AT	AUT	AUT
+AU	AUS	AUS
+AW	ARU	ARU
+AZ	AZE	AZE
+BA	BIH	BIH
+BB	BAR	BRB
+BD	BAN	BAN
+BE	BEL	BEL
+BF	BUR	BFA
+BG	BUL	BUL
+BH	BRN	BHR
+BI	BDI	BDI
+BJ	BEN	BEN
+BM	BER	BER
+BN	BRU	BRU
+BO	BOL	BOL
+BR	BRA	BRA
+BS	BAH	BAH
+BT	BHU	BHU
+BW	BOT	BOT
+BY	BLR	BLR
+BZ	BIZ	BLZ
+CA	CAN	CAN
+CD	COD	COD
+CF	CAF	CTA
+CG	CGO	CGO
+CH	SUI	SUI
+CI	CIV	CIV
+CK	COK	COK
+CL	CHI	CHI
+CM	CMR	CMR
+CN	CHN	CHN
+CO	COL	COL
+CR	CRC	CRC
+CU	CUB	CUB
+CV	CPV	CPV
+CW		CUW
+CY	CYP	CYP
+CZ	CZE	CZE
+DE	GER	GER
+DJ	DJI	DJI
+DK	DEN	DEN
+DM	DMA	DMA
+DO	DOM	DOM
+DZ	ALG	ALG
+EC	ECU	ECU
+EE	EST	EST
+EG	EGY	EGY
+ER	ERI	ERI
+ES	ESP	ESP
+ET	ETH	ETH
+FI	FIN	FIN
+FJ	FIJ	FIJ
+FM	FSM	
+FO		FRO
+FR	FRA	FRA
+GA	GAB	GAB
+GB	GBR	
+GD	GRN	GRN
+GE	GEO	GEO
+GH	GHA	GHA
+GI		GIB
+GM	GAM	GAM
+GN	GUI	GUI
+GQ	GEQ	EQG
+GR	GRE	GRE
+GT	GUA	GUA
+GU	GUM	GUM
+GW	GBS	GNB
+GY	GUY	GUY
+HK	HKG	HKG
+HN	HON	HON
+HR	CRO	CRO
+HT	HAI	HAI
+HU	HUN	HUN
+ID	INA	IDN
+IE	IRL	IRL
+IL	ISR	ISR
+IN	IND	IND
+IQ	IRQ	IRQ
+IR	IRI	IRN
+IS	ISL	ISL
+IT	ITA	ITA
+JM	JAM	JAM
+JO	JOR	JOR
+JP	JPN	JPN
+KE	KEN	KEN
+KG	KGZ	KGZ
+KH	CAM	CAM
+KI	KIR	
+KM	COM	COM
+KN	SKN	SKN
+KP	PRK	PRK
+KR	KOR	KOR
+KW	KUW	KUW
+KY	CAY	CAY
+KZ	KAZ	KAZ
+LA	LAO	LAO
+LB	LBN	LBN
+LC	LCA	LCA
+LI	LIE	LIE
+LK	SRI	SRI
+LR	LBR	LBR
+LS	LES	LES
+LT	LTU	LTU
+LU	LUX	LUX
+LV	LAT	LVA
+LY	LBA	LBY
+MA	MAR	MAR
+MC	MON	
+MD	MDA	MDA
+ME	MNE	MNE
+MG	MAD	MAD
+MH	MHL	
+MK	MKD	MKD
+ML	MLI	MLI
+MM	MYA	MYA
+MN	MGL	MNG
+MO		MAC
+MR	MTN	MTN
+MS		MSR
+MT	MLT	MLT
+MU	MRI	MRI
+MV	MDV	MDV
+MW	MAW	MWI
+MX	MEX	MEX
+MY	MAS	MAS
+MZ	MOZ	MOZ
+NA	NAM	NAM
+NC		NCL
+NE	NIG	NIG
+NG	NGR	NGA
+NI	NCA	NCA
+NL	NED	NED
+NO	NOR	NOR
+NP	NEP	NEP
+NR	NRU	
+NZ	NZL	NZL
+OM	OMA	OMA
+PA	PAN	PAN
+PE	PER	PER
+PF		TAH
+PG	PNG	PNG
+PH	PHI	PHI
+PK	PAK	PAK
+PL	POL	POL
+PR	PUR	PUR
+PS	PLE	PLE
+PT	POR	POR
+PW	PLW	
+PY	PAR	PAR
+QA	QAT	QAT
+RO	ROU	ROU
+RS	SRB	SRB
+RU	RUS	RUS
+RW	RWA	RWA
+SA	KSA	KSA
+SB	SOL	SOL
+SC	SEY	SEY
+SD	SUD	SDN
+SE	SWE	SWE
+SG	SGP	SGP
+SI	SLO	SVN
+SK	SVK	SVK
+SL	SLE	SLE
+SM	SMR	SMR
+SN	SEN	SEN
+SO	SOM	SOM
+SR	SUR	SUR
+SS	SSD	SSD
+ST	STP	STP
+SV	ESA	SLV
+SY	SYR	SYR
+SZ	SWZ	SWZ
+TC		TCA
+TD	CHA	CHA
+TG	TOG	TOG
+TH	THA	THA
+TJ	TJK	TJK
+TL	TLS	TLS
+TM	TKM	TKM
+TN	TUN	TUN
+TO	TGA	TGA
+TR	TUR	TUR
+TT	TTO	TRI
+TV	TUV	
+TW	TPE	TPE
+TZ	TAN	TAN
+UA	UKR	UKR
+UG	UGA	UGA
+US	USA	USA
+UY	URU	URU
+UZ	UZB	UZB
+VC	VIN	VIN
+VE	VEN	VEN
+VG	IVB	VGB
+VI	ISV	VIR
+VN	VIE	VIE
+VU	VAN	VAN
+WS	SAM	SAM
+YE	YEM	YEM
+ZA	RSA	RSA
+ZM	ZAM	ZAM
+ZW	ZIM	ZIM`
@@ -0,0 +1,183 @@
+package country
+
+/*
+vehicledata holds the distinguishing signs of vehicles in international
+traffic, as notified under the 1949 and 1968 United Nations Conventions
+on Road Traffic, for example D for Germany and CDN for Canada. Each line
+holds the alpha-2 code and the sign, separated by a tab. Countries
+without a notified sign are not listed.
+*/
+var vehicledata = `AD	AND
+AE	UAE
+AF	AFG
+AL	AL
+AM	AM
+AO	ANG
+AR	RA
+AT	A
+AU	AUS
+AX	AX
+AZ	AZ
+BA	BIH
+BB	BDS
+BD	BD
+BE	B
+BF	BF
+BG	BG
+BH	BRN
+BI	RU
+BJ	DY
+BN	BRU
+BO	BOL
+BR	BR
+BS	BS
+BT	BHT
+BW	RB
+BY	BY
+BZ	BH
+CA	CDN
+CD	CGO
+CF	RCA
+CG	RCB
+CH	CH
+CI	CI
+CL	RCH
+CM	CAM
+CO	CO
+CR	CR
+CU	C
+CV	CV
+CY	CY
+CZ	CZ
+DE	D
+DK	DK
+DM	WD
+DO	DOM
+DZ	DZ
+EC	EC
+EE	EST
+EG	ET
+ES	E
+ET	ETH
+FI	FIN
+FJ	FJI
+FO	FO
+FR	F
+GA	G
+GB	UK
+GD	WG
+GE	GE
+GG	GBG
+GH	GH
+GI	GBZ
+GM	WAG
+GN	RG
+GR	GR
+GT	GCA
+GY	GUY
+HK	HK
+HR	HR
+HT	RH
+HU	H
+ID	RI
+IE	IRL
+IL	IL
+IM	GBM
+IN	IND
+IQ	IRQ
+IR	IR
+IS	IS
+IT	I
+JE	GBJ
+JM	JA
+JO	HKJ
+JP	J
+KE	EAK
+KG	KS
+KH	K
+KR	ROK
+KW	KWT
+KZ	KZ
+LA	LAO
+LB	RL
+LC	WL
+LI	FL
+LK	CL
+LR	LB
+LS	LS
+LT	LT
+LU	L
+LV	LV
+LY	LAR
+MA	MA
+MC	MC
+MD	MD
+ME	MNE
+MG	RM
+MK	NMK
+ML	RMM
+MN	MGL
+MR	RIM
+MT	M
+MU	MS
+MV	MV
+MW	MW
+MX	MEX
+MY	MAL
+MZ	MOC
+NA	NAM
+NE	RN
+NG	WAN
+NI	NIC
+NL	NL
+NO	N
+NP	NEP
+NZ	NZ
+PA	PA
+PE	PE
+PG	PNG
+PH	RP
+PK	PK
+PL	PL
+PT	P
+PY	PY
+QA	Q
+RO	RO
+RS	SRB
+RU	RUS
+RW	RWA
+SA	KSA
+SC	SY
+SD	SUD
+SE	S
+SG	SGP
+SI	SLO
+SK	SK
+SL	WAL
+SM	RSM
+SN	SN
+SO	SO
+SR	SME
+SV	ES
+SY	SYR
+TG	TG
+TH	T
+TJ	TJ
+TM	TM
+TN	TN
+TR	TR
+TT	TT
+TW	RC
+TZ	EAT
+UA	UA
+UG	EAU
+US	USA
+UZ	UZ
+VA	V
+VC	WV
+VE	YV
+VN	VN
+WS	WS
+ZA	ZA
+ZM	Z
+ZW	ZW`
@@ -0,0 +1,97 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/musicbeat/stddata/country"
+)
+
+// tableNames holds the alpha-2 codes of the countries whose names in
+// Table A.1 are not found among the names and aliases of the country
+// provider.
+var tableNames = map[string]string{
+	"CONGO, DEMOCRATIC REPUBLIC OF THE":      "CD",
+	"CONGO (THE DEMOCRATIC REPUBLIC OF THE)": "CD",
+	"VIRGIN ISLANDS (BRITISH)":               "VG",
+	"VIRGIN ISLANDS (U.S.)":                  "VI",
+}
+
+// tableName returns name as it is compared with the names of the
+// country provider: without the line breaks and runs of spaces of
+// the XML document, and with plain apostrophes.
+func tableName(name string) string {
+	name = strings.Join(strings.Fields(name), " ")
+	return strings.Replace(name, "’", "'", -1)
+}
+
+// countryCode returns the ISO 3166-1 alpha-2 code of the country
+// named in Table A.1, or "" if name is not a country, as for the
+// entries of the European Union and the IMF.
+func countryCode(countries *country.CountryProvider, name string) string {
+	name = tableName(name)
+	if code, found := tableNames[name]; found {
+		return code
+	}
+	// later editions of Table A.1 append "(THE)" to some names
+	name = strings.TrimSuffix(name, " (THE)")
+	for _, index := range []string{"name", "official", "common"} {
+		res, err := countries.Search(index, name)
+		if err != nil {
+			return ""
+		}
+		for _, c := range res.(country.CountryResult).Countries {
+			if strings.EqualFold(name, c.EnglishName) || strings.EqualFold(name, c.OfficialName) ||
+				strings.EqualFold(name, c.CommonName) {
+				return c.Alpha2Code
+			}
+		}
+	}
+	// an alias is only trusted when it is the sole match
+	res, err := countries.Search("alias", name)
+	if err != nil {
+		return ""
+	}
+	if found := res.(country.CountryResult).Countries; len(found) == 1 {
+		return found[0].Alpha2Code
+	}
+	return ""
+}
+
+// CurrenciesOf returns the currencies used in the country with the
+// ISO 3166-1 alpha-2 code alpha2, for example EUR for "DE", and BTN
+// and INR for "BT". Like Search, it returns historic currencies only
+// when IncludeHistoric is set, and no fund codes when ExcludeFunds is.
+func (p *CurrencyProvider) CurrenciesOf(alpha2 string) (currencies []Currency) {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	for _, c := range d.currencyIndexes["alpha2"].currencyMap[strings.ToUpper(alpha2)] {
+		if p.included(c) {
+			currencies = append(currencies, c)
+		}
+	}
+	return currencies
+}
+
+// CountriesOf returns the ISO 3166-1 alpha-2 codes of the countries
+// that use the currency with the alphabetic code code, in order.
+func (p *CurrencyProvider) CountriesOf(code string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	var codes []string
+	for _, c := range d.currencyIndexes["code"].currencyMap[strings.ToUpper(code)] {
+		if c.CountryCode != "" && p.included(c) {
+			codes = append(codes, c.CountryCode)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
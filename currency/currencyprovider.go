@@ -5,30 +5,51 @@
 /*
 Package currency implements the methods of a stddata.Provider.
 It provides searches against the data set retrieved from
-currency-iso.org.
+currency-iso.org. Each entry of the data set is related to its
+country by the country's ISO 3166-1 alpha-2 code. The codes of
+withdrawn currencies, declared in historicdata.go, are loaded too,
+but are only found by searches when IncludeHistoric is set.
 */
 package currency
 
 import (
+	"encoding/csv"
 	"encoding/xml"
-	"io/ioutil"
-	"net/http"
-	"sort"
+	"io"
+	"iter"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/country"
 )
 
-// CurrencyProvider implements the Provider interface.
+// CurrencyProvider implements the Provider interface. Its data is
+// replaced whole, by swapping a pointer, when it is loaded again, so
+// searches never take a lock, and never see a load half done.
 type CurrencyProvider struct {
-	loaded          bool
+	// IncludeHistoric makes searches find the codes of withdrawn
+	// currencies, such as DEM and FRF.
+	IncludeHistoric bool
+	// ExcludeFunds keeps searches from finding fund codes, such as
+	// USN and CHE, which are not currencies in circulation.
+	ExcludeFunds bool
+	data         atomic.Pointer[currencyData] // nil until the data is loaded
+}
+
+// currencyData is the data of a CurrencyProvider. Once it is stored in
+// the CurrencyProvider it is not modified.
+type currencyData struct {
 	size            int
+	info            stddata.Info
 	currencyIndexes map[string]currencyIndex
 }
 
 type currencyIndex struct {
 	currencyMap  map[string][]Currency
 	currencyKeys []string
+	foldedKeys   []string // the keys in lower case, for stddata.PrefixRange
 }
 
 // Currency is the information on one currency in the source data.
@@ -39,70 +60,217 @@ type Currency struct {
 	CurrencyCode   string `xml:"Ccy"`
 	CurrencyNumber string `xml:"CcyNbr"`
 	MinorUnits     string `xml:"CcyMnrUnts"`
+	// CountryCode is the ISO 3166-1 alpha-2 code of the country, or
+	// empty when the entry is not a country, such as the European Union.
+	CountryCode string `xml:"-"`
+	IsFund      bool   `xml:"-"` // a fund code, such as USN, rather than a currency
+	// Withdrawn is the month a historic currency was withdrawn, for
+	// example "2002-03"; it is empty for a current currency.
+	Withdrawn string `xml:"-"`
+	// Formatting holds the conventions for rendering amounts.
+	Formatting Formatting `xml:"-"`
 }
 type Currencies struct {
 	// XMLName		xml.Name	`xml:"ISO_4217"`
+	Published  string     `xml:"Pblshd,attr"`
 	Currencies []Currency `xml:"CcyTbl>CcyNtry"`
 }
 
+// UnmarshalXML decodes an entry of the XML document, including the
+// IsFund attribute of the currency name.
+func (c *Currency) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var e struct {
+		CountryName  string `xml:"CtryNm"`
+		CurrencyName struct {
+			Name   string `xml:",chardata"`
+			IsFund bool   `xml:"IsFund,attr"`
+		} `xml:"CcyNm"`
+		CurrencyCode   string `xml:"Ccy"`
+		CurrencyNumber string `xml:"CcyNbr"`
+		MinorUnits     string `xml:"CcyMnrUnts"`
+	}
+	if err := d.DecodeElement(&e, &start); err != nil {
+		return err
+	}
+	c.CountryName = e.CountryName
+	c.CurrencyName = e.CurrencyName.Name
+	c.IsFund = e.CurrencyName.IsFund
+	c.CurrencyCode = e.CurrencyCode
+	c.CurrencyNumber = e.CurrencyNumber
+	c.MinorUnits = e.MinorUnits
+	return nil
+}
+
 // CurrencyResult is the interface{} that is returned from Search
 type CurrencyResult struct {
 	Currencies [][]Currency
 }
 
-var countryNameMap map[string][]Currency
-var currencyNameMap map[string][]Currency
-var currencyCodeMap map[string][]Currency
-var currencyNumberMap map[string][]Currency
+var isourl = "http://www.currency-iso.org/dam/downloads/table_a1.xml"
 
 // Load does the heavy lifting of retrieving the iso.org
 // web site's handy XML file. The file is retrieved and
 // parsed into structs, and loaded into maps and indexes
 // to support searches.
 func (p *CurrencyProvider) Load() (n int, err error) {
-	// Initialize the maps:
-	p.currencyIndexes = make(map[string]currencyIndex)
-	countryNameMap = make(map[string][]Currency)
-	currencyNameMap = make(map[string][]Currency)
-	currencyCodeMap = make(map[string][]Currency)
-	currencyNumberMap = make(map[string][]Currency)
-
-	res, err := http.Get("http://www.currency-iso.org/dam/downloads/table_a1.xml")
-	if err != nil {
-		msg := "Failed to retrieve http://www.currency-iso.org/dam/downloads/table_a1.xml " + err.Error()
-		return 0, &stddata.ServiceError{msg, http.StatusServiceUnavailable}
-	}
-	defer res.Body.Close()
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the currency data. The XML document is parsed
+// as a whole, so a malformed document causes LoadMode to fail
+// in either mode.
+func (p *CurrencyProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// Initialize the maps. They are built aside, and replace those that
+	// are being searched only when they are complete.
+	d := &currencyData{currencyIndexes: make(map[string]currencyIndex)}
+	countryNameMap := make(map[string][]Currency)
+	currencyNameMap := make(map[string][]Currency)
+	currencyCodeMap := make(map[string][]Currency)
+	currencyNumberMap := make(map[string][]Currency)
+	alpha2Map := make(map[string][]Currency)
 
-	currencyBody, err := ioutil.ReadAll(res.Body)
+	currencyBody, err := fetchTable()
 	if err != nil {
-		return 0, &stddata.ServiceError{err.Error(), http.StatusServiceUnavailable}
+		return r, err
 	}
 
 	var currencies Currencies
 	err = xml.Unmarshal([]byte(currencyBody), &currencies)
 	if err != nil {
-		return 0, &stddata.ServiceError{err.Error(), http.StatusServiceUnavailable}
+		return r, stddata.NewSourceError(err.Error(), err)
 	}
 
+	// the country provider relates the country names to codes
+	countries := new(country.CountryProvider)
+	if _, err = countries.Load(); err != nil {
+		return r, err
+	}
+
+	// the historic currencies follow the current ones, unless the
+	// current table still lists them
+	current := len(currencies.Currencies)
+	historic, err := readHistoric()
+	if err != nil {
+		return r, err
+	}
+	for _, h := range historic {
+		listed := false
+		for _, c := range currencies.Currencies[0:current] {
+			listed = listed || c.CurrencyCode == h.CurrencyCode
+		}
+		if !listed {
+			currencies.Currencies = append(currencies.Currencies, h)
+		}
+	}
+	codes := make(map[string]bool)
+
 	// add the currency entities to the maps:
 	for _, c := range currencies.Currencies {
+		c.Formatting, err = formattingOf(c.CurrencyCode, c.MinorUnits)
+		if err != nil {
+			return r, err
+		}
+		if c.Withdrawn == "" {
+			codes[c.CurrencyCode] = true
+		}
+		c.CountryCode = countryCode(countries, c.CountryName)
+		if c.CountryCode != "" && c.CurrencyCode != "" {
+			alpha2Map[c.CountryCode] = append(alpha2Map[c.CountryCode], c)
+		}
 		countryNameMap[c.CountryName] = append(countryNameMap[c.CountryName], c)
 		currencyNameMap[c.CurrencyName] = append(currencyNameMap[c.CurrencyName], c)
 		currencyCodeMap[c.CurrencyCode] = append(currencyCodeMap[c.CurrencyCode], c)
 		currencyNumberMap[c.CurrencyNumber] = append(currencyNumberMap[c.CurrencyNumber], c)
 	}
 
-	p.storeData("country", countryNameMap)
-	p.storeData("name", currencyNameMap)
-	p.storeData("code", currencyCodeMap)
-	p.storeData("number", currencyNumberMap)
-	p.size = len(currencyCodeMap)
-	p.loaded = true
-	return len(currencyCodeMap), err
+	d.storeData("country", countryNameMap)
+	d.storeData("name", currencyNameMap)
+	d.storeData("code", currencyCodeMap)
+	d.storeData("number", currencyNumberMap)
+	d.storeData("alpha2", alpha2Map)
+	d.size = len(codes)
+	d.info = stddata.Info{
+		Source:   "ISO 4217 Currency Codes, Table A.1",
+		URL:      isourl,
+		Edition:  currencies.Published,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(codes)
+	return r, nil
+}
+
+// readHistoric returns the historic currencies declared in
+// historicdata.go.
+func readHistoric() (historic []Currency, err error) {
+	reader := csv.NewReader(strings.NewReader(historicdata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 5
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, stddata.NewSourceError(err.Error(), err)
+	}
+	for _, record := range records {
+		var c Currency
+		c.CurrencyCode = record[0]
+		c.CurrencyNumber = record[1]
+		c.CurrencyName = record[2]
+		c.CountryName = record[3]
+		c.Withdrawn = record[4]
+		historic = append(historic, c)
+	}
+	return historic, nil
+}
+
+// included reports whether c is found by searches.
+func (p *CurrencyProvider) included(c Currency) bool {
+	return (p.IncludeHistoric || c.Withdrawn == "") && !(p.ExcludeFunds && c.IsFund)
 }
 
-func (p *CurrencyProvider) storeData(s string, m map[string][]Currency) {
+// SaveSnapshot implements the stddata.Snapshotter interface.
+func (p *CurrencyProvider) SaveSnapshot(w io.Writer) error {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return stddata.ErrNotLoaded
+	}
+	indexes := make(map[string]map[string][]Currency)
+	for s, ci := range d.currencyIndexes {
+		indexes[s] = ci.currencyMap
+	}
+	return stddata.WriteSnapshot(w, "currency", d.info, indexes)
+}
+
+// LoadSnapshot implements the stddata.Snapshotter interface. It
+// restores the data that SaveSnapshot saved, without retrieving it from currency-iso.org.
+func (p *CurrencyProvider) LoadSnapshot(r io.Reader) (n int, err error) {
+	var indexes map[string]map[string][]Currency
+	info, err := stddata.ReadSnapshot(r, "currency", &indexes)
+	if err != nil {
+		return 0, err
+	}
+	d := &currencyData{currencyIndexes: make(map[string]currencyIndex)}
+	for s, m := range indexes {
+		d.storeData(s, m)
+	}
+	d.size = info.Count
+	d.info = info
+	p.data.Store(d)
+	return d.size, nil
+}
+
+// Info describes the provenance of the loaded data. The Edition
+// is the publication date declared in the XML document.
+func (p *CurrencyProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+func (d *currencyData) storeData(s string, m map[string][]Currency) {
 	// store the map
 	var ci currencyIndex
 	ci.currencyMap = m
@@ -114,8 +282,31 @@ func (p *CurrencyProvider) storeData(s string, m map[string][]Currency) {
 		i++
 	}
 	// sort the keys
-	sort.Strings(ci.currencyKeys)
-	p.currencyIndexes[s] = ci
+	ci.foldedKeys = stddata.SortKeys(ci.currencyKeys)
+	d.currencyIndexes[s] = ci
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *CurrencyProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "country", Description: "name of the country, as ISO 4217 writes it", Example: "GERMANY"},
+		{Name: "name", Description: "name of the currency", Example: "Euro"},
+		{Name: "code", Description: "ISO 4217 alphabetic code", Example: "EUR"},
+		{Name: "number", Description: "ISO 4217 numeric code", Example: "978"},
+		{Name: "alpha2", Description: "ISO 3166-1 alpha-2 code of the country", Example: "DE"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *CurrencyProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ci := d.currencyIndexes[index]
+	return stddata.Suggest(ci.currencyKeys, ci.foldedKeys, query)
 }
 
 // Search returns a collection as an interface{} and error. The collection
@@ -126,42 +317,100 @@ func (p *CurrencyProvider) storeData(s string, m map[string][]Currency) {
 // any matching Currency entities are returned in the result.
 // Search can also "dump" an index. When the value of query is "_dump", the index specified
 // is used to supply the entire data set, in the order of the index.
+// Historic currencies are only returned when IncludeHistoric is set, and fund codes
+// are not returned when ExcludeFunds is set.
 func (p *CurrencyProvider) Search(index string, query string) (result interface{}, err error) {
 	// make sure the data is loaded
-	if p.loaded != true {
-		return 0, &stddata.ServiceError{err.Error(), http.StatusServiceUnavailable}
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
 	}
-	ci, found := p.currencyIndexes[index]
+	ci, found := d.currencyIndexes[index]
 	if !found {
 		// search cannot be performed
-		msg := "No index on " + index
-		return nil, &stddata.ServiceError{msg, http.StatusBadRequest}
+		return nil, stddata.NewIndexError(index, d.currencyIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
 	}
-	result = doSearch(ci, query)
+	result = p.filter(doSearch(ci, query))
 	return result, nil
 }
+
+// SearchCurrencies is Search, returning the matches as they are, rather
+// than as a CurrencyResult in an interface{}.
+func (p *CurrencyProvider) SearchCurrencies(index string, query string) ([][]Currency, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(CurrencyResult).Currencies, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Currencies of each. It yields nothing if the
+// CurrencyProvider has no such index, or is not loaded.
+func (p *CurrencyProvider) Range(index string) iter.Seq2[string, []Currency] {
+	return func(yield func(string, []Currency) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ci := d.currencyIndexes[index]
+		for _, k := range ci.currencyKeys {
+			if !yield(k, ci.currencyMap[k]) {
+				return
+			}
+		}
+	}
+}
+
+// filter removes from res the currencies that are not included, and
+// the groups that are left empty.
+func (p *CurrencyProvider) filter(res CurrencyResult) CurrencyResult {
+	groups := res.Currencies[:0]
+	for _, group := range res.Currencies {
+		n := 0
+		for _, c := range group {
+			if p.included(c) {
+				n++
+			}
+		}
+		// a group that is included whole is returned as it is, without
+		// a copy, as the groups of the index are not modified.
+		if n == len(group) {
+			groups = append(groups, group)
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+		kept := make([]Currency, 0, n)
+		for _, c := range group {
+			if p.included(c) {
+				kept = append(kept, c)
+			}
+		}
+		groups = append(groups, kept)
+	}
+	res.Currencies = groups
+	return res
+}
 func doSearch(ci currencyIndex, query string) (res CurrencyResult) {
 	// the "reserved" query term "_dump" is handled by returning all the
 	// results in the order of the index.
 	dump := query == "_dump"
-	// prepare the response. allocate enough space for the response to be the
-	// entire data set.
-	tmp := make([][]Currency, len(ci.currencyKeys))
-	// brute force the sorted list of keys, looking for a match to 'query.*'.
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ci.currencyKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ci.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
 	// add each match to the result array. The results are added in the
 	// order of the sorted keys, so the results are sorted.
-	i := 0
-	for k := range ci.currencyKeys {
-		if dump {
-			tmp[i] = ci.currencyMap[ci.currencyKeys[k]]
-			i++
-		} else if len(ci.currencyKeys[k]) >= len(query) {
-			if strings.EqualFold(query, ci.currencyKeys[k][0:len(query)]) {
-				tmp[i] = ci.currencyMap[ci.currencyKeys[k]]
-				i++
-			}
-		}
+	res.Currencies = make([][]Currency, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Currencies = append(res.Currencies, ci.currencyMap[ci.currencyKeys[k]])
 	}
-	res.Currencies = tmp[0:i]
 	return res
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
 )
 
 var p Provider
@@ -57,6 +58,105 @@ func TestNumberSearch(t *testing.T) {
 	}
 	fmt.Println("matches %s\n", matches)
 }
+func TestCountryRelationships(t *testing.T) {
+	cp := p.(*CurrencyProvider)
+	c := cp.CurrenciesOf("bt")
+	if len(c) != 2 || c[0].CurrencyCode != "BTN" || c[1].CurrencyCode != "INR" {
+		t.Fatalf("Expected the currencies of Bhutan, got %v\n", c)
+	}
+	if codes := cp.CountriesOf("CHF"); len(codes) != 2 || codes[0] != "CH" || codes[1] != "LI" {
+		t.Fatalf("Expected Switzerland and Liechtenstein, got %v\n", codes)
+	}
+	res, err := p.Search("code", "EUR")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	eur := res.(CurrencyResult).Currencies[0]
+	if codes := cp.CountriesOf("EUR"); len(codes) != len(eur)-1 {
+		t.Fatalf("Expected every euro country but the European Union, got %v\n", codes)
+	}
+	res, err = p.Search("alpha2", "VG")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if vg := res.(CurrencyResult).Currencies; len(vg) != 1 || vg[0][0].CurrencyCode != "USD" {
+		t.Fatalf("Expected the US Dollar for the British Virgin Islands, got %v\n", vg)
+	}
+}
+func TestHistoricAndFunds(t *testing.T) {
+	cp := p.(*CurrencyProvider)
+	res, err := p.Search("code", "DEM")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if c := res.(CurrencyResult).Currencies; len(c) != 0 {
+		t.Fatalf("Expected no historic currencies, got %v\n", c)
+	}
+	cp.IncludeHistoric = true
+	defer func() { cp.IncludeHistoric = false }()
+	res, err = p.Search("code", "DEM")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(CurrencyResult).Currencies
+	if len(c) != 1 || c[0][0].Withdrawn != "2002-03" || c[0][0].CountryCode != "DE" {
+		t.Fatalf("Expected the Deutsche Mark, got %v\n", c)
+	}
+	if de := cp.CurrenciesOf("DE"); len(de) != 2 {
+		t.Fatalf("Expected the Euro and the Deutsche Mark, got %v\n", de)
+	}
+	res, _ = p.Search("code", "USN")
+	if c := res.(CurrencyResult).Currencies; len(c) != 1 || !c[0][0].IsFund {
+		t.Fatalf("Expected the USN fund code, got %v\n", c)
+	}
+	cp.ExcludeFunds = true
+	defer func() { cp.ExcludeFunds = false }()
+	res, _ = p.Search("code", "US")
+	if c := res.(CurrencyResult).Currencies; len(c) != 1 || c[0][0].CurrencyCode != "USD" {
+		t.Fatalf("Expected USD without the fund codes, got %v\n", c)
+	}
+}
+func TestFormatting(t *testing.T) {
+	res, err := p.Search("code", "CHF")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	chf := res.(CurrencyResult).Currencies[0][0].Formatting
+	if s := chf.FormatCash(1234.23); s != "CHF\u00a01’234.25" {
+		t.Fatalf("Expected CHF 1’234.25, got %q\n", s)
+	}
+	for _, tc := range []struct {
+		code, minorUnits string
+		amount           float64
+		expected         string
+	}{
+		{"USD", "2", 1234.567, "$1,234.57"},
+		{"USD", "2", -0.5, "-$0.50"},
+		{"EUR", "2", 1234.567, "1.234,57\u00a0€"},
+		{"JPY", "0", 1234567.4, "¥1,234,567"},
+		{"KWD", "3", 1.5, "KWD\u00a01.500"},
+		{"XTS", "N.A.", 12, "XTS\u00a012"},
+		{"GHS", "2", 1000, "GHS\u00a01,000.00"},
+	} {
+		f, err := formattingOf(tc.code, tc.minorUnits)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if s := f.Format(tc.amount); s != tc.expected {
+			t.Fatalf("Expected %q, got %q\n", tc.expected, s)
+		}
+	}
+}
+func TestSearchAllocs(t *testing.T) {
+	// a search allocates its result, the interface{} that holds it, and
+	// the lower case of the query. A group of currencies that is found
+	// whole is not copied.
+	for _, s := range []stddatatest.Search{{Index: "code", Query: "EUR"}, {Index: "name", Query: "euro"}, {Index: "number", Query: "978"}} {
+		if n := stddatatest.AllocsPerSearch(p, s); n > 3 {
+			t.Fatalf("%s %q: Expected at most 3 allocations, got %v\n", s.Index, s.Query, n)
+		}
+	}
+}
 func BenchmarkNameSearch(b *testing.B) {
 	p = new(CurrencyProvider)
 	n, err := p.Load()
@@ -75,3 +175,16 @@ func BenchmarkNameSearch(b *testing.B) {
 		}
 	}
 }
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(CurrencyProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(CurrencyProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "code", Query: "EUR"}, {Index: "name", Query: "euro"}, {Index: "alpha2", Query: "DE"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
@@ -0,0 +1,35 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !js
+
+package currency
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/musicbeat/stddata"
+)
+
+// fetchTable retrieves Table A.1 from isourl. A response other than
+// 200 OK is a source error.
+func fetchTable() ([]byte, error) {
+	res, err := http.Get(isourl)
+	if err != nil {
+		msg := "Failed to retrieve " + isourl + " " + err.Error()
+		return nil, stddata.NewSourceError(msg, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		msg := "Failed to retrieve " + isourl + " " + res.Status
+		return nil, stddata.NewSourceError(msg, nil)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, stddata.NewSourceError(err.Error(), err)
+	}
+	return body, nil
+}
@@ -0,0 +1,27 @@
+//go:build !js
+
+package currency
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+)
+
+func TestFetchStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "gone", http.StatusNotFound)
+	}))
+	defer ts.Close()
+	saved := isourl
+	defer func() { isourl = saved }()
+	isourl = ts.URL
+
+	_, err := new(CurrencyProvider).Load()
+	if !errors.Is(err, ErrSourceUnavailable) || StatusCode(err) != http.StatusServiceUnavailable {
+		t.Fatalf("Expected a 404 to be a source error, got %v\n", err)
+	}
+}
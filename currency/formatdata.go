@@ -0,0 +1,73 @@
+package currency
+
+/*
+formatdata holds the conventions for rendering amounts of the most
+widely used currencies, derived from the Unicode CLDR: the symbol,
+where it is placed, and the decimal and grouping separators, as used
+in the currency's principal country (Germany, for the euro); and the
+number of fraction digits and the rounding increment, for accounting
+and for cash. Each line holds the alphabetic code, the symbol, the
+placement of the symbol, the decimal separator, the grouping
+separator, the digits, the rounding increment, the cash digits and
+the cash rounding increment, separated by tabs. The placement is a
+pattern in which ¤ stands for the symbol and # for the amount, such
+as "¤#" for $1,234.56 and "# ¤" for 1.234,56 €. A rounding increment
+is in units of the last digit, so 5 rounds 1.23 CHF to 1.25 CHF; 0
+means there is no rounding beyond the digits. The grouping separators
+of some countries are no-break spaces.
+*/
+var formatdata = `ARS	$	¤ #	,	.	2	0	2	0
+AUD	$	¤#	.	,	2	0	2	0
+BGN	лв.	# ¤	,	 	2	0	2	0
+BHD	BHD	¤ #	.	,	3	0	3	0
+BRL	R$	¤ #	,	.	2	0	2	0
+CAD	$	¤#	.	,	2	0	2	5
+CHF	CHF	¤ #	.	’	2	0	2	5
+CLP	$	¤#	,	.	0	0	0	0
+CNY	¥	¤#	.	,	2	0	2	0
+COP	$	¤ #	,	.	2	0	0	0
+CZK	Kč	# ¤	,	 	2	0	0	0
+DKK	kr.	# ¤	,	.	2	0	2	50
+EUR	€	# ¤	,	.	2	0	2	0
+GBP	£	¤#	.	,	2	0	2	0
+HKD	HK$	¤#	.	,	2	0	2	0
+HUF	Ft	# ¤	,	 	2	0	0	0
+IDR	Rp	¤#	,	.	2	0	0	0
+ILS	₪	# ¤	.	,	2	0	2	0
+INR	₹	¤#	.	,	2	0	2	0
+IQD	IQD	¤ #	.	,	0	0	0	0
+ISK	kr	# ¤	,	.	0	0	0	0
+JOD	JOD	¤ #	.	,	3	0	3	0
+JPY	¥	¤#	.	,	0	0	0	0
+KES	Ksh	¤#	.	,	2	0	2	0
+KRW	₩	¤#	.	,	0	0	0	0
+KWD	KWD	¤ #	.	,	3	0	3	0
+KZT	₸	# ¤	,	 	2	0	2	0
+LYD	LYD	¤ #	.	,	3	0	3	0
+MXN	$	¤#	.	,	2	0	2	0
+MYR	RM	¤#	.	,	2	0	2	0
+NGN	₦	¤#	.	,	2	0	2	0
+NOK	kr	# ¤	,	 	2	0	0	0
+NZD	$	¤#	.	,	2	0	2	0
+OMR	OMR	¤ #	.	,	3	0	3	0
+PEN	S/	¤ #	.	,	2	0	2	0
+PHP	₱	¤#	.	,	2	0	2	0
+PKR	Rs	¤#	.	,	2	0	0	0
+PLN	zł	# ¤	,	 	2	0	2	0
+RON	lei	# ¤	,	.	2	0	2	0
+RSD	RSD	# ¤	,	.	0	0	0	0
+RUB	₽	# ¤	,	 	2	0	2	0
+SEK	kr	# ¤	,	 	2	0	0	0
+SGD	$	¤#	.	,	2	0	2	0
+THB	฿	¤#	.	,	2	0	2	0
+TND	TND	¤ #	.	,	3	0	3	0
+TRY	₺	¤#	,	.	2	0	2	0
+TWD	$	¤#	.	,	2	0	0	0
+UAH	₴	# ¤	,	 	2	0	2	0
+UGX	USh	¤#	.	,	0	0	0	0
+USD	$	¤#	.	,	2	0	2	0
+UYU	$	¤ #	,	.	2	0	0	0
+VND	₫	# ¤	,	.	0	0	0	0
+XAF	FCFA	# ¤	,	 	0	0	0	0
+XOF	F CFA	# ¤	,	 	0	0	0	0
+ZAR	R	¤#	,	 	2	0	2	0`
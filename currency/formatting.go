@@ -0,0 +1,146 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package currency
+
+import (
+	"encoding/csv"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/musicbeat/stddata"
+)
+
+// Formatting holds the conventions for rendering amounts of a
+// currency.
+type Formatting struct {
+	Symbol           string // for example "$", "€" or "CHF"
+	SymbolFirst      bool   // the symbol precedes the amount
+	SymbolSpace      bool   // a no-break space separates the symbol and the amount
+	DecimalSeparator string
+	GroupSeparator   string
+	Digits           int // the number of fraction digits
+	Rounding         int // the rounding increment, in units of the last digit; 0 for none
+	CashDigits       int // the number of fraction digits of cash amounts
+	CashRounding     int // the rounding increment of cash amounts
+}
+
+// formats holds the Formatting declared in formatdata, by currency
+// code. It is read the first time it is needed.
+var formats struct {
+	once sync.Once
+	m    map[string]Formatting
+	err  error
+}
+
+// readFormats reads formatdata into formats.
+func readFormats() {
+	formats.m = make(map[string]Formatting)
+	reader := csv.NewReader(strings.NewReader(formatdata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 9
+	records, err := reader.ReadAll()
+	if err != nil {
+		formats.err = stddata.NewSourceError(err.Error(), err)
+		return
+	}
+	for _, record := range records {
+		var f Formatting
+		f.Symbol = record[1]
+		f.SymbolFirst = strings.HasPrefix(record[2], "¤")
+		f.SymbolSpace = strings.Contains(record[2], " ")
+		f.DecimalSeparator = record[3]
+		f.GroupSeparator = record[4]
+		var n [4]int
+		for i := range n {
+			if n[i], err = strconv.Atoi(record[5+i]); err != nil {
+				msg := "Malformed formatting of " + record[0] + ": " + err.Error()
+				formats.err = stddata.NewSourceError(msg, err)
+				return
+			}
+		}
+		f.Digits, f.Rounding, f.CashDigits, f.CashRounding = n[0], n[1], n[2], n[3]
+		formats.m[record[0]] = f
+	}
+}
+
+// formattingOf returns the Formatting of the currency with the
+// alphabetic code code and the minor units minorUnits, as given in
+// Table A.1. A currency that is not declared in formatdata is given
+// its code as its symbol, placed before the amount, the separators
+// of the US, and its minor units as its digits.
+func formattingOf(code string, minorUnits string) (Formatting, error) {
+	formats.once.Do(readFormats)
+	if formats.err != nil {
+		return Formatting{}, formats.err
+	}
+	if f, found := formats.m[code]; found {
+		return f, nil
+	}
+	digits, err := strconv.Atoi(minorUnits)
+	if err != nil {
+		// "N.A.", for gold, testing and the like
+		digits = 0
+	}
+	return Formatting{
+		Symbol:           code,
+		SymbolFirst:      true,
+		SymbolSpace:      true,
+		DecimalSeparator: ".",
+		GroupSeparator:   ",",
+		Digits:           digits,
+		CashDigits:       digits,
+	}, nil
+}
+
+// Format renders amount, rounded to the Digits and Rounding of f,
+// with the symbol and the separators of f: 1234.567 in US dollars is
+// "$1,234.57", and in euro "1.234,57 €".
+func (f Formatting) Format(amount float64) string {
+	return f.format(amount, f.Digits, f.Rounding)
+}
+
+// FormatCash renders amount as Format does, but rounded to the
+// CashDigits and CashRounding of f: 1.23 in Swiss francs is
+// "CHF 1.25".
+func (f Formatting) FormatCash(amount float64) string {
+	return f.format(amount, f.CashDigits, f.CashRounding)
+}
+
+func (f Formatting) format(amount float64, digits int, rounding int) string {
+	if rounding < 1 {
+		rounding = 1
+	}
+	units := math.Round(math.Abs(amount)*math.Pow10(digits)/float64(rounding)) * float64(rounding)
+	s := strconv.FormatFloat(units, 'f', 0, 64)
+	for len(s) <= digits {
+		s = "0" + s
+	}
+	whole, fraction := s[0:len(s)-digits], s[len(s)-digits:]
+	var b strings.Builder
+	for i, r := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteString(f.GroupSeparator)
+		}
+		b.WriteRune(r)
+	}
+	if digits > 0 {
+		b.WriteString(f.DecimalSeparator)
+		b.WriteString(fraction)
+	}
+	space := ""
+	if f.SymbolSpace {
+		space = "\u00a0"
+	}
+	sign := ""
+	if amount < 0 && units > 0 {
+		sign = "-"
+	}
+	if f.SymbolFirst {
+		return sign + f.Symbol + space + b.String()
+	}
+	return sign + b.String() + space + f.Symbol
+}
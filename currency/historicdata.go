@@ -0,0 +1,58 @@
+package currency
+
+/*
+historicdata holds a selection of ISO 4217 Table A.3, the codes of
+withdrawn currencies: those most often found in financial archives,
+such as the national currencies replaced by the euro. Each line holds
+the alphabetic code, the numeric code, the name of the currency, the
+name of the entity that used it, and the month it was withdrawn,
+separated by tabs.
+*/
+var historicdata = `ADP	020	Andorran Peseta	ANDORRA	2003-07
+ATS	040	Schilling	AUSTRIA	2002-03
+AZM	031	Azerbaijanian Manat	AZERBAIJAN	2005-12
+BEF	056	Belgian Franc	BELGIUM	2002-03
+BRR	987	Cruzeiro Real	BRAZIL	1994-07
+BYB	112	Belarusian Ruble	BELARUS	2001-01
+BYR	974	Belarusian Ruble	BELARUS	2017-01
+CSD	891	Serbian Dinar	SERBIA AND MONTENEGRO	2006-10
+CYP	196	Cyprus Pound	CYPRUS	2008-01
+DEM	276	Deutsche Mark	GERMANY	2002-03
+EEK	233	Kroon	ESTONIA	2011-01
+ESP	724	Spanish Peseta	SPAIN	2002-03
+FIM	246	Markka	FINLAND	2002-03
+FRF	250	French Franc	FRANCE	2002-03
+GHC	288	Cedi	GHANA	2008-01
+GRD	300	Drachma	GREECE	2002-03
+HRK	191	Kuna	CROATIA	2023-01
+IEP	372	Irish Pound	IRELAND	2002-03
+ITL	380	Italian Lira	ITALY	2002-03
+LTL	440	Lithuanian Litas	LITHUANIA	2014-12
+LUF	442	Luxembourg Franc	LUXEMBOURG	2002-03
+LVL	428	Latvian Lats	LATVIA	2014-01
+MGF	450	Malagasy Franc	MADAGASCAR	2004-12
+MRO	478	Ouguiya	MAURITANIA	2017-12
+MTL	470	Maltese Lira	MALTA	2008-01
+MXP	484	Mexican Peso	MEXICO	1993-01
+MZM	508	Mozambique Metical	MOZAMBIQUE	2006-06
+NLG	528	Netherlands Guilder	NETHERLANDS	2002-03
+PLZ	616	Zloty	POLAND	1997-01
+PTE	620	Portuguese Escudo	PORTUGAL	2002-03
+ROL	642	Old Leu	ROMANIA	2005-06
+SDD	736	Sudanese Dinar	SUDAN	2007-07
+SIT	705	Tolar	SLOVENIA	2007-01
+SKK	703	Slovak Koruna	SLOVAKIA	2009-01
+SLL	694	Leone	SIERRA LEONE	2023-12
+STD	678	Dobra	SAO TOME AND PRINCIPE	2017-12
+SUR	810	Rouble	UNION OF SOVIET SOCIALIST REPUBLICS	1990-12
+TMM	795	Turkmenistan Manat	TURKMENISTAN	2009-01
+TRL	792	Old Turkish Lira	TURKEY	2005-12
+VEB	862	Bolivar	VENEZUELA	2008-01
+VEF	937	Bolivar	VENEZUELA	2018-08
+XEU	954	European Currency Unit (E.C.U)	EUROPEAN MONETARY CO-OPERATION FUND	1999-01
+YUM	891	New Dinar	YUGOSLAVIA	2003-07
+ZMK	894	Zambian Kwacha	ZAMBIA	2012-12
+ZWD	716	Zimbabwe Dollar	ZIMBABWE	2008-08
+ZWL	932	Zimbabwe Dollar	ZIMBABWE	2024-09
+ZWN	942	Zimbabwe Dollar (new)	ZIMBABWE	2006-09
+ZWR	935	Zimbabwe Dollar	ZIMBABWE	2009-06`
@@ -0,0 +1,321 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package ddex implements the methods of a stddata.Provider for the
+allowed-value sets of DDEX, the standards for the messages, such as
+the Electronic Release Notification (ERN), that record labels,
+distributors and digital services exchange: the values that elements
+such as ArtistRole, ContributorRole and RightsControllerRole may
+take. The sets are read from the allowed-value set schema, avs.xsd,
+which DDEX publishes with each release of its standards, at
+http://service.ddex.net/xml/avs/avs.xsd, and which the caller supplies.
+Territories validates the TerritoryCodes of the messages, which are
+ISO 3166-1 alpha-2 codes or "Worldwide", against the country provider.
+*/
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// AVSProvider implements the Provider interface.
+type AVSProvider struct {
+	// File is the path of the allowed-value set schema, avs.xsd. It
+	// must be set before Load is called.
+	File string
+	data atomic.Pointer[avsData] // nil until the data is loaded
+}
+
+// avsData is the data of an AVSProvider. Once it is stored in the
+// AVSProvider it is not modified.
+type avsData struct {
+	size         int
+	info         stddata.Info
+	valueIndexes map[string]valueIndex
+}
+
+type valueIndex struct {
+	valueMap   map[string][]Value
+	valueKeys  []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Value models one entity: a value of an allowed-value set.
+type Value struct {
+	Set         string // the name of the set, for example "ArtistRole"
+	Value       string // for example "MainArtist"
+	Description string // the definition of the value in the schema
+}
+
+// ValueResult is the interface{} that is returned from Search
+type ValueResult struct {
+	Values [][]Value
+}
+
+// simpleType is a simple type of the schema, each of which is an
+// allowed-value set.
+type simpleType struct {
+	Name         string `xml:"name,attr"`
+	Enumerations []struct {
+		Value         string `xml:"value,attr"`
+		Documentation string `xml:"annotation>documentation"`
+	} `xml:"restriction>enumeration"`
+}
+
+// Load implements the Loader interface. A malformed set in the
+// schema causes Load to fail.
+func (p *AVSProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the schema, treating malformed sets according to
+// mode. In stddata.Lenient mode, malformed sets are skipped, and the
+// line numbers they begin on are returned in the LoadReport.
+func (p *AVSProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &avsData{valueIndexes: make(map[string]valueIndex)}
+	setMap := make(map[string][]Value)
+	valueMap := make(map[string][]Value)
+
+	if p.File == "" {
+		return r, stddata.NewSourceError("No DDEX allowed-value set schema", nil)
+	}
+	f, err := os.Open(p.File)
+	if err != nil {
+		return r, stddata.NewSourceError(err.Error(), err)
+	}
+	defer f.Close()
+	dec := xml.NewDecoder(f)
+	n := 0
+	for {
+		token, err := dec.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "simpleType" {
+			continue
+		}
+		line, _ := dec.InputPos()
+		var st simpleType
+		if err := dec.DecodeElement(&st, &start); err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+		if st.Name == "" || len(st.Enumerations) == 0 || !validValues(st) {
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("line %d: malformed allowed-value set %q", line, st.Name)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Values to the maps
+		for _, e := range st.Enumerations {
+			v := Value{st.Name, e.Value, strings.Join(strings.Fields(e.Documentation), " ")}
+			setMap[v.Set] = append(setMap[v.Set], v)
+			valueMap[v.Value] = append(valueMap[v.Value], v)
+			n++
+		}
+	}
+	p.storeData(d, "set", setMap)
+	p.storeData(d, "value", valueMap)
+	d.size = n
+	d.info = stddata.Info{
+		Source:   "DDEX Allowed Value Sets",
+		URL:      p.File,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = n
+	return r, nil
+}
+
+// validValues reports whether the values of st are not empty, and
+// not repeated.
+func validValues(st simpleType) bool {
+	seen := make(map[string]bool)
+	for _, e := range st.Enumerations {
+		if e.Value == "" || seen[e.Value] {
+			return false
+		}
+		seen[e.Value] = true
+	}
+	return true
+}
+
+// Info describes the provenance of the loaded data.
+func (p *AVSProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Values returns the values of the allowed-value set whose name is
+// set, in any case, in the order of the schema.
+func (p *AVSProvider) Values(set string) (values []Value, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	for name, values := range d.valueIndexes["set"].valueMap {
+		if strings.EqualFold(name, set) {
+			return values, nil
+		}
+	}
+	msg := "No DDEX allowed-value set " + set
+	return nil, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+}
+
+// Validate returns nil if value is one of the values of the
+// allowed-value set whose name is set. Values are case sensitive, as
+// they are in DDEX messages, so "mainartist" is not a valid ArtistRole;
+// the error for it suggests "MainArtist".
+func (p *AVSProvider) Validate(set string, value string) error {
+	values, err := p.Values(set)
+	if err != nil {
+		return err
+	}
+	for _, v := range values {
+		if v.Value == value {
+			return nil
+		}
+	}
+	msg := value + " is not a DDEX " + values[0].Set
+	for _, v := range values {
+		if strings.EqualFold(v.Value, value) {
+			msg += "; did you mean " + v.Value + "?"
+			break
+		}
+	}
+	return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+}
+
+func (p *AVSProvider) storeData(d *avsData, s string, m map[string][]Value) {
+	// store the map
+	var vi valueIndex
+	vi.valueMap = m
+	// extract the keys
+	vi.valueKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		vi.valueKeys[i] = k
+		i++
+	}
+	// sort the keys
+	vi.foldedKeys = stddata.SortKeys(vi.valueKeys)
+	// add to valueIndexes
+	d.valueIndexes[s] = vi
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *AVSProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "set", Description: "name of the allowed-value set", Example: "ArtistRole"},
+		{Name: "value", Description: "allowed value", Example: "MainArtist"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *AVSProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	vi := d.valueIndexes[index]
+	return stddata.Suggest(vi.valueKeys, vi.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Value entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Values are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The value index is keyed by the values alone, so a value is found in every
+// set that has it; the set index finds the whole of a set.
+func (p *AVSProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	vi, found := d.valueIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.valueIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(vi, query)
+	return result, nil
+}
+
+// SearchValues is Search, returning the matches as they are, rather
+// than as a ValueResult in an interface{}.
+func (p *AVSProvider) SearchValues(index string, query string) ([][]Value, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(ValueResult).Values, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Values of each. It yields nothing if the
+// AVSProvider has no such index, or is not loaded.
+func (p *AVSProvider) Range(index string) iter.Seq2[string, []Value] {
+	return func(yield func(string, []Value) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		vi := d.valueIndexes[index]
+		for _, k := range vi.valueKeys {
+			if !yield(k, vi.valueMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(vi valueIndex, query string) (res ValueResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(vi.valueKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(vi.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Values = make([][]Value, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Values = append(res.Values, vi.valueMap[vi.valueKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,162 @@
+package ddex
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/country"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+// a small schema, in the form of avs.xsd
+const avs = `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://ddex.net/xml/avs/avs">
+	<xs:simpleType name="ArtistRole">
+		<xs:restriction base="xs:string">
+			<xs:enumeration value="MainArtist">
+				<xs:annotation>
+					<xs:documentation>The primary artist of a
+						release.</xs:documentation>
+				</xs:annotation>
+			</xs:enumeration>
+			<xs:enumeration value="FeaturedArtist"/>
+			<xs:enumeration value="UserDefined"/>
+		</xs:restriction>
+	</xs:simpleType>
+	<xs:simpleType name="CommercialModelType">
+		<xs:restriction base="xs:string">
+			<xs:enumeration value="SubscriptionModel"/>
+			<xs:enumeration value="AdvertisementSupportedModel"/>
+			<xs:enumeration value="UserDefined"/>
+		</xs:restriction>
+	</xs:simpleType>
+</xs:schema>
+`
+
+var p Provider
+var avsp AVSProvider
+
+func writeSchema(t testing.TB, schema string) string {
+	file := filepath.Join(t.TempDir(), "avs.xsd")
+	if err := os.WriteFile(file, []byte(schema), 0644); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	return file
+}
+func TestAVSProvider(t *testing.T) {
+	expected := 6
+	fmt.Println("Test: AVSProvider.Load")
+	avsp.File = writeSchema(t, avs)
+	p = &avsp
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestValueSearch(t *testing.T) {
+	res, err := p.Search("value", "UserDefined")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	v := res.(ValueResult).Values
+	if len(v) != 1 || len(v[0]) != 2 {
+		t.Fatalf("Expected UserDefined in two sets, got %v\n", v)
+	}
+	values, err := avsp.Values("artistrole")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(values) != 3 || values[0].Description != "The primary artist of a release." {
+		t.Fatalf("Expected the ArtistRoles, got %v\n", values)
+	}
+}
+func TestValidate(t *testing.T) {
+	if err := avsp.Validate("ArtistRole", "FeaturedArtist"); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	err := avsp.Validate("ArtistRole", "mainartist")
+	if err == nil || err.Error() != "mainartist is not a DDEX ArtistRole; did you mean MainArtist?" {
+		t.Fatalf("Expected a suggestion of MainArtist, got %v\n", err)
+	}
+	if err := avsp.Validate("ArtistRole", "SubscriptionModel"); err == nil {
+		t.Fatalf("Expected SubscriptionModel not to be an ArtistRole\n")
+	}
+	if err := avsp.Validate("NoSuchSet", "MainArtist"); err == nil {
+		t.Fatalf("Expected an error for an unknown set\n")
+	}
+}
+func TestMalformedSchema(t *testing.T) {
+	schema := avs[:len(avs)-len("</xs:schema>\n")] + `	<xs:simpleType name="Empty">
+		<xs:restriction base="xs:string"/>
+	</xs:simpleType>
+</xs:schema>
+`
+	a := AVSProvider{File: writeSchema(t, schema)}
+	if _, err := a.Load(); err == nil {
+		t.Fatalf("Expected an empty set to fail Load\n")
+	}
+	r, err := a.LoadMode(Lenient)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 6 || r.Skipped != 1 || r.SkippedLines[0] != 22 {
+		t.Fatalf("Expected 6 loaded and line 22 skipped, got %v\n", r)
+	}
+}
+func TestTerritories(t *testing.T) {
+	var zero Territories
+	if err := zero.Validate("ZZ"); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if err := zero.Validate("gb"); err == nil {
+		t.Fatalf("Expected a lower case code to be invalid\n")
+	}
+	territories := Territories{new(country.CountryProvider)}
+	if _, err := territories.Countries.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if err := territories.Validate(Worldwide); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if err := territories.Validate("ZZ"); err == nil {
+		t.Fatalf("Expected ZZ not to be a territory\n")
+	}
+	all, err := territories.Expand([]string{Worldwide}, nil)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	codes, err := territories.Expand([]string{Worldwide}, []string{"US", "CA"})
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(codes) != len(all)-2 {
+		t.Fatalf("Expected %d territories, got %d\n", len(all)-2, len(codes))
+	}
+	for _, code := range codes {
+		if code == "US" || code == "CA" {
+			t.Fatalf("Expected %s to be excluded\n", code)
+		}
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	file := writeSchema(b, avs)
+	stddatatest.BenchmarkLoad(b, func() Provider { return &AVSProvider{File: file} })
+}
+func BenchmarkSearch(b *testing.B) {
+	file := writeSchema(b, avs)
+	bp := &AVSProvider{File: file}
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "set", Query: "ArtistRole"}, {Index: "value", Query: "MainArtist"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
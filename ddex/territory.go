@@ -0,0 +1,82 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ddex
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/country"
+)
+
+// Worldwide is the TerritoryCode that stands for every territory.
+const Worldwide = "Worldwide"
+
+// Territories validates the TerritoryCodes of DDEX messages against
+// the provider it is given. A nil provider is not consulted, so the
+// zero Territories only checks that codes are well formed.
+type Territories struct {
+	Countries *country.CountryProvider // a loaded provider, to validate ISO 3166-1 alpha-2 codes
+}
+
+// Validate returns nil if code is a valid TerritoryCode: "Worldwide",
+// or an assigned ISO 3166-1 alpha-2 code. Codes are case sensitive, as
+// they are in DDEX messages.
+func (t *Territories) Validate(code string) error {
+	if code == Worldwide {
+		return nil
+	}
+	if len(code) != 2 || code[0] < 'A' || code[0] > 'Z' || code[1] < 'A' || code[1] > 'Z' {
+		msg := code + " is not a DDEX TerritoryCode"
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	if t.Countries != nil {
+		if _, err := t.Countries.GetByAlpha2(code); err != nil {
+			msg := code + " is not an assigned ISO 3166-1 alpha-2 code"
+			return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+		}
+	}
+	return nil
+}
+
+// Expand returns the ISO 3166-1 alpha-2 codes of the territories that
+// a deal covers, in order: those of included, which may be
+// "Worldwide", for every country, less those of excluded, as the
+// TerritoryCode and ExcludedTerritoryCode elements of a deal give
+// them. Every code is validated. Expanding "Worldwide" needs Countries.
+func (t *Territories) Expand(included []string, excluded []string) (codes []string, err error) {
+	covered := make(map[string]bool)
+	for _, code := range included {
+		if err := t.Validate(code); err != nil {
+			return nil, err
+		}
+		if code != Worldwide {
+			covered[code] = true
+			continue
+		}
+		if t.Countries == nil {
+			return nil, stddata.NewServiceError(http.StatusServiceUnavailable, "No countries to expand Worldwide", stddata.ErrNotLoaded)
+		}
+		res, err := t.Countries.Search("alpha2", "_dump")
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range res.(country.CountryResult).Countries {
+			covered[c.Alpha2Code] = true
+		}
+	}
+	for _, code := range excluded {
+		if err := t.Validate(code); err != nil {
+			return nil, err
+		}
+		delete(covered, code)
+	}
+	for code := range covered {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes, nil
+}
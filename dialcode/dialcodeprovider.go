@@ -0,0 +1,312 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package dialcode implements the methods of a stddata.Provider.
+It provides searches against the ITU-T E.164 international calling
+codes, relating them to the countries that use them, and finds the
+calling code of a telephone number by its longest matching prefix.
+Within shared codes, such as +1 for the North American Numbering Plan
+and +7 for Russia and Kazakhstan, the code of a country is followed
+by the prefix that selects it, as +1-684 is for American Samoa. The
+calling codes of countries are taken from the country provider, and
+those of global services are declared in servicedata.go.
+*/
+package dialcode
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/country"
+)
+
+// DialCodeProvider implements the Provider interface.
+type DialCodeProvider struct {
+	data atomic.Pointer[dialCodeData] // nil until the data is loaded
+}
+
+// dialCodeData is the data of a DialCodeProvider. Once it is stored in
+// the DialCodeProvider it is not modified.
+type dialCodeData struct {
+	size        int
+	info        stddata.Info
+	codeIndexes map[string]codeIndex
+}
+
+type codeIndex struct {
+	codeMap    map[string][]CallingCode
+	codeKeys   []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// CallingCode models one entity.
+type CallingCode struct {
+	Code        string // as written, for example "+1-684"
+	Digits      string // the digits of Code, for example "1684"
+	CountryCode string // the ISO 3166-1 alpha-2 code; empty for a global service
+	Name        string // the name of the country or of the global service
+}
+
+// CallingCodeResult is the interface{} that is returned from Search
+type CallingCodeResult struct {
+	CallingCodes [][]CallingCode
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *DialCodeProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *DialCodeProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &dialCodeData{codeIndexes: make(map[string]codeIndex)}
+	digitsMap := make(map[string][]CallingCode)
+	countryMap := make(map[string][]CallingCode)
+	nameMap := make(map[string][]CallingCode)
+	// addCode adds the CallingCode to the maps.
+	addCode := func(c CallingCode) {
+		digitsMap[c.Digits] = append(digitsMap[c.Digits], c)
+		if c.CountryCode != "" {
+			countryMap[c.CountryCode] = append(countryMap[c.CountryCode], c)
+		}
+		nameMap[c.Name] = append(nameMap[c.Name], c)
+	}
+
+	// the country provider holds the calling codes of the countries
+	countries := new(country.CountryProvider)
+	if _, err = countries.Load(); err != nil {
+		return r, err
+	}
+	res, err := countries.Search("alpha2", "_dump")
+	if err != nil {
+		return r, err
+	}
+	for _, c := range res.(country.CountryResult).Countries {
+		for _, code := range c.DialCodes {
+			addCode(CallingCode{code, digits(code), c.Alpha2Code, c.EnglishName})
+		}
+	}
+
+	reader := csv.NewReader(strings.NewReader(servicedata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 2
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		c := CallingCode{record[0], digits(record[0]), "", record[1]}
+		if !strings.HasPrefix(c.Code, "+") || c.Digits == "" {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed calling code %q", line, c.Code)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+		addCode(c)
+	}
+	p.storeData(d, "digits", digitsMap)
+	p.storeData(d, "country", countryMap)
+	p.storeData(d, "name", nameMap)
+	for _, codes := range digitsMap {
+		d.size += len(codes)
+	}
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = d.size
+	return r, nil
+}
+
+// digits reduces a calling code, or a telephone number in
+// international format, to its digits: "+1-684" becomes "1684". The
+// international call prefix "00" is removed, so "0044 20" becomes
+// "4420".
+func digits(s string) string {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "00") {
+		s = s[2:]
+	}
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// Info describes the provenance of the loaded data.
+func (p *DialCodeProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// LongestPrefixMatch returns the calling codes that are the longest
+// prefix of number, a telephone number in international format, such
+// as "+1 684 633 1234" or "0044 20 7946 0000". Where a code is
+// shared, every country that uses it is returned: "+1 212 555 0100"
+// returns the codes of Canada, the United States and its Minor
+// Outlying Islands, while "+1 684 633 1234" returns that of American
+// Samoa alone.
+func (p *DialCodeProvider) LongestPrefixMatch(number string) (codes []CallingCode, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	ds := digits(number)
+	for n := len(ds); n > 0; n-- {
+		if codes, found := d.codeIndexes["digits"].codeMap[ds[0:n]]; found {
+			return codes, nil
+		}
+	}
+	msg := "No calling code for " + number
+	return nil, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+}
+
+func (p *DialCodeProvider) storeData(d *dialCodeData, s string, m map[string][]CallingCode) {
+	// store the map
+	var ci codeIndex
+	ci.codeMap = m
+	// extract the keys
+	ci.codeKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		ci.codeKeys[i] = k
+		i++
+	}
+	// sort the keys
+	ci.foldedKeys = stddata.SortKeys(ci.codeKeys)
+	// add to codeIndexes
+	d.codeIndexes[s] = ci
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *DialCodeProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "digits", Description: "calling code, of which just the digits are searched", Example: "+1 684"},
+		{Name: "country", Description: "ISO 3166-1 alpha-2 code of the country", Example: "AS"},
+		{Name: "name", Description: "name of the country or global service", Example: "American Samoa"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *DialCodeProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ci := d.codeIndexes[index]
+	return stddata.Suggest(ci.codeKeys, ci.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of CallingCode entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching CallingCodes are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The digits index is keyed by the digits of the calling codes, so the query is
+// reduced to its digits: "+1-6" finds +1-684 and +1-649, among others.
+func (p *DialCodeProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	ci, found := d.codeIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.codeIndexes)
+	}
+	if index == "digits" && query != "_dump" {
+		query = digits(query)
+	}
+	// a query with no digits is as empty as one that is empty to begin with
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(ci, query)
+	return result, nil
+}
+
+// SearchCallingCodes is Search, returning the matches as they are, rather
+// than as a CallingCodeResult in an interface{}.
+func (p *DialCodeProvider) SearchCallingCodes(index string, query string) ([][]CallingCode, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(CallingCodeResult).CallingCodes, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the CallingCodes of each. It yields nothing if the
+// DialCodeProvider has no such index, or is not loaded.
+func (p *DialCodeProvider) Range(index string) iter.Seq2[string, []CallingCode] {
+	return func(yield func(string, []CallingCode) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ci := d.codeIndexes[index]
+		for _, k := range ci.codeKeys {
+			if !yield(k, ci.codeMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(ci codeIndex, query string) (res CallingCodeResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ci.codeKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ci.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.CallingCodes = make([][]CallingCode, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.CallingCodes = append(res.CallingCodes, ci.codeMap[ci.codeKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,87 @@
+package dialcode
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestDialCodeProvider(t *testing.T) {
+	expected := 267
+	fmt.Println("Test: DialCodeProvider.Load")
+	p = new(DialCodeProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestDigitsSearch(t *testing.T) {
+	res, err := p.Search("digits", "+44")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if c := res.(CallingCodeResult).CallingCodes; len(c) != 4 {
+		t.Fatalf("Expected the United Kingdom and the Crown Dependencies, got %v\n", c)
+	}
+	res, err = p.Search("country", "KZ")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if c := res.(CallingCodeResult).CallingCodes; len(c) != 1 || len(c[0]) != 2 {
+		t.Fatalf("Expected the two codes of Kazakhstan, got %v\n", c)
+	}
+}
+func TestLongestPrefixMatch(t *testing.T) {
+	dp := p.(*DialCodeProvider)
+	for number, expected := range map[string]string{
+		"+1 684 633 1234":    "AS",
+		"+7 727 250 0000":    "KZ",
+		"+7 495 123 4567":    "RU",
+		"0044 1481 700 000":  "GG",
+		"+44 20 7946 0000":   "GB",
+		"+800 1234 5678":     "",
+		"+358 18 123 456":    "AX",
+		"+39 06 698 12345":   "VA",
+		"+39 06 1234 5678":   "IT",
+		"(+49) 30 1234-5678": "DE",
+	} {
+		codes, err := dp.LongestPrefixMatch(number)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if len(codes) != 1 || codes[0].CountryCode != expected {
+			t.Fatalf("Expected %q for %s, got %v\n", expected, number, codes)
+		}
+	}
+	codes, err := dp.LongestPrefixMatch("+1 212 555 0100")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(codes) != 3 || codes[0].CountryCode != "CA" || codes[2].CountryCode != "US" {
+		t.Fatalf("Expected the countries that share +1, got %v\n", codes)
+	}
+	if _, err = dp.LongestPrefixMatch("+999"); err == nil {
+		t.Fatalf("Expected no calling code for +999\n")
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(DialCodeProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(DialCodeProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "digits", Query: "+1 684"}, {Index: "country", Query: "AS"}, {Index: "name", Query: "American"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
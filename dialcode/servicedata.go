@@ -0,0 +1,24 @@
+package dialcode
+
+// provenance of the calling codes, reported by Info
+const (
+	source    = "ITU-T E.164 assigned country codes"
+	sourceURL = "https://www.itu.int/pub/T-SP-E.164D"
+	edition   = "2014"
+)
+
+/*
+servicedata holds the ITU-T E.164 calling codes that are assigned to
+global services rather than to countries, from ITU-T Recommendation
+E.164 Annex "List of ITU-T Recommendation E.164 assigned country
+codes". Each line holds the calling code and the name of the
+service, separated by a tab.
+*/
+var servicedata = `+800	International Freephone Service
++808	International Shared Cost Service
++870	Inmarsat Single Network Access Code
++881	Global Mobile Satellite System
++882	International Networks
++883	International Networks
++888	Telecommunications for Disaster Relief by OCHA
++979	International Premium Rate Service`
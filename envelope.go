@@ -0,0 +1,107 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stddata
+
+import (
+	"reflect"
+	"time"
+)
+
+// Envelope wraps the result of a search with what a client, or a log,
+// needs to know about it: the index searched, the query as the
+// Provider searched for it, how many matches there are, whether the
+// result holds just the first of them, and how long the search took.
+type Envelope struct {
+	Index     string
+	Query     string        // as normalized by the Provider, for example "44" for "+44"
+	Total     int           // the number of matches, including those left out
+	Truncated bool          // whether the Result holds fewer than Total matches
+	Duration  time.Duration // how long the search took
+	Result    interface{}   // as returned by Search
+	// Suggestions are the keys that a query that finds nothing may have
+	// been meant to find, if the Provider is a Suggester.
+	Suggestions []string `json:",omitempty"`
+}
+
+// QueryNormalizer is implemented by the Providers that normalize a
+// query before they search for it, such as by removing the punctuation
+// of a dial code.
+type QueryNormalizer interface {
+	// NormalizeQuery returns query as the Provider searches index for it.
+	NormalizeQuery(index string, query string) string
+}
+
+// Suggester is implemented by the Providers that suggest what a query
+// that finds nothing may have been meant to find.
+type Suggester interface {
+	// Suggest returns the keys of index that are close to query, the
+	// closest first, as stddata.Suggest does.
+	Suggest(index string, query string) []string
+}
+
+// Totaler is implemented by the results of the Providers that can limit
+// the matches a search returns.
+type Totaler interface {
+	// Total returns the number of matches, including any left out, and
+	// whether some were left out.
+	Total() (total int, truncated bool)
+}
+
+// SearchEnvelope searches index of p for query, and returns the result
+// in an Envelope. The matches of a result that is not a Totaler are
+// counted as the length of its first slice field, such as the Banks of
+// a BankResult. If there are none, and p is a Suggester, the Envelope
+// holds its suggestions.
+func SearchEnvelope(p Provider, index string, query string) (e Envelope, err error) {
+	e.Index = index
+	e.Query = query
+	if n, ok := p.(QueryNormalizer); ok {
+		e.Query = n.NormalizeQuery(index, query)
+	}
+	start := time.Now()
+	e.Result, err = p.Search(index, query)
+	e.Duration = time.Since(start)
+	if err != nil {
+		return e, err
+	}
+	e.Total, e.Truncated = total(e.Result)
+	if s, ok := p.(Suggester); ok && e.Total == 0 {
+		e.Suggestions = s.Suggest(index, e.Query)
+	}
+	return e, nil
+}
+
+// total returns the number of matches of result, and whether some were
+// left out of it: as its Total if it is a Totaler or an Envelope, and
+// otherwise as countMatches counts them.
+func total(result interface{}) (total int, truncated bool) {
+	switch r := result.(type) {
+	case Totaler:
+		return r.Total()
+	case Envelope:
+		return r.Total, r.Truncated
+	}
+	return countMatches(result), false
+}
+
+// countMatches returns the length of the first slice field of result,
+// or of result itself if it is a slice.
+func countMatches(result interface{}) int {
+	v := reflect.ValueOf(result)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Slice:
+		return v.Len()
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() && v.Field(i).Kind() == reflect.Slice {
+				return v.Field(i).Len()
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stddata
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrEmptyQuery is returned by Search for an empty query. A query is
+// matched against the beginnings of the keys, so an empty one would
+// match every key; "_dump" asks for the whole of an index.
+var ErrEmptyQuery = NewServiceError(http.StatusBadRequest, "Empty query: search for the beginning of a key, or _dump for the whole index", nil)
+
+// ErrUnknownIndex is the error of a search of an index that a Provider
+// does not have. Search returns it as an *IndexError, which names the
+// indexes the Provider does have; errors.Is(err, ErrUnknownIndex)
+// reports whether err is one.
+var ErrUnknownIndex = NewServiceError(http.StatusBadRequest, "No such index", nil)
+
+// ErrNotLoaded is the error of a search of a Provider that has not
+// been loaded, or whose load failed. A Service answers it with 503
+// Service Unavailable.
+var ErrNotLoaded = NewServiceError(http.StatusServiceUnavailable, "Not loaded", nil)
+
+// ErrNoMatch is wrapped by the error of a lookup, such as GetByAlpha2,
+// of a key that no entity has. A Search that matches nothing is not an
+// error, but an empty result.
+var ErrNoMatch = NewServiceError(http.StatusNotFound, "No match", nil)
+
+// ErrSourceUnavailable is wrapped by the error of a load whose source
+// could not be retrieved or read, or was malformed.
+var ErrSourceUnavailable = NewServiceError(http.StatusServiceUnavailable, "Source unavailable", nil)
+
+// NewSourceError returns the ServiceError of a load whose source is
+// unavailable, with the message msg: a 503 Service Unavailable whose
+// cause wraps both ErrSourceUnavailable and cause, which may be nil, so
+// that errors.Is and errors.As find either.
+func NewSourceError(msg string, cause error) *ServiceError {
+	if cause == nil {
+		return NewServiceError(http.StatusServiceUnavailable, msg, ErrSourceUnavailable)
+	}
+	return NewServiceError(http.StatusServiceUnavailable, msg, errors.Join(ErrSourceUnavailable, cause))
+}
+
+// IndexError is the error of a search of an index that a Provider does
+// not have.
+type IndexError struct {
+	Index   string   // the index that was searched
+	Indexes []string // the indexes of the Provider, sorted
+	// Suggestions are the indexes that Index is close to, and may have
+	// been meant to be, for example alpha2 for "alpha-2".
+	Suggestions []string
+}
+
+// NewIndexError returns the IndexError of a search of index, which is
+// not one of indexes, the indexes of a Provider by their names.
+func NewIndexError[V any](index string, indexes map[string]V) *IndexError {
+	e := &IndexError{Index: index, Indexes: make([]string, 0, len(indexes))}
+	for name := range indexes {
+		e.Indexes = append(e.Indexes, name)
+	}
+	folded := SortKeys(e.Indexes)
+	e.Suggestions = Suggest(e.Indexes, folded, index)
+	return e
+}
+
+// Error implements the built-in error interface on IndexError.
+func (e *IndexError) Error() string {
+	msg := "No index on " + e.Index
+	if len(e.Suggestions) > 0 {
+		msg += "; did you mean " + strings.Join(e.Suggestions, " or ") + "?"
+	}
+	return msg + "; the indexes are " + strings.Join(e.Indexes, ", ")
+}
+
+// Is reports whether target is ErrUnknownIndex, for errors.Is.
+func (e *IndexError) Is(target error) bool {
+	return target == ErrUnknownIndex
+}
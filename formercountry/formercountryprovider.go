@@ -0,0 +1,256 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package formercountry implements the methods of a stddata.Provider.
+It provides searches against the data set of ISO 3166-3 codes
+for formerly used names of countries, such as "YU", "SU" and "AN",
+so that legacy data carrying withdrawn codes can still be resolved.
+Source data is declared in formerdata.go
+*/
+package formercountry
+
+import (
+	"encoding/csv"
+	"io"
+	"iter"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// FormerCountryProvider implements the Provider interface.
+type FormerCountryProvider struct {
+	data atomic.Pointer[formerCountryData] // nil until the data is loaded
+}
+
+// formerCountryData is the data of a FormerCountryProvider. Once it is
+// stored in the FormerCountryProvider it is not modified.
+type formerCountryData struct {
+	size                 int
+	info                 stddata.Info
+	formerCountryIndexes map[string]formerCountryIndex
+}
+
+type formerCountryIndex struct {
+	formerCountryMap  map[string][]FormerCountry
+	formerCountryKeys []string
+	foldedKeys        []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// FormerCountry models one entity.
+type FormerCountry struct {
+	EnglishName string
+	Alpha2Code  string
+	Alpha3Code  string
+	Alpha4Code  string   // ISO 3166-3 code: the former alpha-2 code, then two letters for the successor
+	NumericCode string   // may be empty, not every former country had a numeric code
+	Withdrawn   string   // the date, or only the year, the codes were withdrawn
+	Successors  []string // ISO 3166-1 alpha-2 codes of the successor countries
+	Comment     string
+}
+
+// FormerCountryResult is the interface{} that is returned from Search
+type FormerCountryResult struct {
+	FormerCountries [][]FormerCountry
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *FormerCountryProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped,
+// and their line numbers are returned in the LoadReport.
+func (p *FormerCountryProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &formerCountryData{formerCountryIndexes: make(map[string]formerCountryIndex)}
+	englishNameMap := make(map[string][]FormerCountry)
+	alpha2Map := make(map[string][]FormerCountry)
+	alpha3Map := make(map[string][]FormerCountry)
+	alpha4Map := make(map[string][]FormerCountry)
+	numericMap := make(map[string][]FormerCountry)
+	successorMap := make(map[string][]FormerCountry)
+
+	reader := csv.NewReader(strings.NewReader(formerdata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 8
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var c FormerCountry
+		c.EnglishName = record[0]
+		c.Alpha2Code = record[1]
+		c.Alpha3Code = record[2]
+		c.Alpha4Code = record[3]
+		c.NumericCode = record[4]
+		c.Withdrawn = record[5]
+		c.Successors = strings.Fields(record[6])
+		c.Comment = record[7]
+
+		// add the FormerCountry to the maps
+		englishNameMap[c.EnglishName] = append(englishNameMap[c.EnglishName], c)
+		alpha2Map[c.Alpha2Code] = append(alpha2Map[c.Alpha2Code], c)
+		alpha3Map[c.Alpha3Code] = append(alpha3Map[c.Alpha3Code], c)
+		alpha4Map[c.Alpha4Code] = append(alpha4Map[c.Alpha4Code], c)
+		if c.NumericCode != "" {
+			numericMap[c.NumericCode] = append(numericMap[c.NumericCode], c)
+		}
+		for _, sc := range c.Successors {
+			successorMap[sc] = append(successorMap[sc], c)
+		}
+	}
+	p.storeData(d, "name", englishNameMap)
+	p.storeData(d, "alpha2", alpha2Map)
+	p.storeData(d, "alpha3", alpha3Map)
+	p.storeData(d, "alpha4", alpha4Map)
+	p.storeData(d, "number", numericMap)
+	p.storeData(d, "successor", successorMap)
+	d.size = len(alpha4Map)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(alpha4Map)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *FormerCountryProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+func (p *FormerCountryProvider) storeData(d *formerCountryData, s string, m map[string][]FormerCountry) {
+	// store the map
+	var si formerCountryIndex
+	si.formerCountryMap = m
+	// extract the keys
+	si.formerCountryKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		si.formerCountryKeys[i] = k
+		i++
+	}
+	// sort the keys
+	si.foldedKeys = stddata.SortKeys(si.formerCountryKeys)
+	// add to formerCountryIndexes
+	d.formerCountryIndexes[s] = si
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *FormerCountryProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "name", Description: "English name", Example: "Yugoslavia"},
+		{Name: "alpha2", Description: "former ISO 3166-1 alpha-2 code", Example: "YU"},
+		{Name: "alpha3", Description: "former ISO 3166-1 alpha-3 code", Example: "YUG"},
+		{Name: "alpha4", Description: "ISO 3166-3 code", Example: "YUCS"},
+		{Name: "number", Description: "former ISO 3166-1 numeric code", Example: "891"},
+		{Name: "successor", Description: "ISO 3166-1 alpha-2 code of a successor country", Example: "RS"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *FormerCountryProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	si := d.formerCountryIndexes[index]
+	return stddata.Suggest(si.formerCountryKeys, si.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of FormerCountry entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching FormerCountries are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+func (p *FormerCountryProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	si, found := d.formerCountryIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.formerCountryIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(si, query)
+	return result, nil
+}
+
+// SearchFormerCountries is Search, returning the matches as they are, rather
+// than as a FormerCountryResult in an interface{}.
+func (p *FormerCountryProvider) SearchFormerCountries(index string, query string) ([][]FormerCountry, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(FormerCountryResult).FormerCountries, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the FormerCountries of each. It yields nothing if the
+// FormerCountryProvider has no such index, or is not loaded.
+func (p *FormerCountryProvider) Range(index string) iter.Seq2[string, []FormerCountry] {
+	return func(yield func(string, []FormerCountry) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		si := d.formerCountryIndexes[index]
+		for _, k := range si.formerCountryKeys {
+			if !yield(k, si.formerCountryMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(si formerCountryIndex, query string) (res FormerCountryResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(si.formerCountryKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(si.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.FormerCountries = make([][]FormerCountry, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.FormerCountries = append(res.FormerCountries, si.formerCountryMap[si.formerCountryKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,103 @@
+package formercountry
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestFormerCountryProvider(t *testing.T) {
+	expected := 31
+	fmt.Println("Test: FormerCountryProvider.Load")
+	p = new(FormerCountryProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestAlpha2Search(t *testing.T) {
+	res, err := p.Search("alpha2", "YU")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(FormerCountryResult).FormerCountries
+	if len(c) != 1 || c[0][0].Alpha3Code != "YUG" || c[0][0].Successors[0] != "CS" {
+		t.Fatalf("Expected Yugoslavia, got %v\n", c)
+	}
+}
+func TestAlpha2SearchShared(t *testing.T) {
+	// CS was used for both Czechoslovakia and Serbia and Montenegro
+	res, err := p.Search("alpha2", "CS")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(FormerCountryResult).FormerCountries
+	if len(c) != 1 || len(c[0]) != 2 {
+		t.Fatalf("Expected two former countries coded CS, got %v\n", c)
+	}
+}
+func TestSuccessorSearch(t *testing.T) {
+	res, err := p.Search("successor", "RU")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	c := res.(FormerCountryResult).FormerCountries
+	if len(c) != 1 || c[0][0].Alpha2Code != "SU" {
+		t.Fatalf("Expected the USSR, got %v\n", c)
+	}
+}
+func TestNameSearch(t *testing.T) {
+	_, err := p.Search("name", "z")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+}
+func TestNumberSearch(t *testing.T) {
+	_, err := p.Search("number", "8")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+}
+func TestRange(t *testing.T) {
+	var keys []string
+	for k, c := range p.(*FormerCountryProvider).Range("alpha4") {
+		if len(c) != 1 || c[0].Alpha4Code != k {
+			t.Fatalf("Expected the former country of %s, got %v\n", k, c)
+		}
+		keys = append(keys, k)
+		if len(keys) == 2 {
+			break
+		}
+	}
+	if len(keys) != 2 || keys[0] >= keys[1] {
+		t.Fatalf("Expected two keys in order, got %v\n", keys)
+	}
+	n := 0
+	for range p.(*FormerCountryProvider).Range("alpha-4") {
+		n++
+	}
+	if n != 0 {
+		t.Fatalf("Expected an unknown index to yield nothing, got %d\n", n)
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(FormerCountryProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(FormerCountryProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "name", Query: "Yugoslavia"}, {Index: "alpha2", Query: "YU"}, {Index: "successor", Query: "RS"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
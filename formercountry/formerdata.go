@@ -0,0 +1,48 @@
+package formercountry
+
+// provenance of formerdata, reported by Info
+const (
+	source    = "ISO 3166-3 Code for formerly used names of countries (Debian iso-codes)"
+	sourceURL = "https://salsa.debian.org/iso-codes-team/iso-codes"
+	edition   = "4.15.0"
+)
+
+/*
+formerdata is derived from the ISO 3166-3 information maintained
+by the Debian iso-codes project. Each line holds the English name,
+the alpha-2, alpha-3 and alpha-4 codes, the numeric code (when one
+was assigned), the date (or year) the codes were withdrawn, the
+space-separated ISO 3166-1 alpha-2 codes of the successors, and a
+comment, separated by tabs.
+*/
+var formerdata = `French Afars and Issas	AI	AFI	AIDJ	262	1977	DJ	
+Netherlands Antilles	AN	ANT	ANHH	530	2010-12-15	BQ CW SX	had numeric code 532 until Aruba split away in 1986
+British Antarctic Territory	BQ	ATB	BQAQ		1979	AQ	
+Burma, Socialist Republic of the Union of	BU	BUR	BUMM	104	1989-12-05	MM	
+Byelorussian SSR Soviet Socialist Republic	BY	BYS	BYAA	112	1992-06-15	BY	
+Czechoslovakia, Czechoslovak Socialist Republic	CS	CSK	CSHH	200	1993-06-15	CZ SK	
+Serbia and Montenegro	CS	SCG	CSXX	891	2006-09-26	ME RS	
+Canton and Enderbury Islands	CT	CTE	CTKI	128	1984	KI	
+German Democratic Republic	DD	DDR	DDDE	278	1990-10-30	DE	
+Dahomey	DY	DHY	DYBJ	204	1977	BJ	
+French Southern and Antarctic Territories	FQ	ATF	FQHH		1979	AQ TF	now split between AQ and TF
+France, Metropolitan	FX	FXX	FXFR	249	1997-07-14	FR	
+Gilbert and Ellice Islands	GE	GEL	GEHH	296	1979	KI TV	now split into Kiribati and Tuvalu
+Upper Volta, Republic of	HV	HVO	HVBF	854	1984	BF	
+Johnston Island	JT	JTN	JTUM	396	1986	UM	
+Midway Islands	MI	MID	MIUM	488	1986	UM	
+New Hebrides	NH	NHB	NHVU	548	1980	VU	
+Dronning Maud Land	NQ	ATN	NQAQ	216	1983	AQ	
+Neutral Zone	NT	NTZ	NTHH	536	1993-07-12	IQ SA	formerly between Saudi Arabia and Iraq
+Pacific Islands (trust territory)	PC	PCI	PCHH	582	1986	FM MH MP PW	divided into FM, MH, MP, and PW
+US Miscellaneous Pacific Islands	PU	PUS	PUUM	849	1986	UM	
+Panama Canal Zone	PZ	PCZ	PZPA		1980	PA	
+Southern Rhodesia	RH	RHO	RHZW	716	1980	ZW	
+Sikkim	SK	SKM	SKIN		1975	IN	
+USSR, Union of Soviet Socialist Republics	SU	SUN	SUHH	810	1992-08-30	AM AZ BY EE GE KG KZ LT LV MD RU TJ TM UA UZ	
+East Timor	TP	TMP	TPTL	626	2002-05-20	TL	was Portuguese Timor
+Viet-Nam, Democratic Republic of	VD	VDR	VDVN		1977	VN	
+Wake Island	WK	WAK	WKUM	872	1986	UM	
+Yemen, Democratic, People's Democratic Republic of	YD	YMD	YDYE	720	1990-08-14	YE	
+Yugoslavia, (Socialist) Federal Republic of	YU	YUG	YUCS	891	2003-07-23	CS	had numeric code 890 until the 'Socialist Federal Republic of Yugoslavia' formerly broke apart on 27 April 1992 and the 'Federal Republic of Yugoslavia' was founded
+Zaire, Republic of	ZR	ZAR	ZRCD	180	1997-07-14	CD	`
@@ -0,0 +1,211 @@
+package genre
+
+// provenance of genredata, reported by Info
+const (
+	source    = "ID3v1 genre list, with the Winamp extensions"
+	sourceURL = "https://id3.org/ID3v1"
+	edition   = "Winamp 5.6"
+)
+
+/*
+genredata holds the genres that a byte in an ID3v1 tag can stand for.
+The fields of each record are tab-delimited: the number of the genre,
+its name and its origin. Genres 0 to 79 are those of the ID3v1
+specification; 80 to 191 were added by Winamp, up to version 5.6,
+and are recognized by most taggers. The names are given as Winamp
+gives them, misspellings such as "Psychadelic" included, except 133,
+which is given by the name that replaced its original one.
+*/
+var genredata = `0	Blues	ID3v1
+1	Classic Rock	ID3v1
+2	Country	ID3v1
+3	Dance	ID3v1
+4	Disco	ID3v1
+5	Funk	ID3v1
+6	Grunge	ID3v1
+7	Hip-Hop	ID3v1
+8	Jazz	ID3v1
+9	Metal	ID3v1
+10	New Age	ID3v1
+11	Oldies	ID3v1
+12	Other	ID3v1
+13	Pop	ID3v1
+14	R&B	ID3v1
+15	Rap	ID3v1
+16	Reggae	ID3v1
+17	Rock	ID3v1
+18	Techno	ID3v1
+19	Industrial	ID3v1
+20	Alternative	ID3v1
+21	Ska	ID3v1
+22	Death Metal	ID3v1
+23	Pranks	ID3v1
+24	Soundtrack	ID3v1
+25	Euro-Techno	ID3v1
+26	Ambient	ID3v1
+27	Trip-Hop	ID3v1
+28	Vocal	ID3v1
+29	Jazz+Funk	ID3v1
+30	Fusion	ID3v1
+31	Trance	ID3v1
+32	Classical	ID3v1
+33	Instrumental	ID3v1
+34	Acid	ID3v1
+35	House	ID3v1
+36	Game	ID3v1
+37	Sound Clip	ID3v1
+38	Gospel	ID3v1
+39	Noise	ID3v1
+40	AlternRock	ID3v1
+41	Bass	ID3v1
+42	Soul	ID3v1
+43	Punk	ID3v1
+44	Space	ID3v1
+45	Meditative	ID3v1
+46	Instrumental Pop	ID3v1
+47	Instrumental Rock	ID3v1
+48	Ethnic	ID3v1
+49	Gothic	ID3v1
+50	Darkwave	ID3v1
+51	Techno-Industrial	ID3v1
+52	Electronic	ID3v1
+53	Pop-Folk	ID3v1
+54	Eurodance	ID3v1
+55	Dream	ID3v1
+56	Southern Rock	ID3v1
+57	Comedy	ID3v1
+58	Cult	ID3v1
+59	Gangsta	ID3v1
+60	Top 40	ID3v1
+61	Christian Rap	ID3v1
+62	Pop/Funk	ID3v1
+63	Jungle	ID3v1
+64	Native American	ID3v1
+65	Cabaret	ID3v1
+66	New Wave	ID3v1
+67	Psychadelic	ID3v1
+68	Rave	ID3v1
+69	Showtunes	ID3v1
+70	Trailer	ID3v1
+71	Lo-Fi	ID3v1
+72	Tribal	ID3v1
+73	Acid Punk	ID3v1
+74	Acid Jazz	ID3v1
+75	Polka	ID3v1
+76	Retro	ID3v1
+77	Musical	ID3v1
+78	Rock & Roll	ID3v1
+79	Hard Rock	ID3v1
+80	Folk	Winamp
+81	Folk-Rock	Winamp
+82	National Folk	Winamp
+83	Swing	Winamp
+84	Fast Fusion	Winamp
+85	Bebob	Winamp
+86	Latin	Winamp
+87	Revival	Winamp
+88	Celtic	Winamp
+89	Bluegrass	Winamp
+90	Avantgarde	Winamp
+91	Gothic Rock	Winamp
+92	Progressive Rock	Winamp
+93	Psychedelic Rock	Winamp
+94	Symphonic Rock	Winamp
+95	Slow Rock	Winamp
+96	Big Band	Winamp
+97	Chorus	Winamp
+98	Easy Listening	Winamp
+99	Acoustic	Winamp
+100	Humour	Winamp
+101	Speech	Winamp
+102	Chanson	Winamp
+103	Opera	Winamp
+104	Chamber Music	Winamp
+105	Sonata	Winamp
+106	Symphony	Winamp
+107	Booty Bass	Winamp
+108	Primus	Winamp
+109	Porn Groove	Winamp
+110	Satire	Winamp
+111	Slow Jam	Winamp
+112	Club	Winamp
+113	Tango	Winamp
+114	Samba	Winamp
+115	Folklore	Winamp
+116	Ballad	Winamp
+117	Power Ballad	Winamp
+118	Rhythmic Soul	Winamp
+119	Freestyle	Winamp
+120	Duet	Winamp
+121	Punk Rock	Winamp
+122	Drum Solo	Winamp
+123	A capella	Winamp
+124	Euro-House	Winamp
+125	Dance Hall	Winamp
+126	Goa	Winamp
+127	Drum & Bass	Winamp
+128	Club-House	Winamp
+129	Hardcore	Winamp
+130	Terror	Winamp
+131	Indie	Winamp
+132	BritPop	Winamp
+133	Afro-Punk	Winamp
+134	Polsk Punk	Winamp
+135	Beat	Winamp
+136	Christian Gangsta Rap	Winamp
+137	Heavy Metal	Winamp
+138	Black Metal	Winamp
+139	Crossover	Winamp
+140	Contemporary Christian	Winamp
+141	Christian Rock	Winamp
+142	Merengue	Winamp
+143	Salsa	Winamp
+144	Thrash Metal	Winamp
+145	Anime	Winamp
+146	JPop	Winamp
+147	Synthpop	Winamp
+148	Abstract	Winamp
+149	Art Rock	Winamp
+150	Baroque	Winamp
+151	Bhangra	Winamp
+152	Big Beat	Winamp
+153	Breakbeat	Winamp
+154	Chillout	Winamp
+155	Downtempo	Winamp
+156	Dub	Winamp
+157	EBM	Winamp
+158	Eclectic	Winamp
+159	Electro	Winamp
+160	Electroclash	Winamp
+161	Emo	Winamp
+162	Experimental	Winamp
+163	Garage	Winamp
+164	Global	Winamp
+165	IDM	Winamp
+166	Illbient	Winamp
+167	Industro-Goth	Winamp
+168	Jam Band	Winamp
+169	Krautrock	Winamp
+170	Leftfield	Winamp
+171	Lounge	Winamp
+172	Math Rock	Winamp
+173	New Romantic	Winamp
+174	Nu-Breakz	Winamp
+175	Post-Punk	Winamp
+176	Post-Rock	Winamp
+177	Psytrance	Winamp
+178	Shoegaze	Winamp
+179	Space Rock	Winamp
+180	Trop Rock	Winamp
+181	World Music	Winamp
+182	Neoclassical	Winamp
+183	Audiobook	Winamp
+184	Audio Theatre	Winamp
+185	Neue Deutsche Welle	Winamp
+186	Podcast	Winamp
+187	Indie Rock	Winamp
+188	G-Funk	Winamp
+189	Dubstep	Winamp
+190	Garage Rock	Winamp
+191	Psybient	Winamp
+`
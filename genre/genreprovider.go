@@ -0,0 +1,381 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package genre implements the methods of a stddata.Provider.
+It provides searches against the genres of ID3v1 tags, with the
+extensions that Winamp made to them, by number and by name. Lookup
+finds the genre that the free text of a genre field stands for,
+forgiving differences in case, punctuation and spelling, so that
+taggers and importers can normalize the genre fields they read.
+Source data is declared in genredata.go.
+*/
+package genre
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/musicbeat/stddata"
+)
+
+// The origins of the genres.
+const (
+	ID3v1  = "ID3v1"  // genres 0 to 79, of the ID3v1 specification
+	Winamp = "Winamp" // genres 80 to 191, added by Winamp
+)
+
+// GenreProvider implements the Provider interface.
+type GenreProvider struct {
+	data atomic.Pointer[genreData] // nil until the data is loaded
+}
+
+// genreData is the data of a GenreProvider. Once it is stored in the
+// GenreProvider it is not modified.
+type genreData struct {
+	size         int
+	info         stddata.Info
+	genreIndexes map[string]genreIndex
+	// genres holds the genres in the order of their numbers, and
+	// normNames holds them by their normalized names.
+	genres    []Genre
+	normNames map[string]Genre
+}
+
+type genreIndex struct {
+	genreMap   map[string][]Genre
+	genreKeys  []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Genre models one entity.
+type Genre struct {
+	ID     int    // the number of the genre, for example 17
+	Name   string // for example "Rock"
+	Origin string // ID3v1 or Winamp
+}
+
+// GenreResult is the interface{} that is returned from Search
+type GenreResult struct {
+	Genres [][]Genre
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *GenreProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *GenreProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &genreData{genreIndexes: make(map[string]genreIndex)}
+	d.genres = nil
+	d.normNames = make(map[string]Genre)
+	idMap := make(map[string][]Genre)
+	nameMap := make(map[string][]Genre)
+	originMap := make(map[string][]Genre)
+
+	reader := csv.NewReader(strings.NewReader(genredata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 3
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var g Genre
+		g.ID, err = strconv.Atoi(record[0])
+		g.Name = record[1]
+		g.Origin = record[2]
+		if err != nil || g.ID < 0 || g.ID > 255 || g.Name == "" {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed genre number %q", line, record[0])
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Genre to the maps
+		id := fmt.Sprintf("%03d", g.ID)
+		idMap[id] = append(idMap[id], g)
+		nameMap[g.Name] = append(nameMap[g.Name], g)
+		originMap[g.Origin] = append(originMap[g.Origin], g)
+		d.genres = append(d.genres, g)
+		if _, found := d.normNames[normalizeName(g.Name)]; !found {
+			d.normNames[normalizeName(g.Name)] = g
+		}
+	}
+	sort.Slice(d.genres, func(i, j int) bool { return d.genres[i].ID < d.genres[j].ID })
+	p.storeData(d, "id", idMap)
+	p.storeData(d, "name", nameMap)
+	p.storeData(d, "origin", originMap)
+	d.size = len(idMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(idMap)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *GenreProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Genre whose number is id, for example 17 for Rock.
+func (p *GenreProvider) Get(id int) (g Genre, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return g, stddata.ErrNotLoaded
+	}
+	genres, found := d.genreIndexes["id"].genreMap[fmt.Sprintf("%03d", id)]
+	if !found {
+		msg := "No genre " + strconv.Itoa(id)
+		return g, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return genres[0], nil
+}
+
+// Lookup returns the Genre that name, the text of a genre field, stands
+// for. A number, such as "17", or a reference to one, such as the
+// "(17)" of an ID3v2 tag, is looked up by Get. A name is matched
+// without regard to case, punctuation or the word "and", so that
+// "hip hop" finds Hip-Hop and "Drum and Bass" finds Drum & Bass, and,
+// failing that, to the genre whose name is closest, by a few letters,
+// so that "Electronica" finds Electronic. An error is returned when no
+// name is close enough.
+func (p *GenreProvider) Lookup(name string) (g Genre, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return g, stddata.ErrNotLoaded
+	}
+	s := strings.TrimSpace(name)
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		s = s[1 : len(s)-1]
+	}
+	if id, err := strconv.Atoi(s); err == nil {
+		return p.Get(id)
+	}
+	norm := normalizeName(s)
+	if g, found := d.normNames[norm]; found {
+		return g, nil
+	}
+	// the genre whose name is within a quarter of the length of the
+	// query, and at least one letter, of it; the lower number wins a tie.
+	best := len(norm)/4 + 1
+	found := false
+	for _, genre := range d.genres {
+		if d := distance(norm, normalizeName(genre.Name)); d < best {
+			g, best, found = genre, d, true
+		}
+	}
+	if !found || norm == "" {
+		msg := "No genre like " + name
+		return Genre{}, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return g, nil
+}
+
+// normalizeName reduces a genre name to its letters and digits, in
+// lower case, without the words "and" and "n": "Rock 'n' Roll" and
+// "Rock & Roll" both become "rockroll".
+func normalizeName(name string) string {
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if word != "and" && word != "n" {
+			b.WriteString(word)
+		}
+	}
+	return b.String()
+}
+
+// distance returns the Levenshtein distance between a and b: the
+// number of letters that must be inserted, deleted or replaced to turn
+// one into the other.
+func distance(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = prev[j-1] + cost
+			if prev[j]+1 < cur[j] {
+				cur[j] = prev[j] + 1
+			}
+			if cur[j-1]+1 < cur[j] {
+				cur[j] = cur[j-1] + 1
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// normalizeID zero-pads a genre number to three digits: "7" becomes
+// "007". Anything else is returned unchanged.
+func normalizeID(s string) string {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || n > 255 || strings.HasPrefix(s, "+") {
+		return s
+	}
+	return fmt.Sprintf("%03d", n)
+}
+
+func (p *GenreProvider) storeData(d *genreData, s string, m map[string][]Genre) {
+	// store the map
+	var gi genreIndex
+	gi.genreMap = m
+	// extract the keys
+	gi.genreKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		gi.genreKeys[i] = k
+		i++
+	}
+	// sort the keys
+	gi.foldedKeys = stddata.SortKeys(gi.genreKeys)
+	// add to genreIndexes
+	d.genreIndexes[s] = gi
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *GenreProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "id", Description: "number of the genre", Example: "17"},
+		{Name: "name", Description: "name of the genre", Example: "Rock"},
+		{Name: "origin", Description: "ID3v1 or Winamp", Example: "Winamp"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *GenreProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	gi := d.genreIndexes[index]
+	return stddata.Suggest(gi.genreKeys, gi.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Genre entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Genres are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// Genre numbers are zero-padded to three digits, so "7", "07" and "007" all
+// find Hip-Hop.
+func (p *GenreProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	gi, found := d.genreIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.genreIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	if index == "id" {
+		query = normalizeID(query)
+	}
+	result = doSearch(gi, query)
+	return result, nil
+}
+
+// SearchGenres is Search, returning the matches as they are, rather
+// than as a GenreResult in an interface{}.
+func (p *GenreProvider) SearchGenres(index string, query string) ([][]Genre, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(GenreResult).Genres, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Genres of each. It yields nothing if the
+// GenreProvider has no such index, or is not loaded.
+func (p *GenreProvider) Range(index string) iter.Seq2[string, []Genre] {
+	return func(yield func(string, []Genre) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		gi := d.genreIndexes[index]
+		for _, k := range gi.genreKeys {
+			if !yield(k, gi.genreMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(gi genreIndex, query string) (res GenreResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(gi.genreKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(gi.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Genres = make([][]Genre, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Genres = append(res.Genres, gi.genreMap[gi.genreKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,86 @@
+package genre
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestGenreProvider(t *testing.T) {
+	expected := 192
+	fmt.Println("Test: GenreProvider.Load")
+	p = new(GenreProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestIDSearch(t *testing.T) {
+	res, err := p.Search("id", "7")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	g := res.(GenreResult).Genres
+	if len(g) != 1 || g[0][0].Name != "Hip-Hop" {
+		t.Fatalf("Expected Hip-Hop, got %v\n", g)
+	}
+}
+func TestOriginSearch(t *testing.T) {
+	res, err := p.Search("origin", "ID3v1")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	g := res.(GenreResult).Genres
+	if len(g) != 1 || len(g[0]) != 80 {
+		t.Fatalf("Expected the 80 ID3v1 genres, got %v\n", g)
+	}
+}
+func TestLookup(t *testing.T) {
+	gp := p.(*GenreProvider)
+	for name, expected := range map[string]int{
+		"17":                17,
+		"(17)":              17,
+		"hip hop":           7,
+		"HIPHOP":            7,
+		"Drum and Bass":     127,
+		"Rock 'n' Roll":     78,
+		"Psychedelic":       67,
+		"Electronica":       52,
+		"rythmic soul":      118,
+		"Christian Gangsta": 136,
+	} {
+		g, err := gp.Lookup(name)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if g.ID != expected {
+			t.Fatalf("Expected %q to find %d, got %v\n", name, expected, g)
+		}
+	}
+	for _, name := range []string{"", "Gregorian chant", "300"} {
+		if g, err := gp.Lookup(name); err == nil {
+			t.Fatalf("Expected no genre like %q, got %v\n", name, g)
+		}
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(GenreProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(GenreProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "id", Query: "17"}, {Index: "name", Query: "rock"}, {Index: "origin", Query: "Winamp"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
@@ -0,0 +1,264 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package iban implements the methods of a stddata.Provider.
+It provides searches against the registry of ISO 13616 International
+Bank Account Number formats: for each country that uses IBANs, the
+length of its IBANs and the structure of its BBAN (Basic Bank Account
+Number). The package also validates IBANs, using the registry and the
+mod-97 check digits, and formats them for print and for storage.
+Source data is declared in ibandata.go
+*/
+package iban
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// IBANProvider implements the Provider interface.
+type IBANProvider struct {
+	data atomic.Pointer[ibanData] // nil until the data is loaded
+}
+
+// ibanData is the data of an IBANProvider. Once it is stored in the
+// IBANProvider it is not modified.
+type ibanData struct {
+	size          int
+	info          stddata.Info
+	formatIndexes map[string]formatIndex
+}
+
+type formatIndex struct {
+	formatMap  map[string][]Format
+	formatKeys []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Format models one entity: the IBAN format of one country.
+type Format struct {
+	CountryCode string // ISO 3166-1 alpha-2 code, the first two characters of the IBAN
+	Length      int    // the length of the IBAN, in its compact form
+	BBAN        string // the structure of the BBAN in the registry's notation, for example "8!n10!n"
+}
+
+// FormatResult is the interface{} that is returned from Search
+type FormatResult struct {
+	Formats [][]Format
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *IBANProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped,
+// and their line numbers are returned in the LoadReport.
+func (p *IBANProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &ibanData{formatIndexes: make(map[string]formatIndex)}
+	countryMap := make(map[string][]Format)
+	lengthMap := make(map[string][]Format)
+
+	reader := csv.NewReader(strings.NewReader(ibandata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 3
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var f Format
+		f.CountryCode = record[0]
+		f.BBAN = record[2]
+		f.Length, err = strconv.Atoi(record[1])
+		if err == nil {
+			var n int
+			n, err = bbanLength(f.BBAN)
+			if err == nil && n+4 != f.Length {
+				err = errors.New("BBAN structure " + f.BBAN + " is not " + strconv.Itoa(f.Length-4) + " characters")
+			}
+		}
+		if err != nil {
+			if mode == stddata.Lenient {
+				line, _ := reader.FieldPos(0)
+				r.Skip(line)
+				continue
+			}
+			line, _ := reader.FieldPos(0)
+			msg := fmt.Sprintf("record on line %d: malformed IBAN format for %s: %v", line, f.CountryCode, err)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Format to the maps
+		countryMap[f.CountryCode] = append(countryMap[f.CountryCode], f)
+		length := strconv.Itoa(f.Length)
+		lengthMap[length] = append(lengthMap[length], f)
+	}
+	p.storeData(d, "country", countryMap)
+	p.storeData(d, "length", lengthMap)
+	d.size = len(countryMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(countryMap)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *IBANProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// FormatOf returns the IBAN format of the country with the ISO 3166-1
+// alpha-2 code countryCode. found is false if the country does not
+// use IBANs.
+func (p *IBANProvider) FormatOf(countryCode string) (f Format, found bool) {
+	d := p.data.Load()
+	if d == nil {
+		return f, false
+	}
+	formats, found := d.formatIndexes["country"].formatMap[strings.ToUpper(countryCode)]
+	if !found {
+		return f, false
+	}
+	return formats[0], true
+}
+
+func (p *IBANProvider) storeData(d *ibanData, s string, m map[string][]Format) {
+	// store the map
+	var si formatIndex
+	si.formatMap = m
+	// extract the keys
+	si.formatKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		si.formatKeys[i] = k
+		i++
+	}
+	// sort the keys
+	si.foldedKeys = stddata.SortKeys(si.formatKeys)
+	// add to formatIndexes
+	d.formatIndexes[s] = si
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *IBANProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "country", Description: "ISO 3166-1 alpha-2 code, the first two characters of the IBAN", Example: "DE"},
+		{Name: "length", Description: "length of the IBAN", Example: "22"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *IBANProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	si := d.formatIndexes[index]
+	return stddata.Suggest(si.formatKeys, si.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Format entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Formats are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+func (p *IBANProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	si, found := d.formatIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.formatIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(si, query)
+	return result, nil
+}
+
+// SearchFormats is Search, returning the matches as they are, rather
+// than as a FormatResult in an interface{}.
+func (p *IBANProvider) SearchFormats(index string, query string) ([][]Format, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(FormatResult).Formats, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Formats of each. It yields nothing if the
+// IBANProvider has no such index, or is not loaded.
+func (p *IBANProvider) Range(index string) iter.Seq2[string, []Format] {
+	return func(yield func(string, []Format) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		si := d.formatIndexes[index]
+		for _, k := range si.formatKeys {
+			if !yield(k, si.formatMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(si formatIndex, query string) (res FormatResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(si.formatKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(si.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Formats = make([][]Format, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Formats = append(res.Formats, si.formatMap[si.formatKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,100 @@
+package iban
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestIBANProvider(t *testing.T) {
+	expected := 88
+	fmt.Println("Test: IBANProvider.Load")
+	p = new(IBANProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestCountrySearch(t *testing.T) {
+	res, err := p.Search("country", "de")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	f := res.(FormatResult).Formats
+	if len(f) != 1 || f[0][0].Length != 22 || f[0][0].BBAN != "8!n10!n" {
+		t.Fatalf("Expected the German format, got %v\n", f)
+	}
+}
+func TestLengthSearch(t *testing.T) {
+	res, err := p.Search("length", "15")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	f := res.(FormatResult).Formats
+	if len(f) != 1 || f[0][0].CountryCode != "NO" {
+		t.Fatalf("Expected Norway, got %v\n", f)
+	}
+}
+func TestValidate(t *testing.T) {
+	valid := []string{
+		"DE89370400440532013000",
+		"GB82 WEST 1234 5698 7654 32",
+		"fr14 2004 1010 0505 0001 3m02 606",
+		"NO9386011117947",
+		"BE68539007547034",
+	}
+	for _, s := range valid {
+		if err := Validate(s); err != nil {
+			t.Fatalf("Expected %s to be valid, got %v\n", s, err)
+		}
+	}
+	invalid := []string{
+		"",
+		"DE88370400440532013000", // check digits
+		"DE8937040044053201300",  // length
+		"GB82WEST1234569876543X", // BBAN structure
+		"US64SVBKUS6S3300958879", // no IBANs
+		"DE89 3704 0044 0532 0130 0!",
+	}
+	for _, s := range invalid {
+		if err := Validate(s); err == nil {
+			t.Fatalf("Expected %s to be invalid\n", s)
+		}
+	}
+}
+func TestFormatting(t *testing.T) {
+	if s := Pretty("de89370400440532013000"); s != "DE89 3704 0044 0532 0130 00" {
+		t.Fatalf("Expected the print form, got %q\n", s)
+	}
+	if s := Compact(" DE89 3704 0044 0532 0130 00 "); s != "DE89370400440532013000" {
+		t.Fatalf("Expected the electronic form, got %q\n", s)
+	}
+	d, err := CheckDigits("DE", "370400440532013000")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if d != "89" {
+		t.Fatalf("Expected check digits 89, got %s\n", d)
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(IBANProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(IBANProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "country", Query: "DE"}, {Index: "length", Query: "22"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
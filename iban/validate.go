@@ -0,0 +1,175 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iban
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/musicbeat/stddata"
+)
+
+// registry is the IBANProvider that backs Validate. It is loaded the
+// first time it is needed.
+var registry struct {
+	once sync.Once
+	p    IBANProvider
+	err  error
+}
+
+// registryProvider returns the loaded IBANProvider behind Validate.
+func registryProvider() (*IBANProvider, error) {
+	registry.once.Do(func() {
+		_, registry.err = registry.p.Load()
+	})
+	return &registry.p, registry.err
+}
+
+// field is one element of a BBAN structure: "8!n" is a field of 8
+// characters of kind 'n'.
+type field struct {
+	length int
+	kind   byte
+}
+
+// parseBBAN parses a BBAN structure in the registry's notation.
+func parseBBAN(s string) (fields []field, err error) {
+	for s != "" {
+		i := strings.IndexByte(s, '!')
+		if i < 1 || i+1 >= len(s) {
+			return nil, errors.New("malformed BBAN structure " + s)
+		}
+		n, err := strconv.Atoi(s[0:i])
+		if err != nil || n < 1 {
+			return nil, errors.New("malformed BBAN structure " + s)
+		}
+		kind := s[i+1]
+		if kind != 'n' && kind != 'a' && kind != 'c' {
+			return nil, errors.New("malformed BBAN structure " + s)
+		}
+		fields = append(fields, field{n, kind})
+		s = s[i+2:]
+	}
+	return fields, nil
+}
+
+// bbanLength returns the number of characters in a BBAN of the
+// structure s.
+func bbanLength(s string) (n int, err error) {
+	fields, err := parseBBAN(s)
+	for _, f := range fields {
+		n += f.length
+	}
+	return n, err
+}
+
+// Compact returns iban in its electronic form: upper case, without
+// spaces. It does not validate iban.
+func Compact(iban string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(iban), ""))
+}
+
+// Pretty returns iban in its print form: upper case, in groups of
+// four characters separated by spaces, for example
+// "DE89 3704 0044 0532 0130 00". It does not validate iban.
+func Pretty(iban string) string {
+	s := Compact(iban)
+	var b strings.Builder
+	for i := 0; i < len(s); i += 4 {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		if i+4 < len(s) {
+			b.WriteString(s[i : i+4])
+		} else {
+			b.WriteString(s[i:])
+		}
+	}
+	return b.String()
+}
+
+// Validate returns nil if iban, in either its print or its
+// electronic form, is a valid IBAN: the country uses IBANs, the
+// length and the structure of the BBAN match the country's format in
+// the registry, and the check digits are correct.
+func Validate(iban string) error {
+	p, err := registryProvider()
+	if err != nil {
+		return err
+	}
+	s := Compact(iban)
+	if len(s) < 5 || !isKind(s[0:2], 'a') || !isKind(s[2:4], 'n') {
+		msg := "IBAN " + iban + " does not begin with a country code and check digits"
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	f, found := p.FormatOf(s[0:2])
+	if !found {
+		msg := "No IBAN format for country " + s[0:2]
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	if len(s) != f.Length {
+		msg := "IBAN " + iban + " is not " + strconv.Itoa(f.Length) + " characters"
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	fields, _ := parseBBAN(f.BBAN)
+	bban := s[4:]
+	for _, fld := range fields {
+		if !isKind(bban[0:fld.length], fld.kind) {
+			msg := "IBAN " + iban + " does not match the BBAN structure " + f.BBAN
+			return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+		}
+		bban = bban[fld.length:]
+	}
+	if mod97(s[4:]+s[0:4]) != 1 {
+		msg := "IBAN " + iban + " has incorrect check digits"
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	return nil
+}
+
+// CheckDigits returns the two check digits of the IBAN of the country
+// with the ISO 3166-1 alpha-2 code countryCode and the BBAN bban, so
+// that an IBAN can be built from a domestic account number.
+func CheckDigits(countryCode string, bban string) (string, error) {
+	s := Compact(bban) + strings.ToUpper(countryCode) + "00"
+	if !isKind(s, 'c') {
+		msg := "BBAN " + bban + " is not upper case letters and digits"
+		return "", stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	d := 98 - mod97(s)
+	return string([]byte{byte('0' + d/10), byte('0' + d%10)}), nil
+}
+
+// isKind reports whether every character of s is of the kind: 'n'
+// for digits, 'a' for upper case letters, and 'c' for either.
+func isKind(s string, kind byte) bool {
+	for i := 0; i < len(s); i++ {
+		digit := s[i] >= '0' && s[i] <= '9'
+		letter := s[i] >= 'A' && s[i] <= 'Z'
+		switch {
+		case kind == 'n' && !digit, kind == 'a' && !letter, kind == 'c' && !digit && !letter:
+			return false
+		}
+	}
+	return true
+}
+
+// mod97 returns the remainder on division by 97 of the number that
+// s represents when each letter is replaced by two digits, A by 10
+// through Z by 35. s must be upper case letters and digits.
+func mod97(s string) int {
+	r := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 'A' {
+			r = (r*100 + int(s[i]-'A') + 10) % 97
+		} else {
+			r = (r*10 + int(s[i]-'0')) % 97
+		}
+	}
+	return r
+}
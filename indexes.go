@@ -0,0 +1,22 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stddata
+
+// IndexDescription describes an index of a Provider, so that a user
+// interface can offer a search of it without knowing the Provider.
+type IndexDescription struct {
+	Name        string // the index, as it is given to Search, for example "alpha2"
+	Description string // what the index is keyed by, for example "ISO 3166-1 alpha-2 code"
+	Example     string // a query that finds something, for example "DE"
+}
+
+// IndexLister is implemented by the Providers that describe their
+// indexes. A Service that serves an IndexLister answers the request
+// "?_indexes" with the descriptions.
+type IndexLister interface {
+	// Indexes returns the descriptions of the indexes that Search
+	// searches.
+	Indexes() []IndexDescription
+}
@@ -0,0 +1,578 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package industry implements the methods of a stddata.Provider.
+It provides searches against two classifications of businesses by
+industry: the North American Industry Classification System (NAICS),
+and the Standard Industrial Classification (SIC) that it replaced,
+which is still found in older records and in SEC filings. Both are
+hierarchies, navigated by Parent, Children and Ancestors: a NAICS
+sector, such as 31-33 Manufacturing, has subsectors, such as 311 Food
+Manufacturing, which have industry groups and industries below them.
+The sectors and subsectors of NAICS, and the divisions and major
+groups of SIC, are declared in industrydata.go. The complete lists,
+which are larger, are read from files supplied by the caller.
+*/
+package industry
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/musicbeat/stddata"
+)
+
+// The classifications.
+const (
+	NAICS = "NAICS"
+	SIC   = "SIC"
+)
+
+// The levels of the classifications, from the top. An industry group
+// is a level of both.
+const (
+	Sector           = "Sector"            // NAICS, two digits or a range of them, for example "31-33"
+	Subsector        = "Subsector"         // NAICS, three digits
+	IndustryGroup    = "Industry Group"    // NAICS, four digits, or SIC, three digits
+	NAICSIndustry    = "NAICS Industry"    // NAICS, five digits
+	NationalIndustry = "National Industry" // NAICS, six digits
+	Division         = "Division"          // SIC, a letter from A to K
+	MajorGroup       = "Major Group"       // SIC, two digits
+	SICIndustry      = "Industry"          // SIC, four digits
+)
+
+// IndustryProvider implements the Provider interface.
+type IndustryProvider struct {
+	// NAICSFile is the path of a complete NAICS list that Load reads
+	// in place of naicsdata, in the format of naicsdata. When it is
+	// empty, the sectors and subsectors in naicsdata are loaded.
+	NAICSFile string
+	// SICFile is the path of a complete SIC list that Load reads in
+	// place of sicdata, in the format of sicdata. When it is empty,
+	// the divisions and major groups in sicdata are loaded.
+	SICFile string
+	data    atomic.Pointer[industryData] // nil until the data is loaded
+}
+
+// industryData is the data of an IndustryProvider. Once it is stored
+// in the IndustryProvider it is not modified.
+type industryData struct {
+	size            int
+	info            stddata.Info
+	industryIndexes map[string]industryIndex
+	// sectors holds the sector of each two-digit NAICS code, for
+	// example "31-33" for "32".
+	sectors map[string]string
+}
+
+type industryIndex struct {
+	industryMap  map[string][]Industry
+	industryKeys []string
+	foldedKeys   []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Industry models one entity.
+type Industry struct {
+	System     string // NAICS or SIC
+	Code       string // for example "311", or "31-33" for a NAICS sector that spans codes
+	Title      string // for example "Food Manufacturing"
+	Level      string // for example Subsector
+	ParentCode string // the code of the industry directly above, in the same System
+}
+
+// IndustryResult is the interface{} that is returned from Search
+type IndustryResult struct {
+	Industries [][]Industry
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *IndustryProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *IndustryProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &industryData{industryIndexes: make(map[string]industryIndex)}
+	d.sectors = make(map[string]string)
+	// the maps of the indexes, by the names of the indexes
+	maps := make(map[string]map[string][]Industry)
+	for _, s := range []string{"naics", "sic", "title", "level", "parent", "word"} {
+		maps[s] = make(map[string][]Industry)
+	}
+
+	n := 0
+	for _, system := range []string{NAICS, SIC} {
+		embedded, file := naicsdata, p.NAICSFile
+		if system == SIC {
+			embedded, file = sicdata, p.SICFile
+		}
+		var data io.Reader = strings.NewReader(embedded)
+		if file != "" {
+			f, err := os.Open(file)
+			if err != nil {
+				return r, stddata.NewSourceError(err.Error(), err)
+			}
+			defer f.Close()
+			data = f
+		}
+		count, err := d.read(system, data, mode, &r, maps)
+		if err != nil {
+			return r, err
+		}
+		n += count
+	}
+	for s, m := range maps {
+		p.storeData(d, s, m)
+	}
+	d.size = n
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	if p.NAICSFile != "" || p.SICFile != "" {
+		d.info.URL = strings.Trim(p.NAICSFile+" "+p.SICFile, " ")
+		d.info.Edition = ""
+	}
+	p.data.Store(d)
+	r.Loaded = n
+	return r, nil
+}
+
+// read reads the codes of system from data. Each industry must follow
+// the industry above it: a NAICS subsector its sector, and a SIC major
+// group its division. The industries are added to the maps of the
+// indexes.
+func (d *industryData) read(system string, data io.Reader, mode stddata.ParseMode, r *stddata.LoadReport, maps map[string]map[string][]Industry) (n int, err error) {
+	reader := csv.NewReader(data)
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 2
+	reader.LazyQuotes = true
+
+	division := ""
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return n, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var i Industry
+		i.System = system
+		i.Code = strings.TrimSpace(record[0])
+		i.Title = strings.TrimSpace(record[1])
+		if system == NAICS {
+			i.Level = naicsLevel(i.Code)
+		} else {
+			i.Level = sicLevel(i.Code)
+		}
+		if i.Level == "" {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed %s code %q", line, system, i.Code)
+			return n, stddata.NewSourceError(msg, nil)
+		}
+		switch i.Level {
+		case Sector:
+			for _, code := range sectorCodes(i.Code) {
+				d.sectors[code] = i.Code
+			}
+		case Subsector:
+			i.ParentCode = d.sectors[i.Code[0:2]]
+		case Division:
+			division = i.Code
+		case MajorGroup:
+			i.ParentCode = division
+		default:
+			i.ParentCode = i.Code[0 : len(i.Code)-1]
+		}
+
+		// add the Industry to the maps
+		if system == NAICS {
+			maps["naics"][i.Code] = append(maps["naics"][i.Code], i)
+			if i.Level == Sector && len(i.Code) > 2 {
+				for _, code := range sectorCodes(i.Code) {
+					maps["naics"][code] = append(maps["naics"][code], i)
+				}
+			}
+		} else {
+			maps["sic"][i.Code] = append(maps["sic"][i.Code], i)
+		}
+		maps["title"][i.Title] = append(maps["title"][i.Title], i)
+		maps["level"][i.Level] = append(maps["level"][i.Level], i)
+		if i.ParentCode != "" {
+			key := system + " " + i.ParentCode
+			maps["parent"][key] = append(maps["parent"][key], i)
+		}
+		for _, word := range titleWords(i.Title) {
+			maps["word"][word] = append(maps["word"][word], i)
+		}
+		n++
+	}
+	return n, nil
+}
+
+// naicsLevel returns the level of a NAICS code, or "" if code is not
+// one.
+func naicsLevel(code string) string {
+	if len(code) == 5 && code[2] == '-' && isDigits(code[0:2]) && isDigits(code[3:5]) {
+		return Sector
+	}
+	if !isDigits(code) {
+		return ""
+	}
+	switch len(code) {
+	case 2:
+		return Sector
+	case 3:
+		return Subsector
+	case 4:
+		return IndustryGroup
+	case 5:
+		return NAICSIndustry
+	case 6:
+		return NationalIndustry
+	}
+	return ""
+}
+
+// sicLevel returns the level of a SIC code, or "" if code is not one.
+func sicLevel(code string) string {
+	if len(code) == 1 && code[0] >= 'A' && code[0] <= 'Z' {
+		return Division
+	}
+	if !isDigits(code) {
+		return ""
+	}
+	switch len(code) {
+	case 2:
+		return MajorGroup
+	case 3:
+		return IndustryGroup
+	case 4:
+		return SICIndustry
+	}
+	return ""
+}
+
+// sectorCodes returns the two-digit codes of a NAICS sector that
+// spans a range of them: "31", "32" and "33" for "31-33". Any other
+// code is returned as is.
+func sectorCodes(code string) []string {
+	if len(code) != 5 || code[2] != '-' {
+		return []string{code}
+	}
+	var codes []string
+	for c := code[0:2]; c <= code[3:5]; c = fmt.Sprintf("%02d", (c[0]-'0')*10+c[1]-'0'+1) {
+		codes = append(codes, c)
+	}
+	return codes
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// titleWords returns the words of title, in lower case, without
+// repetition.
+func titleWords(title string) (words []string) {
+	seen := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// Info describes the provenance of the loaded data.
+func (p *IndustryProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Industry of system, NAICS or SIC, whose code is
+// code. A NAICS sector that spans codes is found by its range, such
+// as "31-33", and by each of its codes.
+func (p *IndustryProvider) Get(system string, code string) (i Industry, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return i, stddata.ErrNotLoaded
+	}
+	return d.get(system, code)
+}
+
+// get returns the Industry of system whose code is code.
+func (d *industryData) get(system string, code string) (i Industry, err error) {
+	system = strings.ToUpper(system)
+	if system != NAICS && system != SIC {
+		msg := "No industry classification " + system
+		return i, stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	industries, found := d.industryIndexes[strings.ToLower(system)].industryMap[strings.ToUpper(code)]
+	if !found {
+		msg := "No " + system + " code " + code
+		return i, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return industries[0], nil
+}
+
+// Parent returns the Industry directly above the industry of system
+// whose code is code. A NAICS sector and a SIC division have no
+// parent, so an error is returned for them.
+func (p *IndustryProvider) Parent(system string, code string) (i Industry, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return i, stddata.ErrNotLoaded
+	}
+	i, err = d.get(system, code)
+	if err != nil {
+		return i, err
+	}
+	if i.ParentCode == "" {
+		msg := "No industry above " + i.System + " " + i.Code
+		return Industry{}, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return d.get(i.System, i.ParentCode)
+}
+
+// Children returns the industries directly below the industry of
+// system whose code is code, in the order of their codes. The
+// children of the NAICS sector 31-33 Manufacturing are its subsectors.
+func (p *IndustryProvider) Children(system string, code string) (industries []Industry, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	i, err := d.get(system, code)
+	if err != nil {
+		return nil, err
+	}
+	return d.industryIndexes["parent"].industryMap[i.System+" "+i.Code], nil
+}
+
+// Ancestors returns the industries above the industry of system whose
+// code is code, from its parent up to its sector or division.
+func (p *IndustryProvider) Ancestors(system string, code string) (industries []Industry, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	i, err := d.get(system, code)
+	if err != nil {
+		return nil, err
+	}
+	for i.ParentCode != "" {
+		if i, err = d.get(i.System, i.ParentCode); err != nil {
+			return nil, err
+		}
+		industries = append(industries, i)
+	}
+	return industries, nil
+}
+
+// SearchTitles returns the industries whose titles contain every word
+// of query, in any case and in any order, each word matching the start
+// of a word of the title: "food manuf" finds 311 Food Manufacturing.
+// The industries are in the order of their systems and codes.
+func (p *IndustryProvider) SearchTitles(query string) (industries []Industry, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	words := titleWords(query)
+	if len(words) == 0 {
+		msg := "No words in query " + query
+		return nil, stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	// count the words that each industry matches
+	type key struct{ system, code string }
+	matched := make(map[key]int)
+	found := make(map[key]Industry)
+	for _, word := range words {
+		seen := make(map[key]bool)
+		for _, matches := range doSearch(d.industryIndexes["word"], word).Industries {
+			for _, i := range matches {
+				k := key{i.System, i.Code}
+				if !seen[k] {
+					seen[k] = true
+					matched[k]++
+					found[k] = i
+				}
+			}
+		}
+	}
+	for k, n := range matched {
+		if n == len(words) {
+			industries = append(industries, found[k])
+		}
+	}
+	sort.Slice(industries, func(a, b int) bool {
+		if industries[a].System != industries[b].System {
+			return industries[a].System < industries[b].System
+		}
+		return industries[a].Code < industries[b].Code
+	})
+	return industries, nil
+}
+
+func (p *IndustryProvider) storeData(d *industryData, s string, m map[string][]Industry) {
+	// store the map
+	var ii industryIndex
+	ii.industryMap = m
+	// extract the keys
+	ii.industryKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		ii.industryKeys[i] = k
+		i++
+	}
+	// sort the keys
+	ii.foldedKeys = stddata.SortKeys(ii.industryKeys)
+	// add to industryIndexes
+	d.industryIndexes[s] = ii
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *IndustryProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "naics", Description: "NAICS code", Example: "311"},
+		{Name: "sic", Description: "SIC code", Example: "20"},
+		{Name: "title", Description: "title", Example: "Food Manufacturing"},
+		{Name: "level", Description: "level, such as Sector or Subsector", Example: "Subsector"},
+		{Name: "parent", Description: "system and code of the industry above", Example: "NAICS 31-33"},
+		{Name: "word", Description: "word of the titles, in lower case", Example: "food"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *IndustryProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ii := d.industryIndexes[index]
+	return stddata.Suggest(ii.industryKeys, ii.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Industry entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Industries are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The naics and sic indexes are keyed by code, so that a prefix finds an industry
+// and the industries below it: "311" finds Food Manufacturing and its industries.
+// A NAICS sector that spans codes is keyed by each of them. The parent index is
+// keyed by the system and code of the parent, such as "NAICS 311", and the word
+// index by each word of the titles, in lower case.
+func (p *IndustryProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	ii, found := d.industryIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.industryIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(ii, query)
+	return result, nil
+}
+
+// SearchIndustries is Search, returning the matches as they are, rather
+// than as an IndustryResult in an interface{}.
+func (p *IndustryProvider) SearchIndustries(index string, query string) ([][]Industry, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(IndustryResult).Industries, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Industries of each. It yields nothing if the
+// IndustryProvider has no such index, or is not loaded.
+func (p *IndustryProvider) Range(index string) iter.Seq2[string, []Industry] {
+	return func(yield func(string, []Industry) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ii := d.industryIndexes[index]
+		for _, k := range ii.industryKeys {
+			if !yield(k, ii.industryMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(ii industryIndex, query string) (res IndustryResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ii.industryKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ii.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Industries = make([][]Industry, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Industries = append(res.Industries, ii.industryMap[ii.industryKeys[k]])
+	}
+	return res
+}
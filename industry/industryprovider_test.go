@@ -0,0 +1,122 @@
+package industry
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestIndustryProvider(t *testing.T) {
+	expected := 210
+	fmt.Println("Test: IndustryProvider.Load")
+	p = new(IndustryProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestCodeSearch(t *testing.T) {
+	res, err := p.Search("naics", "32")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	i := res.(IndustryResult).Industries
+	if len(i) != 8 || i[0][0].Code != "31-33" || i[1][0].Code != "321" {
+		t.Fatalf("Expected Manufacturing and its 32x subsectors, got %v\n", i)
+	}
+	res, err = p.Search("level", "Division")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if i := res.(IndustryResult).Industries; len(i) != 1 || len(i[0]) != 11 {
+		t.Fatalf("Expected 11 SIC divisions, got %v\n", i)
+	}
+}
+func TestHierarchy(t *testing.T) {
+	ip := p.(*IndustryProvider)
+	i, err := ip.Parent("naics", "311")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if i.Code != "31-33" || i.Title != "Manufacturing" {
+		t.Fatalf("Expected Manufacturing, got %v\n", i)
+	}
+	children, err := ip.Children(NAICS, "33")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(children) != 21 || children[0].Code != "311" {
+		t.Fatalf("Expected the 21 manufacturing subsectors, got %v\n", children)
+	}
+	i, err = ip.Parent(SIC, "58")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if i.Code != "G" || i.Level != Division {
+		t.Fatalf("Expected division G, got %v\n", i)
+	}
+	if _, err = ip.Parent(NAICS, "52"); err == nil {
+		t.Fatalf("Expected no industry above a sector\n")
+	}
+	if _, err = ip.Get("ISIC", "01"); err == nil {
+		t.Fatalf("Expected no ISIC\n")
+	}
+}
+func TestSearchTitles(t *testing.T) {
+	ip := p.(*IndustryProvider)
+	industries, err := ip.SearchTitles("manuf food")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(industries) != 1 || industries[0].Code != "311" {
+		t.Fatalf("Expected Food Manufacturing, got %v\n", industries)
+	}
+	industries, err = ip.SearchTitles("Real Estate")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(industries) != 4 || industries[0].System != NAICS || industries[3].Code != "H" {
+		t.Fatalf("Expected 2 NAICS and 2 SIC industries, got %v\n", industries)
+	}
+}
+func TestNAICSFile(t *testing.T) {
+	list := "31-33\tManufacturing\n311\tFood Manufacturing\n3111\tAnimal Food Manufacturing\n31111\tAnimal Food Manufacturing\n311111\tDog and Cat Food Manufacturing\n"
+	file := filepath.Join(t.TempDir(), "naics.txt")
+	if err := os.WriteFile(file, []byte(list), 0644); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	ip := &IndustryProvider{NAICSFile: file}
+	if _, err := ip.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	ancestors, err := ip.Ancestors(NAICS, "311111")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(ancestors) != 4 || ancestors[0].Level != NAICSIndustry || ancestors[3].Code != "31-33" {
+		t.Fatalf("Expected four levels above, got %v\n", ancestors)
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(IndustryProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(IndustryProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "naics", Query: "311"}, {Index: "title", Query: "food"}, {Index: "word", Query: "food"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
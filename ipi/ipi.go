@@ -0,0 +1,137 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package ipi parses and validates the numbers of the Interested Parties
+Information system that CISAC societies use to identify the writers
+and publishers of musical works in rights-management feeds, such as
+CWR. An interested party has one base number, such as "I-000000229-7",
+and a name number, such as "00052500029", for each of its names.
+ParseNameNumber and ParseBaseNumber check that a number is well formed
+and that its check digits are correct.
+*/
+package ipi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NameNumber is an IPI name number.
+type NameNumber struct {
+	Number      string // nine digits, for example "000525000"
+	CheckDigits string // two digits, for example "29"
+}
+
+// String returns the name number as its eleven digits, for example
+// "00052500029".
+func (n NameNumber) String() string {
+	return n.Number + n.CheckDigits
+}
+
+// BaseNumber is an IPI base number.
+type BaseNumber struct {
+	Number     string // nine digits, for example "000000229"
+	CheckDigit string // for example "7"
+}
+
+// String returns the base number in its display form, for example
+// "I-000000229-7".
+func (n BaseNumber) String() string {
+	return "I-" + n.Number + "-" + n.CheckDigit
+}
+
+// Error is returned for an IPI number that is malformed, or whose
+// check digits are incorrect.
+type Error struct {
+	Number string // the number
+	Msg    string // what is wrong with the number
+}
+
+// Error implements the built-in error interface on Error.
+func (e *Error) Error() string {
+	return e.Msg + " in IPI number " + strconv.Quote(e.Number)
+}
+
+// compact returns s without spaces, hyphens and dots, in upper case.
+func compact(s string) string {
+	s = strings.ToUpper(strings.Join(strings.Fields(s), ""))
+	return strings.NewReplacer("-", "", ".", "").Replace(s)
+}
+
+// ParseNameNumber checks that s is a well formed name number, of
+// eleven digits, and that its check digits are correct. A name number
+// with its leading zeros left out, as spreadsheets leave them out, is
+// accepted.
+func ParseNameNumber(s string) (n NameNumber, err error) {
+	code := compact(s)
+	if len(code) < 11 && len(code) > 2 && isDigit(code) {
+		code = strings.Repeat("0", 11-len(code)) + code
+	}
+	if len(code) != 11 || !isDigit(code) {
+		return n, &Error{s, "Not 11 digits"}
+	}
+	n = NameNumber{code[0:9], code[9:11]}
+	if d, _ := NameCheckDigits(n.Number); d != n.CheckDigits {
+		return n, &Error{s, "Incorrect check digits " + strconv.Quote(n.CheckDigits)}
+	}
+	return n, nil
+}
+
+// NameCheckDigits returns the two check digits of the nine digit name
+// number number: the digits are weighted from 10 down to 2, and the
+// check digits bring the sum to a multiple of 101.
+func NameCheckDigits(number string) (string, error) {
+	if len(number) != 9 || !isDigit(number) {
+		return "", &Error{number, "Number not 9 digits"}
+	}
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(number[i]-'0')
+	}
+	c := 0
+	if sum%101 != 0 {
+		c = (101 - sum%101) % 100
+	}
+	return fmt.Sprintf("%02d", c), nil
+}
+
+// ParseBaseNumber checks that s is a well formed base number, "I", nine
+// digits and a check digit, with or without hyphens, and that its check
+// digit is correct.
+func ParseBaseNumber(s string) (n BaseNumber, err error) {
+	code := compact(s)
+	if len(code) != 11 || code[0] != 'I' || !isDigit(code[1:]) {
+		return n, &Error{s, "Not \"I\" and 10 digits"}
+	}
+	n = BaseNumber{code[1:10], code[10:11]}
+	if d, _ := BaseCheckDigit(n.Number); d != n.CheckDigit {
+		return n, &Error{s, "Incorrect check digit " + strconv.Quote(n.CheckDigit)}
+	}
+	return n, nil
+}
+
+// BaseCheckDigit returns the check digit of the nine digit base number
+// number: each digit is weighted by its place, from 1 to 9, and added
+// to 2, and the check digit brings the sum to a multiple of 10.
+func BaseCheckDigit(number string) (string, error) {
+	if len(number) != 9 || !isDigit(number) {
+		return "", &Error{number, "Number not 9 digits"}
+	}
+	sum := 2
+	for i := 0; i < 9; i++ {
+		sum += (i + 1) * int(number[i]-'0')
+	}
+	return strconv.Itoa((10 - sum%10) % 10), nil
+}
+
+func isDigit(s string) bool {
+	for _, r := range s {
+		if !(r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
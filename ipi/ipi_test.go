@@ -0,0 +1,48 @@
+package ipi
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseNameNumber(t *testing.T) {
+	fmt.Println("Test: ipi.ParseNameNumber")
+	for _, s := range []string{"00052500029", "52500029", " 000.525.000.29 "} {
+		n, err := ParseNameNumber(s)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if n.String() != "00052500029" {
+			t.Fatalf("Expected %q to parse as 00052500029, got %s\n", s, n)
+		}
+	}
+	for _, s := range []string{"", "00052500028", "000525000290", "0005250002A"} {
+		if _, err := ParseNameNumber(s); err == nil {
+			t.Fatalf("Expected %q to be invalid\n", s)
+		}
+	}
+	d, err := NameCheckDigits("001234567")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if d != "90" {
+		t.Fatalf("Expected check digits 90, got %s\n", d)
+	}
+}
+func TestParseBaseNumber(t *testing.T) {
+	for _, s := range []string{"I-000000229-7", "i0000002297", "I-000.000.229-7"} {
+		n, err := ParseBaseNumber(s)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if n.String() != "I-000000229-7" {
+			t.Fatalf("Expected %q to parse as I-000000229-7, got %s\n", s, n)
+		}
+	}
+	for _, s := range []string{"", "I-000000229-8", "T-000000229-7", "I-00000229-7"} {
+		if _, err := ParseBaseNumber(s); err == nil {
+			t.Fatalf("Expected %q to be invalid\n", s)
+		}
+	}
+}
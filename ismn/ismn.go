@@ -0,0 +1,134 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package ismn parses and validates International Standard Music
+Numbers (ISO 10957), such as "979-0-2306-7118-7", which identify
+publications of notated music. Parse accepts the 13 digit form, and
+the 10 character form used before 2008, such as "M-2306-7118-7",
+which has the same check digit, checks the check digit, and splits
+the number into its publisher and item elements, so that it can be
+displayed with its hyphens in place.
+*/
+package ismn
+
+import (
+	"strconv"
+	"strings"
+)
+
+// prefix is the EAN prefix that all ISMNs share, in place of the "M"
+// of the 10 character form.
+const prefix = "9790"
+
+// ISMN is a music number, split into its elements.
+type ISMN struct {
+	Publisher  string // three to seven digits, for example "2306"
+	Item       string // one to five digits, for example "7118"
+	CheckDigit string // for example "7"
+}
+
+// String returns the number in its compact 13 digit form, for example
+// "9790230671187", as it is stored and exchanged.
+func (n ISMN) String() string {
+	return prefix + n.Publisher + n.Item + n.CheckDigit
+}
+
+// Display returns the number in its canonical display form, with its
+// elements separated by hyphens, for example "979-0-2306-7118-7".
+func (n ISMN) Display() string {
+	return "979-0-" + n.Publisher + "-" + n.Item + "-" + n.CheckDigit
+}
+
+// ISMN10 returns the number in the 10 character form used before 2008,
+// for example "M-2306-7118-7".
+func (n ISMN) ISMN10() string {
+	return "M-" + n.Publisher + "-" + n.Item + "-" + n.CheckDigit
+}
+
+// Error is returned for an ISMN that is malformed, or whose check
+// digit is incorrect.
+type Error struct {
+	ISMN string // the number
+	Msg  string // what is wrong with the number
+}
+
+// Error implements the built-in error interface on Error.
+func (e *Error) Error() string {
+	return e.Msg + " in ISMN " + strconv.Quote(e.ISMN)
+}
+
+// ranges are the ranges of publisher elements, by their length: the
+// shorter the publisher element, the longer the item element.
+var ranges = []struct {
+	first string
+	last  string
+}{
+	{"000", "099"},
+	{"1000", "3999"},
+	{"40000", "69999"},
+	{"700000", "899999"},
+	{"9000000", "9999999"},
+}
+
+// Compact returns s without hyphens and spaces, in upper case, without
+// the "ISMN" that often precedes a number, and in its 13 digit form: a
+// leading "M" is replaced by "9790". It does not validate s.
+func Compact(s string) string {
+	s = strings.ToUpper(strings.Join(strings.Fields(s), ""))
+	s = strings.Replace(s, "-", "", -1)
+	if strings.HasPrefix(s, "ISMN") {
+		s = s[4:]
+	}
+	if strings.HasPrefix(s, "M") {
+		s = prefix + s[1:]
+	}
+	return s
+}
+
+// Parse checks that s is a well formed ISMN, in its 13 digit or its 10
+// character form, with or without hyphens and a leading "ISMN", and
+// that its check digit is correct.
+func Parse(s string) (n ISMN, err error) {
+	code := Compact(s)
+	if len(code) != 13 || !isDigit(code) || !strings.HasPrefix(code, prefix) {
+		return n, &Error{s, "Not \"979-0\" and 9 digits"}
+	}
+	if d, _ := CheckDigit(code[0:12]); d != code[12:13] {
+		return n, &Error{s, "Incorrect check digit " + strconv.Quote(code[12:13])}
+	}
+	number := code[4:12]
+	for _, r := range ranges {
+		if p := number[0:len(r.first)]; p >= r.first && p <= r.last {
+			return ISMN{p, number[len(p):], code[12:13]}, nil
+		}
+	}
+	// the ranges cover every publisher element, so this is not reached
+	return n, &Error{s, "No publisher element"}
+}
+
+// CheckDigit returns the check digit of an ISMN whose other digits are
+// number, such as "979-0-2306-7118" or "M-2306-7118": the digits are
+// weighted by 1 and 3 in turn, as in an EAN-13, and the check digit
+// brings the sum to a multiple of 10.
+func CheckDigit(number string) (string, error) {
+	number = Compact(number)
+	if len(number) != 12 || !isDigit(number) {
+		return "", &Error{number, "Number not 12 digits"}
+	}
+	sum := 0
+	for i := 0; i < 12; i++ {
+		sum += (1 + 2*(i%2)) * int(number[i]-'0')
+	}
+	return strconv.Itoa((10 - sum%10) % 10), nil
+}
+
+func isDigit(s string) bool {
+	for _, r := range s {
+		if !(r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package ismn
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	fmt.Println("Test: ismn.Parse")
+	for _, s := range []string{
+		"979-0-2306-7118-7",
+		"9790230671187",
+		"ISMN 979-0-2306-7118-7",
+		"M-2306-7118-7",
+		"m230671187",
+	} {
+		n, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if n.String() != "9790230671187" || n.Display() != "979-0-2306-7118-7" || n.ISMN10() != "M-2306-7118-7" {
+			t.Fatalf("Expected %q to parse as 979-0-2306-7118-7, got %s\n", s, n.Display())
+		}
+	}
+	for _, s := range []string{"", "979-0-2306-7118-8", "978-0-2306-7118-7", "M-2306-7118", "979-0-2306-711A-7"} {
+		if _, err := Parse(s); err == nil {
+			t.Fatalf("Expected %q to be invalid\n", s)
+		}
+	}
+}
+func TestElements(t *testing.T) {
+	for number, expected := range map[string]string{
+		"979000000001": "979-0-000-00001",
+		"979040000000": "979-0-40000-000",
+		"979090000000": "979-0-9000000-0",
+	} {
+		d, err := CheckDigit(number)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		n, err := Parse(number + d)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if n.Display() != expected+"-"+d {
+			t.Fatalf("Expected %s-%s, got %s\n", expected, d, n.Display())
+		}
+	}
+}
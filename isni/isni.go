@@ -0,0 +1,111 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package isni parses and validates International Standard Name
+Identifiers (ISO 27729), such as "0000 0001 2281 955X", which identify
+the public identities of the people and organizations that create,
+perform and publish works: artists, writers and publishers among
+them. Parse checks that an identifier is well formed and that its
+check character is correct, and CheckCharacter computes it.
+*/
+package isni
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ISNI is a name identifier.
+type ISNI struct {
+	Number         string // fifteen digits, for example "000000012281955"
+	CheckCharacter string // a digit or "X", for example "X"
+}
+
+// String returns the identifier in its compact form, for example
+// "000000012281955X", as it is stored and exchanged.
+func (n ISNI) String() string {
+	return n.Number + n.CheckCharacter
+}
+
+// Display returns the identifier in its display form, in four blocks
+// of four characters, for example "0000 0001 2281 955X".
+func (n ISNI) Display() string {
+	s := n.String()
+	return s[0:4] + " " + s[4:8] + " " + s[8:12] + " " + s[12:16]
+}
+
+// URI returns the URI of the identifier's record at the ISNI
+// International Agency, for example "https://isni.org/isni/000000012281955X".
+func (n ISNI) URI() string {
+	return "https://isni.org/isni/" + n.String()
+}
+
+// Error is returned for an ISNI that is malformed, or whose check
+// character is incorrect.
+type Error struct {
+	ISNI string // the identifier
+	Msg  string // what is wrong with the identifier
+}
+
+// Error implements the built-in error interface on Error.
+func (e *Error) Error() string {
+	return e.Msg + " in ISNI " + strconv.Quote(e.ISNI)
+}
+
+// Compact returns s without spaces and hyphens, in upper case, and
+// without the "ISNI" that often precedes an identifier, or the prefix
+// of its URI. It does not validate s.
+func Compact(s string) string {
+	s = strings.ToUpper(strings.Join(strings.Fields(s), ""))
+	s = strings.Replace(s, "-", "", -1)
+	for _, prefix := range []string{"HTTPS://ISNI.ORG/ISNI/", "HTTP://ISNI.ORG/ISNI/", "ISNI:", "ISNI"} {
+		if strings.HasPrefix(s, prefix) {
+			return s[len(prefix):]
+		}
+	}
+	return s
+}
+
+// Parse checks that s is a well formed ISNI, in its display or compact
+// form, with or without a leading "ISNI", or as its URI, and that its
+// check character is correct.
+func Parse(s string) (n ISNI, err error) {
+	code := Compact(s)
+	if len(code) != 16 || !isDigit(code[0:15]) {
+		return n, &Error{s, "Not 15 digits and a check character"}
+	}
+	n = ISNI{code[0:15], code[15:16]}
+	if c, _ := CheckCharacter(n.Number); c != n.CheckCharacter {
+		return n, &Error{s, "Incorrect check character " + strconv.Quote(n.CheckCharacter)}
+	}
+	return n, nil
+}
+
+// CheckCharacter returns the check character of the fifteen digit
+// number, by ISO 7064 MOD 11-2: a digit, or "X" for 10.
+func CheckCharacter(number string) (string, error) {
+	number = Compact(number)
+	if len(number) != 15 || !isDigit(number) {
+		return "", &Error{number, "Number not 15 digits"}
+	}
+	p := 0
+	for i := 0; i < 15; i++ {
+		p = (p + int(number[i]-'0')) * 2 % 11
+	}
+	c := (12 - p) % 11
+	if c == 10 {
+		return "X", nil
+	}
+	return strconv.Itoa(c), nil
+}
+
+func isDigit(s string) bool {
+	for _, r := range s {
+		if !(r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
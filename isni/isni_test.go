@@ -0,0 +1,40 @@
+package isni
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	fmt.Println("Test: isni.Parse")
+	for _, s := range []string{
+		"0000 0001 2281 955X",
+		"000000012281955x",
+		"ISNI 0000 0001 2281 955X",
+		"0000-0001-2281-955X",
+		"https://isni.org/isni/000000012281955X",
+	} {
+		n, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if n.String() != "000000012281955X" || n.Display() != "0000 0001 2281 955X" {
+			t.Fatalf("Expected %q to parse as 0000 0001 2281 955X, got %s\n", s, n.Display())
+		}
+	}
+	for _, s := range []string{"", "0000 0001 2281 9550", "0000 0001 2281 955", "X000 0001 2281 955X"} {
+		if _, err := Parse(s); err == nil {
+			t.Fatalf("Expected %q to be invalid\n", s)
+		}
+	}
+}
+func TestCheckCharacter(t *testing.T) {
+	c, err := CheckCharacter("0000 0002 1825 009")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if c != "7" {
+		t.Fatalf("Expected check character 7, got %s\n", c)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package isrc parses and validates International Standard Recording
+Codes (ISO 3901), such as "US-RC1-76-07839", which identify sound and
+music video recordings. Parse checks that a code is well formed, in
+its display form, with hyphens, or its compact form, without, and
+puts it in upper case. A Parser also checks that the country element
+is an assigned code, using the country provider.
+*/
+package isrc
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/musicbeat/stddata/country"
+)
+
+// ISRC is a recording code, split into its elements.
+type ISRC struct {
+	Country     string // ISO 3166-1 alpha-2 code of the allocating agency, for example "US"
+	Registrant  string // three letters or digits, for example "RC1"
+	Year        string // the last two digits of the year of reference, for example "76"
+	Designation string // five digits, for example "07839"
+}
+
+// String returns the code in its compact form, for example
+// "USRC17607839", as it is stored and exchanged.
+func (c ISRC) String() string {
+	return c.Country + c.Registrant + c.Year + c.Designation
+}
+
+// Display returns the code in its display form, with its elements
+// separated by hyphens, for example "US-RC1-76-07839", as it is
+// printed on a release.
+func (c ISRC) Display() string {
+	return c.Country + "-" + c.Registrant + "-" + c.Year + "-" + c.Designation
+}
+
+// Error is returned for an ISRC that is malformed, or that has a
+// country element which is not an assigned code.
+type Error struct {
+	ISRC    string // the code
+	Element string // the offending element
+	Msg     string // what is wrong with the element
+}
+
+// Error implements the built-in error interface on Error.
+func (e *Error) Error() string {
+	return e.Msg + " " + strconv.Quote(e.Element) + " in ISRC " + strconv.Quote(e.ISRC)
+}
+
+// Compact returns s without hyphens and spaces, in upper case, and
+// without the "ISRC" that often precedes a code. It does not validate s.
+func Compact(s string) string {
+	s = strings.ToUpper(strings.Join(strings.Fields(s), ""))
+	s = strings.Replace(s, "-", "", -1)
+	if len(s) == 16 && strings.HasPrefix(s, "ISRC") {
+		s = s[4:]
+	}
+	return s
+}
+
+// Parse checks that s is a well formed ISRC, in its display or compact
+// form, in any case, with or without a leading "ISRC", and returns its
+// elements in upper case. In the display form the hyphens must separate
+// the elements.
+func Parse(s string) (c ISRC, err error) {
+	if t := strings.TrimSpace(s); strings.Contains(t, "-") {
+		if strings.HasPrefix(strings.ToUpper(t), "ISRC") {
+			t = strings.TrimSpace(t[4:])
+		}
+		elements := strings.Split(t, "-")
+		if len(elements) != 4 || len(elements[0]) != 2 || len(elements[1]) != 3 || len(elements[2]) != 2 || len(elements[3]) != 5 {
+			return c, &Error{s, t, "Misplaced hyphens"}
+		}
+	}
+	code := Compact(s)
+	if len(code) != 12 {
+		return c, &Error{s, code, "Not 12 characters"}
+	}
+	c = ISRC{code[0:2], code[2:5], code[5:7], code[7:12]}
+	if !isAlpha(c.Country) {
+		return c, &Error{s, c.Country, "Malformed country code"}
+	}
+	if !isAlnum(c.Registrant) {
+		return c, &Error{s, c.Registrant, "Malformed registrant code"}
+	}
+	if !isDigit(c.Year) {
+		return c, &Error{s, c.Year, "Malformed year of reference"}
+	}
+	if !isDigit(c.Designation) {
+		return c, &Error{s, c.Designation, "Malformed designation code"}
+	}
+	return c, nil
+}
+
+// Parser validates ISRCs against the provider it is given. A nil
+// provider is not consulted, so the zero Parser only checks that codes
+// are well formed.
+type Parser struct {
+	Countries *country.CountryProvider // a loaded provider, to validate country codes
+}
+
+// Result is an ISRC that has been validated by a Parser, with the
+// country of its allocating agency.
+type Result struct {
+	ISRC    ISRC            // the code
+	Country country.Country // the country, when Countries was consulted and the code is not a reserved one
+}
+
+// Parse parses s, as the package's Parse does, and checks that its
+// country element is an assigned ISO 3166-1 alpha-2 code. The codes
+// that ISO 3166 reserves for user assignment, such as QM and QZ, which
+// the International ISRC Agency allocates to national agencies when
+// their country's code runs out, and ZZ, which it uses itself, are
+// accepted without a Country.
+func (p *Parser) Parse(s string) (r Result, err error) {
+	c, err := Parse(s)
+	if err != nil {
+		return r, err
+	}
+	if p.Countries != nil && !isReserved(c.Country) {
+		if r.Country, err = p.Countries.GetByAlpha2(c.Country); err != nil {
+			return r, &Error{s, c.Country, "Unknown country code"}
+		}
+	}
+	r.ISRC = c
+	return r, nil
+}
+
+// isReserved reports whether the alpha-2 code is one of those reserved
+// for user assignment: AA, QM-QZ, XA-XZ and ZZ.
+func isReserved(s string) bool {
+	return s == "AA" || s == "ZZ" || (s >= "QM" && s <= "QZ") || s[0] == 'X'
+}
+
+func isAlnum(s string) bool {
+	for _, r := range s {
+		if !(r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if !(r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigit(s string) bool {
+	for _, r := range s {
+		if !(r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
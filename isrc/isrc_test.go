@@ -0,0 +1,64 @@
+package isrc
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	"github.com/musicbeat/stddata/country"
+)
+
+var parser Parser
+
+func TestLoadProviders(t *testing.T) {
+	fmt.Println("Test: isrc.Parser")
+	parser.Countries = new(country.CountryProvider)
+	if _, err := parser.Countries.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+}
+func TestParse(t *testing.T) {
+	for _, s := range []string{
+		"USRC17607839",
+		"US-RC1-76-07839",
+		"us-rc1-76-07839",
+		"ISRC US-RC1-76-07839",
+		"isrc USRC17607839",
+		" USRC1 7607839 ",
+	} {
+		c, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if c.String() != "USRC17607839" || c.Display() != "US-RC1-76-07839" {
+			t.Fatalf("Expected %q to parse as US-RC1-76-07839, got %s\n", s, c.Display())
+		}
+	}
+	for _, s := range []string{"", "USRC1760783", "USRC176078390", "U1RC17607839", "US-RC!-76-07839", "USRC1A607839", "USRC176O7839", "USR-C1-76-07839"} {
+		if _, err := Parse(s); err == nil {
+			t.Fatalf("Expected %q to be malformed\n", s)
+		}
+	}
+	if s := Compact("isrc gb-aye-03-00001"); s != "GBAYE0300001" {
+		t.Fatalf("Expected the compact form, got %q\n", s)
+	}
+}
+func TestParserParse(t *testing.T) {
+	r, err := parser.Parse("GB-AYE-03-00001")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Country.Alpha2Code != "GB" {
+		t.Fatalf("Expected the United Kingdom, got %v\n", r.Country)
+	}
+	r, err = parser.Parse("QM-ABC-21-00001")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Country.Alpha2Code != "" {
+		t.Fatalf("Expected no country for a reserved code, got %v\n", r.Country)
+	}
+	if _, err = parser.Parse("UK-ABC-21-00001"); err == nil {
+		t.Fatalf("Expected an unknown country code\n")
+	}
+}
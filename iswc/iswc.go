@@ -0,0 +1,98 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package iswc parses and validates International Standard Musical Work
+Codes (ISO 15707), such as "T-034.524.680-1", which identify musical
+works, as distinct from their recordings, which have ISRCs. Parse
+checks that a code is well formed and that its check digit is
+correct, and CheckDigit computes the check digit of a work number.
+*/
+package iswc
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ISWC is a musical work code, split into its elements. The prefix
+// element is always "T".
+type ISWC struct {
+	Work       string // nine digits, for example "034524680"
+	CheckDigit string // for example "1"
+}
+
+// String returns the code in its compact form, for example
+// "T0345246801", as it is stored and exchanged.
+func (c ISWC) String() string {
+	return "T" + c.Work + c.CheckDigit
+}
+
+// Display returns the code in its canonical display form, with the
+// work number in groups of three digits, for example "T-034.524.680-1".
+func (c ISWC) Display() string {
+	return "T-" + c.Work[0:3] + "." + c.Work[3:6] + "." + c.Work[6:9] + "-" + c.CheckDigit
+}
+
+// Error is returned for an ISWC that is malformed, or whose check
+// digit is incorrect.
+type Error struct {
+	ISWC string // the code
+	Msg  string // what is wrong with the code
+}
+
+// Error implements the built-in error interface on Error.
+func (e *Error) Error() string {
+	return e.Msg + " in ISWC " + strconv.Quote(e.ISWC)
+}
+
+// Compact returns s without hyphens, dots and spaces, in upper case,
+// and without the "ISWC" that often precedes a code. It does not
+// validate s.
+func Compact(s string) string {
+	s = strings.ToUpper(strings.Join(strings.Fields(s), ""))
+	s = strings.NewReplacer("-", "", ".", "").Replace(s)
+	if len(s) == 15 && strings.HasPrefix(s, "ISWC") {
+		s = s[4:]
+	}
+	return s
+}
+
+// Parse checks that s is a well formed ISWC, in its display or compact
+// form, in any case, with or without a leading "ISWC", and that its
+// check digit is correct.
+func Parse(s string) (c ISWC, err error) {
+	code := Compact(s)
+	if len(code) != 11 || code[0] != 'T' || !isDigit(code[1:]) {
+		return c, &Error{s, "Not \"T\" and 10 digits"}
+	}
+	c = ISWC{code[1:10], code[10:11]}
+	if d, _ := CheckDigit(c.Work); d != c.CheckDigit {
+		return c, &Error{s, "Incorrect check digit " + strconv.Quote(c.CheckDigit)}
+	}
+	return c, nil
+}
+
+// CheckDigit returns the check digit of the nine digit work number
+// work: each digit is weighted by its place, from 1 to 9, and added to
+// 1, for the "T", and the check digit brings the sum to a multiple of 10.
+func CheckDigit(work string) (string, error) {
+	if len(work) != 9 || !isDigit(work) {
+		return "", &Error{work, "Work number not 9 digits"}
+	}
+	sum := 1
+	for i := 0; i < 9; i++ {
+		sum += (i + 1) * int(work[i]-'0')
+	}
+	return strconv.Itoa((10 - sum%10) % 10), nil
+}
+
+func isDigit(s string) bool {
+	for _, r := range s {
+		if !(r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package iswc
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	fmt.Println("Test: iswc.Parse")
+	for _, s := range []string{
+		"T-034.524.680-1",
+		"T-034524680-1",
+		"T0345246801",
+		"t-034.524.680-1",
+		"ISWC T-034.524.680-1",
+	} {
+		c, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if c.String() != "T0345246801" || c.Display() != "T-034.524.680-1" {
+			t.Fatalf("Expected %q to parse as T-034.524.680-1, got %s\n", s, c.Display())
+		}
+	}
+	for _, s := range []string{"", "T-034.524.680-2", "T-034.524.68-1", "X-034.524.680-1", "T-034.524.6A0-1"} {
+		if _, err := Parse(s); err == nil {
+			t.Fatalf("Expected %q to be invalid\n", s)
+		}
+	}
+}
+func TestCheckDigit(t *testing.T) {
+	d, err := CheckDigit("070041561")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if _, err = Parse("T-070.041.561-" + d); err != nil {
+		t.Fatalf("Expected the computed check digit to be correct, got %v\n", err)
+	}
+	if _, err = CheckDigit("07004156"); err == nil {
+		t.Fatalf("Expected a short work number to be rejected\n")
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package langtag parses and validates BCP 47 (RFC 5646) language tags,
+such as "zh-Hant-TW" and "pt-BR". Parse checks that a tag is well
+formed, and puts its subtags in canonical case. A Parser also checks
+that the language, script and region subtags are assigned codes,
+using the language3, script and country providers, and resolves them
+to the Language, Script and Country they stand for.
+*/
+package langtag
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/musicbeat/stddata/country"
+	"github.com/musicbeat/stddata/language3"
+	"github.com/musicbeat/stddata/script"
+)
+
+// Tag is a language tag, split into its subtags.
+type Tag struct {
+	Language   string   // ISO 639 code, for example "zh"; empty for a private use tag
+	ExtLang    string   // extended language subtag, for example "yue" in "zh-yue"
+	Script     string   // ISO 15924 code, for example "Hant"
+	Region     string   // ISO 3166-1 alpha-2 code or UN M49 code, for example "TW" or "419"
+	Variants   []string // for example "1901" in "de-CH-1901"
+	Extensions []string // each with its singleton, for example "u-ca-buddhist"
+	PrivateUse string   // with its "x", for example "x-phonebk"
+}
+
+// String returns the tag with its subtags joined by hyphens.
+func (t Tag) String() string {
+	var subtags []string
+	for _, s := range []string{t.Language, t.ExtLang, t.Script, t.Region} {
+		if s != "" {
+			subtags = append(subtags, s)
+		}
+	}
+	subtags = append(subtags, t.Variants...)
+	subtags = append(subtags, t.Extensions...)
+	if t.PrivateUse != "" {
+		subtags = append(subtags, t.PrivateUse)
+	}
+	return strings.Join(subtags, "-")
+}
+
+// Error is returned for a language tag that is malformed, or that has
+// a subtag which is not an assigned code.
+type Error struct {
+	Tag    string // the language tag
+	Subtag string // the offending subtag
+	Msg    string // what is wrong with the subtag
+}
+
+// Error implements the built-in error interface on Error.
+func (e *Error) Error() string {
+	return e.Msg + " " + strconv.Quote(e.Subtag) + " in language tag " + strconv.Quote(e.Tag)
+}
+
+// Parse checks that s is a well formed language tag, and returns it
+// with its subtags in canonical case: the language in lower case, the
+// script in title case and the region in upper case, as in
+// "zh-Hant-TW". Underscores are accepted in place of hyphens.
+// Grandfathered tags, such as "i-klingon", are not supported.
+func Parse(s string) (t Tag, err error) {
+	subtags := strings.Split(strings.ToLower(strings.Replace(s, "_", "-", -1)), "-")
+	for _, st := range subtags {
+		if len(st) < 1 || len(st) > 8 || !isAlnum(st) {
+			return t, &Error{s, st, "Malformed subtag"}
+		}
+	}
+	i := 0
+	if subtags[0] != "x" {
+		if len(subtags[0]) < 2 || len(subtags[0]) > 3 || !isAlpha(subtags[0]) {
+			return t, &Error{s, subtags[0], "Malformed language"}
+		}
+		t.Language = subtags[0]
+		i++
+		if i < len(subtags) && len(subtags[i]) == 3 && isAlpha(subtags[i]) {
+			t.ExtLang = subtags[i]
+			i++
+		}
+		if i < len(subtags) && len(subtags[i]) == 4 && isAlpha(subtags[i]) {
+			t.Script = strings.ToUpper(subtags[i][0:1]) + subtags[i][1:]
+			i++
+		}
+		if i < len(subtags) && (len(subtags[i]) == 2 && isAlpha(subtags[i]) || len(subtags[i]) == 3 && isDigit(subtags[i])) {
+			t.Region = strings.ToUpper(subtags[i])
+			i++
+		}
+		for ; i < len(subtags) && isVariant(subtags[i]); i++ {
+			for _, v := range t.Variants {
+				if v == subtags[i] {
+					return t, &Error{s, subtags[i], "Duplicate variant"}
+				}
+			}
+			t.Variants = append(t.Variants, subtags[i])
+		}
+		for i < len(subtags) && len(subtags[i]) == 1 && subtags[i] != "x" {
+			singleton := subtags[i]
+			j := i + 1
+			for ; j < len(subtags) && len(subtags[j]) > 1; j++ {
+			}
+			if j == i+1 {
+				return t, &Error{s, singleton, "Empty extension"}
+			}
+			for _, e := range t.Extensions {
+				if e[0:1] == singleton {
+					return t, &Error{s, singleton, "Duplicate extension"}
+				}
+			}
+			t.Extensions = append(t.Extensions, strings.Join(subtags[i:j], "-"))
+			i = j
+		}
+		// extensions are put in the order of their singletons
+		sort.Strings(t.Extensions)
+	}
+	if i < len(subtags) && subtags[i] == "x" {
+		if i+1 == len(subtags) {
+			return t, &Error{s, "x", "Empty private use"}
+		}
+		t.PrivateUse = strings.Join(subtags[i:], "-")
+		i = len(subtags)
+	}
+	if i < len(subtags) {
+		return t, &Error{s, subtags[i], "Misplaced subtag"}
+	}
+	return t, nil
+}
+
+// Parser validates language tags against the providers it is given.
+// A nil provider is not consulted, so the zero Parser only checks that
+// tags are well formed.
+type Parser struct {
+	Languages *language3.Language3Provider // a loaded provider, to validate language subtags
+	Countries *country.CountryProvider     // a loaded provider, to validate region subtags
+	Scripts   *script.ScriptProvider       // a loaded provider, to validate script subtags
+}
+
+// Result is a language tag that has been validated by a Parser, with
+// the entities its subtags stand for.
+type Result struct {
+	Tag      Tag                // the tag, in canonical form
+	Language language3.Language // the language, when Languages was consulted
+	Script   script.Script      // the script, when Scripts was consulted
+	Country  country.Country    // the country, when the region is a country
+	Area     country.Area       // the UN M49 area, when the region is an area
+}
+
+// Parse parses s, as the package's Parse does, and checks that its
+// language, script and region are assigned codes. The tag is returned in
+// canonical form: an extended language subtag replaces its prefix, as
+// "yue" does "zh-yue", and a language is written with its ISO 639-1
+// code where it has one, so "deu-DE" becomes "de-DE". A country's
+// numeric code is replaced by its alpha-2 code, so "es-724" becomes
+// "es-ES", while UN M49 areas, such as "419", are kept. The script
+// codes Qaaa to Qabx, which are reserved for private use, are accepted.
+func (p *Parser) Parse(s string) (r Result, err error) {
+	t, err := Parse(s)
+	if err != nil {
+		return r, err
+	}
+	if t.ExtLang != "" {
+		t.Language, t.ExtLang = t.ExtLang, ""
+	}
+	if p.Languages != nil && t.Language != "" && !isPrivateLanguage(t.Language) {
+		r.Language, err = p.Languages.GetByCode(t.Language)
+		if err != nil {
+			return r, &Error{s, t.Language, "Unknown language"}
+		}
+		if r.Language.Part1 != "" {
+			t.Language = r.Language.Part1
+		}
+	}
+	if p.Scripts != nil && t.Script != "" {
+		if r.Script, err = p.Scripts.Get(t.Script); err != nil {
+			return r, &Error{s, t.Script, "Unknown script"}
+		}
+	}
+	if p.Countries != nil && t.Region != "" && isDigit(t.Region) {
+		var found bool
+		if r.Area, found = country.M49Area(t.Region); !found {
+			n, _ := strconv.Atoi(t.Region)
+			if r.Country, err = p.Countries.GetByNumeric(n); err != nil {
+				return r, &Error{s, t.Region, "Unknown region"}
+			}
+			t.Region = r.Country.Alpha2Code
+		}
+	} else if p.Countries != nil && t.Region != "" && !isPrivateRegion(t.Region) {
+		if r.Country, err = p.Countries.GetByAlpha2(t.Region); err != nil {
+			return r, &Error{s, t.Region, "Unknown region"}
+		}
+	}
+	r.Tag = t
+	return r, nil
+}
+
+// isPrivateLanguage reports whether the language code is in the range
+// qaa-qtz, which is reserved for local use.
+func isPrivateLanguage(s string) bool {
+	return len(s) == 3 && s >= "qaa" && s <= "qtz"
+}
+
+// isPrivateRegion reports whether the alpha-2 region code is one of
+// those reserved for private use: AA, QM-QZ, XA-XZ and ZZ.
+func isPrivateRegion(s string) bool {
+	return s == "AA" || s == "ZZ" || (s >= "QM" && s <= "QZ") || s[0] == 'X'
+}
+
+// isVariant reports whether s is a well formed variant subtag: five to
+// eight letters or digits, or a digit and three letters or digits.
+func isVariant(s string) bool {
+	return len(s) >= 5 || len(s) == 4 && isDigit(s[0:1])
+}
+
+func isAlnum(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigit(s string) bool {
+	for _, r := range s {
+		if !(r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
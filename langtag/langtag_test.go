@@ -0,0 +1,90 @@
+package langtag
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	"github.com/musicbeat/stddata/country"
+	"github.com/musicbeat/stddata/language3"
+	"github.com/musicbeat/stddata/script"
+)
+
+var parser Parser
+
+func TestLoadProviders(t *testing.T) {
+	fmt.Println("Test: langtag.Parser")
+	parser.Languages = new(language3.Language3Provider)
+	if _, err := parser.Languages.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	parser.Countries = new(country.CountryProvider)
+	if _, err := parser.Countries.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	parser.Scripts = new(script.ScriptProvider)
+	if _, err := parser.Scripts.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+}
+func TestParse(t *testing.T) {
+	for s, expected := range map[string]string{
+		"zh-hant-tw":          "zh-Hant-TW",
+		"pt_br":               "pt-BR",
+		"de-CH-1901":          "de-CH-1901",
+		"en-US-u-ca-gregory":  "en-US-u-ca-gregory",
+		"en-t-ja-u-ca-japan":  "en-t-ja-u-ca-japan",
+		"en-u-ca-japan-t-ja":  "en-t-ja-u-ca-japan",
+		"x-whatever":          "x-whatever",
+		"sr-Latn-RS-x-custom": "sr-Latn-RS-x-custom",
+	} {
+		tag, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if tag.String() != expected {
+			t.Fatalf("Expected %s to parse as %s, got %s\n", s, expected, tag)
+		}
+	}
+	for _, s := range []string{"", "e", "en--US", "en-US-u", "en-a-b-a-c", "toolonglanguage", "en-US-US", "de-1901-1901"} {
+		if _, err := Parse(s); err == nil {
+			t.Fatalf("Expected %q to be malformed\n", s)
+		}
+	}
+}
+func TestParserParse(t *testing.T) {
+	for s, expected := range map[string]string{
+		"zh-Hant-TW": "zh-Hant-TW",
+		"deu-DE":     "de-DE",
+		"zh-yue-HK":  "yue-HK",
+		"es-419":     "es-419",
+		"es-724":     "es-ES",
+		"qaa-ZZ":     "qaa-ZZ",
+		"en-Qaab":    "en-Qaab",
+	} {
+		r, err := parser.Parse(s)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if r.Tag.String() != expected {
+			t.Fatalf("Expected %s to canonicalize to %s, got %s\n", s, expected, r.Tag)
+		}
+	}
+	r, _ := parser.Parse("pt-BR")
+	if r.Language.ReferenceName != "Portuguese" || r.Country.Alpha3Code != "BRA" {
+		t.Fatalf("Expected Portuguese and Brazil, got %v\n", r)
+	}
+	r, _ = parser.Parse("es-419")
+	if r.Area.Name != "Latin America and the Caribbean" {
+		t.Fatalf("Expected Latin America and the Caribbean, got %v\n", r.Area)
+	}
+	r, _ = parser.Parse("sr-latn-RS")
+	if r.Script.EnglishName != "Latin" {
+		t.Fatalf("Expected Latin, got %v\n", r.Script)
+	}
+	for _, s := range []string{"xyz-US", "en-UK", "ger-DE", "en-999", "en-Abcd-US"} {
+		if _, err := parser.Parse(s); err == nil {
+			t.Fatalf("Expected %s to be invalid\n", s)
+		}
+	}
+}
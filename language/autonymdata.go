@@ -0,0 +1,162 @@
+package language
+
+/*
+autonymdata holds the names of languages in the languages themselves,
+as their speakers write them, for example Deutsch, 日本語 and русский.
+The names follow the Unicode CLDR, and are in the case CLDR uses when a
+name stands alone. Each line holds the bibliographic alpha-3 code and
+the autonym, separated by a tab. Only the languages with a written
+standard in common use are listed.
+*/
+var autonymdata = `afr	Afrikaans
+aka	Akan
+alb	shqip
+amh	አማርኛ
+ara	العربية
+arm	հայերեն
+asm	অসমীয়া
+ast	asturianu
+aym	aymar aru
+aze	azərbaycan
+bak	башҡорт теле
+bam	bamanakan
+baq	euskara
+bel	беларуская
+ben	বাংলা
+bis	Bislama
+bos	bosanski
+bre	brezhoneg
+bul	български
+bur	မြန်မာ
+cat	català
+che	нохчийн
+chi	中文
+chv	чӑваш
+cor	kernewek
+cos	corsu
+cze	čeština
+dan	dansk
+div	ދިވެހިބަސް
+dut	Nederlands
+dzo	རྫོང་ཁ
+eng	English
+epo	esperanto
+est	eesti
+ewe	eʋegbe
+fao	føroyskt
+fij	Na Vosa Vakaviti
+fil	Filipino
+fin	suomi
+fre	français
+fry	Frysk
+ful	Pulaar
+fur	furlan
+geo	ქართული
+ger	Deutsch
+gla	Gàidhlig
+gle	Gaeilge
+glg	galego
+glv	Gaelg
+gre	Ελληνικά
+grn	avañeʼẽ
+guj	ગુજરાતી
+hat	kreyòl ayisyen
+hau	Hausa
+haw	ʻŌlelo Hawaiʻi
+heb	עברית
+hin	हिन्दी
+hrv	hrvatski
+hun	magyar
+ibo	Igbo
+ice	íslenska
+ina	interlingua
+ind	Bahasa Indonesia
+jav	Jawa
+jpn	日本語
+kal	kalaallisut
+kan	ಕನ್ನಡ
+kas	कॉशुर
+kaz	қазақ тілі
+khm	ខ្មែរ
+kin	Ikinyarwanda
+kir	кыргызча
+kor	한국어
+kur	Kurdî
+lao	ລາວ
+lat	Latina
+lav	latviešu
+lim	Limburgs
+lin	lingála
+lit	lietuvių
+ltz	Lëtzebuergesch
+lug	Luganda
+mac	македонски
+mal	മലയാളം
+mao	Māori
+mar	मराठी
+may	Bahasa Melayu
+mlg	Malagasy
+mlt	Malti
+mon	монгол
+nbl	isiNdebele
+nde	isiNdebele
+nep	नेपाली
+nno	norsk nynorsk
+nob	norsk bokmål
+nor	norsk
+nya	Chichewa
+oci	occitan
+ori	ଓଡ଼ିଆ
+orm	Oromoo
+oss	ирон
+pan	ਪੰਜਾਬੀ
+per	فارسی
+pol	polski
+por	português
+pus	پښتو
+que	Runasimi
+roh	rumantsch
+rum	română
+rus	русский
+san	संस्कृतम्
+sin	සිංහල
+slo	slovenčina
+slv	slovenščina
+sme	davvisámegiella
+snd	سنڌي
+sna	chiShona
+som	Soomaali
+sot	Sesotho
+spa	español
+srd	sardu
+srp	српски
+ssw	siSwati
+swa	Kiswahili
+swe	svenska
+tah	reo Tahiti
+tam	தமிழ்
+tat	татар
+tel	తెలుగు
+tgk	тоҷикӣ
+tha	ไทย
+tib	བོད་སྐད་
+tir	ትግርኛ
+tpi	Tok Pisin
+tsn	Setswana
+tso	Xitsonga
+tuk	türkmen dili
+tur	Türkçe
+uig	ئۇيغۇرچە
+ukr	українська
+urd	اردو
+uzb	oʻzbek
+ven	Tshivenḓa
+vie	Tiếng Việt
+vol	Volapük
+wel	Cymraeg
+wln	walon
+wol	Wolof
+xho	isiXhosa
+yid	ייִדיש
+yor	Èdè Yorùbá
+zul	isiZulu`
@@ -0,0 +1,30 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !js
+
+package language
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/musicbeat/stddata"
+)
+
+// fetchList retrieves the list of languages from locurl, and returns
+// it with its edition, the date it was last modified. A response other
+// than 200 OK is a source error.
+func fetchList() (list io.ReadCloser, edition string, err error) {
+	res, err := http.Get(locurl)
+	if err != nil {
+		return nil, "", stddata.NewSourceError(err.Error(), err)
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		msg := "Failed to retrieve " + locurl + ". " + res.Status
+		return nil, "", stddata.NewSourceError(msg, nil)
+	}
+	return res.Body, res.Header.Get("Last-Modified"), nil
+}
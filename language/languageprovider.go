@@ -23,24 +23,35 @@ package language
 
 import (
 	"encoding/csv"
+	"fmt"
 	"io"
-	"net/http"
-	"sort"
+	"iter"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/musicbeat/stddata"
 )
 
-// LanguageProvider implements the Provider interfaces.
+// LanguageProvider implements the Provider interfaces. Its data is
+// replaced whole, by swapping a pointer, when it is loaded again, so
+// searches never take a lock, and never see a load half done.
 type LanguageProvider struct {
-	loaded          bool
+	data atomic.Pointer[languageData] // nil until the data is loaded
+}
+
+// languageData is the data of a LanguageProvider. Once it is stored in
+// the LanguageProvider it is not modified.
+type languageData struct {
 	size            int
+	info            stddata.Info
 	languageIndexes map[string]languageIndex
 }
 
 type languageIndex struct {
 	languageMap  map[string][]Language
 	languageKeys []string
+	foldedKeys   []string // the keys in lower case, for stddata.PrefixRange
 }
 
 // Language is the information on one language in the source data
@@ -50,6 +61,53 @@ type Language struct {
 	Alpha2              string
 	EnglishName         string
 	FrenchName          string
+	// Scope is "Individual", "Macrolanguage", "Collective" for a code
+	// that stands for a group of languages, "Special" for mis, mul, und
+	// and zxx, or "Local" for the codes reserved for local use.
+	Scope string
+	// Autonym is the name of the language in the language itself,
+	// for example "Deutsch". It is empty for most languages.
+	Autonym string
+	// Script is the ISO 15924 code of the script the language is
+	// almost always written in, for example "Latn", which BCP 47 tags
+	// leave out. It is empty when there is no such script. LikelyScript
+	// is the script the language is most likely written in, which is
+	// Script when there is one, and otherwise may be empty.
+	Script       string
+	LikelyScript string
+}
+
+// String returns the codes and the English name of l, for example
+// "ger/deu German (de)". The terminologic code is given only where it
+// differs, and the alpha-2 code only where there is one.
+func (l Language) String() string {
+	s := l.Alpha3bibliographic
+	if l.Alpha3terminologic != "" && l.Alpha3terminologic != l.Alpha3bibliographic {
+		s += "/" + l.Alpha3terminologic
+	}
+	s += " " + l.EnglishName
+	if l.Alpha2 != "" {
+		s += " (" + l.Alpha2 + ")"
+	}
+	return s
+}
+
+// Format implements fmt.Formatter. The %+v and %#v verbs format all of
+// the fields of the Language, as for any struct, and the other verbs format
+// what String returns, so that %v is short enough for a log line.
+func (l Language) Format(f fmt.State, verb rune) {
+	// the fields of a Language, without its methods, so that they are
+	// formatted as those of any struct.
+	type fields Language
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		s := fmt.Sprintf("%#v", fields(l))
+		io.WriteString(f, "language.Language"+s[strings.Index(s, "{"):])
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "%+v", fields(l))
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), l.String())
+	}
 }
 
 // LanguageResult is the interface{} that is returned from Search
@@ -57,29 +115,131 @@ type LanguageResult struct {
 	Languages [][]Language
 }
 
-var alphaMap map[string][]Language
-var englishNameMap map[string][]Language
+var locurl = "http://www.loc.gov/standards/iso639-2/ISO-639-2_utf-8.txt"
+
+// macrolanguages holds the bibliographic codes of the ISO 639-2
+// languages that are macrolanguages in ISO 639-3.
+var macrolanguages = map[string]bool{
+	"aka": true, "alb": true, "ara": true, "aym": true, "aze": true,
+	"bal": true, "bik": true, "bua": true, "chi": true, "chm": true,
+	"cre": true, "del": true, "den": true, "din": true, "doi": true,
+	"est": true, "ful": true, "gba": true, "gon": true, "grb": true,
+	"grn": true, "hai": true, "hmn": true, "iku": true, "ipk": true,
+	"jrb": true, "kau": true, "kok": true, "kom": true, "kon": true,
+	"kpe": true, "kur": true, "lah": true, "lav": true, "man": true,
+	"may": true, "mlg": true, "mon": true, "mwr": true, "nep": true,
+	"nor": true, "oji": true, "ori": true, "orm": true, "per": true,
+	"pus": true, "que": true, "raj": true, "rom": true, "srd": true,
+	"swa": true, "syr": true, "tmh": true, "uzb": true, "yid": true,
+	"zap": true, "zha": true, "zza": true,
+}
+
+// collectiveLanguages holds the codes that stand for a group of
+// languages, such as gem for the Germanic languages.
+var collectiveLanguages = map[string]bool{
+	"afa": true, "alg": true, "apa": true, "art": true, "ath": true,
+	"aus": true, "bad": true, "bai": true, "bat": true, "ber": true,
+	"bih": true, "bnt": true, "btk": true, "cai": true, "cau": true,
+	"cel": true, "cmc": true, "cpe": true, "cpf": true, "cpp": true,
+	"crp": true, "cus": true, "day": true, "dra": true, "fiu": true,
+	"gem": true, "him": true, "ijo": true, "inc": true, "ine": true,
+	"ira": true, "iro": true, "kar": true, "khi": true, "kro": true,
+	"map": true, "mkh": true, "mno": true, "mun": true, "myn": true,
+	"nah": true, "nai": true, "nic": true, "nub": true, "oto": true,
+	"paa": true, "phi": true, "pra": true, "roa": true, "sai": true,
+	"sal": true, "sem": true, "sgn": true, "sio": true, "sit": true,
+	"sla": true, "smi": true, "son": true, "ssa": true, "tai": true,
+	"tup": true, "tut": true, "wak": true, "wen": true, "ypk": true,
+	"znd": true,
+}
+
+// specialLanguages holds the codes that do not stand for a language.
+var specialLanguages = map[string]bool{"mis": true, "mul": true, "und": true, "zxx": true}
+
+// foldLetters maps each unaccented Latin letter to the accented
+// letters that foldName replaces with it.
+var foldLetters = map[string]string{
+	"a": "àáâãäåāăąǎḁạảấầẩẫậắằẳẵặ",
+	"b": "ḃḅḇ",
+	"c": "çćĉċčḉ",
+	"d": "ďḋḍḏḑḓđð",
+	"e": "èéêëēĕėęěḕḗḙḛḝẹẻẽếềểễệ",
+	"f": "ḟ",
+	"g": "ĝğġģḡ",
+	"h": "ĥḣḥḧḩḫẖħ",
+	"i": "ìíîïĩīĭįǐḭḯỉịı",
+	"j": "ĵ",
+	"k": "ķḱḳḵ",
+	"l": "ĺļľḷḹḻḽł",
+	"m": "ḿṁṃ",
+	"n": "ñńņňṅṇṉṋ",
+	"o": "òóôõöōŏőơǒṍṏṑṓọỏốồổỗộớờởỡợø",
+	"p": "ṕṗ",
+	"r": "ŕŗřṙṛṝṟ",
+	"s": "śŝşšṡṣṥṧṩ",
+	"t": "ţťṫṭṯṱẗ",
+	"u": "ùúûüũūŭůűųưǔṳṵṷṹṻụủứừửữự",
+	"v": "ṽṿ",
+	"w": "ŵẁẃẅẇẉẘ",
+	"x": "ẋẍ",
+	"y": "ýÿŷẏẙỳỵỷỹ",
+	"z": "źżžẑẓẕ",
+}
+
+// folder replaces accented letters, and the letters and modifiers
+// that are usually typed differently, for foldName.
+var folder = func() *strings.Replacer {
+	oldnew := []string{"ß", "ss", "æ", "ae", "œ", "oe", "þ", "th", "ʻ", "", "ʼ", "", "'", ""}
+	for letter, accented := range foldLetters {
+		for _, r := range accented {
+			oldnew = append(oldnew, string(r), letter)
+		}
+	}
+	return strings.NewReplacer(oldnew...)
+}()
 
 // Load does the heavy lifting of retrieving the
 // Library of Congress' list of languages, a pipe-delimited
-// .csv file, and populating maps for searching.
+// .csv file, and populating maps for searching. A malformed
+// record in the list causes Load to fail.
 func (p *LanguageProvider) Load() (n int, err error) {
-	// initialize the maps:
-	p.languageIndexes = make(map[string]languageIndex)
-	alphaMap = make(map[string][]Language)
-	englishNameMap = make(map[string][]Language)
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
 
-	res, err := http.Get("http://www.loc.gov/standards/iso639-2/ISO-639-2_utf-8.txt")
+// LoadMode loads the list of languages, treating malformed records
+// according to mode. In stddata.Lenient mode, malformed records are
+// skipped, and their line numbers are returned in the LoadReport.
+func (p *LanguageProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps. They are indexed aside, and replace those
+	// that are being searched only when they are complete.
+	d := &languageData{languageIndexes: make(map[string]languageIndex)}
+	maps := make(map[string]map[string][]Language)
+	for _, s := range []string{"alpha", "alpha2", "term", "frname", "scope", "autonym", "script", "name"} {
+		maps[s] = make(map[string][]Language)
+	}
+	// autonyms and scripts hold autonymdata and scriptdata, keyed by
+	// bibliographic code.
+	autonyms, err := readSupplement(autonymdata, 2)
 	if err != nil {
-		return 0, &stddata.ServiceError{err.Error(), http.StatusServiceUnavailable}
+		return r, err
+	}
+	scripts, err := readSupplement(scriptdata, 3)
+	if err != nil {
+		return r, err
+	}
+
+	list, edition, err := fetchList()
+	if err != nil {
+		return r, err
 	}
 
-	reader := csv.NewReader(res.Body)
+	reader := csv.NewReader(list)
 	reader.Comma = '|'
 	reader.FieldsPerRecord = 5
 	reader.TrimLeadingSpace = true
 
-	defer res.Body.Close()
+	defer list.Close()
 
 	for {
 		// read just one record
@@ -87,30 +247,180 @@ func (p *LanguageProvider) Load() (n int, err error) {
 		// end-of-file is fitted into err
 		if err == io.EOF {
 			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
 		} else if err != nil {
-			return 0, &stddata.ServiceError{err.Error(), http.StatusServiceUnavailable}
+			return r, stddata.NewSourceError(err.Error(), err)
 		}
 
 		var l Language
 		l.Alpha3bibliographic = record[0]
 		l.Alpha3terminologic = record[1]
-		l.Alpha2 = record[2]
+		l.Alpha2 = strings.ToLower(record[2])
 		l.EnglishName = record[3]
 		l.FrenchName = record[4]
 
-		// add the language to the maps:
-		alphaMap[l.Alpha3bibliographic] = append(alphaMap[l.Alpha3bibliographic], l)
-		englishNameMap[l.EnglishName] = append(englishNameMap[l.EnglishName], l)
+		// the range of codes reserved for local use is a single record
+		// in the source data, which is expanded to one Language per code
+		languages := []Language{l}
+		if l.Alpha3bibliographic == "qaa-qtz" {
+			languages = localLanguages(l)
+		}
+		for _, l := range languages {
+			addLanguage(maps, autonyms, scripts, l)
+			r.Loaded++
+		}
+	}
+	for s, m := range maps {
+		d.storeData(s, m)
+	}
+	d.size = r.Loaded
+	d.info = stddata.Info{
+		Source:   "ISO 639-2 Codes for the Representation of Names of Languages (Library of Congress)",
+		URL:      locurl,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	return r, nil
+}
+
+// addLanguage sets the Scope, and the Autonym and scripts found in
+// autonyms and scripts, of the Language, and adds it to maps, the maps
+// of the indexes by their names.
+func addLanguage(maps map[string]map[string][]Language, autonyms map[string][]string, scripts map[string][]string, l Language) {
+	if a, found := autonyms[l.Alpha3bibliographic]; found {
+		l.Autonym = a[0]
+	}
+	if s, found := scripts[l.Alpha3bibliographic]; found {
+		l.Script, l.LikelyScript = s[0], s[1]
+	}
+	switch {
+	case l.Scope != "":
+	case specialLanguages[l.Alpha3bibliographic]:
+		l.Scope = "Special"
+	case macrolanguages[l.Alpha3bibliographic]:
+		l.Scope = "Macrolanguage"
+	case collectiveLanguages[l.Alpha3bibliographic]:
+		l.Scope = "Collective"
+	default:
+		l.Scope = "Individual"
+	}
+	// add the language to the maps:
+	maps["alpha"][l.Alpha3bibliographic] = append(maps["alpha"][l.Alpha3bibliographic], l)
+	// the alpha index is keyed by both codes, where they differ, so
+	// that "ger" and "deu" both find German
+	if l.Alpha3terminologic != "" && l.Alpha3terminologic != l.Alpha3bibliographic {
+		maps["alpha"][l.Alpha3terminologic] = append(maps["alpha"][l.Alpha3terminologic], l)
+	}
+	// most languages have no alpha-2 code
+	if l.Alpha2 != "" {
+		maps["alpha2"][l.Alpha2] = append(maps["alpha2"][l.Alpha2], l)
+	}
+	// the terminologic code is only given where it differs from
+	// the bibliographic code
+	term := l.Alpha3terminologic
+	if term == "" {
+		term = l.Alpha3bibliographic
+	}
+	maps["term"][term] = append(maps["term"][term], l)
+	if l.FrenchName != "" {
+		maps["frname"][l.FrenchName] = append(maps["frname"][l.FrenchName], l)
+	}
+	maps["name"][l.EnglishName] = append(maps["name"][l.EnglishName], l)
+	maps["scope"][l.Scope] = append(maps["scope"][l.Scope], l)
+	if l.Autonym != "" {
+		key := foldName(l.Autonym)
+		maps["autonym"][key] = append(maps["autonym"][key], l)
+	}
+	if l.LikelyScript != "" {
+		maps["script"][l.LikelyScript] = append(maps["script"][l.LikelyScript], l)
+	}
+}
+
+// readSupplement reads tab-delimited supplementary data, in which the
+// first field of each record is a bibliographic code, into a map of the
+// other fields keyed by the code.
+func readSupplement(data string, fields int) (map[string][]string, error) {
+	m := make(map[string][]string)
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = fields
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, stddata.NewSourceError(err.Error(), err)
+	}
+	for _, record := range records {
+		m[record[0]] = record[1:]
+	}
+	return m, nil
+}
+
+// foldName puts a name in the form used as the key of the autonym
+// index: in lower case, and with accents removed from Latin letters,
+// so that "espanol" and "Español" both find español.
+func foldName(s string) string {
+	return folder.Replace(strings.ToLower(s))
+}
+
+// localLanguages expands the range of codes reserved for local use,
+// qaa to qtz, to a Language for each code.
+func localLanguages(r Language) (languages []Language) {
+	for first := 'a'; first <= 't'; first++ {
+		for second := 'a'; second <= 'z'; second++ {
+			l := r
+			l.Alpha3bibliographic = "q" + string(first) + string(second)
+			l.Scope = "Local"
+			languages = append(languages, l)
+		}
+	}
+	return languages
+}
+
+// SaveSnapshot implements the stddata.Snapshotter interface.
+func (p *LanguageProvider) SaveSnapshot(w io.Writer) error {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return stddata.ErrNotLoaded
+	}
+	indexes := make(map[string]map[string][]Language)
+	for s, li := range d.languageIndexes {
+		indexes[s] = li.languageMap
+	}
+	return stddata.WriteSnapshot(w, "language", d.info, indexes)
+}
 
+// LoadSnapshot implements the stddata.Snapshotter interface. It
+// restores the data that SaveSnapshot saved, without retrieving it from the Library of Congress.
+func (p *LanguageProvider) LoadSnapshot(r io.Reader) (n int, err error) {
+	var indexes map[string]map[string][]Language
+	info, err := stddata.ReadSnapshot(r, "language", &indexes)
+	if err != nil {
+		return 0, err
 	}
-	p.storeData("alpha", alphaMap)
-	p.storeData("name", englishNameMap)
-	p.size = len(alphaMap)
-	p.loaded = true
-	return len(alphaMap), err
+	d := &languageData{languageIndexes: make(map[string]languageIndex)}
+	for s, m := range indexes {
+		d.storeData(s, m)
+	}
+	d.size = info.Count
+	d.info = info
+	p.data.Store(d)
+	return d.size, nil
 }
 
-func (p *LanguageProvider) storeData(s string, m map[string][]Language) {
+// Info describes the provenance of the loaded data. The Edition
+// is the Last-Modified date reported when the list was retrieved.
+func (p *LanguageProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+func (d *languageData) storeData(s string, m map[string][]Language) {
 	// store the map
 	var li languageIndex
 	li.languageMap = m
@@ -122,9 +432,35 @@ func (p *LanguageProvider) storeData(s string, m map[string][]Language) {
 		i++
 	}
 	// sort the keys
-	sort.Strings(li.languageKeys)
+	li.foldedKeys = stddata.SortKeys(li.languageKeys)
 	// add to languageIndexes
-	p.languageIndexes[s] = li
+	d.languageIndexes[s] = li
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *LanguageProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "alpha", Description: "ISO 639-2 code, bibliographic or terminologic", Example: "ger"},
+		{Name: "alpha2", Description: "ISO 639-1 code", Example: "de"},
+		{Name: "term", Description: "ISO 639-2 terminologic code", Example: "deu"},
+		{Name: "frname", Description: "French name", Example: "allemand"},
+		{Name: "scope", Description: "scope, such as Individual or Collective", Example: "Collective"},
+		{Name: "autonym", Description: "name in the language itself", Example: "Deutsch"},
+		{Name: "script", Description: "ISO 15924 code of the likely script", Example: "Cyrl"},
+		{Name: "name", Description: "English name", Example: "German"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *LanguageProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	li := d.languageIndexes[index]
+	return stddata.Suggest(li.languageKeys, li.foldedKeys, query)
 }
 
 // Search returns a collection as an interface{} and error. The collection
@@ -135,42 +471,71 @@ func (p *LanguageProvider) storeData(s string, m map[string][]Language) {
 // any matching Languages are returned in the result.
 // Search can also "dump" an index. When the value of query is "_dump", the index specified
 // is used to supply the entire data set, in the order of the index.
+// The autonym index is keyed by autonyms in lower case and without accents, and the
+// query is folded the same way, so "espanol" finds español.
 func (p *LanguageProvider) Search(index string, query string) (result interface{}, err error) {
 	// make sure the data is loaded
-	if p.loaded != true {
-		return nil, &stddata.ServiceError{err.Error(), http.StatusServiceUnavailable}
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
 	}
-	li, found := p.languageIndexes[index]
+	li, found := d.languageIndexes[index]
 	if !found {
 		// search cannot be performed
-		msg := "No index on " + index
-		return nil, &stddata.ServiceError{msg, http.StatusBadRequest}
+		return nil, stddata.NewIndexError(index, d.languageIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	if index == "autonym" && query != "_dump" {
+		query = foldName(query)
 	}
 	result = doSearch(li, query)
 	return result, nil
 }
+
+// SearchLanguages is Search, returning the matches as they are, rather
+// than as a LanguageResult in an interface{}.
+func (p *LanguageProvider) SearchLanguages(index string, query string) ([][]Language, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(LanguageResult).Languages, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Languages of each. It yields nothing if the
+// LanguageProvider has no such index, or is not loaded.
+func (p *LanguageProvider) Range(index string) iter.Seq2[string, []Language] {
+	return func(yield func(string, []Language) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		li := d.languageIndexes[index]
+		for _, k := range li.languageKeys {
+			if !yield(k, li.languageMap[k]) {
+				return
+			}
+		}
+	}
+}
 func doSearch(li languageIndex, query string) (res LanguageResult) {
 	// the "reserved" query term "_dump" is handled by returning all the
 	// results in the order of the index.
 	dump := query == "_dump"
-	// prepare the response. allocate enough space for the response to be the
-	// entire data set.
-	tmp := make([][]Language, len(li.languageKeys))
-	// brute force the sorted list of keys, looking for a match to 'query.*'.
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(li.languageKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(li.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
 	// add each match to the result array. The results are added in the
 	// order of the sorted keys, so the results are sorted.
-	i := 0
-	for k := range li.languageKeys {
-		if dump {
-			tmp[i] = li.languageMap[li.languageKeys[k]]
-			i++
-		} else if len(li.languageKeys[k]) >= len(query) {
-			if strings.EqualFold(query, li.languageKeys[k][0:len(query)]) {
-				tmp[i] = li.languageMap[li.languageKeys[k]]
-				i++
-			}
-		}
+	res.Languages = make([][]Language, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Languages = append(res.Languages, li.languageMap[li.languageKeys[k]])
 	}
-	res.Languages = tmp[0:i]
 	return res
 }
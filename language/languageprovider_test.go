@@ -6,10 +6,11 @@ import (
 	"testing"
 
 	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
 )
 
 var p Provider
-var expected int = 486
+var expected int = 1005
 
 func TestLanguageProviderLoad(t *testing.T) {
 	fmt.Println("Test: LanguageProvider.Load")
@@ -43,6 +44,135 @@ func TestNameSearchLowerCase(t *testing.T) {
 	}
 	fmt.Println("matches %s\n", matches)
 }
+func TestAlpha2Search(t *testing.T) {
+	res, err := p.Search("alpha2", "EN")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	l := res.(LanguageResult).Languages
+	if len(l) != 1 || l[0][0].Alpha3bibliographic != "eng" {
+		t.Fatalf("Expected English, got %v\n", l)
+	}
+}
+func TestTermAndFrenchNameSearch(t *testing.T) {
+	for _, s := range []struct{ index, query, alpha3 string }{
+		{"term", "deu", "ger"}, {"term", "eng", "eng"}, {"frname", "japonais", "jpn"},
+	} {
+		res, err := p.Search(s.index, s.query)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		l := res.(LanguageResult).Languages
+		if len(l) != 1 || l[0][0].Alpha3bibliographic != s.alpha3 {
+			t.Fatalf("Expected %s %s to find %s, got %v\n", s.index, s.query, s.alpha3, l)
+		}
+	}
+}
+func TestScopeSearch(t *testing.T) {
+	for scope, n := range map[string]int{"Collective": 66, "Local": 520, "Special": 4, "Macrolanguage": 58} {
+		res, err := p.Search("scope", scope)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		l := res.(LanguageResult).Languages
+		if len(l) != 1 || len(l[0]) != n {
+			t.Fatalf("Expected %d %s languages, got %d\n", n, scope, len(l[0]))
+		}
+	}
+	res, _ := p.Search("alpha", "qab")
+	if l := res.(LanguageResult).Languages; len(l) != 1 || l[0][0].Scope != "Local" {
+		t.Fatalf("Expected qab to be reserved for local use, got %v\n", l)
+	}
+}
+func TestAutonymSearch(t *testing.T) {
+	for query, alpha3 := range map[string]string{"Deutsch": "ger", "espanol": "spa", "日本語": "jpn",
+		"РУССКИЙ": "rus", "tieng viet": "vie", "ozbek": "uzb"} {
+		res, err := p.Search("autonym", query)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		l := res.(LanguageResult).Languages
+		if len(l) != 1 || l[0][0].Alpha3bibliographic != alpha3 {
+			t.Fatalf("Expected %s to find %s, got %v\n", query, alpha3, l)
+		}
+	}
+}
+func TestScripts(t *testing.T) {
+	for alpha3, scripts := range map[string][2]string{"eng": {"Latn", "Latn"}, "rus": {"Cyrl", "Cyrl"},
+		"chi": {"", "Hans"}, "gre": {"Grek", "Grek"}, "ain": {"", ""}} {
+		res, err := p.Search("alpha", alpha3)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		l := res.(LanguageResult).Languages[0][0]
+		if l.Script != scripts[0] || l.LikelyScript != scripts[1] {
+			t.Fatalf("Expected %s to have scripts %v, got %s and %s\n", alpha3, scripts, l.Script, l.LikelyScript)
+		}
+	}
+	res, _ := p.Search("script", "Cans")
+	if l := res.(LanguageResult).Languages; len(l) != 1 || len(l[0]) != 2 {
+		t.Fatalf("Expected Cree and Inuktitut to be written in Cans, got %v\n", l)
+	}
+}
+func TestAlphaSearchBothCodes(t *testing.T) {
+	for _, query := range []string{"ger", "deu"} {
+		res, err := p.Search("alpha", query)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		l := res.(LanguageResult).Languages
+		if len(l) != 1 || len(l[0]) != 1 || l[0][0].EnglishName != "German" {
+			t.Fatalf("Expected %s to find German, got %v\n", query, l)
+		}
+	}
+}
+func TestConcurrentLoad(t *testing.T) {
+	// run with -race: each LanguageProvider builds its own maps
+	errs := make(chan error)
+	for i := 0; i < 2; i++ {
+		go func() {
+			n, err := new(LanguageProvider).Load()
+			if err == nil && n != expected {
+				err = fmt.Errorf("loaded %d", n)
+			}
+			errs <- err
+		}()
+	}
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+	}
+}
+func TestByName(t *testing.T) {
+	for name, alpha2 := range map[string]string{"Finnish": "fi", "finnish": "fi", "Spanish": "es"} {
+		l, err := ByName(name)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if l.Alpha2 != alpha2 {
+			t.Fatalf("Expected %s to be %s, got %v\n", name, alpha2, l)
+		}
+	}
+	if l, err := ByAlpha3("deu"); err != nil || l.Alpha3bibliographic != "ger" {
+		t.Fatalf("Expected German, got %v and %v\n", l, err)
+	}
+	if l, err := ByAlpha2("FI"); err != nil || l.EnglishName != "Finnish" {
+		t.Fatalf("Expected Finnish, got %v and %v\n", l, err)
+	}
+	if _, err := ByName("Finn"); err == nil {
+		t.Fatalf("Expected a partial name not to be found\n")
+	}
+}
+func TestSearchAllocs(t *testing.T) {
+	// a search allocates its result, the interface{} that holds it, and
+	// the lower case of the query
+	for _, s := range []stddatatest.Search{{Index: "alpha", Query: "ger"}, {Index: "name", Query: "German"}, {Index: "alpha2", Query: "EN"}} {
+		if n := stddatatest.AllocsPerSearch(p, s); n > 3 {
+			t.Fatalf("%s %q: Expected at most 3 allocations, got %v\n", s.Index, s.Query, n)
+		}
+	}
+}
 func BenchmarkNameSearch(b *testing.B) {
 	p = new(LanguageProvider)
 	n, err := p.Load()
@@ -61,3 +191,16 @@ func BenchmarkNameSearch(b *testing.B) {
 		}
 	}
 }
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(LanguageProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(LanguageProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "alpha", Query: "ger"}, {Index: "name", Query: "german"}, {Index: "autonym", Query: "Deutsch"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
@@ -0,0 +1,82 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package language
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/musicbeat/stddata"
+)
+
+// defaults is the LanguageProvider that backs the lookup functions. It
+// is loaded the first time it is needed, which retrieves the list from
+// the Library of Congress. If that fails, the lookup returns the error,
+// and the next lookup tries again.
+var defaults struct {
+	mu     sync.Mutex
+	loaded bool
+	p      LanguageProvider
+}
+
+// defaultProvider returns the loaded LanguageProvider behind the lookup
+// functions.
+func defaultProvider() (*LanguageProvider, error) {
+	defaults.mu.Lock()
+	defer defaults.mu.Unlock()
+	if !defaults.loaded {
+		if _, err := defaults.p.Load(); err != nil {
+			return nil, err
+		}
+		defaults.loaded = true
+	}
+	return &defaults.p, nil
+}
+
+// ByAlpha3 returns the Language whose ISO 639-2 code, bibliographic or
+// terminologic, is code, in any case: "ger" and "deu" are German. It
+// needs no LanguageProvider of its own.
+func ByAlpha3(code string) (Language, error) {
+	return lookup("alpha", code, "code")
+}
+
+// ByAlpha2 returns the Language whose ISO 639-1 code is code, in any
+// case: "fi" is Finnish.
+func ByAlpha2(code string) (Language, error) {
+	return lookup("alpha2", code, "code")
+}
+
+// ByName returns the Language whose English name is name, in any
+// case. Of a language with several names, such as "Spanish; Castilian",
+// the first is its name: "Spanish".
+func ByName(name string) (Language, error) {
+	return lookup("name", name, "name")
+}
+
+// lookup returns the Language that key is, in any case, the key of in
+// index, or the first of the keys separated by semicolons.
+func lookup(index string, key string, kind string) (l Language, err error) {
+	p, err := defaultProvider()
+	if err != nil {
+		return l, err
+	}
+	d := p.data.Load()
+	if d == nil {
+		return l, stddata.ErrNotLoaded
+	}
+	li := d.languageIndexes[index]
+	folded := strings.ToLower(key)
+	// the keys are sorted by their lower case, so the keys that are key,
+	// or that begin with it and a semicolon, are among those it begins.
+	lo, hi := stddata.PrefixRange(li.foldedKeys, key)
+	for k := lo; k < hi; k++ {
+		if first := strings.SplitN(li.foldedKeys[k], ";", 2)[0]; first == folded {
+			return li.languageMap[li.languageKeys[k]][0], nil
+		}
+	}
+	msg := "No language with " + kind + " " + key
+	return l, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+}
@@ -0,0 +1,193 @@
+package language
+
+/*
+scriptdata holds the ISO 15924 script codes that languages are written
+in. The default script is the Suppress-Script of the language in the
+IANA Language Subtag Registry: the script the language is almost always
+written in, which is left out of its BCP 47 tags. The likely script is
+the one the Unicode CLDR likely subtags data gives for the language,
+which is the default script when there is one; for example Hans for
+Chinese, which is written in more than one script. Each line holds the
+bibliographic alpha-3 code, the default script (when the language has
+one) and the likely script, separated by tabs.
+*/
+var scriptdata = `abk		Cyrl
+afr	Latn	Latn
+aka		Latn
+alb	Latn	Latn
+amh	Ethi	Ethi
+ara	Arab	Arab
+arm	Armn	Armn
+asm	Beng	Beng
+ava		Cyrl
+aym	Latn	Latn
+aze		Latn
+bak		Cyrl
+baq	Latn	Latn
+bel	Cyrl	Cyrl
+ben	Beng	Beng
+bis		Latn
+bos	Latn	Latn
+bre		Latn
+bul	Cyrl	Cyrl
+bur	Mymr	Mymr
+cat	Latn	Latn
+cha	Latn	Latn
+che		Cyrl
+chi		Hans
+chv		Cyrl
+cor		Latn
+cos		Latn
+cre		Cans
+cze	Latn	Latn
+dan	Latn	Latn
+div	Thaa	Thaa
+dsb	Latn	Latn
+dut	Latn	Latn
+dzo	Tibt	Tibt
+eng	Latn	Latn
+epo	Latn	Latn
+est	Latn	Latn
+ewe		Latn
+fao	Latn	Latn
+fij	Latn	Latn
+fin	Latn	Latn
+fre	Latn	Latn
+frr	Latn	Latn
+frs	Latn	Latn
+fry	Latn	Latn
+ful		Latn
+geo	Geor	Geor
+ger	Latn	Latn
+gla		Latn
+gle	Latn	Latn
+glg	Latn	Latn
+glv	Latn	Latn
+gre	Grek	Grek
+grn	Latn	Latn
+gsw	Latn	Latn
+guj	Gujr	Gujr
+hat	Latn	Latn
+hau		Latn
+heb	Hebr	Hebr
+hin	Deva	Deva
+hrv	Latn	Latn
+hsb	Latn	Latn
+hun	Latn	Latn
+ibo		Latn
+ice	Latn	Latn
+ido		Latn
+iii		Yiii
+iku		Cans
+ina		Latn
+ind	Latn	Latn
+ita	Latn	Latn
+jav		Latn
+jpn	Jpan	Jpan
+kal	Latn	Latn
+kan	Knda	Knda
+kas		Arab
+kaz	Cyrl	Cyrl
+khm	Khmr	Khmr
+kin	Latn	Latn
+kir		Cyrl
+kok	Deva	Deva
+kom		Cyrl
+kor	Kore	Kore
+kur		Latn
+lao	Laoo	Laoo
+lat	Latn	Latn
+lav	Latn	Latn
+lim		Latn
+lin	Latn	Latn
+lit	Latn	Latn
+ltz	Latn	Latn
+lug		Latn
+mac	Cyrl	Cyrl
+mah	Latn	Latn
+mai	Deva	Deva
+mal	Mlym	Mlym
+mao		Latn
+mar	Deva	Deva
+may	Latn	Latn
+men	Latn	Latn
+mlg	Latn	Latn
+mlt	Latn	Latn
+mon		Cyrl
+nau	Latn	Latn
+nbl	Latn	Latn
+nde	Latn	Latn
+nds	Latn	Latn
+nep	Deva	Deva
+niu	Latn	Latn
+nno	Latn	Latn
+nob	Latn	Latn
+nor	Latn	Latn
+nqo	Nkoo	Nkoo
+nso	Latn	Latn
+nya	Latn	Latn
+oci		Latn
+ori	Orya	Orya
+orm	Latn	Latn
+oss		Cyrl
+pan	Guru	Guru
+per	Arab	Arab
+pol	Latn	Latn
+por	Latn	Latn
+pus	Arab	Arab
+que	Latn	Latn
+roh	Latn	Latn
+rum	Latn	Latn
+run	Latn	Latn
+rus	Cyrl	Cyrl
+sag	Latn	Latn
+san		Deva
+sin	Sinh	Sinh
+slo	Latn	Latn
+slv	Latn	Latn
+smo	Latn	Latn
+sna		Latn
+snd		Arab
+som	Latn	Latn
+sot	Latn	Latn
+spa	Latn	Latn
+srd		Latn
+srp		Cyrl
+ssw	Latn	Latn
+sun		Latn
+swa	Latn	Latn
+swe	Latn	Latn
+tah		Latn
+tam	Taml	Taml
+tat		Cyrl
+tel	Telu	Telu
+tem	Latn	Latn
+tgk		Cyrl
+tgl	Latn	Latn
+tha	Thai	Thai
+tib		Tibt
+tir	Ethi	Ethi
+tkl	Latn	Latn
+tmh	Latn	Latn
+ton	Latn	Latn
+tpi	Latn	Latn
+tsn	Latn	Latn
+tso	Latn	Latn
+tuk		Latn
+tur	Latn	Latn
+tvl	Latn	Latn
+uig		Arab
+ukr	Cyrl	Cyrl
+urd	Arab	Arab
+uzb		Latn
+ven	Latn	Latn
+vie	Latn	Latn
+vol		Latn
+wel	Latn	Latn
+wln		Latn
+wol		Latn
+xho	Latn	Latn
+yid	Hebr	Hebr
+yor		Latn
+zbl	Blis	Blis
+zul	Latn	Latn`
@@ -0,0 +1,297 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package language3 implements the methods of a stddata.Provider.
+It provides searches against the data set of ISO 639-3 language
+codes, which cover some 7,900 individual languages, macrolanguages
+and special codes, where ISO 639-2 covers fewer than 500.
+Source data is declared in language3data.go
+*/
+package language3
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// Language3Provider implements the Provider interface.
+type Language3Provider struct {
+	data atomic.Pointer[language3Data] // nil until the data is loaded
+}
+
+// language3Data is the data of a Language3Provider. Once it is stored
+// in the Language3Provider it is not modified.
+type language3Data struct {
+	size             int
+	info             stddata.Info
+	language3Indexes map[string]language3Index
+}
+
+type language3Index struct {
+	languageMap  map[string][]Language
+	languageKeys []string
+	foldedKeys   []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Language models one entity.
+type Language struct {
+	Code          string // ISO 639-3 code, for example "deu"
+	Part2B        string // ISO 639-2 bibliographic code, for example "ger"
+	Part2T        string // ISO 639-2 terminologic code, for example "deu"
+	Part1         string // ISO 639-1 code, for example "de"
+	Scope         string // "Individual", "Macrolanguage" or "Special"
+	Type          string // "Living", "Extinct", "Ancient", "Historical", "Constructed" or "Special"
+	ReferenceName string
+	InvertedName  string // for example "Arabic, Egyptian", when it differs from the reference name
+}
+
+// Language3Result is the interface{} that is returned from Search
+type Language3Result struct {
+	Languages [][]Language
+}
+
+// scopes and types spell out the letters used in language3data.
+var scopes = map[string]string{
+	"I": "Individual",
+	"M": "Macrolanguage",
+	"S": "Special",
+}
+var types = map[string]string{
+	"L": "Living",
+	"E": "Extinct",
+	"A": "Ancient",
+	"H": "Historical",
+	"C": "Constructed",
+	"S": "Special",
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *Language3Provider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped,
+// and their line numbers are returned in the LoadReport.
+func (p *Language3Provider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &language3Data{language3Indexes: make(map[string]language3Index)}
+	codeMap := make(map[string][]Language)
+	part1Map := make(map[string][]Language)
+	referenceNameMap := make(map[string][]Language)
+	scopeMap := make(map[string][]Language)
+	typeMap := make(map[string][]Language)
+
+	reader := csv.NewReader(strings.NewReader(language3data))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 8
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var l Language
+		l.Code = record[0]
+		l.Part2B = record[1]
+		l.Part2T = record[2]
+		l.Part1 = record[3]
+		l.ReferenceName = record[6]
+		l.InvertedName = record[7]
+		var scopeFound, typeFound bool
+		l.Scope, scopeFound = scopes[record[4]]
+		l.Type, typeFound = types[record[5]]
+		if !scopeFound || !typeFound {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed scope %q or type %q", line, record[4], record[5])
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Language to the maps
+		codeMap[l.Code] = append(codeMap[l.Code], l)
+		if l.Part1 != "" {
+			part1Map[l.Part1] = append(part1Map[l.Part1], l)
+		}
+		referenceNameMap[l.ReferenceName] = append(referenceNameMap[l.ReferenceName], l)
+		scopeMap[l.Scope] = append(scopeMap[l.Scope], l)
+		typeMap[l.Type] = append(typeMap[l.Type], l)
+	}
+	p.storeData(d, "code", codeMap)
+	p.storeData(d, "part1", part1Map)
+	p.storeData(d, "name", referenceNameMap)
+	p.storeData(d, "scope", scopeMap)
+	p.storeData(d, "type", typeMap)
+	d.size = len(codeMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(codeMap)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *Language3Provider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// GetByCode returns the Language whose ISO 639-3 code, or ISO 639-1
+// code, is exactly code, for example "deu" or "de" for German.
+func (p *Language3Provider) GetByCode(code string) (l Language, err error) {
+	d := p.data.Load()
+	if d == nil {
+		return l, stddata.ErrNotLoaded
+	}
+	index := "code"
+	if len(code) == 2 {
+		index = "part1"
+	}
+	languages, found := d.language3Indexes[index].languageMap[strings.ToLower(code)]
+	if !found {
+		msg := "No language with code " + code
+		return l, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return languages[0], nil
+}
+
+func (p *Language3Provider) storeData(d *language3Data, s string, m map[string][]Language) {
+	// store the map
+	var li language3Index
+	li.languageMap = m
+	// extract the keys
+	li.languageKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		li.languageKeys[i] = k
+		i++
+	}
+	// sort the keys
+	li.foldedKeys = stddata.SortKeys(li.languageKeys)
+	// add to language3Indexes
+	d.language3Indexes[s] = li
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *Language3Provider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "code", Description: "ISO 639-3 code", Example: "deu"},
+		{Name: "part1", Description: "ISO 639-1 code", Example: "de"},
+		{Name: "name", Description: "reference name", Example: "German"},
+		{Name: "scope", Description: "Individual, Macrolanguage or Special", Example: "Macrolanguage"},
+		{Name: "type", Description: "type, such as Living or Extinct", Example: "Extinct"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *Language3Provider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	li := d.language3Indexes[index]
+	return stddata.Suggest(li.languageKeys, li.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Language entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Languages are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+func (p *Language3Provider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	li, found := d.language3Indexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.language3Indexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(li, query)
+	return result, nil
+}
+
+// SearchLanguages is Search, returning the matches as they are, rather
+// than as a Language3Result in an interface{}.
+func (p *Language3Provider) SearchLanguages(index string, query string) ([][]Language, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(Language3Result).Languages, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Languages of each. It yields nothing if the
+// Language3Provider has no such index, or is not loaded.
+func (p *Language3Provider) Range(index string) iter.Seq2[string, []Language] {
+	return func(yield func(string, []Language) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		li := d.language3Indexes[index]
+		for _, k := range li.languageKeys {
+			if !yield(k, li.languageMap[k]) {
+				return
+			}
+		}
+	}
+}
+
+func doSearch(li language3Index, query string) (res Language3Result) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(li.languageKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(li.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Languages = make([][]Language, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Languages = append(res.Languages, li.languageMap[li.languageKeys[k]])
+	}
+	return res
+}
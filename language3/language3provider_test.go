@@ -0,0 +1,84 @@
+package language3
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestLanguage3Provider(t *testing.T) {
+	expected := 7910
+	fmt.Println("Test: Language3Provider.Load")
+	p = new(Language3Provider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestCodeSearch(t *testing.T) {
+	res, err := p.Search("code", "deu")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	l := res.(Language3Result).Languages
+	if len(l) != 1 || l[0][0].ReferenceName != "German" || l[0][0].Part2B != "ger" || l[0][0].Part1 != "de" {
+		t.Fatalf("Expected German, got %v\n", l)
+	}
+}
+func TestNameSearch(t *testing.T) {
+	res, err := p.Search("name", "Ghotuo")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	l := res.(Language3Result).Languages
+	if len(l) != 1 || l[0][0].Code != "aaa" || l[0][0].Scope != "Individual" || l[0][0].Type != "Living" {
+		t.Fatalf("Expected Ghotuo, got %v\n", l)
+	}
+}
+func TestScopeSearch(t *testing.T) {
+	res, err := p.Search("scope", "Macrolanguage")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	l := res.(Language3Result).Languages
+	if len(l) != 1 || len(l[0]) != 62 {
+		t.Fatalf("Expected 62 macrolanguages, got %v\n", len(l))
+	}
+}
+func TestLoadMode(t *testing.T) {
+	saved := language3data
+	defer func() { language3data = saved }()
+	language3data = "aaa\t\t\t\tI\tL\tGhotuo\t\nmalformed\nzzz\t\t\t\tX\tL\tNone\t"
+	lp := new(Language3Provider)
+	if _, err := lp.LoadMode(Strict); err == nil {
+		t.Fatalf("Expected strict LoadMode to fail on line 2\n")
+	}
+	r, err := lp.LoadMode(Lenient)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 1 || r.Skipped != 2 || r.SkippedLines[0] != 2 || r.SkippedLines[1] != 3 {
+		t.Fatalf("Expected 1 loaded and lines 2 and 3 skipped, got %+v\n", r)
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(Language3Provider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(Language3Provider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "code", Query: "deu"}, {Index: "part1", Query: "de"}, {Index: "name", Query: "german"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
@@ -0,0 +1,44 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stddata
+
+import "time"
+
+// ParseMode selects how a Provider treats malformed records in its
+// source data while loading.
+type ParseMode int
+
+const (
+	// Strict stops the load at the first malformed record, and
+	// reports the line on which it was found.
+	Strict ParseMode = iota
+	// Lenient skips malformed records, and counts them in the
+	// LoadReport.
+	Lenient
+)
+
+// LoadReport describes the outcome of loading a Provider's data.
+type LoadReport struct {
+	Loaded       int   // number of items loaded
+	Skipped      int   // number of malformed records skipped
+	SkippedLines []int // line numbers of the skipped records
+}
+
+// Skip records that the malformed record found at line was skipped.
+func (r *LoadReport) Skip(line int) {
+	r.Skipped++
+	r.SkippedLines = append(r.SkippedLines, line)
+}
+
+// Info describes the provenance of the data a Provider has loaded,
+// so that it is possible to audit which revision of a standard is
+// being served.
+type Info struct {
+	Source   string    // name of the source data set
+	URL      string    // where the source data set was retrieved
+	Edition  string    // edition, publication or retrieval date of the data set
+	Count    int       // number of items loaded
+	LoadedAt time.Time // when the data was loaded
+}
@@ -0,0 +1,824 @@
+package locale
+
+// provenance of localedata, reported by Info
+const (
+	source    = "Unicode CLDR, as listed by ICU 72.1"
+	sourceURL = "https://cldr.unicode.org/"
+	edition   = "CLDR 42"
+)
+
+/*
+localedata holds the locales of the Unicode Common Locale Data
+Repository, as ICU 72.1, which is built on CLDR 42, lists them. The
+fields of each record are tab-delimited. They are the BCP 47 tag of
+the locale, its language, script and region subtags, its English
+name, its name in its own language, and the tags, without a script,
+that CLDR's likely subtags resolve to the locale where no locale of
+that tag exists, such as "zh-TW" for "zh-Hant-TW".
+*/
+var localedata = `af	af			Afrikaans	Afrikaans	
+af-NA	af		NA	Afrikaans (Namibia)	Afrikaans (Namibië)	
+af-ZA	af		ZA	Afrikaans (South Africa)	Afrikaans (Suid-Afrika)	
+agq	agq			Aghem	Aghem	
+agq-CM	agq		CM	Aghem (Cameroon)	Aghem (Kàmàlûŋ)	
+ak	ak			Akan	Akan	
+ak-GH	ak		GH	Akan (Ghana)	Akan (Gaana)	
+am	am			Amharic	አማርኛ	
+am-ET	am		ET	Amharic (Ethiopia)	አማርኛ (ኢትዮጵያ)	
+ar	ar			Arabic	العربية	
+ar-001	ar		001	Arabic (world)	العربية (العالم)	
+ar-AE	ar		AE	Arabic (United Arab Emirates)	العربية (الإمارات العربية المتحدة)	
+ar-BH	ar		BH	Arabic (Bahrain)	العربية (البحرين)	
+ar-DJ	ar		DJ	Arabic (Djibouti)	العربية (جيبوتي)	
+ar-DZ	ar		DZ	Arabic (Algeria)	العربية (الجزائر)	
+ar-EG	ar		EG	Arabic (Egypt)	العربية (مصر)	
+ar-EH	ar		EH	Arabic (Western Sahara)	العربية (الصحراء الغربية)	
+ar-ER	ar		ER	Arabic (Eritrea)	العربية (إريتريا)	
+ar-IL	ar		IL	Arabic (Israel)	العربية (إسرائيل)	
+ar-IQ	ar		IQ	Arabic (Iraq)	العربية (العراق)	
+ar-JO	ar		JO	Arabic (Jordan)	العربية (الأردن)	
+ar-KM	ar		KM	Arabic (Comoros)	العربية (جزر القمر)	
+ar-KW	ar		KW	Arabic (Kuwait)	العربية (الكويت)	
+ar-LB	ar		LB	Arabic (Lebanon)	العربية (لبنان)	
+ar-LY	ar		LY	Arabic (Libya)	العربية (ليبيا)	
+ar-MA	ar		MA	Arabic (Morocco)	العربية (المغرب)	
+ar-MR	ar		MR	Arabic (Mauritania)	العربية (موريتانيا)	
+ar-OM	ar		OM	Arabic (Oman)	العربية (عُمان)	
+ar-PS	ar		PS	Arabic (Palestinian Territories)	العربية (الأراضي الفلسطينية)	
+ar-QA	ar		QA	Arabic (Qatar)	العربية (قطر)	
+ar-SA	ar		SA	Arabic (Saudi Arabia)	العربية (المملكة العربية السعودية)	
+ar-SD	ar		SD	Arabic (Sudan)	العربية (السودان)	
+ar-SO	ar		SO	Arabic (Somalia)	العربية (الصومال)	
+ar-SS	ar		SS	Arabic (South Sudan)	العربية (جنوب السودان)	
+ar-SY	ar		SY	Arabic (Syria)	العربية (سوريا)	
+ar-TD	ar		TD	Arabic (Chad)	العربية (تشاد)	
+ar-TN	ar		TN	Arabic (Tunisia)	العربية (تونس)	
+ar-YE	ar		YE	Arabic (Yemen)	العربية (اليمن)	
+as	as			Assamese	অসমীয়া	
+as-IN	as		IN	Assamese (India)	অসমীয়া (ভাৰত)	
+asa	asa			Asu	Kipare	
+asa-TZ	asa		TZ	Asu (Tanzania)	Kipare (Tadhania)	
+ast	ast			Asturian	asturianu	
+ast-ES	ast		ES	Asturian (Spain)	asturianu (España)	
+az	az			Azerbaijani	azərbaycan	
+az-Cyrl	az	Cyrl		Azerbaijani (Cyrillic)	азәрбајҹан (Кирил)	
+az-Cyrl-AZ	az	Cyrl	AZ	Azerbaijani (Cyrillic, Azerbaijan)	азәрбајҹан (Кирил, Азәрбајҹан)	
+az-Latn	az	Latn		Azerbaijani (Latin)	azərbaycan (latın)	
+az-Latn-AZ	az	Latn	AZ	Azerbaijani (Latin, Azerbaijan)	azərbaycan (latın, Azərbaycan)	az-AZ
+bas	bas			Basaa	Ɓàsàa	
+bas-CM	bas		CM	Basaa (Cameroon)	Ɓàsàa (Kàmɛ̀rûn)	
+be	be			Belarusian	беларуская	
+be-BY	be		BY	Belarusian (Belarus)	беларуская (Беларусь)	
+bem	bem			Bemba	Ichibemba	
+bem-ZM	bem		ZM	Bemba (Zambia)	Ichibemba (Zambia)	
+bez	bez			Bena	Hibena	
+bez-TZ	bez		TZ	Bena (Tanzania)	Hibena (Hutanzania)	
+bg	bg			Bulgarian	български	
+bg-BG	bg		BG	Bulgarian (Bulgaria)	български (България)	
+bgc	bgc			Haryanvi	हरियाणवी	
+bgc-IN	bgc		IN	Haryanvi (India)	हरियाणवी (भारत)	
+bho	bho			Bhojpuri	भोजपुरी	
+bho-IN	bho		IN	Bhojpuri (India)	भोजपुरी (भारत)	
+bm	bm			Bambara	bamanakan	
+bm-ML	bm		ML	Bambara (Mali)	bamanakan (Mali)	
+bn	bn			Bangla	বাংলা	
+bn-BD	bn		BD	Bangla (Bangladesh)	বাংলা (বাংলাদেশ)	
+bn-IN	bn		IN	Bangla (India)	বাংলা (ভারত)	
+bo	bo			Tibetan	བོད་སྐད་	
+bo-CN	bo		CN	Tibetan (China)	བོད་སྐད་ (རྒྱ་ནག)	
+bo-IN	bo		IN	Tibetan (India)	བོད་སྐད་ (རྒྱ་གར་)	
+br	br			Breton	brezhoneg	
+br-FR	br		FR	Breton (France)	brezhoneg (Frañs)	
+brx	brx			Bodo	बर’	
+brx-IN	brx		IN	Bodo (India)	बर’ (भारत)	
+bs	bs			Bosnian	bosanski	
+bs-Cyrl	bs	Cyrl		Bosnian (Cyrillic)	босански (ћирилица)	
+bs-Cyrl-BA	bs	Cyrl	BA	Bosnian (Cyrillic, Bosnia & Herzegovina)	босански (ћирилица, Босна и Херцеговина)	
+bs-Latn	bs	Latn		Bosnian (Latin)	bosanski (latinica)	
+bs-Latn-BA	bs	Latn	BA	Bosnian (Latin, Bosnia & Herzegovina)	bosanski (latinica, Bosna i Hercegovina)	bs-BA
+ca	ca			Catalan	català	
+ca-AD	ca		AD	Catalan (Andorra)	català (Andorra)	
+ca-ES	ca		ES	Catalan (Spain)	català (Espanya)	
+ca-FR	ca		FR	Catalan (France)	català (França)	
+ca-IT	ca		IT	Catalan (Italy)	català (Itàlia)	
+ccp	ccp			Chakma	𑄌𑄋𑄴𑄟𑄳𑄦	
+ccp-BD	ccp		BD	Chakma (Bangladesh)	𑄌𑄋𑄴𑄟𑄳𑄦 (𑄝𑄁𑄣𑄘𑄬𑄌𑄴)	
+ccp-IN	ccp		IN	Chakma (India)	𑄌𑄋𑄴𑄟𑄳𑄦 (𑄞𑄢𑄧𑄖𑄴)	
+ce	ce			Chechen	нохчийн	
+ce-RU	ce		RU	Chechen (Russia)	нохчийн (Росси)	
+ceb	ceb			Cebuano	Cebuano	
+ceb-PH	ceb		PH	Cebuano (Philippines)	Cebuano (Pilipinas)	
+cgg	cgg			Chiga	Rukiga	
+cgg-UG	cgg		UG	Chiga (Uganda)	Rukiga (Uganda)	
+chr	chr			Cherokee	ᏣᎳᎩ	
+chr-US	chr		US	Cherokee (United States)	ᏣᎳᎩ (ᏌᏊ ᎢᏳᎾᎵᏍᏔᏅ ᏍᎦᏚᎩ)	
+ckb	ckb			Central Kurdish	کوردیی ناوەندی	
+ckb-IQ	ckb		IQ	Central Kurdish (Iraq)	کوردیی ناوەندی (عێراق)	
+ckb-IR	ckb		IR	Central Kurdish (Iran)	کوردیی ناوەندی (ئێران)	
+cs	cs			Czech	čeština	
+cs-CZ	cs		CZ	Czech (Czechia)	čeština (Česko)	
+cv	cv			Chuvash	чӑваш	
+cv-RU	cv		RU	Chuvash (Russia)	чӑваш (Раҫҫей)	
+cy	cy			Welsh	Cymraeg	
+cy-GB	cy		GB	Welsh (United Kingdom)	Cymraeg (Y Deyrnas Unedig)	
+da	da			Danish	dansk	
+da-DK	da		DK	Danish (Denmark)	dansk (Danmark)	
+da-GL	da		GL	Danish (Greenland)	dansk (Grønland)	
+dav	dav			Taita	Kitaita	
+dav-KE	dav		KE	Taita (Kenya)	Kitaita (Kenya)	
+de	de			German	Deutsch	
+de-AT	de		AT	German (Austria)	Deutsch (Österreich)	
+de-BE	de		BE	German (Belgium)	Deutsch (Belgien)	
+de-CH	de		CH	German (Switzerland)	Deutsch (Schweiz)	
+de-DE	de		DE	German (Germany)	Deutsch (Deutschland)	
+de-IT	de		IT	German (Italy)	Deutsch (Italien)	
+de-LI	de		LI	German (Liechtenstein)	Deutsch (Liechtenstein)	
+de-LU	de		LU	German (Luxembourg)	Deutsch (Luxemburg)	
+dje	dje			Zarma	Zarmaciine	
+dje-NE	dje		NE	Zarma (Niger)	Zarmaciine (Nižer)	
+doi	doi			Dogri	डोगरी	
+doi-IN	doi		IN	Dogri (India)	डोगरी (भारत)	
+dsb	dsb			Lower Sorbian	dolnoserbšćina	
+dsb-DE	dsb		DE	Lower Sorbian (Germany)	dolnoserbšćina (Nimska)	
+dua	dua			Duala	duálá	
+dua-CM	dua		CM	Duala (Cameroon)	duálá (Cameroun)	
+dyo	dyo			Jola-Fonyi	joola	
+dyo-SN	dyo		SN	Jola-Fonyi (Senegal)	joola (Senegal)	
+dz	dz			Dzongkha	རྫོང་ཁ	
+dz-BT	dz		BT	Dzongkha (Bhutan)	རྫོང་ཁ། (འབྲུག།)	
+ebu	ebu			Embu	Kĩembu	
+ebu-KE	ebu		KE	Embu (Kenya)	Kĩembu (Kenya)	
+ee	ee			Ewe	Eʋegbe	
+ee-GH	ee		GH	Ewe (Ghana)	Eʋegbe (Ghana nutome)	
+ee-TG	ee		TG	Ewe (Togo)	Eʋegbe (Togo nutome)	
+el	el			Greek	Ελληνικά	
+el-CY	el		CY	Greek (Cyprus)	Ελληνικά (Κύπρος)	
+el-GR	el		GR	Greek (Greece)	Ελληνικά (Ελλάδα)	
+en	en			English	English	
+en-001	en		001	English (world)	English (world)	
+en-150	en		150	English (Europe)	English (Europe)	
+en-AE	en		AE	English (United Arab Emirates)	English (United Arab Emirates)	
+en-AG	en		AG	English (Antigua & Barbuda)	English (Antigua & Barbuda)	
+en-AI	en		AI	English (Anguilla)	English (Anguilla)	
+en-AS	en		AS	English (American Samoa)	English (American Samoa)	
+en-AT	en		AT	English (Austria)	English (Austria)	
+en-AU	en		AU	English (Australia)	English (Australia)	
+en-BB	en		BB	English (Barbados)	English (Barbados)	
+en-BE	en		BE	English (Belgium)	English (Belgium)	
+en-BI	en		BI	English (Burundi)	English (Burundi)	
+en-BM	en		BM	English (Bermuda)	English (Bermuda)	
+en-BS	en		BS	English (Bahamas)	English (Bahamas)	
+en-BW	en		BW	English (Botswana)	English (Botswana)	
+en-BZ	en		BZ	English (Belize)	English (Belize)	
+en-CA	en		CA	English (Canada)	English (Canada)	
+en-CC	en		CC	English (Cocos [Keeling] Islands)	English (Cocos [Keeling] Islands)	
+en-CH	en		CH	English (Switzerland)	English (Switzerland)	
+en-CK	en		CK	English (Cook Islands)	English (Cook Islands)	
+en-CM	en		CM	English (Cameroon)	English (Cameroon)	
+en-CX	en		CX	English (Christmas Island)	English (Christmas Island)	
+en-CY	en		CY	English (Cyprus)	English (Cyprus)	
+en-DE	en		DE	English (Germany)	English (Germany)	
+en-DG	en		DG	English (Diego Garcia)	English (Diego Garcia)	
+en-DK	en		DK	English (Denmark)	English (Denmark)	
+en-DM	en		DM	English (Dominica)	English (Dominica)	
+en-ER	en		ER	English (Eritrea)	English (Eritrea)	
+en-FI	en		FI	English (Finland)	English (Finland)	
+en-FJ	en		FJ	English (Fiji)	English (Fiji)	
+en-FK	en		FK	English (Falkland Islands)	English (Falkland Islands)	
+en-FM	en		FM	English (Micronesia)	English (Micronesia)	
+en-GB	en		GB	English (United Kingdom)	English (United Kingdom)	
+en-GD	en		GD	English (Grenada)	English (Grenada)	
+en-GG	en		GG	English (Guernsey)	English (Guernsey)	
+en-GH	en		GH	English (Ghana)	English (Ghana)	
+en-GI	en		GI	English (Gibraltar)	English (Gibraltar)	
+en-GM	en		GM	English (Gambia)	English (Gambia)	
+en-GU	en		GU	English (Guam)	English (Guam)	
+en-GY	en		GY	English (Guyana)	English (Guyana)	
+en-HK	en		HK	English (Hong Kong SAR China)	English (Hong Kong SAR China)	
+en-IE	en		IE	English (Ireland)	English (Ireland)	
+en-IL	en		IL	English (Israel)	English (Israel)	
+en-IM	en		IM	English (Isle of Man)	English (Isle of Man)	
+en-IN	en		IN	English (India)	English (India)	
+en-IO	en		IO	English (British Indian Ocean Territory)	English (British Indian Ocean Territory)	
+en-JE	en		JE	English (Jersey)	English (Jersey)	
+en-JM	en		JM	English (Jamaica)	English (Jamaica)	
+en-KE	en		KE	English (Kenya)	English (Kenya)	
+en-KI	en		KI	English (Kiribati)	English (Kiribati)	
+en-KN	en		KN	English (St. Kitts & Nevis)	English (St Kitts & Nevis)	
+en-KY	en		KY	English (Cayman Islands)	English (Cayman Islands)	
+en-LC	en		LC	English (St. Lucia)	English (St Lucia)	
+en-LR	en		LR	English (Liberia)	English (Liberia)	
+en-LS	en		LS	English (Lesotho)	English (Lesotho)	
+en-MG	en		MG	English (Madagascar)	English (Madagascar)	
+en-MH	en		MH	English (Marshall Islands)	English (Marshall Islands)	
+en-MO	en		MO	English (Macao SAR China)	English (Macao SAR China)	
+en-MP	en		MP	English (Northern Mariana Islands)	English (Northern Mariana Islands)	
+en-MS	en		MS	English (Montserrat)	English (Montserrat)	
+en-MT	en		MT	English (Malta)	English (Malta)	
+en-MU	en		MU	English (Mauritius)	English (Mauritius)	
+en-MV	en		MV	English (Maldives)	English (Maldives)	
+en-MW	en		MW	English (Malawi)	English (Malawi)	
+en-MY	en		MY	English (Malaysia)	English (Malaysia)	
+en-NA	en		NA	English (Namibia)	English (Namibia)	
+en-NF	en		NF	English (Norfolk Island)	English (Norfolk Island)	
+en-NG	en		NG	English (Nigeria)	English (Nigeria)	
+en-NL	en		NL	English (Netherlands)	English (Netherlands)	
+en-NR	en		NR	English (Nauru)	English (Nauru)	
+en-NU	en		NU	English (Niue)	English (Niue)	
+en-NZ	en		NZ	English (New Zealand)	English (New Zealand)	
+en-PG	en		PG	English (Papua New Guinea)	English (Papua New Guinea)	
+en-PH	en		PH	English (Philippines)	English (Philippines)	
+en-PK	en		PK	English (Pakistan)	English (Pakistan)	
+en-PN	en		PN	English (Pitcairn Islands)	English (Pitcairn Islands)	
+en-PR	en		PR	English (Puerto Rico)	English (Puerto Rico)	
+en-PW	en		PW	English (Palau)	English (Palau)	
+en-RW	en		RW	English (Rwanda)	English (Rwanda)	
+en-SB	en		SB	English (Solomon Islands)	English (Solomon Islands)	
+en-SC	en		SC	English (Seychelles)	English (Seychelles)	
+en-SD	en		SD	English (Sudan)	English (Sudan)	
+en-SE	en		SE	English (Sweden)	English (Sweden)	
+en-SG	en		SG	English (Singapore)	English (Singapore)	
+en-SH	en		SH	English (St. Helena)	English (St Helena)	
+en-SI	en		SI	English (Slovenia)	English (Slovenia)	
+en-SL	en		SL	English (Sierra Leone)	English (Sierra Leone)	
+en-SS	en		SS	English (South Sudan)	English (South Sudan)	
+en-SX	en		SX	English (Sint Maarten)	English (Sint Maarten)	
+en-SZ	en		SZ	English (Eswatini)	English (Eswatini)	
+en-TC	en		TC	English (Turks & Caicos Islands)	English (Turks & Caicos Islands)	
+en-TK	en		TK	English (Tokelau)	English (Tokelau)	
+en-TO	en		TO	English (Tonga)	English (Tonga)	
+en-TT	en		TT	English (Trinidad & Tobago)	English (Trinidad & Tobago)	
+en-TV	en		TV	English (Tuvalu)	English (Tuvalu)	
+en-TZ	en		TZ	English (Tanzania)	English (Tanzania)	
+en-UG	en		UG	English (Uganda)	English (Uganda)	
+en-UM	en		UM	English (U.S. Outlying Islands)	English (U.S. Outlying Islands)	
+en-US	en		US	English (United States)	English (United States)	
+en-US-u-va-posix	en		US	English (United States, Computer)	English (United States, Computer)	
+en-VC	en		VC	English (St. Vincent & Grenadines)	English (St Vincent & the Grenadines)	
+en-VG	en		VG	English (British Virgin Islands)	English (British Virgin Islands)	
+en-VI	en		VI	English (U.S. Virgin Islands)	English (U.S. Virgin Islands)	
+en-VU	en		VU	English (Vanuatu)	English (Vanuatu)	
+en-WS	en		WS	English (Samoa)	English (Samoa)	
+en-ZA	en		ZA	English (South Africa)	English (South Africa)	
+en-ZM	en		ZM	English (Zambia)	English (Zambia)	
+en-ZW	en		ZW	English (Zimbabwe)	English (Zimbabwe)	
+eo	eo			Esperanto	esperanto	
+eo-001	eo		001	Esperanto (world)	esperanto (Mondo)	
+es	es			Spanish	español	
+es-419	es		419	Spanish (Latin America)	español (Latinoamérica)	
+es-AR	es		AR	Spanish (Argentina)	español (Argentina)	
+es-BO	es		BO	Spanish (Bolivia)	español (Bolivia)	
+es-BR	es		BR	Spanish (Brazil)	español (Brasil)	
+es-BZ	es		BZ	Spanish (Belize)	español (Belice)	
+es-CL	es		CL	Spanish (Chile)	español (Chile)	
+es-CO	es		CO	Spanish (Colombia)	español (Colombia)	
+es-CR	es		CR	Spanish (Costa Rica)	español (Costa Rica)	
+es-CU	es		CU	Spanish (Cuba)	español (Cuba)	
+es-DO	es		DO	Spanish (Dominican Republic)	español (República Dominicana)	
+es-EA	es		EA	Spanish (Ceuta & Melilla)	español (Ceuta y Melilla)	
+es-EC	es		EC	Spanish (Ecuador)	español (Ecuador)	
+es-ES	es		ES	Spanish (Spain)	español (España)	
+es-GQ	es		GQ	Spanish (Equatorial Guinea)	español (Guinea Ecuatorial)	
+es-GT	es		GT	Spanish (Guatemala)	español (Guatemala)	
+es-HN	es		HN	Spanish (Honduras)	español (Honduras)	
+es-IC	es		IC	Spanish (Canary Islands)	español (Canarias)	
+es-MX	es		MX	Spanish (Mexico)	español (México)	
+es-NI	es		NI	Spanish (Nicaragua)	español (Nicaragua)	
+es-PA	es		PA	Spanish (Panama)	español (Panamá)	
+es-PE	es		PE	Spanish (Peru)	español (Perú)	
+es-PH	es		PH	Spanish (Philippines)	español (Filipinas)	
+es-PR	es		PR	Spanish (Puerto Rico)	español (Puerto Rico)	
+es-PY	es		PY	Spanish (Paraguay)	español (Paraguay)	
+es-SV	es		SV	Spanish (El Salvador)	español (El Salvador)	
+es-US	es		US	Spanish (United States)	español (Estados Unidos)	
+es-UY	es		UY	Spanish (Uruguay)	español (Uruguay)	
+es-VE	es		VE	Spanish (Venezuela)	español (Venezuela)	
+et	et			Estonian	eesti	
+et-EE	et		EE	Estonian (Estonia)	eesti (Eesti)	
+eu	eu			Basque	euskara	
+eu-ES	eu		ES	Basque (Spain)	euskara (Espainia)	
+ewo	ewo			Ewondo	ewondo	
+ewo-CM	ewo		CM	Ewondo (Cameroon)	ewondo (Kamərún)	
+fa	fa			Persian	فارسی	
+fa-AF	fa		AF	Persian (Afghanistan)	فارسی (افغانستان)	
+fa-IR	fa		IR	Persian (Iran)	فارسی (ایران)	
+ff	ff			Fula	Pulaar	
+ff-Adlm	ff	Adlm		Fula (Adlam)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃)	
+ff-Adlm-BF	ff	Adlm	BF	Fula (Adlam, Burkina Faso)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃⹁ 𞤄𞤵𞤪𞤳𞤭𞤲𞤢 𞤊𞤢𞤧𞤮𞥅)	
+ff-Adlm-CM	ff	Adlm	CM	Fula (Adlam, Cameroon)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃⹁ 𞤑𞤢𞤥𞤢𞤪𞤵𞥅𞤲)	
+ff-Adlm-GH	ff	Adlm	GH	Fula (Adlam, Ghana)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃⹁ 𞤘𞤢𞤲𞤢)	
+ff-Adlm-GM	ff	Adlm	GM	Fula (Adlam, Gambia)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃⹁ 𞤘𞤢𞤥𞤦𞤭𞤴𞤢)	
+ff-Adlm-GN	ff	Adlm	GN	Fula (Adlam, Guinea)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃⹁ 𞤘𞤭𞤲𞤫)	
+ff-Adlm-GW	ff	Adlm	GW	Fula (Adlam, Guinea-Bissau)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃⹁ 𞤘𞤭𞤲𞤫-𞤄𞤭𞤧𞤢𞤱𞤮𞥅)	
+ff-Adlm-LR	ff	Adlm	LR	Fula (Adlam, Liberia)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃⹁ 𞤂𞤢𞤦𞤭𞤪𞤭𞤴𞤢𞥄)	
+ff-Adlm-MR	ff	Adlm	MR	Fula (Adlam, Mauritania)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃⹁ 𞤃𞤮𞤪𞤼𞤢𞤲𞤭𞥅)	
+ff-Adlm-NE	ff	Adlm	NE	Fula (Adlam, Niger)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃⹁ 𞤐𞤭𞥅𞤶𞤫𞤪)	
+ff-Adlm-NG	ff	Adlm	NG	Fula (Adlam, Nigeria)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃⹁ 𞤐𞤢𞤶𞤫𞤪𞤭𞤴𞤢𞥄)	
+ff-Adlm-SL	ff	Adlm	SL	Fula (Adlam, Sierra Leone)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃⹁ 𞤅𞤢𞤪𞤢𞤤𞤮𞤲)	
+ff-Adlm-SN	ff	Adlm	SN	Fula (Adlam, Senegal)	𞤆𞤵𞤤𞤢𞤪 (𞤀𞤁𞤂𞤢𞤃⹁ 𞤅𞤫𞤲𞤫𞤺𞤢𞥄𞤤)	
+ff-Latn	ff	Latn		Fula (Latin)	Pulaar (Latn)	
+ff-Latn-BF	ff	Latn	BF	Fula (Latin, Burkina Faso)	Pulaar (Latn, Burkibaa Faaso)	ff-BF
+ff-Latn-CM	ff	Latn	CM	Fula (Latin, Cameroon)	Pulaar (Latn, Kameruun)	ff-CM
+ff-Latn-GH	ff	Latn	GH	Fula (Latin, Ghana)	Pulaar (Latn, Ganaa)	ff-GH
+ff-Latn-GM	ff	Latn	GM	Fula (Latin, Gambia)	Pulaar (Latn, Gammbi)	ff-GM
+ff-Latn-GN	ff	Latn	GN	Fula (Latin, Guinea)	Pulaar (Latn, Gine)	ff-GN
+ff-Latn-GW	ff	Latn	GW	Fula (Latin, Guinea-Bissau)	Pulaar (Latn, Gine-Bisaawo)	ff-GW
+ff-Latn-LR	ff	Latn	LR	Fula (Latin, Liberia)	Pulaar (Latn, Liberiyaa)	ff-LR
+ff-Latn-MR	ff	Latn	MR	Fula (Latin, Mauritania)	Pulaar (Latn, Muritani)	ff-MR
+ff-Latn-NE	ff	Latn	NE	Fula (Latin, Niger)	Pulaar (Latn, Nijeer)	ff-NE
+ff-Latn-NG	ff	Latn	NG	Fula (Latin, Nigeria)	Pulaar (Latn, Nijeriyaa)	ff-NG
+ff-Latn-SL	ff	Latn	SL	Fula (Latin, Sierra Leone)	Pulaar (Latn, Seraa liyon)	ff-SL
+ff-Latn-SN	ff	Latn	SN	Fula (Latin, Senegal)	Pulaar (Latn, Senegaal)	ff-SN
+fi	fi			Finnish	suomi	
+fi-FI	fi		FI	Finnish (Finland)	suomi (Suomi)	
+fil	fil			Filipino	Filipino	
+fil-PH	fil		PH	Filipino (Philippines)	Filipino (Pilipinas)	
+fo	fo			Faroese	føroyskt	
+fo-DK	fo		DK	Faroese (Denmark)	føroyskt (Danmark)	
+fo-FO	fo		FO	Faroese (Faroe Islands)	føroyskt (Føroyar)	
+fr	fr			French	français	
+fr-BE	fr		BE	French (Belgium)	français (Belgique)	
+fr-BF	fr		BF	French (Burkina Faso)	français (Burkina Faso)	
+fr-BI	fr		BI	French (Burundi)	français (Burundi)	
+fr-BJ	fr		BJ	French (Benin)	français (Bénin)	
+fr-BL	fr		BL	French (St. Barthélemy)	français (Saint-Barthélemy)	
+fr-CA	fr		CA	French (Canada)	français (Canada)	
+fr-CD	fr		CD	French (Congo - Kinshasa)	français (Congo-Kinshasa)	
+fr-CF	fr		CF	French (Central African Republic)	français (République centrafricaine)	
+fr-CG	fr		CG	French (Congo - Brazzaville)	français (Congo-Brazzaville)	
+fr-CH	fr		CH	French (Switzerland)	français (Suisse)	
+fr-CI	fr		CI	French (Côte d’Ivoire)	français (Côte d’Ivoire)	
+fr-CM	fr		CM	French (Cameroon)	français (Cameroun)	
+fr-DJ	fr		DJ	French (Djibouti)	français (Djibouti)	
+fr-DZ	fr		DZ	French (Algeria)	français (Algérie)	
+fr-FR	fr		FR	French (France)	français (France)	
+fr-GA	fr		GA	French (Gabon)	français (Gabon)	
+fr-GF	fr		GF	French (French Guiana)	français (Guyane française)	
+fr-GN	fr		GN	French (Guinea)	français (Guinée)	
+fr-GP	fr		GP	French (Guadeloupe)	français (Guadeloupe)	
+fr-GQ	fr		GQ	French (Equatorial Guinea)	français (Guinée équatoriale)	
+fr-HT	fr		HT	French (Haiti)	français (Haïti)	
+fr-KM	fr		KM	French (Comoros)	français (Comores)	
+fr-LU	fr		LU	French (Luxembourg)	français (Luxembourg)	
+fr-MA	fr		MA	French (Morocco)	français (Maroc)	
+fr-MC	fr		MC	French (Monaco)	français (Monaco)	
+fr-MF	fr		MF	French (St. Martin)	français (Saint-Martin)	
+fr-MG	fr		MG	French (Madagascar)	français (Madagascar)	
+fr-ML	fr		ML	French (Mali)	français (Mali)	
+fr-MQ	fr		MQ	French (Martinique)	français (Martinique)	
+fr-MR	fr		MR	French (Mauritania)	français (Mauritanie)	
+fr-MU	fr		MU	French (Mauritius)	français (Maurice)	
+fr-NC	fr		NC	French (New Caledonia)	français (Nouvelle-Calédonie)	
+fr-NE	fr		NE	French (Niger)	français (Niger)	
+fr-PF	fr		PF	French (French Polynesia)	français (Polynésie française)	
+fr-PM	fr		PM	French (St. Pierre & Miquelon)	français (Saint-Pierre-et-Miquelon)	
+fr-RE	fr		RE	French (Réunion)	français (La Réunion)	
+fr-RW	fr		RW	French (Rwanda)	français (Rwanda)	
+fr-SC	fr		SC	French (Seychelles)	français (Seychelles)	
+fr-SN	fr		SN	French (Senegal)	français (Sénégal)	
+fr-SY	fr		SY	French (Syria)	français (Syrie)	
+fr-TD	fr		TD	French (Chad)	français (Tchad)	
+fr-TG	fr		TG	French (Togo)	français (Togo)	
+fr-TN	fr		TN	French (Tunisia)	français (Tunisie)	
+fr-VU	fr		VU	French (Vanuatu)	français (Vanuatu)	
+fr-WF	fr		WF	French (Wallis & Futuna)	français (Wallis-et-Futuna)	
+fr-YT	fr		YT	French (Mayotte)	français (Mayotte)	
+fur	fur			Friulian	furlan	
+fur-IT	fur		IT	Friulian (Italy)	furlan (Italie)	
+fy	fy			Western Frisian	Frysk	
+fy-NL	fy		NL	Western Frisian (Netherlands)	Frysk (Nederlân)	
+ga	ga			Irish	Gaeilge	
+ga-GB	ga		GB	Irish (United Kingdom)	Gaeilge (an Ríocht Aontaithe)	
+ga-IE	ga		IE	Irish (Ireland)	Gaeilge (Éire)	
+gd	gd			Scottish Gaelic	Gàidhlig	
+gd-GB	gd		GB	Scottish Gaelic (United Kingdom)	Gàidhlig (An Rìoghachd Aonaichte)	
+gl	gl			Galician	galego	
+gl-ES	gl		ES	Galician (Spain)	galego (España)	
+gsw	gsw			Swiss German	Schwiizertüütsch	
+gsw-CH	gsw		CH	Swiss German (Switzerland)	Schwiizertüütsch (Schwiiz)	
+gsw-FR	gsw		FR	Swiss German (France)	Schwiizertüütsch (Frankriich)	
+gsw-LI	gsw		LI	Swiss German (Liechtenstein)	Schwiizertüütsch (Liächteschtäi)	
+gu	gu			Gujarati	ગુજરાતી	
+gu-IN	gu		IN	Gujarati (India)	ગુજરાતી (ભારત)	
+guz	guz			Gusii	Ekegusii	
+guz-KE	guz		KE	Gusii (Kenya)	Ekegusii (Kenya)	
+gv	gv			Manx	Gaelg	
+gv-IM	gv		IM	Manx (Isle of Man)	Gaelg (Ellan Vannin)	
+ha	ha			Hausa	Hausa	
+ha-GH	ha		GH	Hausa (Ghana)	Hausa (Gana)	
+ha-NE	ha		NE	Hausa (Niger)	Hausa (Nijar)	
+ha-NG	ha		NG	Hausa (Nigeria)	Hausa (Nijeriya)	
+haw	haw			Hawaiian	ʻŌlelo Hawaiʻi	
+haw-US	haw		US	Hawaiian (United States)	ʻŌlelo Hawaiʻi (ʻAmelika Hui Pū ʻIa)	
+he	he			Hebrew	עברית	
+he-IL	he		IL	Hebrew (Israel)	עברית (ישראל)	
+hi	hi			Hindi	हिन्दी	
+hi-IN	hi		IN	Hindi (India)	हिन्दी (भारत)	
+hi-Latn	hi	Latn		Hindi (Latin)	Hindi (Latin)	
+hi-Latn-IN	hi	Latn	IN	Hindi (Latin, India)	Hindi (Latin, India)	
+hr	hr			Croatian	hrvatski	
+hr-BA	hr		BA	Croatian (Bosnia & Herzegovina)	hrvatski (Bosna i Hercegovina)	
+hr-HR	hr		HR	Croatian (Croatia)	hrvatski (Hrvatska)	
+hsb	hsb			Upper Sorbian	hornjoserbšćina	
+hsb-DE	hsb		DE	Upper Sorbian (Germany)	hornjoserbšćina (Němska)	
+hu	hu			Hungarian	magyar	
+hu-HU	hu		HU	Hungarian (Hungary)	magyar (Magyarország)	
+hy	hy			Armenian	հայերեն	
+hy-AM	hy		AM	Armenian (Armenia)	հայերեն (Հայաստան)	
+ia	ia			Interlingua	interlingua	
+ia-001	ia		001	Interlingua (world)	interlingua (Mundo)	
+id	id			Indonesian	Indonesia	
+id-ID	id		ID	Indonesian (Indonesia)	Indonesia (Indonesia)	
+ig	ig			Igbo	Igbo	
+ig-NG	ig		NG	Igbo (Nigeria)	Igbo (Naịjịrịa)	
+ii	ii			Sichuan Yi	ꆈꌠꉙ	
+ii-CN	ii		CN	Sichuan Yi (China)	ꆈꌠꉙ (ꍏꇩ)	
+is	is			Icelandic	íslenska	
+is-IS	is		IS	Icelandic (Iceland)	íslenska (Ísland)	
+it	it			Italian	italiano	
+it-CH	it		CH	Italian (Switzerland)	italiano (Svizzera)	
+it-IT	it		IT	Italian (Italy)	italiano (Italia)	
+it-SM	it		SM	Italian (San Marino)	italiano (San Marino)	
+it-VA	it		VA	Italian (Vatican City)	italiano (Città del Vaticano)	
+ja	ja			Japanese	日本語	
+ja-JP	ja		JP	Japanese (Japan)	日本語 (日本)	
+jgo	jgo			Ngomba	Ndaꞌa	
+jgo-CM	jgo		CM	Ngomba (Cameroon)	Ndaꞌa (Kamɛlûn)	
+jmc	jmc			Machame	Kimachame	
+jmc-TZ	jmc		TZ	Machame (Tanzania)	Kimachame (Tanzania)	
+jv	jv			Javanese	Jawa	
+jv-ID	jv		ID	Javanese (Indonesia)	Jawa (Indonésia)	
+ka	ka			Georgian	ქართული	
+ka-GE	ka		GE	Georgian (Georgia)	ქართული (საქართველო)	
+kab	kab			Kabyle	Taqbaylit	
+kab-DZ	kab		DZ	Kabyle (Algeria)	Taqbaylit (Lezzayer)	
+kam	kam			Kamba	Kikamba	
+kam-KE	kam		KE	Kamba (Kenya)	Kikamba (Kenya)	
+kde	kde			Makonde	Chimakonde	
+kde-TZ	kde		TZ	Makonde (Tanzania)	Chimakonde (Tanzania)	
+kea	kea			Kabuverdianu	kabuverdianu	
+kea-CV	kea		CV	Kabuverdianu (Cape Verde)	kabuverdianu (Kabu Verdi)	
+kgp	kgp			Kaingang	kanhgág	
+kgp-BR	kgp		BR	Kaingang (Brazil)	kanhgág (Mrasir)	
+khq	khq			Koyra Chiini	Koyra ciini	
+khq-ML	khq		ML	Koyra Chiini (Mali)	Koyra ciini (Maali)	
+ki	ki			Kikuyu	Gikuyu	
+ki-KE	ki		KE	Kikuyu (Kenya)	Gikuyu (Kenya)	
+kk	kk			Kazakh	қазақ тілі	
+kk-KZ	kk		KZ	Kazakh (Kazakhstan)	қазақ тілі (Қазақстан)	
+kkj	kkj			Kako	kakɔ	
+kkj-CM	kkj		CM	Kako (Cameroon)	kakɔ (Kamɛrun)	
+kl	kl			Kalaallisut	kalaallisut	
+kl-GL	kl		GL	Kalaallisut (Greenland)	kalaallisut (Kalaallit Nunaat)	
+kln	kln			Kalenjin	Kalenjin	
+kln-KE	kln		KE	Kalenjin (Kenya)	Kalenjin (Emetab Kenya)	
+km	km			Khmer	ខ្មែរ	
+km-KH	km		KH	Khmer (Cambodia)	ខ្មែរ (កម្ពុជា)	
+kn	kn			Kannada	ಕನ್ನಡ	
+kn-IN	kn		IN	Kannada (India)	ಕನ್ನಡ (ಭಾರತ)	
+ko	ko			Korean	한국어	
+ko-KP	ko		KP	Korean (North Korea)	한국어(조선민주주의인민공화국)	
+ko-KR	ko		KR	Korean (South Korea)	한국어(대한민국)	
+kok	kok			Konkani	कोंकणी	
+kok-IN	kok		IN	Konkani (India)	कोंकणी (भारत)	
+ks	ks			Kashmiri	کٲشُر	
+ks-Arab	ks	Arab		Kashmiri (Arabic)	کٲشُر (عربی)	
+ks-Arab-IN	ks	Arab	IN	Kashmiri (Arabic, India)	کٲشُر (عربی, ہِندوستان)	ks-IN
+ks-Deva	ks	Deva		Kashmiri (Devanagari)	कॉशुर (देवनागरी)	
+ks-Deva-IN	ks	Deva	IN	Kashmiri (Devanagari, India)	कॉशुर (देवनागरी, हिंदोस्तान)	
+ksb	ksb			Shambala	Kishambaa	
+ksb-TZ	ksb		TZ	Shambala (Tanzania)	Kishambaa (Tanzania)	
+ksf	ksf			Bafia	rikpa	
+ksf-CM	ksf		CM	Bafia (Cameroon)	rikpa (kamɛrún)	
+ksh	ksh			Colognian	Kölsch	
+ksh-DE	ksh		DE	Colognian (Germany)	Kölsch en Doütschland	
+ku	ku			Kurdish	kurdî	
+ku-TR	ku		TR	Kurdish (Turkey)	kurdî (Tirkiye)	
+kw	kw			Cornish	kernewek	
+kw-GB	kw		GB	Cornish (United Kingdom)	kernewek (Rywvaneth Unys)	
+ky	ky			Kyrgyz	кыргызча	
+ky-KG	ky		KG	Kyrgyz (Kyrgyzstan)	кыргызча (Кыргызстан)	
+lag	lag			Langi	Kɨlaangi	
+lag-TZ	lag		TZ	Langi (Tanzania)	Kɨlaangi (Taansanía)	
+lb	lb			Luxembourgish	Lëtzebuergesch	
+lb-LU	lb		LU	Luxembourgish (Luxembourg)	Lëtzebuergesch (Lëtzebuerg)	
+lg	lg			Ganda	Luganda	
+lg-UG	lg		UG	Ganda (Uganda)	Luganda (Yuganda)	
+lkt	lkt			Lakota	Lakȟólʼiyapi	
+lkt-US	lkt		US	Lakota (United States)	Lakȟólʼiyapi (Mílahaŋska Tȟamákȟočhe)	
+ln	ln			Lingala	lingála	
+ln-AO	ln		AO	Lingala (Angola)	lingála (Angóla)	
+ln-CD	ln		CD	Lingala (Congo - Kinshasa)	lingála (Republíki ya Kongó Demokratíki)	
+ln-CF	ln		CF	Lingala (Central African Republic)	lingála (Repibiki ya Afríka ya Káti)	
+ln-CG	ln		CG	Lingala (Congo - Brazzaville)	lingála (Kongo)	
+lo	lo			Lao	ລາວ	
+lo-LA	lo		LA	Lao (Laos)	ລາວ (ລາວ)	
+lrc	lrc			Northern Luri	لۊری شومالی	
+lrc-IQ	lrc		IQ	Northern Luri (Iraq)	لۊری شومالی (IQ)	
+lrc-IR	lrc		IR	Northern Luri (Iran)	لۊری شومالی (IR)	
+lt	lt			Lithuanian	lietuvių	
+lt-LT	lt		LT	Lithuanian (Lithuania)	lietuvių (Lietuva)	
+lu	lu			Luba-Katanga	Tshiluba	
+lu-CD	lu		CD	Luba-Katanga (Congo - Kinshasa)	Tshiluba (Ditunga wa Kongu)	
+luo	luo			Luo	Dholuo	
+luo-KE	luo		KE	Luo (Kenya)	Dholuo (Kenya)	
+luy	luy			Luyia	Luluhia	
+luy-KE	luy		KE	Luyia (Kenya)	Luluhia (Kenya)	
+lv	lv			Latvian	latviešu	
+lv-LV	lv		LV	Latvian (Latvia)	latviešu (Latvija)	
+mai	mai			Maithili	मैथिली	
+mai-IN	mai		IN	Maithili (India)	मैथिली (भारत)	
+mas	mas			Masai	Maa	
+mas-KE	mas		KE	Masai (Kenya)	Maa (Kenya)	
+mas-TZ	mas		TZ	Masai (Tanzania)	Maa (Tansania)	
+mer	mer			Meru	Kĩmĩrũ	
+mer-KE	mer		KE	Meru (Kenya)	Kĩmĩrũ (Kenya)	
+mfe	mfe			Morisyen	kreol morisien	
+mfe-MU	mfe		MU	Morisyen (Mauritius)	kreol morisien (Moris)	
+mg	mg			Malagasy	Malagasy	
+mg-MG	mg		MG	Malagasy (Madagascar)	Malagasy (Madagasikara)	
+mgh	mgh			Makhuwa-Meetto	Makua	
+mgh-MZ	mgh		MZ	Makhuwa-Meetto (Mozambique)	Makua (Umozambiki)	
+mgo	mgo			Metaʼ	metaʼ	
+mgo-CM	mgo		CM	Metaʼ (Cameroon)	metaʼ (Kamalun)	
+mi	mi			Māori	Māori	
+mi-NZ	mi		NZ	Māori (New Zealand)	Māori (Aotearoa)	
+mk	mk			Macedonian	македонски	
+mk-MK	mk		MK	Macedonian (North Macedonia)	македонски (Северна Македонија)	
+ml	ml			Malayalam	മലയാളം	
+ml-IN	ml		IN	Malayalam (India)	മലയാളം (ഇന്ത്യ)	
+mn	mn			Mongolian	монгол	
+mn-MN	mn		MN	Mongolian (Mongolia)	монгол (Монгол)	
+mni	mni			Manipuri	মৈতৈলোন্	
+mni-Beng	mni	Beng		Manipuri (Bangla)	মৈতৈলোন্ (বাংলা)	
+mni-Beng-IN	mni	Beng	IN	Manipuri (Bangla, India)	মৈতৈলোন্ (বাংলা, ইন্দিয়া)	mni-IN
+mr	mr			Marathi	मराठी	
+mr-IN	mr		IN	Marathi (India)	मराठी (भारत)	
+ms	ms			Malay	Melayu	
+ms-BN	ms		BN	Malay (Brunei)	Melayu (Brunei)	
+ms-ID	ms		ID	Malay (Indonesia)	Melayu (Indonesia)	
+ms-MY	ms		MY	Malay (Malaysia)	Melayu (Malaysia)	
+ms-SG	ms		SG	Malay (Singapore)	Melayu (Singapura)	
+mt	mt			Maltese	Malti	
+mt-MT	mt		MT	Maltese (Malta)	Malti (Malta)	
+mua	mua			Mundang	MUNDAŊ	
+mua-CM	mua		CM	Mundang (Cameroon)	MUNDAŊ (kameruŋ)	
+my	my			Burmese	မြန်မာ	
+my-MM	my		MM	Burmese (Myanmar [Burma])	မြန်မာ (မြန်မာ)	
+mzn	mzn			Mazanderani	مازرونی	
+mzn-IR	mzn		IR	Mazanderani (Iran)	مازرونی (ایران)	
+naq	naq			Nama	Khoekhoegowab	
+naq-NA	naq		NA	Nama (Namibia)	Khoekhoegowab (Namibiab)	
+nb	nb			Norwegian Bokmål	norsk bokmål	
+nb-NO	nb		NO	Norwegian Bokmål (Norway)	norsk bokmål (Norge)	
+nb-SJ	nb		SJ	Norwegian Bokmål (Svalbard & Jan Mayen)	norsk bokmål (Svalbard og Jan Mayen)	
+nd	nd			North Ndebele	isiNdebele	
+nd-ZW	nd		ZW	North Ndebele (Zimbabwe)	isiNdebele (Zimbabwe)	
+ne	ne			Nepali	नेपाली	
+ne-IN	ne		IN	Nepali (India)	नेपाली (भारत)	
+ne-NP	ne		NP	Nepali (Nepal)	नेपाली (नेपाल)	
+nl	nl			Dutch	Nederlands	
+nl-AW	nl		AW	Dutch (Aruba)	Nederlands (Aruba)	
+nl-BE	nl		BE	Dutch (Belgium)	Nederlands (België)	
+nl-BQ	nl		BQ	Dutch (Caribbean Netherlands)	Nederlands (Caribisch Nederland)	
+nl-CW	nl		CW	Dutch (Curaçao)	Nederlands (Curaçao)	
+nl-NL	nl		NL	Dutch (Netherlands)	Nederlands (Nederland)	
+nl-SR	nl		SR	Dutch (Suriname)	Nederlands (Suriname)	
+nl-SX	nl		SX	Dutch (Sint Maarten)	Nederlands (Sint-Maarten)	
+nmg	nmg			Kwasio	nmg	
+nmg-CM	nmg		CM	Kwasio (Cameroon)	nmg (Kamerun)	
+nn	nn			Norwegian Nynorsk	norsk nynorsk	
+nn-NO	nn		NO	Norwegian Nynorsk (Norway)	norsk nynorsk (Noreg)	
+nnh	nnh			Ngiemboon	Shwóŋò ngiembɔɔn	
+nnh-CM	nnh		CM	Ngiemboon (Cameroon)	Shwóŋò ngiembɔɔn (Kàmalûm)	
+no	no			Norwegian	norsk	
+nus	nus			Nuer	Thok Nath	
+nus-SS	nus		SS	Nuer (South Sudan)	Thok Nath (SS)	
+nyn	nyn			Nyankole	Runyankore	
+nyn-UG	nyn		UG	Nyankole (Uganda)	Runyankore (Uganda)	
+om	om			Oromo	Oromoo	
+om-ET	om		ET	Oromo (Ethiopia)	Oromoo (Itoophiyaa)	
+om-KE	om		KE	Oromo (Kenya)	Oromoo (Keeniyaa)	
+or	or			Odia	ଓଡ଼ିଆ	
+or-IN	or		IN	Odia (India)	ଓଡ଼ିଆ (ଭାରତ)	
+os	os			Ossetic	ирон	
+os-GE	os		GE	Ossetic (Georgia)	ирон (Гуырдзыстон)	
+os-RU	os		RU	Ossetic (Russia)	ирон (Уӕрӕсе)	
+pa	pa			Punjabi	ਪੰਜਾਬੀ	
+pa-Arab	pa	Arab		Punjabi (Arabic)	پنجابی (عربی)	
+pa-Arab-PK	pa	Arab	PK	Punjabi (Arabic, Pakistan)	پنجابی (عربی, پاکستان)	pa-PK
+pa-Guru	pa	Guru		Punjabi (Gurmukhi)	ਪੰਜਾਬੀ (ਗੁਰਮੁਖੀ)	
+pa-Guru-IN	pa	Guru	IN	Punjabi (Gurmukhi, India)	ਪੰਜਾਬੀ (ਗੁਰਮੁਖੀ, ਭਾਰਤ)	pa-IN
+pcm	pcm			Nigerian Pidgin	Naijíriá Píjin	
+pcm-NG	pcm		NG	Nigerian Pidgin (Nigeria)	Naijíriá Píjin (Naijíria)	
+pl	pl			Polish	polski	
+pl-PL	pl		PL	Polish (Poland)	polski (Polska)	
+ps	ps			Pashto	پښتو	
+ps-AF	ps		AF	Pashto (Afghanistan)	پښتو (افغانستان)	
+ps-PK	ps		PK	Pashto (Pakistan)	پښتو (پاکستان)	
+pt	pt			Portuguese	português	
+pt-AO	pt		AO	Portuguese (Angola)	português (Angola)	
+pt-BR	pt		BR	Portuguese (Brazil)	português (Brasil)	
+pt-CH	pt		CH	Portuguese (Switzerland)	português (Suíça)	
+pt-CV	pt		CV	Portuguese (Cape Verde)	português (Cabo Verde)	
+pt-GQ	pt		GQ	Portuguese (Equatorial Guinea)	português (Guiné Equatorial)	
+pt-GW	pt		GW	Portuguese (Guinea-Bissau)	português (Guiné-Bissau)	
+pt-LU	pt		LU	Portuguese (Luxembourg)	português (Luxemburgo)	
+pt-MO	pt		MO	Portuguese (Macao SAR China)	português (Macau, RAE da China)	
+pt-MZ	pt		MZ	Portuguese (Mozambique)	português (Moçambique)	
+pt-PT	pt		PT	Portuguese (Portugal)	português (Portugal)	
+pt-ST	pt		ST	Portuguese (São Tomé & Príncipe)	português (São Tomé e Príncipe)	
+pt-TL	pt		TL	Portuguese (Timor-Leste)	português (Timor-Leste)	
+qu	qu			Quechua	Runasimi	
+qu-BO	qu		BO	Quechua (Bolivia)	Runasimi (Bolivia)	
+qu-EC	qu		EC	Quechua (Ecuador)	Runasimi (Ecuador)	
+qu-PE	qu		PE	Quechua (Peru)	Runasimi (Perú)	
+raj	raj			Rajasthani	राजस्थानी	
+raj-IN	raj		IN	Rajasthani (India)	राजस्थानी (भारत)	
+rm	rm			Romansh	rumantsch	
+rm-CH	rm		CH	Romansh (Switzerland)	rumantsch (Svizra)	
+rn	rn			Rundi	Ikirundi	
+rn-BI	rn		BI	Rundi (Burundi)	Ikirundi (Uburundi)	
+ro	ro			Romanian	română	
+ro-MD	ro		MD	Romanian (Moldova)	română (Republica Moldova)	
+ro-RO	ro		RO	Romanian (Romania)	română (România)	
+rof	rof			Rombo	Kihorombo	
+rof-TZ	rof		TZ	Rombo (Tanzania)	Kihorombo (Tanzania)	
+ru	ru			Russian	русский	
+ru-BY	ru		BY	Russian (Belarus)	русский (Беларусь)	
+ru-KG	ru		KG	Russian (Kyrgyzstan)	русский (Киргизия)	
+ru-KZ	ru		KZ	Russian (Kazakhstan)	русский (Казахстан)	
+ru-MD	ru		MD	Russian (Moldova)	русский (Молдова)	
+ru-RU	ru		RU	Russian (Russia)	русский (Россия)	
+ru-UA	ru		UA	Russian (Ukraine)	русский (Украина)	
+rw	rw			Kinyarwanda	Kinyarwanda	
+rw-RW	rw		RW	Kinyarwanda (Rwanda)	Kinyarwanda (U Rwanda)	
+rwk	rwk			Rwa	Kiruwa	
+rwk-TZ	rwk		TZ	Rwa (Tanzania)	Kiruwa (Tanzania)	
+sa	sa			Sanskrit	संस्कृत भाषा	
+sa-IN	sa		IN	Sanskrit (India)	संस्कृत भाषा (भारतः)	
+sah	sah			Yakut	саха тыла	
+sah-RU	sah		RU	Yakut (Russia)	саха тыла (Арассыыйа)	
+saq	saq			Samburu	Kisampur	
+saq-KE	saq		KE	Samburu (Kenya)	Kisampur (Kenya)	
+sat	sat			Santali	ᱥᱟᱱᱛᱟᱲᱤ	
+sat-Olck	sat	Olck		Santali (Ol Chiki)	ᱥᱟᱱᱛᱟᱲᱤ (ᱚᱞ ᱪᱤᱠᱤ)	
+sat-Olck-IN	sat	Olck	IN	Santali (Ol Chiki, India)	ᱥᱟᱱᱛᱟᱲᱤ (ᱚᱞ ᱪᱤᱠᱤ, ᱤᱱᱰᱤᱭᱟ)	sat-IN
+sbp	sbp			Sangu	Ishisangu	
+sbp-TZ	sbp		TZ	Sangu (Tanzania)	Ishisangu (Tansaniya)	
+sc	sc			Sardinian	sardu	
+sc-IT	sc		IT	Sardinian (Italy)	sardu (Itàlia)	
+sd	sd			Sindhi	سنڌي	
+sd-Arab	sd	Arab		Sindhi (Arabic)	سنڌي (عربي)	
+sd-Arab-PK	sd	Arab	PK	Sindhi (Arabic, Pakistan)	سنڌي (عربي, پاڪستان)	sd-PK
+sd-Deva	sd	Deva		Sindhi (Devanagari)	सिन्धी (देवनागिरी)	
+sd-Deva-IN	sd	Deva	IN	Sindhi (Devanagari, India)	सिन्धी (देवनागिरी, भारत)	sd-IN
+se	se			Northern Sami	davvisámegiella	
+se-FI	se		FI	Northern Sami (Finland)	davvisámegiella (Suopma)	
+se-NO	se		NO	Northern Sami (Norway)	davvisámegiella (Norga)	
+se-SE	se		SE	Northern Sami (Sweden)	davvisámegiella (Ruoŧŧa)	
+seh	seh			Sena	sena	
+seh-MZ	seh		MZ	Sena (Mozambique)	sena (Moçambique)	
+ses	ses			Koyraboro Senni	Koyraboro senni	
+ses-ML	ses		ML	Koyraboro Senni (Mali)	Koyraboro senni (Maali)	
+sg	sg			Sango	Sängö	
+sg-CF	sg		CF	Sango (Central African Republic)	Sängö (Ködörösêse tî Bêafrîka)	
+shi	shi			Tachelhit	ⵜⴰⵛⵍⵃⵉⵜ	
+shi-Latn	shi	Latn		Tachelhit (Latin)	Tashelḥiyt (Latn)	
+shi-Latn-MA	shi	Latn	MA	Tachelhit (Latin, Morocco)	Tashelḥiyt (Latn, lmɣrib)	
+shi-Tfng	shi	Tfng		Tachelhit (Tifinagh)	ⵜⴰⵛⵍⵃⵉⵜ (Tfng)	
+shi-Tfng-MA	shi	Tfng	MA	Tachelhit (Tifinagh, Morocco)	ⵜⴰⵛⵍⵃⵉⵜ (Tfng, ⵍⵎⵖⵔⵉⴱ)	shi-MA
+si	si			Sinhala	සිංහල	
+si-LK	si		LK	Sinhala (Sri Lanka)	සිංහල (ශ්‍රී ලංකාව)	
+sk	sk			Slovak	slovenčina	
+sk-SK	sk		SK	Slovak (Slovakia)	slovenčina (Slovensko)	
+sl	sl			Slovenian	slovenščina	
+sl-SI	sl		SI	Slovenian (Slovenia)	slovenščina (Slovenija)	
+smn	smn			Inari Sami	anarâškielâ	
+smn-FI	smn		FI	Inari Sami (Finland)	anarâškielâ (Suomâ)	
+sn	sn			Shona	chiShona	
+sn-ZW	sn		ZW	Shona (Zimbabwe)	chiShona (Zimbabwe)	
+so	so			Somali	Soomaali	
+so-DJ	so		DJ	Somali (Djibouti)	Soomaali (Jabuuti)	
+so-ET	so		ET	Somali (Ethiopia)	Soomaali (Itoobiya)	
+so-KE	so		KE	Somali (Kenya)	Soomaali (Kenya)	
+so-SO	so		SO	Somali (Somalia)	Soomaali (Soomaaliya)	
+sq	sq			Albanian	shqip	
+sq-AL	sq		AL	Albanian (Albania)	shqip (Shqipëri)	
+sq-MK	sq		MK	Albanian (North Macedonia)	shqip (Maqedonia e Veriut)	
+sq-XK	sq		XK	Albanian (Kosovo)	shqip (Kosovë)	
+sr	sr			Serbian	српски	
+sr-Cyrl	sr	Cyrl		Serbian (Cyrillic)	српски (ћирилица)	
+sr-Cyrl-BA	sr	Cyrl	BA	Serbian (Cyrillic, Bosnia & Herzegovina)	српски (ћирилица, Босна и Херцеговина)	sr-BA
+sr-Cyrl-ME	sr	Cyrl	ME	Serbian (Cyrillic, Montenegro)	српски (ћирилица, Црна Гора)	
+sr-Cyrl-RS	sr	Cyrl	RS	Serbian (Cyrillic, Serbia)	српски (ћирилица, Србија)	sr-RS
+sr-Cyrl-XK	sr	Cyrl	XK	Serbian (Cyrillic, Kosovo)	српски (ћирилица, Косово)	sr-XK
+sr-Latn	sr	Latn		Serbian (Latin)	srpski (latinica)	
+sr-Latn-BA	sr	Latn	BA	Serbian (Latin, Bosnia & Herzegovina)	srpski (latinica, Bosna i Hercegovina)	
+sr-Latn-ME	sr	Latn	ME	Serbian (Latin, Montenegro)	srpski (latinica, Crna Gora)	sr-ME
+sr-Latn-RS	sr	Latn	RS	Serbian (Latin, Serbia)	srpski (latinica, Srbija)	
+sr-Latn-XK	sr	Latn	XK	Serbian (Latin, Kosovo)	srpski (latinica, Kosovo)	
+su	su			Sundanese	Basa Sunda	
+su-Latn	su	Latn		Sundanese (Latin)	Basa Sunda (Latin)	
+su-Latn-ID	su	Latn	ID	Sundanese (Latin, Indonesia)	Basa Sunda (Latin, Indonesia)	su-ID
+sv	sv			Swedish	svenska	
+sv-AX	sv		AX	Swedish (Åland Islands)	svenska (Åland)	
+sv-FI	sv		FI	Swedish (Finland)	svenska (Finland)	
+sv-SE	sv		SE	Swedish (Sweden)	svenska (Sverige)	
+sw	sw			Swahili	Kiswahili	
+sw-CD	sw		CD	Swahili (Congo - Kinshasa)	Kiswahili (Jamhuri ya Kidemokrasia ya Kongo)	
+sw-KE	sw		KE	Swahili (Kenya)	Kiswahili (Kenya)	
+sw-TZ	sw		TZ	Swahili (Tanzania)	Kiswahili (Tanzania)	
+sw-UG	sw		UG	Swahili (Uganda)	Kiswahili (Uganda)	
+ta	ta			Tamil	தமிழ்	
+ta-IN	ta		IN	Tamil (India)	தமிழ் (இந்தியா)	
+ta-LK	ta		LK	Tamil (Sri Lanka)	தமிழ் (இலங்கை)	
+ta-MY	ta		MY	Tamil (Malaysia)	தமிழ் (மலேசியா)	
+ta-SG	ta		SG	Tamil (Singapore)	தமிழ் (சிங்கப்பூர்)	
+te	te			Telugu	తెలుగు	
+te-IN	te		IN	Telugu (India)	తెలుగు (భారతదేశం)	
+teo	teo			Teso	Kiteso	
+teo-KE	teo		KE	Teso (Kenya)	Kiteso (Kenia)	
+teo-UG	teo		UG	Teso (Uganda)	Kiteso (Uganda)	
+tg	tg			Tajik	тоҷикӣ	
+tg-TJ	tg		TJ	Tajik (Tajikistan)	тоҷикӣ (Тоҷикистон)	
+th	th			Thai	ไทย	
+th-TH	th		TH	Thai (Thailand)	ไทย (ไทย)	
+ti	ti			Tigrinya	ትግርኛ	
+ti-ER	ti		ER	Tigrinya (Eritrea)	ትግርኛ (ኤርትራ)	
+ti-ET	ti		ET	Tigrinya (Ethiopia)	ትግርኛ (ኢትዮጵያ)	
+tk	tk			Turkmen	türkmen dili	
+tk-TM	tk		TM	Turkmen (Turkmenistan)	türkmen dili (Türkmenistan)	
+to	to			Tongan	lea fakatonga	
+to-TO	to		TO	Tongan (Tonga)	lea fakatonga (Tonga)	
+tr	tr			Turkish	Türkçe	
+tr-CY	tr		CY	Turkish (Cyprus)	Türkçe (Kıbrıs)	
+tr-TR	tr		TR	Turkish (Turkey)	Türkçe (Türkiye)	
+tt	tt			Tatar	татар	
+tt-RU	tt		RU	Tatar (Russia)	татар (Россия)	
+twq	twq			Tasawaq	Tasawaq senni	
+twq-NE	twq		NE	Tasawaq (Niger)	Tasawaq senni (Nižer)	
+tzm	tzm			Central Atlas Tamazight	Tamaziɣt n laṭlaṣ	
+tzm-MA	tzm		MA	Central Atlas Tamazight (Morocco)	Tamaziɣt n laṭlaṣ (Meṛṛuk)	
+ug	ug			Uyghur	ئۇيغۇرچە	
+ug-CN	ug		CN	Uyghur (China)	ئۇيغۇرچە (جۇڭگو)	
+uk	uk			Ukrainian	українська	
+uk-UA	uk		UA	Ukrainian (Ukraine)	українська (Україна)	
+ur	ur			Urdu	اردو	
+ur-IN	ur		IN	Urdu (India)	اردو (بھارت)	
+ur-PK	ur		PK	Urdu (Pakistan)	اردو (پاکستان)	
+uz	uz			Uzbek	o‘zbek	
+uz-Arab	uz	Arab		Uzbek (Arabic)	اوزبیک (عربی)	
+uz-Arab-AF	uz	Arab	AF	Uzbek (Arabic, Afghanistan)	اوزبیک (عربی, افغانستان)	uz-AF
+uz-Cyrl	uz	Cyrl		Uzbek (Cyrillic)	ўзбекча (Кирил)	
+uz-Cyrl-UZ	uz	Cyrl	UZ	Uzbek (Cyrillic, Uzbekistan)	ўзбекча (Кирил, Ўзбекистон)	
+uz-Latn	uz	Latn		Uzbek (Latin)	o‘zbek (lotin)	
+uz-Latn-UZ	uz	Latn	UZ	Uzbek (Latin, Uzbekistan)	o‘zbek (lotin, Oʻzbekiston)	uz-UZ
+vai	vai			Vai	ꕙꔤ	
+vai-Latn	vai	Latn		Vai (Latin)	Vai (Latn)	
+vai-Latn-LR	vai	Latn	LR	Vai (Latin, Liberia)	Vai (Latn, Laibhiya)	
+vai-Vaii	vai	Vaii		Vai (Vai)	ꕙꔤ (Vaii)	
+vai-Vaii-LR	vai	Vaii	LR	Vai (Vai, Liberia)	ꕙꔤ (Vaii, ꕞꔤꔫꕩ)	vai-LR
+vi	vi			Vietnamese	Tiếng Việt	
+vi-VN	vi		VN	Vietnamese (Vietnam)	Tiếng Việt (Việt Nam)	
+vun	vun			Vunjo	Kyivunjo	
+vun-TZ	vun		TZ	Vunjo (Tanzania)	Kyivunjo (Tanzania)	
+wae	wae			Walser	Walser	
+wae-CH	wae		CH	Walser (Switzerland)	Walser (Schwiz)	
+wo	wo			Wolof	Wolof	
+wo-SN	wo		SN	Wolof (Senegal)	Wolof (Senegaal)	
+xh	xh			Xhosa	IsiXhosa	
+xh-ZA	xh		ZA	Xhosa (South Africa)	IsiXhosa (EMzantsi Afrika)	
+xog	xog			Soga	Olusoga	
+xog-UG	xog		UG	Soga (Uganda)	Olusoga (Yuganda)	
+yav	yav			Yangben	nuasue	
+yav-CM	yav		CM	Yangben (Cameroon)	nuasue (Kemelún)	
+yi	yi			Yiddish	ייִדיש	
+yi-001	yi		001	Yiddish (world)	ייִדיש (וועלט)	
+yo	yo			Yoruba	Èdè Yorùbá	
+yo-BJ	yo		BJ	Yoruba (Benin)	Èdè Yorùbá (Bɛ̀nɛ̀)	
+yo-NG	yo		NG	Yoruba (Nigeria)	Èdè Yorùbá (Nàìjíríà)	
+yrl	yrl			Nheengatu	nheẽgatu	
+yrl-BR	yrl		BR	Nheengatu (Brazil)	nheẽgatu (Brasiu)	
+yrl-CO	yrl		CO	Nheengatu (Colombia)	ñengatú (Kurũbiya)	
+yrl-VE	yrl		VE	Nheengatu (Venezuela)	ñengatú (Wenesuera)	
+yue	yue			Cantonese	粵語	
+yue-Hans	yue	Hans		Cantonese (Simplified)	粤语 (简体)	
+yue-Hans-CN	yue	Hans	CN	Cantonese (Simplified, China)	粤语 (简体，中华人民共和国)	yue-CN
+yue-Hant	yue	Hant		Cantonese (Traditional)	粵語 (繁體)	
+yue-Hant-HK	yue	Hant	HK	Cantonese (Traditional, Hong Kong SAR China)	粵語 (繁體，中華人民共和國香港特別行政區)	yue-HK
+zgh	zgh			Standard Moroccan Tamazight	ⵜⴰⵎⴰⵣⵉⵖⵜ	
+zgh-MA	zgh		MA	Standard Moroccan Tamazight (Morocco)	ⵜⴰⵎⴰⵣⵉⵖⵜ (ⵍⵎⵖⵔⵉⴱ)	
+zh	zh			Chinese	中文	
+zh-Hans	zh	Hans		Chinese (Simplified)	中文（简体）	
+zh-Hans-CN	zh	Hans	CN	Chinese (Simplified, China)	中文（简体，中国）	zh-CN
+zh-Hans-HK	zh	Hans	HK	Chinese (Simplified, Hong Kong SAR China)	中文（简体，中国香港特别行政区）	
+zh-Hans-MO	zh	Hans	MO	Chinese (Simplified, Macao SAR China)	中文（简体，中国澳门特别行政区）	
+zh-Hans-SG	zh	Hans	SG	Chinese (Simplified, Singapore)	中文（简体，新加坡）	zh-SG
+zh-Hant	zh	Hant		Chinese (Traditional)	中文（繁體）	
+zh-Hant-HK	zh	Hant	HK	Chinese (Traditional, Hong Kong SAR China)	中文（繁體字，中國香港特別行政區）	zh-HK
+zh-Hant-MO	zh	Hant	MO	Chinese (Traditional, Macao SAR China)	中文（繁體字，中國澳門特別行政區）	zh-MO
+zh-Hant-TW	zh	Hant	TW	Chinese (Traditional, Taiwan)	中文（繁體，台灣）	zh-TW
+zu	zu			Zulu	isiZulu	
+zu-ZA	zu		ZA	Zulu (South Africa)	isiZulu (iNingizimu Afrika)	
+`
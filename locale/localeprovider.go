@@ -0,0 +1,393 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package locale implements the methods of a stddata.Provider.
+It provides searches against the locales of the Unicode Common Locale
+Data Repository (CLDR): the combinations of a language, a script and
+a region for which CLDR has data, such as "en-GB", "sr-Latn-RS" and
+"zh-Hant-TW", with their English names and their names in their own
+languages. Compose builds a locale from a language and a country
+given separately, as they often are in user profiles, and checks
+that CLDR has it. Source data is declared in localedata.go.
+*/
+package locale
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/country"
+	"github.com/musicbeat/stddata/language3"
+)
+
+// LocaleProvider implements the Provider interface.
+type LocaleProvider struct {
+	data atomic.Pointer[localeData] // nil until the data is loaded
+}
+
+// localeData is the data of a LocaleProvider. Once it is stored in the
+// LocaleProvider it is not modified.
+type localeData struct {
+	size          int
+	info          stddata.Info
+	localeIndexes map[string]localeIndex
+	// lowerTags holds the locales by tag and alias, in lower case.
+	lowerTags map[string]Locale
+}
+
+type localeIndex struct {
+	localeMap  map[string][]Locale
+	localeKeys []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Locale models one entity.
+type Locale struct {
+	Tag         string // the BCP 47 tag, for example "sr-Latn-RS"
+	Language    string // the language subtag, for example "sr"
+	Script      string // the ISO 15924 script subtag, for example "Latn", if any
+	Region      string // the ISO 3166-1 alpha-2 or UN M49 region subtag, for example "RS" or "419", if any
+	EnglishName string // for example "Serbian (Latin, Serbia)"
+	NativeName  string // the name in the locale's own language, for example "srpski (latinica, Srbija)"
+	// Aliases are the tags without a script that stand for the locale,
+	// by CLDR's likely subtags, for example "zh-TW" for "zh-Hant-TW".
+	Aliases []string
+}
+
+// LocaleResult is the interface{} that is returned from Search
+type LocaleResult struct {
+	Locales [][]Locale
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *LocaleProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *LocaleProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &localeData{localeIndexes: make(map[string]localeIndex)}
+	d.lowerTags = make(map[string]Locale)
+	tagMap := make(map[string][]Locale)
+	nameMap := make(map[string][]Locale)
+	languageMap := make(map[string][]Locale)
+	scriptMap := make(map[string][]Locale)
+	regionMap := make(map[string][]Locale)
+
+	reader := csv.NewReader(strings.NewReader(localedata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 7
+	reader.LazyQuotes = true
+
+	n := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var l Locale
+		l.Tag = record[0]
+		l.Language = record[1]
+		l.Script = record[2]
+		l.Region = record[3]
+		l.EnglishName = record[4]
+		l.NativeName = record[5]
+		l.Aliases = strings.Fields(record[6])
+		if l.Language == "" || !strings.HasPrefix(l.Tag, l.Language) {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed locale %q", line, l.Tag)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Locale to the maps
+		for _, tag := range append([]string{l.Tag}, l.Aliases...) {
+			tagMap[tag] = append(tagMap[tag], l)
+			d.lowerTags[strings.ToLower(tag)] = l
+		}
+		nameMap[l.EnglishName] = append(nameMap[l.EnglishName], l)
+		if l.NativeName != l.EnglishName {
+			nameMap[l.NativeName] = append(nameMap[l.NativeName], l)
+		}
+		languageMap[l.Language] = append(languageMap[l.Language], l)
+		if l.Script != "" {
+			scriptMap[l.Script] = append(scriptMap[l.Script], l)
+		}
+		if l.Region != "" {
+			regionMap[l.Region] = append(regionMap[l.Region], l)
+		}
+		n++
+	}
+	p.storeData(d, "tag", tagMap)
+	p.storeData(d, "name", nameMap)
+	p.storeData(d, "language", languageMap)
+	p.storeData(d, "script", scriptMap)
+	p.storeData(d, "region", regionMap)
+	d.size = n
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = n
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *LocaleProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Locale whose tag, or one of whose Aliases, is tag,
+// in any case, with its subtags separated by hyphens or underscores:
+// "en-GB", "en_gb" and "zh-TW" are all found.
+func (p *LocaleProvider) Get(tag string) (l Locale, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return l, stddata.ErrNotLoaded
+	}
+	l, found := d.lowerTags[strings.ToLower(strings.Replace(tag, "_", "-", -1))]
+	if !found {
+		msg := "No CLDR locale " + tag
+		return l, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return l, nil
+}
+
+// IsValid reports whether tag is a CLDR locale, as Get finds it.
+func (p *LocaleProvider) IsValid(tag string) bool {
+	_, err := p.Get(tag)
+	return err == nil
+}
+
+// Compose returns the Locale of a language and a country given
+// separately. The language, lang, is resolved by languages, and may be
+// an ISO 639-1 or ISO 639-3 code, or an ISO 639-3 reference name, such
+// as "de", "deu" or "German". The country, cntry, is resolved by
+// countries, and may be an ISO 3166-1 alpha-2 code, or an English or
+// common name, such as "AT" or "Austria"; when cntry is empty, the
+// Locale of the language alone is returned. Both providers must be
+// loaded. An error is returned when either cannot be resolved, and
+// when CLDR has no locale for the combination, such as German in
+// Japan.
+func (p *LocaleProvider) Compose(lang string, cntry string, languages *language3.Language3Provider, countries *country.CountryProvider) (l Locale, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return l, stddata.ErrNotLoaded
+	}
+	ll, err := resolveLanguage(lang, languages)
+	if err != nil {
+		return l, err
+	}
+	tag := ll.Part1
+	if tag == "" {
+		tag = ll.Code
+	}
+	if cntry != "" {
+		c, err := resolveCountry(cntry, countries)
+		if err != nil {
+			return l, err
+		}
+		tag += "-" + c.Alpha2Code
+	}
+	l, found := d.lowerTags[strings.ToLower(tag)]
+	if !found {
+		msg := "No CLDR locale for " + ll.ReferenceName
+		if cntry != "" {
+			msg += " in " + cntry
+		}
+		return l, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return l, nil
+}
+
+// resolveLanguage returns the Language whose code or reference name
+// is lang.
+func resolveLanguage(lang string, languages *language3.Language3Provider) (l language3.Language, err error) {
+	if len(lang) == 2 || len(lang) == 3 {
+		if l, err = languages.GetByCode(lang); err == nil {
+			return l, nil
+		}
+	}
+	res, err := languages.Search("name", lang)
+	if err != nil {
+		return l, err
+	}
+	for _, matches := range res.(language3.Language3Result).Languages {
+		for _, m := range matches {
+			if strings.EqualFold(m.ReferenceName, lang) {
+				return m, nil
+			}
+		}
+	}
+	msg := "No language " + lang
+	return l, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+}
+
+// resolveCountry returns the Country whose alpha-2 code, or English
+// or common name, is cntry.
+func resolveCountry(cntry string, countries *country.CountryProvider) (c country.Country, err error) {
+	if len(cntry) == 2 {
+		if c, err = countries.GetByAlpha2(cntry); err == nil {
+			return c, nil
+		}
+	}
+	for _, index := range []string{"name", "common"} {
+		res, err := countries.Search(index, cntry)
+		if err != nil {
+			return c, err
+		}
+		for _, m := range res.(country.CountryResult).Countries {
+			if strings.EqualFold(m.EnglishName, cntry) || strings.EqualFold(m.CommonName, cntry) {
+				return m.Country, nil
+			}
+		}
+	}
+	msg := "No country " + cntry
+	return c, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+}
+
+func (p *LocaleProvider) storeData(d *localeData, s string, m map[string][]Locale) {
+	// store the map
+	var li localeIndex
+	li.localeMap = m
+	// extract the keys
+	li.localeKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		li.localeKeys[i] = k
+		i++
+	}
+	// sort the keys
+	li.foldedKeys = stddata.SortKeys(li.localeKeys)
+	// add to localeIndexes
+	d.localeIndexes[s] = li
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *LocaleProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "tag", Description: "BCP 47 tag, or an alias of it", Example: "sr-Latn"},
+		{Name: "name", Description: "English or native name", Example: "Serbian"},
+		{Name: "language", Description: "language subtag", Example: "sr"},
+		{Name: "script", Description: "ISO 15924 script subtag", Example: "Latn"},
+		{Name: "region", Description: "region subtag", Example: "RS"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *LocaleProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	li := d.localeIndexes[index]
+	return stddata.Suggest(li.localeKeys, li.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Locale entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Locales are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The tag index is keyed by the tags and the aliases of the locales, and the name
+// index by their English and native names.
+func (p *LocaleProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	li, found := d.localeIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.localeIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(li, query)
+	return result, nil
+}
+
+// SearchLocales is Search, returning the matches as they are, rather
+// than as a LocaleResult in an interface{}.
+func (p *LocaleProvider) SearchLocales(index string, query string) ([][]Locale, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(LocaleResult).Locales, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Locales of each. It yields nothing if the
+// LocaleProvider has no such index, or is not loaded.
+func (p *LocaleProvider) Range(index string) iter.Seq2[string, []Locale] {
+	return func(yield func(string, []Locale) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		li := d.localeIndexes[index]
+		for _, k := range li.localeKeys {
+			if !yield(k, li.localeMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(li localeIndex, query string) (res LocaleResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(li.localeKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(li.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Locales = make([][]Locale, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Locales = append(res.Locales, li.localeMap[li.localeKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,113 @@
+package locale
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/country"
+	"github.com/musicbeat/stddata/language3"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestLocaleProvider(t *testing.T) {
+	expected := 805
+	fmt.Println("Test: LocaleProvider.Load")
+	p = new(LocaleProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestTagSearch(t *testing.T) {
+	res, err := p.Search("tag", "sr-Latn")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if l := res.(LocaleResult).Locales; len(l) != 5 {
+		t.Fatalf("Expected 5 Serbian locales in Latin, got %v\n", l)
+	}
+	res, err = p.Search("name", "srpski (latinica, Srbija)")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if l := res.(LocaleResult).Locales; len(l) != 1 || l[0][0].Tag != "sr-Latn-RS" {
+		t.Fatalf("Expected sr-Latn-RS, got %v\n", l)
+	}
+}
+func TestGet(t *testing.T) {
+	lp := p.(*LocaleProvider)
+	l, err := lp.Get("en_gb")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if l.Tag != "en-GB" || l.EnglishName != "English (United Kingdom)" {
+		t.Fatalf("Expected en-GB, got %v\n", l)
+	}
+	l, err = lp.Get("zh-TW")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if l.Tag != "zh-Hant-TW" || l.Script != "Hant" {
+		t.Fatalf("Expected zh-Hant-TW, got %v\n", l)
+	}
+	if lp.IsValid("de-JP") || !lp.IsValid("es-419") {
+		t.Fatalf("Expected es-419 and not de-JP to be valid\n")
+	}
+}
+func TestCompose(t *testing.T) {
+	languages := new(language3.Language3Provider)
+	if _, err := languages.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	countries := new(country.CountryProvider)
+	if _, err := countries.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	lp := p.(*LocaleProvider)
+	for _, c := range []struct{ lang, cntry, expected string }{
+		{"de", "AT", "de-AT"},
+		{"German", "Austria", "de-AT"},
+		{"deu", "ch", "de-CH"},
+		{"Serbian", "Serbia", "sr-Cyrl-RS"},
+		{"zh", "TW", "zh-Hant-TW"},
+		{"Hawaiian", "", "haw"},
+		{"haw", "US", "haw-US"},
+	} {
+		l, err := lp.Compose(c.lang, c.cntry, languages, countries)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if l.Tag != c.expected {
+			t.Fatalf("Expected %s for %s in %s, got %v\n", c.expected, c.lang, c.cntry, l)
+		}
+	}
+	for _, c := range []struct{ lang, cntry string }{
+		{"de", "JP"},
+		{"Klingonese", "DE"},
+		{"de", "Atlantis"},
+	} {
+		if l, err := lp.Compose(c.lang, c.cntry, languages, countries); err == nil {
+			t.Fatalf("Expected no locale for %s in %s, got %v\n", c.lang, c.cntry, l)
+		}
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(LocaleProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(LocaleProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "tag", Query: "sr-Latn"}, {Index: "language", Query: "sr"}, {Index: "region", Query: "RS"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
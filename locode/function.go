@@ -0,0 +1,102 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package locode
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Function is a set of flags recording the transport functions of a
+// location.
+type Function uint
+
+const (
+	Port           Function = 1 << iota // a port, other than a rail, road or air terminal
+	Rail                                // a rail terminal
+	Road                                // a road terminal
+	Airport                             // an airport
+	Postal                              // a postal exchange office
+	Multimodal                          // a multimodal function, such as an inland clearance depot
+	FixedTransport                      // a fixed transport function, such as an oil platform
+	BorderCrossing                      // a border crossing
+)
+
+// functionNames holds the names of the flags, which are also the keys
+// of the function index, with the characters that mark them in the
+// function classifier of UN/LOCODE.
+var functionNames = []struct {
+	flag Function
+	mark byte
+	name string
+}{
+	{Port, '1', "Port"},
+	{Rail, '2', "Rail"},
+	{Road, '3', "Road"},
+	{Airport, '4', "Airport"},
+	{Postal, '5', "Postal"},
+	{Multimodal, '6', "Multimodal"},
+	{FixedTransport, '7', "FixedTransport"},
+	{BorderCrossing, 'B', "BorderCrossing"},
+}
+
+// parseFunction returns the Function of the function classifier s,
+// for example "1-34----" for a port that is also a road terminal and
+// an airport. "0", for a function not known, and "-" mark nothing.
+func parseFunction(s string) (f Function) {
+	for i := 0; i < len(s); i++ {
+		for _, fn := range functionNames {
+			if fn.mark == s[i] {
+				f |= fn.flag
+			}
+		}
+	}
+	return f
+}
+
+// Has reports whether f includes all of the functions in g.
+func (f Function) Has(g Function) bool {
+	return f&g == g
+}
+
+// Names returns the names of the functions in f, for example
+// ["Port", "Road", "Airport"].
+func (f Function) Names() []string {
+	names := []string{}
+	for _, fn := range functionNames {
+		if f.Has(fn.flag) {
+			names = append(names, fn.name)
+		}
+	}
+	return names
+}
+
+// String returns the names of the functions in f, separated by spaces.
+func (f Function) String() string {
+	return strings.Join(f.Names(), " ")
+}
+
+// MarshalJSON encodes f as the array of its names, rather than as a number.
+func (f Function) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
+
+// UnmarshalJSON decodes f from the array of its names, as MarshalJSON
+// encodes it. Unknown names are ignored.
+func (f *Function) UnmarshalJSON(b []byte) error {
+	var names []string
+	if err := json.Unmarshal(b, &names); err != nil {
+		return err
+	}
+	*f = 0
+	for _, name := range names {
+		for _, fn := range functionNames {
+			if fn.name == name {
+				*f |= fn.flag
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,426 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package locode implements the methods of a stddata.Provider.
+It provides searches against the United Nations Code for Trade and
+Transport Locations (UN/LOCODE): the ports, airports, rail and road
+terminals and other places used in trade, such as DEHAM for Hamburg.
+The code list is large and revised twice a year, so no data is
+embedded here: it is read from a file supplied by the caller, in the
+comma-separated format that UNECE distributes, whose lines hold the
+change indicator, the country, the location, the name, the name
+without diacritics, the subdivision, the status, the function
+classifier, the date, the IATA code, the coordinates and remarks.
+*/
+package locode
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/musicbeat/stddata"
+)
+
+// LocodeProvider implements the Provider interface.
+type LocodeProvider struct {
+	// File is the path of the code list that Load reads.
+	File string
+	// Options selects the indexes that are searched with a radix tree,
+	// and limits the results of searches. It must be set before Load
+	// is called.
+	Options stddata.IndexOptions
+	data    atomic.Pointer[locodeData] // nil until the data is loaded
+}
+
+// locodeData is the data of a LocodeProvider. Once it is stored in the
+// LocodeProvider it is not modified.
+type locodeData struct {
+	size            int
+	info            stddata.Info
+	locationIndexes map[string]locationIndex
+}
+
+type locationIndex struct {
+	locationMap  map[string][]Location
+	locationKeys []string
+	foldedKeys   []string       // the keys in lower case, for stddata.PrefixRange
+	radix        *stddata.Radix // when Options selects a radix tree for the index
+}
+
+// Location models one entity.
+type Location struct {
+	Code         string // the UN/LOCODE, for example "DEHAM"
+	CountryCode  string // ISO 3166-1 alpha-2 code, for example "DE"
+	LocationCode string // for example "HAM"
+	Name         string // for example "Hamburg"
+	// NameWoDiacritics is Name without diacritic signs, for example
+	// "Sao Paulo" for "São Paulo".
+	NameWoDiacritics string
+	Subdivision      string // the ISO 3166-2 subdivision code, without the country, for example "HH"
+	Status           string // for example "AI", recognised by an international organization
+	Functions        Function
+	Date             string // the date of the last change, as YYMM
+	IATACode         string // where it differs from LocationCode
+	// Coordinates are in the form "5331N 00958E", of degrees and
+	// minutes; see LatLong.
+	Coordinates string
+}
+
+// LocationResult is the interface{} that is returned from Search
+type LocationResult struct {
+	Locations [][]Location
+	total     int // the number of keys that match, before the Limit
+}
+
+// Total returns the number of keys that match, and whether the Limit
+// of the IndexOptions left some of them out, for stddata.Totaler.
+func (r LocationResult) Total() (total int, truncated bool) {
+	return r.total, r.total > len(r.Locations)
+}
+
+// Load implements the Loader interface. It reads the code list
+// named by File. A malformed record causes Load to fail.
+func (p *LocodeProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode reads the code list named by File, treating malformed
+// records according to mode. In stddata.Lenient mode, malformed
+// records are skipped, and their line numbers are returned in the
+// LoadReport.
+func (p *LocodeProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	if p.File == "" {
+		return r, stddata.NewSourceError("No UN/LOCODE code list file", nil)
+	}
+	f, err := os.Open(p.File)
+	if err != nil {
+		return r, stddata.NewSourceError(err.Error(), err)
+	}
+	defer f.Close()
+	return p.LoadFrom(f, mode)
+}
+
+// LoadFrom reads a code list from data, as LoadMode reads File. The
+// lines that name a country, which have no location code, are
+// passed over, as are the entries marked for deletion ("X") and the
+// references to other entries ("="). UNECE distributes the list in
+// ISO 8859-1; names that are not valid UTF-8 are read as ISO 8859-1.
+func (p *LocodeProvider) LoadFrom(data io.Reader, mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &locodeData{locationIndexes: make(map[string]locationIndex)}
+	locodeMap := make(map[string][]Location)
+	nameMap := make(map[string][]Location)
+	countryMap := make(map[string][]Location)
+	functionMap := make(map[string][]Location)
+	iataMap := make(map[string][]Location)
+
+	reader := csv.NewReader(data)
+	reader.FieldsPerRecord = 12
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		change := record[0]
+		if record[2] == "" || change == "X" || change == "=" {
+			continue
+		}
+		var l Location
+		l.CountryCode = record[1]
+		l.LocationCode = record[2]
+		l.Code = l.CountryCode + l.LocationCode
+		if !isCode(l.CountryCode, 2) || !isCode(l.LocationCode, 3) {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed UN/LOCODE %q", line, l.Code)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+		l.Name = latin1(record[3])
+		l.NameWoDiacritics = latin1(record[4])
+		l.Subdivision = record[5]
+		l.Status = record[6]
+		l.Functions = parseFunction(record[7])
+		l.Date = record[8]
+		l.IATACode = record[9]
+		l.Coordinates = record[10]
+
+		// add the Location to the maps
+		locodeMap[l.Code] = append(locodeMap[l.Code], l)
+		nameMap[l.Name] = append(nameMap[l.Name], l)
+		if l.NameWoDiacritics != l.Name {
+			nameMap[l.NameWoDiacritics] = append(nameMap[l.NameWoDiacritics], l)
+		}
+		countryMap[l.CountryCode] = append(countryMap[l.CountryCode], l)
+		for _, name := range l.Functions.Names() {
+			functionMap[name] = append(functionMap[name], l)
+		}
+		if l.IATACode != "" {
+			iataMap[l.IATACode] = append(iataMap[l.IATACode], l)
+		} else if l.Functions.Has(Airport) {
+			iataMap[l.LocationCode] = append(iataMap[l.LocationCode], l)
+		}
+	}
+	p.storeData(d, "locode", locodeMap)
+	p.storeData(d, "name", nameMap)
+	p.storeData(d, "country", countryMap)
+	p.storeData(d, "function", functionMap)
+	p.storeData(d, "iata", iataMap)
+	d.size = len(locodeMap)
+	d.info = stddata.Info{
+		Source:   "UN/LOCODE Code List",
+		URL:      p.File,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(locodeMap)
+	return r, nil
+}
+
+// isCode reports whether s is n upper case letters or the digits 2
+// to 9, which UN/LOCODE uses where the letters are exhausted.
+func isCode(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= 'A' && r <= 'Z' || r >= '2' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// latin1 returns s, converted from ISO 8859-1 unless it is valid UTF-8.
+func latin1(s string) string {
+	if utf8.ValidString(s) {
+		return s
+	}
+	runes := make([]rune, len(s))
+	for i := 0; i < len(s); i++ {
+		runes[i] = rune(s[i])
+	}
+	return string(runes)
+}
+
+// Info describes the provenance of the loaded data. The URL is
+// the File the code list was read from.
+func (p *LocodeProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Location whose UN/LOCODE is code, in any case, with
+// or without a space after the country, as in "DE HAM".
+func (p *LocodeProvider) Get(code string) (l Location, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return l, stddata.ErrNotLoaded
+	}
+	key := strings.ToUpper(strings.Replace(code, " ", "", -1))
+	locations, found := d.locationIndexes["locode"].locationMap[key]
+	if !found {
+		msg := "No location " + code
+		return l, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return locations[0], nil
+}
+
+// LatLong returns the Coordinates of l as decimal degrees of latitude
+// and longitude, negative to the south and west, and whether l has
+// coordinates that are well formed.
+func (l Location) LatLong() (lat float64, long float64, ok bool) {
+	parts := strings.Fields(l.Coordinates)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	lat, ok = degrees(parts[0], 2, "N", "S")
+	if !ok {
+		return 0, 0, false
+	}
+	long, ok = degrees(parts[1], 3, "E", "W")
+	if !ok {
+		return 0, 0, false
+	}
+	return lat, long, true
+}
+
+// degrees converts s, of n digits of degrees, two of minutes, and the
+// hemisphere pos or neg, to decimal degrees.
+func degrees(s string, n int, pos string, neg string) (float64, bool) {
+	if len(s) != n+3 {
+		return 0, false
+	}
+	d, err := strconv.Atoi(s[0:n])
+	if err != nil {
+		return 0, false
+	}
+	m, err := strconv.Atoi(s[n : n+2])
+	if err != nil || m >= 60 {
+		return 0, false
+	}
+	v := float64(d) + float64(m)/60
+	switch s[n+2:] {
+	case pos:
+		return v, true
+	case neg:
+		return -v, true
+	}
+	return 0, false
+}
+
+func (p *LocodeProvider) storeData(d *locodeData, s string, m map[string][]Location) {
+	// store the map
+	var li locationIndex
+	li.locationMap = m
+	// extract the keys
+	li.locationKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		li.locationKeys[i] = k
+		i++
+	}
+	// sort the keys
+	li.foldedKeys = stddata.SortKeys(li.locationKeys)
+	if p.Options.UseRadix(s) {
+		li.radix = stddata.NewRadix(li.foldedKeys)
+	}
+	// add to locationIndexes
+	d.locationIndexes[s] = li
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *LocodeProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "locode", Description: "UN/LOCODE", Example: "DEHAM"},
+		{Name: "name", Description: "name, with or without its diacritics", Example: "Hamburg"},
+		{Name: "country", Description: "ISO 3166-1 alpha-2 code of the country", Example: "DE"},
+		{Name: "function", Description: "function, such as Port or Airport", Example: "Port"},
+		{Name: "iata", Description: "IATA code of an airport", Example: "HAM"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *LocodeProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	li := d.locationIndexes[index]
+	return stddata.Suggest(li.locationKeys, li.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Location entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Locations are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The function index is keyed by the names of the functions, such as "Port" and "Airport".
+// The iata index is keyed by the IATA code of each airport, which is its location code
+// unless the code list gives another.
+// An index that Options selects is searched with a radix tree, and a Limit in Options
+// returns only the first keys that match.
+func (p *LocodeProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	li, found := d.locationIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.locationIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(li, query, p.Options)
+	return result, nil
+}
+
+// SearchLocations is Search, returning the matches as they are, rather
+// than as a LocationResult in an interface{}.
+func (p *LocodeProvider) SearchLocations(index string, query string) ([][]Location, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(LocationResult).Locations, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Locations of each. It yields nothing if the
+// LocodeProvider has no such index, or is not loaded.
+func (p *LocodeProvider) Range(index string) iter.Seq2[string, []Location] {
+	return func(yield func(string, []Location) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		li := d.locationIndexes[index]
+		for _, k := range li.locationKeys {
+			if !yield(k, li.locationMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(li locationIndex, query string, o stddata.IndexOptions) (res LocationResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// search the sorted list of keys, with the radix tree of the index if
+	// it has one, for the range that matches 'query.*', and return only the
+	// first of the range if the options limit it.
+	lo, hi := 0, len(li.locationKeys)
+	if !dump {
+		if li.radix != nil {
+			lo, hi = li.radix.PrefixRange(query)
+		} else {
+			lo, hi = stddata.PrefixRange(li.foldedKeys, query)
+		}
+	}
+	res.total = hi - lo
+	if !dump {
+		lo, hi = o.Top(lo, hi)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Locations = make([][]Location, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Locations = append(res.Locations, li.locationMap[li.locationKeys[k]])
+	}
+	return res
+}
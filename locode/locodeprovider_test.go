@@ -0,0 +1,118 @@
+package locode
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+var codeList = `,"BR",,".BRAZIL",,,,,,,,
+,"BR","SSZ","Santos","Santos","SP","AI","1234----","0707",,"2357S 04619W",
+` + ",\"BR\",\"SAO\",\"S\xe3o Paulo\",\"Sao Paulo\",\"SP\",\"AI\",\"12345---\",\"0307\",,\"2332S 04637W\",\n" +
+	`,"DE",,".GERMANY",,,,,,,,
+,"DE","HAM","Hamburg","Hamburg","HH","AI","12345---","0501",,"5333N 00958E",
+"X","DE","ZZZ","Nowhere","Nowhere",,"RL","--3-----","0101",,,
+,"GB","LHR","Heathrow Apt/London","Heathrow Apt/London","GLL","AI","---4----","0001",,"5128N 00027W",
+,"US","NYC","New York","New York","NY","AI","12345---","0901",,"4042N 07400W",
+,"US","JFK","John F. Kennedy Apt/New York","John F. Kennedy Apt/New York","NY","AI","---4----","0001",,"4038N 07347W",`
+
+func TestLocodeProvider(t *testing.T) {
+	expected := 6
+	fmt.Println("Test: LocodeProvider.Load")
+	file := filepath.Join(t.TempDir(), "locode.csv")
+	if err := os.WriteFile(file, []byte(codeList), 0644); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	p = &LocodeProvider{File: file}
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestNameSearch(t *testing.T) {
+	res, err := p.Search("name", "sao")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	l := res.(LocationResult).Locations
+	if len(l) != 1 || l[0][0].Name != "São Paulo" {
+		t.Fatalf("Expected São Paulo, got %v\n", l)
+	}
+}
+func TestFunctionSearch(t *testing.T) {
+	res, err := p.Search("function", "Airport")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if l := res.(LocationResult).Locations; len(l) != 1 || len(l[0]) != 6 {
+		t.Fatalf("Expected six airports, got %v\n", l)
+	}
+	res, err = p.Search("iata", "JFK")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if l := res.(LocationResult).Locations; len(l) != 1 || l[0][0].Code != "USJFK" {
+		t.Fatalf("Expected Kennedy Airport, got %v\n", l)
+	}
+}
+func TestGet(t *testing.T) {
+	lp := p.(*LocodeProvider)
+	l, err := lp.Get("de ham")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if !l.Functions.Has(Port|Rail) || l.Functions.String() != "Port Rail Road Airport Postal" {
+		t.Fatalf("Expected the functions of Hamburg, got %v\n", l.Functions)
+	}
+	lat, long, ok := l.LatLong()
+	if !ok || math.Abs(lat-53.55) > 0.001 || math.Abs(long-9.9667) > 0.001 {
+		t.Fatalf("Expected the coordinates of Hamburg, got %v %v\n", lat, long)
+	}
+	if _, err = lp.Get("DEZZZ"); err == nil {
+		t.Fatalf("Expected DEZZZ, marked for deletion, not to be loaded\n")
+	}
+}
+func TestLoadFromLenient(t *testing.T) {
+	lp := new(LocodeProvider)
+	data := strings.NewReader(codeList + "\n,\"de\",\"hh\",\"Bad\",\"Bad\",,,,,,,\n")
+	r, err := lp.LoadFrom(data, Lenient)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 6 || r.Skipped != 1 {
+		t.Fatalf("Expected 6 loaded and 1 skipped, got %v\n", r)
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	file := filepath.Join(b.TempDir(), "locode.csv")
+	if err := os.WriteFile(file, []byte(codeList), 0644); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkLoad(b, func() Provider { return &LocodeProvider{File: file} })
+}
+func BenchmarkSearch(b *testing.B) {
+	file := filepath.Join(b.TempDir(), "locode.csv")
+	if err := os.WriteFile(file, []byte(codeList), 0644); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	bp := &LocodeProvider{File: file}
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "locode", Query: "DEHAM"}, {Index: "name", Query: "Hamburg"}, {Index: "function", Query: "Port"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
@@ -0,0 +1,52 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stddata
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// maxLoggedLines is the most skipped line numbers that LogLoad logs.
+const maxLoggedLines = 10
+
+// LogLoad logs the load of the Provider named provider to l, if l is
+// not nil: where its data came from, as info tells, how many items it
+// loaded, and the lines of the records it skipped, as r tells, how long
+// it took, and its error. A load that skips records is logged as a
+// warning, and one that fails as an error.
+func LogLoad(l *slog.Logger, provider string, info Info, r LoadReport, elapsed time.Duration, err error) {
+	if l == nil {
+		return
+	}
+	level := slog.LevelInfo
+	attrs := []slog.Attr{
+		slog.String("provider", provider),
+		slog.Duration("duration", elapsed),
+	}
+	switch {
+	case err != nil:
+		level = slog.LevelError
+		attrs = append(attrs, slog.String("error", err.Error()))
+	case r.Skipped > 0:
+		level = slog.LevelWarn
+	}
+	if err == nil {
+		attrs = append(attrs,
+			slog.String("source", info.Source),
+			slog.String("url", info.URL),
+			slog.String("edition", info.Edition),
+			slog.Int("items", r.Loaded))
+	}
+	if r.Skipped > 0 {
+		lines := r.SkippedLines
+		if len(lines) > maxLoggedLines {
+			lines = lines[:maxLoggedLines]
+		}
+		attrs = append(attrs, slog.Int("skipped", r.Skipped), slog.Any("skipped_lines", lines))
+	}
+	l.LogAttrs(context.Background(), level, "stddata load", attrs...)
+}
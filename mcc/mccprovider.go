@@ -0,0 +1,340 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package mcc implements the methods of a stddata.Provider.
+It provides searches against the merchant category codes of ISO
+18245, which card schemes use to classify the merchants that accept
+card payments, such as 5411 for grocery stores and supermarkets, with
+their descriptions and the categories they are grouped in, such as
+Retail Outlet Services, so that card transactions can be analyzed by
+the kind of business they were spent at. Source data is declared in
+mccdata.go.
+*/
+package mcc
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// MCCProvider implements the Provider interface.
+type MCCProvider struct {
+	data atomic.Pointer[mccData] // nil until the data is loaded
+}
+
+// mccData is the data of a MCCProvider. Once it is stored in the
+// MCCProvider it is not modified.
+type mccData struct {
+	size       int
+	info       stddata.Info
+	mccIndexes map[string]mccIndex
+	categories []category
+}
+
+type mccIndex struct {
+	mccMap     map[string][]MCC
+	mccKeys    []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// category is a range of codes that make up a category.
+type category struct {
+	first int
+	last  int
+	name  string
+}
+
+// MCC models one entity.
+type MCC struct {
+	Code        string // four digits, for example "5411"
+	Description string // for example "Grocery Stores and Supermarkets"
+	Category    string // for example "Retail Outlet Services"
+}
+
+// MCCResult is the interface{} that is returned from Search
+type MCCResult struct {
+	MCCs [][]MCC
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *MCCProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *MCCProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &mccData{mccIndexes: make(map[string]mccIndex)}
+	codeMap := make(map[string][]MCC)
+	descriptionMap := make(map[string][]MCC)
+	categoryMap := make(map[string][]MCC)
+
+	if d.categories, err = readCategories(); err != nil {
+		return r, err
+	}
+
+	reader := csv.NewReader(strings.NewReader(mccdata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 2
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var m MCC
+		m.Code = record[0]
+		m.Description = record[1]
+		m.Category = d.categoryOf(m.Code)
+		if m.Category == "" {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed merchant category code %q", line, m.Code)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the MCC to the maps
+		codeMap[m.Code] = append(codeMap[m.Code], m)
+		descriptionMap[m.Description] = append(descriptionMap[m.Description], m)
+		categoryMap[m.Category] = append(categoryMap[m.Category], m)
+	}
+	p.storeData(d, "code", codeMap)
+	p.storeData(d, "description", descriptionMap)
+	p.storeData(d, "category", categoryMap)
+	d.size = len(codeMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(codeMap)
+	return r, nil
+}
+
+// readCategories reads the ranges of codes in categorydata.
+func readCategories() ([]category, error) {
+	reader := csv.NewReader(strings.NewReader(categorydata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 3
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, stddata.NewSourceError(err.Error(), err)
+	}
+	categories := make([]category, len(records))
+	for i, record := range records {
+		first, err1 := strconv.Atoi(record[0])
+		last, err2 := strconv.Atoi(record[1])
+		if err1 != nil || err2 != nil {
+			msg := "malformed category range " + record[0] + "-" + record[1]
+			return nil, stddata.NewSourceError(msg, nil)
+		}
+		categories[i] = category{first, last, record[2]}
+	}
+	return categories, nil
+}
+
+// categoryOf returns the name of the category of code, which must be
+// four digits, or "" if it is not in one.
+func (d *mccData) categoryOf(code string) string {
+	n, err := strconv.Atoi(code)
+	if err != nil || len(code) != 4 {
+		return ""
+	}
+	for _, c := range d.categories {
+		if n >= c.first && n <= c.last {
+			return c.name
+		}
+	}
+	return ""
+}
+
+// normalizeCode pads a code of fewer than four digits with zeros:
+// "742" becomes "0742".
+func normalizeCode(s string) string {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || n > 9999 || strings.HasPrefix(s, "+") {
+		return s
+	}
+	return fmt.Sprintf("%04d", n)
+}
+
+// Info describes the provenance of the loaded data.
+func (p *MCCProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the MCC whose code is code, with or without its leading
+// zeros: "0742" and "742" are both found.
+func (p *MCCProvider) Get(code string) (m MCC, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return m, stddata.ErrNotLoaded
+	}
+	mccs, found := d.mccIndexes["code"].mccMap[normalizeCode(code)]
+	if !found {
+		msg := "No merchant category code " + code
+		return m, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return mccs[0], nil
+}
+
+// CategoryOf returns the name of the category of code, such as
+// "Airlines" for 3058, including the codes of individual airlines,
+// car rental agencies and hotel chains that Get does not find.
+func (p *MCCProvider) CategoryOf(code string) (string, error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return "", stddata.ErrNotLoaded
+	}
+	name := d.categoryOf(normalizeCode(code))
+	if name == "" {
+		msg := "No category for merchant category code " + code
+		return "", stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return name, nil
+}
+
+func (p *MCCProvider) storeData(d *mccData, s string, m map[string][]MCC) {
+	// store the map
+	var mi mccIndex
+	mi.mccMap = m
+	// extract the keys
+	mi.mccKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		mi.mccKeys[i] = k
+		i++
+	}
+	// sort the keys
+	mi.foldedKeys = stddata.SortKeys(mi.mccKeys)
+	// add to mccIndexes
+	d.mccIndexes[s] = mi
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *MCCProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "code", Description: "merchant category code", Example: "5411"},
+		{Name: "description", Description: "description", Example: "Grocery"},
+		{Name: "category", Description: "category", Example: "Retail"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *MCCProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	mi := d.mccIndexes[index]
+	return stddata.Suggest(mi.mccKeys, mi.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of MCC entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching MCCs are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// Searching the code index by a prefix finds a range of codes: "58" finds
+// the eating and drinking places.
+func (p *MCCProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	mi, found := d.mccIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.mccIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(mi, query)
+	return result, nil
+}
+
+// SearchMCCs is Search, returning the matches as they are, rather
+// than as a MCCResult in an interface{}.
+func (p *MCCProvider) SearchMCCs(index string, query string) ([][]MCC, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(MCCResult).MCCs, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the MCCs of each. It yields nothing if the
+// MCCProvider has no such index, or is not loaded.
+func (p *MCCProvider) Range(index string) iter.Seq2[string, []MCC] {
+	return func(yield func(string, []MCC) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		mi := d.mccIndexes[index]
+		for _, k := range mi.mccKeys {
+			if !yield(k, mi.mccMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(mi mccIndex, query string) (res MCCResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(mi.mccKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(mi.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.MCCs = make([][]MCC, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.MCCs = append(res.MCCs, mi.mccMap[mi.mccKeys[k]])
+	}
+	return res
+}
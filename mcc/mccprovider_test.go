@@ -0,0 +1,95 @@
+package mcc
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestMCCProvider(t *testing.T) {
+	expected := 316
+	fmt.Println("Test: MCCProvider.Load")
+	p = new(MCCProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestCodeSearch(t *testing.T) {
+	res, err := p.Search("code", "58")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if m := res.(MCCResult).MCCs; len(m) != 8 || m[0][0].Code != "5811" {
+		t.Fatalf("Expected the 8 codes from 5811, got %v\n", m)
+	}
+	res, err = p.Search("category", "Clothing Stores")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if m := res.(MCCResult).MCCs; len(m) != 1 || len(m[0]) != 12 {
+		t.Fatalf("Expected 12 clothing stores, got %v\n", m)
+	}
+}
+func TestGet(t *testing.T) {
+	mp := p.(*MCCProvider)
+	m, err := mp.Get("742")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if m.Code != "0742" || m.Description != "Veterinary Services" || m.Category != "Agricultural Services" {
+		t.Fatalf("Expected veterinary services, got %v\n", m)
+	}
+	m, err = mp.Get("5411")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if m.Category != "Retail Outlet Services" {
+		t.Fatalf("Expected a retail outlet, got %v\n", m)
+	}
+	if _, err = mp.Get("3058"); err == nil {
+		t.Fatalf("Expected no general code 3058\n")
+	}
+}
+func TestCategoryOf(t *testing.T) {
+	mp := p.(*MCCProvider)
+	for code, expected := range map[string]string{
+		"3058": "Airlines",
+		"3389": "Car Rental",
+		"3509": "Lodging",
+		"7011": "Miscellaneous Stores",
+		"9311": "Government Services",
+	} {
+		c, err := mp.CategoryOf(code)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if c != expected {
+			t.Fatalf("Expected %s for %s, got %s\n", expected, code, c)
+		}
+	}
+	if _, err := mp.CategoryOf("12345"); err == nil {
+		t.Fatalf("Expected no category for 12345\n")
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(MCCProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(MCCProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "code", Query: "5411"}, {Index: "description", Query: "grocery"}, {Index: "category", Query: "Retail"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
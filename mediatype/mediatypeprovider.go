@@ -0,0 +1,345 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package mediatype implements the methods of a stddata.Provider.
+It provides searches against the media (MIME) types of the IANA
+registry, such as "audio/flac", and the file name extensions that
+represent them, for example to check the type of an upload against
+its extension. Source data is declared in typedata.go.
+*/
+package mediatype
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// The registration trees of RFC 6838, which are the keys of the tree index.
+const (
+	Standards    = "standards"    // for example "audio/flac"
+	Vendor       = "vendor"       // "vnd.", for example "application/vnd.ms-excel"
+	Personal     = "personal"     // "prs.", for example "image/prs.btif"
+	Unregistered = "unregistered" // "x-", for example "audio/x-wav"
+)
+
+// MediaTypeProvider implements the Provider interface.
+type MediaTypeProvider struct {
+	data atomic.Pointer[mediaTypeData] // nil until the data is loaded
+}
+
+// mediaTypeData is the data of a MediaTypeProvider. Once it is stored
+// in the MediaTypeProvider it is not modified.
+type mediaTypeData struct {
+	size        int
+	info        stddata.Info
+	typeIndexes map[string]typeIndex
+}
+
+type typeIndex struct {
+	typeMap    map[string][]MediaType
+	typeKeys   []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// MediaType models one entity.
+type MediaType struct {
+	Type string // for example "audio/mp4"
+	// Category is the top-level type, which names the registry the
+	// type belongs to, for example "audio".
+	Category string
+	Tree     string // Standards, Vendor, Personal or Unregistered
+	// Suffix is the structured syntax suffix, for example "xml" for
+	// "application/atom+xml".
+	Suffix     string
+	Extensions []string // with their dots, for example [".m4a"]
+}
+
+// MediaTypeResult is the interface{} that is returned from Search
+type MediaTypeResult struct {
+	MediaTypes [][]MediaType
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *MediaTypeProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *MediaTypeProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &mediaTypeData{typeIndexes: make(map[string]typeIndex)}
+	typeMap := make(map[string][]MediaType)
+	extensionMap := make(map[string][]MediaType)
+	categoryMap := make(map[string][]MediaType)
+	treeMap := make(map[string][]MediaType)
+	suffixMap := make(map[string][]MediaType)
+
+	reader := csv.NewReader(strings.NewReader(typedata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 2
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		m, err := parseType(record[0])
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: %s", line, err.Error())
+			return r, stddata.NewSourceError(msg, err)
+		}
+		for _, ext := range strings.Fields(record[1]) {
+			m.Extensions = append(m.Extensions, "."+ext)
+		}
+
+		// add the MediaType to the maps
+		key := strings.ToLower(m.Type)
+		typeMap[key] = append(typeMap[key], m)
+		for _, ext := range m.Extensions {
+			extensionMap[strings.ToLower(ext)] = append(extensionMap[strings.ToLower(ext)], m)
+		}
+		categoryMap[m.Category] = append(categoryMap[m.Category], m)
+		treeMap[m.Tree] = append(treeMap[m.Tree], m)
+		if m.Suffix != "" {
+			suffixMap[m.Suffix] = append(suffixMap[m.Suffix], m)
+		}
+	}
+	p.storeData(d, "type", typeMap)
+	p.storeData(d, "extension", extensionMap)
+	p.storeData(d, "category", categoryMap)
+	p.storeData(d, "tree", treeMap)
+	p.storeData(d, "suffix", suffixMap)
+	d.size = len(typeMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(typeMap)
+	return r, nil
+}
+
+// parseType returns the MediaType named by s, with its category, tree
+// and suffix.
+func parseType(s string) (m MediaType, err error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return m, errors.New("malformed media type " + s)
+	}
+	m.Type = s
+	m.Category = strings.ToLower(parts[0])
+	subtype := strings.ToLower(parts[1])
+	switch {
+	case strings.HasPrefix(subtype, "vnd."):
+		m.Tree = Vendor
+	case strings.HasPrefix(subtype, "prs."):
+		m.Tree = Personal
+	case strings.HasPrefix(subtype, "x-") || strings.HasPrefix(subtype, "x."):
+		m.Tree = Unregistered
+	default:
+		m.Tree = Standards
+	}
+	if i := strings.LastIndex(subtype, "+"); i >= 0 {
+		m.Suffix = subtype[i+1:]
+	}
+	return m, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *MediaTypeProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the MediaType t, in any case, for example
+// "audio/flac". Parameters, as in "text/plain; charset=utf-8", are
+// ignored.
+func (p *MediaTypeProvider) Get(t string) (m MediaType, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return m, stddata.ErrNotLoaded
+	}
+	if i := strings.Index(t, ";"); i >= 0 {
+		t = t[0:i]
+	}
+	types, found := d.typeIndexes["type"].typeMap[strings.ToLower(strings.TrimSpace(t))]
+	if !found {
+		msg := "No media type " + t
+		return m, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return types[0], nil
+}
+
+// ByExtension returns the media types represented by the file name
+// extension ext, in any case and with or without its dot, for example
+// audio/mp4 for ".m4a". A few extensions represent more than one
+// type, as ".sh" does; those of the IANA registry come first.
+func (p *MediaTypeProvider) ByExtension(ext string) (types []MediaType, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	key := strings.ToLower(ext)
+	if !strings.HasPrefix(key, ".") {
+		key = "." + key
+	}
+	found := d.typeIndexes["extension"].typeMap[key]
+	if len(found) == 0 {
+		msg := "No media type for extension " + ext
+		return nil, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	types = append(types, found...)
+	sort.SliceStable(types, func(i, j int) bool {
+		return types[i].Tree != Unregistered && types[j].Tree == Unregistered
+	})
+	return types, nil
+}
+
+func (p *MediaTypeProvider) storeData(d *mediaTypeData, s string, m map[string][]MediaType) {
+	// store the map
+	var ti typeIndex
+	ti.typeMap = m
+	// extract the keys
+	ti.typeKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		ti.typeKeys[i] = k
+		i++
+	}
+	// sort the keys
+	ti.foldedKeys = stddata.SortKeys(ti.typeKeys)
+	// add to typeIndexes
+	d.typeIndexes[s] = ti
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *MediaTypeProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "type", Description: "media type", Example: "audio/mp4"},
+		{Name: "extension", Description: "file extension, with its dot", Example: ".m4a"},
+		{Name: "category", Description: "top-level type", Example: "audio"},
+		{Name: "tree", Description: "registration tree, such as Standards or Vendor", Example: "Vendor"},
+		{Name: "suffix", Description: "structured syntax suffix", Example: "xml"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *MediaTypeProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ti := d.typeIndexes[index]
+	return stddata.Suggest(ti.typeKeys, ti.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of MediaType entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching MediaTypes are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The extension index is keyed by the extensions with their dots, such as ".flac",
+// and the category index by the top-level types, such as "audio".
+func (p *MediaTypeProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	ti, found := d.typeIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.typeIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(ti, query)
+	return result, nil
+}
+
+// SearchMediaTypes is Search, returning the matches as they are, rather
+// than as a MediaTypeResult in an interface{}.
+func (p *MediaTypeProvider) SearchMediaTypes(index string, query string) ([][]MediaType, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(MediaTypeResult).MediaTypes, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the MediaTypes of each. It yields nothing if the
+// MediaTypeProvider has no such index, or is not loaded.
+func (p *MediaTypeProvider) Range(index string) iter.Seq2[string, []MediaType] {
+	return func(yield func(string, []MediaType) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ti := d.typeIndexes[index]
+		for _, k := range ti.typeKeys {
+			if !yield(k, ti.typeMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(ti typeIndex, query string) (res MediaTypeResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ti.typeKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ti.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.MediaTypes = make([][]MediaType, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.MediaTypes = append(res.MediaTypes, ti.typeMap[ti.typeKeys[k]])
+	}
+	return res
+}
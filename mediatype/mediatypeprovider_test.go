@@ -0,0 +1,89 @@
+package mediatype
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestMediaTypeProvider(t *testing.T) {
+	expected := 2249
+	fmt.Println("Test: MediaTypeProvider.Load")
+	p = new(MediaTypeProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestCategorySearch(t *testing.T) {
+	res, err := p.Search("category", "audio")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	m := res.(MediaTypeResult).MediaTypes
+	if len(m) != 1 || len(m[0]) != 169 {
+		t.Fatalf("Expected 169 audio types, got %d\n", len(m))
+	}
+	res, err = p.Search("type", "audio/fla")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if m := res.(MediaTypeResult).MediaTypes; len(m) != 1 || m[0][0].Extensions[0] != ".flac" {
+		t.Fatalf("Expected audio/flac, got %v\n", m)
+	}
+}
+func TestGet(t *testing.T) {
+	mp := p.(*MediaTypeProvider)
+	m, err := mp.Get("Application/Atom+XML; charset=utf-8")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if m.Category != "application" || m.Tree != Standards || m.Suffix != "xml" {
+		t.Fatalf("Expected application/atom+xml, got %v\n", m)
+	}
+	m, _ = mp.Get("application/vnd.ms-excel")
+	if m.Tree != Vendor {
+		t.Fatalf("Expected the vendor tree, got %v\n", m)
+	}
+}
+func TestByExtension(t *testing.T) {
+	mp := p.(*MediaTypeProvider)
+	types, err := mp.ByExtension(".M4A")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(types) != 1 || types[0].Type != "audio/mp4" {
+		t.Fatalf("Expected audio/mp4, got %v\n", types)
+	}
+	types, err = mp.ByExtension("gsm")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(types) != 2 || types[0].Type != "model/vnd.gdl" || types[1].Tree != Unregistered {
+		t.Fatalf("Expected the registered type first, got %v\n", types)
+	}
+	if _, err = mp.ByExtension(".nosuchext"); err == nil {
+		t.Fatalf("Expected no media type for .nosuchext\n")
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(MediaTypeProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(MediaTypeProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "type", Query: "audio/fla"}, {Index: "extension", Query: ".m4a"}, {Index: "category", Query: "audio"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
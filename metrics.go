@@ -0,0 +1,219 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stddata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metrics is an Observer that counts the loads and searches of the
+// Services it observes, and serves the counts in the Prometheus text
+// format, so that a server can be monitored without a client library:
+//
+//	m := new(stddata.Metrics)
+//	s := &stddata.Service{Observer: m}
+//	s.LoadProvider(new(country.CountryProvider), "country")
+//	http.Handle("/country", s)
+//	http.Handle("/metrics", m)
+//
+// The metrics are:
+//
+//	stddata_items{provider}                   the number of items loaded
+//	stddata_load_duration_seconds{provider}   how long the last load took
+//	stddata_last_load_timestamp_seconds{provider}
+//	                                          when the last load was done
+//	stddata_last_refresh_timestamp_seconds{provider}
+//	                                          when the data was last
+//	                                          refreshed, by LoadRefresh
+//	                                          or ApplyChanges
+//	stddata_load_errors_total{provider}       the loads that failed
+//	stddata_searches_total{provider,index,result}
+//	                                          the searches, by whether
+//	                                          they were a hit, a miss or
+//	                                          an error
+//	stddata_search_errors_total{provider,code}
+//	                                          the searches that failed,
+//	                                          by HTTP status code
+//	stddata_search_duration_seconds{provider} a summary of how long the
+//	                                          searches took
+//
+// A search of an index that the Provider does not have is counted
+// under the index "_unknown", so that the indexes that clients make up
+// do not each become a series. A Metrics may observe several Services,
+// and be used concurrently. It is also a RefreshObserver, of
+// country.LoadRefresh and bank.ApplyChanges.
+type Metrics struct {
+	mu        sync.Mutex
+	loads     map[string]loadMetrics
+	searches  map[[3]string]int64   // by provider, index and result
+	errors    map[[2]string]int64   // by provider and status code
+	durations map[string][2]float64 // the count and sum of the search durations, by provider
+}
+
+// loadMetrics are the metrics of the loads of a Provider.
+type loadMetrics struct {
+	items     int
+	duration  time.Duration
+	done      time.Time
+	refreshed time.Time // when the last refresh was done
+	errors    int64
+}
+
+// ObserveLoad implements the ObserveLoad method of the Observer interface.
+func (m *Metrics) ObserveLoad(ctx context.Context, provider string) func(n int, err error) {
+	start := time.Now()
+	return func(n int, err error) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.loads == nil {
+			m.loads = make(map[string]loadMetrics)
+		}
+		l := m.loads[provider]
+		if err != nil {
+			l.errors++
+		} else {
+			l.items, l.duration, l.done = n, time.Since(start), time.Now()
+		}
+		m.loads[provider] = l
+	}
+}
+
+// ObserveRefresh implements the RefreshObserver interface. A refresh
+// that fails leaves the time of the last refresh as it was.
+func (m *Metrics) ObserveRefresh(ctx context.Context, provider string) func(err error) {
+	return func(err error) {
+		if err != nil {
+			return
+		}
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.loads == nil {
+			m.loads = make(map[string]loadMetrics)
+		}
+		l := m.loads[provider]
+		l.refreshed = time.Now()
+		m.loads[provider] = l
+	}
+}
+
+// ObserveSearch implements the ObserveSearch method of the Observer
+// interface.
+func (m *Metrics) ObserveSearch(ctx context.Context, provider string, index string, query string) func(matches int, err error) {
+	start := time.Now()
+	return func(matches int, err error) {
+		elapsed := time.Since(start).Seconds()
+		result := "hit"
+		switch {
+		case err != nil:
+			result = "error"
+		case matches == 0:
+			result = "miss"
+		}
+		if errors.Is(err, ErrUnknownIndex) {
+			index = "_unknown"
+		}
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.searches == nil {
+			m.searches = make(map[[3]string]int64)
+			m.errors = make(map[[2]string]int64)
+			m.durations = make(map[string][2]float64)
+		}
+		m.searches[[3]string{provider, index, result}]++
+		if err != nil {
+			m.errors[[2]string{provider, strconv.Itoa(StatusCode(err))}]++
+		}
+		d := m.durations[provider]
+		m.durations[provider] = [2]float64{d[0] + 1, d[1] + elapsed}
+	}
+}
+
+// ServeHTTP serves the metrics in the Prometheus text format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// WriteTo writes the metrics to w in the Prometheus text format, each
+// in the order of its labels, and returns the number of bytes written.
+func (m *Metrics) WriteTo(w io.Writer) (n int64, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var b strings.Builder
+	providers := make([]string, 0, len(m.loads))
+	for provider := range m.loads {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+	gauge := func(name string, help string, value func(l loadMetrics) (float64, bool)) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s gauge\n", name, help, name)
+		for _, provider := range providers {
+			if v, ok := value(m.loads[provider]); ok {
+				fmt.Fprintf(&b, "%s{provider=%q} %g\n", name, provider, v)
+			}
+		}
+	}
+	gauge("stddata_items", "The number of items loaded.", func(l loadMetrics) (float64, bool) {
+		return float64(l.items), !l.done.IsZero()
+	})
+	gauge("stddata_load_duration_seconds", "How long the last load took.", func(l loadMetrics) (float64, bool) {
+		return l.duration.Seconds(), !l.done.IsZero()
+	})
+	gauge("stddata_last_load_timestamp_seconds", "When the last load was done.", func(l loadMetrics) (float64, bool) {
+		return float64(l.done.UnixNano()) / 1e9, !l.done.IsZero()
+	})
+	gauge("stddata_last_refresh_timestamp_seconds", "When the data was last refreshed.", func(l loadMetrics) (float64, bool) {
+		return float64(l.refreshed.UnixNano()) / 1e9, !l.refreshed.IsZero()
+	})
+	fmt.Fprintf(&b, "# HELP stddata_load_errors_total The loads that failed.\n# TYPE stddata_load_errors_total counter\n")
+	for _, provider := range providers {
+		fmt.Fprintf(&b, "stddata_load_errors_total{provider=%q} %d\n", provider, m.loads[provider].errors)
+	}
+
+	fmt.Fprintf(&b, "# HELP stddata_searches_total The searches, by whether they were a hit, a miss or an error.\n# TYPE stddata_searches_total counter\n")
+	searches := make([][3]string, 0, len(m.searches))
+	for k := range m.searches {
+		searches = append(searches, k)
+	}
+	sort.Slice(searches, func(i, j int) bool {
+		return strings.Join(searches[i][:], "\x00") < strings.Join(searches[j][:], "\x00")
+	})
+	for _, k := range searches {
+		fmt.Fprintf(&b, "stddata_searches_total{provider=%q,index=%q,result=%q} %d\n", k[0], k[1], k[2], m.searches[k])
+	}
+	fmt.Fprintf(&b, "# HELP stddata_search_errors_total The searches that failed, by HTTP status code.\n# TYPE stddata_search_errors_total counter\n")
+	errs := make([][2]string, 0, len(m.errors))
+	for k := range m.errors {
+		errs = append(errs, k)
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i][0] < errs[j][0] || errs[i][0] == errs[j][0] && errs[i][1] < errs[j][1]
+	})
+	for _, k := range errs {
+		fmt.Fprintf(&b, "stddata_search_errors_total{provider=%q,code=%q} %d\n", k[0], k[1], m.errors[k])
+	}
+	fmt.Fprintf(&b, "# HELP stddata_search_duration_seconds How long the searches took.\n# TYPE stddata_search_duration_seconds summary\n")
+	searched := make([]string, 0, len(m.durations))
+	for provider := range m.durations {
+		searched = append(searched, provider)
+	}
+	sort.Strings(searched)
+	for _, provider := range searched {
+		d := m.durations[provider]
+		fmt.Fprintf(&b, "stddata_search_duration_seconds_sum{provider=%q} %g\n", provider, d[1])
+		fmt.Fprintf(&b, "stddata_search_duration_seconds_count{provider=%q} %g\n", provider, d[0])
+	}
+	written, err := io.WriteString(w, b.String())
+	return int64(written), err
+}
@@ -0,0 +1,135 @@
+package stddata
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stubProvider is a Provider of a few words, with a single index,
+// "word", that takes delay to search.
+type stubProvider struct {
+	words  []string
+	delay  time.Duration
+	loaded bool
+}
+
+type stubResult struct {
+	Words []string
+}
+
+func newStubProvider(delay time.Duration, words ...string) *stubProvider {
+	return &stubProvider{words: words, delay: delay}
+}
+func (p *stubProvider) Load() (n int, err error) {
+	p.loaded = true
+	return len(p.words), nil
+}
+func (p *stubProvider) Indexes() []IndexDescription {
+	return []IndexDescription{{Name: "word", Description: "the word", Example: p.words[0]}}
+}
+func (p *stubProvider) Search(index string, query string) (result interface{}, err error) {
+	if !p.loaded {
+		return nil, ErrNotLoaded
+	}
+	if index != "word" {
+		return nil, NewIndexError(index, map[string]bool{"word": true})
+	}
+	if len(query) < 1 {
+		return nil, ErrEmptyQuery
+	}
+	time.Sleep(p.delay)
+	var res stubResult
+	for _, w := range p.words {
+		if strings.HasPrefix(w, query) {
+			res.Words = append(res.Words, w)
+		}
+	}
+	return res, nil
+}
+
+// recordingObserver records the loads and searches it is told of.
+type recordingObserver struct {
+	events []string
+}
+
+func (o *recordingObserver) ObserveLoad(ctx context.Context, provider string) func(int, error) {
+	return func(n int, err error) {
+		o.events = append(o.events, fmt.Sprintf("load %s %d %v", provider, n, err))
+	}
+}
+func (o *recordingObserver) ObserveSearch(ctx context.Context, provider string, index string, query string) func(int, error) {
+	return func(matches int, err error) {
+		o.events = append(o.events, fmt.Sprintf("search %s %s=%s %d %v", provider, index, query, matches, err != nil))
+	}
+}
+
+// get requests each of queries of the Service at url.
+func get(t *testing.T, url string, queries ...string) {
+	for _, q := range queries {
+		resp, err := http.Get(url + "?" + q)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		resp.Body.Close()
+	}
+}
+func TestObserver(t *testing.T) {
+	o := new(recordingObserver)
+	svc := &Service{Observer: o, Enveloped: true}
+	if err := svc.LoadProvider(newStubProvider(0, "alpha", "alpine", "beta"), "stub"); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	s := httptest.NewServer(svc)
+	defer s.Close()
+	get(t, s.URL, "word=al", "word=zz", "wrd=al")
+	expected := []string{"load stub 3 <nil>", "search stub word=al 2 false", "search stub word=zz 0 false", "search stub wrd=al 0 true"}
+	if fmt.Sprint(o.events) != fmt.Sprint(expected) {
+		t.Fatalf("Expected %v, got %v\n", expected, o.events)
+	}
+}
+func TestMetrics(t *testing.T) {
+	m := new(Metrics)
+	svc := &Service{Observer: m}
+	if err := svc.LoadProvider(newStubProvider(0, "alpha", "alpine", "beta"), "stub"); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	s := httptest.NewServer(svc)
+	defer s.Close()
+	get(t, s.URL, "word=al", "word=be", "word=zz", "wrd=al", "nope=al")
+	m.ObserveRefresh(context.Background(), "stub")(nil)
+	m.ObserveRefresh(context.Background(), "failed")(fmt.Errorf("refresh failed"))
+	ms := httptest.NewServer(m)
+	defer ms.Close()
+	resp, err := http.Get(ms.URL)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	for _, expected := range []string{
+		`stddata_items{provider="stub"} 3`,
+		`stddata_load_errors_total{provider="stub"} 0`,
+		`stddata_searches_total{provider="stub",index="_unknown",result="error"} 2`,
+		`stddata_searches_total{provider="stub",index="word",result="hit"} 2`,
+		`stddata_searches_total{provider="stub",index="word",result="miss"} 1`,
+		`stddata_search_errors_total{provider="stub",code="400"} 2`,
+		`stddata_search_duration_seconds_count{provider="stub"} 5`,
+		"# TYPE stddata_last_load_timestamp_seconds gauge",
+	} {
+		if !strings.Contains(string(body), expected) {
+			t.Fatalf("Expected %s in\n%s\n", expected, body)
+		}
+	}
+	if !strings.Contains(string(body), `stddata_last_refresh_timestamp_seconds{provider="stub"} `) {
+		t.Fatalf("Expected the refresh of stub in\n%s\n", body)
+	}
+	if strings.Contains(string(body), `provider="failed"} `) {
+		t.Fatalf("Expected no refresh of failed in\n%s\n", body)
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package musicbrainz
+
+import (
+	"iter"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// areaTypes are the names of the rows of the area_type table.
+var areaTypes = map[string]string{
+	"1": "Country",
+	"2": "Subdivision",
+	"3": "County",
+	"4": "Municipality",
+	"5": "City",
+	"6": "District",
+	"7": "Island",
+}
+
+// AreaProvider implements the Provider interface for the areas of
+// MusicBrainz.
+type AreaProvider struct {
+	// File is the path of the area table of a MusicBrainz dump,
+	// mbdump/area. It must be set before Load is called.
+	File string
+	// ISO31661File and ISO31662File are the paths of the iso_3166_1
+	// and iso_3166_2 tables of the same dump, mbdump/iso_3166_1 and
+	// mbdump/iso_3166_2, which give the ISO 3166 codes of the areas.
+	// When they are empty, the areas have no ISO codes.
+	ISO31661File string
+	ISO31662File string
+	data         atomic.Pointer[areaData] // nil until the data is loaded
+}
+
+// areaData is the data of an AreaProvider. Once it is stored in the
+// AreaProvider it is not modified.
+type areaData struct {
+	size        int
+	info        stddata.Info
+	areaIndexes map[string]areaIndex
+	// rows holds the areas by the ids of their rows, which the other
+	// tables of the dump refer to them by.
+	rows map[string]Area
+}
+
+type areaIndex struct {
+	areaMap    map[string][]Area
+	areaKeys   []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Area models one entity.
+type Area struct {
+	MBID    string // for example "489ce91b-6658-3307-9877-795b68554c98" for the United States
+	Name    string // for example "United States"
+	Type    string // for example "Country", or "" if the area has no type
+	Comment string // the disambiguation comment, if any
+	Ended   bool   // the area no longer exists, for example the Soviet Union
+	// ISOCodes are the ISO 3166-1 alpha-2 and ISO 3166-2 codes of the
+	// area, for example "US", or "US-CA" for California.
+	ISOCodes []string
+}
+
+// AreaResult is the interface{} that is returned from Search
+type AreaResult struct {
+	Areas [][]Area
+}
+
+// Load implements the Loader interface. A malformed row in the
+// dump causes Load to fail.
+func (p *AreaProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the dump, treating malformed rows according to
+// mode. In stddata.Lenient mode, malformed rows are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *AreaProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &areaData{areaIndexes: make(map[string]areaIndex)}
+	d.rows = make(map[string]Area)
+	areaMBIDMap := make(map[string][]Area)
+	areaNameMap := make(map[string][]Area)
+	areaISOMap := make(map[string][]Area)
+
+	if p.File == "" {
+		return r, stddata.NewSourceError("No MusicBrainz area table", nil)
+	}
+	// the columns of the area table are id, gid, name, type,
+	// edits_pending, last_updated, the begin and end dates, ended and
+	// comment.
+	err = readDump(p.File, 14, mode, &r, func(fields []string) bool {
+		var a Area
+		a.MBID = fields[1]
+		a.Name = fields[2]
+		a.Type = areaTypes[fields[3]]
+		a.Ended = fields[12] == "t"
+		a.Comment = fields[13]
+		if fields[0] == "" || !isMBID(a.MBID) || a.Name == "" {
+			return false
+		}
+		d.rows[fields[0]] = a
+		return true
+	})
+	if err != nil {
+		return r, err
+	}
+	// the columns of the iso_3166_1 and iso_3166_2 tables are area and
+	// code.
+	for _, file := range []string{p.ISO31661File, p.ISO31662File} {
+		if file == "" {
+			continue
+		}
+		err = readDump(file, 2, mode, &r, func(fields []string) bool {
+			a, found := d.rows[fields[0]]
+			if !found || fields[1] == "" {
+				return false
+			}
+			a.ISOCodes = append(a.ISOCodes, fields[1])
+			d.rows[fields[0]] = a
+			return true
+		})
+		if err != nil {
+			return r, err
+		}
+	}
+
+	// add the Areas to the maps
+	for _, a := range d.rows {
+		areaMBIDMap[a.MBID] = append(areaMBIDMap[a.MBID], a)
+		areaNameMap[a.Name] = append(areaNameMap[a.Name], a)
+		for _, code := range a.ISOCodes {
+			areaISOMap[code] = append(areaISOMap[code], a)
+		}
+	}
+	p.storeData(d, "mbid", areaMBIDMap)
+	p.storeData(d, "name", areaNameMap)
+	p.storeData(d, "iso", areaISOMap)
+	d.size = len(areaMBIDMap)
+	d.info = stddata.Info{
+		Source:   "MusicBrainz database dump",
+		URL:      strings.TrimSpace(p.File + " " + p.ISO31661File + " " + p.ISO31662File),
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(areaMBIDMap)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *AreaProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Area whose MBID is mbid.
+func (p *AreaProvider) Get(mbid string) (a Area, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return a, stddata.ErrNotLoaded
+	}
+	areas, found := d.areaIndexes["mbid"].areaMap[strings.ToLower(mbid)]
+	if !found {
+		msg := "No MusicBrainz area " + mbid
+		return a, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return areas[0], nil
+}
+
+// GetByISO returns the Area whose ISO 3166-1 alpha-2 or ISO 3166-2
+// code is code, for example "DE" or "US-CA". When several areas share
+// a code, the one that has not ended is returned.
+func (p *AreaProvider) GetByISO(code string) (a Area, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return a, stddata.ErrNotLoaded
+	}
+	areas, found := d.areaIndexes["iso"].areaMap[strings.ToUpper(code)]
+	if !found {
+		msg := "No MusicBrainz area with ISO 3166 code " + code
+		return a, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	for _, a := range areas {
+		if !a.Ended {
+			return a, nil
+		}
+	}
+	return areas[0], nil
+}
+
+func (p *AreaProvider) storeData(d *areaData, s string, m map[string][]Area) {
+	// store the map
+	var ai areaIndex
+	ai.areaMap = m
+	// extract the keys
+	ai.areaKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		ai.areaKeys[i] = k
+		i++
+	}
+	// sort the keys
+	ai.foldedKeys = stddata.SortKeys(ai.areaKeys)
+	// add to areaIndexes
+	d.areaIndexes[s] = ai
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *AreaProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "mbid", Description: "MusicBrainz identifier", Example: "489ce91b-6658-3307-9877-795b68554c98"},
+		{Name: "name", Description: "name of the area", Example: "United States"},
+		{Name: "iso", Description: "ISO 3166-1 alpha-2 or ISO 3166-2 code", Example: "US"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *AreaProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ai := d.areaIndexes[index]
+	return stddata.Suggest(ai.areaKeys, ai.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Area entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Areas are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The iso index is keyed by ISO 3166-1 alpha-2 and ISO 3166-2 codes, so "US" finds
+// the United States and its states.
+func (p *AreaProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	ai, found := d.areaIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.areaIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doAreaSearch(ai, query)
+	return result, nil
+}
+
+// SearchAreas is Search, returning the matches as they are, rather
+// than as an AreaResult in an interface{}.
+func (p *AreaProvider) SearchAreas(index string, query string) ([][]Area, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(AreaResult).Areas, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Areas of each. It yields nothing if the
+// AreaProvider has no such index, or is not loaded.
+func (p *AreaProvider) Range(index string) iter.Seq2[string, []Area] {
+	return func(yield func(string, []Area) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ai := d.areaIndexes[index]
+		for _, k := range ai.areaKeys {
+			if !yield(k, ai.areaMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doAreaSearch(ai areaIndex, query string) (res AreaResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ai.areaKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ai.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Areas = make([][]Area, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Areas = append(res.Areas, ai.areaMap[ai.areaKeys[k]])
+	}
+	return res
+}
+
+// areaOf returns the Area whose row id is id, and whether it was found.
+func (d *areaData) areaOf(id string) (a Area, found bool) {
+	if d == nil || id == "" {
+		return a, false
+	}
+	a, found = d.rows[id]
+	return a, found
+}
@@ -0,0 +1,151 @@
+package musicbrainz
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+// the rows of a small dump, in the format of the MusicBrainz dumps
+const areaRows = `222	489ce91b-6658-3307-9877-795b68554c98	United States	1	0	2013-05-27 13:15:52.179105+00	\N	\N	\N	\N	\N	\N	f	
+81	85752fda-13c4-31a3-bee5-0e5cb1f51dad	Germany	1	0	2013-05-27 12:44:37.529747+00	\N	\N	\N	\N	\N	\N	f	
+243	32f90933-b4b4-3248-b98c-e573d5329f57	Soviet Union	1	0	2013-06-15 18:06:39.59323+00	1922	12	30	1991	12	25	t	
+5338	ae0110b6-13d4-4998-9116-5b926287aa23	California	2	0	2013-06-10 14:34:21.744436+00	\N	\N	\N	\N	\N	\N	f	
+`
+const iso31661Rows = `222	US
+81	DE
+243	SU
+`
+const iso31662Rows = `5338	US-CA
+`
+const labelRows = `1	c3c9d7bd-7a3d-4a5b-8f2e-3a1c6f0f4d19	Deutsche Grammophon	1898	\N	\N	\N	\N	\N	173	4	81		0	2013-06-02 14:19:33.547925+00	f
+2	0d5ef2b4-3c1d-4f4e-9a2b-7d6e5f4a3b21	Archiv Produktion	1947	\N	\N	\N	\N	\N	173	9	81		0	2013-06-02 14:19:33.547925+00	f
+3	9b8c7d6e-5f4a-4b3c-8d2e-1f0a9b8c7d6e	Blue Note	1939	\N	\N	\N	\N	\N	\N	4	222	US jazz label	0	2013-06-02 14:19:33.547925+00	f
+`
+
+var ap AreaProvider
+var lp LabelProvider
+
+func writeDump(t testing.TB, dir string, name string, rows string) string {
+	file := filepath.Join(dir, name)
+	if err := os.WriteFile(file, []byte(rows), 0644); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	return file
+}
+func TestAreaProvider(t *testing.T) {
+	expected := 4
+	fmt.Println("Test: AreaProvider.Load")
+	dir := t.TempDir()
+	ap.File = writeDump(t, dir, "area", areaRows)
+	ap.ISO31661File = writeDump(t, dir, "iso_3166_1", iso31661Rows)
+	ap.ISO31662File = writeDump(t, dir, "iso_3166_2", iso31662Rows)
+	var p Provider = &ap
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestAreaISOSearch(t *testing.T) {
+	res, err := ap.Search("iso", "US")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	a := res.(AreaResult).Areas
+	if len(a) != 2 || a[0][0].Name != "United States" || a[1][0].Name != "California" {
+		t.Fatalf("Expected the United States and California, got %v\n", a)
+	}
+	de, err := ap.GetByISO("de")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if de.MBID != "85752fda-13c4-31a3-bee5-0e5cb1f51dad" || de.Type != "Country" {
+		t.Fatalf("Expected Germany, got %v\n", de)
+	}
+	su, err := ap.Get("32F90933-B4B4-3248-B98C-E573D5329F57")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if !su.Ended {
+		t.Fatalf("Expected the Soviet Union to have ended, got %v\n", su)
+	}
+}
+func TestLabelProvider(t *testing.T) {
+	fmt.Println("Test: LabelProvider.Load")
+	lp.File = writeDump(t, t.TempDir(), "label", labelRows)
+	lp.Areas = &ap
+	n, err := lp.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != 3 {
+		t.Fatalf("Expected to load 3, loaded %d\n", n)
+	}
+	labels, err := lp.GetByLabelCode("LC 0173")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(labels) != 2 || labels[0].Name != "Deutsche Grammophon" || labels[0].Area.Name != "Germany" {
+		t.Fatalf("Expected Deutsche Grammophon and its imprint, got %v\n", labels)
+	}
+	res, err := lp.Search("area", "United")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	l := res.(LabelResult).Labels
+	if len(l) != 1 || l[0][0].Name != "Blue Note" || l[0][0].LabelCode != "" {
+		t.Fatalf("Expected Blue Note, got %v\n", l)
+	}
+}
+func TestMalformedDump(t *testing.T) {
+	file := writeDump(t, t.TempDir(), "label", labelRows+"4\tnot-an-mbid\tNobody\n")
+	p := LabelProvider{File: file}
+	if _, err := p.Load(); err == nil {
+		t.Fatalf("Expected a malformed row to fail Load\n")
+	}
+	r, err := p.LoadMode(Lenient)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 3 || r.Skipped != 1 || r.SkippedLines[0] != 4 {
+		t.Fatalf("Expected 3 loaded and line 4 skipped, got %v\n", r)
+	}
+}
+func BenchmarkAreaLoad(b *testing.B) {
+	dir := b.TempDir()
+	file := writeDump(b, dir, "area", areaRows)
+	iso31661 := writeDump(b, dir, "iso_3166_1", iso31661Rows)
+	iso31662 := writeDump(b, dir, "iso_3166_2", iso31662Rows)
+	stddatatest.BenchmarkLoad(b, func() Provider { return &AreaProvider{File: file, ISO31661File: iso31661, ISO31662File: iso31662} })
+}
+func BenchmarkAreaSearch(b *testing.B) {
+	dir := b.TempDir()
+	file := writeDump(b, dir, "area", areaRows)
+	iso31661 := writeDump(b, dir, "iso_3166_1", iso31661Rows)
+	iso31662 := writeDump(b, dir, "iso_3166_2", iso31662Rows)
+	bp := &AreaProvider{File: file, ISO31661File: iso31661, ISO31662File: iso31662}
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "name", Query: "United"}, {Index: "iso", Query: "US"}})
+}
+func BenchmarkLabelLoad(b *testing.B) {
+	file := writeDump(b, b.TempDir(), "label", labelRows)
+	stddatatest.BenchmarkLoad(b, func() Provider { return &LabelProvider{File: file} })
+}
+func BenchmarkLabelSearch(b *testing.B) {
+	file := writeDump(b, b.TempDir(), "label", labelRows)
+	bp := &LabelProvider{File: file}
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "name", Query: "Deutsche"}, {Index: "code", Query: "LC 173"}})
+}
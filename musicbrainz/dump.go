@@ -0,0 +1,110 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package musicbrainz implements the methods of a stddata.Provider for
+two tables of the MusicBrainz database: its areas, which are the
+countries, subdivisions and cities of the world, bridged to ISO 3166
+by their codes, and its record labels, with their label codes (LC
+numbers). Both are identified by MusicBrainz identifiers (MBIDs).
+The data is read from the files of a MusicBrainz database dump,
+mbdump/area, mbdump/label and so on, which the caller downloads from
+https://metabrainz.org/datasets and unpacks; none is embedded, since
+the tables are large and change daily.
+*/
+package musicbrainz
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/musicbeat/stddata"
+)
+
+// readDump reads the table in file, which is in PostgreSQL's COPY text
+// format: one row a line, with its columns separated by tabs, its
+// special characters escaped by backslashes, and "\N" for null, which
+// is read as "". Each row of at least columns columns is passed to
+// row, which reports whether it is well formed. Malformed rows are
+// treated according to mode.
+func readDump(file string, columns int, mode stddata.ParseMode, r *stddata.LoadReport, row func(fields []string) bool) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return stddata.NewSourceError(err.Error(), err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Split(scanner.Text(), "\t")
+		for i := range fields {
+			fields[i] = unescape(fields[i])
+		}
+		if len(fields) < columns || !row(fields) {
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("%s: line %d: malformed row", file, line)
+			return stddata.NewSourceError(msg, nil)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return stddata.NewSourceError(err.Error(), err)
+	}
+	return nil
+}
+
+// unescape returns the value of a column in PostgreSQL's COPY text
+// format.
+func unescape(s string) string {
+	if s == `\N` {
+		return ""
+	}
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+// isMBID reports whether s is a well formed MBID, a UUID in its
+// canonical form.
+func isMBID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch {
+		case i == 8 || i == 13 || i == 18 || i == 23:
+			if s[i] != '-' {
+				return false
+			}
+		case !(s[i] >= '0' && s[i] <= '9' || s[i] >= 'a' && s[i] <= 'f'):
+			return false
+		}
+	}
+	return true
+}
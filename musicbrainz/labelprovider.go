@@ -0,0 +1,309 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package musicbrainz
+
+import (
+	"fmt"
+	"iter"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// LabelProvider implements the Provider interface for the record
+// labels of MusicBrainz.
+type LabelProvider struct {
+	// File is the path of the label table of a MusicBrainz dump,
+	// mbdump/label. It must be set before Load is called.
+	File string
+	// Areas is a loaded AreaProvider, of the same dump, that gives
+	// the areas of the labels. When it is nil, the labels have no
+	// Area.
+	Areas *AreaProvider
+	data  atomic.Pointer[labelData] // nil until the data is loaded
+}
+
+// labelData is the data of a LabelProvider. Once it is stored in the
+// LabelProvider it is not modified.
+type labelData struct {
+	size         int
+	info         stddata.Info
+	labelIndexes map[string]labelIndex
+}
+
+type labelIndex struct {
+	labelMap   map[string][]Label
+	labelKeys  []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Label models one entity.
+type Label struct {
+	MBID string // the MBID of the label
+	Name string // for example "Deutsche Grammophon"
+	// LabelCode is the label code, which identifies the label to the
+	// collecting societies, zero-padded to five digits, for example
+	// "00173", printed as "LC 00173". It is "" if the label has none.
+	LabelCode string
+	Area      Area   // the area the label is based in, if known
+	Comment   string // the disambiguation comment, if any
+	Ended     bool   // the label no longer exists
+}
+
+// LabelResult is the interface{} that is returned from Search
+type LabelResult struct {
+	Labels [][]Label
+}
+
+// Load implements the Loader interface. A malformed row in the
+// dump causes Load to fail.
+func (p *LabelProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the dump, treating malformed rows according to
+// mode. In stddata.Lenient mode, malformed rows are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *LabelProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &labelData{labelIndexes: make(map[string]labelIndex)}
+	labelMBIDMap := make(map[string][]Label)
+	labelCodeMap := make(map[string][]Label)
+	labelNameMap := make(map[string][]Label)
+	labelAreaMap := make(map[string][]Label)
+	// the areas of the labels, when there is an AreaProvider
+	var areas *areaData
+	if p.Areas != nil {
+		areas = p.Areas.data.Load()
+	}
+
+	if p.File == "" {
+		return r, stddata.NewSourceError("No MusicBrainz label table", nil)
+	}
+	// the columns of the label table are id, gid, name, the begin and
+	// end dates, label_code, type, area, comment, edits_pending,
+	// last_updated and ended.
+	err = readDump(p.File, 16, mode, &r, func(fields []string) bool {
+		var l Label
+		l.MBID = fields[1]
+		l.Name = fields[2]
+		if fields[9] != "" {
+			l.LabelCode = normalizeLabelCode(fields[9])
+			if l.LabelCode == "" {
+				return false
+			}
+		}
+		l.Area, _ = areas.areaOf(fields[11])
+		l.Comment = fields[12]
+		l.Ended = fields[15] == "t"
+		if !isMBID(l.MBID) || l.Name == "" {
+			return false
+		}
+
+		// add the Label to the maps
+		labelMBIDMap[l.MBID] = append(labelMBIDMap[l.MBID], l)
+		if l.LabelCode != "" {
+			labelCodeMap[l.LabelCode] = append(labelCodeMap[l.LabelCode], l)
+		}
+		labelNameMap[l.Name] = append(labelNameMap[l.Name], l)
+		if l.Area.Name != "" {
+			labelAreaMap[l.Area.Name] = append(labelAreaMap[l.Area.Name], l)
+		}
+		return true
+	})
+	if err != nil {
+		return r, err
+	}
+	p.storeData(d, "mbid", labelMBIDMap)
+	p.storeData(d, "code", labelCodeMap)
+	p.storeData(d, "name", labelNameMap)
+	p.storeData(d, "area", labelAreaMap)
+	d.size = len(labelMBIDMap)
+	d.info = stddata.Info{
+		Source:   "MusicBrainz database dump",
+		URL:      p.File,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(labelMBIDMap)
+	return r, nil
+}
+
+// normalizeLabelCode returns the digits of a label code, zero-padded
+// to five: "LC 0173", "LC-173" and "173" all become "00173". It returns
+// "" if s is not a label code.
+func normalizeLabelCode(s string) string {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	s = strings.TrimLeft(strings.TrimPrefix(s, "LC"), " -")
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || n > 99999 || strings.HasPrefix(s, "+") {
+		return ""
+	}
+	return fmt.Sprintf("%05d", n)
+}
+
+// Info describes the provenance of the loaded data.
+func (p *LabelProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Label whose MBID is mbid.
+func (p *LabelProvider) Get(mbid string) (l Label, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return l, stddata.ErrNotLoaded
+	}
+	labels, found := d.labelIndexes["mbid"].labelMap[strings.ToLower(mbid)]
+	if !found {
+		msg := "No MusicBrainz label " + mbid
+		return l, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return labels[0], nil
+}
+
+// GetByLabelCode returns the Labels whose label code is code, written
+// with or without its "LC" and its leading zeros: "LC 00173", "LC-0173"
+// and "173" all find Deutsche Grammophon. Several labels can share a
+// code, as the imprints of a label often do.
+func (p *LabelProvider) GetByLabelCode(code string) (labels []Label, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	labels, found := d.labelIndexes["code"].labelMap[normalizeLabelCode(code)]
+	if !found {
+		msg := "No MusicBrainz label with label code " + code
+		return nil, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return labels, nil
+}
+
+func (p *LabelProvider) storeData(d *labelData, s string, m map[string][]Label) {
+	// store the map
+	var li labelIndex
+	li.labelMap = m
+	// extract the keys
+	li.labelKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		li.labelKeys[i] = k
+		i++
+	}
+	// sort the keys
+	li.foldedKeys = stddata.SortKeys(li.labelKeys)
+	// add to labelIndexes
+	d.labelIndexes[s] = li
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *LabelProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "mbid", Description: "MusicBrainz identifier", Example: "2a1ba9a6-2a5f-4b4a-8a71-2b2e1b3c5f1e"},
+		{Name: "code", Description: "label code", Example: "LC 173"},
+		{Name: "name", Description: "name of the label", Example: "Deutsche Grammophon"},
+		{Name: "area", Description: "name of the area of the label", Example: "Germany"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *LabelProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	li := d.labelIndexes[index]
+	return stddata.Suggest(li.labelKeys, li.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Label entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Labels are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The code index is keyed by label codes zero-padded to five digits, and a query that
+// is a label code is padded likewise, so "LC 173" finds Deutsche Grammophon. The area
+// index is keyed by the names of the areas of the labels.
+func (p *LabelProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	li, found := d.labelIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.labelIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	if code := normalizeLabelCode(query); index == "code" && code != "" {
+		query = code
+	}
+	result = doLabelSearch(li, query)
+	return result, nil
+}
+
+// SearchLabels is Search, returning the matches as they are, rather
+// than as a LabelResult in an interface{}.
+func (p *LabelProvider) SearchLabels(index string, query string) ([][]Label, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(LabelResult).Labels, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Labels of each. It yields nothing if the
+// LabelProvider has no such index, or is not loaded.
+func (p *LabelProvider) Range(index string) iter.Seq2[string, []Label] {
+	return func(yield func(string, []Label) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		li := d.labelIndexes[index]
+		for _, k := range li.labelKeys {
+			if !yield(k, li.labelMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doLabelSearch(li labelIndex, query string) (res LabelResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(li.labelKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(li.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Labels = make([][]Label, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Labels = append(res.Labels, li.labelMap[li.labelKeys[k]])
+	}
+	return res
+}
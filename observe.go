@@ -0,0 +1,79 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stddata
+
+import "context"
+
+// Observer is told of the loads and searches of a Service, so that
+// they can be traced and counted, by provider, by index, and by
+// whether a search found anything. An Observer that reports to
+// OpenTelemetry starts a span, and counts, in each of its methods:
+//
+//	func (o *otelObserver) ObserveSearch(ctx context.Context, provider, index, query string) func(int, error) {
+//		ctx, span := o.tracer.Start(ctx, "stddata.Search", trace.WithAttributes(
+//			attribute.String("stddata.provider", provider),
+//			attribute.String("stddata.index", index)))
+//		return func(matches int, err error) {
+//			if err != nil {
+//				span.RecordError(err)
+//			}
+//			o.searches.Add(ctx, 1, metric.WithAttributes(
+//				attribute.String("stddata.provider", provider),
+//				attribute.String("stddata.index", index),
+//				attribute.Bool("stddata.hit", matches > 0)))
+//			span.End()
+//		}
+//	}
+//
+// The methods of an Observer may be called concurrently.
+type Observer interface {
+	// ObserveLoad is called as the Provider named provider, the
+	// EntityName of the Service, starts to load. It returns the
+	// function that is called when the load is done, with the number
+	// of items loaded, and the error of the load.
+	ObserveLoad(ctx context.Context, provider string) (done func(n int, err error))
+	// ObserveSearch is called as a search of index for query starts.
+	// It returns the function that is called when the search is done,
+	// with the number of matches, as an Envelope counts them, and the
+	// error of the search.
+	ObserveSearch(ctx context.Context, provider string, index string, query string) (done func(matches int, err error))
+}
+
+// RefreshObserver is told of the refreshes of a Provider's data that
+// are not loads by a Service: the refreshes of country.LoadRefresh and
+// the changes of bank.ApplyChanges. A Metrics is a RefreshObserver.
+type RefreshObserver interface {
+	// ObserveRefresh is called as the data of the Provider named
+	// provider starts to be refreshed. It returns the function that is
+	// called when the refresh is done, with its error.
+	ObserveRefresh(ctx context.Context, provider string) (done func(err error))
+}
+
+// observeLoad tells the Observer of s, if it has one, that the load of
+// its Provider starts, and returns the function that tells it the load
+// is done.
+func (s *Service) observeLoad(ctx context.Context) func(n int, err error) {
+	if s.Observer == nil {
+		return func(int, error) {}
+	}
+	return s.Observer.ObserveLoad(ctx, s.EntityName)
+}
+
+// observeSearch tells the Observer of s, if it has one, that a search
+// starts, and returns the function that tells it the search is done,
+// with its result.
+func (s *Service) observeSearch(ctx context.Context, index string, query string) func(result interface{}, err error) {
+	if s.Observer == nil {
+		return func(interface{}, error) {}
+	}
+	done := s.Observer.ObserveSearch(ctx, s.EntityName, index, query)
+	return func(result interface{}, err error) {
+		matches := 0
+		if err == nil {
+			matches, _ = total(result)
+		}
+		done(matches, err)
+	}
+}
@@ -0,0 +1,121 @@
+package postal
+
+// provenance of postaldata, reported by Info
+const (
+	source    = "Postal code formats, as given by Google's address metadata for libaddressinput"
+	sourceURL = "https://chromium-i18n.appspot.com/ssl-address"
+	edition   = "2024"
+)
+
+/*
+postaldata holds the formats of the postal codes of the countries
+that address forms most often need, and of the countries that have
+no postal codes. The fields of each record are tab-delimited: the
+ISO 3166-1 alpha-2 code of the country, the name that the country
+gives its postal codes, the pattern of a postal code, which is a
+regular expression in the syntax of the regexp package, and an
+example. A country that has no postal codes has no label, pattern
+or example.
+*/
+var postaldata = `AD	Codi postal	AD[1-7]0\d	AD100
+AE			
+AG			
+AM	Postal code	(?:37)?\d{4}	375010
+AO			
+AR	Código postal	(?:[A-HJ-NP-Z])?\d{4}(?:[A-Z]{3})?	C1070AAM
+AT	PLZ	\d{4}	1010
+AU	Postcode	\d{4}	2060
+AZ	Postal code	(?:AZ ?)?\d{4}	AZ 1000
+BA	Poštanski broj	\d{5}	71000
+BD	Postal code	\d{4}	1340
+BE	Postcode	\d{4}	4000
+BG	Пощенски код	\d{4}	1000
+BN	Postcode	[A-Z]{2} ?\d{4}	BT2328
+BR	CEP	\d{5}-?\d{3}	40301-110
+BS			
+BY	Почтовый индекс	\d{6}	223016
+BZ			
+CA	Postal code	[ABCEGHJKLMNPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d	H3Z 2Y7
+CH	PLZ	\d{4}	2544
+CL	Código postal	\d{7}	8340457
+CN	邮政编码	\d{6}	266033
+CO	Código postal	\d{6}	111221
+CR	Código postal	\d{4,5}|\d{3}-\d{4}	1000
+CY	Postal code	\d{4}	2008
+CZ	PSČ	\d{3} ?\d{2}	100 00
+DE	PLZ	\d{5}	26133
+DK	Postnummer	\d{4}	8660
+DZ	Code postal	\d{5}	40304
+EC	Código postal	\d{6}	090105
+EE	Sihtnumber	\d{5}	69501
+EG	Postal code	\d{5}	12411
+ES	Código postal	\d{5}	28039
+FI	Postinumero	\d{5}	00550
+FJ			
+FO	Postnummer	\d{3}	100
+FR	Code postal	\d{2} ?\d{3}	33380
+GB	Postcode	[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}|GIR ?0AA	SW1A 1AA
+GD			
+GE	Postal code	\d{4}	0101
+GR	Τ.Κ.	\d{3} ?\d{2}	151 24
+GT	Código postal	\d{5}	09001
+HK			
+HR	Poštanski broj	\d{5}	10000
+HU	Irányítószám	\d{4}	1037
+ID	Kode pos	\d{5}	40115
+IE	Eircode	[\dA-Z]{3} ?[\dA-Z]{4}	A65 F4E2
+IL	Postal code	\d{5}(?:\d{2})?	9614303
+IN	PIN code	\d{6}	110034
+IS	Póstnúmer	\d{3}	320
+IT	CAP	\d{5}	00144
+JP	郵便番号	\d{3}-?\d{4}	154-0023
+KE	Postal code	\d{5}	20100
+KN			
+KR	우편번호	\d{5}	03051
+KZ	Почтовый индекс	\d{6}	040900
+LI	PLZ	948[5-9]|949[0-8]	9496
+LK	Postal code	\d{5}	20000
+LT	Pašto kodas	(?:LT-)?\d{5}	LT-04340
+LU	Code postal	(?:L-)?\d{4}	4750
+LV	Pasta indekss	LV-\d{4}	LV-1073
+MA	Code postal	\d{5}	53000
+MC	Code postal	980\d{2}	98000
+MD	Cod poștal	(?:MD-?)?\d{4}	2012
+MK	Поштенски код	\d{4}	1314
+MO			
+MT	Postcode	[A-Z]{3} ?\d{2,4}	NXR 01
+MX	Código postal	\d{5}	02860
+MY	Poskod	\d{5}	43000
+NG	Postal code	\d{6}	930283
+NL	Postcode	\d{4} ?[A-Z]{2}	1234 AB
+NO	Postnummer	\d{4}	0025
+NP	Postal code	\d{5}	44601
+NZ	Postcode	\d{4}	6001
+PE	Código postal	(?:LIMA \d{1,2}|CALLAO 0?\d)|[0-2]\d{4}	15001
+PH	ZIP code	\d{4}	1008
+PK	Postal code	\d{5}	44000
+PL	Kod pocztowy	\d{2}-\d{3}	00-950
+PR	ZIP Code	00[679]\d{2}(?:[ \-]\d{4})?	00930
+PT	Código postal	\d{4}-\d{3}	2725-079
+QA			
+RO	Cod poștal	\d{6}	060274
+RS	Поштански број	\d{5,6}	106314
+RU	Почтовый индекс	\d{6}	247112
+SA	Postal code	\d{5}(?:-\d{4})?	11564
+SB			
+SE	Postnummer	\d{3} ?\d{2}	114 55
+SG	Postal code	\d{6}	546080
+SI	Poštna številka	(?:SI-)?\d{4}	4000
+SK	PSČ	\d{3} ?\d{2}	010 01
+TH	รหัสไปรษณีย์	\d{5}	10150
+TN	Code postal	\d{4}	1002
+TR	Posta kodu	\d{5}	01960
+TV			
+TW	郵遞區號	\d{3}(?:\d{2,3})?	104
+UA	Поштовий індекс	\d{5}	15432
+US	ZIP Code	\d{5}(?:[ \-]\d{4})?	95014
+UY	Código postal	\d{5}	11600
+VN	Mã bưu chính	\d{5}\d?	70010
+ZA	Postal code	\d{4}	0083
+ZW			
+`
@@ -0,0 +1,310 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package postal implements the methods of a stddata.Provider.
+It provides searches against the formats of the postal codes of
+countries: the name each country gives them, such as "ZIP Code",
+"Postcode" or "PLZ", their pattern and an example, keyed by the ISO
+3166-1 alpha-2 code of the country, so that an address form can
+label, check and hint its postal code field for the country chosen
+in it, or leave the field out for a country that has no postal
+codes. Source data is declared in postaldata.go.
+*/
+package postal
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// PostalProvider implements the Provider interface.
+type PostalProvider struct {
+	data atomic.Pointer[postalData] // nil until the data is loaded
+}
+
+// postalData is the data of a PostalProvider. Once it is stored in the
+// PostalProvider it is not modified.
+type postalData struct {
+	size          int
+	info          stddata.Info
+	formatIndexes map[string]formatIndex
+	// patterns holds the compiled pattern of each country, anchored
+	// to match a whole postal code.
+	patterns map[string]*regexp.Regexp
+}
+
+type formatIndex struct {
+	formatMap  map[string][]Format
+	formatKeys []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Format models one entity.
+type Format struct {
+	CountryCode string // ISO 3166-1 alpha-2 code, for example "DE"
+	Label       string // the name of the postal code in the country, for example "PLZ"
+	// Pattern is a regular expression that matches the postal codes
+	// of the country, for example `\d{5}`. It is empty for a country
+	// that has no postal codes.
+	Pattern string
+	Example string // for example "26133"
+}
+
+// FormatResult is the interface{} that is returned from Search
+type FormatResult struct {
+	Formats [][]Format
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *PostalProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *PostalProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &postalData{formatIndexes: make(map[string]formatIndex)}
+	d.patterns = make(map[string]*regexp.Regexp)
+	countryMap := make(map[string][]Format)
+	labelMap := make(map[string][]Format)
+
+	reader := csv.NewReader(strings.NewReader(postaldata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 4
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var f Format
+		f.CountryCode = record[0]
+		f.Label = record[1]
+		f.Pattern = record[2]
+		f.Example = record[3]
+		var re *regexp.Regexp
+		if f.Pattern != "" {
+			re, err = regexp.Compile("^(?:" + f.Pattern + ")$")
+		}
+		if err != nil || re != nil && !re.MatchString(f.Example) {
+			line, _ := reader.FieldPos(2)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed postal code pattern %q", line, f.Pattern)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Format to the maps
+		countryMap[f.CountryCode] = append(countryMap[f.CountryCode], f)
+		if f.Label != "" {
+			labelMap[f.Label] = append(labelMap[f.Label], f)
+		}
+		d.patterns[f.CountryCode] = re
+	}
+	p.storeData(d, "country", countryMap)
+	p.storeData(d, "label", labelMap)
+	d.size = len(countryMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(countryMap)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *PostalProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Format of the postal codes of the country whose
+// alpha-2 code is alpha2, in any case.
+func (p *PostalProvider) Get(alpha2 string) (f Format, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return f, stddata.ErrNotLoaded
+	}
+	return d.get(alpha2)
+}
+
+// get returns the Format of the country whose alpha-2 code is alpha2.
+func (d *postalData) get(alpha2 string) (f Format, err error) {
+	formats, found := d.formatIndexes["country"].formatMap[strings.ToUpper(alpha2)]
+	if !found {
+		msg := "No postal code format for country " + alpha2
+		return f, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return formats[0], nil
+}
+
+// Validate checks postalCode against the format of the postal codes of
+// the country whose alpha-2 code is alpha2. The postal code is read in
+// any case, without leading or trailing spaces. For a country that has
+// no postal codes, only an empty postal code is valid. An error is
+// returned for a country whose format is not known.
+func (p *PostalProvider) Validate(alpha2 string, postalCode string) error {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return stddata.ErrNotLoaded
+	}
+	f, err := d.get(alpha2)
+	if err != nil {
+		return err
+	}
+	code := strings.ToUpper(strings.TrimSpace(postalCode))
+	re := d.patterns[f.CountryCode]
+	if re == nil {
+		if code != "" {
+			msg := "Country " + f.CountryCode + " has no postal codes"
+			return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+		}
+		return nil
+	}
+	if !re.MatchString(code) {
+		msg := "Postal code " + postalCode + " is not valid in country " + f.CountryCode + ", for example " + f.Example
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	return nil
+}
+
+func (p *PostalProvider) storeData(d *postalData, s string, m map[string][]Format) {
+	// store the map
+	var fi formatIndex
+	fi.formatMap = m
+	// extract the keys
+	fi.formatKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		fi.formatKeys[i] = k
+		i++
+	}
+	// sort the keys
+	fi.foldedKeys = stddata.SortKeys(fi.formatKeys)
+	// add to formatIndexes
+	d.formatIndexes[s] = fi
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *PostalProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "country", Description: "ISO 3166-1 alpha-2 code of the country", Example: "DE"},
+		{Name: "label", Description: "name of the postal code in the country", Example: "PLZ"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *PostalProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	fi := d.formatIndexes[index]
+	return stddata.Suggest(fi.formatKeys, fi.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Format entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Formats are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+func (p *PostalProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	fi, found := d.formatIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.formatIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(fi, query)
+	return result, nil
+}
+
+// SearchFormats is Search, returning the matches as they are, rather
+// than as a FormatResult in an interface{}.
+func (p *PostalProvider) SearchFormats(index string, query string) ([][]Format, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(FormatResult).Formats, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Formats of each. It yields nothing if the
+// PostalProvider has no such index, or is not loaded.
+func (p *PostalProvider) Range(index string) iter.Seq2[string, []Format] {
+	return func(yield func(string, []Format) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		fi := d.formatIndexes[index]
+		for _, k := range fi.formatKeys {
+			if !yield(k, fi.formatMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(fi formatIndex, query string) (res FormatResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(fi.formatKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(fi.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Formats = make([][]Format, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Formats = append(res.Formats, fi.formatMap[fi.formatKeys[k]])
+	}
+	return res
+}
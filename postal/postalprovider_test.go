@@ -0,0 +1,88 @@
+package postal
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestPostalProvider(t *testing.T) {
+	expected := 101
+	fmt.Println("Test: PostalProvider.Load")
+	p = new(PostalProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestLabelSearch(t *testing.T) {
+	res, err := p.Search("label", "PLZ")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if f := res.(FormatResult).Formats; len(f) != 1 || len(f[0]) != 4 {
+		t.Fatalf("Expected the 4 countries of the PLZ, got %v\n", f)
+	}
+}
+func TestGet(t *testing.T) {
+	pp := p.(*PostalProvider)
+	f, err := pp.Get("us")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if f.Label != "ZIP Code" || f.Example != "95014" {
+		t.Fatalf("Expected the ZIP Code, got %v\n", f)
+	}
+	if _, err = pp.Get("XX"); err == nil {
+		t.Fatalf("Expected no format for XX\n")
+	}
+}
+func TestValidate(t *testing.T) {
+	pp := p.(*PostalProvider)
+	for _, c := range []struct{ country, code string }{
+		{"US", "95014"},
+		{"US", "95014-1234"},
+		{"GB", "sw1a 1aa"},
+		{"GB", "EC1A1BB"},
+		{"CA", " H3Z 2Y7 "},
+		{"NL", "1234 AB"},
+		{"DE", "01067"},
+		{"HK", ""},
+	} {
+		if err := pp.Validate(c.country, c.code); err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+	}
+	for _, c := range []struct{ country, code string }{
+		{"US", "9501"},
+		{"DE", "123456"},
+		{"CA", "D3Z 2Y7"},
+		{"HK", "999077"},
+		{"XX", "12345"},
+	} {
+		if err := pp.Validate(c.country, c.code); err == nil {
+			t.Fatalf("Expected %q to be invalid in %s\n", c.code, c.country)
+		}
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(PostalProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(PostalProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "country", Query: "DE"}, {Index: "label", Query: "PLZ"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
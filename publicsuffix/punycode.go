@@ -0,0 +1,104 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"errors"
+	"strings"
+)
+
+// the parameters of Punycode, from RFC 3492, section 5.
+const (
+	base        = 36
+	tmin        = 1
+	tmax        = 26
+	skew        = 38
+	damp        = 700
+	initialBias = 72
+	initialN    = 128
+)
+
+var errPunycode = errors.New("malformed Punycode")
+
+// decodePunycode decodes s, the Punycode of an internationalized
+// label without its "xn--" prefix, for example "p1ai" for "рф", as
+// RFC 3492, section 6.2, describes.
+func decodePunycode(s string) (string, error) {
+	var output []rune
+	pos := 0
+	if b := strings.LastIndexByte(s, '-'); b >= 0 {
+		for i := 0; i < b; i++ {
+			if s[i] >= 0x80 {
+				return "", errPunycode
+			}
+			output = append(output, rune(s[i]))
+		}
+		pos = b + 1
+	}
+	n, i, bias := initialN, 0, initialBias
+	for pos < len(s) {
+		oldi, w := i, 1
+		for k := base; ; k += base {
+			if pos == len(s) {
+				return "", errPunycode
+			}
+			digit := decodeDigit(s[pos])
+			pos++
+			if digit < 0 || digit > (1<<31-1-i)/w {
+				return "", errPunycode
+			}
+			i += digit * w
+			t := k - bias
+			if t < tmin {
+				t = tmin
+			} else if t > tmax {
+				t = tmax
+			}
+			if digit < t {
+				break
+			}
+			w *= base - t
+		}
+		l := len(output) + 1
+		bias = adapt(i-oldi, l, oldi == 0)
+		n += i / l
+		i %= l
+		if n > 0x10FFFF {
+			return "", errPunycode
+		}
+		output = append(output, 0)
+		copy(output[i+1:], output[i:])
+		output[i] = rune(n)
+		i++
+	}
+	return string(output), nil
+}
+
+func decodeDigit(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c-'0') + 26
+	case c >= 'a' && c <= 'z':
+		return int(c - 'a')
+	case c >= 'A' && c <= 'Z':
+		return int(c - 'A')
+	}
+	return -1
+}
+
+func adapt(delta int, numPoints int, first bool) int {
+	if first {
+		delta /= damp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := 0
+	for delta > ((base-tmin)*tmax)/2 {
+		delta /= base - tmin
+		k += base
+	}
+	return k + (base-tmin+1)*delta/(delta+skew)
+}
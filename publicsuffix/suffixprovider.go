@@ -0,0 +1,453 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package publicsuffix implements the methods of a stddata.Provider.
+It provides searches against the rules of the Public Suffix List,
+the domains under which anyone may register names, such as "com",
+"co.uk" and "github.io", and applies them: EffectiveTLDPlusOne finds
+the registered domain of a host name, such as "example.co.uk" for
+"www.example.co.uk", and IsPublicSuffix reports whether a domain is
+itself a public suffix, which must not be given cookies or treated
+as one site. A snapshot of the list is declared in suffixdata.go,
+and LoadRefresh replaces it with the current list.
+*/
+package publicsuffix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// The kinds of rule, as the list writes them.
+const (
+	Normal    = "normal"    // for example "co.uk"
+	Wildcard  = "wildcard"  // for example "*.ck", every child of ck
+	Exception = "exception" // for example "!www.ck", an exception to a wildcard
+)
+
+// The sections of the list.
+const (
+	ICANN   = "ICANN"   // the suffixes delegated by the root zone and its registries
+	Private = "PRIVATE" // the suffixes that companies offer for others to register under
+)
+
+// RefreshURL is the default source of the current list for
+// LoadRefresh.
+const RefreshURL = "https://publicsuffix.org/list/public_suffix_list.dat"
+
+// SuffixProvider implements the Provider interface.
+type SuffixProvider struct {
+	data atomic.Pointer[suffixData] // nil until the data is loaded
+}
+
+// suffixData is the data of a SuffixProvider. Once it is stored in the
+// SuffixProvider it is not modified.
+type suffixData struct {
+	size        int
+	info        stddata.Info
+	ruleIndexes map[string]ruleIndex
+}
+
+type ruleIndex struct {
+	ruleMap    map[string][]Rule
+	ruleKeys   []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Rule models one entity.
+type Rule struct {
+	Rule    string // as the list writes it, for example "co.uk", "*.ck" or "!www.ck"
+	Kind    string // Normal, Wildcard or Exception
+	Section string // ICANN or Private
+}
+
+// RuleResult is the interface{} that is returned from Search
+type RuleResult struct {
+	Rules [][]Rule
+}
+
+// Load implements the Loader interface. It loads the embedded
+// snapshot of the list. A malformed rule causes Load to fail.
+func (p *SuffixProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the embedded snapshot of the list, treating
+// malformed rules according to mode. In stddata.Lenient mode,
+// malformed rules are skipped, and their line numbers are returned
+// in the LoadReport.
+func (p *SuffixProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	return p.read(strings.NewReader(suffixdata), mode, stddata.Info{Source: source, URL: sourceURL, Edition: edition})
+}
+
+// LoadFrom reads a list from data, in the format that publicsuffix.org
+// publishes. Only the first word of a line is read, and comments are
+// passed over, except the lines that mark the sections and the
+// VERSION line, which gives the edition.
+func (p *SuffixProvider) LoadFrom(data io.Reader, mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	return p.read(data, mode, stddata.Info{Source: source})
+}
+
+// read loads a list from data, whose provenance is info. The edition
+// of info, when it is empty, is that of the VERSION line.
+func (p *SuffixProvider) read(data io.Reader, mode stddata.ParseMode, info stddata.Info) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &suffixData{ruleIndexes: make(map[string]ruleIndex)}
+	ruleMap := make(map[string][]Rule)
+	kindMap := make(map[string][]Rule)
+	sectionMap := make(map[string][]Rule)
+	tldMap := make(map[string][]Rule)
+
+	scanner := bufio.NewScanner(data)
+	section := ICANN
+	version := ""
+	line := 0
+	for scanner.Scan() {
+		line++
+		s := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(s, "//") {
+			switch {
+			case strings.Contains(s, "===BEGIN ICANN DOMAINS==="):
+				section = ICANN
+			case strings.Contains(s, "===BEGIN PRIVATE DOMAINS==="):
+				section = Private
+			case strings.HasPrefix(s, "// VERSION:"):
+				version = strings.TrimSpace(strings.TrimPrefix(s, "// VERSION:"))
+			}
+			continue
+		}
+		if s == "" {
+			continue
+		}
+
+		var u Rule
+		u.Rule = strings.ToLower(strings.Fields(s)[0])
+		u.Section = section
+		name := u.Rule
+		switch {
+		case strings.HasPrefix(name, "!"):
+			u.Kind = Exception
+			name = name[1:]
+		case strings.HasPrefix(name, "*."):
+			u.Kind = Wildcard
+			name = name[2:]
+		default:
+			u.Kind = Normal
+		}
+		if !isName(name) || u.Kind == Exception && !strings.Contains(name, ".") {
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed rule %q", line, u.Rule)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Rule to the maps
+		ruleMap[u.Rule] = append(ruleMap[u.Rule], u)
+		kindMap[u.Kind] = append(kindMap[u.Kind], u)
+		sectionMap[u.Section] = append(sectionMap[u.Section], u)
+		tld := name[strings.LastIndex(name, ".")+1:]
+		tldMap[tld] = append(tldMap[tld], u)
+	}
+	if err = scanner.Err(); err != nil {
+		return r, stddata.NewSourceError(err.Error(), err)
+	}
+	p.storeData(d, "rule", ruleMap)
+	p.storeData(d, "kind", kindMap)
+	p.storeData(d, "section", sectionMap)
+	p.storeData(d, "tld", tldMap)
+	d.size = len(ruleMap)
+	d.info = info
+	if d.info.Edition == "" {
+		d.info.Edition = version
+	}
+	d.info.Count = d.size
+	d.info.LoadedAt = time.Now()
+	p.data.Store(d)
+	r.Loaded = len(ruleMap)
+	return r, nil
+}
+
+// isName reports whether s is a domain name of labels without
+// wildcards or exceptions.
+func isName(s string) bool {
+	for _, label := range strings.Split(s, ".") {
+		if label == "" || strings.ContainsAny(label, "*! \t") {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadRefresh fetches the current list from url, in the format that
+// publicsuffix.org publishes (see RefreshURL), and loads it in place
+// of the embedded snapshot. Malformed rules are skipped, as in
+// stddata.Lenient mode, and reported in the LoadReport. If the list
+// cannot be fetched or read, the embedded snapshot is loaded, as
+// Load loads it, and the error is returned.
+func (p *SuffixProvider) LoadRefresh(url string) (r stddata.LoadReport, err error) {
+	r, err = p.refresh(url)
+	if err != nil {
+		if _, lerr := p.Load(); lerr != nil {
+			return r, lerr
+		}
+		return r, err
+	}
+	return r, nil
+}
+
+func (p *SuffixProvider) refresh(url string) (r stddata.LoadReport, err error) {
+	res, err := http.Get(url)
+	if err != nil {
+		return r, stddata.NewSourceError(err.Error(), err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		msg := "Refresh from " + url + " failed: " + res.Status
+		return r, stddata.NewSourceError(msg, nil)
+	}
+	r, err = p.read(res.Body, stddata.Lenient, stddata.Info{Source: source, URL: url})
+	if err != nil {
+		return r, err
+	}
+	if r.Loaded == 0 {
+		msg := "Refresh from " + url + " failed: no rules"
+		return r, stddata.NewSourceError(msg, nil)
+	}
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *SuffixProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// PublicSuffix returns the public suffix of domain, by the rule of
+// the list that prevails for it, and whether that rule is in the
+// ICANN section. A domain that no rule matches has its top-level
+// domain as its public suffix, which is not in the ICANN section.
+// Domain is read in any case, with or without a trailing dot, and its
+// internationalized labels may be in their ASCII ("xn--p1ai") or
+// their Unicode ("рф") form; the suffix is returned as domain gives
+// it, in lower case.
+func (p *SuffixProvider) PublicSuffix(domain string) (suffix string, icann bool, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return "", false, stddata.ErrNotLoaded
+	}
+	labels, err := split(domain)
+	if err != nil {
+		return "", false, err
+	}
+	n, icann := d.match(labels)
+	return strings.Join(labels[len(labels)-n:], "."), icann, nil
+}
+
+// EffectiveTLDPlusOne returns the registered domain of domain: its
+// public suffix and the label before it, for example "example.co.uk"
+// for "www.example.co.uk". An error is returned for a domain that is
+// itself a public suffix, such as "co.uk".
+func (p *SuffixProvider) EffectiveTLDPlusOne(domain string) (string, error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return "", stddata.ErrNotLoaded
+	}
+	labels, err := split(domain)
+	if err != nil {
+		return "", err
+	}
+	n, _ := d.match(labels)
+	if n >= len(labels) {
+		msg := "Domain " + domain + " is a public suffix"
+		return "", stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	return strings.Join(labels[len(labels)-n-1:], "."), nil
+}
+
+// IsPublicSuffix reports whether domain is a public suffix, such as
+// "com", "co.uk" or "github.io", as PublicSuffix finds it.
+func (p *SuffixProvider) IsPublicSuffix(domain string) bool {
+	suffix, _, err := p.PublicSuffix(domain)
+	return err == nil && suffix == strings.ToLower(strings.TrimSuffix(domain, "."))
+}
+
+// split returns the labels of domain, in lower case.
+func split(domain string) ([]string, error) {
+	name := strings.ToLower(strings.TrimSuffix(domain, "."))
+	labels := strings.Split(name, ".")
+	for _, label := range labels {
+		if label == "" {
+			msg := "Domain " + domain + " has an empty label"
+			return nil, stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+		}
+	}
+	return labels, nil
+}
+
+// match returns the number of labels of the public suffix of the
+// domain of labels, by the prevailing rule: an exception rule if one
+// matches, otherwise the matching rule of the most labels, otherwise
+// the implicit rule "*". The rules are written with internationalized
+// labels in their Unicode form, so labels in their ASCII form are
+// decoded to match them.
+func (d *suffixData) match(labels []string) (n int, icann bool) {
+	names := make([]string, len(labels))
+	for i, label := range labels {
+		names[i] = label
+		if strings.HasPrefix(label, "xn--") {
+			if u, err := decodePunycode(label[4:]); err == nil {
+				names[i] = u
+			}
+		}
+	}
+	rules := d.ruleIndexes["rule"].ruleMap
+	// an exception rule prevails, and its suffix is the domain it
+	// names without its first label.
+	for i := range names {
+		if u, found := rules["!"+strings.Join(names[i:], ".")]; found {
+			return len(names) - i - 1, u[0].Section == ICANN
+		}
+	}
+	// otherwise the first match, from the longest suffix, has the
+	// most labels.
+	for i := range names {
+		name := strings.Join(names[i:], ".")
+		if u, found := rules["*."+name]; found && i > 0 {
+			return len(names) - i + 1, u[0].Section == ICANN
+		}
+		if u, found := rules[name]; found {
+			return len(names) - i, u[0].Section == ICANN
+		}
+	}
+	return 1, false
+}
+
+func (p *SuffixProvider) storeData(d *suffixData, s string, m map[string][]Rule) {
+	// store the map
+	var ri ruleIndex
+	ri.ruleMap = m
+	// extract the keys
+	ri.ruleKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		ri.ruleKeys[i] = k
+		i++
+	}
+	// sort the keys
+	ri.foldedKeys = stddata.SortKeys(ri.ruleKeys)
+	// add to ruleIndexes
+	d.ruleIndexes[s] = ri
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *SuffixProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "rule", Description: "rule, as the list writes it", Example: "co.uk"},
+		{Name: "kind", Description: "Normal, Wildcard or Exception", Example: "Wildcard"},
+		{Name: "section", Description: "ICANN or Private", Example: "Private"},
+		{Name: "tld", Description: "last label of the rule", Example: "uk"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *SuffixProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ri := d.ruleIndexes[index]
+	return stddata.Suggest(ri.ruleKeys, ri.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Rule entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Rules are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The tld index is keyed by the last label of each rule, so that, for example,
+// the rules under "uk" are found together.
+func (p *SuffixProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	ri, found := d.ruleIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.ruleIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(ri, query)
+	return result, nil
+}
+
+// SearchRules is Search, returning the matches as they are, rather
+// than as a RuleResult in an interface{}.
+func (p *SuffixProvider) SearchRules(index string, query string) ([][]Rule, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(RuleResult).Rules, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Rules of each. It yields nothing if the
+// SuffixProvider has no such index, or is not loaded.
+func (p *SuffixProvider) Range(index string) iter.Seq2[string, []Rule] {
+	return func(yield func(string, []Rule) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ri := d.ruleIndexes[index]
+		for _, k := range ri.ruleKeys {
+			if !yield(k, ri.ruleMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(ri ruleIndex, query string) (res RuleResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ri.ruleKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ri.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Rules = make([][]Rule, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Rules = append(res.Rules, ri.ruleMap[ri.ruleKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,148 @@
+package publicsuffix
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestSuffixProvider(t *testing.T) {
+	expected := 9506
+	fmt.Println("Test: SuffixProvider.Load")
+	p = new(SuffixProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestKindSearch(t *testing.T) {
+	res, err := p.Search("kind", "exception")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r := res.(RuleResult).Rules; len(r) != 1 || len(r[0]) != 8 {
+		t.Fatalf("Expected 8 exception rules, got %v\n", r)
+	}
+	res, err = p.Search("rule", "github.io")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r := res.(RuleResult).Rules; len(r) != 1 || r[0][0].Section != Private {
+		t.Fatalf("Expected github.io in the private section, got %v\n", r)
+	}
+}
+func TestEffectiveTLDPlusOne(t *testing.T) {
+	sp := p.(*SuffixProvider)
+	for domain, expected := range map[string]string{
+		"www.example.co.uk":         "example.co.uk",
+		"WWW.Example.COM.":          "example.com",
+		"foo.bar.github.io":         "bar.github.io",
+		"a.b.c.kawasaki.jp":         "b.c.kawasaki.jp",
+		"a.city.kawasaki.jp":        "city.kawasaki.jp",
+		"www.ck":                    "www.ck",
+		"www.пример.рф":             "пример.рф",
+		"www.xn--e1afmkfd.xn--p1ai": "xn--e1afmkfd.xn--p1ai",
+		"example.nosuchtld":         "example.nosuchtld",
+	} {
+		d, err := sp.EffectiveTLDPlusOne(domain)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if d != expected {
+			t.Fatalf("Expected %s for %s, got %s\n", expected, domain, d)
+		}
+	}
+	for _, domain := range []string{"co.uk", "com", "foo.ck", "example..com", ""} {
+		if d, err := sp.EffectiveTLDPlusOne(domain); err == nil {
+			t.Fatalf("Expected no registered domain for %q, got %s\n", domain, d)
+		}
+	}
+}
+func TestIsPublicSuffix(t *testing.T) {
+	sp := p.(*SuffixProvider)
+	for _, domain := range []string{"com", "co.uk", "github.io", "xn--p1ai", "рф", "foo.ck"} {
+		if !sp.IsPublicSuffix(domain) {
+			t.Fatalf("Expected %s to be a public suffix\n", domain)
+		}
+	}
+	for _, domain := range []string{"example.com", "www.ck", "city.kawasaki.jp"} {
+		if sp.IsPublicSuffix(domain) {
+			t.Fatalf("Expected %s not to be a public suffix\n", domain)
+		}
+	}
+	suffix, icann, err := sp.PublicSuffix("foo.blogspot.com")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if suffix != "blogspot.com" || icann {
+		t.Fatalf("Expected the private suffix blogspot.com, got %s %v\n", suffix, icann)
+	}
+}
+func TestDecodePunycode(t *testing.T) {
+	for s, expected := range map[string]string{
+		"p1ai":       "рф",
+		"e1afmkfd":   "пример",
+		"bcher-kva":  "bücher",
+		"mnchen-3ya": "münchen",
+		"fiqs8s":     "中国",
+	} {
+		u, err := decodePunycode(s)
+		if err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if u != expected {
+			t.Fatalf("Expected %s for %s, got %s\n", expected, s, u)
+		}
+	}
+}
+func TestLoadRefresh(t *testing.T) {
+	list := "// VERSION: 2025-01-01\n// ===BEGIN ICANN DOMAINS===\ncom\nco.uk\n// ===END ICANN DOMAINS===\n// ===BEGIN PRIVATE DOMAINS===\nexample.com\n*.bad rule\n// ===END PRIVATE DOMAINS===\n"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/list" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, list)
+	}))
+	defer ts.Close()
+	sp := new(SuffixProvider)
+	r, err := sp.LoadRefresh(ts.URL + "/list")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if r.Loaded != 4 || sp.Info().Edition != "2025-01-01" || sp.Info().URL != ts.URL+"/list" {
+		t.Fatalf("Expected the refreshed list, got %v %v\n", r, sp.Info())
+	}
+	if d, _ := sp.EffectiveTLDPlusOne("www.foo.example.com"); d != "foo.example.com" {
+		t.Fatalf("Expected foo.example.com, got %s\n", d)
+	}
+	if _, err = sp.LoadRefresh(ts.URL + "/missing"); err == nil {
+		t.Fatalf("Expected the refresh to fail\n")
+	}
+	if sp.Info().Count != 9506 || !sp.IsPublicSuffix("github.io") {
+		t.Fatalf("Expected the embedded snapshot after a failed refresh, got %v\n", sp.Info())
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(SuffixProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(SuffixProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "rule", Query: "github.io"}, {Index: "kind", Query: "exception"}, {Index: "tld", Query: "uk"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
@@ -0,0 +1,133 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stddata
+
+import "strings"
+
+// IndexOptions selects how the indexes of a Provider that accepts them
+// are searched. The zero IndexOptions binary searches every index,
+// and does not limit the results.
+type IndexOptions struct {
+	// Radix names the indexes that are searched with a radix tree,
+	// for which a query costs O(len(query)), rather than by binary
+	// search, which costs O(log n). The tree costs a few words per key
+	// more memory, so it suits the large indexes of large providers.
+	Radix []string
+	// Limit is the most keys a search returns, the first in the order
+	// of the index, or 0 for no limit. A "_dump" is not limited.
+	Limit int
+}
+
+// UseRadix reports whether index is to be searched with a radix tree.
+func (o IndexOptions) UseRadix(index string) bool {
+	for _, s := range o.Radix {
+		if s == index {
+			return true
+		}
+	}
+	return false
+}
+
+// Top limits the bounds of the keys that match a search, lo inclusive
+// and hi exclusive, to the first Limit keys.
+func (o IndexOptions) Top(lo int, hi int) (int, int) {
+	if o.Limit > 0 && hi-lo > o.Limit {
+		hi = lo + o.Limit
+	}
+	return lo, hi
+}
+
+// Radix is a radix tree of the lower case keys of an index, which
+// finds the keys that begin with a query. Because the keys are sorted,
+// those below each node of the tree are adjacent, so each node holds
+// just their bounds, and the labels of its edges are slices of the
+// keys themselves.
+type Radix struct {
+	root *radixNode
+}
+
+type radixNode struct {
+	label    string // the bytes from the parent, a slice of a key
+	lo, hi   int    // the bounds of the keys below the node
+	children []*radixNode
+}
+
+// NewRadix returns the radix tree of folded, the lower case keys
+// returned by SortKeys.
+func NewRadix(folded []string) *Radix {
+	t := new(Radix)
+	if len(folded) > 0 {
+		t.root = buildRadix(folded, 0, len(folded), 0)
+	}
+	return t
+}
+
+// buildRadix returns the node for the keys from lo to hi, which share
+// their first depth bytes.
+func buildRadix(folded []string, lo int, hi int, depth int) *radixNode {
+	// the keys are sorted, so the prefix that the first and the last
+	// share is the one that all of them share.
+	first, last := folded[lo], folded[hi-1]
+	end := depth
+	for end < len(first) && end < len(last) && first[end] == last[end] {
+		end++
+	}
+	n := &radixNode{label: first[depth:end], lo: lo, hi: hi}
+	// the keys that end at the node come first, then those that go on,
+	// grouped by their next byte.
+	k := lo
+	for k < hi && len(folded[k]) == end {
+		k++
+	}
+	for k < hi {
+		c := folded[k][end]
+		j := k + 1
+		for j < hi && folded[j][end] == c {
+			j++
+		}
+		n.children = append(n.children, buildRadix(folded, k, j, end))
+		k = j
+	}
+	return n
+}
+
+// PrefixRange returns the bounds, lo inclusive and hi exclusive, of the
+// keys that begin with query, in any case, as stddata.PrefixRange does.
+func (t *Radix) PrefixRange(query string) (lo int, hi int) {
+	q := strings.ToLower(query)
+	n := t.root
+	for n != nil {
+		if len(q) <= len(n.label) {
+			if strings.HasPrefix(n.label, q) {
+				return n.lo, n.hi
+			}
+			break
+		}
+		if !strings.HasPrefix(q, n.label) {
+			break
+		}
+		q = q[len(n.label):]
+		var next *radixNode
+		for _, c := range n.children {
+			if c.label[0] == q[0] {
+				next = c
+				break
+			}
+		}
+		n = next
+	}
+	return 0, 0
+}
+
+// Walk calls fn with the position of each key that begins with query,
+// in the order of the keys, until fn returns false.
+func (t *Radix) Walk(query string, fn func(k int) bool) {
+	lo, hi := t.PrefixRange(query)
+	for k := lo; k < hi; k++ {
+		if !fn(k) {
+			return
+		}
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package rediscache shares the data of loaded stddata Providers between
+the instances of an application through Redis: one instance loads a
+Provider and writes its indexes into Redis with Write, and the others
+search them there, through a Provider of this package, without loading
+the data themselves, or again when they restart.
+
+For a Provider named "country", the index alpha2 is held in two Redis
+keys: the hash stddata:country:alpha2, of the keys of the index and the
+JSON of their entities, and the sorted set stddata:country:alpha2:keys,
+of the keys in lower case, each followed by a NUL and the key, which is
+searched by ZRANGEBYLEX for the keys that begin with a query, in any
+case, as the Providers search their indexes. The set
+stddata:country:indexes names the indexes.
+
+The package has no Redis client of its own: a Conn is the Do method of
+the connections of the common clients.
+*/
+package rediscache
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/musicbeat/stddata"
+)
+
+// Conn sends a command to Redis and returns its reply, as the Do method
+// of a redigo redis.Conn does: a bulk string as a []byte or a string,
+// an integer as an int64, an array as an []interface{}, and a missing
+// value as nil. A Provider may search concurrently, so its Conn must
+// be safe for concurrent use, as a ConnFunc that takes a connection
+// from a pool for each command is.
+type Conn interface {
+	Do(command string, args ...interface{}) (reply interface{}, err error)
+}
+
+// ConnFunc adapts a function to a Conn.
+type ConnFunc func(command string, args ...interface{}) (reply interface{}, err error)
+
+// Do implements Conn by calling f.
+func (f ConnFunc) Do(command string, args ...interface{}) (reply interface{}, err error) {
+	return f(command, args...)
+}
+
+// Cache is where the data of Providers is held in Redis.
+type Cache struct {
+	Conn Conn
+	// Prefix begins the Redis keys of the Cache, "stddata" if it is
+	// empty, so that several Caches can share a Redis database.
+	Prefix string
+}
+
+// batch is the most keys that are sent to Redis in one command.
+const batch = 1000
+
+// key returns the Redis key of the parts, such as a Provider and one of
+// its indexes.
+func (c *Cache) key(parts ...string) string {
+	prefix := c.Prefix
+	if prefix == "" {
+		prefix = "stddata"
+	}
+	return prefix + ":" + strings.Join(parts, ":")
+}
+
+// Write writes the indexes of p, a loaded Provider named name, such as
+// "country", into Redis, replacing those written before, and returns
+// the number of keys written. p must describe its indexes, as an
+// stddata.IndexLister does, and range over them, as the Range methods of
+// the Providers of stddata do. Each index is written under temporary
+// keys, which are then renamed, so that a search does not see an index
+// that is partly written.
+func (c *Cache) Write(name string, p stddata.Provider) (n int, err error) {
+	lister, ok := p.(stddata.IndexLister)
+	var rng reflect.Value
+	if ok {
+		rng = reflect.ValueOf(p).MethodByName("Range")
+	}
+	// Range(index string) iter.Seq2[string, []T]
+	if !rng.IsValid() || rng.Type().NumIn() != 1 || rng.Type().NumOut() != 1 || rng.Type().Out(0).Kind() != reflect.Func {
+		return 0, errors.New("Cannot write " + name + ", which does not describe and range over its indexes")
+	}
+	yieldType := rng.Type().Out(0).In(0)
+
+	var names []interface{}
+	for _, d := range lister.Indexes() {
+		hash, keys := c.key(name, d.Name), c.key(name, d.Name, "keys")
+		fields := []interface{}{hash + "~"}
+		members := []interface{}{keys + "~"}
+		flush := func(force bool) error {
+			if len(fields) > 1 && (force || len(fields) > 2*batch) {
+				if _, err := c.Conn.Do("HSET", fields...); err != nil {
+					return err
+				}
+				if _, err := c.Conn.Do("ZADD", members...); err != nil {
+					return err
+				}
+				fields, members = fields[:1], members[:1]
+			}
+			return nil
+		}
+		if _, err := c.Conn.Do("DEL", hash+"~", keys+"~"); err != nil {
+			return n, err
+		}
+		written := n
+		yield := reflect.MakeFunc(yieldType, func(in []reflect.Value) []reflect.Value {
+			key := in[0].String()
+			var b []byte
+			if b, err = json.Marshal(in[1].Interface()); err == nil {
+				fields = append(fields, key, b)
+				members = append(members, 0, strings.ToLower(key)+"\x00"+key)
+				n++
+				err = flush(false)
+			}
+			return []reflect.Value{reflect.ValueOf(err == nil)}
+		})
+		rng.Call([]reflect.Value{reflect.ValueOf(d.Name)})[0].Call([]reflect.Value{yield})
+		if err == nil {
+			err = flush(true)
+		}
+		if err != nil {
+			return n, err
+		}
+		// RENAME replaces the index that was written before at once, but
+		// an index with no keys has no temporary keys to rename
+		if n == written {
+			if _, err := c.Conn.Do("DEL", hash, keys); err != nil {
+				return n, err
+			}
+		} else {
+			if _, err := c.Conn.Do("RENAME", hash+"~", hash); err != nil {
+				return n, err
+			}
+			if _, err := c.Conn.Do("RENAME", keys+"~", keys); err != nil {
+				return n, err
+			}
+		}
+		names = append(names, d.Name)
+	}
+	indexes := c.key(name, "indexes")
+	if _, err := c.Conn.Do("DEL", indexes); err != nil {
+		return n, err
+	}
+	if len(names) > 0 {
+		if _, err := c.Conn.Do("SADD", append([]interface{}{indexes}, names...)...); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// Provider searches the indexes of the Provider named Name, as written
+// into the Cache by Write, so that it can be served by an
+// stddata.Service as the Provider itself would be.
+type Provider struct {
+	Cache *Cache
+	Name  string // of the Provider that was written, for example "country"
+}
+
+// Result is the result of a search of a Provider: the keys that match,
+// in the order of the index, with their entities.
+type Result struct {
+	Matches []Match
+}
+
+// Match is a key that matches a search, with its entities, as the
+// Provider that was written encoded them.
+type Match struct {
+	Key      string
+	Entities json.RawMessage
+}
+
+// Load implements the Load method of the stddata.Provider interface. The
+// data is loaded into Redis by Write, so Load just checks that it is
+// there, and returns the number of keys of its indexes.
+func (p *Provider) Load() (n int, err error) {
+	indexes, err := p.indexes()
+	if err != nil {
+		return 0, err
+	}
+	if len(indexes) == 0 {
+		msg := "No data for " + p.Name + " in Redis"
+		return 0, stddata.NewSourceError(msg, nil)
+	}
+	for index := range indexes {
+		reply, err := p.Cache.Conn.Do("HLEN", p.Cache.key(p.Name, index))
+		if err != nil {
+			return 0, err
+		}
+		count, _ := reply.(int64)
+		n += int(count)
+	}
+	return n, nil
+}
+
+// Search implements the Search method of the stddata.Provider interface.
+func (p *Provider) Search(index string, query string) (result interface{}, err error) {
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	indexes, err := p.indexes()
+	if err != nil {
+		return nil, err
+	}
+	if len(indexes) == 0 {
+		msg := "No data for " + p.Name + " in Redis"
+		return nil, stddata.NewServiceError(http.StatusServiceUnavailable, msg, stddata.ErrNotLoaded)
+	}
+	if !indexes[index] {
+		return nil, stddata.NewIndexError(index, indexes)
+	}
+	// the members of the sorted set that begin with the folded query
+	min, max := "-", "+"
+	if query != "_dump" {
+		q := strings.ToLower(query)
+		min, max = "["+q, "("+q+"\xff"
+	}
+	reply, err := p.Cache.Conn.Do("ZRANGEBYLEX", p.Cache.key(p.Name, index, "keys"), min, max)
+	if err != nil {
+		return nil, err
+	}
+	members, err := strs(reply)
+	if err != nil {
+		return nil, err
+	}
+	var res Result
+	if len(members) == 0 {
+		return res, nil
+	}
+	args := []interface{}{p.Cache.key(p.Name, index)}
+	for _, m := range members {
+		key := m[strings.IndexByte(m, 0)+1:]
+		res.Matches = append(res.Matches, Match{Key: key})
+		args = append(args, key)
+	}
+	reply, err = p.Cache.Conn.Do("HMGET", args...)
+	if err != nil {
+		return nil, err
+	}
+	values, err := strs(reply)
+	if err != nil {
+		return nil, err
+	}
+	for i := range res.Matches {
+		res.Matches[i].Entities = json.RawMessage(values[i])
+	}
+	return res, nil
+}
+
+// indexes returns the names of the indexes of the Provider that was
+// written.
+func (p *Provider) indexes() (map[string]bool, error) {
+	reply, err := p.Cache.Conn.Do("SMEMBERS", p.Cache.key(p.Name, "indexes"))
+	if err != nil {
+		return nil, err
+	}
+	names, err := strs(reply)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make(map[string]bool, len(names))
+	for _, name := range names {
+		indexes[name] = true
+	}
+	return indexes, nil
+}
+
+// strs returns reply, the array reply of a command, as strings, a
+// missing value being empty.
+func strs(reply interface{}) ([]string, error) {
+	values, ok := reply.([]interface{})
+	if !ok && reply != nil {
+		return nil, stddata.NewSourceError("Unexpected reply from Redis", nil)
+	}
+	s := make([]string, len(values))
+	for i, v := range values {
+		switch v := v.(type) {
+		case []byte:
+			s[i] = string(v)
+		case string:
+			s[i] = v
+		case nil:
+		default:
+			return nil, stddata.NewSourceError("Unexpected reply from Redis", nil)
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,156 @@
+package rediscache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/country"
+)
+
+// fakeRedis holds hashes, sorted sets of members of the same score, and
+// sets, in memory, and answers the commands that a Cache sends.
+type fakeRedis struct {
+	mu   sync.Mutex
+	keys map[string]map[string]string
+}
+
+func (r *fakeRedis) Do(command string, args ...interface{}) (interface{}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := make([]string, len(args))
+	for i, a := range args {
+		if b, ok := a.([]byte); ok {
+			s[i] = string(b)
+		} else {
+			s[i] = fmt.Sprint(a)
+		}
+	}
+	m := r.keys[s[0]]
+	switch command {
+	case "DEL":
+		for _, k := range s {
+			delete(r.keys, k)
+		}
+		return int64(0), nil
+	case "HSET", "ZADD", "SADD":
+		if m == nil {
+			m = make(map[string]string)
+			r.keys[s[0]] = m
+		}
+		switch command {
+		case "HSET":
+			for i := 1; i < len(s); i += 2 {
+				m[s[i]] = s[i+1]
+			}
+		case "ZADD":
+			for i := 2; i < len(s); i += 2 {
+				m[s[i]] = ""
+			}
+		default:
+			for _, v := range s[1:] {
+				m[v] = ""
+			}
+		}
+		return int64(len(s)), nil
+	case "RENAME":
+		if m == nil {
+			return nil, errors.New("ERR no such key")
+		}
+		r.keys[s[1]] = m
+		delete(r.keys, s[0])
+		return "OK", nil
+	case "HLEN":
+		return int64(len(m)), nil
+	case "HMGET":
+		reply := make([]interface{}, len(s)-1)
+		for i, f := range s[1:] {
+			if v, found := m[f]; found {
+				reply[i] = []byte(v)
+			}
+		}
+		return reply, nil
+	case "SMEMBERS", "ZRANGEBYLEX":
+		var members []string
+		for v := range m {
+			if command == "SMEMBERS" || ((s[1] == "-" || v >= s[1][1:]) && (s[2] == "+" || v < s[2][1:])) {
+				members = append(members, v)
+			}
+		}
+		sort.Strings(members)
+		reply := make([]interface{}, len(members))
+		for i, v := range members {
+			reply[i] = []byte(v)
+		}
+		return reply, nil
+	}
+	return nil, errors.New("ERR unknown command " + command)
+}
+
+var c = &Cache{Conn: &fakeRedis{keys: make(map[string]map[string]string)}}
+
+func TestWrite(t *testing.T) {
+	p := new(country.CountryProvider)
+	if _, err := p.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	n, err := c.Write("country", p)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n == 0 {
+		t.Fatalf("Expected keys to be written\n")
+	}
+	// a second instance, or this one when it starts again
+	cached := &Provider{Cache: c, Name: "country"}
+	loaded, err := cached.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if loaded != n {
+		t.Fatalf("Expected %d keys, got %d\n", n, loaded)
+	}
+	for _, index := range []string{"alpha2", "name"} {
+		for _, query := range []string{"d", "Ger", "zz", "_dump"} {
+			res, err := cached.Search(index, query)
+			if err != nil {
+				t.Fatalf("Err %v\n", err)
+			}
+			expected, _ := p.Search(index, query)
+			matches := expected.(country.CountryResult).Countries
+			got := res.(Result).Matches
+			if len(got) != len(matches) {
+				t.Fatalf("Expected %d matches of %s=%s, got %d\n", len(matches), index, query, len(got))
+			}
+			for i, m := range got {
+				var countries []struct{ Alpha2Code string }
+				if err := json.Unmarshal(m.Entities, &countries); err != nil {
+					t.Fatalf("Err %v\n", err)
+				}
+				if m.Key != matches[i].Key || countries[0].Alpha2Code != matches[i].Country.Alpha2Code {
+					t.Fatalf("Expected %s of %s, got %s of %s\n", matches[i].Country.Alpha2Code, matches[i].Key, countries[0].Alpha2Code, m.Key)
+				}
+			}
+		}
+	}
+}
+func TestQueryErrors(t *testing.T) {
+	cached := &Provider{Cache: c, Name: "nothing"}
+	if _, err := cached.Load(); err == nil {
+		t.Fatalf("Expected a Provider that was not written to fail\n")
+	}
+	if _, err := c.Write("nothing", cached); err == nil {
+		t.Fatalf("Expected a Provider that cannot range to fail\n")
+	}
+	cached.Name = "country"
+	if _, err := cached.Search("alpha2", ""); err != stddata.ErrEmptyQuery {
+		t.Fatalf("Expected ErrEmptyQuery, got %v\n", err)
+	}
+	if _, err := cached.Search("alpha-2", "DE"); !errors.Is(err, stddata.ErrUnknownIndex) {
+		t.Fatalf("Expected ErrUnknownIndex, got %v\n", err)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stddata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Registry holds the Providers of a service, so that a query can be
+// searched for in all of them at once, as a "universal search box"
+// does. The methods of a Registry may be called concurrently.
+type Registry struct {
+	// Timeout is how long SearchAll waits for the results of each
+	// Provider that does not have a Timeout of its own. If it is 0,
+	// SearchAll waits until its context is done.
+	Timeout time.Duration
+	mu      sync.RWMutex
+	entries []Registration
+}
+
+// Registration is a Provider registered with a Registry.
+type Registration struct {
+	Name     string   // labels the results, for example "country"
+	Provider Provider // which must be loaded before it is searched
+	Indexes  []string // the indexes that SearchAll searches
+	// Timeout is how long SearchAll waits for the results of the
+	// Provider, or 0 for the Timeout of the Registry.
+	Timeout time.Duration
+}
+
+// LabeledResult is the result of searching one index of a registered
+// Provider, labeled with the Name of its Registration and the index.
+type LabeledResult struct {
+	Provider string
+	Index    string
+	Result   interface{} // as returned by Search, nil if Err is not
+	Err      error
+}
+
+// Register adds a Provider to the Registry. The Name must not be that
+// of another Registration, and at least one index must be named.
+func (r *Registry) Register(reg Registration) error {
+	if len(reg.Name) < 1 || reg.Provider == nil || len(reg.Indexes) < 1 {
+		return errors.New("Registration must have a Name, a Provider and Indexes")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.entries {
+		if e.Name == reg.Name {
+			return errors.New("Provider " + reg.Name + " is already registered")
+		}
+	}
+	r.entries = append(r.entries, reg)
+	return nil
+}
+
+// SearchAll searches each of the indexes of every registered Provider
+// for query, concurrently, and returns the results in the order of
+// the Registrations and of their Indexes. The result of a Provider
+// that does not answer in time has an Err with the code 504 Gateway
+// Timeout, or the error of ctx if it is done first, so that the
+// results of the others are not held up. query must not be "_dump",
+// since the whole of every index is not a search.
+func (r *Registry) SearchAll(ctx context.Context, query string) (results []LabeledResult, err error) {
+	if len(query) < 1 {
+		return nil, ErrEmptyQuery
+	}
+	if query == "_dump" {
+		return nil, NewServiceError(http.StatusBadRequest, "Malformed query "+query, nil)
+	}
+	r.mu.RLock()
+	entries := make([]Registration, len(r.entries))
+	copy(entries, r.entries)
+	r.mu.RUnlock()
+
+	// lay out all of the results first, so that each Provider fills in
+	// its own part of them.
+	first := make([]int, len(entries)+1)
+	for i, e := range entries {
+		for _, index := range e.Indexes {
+			results = append(results, LabeledResult{Provider: e.Name, Index: index})
+		}
+		first[i+1] = len(results)
+	}
+	var wg sync.WaitGroup
+	for i, e := range entries {
+		wg.Add(1)
+		go func(e Registration, res []LabeledResult) {
+			defer wg.Done()
+			r.searchOne(ctx, e, query, res)
+		}(e, results[first[i]:first[i+1]])
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// searchOne searches the indexes of one Registration for query, and
+// fills in res, which holds their LabeledResults.
+func (r *Registry) searchOne(ctx context.Context, e Registration, query string, res []LabeledResult) {
+	parent := ctx
+	timeout := e.Timeout
+	if timeout == 0 {
+		timeout = r.Timeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	// Search does not take a context, so it is left to finish in the
+	// background if it is late. The channel is buffered, so that it
+	// can always send its results and exit.
+	done := make(chan []LabeledResult, 1)
+	go func() {
+		found := make([]LabeledResult, len(res))
+		for i, index := range e.Indexes {
+			found[i] = LabeledResult{Provider: e.Name, Index: index}
+			found[i].Result, found[i].Err = e.Provider.Search(index, query)
+		}
+		done <- found
+	}()
+	select {
+	case found := <-done:
+		copy(res, found)
+	case <-ctx.Done():
+		err := parent.Err()
+		if err == nil {
+			err = NewServiceError(http.StatusGatewayTimeout, "Search of "+e.Name+" timed out", nil)
+		}
+		for i := range res {
+			res[i].Err = err
+		}
+	}
+}
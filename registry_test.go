@@ -0,0 +1,115 @@
+package stddata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// newRegistry returns a Registry of the loaded regs.
+func newRegistry(t *testing.T, timeout time.Duration, regs ...Registration) *Registry {
+	r := &Registry{Timeout: timeout}
+	for _, reg := range regs {
+		if _, err := reg.Provider.Load(); err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if err := r.Register(reg); err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+	}
+	return r
+}
+func TestRegister(t *testing.T) {
+	r := new(Registry)
+	p := newStubProvider(0, "alpha")
+	for _, reg := range []Registration{
+		{Provider: p, Indexes: []string{"word"}},
+		{Name: "stub", Indexes: []string{"word"}},
+		{Name: "stub", Provider: p},
+	} {
+		if err := r.Register(reg); err == nil {
+			t.Fatalf("Expected %+v to be rejected\n", reg)
+		}
+	}
+	if err := r.Register(Registration{Name: "stub", Provider: p, Indexes: []string{"word"}}); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if err := r.Register(Registration{Name: "stub", Provider: p, Indexes: []string{"word"}}); err == nil {
+		t.Fatalf("Expected a second registration of stub to be rejected\n")
+	}
+}
+func TestSearchAll(t *testing.T) {
+	// the slower Provider is registered first, so that the results are
+	// not in the order that they are found.
+	r := newRegistry(t, 0,
+		Registration{Name: "slow", Provider: newStubProvider(20*time.Millisecond, "alpha", "beta"), Indexes: []string{"word", "word"}},
+		Registration{Name: "fast", Provider: newStubProvider(0, "alpine", "apple"), Indexes: []string{"word"}})
+	results, err := r.SearchAll(context.Background(), "al")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	var got []string
+	for _, res := range results {
+		if res.Err != nil {
+			t.Fatalf("%s %s: Err %v\n", res.Provider, res.Index, res.Err)
+		}
+		got = append(got, fmt.Sprintf("%s %s %v", res.Provider, res.Index, res.Result.(stubResult).Words))
+	}
+	expected := []string{"slow word [alpha]", "slow word [alpha]", "fast word [alpine]"}
+	if fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Fatalf("Expected %v, got %v\n", expected, got)
+	}
+}
+func TestSearchAllTimeout(t *testing.T) {
+	r := newRegistry(t, time.Second,
+		Registration{Name: "slow", Provider: newStubProvider(200*time.Millisecond, "alpha"), Indexes: []string{"word"}, Timeout: 10 * time.Millisecond},
+		Registration{Name: "fast", Provider: newStubProvider(0, "alpine"), Indexes: []string{"word"}})
+	start := time.Now()
+	results, err := r.SearchAll(context.Background(), "al")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if elapsed := time.Since(start); elapsed >= 200*time.Millisecond {
+		t.Fatalf("Expected the slow Provider not to be waited for, took %v\n", elapsed)
+	}
+	var serr *ServiceError
+	if !errors.As(results[0].Err, &serr) || serr.Code != http.StatusGatewayTimeout || results[0].Result != nil {
+		t.Fatalf("Expected slow to time out, got %v\n", results[0].Err)
+	}
+	if results[1].Err != nil || len(results[1].Result.(stubResult).Words) != 1 {
+		t.Fatalf("Expected fast to answer, got %v %v\n", results[1].Result, results[1].Err)
+	}
+}
+func TestSearchAllCancel(t *testing.T) {
+	r := newRegistry(t, 0,
+		Registration{Name: "slow", Provider: newStubProvider(200*time.Millisecond, "alpha"), Indexes: []string{"word"}})
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	results, err := r.SearchAll(ctx, "al")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if !errors.Is(results[0].Err, context.Canceled) {
+		t.Fatalf("Expected the search to be canceled, got %v\n", results[0].Err)
+	}
+}
+func TestSearchAllErrors(t *testing.T) {
+	r := newRegistry(t, 0,
+		Registration{Name: "stub", Provider: newStubProvider(0, "alpha"), Indexes: []string{"wrd"}})
+	if _, err := r.SearchAll(context.Background(), ""); err != ErrEmptyQuery {
+		t.Fatalf("Expected ErrEmptyQuery, got %v\n", err)
+	}
+	if _, err := r.SearchAll(context.Background(), "_dump"); StatusCode(err) != http.StatusBadRequest {
+		t.Fatalf("Expected _dump to be rejected, got %v\n", err)
+	}
+	results, err := r.SearchAll(context.Background(), "al")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if len(results) != 1 || !errors.Is(results[0].Err, ErrUnknownIndex) {
+		t.Fatalf("Expected an unknown index, got %+v\n", results)
+	}
+}
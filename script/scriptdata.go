@@ -0,0 +1,234 @@
+package script
+
+// provenance of scriptdata, reported by Info
+const (
+	source    = "ISO 15924 Codes for the representation of names of scripts (Debian iso-codes)"
+	sourceURL = "https://www.unicode.org/iso15924/"
+	edition   = "iso-codes 4.15.0, with the codes registered since"
+)
+
+/*
+scriptdata is derived from the ISO 15924 information maintained by the
+Debian iso-codes project, with its French translations, brought up to
+date with the codes registered since, whose French names are not
+included. The Unicode alias is the script's Property Value Alias in
+the Unicode Character Database, such as "Old_Italic"; it is empty for
+scripts that are not encoded in Unicode, and for the codes that stand
+for combinations of scripts, except Hrkt. Each line holds the alpha-4
+code, the numeric code, the English name, the French name and the
+Unicode alias, separated by tabs.
+*/
+var scriptdata = `Adlm	166	Adlam	adlam	Adlam
+Afak	439	Afaka	afaka	
+Aghb	239	Caucasian Albanian	aghbanien	Caucasian_Albanian
+Ahom	338	Ahom, Tai Ahom	ahom, tai ahom	Ahom
+Arab	160	Arabic	arabe	Arabic
+Aran	161	Arabic (Nastaliq variant)	arabe (variante Nastaliq)	Arabic
+Armi	124	Imperial Aramaic	araméen impérial	Imperial_Aramaic
+Armn	230	Armenian	arménien	Armenian
+Avst	134	Avestan	avestique	Avestan
+Bali	360	Balinese	balinais	Balinese
+Bamu	435	Bamum	bamoum	Bamum
+Bass	259	Bassa Vah	bassa	Bassa_Vah
+Batk	365	Batak	batak	Batak
+Beng	325	Bengali	bengali	Bengali
+Bhks	334	Bhaiksuki	bhaiksuki	Bhaiksuki
+Blis	550	Blissymbols	symboles Bliss	
+Bopo	285	Bopomofo	bopomofo	Bopomofo
+Brah	300	Brahmi	brâhmî	Brahmi
+Brai	570	Braille	braille	Braille
+Bugi	367	Buginese	bouguis	Buginese
+Buhd	372	Buhid	bouhide	Buhid
+Cakm	349	Chakma	chakma	Chakma
+Cans	440	Unified Canadian Aboriginal Syllabics	syllabaire autochtone canadien unifié	Canadian_Aboriginal
+Cari	201	Carian	carien	Carian
+Cham	358	Cham	cham (čam, tcham)	Cham
+Cher	445	Cherokee	tchérokî	Cherokee
+Chrs	109	Chorasmian		Chorasmian
+Cirt	291	Cirth	cirth	
+Copt	204	Coptic	copte	Coptic
+Cpmn	402	Cypro-Minoan		Cypro_Minoan
+Cprt	403	Cypriot	syllabaire chypriote	Cypriot
+Cyrl	220	Cyrillic	cyrillique	Cyrillic
+Cyrs	221	Cyrillic (Old Church Slavonic variant)	cyrillique (variante slavonne)	Cyrillic
+Deva	315	Devanagari (Nagari)	dévanâgarî	Devanagari
+Diak	342	Dives Akuru		Dives_Akuru
+Dogr	328	Dogra		Dogra
+Dsrt	250	Deseret (Mormon)	déseret (mormon)	Deseret
+Dupl	755	Duployan shorthand, Duployan stenography	sténographie Duployé	Duployan
+Egyd	070	Egyptian demotic	démotique égyptien	
+Egyh	060	Egyptian hieratic	hiératique égyptien	
+Egyp	050	Egyptian hieroglyphs	hiéroglyphes égyptiens	Egyptian_Hieroglyphs
+Elba	226	Elbasan	elbasan	Elbasan
+Elym	128	Elymaic		Elymaic
+Ethi	430	Ethiopic (Geʻez)	éthiopien (geʻez, guèze)	Ethiopic
+Gara	164	Garay		Garay
+Geok	241	Khutsuri (Asomtavruli and Nuskhuri)	khoutsouri (assomtavrouli et nouskhouri)	
+Geor	240	Georgian (Mkhedruli)	géorgien (mkhédrouli)	Georgian
+Glag	225	Glagolitic	glagolitique	Glagolitic
+Gong	312	Gunjala Gondi		Gunjala_Gondi
+Gonm	313	Masaram Gondi		Masaram_Gondi
+Goth	206	Gothic	gotique	Gothic
+Gran	343	Grantha	grantha	Grantha
+Grek	200	Greek	grec	Greek
+Gujr	320	Gujarati	goudjarâtî (gujrâtî)	Gujarati
+Gukh	397	Gurung Khema		Gurung_Khema
+Guru	310	Gurmukhi	gourmoukhî	Gurmukhi
+Hanb	503	Han with Bopomofo (alias for Han + Bopomofo)	han avec bopomofo (alias pour han + bopomofo)	
+Hang	286	Hangul (Hangŭl, Hangeul)	hangûl (hangŭl, hangeul)	Hangul
+Hani	500	Han (Hanzi, Kanji, Hanja)	idéogrammes han (sinogrammes)	Han
+Hano	371	Hanunoo (Hanunóo)	hanounóo	Hanunoo
+Hans	501	Han (Simplified variant)	idéogrammes han (variante simplifiée)	Han
+Hant	502	Han (Traditional variant)	idéogrammes han (variante traditionnelle)	Han
+Hatr	127	Hatran	hatrénien	Hatran
+Hebr	125	Hebrew	hébreu	Hebrew
+Hira	410	Hiragana	hiragana	Hiragana
+Hluw	080	Anatolian Hieroglyphs (Luwian Hieroglyphs, Hittite Hieroglyphs)	hiéroglyphes anatoliens (hiéroglyphes louvites, hiéroglyphes hittites)	Anatolian_Hieroglyphs
+Hmng	450	Pahawh Hmong	pahawh hmong	Pahawh_Hmong
+Hmnp	451	Nyiakeng Puachue Hmong		Nyiakeng_Puachue_Hmong
+Hrkt	412	Japanese syllabaries (alias for Hiragana + Katakana)	syllabaires japonais (alias pour hiragana + katakana)	Katakana_Or_Hiragana
+Hung	176	Old Hungarian (Hungarian Runic)	runes hongroises (ancien hongrois)	Old_Hungarian
+Inds	610	Indus (Harappan)	indus	
+Ital	210	Old Italic (Etruscan, Oscan, etc.)	ancien italique (étrusque, osque, etc.)	Old_Italic
+Jamo	284	Jamo (alias for Jamo subset of Hangul)	jamo (alias pour le sous-ensemble jamo du hangûl )	
+Java	361	Javanese	javanais	Javanese
+Jpan	413	Japanese (alias for Han + Hiragana + Katakana)	japonais (alias pour han + hiragana + katakana)	
+Jurc	510	Jurchen	jurchen	
+Kali	357	Kayah Li	kayah li	Kayah_Li
+Kana	411	Katakana	katakana	Katakana
+Kawi	368	Kawi		Kawi
+Khar	305	Kharoshthi	kharochthî	Kharoshthi
+Khmr	355	Khmer	khmer	Khmer
+Khoj	322	Khojki	khojkî	Khojki
+Kitl	505	Khitan large script	khitan (grande écriture)	
+Kits	288	Khitan small script	khitan (petite écriture)	Khitan_Small_Script
+Knda	345	Kannada	kannara (canara)	Kannada
+Kore	287	Korean (alias for Hangul + Han)	coréen (alias pour hangûl + han)	
+Kpel	436	Kpelle	kpèllé	
+Krai	396	Kirat Rai		Kirat_Rai
+Kthi	317	Kaithi	kaithî	Kaithi
+Lana	351	Tai Tham (Lanna)	taï tham (lanna)	Tai_Tham
+Laoo	356	Lao	laotien	Lao
+Latf	217	Latin (Fraktur variant)	latin (variante brisée)	Latin
+Latg	216	Latin (Gaelic variant)	latin (variante gaélique)	Latin
+Latn	215	Latin	latin	Latin
+Leke	364	Leke	leke	
+Lepc	335	Lepcha (Róng)	lepcha (róng)	Lepcha
+Limb	336	Limbu	limbou	Limbu
+Lina	400	Linear A	linéaire A	Linear_A
+Linb	401	Linear B	linéaire B	Linear_B
+Lisu	399	Lisu (Fraser)	lisu (Fraser)	Lisu
+Loma	437	Loma	loma	
+Lyci	202	Lycian	lycien	Lycian
+Lydi	116	Lydian	lydien	Lydian
+Mahj	314	Mahajani	mahâjanî	Mahajani
+Maka	366	Makasar		Makasar
+Mand	140	Mandaic, Mandaean	mandéen	Mandaic
+Mani	139	Manichaean	manichéen	Manichaean
+Marc	332	Marchen	marchen	Marchen
+Maya	090	Mayan hieroglyphs	hiéroglyphes mayas	
+Medf	265	Medefaidrin (Oberi Okaime, Oberi Ɔkaimɛ)		Medefaidrin
+Mend	438	Mende Kikakui	mendé kikakui	Mende_Kikakui
+Merc	101	Meroitic Cursive	cursif méroïtique	Meroitic_Cursive
+Mero	100	Meroitic Hieroglyphs	hiéroglyphes méroïtiques	Meroitic_Hieroglyphs
+Mlym	347	Malayalam	malayalam	Malayalam
+Modi	324	Modi, Moḍī	modî	Modi
+Mong	145	Mongolian	mongol	Mongolian
+Moon	218	Moon (Moon code, Moon script, Moon type)	écriture Moon	
+Mroo	199	Mro, Mru	mru	Mro
+Mtei	337	Meitei Mayek (Meithei, Meetei)	meitei mayek	Meetei_Mayek
+Mult	323	Multani	multanî	Multani
+Mymr	350	Myanmar (Burmese)	birman	Myanmar
+Nagm	295	Nag Mundari		Nag_Mundari
+Nand	311	Nandinagari		Nandinagari
+Narb	106	Old North Arabian (Ancient North Arabian)	nor-arabien	Old_North_Arabian
+Nbat	159	Nabataean	nabatéen	Nabataean
+Newa	333	Newa, Newar, Newari, Nepāla lipi	newa, newar, newari, lipi du Népal	Newa
+Nkgb	420	Nakhi Geba ('Na-'Khi ²Ggŏ-¹baw, Naxi Geba)	nakhi gueba	
+Nkoo	165	N’Ko	n’ko	Nko
+Nshu	499	Nüshu	nüshu	Nushu
+Ogam	212	Ogham	ogam	Ogham
+Olck	261	Ol Chiki (Ol Cemet’, Ol, Santali)	ol tchiki	Ol_Chiki
+Onao	296	Ol Onal		Ol_Onal
+Orkh	175	Old Turkic, Orkhon Runic	orkhon	Old_Turkic
+Orya	327	Oriya	oriya	Oriya
+Osge	219	Osage	osage	Osage
+Osma	260	Osmanya	osmanais	Osmanya
+Ougr	143	Old Uyghur		Old_Uyghur
+Palm	126	Palmyrene	palmyrénien	Palmyrene
+Pauc	263	Pau Cin Hau	paou chin haou	Pau_Cin_Hau
+Perm	227	Old Permic	ancien permien	Old_Permic
+Phag	331	Phags-pa	’phags pa	Phags_Pa
+Phli	131	Inscriptional Pahlavi	pehlevi des inscriptions	Inscriptional_Pahlavi
+Phlp	132	Psalter Pahlavi	pehlevi des psautiers	Psalter_Pahlavi
+Phlv	133	Book Pahlavi	pehlevi des livres	
+Phnx	115	Phoenician	phénicien	Phoenician
+Piqd	293	Klingon (KLI pIqaD)	klingon (KLI pIqaD)	
+Plrd	282	Miao (Pollard)	miao (Pollard)	Miao
+Prti	130	Inscriptional Parthian	parthe des inscriptions	Inscriptional_Parthian
+Qaaa	900	Reserved for private use (start)	réservé à l’usage privé (début)	
+Qabx	949	Reserved for private use (end)	réservé à l’usage privé (fin)	
+Rjng	363	Rejang (Redjang, Kaganga)	redjang (kaganga)	Rejang
+Rohg	167	Hanifi Rohingya		Hanifi_Rohingya
+Roro	620	Rongorongo	rongorongo	
+Runr	211	Runic	runique	Runic
+Samr	123	Samaritan	samaritain	Samaritan
+Sara	292	Sarati	sarati	
+Sarb	105	Old South Arabian	sud-arabique, himyarite	Old_South_Arabian
+Saur	344	Saurashtra	saurachtra	Saurashtra
+Sgnw	095	SignWriting	signÉcriture, signWriting	SignWriting
+Shaw	281	Shavian (Shaw)	shavien (Shaw)	Shavian
+Shrd	319	Sharada, Śāradā	charada, sharda	Sharada
+Sidd	302	Siddham, Siddhaṃ, Siddhamātṛkā	siddham	Siddham
+Sind	318	Khudawadi, Sindhi	khoudawadî, sindhî	Khudawadi
+Sinh	348	Sinhala	singhalais	Sinhala
+Sogd	141	Sogdian		Sogdian
+Sogo	142	Old Sogdian		Old_Sogdian
+Sora	398	Sora Sompeng	sora sompeng	Sora_Sompeng
+Soyo	329	Soyombo		Soyombo
+Sund	362	Sundanese	sundanais	Sundanese
+Sunu	274	Sunuwar		Sunuwar
+Sylo	316	Syloti Nagri	sylotî nâgrî	Syloti_Nagri
+Syrc	135	Syriac	syriaque	Syriac
+Syre	138	Syriac (Estrangelo variant)	syriaque (variante estranghélo)	Syriac
+Syrj	137	Syriac (Western variant)	syriaque (variante occidentale)	Syriac
+Syrn	136	Syriac (Eastern variant)	syriaque (variante orientale)	Syriac
+Tagb	373	Tagbanwa	tagbanoua	Tagbanwa
+Takr	321	Takri, Ṭākrī, Ṭāṅkrī	tâkrî	Takri
+Tale	353	Tai Le	taï-le	Tai_Le
+Talu	354	New Tai Lue	nouveau taï-lue	New_Tai_Lue
+Taml	346	Tamil	tamoul	Tamil
+Tang	520	Tangut	tangoute	Tangut
+Tavt	359	Tai Viet	taï viêt	Tai_Viet
+Telu	340	Telugu	télougou	Telugu
+Teng	290	Tengwar	tengwar	
+Tfng	120	Tifinagh (Berber)	tifinagh (berbère)	Tifinagh
+Tglg	370	Tagalog (Baybayin, Alibata)	tagal (baybayin, alibata)	Tagalog
+Thaa	170	Thaana	thâna	Thaana
+Thai	352	Thai	thaï	Thai
+Tibt	330	Tibetan	tibétain	Tibetan
+Tirh	326	Tirhuta	tirhouta	Tirhuta
+Tnsa	275	Tangsa		Tangsa
+Todr	229	Todhri		Todhri
+Tols	299	Tolong Siki		Tolong_Siki
+Toto	294	Toto		Toto
+Tutg	341	Tulu-Tigalari		Tulu_Tigalari
+Ugar	040	Ugaritic	ougaritique	Ugaritic
+Vaii	470	Vai	vaï	Vai
+Visp	280	Visible Speech	parole visible	
+Vith	228	Vithkuqi		Vithkuqi
+Wara	262	Warang Citi (Varang Kshiti)	warang citi	Warang_Citi
+Wcho	283	Wancho		Wancho
+Wole	480	Woleai	woléaï	
+Xpeo	030	Old Persian	cunéiforme persépolitain	Old_Persian
+Xsux	020	Cuneiform, Sumero-Akkadian	cunéiforme suméro-akkadien	Cuneiform
+Yezi	192	Yezidi		Yezidi
+Yiii	460	Yi	yi	Yi
+Zanb	339	Zanabazar Square		Zanabazar_Square
+Zinh	994	Code for inherited script	codet pour écriture héritée	Inherited
+Zmth	995	Mathematical notation	notation mathématique	
+Zsye	993	Symbols (Emoji variant)	symboles (variante emoji)	
+Zsym	996	Symbols	symboles	
+Zxxx	997	Code for unwritten documents	codet pour les documents non écrites	
+Zyyy	998	Code for undetermined script	codet pour écriture indéterminée	Common
+Zzzz	999	Code for uncoded script	codet pour écriture non codée	Unknown`
@@ -0,0 +1,296 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package script implements the methods of a stddata.Provider.
+It provides searches against the ISO 15924 codes for the
+representation of names of scripts, such as "Latn" and "Hant", with
+their numeric codes, their English and French names, and the names
+the Unicode Character Database uses for them. Source data is declared
+in scriptdata.go.
+*/
+package script
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// ScriptProvider implements the Provider interface.
+type ScriptProvider struct {
+	data atomic.Pointer[scriptData] // nil until the data is loaded
+}
+
+// scriptData is the data of a ScriptProvider. Once it is stored in the
+// ScriptProvider it is not modified.
+type scriptData struct {
+	size          int
+	info          stddata.Info
+	scriptIndexes map[string]scriptIndex
+}
+
+type scriptIndex struct {
+	scriptMap  map[string][]Script
+	scriptKeys []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Script models one entity.
+type Script struct {
+	Code         string // the alpha-4 code, in title case, for example "Latn"
+	Numeric      string // the three digit numeric code, for example "215"
+	EnglishName  string
+	FrenchName   string // empty for the codes registered since the source was translated
+	UnicodeAlias string // the Unicode Property Value Alias, for example "Latin"; empty if not in Unicode
+}
+
+// ScriptResult is the interface{} that is returned from Search
+type ScriptResult struct {
+	Scripts [][]Script
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *ScriptProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *ScriptProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &scriptData{scriptIndexes: make(map[string]scriptIndex)}
+	codeMap := make(map[string][]Script)
+	numberMap := make(map[string][]Script)
+	nameMap := make(map[string][]Script)
+	frnameMap := make(map[string][]Script)
+	aliasMap := make(map[string][]Script)
+
+	reader := csv.NewReader(strings.NewReader(scriptdata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 5
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var s Script
+		s.Code = record[0]
+		s.Numeric = record[1]
+		s.EnglishName = record[2]
+		s.FrenchName = record[3]
+		s.UnicodeAlias = record[4]
+		if len(s.Code) != 4 || len(s.Numeric) != 3 {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed code %q", line, s.Code+" "+s.Numeric)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Script to the maps
+		codeMap[s.Code] = append(codeMap[s.Code], s)
+		numberMap[s.Numeric] = append(numberMap[s.Numeric], s)
+		nameMap[s.EnglishName] = append(nameMap[s.EnglishName], s)
+		if s.FrenchName != "" {
+			frnameMap[s.FrenchName] = append(frnameMap[s.FrenchName], s)
+		}
+		if s.UnicodeAlias != "" {
+			aliasMap[s.UnicodeAlias] = append(aliasMap[s.UnicodeAlias], s)
+		}
+	}
+	p.storeData(d, "code", codeMap)
+	p.storeData(d, "number", numberMap)
+	p.storeData(d, "name", nameMap)
+	p.storeData(d, "frname", frnameMap)
+	p.storeData(d, "alias", aliasMap)
+	d.size = len(codeMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(codeMap)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *ScriptProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Script with the alpha-4 code code, in any case, so
+// that "latn" and "Latn" both return Latin. Unlike Search, it does not
+// match prefixes. The codes Qaaa to Qabx, which are reserved for
+// private use, are returned as the Script for Qaaa or Qabx.
+func (p *ScriptProvider) Get(code string) (s Script, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return s, stddata.ErrNotLoaded
+	}
+	if len(code) == 4 {
+		code = strings.ToUpper(code[0:1]) + strings.ToLower(code[1:])
+	}
+	scripts, found := d.scriptIndexes["code"].scriptMap[code]
+	if !found && IsPrivateUse(code) {
+		scripts, found = d.scriptIndexes["code"].scriptMap["Qaaa"], true
+	}
+	if !found {
+		msg := "No script " + code
+		return s, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return scripts[0], nil
+}
+
+// IsValid reports whether code is an assigned ISO 15924 alpha-4 code,
+// or one of those reserved for private use.
+func (p *ScriptProvider) IsValid(code string) bool {
+	_, err := p.Get(code)
+	return err == nil
+}
+
+// IsPrivateUse reports whether the alpha-4 code, in title case, is in
+// the range Qaaa-Qabx, which is reserved for private use.
+func IsPrivateUse(code string) bool {
+	return len(code) == 4 && code >= "Qaaa" && code <= "Qabx"
+}
+
+func (p *ScriptProvider) storeData(d *scriptData, s string, m map[string][]Script) {
+	// store the map
+	var si scriptIndex
+	si.scriptMap = m
+	// extract the keys
+	si.scriptKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		si.scriptKeys[i] = k
+		i++
+	}
+	// sort the keys
+	si.foldedKeys = stddata.SortKeys(si.scriptKeys)
+	// add to scriptIndexes
+	d.scriptIndexes[s] = si
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *ScriptProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "code", Description: "ISO 15924 alpha-4 code", Example: "Latn"},
+		{Name: "number", Description: "ISO 15924 numeric code", Example: "215"},
+		{Name: "name", Description: "English name", Example: "Latin"},
+		{Name: "frname", Description: "French name", Example: "latin"},
+		{Name: "alias", Description: "Unicode Property Value Alias", Example: "Cyrillic"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *ScriptProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	si := d.scriptIndexes[index]
+	return stddata.Suggest(si.scriptKeys, si.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Script entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Scripts are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+func (p *ScriptProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	si, found := d.scriptIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.scriptIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(si, query)
+	return result, nil
+}
+
+// SearchScripts is Search, returning the matches as they are, rather
+// than as a ScriptResult in an interface{}.
+func (p *ScriptProvider) SearchScripts(index string, query string) ([][]Script, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(ScriptResult).Scripts, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Scripts of each. It yields nothing if the
+// ScriptProvider has no such index, or is not loaded.
+func (p *ScriptProvider) Range(index string) iter.Seq2[string, []Script] {
+	return func(yield func(string, []Script) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		si := d.scriptIndexes[index]
+		for _, k := range si.scriptKeys {
+			if !yield(k, si.scriptMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(si scriptIndex, query string) (res ScriptResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(si.scriptKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(si.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Scripts = make([][]Script, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Scripts = append(res.Scripts, si.scriptMap[si.scriptKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,90 @@
+package script
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestScriptProvider(t *testing.T) {
+	expected := 214
+	fmt.Println("Test: ScriptProvider.Load")
+	p = new(ScriptProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestCodeSearch(t *testing.T) {
+	res, err := p.Search("code", "hant")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	s := res.(ScriptResult).Scripts
+	if len(s) != 1 || s[0][0].Numeric != "502" || s[0][0].UnicodeAlias != "Han" {
+		t.Fatalf("Expected Han (Traditional variant), got %v\n", s)
+	}
+}
+func TestNameSearch(t *testing.T) {
+	res, err := p.Search("name", "Cyrillic")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if s := res.(ScriptResult).Scripts; len(s) != 2 {
+		t.Fatalf("Expected Cyrillic and Old Church Slavonic Cyrillic, got %v\n", s)
+	}
+	res, err = p.Search("frname", "latin")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if s := res.(ScriptResult).Scripts; len(s) == 0 || s[0][0].Code != "Latn" {
+		t.Fatalf("Expected latin, got %v\n", s)
+	}
+}
+func TestAliasSearch(t *testing.T) {
+	res, err := p.Search("alias", "Old_Italic")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if s := res.(ScriptResult).Scripts; len(s) != 1 || s[0][0].Code != "Ital" {
+		t.Fatalf("Expected Old Italic, got %v\n", s)
+	}
+	res, _ = p.Search("number", "215")
+	if s := res.(ScriptResult).Scripts; len(s) != 1 || s[0][0].Code != "Latn" {
+		t.Fatalf("Expected Latin, got %v\n", s)
+	}
+}
+func TestGet(t *testing.T) {
+	sp := p.(*ScriptProvider)
+	s, err := sp.Get("latn")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if s.Code != "Latn" || s.FrenchName != "latin" {
+		t.Fatalf("Expected Latin, got %v\n", s)
+	}
+	if !sp.IsValid("Qaba") || sp.IsValid("Qaby") || sp.IsValid("Latx") || sp.IsValid("Lat") {
+		t.Fatalf("Expected only Qaba to be valid\n")
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(ScriptProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(ScriptProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "code", Query: "Latn"}, {Index: "number", Query: "215"}, {Index: "name", Query: "latin"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
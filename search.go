@@ -0,0 +1,141 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stddata
+
+import (
+	"sort"
+	"strings"
+)
+
+// SortKeys sorts the keys of an index for PrefixRange: by their lower
+// case forms, so that the keys that begin with a query, in any case,
+// are adjacent, and then by their bytes, so that the order is stable.
+// It returns the lower case forms of the sorted keys, in the same
+// order, which are what PrefixRange searches; folding them once, when
+// the index is stored, saves folding them for every query.
+func SortKeys(keys []string) (folded []string) {
+	type key struct {
+		key    string
+		folded string
+	}
+	k := make([]key, len(keys))
+	for i := range keys {
+		k[i] = key{keys[i], strings.ToLower(keys[i])}
+	}
+	sort.Slice(k, func(i, j int) bool {
+		if k[i].folded != k[j].folded {
+			return k[i].folded < k[j].folded
+		}
+		return k[i].key < k[j].key
+	})
+	folded = make([]string, len(keys))
+	for i := range k {
+		keys[i] = k[i].key
+		folded[i] = k[i].folded
+	}
+	return folded
+}
+
+// PrefixRange returns the bounds, lo inclusive and hi exclusive, of the
+// keys that begin with query, in any case: the keys that match a
+// regex-like 'query.*'. folded must be the lower case keys returned by
+// SortKeys, and the bounds are those of the keys it sorted.
+// PrefixRange binary searches the keys, so a query costs O(log n),
+// and not O(n), comparisons, and the query is folded only once.
+func PrefixRange(folded []string, query string) (lo int, hi int) {
+	q := strings.ToLower(query)
+	// the first key that is not less than the query
+	lo = sort.SearchStrings(folded, q)
+	// the keys from lo that begin with the query are followed by those
+	// that do not, which are greater.
+	hi = lo + sort.Search(len(folded)-lo, func(i int) bool {
+		return !strings.HasPrefix(folded[lo+i], q)
+	})
+	return lo, hi
+}
+
+// maxSuggestions is the most keys that Suggest returns.
+const maxSuggestions = 5
+
+// Suggest returns the keys that a query that matches none of them may
+// have been meant to match: those that begin with a prefix that is
+// within an edit or two, in any case, of query, the closest first. A
+// query of one letter is too short to be near missed. keys and folded
+// are the keys of an index, as sorted by SortKeys, and its lower case
+// keys. The keys are compared with the query one by one, so Suggest is
+// for a query that has found nothing, rather than for every query.
+func Suggest(keys []string, folded []string, query string) []string {
+	q := []rune(strings.ToLower(query))
+	if len(q) < 2 {
+		return nil
+	}
+	// a typo in a short query is a larger part of it
+	limit := 1
+	if len(q) > 4 {
+		limit = 2
+	}
+	type suggestion struct {
+		key      string
+		distance int
+	}
+	var found []suggestion
+	for i, k := range folded {
+		if d := prefixDistance(q, []rune(k), limit); d <= limit {
+			found = append(found, suggestion{keys[i], d})
+		}
+	}
+	// the keys are in order, so a stable sort keeps them in order
+	// among those that are as close as each other
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].distance < found[j].distance
+	})
+	var suggestions []string
+	for _, s := range found {
+		if len(suggestions) == maxSuggestions {
+			break
+		}
+		suggestions = append(suggestions, s.key)
+	}
+	return suggestions
+}
+
+// prefixDistance returns the least Levenshtein distance between q and a
+// prefix of key, or more than limit if every prefix is further than limit.
+func prefixDistance(q []rune, key []rune, limit int) int {
+	// the prefixes that are closest are within limit of the length of q
+	if len(key) > len(q)+limit {
+		key = key[:len(q)+limit]
+	}
+	// row holds the distances between the prefix of q so far and each
+	// prefix of key
+	row := make([]int, len(key)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(q); i++ {
+		prev := row[0]
+		row[0] = i
+		least := row[0]
+		for j := 1; j <= len(key); j++ {
+			d := prev
+			if q[i-1] != key[j-1] {
+				d = 1 + min(prev, row[j], row[j-1])
+			}
+			prev, row[j] = row[j], d
+			least = min(least, d)
+		}
+		// the distances only grow from one row to the next
+		if least > limit {
+			return least
+		}
+	}
+	least := limit + 1
+	for j := len(q) - limit; j <= len(key); j++ {
+		if j >= 0 {
+			least = min(least, row[j])
+		}
+	}
+	return least
+}
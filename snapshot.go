@@ -0,0 +1,61 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stddata
+
+import (
+	"encoding/gob"
+	"io"
+)
+
+// Snapshotter is implemented by the Providers whose loaded indexes can
+// be saved, and restored in place of a Load. A service can save a
+// snapshot after it loads, and restore it when it starts again, in a
+// fraction of the time that retrieving and parsing the source data
+// takes.
+type Snapshotter interface {
+	// SaveSnapshot writes a snapshot of the loaded indexes to w.
+	SaveSnapshot(w io.Writer) error
+	// LoadSnapshot restores the indexes from a snapshot read from r,
+	// and returns the number of items restored, as Load does.
+	LoadSnapshot(r io.Reader) (n int, err error)
+}
+
+// snapshotHeader begins each snapshot.
+type snapshotHeader struct {
+	Kind string // the kind of Provider the snapshot was saved from
+	Info Info   // the provenance of the data the snapshot holds
+}
+
+// WriteSnapshot writes a snapshot, encoded with encoding/gob, of the
+// data of a Provider: kind, which names the kind of Provider, such as
+// "currency", info, the provenance of its data, and data, which is
+// typically a map of the names of its indexes to their maps.
+func WriteSnapshot(w io.Writer, kind string, info Info, data interface{}) error {
+	enc := gob.NewEncoder(w)
+	if err := enc.Encode(snapshotHeader{kind, info}); err != nil {
+		return err
+	}
+	return enc.Encode(data)
+}
+
+// ReadSnapshot reads a snapshot written by WriteSnapshot, decoding its
+// data into data, which must be a pointer to the type that was written,
+// and returns its Info. A snapshot of another kind of Provider is an
+// error.
+func ReadSnapshot(r io.Reader, kind string, data interface{}) (info Info, err error) {
+	dec := gob.NewDecoder(r)
+	var h snapshotHeader
+	if err = dec.Decode(&h); err != nil {
+		return info, NewSourceError("Malformed snapshot: "+err.Error(), err)
+	}
+	if h.Kind != kind {
+		msg := "Snapshot of " + h.Kind + " data, not " + kind
+		return info, NewSourceError(msg, nil)
+	}
+	if err = dec.Decode(data); err != nil {
+		return info, NewSourceError("Malformed snapshot: "+err.Error(), err)
+	}
+	return h.Info, nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sqlexport
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/musicbeat/stddata"
+)
+
+// indexesTable is the table of a snapshot that describes the indexes of
+// its Providers.
+const indexesTable = "stddata_indexes"
+
+// Snapshot writes the data of each of providers, by their names, such as
+// "country", into the database of e, so that an application, typically
+// a mobile or embedded one, can ship the standard data as a single file
+// and search it with SQL rather than with the service. The database is
+// typically a new SQLite file, opened with whichever SQLite driver the
+// application uses:
+//
+//	db, err := sql.Open("sqlite3", "stddata.db")
+//	...
+//	n, err := (&sqlexport.Exporter{DB: db}).Snapshot(ctx, providers)
+//
+// Each Provider must be loaded, describe its indexes, as an
+// stddata.IndexLister does, and range over them, as the Range methods of
+// the Providers of stddata do. Its entities are the rows of a table
+// named by the Provider, or by the Tables of e, with an INTEGER _id as
+// their primary key. Each of its indexes is a table of the keys of the
+// index and the _id of their entities, named by the Provider and the
+// index, such as country_alpha2, with a database index on its keys:
+//
+//	SELECT c.* FROM country c JOIN country_alpha2 k ON k._id = c._id
+//		WHERE k.key LIKE 'DE%'
+//
+// The stddata_indexes table names the tables of each index, with its
+// description and an example. The tables are dropped and created again,
+// in a single transaction, so a snapshot can be written again into the
+// same database. Snapshot returns the number of entities written.
+func (e *Exporter) Snapshot(ctx context.Context, providers map[string]stddata.Provider) (n int, err error) {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tx, err := e.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	// after a Commit, Rollback does nothing
+	defer tx.Rollback()
+	if err := e.recreate(ctx, tx, indexesTable, []string{
+		e.Dialect.quote("provider") + " VARCHAR(255) NOT NULL",
+		e.Dialect.quote("index_name") + " VARCHAR(255) NOT NULL",
+		e.Dialect.quote("table_name") + " VARCHAR(255) NOT NULL",
+		e.Dialect.quote("description") + " TEXT",
+		e.Dialect.quote("example") + " TEXT",
+	}); err != nil {
+		return 0, err
+	}
+	for _, name := range names {
+		written, err := e.snapshot(ctx, tx, name, providers[name])
+		if err != nil {
+			return 0, err
+		}
+		n += written
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// snapshot writes the entities and the indexes of p, the Provider
+// named name, in tx, and returns the number of entities written.
+func (e *Exporter) snapshot(ctx context.Context, tx *sql.Tx, name string, p stddata.Provider) (n int, err error) {
+	lister, ok := p.(stddata.IndexLister)
+	var rng reflect.Value
+	if ok {
+		rng = reflect.ValueOf(p).MethodByName("Range")
+	}
+	if !rng.IsValid() {
+		return 0, errors.New("Cannot snapshot " + name + ", which does not describe and range over its indexes")
+	}
+	// Range(index string) iter.Seq2[string, []T]
+	rt := rng.Type()
+	if rt.NumIn() != 1 || rt.NumOut() != 1 || rt.Out(0).Kind() != reflect.Func || rt.Out(0).NumIn() != 1 {
+		return 0, errors.New("Cannot snapshot " + name + ", whose Range is not that of a Provider")
+	}
+	yieldType := rt.Out(0).In(0)
+	t := yieldType.In(1).Elem()
+	if t.Kind() != reflect.Struct {
+		return 0, errors.New("Cannot snapshot " + name + " of " + t.String() + ", which is not a struct")
+	}
+	cols := columnsOf(t, "", nil)
+	if table, found := e.Tables[name]; found {
+		name = table
+	}
+
+	defs := []string{e.Dialect.quote("_id") + " INTEGER NOT NULL PRIMARY KEY"}
+	names := []string{"_id"}
+	for _, c := range cols {
+		defs = append(defs, e.Dialect.quote(c.name)+" "+c.kind)
+		names = append(names, c.name)
+	}
+	if err := e.recreate(ctx, tx, name, defs); err != nil {
+		return 0, err
+	}
+	insert, err := tx.PrepareContext(ctx, e.insert(name, names))
+	if err != nil {
+		return 0, err
+	}
+	defer insert.Close()
+
+	// an entity is in an index under each of its keys, and in several
+	// indexes, but is written once, and known by its encoding.
+	ids := make(map[string]int64)
+	args := make([]interface{}, len(cols)+1)
+	write := func(entity reflect.Value) (id int64, err error) {
+		b, err := json.Marshal(entity.Interface())
+		if err != nil {
+			return 0, err
+		}
+		if id, found := ids[string(b)]; found {
+			return id, nil
+		}
+		id = int64(len(ids) + 1)
+		args[0] = id
+		for i, c := range cols {
+			args[i+1] = c.value(entity.FieldByIndex(c.index))
+		}
+		if _, err := insert.ExecContext(ctx, args...); err != nil {
+			return 0, err
+		}
+		ids[string(b)] = id
+		return id, nil
+	}
+
+	for _, d := range lister.Indexes() {
+		keyTable := name + "_" + d.Name
+		if err := e.recreate(ctx, tx, keyTable, []string{
+			e.Dialect.quote("key") + " VARCHAR(255) NOT NULL",
+			e.Dialect.quote("_id") + " INTEGER NOT NULL",
+		}); err != nil {
+			return 0, err
+		}
+		create := "CREATE INDEX " + e.Dialect.quote(keyTable+"_key") + " ON " + e.Dialect.quote(keyTable) + " (" + e.Dialect.quote("key") + ")"
+		if _, err := tx.ExecContext(ctx, create); err != nil {
+			return 0, err
+		}
+		describe := e.insert(indexesTable, []string{"provider", "index_name", "table_name", "description", "example"})
+		if _, err := tx.ExecContext(ctx, describe, name, d.Name, keyTable, d.Description, d.Example); err != nil {
+			return 0, err
+		}
+		if err := e.snapshotIndex(ctx, tx, keyTable, rng, d.Name, yieldType, write); err != nil {
+			return 0, err
+		}
+	}
+	return len(ids), nil
+}
+
+// snapshotIndex writes the keys of index, ranged over by rng, the Range
+// method of a Provider, into keyTable, with the _id of each of their
+// entities, as returned by write.
+func (e *Exporter) snapshotIndex(ctx context.Context, tx *sql.Tx, keyTable string, rng reflect.Value, index string,
+	yieldType reflect.Type, write func(entity reflect.Value) (int64, error)) (err error) {
+	stmt, err := tx.PrepareContext(ctx, e.insert(keyTable, []string{"key", "_id"}))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	yield := reflect.MakeFunc(yieldType, func(in []reflect.Value) []reflect.Value {
+		key, entities := in[0].String(), in[1]
+		for i := 0; i < entities.Len() && err == nil; i++ {
+			var id int64
+			if id, err = write(entities.Index(i)); err == nil {
+				_, err = stmt.ExecContext(ctx, key, id)
+			}
+		}
+		return []reflect.Value{reflect.ValueOf(err == nil)}
+	})
+	rng.Call([]reflect.Value{reflect.ValueOf(index)})[0].Call([]reflect.Value{yield})
+	return err
+}
+
+// recreate drops the table name, if it exists, and creates it with the
+// column definitions defs.
+func (e *Exporter) recreate(ctx context.Context, tx *sql.Tx, name string, defs []string) error {
+	if _, err := tx.ExecContext(ctx, "DROP TABLE IF EXISTS "+e.Dialect.quote(name)); err != nil {
+		return err
+	}
+	_, err := tx.ExecContext(ctx, "CREATE TABLE "+e.Dialect.quote(name)+" ("+strings.Join(defs, ", ")+")")
+	return err
+}
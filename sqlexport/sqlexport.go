@@ -0,0 +1,288 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package sqlexport writes the data of loaded stddata Providers into the
+tables of a database, through database/sql and whichever driver the
+application uses, so that the standard data can be joined to its own
+in SQL queries.
+
+Each entity is a row, and each field of the entity a column, named in
+snake case: the Alpha2Code of a Country is alpha2_code. A list, such as
+the CurrencyCodes of a Country, is written as its items separated by
+semicolons, a struct, such as the Region of a Country, as a column for
+each of its fields, prefixed with the name of the struct, and a value
+with a String method, such as the Memberships of a Country, as its
+String. Maps are left out.
+
+An export creates its table if it does not exist, and inserts or updates
+each row by its key, in a single transaction, so exporting again after a
+Provider is reloaded refreshes the table in place. Rows whose keys are
+no longer in the data are left in the table.
+
+A Snapshot, rather, writes every index of each of a set of Providers, as
+tables that a mobile or embedded application can ship, as a single
+SQLite file, and search without the service.
+*/
+package sqlexport
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/musicbeat/stddata/bank"
+	"github.com/musicbeat/stddata/country"
+	"github.com/musicbeat/stddata/language"
+)
+
+// Dialect is the SQL dialect of a database, which decides how the values
+// of a statement are written and how a row is inserted or updated.
+type Dialect int
+
+const (
+	SQLite     Dialect = iota // "?" values, and INSERT ... ON CONFLICT
+	PostgreSQL                // "$1" values, and INSERT ... ON CONFLICT
+	MySQL                     // "?" values, and INSERT ... ON DUPLICATE KEY UPDATE
+)
+
+// Exporter writes the data of loaded Providers into the tables of DB.
+type Exporter struct {
+	DB      *sql.DB
+	Dialect Dialect
+	// Tables names the tables by their default names, "countries",
+	// "languages" and "banks", or the name given to Export. A table that
+	// is not in Tables has its default name.
+	Tables map[string]string
+}
+
+// Countries exports the countries of p, keyed by alpha2_code, into the
+// countries table, and returns the number of rows written.
+func (e *Exporter) Countries(ctx context.Context, p *country.CountryProvider) (n int, err error) {
+	return Export(ctx, e, "countries", "Alpha2Code", p.Range("alpha2"))
+}
+
+// Languages exports the languages of p, keyed by alpha3bibliographic,
+// into the languages table, and returns the number of rows written.
+func (e *Exporter) Languages(ctx context.Context, p *language.LanguageProvider) (n int, err error) {
+	return Export(ctx, e, "languages", "Alpha3bibliographic", p.Range("term"))
+}
+
+// Banks exports the banks of p, keyed by routing, into the banks table,
+// and returns the number of rows written.
+func (e *Exporter) Banks(ctx context.Context, p *bank.BankProvider) (n int, err error) {
+	return Export(ctx, e, "banks", "Routing", p.Range("routing"))
+}
+
+// Export exports the entities of rows, such as those of the Range of an
+// index of a Provider, into the table named name, keyed by the column of
+// the field key, and returns the number of rows written. An entity that
+// is in rows under more than one key is written once.
+func Export[T any](ctx context.Context, e *Exporter, name string, key string, rows iter.Seq2[string, []T]) (n int, err error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return 0, errors.New("Cannot export " + t.String() + ", which is not a struct")
+	}
+	cols := columnsOf(t, "", nil)
+	keyCol := -1
+	for i, c := range cols {
+		if f := t.Field(c.index[0]); len(c.index) == 1 && f.Name == key && f.Type.Kind() == reflect.String {
+			keyCol = i
+		}
+	}
+	if keyCol < 0 {
+		return 0, errors.New("No string field " + key + " in " + t.String())
+	}
+	if table, found := e.Tables[name]; found {
+		name = table
+	}
+
+	tx, err := e.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	// after a Commit, Rollback does nothing
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, e.createTable(name, cols, keyCol)); err != nil {
+		return 0, err
+	}
+	stmt, err := tx.PrepareContext(ctx, e.upsert(name, cols, keyCol))
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	written := make(map[string]bool)
+	args := make([]interface{}, len(cols))
+	for _, entities := range rows {
+		for _, entity := range entities {
+			v := reflect.ValueOf(entity)
+			for i, c := range cols {
+				args[i] = c.value(v.FieldByIndex(c.index))
+			}
+			k := args[keyCol].(string)
+			if written[k] {
+				continue
+			}
+			if _, err := stmt.ExecContext(ctx, args...); err != nil {
+				return n, err
+			}
+			written[k] = true
+			n++
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// column is a column of a table, and the field of the entities that it
+// holds.
+type column struct {
+	name  string
+	index []int // of the field, for reflect.Value.FieldByIndex
+	kind  string
+}
+
+var stringer = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+// columnsOf returns the columns of the fields of t, a struct type, with
+// their names prefixed by prefix, and index, that of t in the entity.
+func columnsOf(t reflect.Type, prefix string, index []int) (cols []column) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		c := column{name: prefix + snakeCase(f.Name), index: append(append([]int(nil), index...), i)}
+		switch {
+		case f.Type.Implements(stringer):
+			c.kind = "TEXT"
+		case f.Type.Kind() == reflect.String:
+			c.kind = "TEXT"
+		case f.Type.Kind() == reflect.Bool:
+			c.kind = "BOOLEAN"
+		case f.Type.Kind() >= reflect.Int && f.Type.Kind() <= reflect.Uint64:
+			c.kind = "INTEGER"
+		case f.Type.Kind() == reflect.Slice && f.Type.Elem().Kind() == reflect.String:
+			c.kind = "TEXT"
+		case f.Type.Kind() == reflect.Struct:
+			cols = append(cols, columnsOf(f.Type, c.name+"_", c.index)...)
+			continue
+		default:
+			continue
+		}
+		cols = append(cols, c)
+	}
+	return cols
+}
+
+// value returns the value of the column of the field v.
+func (c column) value(v reflect.Value) interface{} {
+	if v.Type().Implements(stringer) {
+		return v.Interface().(fmt.Stringer).String()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Slice:
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = v.Index(i).String()
+		}
+		return strings.Join(items, ";")
+	}
+	return int64(v.Uint())
+}
+
+// snakeCase returns name, the name of a field, in snake case: alpha2_code
+// for Alpha2Code, and ioc_code for IOCCode.
+func snakeCase(name string) string {
+	r := []rune(name)
+	var b strings.Builder
+	for i, c := range r {
+		if i > 0 && unicode.IsUpper(c) {
+			prev := r[i-1]
+			nextLower := i+1 < len(r) && unicode.IsLower(r[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(c))
+	}
+	return b.String()
+}
+
+// quote returns the identifier name quoted for the Dialect.
+func (d Dialect) quote(name string) string {
+	if d == MySQL {
+		return "`" + name + "`"
+	}
+	return `"` + name + `"`
+}
+
+// createTable returns the statement that creates the table name, of
+// cols, unless it exists.
+func (e *Exporter) createTable(name string, cols []column, keyCol int) string {
+	defs := make([]string, len(cols))
+	for i, c := range cols {
+		kind := c.kind
+		// MySQL cannot key a TEXT column
+		if i == keyCol {
+			kind = "VARCHAR(255) NOT NULL"
+		}
+		defs[i] = e.Dialect.quote(c.name) + " " + kind
+	}
+	return "CREATE TABLE IF NOT EXISTS " + e.Dialect.quote(name) + " (" + strings.Join(defs, ", ") +
+		", PRIMARY KEY (" + e.Dialect.quote(cols[keyCol].name) + "))"
+}
+
+// insert returns the statement that inserts a row, of the columns
+// names, into the table name.
+func (e *Exporter) insert(name string, names []string) string {
+	quoted := make([]string, len(names))
+	values := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = e.Dialect.quote(n)
+		values[i] = "?"
+		if e.Dialect == PostgreSQL {
+			values[i] = "$" + strconv.Itoa(i+1)
+		}
+	}
+	return "INSERT INTO " + e.Dialect.quote(name) + " (" + strings.Join(quoted, ", ") + ") VALUES (" + strings.Join(values, ", ") + ")"
+}
+
+// upsert returns the statement that inserts a row into the table name,
+// or updates the row with its key.
+func (e *Exporter) upsert(name string, cols []column, keyCol int) string {
+	names := make([]string, len(cols))
+	var updates []string
+	for i, c := range cols {
+		names[i] = c.name
+		if i == keyCol {
+			continue
+		}
+		q := e.Dialect.quote(c.name)
+		if e.Dialect == MySQL {
+			updates = append(updates, q+" = VALUES("+q+")")
+		} else {
+			updates = append(updates, q+" = excluded."+q)
+		}
+	}
+	s := e.insert(name, names)
+	if e.Dialect == MySQL {
+		return s + " ON DUPLICATE KEY UPDATE " + strings.Join(updates, ", ")
+	}
+	return s + " ON CONFLICT (" + e.Dialect.quote(cols[keyCol].name) + ") DO UPDATE SET " + strings.Join(updates, ", ")
+}
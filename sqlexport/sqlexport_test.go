@@ -0,0 +1,198 @@
+package sqlexport
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/bank"
+	"github.com/musicbeat/stddata/country"
+)
+
+// recorder is a database/sql driver that records the statements it is
+// given, and the values of each execution, rather than running them.
+type recorder struct {
+	mu    sync.Mutex
+	stmts []string
+	rows  map[string][][]driver.Value
+	fail  bool // fail the executions of an upsert
+}
+
+var rec = &recorder{rows: make(map[string][][]driver.Value)}
+
+func init() {
+	sql.Register("recorder", rec)
+}
+
+func (r *recorder) Open(name string) (driver.Conn, error) { return r, nil }
+func (r *recorder) Prepare(query string) (driver.Stmt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stmts = append(r.stmts, query)
+	return &recordedStmt{r, query}, nil
+}
+func (r *recorder) Close() error              { return nil }
+func (r *recorder) Begin() (driver.Tx, error) { return r, nil }
+func (r *recorder) Commit() error             { return nil }
+func (r *recorder) Rollback() error           { return nil }
+
+type recordedStmt struct {
+	r     *recorder
+	query string
+}
+
+func (s *recordedStmt) Close() error  { return nil }
+func (s *recordedStmt) NumInput() int { return -1 }
+func (s *recordedStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+	if s.r.fail && strings.HasPrefix(s.query, "INSERT") {
+		return nil, errors.New("disk full")
+	}
+	s.r.rows[s.query] = append(s.r.rows[s.query], args)
+	return driver.RowsAffected(1), nil
+}
+func (s *recordedStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+// reset forgets the statements recorded so far.
+func (r *recorder) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stmts = nil
+	r.rows = make(map[string][][]driver.Value)
+	r.fail = false
+}
+
+func TestCountries(t *testing.T) {
+	rec.reset()
+	db, err := sql.Open("recorder", "")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	defer db.Close()
+	p := new(country.CountryProvider)
+	expected, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	e := &Exporter{DB: db, Dialect: PostgreSQL, Tables: map[string]string{"countries": "iso_countries"}}
+	n, err := e.Countries(context.Background(), p)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected %d rows, got %d\n", expected, n)
+	}
+	create, upsert := rec.stmts[0], rec.stmts[1]
+	if !strings.HasPrefix(create, `CREATE TABLE IF NOT EXISTS "iso_countries" ("english_name" TEXT, "alpha2_code" VARCHAR(255) NOT NULL,`) ||
+		!strings.Contains(create, `"region_code" TEXT, "region_name" TEXT`) || !strings.Contains(create, `"ioc_code" TEXT`) ||
+		!strings.HasSuffix(create, `PRIMARY KEY ("alpha2_code"))`) || strings.Contains(create, `"names"`) {
+		t.Fatalf("Unexpected CREATE statement %s\n", create)
+	}
+	if !strings.HasPrefix(upsert, `INSERT INTO "iso_countries" ("english_name", "alpha2_code",`) || !strings.Contains(upsert, "VALUES ($1, $2, $3,") ||
+		!strings.Contains(upsert, `ON CONFLICT ("alpha2_code") DO UPDATE SET "english_name" = excluded."english_name", "alpha3_code"`) {
+		t.Fatalf("Unexpected INSERT statement %s\n", upsert)
+	}
+	for _, row := range rec.rows[upsert] {
+		if row[1] == "DE" {
+			if row[0] != "Germany" {
+				t.Fatalf("Expected Germany, got %v\n", row)
+			}
+			for _, v := range row {
+				if v == "Europe/Berlin;Europe/Busingen" {
+					return
+				}
+			}
+			t.Fatalf("Expected the time zones of Germany, got %v\n", row)
+		}
+	}
+	t.Fatalf("Expected a row for DE\n")
+}
+func TestBanks(t *testing.T) {
+	rec.reset()
+	db, _ := sql.Open("recorder", "")
+	defer db.Close()
+	p := new(bank.BankProvider)
+	expected, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	e := &Exporter{DB: db, Dialect: MySQL}
+	n, err := e.Banks(context.Background(), p)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected %d rows, got %d\n", expected, n)
+	}
+	if upsert := rec.stmts[1]; !strings.HasPrefix(upsert, "INSERT INTO `banks` (`routing`, `office_code`,") ||
+		!strings.Contains(upsert, "ON DUPLICATE KEY UPDATE `office_code` = VALUES(`office_code`)") {
+		t.Fatalf("Unexpected INSERT statement %s\n", upsert)
+	}
+	rec.fail = true
+	if _, err := e.Banks(context.Background(), p); err == nil {
+		t.Fatalf("Expected the error of the database\n")
+	}
+}
+func TestExport(t *testing.T) {
+	if got := snakeCase("GS1Prefixes") + " " + snakeCase("IOCCode") + " " + snakeCase("Alpha3bibliographic"); got != "gs1_prefixes ioc_code alpha3bibliographic" {
+		t.Fatalf("Unexpected snake case %s\n", got)
+	}
+	db, _ := sql.Open("recorder", "")
+	defer db.Close()
+	p := new(country.CountryProvider)
+	if _, err := Export(context.Background(), &Exporter{DB: db}, "countries", "Population", p.Range("alpha2")); err == nil {
+		t.Fatalf("Expected a key that is not a field to fail\n")
+	}
+}
+func TestSnapshot(t *testing.T) {
+	rec.reset()
+	db, _ := sql.Open("recorder", "")
+	defer db.Close()
+	countries := new(country.CountryProvider)
+	expected, err := countries.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	n, err := (&Exporter{DB: db}).Snapshot(context.Background(), map[string]stddata.Provider{"country": countries})
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected %d entities, got %d\n", expected, n)
+	}
+	var entities, keys, describe string
+	for _, s := range rec.stmts {
+		switch {
+		case strings.HasPrefix(s, `CREATE TABLE "country" ("_id" INTEGER NOT NULL PRIMARY KEY, "english_name" TEXT, "alpha2_code" TEXT,`):
+			entities = s
+		case s == `INSERT INTO "country_alpha2" ("key", "_id") VALUES (?, ?)`:
+			keys = s
+		case strings.HasPrefix(s, `INSERT INTO "stddata_indexes"`):
+			describe = s
+		}
+	}
+	if entities == "" || keys == "" || describe == "" {
+		t.Fatalf("Expected the tables of country, got %v\n", rec.stmts)
+	}
+	if len(rec.rows[keys]) != expected {
+		t.Fatalf("Expected %d keys of alpha2, got %d\n", expected, len(rec.rows[keys]))
+	}
+	if len(rec.rows[describe]) != len(countries.Indexes()) {
+		t.Fatalf("Expected %d indexes described, got %v\n", len(countries.Indexes()), rec.rows[describe])
+	}
+	index := `CREATE INDEX "country_alpha2_key" ON "country_alpha2" ("key")`
+	if _, found := rec.rows[index]; !found {
+		t.Fatalf("Expected the index of the keys of alpha2\n")
+	}
+	if _, err := (&Exporter{DB: db}).Snapshot(context.Background(), map[string]stddata.Provider{"none": nil}); err == nil {
+		t.Fatalf("Expected a Provider that cannot range to fail\n")
+	}
+}
@@ -7,9 +7,37 @@ Package stddata provides a simple mechanism for making
 information about "standard" data sets available
 for lookups and queries. The standards are:
 	Federal Reserve E-Payments Routing Directory
+	ISO 9362 Business Identifier Codes (SWIFT/BIC)
+	ISO 13616 International Bank Account Numbers (IBAN)
+	UK Sort Codes and Canadian Institution and Transit Numbers
 	ISO 639 Language Codes
+	ISO 639-3 Language Codes
+	ISO 15924 Script Codes
 	ISO 4217 Currency Codes
 	ISO 3166-1 Country Codes (Officially Assigned)
+	ISO 3166-2 Country Subdivision Codes
+	ISO 3166-3 Codes for Formerly Used Names of Countries
+	ANSI/FIPS and USPS Codes for US States and Territories
+	UN M49 Standard Area Codes
+	ITU-T E.164 International Calling Codes
+	IANA Time Zone Database
+	UN/LOCODE Codes for Trade and Transport Locations
+	IATA and ICAO Airport Codes
+	IANA Media Types
+	IANA Character Sets
+	IANA Root Zone Top-Level Domains
+	Public Suffix List
+	Unicode CLDR Locales
+	ISO 18245 Merchant Category Codes
+	NAICS and SIC Industry Codes
+	Postal Code Formats
+	EU VAT Identification Numbers
+	Small Code Tables (ISO/IEC 5218 and others)
+	ID3v1 and Winamp Music Genres
+	MusicBrainz Areas and Label Codes
+	DDEX Allowed Value Sets
+	Audio Codecs and Containers
+	CLDR Calendar Conventions of Countries
 
 Packages
 
@@ -17,27 +45,162 @@ The pieces of stddata's implementation are organized
 as follows:
 	stddata - interfaces, types, and functions for managing the data providers
 	stddata/bank - Federal Reserve E-Payments Routing Directory
-		A handy, fixed format text file available at the Fed's website
+		A handy, fixed format text file available at the Fed's website.
+		A snapshot is embedded in bankdata.go, and a snapshot of the
+		Fedwire participant directory in wiredata.go.
+	stddata/bic - ISO 9362 Business Identifier Codes (SWIFT/BIC)
+		The directory is licensed by SWIFT, so it is read from a
+		file supplied by the caller.
+	stddata/iban - ISO 13616 International Bank Account Numbers (IBAN)
+		Per-country IBAN formats from SWIFT's IBAN Registry, embedded
+		in ibandata.go, and IBAN validation and formatting.
+	stddata/clearing - UK Sort Codes and Canadian Institution and Transit Numbers
+		The directories are licensed by the clearing systems, so they
+		are read from files supplied by the caller.
 	stddata/country - ISO 3166-1 Country Codes (Officially Assigned)
 		ISO charges for access to this information through their website, but
 		Wikipedia has a table of these codes. A data set was extracted from
 		Wikipedia's website for use in this package.
+	stddata/subdivision - ISO 3166-2 Country Subdivision Codes
+		States, provinces and regions, from the Debian iso-codes
+		project's data set, embedded in subdivisiondata.go.
+	stddata/usstate - ANSI/FIPS and USPS Codes for US States and Territories
+		States, territories and their capitals, embedded in statedata.go.
+	stddata/formercountry - ISO 3166-3 Codes for Formerly Used Names of Countries
+		Withdrawn codes, with their successors, from the Debian
+		iso-codes project's data set, embedded in formerdata.go.
+	stddata/area - UN M49 Standard Area Codes
+		Regions, subregions and the countries within them, from the
+		UN Statistics Division, embedded in areadata.go.
+	stddata/dialcode - ITU-T E.164 International Calling Codes
+		The calling codes of the country provider, and those of global
+		services, embedded in servicedata.go.
 	stddata/currency - ISO 4217 Currency Codes
 		A handy xml document available from iso.org's website.
 	stddata/language - ISO 639 Language Codes
 		A handy, pipe-delimited csv file.
+	stddata/language3 - ISO 639-3 Language Codes
+		Individual languages, macrolanguages and special codes, from the
+		Debian iso-codes project's data set, embedded in language3data.go.
+	stddata/langtag - BCP 47 Language Tags
+		Parsing and validation of tags such as "zh-Hant-TW", against
+		the language3, script and country providers.
+	stddata/script - ISO 15924 Script Codes
+		Scripts, with their Unicode names, from the Debian iso-codes
+		project's data set, embedded in scriptdata.go.
+	stddata/locode - UN/LOCODE Codes for Trade and Transport Locations
+		The code list is large and revised twice a year, so it is read
+		from a file supplied by the caller.
+	stddata/airport - IATA and ICAO Airport Codes
+		Major international airports, embedded in airportdata.go, or
+		a complete data set read from a file supplied by the caller.
+	stddata/mediatype - IANA Media Types
+		Media types and their file name extensions, from the Debian
+		media-types package, embedded in typedata.go.
+	stddata/charset - IANA Character Sets
+		Character sets in common use, with their MIB numbers and
+		aliases, embedded in charsetdata.go.
+	stddata/tld - IANA Root Zone Top-Level Domains
+		Generic, sponsored and country-code TLDs, from the ICANN
+		section of the Public Suffix List, embedded in tlddata.go.
+	stddata/publicsuffix - Public Suffix List
+		The rules of the Public Suffix List, a snapshot embedded in
+		suffixdata.go, which can be refreshed from publicsuffix.org.
+	stddata/locale - Unicode CLDR Locales
+		The locales of CLDR, with their English and native names, as
+		ICU lists them, embedded in localedata.go.
+	stddata/mcc - ISO 18245 Merchant Category Codes
+		The general merchant category codes and their categories, from
+		the Visa Merchant Data Standards Manual, embedded in mccdata.go.
+	stddata/industry - NAICS and SIC Industry Codes
+		NAICS sectors and subsectors, and SIC divisions and major groups,
+		are embedded in industrydata.go. The complete lists are read from
+		files supplied by the caller.
+	stddata/postal - Postal Code Formats
+		The labels, patterns and examples of postal codes by country,
+		from Google's address metadata, embedded in postaldata.go.
+	stddata/vat - EU VAT Identification Numbers
+		The prefixes, patterns and check digit algorithms of the VAT
+		numbers of the EU member states, embedded in vatdata.go.
+	stddata/codetable - Small Code Tables
+		Enumerations too small for a package of their own, such as
+		ISO/IEC 5218 sex codes, by table, embedded in codetabledata.go.
+	stddata/isrc - ISO 3901 International Standard Recording Codes
+		Parsing and validation of codes such as "US-RC1-76-07839",
+		against the country provider.
+	stddata/iswc - ISO 15707 International Standard Musical Work Codes
+		Parsing and check digits of codes such as "T-034.524.680-1".
+	stddata/ismn - ISO 10957 International Standard Music Numbers
+		Parsing, check digits and hyphenation of numbers such as
+		"979-0-2306-7118-7".
+	stddata/isni - ISO 27729 International Standard Name Identifiers
+		Parsing and check characters of identifiers such as
+		"0000 0001 2281 955X".
+	stddata/ipi - CISAC Interested Parties Information Numbers
+		Parsing and check digits of name numbers such as "00052500029",
+		and base numbers such as "I-000000229-7".
+	stddata/genre - ID3v1 and Winamp Music Genres
+		The genres of ID3v1 tags, with Winamp's extensions, embedded
+		in genredata.go.
+	stddata/musicbrainz - MusicBrainz Areas and Label Codes
+		Areas, bridged to ISO 3166, and record labels with their LC
+		numbers, read from the files of a MusicBrainz database dump.
+	stddata/ddex - DDEX Allowed Value Sets and Territory Codes
+		The allowed-value sets of DDEX messages, read from the avs.xsd
+		schema, and TerritoryCodes validated against stddata/country.
+	stddata/audioformat - Audio Codecs and Containers
+		Codecs, such as FLAC, AAC and Opus, and the containers that
+		carry them, with media types and extensions, in formatdata.go.
+	stddata/calendar - CLDR Calendar Conventions of Countries
+		The first day of the week, the weekend, the week numbering and
+		the date pattern of each country, in conventiondata.go.
+	stddata/timezone - IANA Time Zone Database
+		Zones, with their countries, from the tz database's zone.tab,
+		embedded in zonedata.go, and their offsets and abbreviations.
+	stddata/sqlexport - Export of Providers into SQL Tables
+		Writes the countries, languages and banks of loaded Providers
+		into the tables of a database/sql database, a row an entity,
+		and Snapshot writes every index of a set of Providers, as a
+		SQLite file that an application can ship without the service.
+	stddata/rediscache - Provider Data Shared through Redis
+		Writes the indexes of loaded Providers into Redis, and searches
+		them there, so that instances share one loaded data set.
+	stddata/templatefuncs - Template Functions for Standard Codes
+		A FuncMap, for text/template and html/template, of country
+		names and flags, language names and currency symbols by code.
+	stddata/validators - Validation Functions for Standard Codes
+		Functions that check country, language and currency codes and
+		routing numbers, to register with validator libraries.
+	stddata/client - Provider of a Remote Service
+		Searches a Service over HTTP as a Provider, with a cache, so
+		that an application can move the data out of process.
+
+Builds for js/wasm
+
+Every package builds for GOOS=js GOARCH=wasm, so that a tool that runs
+in a browser can look codes up without a server. There, the currency
+and language Providers, which otherwise retrieve their lists as they
+load, load the editions embedded in tabledata.go and languagedata.go,
+which are built only for js. The other Providers load embedded data
+everywhere; the few methods that retrieve newer data, such as
+LoadRefresh of the CountryProvider, retrieve it with the browser's
+fetch, and so only from the sites that allow it.
 
 */
 package stddata
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 )
 
 // Provider is the interface for a Standard Data Provider. A type that 
@@ -48,7 +211,10 @@ type Provider interface {
 	// items it has loaded. If an error occurs, it returns that as well.
 	Load() (n int, err error)
 	// Search takes the name of the index to be searched, and the value
-	// to match in that index. It returns an interface and an error.
+	// to match in that index. It returns an interface and an error:
+	// an *IndexError if there is no such index, ErrEmptyQuery if
+	// the value is empty, and ErrNotLoaded if the Provider has not
+	// been loaded.
 	// The value that is returned as v is intended to be marshaled as
 	// json -- it is expected to be the collection of entities that
 	// match the search.
@@ -60,6 +226,22 @@ type Service struct {
 	Provider   Provider
 	Count      int
 	EntityName string
+	// Enveloped makes ServeHTTP respond with an Envelope, which holds
+	// the result with the index, the query, the number of matches and
+	// the duration of the search, rather than with the result alone.
+	Enveloped bool
+	// Observer, if it is not nil, is told of each load and search, so
+	// that they can be traced and counted.
+	Observer Observer
+	// Logger, if it is not nil, logs the load of the Provider, where
+	// its data came from and how many items it loaded, and the errors
+	// of searches, with structured fields, rather than the standard
+	// logger logging just the errors. A Provider that has a Logger of
+	// its own also logs the records it skips.
+	Logger *slog.Logger
+	// SlowSearch, if it is not 0, is how long a search may take before
+	// the Logger logs it as slow.
+	SlowSearch time.Duration
 }
 
 // LoadProvider is used to prepare the data.
@@ -69,10 +251,22 @@ type Service struct {
 func (s *Service) LoadProvider(p Provider, e string) (err error) {
 	s.Provider = p
 	s.EntityName = e
+	start := time.Now()
+	done := s.observeLoad(context.Background())
 	n, err := s.Provider.Load()
+	done(n, err)
+	if s.Logger != nil {
+		var info Info
+		if i, ok := s.Provider.(interface{ Info() Info }); ok && err == nil {
+			info = i.Info()
+		}
+		LogLoad(s.Logger, e, info, LoadReport{Loaded: n}, time.Since(start), err)
+	}
 	if err != nil {
-		log.Printf("Provider for %s failed to load. %s\n", e, err)
-		return errors.New("Searches will get 503 Service Unavailable for this provider")
+		if s.Logger == nil {
+			log.Printf("Provider for %s failed to load. %s\n", e, err)
+		}
+		return NewServiceError(http.StatusServiceUnavailable, "Searches will get 503 Service Unavailable for this provider", err)
 	}
 	s.Count = n
 	return nil
@@ -85,6 +279,22 @@ func (s *Service) LoadProvider(p Provider, e string) (err error) {
 // from Search() is marshalled into json.
 func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
+	// list the indexes, for a client that builds its search forms
+	if r.URL.RawQuery == "_indexes" {
+		l, ok := s.Provider.(IndexLister)
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		j, err := json.MarshalIndent(l.Indexes(), "", "  ")
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		io.WriteString(w, fmt.Sprintf("%s\n", j))
+		return
+	}
+
 	// get the index and query values
 	var index, query string
 	var err error
@@ -93,13 +303,36 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := s.Provider.Search(index, query)
+	var res interface{}
+	start := time.Now()
+	done := s.observeSearch(r.Context(), index, query)
+	if s.Enveloped {
+		res, err = SearchEnvelope(s.Provider, index, query)
+	} else {
+		res, err = s.Provider.Search(index, query)
+	}
+	done(res, err)
+	if elapsed := time.Since(start); s.Logger != nil && s.SlowSearch > 0 && elapsed >= s.SlowSearch {
+		s.Logger.LogAttrs(r.Context(), slog.LevelWarn, "stddata slow search", slog.String("provider", s.EntityName),
+			slog.String("index", index), slog.String("query", query), slog.Duration("duration", elapsed))
+	}
 	if err != nil {
-		if serr, ok := err.(*ServiceError); ok {
+		// name the indexes there are, to help the client correct the request
+		if ierr, ok := err.(*IndexError); ok {
+			http.Error(w, ierr.Error(), http.StatusBadRequest)
+			return
+		}
+		var serr *ServiceError
+		if errors.As(err, &serr) {
 			w.WriteHeader(serr.Code)
 			return
 		}
-		log.Printf("Error %v\n", err)
+		if s.Logger != nil {
+			s.Logger.LogAttrs(r.Context(), slog.LevelError, "stddata search", slog.String("provider", s.EntityName),
+				slog.String("index", index), slog.String("query", query), slog.String("error", err.Error()))
+		} else {
+			log.Printf("Error %v\n", err)
+		}
 		return
 	}
 	// convert result to json
@@ -112,33 +345,62 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 // Get the "index=query" parts of the request, for example, "name=Abc".
-// Or for a dump of an index, "name=_dump". Or error.
+// Or for a dump of an index, "name=_dump". Or error. The parts may be
+// percent-encoded, "name=United%20Kingdom", but a "+" is itself, as in
+// "dialcode=+44", rather than a space.
 func getQuery(u string) (query string, index string, err error) {
 	v := strings.Split(u, "=")
 	if len(v) < 2 {
 		err := errors.New("Malformed request")
 		return index, query, err
 	}
-	index = v[0]
-	if len(index) < 1 {
+	if index, err = url.PathUnescape(v[0]); err != nil || len(index) < 1 {
 		err := errors.New("Malformed request")
 		return index, query, err
 	}
-	query = v[1]
-	if len(query) < 1 {
+	if query, err = url.PathUnescape(v[1]); err != nil || len(query) < 1 {
 		err := errors.New("Malformed request")
 		return index, query, err
 	}
 	return index, query, err
 }
+
 // ServiceError combines an http status code and an
-// application error message.
+// application error message, and wraps the error that caused it, if
+// any, so that errors.Is and errors.As see through it to the cause.
 type ServiceError struct {
 	Msg  string // description of error
 	Code int    // http status constant
+	Err  error  // the cause of the error, or nil
+}
+
+// NewServiceError returns a ServiceError with the http status code,
+// the message msg, and the cause, which may be nil.
+func NewServiceError(code int, msg string, cause error) *ServiceError {
+	return &ServiceError{Msg: msg, Code: code, Err: cause}
 }
 
 // Error implements the built-in error interface on ServiceError.
 func (e *ServiceError) Error() string {
 	return e.Msg
 }
+
+// Unwrap returns the cause of the error, or nil.
+func (e *ServiceError) Unwrap() error {
+	return e.Err
+}
+
+// StatusCode returns the http status code that a Service answers err
+// with: the Code of the first ServiceError in the chain of err,
+// http.StatusBadRequest for an unknown index, and otherwise
+// http.StatusInternalServerError.
+func StatusCode(err error) int {
+	var serr *ServiceError
+	switch {
+	case errors.Is(err, ErrUnknownIndex):
+		return http.StatusBadRequest
+	case errors.As(err, &serr):
+		return serr.Code
+	}
+	return http.StatusInternalServerError
+}
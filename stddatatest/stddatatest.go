@@ -0,0 +1,85 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package stddatatest benchmarks the Providers of stddata, for the
+tests of the packages that implement them. The benchmarks report the
+allocations of each operation as well as its time, so that the
+results of go test -bench, from before and after a change made for
+performance, can be compared with benchstat. AllocsPerSearch lets a
+test guard the allocations of a search against regression, and
+CheckIndexes checks the descriptions of the indexes of a Provider.
+*/
+package stddatatest
+
+import (
+	"testing"
+
+	"github.com/musicbeat/stddata"
+)
+
+// A Search is the index and the query of a search.
+type Search struct {
+	Index string
+	Query string
+}
+
+// BenchmarkLoad benchmarks loading a Provider returned by newProvider,
+// a new one for each load.
+func BenchmarkLoad(b *testing.B, newProvider func() stddata.Provider) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := newProvider().Load(); err != nil {
+			b.Fatalf("Err %v\n", err)
+		}
+	}
+}
+
+// BenchmarkSearch benchmarks the searches of p, which must be loaded,
+// taking each of searches in turn.
+func BenchmarkSearch(b *testing.B, p stddata.Provider, searches []Search) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s := searches[i%len(searches)]
+		if _, err := p.Search(s.Index, s.Query); err != nil {
+			b.Fatalf("Err %v\n", err)
+		}
+	}
+}
+
+// AllocsPerSearch returns the average number of allocations that a
+// search of p, which must be loaded, makes.
+func AllocsPerSearch(p stddata.Provider, s Search) float64 {
+	return testing.AllocsPerRun(100, func() {
+		p.Search(s.Index, s.Query)
+	})
+}
+
+// CheckIndexes checks that p, which must be loaded, describes its
+// indexes, and that the example query of each finds something.
+func CheckIndexes(t *testing.T, p stddata.Provider) {
+	l, ok := p.(stddata.IndexLister)
+	if !ok {
+		t.Fatalf("Expected %T to describe its indexes\n", p)
+	}
+	indexes := l.Indexes()
+	if len(indexes) < 1 {
+		t.Fatalf("Expected %T to have indexes\n", p)
+	}
+	seen := make(map[string]bool)
+	for _, d := range indexes {
+		if seen[d.Name] || len(d.Description) < 1 || len(d.Example) < 1 {
+			t.Fatalf("Expected a single, complete description of %s, got %+v\n", d.Name, d)
+		}
+		seen[d.Name] = true
+		e, err := stddata.SearchEnvelope(p, d.Name, d.Example)
+		if err != nil {
+			t.Fatalf("%s %q: Err %v\n", d.Name, d.Example, err)
+		}
+		if e.Total < 1 {
+			t.Fatalf("Expected %s %q to find something\n", d.Name, d.Example)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package subdivision implements the methods of a stddata.Provider.
+It provides searches against the data set of ISO 3166-2
+country subdivision codes: the states, provinces, regions and
+other principal subdivisions of the ISO 3166-1 countries.
+Source data is declared in subdivisiondata.go
+*/
+package subdivision
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// SubdivisionProvider implements the Provider interface.
+type SubdivisionProvider struct {
+	data atomic.Pointer[subdivisionData] // nil until the data is loaded
+}
+
+// subdivisionData is the data of a SubdivisionProvider. Once it is
+// stored in the SubdivisionProvider it is not modified.
+type subdivisionData struct {
+	size               int
+	info               stddata.Info
+	subdivisionIndexes map[string]subdivisionIndex
+}
+
+type subdivisionIndex struct {
+	subdivisionMap  map[string][]Subdivision
+	subdivisionKeys []string
+	foldedKeys      []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Subdivision models one entity.
+type Subdivision struct {
+	Code        string // ISO 3166-2 code, for example "US-CA"
+	Name        string
+	Category    string // for example "State", "Province" or "Region"
+	CountryCode string // ISO 3166-1 alpha-2 code of the country
+	ParentCode  string // ISO 3166-2 code of the parent subdivision, if any
+}
+
+// SubdivisionResult is the interface{} that is returned from Search
+type SubdivisionResult struct {
+	Subdivisions [][]Subdivision
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *SubdivisionProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped,
+// and their line numbers are returned in the LoadReport.
+func (p *SubdivisionProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &subdivisionData{subdivisionIndexes: make(map[string]subdivisionIndex)}
+	codeMap := make(map[string][]Subdivision)
+	nameMap := make(map[string][]Subdivision)
+	countryMap := make(map[string][]Subdivision)
+	categoryMap := make(map[string][]Subdivision)
+
+	reader := csv.NewReader(strings.NewReader(subdivisiondata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 4
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var s Subdivision
+		s.Code = record[0]
+		s.Name = record[1]
+		s.Category = record[2]
+		s.ParentCode = record[3]
+		if i := strings.Index(s.Code, "-"); i > 0 {
+			s.CountryCode = s.Code[0:i]
+		} else if mode == stddata.Lenient {
+			line, _ := reader.FieldPos(0)
+			r.Skip(line)
+			continue
+		} else {
+			line, _ := reader.FieldPos(0)
+			msg := fmt.Sprintf("record on line %d: malformed subdivision code %q", line, s.Code)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Subdivision to the maps
+		codeMap[s.Code] = append(codeMap[s.Code], s)
+		nameMap[s.Name] = append(nameMap[s.Name], s)
+		countryMap[s.CountryCode] = append(countryMap[s.CountryCode], s)
+		categoryMap[s.Category] = append(categoryMap[s.Category], s)
+	}
+	p.storeData(d, "code", codeMap)
+	p.storeData(d, "name", nameMap)
+	p.storeData(d, "country", countryMap)
+	p.storeData(d, "category", categoryMap)
+	d.size = len(codeMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(codeMap)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *SubdivisionProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// IsValid reports whether code is exactly an ISO 3166-2 subdivision
+// code, for example "US-CA". Unlike Search, a partial code is not
+// accepted.
+func (p *SubdivisionProvider) IsValid(code string) bool {
+	d := p.data.Load()
+	if d == nil {
+		return false
+	}
+	_, found := d.subdivisionIndexes["code"].subdivisionMap[strings.ToUpper(code)]
+	return found
+}
+
+func (p *SubdivisionProvider) storeData(d *subdivisionData, s string, m map[string][]Subdivision) {
+	// store the map
+	var si subdivisionIndex
+	si.subdivisionMap = m
+	// extract the keys
+	si.subdivisionKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		si.subdivisionKeys[i] = k
+		i++
+	}
+	// sort the keys
+	si.foldedKeys = stddata.SortKeys(si.subdivisionKeys)
+	// add to subdivisionIndexes
+	d.subdivisionIndexes[s] = si
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *SubdivisionProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "code", Description: "ISO 3166-2 code", Example: "US-CA"},
+		{Name: "name", Description: "name", Example: "California"},
+		{Name: "country", Description: "ISO 3166-1 alpha-2 code of the country", Example: "US"},
+		{Name: "category", Description: "category, such as State or Province", Example: "Province"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *SubdivisionProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	si := d.subdivisionIndexes[index]
+	return stddata.Suggest(si.subdivisionKeys, si.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Subdivision entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Subdivisions are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+func (p *SubdivisionProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	si, found := d.subdivisionIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.subdivisionIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(si, query)
+	return result, nil
+}
+
+// SearchSubdivisions is Search, returning the matches as they are, rather
+// than as a SubdivisionResult in an interface{}.
+func (p *SubdivisionProvider) SearchSubdivisions(index string, query string) ([][]Subdivision, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(SubdivisionResult).Subdivisions, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Subdivisions of each. It yields nothing if the
+// SubdivisionProvider has no such index, or is not loaded.
+func (p *SubdivisionProvider) Range(index string) iter.Seq2[string, []Subdivision] {
+	return func(yield func(string, []Subdivision) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		si := d.subdivisionIndexes[index]
+		for _, k := range si.subdivisionKeys {
+			if !yield(k, si.subdivisionMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(si subdivisionIndex, query string) (res SubdivisionResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(si.subdivisionKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(si.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Subdivisions = make([][]Subdivision, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Subdivisions = append(res.Subdivisions, si.subdivisionMap[si.subdivisionKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,79 @@
+package subdivision
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestSubdivisionProvider(t *testing.T) {
+	expected := 5127
+	fmt.Println("Test: SubdivisionProvider.Load")
+	p = new(SubdivisionProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestCodeSearch(t *testing.T) {
+	res, err := p.Search("code", "us-ca")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	s := res.(SubdivisionResult).Subdivisions
+	if len(s) != 1 || s[0][0].Name != "California" || s[0][0].CountryCode != "US" {
+		t.Fatalf("Expected California, got %v\n", s)
+	}
+}
+func TestCountrySearch(t *testing.T) {
+	res, err := p.Search("country", "CA")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	s := res.(SubdivisionResult).Subdivisions
+	if len(s) != 1 || len(s[0]) != 13 {
+		t.Fatalf("Expected the 13 subdivisions of Canada, got %v\n", s)
+	}
+}
+func TestNameSearch(t *testing.T) {
+	_, err := p.Search("name", "b")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+}
+func TestCategorySearch(t *testing.T) {
+	_, err := p.Search("category", "Province")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+}
+func TestIsValid(t *testing.T) {
+	sp := p.(*SubdivisionProvider)
+	if !sp.IsValid("US-CA") {
+		t.Fatalf("Expected US-CA to be valid\n")
+	}
+	if sp.IsValid("US-C") {
+		t.Fatalf("Expected US-C to be invalid\n")
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(SubdivisionProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(SubdivisionProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "code", Query: "us-ca"}, {Index: "name", Query: "new"}, {Index: "country", Query: "CA"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
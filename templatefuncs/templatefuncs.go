@@ -0,0 +1,137 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package templatefuncs resolves standard codes inline in server-rendered
+templates, with functions backed by loaded stddata Providers:
+
+	t := template.New("page").Funcs(f.FuncMap())
+
+	{{countryFlag .Country}} {{countryName .Country}}
+	{{languageName .Lang}}
+	{{currencySymbol .Currency}}{{.Amount}}
+
+The FuncMap is a map[string]interface{}, so it can be given to the Funcs
+of both text/template and html/template. A code that is not found is
+rendered as it is, so that a page still shows something useful for it.
+*/
+package templatefuncs
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/musicbeat/stddata/country"
+	"github.com/musicbeat/stddata/currency"
+	"github.com/musicbeat/stddata/language"
+)
+
+// Funcs holds the loaded Providers behind the functions of a FuncMap.
+// The functions of a Provider that is nil are left out of the FuncMap,
+// so that a template that calls them fails to parse.
+type Funcs struct {
+	Countries  *country.CountryProvider
+	Languages  *language.LanguageProvider
+	Currencies *currency.CurrencyProvider
+}
+
+// FuncMap returns the functions, by their names in templates:
+//
+//	countryName     the English name of a country, by its ISO 3166-1
+//	                alpha-2, alpha-3 or numeric code: "Germany" for "DE"
+//	countryFlag     the flag emoji of a country, by the same codes
+//	languageName    the English name of a language, by its ISO 639-1
+//	                or ISO 639-2 code: "German" for "de" or "ger"
+//	currencySymbol  the symbol of a currency, by its ISO 4217 code:
+//	                "€" for "EUR", or the code if it has none
+func (f *Funcs) FuncMap() map[string]interface{} {
+	m := make(map[string]interface{})
+	if f.Countries != nil {
+		m["countryName"] = f.CountryName
+		m["countryFlag"] = f.CountryFlag
+	}
+	if f.Languages != nil {
+		m["languageName"] = f.LanguageName
+	}
+	if f.Currencies != nil {
+		m["currencySymbol"] = f.CurrencySymbol
+	}
+	return m
+}
+
+// CountryName returns the English name of the country whose alpha-2,
+// alpha-3 or numeric code is code, or code if there is none.
+func (f *Funcs) CountryName(code string) string {
+	c, found := f.country(code)
+	if !found {
+		return code
+	}
+	return c.EnglishName
+}
+
+// CountryFlag returns the flag emoji of the country whose alpha-2,
+// alpha-3 or numeric code is code, or code if there is none.
+func (f *Funcs) CountryFlag(code string) string {
+	c, found := f.country(code)
+	if !found {
+		return code
+	}
+	return country.FlagEmoji(c.Alpha2Code)
+}
+
+// country returns the Country whose code, of the kind its form tells,
+// is code, and whether there is one.
+func (f *Funcs) country(code string) (c country.Country, found bool) {
+	var err error
+	if n, nerr := strconv.Atoi(code); nerr == nil {
+		c, err = f.Countries.GetByNumeric(n)
+	} else if len(code) == 3 {
+		c, err = f.Countries.GetByAlpha3(code)
+	} else {
+		c, err = f.Countries.GetByAlpha2(code)
+	}
+	return c, err == nil
+}
+
+// LanguageName returns the English name of the language whose ISO 639-1
+// code, or ISO 639-2 bibliographic or terminologic code, is code, or
+// code if there is none.
+func (f *Funcs) LanguageName(code string) string {
+	index := "alpha"
+	if len(code) == 2 {
+		index = "alpha2"
+	}
+	groups, err := f.Languages.SearchLanguages(index, code)
+	if err != nil {
+		return code
+	}
+	// the search finds the codes that begin with code, too
+	for _, languages := range groups {
+		for _, l := range languages {
+			if strings.EqualFold(l.Alpha2, code) || strings.EqualFold(l.Alpha3bibliographic, code) ||
+				strings.EqualFold(l.Alpha3terminologic, code) {
+				return l.EnglishName
+			}
+		}
+	}
+	return code
+}
+
+// CurrencySymbol returns the symbol of the currency whose alphabetic
+// code is code, or code if there is none, or if the currency has no
+// symbol.
+func (f *Funcs) CurrencySymbol(code string) string {
+	groups, err := f.Currencies.SearchCurrencies("code", code)
+	if err != nil {
+		return code
+	}
+	for _, currencies := range groups {
+		for _, c := range currencies {
+			if strings.EqualFold(c.CurrencyCode, code) && c.Formatting.Symbol != "" {
+				return c.Formatting.Symbol
+			}
+		}
+	}
+	return code
+}
@@ -0,0 +1,58 @@
+package templatefuncs
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"testing"
+	"text/template"
+
+	"github.com/musicbeat/stddata/country"
+	"github.com/musicbeat/stddata/currency"
+	"github.com/musicbeat/stddata/language"
+)
+
+var f Funcs
+
+func TestFuncMap(t *testing.T) {
+	f.Countries = new(country.CountryProvider)
+	if _, err := f.Countries.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	// without languages or currencies, their functions are not defined
+	if _, err := template.New("t").Funcs(f.FuncMap()).Parse("{{languageName .}}"); err == nil {
+		t.Fatalf("Expected languageName to be left out\n")
+	}
+	tmpl := template.Must(template.New("t").Funcs(f.FuncMap()).Parse("{{countryFlag .}} {{countryName .}}"))
+	for _, code := range []string{"DE", "de", "DEU", "276"} {
+		var b bytes.Buffer
+		if err := tmpl.Execute(&b, code); err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+		if b.String() != "🇩🇪 Germany" {
+			t.Fatalf("Expected 🇩🇪 Germany for %s, got %s\n", code, b.String())
+		}
+	}
+	html := htmltemplate.Must(htmltemplate.New("t").Funcs(f.FuncMap()).Parse("<b>{{countryName .}}</b>"))
+	var b bytes.Buffer
+	if err := html.Execute(&b, "BA"); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if b.String() != "<b>Bosnia and Herzegovina</b>" {
+		t.Fatalf("Expected Bosnia and Herzegovina, got %s\n", b.String())
+	}
+}
+func TestUnknownCodes(t *testing.T) {
+	for _, code := range []string{"XX", "XXX", "999", ""} {
+		if name := f.CountryName(code); name != code {
+			t.Fatalf("Expected %s, got %s\n", code, name)
+		}
+		if flag := f.CountryFlag(code); flag != code {
+			t.Fatalf("Expected %s, got %s\n", code, flag)
+		}
+	}
+	f.Languages = new(language.LanguageProvider)
+	f.Currencies = new(currency.CurrencyProvider)
+	if len(f.FuncMap()) != 4 {
+		t.Fatalf("Expected 4 functions, got %v\n", f.FuncMap())
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package timezone implements the methods of a stddata.Provider.
+It provides searches against the zones of the IANA Time Zone
+Database, such as "Europe/Paris", with the country of each zone and
+its current standard and daylight saving time offsets and
+abbreviations. Source data is declared in zonedata.go; the offsets
+are computed from the tz database that the time package loads, which
+is embedded by importing time/tzdata, so they do not depend on the
+zoneinfo files of the host.
+*/
+package timezone
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+	_ "time/tzdata" // the offsets must not depend on the host
+
+	"github.com/musicbeat/stddata"
+)
+
+// TimeZoneProvider implements the Provider interface.
+type TimeZoneProvider struct {
+	data atomic.Pointer[timeZoneData] // nil until the data is loaded
+}
+
+// timeZoneData is the data of a TimeZoneProvider. Once it is stored in
+// the TimeZoneProvider it is not modified.
+type timeZoneData struct {
+	size        int
+	info        stddata.Info
+	zoneIndexes map[string]zoneIndex
+}
+
+type zoneIndex struct {
+	zoneMap    map[string][]Zone
+	zoneKeys   []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Zone models one entity.
+type Zone struct {
+	ID          string // the zone identifier, for example "America/New_York"
+	CountryCode string // ISO 3166-1 alpha-2 code of the country
+	Coordinates string // the principal location, in ISO 6709 format, for example "+404251-0740023"
+	Comment     string // distinguishes the zones of a country, for example "Eastern (most areas)"
+	// StandardOffset and DSTOffset are the offsets from UTC, in the
+	// form "-05:00", in the current year. When the zone does not
+	// observe daylight saving time, they are the same.
+	StandardOffset       string
+	DSTOffset            string
+	StandardAbbreviation string // for example "EST", or "-03" where the tz database has none
+	DSTAbbreviation      string // for example "EDT"; the same as StandardAbbreviation without DST
+	ObservesDST          bool
+}
+
+// ZoneResult is the interface{} that is returned from Search
+type ZoneResult struct {
+	Zones [][]Zone
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *TimeZoneProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records, and zones the
+// tz database cannot load, are skipped, and their line numbers are
+// returned in the LoadReport.
+func (p *TimeZoneProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &timeZoneData{zoneIndexes: make(map[string]zoneIndex)}
+	idMap := make(map[string][]Zone)
+	countryMap := make(map[string][]Zone)
+	offsetMap := make(map[string][]Zone)
+
+	reader := csv.NewReader(strings.NewReader(zonedata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 4
+	reader.LazyQuotes = true
+
+	year := time.Now().Year()
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var z Zone
+		z.CountryCode = record[0]
+		z.Coordinates = record[1]
+		z.ID = record[2]
+		z.Comment = record[3]
+		if err = z.setOffsets(year); err != nil {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: %s", line, err.Error())
+			return r, stddata.NewSourceError(msg, err)
+		}
+
+		// add the Zone to the maps
+		idMap[z.ID] = append(idMap[z.ID], z)
+		countryMap[z.CountryCode] = append(countryMap[z.CountryCode], z)
+		offsetMap[z.StandardOffset] = append(offsetMap[z.StandardOffset], z)
+		if z.ObservesDST {
+			offsetMap[z.DSTOffset] = append(offsetMap[z.DSTOffset], z)
+		}
+	}
+	p.storeData(d, "id", idMap)
+	p.storeData(d, "country", countryMap)
+	p.storeData(d, "offset", offsetMap)
+	d.size = len(idMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(idMap)
+	return r, nil
+}
+
+// setOffsets sets the offsets and abbreviations of z from the tz
+// database, as they are in January and in July of year.
+func (z *Zone) setOffsets(year int) error {
+	loc, err := time.LoadLocation(z.ID)
+	if err != nil {
+		return err
+	}
+	std := time.Date(year, time.January, 1, 12, 0, 0, 0, loc)
+	dst := time.Date(year, time.July, 1, 12, 0, 0, 0, loc)
+	if std.IsDST() {
+		// the southern hemisphere
+		std, dst = dst, std
+	}
+	if !dst.IsDST() {
+		dst = std
+	}
+	z.ObservesDST = dst.IsDST()
+	z.StandardAbbreviation, _ = std.Zone()
+	z.DSTAbbreviation, _ = dst.Zone()
+	z.StandardOffset = std.Format("-07:00")
+	z.DSTOffset = dst.Format("-07:00")
+	return nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *TimeZoneProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the Zone whose identifier is exactly id. Unlike Search,
+// it neither matches prefixes nor ignores case, so it is the way to
+// validate a zone identifier.
+func (p *TimeZoneProvider) Get(id string) (z Zone, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return z, stddata.ErrNotLoaded
+	}
+	zones, found := d.zoneIndexes["id"].zoneMap[id]
+	if !found {
+		msg := "No time zone " + id
+		return z, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return zones[0], nil
+}
+
+// IsValid reports whether id is exactly the identifier of a zone.
+func (p *TimeZoneProvider) IsValid(id string) bool {
+	_, err := p.Get(id)
+	return err == nil
+}
+
+func (p *TimeZoneProvider) storeData(d *timeZoneData, s string, m map[string][]Zone) {
+	// store the map
+	var zi zoneIndex
+	zi.zoneMap = m
+	// extract the keys
+	zi.zoneKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		zi.zoneKeys[i] = k
+		i++
+	}
+	// sort the keys
+	zi.foldedKeys = stddata.SortKeys(zi.zoneKeys)
+	// add to zoneIndexes
+	d.zoneIndexes[s] = zi
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *TimeZoneProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "id", Description: "zone identifier", Example: "America/New_York"},
+		{Name: "country", Description: "ISO 3166-1 alpha-2 code of the country", Example: "US"},
+		{Name: "offset", Description: "standard or daylight saving offset from UTC", Example: "-05:00"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *TimeZoneProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	zi := d.zoneIndexes[index]
+	return stddata.Suggest(zi.zoneKeys, zi.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Zone entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Zones are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The offset index is keyed by both the standard and the daylight saving time offsets
+// of each zone, in the form "+05:30", so the query "+05" finds the zones with offsets
+// of +05:00, +05:30 and +05:45.
+func (p *TimeZoneProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	zi, found := d.zoneIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.zoneIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(zi, query)
+	return result, nil
+}
+
+// SearchZones is Search, returning the matches as they are, rather
+// than as a ZoneResult in an interface{}.
+func (p *TimeZoneProvider) SearchZones(index string, query string) ([][]Zone, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(ZoneResult).Zones, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Zones of each. It yields nothing if the
+// TimeZoneProvider has no such index, or is not loaded.
+func (p *TimeZoneProvider) Range(index string) iter.Seq2[string, []Zone] {
+	return func(yield func(string, []Zone) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		zi := d.zoneIndexes[index]
+		for _, k := range zi.zoneKeys {
+			if !yield(k, zi.zoneMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(zi zoneIndex, query string) (res ZoneResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(zi.zoneKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(zi.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Zones = make([][]Zone, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Zones = append(res.Zones, zi.zoneMap[zi.zoneKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,92 @@
+package timezone
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestTimeZoneProvider(t *testing.T) {
+	expected := 418
+	fmt.Println("Test: TimeZoneProvider.Load")
+	p = new(TimeZoneProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestIDSearch(t *testing.T) {
+	res, err := p.Search("id", "america/new_y")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	z := res.(ZoneResult).Zones
+	if len(z) != 1 || z[0][0].CountryCode != "US" {
+		t.Fatalf("Expected New York, got %v\n", z)
+	}
+	ny := z[0][0]
+	if !ny.ObservesDST || ny.StandardOffset != "-05:00" || ny.DSTOffset != "-04:00" ||
+		ny.StandardAbbreviation != "EST" || ny.DSTAbbreviation != "EDT" {
+		t.Fatalf("Unexpected offsets for New York: %+v\n", ny)
+	}
+}
+func TestSouthernHemisphere(t *testing.T) {
+	z, err := p.(*TimeZoneProvider).Get("Australia/Sydney")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if z.StandardOffset != "+10:00" || z.DSTOffset != "+11:00" || z.StandardAbbreviation != "AEST" {
+		t.Fatalf("Unexpected offsets for Sydney: %+v\n", z)
+	}
+}
+func TestCountrySearch(t *testing.T) {
+	res, err := p.Search("country", "CH")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	z := res.(ZoneResult).Zones
+	if len(z) != 1 || len(z[0]) != 1 || z[0][0].ID != "Europe/Zurich" {
+		t.Fatalf("Expected Zurich, got %v\n", z)
+	}
+}
+func TestOffsetSearch(t *testing.T) {
+	res, err := p.Search("offset", "+05:30")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	z := res.(ZoneResult).Zones
+	if len(z) != 1 || z[0][0].ObservesDST {
+		t.Fatalf("Expected the zones of India and Sri Lanka, got %v\n", z)
+	}
+	res, _ = p.Search("offset", "+05")
+	if len(res.(ZoneResult).Zones) < 3 {
+		t.Fatalf("Expected offsets +05:00, +05:30 and +05:45, got %v\n", res)
+	}
+}
+func TestIsValid(t *testing.T) {
+	tp := p.(*TimeZoneProvider)
+	if !tp.IsValid("Europe/Paris") || tp.IsValid("europe/paris") || tp.IsValid("Europe/Par") {
+		t.Fatalf("Expected only Europe/Paris to be valid\n")
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(TimeZoneProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(TimeZoneProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "id", Query: "america/new_y"}, {Index: "country", Query: "CH"}, {Index: "offset", Query: "+01:00"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
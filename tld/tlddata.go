@@ -0,0 +1,1499 @@
+package tld
+
+// provenance of tlddata, reported by Info
+const (
+	source    = "IANA Root Zone Database, as listed by the Public Suffix List"
+	sourceURL = "https://www.iana.org/domains/root/db"
+	edition   = "2023-02-09"
+)
+
+/*
+tlddata holds the top-level domains of the root zone, as they are
+listed in the ICANN section of the Public Suffix List, with the
+registry operators of the gTLDs delegated since 2013, from ICANN's
+list of gTLDs. Each line holds the domain in ASCII, its Unicode form
+for internationalized domains, its type, its manager and, for
+ccTLDs, the ISO 3166-1 alpha-2 code of the country, separated by
+tabs. The manager is not recorded for ccTLDs and the older gTLDs,
+and the ccTLDs of places that are not countries, such as .eu and
+.su, have no country code.
+*/
+var tlddata = `aaa		generic	American Automobile Association, Inc.	
+aarp		generic	AARP	
+abarth		generic	Fiat Chrysler Automobiles N.V.	
+abb		generic	ABB Ltd	
+abbott		generic	Abbott Laboratories, Inc.	
+abbvie		generic	AbbVie Inc.	
+abc		generic	Disney Enterprises, Inc.	
+able		generic	Able Inc.	
+abogado		generic	Registry Services, LLC	
+abudhabi		generic	Abu Dhabi Systems and Information Centre	
+ac		country-code		
+academy		generic	Binky Moon, LLC	
+accenture		generic	Accenture plc	
+accountant		generic	dot Accountant Limited	
+accountants		generic	Binky Moon, LLC	
+aco		generic	ACO Severin Ahlmann GmbH & Co. KG	
+actor		generic	Dog Beach, LLC	
+ad		country-code		AD
+ads		generic	Charleston Road Registry Inc.	
+adult		generic	ICM Registry AD LLC	
+ae		country-code		AE
+aeg		generic	Aktiebolaget Electrolux	
+aero		sponsored		
+aetna		generic	Aetna Life Insurance Company	
+af		country-code		AF
+afl		generic	Australian Football League	
+africa		generic	ZA Central Registry NPC trading as Registry.Africa	
+ag		country-code		AG
+agakhan		generic	Fondation Aga Khan (Aga Khan Foundation)	
+agency		generic	Binky Moon, LLC	
+ai		country-code		AI
+aig		generic	American International Group, Inc.	
+airbus		generic	Airbus S.A.S.	
+airforce		generic	Dog Beach, LLC	
+airtel		generic	Bharti Airtel Limited	
+akdn		generic	Fondation Aga Khan (Aga Khan Foundation)	
+al		country-code		AL
+alfaromeo		generic	Fiat Chrysler Automobiles N.V.	
+alibaba		generic	Alibaba Group Holding Limited	
+alipay		generic	Alibaba Group Holding Limited	
+allfinanz		generic	Allfinanz Deutsche Vermögensberatung Aktiengesellschaft	
+allstate		generic	Allstate Fire and Casualty Insurance Company	
+ally		generic	Ally Financial Inc.	
+alsace		generic	Region Grand Est	
+alstom		generic	ALSTOM	
+am		country-code		AM
+amazon		generic	Amazon Registry Services, Inc.	
+americanexpress		generic	American Express Travel Related Services Company, Inc.	
+americanfamily		generic	AmFam, Inc.	
+amex		generic	American Express Travel Related Services Company, Inc.	
+amfam		generic	AmFam, Inc.	
+amica		generic	Amica Mutual Insurance Company	
+amsterdam		generic	Gemeente Amsterdam	
+analytics		generic	Campus IP LLC	
+android		generic	Charleston Road Registry Inc.	
+anquan		generic	Beijing Qihu Keji Co., Ltd.	
+anz		generic	Australia and New Zealand Banking Group Limited	
+ao		country-code		AO
+aol		generic	Oath Inc.	
+apartments		generic	Binky Moon, LLC	
+app		generic	Charleston Road Registry Inc.	
+apple		generic	Apple Inc.	
+aq		country-code		AQ
+aquarelle		generic	Aquarelle.com	
+ar		country-code		AR
+arab		generic	League of Arab States	
+aramco		generic	Aramco Services Company	
+archi		generic	Identity Digital Limited	
+army		generic	Dog Beach, LLC	
+arpa		infrastructure		
+art		generic	UK Creative Ideas Limited	
+arte		generic	Association Relative à la Télévision Européenne G.E.I.E.	
+as		country-code		AS
+asda		generic	Wal-Mart Stores, Inc.	
+asia		sponsored		
+associates		generic	Binky Moon, LLC	
+at		country-code		AT
+athleta		generic	The Gap, Inc.	
+attorney		generic	Dog Beach, LLC	
+au		country-code		AU
+auction		generic	Dog Beach, LLC	
+audi		generic	AUDI Aktiengesellschaft	
+audible		generic	Amazon Registry Services, Inc.	
+audio		generic	XYZ.COM LLC	
+auspost		generic	Australian Postal Corporation	
+author		generic	Amazon Registry Services, Inc.	
+auto		generic	XYZ.COM LLC	
+autos		generic	XYZ.COM LLC	
+avianca		generic	Avianca Inc.	
+aw		country-code		AW
+aws		generic	AWS Registry LLC	
+ax		country-code		AX
+axa		generic	AXA Group Operations SAS	
+az		country-code		AZ
+azure		generic	Microsoft Corporation	
+ba		country-code		BA
+baby		generic	XYZ.COM LLC	
+baidu		generic	Baidu, Inc.	
+banamex		generic	Citigroup Inc.	
+bananarepublic		generic	The Gap, Inc.	
+band		generic	Dog Beach, LLC	
+bank		generic	fTLD Registry Services LLC	
+bar		generic	Punto 2012 Sociedad Anonima Promotora de Inversion de Capital Variable	
+barcelona		generic	Municipi de Barcelona	
+barclaycard		generic	Barclays Bank PLC	
+barclays		generic	Barclays Bank PLC	
+barefoot		generic	Gallo Vineyards, Inc.	
+bargains		generic	Binky Moon, LLC	
+baseball		generic	MLB Advanced Media DH, LLC	
+basketball		generic	Fédération Internationale de Basketball (FIBA)	
+bauhaus		generic	Werkhaus GmbH	
+bayern		generic	Bayern Connect GmbH	
+bb		country-code		BB
+bbc		generic	British Broadcasting Corporation	
+bbt		generic	BB&T Corporation	
+bbva		generic	BANCO BILBAO VIZCAYA ARGENTARIA, S.A.	
+bcg		generic	The Boston Consulting Group, Inc.	
+bcn		generic	Municipi de Barcelona	
+be		country-code		BE
+beats		generic	Beats Electronics, LLC	
+beauty		generic	XYZ.COM LLC	
+beer		generic	Registry Services, LLC	
+bentley		generic	Bentley Motors Limited	
+berlin		generic	dotBERLIN GmbH & Co. KG	
+best		generic	BestTLD Pty Ltd	
+bestbuy		generic	BBY Solutions, Inc.	
+bet		generic	Identity Digital Limited	
+bf		country-code		BF
+bg		country-code		BG
+bh		country-code		BH
+bharti		generic	Bharti Enterprises (Holding) Private Limited	
+bi		country-code		BI
+bible		generic	American Bible Society	
+bid		generic	dot Bid Limited	
+bike		generic	Binky Moon, LLC	
+bing		generic	Microsoft Corporation	
+bingo		generic	Binky Moon, LLC	
+bio		generic	Identity Digital Limited	
+biz		generic-restricted		
+bj		country-code		BJ
+black		generic	Identity Digital Limited	
+blackfriday		generic	Registry Services, LLC	
+blockbuster		generic	Dish DBS Corporation	
+blog		generic	Knock Knock WHOIS There, LLC	
+bloomberg		generic	Bloomberg IP Holdings LLC	
+blue		generic	Identity Digital Limited	
+bm		country-code		BM
+bms		generic	Bristol-Myers Squibb Company	
+bmw		generic	Bayerische Motoren Werke Aktiengesellschaft	
+bn		country-code		BN
+bnpparibas		generic	BNP Paribas	
+bo		country-code		BO
+boats		generic	XYZ.COM LLC	
+boehringer		generic	Boehringer Ingelheim International GmbH	
+bofa		generic	Bank of America Corporation	
+bom		generic	Núcleo de Informação e Coordenação do Ponto BR - NIC.br	
+bond		generic	ShortDot SA	
+boo		generic	Charleston Road Registry Inc.	
+book		generic	Amazon Registry Services, Inc.	
+booking		generic	Booking.com B.V.	
+bosch		generic	Robert Bosch GMBH	
+bostik		generic	Bostik SA	
+boston		generic	Registry Services, LLC	
+bot		generic	Amazon Registry Services, Inc.	
+boutique		generic	Binky Moon, LLC	
+box		generic	Intercap Registry Inc.	
+br		country-code		BR
+bradesco		generic	Banco Bradesco S.A.	
+bridgestone		generic	Bridgestone Corporation	
+broadway		generic	Celebrate Broadway, Inc.	
+broker		generic	Dog Beach, LLC	
+brother		generic	Brother Industries, Ltd.	
+brussels		generic	DNS.be vzw	
+bs		country-code		BS
+bt		country-code		BT
+build		generic	Plan Bee LLC	
+builders		generic	Binky Moon, LLC	
+business		generic	Binky Moon, LLC	
+buy		generic	Amazon Registry Services, Inc.	
+buzz		generic	DOTSTRATEGY CO.	
+bv		country-code		BV
+bw		country-code		BW
+by		country-code		BY
+bz		country-code		BZ
+bzh		generic	Association www.bzh	
+ca		country-code		CA
+cab		generic	Binky Moon, LLC	
+cafe		generic	Binky Moon, LLC	
+cal		generic	Charleston Road Registry Inc.	
+call		generic	Amazon Registry Services, Inc.	
+calvinklein		generic	PVH gTLD Holdings LLC	
+cam		generic	Cam Connecting SARL	
+camera		generic	Binky Moon, LLC	
+camp		generic	Binky Moon, LLC	
+canon		generic	Canon Inc.	
+capetown		generic	ZA Central Registry NPC trading as ZA Central Registry	
+capital		generic	Binky Moon, LLC	
+capitalone		generic	Capital One Financial Corporation	
+car		generic	XYZ.COM LLC	
+caravan		generic	Caravan International, Inc.	
+cards		generic	Binky Moon, LLC	
+care		generic	Binky Moon, LLC	
+career		generic	dotCareer LLC	
+careers		generic	Binky Moon, LLC	
+cars		generic	XYZ.COM LLC	
+casa		generic	Registry Services, LLC	
+case		generic	Digity, LLC	
+cash		generic	Binky Moon, LLC	
+casino		generic	Binky Moon, LLC	
+cat		sponsored		
+catering		generic	Binky Moon, LLC	
+catholic		generic	Pontificium Consilium de Comunicationibus Socialibus (PCCS) (Pontifical Council for Social Communication)	
+cba		generic	COMMONWEALTH BANK OF AUSTRALIA	
+cbn		generic	The Christian Broadcasting Network, Inc.	
+cbre		generic	CBRE, Inc.	
+cbs		generic	CBS Domains Inc.	
+cc		country-code		CC
+cd		country-code		CD
+center		generic	Binky Moon, LLC	
+ceo		generic	CEOTLD Pty Ltd	
+cern		generic	European Organization for Nuclear Research ("CERN")	
+cf		country-code		CF
+cfa		generic	CFA Institute	
+cfd		generic	ShortDot SA	
+cg		country-code		CG
+ch		country-code		CH
+chanel		generic	Chanel International B.V.	
+channel		generic	Charleston Road Registry Inc.	
+charity		generic	Public Interest Registry	
+chase		generic	JPMorgan Chase Bank, National Association	
+chat		generic	Binky Moon, LLC	
+cheap		generic	Binky Moon, LLC	
+chintai		generic	CHINTAI Corporation	
+christmas		generic	XYZ.COM LLC	
+chrome		generic	Charleston Road Registry Inc.	
+church		generic	Binky Moon, LLC	
+ci		country-code		CI
+cipriani		generic	Hotel Cipriani Srl	
+circle		generic	Amazon Registry Services, Inc.	
+cisco		generic	Cisco Technology, Inc.	
+citadel		generic	Citadel Domain LLC	
+citi		generic	Citigroup Inc.	
+citic		generic	CITIC Group Corporation	
+city		generic	Binky Moon, LLC	
+cityeats		generic	Lifestyle Domain Holdings, Inc.	
+cl		country-code		CL
+claims		generic	Binky Moon, LLC	
+cleaning		generic	Binky Moon, LLC	
+click		generic	Internet Naming Company LLC	
+clinic		generic	Binky Moon, LLC	
+clinique		generic	The Estée Lauder Companies Inc.	
+clothing		generic	Binky Moon, LLC	
+cloud		generic	Aruba PEC S.p.A.	
+club		generic	Registry Services, LLC	
+clubmed		generic	Club Méditerranée S.A.	
+cm		country-code		CM
+cn		country-code		CN
+co		country-code		CO
+coach		generic	Binky Moon, LLC	
+codes		generic	Binky Moon, LLC	
+coffee		generic	Binky Moon, LLC	
+college		generic	XYZ.COM LLC	
+cologne		generic	dotKoeln GmbH	
+com		generic		
+comcast		generic	Comcast IP Holdings I, LLC	
+commbank		generic	COMMONWEALTH BANK OF AUSTRALIA	
+community		generic	Binky Moon, LLC	
+company		generic	Binky Moon, LLC	
+compare		generic	Registry Services, LLC	
+computer		generic	Binky Moon, LLC	
+comsec		generic	VeriSign, Inc.	
+condos		generic	Binky Moon, LLC	
+construction		generic	Binky Moon, LLC	
+consulting		generic	Dog Beach, LLC	
+contact		generic	Dog Beach, LLC	
+contractors		generic	Binky Moon, LLC	
+cooking		generic	Registry Services, LLC	
+cookingchannel		generic	Lifestyle Domain Holdings, Inc.	
+cool		generic	Binky Moon, LLC	
+coop		sponsored		
+corsica		generic	Collectivité de Corse	
+country		generic	Internet Naming Company LLC	
+coupon		generic	Amazon Registry Services, Inc.	
+coupons		generic	Binky Moon, LLC	
+courses		generic	Registry Services, LLC	
+cpa		generic	American Institute of Certified Public Accountants	
+cr		country-code		CR
+credit		generic	Binky Moon, LLC	
+creditcard		generic	Binky Moon, LLC	
+creditunion		generic	DotCooperation LLC	
+cricket		generic	dot Cricket Limited	
+crown		generic	Crown Equipment Corporation	
+crs		generic	Federated Co-operatives Limited	
+cruise		generic	Viking River Cruises (Bermuda) Ltd.	
+cruises		generic	Binky Moon, LLC	
+cu		country-code		CU
+cuisinella		generic	SCHMIDT GROUPE S.A.S.	
+cv		country-code		CV
+cw		country-code		CW
+cx		country-code		CX
+cy		country-code		CY
+cymru		generic	Nominet UK	
+cyou		generic	ShortDot SA	
+cz		country-code		CZ
+dabur		generic	Dabur India Limited	
+dad		generic	Charleston Road Registry Inc.	
+dance		generic	Dog Beach, LLC	
+data		generic	Dish DBS Corporation	
+date		generic	dot Date Limited	
+dating		generic	Binky Moon, LLC	
+datsun		generic	NISSAN MOTOR CO., LTD.	
+day		generic	Charleston Road Registry Inc.	
+dclk		generic	Charleston Road Registry Inc.	
+dds		generic	Registry Services, LLC	
+de		country-code		DE
+deal		generic	Amazon Registry Services, Inc.	
+dealer		generic	Intercap Registry Inc.	
+deals		generic	Binky Moon, LLC	
+degree		generic	Dog Beach, LLC	
+delivery		generic	Binky Moon, LLC	
+dell		generic	Dell Inc.	
+deloitte		generic	Deloitte Touche Tohmatsu	
+delta		generic	Delta Air Lines, Inc.	
+democrat		generic	Dog Beach, LLC	
+dental		generic	Binky Moon, LLC	
+dentist		generic	Dog Beach, LLC	
+desi		generic	Desi Networks LLC	
+design		generic	Registry Services, LLC	
+dev		generic	Charleston Road Registry Inc.	
+dhl		generic	Deutsche Post AG	
+diamonds		generic	Binky Moon, LLC	
+diet		generic	XYZ.COM LLC	
+digital		generic	Binky Moon, LLC	
+direct		generic	Binky Moon, LLC	
+directory		generic	Binky Moon, LLC	
+discount		generic	Binky Moon, LLC	
+discover		generic	Discover Financial Services	
+dish		generic	Dish DBS Corporation	
+diy		generic	Lifestyle Domain Holdings, Inc.	
+dj		country-code		DJ
+dk		country-code		DK
+dm		country-code		DM
+dnp		generic	Dai Nippon Printing Co., Ltd.	
+do		country-code		DO
+docs		generic	Charleston Road Registry Inc.	
+doctor		generic	Binky Moon, LLC	
+dog		generic	Binky Moon, LLC	
+domains		generic	Binky Moon, LLC	
+dot		generic	Dish DBS Corporation	
+download		generic	dot Support Limited	
+drive		generic	Charleston Road Registry Inc.	
+dtv		generic	Dish DBS Corporation	
+dubai		generic	Dubai Smart Government Department	
+dunlop		generic	The Goodyear Tire & Rubber Company	
+dupont		generic	DuPont Specialty Products USA, LLC	
+durban		generic	ZA Central Registry NPC trading as ZA Central Registry	
+dvag		generic	Deutsche Vermögensberatung Aktiengesellschaft DVAG	
+dvr		generic	DISH Technologies L.L.C.	
+dz		country-code		DZ
+earth		generic	Interlink Systems Innovation Institute K.K.	
+eat		generic	Charleston Road Registry Inc.	
+ec		country-code		EC
+eco		generic	Big Room Inc.	
+edeka		generic	EDEKA Verband kaufmännischer Genossenschaften e.V.	
+edu		sponsored		
+education		generic	Binky Moon, LLC	
+ee		country-code		EE
+eg		country-code		EG
+email		generic	Binky Moon, LLC	
+emerck		generic	Merck KGaA	
+energy		generic	Binky Moon, LLC	
+engineer		generic	Dog Beach, LLC	
+engineering		generic	Binky Moon, LLC	
+enterprises		generic	Binky Moon, LLC	
+epson		generic	Seiko Epson Corporation	
+equipment		generic	Binky Moon, LLC	
+ericsson		generic	Telefonaktiebolaget L M Ericsson	
+erni		generic	ERNI Group Holding AG	
+es		country-code		ES
+esq		generic	Charleston Road Registry Inc.	
+estate		generic	Binky Moon, LLC	
+et		country-code		ET
+etisalat		generic	Emirates Telecommunications Corporation (trading as Etisalat)	
+eu		country-code		
+eurovision		generic	European Broadcasting Union (EBU)	
+eus		generic	Puntueus Fundazioa	
+events		generic	Binky Moon, LLC	
+exchange		generic	Binky Moon, LLC	
+expert		generic	Binky Moon, LLC	
+exposed		generic	Binky Moon, LLC	
+express		generic	Binky Moon, LLC	
+extraspace		generic	Extra Space Storage LLC	
+fage		generic	Fage International S.A.	
+fail		generic	Binky Moon, LLC	
+fairwinds		generic	FairWinds Partners, LLC	
+faith		generic	dot Faith Limited	
+family		generic	Dog Beach, LLC	
+fan		generic	Dog Beach, LLC	
+fans		generic	ZDNS International Limited	
+farm		generic	Binky Moon, LLC	
+farmers		generic	Farmers Insurance Exchange	
+fashion		generic	Registry Services, LLC	
+fast		generic	Amazon Registry Services, Inc.	
+fedex		generic	Federal Express Corporation	
+feedback		generic	Top Level Spectrum, Inc.	
+ferrari		generic	Fiat Chrysler Automobiles N.V.	
+ferrero		generic	Ferrero Trading Lux S.A.	
+fi		country-code		FI
+fiat		generic	Fiat Chrysler Automobiles N.V.	
+fidelity		generic	Fidelity Brokerage Services LLC	
+fido		generic	Rogers Communications Canada Inc.	
+film		generic	Motion Picture Domain Registry Pty Ltd	
+final		generic	Núcleo de Informação e Coordenação do Ponto BR - NIC.br	
+finance		generic	Binky Moon, LLC	
+financial		generic	Binky Moon, LLC	
+fire		generic	Amazon Registry Services, Inc.	
+firestone		generic	Bridgestone Licensing Services, Inc	
+firmdale		generic	Firmdale Holdings Limited	
+fish		generic	Binky Moon, LLC	
+fishing		generic	Registry Services, LLC	
+fit		generic	Registry Services, LLC	
+fitness		generic	Binky Moon, LLC	
+fj		country-code		FJ
+flickr		generic	Flickr, Inc.	
+flights		generic	Binky Moon, LLC	
+flir		generic	FLIR Systems, Inc.	
+florist		generic	Binky Moon, LLC	
+flowers		generic	XYZ.COM LLC	
+fly		generic	Charleston Road Registry Inc.	
+fm		country-code		FM
+fo		country-code		FO
+foo		generic	Charleston Road Registry Inc.	
+food		generic	Lifestyle Domain Holdings, Inc.	
+foodnetwork		generic	Lifestyle Domain Holdings, Inc.	
+football		generic	Binky Moon, LLC	
+ford		generic	Ford Motor Company	
+forex		generic	Dog Beach, LLC	
+forsale		generic	Dog Beach, LLC	
+forum		generic	Fegistry, LLC	
+foundation		generic	Public Interest Registry	
+fox		generic	FOX Registry, LLC	
+fr		country-code		FR
+free		generic	Amazon Registry Services, Inc.	
+fresenius		generic	Fresenius Immobilien-Verwaltungs-GmbH	
+frl		generic	FRLregistry B.V.	
+frogans		generic	OP3FT	
+frontdoor		generic	Lifestyle Domain Holdings, Inc.	
+frontier		generic	Frontier Communications Corporation	
+ftr		generic	Frontier Communications Corporation	
+fujitsu		generic	Fujitsu Limited	
+fun		generic	Radix FZC	
+fund		generic	Binky Moon, LLC	
+furniture		generic	Binky Moon, LLC	
+futbol		generic	Dog Beach, LLC	
+fyi		generic	Binky Moon, LLC	
+ga		country-code		GA
+gal		generic	Asociación puntoGAL	
+gallery		generic	Binky Moon, LLC	
+gallo		generic	Gallo Vineyards, Inc.	
+gallup		generic	Gallup, Inc.	
+game		generic	XYZ.COM LLC	
+games		generic	Dog Beach, LLC	
+gap		generic	The Gap, Inc.	
+garden		generic	Registry Services, LLC	
+gay		generic	Top Level Design, LLC	
+gb		country-code		GB
+gbiz		generic	Charleston Road Registry Inc.	
+gd		country-code		GD
+gdn		generic	Joint Stock Company "Navigation-information systems"	
+ge		country-code		GE
+gea		generic	GEA Group Aktiengesellschaft	
+gent		generic	Easyhost BV	
+genting		generic	Resorts World Inc Pte. Ltd.	
+george		generic	Wal-Mart Stores, Inc.	
+gf		country-code		GF
+gg		country-code		GG
+ggee		generic	GMO Internet, Inc.	
+gh		country-code		GH
+gi		country-code		GI
+gift		generic	DotGift, LLC	
+gifts		generic	Binky Moon, LLC	
+gives		generic	Public Interest Registry	
+giving		generic	Public Interest Registry	
+gl		country-code		GL
+glass		generic	Binky Moon, LLC	
+gle		generic	Charleston Road Registry Inc.	
+global		generic	Dot Global Domain Registry Limited	
+globo		generic	Globo Comunicação e Participações S.A	
+gm		country-code		GM
+gmail		generic	Charleston Road Registry Inc.	
+gmbh		generic	Binky Moon, LLC	
+gmo		generic	GMO Internet, Inc.	
+gmx		generic	1&1 Mail & Media GmbH	
+gn		country-code		GN
+godaddy		generic	Go Daddy East, LLC	
+gold		generic	Binky Moon, LLC	
+goldpoint		generic	YODOBASHI CAMERA CO.,LTD.	
+golf		generic	Binky Moon, LLC	
+goo		generic	NTT Resonant Inc.	
+goodyear		generic	The Goodyear Tire & Rubber Company	
+goog		generic	Charleston Road Registry Inc.	
+google		generic	Charleston Road Registry Inc.	
+gop		generic	Republican State Leadership Committee, Inc.	
+got		generic	Amazon Registry Services, Inc.	
+gov		sponsored		
+gp		country-code		GP
+gq		country-code		GQ
+gr		country-code		GR
+grainger		generic	Grainger Registry Services, LLC	
+graphics		generic	Binky Moon, LLC	
+gratis		generic	Binky Moon, LLC	
+green		generic	Identity Digital Limited	
+gripe		generic	Binky Moon, LLC	
+grocery		generic	Wal-Mart Stores, Inc.	
+group		generic	Binky Moon, LLC	
+gs		country-code		GS
+gt		country-code		GT
+gu		country-code		GU
+guardian		generic	The Guardian Life Insurance Company of America	
+gucci		generic	Guccio Gucci S.p.a.	
+guge		generic	Charleston Road Registry Inc.	
+guide		generic	Binky Moon, LLC	
+guitars		generic	XYZ.COM LLC	
+guru		generic	Binky Moon, LLC	
+gw		country-code		GW
+gy		country-code		GY
+hair		generic	XYZ.COM LLC	
+hamburg		generic	Hamburg Top-Level-Domain GmbH	
+hangout		generic	Charleston Road Registry Inc.	
+haus		generic	Dog Beach, LLC	
+hbo		generic	HBO Registry Services, Inc.	
+hdfc		generic	HOUSING DEVELOPMENT FINANCE CORPORATION LIMITED	
+hdfcbank		generic	HDFC Bank Limited	
+health		generic	DotHealth, LLC	
+healthcare		generic	Binky Moon, LLC	
+help		generic	Innovation service Limited	
+helsinki		generic	City of Helsinki	
+here		generic	Charleston Road Registry Inc.	
+hermes		generic	HERMES INTERNATIONAL	
+hgtv		generic	Lifestyle Domain Holdings, Inc.	
+hiphop		generic	Dot Hip Hop, LLC	
+hisamitsu		generic	Hisamitsu Pharmaceutical Co.,Inc.	
+hitachi		generic	Hitachi, Ltd.	
+hiv		generic	Internet Naming Company LLC	
+hk		country-code		HK
+hkt		generic	PCCW-HKT DataCom Services Limited	
+hm		country-code		HM
+hn		country-code		HN
+hockey		generic	Binky Moon, LLC	
+holdings		generic	Binky Moon, LLC	
+holiday		generic	Binky Moon, LLC	
+homedepot		generic	Home Depot Product Authority, LLC	
+homegoods		generic	The TJX Companies, Inc.	
+homes		generic	XYZ.COM LLC	
+homesense		generic	The TJX Companies, Inc.	
+honda		generic	Honda Motor Co., Ltd.	
+horse		generic	Registry Services, LLC	
+hospital		generic	Binky Moon, LLC	
+host		generic	Radix FZC	
+hosting		generic	XYZ.COM LLC	
+hot		generic	Amazon Registry Services, Inc.	
+hoteles		generic	Travel Reservations SRL	
+hotels		generic	Booking.com B.V.	
+hotmail		generic	Microsoft Corporation	
+house		generic	Binky Moon, LLC	
+how		generic	Charleston Road Registry Inc.	
+hr		country-code		HR
+hsbc		generic	HSBC Global Services (UK) Limited	
+ht		country-code		HT
+hu		country-code		HU
+hughes		generic	Hughes Satellite Systems Corporation	
+hyatt		generic	Hyatt GTLD, L.L.C.	
+hyundai		generic	Hyundai Motor Company	
+ibm		generic	International Business Machines Corporation	
+icbc		generic	Industrial and Commercial Bank of China Limited	
+ice		generic	IntercontinentalExchange, Inc.	
+icu		generic	ShortDot SA	
+id		country-code		ID
+ie		country-code		IE
+ieee		generic	IEEE Global LLC	
+ifm		generic	ifm electronic gmbh	
+ikano		generic	Ikano S.A.	
+il		country-code		IL
+im		country-code		IM
+imamat		generic	Fondation Aga Khan (Aga Khan Foundation)	
+imdb		generic	Amazon Registry Services, Inc.	
+immo		generic	Binky Moon, LLC	
+immobilien		generic	Dog Beach, LLC	
+in		country-code		IN
+inc		generic	Intercap Registry Inc.	
+industries		generic	Binky Moon, LLC	
+infiniti		generic	NISSAN MOTOR CO., LTD.	
+info		generic		
+ing		generic	Charleston Road Registry Inc.	
+ink		generic	Top Level Design, LLC	
+institute		generic	Binky Moon, LLC	
+insurance		generic	fTLD Registry Services LLC	
+insure		generic	Binky Moon, LLC	
+int		sponsored		
+international		generic	Binky Moon, LLC	
+intuit		generic	Intuit Administrative Services, Inc.	
+investments		generic	Binky Moon, LLC	
+io		country-code		IO
+ipiranga		generic	Ipiranga Produtos de Petroleo S.A.	
+iq		country-code		IQ
+ir		country-code		IR
+irish		generic	Binky Moon, LLC	
+is		country-code		IS
+ismaili		generic	Fondation Aga Khan (Aga Khan Foundation)	
+ist		generic	Istanbul Metropolitan Municipality	
+istanbul		generic	Istanbul Metropolitan Municipality	
+it		country-code		IT
+itau		generic	Itau Unibanco Holding S.A.	
+itv		generic	ITV Services Limited	
+jaguar		generic	Jaguar Land Rover Ltd	
+java		generic	Oracle Corporation	
+jcb		generic	JCB Co., Ltd.	
+je		country-code		JE
+jeep		generic	FCA US LLC.	
+jetzt		generic	Binky Moon, LLC	
+jewelry		generic	Binky Moon, LLC	
+jio		generic	Reliance Industries Limited	
+jll		generic	Jones Lang LaSalle Incorporated	
+jmp		generic	Matrix IP LLC	
+jnj		generic	Johnson & Johnson Services, Inc.	
+jo		country-code		JO
+jobs		sponsored		
+joburg		generic	ZA Central Registry NPC trading as ZA Central Registry	
+jot		generic	Amazon Registry Services, Inc.	
+joy		generic	Amazon Registry Services, Inc.	
+jp		country-code		JP
+jpmorgan		generic	JPMorgan Chase Bank, National Association	
+jprs		generic	Japan Registry Services Co., Ltd.	
+juegos		generic	Internet Naming Company LLC	
+juniper		generic	JUNIPER NETWORKS, INC.	
+kaufen		generic	Dog Beach, LLC	
+kddi		generic	KDDI CORPORATION	
+ke		country-code		KE
+kerryhotels		generic	Kerry Trading Co. Limited	
+kerrylogistics		generic	Kerry Trading Co. Limited	
+kerryproperties		generic	Kerry Trading Co. Limited	
+kfh		generic	Kuwait Finance House	
+kg		country-code		KG
+ki		country-code		KI
+kia		generic	KIA MOTORS CORPORATION	
+kids		generic	DotKids Foundation Limited	
+kim		generic	Identity Digital Limited	
+kinder		generic	Ferrero Trading Lux S.A.	
+kindle		generic	Amazon Registry Services, Inc.	
+kitchen		generic	Binky Moon, LLC	
+kiwi		generic	DOT KIWI LIMITED	
+km		country-code		KM
+kn		country-code		KN
+koeln		generic	dotKoeln GmbH	
+komatsu		generic	Komatsu Ltd.	
+kosher		generic	Kosher Marketing Assets LLC	
+kp		country-code		KP
+kpmg		generic	KPMG International Cooperative (KPMG International Genossenschaft)	
+kpn		generic	Koninklijke KPN N.V.	
+kr		country-code		KR
+krd		generic	KRG Department of Information Technology	
+kred		generic	KredTLD Pty Ltd	
+kuokgroup		generic	Kerry Trading Co. Limited	
+kw		country-code		KW
+ky		country-code		KY
+kyoto		generic	Academic Institution: Kyoto Jyoho Gakuen	
+kz		country-code		KZ
+la		country-code		LA
+lacaixa		generic	Fundación Bancaria Caixa d’Estalvis i Pensions de Barcelona, “la Caixa”	
+lamborghini		generic	Automobili Lamborghini S.p.A.	
+lamer		generic	The Estée Lauder Companies Inc.	
+lancaster		generic	LANCASTER	
+lancia		generic	Fiat Chrysler Automobiles N.V.	
+land		generic	Binky Moon, LLC	
+landrover		generic	Jaguar Land Rover Ltd	
+lanxess		generic	LANXESS Corporation	
+lasalle		generic	Jones Lang LaSalle Incorporated	
+lat		generic	XYZ.COM LLC	
+latino		generic	Dish DBS Corporation	
+latrobe		generic	La Trobe University	
+law		generic	Registry Services, LLC	
+lawyer		generic	Dog Beach, LLC	
+lb		country-code		LB
+lc		country-code		LC
+lds		generic	IRI Domain Management, LLC	
+lease		generic	Binky Moon, LLC	
+leclerc		generic	A.C.D. LEC Association des Centres Distributeurs Edouard Leclerc	
+lefrak		generic	LeFrak Organization, Inc.	
+legal		generic	Binky Moon, LLC	
+lego		generic	LEGO Juris A/S	
+lexus		generic	TOYOTA MOTOR CORPORATION	
+lgbt		generic	Identity Digital Limited	
+li		country-code		LI
+lidl		generic	Schwarz Domains und Services GmbH & Co. KG	
+life		generic	Binky Moon, LLC	
+lifeinsurance		generic	American Council of Life Insurers	
+lifestyle		generic	Lifestyle Domain Holdings, Inc.	
+lighting		generic	Binky Moon, LLC	
+like		generic	Amazon Registry Services, Inc.	
+lilly		generic	Eli Lilly and Company	
+limited		generic	Binky Moon, LLC	
+limo		generic	Binky Moon, LLC	
+lincoln		generic	Ford Motor Company	
+linde		generic	Linde Aktiengesellschaft	
+link		generic	Nova Registry Ltd	
+lipsy		generic	Lipsy Ltd	
+live		generic	Dog Beach, LLC	
+living		generic	Lifestyle Domain Holdings, Inc.	
+lk		country-code		LK
+llc		generic	Identity Digital Limited	
+llp		generic	Intercap Registry Inc.	
+loan		generic	dot Loan Limited	
+loans		generic	Binky Moon, LLC	
+locker		generic	Dish DBS Corporation	
+locus		generic	Locus Analytics LLC	
+lol		generic	XYZ.COM LLC	
+london		generic	Dot London Domains Limited	
+lotte		generic	Lotte Holdings Co., Ltd.	
+lotto		generic	Identity Digital Limited	
+love		generic	Merchant Law Group LLP	
+lpl		generic	LPL Holdings, Inc.	
+lplfinancial		generic	LPL Holdings, Inc.	
+lr		country-code		LR
+ls		country-code		LS
+lt		country-code		LT
+ltd		generic	Binky Moon, LLC	
+ltda		generic	InterNetX, Corp	
+lu		country-code		LU
+lundbeck		generic	H. Lundbeck A/S	
+luxe		generic	Registry Services, LLC	
+luxury		generic	Luxury Partners, LLC	
+lv		country-code		LV
+ly		country-code		LY
+ma		country-code		MA
+macys		generic	Macys, Inc.	
+madrid		generic	Comunidad de Madrid	
+maif		generic	Mutuelle Assurance Instituteur France (MAIF)	
+maison		generic	Binky Moon, LLC	
+makeup		generic	XYZ.COM LLC	
+man		generic	MAN SE	
+management		generic	Binky Moon, LLC	
+mango		generic	PUNTO FA S.L.	
+map		generic	Charleston Road Registry Inc.	
+market		generic	Dog Beach, LLC	
+marketing		generic	Binky Moon, LLC	
+markets		generic	Dog Beach, LLC	
+marriott		generic	Marriott Worldwide Corporation	
+marshalls		generic	The TJX Companies, Inc.	
+maserati		generic	Fiat Chrysler Automobiles N.V.	
+mattel		generic	Mattel Sites, Inc.	
+mba		generic	Binky Moon, LLC	
+mc		country-code		MC
+mckinsey		generic	McKinsey Holdings, Inc.	
+md		country-code		MD
+me		country-code		ME
+med		generic	Medistry LLC	
+media		generic	Binky Moon, LLC	
+meet		generic	Charleston Road Registry Inc.	
+melbourne		generic	The Crown in right of the State of Victoria, represented by its Department of State Development, Business and Innovation	
+meme		generic	Charleston Road Registry Inc.	
+memorial		generic	Dog Beach, LLC	
+men		generic	Exclusive Registry Limited	
+menu		generic	Dot Menu Registry, LLC	
+merckmsd		generic	MSD Registry Holdings, Inc.	
+mg		country-code		MG
+mh		country-code		MH
+miami		generic	Registry Services, LLC	
+microsoft		generic	Microsoft Corporation	
+mil		sponsored		
+mini		generic	Bayerische Motoren Werke Aktiengesellschaft	
+mint		generic	Intuit Administrative Services, Inc.	
+mit		generic	Massachusetts Institute of Technology	
+mitsubishi		generic	Mitsubishi Corporation	
+mk		country-code		MK
+ml		country-code		ML
+mlb		generic	MLB Advanced Media DH, LLC	
+mls		generic	The Canadian Real Estate Association	
+mma		generic	MMA IARD	
+mn		country-code		MN
+mo		country-code		MO
+mobi		generic		
+mobile		generic	Dish DBS Corporation	
+moda		generic	Dog Beach, LLC	
+moe		generic	Interlink Systems Innovation Institute K.K.	
+moi		generic	Amazon Registry Services, Inc.	
+mom		generic	XYZ.COM LLC	
+monash		generic	Monash University	
+money		generic	Binky Moon, LLC	
+monster		generic	XYZ.COM LLC	
+mormon		generic	IRI Domain Management, LLC	
+mortgage		generic	Dog Beach, LLC	
+moscow		generic	Foundation for Assistance for Internet Technologies and Infrastructure Development (FAITID)	
+moto		generic	Motorola Trademark Holdings, LLC	
+motorcycles		generic	XYZ.COM LLC	
+mov		generic	Charleston Road Registry Inc.	
+movie		generic	Binky Moon, LLC	
+mp		country-code		MP
+mq		country-code		MQ
+mr		country-code		MR
+ms		country-code		MS
+msd		generic	MSD Registry Holdings, Inc.	
+mt		country-code		MT
+mtn		generic	MTN Dubai Limited	
+mtr		generic	MTR Corporation Limited	
+mu		country-code		MU
+museum		sponsored		
+music		generic	DotMusic Limited	
+mutual		generic	Northwestern Mutual MU TLD Registry, LLC	
+mv		country-code		MV
+mw		country-code		MW
+mx		country-code		MX
+my		country-code		MY
+mz		country-code		MZ
+na		country-code		NA
+nab		generic	National Australia Bank Limited	
+nagoya		generic	GMO Registry, Inc.	
+name		generic-restricted		
+natura		generic	NATURA COSMÉTICOS S.A.	
+navy		generic	Dog Beach, LLC	
+nba		generic	NBA REGISTRY, LLC	
+nc		country-code		NC
+ne		country-code		NE
+nec		generic	NEC Corporation	
+net		generic		
+netbank		generic	COMMONWEALTH BANK OF AUSTRALIA	
+netflix		generic	Netflix, Inc.	
+network		generic	Binky Moon, LLC	
+neustar		generic	NeuStar, Inc.	
+new		generic	Charleston Road Registry Inc.	
+news		generic	Dog Beach, LLC	
+next		generic	Next plc	
+nextdirect		generic	Next plc	
+nexus		generic	Charleston Road Registry Inc.	
+nf		country-code		NF
+nfl		generic	NFL Reg Ops LLC	
+ng		country-code		NG
+ngo		generic	Public Interest Registry	
+nhk		generic	Japan Broadcasting Corporation (NHK)	
+ni		country-code		NI
+nico		generic	DWANGO Co., Ltd.	
+nike		generic	NIKE, Inc.	
+nikon		generic	NIKON CORPORATION	
+ninja		generic	Dog Beach, LLC	
+nissan		generic	NISSAN MOTOR CO., LTD.	
+nissay		generic	Nippon Life Insurance Company	
+nl		country-code		NL
+no		country-code		NO
+nokia		generic	Nokia Corporation	
+northwesternmutual		generic	Northwestern Mutual Registry, LLC	
+norton		generic	NortonLifeLock Inc.	
+now		generic	Amazon Registry Services, Inc.	
+nowruz		generic	Asia Green IT System Bilgisayar San. ve Tic. Ltd. Sti.	
+nowtv		generic	Starbucks (HK) Limited	
+nr		country-code		NR
+nra		generic	NRA Holdings Company, INC.	
+nrw		generic	Minds + Machines GmbH	
+ntt		generic	NIPPON TELEGRAPH AND TELEPHONE CORPORATION	
+nu		country-code		NU
+nyc		generic	The City of New York by and through the New York City Department of Information Technology & Telecommunications	
+nz		country-code		NZ
+obi		generic	OBI Group Holding SE & Co. KGaA	
+observer		generic	Dog Beach, LLC	
+office		generic	Microsoft Corporation	
+okinawa		generic	BRregistry, Inc.	
+olayan		generic	Crescent Holding GmbH	
+olayangroup		generic	Crescent Holding GmbH	
+oldnavy		generic	The Gap, Inc.	
+ollo		generic	Dish DBS Corporation	
+om		country-code		OM
+omega		generic	The Swatch Group Ltd	
+one		generic	One.com A/S	
+ong		generic	Public Interest Registry	
+onl		generic	iRegistry GmbH	
+online		generic	Radix FZC	
+ooo		generic	INFIBEAM AVENUES LIMITED	
+open		generic	American Express Travel Related Services Company, Inc.	
+oracle		generic	Oracle Corporation	
+orange		generic	Orange Brand Services Limited	
+org		generic		
+organic		generic	Identity Digital Limited	
+origins		generic	The Estée Lauder Companies Inc.	
+osaka		generic	Osaka Registry Co., Ltd.	
+otsuka		generic	Otsuka Holdings Co., Ltd.	
+ott		generic	Dish DBS Corporation	
+ovh		generic	MédiaBC	
+pa		country-code		PA
+page		generic	Charleston Road Registry Inc.	
+panasonic		generic	Panasonic Corporation	
+paris		generic	City of Paris	
+pars		generic	Asia Green IT System Bilgisayar San. ve Tic. Ltd. Sti.	
+partners		generic	Binky Moon, LLC	
+parts		generic	Binky Moon, LLC	
+party		generic	Blue Sky Registry Limited	
+passagens		generic	Travel Reservations SRL	
+pay		generic	Amazon Registry Services, Inc.	
+pccw		generic	PCCW Enterprises Limited	
+pe		country-code		PE
+pet		generic	Identity Digital Limited	
+pf		country-code		PF
+pfizer		generic	Pfizer Inc.	
+ph		country-code		PH
+pharmacy		generic	National Association of Boards of Pharmacy	
+phd		generic	Charleston Road Registry Inc.	
+philips		generic	Koninklijke Philips N.V.	
+phone		generic	Dish DBS Corporation	
+photo		generic	Registry Services, LLC	
+photography		generic	Binky Moon, LLC	
+photos		generic	Binky Moon, LLC	
+physio		generic	PhysBiz Pty Ltd	
+pics		generic	XYZ.COM LLC	
+pictet		generic	Pictet Europe S.A.	
+pictures		generic	Binky Moon, LLC	
+pid		generic	Top Level Spectrum, Inc.	
+pin		generic	Amazon Registry Services, Inc.	
+ping		generic	Ping Registry Provider, Inc.	
+pink		generic	Identity Digital Limited	
+pioneer		generic	Pioneer Corporation	
+pizza		generic	Binky Moon, LLC	
+pk		country-code		PK
+pl		country-code		PL
+place		generic	Binky Moon, LLC	
+play		generic	Charleston Road Registry Inc.	
+playstation		generic	Sony Interactive Entertainment Inc.	
+plumbing		generic	Binky Moon, LLC	
+plus		generic	Binky Moon, LLC	
+pm		country-code		PM
+pn		country-code		PN
+pnc		generic	PNC Domain Co., LLC	
+pohl		generic	Deutsche Vermögensberatung Aktiengesellschaft DVAG	
+poker		generic	Identity Digital Limited	
+politie		generic	Politie Nederland	
+porn		generic	ICM Registry PN LLC	
+post		sponsored		
+pr		country-code		PR
+pramerica		generic	Prudential Financial, Inc.	
+praxi		generic	Praxi S.p.A.	
+press		generic	Radix FZC	
+prime		generic	Amazon Registry Services, Inc.	
+pro		generic-restricted		
+prod		generic	Charleston Road Registry Inc.	
+productions		generic	Binky Moon, LLC	
+prof		generic	Charleston Road Registry Inc.	
+progressive		generic	Progressive Casualty Insurance Company	
+promo		generic	Identity Digital Limited	
+properties		generic	Binky Moon, LLC	
+property		generic	Internet Naming Company LLC	
+protection		generic	XYZ.COM LLC	
+pru		generic	Prudential Financial, Inc.	
+prudential		generic	Prudential Financial, Inc.	
+ps		country-code		PS
+pt		country-code		PT
+pub		generic	Dog Beach, LLC	
+pw		country-code		PW
+pwc		generic	PricewaterhouseCoopers LLP	
+py		country-code		PY
+qa		country-code		QA
+qpon		generic	dotCOOL, Inc.	
+quebec		generic	PointQuébec Inc	
+quest		generic	XYZ.COM LLC	
+racing		generic	Premier Registry Limited	
+radio		generic	European Broadcasting Union (EBU)	
+re		country-code		RE
+read		generic	Amazon Registry Services, Inc.	
+realestate		generic	dotRealEstate LLC	
+realtor		generic	Real Estate Domains LLC	
+realty		generic	Dog Beach, LLC	
+recipes		generic	Binky Moon, LLC	
+red		generic	Identity Digital Limited	
+redstone		generic	Redstone Haute Couture Co., Ltd.	
+redumbrella		generic	Travelers TLD, LLC	
+rehab		generic	Dog Beach, LLC	
+reise		generic	Binky Moon, LLC	
+reisen		generic	Binky Moon, LLC	
+reit		generic	National Association of Real Estate Investment Trusts, Inc.	
+reliance		generic	Reliance Industries Limited	
+ren		generic	ZDNS International Limited	
+rent		generic	XYZ.COM LLC	
+rentals		generic	Binky Moon, LLC	
+repair		generic	Binky Moon, LLC	
+report		generic	Binky Moon, LLC	
+republican		generic	Dog Beach, LLC	
+rest		generic	Punto 2012 Sociedad Anonima Promotora de Inversion de Capital Variable	
+restaurant		generic	Binky Moon, LLC	
+review		generic	dot Review Limited	
+reviews		generic	Dog Beach, LLC	
+rexroth		generic	Robert Bosch GMBH	
+rich		generic	iRegistry GmbH	
+richardli		generic	Pacific Century Asset Management (HK) Limited	
+ricoh		generic	Ricoh Company, Ltd.	
+ril		generic	Reliance Industries Limited	
+rio		generic	Empresa Municipal de Informática SA - IPLANRIO	
+rip		generic	Dog Beach, LLC	
+ro		country-code		RO
+rocher		generic	Ferrero Trading Lux S.A.	
+rocks		generic	Dog Beach, LLC	
+rodeo		generic	Registry Services, LLC	
+rogers		generic	Rogers Communications Canada Inc.	
+room		generic	Amazon Registry Services, Inc.	
+rs		country-code		RS
+rsvp		generic	Charleston Road Registry Inc.	
+ru		country-code		RU
+rugby		generic	World Rugby Strategic Developments Limited	
+ruhr		generic	dotSaarland GmbH	
+run		generic	Binky Moon, LLC	
+rw		country-code		RW
+rwe		generic	RWE AG	
+ryukyu		generic	BRregistry, Inc.	
+sa		country-code		SA
+saarland		generic	dotSaarland GmbH	
+safe		generic	Amazon Registry Services, Inc.	
+safety		generic	Safety Registry Services, LLC.	
+sakura		generic	SAKURA Internet Inc.	
+sale		generic	Dog Beach, LLC	
+salon		generic	Binky Moon, LLC	
+samsclub		generic	Wal-Mart Stores, Inc.	
+samsung		generic	SAMSUNG SDS CO., LTD	
+sandvik		generic	Sandvik AB	
+sandvikcoromant		generic	Sandvik AB	
+sanofi		generic	Sanofi	
+sap		generic	SAP AG	
+sarl		generic	Binky Moon, LLC	
+sas		generic	Research IP LLC	
+save		generic	Amazon Registry Services, Inc.	
+saxo		generic	Saxo Bank A/S	
+sb		country-code		SB
+sbi		generic	STATE BANK OF INDIA	
+sbs		generic	ShortDot SA	
+sc		country-code		SC
+sca		generic	SVENSKA CELLULOSA AKTIEBOLAGET SCA (publ)	
+scb		generic	The Siam Commercial Bank Public Company Limited ("SCB")	
+schaeffler		generic	Schaeffler Technologies AG & Co. KG	
+schmidt		generic	SCHMIDT GROUPE S.A.S.	
+scholarships		generic	Scholarships.com, LLC	
+school		generic	Binky Moon, LLC	
+schule		generic	Binky Moon, LLC	
+schwarz		generic	Schwarz Domains und Services GmbH & Co. KG	
+science		generic	dot Science Limited	
+scot		generic	Dot Scot Registry Limited	
+sd		country-code		SD
+se		country-code		SE
+search		generic	Charleston Road Registry Inc.	
+seat		generic	SEAT, S.A. (Sociedad Unipersonal)	
+secure		generic	Amazon Registry Services, Inc.	
+security		generic	XYZ.COM LLC	
+seek		generic	Seek Limited	
+select		generic	Registry Services, LLC	
+sener		generic	Sener Ingeniería y Sistemas, S.A.	
+services		generic	Binky Moon, LLC	
+seven		generic	Seven West Media Ltd	
+sew		generic	SEW-EURODRIVE GmbH & Co KG	
+sex		generic	ICM Registry SX LLC	
+sexy		generic	Internet Naming Company LLC	
+sfr		generic	Societe Francaise du Radiotelephone - SFR	
+sg		country-code		SG
+sh		country-code		SH
+shangrila		generic	Shangri‐La International Hotel Management Limited	
+sharp		generic	Sharp Corporation	
+shaw		generic	Shaw Cablesystems G.P.	
+shell		generic	Shell Information Technology International Inc	
+shia		generic	Asia Green IT System Bilgisayar San. ve Tic. Ltd. Sti.	
+shiksha		generic	Identity Digital Limited	
+shoes		generic	Binky Moon, LLC	
+shop		generic	GMO Registry, Inc.	
+shopping		generic	Binky Moon, LLC	
+shouji		generic	Beijing Qihu Keji Co., Ltd.	
+show		generic	Binky Moon, LLC	
+showtime		generic	CBS Domains Inc.	
+si		country-code		SI
+silk		generic	Amazon Registry Services, Inc.	
+sina		generic	Sina Corporation	
+singles		generic	Binky Moon, LLC	
+site		generic	Radix FZC	
+sj		country-code		SJ
+sk		country-code		SK
+ski		generic	Identity Digital Limited	
+skin		generic	XYZ.COM LLC	
+sky		generic	Sky International AG	
+skype		generic	Microsoft Corporation	
+sl		country-code		SL
+sling		generic	DISH Technologies L.L.C.	
+sm		country-code		SM
+smart		generic	Smart Communications, Inc. (SMART)	
+smile		generic	Amazon Registry Services, Inc.	
+sn		country-code		SN
+sncf		generic	Société Nationale SNCF	
+so		country-code		SO
+soccer		generic	Binky Moon, LLC	
+social		generic	Dog Beach, LLC	
+softbank		generic	SoftBank Group Corp.	
+software		generic	Dog Beach, LLC	
+sohu		generic	Sohu.com Limited	
+solar		generic	Binky Moon, LLC	
+solutions		generic	Binky Moon, LLC	
+song		generic	Amazon Registry Services, Inc.	
+sony		generic	Sony Corporation	
+soy		generic	Charleston Road Registry Inc.	
+spa		generic	Asia Spa and Wellness Promotion Council Limited	
+space		generic	Radix FZC	
+sport		generic	Global Association of International Sports Federations (GAISF)	
+spot		generic	Amazon Registry Services, Inc.	
+sr		country-code		SR
+srl		generic	InterNetX, Corp	
+ss		country-code		SS
+st		country-code		ST
+stada		generic	STADA Arzneimittel AG	
+staples		generic	Staples, Inc.	
+star		generic	Star India Private Limited	
+statebank		generic	STATE BANK OF INDIA	
+statefarm		generic	State Farm Mutual Automobile Insurance Company	
+stc		generic	Saudi Telecom Company	
+stcgroup		generic	Saudi Telecom Company	
+stockholm		generic	Stockholms kommun	
+storage		generic	XYZ.COM LLC	
+store		generic	Radix FZC	
+stream		generic	dot Stream Limited	
+studio		generic	Dog Beach, LLC	
+study		generic	Registry Services, LLC	
+style		generic	Binky Moon, LLC	
+su		country-code		
+sucks		generic	Vox Populi Registry Ltd.	
+supplies		generic	Binky Moon, LLC	
+supply		generic	Binky Moon, LLC	
+support		generic	Binky Moon, LLC	
+surf		generic	Registry Services, LLC	
+surgery		generic	Binky Moon, LLC	
+suzuki		generic	SUZUKI MOTOR CORPORATION	
+sv		country-code		SV
+swatch		generic	The Swatch Group Ltd	
+swiss		generic	Swiss Confederation	
+sx		country-code		SX
+sy		country-code		SY
+sydney		generic	State of New South Wales, Department of Premier and Cabinet	
+systems		generic	Binky Moon, LLC	
+sz		country-code		SZ
+tab		generic	Tabcorp Holdings Limited	
+taipei		generic	Taipei City Government	
+talk		generic	Amazon Registry Services, Inc.	
+taobao		generic	Alibaba Group Holding Limited	
+target		generic	Target Domain Holdings, LLC	
+tatamotors		generic	Tata Motors Ltd	
+tatar		generic	Limited Liability Company "Coordination Center of Regional Domain of Tatarstan Republic"	
+tattoo		generic	Top Level Design, LLC	
+tax		generic	Binky Moon, LLC	
+taxi		generic	Binky Moon, LLC	
+tc		country-code		TC
+tci		generic	Asia Green IT System Bilgisayar San. ve Tic. Ltd. Sti.	
+td		country-code		TD
+tdk		generic	TDK Corporation	
+team		generic	Binky Moon, LLC	
+tech		generic	Radix FZC	
+technology		generic	Binky Moon, LLC	
+tel		sponsored		
+temasek		generic	Temasek Holdings (Private) Limited	
+tennis		generic	Binky Moon, LLC	
+teva		generic	Teva Pharmaceutical Industries Limited	
+tf		country-code		TF
+tg		country-code		TG
+th		country-code		TH
+thd		generic	Home Depot Product Authority, LLC	
+theater		generic	Binky Moon, LLC	
+theatre		generic	XYZ.COM LLC	
+tiaa		generic	Teachers Insurance and Annuity Association of America	
+tickets		generic	XYZ.COM LLC	
+tienda		generic	Binky Moon, LLC	
+tiffany		generic	Tiffany and Company	
+tips		generic	Binky Moon, LLC	
+tires		generic	Binky Moon, LLC	
+tirol		generic	punkt Tirol GmbH	
+tj		country-code		TJ
+tjmaxx		generic	The TJX Companies, Inc.	
+tjx		generic	The TJX Companies, Inc.	
+tk		country-code		TK
+tkmaxx		generic	The TJX Companies, Inc.	
+tl		country-code		TL
+tm		country-code		TM
+tmall		generic	Alibaba Group Holding Limited	
+tn		country-code		TN
+to		country-code		TO
+today		generic	Binky Moon, LLC	
+tokyo		generic	GMO Registry, Inc.	
+tools		generic	Binky Moon, LLC	
+top		generic	.TOP Registry	
+toray		generic	Toray Industries, Inc.	
+toshiba		generic	TOSHIBA Corporation	
+total		generic	TotalEnergies SE	
+tours		generic	Binky Moon, LLC	
+town		generic	Binky Moon, LLC	
+toyota		generic	TOYOTA MOTOR CORPORATION	
+toys		generic	Binky Moon, LLC	
+tr		country-code		TR
+trade		generic	Elite Registry Limited	
+trading		generic	Dog Beach, LLC	
+training		generic	Binky Moon, LLC	
+travel		sponsored	Dog Beach, LLC	
+travelchannel		generic	Lifestyle Domain Holdings, Inc.	
+travelers		generic	Travelers TLD, LLC	
+travelersinsurance		generic	Travelers TLD, LLC	
+trust		generic	Internet Naming Company LLC	
+trv		generic	Travelers TLD, LLC	
+tt		country-code		TT
+tube		generic	Latin American Telecom LLC	
+tui		generic	TUI AG	
+tunes		generic	Amazon Registry Services, Inc.	
+tushu		generic	Amazon Registry Services, Inc.	
+tv		country-code		TV
+tvs		generic	T V SUNDRAM IYENGAR  & SONS LIMITED	
+tw		country-code		TW
+tz		country-code		TZ
+ua		country-code		UA
+ubank		generic	National Australia Bank Limited	
+ubs		generic	UBS AG	
+ug		country-code		UG
+uk		country-code		GB
+unicom		generic	China United Network Communications Corporation Limited	
+university		generic	Binky Moon, LLC	
+uno		generic	Radix FZC	
+uol		generic	UBN INTERNET LTDA.	
+ups		generic	UPS Market Driver, Inc.	
+us		country-code		US
+uy		country-code		UY
+uz		country-code		UZ
+va		country-code		VA
+vacations		generic	Binky Moon, LLC	
+vana		generic	Lifestyle Domain Holdings, Inc.	
+vanguard		generic	The Vanguard Group, Inc.	
+vc		country-code		VC
+ve		country-code		VE
+vegas		generic	Dot Vegas, Inc.	
+ventures		generic	Binky Moon, LLC	
+verisign		generic	VeriSign, Inc.	
+versicherung		generic	tldbox GmbH	
+vet		generic	Dog Beach, LLC	
+vg		country-code		VG
+vi		country-code		VI
+viajes		generic	Binky Moon, LLC	
+video		generic	Dog Beach, LLC	
+vig		generic	VIENNA INSURANCE GROUP AG Wiener Versicherung Gruppe	
+viking		generic	Viking River Cruises (Bermuda) Ltd.	
+villas		generic	Binky Moon, LLC	
+vin		generic	Binky Moon, LLC	
+vip		generic	Registry Services, LLC	
+virgin		generic	Virgin Enterprises Limited	
+visa		generic	Visa Worldwide Pte. Limited	
+vision		generic	Binky Moon, LLC	
+viva		generic	Saudi Telecom Company	
+vivo		generic	Telefonica Brasil S.A.	
+vlaanderen		generic	DNS.be vzw	
+vn		country-code		VN
+vodka		generic	Registry Services, LLC	
+volkswagen		generic	Volkswagen Group of America Inc.	
+volvo		generic	Volvo Holding Sverige Aktiebolag	
+vote		generic	Monolith Registry LLC	
+voting		generic	Valuetainment Corp.	
+voto		generic	Monolith Registry LLC	
+voyage		generic	Binky Moon, LLC	
+vu		country-code		VU
+vuelos		generic	Travel Reservations SRL	
+wales		generic	Nominet UK	
+walmart		generic	Wal-Mart Stores, Inc.	
+walter		generic	Sandvik AB	
+wang		generic	Zodiac Wang Limited	
+wanggou		generic	Amazon Registry Services, Inc.	
+watch		generic	Binky Moon, LLC	
+watches		generic	Identity Digital Limited	
+weather		generic	International Business Machines Corporation	
+weatherchannel		generic	International Business Machines Corporation	
+webcam		generic	dot Webcam Limited	
+weber		generic	Saint-Gobain Weber SA	
+website		generic	Radix FZC	
+wedding		generic	Registry Services, LLC	
+weibo		generic	Sina Corporation	
+weir		generic	Weir Group IP Limited	
+wf		country-code		WF
+whoswho		generic	Who's Who Registry	
+wien		generic	punkt.wien GmbH	
+wiki		generic	Top Level Design, LLC	
+williamhill		generic	William Hill Organization Limited	
+win		generic	First Registry Limited	
+windows		generic	Microsoft Corporation	
+wine		generic	Binky Moon, LLC	
+winners		generic	The TJX Companies, Inc.	
+wme		generic	William Morris Endeavor Entertainment, LLC	
+wolterskluwer		generic	Wolters Kluwer N.V.	
+woodside		generic	Woodside Petroleum Limited	
+work		generic	Registry Services, LLC	
+works		generic	Binky Moon, LLC	
+world		generic	Binky Moon, LLC	
+wow		generic	Amazon Registry Services, Inc.	
+ws		country-code		WS
+wtc		generic	World Trade Centers Association, Inc.	
+wtf		generic	Binky Moon, LLC	
+xbox		generic	Microsoft Corporation	
+xerox		generic	Xerox DNHC LLC	
+xfinity		generic	Comcast IP Holdings I, LLC	
+xihuan		generic	Beijing Qihu Keji Co., Ltd.	
+xin		generic	Elegant Leader Limited	
+xn--11b4c3d	कॉम	generic	VeriSign Sarl	
+xn--1ck2e1b	セール	generic	Amazon Registry Services, Inc.	
+xn--1qqw23a	佛山	generic	Guangzhou YU Wei Information Technology Co., Ltd.	
+xn--2scrj9c	ಭಾರತ	country-code		IN
+xn--30rr7y	慈善	generic	Excellent First Limited	
+xn--3bst00m	集团	generic	Eagle Horizon Limited	
+xn--3ds443g	在线	generic	TLD REGISTRY LIMITED OY	
+xn--3e0b707e	한국	country-code		KR
+xn--3hcrj9c	ଭାରତ	country-code		IN
+xn--3pxu8k	点看	generic	VeriSign Sarl	
+xn--42c2d9a	คอม	generic	VeriSign Sarl	
+xn--45br5cyl	ভাৰত	country-code		IN
+xn--45brj9c	ভারত	country-code		IN
+xn--45q11c	八卦	generic	Zodiac Gemini Ltd	
+xn--4dbrk0ce	ישראל	country-code		IL
+xn--4gbrim	موقع	generic	Helium TLDs Ltd	
+xn--54b7fta0cc	বাংলা	country-code		BD
+xn--55qw42g	公益	generic	China Organizational Name Administration Center	
+xn--55qx5d	公司	generic	China Internet Network Information Center (CNNIC)	
+xn--5su34j936bgsg	香格里拉	generic	Shangri‐La International Hotel Management Limited	
+xn--5tzm5g	网站	generic	Global Website TLD Asia Limited	
+xn--6frz82g	移动	generic	Identity Digital Limited	
+xn--6qq986b3xl	我爱你	generic	Tycoon Treasure Limited	
+xn--80adxhks	москва	generic	Foundation for Assistance for Internet Technologies and Infrastructure Development (FAITID)	
+xn--80ao21a	қаз	country-code		KZ
+xn--80aqecdr1a	католик	generic	Pontificium Consilium de Comunicationibus Socialibus (PCCS) (Pontifical Council for Social Communication)	
+xn--80asehdb	онлайн	generic	CORE Association	
+xn--80aswg	сайт	generic	CORE Association	
+xn--8y0a063a	联通	generic	China United Network Communications Corporation Limited	
+xn--90a3ac	срб	country-code		RS
+xn--90ae	бг	country-code		BG
+xn--90ais	бел	country-code		BY
+xn--9dbq2a	קום	generic	VeriSign Sarl	
+xn--9et52u	时尚	generic	RISE VICTORY LIMITED	
+xn--9krt00a	微博	generic	Sina Corporation	
+xn--b4w605ferd	淡马锡	generic	Temasek Holdings (Private) Limited	
+xn--bck1b9a5dre4c	ファッション	generic	Amazon Registry Services, Inc.	
+xn--c1avg	орг	generic	Public Interest Registry	
+xn--c2br7g	नेट	generic	VeriSign Sarl	
+xn--cck2b3b	ストア	generic	Amazon Registry Services, Inc.	
+xn--cckwcxetd	アマゾン	generic	Amazon Registry Services, Inc.	
+xn--cg4bki	삼성	generic	SAMSUNG SDS CO., LTD	
+xn--clchc0ea0b2g2a9gcd	சிங்கப்பூர்	country-code		SG
+xn--czr694b	商标	generic	Internet DotTrademark Organisation Limited	
+xn--czrs0t	商店	generic	Binky Moon, LLC	
+xn--czru2d	商城	generic	Zodiac Aquarius Limited	
+xn--d1acj3b	дети	generic	The Foundation for Network Initiatives “The Smart Internet”	
+xn--d1alf	мкд	country-code		MK
+xn--e1a4c	ею	country-code		
+xn--eckvdtc9d	ポイント	generic	Amazon Registry Services, Inc.	
+xn--efvy88h	新闻	generic	Guangzhou YU Wei Information Technology Co., Ltd.	
+xn--fct429k	家電	generic	Amazon Registry Services, Inc.	
+xn--fhbei	كوم	generic	VeriSign Sarl	
+xn--fiq228c5hs	中文网	generic	TLD REGISTRY LIMITED OY	
+xn--fiq64b	中信	generic	CITIC Group Corporation	
+xn--fiqs8s	中国	country-code		CN
+xn--fiqz9s	中國	country-code		CN
+xn--fjq720a	娱乐	generic	Binky Moon, LLC	
+xn--flw351e	谷歌	generic	Charleston Road Registry Inc.	
+xn--fpcrj9c3d	భారత్	country-code		IN
+xn--fzc2c9e2c	ලංකා	country-code		LK
+xn--fzys8d69uvgm	電訊盈科	generic	PCCW Enterprises Limited	
+xn--g2xx48c	购物	generic	Nawang Heli(Xiamen) Network Service Co., LTD.	
+xn--gckr3f0f	クラウド	generic	Amazon Registry Services, Inc.	
+xn--gecrj9c	ભારત	country-code		IN
+xn--gk3at1e	通販	generic	Amazon Registry Services, Inc.	
+xn--h2breg3eve	भारतम्	country-code		IN
+xn--h2brj9c	भारत	country-code		IN
+xn--h2brj9c8c	भारोत	country-code		IN
+xn--hxt814e	网店	generic	Zodiac Taurus Limited	
+xn--i1b6b1a6a2e	संगठन	generic	Public Interest Registry	
+xn--imr513n	餐厅	generic	Internet DotTrademark Organisation Limited	
+xn--io0a7i	网络	generic	China Internet Network Information Center (CNNIC)	
+xn--j1aef	ком	generic	VeriSign Sarl	
+xn--j1amh	укр	country-code		UA
+xn--j6w193g	香港	country-code		HK
+xn--jlq480n2rg	亚马逊	generic	Amazon Registry Services, Inc.	
+xn--jvr189m	食品	generic	Amazon Registry Services, Inc.	
+xn--kcrx77d1x4a	飞利浦	generic	Koninklijke Philips N.V.	
+xn--kprw13d	台湾	country-code		TW
+xn--kpry57d	台灣	country-code		TW
+xn--kput3i	手机	generic	Beijing RITT-Net Technology Development Co., Ltd	
+xn--l1acc	мон	country-code		MN
+xn--lgbbat1ad8j	الجزائر	country-code		DZ
+xn--mgb2ddes	اليمن	country-code		YE
+xn--mgb9awbf	عمان	country-code		OM
+xn--mgba3a3ejt	ارامكو	generic	Aramco Services Company	
+xn--mgba3a4f16a	ایران	country-code		IR
+xn--mgba3a4fra	ايران	country-code		IR
+xn--mgba7c0bbn0a	العليان	generic	Crescent Holding GmbH	
+xn--mgbaakc7dvf	اتصالات	generic	Emirates Telecommunications Corporation (trading as Etisalat)	
+xn--mgbaam7a8h	امارات	country-code		AE
+xn--mgbab2bd	بازار	generic	CORE Association	
+xn--mgbah1a3hjkrd	موريتانيا	country-code		MR
+xn--mgbai9a5eva00b	پاكستان	country-code		PK
+xn--mgbai9azgqp6j	پاکستان	country-code		PK
+xn--mgbayh7gpa	الاردن	country-code		JO
+xn--mgbbh1a	بارت	country-code		IN
+xn--mgbbh1a71e	بھارت	country-code		IN
+xn--mgbc0a9azcg	المغرب	country-code		MA
+xn--mgbca7dzdo	ابوظبي	generic	Abu Dhabi Systems and Information Centre	
+xn--mgbcpq6gpa1a	البحرين	country-code		BH
+xn--mgberp4a5d4a87g	السعودیة	country-code		SA
+xn--mgberp4a5d4ar	السعودية	country-code		SA
+xn--mgbgu82a	ڀارت	country-code		IN
+xn--mgbi4ecexp	كاثوليك	generic	Pontificium Consilium de Comunicationibus Socialibus (PCCS) (Pontifical Council for Social Communication)	
+xn--mgbpl2fh	سودان	country-code		SD
+xn--mgbqly7c0a67fbc	السعودیۃ	country-code		SA
+xn--mgbqly7cvafr	السعوديه	country-code		SA
+xn--mgbt3dhd	همراه	generic	Asia Green IT System Bilgisayar San. ve Tic. Ltd. Sti.	
+xn--mgbtf8fl	سوريا	country-code		SY
+xn--mgbtx2b	عراق	country-code		IQ
+xn--mgbx4cd0ab	مليسيا	country-code		MY
+xn--mix082f	澳门	country-code		MO
+xn--mix891f	澳門	country-code		MO
+xn--mk1bu44c	닷컴	generic	VeriSign Sarl	
+xn--mxtq1m	政府	generic	Net-Chinese Co., Ltd.	
+xn--ngbc5azd	شبكة	generic	International Domain Registry Pty. Ltd.	
+xn--ngbe9e0a	بيتك	generic	Kuwait Finance House	
+xn--ngbrx	عرب	generic	League of Arab States	
+xn--nnx388a	臺灣	country-code		TW
+xn--node	გე	country-code		GE
+xn--nqv7f	机构	generic	Public Interest Registry	
+xn--nqv7fs00ema	组织机构	generic	Public Interest Registry	
+xn--nyqy26a	健康	generic	Stable Tone Limited	
+xn--o3cw4h	ไทย	country-code		TH
+xn--ogbpf8fl	سورية	country-code		SY
+xn--otu796d	招聘	generic	Jiang Yu Liang Cai Technology Company Limited	
+xn--p1acf	рус	generic	Rusnames Limited	
+xn--p1ai	рф	country-code		RU
+xn--pgbs0dh	تونس	country-code		TN
+xn--pssy2u	大拿	generic	VeriSign Sarl	
+xn--q7ce6a	ລາວ	country-code		LA
+xn--q9jyb4c	みんな	generic	Charleston Road Registry Inc.	
+xn--qcka1pmc	グーグル	generic	Charleston Road Registry Inc.	
+xn--qxa6a	ευ	country-code		
+xn--qxam	ελ	country-code		GR
+xn--rhqv96g	世界	generic	Stable Tone Limited	
+xn--rovu88b	書籍	generic	Amazon Registry Services, Inc.	
+xn--rvc1e0am3e	ഭാരതം	country-code		IN
+xn--s9brj9c	ਭਾਰਤ	country-code		IN
+xn--ses554g	网址	generic	KNET Co., Ltd.	
+xn--t60b56a	닷넷	generic	VeriSign Sarl	
+xn--tckwe	コム	generic	VeriSign Sarl	
+xn--tiq49xqyj	天主教	generic	Pontificium Consilium de Comunicationibus Socialibus (PCCS) (Pontifical Council for Social Communication)	
+xn--unup4y	游戏	generic	Binky Moon, LLC	
+xn--vermgensberater-ctb	vermögensberater	generic	Deutsche Vermögensberatung Aktiengesellschaft DVAG	
+xn--vermgensberatung-pwb	vermögensberatung	generic	Deutsche Vermögensberatung Aktiengesellschaft DVAG	
+xn--vhquv	企业	generic	Binky Moon, LLC	
+xn--vuq861b	信息	generic	Beijing Tele-info Network Technology Co., Ltd.	
+xn--w4r85el8fhu5dnra	嘉里大酒店	generic	Kerry Trading Co. Limited	
+xn--w4rs40l	嘉里	generic	Kerry Trading Co. Limited	
+xn--wgbh1c	مصر	country-code		EG
+xn--wgbl6a	قطر	country-code		QA
+xn--xhq521b	广东	generic	Guangzhou YU Wei Information Technology Co., Ltd.	
+xn--xkc2al3hye2a	இலங்கை	country-code		LK
+xn--xkc2dl3a5ee0h	இந்தியா	country-code		IN
+xn--y9a3aq	հայ	country-code		AM
+xn--yfro4i67o	新加坡	country-code		SG
+xn--ygbi2ammx	فلسطين	country-code		PS
+xn--zfr164b	政务	generic	China Organizational Name Administration Center	
+xxx		sponsored		
+xyz		generic	XYZ.COM LLC	
+yachts		generic	XYZ.COM LLC	
+yahoo		generic	Oath Inc.	
+yamaxun		generic	Amazon Registry Services, Inc.	
+yandex		generic	Yandex Europe B.V.	
+ye		country-code		YE
+yodobashi		generic	YODOBASHI CAMERA CO.,LTD.	
+yoga		generic	Registry Services, LLC	
+yokohama		generic	GMO Registry, Inc.	
+you		generic	Amazon Registry Services, Inc.	
+youtube		generic	Charleston Road Registry Inc.	
+yt		country-code		YT
+yun		generic	Beijing Qihu Keji Co., Ltd.	
+zappos		generic	Amazon Registry Services, Inc.	
+zara		generic	Industria de Diseño Textil, S.A. (INDITEX, S.A.)	
+zero		generic	Amazon Registry Services, Inc.	
+zip		generic	Charleston Road Registry Inc.	
+zm		country-code		ZM
+zone		generic	Binky Moon, LLC	
+zuerich		generic	Kanton Zürich (Canton of Zurich)	
+zw		country-code		ZW`
@@ -0,0 +1,371 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package tld implements the methods of a stddata.Provider.
+It provides searches against the top-level domains of the DNS root
+zone: the generic, sponsored and country-code TLDs, with their types,
+their managers, and the countries of the ccTLDs. Internationalized
+TLDs are found by their ASCII form, such as "xn--p1ai", and by their
+Unicode form, such as "рф". ValidateDomain checks domain names, such
+as the domain of an email address, against them. Source data is
+declared in tlddata.go.
+*/
+package tld
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/country"
+)
+
+// The types of TLD, as the root zone database names them.
+const (
+	Generic           = "generic"            // for example .com and .music
+	GenericRestricted = "generic-restricted" // .biz, .name and .pro
+	Sponsored         = "sponsored"          // for example .aero and .museum
+	CountryCode       = "country-code"       // for example .de and .рф
+	Infrastructure    = "infrastructure"     // .arpa
+)
+
+// TLDProvider implements the Provider interface.
+type TLDProvider struct {
+	data atomic.Pointer[tldData] // nil until the data is loaded
+}
+
+// tldData is the data of a TLDProvider. Once it is stored in the
+// TLDProvider it is not modified.
+type tldData struct {
+	size       int
+	info       stddata.Info
+	tldIndexes map[string]tldIndex
+}
+
+type tldIndex struct {
+	tldMap     map[string][]TLD
+	tldKeys    []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// TLD models one entity.
+type TLD struct {
+	Domain  string // in ASCII, without the dot, for example "de" or "xn--p1ai"
+	Unicode string // the Unicode form of an internationalized TLD, for example "рф"
+	Type    string // Generic, GenericRestricted, Sponsored, CountryCode or Infrastructure
+	Manager string // the registry operator, where it is recorded
+	// CountryCode is the ISO 3166-1 alpha-2 code of the country of a
+	// ccTLD, for example "GB" for .uk.
+	CountryCode string
+}
+
+// TLDResult is the interface{} that is returned from Search
+type TLDResult struct {
+	TLDs [][]TLD
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *TLDProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *TLDProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &tldData{tldIndexes: make(map[string]tldIndex)}
+	domainMap := make(map[string][]TLD)
+	typeMap := make(map[string][]TLD)
+	managerMap := make(map[string][]TLD)
+	countryMap := make(map[string][]TLD)
+
+	reader := csv.NewReader(strings.NewReader(tlddata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 5
+	reader.LazyQuotes = true
+
+	n := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var t TLD
+		t.Domain = record[0]
+		t.Unicode = record[1]
+		t.Type = record[2]
+		t.Manager = record[3]
+		t.CountryCode = record[4]
+		if t.Domain == "" || !isLDH(t.Domain) {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed domain %q", line, t.Domain)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the TLD to the maps
+		domainMap[t.Domain] = append(domainMap[t.Domain], t)
+		if t.Unicode != "" {
+			domainMap[t.Unicode] = append(domainMap[t.Unicode], t)
+		}
+		typeMap[t.Type] = append(typeMap[t.Type], t)
+		if t.Manager != "" {
+			managerMap[t.Manager] = append(managerMap[t.Manager], t)
+		}
+		if t.CountryCode != "" {
+			countryMap[t.CountryCode] = append(countryMap[t.CountryCode], t)
+		}
+		n++
+	}
+	p.storeData(d, "domain", domainMap)
+	p.storeData(d, "type", typeMap)
+	p.storeData(d, "manager", managerMap)
+	p.storeData(d, "country", countryMap)
+	d.size = n
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = n
+	return r, nil
+}
+
+// isLDH reports whether s holds only lower case letters, digits and
+// hyphens.
+func isLDH(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// Info describes the provenance of the loaded data.
+func (p *TLDProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// Get returns the TLD domain, in its ASCII or Unicode form, with or
+// without its leading dot, and in any case: "DE", ".de" and "рф" are
+// all found.
+func (p *TLDProvider) Get(domain string) (t TLD, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return t, stddata.ErrNotLoaded
+	}
+	key := strings.ToLower(strings.TrimPrefix(domain, "."))
+	tlds, found := d.tldIndexes["domain"].tldMap[key]
+	if !found {
+		msg := "No top-level domain " + domain
+		return t, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return tlds[0], nil
+}
+
+// IsValid reports whether domain is a top-level domain of the root
+// zone, as Get finds it.
+func (p *TLDProvider) IsValid(domain string) bool {
+	_, err := p.Get(domain)
+	return err == nil
+}
+
+// ValidateDomain checks that domain is a well formed domain name of
+// at least two labels, such as "example.com" or "пример.рф", whose
+// top-level domain is in the root zone. A trailing dot is allowed. It
+// can be used to check the domain of an email address, the part after
+// its "@".
+func (p *TLDProvider) ValidateDomain(domain string) error {
+	name := strings.TrimSuffix(domain, ".")
+	labels := strings.Split(name, ".")
+	if len(name) > 253 || len(labels) < 2 {
+		msg := "Domain " + domain + " is not a domain name of at least two labels"
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	for _, label := range labels {
+		if !isLabel(label) {
+			msg := "Domain " + domain + " has a malformed label " + label
+			return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+		}
+	}
+	if _, err := p.Get(labels[len(labels)-1]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// isLabel reports whether s is a label of 1 to 63 characters of
+// letters, digits and hyphens, which neither begins nor ends with a
+// hyphen. The letters of internationalized labels may be any
+// letters, with their marks.
+func isLabel(s string) bool {
+	n := utf8.RuneCountInString(s)
+	if n < 1 || n > 63 || strings.HasPrefix(s, "-") || strings.HasSuffix(s, "-") {
+		return false
+	}
+	for _, r := range s {
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// CountryOf returns the Country of the ccTLD domain, as Get finds it,
+// from countries, which must be loaded. An error is returned for a
+// TLD that is not a ccTLD, and for the ccTLDs of places that are not
+// countries, such as .eu.
+func (p *TLDProvider) CountryOf(domain string, countries *country.CountryProvider) (c country.Country, err error) {
+	t, err := p.Get(domain)
+	if err != nil {
+		return c, err
+	}
+	if t.CountryCode == "" {
+		msg := "Top-level domain " + domain + " is not the ccTLD of a country"
+		return c, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return countries.GetByAlpha2(t.CountryCode)
+}
+
+func (p *TLDProvider) storeData(d *tldData, s string, m map[string][]TLD) {
+	// store the map
+	var ti tldIndex
+	ti.tldMap = m
+	// extract the keys
+	ti.tldKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		ti.tldKeys[i] = k
+		i++
+	}
+	// sort the keys
+	ti.foldedKeys = stddata.SortKeys(ti.tldKeys)
+	// add to tldIndexes
+	d.tldIndexes[s] = ti
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *TLDProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "domain", Description: "TLD, without its dot, in ASCII or Unicode", Example: "de"},
+		{Name: "type", Description: "type, such as generic or country-code", Example: "country-code"},
+		{Name: "manager", Description: "registry operator", Example: "Verisign"},
+		{Name: "country", Description: "ISO 3166-1 alpha-2 code of the country of a ccTLD", Example: "GB"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *TLDProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	ti := d.tldIndexes[index]
+	return stddata.Suggest(ti.tldKeys, ti.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of TLD entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching TLDs are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+// The domain index is keyed by both the ASCII and the Unicode forms of the TLDs,
+// without their dots, so an internationalized TLD is dumped twice.
+func (p *TLDProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	ti, found := d.tldIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.tldIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(ti, query)
+	return result, nil
+}
+
+// SearchTLDs is Search, returning the matches as they are, rather
+// than as a TLDResult in an interface{}.
+func (p *TLDProvider) SearchTLDs(index string, query string) ([][]TLD, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(TLDResult).TLDs, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the TLDs of each. It yields nothing if the
+// TLDProvider has no such index, or is not loaded.
+func (p *TLDProvider) Range(index string) iter.Seq2[string, []TLD] {
+	return func(yield func(string, []TLD) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		ti := d.tldIndexes[index]
+		for _, k := range ti.tldKeys {
+			if !yield(k, ti.tldMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(ti tldIndex, query string) (res TLDResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(ti.tldKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(ti.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.TLDs = make([][]TLD, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.TLDs = append(res.TLDs, ti.tldMap[ti.tldKeys[k]])
+	}
+	return res
+}
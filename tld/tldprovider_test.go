@@ -0,0 +1,108 @@
+package tld
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/country"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestTLDProvider(t *testing.T) {
+	expected := 1479
+	fmt.Println("Test: TLDProvider.Load")
+	p = new(TLDProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestTypeSearch(t *testing.T) {
+	res, err := p.Search("type", "sponsored")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if d := res.(TLDResult).TLDs; len(d) != 1 || len(d[0]) != 14 {
+		t.Fatalf("Expected 14 sponsored TLDs, got %v\n", d)
+	}
+	res, err = p.Search("country", "RU")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if d := res.(TLDResult).TLDs; len(d) != 1 || len(d[0]) != 2 {
+		t.Fatalf("Expected .ru and .рф, got %v\n", d)
+	}
+}
+func TestGet(t *testing.T) {
+	tp := p.(*TLDProvider)
+	d, err := tp.Get(".MUSIC")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if d.Type != Generic || d.Manager != "DotMusic Limited" {
+		t.Fatalf("Expected .music, got %v\n", d)
+	}
+	d, err = tp.Get("рф")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if d.Domain != "xn--p1ai" || d.Type != CountryCode {
+		t.Fatalf("Expected .рф, got %v\n", d)
+	}
+	if tp.IsValid("onion") || tp.IsValid("invalid") {
+		t.Fatalf("Expected only root zone TLDs to be valid\n")
+	}
+}
+func TestValidateDomain(t *testing.T) {
+	tp := p.(*TLDProvider)
+	for _, domain := range []string{"example.com", "www.example.co.uk.", "пример.рф", "xn--e1afmkfd.xn--p1ai", "a-b.music"} {
+		if err := tp.ValidateDomain(domain); err != nil {
+			t.Fatalf("Err %v\n", err)
+		}
+	}
+	for _, domain := range []string{"com", "example.invalid", "-example.com", "exa mple.com", "example..com", "example.com.."} {
+		if err := tp.ValidateDomain(domain); err == nil {
+			t.Fatalf("Expected %q to be invalid\n", domain)
+		}
+	}
+}
+func TestCountryOf(t *testing.T) {
+	countries := new(country.CountryProvider)
+	if _, err := countries.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	tp := p.(*TLDProvider)
+	c, err := tp.CountryOf(".uk", countries)
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if c.Alpha3Code != "GBR" {
+		t.Fatalf("Expected the United Kingdom, got %v\n", c)
+	}
+	if _, err = tp.CountryOf("eu", countries); err == nil {
+		t.Fatalf("Expected .eu not to be the ccTLD of a country\n")
+	}
+	if _, err = tp.CountryOf("com", countries); err == nil {
+		t.Fatalf("Expected .com not to be a ccTLD\n")
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(TLDProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(TLDProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "domain", Query: "de"}, {Index: "type", Query: "country-code"}, {Index: "country", Query: "GB"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
@@ -0,0 +1,78 @@
+package usstate
+
+// provenance of statedata, reported by Info
+const (
+	source    = "USPS Publication 28 and ANSI INCITS 38 (FIPS 5-2) State Codes"
+	sourceURL = "https://www.census.gov/library/reference/code-lists/ansi.html"
+	edition   = "2014"
+)
+
+/*
+statedata holds the states of the United States, the District of
+Columbia, the territories, and the freely associated states that the
+Postal Service addresses as states. Each line holds the USPS
+abbreviation, the two digit ANSI/FIPS code, the name, the capital
+and the type, separated by tabs. The Minor Outlying Islands have no
+capital, and the military "states" AA, AE and AP, which have no FIPS
+code, are not included.
+*/
+var statedata = `AL	01	Alabama	Montgomery	State
+AK	02	Alaska	Juneau	State
+AZ	04	Arizona	Phoenix	State
+AR	05	Arkansas	Little Rock	State
+CA	06	California	Sacramento	State
+CO	08	Colorado	Denver	State
+CT	09	Connecticut	Hartford	State
+DE	10	Delaware	Dover	State
+DC	11	District of Columbia	Washington	District
+FL	12	Florida	Tallahassee	State
+GA	13	Georgia	Atlanta	State
+HI	15	Hawaii	Honolulu	State
+ID	16	Idaho	Boise	State
+IL	17	Illinois	Springfield	State
+IN	18	Indiana	Indianapolis	State
+IA	19	Iowa	Des Moines	State
+KS	20	Kansas	Topeka	State
+KY	21	Kentucky	Frankfort	State
+LA	22	Louisiana	Baton Rouge	State
+ME	23	Maine	Augusta	State
+MD	24	Maryland	Annapolis	State
+MA	25	Massachusetts	Boston	State
+MI	26	Michigan	Lansing	State
+MN	27	Minnesota	Saint Paul	State
+MS	28	Mississippi	Jackson	State
+MO	29	Missouri	Jefferson City	State
+MT	30	Montana	Helena	State
+NE	31	Nebraska	Lincoln	State
+NV	32	Nevada	Carson City	State
+NH	33	New Hampshire	Concord	State
+NJ	34	New Jersey	Trenton	State
+NM	35	New Mexico	Santa Fe	State
+NY	36	New York	Albany	State
+NC	37	North Carolina	Raleigh	State
+ND	38	North Dakota	Bismarck	State
+OH	39	Ohio	Columbus	State
+OK	40	Oklahoma	Oklahoma City	State
+OR	41	Oregon	Salem	State
+PA	42	Pennsylvania	Harrisburg	State
+RI	44	Rhode Island	Providence	State
+SC	45	South Carolina	Columbia	State
+SD	46	South Dakota	Pierre	State
+TN	47	Tennessee	Nashville	State
+TX	48	Texas	Austin	State
+UT	49	Utah	Salt Lake City	State
+VT	50	Vermont	Montpelier	State
+VA	51	Virginia	Richmond	State
+WA	53	Washington	Olympia	State
+WV	54	West Virginia	Charleston	State
+WI	55	Wisconsin	Madison	State
+WY	56	Wyoming	Cheyenne	State
+AS	60	American Samoa	Pago Pago	Territory
+FM	64	Federated States of Micronesia	Palikir	Freely Associated State
+GU	66	Guam	Hagåtña	Territory
+MH	68	Marshall Islands	Majuro	Freely Associated State
+MP	69	Northern Mariana Islands	Saipan	Territory
+PW	70	Palau	Ngerulmud	Freely Associated State
+PR	72	Puerto Rico	San Juan	Territory
+UM	74	U.S. Minor Outlying Islands		Territory
+VI	78	U.S. Virgin Islands	Charlotte Amalie	Territory`
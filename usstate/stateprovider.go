@@ -0,0 +1,294 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package usstate implements the methods of a stddata.Provider.
+It provides searches against the states and territories of the
+United States, with their USPS abbreviations, ANSI/FIPS codes, names
+and capitals. It is independent of the ISO 3166-2 data of the
+subdivision package, for the many forms that need only these. Source
+data is declared in statedata.go.
+*/
+package usstate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// StateProvider implements the Provider interface.
+type StateProvider struct {
+	data atomic.Pointer[stateData] // nil until the data is loaded
+}
+
+// stateData is the data of a StateProvider. Once it is stored in the
+// StateProvider it is not modified.
+type stateData struct {
+	size         int
+	info         stddata.Info
+	stateIndexes map[string]stateIndex
+}
+
+type stateIndex struct {
+	stateMap   map[string][]State
+	stateKeys  []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// State models one entity.
+type State struct {
+	Abbreviation string // the USPS abbreviation, for example "NY"
+	FIPSCode     string // the two digit ANSI/FIPS code, for example "36"
+	Name         string
+	Capital      string // empty for the Minor Outlying Islands
+	// Type is "State", "District", "Territory", or "Freely Associated
+	// State" for the countries that the Postal Service serves as states.
+	Type string
+}
+
+// StateResult is the interface{} that is returned from Search
+type StateResult struct {
+	States [][]State
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *StateProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped, and
+// their line numbers are returned in the LoadReport.
+func (p *StateProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &stateData{stateIndexes: make(map[string]stateIndex)}
+	abbreviationMap := make(map[string][]State)
+	fipsMap := make(map[string][]State)
+	nameMap := make(map[string][]State)
+	capitalMap := make(map[string][]State)
+	typeMap := make(map[string][]State)
+
+	reader := csv.NewReader(strings.NewReader(statedata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 5
+	reader.LazyQuotes = true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var s State
+		s.Abbreviation = record[0]
+		s.FIPSCode = record[1]
+		s.Name = record[2]
+		s.Capital = record[3]
+		s.Type = record[4]
+		if len(s.Abbreviation) != 2 || len(s.FIPSCode) != 2 {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed code %q", line, s.Abbreviation+" "+s.FIPSCode)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the State to the maps
+		abbreviationMap[s.Abbreviation] = append(abbreviationMap[s.Abbreviation], s)
+		fipsMap[s.FIPSCode] = append(fipsMap[s.FIPSCode], s)
+		nameMap[s.Name] = append(nameMap[s.Name], s)
+		if s.Capital != "" {
+			capitalMap[s.Capital] = append(capitalMap[s.Capital], s)
+		}
+		typeMap[s.Type] = append(typeMap[s.Type], s)
+	}
+	p.storeData(d, "abbreviation", abbreviationMap)
+	p.storeData(d, "fips", fipsMap)
+	p.storeData(d, "name", nameMap)
+	p.storeData(d, "capital", capitalMap)
+	p.storeData(d, "type", typeMap)
+	d.size = len(abbreviationMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(abbreviationMap)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *StateProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// GetByAbbreviation returns the State whose USPS abbreviation is
+// abbreviation, in any case, for example "ny" for New York.
+func (p *StateProvider) GetByAbbreviation(abbreviation string) (s State, err error) {
+	d := p.data.Load()
+	if d == nil {
+		return s, stddata.ErrNotLoaded
+	}
+	return d.lookup("abbreviation", strings.ToUpper(abbreviation))
+}
+
+// GetByFIPS returns the State whose ANSI/FIPS code is fips. The
+// leading zero may be omitted, so "6" and "06" both return
+// California.
+func (p *StateProvider) GetByFIPS(fips string) (s State, err error) {
+	d := p.data.Load()
+	if d == nil {
+		return s, stddata.ErrNotLoaded
+	}
+	if n, err := strconv.Atoi(fips); err == nil && n >= 0 && n < 100 {
+		fips = fmt.Sprintf("%02d", n)
+	}
+	return d.lookup("fips", fips)
+}
+
+// lookup returns the State with the key in the index kind.
+func (d *stateData) lookup(kind string, key string) (s State, err error) {
+	states, found := d.stateIndexes[kind].stateMap[key]
+	if !found {
+		msg := "No state with " + kind + " " + key
+		return s, stddata.NewServiceError(http.StatusNotFound, msg, stddata.ErrNoMatch)
+	}
+	return states[0], nil
+}
+
+func (p *StateProvider) storeData(d *stateData, s string, m map[string][]State) {
+	// store the map
+	var si stateIndex
+	si.stateMap = m
+	// extract the keys
+	si.stateKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		si.stateKeys[i] = k
+		i++
+	}
+	// sort the keys
+	si.foldedKeys = stddata.SortKeys(si.stateKeys)
+	// add to stateIndexes
+	d.stateIndexes[s] = si
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *StateProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "abbreviation", Description: "USPS abbreviation", Example: "NY"},
+		{Name: "fips", Description: "ANSI/FIPS code", Example: "36"},
+		{Name: "name", Description: "name", Example: "New York"},
+		{Name: "capital", Description: "capital", Example: "Albany"},
+		{Name: "type", Description: "State, District, Territory or Freely Associated State", Example: "Territory"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *StateProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	si := d.stateIndexes[index]
+	return stddata.Suggest(si.stateKeys, si.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of State entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching States are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+func (p *StateProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	si, found := d.stateIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.stateIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(si, query)
+	return result, nil
+}
+
+// SearchStates is Search, returning the matches as they are, rather
+// than as a StateResult in an interface{}.
+func (p *StateProvider) SearchStates(index string, query string) ([][]State, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(StateResult).States, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the States of each. It yields nothing if the
+// StateProvider has no such index, or is not loaded.
+func (p *StateProvider) Range(index string) iter.Seq2[string, []State] {
+	return func(yield func(string, []State) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		si := d.stateIndexes[index]
+		for _, k := range si.stateKeys {
+			if !yield(k, si.stateMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(si stateIndex, query string) (res StateResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(si.stateKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(si.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.States = make([][]State, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.States = append(res.States, si.stateMap[si.stateKeys[k]])
+	}
+	return res
+}
@@ -0,0 +1,84 @@
+package usstate
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestStateProvider(t *testing.T) {
+	expected := 60
+	fmt.Println("Test: StateProvider.Load")
+	p = new(StateProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestTypeSearch(t *testing.T) {
+	res, err := p.Search("type", "State")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	s := res.(StateResult).States
+	if len(s) != 1 || len(s[0]) != 50 {
+		t.Fatalf("Expected the 50 states, got %v\n", s)
+	}
+}
+func TestNameSearch(t *testing.T) {
+	res, err := p.Search("name", "new")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if s := res.(StateResult).States; len(s) != 4 {
+		t.Fatalf("Expected four states named New, got %v\n", s)
+	}
+	res, err = p.Search("capital", "Columb")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if s := res.(StateResult).States; len(s) != 2 || s[0][0].Abbreviation != "SC" {
+		t.Fatalf("Expected South Carolina and Ohio, got %v\n", s)
+	}
+}
+func TestGet(t *testing.T) {
+	sp := p.(*StateProvider)
+	s, err := sp.GetByAbbreviation("ny")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if s.Name != "New York" || s.FIPSCode != "36" || s.Capital != "Albany" {
+		t.Fatalf("Expected New York, got %v\n", s)
+	}
+	s, err = sp.GetByFIPS("6")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if s.Abbreviation != "CA" {
+		t.Fatalf("Expected California, got %v\n", s)
+	}
+	if _, err = sp.GetByFIPS("03"); err == nil {
+		t.Fatalf("Expected no state with FIPS code 03\n")
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(StateProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(StateProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "abbreviation", Query: "NY"}, {Index: "name", Query: "new"}, {Index: "type", Query: "Territory"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}
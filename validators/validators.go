@@ -0,0 +1,125 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package validators checks standard codes in request structs, with
+functions backed by loaded stddata Providers, which can be registered
+with validator libraries under their tags. With go-playground/validator:
+
+	for tag, valid := range v.Funcs() {
+		validate.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+			return valid(fl.Field().String())
+		})
+	}
+
+	type Order struct {
+		Country  string `validate:"iso3166_alpha2"`
+		Language string `validate:"iso639_2"`
+		Routing  string `validate:"aba_routing"`
+		Currency string `validate:"iso4217"`
+	}
+
+The codes must be exactly as the standards write them: "DE", "ger" or
+"deu", and "EUR", but not "de", "Ger" or "eur".
+*/
+package validators
+
+import (
+	"github.com/musicbeat/stddata/bank"
+	"github.com/musicbeat/stddata/country"
+	"github.com/musicbeat/stddata/currency"
+	"github.com/musicbeat/stddata/language"
+)
+
+// Validators holds the loaded Providers behind the validation
+// functions. The functions of a Provider that is nil are left out of
+// Funcs, so that a tag that needs them is not registered.
+type Validators struct {
+	Countries  *country.CountryProvider
+	Languages  *language.LanguageProvider
+	Banks      *bank.BankProvider
+	Currencies *currency.CurrencyProvider
+}
+
+// Funcs returns the validation functions, by their tags:
+//
+//	iso3166_alpha2  an ISO 3166-1 alpha-2 country code, such as "DE"
+//	iso639_2        an ISO 639-2 language code, bibliographic or
+//	                terminologic, such as "ger" or "deu"
+//	aba_routing     an ABA routing number of a bank in the Federal
+//	                Reserve E-Payments Routing Directory
+//	iso4217         an ISO 4217 alphabetic currency code, such as
+//	                "EUR", that the CurrencyProvider finds
+func (v *Validators) Funcs() map[string]func(s string) bool {
+	m := make(map[string]func(s string) bool)
+	if v.Countries != nil {
+		m["iso3166_alpha2"] = v.IsCountry
+	}
+	if v.Languages != nil {
+		m["iso639_2"] = v.IsLanguage
+	}
+	if v.Banks != nil {
+		m["aba_routing"] = v.IsRoutingNumber
+	}
+	if v.Currencies != nil {
+		m["iso4217"] = v.IsCurrency
+	}
+	return m
+}
+
+// IsCountry reports whether s is exactly the ISO 3166-1 alpha-2 code
+// of a country.
+func (v *Validators) IsCountry(s string) bool {
+	c, err := v.Countries.GetByAlpha2(s)
+	return err == nil && c.Alpha2Code == s
+}
+
+// IsLanguage reports whether s is exactly the ISO 639-2 bibliographic
+// or terminologic code of a language.
+func (v *Validators) IsLanguage(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	groups, err := v.Languages.SearchLanguages("alpha", s)
+	if err != nil {
+		return false
+	}
+	for _, languages := range groups {
+		for _, l := range languages {
+			if l.Alpha3bibliographic == s || l.Alpha3terminologic == s {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsRoutingNumber reports whether s is the routing number of a bank: a
+// valid ABA routing number, as ValidateRoutingNumber checks, that is in
+// the directory.
+func (v *Validators) IsRoutingNumber(s string) bool {
+	_, err := v.Banks.Get("routing", s)
+	return err == nil
+}
+
+// IsCurrency reports whether s is exactly the ISO 4217 alphabetic code
+// of a currency that the CurrencyProvider finds, so that historic
+// currencies are valid only when it has IncludeHistoric set.
+func (v *Validators) IsCurrency(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	groups, err := v.Currencies.SearchCurrencies("code", s)
+	if err != nil {
+		return false
+	}
+	for _, currencies := range groups {
+		for _, c := range currencies {
+			if c.CurrencyCode == s {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/musicbeat/stddata/bank"
+	"github.com/musicbeat/stddata/country"
+)
+
+var v Validators
+
+func TestFuncs(t *testing.T) {
+	v.Countries = new(country.CountryProvider)
+	if _, err := v.Countries.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	v.Banks = new(bank.BankProvider)
+	if _, err := v.Banks.Load(); err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	funcs := v.Funcs()
+	if len(funcs) != 2 || funcs["iso3166_alpha2"] == nil || funcs["aba_routing"] == nil {
+		t.Fatalf("Expected the functions of countries and banks, got %v\n", funcs)
+	}
+}
+func TestIsCountry(t *testing.T) {
+	for s, expected := range map[string]bool{"DE": true, "US": true, "de": false, "D": false, "DEU": false, "XX": false, "": false} {
+		if v.IsCountry(s) != expected {
+			t.Fatalf("Expected IsCountry(%q) to be %v\n", s, expected)
+		}
+	}
+}
+func TestIsRoutingNumber(t *testing.T) {
+	for s, expected := range map[string]bool{"011000028": true, "011000029": false, "0110000": false, "": false} {
+		if v.IsRoutingNumber(s) != expected {
+			t.Fatalf("Expected IsRoutingNumber(%q) to be %v\n", s, expected)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vat
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/musicbeat/stddata"
+)
+
+// registry is the VATProvider that backs Validate. It is loaded the
+// first time it is needed.
+var registry struct {
+	once sync.Once
+	p    VATProvider
+	err  error
+}
+
+// registryProvider returns the loaded VATProvider behind Validate.
+func registryProvider() (*VATProvider, error) {
+	registry.once.Do(func() {
+		_, registry.err = registry.p.Load()
+	})
+	return &registry.p, registry.err
+}
+
+// checks holds the check digit algorithm of each prefix. Each is given
+// a number, without its prefix, that matches the pattern of its member
+// state, and reports whether its check digits are correct.
+var checks = map[string]func(s string) bool{
+	"AT": checkAT,
+	"BE": func(s string) bool { return 97-atoi(s[0:8])%97 == atoi(s[8:]) },
+	"BG": checkBG,
+	"CY": checkCY,
+	"CZ": checkCZ,
+	"DE": mod1110,
+	"DK": func(s string) bool { return weighted(s, 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0 },
+	"EE": func(s string) bool { return weighted(s, 3, 7, 1, 3, 7, 1, 3, 7, 1)%10 == 0 },
+	"EL": func(s string) bool { return weighted(s, 256, 128, 64, 32, 16, 8, 4, 2)%11%10 == digit(s, 8) },
+	"ES": checkES,
+	"FI": func(s string) bool { return weighted(s, 7, 9, 10, 5, 8, 4, 2, 1)%11 == 0 },
+	"FR": checkFR,
+	"HR": mod1110,
+	"HU": func(s string) bool { return weighted(s, 9, 7, 3, 1, 9, 7, 3, 1)%10 == 0 },
+	"IE": checkIE,
+	"IT": checkIT,
+	"LT": checkLT,
+	"LU": func(s string) bool { return atoi(s[0:6])%89 == atoi(s[6:]) },
+	"LV": checkLV,
+	"MT": func(s string) bool { return weighted(s, 3, 4, 6, 7, 8, 9, 10, 1)%37 == 0 },
+	"NL": checkNL,
+	"PL": func(s string) bool { return weighted(s, 6, 5, 7, 2, 3, 4, 5, 6, 7)%11 == digit(s, 9) },
+	"PT": func(s string) bool { return (11-weighted(s, 9, 8, 7, 6, 5, 4, 3, 2)%11)%11%10 == digit(s, 8) },
+	"RO": checkRO,
+	"SE": func(s string) bool { return luhn(s[0:10]) },
+	"SI": checkSI,
+	"SK": func(s string) bool { return atoi(s)%11 == 0 },
+	"XI": checkXI,
+}
+
+// Compact returns vatNumber in its electronic form: upper case,
+// without spaces, dots or hyphens. It does not validate vatNumber.
+func Compact(vatNumber string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '.', '-':
+			return -1
+		}
+		return r
+	}, strings.ToUpper(vatNumber))
+}
+
+// Validate returns nil if vatNumber is a valid VAT identification
+// number of the member state whose prefix, or ISO 3166-1 alpha-2
+// code, is countryCode: the number, with or without its prefix,
+// matches the member state's pattern and its check digits are
+// correct. The check is offline, and of the syntax only: a valid
+// number may not have been issued, or may no longer be in use.
+func Validate(countryCode string, vatNumber string) error {
+	p, err := registryProvider()
+	if err != nil {
+		return err
+	}
+	d := p.data.Load()
+	if d == nil {
+		return stddata.ErrNotLoaded
+	}
+	f, found := d.formatOf(countryCode)
+	if !found {
+		msg := "No VAT number format for country " + countryCode
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	s := Compact(vatNumber)
+	if strings.HasPrefix(s, f.Prefix) {
+		s = s[len(f.Prefix):]
+	} else if strings.HasPrefix(s, f.CountryCode) {
+		s = s[len(f.CountryCode):]
+	}
+	if !d.patterns[f.Prefix].MatchString(s) {
+		msg := "VAT number " + vatNumber + " does not match the format of " + f.Prefix + ", for example " + f.Prefix + f.Example
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	if !checks[f.Prefix](s) {
+		msg := "VAT number " + vatNumber + " has an incorrect check digit"
+		return stddata.NewServiceError(http.StatusBadRequest, msg, nil)
+	}
+	return nil
+}
+
+// digit returns the value of the digit at i in s.
+func digit(s string, i int) int {
+	return int(s[i] - '0')
+}
+
+// atoi returns the value of s, which must be digits.
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// weighted returns the sum of the leading digits of s, each multiplied
+// by its weight.
+func weighted(s string, weights ...int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += digit(s, i) * w
+	}
+	return sum
+}
+
+// luhn reports whether the digits of s pass the Luhn algorithm.
+func luhn(s string) bool {
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		d := digit(s, len(s)-1-i)
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// mod1110 reports whether the last digit of s is the check digit of
+// the others by ISO 7064 MOD 11,10.
+func mod1110(s string) bool {
+	p := 10
+	for i := 0; i < len(s)-1; i++ {
+		r := (digit(s, i) + p) % 10
+		if r == 0 {
+			r = 10
+		}
+		p = r * 2 % 11
+	}
+	return (11-p)%10 == digit(s, len(s)-1)
+}
+
+func checkAT(s string) bool {
+	// s is "U" and eight digits; the second, fourth and sixth digits
+	// are doubled, and the digits of the products are added.
+	sum := 0
+	for i := 1; i < 8; i++ {
+		d := digit(s, i)
+		if i%2 == 0 {
+			d = d*2/10 + d*2%10
+		}
+		sum += d
+	}
+	return (10-(sum+4)%10)%10 == digit(s, 8)
+}
+
+func checkBG(s string) bool {
+	if len(s) == 9 {
+		c := weighted(s, 1, 2, 3, 4, 5, 6, 7, 8) % 11
+		if c == 10 {
+			c = weighted(s, 3, 4, 5, 6, 7, 8, 9, 10) % 11 % 10
+		}
+		return c == digit(s, 8)
+	}
+	// a number of ten digits is the personal number of a citizen, of a
+	// foreigner, or the number of another taxable person.
+	if weighted(s, 2, 4, 8, 5, 10, 9, 7, 3, 6)%11%10 == digit(s, 9) {
+		return true
+	}
+	if weighted(s, 21, 19, 17, 13, 11, 9, 7, 3, 1)%10 == digit(s, 9) {
+		return true
+	}
+	c := 11 - weighted(s, 4, 3, 2, 7, 6, 5, 4, 3, 2)%11
+	return c != 10 && c%11 == digit(s, 9)
+}
+
+func checkCY(s string) bool {
+	if s[0:2] == "12" {
+		return false
+	}
+	// the digits in even places are replaced by the values in odd.
+	odd := []int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21}
+	sum := 0
+	for i := 0; i < 8; i++ {
+		if i%2 == 0 {
+			sum += odd[digit(s, i)]
+		} else {
+			sum += digit(s, i)
+		}
+	}
+	return byte('A'+sum%26) == s[8]
+}
+
+func checkCZ(s string) bool {
+	switch len(s) {
+	case 8:
+		// a legal entity
+		return (11-weighted(s, 8, 7, 6, 5, 4, 3, 2)%11)%10 == digit(s, 7)
+	case 10:
+		// the birth number of an individual
+		n, _ := strconv.ParseInt(s, 10, 64)
+		return n%11 == 0 || n/10%11 == 10 && n%10 == 0
+	}
+	// the birth number of an individual born before 1954, which has no
+	// check digit.
+	return true
+}
+
+func checkES(s string) bool {
+	const letters = "TRWAGMYFPDXBNJZSQVHLCKE"
+	switch {
+	case s[0] >= '0' && s[0] <= '9':
+		// the DNI of a citizen
+		return letters[atoi(s[0:8])%23] == s[8]
+	case s[0] >= 'X' && s[0] <= 'Z':
+		// the NIE of a foreigner: X, Y and Z stand for 0, 1 and 2
+		return letters[atoi(string('0'+s[0]-'X')+s[1:8])%23] == s[8]
+	case s[0] >= 'K' && s[0] <= 'M':
+		return letters[atoi(s[1:8])%23] == s[8]
+	}
+	// the CIF of a legal entity: the digits in odd places are doubled,
+	// and the digits of the products are added.
+	sum := 0
+	for i := 1; i < 8; i++ {
+		d := digit(s, i)
+		if i%2 == 1 {
+			d = d*2/10 + d*2%10
+		}
+		sum += d
+	}
+	c := (10 - sum%10) % 10
+	return s[8] == byte('0'+c) || s[8] == "JABCDEFGHI"[c]
+}
+
+func checkFR(s string) bool {
+	siren := s[2:]
+	if !luhn(siren) {
+		return false
+	}
+	if !isDigits(s[0:2]) {
+		// a key of letters is not computed from the SIREN
+		return true
+	}
+	return atoi(s[0:2]) == (12+3*(atoi(siren)%97))%97
+}
+
+func checkIE(s string) bool {
+	if s[1] < '0' || s[1] > '9' {
+		// the old format: the digits of the new format are rearranged
+		s = "0" + s[2:7] + s[0:1] + s[7:]
+	}
+	sum := weighted(s, 8, 7, 6, 5, 4, 3, 2)
+	if len(s) == 9 && s[8] != 'W' {
+		sum += 9 * int(s[8]-'A'+1)
+	}
+	return "WABCDEFGHIJKLMNOPQRSTUV"[sum%23] == s[7]
+}
+
+func checkIT(s string) bool {
+	if s[0:7] == "0000000" {
+		return false
+	}
+	// the code of the tax office
+	office := atoi(s[7:10])
+	if (office < 1 || office > 100) && office != 120 && office != 121 && office != 888 && office != 999 {
+		return false
+	}
+	return luhn(s)
+}
+
+func checkLT(s string) bool {
+	n := len(s) - 1
+	if s[n-1] != '1' {
+		return false
+	}
+	sum := 0
+	for i := 0; i < n; i++ {
+		sum += digit(s, i) * (1 + i%9)
+	}
+	if sum%11 == 10 {
+		sum = 0
+		for i := 0; i < n; i++ {
+			sum += digit(s, i) * (1 + (i+2)%9)
+		}
+	}
+	return sum%11%10 == digit(s, n)
+}
+
+func checkLV(s string) bool {
+	if s[0] <= '3' {
+		// the personal code of an individual, which has no check digit
+		return true
+	}
+	return weighted(s, 9, 1, 4, 8, 3, 10, 2, 5, 7, 6, 1)%11 == 3
+}
+
+func checkNL(s string) bool {
+	// the number of a sole proprietor since 2020 passes the IBAN check
+	return (weighted(s, 9, 8, 7, 6, 5, 4, 3, 2)-digit(s, 8))%11 == 0 || mod97("NL"+s) == 1
+}
+
+func checkRO(s string) bool {
+	s = strings.Repeat("0", 10-len(s)) + s
+	return weighted(s, 7, 5, 3, 2, 1, 7, 5, 3, 2)*10%11%10 == digit(s, 9)
+}
+
+func checkSI(s string) bool {
+	c := 11 - weighted(s, 8, 7, 6, 5, 4, 3, 2)%11
+	return c != 11 && c%10 == digit(s, 7)
+}
+
+func checkXI(s string) bool {
+	if !isDigits(s) {
+		// the number of a government department or a health authority,
+		// which has no check digit
+		return true
+	}
+	total := weighted(s, 8, 7, 6, 5, 4, 3, 2) + atoi(s[7:9])
+	return total%97 == 0 || (total+55)%97 == 0
+}
+
+// isDigits reports whether s is all digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// mod97 returns the remainder on division by 97 of the number that
+// s represents when each letter is replaced by two digits, A by 10
+// through Z by 35. s must be upper case letters and digits.
+func mod97(s string) int {
+	r := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 'A' {
+			r = (r*100 + int(s[i]-'A') + 10) % 97
+		} else {
+			r = (r*10 + int(s[i]-'0')) % 97
+		}
+	}
+	return r
+}
@@ -0,0 +1,50 @@
+package vat
+
+// provenance of vatdata, reported by Info
+const (
+	source    = "European Commission VIES VAT number formats"
+	sourceURL = "https://ec.europa.eu/taxation_customs/vies/"
+	edition   = "2025"
+)
+
+/*
+vatdata holds the formats of the VAT identification numbers of the
+member states of the European Union, and of Northern Ireland, which
+VIES also covers. The fields of each record are tab-delimited: the
+prefix of the numbers, which is the ISO 3166-1 alpha-2 code of the
+country except for Greece ("EL") and Northern Ireland ("XI"); the
+alpha-2 code; the pattern of a number without its prefix, which is a
+regular expression in the syntax of the regexp package; the kind of
+check digit algorithm; and an example. The algorithms themselves,
+which differ in their weights from one member state to another, are
+implemented in validate.go.
+*/
+var vatdata = `AT	AT	U\d{8}	weighted sum mod 10	U13585627
+BE	BE	[01]\d{9}	mod 97	0403019261
+BG	BG	\d{9,10}	weighted sum mod 11	175074752
+CY	CY	[0-59]\d{7}[A-Z]	weighted sum mod 26	10259033P
+CZ	CZ	\d{8,10}	weighted sum mod 11	25123891
+DE	DE	[1-9]\d{8}	ISO 7064 MOD 11,10	136695976
+DK	DK	[1-9]\d{7}	weighted sum mod 11	13585628
+EE	EE	10\d{7}	weighted sum mod 10	100931558
+EL	GR	\d{9}	weighted sum mod 11	094259216
+ES	ES	[0-9A-Z]\d{7}[0-9A-Z]	mod 23 or weighted sum mod 10	A13585625
+FI	FI	\d{8}	weighted sum mod 11	20774740
+FR	FR	[0-9A-HJ-NP-Z]{2}\d{9}	mod 97 and Luhn	40303265045
+HR	HR	\d{11}	ISO 7064 MOD 11,10	33392005961
+HU	HU	\d{8}	weighted sum mod 10	12892312
+IE	IE	\d{7}[A-W][A-IW]?|\d[A-Z+*]\d{5}[A-W]	weighted sum mod 23	6433435F
+IT	IT	\d{11}	Luhn	00743110157
+LT	LT	\d{9}|\d{12}	weighted sum mod 11	119511515
+LU	LU	\d{8}	mod 89	15027442
+LV	LV	\d{11}	weighted sum mod 11	40003521600
+MT	MT	[1-9]\d{7}	weighted sum mod 37	11679112
+NL	NL	\d{9}B\d{2}	weighted sum mod 11 or mod 97	004495445B01
+PL	PL	\d{10}	weighted sum mod 11	8567346215
+PT	PT	[1-9]\d{8}	weighted sum mod 11	501964843
+RO	RO	[1-9]\d{1,9}	weighted sum mod 11	18547290
+SE	SE	\d{10}01	Luhn	123456789701
+SI	SI	[1-9]\d{7}	weighted sum mod 11	50223054
+SK	SK	[1-9]\d[2-47-9]\d{7}	mod 11	2022749619
+XI	GB	\d{9}|\d{12}|GD[0-4]\d{2}|HA[5-9]\d{2}	weighted sum mod 97	980780684
+`
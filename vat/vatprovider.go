@@ -0,0 +1,288 @@
+// Copyright 2014 Musicbeat.com. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package vat implements the methods of a stddata.Provider.
+It provides searches against the formats of the VAT identification
+numbers of the member states of the European Union: for each member
+state, the prefix of its numbers, their pattern and the kind of their
+check digit algorithm. The package also validates VAT numbers, using
+the formats and the check digits, for invoicing software that must
+check the numbers of its customers. The check is of the syntax only:
+whether a number has been issued, and to whom, can only be answered
+by the VIES service of the European Commission. Source data is
+declared in vatdata.go
+*/
+package vat
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/musicbeat/stddata"
+)
+
+// VATProvider implements the Provider interface.
+type VATProvider struct {
+	data atomic.Pointer[vatData] // nil until the data is loaded
+}
+
+// vatData is the data of a VATProvider. Once it is stored in the
+// VATProvider it is not modified.
+type vatData struct {
+	size          int
+	info          stddata.Info
+	formatIndexes map[string]formatIndex
+	// patterns holds the compiled pattern of each prefix, anchored to
+	// match a whole number.
+	patterns map[string]*regexp.Regexp
+}
+
+type formatIndex struct {
+	formatMap  map[string][]Format
+	formatKeys []string
+	foldedKeys []string // the keys in lower case, for stddata.PrefixRange
+}
+
+// Format models one entity: the VAT number format of one member state.
+type Format struct {
+	Prefix      string // the prefix of the numbers, for example "DE", or "EL" for Greece
+	CountryCode string // ISO 3166-1 alpha-2 code, for example "DE", or "GR" for Greece
+	Pattern     string // a regular expression that matches a number without its prefix, for example `[1-9]\d{8}`
+	Algorithm   string // the kind of check digit algorithm, for example "ISO 7064 MOD 11,10"
+	Example     string // a valid number, without its prefix
+}
+
+// FormatResult is the interface{} that is returned from Search
+type FormatResult struct {
+	Formats [][]Format
+}
+
+// Load implements the Loader interface. A malformed record
+// in the source data causes Load to fail.
+func (p *VATProvider) Load() (n int, err error) {
+	r, err := p.LoadMode(stddata.Strict)
+	return r.Loaded, err
+}
+
+// LoadMode loads the data, treating malformed records according
+// to mode. In stddata.Lenient mode, malformed records are skipped,
+// and their line numbers are returned in the LoadReport.
+func (p *VATProvider) LoadMode(mode stddata.ParseMode) (r stddata.LoadReport, err error) {
+	// initialize the maps:
+	d := &vatData{formatIndexes: make(map[string]formatIndex)}
+	d.patterns = make(map[string]*regexp.Regexp)
+	prefixMap := make(map[string][]Format)
+	countryMap := make(map[string][]Format)
+	algorithmMap := make(map[string][]Format)
+
+	reader := csv.NewReader(strings.NewReader(vatdata))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = 5
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if perr, ok := err.(*csv.ParseError); ok && mode == stddata.Lenient {
+			r.Skip(perr.StartLine)
+			continue
+		} else if err != nil {
+			return r, stddata.NewSourceError(err.Error(), err)
+		}
+
+		var f Format
+		f.Prefix = record[0]
+		f.CountryCode = record[1]
+		f.Pattern = record[2]
+		f.Algorithm = record[3]
+		f.Example = record[4]
+		re, err := regexp.Compile("^(?:" + f.Pattern + ")$")
+		if err == nil && checks[f.Prefix] == nil {
+			err = errors.New("no check digit algorithm")
+		}
+		if err == nil && !(re.MatchString(f.Example) && checks[f.Prefix](f.Example)) {
+			err = errors.New("invalid example " + f.Example)
+		}
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			if mode == stddata.Lenient {
+				r.Skip(line)
+				continue
+			}
+			msg := fmt.Sprintf("record on line %d: malformed VAT number format for %s: %v", line, f.Prefix, err)
+			return r, stddata.NewSourceError(msg, nil)
+		}
+
+		// add the Format to the maps
+		prefixMap[f.Prefix] = append(prefixMap[f.Prefix], f)
+		countryMap[f.CountryCode] = append(countryMap[f.CountryCode], f)
+		algorithmMap[f.Algorithm] = append(algorithmMap[f.Algorithm], f)
+		d.patterns[f.Prefix] = re
+	}
+	p.storeData(d, "prefix", prefixMap)
+	p.storeData(d, "country", countryMap)
+	p.storeData(d, "algorithm", algorithmMap)
+	d.size = len(prefixMap)
+	d.info = stddata.Info{
+		Source:   source,
+		URL:      sourceURL,
+		Edition:  edition,
+		Count:    d.size,
+		LoadedAt: time.Now(),
+	}
+	p.data.Store(d)
+	r.Loaded = len(prefixMap)
+	return r, nil
+}
+
+// Info describes the provenance of the loaded data.
+func (p *VATProvider) Info() stddata.Info {
+	if d := p.data.Load(); d != nil {
+		return d.info
+	}
+	return stddata.Info{}
+}
+
+// FormatOf returns the VAT number format of the member state whose
+// prefix, or ISO 3166-1 alpha-2 code, is countryCode: "EL" and "GR"
+// both find Greece. found is false if countryCode is neither.
+func (p *VATProvider) FormatOf(countryCode string) (f Format, found bool) {
+	d := p.data.Load()
+	if d == nil {
+		return f, false
+	}
+	return d.formatOf(countryCode)
+}
+
+// formatOf returns the Format whose prefix, or alpha-2 code, is
+// countryCode, and whether it was found.
+func (d *vatData) formatOf(countryCode string) (f Format, found bool) {
+	code := strings.ToUpper(countryCode)
+	formats, found := d.formatIndexes["prefix"].formatMap[code]
+	if !found {
+		formats, found = d.formatIndexes["country"].formatMap[code]
+	}
+	if !found {
+		return f, false
+	}
+	return formats[0], true
+}
+
+func (p *VATProvider) storeData(d *vatData, s string, m map[string][]Format) {
+	// store the map
+	var fi formatIndex
+	fi.formatMap = m
+	// extract the keys
+	fi.formatKeys = make([]string, len(m))
+	i := 0
+	for k := range m {
+		fi.formatKeys[i] = k
+		i++
+	}
+	// sort the keys
+	fi.foldedKeys = stddata.SortKeys(fi.formatKeys)
+	// add to formatIndexes
+	d.formatIndexes[s] = fi
+}
+
+// Indexes returns the descriptions of the indexes that Search searches,
+// for stddata.IndexLister.
+func (p *VATProvider) Indexes() []stddata.IndexDescription {
+	return []stddata.IndexDescription{
+		{Name: "prefix", Description: "prefix of the numbers", Example: "EL"},
+		{Name: "country", Description: "ISO 3166-1 alpha-2 code of the country", Example: "GR"},
+		{Name: "algorithm", Description: "check digit algorithm", Example: "ISO 7064"},
+	}
+}
+
+// Suggest returns the keys of index that are close to query, for a
+// query that finds nothing, for stddata.Suggester.
+func (p *VATProvider) Suggest(index string, query string) []string {
+	d := p.data.Load()
+	if d == nil {
+		return nil
+	}
+	fi := d.formatIndexes[index]
+	return stddata.Suggest(fi.formatKeys, fi.foldedKeys, query)
+}
+
+// Search returns a collection as an interface{} and error. The collection
+// contains an array of the results to the search. The value
+// in index is used to choose the map of Format entities that will be searched.
+// If the value in index does not match the name of a map, an error is returned.
+// The keys in the map specified by index are searched using a regex-like 'query.*', and
+// any matching Formats are returned in the result.
+// Search can also "dump" an index. When the value of query is "_dump", the index specified
+// is used to supply the entire data set, in the order of the index.
+func (p *VATProvider) Search(index string, query string) (result interface{}, err error) {
+	// make sure the data is loaded
+	d := p.data.Load()
+	if d == nil {
+		return nil, stddata.ErrNotLoaded
+	}
+	fi, found := d.formatIndexes[index]
+	if !found {
+		// search cannot be performed
+		return nil, stddata.NewIndexError(index, d.formatIndexes)
+	}
+	if len(query) < 1 {
+		return nil, stddata.ErrEmptyQuery
+	}
+	result = doSearch(fi, query)
+	return result, nil
+}
+
+// SearchFormats is Search, returning the matches as they are, rather
+// than as a FormatResult in an interface{}.
+func (p *VATProvider) SearchFormats(index string, query string) ([][]Format, error) {
+	res, err := p.Search(index, query)
+	if err != nil {
+		return nil, err
+	}
+	return res.(FormatResult).Formats, nil
+}
+
+// Range returns an iterator over the keys of index, in the order of
+// the index, and the Formats of each. It yields nothing if the
+// VATProvider has no such index, or is not loaded.
+func (p *VATProvider) Range(index string) iter.Seq2[string, []Format] {
+	return func(yield func(string, []Format) bool) {
+		d := p.data.Load()
+		if d == nil {
+			return
+		}
+		fi := d.formatIndexes[index]
+		for _, k := range fi.formatKeys {
+			if !yield(k, fi.formatMap[k]) {
+				return
+			}
+		}
+	}
+}
+func doSearch(fi formatIndex, query string) (res FormatResult) {
+	// the "reserved" query term "_dump" is handled by returning all the
+	// results in the order of the index.
+	dump := query == "_dump"
+	// binary search the sorted list of keys for the range that matches 'query.*'.
+	lo, hi := 0, len(fi.formatKeys)
+	if !dump {
+		lo, hi = stddata.PrefixRange(fi.foldedKeys, query)
+	}
+	// prepare the response. allocate just enough space for the matches.
+	// add each match to the result array. The results are added in the
+	// order of the sorted keys, so the results are sorted.
+	res.Formats = make([][]Format, 0, hi-lo)
+	for k := lo; k < hi; k++ {
+		res.Formats = append(res.Formats, fi.formatMap[fi.formatKeys[k]])
+	}
+	return res
+}
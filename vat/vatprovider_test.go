@@ -0,0 +1,98 @@
+package vat
+
+// Keep reading: http://golang.org/doc/code.html#Testing
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/musicbeat/stddata"
+	"github.com/musicbeat/stddata/stddatatest"
+)
+
+var p Provider
+
+func TestVATProvider(t *testing.T) {
+	expected := 28
+	fmt.Println("Test: VATProvider.Load")
+	p = new(VATProvider)
+	n, err := p.Load()
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	if n != expected {
+		t.Fatalf("Expected to load %d, loaded %d\n", expected, n)
+	}
+}
+func TestCountrySearch(t *testing.T) {
+	res, err := p.Search("country", "gr")
+	if err != nil {
+		t.Fatalf("Err %v\n", err)
+	}
+	f := res.(FormatResult).Formats
+	if len(f) != 1 || f[0][0].Prefix != "EL" {
+		t.Fatalf("Expected the Greek format, got %v\n", f)
+	}
+}
+func TestFormatOf(t *testing.T) {
+	vp := p.(*VATProvider)
+	for _, code := range []string{"EL", "gr"} {
+		f, found := vp.FormatOf(code)
+		if !found || f.CountryCode != "GR" {
+			t.Fatalf("Expected the Greek format for %s, got %v\n", code, f)
+		}
+	}
+	if _, found := vp.FormatOf("US"); found {
+		t.Fatalf("Expected no format for US\n")
+	}
+}
+func TestValidate(t *testing.T) {
+	valid := [][2]string{
+		{"DE", "DE136695976"},
+		{"DE", "136 695 976"},
+		{"at", "atu13585627"},
+		{"GR", "EL094259216"},
+		{"EL", "094259216"},
+		{"FR", "FR 40 303 265 045"},
+		{"ES", "ESA13585625"},
+		{"NL", "NL004495445B01"},
+		{"IE", "IE6433435F"},
+		{"BE", "BE0403.019.261"},
+		{"GB", "XI980780684"},
+		{"SE", "SE123456789701"},
+	}
+	for _, v := range valid {
+		if err := Validate(v[0], v[1]); err != nil {
+			t.Fatalf("Expected %s to be valid in %s, got %v\n", v[1], v[0], err)
+		}
+	}
+	invalid := [][2]string{
+		{"DE", ""},
+		{"DE", "DE136695977"},   // check digit
+		{"DE", "DE13669597"},    // length
+		{"AT", "AT13585627"},    // pattern
+		{"IT", "IT00743110158"}, // check digit
+		{"FR", "DE136695976"},   // wrong member state
+		{"US", "123456789"},     // no VAT numbers
+	}
+	for _, v := range invalid {
+		if err := Validate(v[0], v[1]); err == nil {
+			t.Fatalf("Expected %s to be invalid in %s\n", v[1], v[0])
+		}
+	}
+	if s := Compact(" de-136.695 976 "); s != "DE136695976" {
+		t.Fatalf("Expected the electronic form, got %q\n", s)
+	}
+}
+func BenchmarkLoad(b *testing.B) {
+	stddatatest.BenchmarkLoad(b, func() Provider { return new(VATProvider) })
+}
+func BenchmarkSearch(b *testing.B) {
+	bp := new(VATProvider)
+	if _, err := bp.Load(); err != nil {
+		b.Fatalf("Err %v\n", err)
+	}
+	stddatatest.BenchmarkSearch(b, bp, []stddatatest.Search{{Index: "prefix", Query: "EL"}, {Index: "country", Query: "GR"}})
+}
+func TestIndexes(t *testing.T) {
+	stddatatest.CheckIndexes(t, p)
+}